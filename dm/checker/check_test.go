@@ -20,10 +20,10 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 
-	router "github.com/pingcap/tidb-tools/pkg/table-router"
 	"github.com/pingcap/tiflow/dm/dm/config"
 	"github.com/pingcap/tiflow/dm/dm/ctl/common"
 	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/pingcap/tiflow/dm/pkg/router"
 
 	tc "github.com/pingcap/check"
 )
@@ -54,6 +54,8 @@ func ignoreExcept(itemMap map[string]struct{}) []string {
 		config.TableSchemaChecking,
 		config.ShardTableSchemaChecking,
 		config.ShardAutoIncrementIDChecking,
+		config.CharsetChecking,
+		config.OnlineDDLRulesChecking,
 	}
 	ignoreCheckingItems := make([]string, 0, len(items)-len(itemMap))
 	for _, i := range items {
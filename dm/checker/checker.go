@@ -34,7 +34,9 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/dumpling"
 	fr "github.com/pingcap/tiflow/dm/pkg/func-rollback"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/router"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/transcode"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	onlineddl "github.com/pingcap/tiflow/dm/syncer/online-ddl-tools"
 
@@ -42,7 +44,6 @@ import (
 	column "github.com/pingcap/tidb-tools/pkg/column-mapping"
 	"github.com/pingcap/tidb-tools/pkg/dbutil"
 	"github.com/pingcap/tidb-tools/pkg/filter"
-	router "github.com/pingcap/tidb-tools/pkg/table-router"
 	"github.com/pingcap/tidb/dumpling/export"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -124,6 +125,7 @@ func (c *Checker) Init(ctx context.Context) (err error) {
 	shardingCounter := make(map[string]int)
 	dbs := make(map[string]*sql.DB)
 	columnMapping := make(map[string]*column.Mapping)
+	routers := make(map[string]*router.Table)
 	_, checkingShardID := c.checkingItems[config.ShardAutoIncrementIDChecking]
 	_, checkingShard := c.checkingItems[config.ShardTableSchemaChecking]
 	_, checkSchema := c.checkingItems[config.TableSchemaChecking]
@@ -137,6 +139,7 @@ func (c *Checker) Init(ctx context.Context) (err error) {
 		if err != nil {
 			return terror.ErrTaskCheckGenTableRouter.Delegate(err)
 		}
+		routers[instance.cfg.SourceID] = r
 
 		if instance.cfg.OnlineDDL && c.onlineDDL == nil {
 			c.onlineDDL, err = onlineddl.NewRealOnlinePlugin(c.tctx, instance.cfg)
@@ -240,10 +243,37 @@ func (c *Checker) Init(ctx context.Context) (err error) {
 		}
 		if c.onlineDDL != nil {
 			c.checkList = append(c.checkList, checker.NewOnlineDDLChecker(instance.sourceDB.DB, checkSchemas, c.onlineDDL, bw))
+			if _, ok := c.checkingItems[config.OnlineDDLRulesChecking]; ok {
+				c.checkList = append(c.checkList, checker.NewOnlineDDLRulesChecker(checkTables, c.onlineDDL))
+			}
 		}
 		if checkSchema {
 			c.checkList = append(c.checkList, checker.NewTablesChecker(instance.sourceDB.DB, instance.sourceDBinfo, checkTables))
 		}
+		if _, ok := c.checkingItems[config.CharsetChecking]; ok && len(instance.cfg.CharsetRules) > 0 {
+			transcoder, err := transcode.NewTranscoder(instance.cfg.CaseSensitive, instance.cfg.CharsetRules)
+			if err != nil {
+				return err
+			}
+			// schema => table => rule
+			charsetTables := make(map[string]map[string]*config.CharsetRule)
+			for _, tables := range mapping {
+				for _, table := range tables {
+					rule := transcoder.Match(table.Schema, table.Name)
+					if rule == nil {
+						continue
+					}
+					if _, ok := charsetTables[table.Schema]; !ok {
+						charsetTables[table.Schema] = make(map[string]*config.CharsetRule)
+					}
+					charsetTables[table.Schema][table.Name] = rule
+				}
+			}
+			if len(charsetTables) > 0 {
+				c.checkList = append(c.checkList, checker.NewCharsetChecker(instance.sourceDB.DB, instance.sourceDBinfo,
+					instance.targetDB.DB, instance.targetDBInfo, charsetTables))
+			}
+		}
 	}
 
 	if checkingShard {
@@ -252,7 +282,8 @@ func (c *Checker) Init(ctx context.Context) (err error) {
 				continue
 			}
 
-			c.checkList = append(c.checkList, checker.NewShardingTablesChecker(name, dbs, shardingSet, columnMapping, checkingShardID))
+			c.checkList = append(c.checkList, checker.NewShardingTablesChecker(name, dbs, shardingSet, columnMapping, checkingShardID,
+				c.instances[0].cfg.AutoIDConflictStrategy, routers))
 		}
 	}
 
@@ -361,10 +361,13 @@ func (oc *syncerConfig) convertToNewFormat() (*config.SubTaskConfig, error) {
 		})
 	}
 
-	newTask.FilterRules, err = generateBinlogEventRule(oc.SkipDDLs, oc.SkipDMLs)
+	filterRules, err := generateBinlogEventRule(oc.SkipDDLs, oc.SkipDMLs)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	for _, rule := range filterRules {
+		newTask.FilterRules = append(newTask.FilterRules, &config.BinlogEventRule{BinlogEventRule: *rule})
+	}
 	err = newTask.Adjust(false)
 	return newTask, err
 }
@@ -29,12 +29,18 @@ var (
 	// ClusterIDKey is used to store the cluster id of the whole dm cluster. Cluster id is the unique identification of dm cluster
 	// After leader of dm master bootstraped, the leader will get the id from etcd or generate fresh one, and backfill to etcd.
 	ClusterIDKey = "/dm-cluster/id"
+	// AuditLogUserKey is the gRPC metadata key and the HTTP header to pass the user identity recorded in the audit log.
+	AuditLogUserKey = "dm-audit-user"
 	// WorkerRegisterKeyAdapter is used to encode and decode register key.
 	// k/v: Encode(worker-name) -> the information of the DM-worker node.
 	WorkerRegisterKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-worker/r/")
 	// WorkerKeepAliveKeyAdapter is used to encode and decode keepalive key.
 	// k/v: Encode(worker-name) -> time.
 	WorkerKeepAliveKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-worker/a/")
+	// WorkerLoadKeyAdapter is used to store the load reported by the DM-worker periodically, which is used to schedule
+	// sources to the under-utilized DM-workers.
+	// k/v: Encode(worker-name) -> the load of the DM-worker.
+	WorkerLoadKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-worker/load/")
 	// LoadTaskKeyAdapter is used to store the worker which in load stage for the source of the subtask.
 	// k/v: Encode(task, source-id) -> worker-name.
 	LoadTaskKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/load-task/")
@@ -51,6 +57,10 @@ var (
 	// UpstreamRelayWorkerKeyAdapter is used to store the upstream which this worker needs to pull relay log
 	// k/v: Encode(worker-name) -> source-id.
 	UpstreamRelayWorkerKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/relay-worker/")
+	// RelayMetaKeyAdapter is used to store the relay meta of the source, to let another DM-worker take over
+	// pulling relay log from the position where the previous DM-worker stopped.
+	// k/v: Encode(source-id) -> relay meta.
+	RelayMetaKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-worker/relay-meta/")
 	// UpstreamSubTaskKeyAdapter is used to store SubTask which are subscribing data from MySQL source.
 	// k/v: Encode(source-id, task-name) -> SubTaskConfig.
 	UpstreamSubTaskKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/upstream/subtask/")
@@ -67,6 +77,9 @@ var (
 	// ShardDDLPessimismOperationKeyAdapter is used to store shard DDL operation in pessimistic model.
 	// k/v: Encode(task-name, source-id) -> shard DDL operation.
 	ShardDDLPessimismOperationKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/shardddl-pessimism/operation/")
+	// ShardDDLPessimismAutoUnlockKeyAdapter is used to store the audit records of the locks unlocked automatically after timeout.
+	// k/v: Encode(task-name, lock-id, unlock-time) -> auto-unlock record.
+	ShardDDLPessimismAutoUnlockKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/shardddl-pessimism/auto-unlock/")
 
 	// ShardDDLOptimismSourceTablesKeyAdapter is used to store INITIAL upstream schema & table names when starting the subtask.
 	// In other words, if any Info for this subtask exists, we should obey source tables in the Info.
@@ -104,18 +117,27 @@ var (
 	// config because the command line arguments may be expected to take effect only once when failover.
 	// kv: Encode(task-name, source-id) -> TaskCliArgs.
 	TaskCliArgsKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/task-cli-args/")
+	// AuditLogKeyAdapter is used to store the audit logs of the control-plane mutations handled by the DM-master.
+	// kv: Encode(zero-padded unix nano time) -> the audit log.
+	AuditLogKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/audit-log/")
+	// WebhookKeyAdapter is used to store the webhooks registered to receive the notifications of DM events.
+	// kv: Encode(webhook-name) -> the webhook.
+	WebhookKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/webhook/")
 )
 
 func keyAdapterKeysLen(s KeyAdapter) int {
 	switch s {
 	case WorkerRegisterKeyAdapter, UpstreamConfigKeyAdapter, UpstreamBoundWorkerKeyAdapter,
 		WorkerKeepAliveKeyAdapter, StageRelayKeyAdapter,
-		UpstreamLastBoundWorkerKeyAdapter, UpstreamRelayWorkerKeyAdapter, OpenAPITaskTemplateKeyAdapter:
+		UpstreamLastBoundWorkerKeyAdapter, UpstreamRelayWorkerKeyAdapter, OpenAPITaskTemplateKeyAdapter,
+		RelayMetaKeyAdapter, WorkerLoadKeyAdapter, AuditLogKeyAdapter, WebhookKeyAdapter:
 		return 1
 	case UpstreamSubTaskKeyAdapter, StageSubTaskKeyAdapter,
 		ShardDDLPessimismInfoKeyAdapter, ShardDDLPessimismOperationKeyAdapter,
 		ShardDDLOptimismSourceTablesKeyAdapter, LoadTaskKeyAdapter, TaskCliArgsKeyAdapter:
 		return 2
+	case ShardDDLPessimismAutoUnlockKeyAdapter:
+		return 3
 	case ShardDDLOptimismInfoKeyAdapter, ShardDDLOptimismOperationKeyAdapter:
 		return 4
 	case ShardDDLOptimismDroppedColumnsKeyAdapter:
@@ -0,0 +1,90 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// BinlogEventRule is the binlog event filter rule, which can also filter the rows of row events by the column values.
+// a rule with RowValueExpr is applied by syncer on the decoded rows of the matched tables and events: the rows
+// satisfying the expression are dropped by an `Ignore` rule, and the rows not satisfying it are dropped by a `Do` rule.
+type BinlogEventRule struct {
+	bf.BinlogEventRule `yaml:",inline" toml:",inline" json:",inline"`
+
+	// the name of the rule in task config, used to report the filtered rows.
+	Name         string `yaml:"-" toml:"name,omitempty" json:"name,omitempty"`
+	RowValueExpr string `yaml:"row-value-expr,omitempty" toml:"row-value-expr,omitempty" json:"row-value-expr,omitempty"`
+}
+
+// rowValueEvents are the events which the rules with RowValueExpr can match.
+var rowValueEvents = map[bf.EventType]struct{}{
+	bf.AllDML:      {},
+	bf.InsertEvent: {},
+	bf.UpdateEvent: {},
+	bf.DeleteEvent: {},
+}
+
+// validate checks the RowValueExpr of the rule.
+func (r *BinlogEventRule) validate() error {
+	if r.RowValueExpr == "" {
+		return nil
+	}
+	name := r.Name
+	if name == "" {
+		name = r.SchemaPattern + "." + r.TablePattern
+	}
+	if err := r.BinlogEventRule.Valid(); err != nil {
+		return terror.ErrConfigBinlogEventFilter.Delegate(err)
+	}
+	if len(r.SQLPattern) > 0 {
+		return terror.ErrConfigInvalidRowValueFilter.Generate(name, "sql-pattern can't be used together")
+	}
+	if len(r.Events) == 0 {
+		return terror.ErrConfigInvalidRowValueFilter.Generate(name, "no DML event is specified")
+	}
+	for _, event := range r.Events {
+		if _, ok := rowValueEvents[event]; !ok {
+			return terror.ErrConfigInvalidRowValueFilter.Generate(name, "event "+string(event)+" is not a DML event")
+		}
+	}
+	if err := checkValidExpr(r.RowValueExpr); err != nil {
+		return terror.ErrConfigInvalidRowValueFilter.Generate(name, err.Error())
+	}
+	return nil
+}
+
+// BinlogFilterRules returns the rules applied by the binlog event filter, which are the rules without RowValueExpr.
+func BinlogFilterRules(rules []*BinlogEventRule) []*bf.BinlogEventRule {
+	ret := make([]*bf.BinlogEventRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.RowValueExpr == "" {
+			ret = append(ret, &rule.BinlogEventRule)
+		}
+	}
+	return ret
+}
+
+// RowValueFilterRules returns the rules with RowValueExpr.
+func RowValueFilterRules(rules []*BinlogEventRule) []*BinlogEventRule {
+	ret := make([]*BinlogEventRule, 0)
+	for _, rule := range rules {
+		if rule.RowValueExpr != "" {
+			ret = append(ret, rule)
+		}
+	}
+	return ret
+}
@@ -34,6 +34,8 @@ const (
 	TableSchemaChecking          = "table_schema"
 	ShardTableSchemaChecking     = "schema_of_shard_tables"
 	ShardAutoIncrementIDChecking = "auto_increment_ID"
+	CharsetChecking              = "charset"
+	OnlineDDLRulesChecking       = "online_ddl_rules"
 )
 
 // AllCheckingItems contains all checking items.
@@ -49,6 +51,8 @@ var AllCheckingItems = map[string]string{
 	TableSchemaChecking:          "table schema compatibility checking item",
 	ShardTableSchemaChecking:     "consistent schema of shard tables checking item",
 	ShardAutoIncrementIDChecking: "conflict auto increment ID of shard tables checking item",
+	CharsetChecking:              "charset of tables matched by charset rules checking item",
+	OnlineDDLRulesChecking:       "tables matched by shadow and trash table rules of online DDL checking item",
 }
 
 // MaxSourceIDLength is the max length for dm-worker source id.
@@ -25,4 +25,8 @@ type ExpressionFilter struct {
 	UpdateOldValueExpr string `yaml:"update-old-value-expr" toml:"update-old-value-expr" json:"update-old-value-expr"`
 	UpdateNewValueExpr string `yaml:"update-new-value-expr" toml:"update-new-value-expr" json:"update-new-value-expr"`
 	DeleteValueExpr    string `yaml:"delete-value-expr" toml:"delete-value-expr" json:"delete-value-expr"`
+	// the path of a Go plugin which creates a row filter to keep, drop or rewrite all the row changes of the table,
+	// see package rowfilter. it can't be used together with the expressions.
+	Plugin     string `yaml:"plugin" toml:"plugin" json:"plugin"`
+	PluginArgs string `yaml:"plugin-args" toml:"plugin-args" json:"plugin-args"`
 }
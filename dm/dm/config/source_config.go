@@ -18,6 +18,7 @@ import (
 	"context"
 	"database/sql"
 	_ "embed"
+	"encoding/hex"
 	"encoding/json"
 	"math"
 	"math/rand"
@@ -58,6 +59,49 @@ type PurgeConfig struct {
 	Interval    int64 `yaml:"interval" toml:"interval" json:"interval"`             // check whether need to purge at this @Interval (seconds)
 	Expires     int64 `yaml:"expires" toml:"expires" json:"expires"`                // if file's modified time is older than @Expires (hours), then it can be purged
 	RemainSpace int64 `yaml:"remain-space" toml:"remain-space" json:"remain-space"` // if remain space in @RelayBaseDir less than @RemainSpace (GB), then it can be purged
+	// if true, relay log files still needed by relay log readers or task checkpoints can be purged, only the one being written is kept
+	IgnoreActiveReaders bool `yaml:"ignore-active-readers" toml:"ignore-active-readers" json:"ignore-active-readers"`
+	// if a relay sub directory has no relay log files, is not modified for @SubDirRetention (hours) and is earlier than
+	// all relay log readers and task checkpoints, it's removed and trimmed from the UUID index file, 0 means never
+	SubDirRetention int64 `yaml:"sub-dir-retention" toml:"sub-dir-retention" json:"sub-dir-retention"`
+}
+
+// WorkerAffinity is the affinity of the source to the DM-workers by their labels, DM-master honors it when binding the
+// source to a DM-worker.
+type WorkerAffinity struct {
+	// the source can only be bound to the DM-workers which have all these labels.
+	Required map[string]string `yaml:"required,omitempty" toml:"required" json:"required"`
+	// the source prefers the DM-workers which have more of these labels, like the zone of the upstream, so the relay
+	// log is pulled close to the upstream and the failover happens in the same zone.
+	Preferred map[string]string `yaml:"preferred,omitempty" toml:"preferred" json:"preferred"`
+	// the source can't be bound to the DM-workers which have any of these labels.
+	Excluded map[string]string `yaml:"excluded,omitempty" toml:"excluded" json:"excluded"`
+}
+
+// Match returns whether the DM-worker with the labels satisfies the required and excluded labels.
+func (a WorkerAffinity) Match(labels map[string]string) bool {
+	for k, v := range a.Required {
+		if labels[k] != v {
+			return false
+		}
+	}
+	for k, v := range a.Excluded {
+		if lv, ok := labels[k]; ok && lv == v {
+			return false
+		}
+	}
+	return true
+}
+
+// PreferredScore returns the number of the preferred labels the DM-worker with the labels has.
+func (a WorkerAffinity) PreferredScore(labels map[string]string) int {
+	score := 0
+	for k, v := range a.Preferred {
+		if lv, ok := labels[k]; ok && lv == v {
+			score++
+		}
+	}
+	return score
 }
 
 // SourceConfig is the configuration for source.
@@ -73,6 +117,10 @@ type SourceConfig struct {
 	// relay synchronous starting point (if specified)
 	RelayBinLogName string `yaml:"relay-binlog-name" toml:"relay-binlog-name" json:"relay-binlog-name"`
 	RelayBinlogGTID string `yaml:"relay-binlog-gtid" toml:"relay-binlog-gtid" json:"relay-binlog-gtid"`
+	// persist relay meta into etcd besides local files, so another worker can take over relay at the exact position
+	RelayMetaInEtcd bool `yaml:"relay-meta-in-etcd" toml:"relay-meta-in-etcd" json:"relay-meta-in-etcd"`
+	// hex encoded AES key (16, 24 or 32 bytes) used to decrypt the relay log files which are encrypted at rest
+	RelayEncryptKey string `yaml:"relay-encrypt-key" toml:"relay-encrypt-key" json:"-"`
 	// only use when worker bound source, do not marsh it
 	UUIDSuffix int `yaml:"-" toml:"-" json:"-"`
 
@@ -93,6 +141,9 @@ type SourceConfig struct {
 
 	CaseSensitive bool                  `yaml:"case-sensitive" toml:"case-sensitive" json:"case-sensitive"`
 	Filters       []*bf.BinlogEventRule `yaml:"filters" toml:"filters" json:"filters"`
+
+	// the affinity to the DM-workers by their labels
+	Affinity WorkerAffinity `yaml:"affinity" toml:"affinity" json:"affinity"`
 }
 
 // NewSourceConfig creates a new base config for upstream MySQL/MariaDB source.
@@ -213,6 +264,9 @@ func (c *SourceConfig) Verify() error {
 			return terror.WithClass(terror.Annotatef(err, "relay-binlog-gtid %s", c.RelayBinlogGTID), terror.ClassDMWorker)
 		}
 	}
+	if _, err = c.RelayEncryptKeyBytes(); err != nil {
+		return err
+	}
 
 	c.DecryptPassword()
 
@@ -225,6 +279,12 @@ func (c *SourceConfig) Verify() error {
 		return terror.ErrConfigCheckerMaxTooSmall.Generate(c.Checker.BackoffMax.Duration, c.Checker.BackoffMin.Duration)
 	}
 
+	for k, v := range c.Affinity.Required {
+		if ev, ok := c.Affinity.Excluded[k]; ok && ev == v {
+			return terror.ErrConfigWorkerAffinityConflict.Generate(k, v)
+		}
+	}
+
 	return nil
 }
 
@@ -393,6 +453,23 @@ func (c *SourceConfig) YamlForDowngrade() (string, error) {
 	return s.Yaml()
 }
 
+// RelayEncryptKeyBytes returns the decoded relay-encrypt-key, nil if it's not set.
+func (c *SourceConfig) RelayEncryptKeyBytes() ([]byte, error) {
+	if c.RelayEncryptKey == "" {
+		return nil, nil
+	}
+	key, err := hex.DecodeString(c.RelayEncryptKey)
+	if err != nil {
+		return nil, terror.ErrEncryptSecretKeyNotValid.Delegate(err, len(c.RelayEncryptKey))
+	}
+	switch len(key) {
+	case 16, 24, 32:
+		return key, nil
+	default:
+		return nil, terror.ErrEncryptSecretKeyNotValid.Generate(len(key))
+	}
+}
+
 // SourceConfigForDowngrade is the base configuration for source in v2.0.
 // This config is used for downgrade(config export) from a higher dmctl version.
 // When we add any new config item into SourceConfig, we should update it also.
@@ -416,6 +493,12 @@ type SourceConfigForDowngrade struct {
 	// any new config item, we mark it omitempty
 	CaseSensitive bool                  `yaml:"case-sensitive,omitempty"`
 	Filters       []*bf.BinlogEventRule `yaml:"filters,omitempty"`
+	// relay meta in etcd
+	RelayMetaInEtcd bool `yaml:"relay-meta-in-etcd,omitempty"`
+	// relay log encryption
+	RelayEncryptKey string `yaml:"relay-encrypt-key,omitempty"`
+	// worker affinity
+	Affinity WorkerAffinity `yaml:"affinity,omitempty"`
 }
 
 // NewSourceConfigForDowngrade creates a new base config for downgrade.
@@ -439,6 +522,9 @@ func NewSourceConfigForDowngrade(sourceCfg *SourceConfig) *SourceConfigForDowngr
 		Tracer:          sourceCfg.Tracer,
 		CaseSensitive:   sourceCfg.CaseSensitive,
 		Filters:         sourceCfg.Filters,
+		RelayMetaInEtcd: sourceCfg.RelayMetaInEtcd,
+		RelayEncryptKey: sourceCfg.RelayEncryptKey,
+		Affinity:        sourceCfg.Affinity,
 	}
 }
 
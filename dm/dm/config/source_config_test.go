@@ -224,6 +224,39 @@ func (t *testConfig) TestConfigVerify(c *C) {
 			},
 			"",
 		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.Affinity.Required = map[string]string{"zone": "zone-1"}
+				cfg.Affinity.Excluded = map[string]string{"zone": "zone-1"}
+				return cfg
+			},
+			".*label zone=zone-1 is both required and excluded in worker affinity.*",
+		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.RelayEncryptKey = "not-hex"
+				return cfg
+			},
+			".*key size should be 16, 24 or 32, but input key's size is 7.*",
+		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.RelayEncryptKey = "0123456789abcdef"
+				return cfg
+			},
+			".*key size should be 16, 24 or 32, but input key's size is 8.*",
+		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.RelayEncryptKey = "0123456789abcdef0123456789abcdef"
+				return cfg
+			},
+			"",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -239,6 +272,43 @@ func (t *testConfig) TestConfigVerify(c *C) {
 	}
 }
 
+func (t *testConfig) TestWorkerAffinity(c *C) {
+	cfg, err := ParseYaml(`
+source-id: mysql-replica-01
+affinity:
+  required:
+    tier: ssd
+  preferred:
+    zone: zone-1
+    rack: rack-1
+  excluded:
+    host: host-1
+`)
+	c.Assert(err, IsNil)
+	c.Assert(cfg.Verify(), IsNil)
+	affinity := cfg.Affinity
+
+	c.Assert(affinity.Match(nil), IsFalse)
+	c.Assert(affinity.Match(map[string]string{"tier": "hdd"}), IsFalse)
+	c.Assert(affinity.Match(map[string]string{"tier": "ssd"}), IsTrue)
+	c.Assert(affinity.Match(map[string]string{"tier": "ssd", "host": "host-1"}), IsFalse)
+	c.Assert(affinity.Match(map[string]string{"tier": "ssd", "host": "host-2"}), IsTrue)
+	c.Assert(affinity.PreferredScore(map[string]string{"tier": "ssd"}), Equals, 0)
+	c.Assert(affinity.PreferredScore(map[string]string{"zone": "zone-1", "rack": "rack-2"}), Equals, 1)
+	c.Assert(affinity.PreferredScore(map[string]string{"zone": "zone-1", "rack": "rack-1"}), Equals, 2)
+
+	// no affinity matches all workers.
+	c.Assert(WorkerAffinity{}.Match(nil), IsTrue)
+	c.Assert(WorkerAffinity{}.Match(map[string]string{"zone": "zone-1"}), IsTrue)
+
+	// the affinity is kept in the toml config stored in etcd.
+	content, err := cfg.Toml()
+	c.Assert(err, IsNil)
+	cfg2 := NewSourceConfig()
+	c.Assert(cfg2.Parse(content), IsNil)
+	c.Assert(cfg2.Affinity, DeepEquals, affinity)
+}
+
 func (t *testConfig) TestSourceConfigForDowngrade(c *C) {
 	cfg, err := LoadFromFile(sourceSampleFile)
 	c.Assert(err, IsNil)
@@ -25,11 +25,12 @@ import (
 	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
 	"github.com/pingcap/tidb-tools/pkg/column-mapping"
 	"github.com/pingcap/tidb-tools/pkg/filter"
-	router "github.com/pingcap/tidb-tools/pkg/table-router"
 	"go.uber.org/zap"
 
 	"github.com/pingcap/tiflow/dm/pkg/dumpling"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/router"
+	"github.com/pingcap/tiflow/dm/pkg/storage"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 )
@@ -48,6 +49,12 @@ const (
 	TiDBLightningCheckpointPrefix = "tidb_lightning_checkpoint_"
 )
 
+// database types.
+const (
+	DBTypeMySQL    = "mysql"
+	DBTypePostgres = "postgres"
+)
+
 var defaultMaxIdleConns = 2
 
 // RawDBConfig contains some low level database config.
@@ -86,6 +93,8 @@ func (c *RawDBConfig) SetMaxIdleConns(value int) *RawDBConfig {
 
 // DBConfig is the DB configuration.
 type DBConfig struct {
+	// Type is the type of database, empty means MySQL. only the target database can be PostgreSQL.
+	Type     string `toml:"type,omitempty" json:"type,omitempty" yaml:"type,omitempty"`
 	Host     string `toml:"host" json:"host" yaml:"host"`
 	Port     int    `toml:"port" json:"port" yaml:"port"`
 	User     string `toml:"user" json:"user" yaml:"user"`
@@ -100,6 +109,11 @@ type DBConfig struct {
 	RawDBCfg *RawDBConfig `toml:"-" json:"-" yaml:"-"`
 }
 
+// IsPostgres returns whether the database is PostgreSQL.
+func (db *DBConfig) IsPostgres() bool {
+	return db.Type == DBTypePostgres
+}
+
 func (db *DBConfig) String() string {
 	cfg, err := json.Marshal(db)
 	if err != nil {
@@ -184,11 +198,16 @@ type SubTaskConfig struct {
 	// when in sharding, multi dm-workers do one task
 	IsSharding bool   `toml:"is-sharding" json:"is-sharding"`
 	ShardMode  string `toml:"shard-mode" json:"shard-mode"`
-	OnlineDDL  bool   `toml:"online-ddl" json:"online-ddl"`
+	// timeout (in seconds) and strategy to unlock the pessimistic shard DDL lock automatically
+	ShardDDLLockTimeout         int    `toml:"shard-ddl-lock-timeout" json:"shard-ddl-lock-timeout"`
+	ShardDDLLockTimeoutStrategy string `toml:"shard-ddl-lock-timeout-strategy" json:"shard-ddl-lock-timeout-strategy"`
+	OnlineDDL                   bool   `toml:"online-ddl" json:"online-ddl"`
 
 	// pt/gh-ost name rule, support regex
 	ShadowTableRules []string `yaml:"shadow-table-rules" toml:"shadow-table-rules" json:"shadow-table-rules"`
 	TrashTableRules  []string `yaml:"trash-table-rules" toml:"trash-table-rules" json:"trash-table-rules"`
+	// other online schema change tools whose tables are recognized besides the rules above
+	OnlineDDLTools []string `yaml:"online-ddl-tools" toml:"online-ddl-tools" json:"online-ddl-tools"`
 
 	// deprecated
 	OnlineDDLScheme string `toml:"online-ddl-scheme" json:"online-ddl-scheme"`
@@ -222,20 +241,36 @@ type SubTaskConfig struct {
 
 	// RelayDir get value from dm-worker config
 	RelayDir string `toml:"relay-dir" json:"relay-dir"`
+	// RelayEncryptKey get value from the relay-encrypt-key of source config
+	RelayEncryptKey []byte `toml:"-" json:"-"`
 
 	// UseRelay get value from dm-worker's relayEnabled
 	UseRelay bool     `toml:"use-relay" json:"use-relay"`
 	From     DBConfig `toml:"from" json:"from"`
 	To       DBConfig `toml:"to" json:"to"`
 
-	RouteRules         []*router.TableRule   `toml:"route-rules" json:"route-rules"`
-	FilterRules        []*bf.BinlogEventRule `toml:"filter-rules" json:"filter-rules"`
-	ColumnMappingRules []*column.Rule        `toml:"mapping-rule" json:"mapping-rule"`
-	ExprFilter         []*ExpressionFilter   `yaml:"expression-filter" toml:"expression-filter" json:"expression-filter"`
+	RouteRules         []*router.TableRule `toml:"route-rules" json:"route-rules"`
+	FilterRules        []*BinlogEventRule  `toml:"filter-rules" json:"filter-rules"`
+	ColumnMappingRules []*column.Rule      `toml:"mapping-rule" json:"mapping-rule"`
+	ExprFilter         []*ExpressionFilter `yaml:"expression-filter" toml:"expression-filter" json:"expression-filter"`
+
+	// the extra column of all downstream tables which is populated with the source ID
+	SourceTagColumn string `toml:"source-tag-column" json:"source-tag-column"`
+	// strategy to handle the conflicts of the auto-increment keys of the merged shard tables
+	AutoIDConflictStrategy string `toml:"auto-id-conflict-strategy" json:"auto-id-conflict-strategy"`
+	// the index of the source in `mysql-instances` of the task, used to prefix the auto-increment keys
+	SourceIndex int `toml:"source-index" json:"source-index"`
+	// the priority of the task, the subtasks with lower priorities are throttled first when the DM-worker is busy
+	Priority string `toml:"priority" json:"priority"`
 
 	// black-white-list is deprecated, use block-allow-list instead
 	BWList *filter.Rules `toml:"black-white-list" json:"black-white-list"`
 	BAList *filter.Rules `toml:"block-allow-list" json:"block-allow-list"`
+	// the version of the route, filter and block-allow-list rules, it's increased each time the rules are hot reloaded.
+	RulesVersion int64 `toml:"rules-version" json:"rules-version"`
+	// the upstream tables paused by pause-table, the changes of them are skipped or buffered according to the policy
+	// while the other tables continue to be replicated.
+	PausedTables []*PausedTable `toml:"paused-tables" json:"paused-tables"`
 
 	MydumperConfig // Mydumper configuration
 	LoaderConfig   // Loader configuration
@@ -322,6 +357,15 @@ func (c *SubTaskConfig) Decode(data string, verifyDecryptPassword bool) error {
 	return c.Adjust(verifyDecryptPassword)
 }
 
+// loaderDirSuffix returns the suffix appended to the dir of the dump files. the subtasks of different sources share the
+// same dir in an external storage, so the source ID is added to separate them.
+func loaderDirSuffix(dir, task, source string) string {
+	if storage.IsExternalPath(dir) {
+		return "/" + task + "." + source
+	}
+	return "." + task
+}
+
 func adjustOnlineTableRules(ruleType string, rules []string) ([]string, error) {
 	adjustedRules := make([]string, 0, len(rules))
 	for _, r := range rules {
@@ -394,10 +438,11 @@ func (c *SubTaskConfig) Adjust(verifyDecryptPassword bool) error {
 		c.MetaSchema = defaultMetaSchema
 	}
 
-	dirSuffix := "." + c.Name
-	if !strings.HasSuffix(c.LoaderConfig.Dir, dirSuffix) { // check to support multiple times calling
-		// if not ends with the task name, we append the task name to the tail
-		c.LoaderConfig.Dir += dirSuffix
+	// if not ends with the suffix, we append the suffix to the tail, check to support multiple times calling
+	var err error
+	c.LoaderConfig.Dir, err = storage.AdjustPath(c.LoaderConfig.Dir, loaderDirSuffix(c.LoaderConfig.Dir, c.Name, c.SourceID))
+	if err != nil {
+		return terror.ErrConfigInvalidLoadDir.Delegate(err, c.LoaderConfig.Dir, "invalid url")
 	}
 
 	if c.SyncerConfig.QueueSize == 0 {
@@ -436,12 +481,87 @@ func (c *SubTaskConfig) Adjust(verifyDecryptPassword bool) error {
 		return terror.ErrConfigInvalidChunkFileSize.Generate(c.MydumperConfig.ChunkFilesize)
 	}
 
-	if _, err := bf.NewBinlogEvent(c.CaseSensitive, c.FilterRules); err != nil {
+	if _, err := bf.NewBinlogEvent(c.CaseSensitive, BinlogFilterRules(c.FilterRules)); err != nil {
 		return terror.ErrConfigBinlogEventFilter.Delegate(err)
 	}
+	for _, rule := range c.FilterRules {
+		if err := rule.validate(); err != nil {
+			return err
+		}
+	}
+	if err := c.MydumperConfig.adjust(); err != nil {
+		return err
+	}
 	if err := c.LoaderConfig.adjust(); err != nil {
 		return err
 	}
+	// tidb-lightning can't import compressed files yet.
+	if c.MydumperConfig.Compress != "" && c.LoaderConfig.ImportMode != LoadModeLoader {
+		return terror.ErrConfigInvalidDumpCompress.Generate(fmt.Sprintf("compressed dump files can only be imported by import-mode `%s`", LoadModeLoader))
+	}
+	if c.LoaderConfig.External != nil {
+		switch {
+		case c.Mode == ModeIncrement:
+			return terror.ErrConfigInvalidExternalData.Generate(fmt.Sprintf("external data can't be imported in task-mode `%s`", ModeIncrement))
+		case c.LoaderConfig.ImportMode == LoadModeLoader:
+			return terror.ErrConfigInvalidExternalData.Generate(fmt.Sprintf("external data can't be imported by import-mode `%s`", LoadModeLoader))
+		// tidb-lightning imports the external data, which doesn't support these configs.
+		case len(c.ColumnMappingRules) > 0, c.LoaderConfig.ImportRowsPerSecond > 0, c.LoaderConfig.ImportBytesPerSecond > 0:
+			return terror.ErrConfigInvalidExternalData.Generate("column-mapping-rules and import rate limit are not supported for external data")
+		}
+	}
+	if c.LoaderConfig.SkipCreateTable && c.LoaderConfig.ImportMode != LoadModeLoader {
+		return terror.ErrConfigInvalidSkipCreateTable.Generate(fmt.Sprintf("skip-create-table can only be used with import-mode `%s`", LoadModeLoader))
+	}
+	if c.LoaderConfig.MaxError > 0 && c.LoaderConfig.ImportMode != LoadModeLoader {
+		return terror.ErrConfigInvalidMaxError.Generate(fmt.Sprintf("max-error can only be used with import-mode `%s`", LoadModeLoader))
+	}
+	if storage.IsExternalPath(c.LoaderConfig.Dir) {
+		switch {
+		case c.LoaderConfig.ImportMode == LoadModeLoader:
+			return terror.ErrConfigInvalidLoadDir.Generate(c.LoaderConfig.Dir, fmt.Sprintf("the dump files in external storage can't be imported by import-mode `%s`", LoadModeLoader))
+		case c.LoaderConfig.External != nil:
+			return terror.ErrConfigInvalidLoadDir.Generate(c.LoaderConfig.Dir, "the dir should be a local path when importing external data")
+		}
+	}
+	if err := c.SyncerConfig.adjust(); err != nil {
+		return err
+	}
+	if c.SyncerConfig.OnBinlogGap == BinlogGapRedump && c.LoaderConfig.External != nil {
+		return terror.ErrConfigInvalidExternalData.Generate(fmt.Sprintf("on-binlog-gap `%s` can't be used with external data, which can't be dumped again", BinlogGapRedump))
+	}
+	if err := c.adjustDBType(); err != nil {
+		return err
+	}
+	if c.SinkURI != "" {
+		// the dumped data is only loaded into the target database, and the shard DDLs need to be coordinated
+		// with the structure of the downstream tables.
+		switch {
+		case c.Mode != ModeIncrement:
+			return terror.ErrConfigSinkNotSupport.Generate(fmt.Sprintf("task-mode %s", c.Mode))
+		case c.ShardMode != "":
+			return terror.ErrConfigSinkNotSupport.Generate("shard-mode")
+		}
+	}
+	if c.DryRunDir != "" {
+		// the dumped data would be loaded into the target database, and the shard DDLs are coordinated with other
+		// sources which would apply them.
+		switch {
+		case c.Mode != ModeIncrement:
+			return terror.ErrConfigDryRunNotSupport.Generate(fmt.Sprintf("task-mode %s", c.Mode))
+		case c.ShardMode != "":
+			return terror.ErrConfigDryRunNotSupport.Generate("shard-mode")
+		}
+	}
+
+	for _, pt := range c.PausedTables {
+		if err := pt.adjust(); err != nil {
+			return err
+		}
+	}
+	if err := AdjustTaskPriority(&c.Priority); err != nil {
+		return err
+	}
 
 	// TODO: check every member
 	// TODO: since we checked here, we could remove other terror like ErrSyncerUnitGenBAList
@@ -450,6 +570,70 @@ func (c *SubTaskConfig) Adjust(verifyDecryptPassword bool) error {
 	return nil
 }
 
+// PauseTablePolicy defines how the changes of a paused table are handled.
+type PauseTablePolicy string
+
+const (
+	// PauseTablePolicySkip represents the DMLs of the paused table are skipped and never replicated, the DDLs are still
+	// replicated to keep the table structure in downstream up to date.
+	PauseTablePolicySkip PauseTablePolicy = "skip"
+	// PauseTablePolicyBuffer represents the DMLs and DDLs of the paused table are held back, they're replicated from
+	// the location where the table is paused after the table is resumed.
+	PauseTablePolicyBuffer PauseTablePolicy = "buffer"
+)
+
+// PausedTable is an upstream table paused by pause-table.
+type PausedTable struct {
+	Schema string           `toml:"schema" json:"schema"`
+	Table  string           `toml:"table" json:"table"`
+	Policy PauseTablePolicy `toml:"policy" json:"policy"`
+}
+
+// adjust checks the paused table, the policy is buffer by default.
+func (t *PausedTable) adjust() error {
+	if t.Schema == "" || t.Table == "" {
+		return terror.ErrConfigInvalidPausedTable.Generate(fmt.Sprintf("`%s`.`%s`", t.Schema, t.Table), "the schema and table name should not be empty")
+	}
+	if t.Policy == "" {
+		t.Policy = PauseTablePolicyBuffer
+	}
+	t.Policy = PauseTablePolicy(strings.ToLower(string(t.Policy)))
+	if t.Policy != PauseTablePolicySkip && t.Policy != PauseTablePolicyBuffer {
+		return terror.ErrConfigInvalidPausedTable.Generate(fmt.Sprintf("`%s`.`%s`", t.Schema, t.Table), fmt.Sprintf("invalid policy '%s'", t.Policy))
+	}
+	return nil
+}
+
+// adjustDBType checks the types of databases, and the features not supported when the target database is PostgreSQL.
+func (c *SubTaskConfig) adjustDBType() error {
+	c.From.Type = strings.ToLower(c.From.Type)
+	c.To.Type = strings.ToLower(c.To.Type)
+	if c.From.Type != "" && c.From.Type != DBTypeMySQL {
+		return terror.ErrConfigInvalidDBType.Generate(c.From.Type, "source database")
+	}
+	switch c.To.Type {
+	case "", DBTypeMySQL:
+		return nil
+	case DBTypePostgres:
+	default:
+		return terror.ErrConfigInvalidDBType.Generate(c.To.Type, "target database")
+	}
+
+	// PostgreSQL can only be the target of incremental replication, the features need to execute MySQL specific
+	// statements in downstream are not supported.
+	switch {
+	case c.Mode != ModeIncrement:
+		return terror.ErrConfigPostgresNotSupport.Generate(fmt.Sprintf("task-mode %s", c.Mode))
+	case c.ShardMode != "":
+		return terror.ErrConfigPostgresNotSupport.Generate("shard-mode")
+	case c.OnlineDDL:
+		return terror.ErrConfigPostgresNotSupport.Generate("online-ddl")
+	case c.MultipleRows:
+		return terror.ErrConfigPostgresNotSupport.Generate("multiple-rows")
+	}
+	return nil
+}
+
 // Parse parses flag definitions from the argument list.
 func (c *SubTaskConfig) Parse(arguments []string, verifyDecryptPassword bool) error {
 	// Parse first to get config file.
@@ -523,7 +707,16 @@ func (c *SubTaskConfig) Clone() (*SubTaskConfig, error) {
 	return clone, nil
 }
 
+// LoaderLocalDir returns the local dir of the load unit to keep the files like checkpoints and sorted KV pairs. it's
+// the dump dir if the dump files are in the local filesystem, otherwise a dir in the working dir of DM-worker.
+func (c *SubTaskConfig) LoaderLocalDir() string {
+	if !storage.IsExternalPath(c.LoaderConfig.Dir) {
+		return c.LoaderConfig.Dir
+	}
+	return defaultDir + "." + c.Name + "." + c.SourceID
+}
+
 // NeedUseLightning returns whether need to use lightning loader.
 func (c *SubTaskConfig) NeedUseLightning() bool {
-	return (c.Mode == ModeAll || c.Mode == ModeFull) && c.ImportMode == LoadModeSQL
+	return (c.Mode == ModeAll || c.Mode == ModeFull) && (c.ImportMode == LoadModeSQL || c.ImportMode == LoadModePhysical)
 }
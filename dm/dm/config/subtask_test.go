@@ -69,6 +69,48 @@ func (t *testConfig) TestSubTask(c *C) {
 
 	err = cfg.Adjust(true)
 	c.Assert(err, IsNil)
+	c.Assert(cfg.CheckpointFlushPolicy, Equals, CheckpointFlushByInterval)
+
+	cfg.CheckpointFlushPolicy = "TXN"
+	c.Assert(cfg.Adjust(true), IsNil)
+	c.Assert(cfg.CheckpointFlushPolicy, Equals, CheckpointFlushPolicy(CheckpointFlushByTxn))
+	c.Assert(cfg.CheckpointFlushTxnCount, Equals, defaultCheckpointFlushTxnCount)
+
+	c.Assert(cfg.ValidationMode, Equals, ValidationNone)
+	cfg.ValidationMode = "Chunk"
+	c.Assert(cfg.Adjust(true), IsNil)
+	c.Assert(cfg.ValidationMode, Equals, ValidationMode(ValidationChunk))
+	c.Assert(cfg.ValidationChunkSize, Equals, defaultValidationChunkSize)
+	c.Assert(cfg.ValidationInterval, Equals, defaultValidationInterval)
+	c.Assert(cfg.ValidationMaxRetry, Equals, defaultValidationMaxRetry)
+
+	cfg.CharsetRules = []*CharsetRule{{SchemaPattern: "db", FromCharset: "LATIN1"}}
+	c.Assert(cfg.Adjust(true), IsNil)
+	c.Assert(cfg.CharsetRules[0].FromCharset, Equals, CharsetLatin1)
+	c.Assert(cfg.CharsetRules[0].ToCharset, Equals, CharsetUTF8MB4)
+
+	c.Assert(cfg.GeneratedColumnPolicy, Equals, ColumnPolicy(ColumnPolicySkip))
+	c.Assert(cfg.InvisibleColumnPolicy, Equals, ColumnPolicy(ColumnPolicyMaterialize))
+	cfg.GeneratedColumnPolicy = "Materialize-Stored"
+	c.Assert(cfg.Adjust(true), IsNil)
+	c.Assert(cfg.GeneratedColumnPolicy, Equals, ColumnPolicy(ColumnPolicyMaterializeStored))
+
+	c.Assert(cfg.ForeignKeyPolicy, Equals, ForeignKeyPolicy(ForeignKeyPolicyNone))
+	cfg.ForeignKeyPolicy = "Ordered"
+	c.Assert(cfg.Adjust(true), IsNil)
+	c.Assert(cfg.ForeignKeyPolicy, Equals, ForeignKeyPolicy(ForeignKeyPolicyOrdered))
+
+	cfg.Dir = "./dumped_data"
+	c.Assert(cfg.Adjust(true), IsNil)
+	c.Assert(cfg.Dir, Equals, "./dumped_data.test-task")
+	c.Assert(cfg.LoaderLocalDir(), Equals, "./dumped_data.test-task")
+	// the dump files of sources are separated in the external storage
+	cfg.Dir = "s3://bucket/dumped_data?endpoint=http://127.0.0.1:9000"
+	c.Assert(cfg.Adjust(true), IsNil)
+	c.Assert(cfg.Dir, Equals, "s3://bucket/dumped_data/test-task.mysql-instance-01?endpoint=http://127.0.0.1:9000")
+	c.Assert(cfg.Adjust(true), IsNil)
+	c.Assert(cfg.Dir, Equals, "s3://bucket/dumped_data/test-task.mysql-instance-01?endpoint=http://127.0.0.1:9000")
+	c.Assert(cfg.LoaderLocalDir(), Equals, "./dumped_data.test-task.mysql-instance-01")
 }
 
 func (t *testConfig) TestSubTaskAdjustFail(c *C) {
@@ -135,6 +177,273 @@ func (t *testConfig) TestSubTaskAdjustFail(c *C) {
 			},
 			"\\[.*\\], Message: online scheme rtc not supported.*",
 		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.MaxEventSizePolicy = "truncate"
+				return cfg
+			},
+			"\\[.*\\], Message: invalid max-event-size-policy 'truncate'.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.Compress = DumpCompressGzip
+				cfg.ImportMode = LoadModeSQL
+				return cfg
+			},
+			"\\[.*\\], Message: invalid compress config: compressed dump files can only be imported by import-mode `loader`.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.SkipCreateTable = true
+				cfg.ImportMode = LoadModePhysical
+				return cfg
+			},
+			"\\[.*\\], Message: invalid skip-create-table config: skip-create-table can only be used with import-mode `loader`.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.MaxError = 10
+				cfg.ImportMode = LoadModeSQL
+				return cfg
+			},
+			"\\[.*\\], Message: invalid max-error config: max-error can only be used with import-mode `loader`.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.ImportMode = LoadModeLoader
+				cfg.External = &ExternalDataConfig{
+					Dir:    "/data/external",
+					Format: ExternalFormatCSV,
+					Tables: []*ExternalTable{{Schema: "shop", Table: "orders", CreateTable: "CREATE TABLE orders (id INT)"}},
+				}
+				return cfg
+			},
+			"\\[.*\\], Message: invalid external config: external data can't be imported by import-mode `loader`.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.Dir = "s3://bucket/dumped_data"
+				cfg.ImportMode = LoadModeLoader
+				return cfg
+			},
+			"\\[.*\\], Message: invalid dir config s3://bucket/dumped_data/test-task.mysql-instance-01: the dump files in external storage can't be imported by import-mode `loader`.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.Dir = "s3://bucket/dumped_data"
+				cfg.ImportMode = LoadModeSQL
+				cfg.External = &ExternalDataConfig{
+					Dir:    "/data/external",
+					Format: ExternalFormatCSV,
+					Tables: []*ExternalTable{{Schema: "shop", Table: "orders", CreateTable: "CREATE TABLE orders (id INT)"}},
+				}
+				return cfg
+			},
+			"\\[.*\\], Message: invalid dir config s3://bucket/dumped_data/test-task.mysql-instance-01: the dir should be a local path when importing external data.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.CheckpointFlushPolicy = "rows"
+				return cfg
+			},
+			"\\[.*\\], Message: invalid checkpoint-flush-policy 'rows'.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.ValidationMode = "full"
+				return cfg
+			},
+			"\\[.*\\], Message: invalid validation-mode 'full'.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.OnBinlogGap = "skip"
+				return cfg
+			},
+			"\\[.*\\], Message: invalid on-binlog-gap 'skip'.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.OnBinlogGap = "Redump"
+				cfg.External = &ExternalDataConfig{
+					Dir:    "/data/external",
+					Format: ExternalFormatCSV,
+					Tables: []*ExternalTable{{Schema: "shop", Table: "orders", CreateTable: "CREATE TABLE orders (id INT)"}},
+				}
+				return cfg
+			},
+			"\\[.*\\], Message: invalid external config: on-binlog-gap `redump` can't be used with external data.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.To.Type = "oracle"
+				return cfg
+			},
+			"\\[.*\\], Message: invalid database type 'oracle' of target database.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.To.Type = "Postgres"
+				cfg.Mode = ModeIncrement
+				return cfg
+			},
+			"\\[.*\\], Message: online-ddl is not supported when the target database is PostgreSQL.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.SinkURI = "pulsar://127.0.0.1:6650/topic"
+				return cfg
+			},
+			"\\[.*\\], Message: invalid sink-uri 'pulsar://127.0.0.1:6650/topic': scheme 'pulsar' is not supported.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.SinkURI = "kafka://127.0.0.1:9092/topic?protocol=avro"
+				return cfg
+			},
+			"\\[.*\\], Message: invalid sink-uri .*: protocol 'avro' is not supported.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.SinkURI = "kafka://127.0.0.1:9092/topic"
+				cfg.SinkDispatcher = "ts"
+				return cfg
+			},
+			"\\[.*\\], Message: invalid sink-dispatcher 'ts'.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.SinkURI = "kafka://127.0.0.1:9092/topic?protocol=canal-json"
+				cfg.Mode = ModeAll
+				return cfg
+			},
+			"\\[.*\\], Message: task-mode all is not supported when publishing the changes to Kafka.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.SinkURI = "kafka://127.0.0.1:9092/topic?protocol=canal-json"
+				cfg.DryRunDir = "./dry-run"
+				return cfg
+			},
+			"\\[.*\\], Message: sink-uri is not supported in dry-run mode.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.DryRunDir = "./dry-run"
+				cfg.Mode = ModeAll
+				return cfg
+			},
+			"\\[.*\\], Message: task-mode all is not supported in dry-run mode.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.DDLRewriteRules = []*DDLRewriteRule{{Pattern: "ENGINE=(MyISAM", Replacement: "ENGINE=InnoDB"}}
+				return cfg
+			},
+			"\\[.*\\], Message: invalid ddl-rewrite-rules pattern 'ENGINE=\\(MyISAM': error parsing regexp.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.DDLRewriteHook = "tcp://127.0.0.1:8080"
+				return cfg
+			},
+			"\\[.*\\], Message: invalid ddl-rewrite-hook 'tcp://127.0.0.1:8080': only http and https are supported.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.StartTime = "2022-01-01"
+				return cfg
+			},
+			"\\[.*\\], Message: invalid replication time window, start-time 2022-01-01: .*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.StartTime = "2022-01-02 00:00:00"
+				cfg.StopTime = "2022-01-01T00:00:00"
+				return cfg
+			},
+			"\\[.*\\], Message: invalid replication time window, start-time 2022-01-02 00:00:00 is not earlier than stop-time 2022-01-01T00:00:00.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.CharsetRules = []*CharsetRule{{SchemaPattern: "db", FromCharset: "utf16"}}
+				return cfg
+			},
+			"\\[.*\\], Message: invalid charset rule: from-charset 'utf16' is not supported.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.CharsetRules = []*CharsetRule{{SchemaPattern: "db", FromCharset: "gbk", ToCollation: "gbk_bin"}}
+				return cfg
+			},
+			"\\[.*\\], Message: invalid charset rule: to-collation 'gbk_bin' doesn't belong to charset utf8mb4.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.InvisibleColumnPolicy = ColumnPolicyMaterializeStored
+				return cfg
+			},
+			"\\[.*\\], Message: invalid invisible-column-policy 'materialize-stored'.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.ForeignKeyPolicy = "cascade"
+				return cfg
+			},
+			"\\[.*\\], Message: invalid foreign-key-policy 'cascade', Workaround.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.ForeignKeyPolicy = ForeignKeyPolicyOrdered
+				cfg.Compact = true
+				return cfg
+			},
+			"\\[.*\\], Message: invalid foreign-key-policy 'ordered', it can't be used with compact.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.DMLType = "pipelined"
+				return cfg
+			},
+			"\\[.*\\], Message: invalid dml-type 'pipelined'.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.PausedTables = []*PausedTable{{Schema: "db", Table: "tbl", Policy: "drop"}}
+				return cfg
+			},
+			"\\[.*\\], Message: invalid paused table `db`.`tbl`: invalid policy 'drop'.*",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -173,6 +482,7 @@ func (t *testConfig) TestSubTaskBlockAllowList(c *C) {
 
 func (t *testConfig) TestDBConfigClone(c *C) {
 	a := &DBConfig{
+		Type:     DBTypePostgres,
 		Host:     "127.0.0.1",
 		Port:     4306,
 		User:     "root",
@@ -182,7 +492,7 @@ func (t *testConfig) TestDBConfigClone(c *C) {
 	}
 
 	// When add new fields, also update this value
-	c.Assert(reflect.Indirect(reflect.ValueOf(a)).NumField(), Equals, 8)
+	c.Assert(reflect.Indirect(reflect.ValueOf(a)).NumField(), Equals, 9)
 
 	b := a.Clone()
 	c.Assert(a, DeepEquals, b)
@@ -17,9 +17,12 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"net/url"
 	"os"
+	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/coreos/go-semver/semver"
 	"github.com/dustin/go-humanize"
@@ -50,6 +53,69 @@ const (
 	tidbTxnOptimistic = "optimistic"
 )
 
+// strategies to unlock the pessimistic shard DDL lock automatically after timeout.
+const (
+	// ShardDDLLockTimeoutSkip lets the owner execute the DDL and the synced sources skip it, the lagging sources are
+	// left to handle the DDL by themselves. the lock is kept if the owner fails to execute the DDL.
+	ShardDDLLockTimeoutSkip = "skip"
+	// ShardDDLLockTimeoutForceExec is like ShardDDLLockTimeoutSkip, but the lock is removed even if the owner fails
+	// to execute the DDL.
+	ShardDDLLockTimeoutForceExec = "force-exec"
+)
+
+// strategies to handle the conflicts of the auto-increment keys of the shard tables merged into the same table.
+const (
+	// AutoIDConflictCheck checks whether the value ranges of the auto-increment keys of the shard tables overlap in
+	// precheck, so the conflicts are found before the task starts.
+	AutoIDConflictCheck = "check"
+	// AutoIDConflictSourcePrefix rewrites the auto-increment keys of the shard tables in incremental replication, the
+	// highest bits of the keys are replaced by the index of the source and the numeric suffixes of the schema and
+	// table names, like the `partition id` expression of column mapping. the keys should be BIGINT.
+	AutoIDConflictSourcePrefix = "source-prefix"
+	// AutoIDConflictExtraColumn relies on the extra columns extracted by the route rules or `source-tag-column`,
+	// which should be part of the primary or unique keys of the downstream tables along with the auto-increment keys.
+	AutoIDConflictExtraColumn = "extra-column"
+
+	// maxAutoIDSourceCount is the max count of sources when using AutoIDConflictSourcePrefix, because 4 bits are used
+	// for the index of the source.
+	maxAutoIDSourceCount = 16
+)
+
+// priorities of the tasks. when the resources are contended, the sources of the tasks with higher priorities are
+// bound to the DM-workers first, and the subtasks with lower priorities are throttled first by the DM-workers.
+const (
+	TaskPriorityHigh   = "high"
+	TaskPriorityNormal = "normal"
+	TaskPriorityLow    = "low"
+)
+
+// TaskPriorityLevel returns the level of the task priority, a higher priority has a larger level. the empty priority
+// is treated as normal, and the invalid one returns 0.
+func TaskPriorityLevel(priority string) int {
+	switch priority {
+	case TaskPriorityLow:
+		return 1
+	case TaskPriorityNormal, "":
+		return 2
+	case TaskPriorityHigh:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// AdjustTaskPriority sets the default priority and checks it.
+func AdjustTaskPriority(priority *string) error {
+	if *priority == "" {
+		*priority = TaskPriorityNormal
+	}
+	*priority = strings.ToLower(*priority)
+	if TaskPriorityLevel(*priority) == 0 {
+		return terror.ErrConfigInvalidTaskPriority.Generate(*priority)
+	}
+	return nil
+}
+
 // collation_compatible.
 const (
 	LooseCollationCompatible  = "loose"
@@ -78,6 +144,11 @@ var (
 	defaultBatch                   = 100
 	defaultQueueSize               = 1024 // do not give too large default value to avoid OOM
 	defaultCheckpointFlushInterval = 30   // in seconds
+	defaultCheckpointFlushTxnCount = 10000
+	defaultCheckpointFlushBytes    = int64(64 * 1024 * 1024)
+	defaultValidationChunkSize     = 100
+	defaultValidationInterval      = 5 // in seconds
+	defaultValidationMaxRetry      = 10
 
 	// TargetDBConfig.
 	defaultSessionCfg = []struct {
@@ -180,10 +251,23 @@ type MydumperConfig struct {
 
 	SkipTzUTC bool   `yaml:"skip-tz-utc" toml:"skip-tz-utc" json:"skip-tz-utc"` // --skip-tz-utc
 	ExtraArgs string `yaml:"extra-args" toml:"extra-args" json:"extra-args"`    // other extra args
+	// the conditions of the tables, a table uses the first matched one, and the tables not matched use `where`.
+	TableWheres []*TableWhere `yaml:"table-wheres,omitempty" toml:"table-wheres,omitempty" json:"table-wheres,omitempty"`
+	// the compression algorithm of the dumped SQL/CSV files, only `gzip` is supported by the dump unit now, the load
+	// unit can read both `gzip` and `zstd` compressed files.
+	Compress string `yaml:"compress,omitempty" toml:"compress,omitempty" json:"compress,omitempty"` // --compress
 	// NOTE: use LoaderConfig.Dir as --outputdir
 	// TODO zxc: combine -B -T --regex with filter rules?
 }
 
+// TableWhere is the condition to dump only the selected records of the tables, the tables are matched by the same
+// syntax as `do-tables` of block-allow-list.
+type TableWhere struct {
+	Schema string `yaml:"db-name" toml:"db-name" json:"db-name"`
+	Table  string `yaml:"tbl-name" toml:"tbl-name" json:"tbl-name"`
+	Where  string `yaml:"where" toml:"where" json:"where"`
+}
+
 // DefaultMydumperConfig return default mydumper config for task.
 func DefaultMydumperConfig() MydumperConfig {
 	return MydumperConfig{
@@ -207,6 +291,33 @@ func (m *MydumperConfig) UnmarshalYAML(unmarshal func(interface{}) error) error
 	return nil
 }
 
+func (m *MydumperConfig) adjust() error {
+	for i, tw := range m.TableWheres {
+		if tw == nil || len(tw.Schema) == 0 || len(tw.Table) == 0 {
+			return terror.ErrConfigInvalidTableWhere.Generate(fmt.Sprintf("both db-name and tbl-name of condition %d should be specified", i))
+		}
+		if len(strings.TrimSpace(tw.Where)) == 0 {
+			return terror.ErrConfigInvalidTableWhere.Generate(fmt.Sprintf("where of %s.%s should not be empty", tw.Schema, tw.Table))
+		}
+		if _, err := filter.New(true, &filter.Rules{DoTables: []*filter.Table{{Schema: tw.Schema, Name: tw.Table}}}); err != nil {
+			return terror.ErrConfigInvalidTableWhere.Delegate(err, fmt.Sprintf("invalid pattern %s.%s", tw.Schema, tw.Table))
+		}
+	}
+
+	switch strings.ToLower(m.Compress) {
+	case "", "no-compression":
+		m.Compress = ""
+	case "gzip", "gz":
+		m.Compress = DumpCompressGzip
+	default:
+		return terror.ErrConfigInvalidDumpCompress.Generate(fmt.Sprintf("compression algorithm %s is not supported by the dump unit", m.Compress))
+	}
+	return nil
+}
+
+// DumpCompressGzip is the only compression algorithm supported by the dump unit now.
+const DumpCompressGzip = "gzip"
+
 // LoadMode defines different mode used in load phase.
 type LoadMode string
 
@@ -215,6 +326,9 @@ const (
 	LoadModeSQL LoadMode = "sql"
 	// LoadModeLoader is the legacy sql mode, use loader to load data. this should be replaced by sql mode in new version.
 	LoadModeLoader = "loader"
+	// LoadModePhysical means write data by encoded KV pairs, uses tidb-lightning local backend to load data. it's
+	// much faster than sql mode but requires the target tables to be empty, otherwise falls back to sql mode.
+	LoadModePhysical LoadMode = "physical"
 )
 
 // DuplicateResolveType defines the duplication resolution when meet duplicate rows.
@@ -229,6 +343,106 @@ const (
 	OnDuplicateIgnore = "ignore"
 )
 
+// MaxEventSizePolicy defines how to handle the events larger than max-event-size.
+type MaxEventSizePolicy string
+
+const (
+	// MaxEventSizeError represents return an error when meet a too large event.
+	MaxEventSizeError MaxEventSizePolicy = "error"
+	// MaxEventSizeSkip represents skip the too large event with a warning log.
+	MaxEventSizeSkip = "skip"
+	// MaxEventSizeChunk represents split the too large rows event into several smaller rows events.
+	MaxEventSizeChunk = "chunk"
+)
+
+// CheckpointFlushPolicy defines when the checkpoint of syncer is flushed to downstream.
+// the checkpoint is always flushed after DDL, when the task is paused or stopped, and when requested by API.
+type CheckpointFlushPolicy string
+
+const (
+	// CheckpointFlushByInterval represents flush the checkpoint every checkpoint-flush-interval seconds.
+	CheckpointFlushByInterval CheckpointFlushPolicy = "interval"
+	// CheckpointFlushByTxn represents flush the checkpoint every checkpoint-flush-txn-count transactions.
+	CheckpointFlushByTxn = "txn"
+	// CheckpointFlushByBytes represents flush the checkpoint every checkpoint-flush-bytes bytes of binlog events applied.
+	CheckpointFlushByBytes = "bytes"
+	// CheckpointFlushManually represents only flush the checkpoint in the above cases.
+	CheckpointFlushManually = "manual"
+)
+
+// ValidationMode defines how the rows applied to downstream are validated.
+type ValidationMode string
+
+const (
+	// ValidationNone represents the validation is disabled.
+	ValidationNone ValidationMode = "none"
+	// ValidationRow represents query and compare the checksum of every row.
+	ValidationRow = "row"
+	// ValidationChunk represents query the rows of a table in chunks and compare the checksum of every chunk, the rows
+	// of a mismatched chunk are compared one by one.
+	ValidationChunk = "chunk"
+)
+
+// SinkDispatcher defines how the row changes are dispatched to the partitions of the message queue.
+type SinkDispatcher string
+
+const (
+	// SinkDispatchByTable represents dispatch the row changes of a table to the same partition.
+	SinkDispatchByTable SinkDispatcher = "table"
+	// SinkDispatchByPK represents dispatch the row changes by the value of primary key or not null unique key.
+	SinkDispatchByPK = "pk"
+)
+
+// ColumnPolicy defines whether the values of the generated or invisible columns are replicated in DMLs.
+type ColumnPolicy string
+
+const (
+	// ColumnPolicySkip represents the values of the columns are not replicated.
+	ColumnPolicySkip ColumnPolicy = "skip"
+	// ColumnPolicyMaterialize represents the values of the columns in upstream are replicated. the values of generated
+	// columns are only replicated to the columns which are not generated in downstream.
+	ColumnPolicyMaterialize = "materialize"
+	// ColumnPolicyMaterializeStored is like ColumnPolicyMaterialize but only for the stored generated columns, the
+	// virtual generated columns are skipped. it's only used by generated-column-policy.
+	ColumnPolicyMaterializeStored = "materialize-stored"
+)
+
+// ForeignKeyPolicy defines how to replicate the DMLs of the tables with foreign keys in downstream.
+type ForeignKeyPolicy string
+
+const (
+	// ForeignKeyPolicyNone represents the foreign keys are not considered, the DMLs of the parent and child tables may
+	// be executed in different order from upstream.
+	ForeignKeyPolicyNone ForeignKeyPolicy = "none"
+	// ForeignKeyPolicyOrdered represents the DML of a child row is executed after the DMLs of its parent row before it
+	// in binlog, and the DML of a parent row is executed after the DMLs of its child rows before it in binlog.
+	ForeignKeyPolicyOrdered = "ordered"
+	// ForeignKeyPolicyDisableChecks represents `foreign_key_checks` is disabled in the sessions of downstream.
+	ForeignKeyPolicyDisableChecks = "disable-checks"
+)
+
+// DMLType defines how the DMLs are executed in downstream.
+type DMLType string
+
+const (
+	// DMLTypeStandard represents the DMLs of a batch are executed in one transaction.
+	DMLTypeStandard DMLType = "standard"
+	// DMLTypeBulk represents the DMLs are executed as autocommit statements with the pipelined DML of TiDB
+	// (`tidb_dml_type = 'bulk'`), it falls back to DMLTypeStandard when the downstream doesn't support it.
+	DMLTypeBulk = "bulk"
+)
+
+// BinlogGapPolicy defines how to handle the binlog gap found when the syncer starts from the location recorded by the
+// dump unit, which means the binlog from the location has been purged in upstream.
+type BinlogGapPolicy string
+
+const (
+	// BinlogGapError represents return an error which can't be resumed automatically.
+	BinlogGapError BinlogGapPolicy = "error"
+	// BinlogGapRedump represents dump and load the data again when the subtask is resumed.
+	BinlogGapRedump BinlogGapPolicy = "redump"
+)
+
 // LoaderConfig represents loader process unit's specific config.
 type LoaderConfig struct {
 	PoolSize    int                  `yaml:"pool-size" toml:"pool-size" json:"pool-size"`
@@ -236,6 +450,116 @@ type LoaderConfig struct {
 	SQLMode     string               `yaml:"-" toml:"-" json:"-"` // wrote by dump unit
 	ImportMode  LoadMode             `yaml:"import-mode" toml:"import-mode" json:"import-mode"`
 	OnDuplicate DuplicateResolveType `yaml:"on-duplicate" toml:"on-duplicate" json:"on-duplicate"`
+
+	// the max rows and bytes of the dumped data imported to downstream per second, 0 means unlimited. the rows are
+	// counted by the values of INSERT statements. the data is imported by the loader rather than tidb-lightning when
+	// they're set.
+	ImportRowsPerSecond  int64 `yaml:"import-rows-per-second" toml:"import-rows-per-second" json:"import-rows-per-second"`
+	ImportBytesPerSecond int64 `yaml:"import-bytes-per-second" toml:"import-bytes-per-second" json:"import-bytes-per-second"`
+
+	// don't create the schemas and tables in the downstream, the data is imported into the pre-created tables, which
+	// are checked to be compatible with the dumped tables before importing. only supported by import-mode `loader`.
+	SkipCreateTable bool `yaml:"skip-create-table,omitempty" toml:"skip-create-table,omitempty" json:"skip-create-table,omitempty"`
+	// the columns of the pre-created tables which have different names from the dumped columns, a table uses the
+	// first matched one.
+	ColumnNameMappings []*ColumnNameMapping `yaml:"column-name-mappings,omitempty" toml:"column-name-mappings,omitempty" json:"column-name-mappings,omitempty"`
+	// the external data files imported instead of the data dumped from the source, the dump unit is skipped if it's set.
+	External *ExternalDataConfig `yaml:"external,omitempty" toml:"external,omitempty" json:"external,omitempty"`
+	// the max number of rows rejected by downstream, such as rows with bad encodings or out-of-range values. the
+	// rejected rows are written to the bad-row files with the errors instead of failing the task, 0 means no rows
+	// can be rejected. only supported by import-mode `loader`.
+	MaxError int64 `yaml:"max-error,omitempty" toml:"max-error,omitempty" json:"max-error,omitempty"`
+}
+
+// ColumnNameMapping maps the columns of the dumped tables to the columns of the pre-created downstream tables, the
+// downstream tables are matched by the same syntax as `do-tables` of block-allow-list.
+type ColumnNameMapping struct {
+	Schema string `yaml:"db-name" toml:"db-name" json:"db-name"`
+	Table  string `yaml:"tbl-name" toml:"tbl-name" json:"tbl-name"`
+	// the dumped column name -> the downstream column name
+	Columns map[string]string `yaml:"columns" toml:"columns" json:"columns"`
+}
+
+// the formats of the external data files.
+const (
+	ExternalFormatCSV     = "csv"
+	ExternalFormatParquet = "parquet"
+)
+
+// ExternalDataConfig is the config of the external data files, which are imported by tidb-lightning. the tables are
+// created by the declared statements, and the incremental sync starts from the `meta` of the task in `all` mode.
+type ExternalDataConfig struct {
+	Dir    string            `yaml:"dir" toml:"dir" json:"dir"`
+	Format string            `yaml:"format" toml:"format" json:"format"`
+	CSV    ExternalCSVConfig `yaml:"csv" toml:"csv" json:"csv"`
+	Tables []*ExternalTable  `yaml:"tables" toml:"tables" json:"tables"`
+}
+
+// ExternalCSVConfig is the format of the external CSV files.
+type ExternalCSVConfig struct {
+	Separator       string `yaml:"separator" toml:"separator" json:"separator"`
+	Delimiter       string `yaml:"delimiter" toml:"delimiter" json:"delimiter"`
+	Terminator      string `yaml:"terminator" toml:"terminator" json:"terminator"`
+	Null            string `yaml:"null" toml:"null" json:"null"`
+	Header          bool   `yaml:"header" toml:"header" json:"header"`
+	BackslashEscape bool   `yaml:"backslash-escape" toml:"backslash-escape" json:"backslash-escape"`
+}
+
+// ExternalTable is a source table of the external data files.
+type ExternalTable struct {
+	Schema string `yaml:"db-name" toml:"db-name" json:"db-name"`
+	Table  string `yaml:"tbl-name" toml:"tbl-name" json:"tbl-name"`
+	// the regular expression of the data file paths relative to the dir, defaults to `{db-name}.{tbl-name}.*.{format}`.
+	FilePattern string `yaml:"file-pattern,omitempty" toml:"file-pattern,omitempty" json:"file-pattern,omitempty"`
+	// the statement to create the source table, the table is created in the downstream by route rules.
+	CreateTable string `yaml:"create-table" toml:"create-table" json:"create-table"`
+}
+
+func (e *ExternalDataConfig) adjust() error {
+	if len(e.Dir) == 0 {
+		return terror.ErrConfigInvalidExternalData.Generate("dir should be specified")
+	}
+	e.Format = strings.ToLower(e.Format)
+	if e.Format != ExternalFormatCSV && e.Format != ExternalFormatParquet {
+		return terror.ErrConfigInvalidExternalData.Generate(fmt.Sprintf("format %s is not supported", e.Format))
+	}
+	if e.Format == ExternalFormatCSV {
+		if len(e.CSV.Separator) == 0 {
+			e.CSV.Separator = ","
+		}
+		if len(e.CSV.Delimiter) == 0 {
+			e.CSV.Delimiter = `"`
+		}
+		if len(e.CSV.Null) == 0 {
+			e.CSV.Null = `\N`
+		}
+		if e.CSV.Separator == e.CSV.Delimiter {
+			return terror.ErrConfigInvalidExternalData.Generate("separator and delimiter of csv should be different")
+		}
+	}
+	if len(e.Tables) == 0 {
+		return terror.ErrConfigInvalidExternalData.Generate("tables should not be empty")
+	}
+	tables := make(map[string]struct{}, len(e.Tables))
+	for i, t := range e.Tables {
+		if t == nil || len(t.Schema) == 0 || len(t.Table) == 0 {
+			return terror.ErrConfigInvalidExternalData.Generate(fmt.Sprintf("both db-name and tbl-name of table %d should be specified", i))
+		}
+		name := t.Schema + "." + t.Table
+		if _, ok := tables[name]; ok {
+			return terror.ErrConfigInvalidExternalData.Generate(fmt.Sprintf("table %s is duplicated", name))
+		}
+		tables[name] = struct{}{}
+		if len(strings.TrimSpace(t.CreateTable)) == 0 {
+			return terror.ErrConfigInvalidExternalData.Generate(fmt.Sprintf("create-table of table %s should not be empty", name))
+		}
+		if len(t.FilePattern) > 0 {
+			if _, err := regexp.Compile(t.FilePattern); err != nil {
+				return terror.ErrConfigInvalidExternalData.Delegate(err, fmt.Sprintf("invalid file-pattern of table %s", name))
+			}
+		}
+	}
+	return nil
 }
 
 // DefaultLoaderConfig return default loader config for task.
@@ -264,7 +588,7 @@ func (m *LoaderConfig) adjust() error {
 		m.ImportMode = LoadModeSQL
 	}
 	m.ImportMode = LoadMode(strings.ToLower(string(m.ImportMode)))
-	if m.ImportMode != LoadModeSQL && m.ImportMode != LoadModeLoader {
+	if m.ImportMode != LoadModeSQL && m.ImportMode != LoadModeLoader && m.ImportMode != LoadModePhysical {
 		return terror.ErrConfigInvalidLoadMode.Generate(m.ImportMode)
 	}
 
@@ -276,6 +600,28 @@ func (m *LoaderConfig) adjust() error {
 		return terror.ErrConfigInvalidDuplicateResolution.Generate(m.OnDuplicate)
 	}
 
+	if len(m.ColumnNameMappings) > 0 && !m.SkipCreateTable {
+		return terror.ErrConfigInvalidSkipCreateTable.Generate("column-name-mappings can only be used with skip-create-table")
+	}
+	for i, mapping := range m.ColumnNameMappings {
+		if mapping == nil || len(mapping.Schema) == 0 || len(mapping.Table) == 0 {
+			return terror.ErrConfigInvalidSkipCreateTable.Generate(fmt.Sprintf("both db-name and tbl-name of column-name-mapping %d should be specified", i))
+		}
+		if len(mapping.Columns) == 0 {
+			return terror.ErrConfigInvalidSkipCreateTable.Generate(fmt.Sprintf("columns of column-name-mapping %s.%s should not be empty", mapping.Schema, mapping.Table))
+		}
+		if _, err := filter.New(true, &filter.Rules{DoTables: []*filter.Table{{Schema: mapping.Schema, Name: mapping.Table}}}); err != nil {
+			return terror.ErrConfigInvalidSkipCreateTable.Delegate(err, fmt.Sprintf("invalid pattern %s.%s", mapping.Schema, mapping.Table))
+		}
+	}
+
+	if m.MaxError < 0 {
+		return terror.ErrConfigInvalidMaxError.Generate(fmt.Sprintf("max-error %d should not be negative", m.MaxError))
+	}
+
+	if m.External != nil {
+		return m.External.adjust()
+	}
 	return nil
 }
 
@@ -290,6 +636,10 @@ type SyncerConfig struct {
 	// TODO: add this two new config items for openapi.
 	Compact      bool `yaml:"compact" toml:"compact" json:"compact"`
 	MultipleRows bool `yaml:"multiple-rows" toml:"multiple-rows" json:"multiple-rows"`
+	// the max number of rows combined into one statement when MultipleRows is enabled, 0 means no limit.
+	MultipleRowsBatch int `yaml:"multiple-rows-batch" toml:"multiple-rows-batch" json:"multiple-rows-batch"`
+	// the max number of prepared statements cached by each DML connection to downstream, 0 means disabled.
+	PreparedStmtCacheSize int `yaml:"prepared-stmt-cache-size" toml:"prepared-stmt-cache-size" json:"prepared-stmt-cache-size"`
 
 	// deprecated
 	MaxRetry int `yaml:"max-retry" toml:"max-retry" json:"max-retry"`
@@ -300,8 +650,175 @@ type SyncerConfig struct {
 	// deprecated
 	DisableCausality bool `yaml:"disable-detect" toml:"disable-detect" json:"disable-detect"`
 	SafeMode         bool `yaml:"safe-mode" toml:"safe-mode" json:"safe-mode"`
+	// when DMLs of a table meet duplicate entry or missing row errors, the table enters safe-mode automatically for
+	// this number of seconds and the DMLs are retried, 0 means disabled.
+	AutoSafeModeDuration int `yaml:"auto-safe-mode-duration" toml:"auto-safe-mode-duration" json:"auto-safe-mode-duration"`
 	// deprecated, use `ansi-quotes` in top level config instead
 	EnableANSIQuotes bool `yaml:"enable-ansi-quotes" toml:"enable-ansi-quotes" json:"enable-ansi-quotes"`
+
+	// the max size in bytes of an event read from the relay log, 0 means unlimited.
+	// a larger event is handled by MaxEventSizePolicy before it's read into memory.
+	MaxEventSize       int64              `yaml:"max-event-size" toml:"max-event-size" json:"max-event-size"`
+	MaxEventSizePolicy MaxEventSizePolicy `yaml:"max-event-size-policy" toml:"max-event-size-policy" json:"max-event-size-policy"`
+
+	// the options of the relay log reader used when the relay log is enabled for the source.
+	// RelayReaderChannelCapacity is the max number of events buffered before they're consumed, 0 means the default.
+	RelayReaderChannelCapacity int `yaml:"relay-reader-channel-capacity" toml:"relay-reader-channel-capacity" json:"relay-reader-channel-capacity"`
+	// RelayReaderPrefetchEvents is the max number of events buffered when parsing the next relay log file in advance,
+	// 0 means disabled.
+	RelayReaderPrefetchEvents int `yaml:"relay-reader-prefetch-events" toml:"relay-reader-prefetch-events" json:"relay-reader-prefetch-events"`
+	// RelayReaderRecoverTornEvent enables skipping the torn (half-written) event at the end of a relay log file when a
+	// newer relay log file has been generated, like the one left by a crash of the relay.
+	RelayReaderRecoverTornEvent bool `yaml:"relay-reader-recover-torn-event" toml:"relay-reader-recover-torn-event" json:"relay-reader-recover-torn-event"`
+	// RelayReaderEventsPerSecond and RelayReaderBytesPerSecond limit the rate of the events read from the relay log, so
+	// replaying a large relay log backlog doesn't saturate the downstream. 0 means unlimited.
+	RelayReaderEventsPerSecond float64 `yaml:"relay-reader-events-per-second" toml:"relay-reader-events-per-second" json:"relay-reader-events-per-second"`
+	RelayReaderBytesPerSecond  float64 `yaml:"relay-reader-bytes-per-second" toml:"relay-reader-bytes-per-second" json:"relay-reader-bytes-per-second"`
+	// RelayReaderRebuildUUIDIndex enables rebuilding the server-uuid index file from the relay sub directories when it's
+	// missing or truncated, like after a partial restore of the relay directory.
+	RelayReaderRebuildUUIDIndex bool `yaml:"relay-reader-rebuild-uuid-index" toml:"relay-reader-rebuild-uuid-index" json:"relay-reader-rebuild-uuid-index"`
+
+	// CheckpointFlushTxnCount and CheckpointFlushBytes are used by the "txn" and "bytes" CheckpointFlushPolicy.
+	CheckpointFlushPolicy   CheckpointFlushPolicy `yaml:"checkpoint-flush-policy" toml:"checkpoint-flush-policy" json:"checkpoint-flush-policy"`
+	CheckpointFlushTxnCount int                   `yaml:"checkpoint-flush-txn-count" toml:"checkpoint-flush-txn-count" json:"checkpoint-flush-txn-count"`
+	CheckpointFlushBytes    int64                 `yaml:"checkpoint-flush-bytes" toml:"checkpoint-flush-bytes" json:"checkpoint-flush-bytes"`
+
+	// the URI of Kafka which the row changes and DDLs are published to instead of being executed in the target
+	// database, like `kafka://127.0.0.1:9092/topic?protocol=canal-json`. the checkpoints are still saved in the
+	// target database.
+	SinkURI        string         `yaml:"sink-uri" toml:"sink-uri" json:"sink-uri"`
+	SinkDispatcher SinkDispatcher `yaml:"sink-dispatcher" toml:"sink-dispatcher" json:"sink-dispatcher"`
+
+	// when a DML is found in a query event (written in statement-format binlog), convert it to row changes if it's
+	// an INSERT or REPLACE of constant values, or apply it verbatim if it's deterministic, instead of reporting an
+	// error. other statements are skipped with a warning.
+	StatementDMLFallback bool `yaml:"statement-dml-fallback" toml:"statement-dml-fallback" json:"statement-dml-fallback"`
+
+	// the DDLs are rewritten by DDLRewriteRules in order and then by the HTTP service of DDLRewriteHook before they're
+	// applied to the downstream, a DDL rewritten to empty is skipped. the rewritten DDLs are recorded in the DDL
+	// history table in meta-schema.
+	DDLRewriteRules []*DDLRewriteRule `yaml:"ddl-rewrite-rules" toml:"ddl-rewrite-rules" json:"ddl-rewrite-rules"`
+	DDLRewriteHook  string            `yaml:"ddl-rewrite-hook" toml:"ddl-rewrite-hook" json:"ddl-rewrite-hook"`
+
+	// every DDL pauses the subtask before it's applied to the downstream, and the DDL is applied after it's approved
+	// by `binlog approve` in dmctl or the OpenAPI, or it can be skipped by `binlog skip`.
+	DDLApproval bool `yaml:"ddl-approval" toml:"ddl-approval" json:"ddl-approval"`
+
+	// only the DMLs of the events committed in [StartTime, StopTime] are replicated, the DDLs before StartTime are
+	// still applied to keep the table structures. the subtask finishes when it reads a transaction or DDL committed
+	// after StopTime. the time is like `2006-01-02 15:04:05` in the time zone of upstream, empty means unlimited.
+	StartTime string `yaml:"start-time" toml:"start-time" json:"start-time"`
+	StopTime  string `yaml:"stop-time" toml:"stop-time" json:"stop-time"`
+
+	// OnBinlogGap decides what to do when the binlog from the location recorded by the dump unit is not available in
+	// upstream, the data changed in the gap would be lost if the syncer continued.
+	OnBinlogGap BinlogGapPolicy `yaml:"on-binlog-gap" toml:"on-binlog-gap" json:"on-binlog-gap"`
+
+	// the rows applied to downstream are compared with the upstream rows by ValidationMode asynchronously every
+	// ValidationInterval seconds. the mismatched rows are rechecked with exponential backoff and they're taken as
+	// failed after ValidationMaxRetry times. ValidationChunkSize is the max number of rows in a chunk of "chunk" mode.
+	ValidationMode      ValidationMode `yaml:"validation-mode" toml:"validation-mode" json:"validation-mode"`
+	ValidationChunkSize int            `yaml:"validation-chunk-size" toml:"validation-chunk-size" json:"validation-chunk-size"`
+	ValidationInterval  int            `yaml:"validation-interval" toml:"validation-interval" json:"validation-interval"`
+	ValidationMaxRetry  int            `yaml:"validation-max-retry" toml:"validation-max-retry" json:"validation-max-retry"`
+
+	// the max rows and bytes of the binlog row events replicated to downstream per second, 0 means unlimited. the
+	// limits can be changed at runtime by the OpenAPI.
+	RowsPerSecond  int64 `yaml:"rows-per-second" toml:"rows-per-second" json:"rows-per-second"`
+	BytesPerSecond int64 `yaml:"bytes-per-second" toml:"bytes-per-second" json:"bytes-per-second"`
+
+	// the max bytes of the decoded row changes buffered between the binlog streamer and the DML workers, the binlog
+	// reading is blocked until the buffered rows are executed when it's used up. 0 means only the number of buffered
+	// jobs is limited by QueueSize.
+	MemoryQuota int64 `yaml:"memory-quota" toml:"memory-quota" json:"memory-quota"`
+
+	// the string values of the tables matched by CharsetRules are transcoded from the legacy charset of upstream to
+	// the charset of downstream, and the charset clauses of their DDLs are rewritten. the table level rule has higher
+	// priority than the schema level rule.
+	CharsetRules []*CharsetRule `yaml:"charset-rules" toml:"charset-rules" json:"charset-rules"`
+
+	// GeneratedColumnPolicy decides whether the values of generated columns are replicated, the default "skip" lets
+	// downstream compute them. InvisibleColumnPolicy decides whether the values of the invisible columns of MySQL 8.0
+	// are replicated, default "materialize". the invisible columns are created as normal columns in downstream.
+	GeneratedColumnPolicy ColumnPolicy `yaml:"generated-column-policy" toml:"generated-column-policy" json:"generated-column-policy"`
+	InvisibleColumnPolicy ColumnPolicy `yaml:"invisible-column-policy" toml:"invisible-column-policy" json:"invisible-column-policy"`
+
+	// ForeignKeyPolicy decides how to keep the referential order of the DMLs when the foreign keys are checked in
+	// downstream. "ordered" only orders the foreign keys referencing a PK/UK of a parent table in the same schema.
+	ForeignKeyPolicy ForeignKeyPolicy `yaml:"foreign-key-policy" toml:"foreign-key-policy" json:"foreign-key-policy"`
+
+	// when DryRunDir is set, the syncer runs in dry-run mode: the DMLs and DDLs are generated as usual but written to
+	// a file in this directory with their binlog locations instead of being executed in the target database, so
+	// users can audit what will be done for a range of binlog. the checkpoints are still saved in the target database.
+	DryRunDir string `yaml:"dry-run-dir" toml:"dry-run-dir" json:"dry-run-dir"`
+
+	// DMLType decides how the DMLs are executed in downstream, "bulk" writes large batches of rows more efficiently
+	// with the pipelined DML of TiDB, it's better used with multiple-rows. the DML type in use is shown in the status.
+	DMLType DMLType `yaml:"dml-type" toml:"dml-type" json:"dml-type"`
+
+	// the table infos of all tables are saved as a compressed snapshot along with the checkpoints every
+	// SchemaSnapshotInterval seconds, so only the table infos changed after the snapshot are loaded from the
+	// checkpoints when resuming. 0 means disabled.
+	SchemaSnapshotInterval int `yaml:"schema-snapshot-interval" toml:"schema-snapshot-interval" json:"schema-snapshot-interval"`
+
+	// the estimated memory in MiB of the table infos held by the schema tracker, when it's exceeded, the least recently
+	// used tables whose table infos are flushed with the checkpoints are evicted from the schema tracker, and loaded
+	// from the checkpoints again on the next use. 0 means unlimited.
+	SchemaTrackerMemoryBudget int `yaml:"schema-tracker-memory-budget" toml:"schema-tracker-memory-budget" json:"schema-tracker-memory-budget"`
+}
+
+// DDLRewriteRule rewrites the DDL by replacing the matches of Pattern with Replacement, `$1` in Replacement is
+// replaced by the text of the first submatch.
+type DDLRewriteRule struct {
+	Pattern     string `yaml:"pattern" toml:"pattern" json:"pattern"`
+	Replacement string `yaml:"replacement" toml:"replacement" json:"replacement"`
+}
+
+// the charsets supported by CharsetRule.
+const (
+	CharsetLatin1  = "latin1"
+	CharsetGBK     = "gbk"
+	CharsetUTF8    = "utf8"
+	CharsetUTF8MB4 = "utf8mb4"
+)
+
+// CharsetRule converts the tables matched by SchemaPattern and TablePattern from FromCharset to ToCharset. the
+// collations of FromCharset in DDLs are replaced by ToCollation, or removed to use the default collation of ToCharset
+// if it's empty.
+type CharsetRule struct {
+	SchemaPattern string `yaml:"schema-pattern" toml:"schema-pattern" json:"schema-pattern"`
+	TablePattern  string `yaml:"table-pattern" toml:"table-pattern" json:"table-pattern"`
+	FromCharset   string `yaml:"from-charset" toml:"from-charset" json:"from-charset"`
+	ToCharset     string `yaml:"to-charset" toml:"to-charset" json:"to-charset"`
+	ToCollation   string `yaml:"to-collation" toml:"to-collation" json:"to-collation"`
+}
+
+// adjust lowercases the charsets and validates the rule.
+func (r *CharsetRule) adjust() error {
+	if r.SchemaPattern == "" {
+		return terror.ErrConfigInvalidCharsetRule.Generate("schema-pattern is empty")
+	}
+	r.FromCharset = strings.ToLower(r.FromCharset)
+	r.ToCharset = strings.ToLower(r.ToCharset)
+	r.ToCollation = strings.ToLower(r.ToCollation)
+	if r.ToCharset == "" {
+		r.ToCharset = CharsetUTF8MB4
+	}
+	if r.FromCharset != CharsetLatin1 && r.FromCharset != CharsetGBK {
+		return terror.ErrConfigInvalidCharsetRule.Generate(fmt.Sprintf("from-charset '%s' is not supported", r.FromCharset))
+	}
+	if r.ToCharset != CharsetUTF8MB4 && r.ToCharset != CharsetUTF8 {
+		return terror.ErrConfigInvalidCharsetRule.Generate(fmt.Sprintf("to-charset '%s' is not supported", r.ToCharset))
+	}
+	if r.ToCollation != "" && !strings.HasPrefix(r.ToCollation, r.ToCharset+"_") {
+		return terror.ErrConfigInvalidCharsetRule.Generate(fmt.Sprintf("to-collation '%s' doesn't belong to charset %s", r.ToCollation, r.ToCharset))
+	}
+	return nil
+}
+
+// EnableDDLRewrite returns whether the DDLs are rewritten before applied.
+func (m *SyncerConfig) EnableDDLRewrite() bool {
+	return len(m.DDLRewriteRules) > 0 || m.DDLRewriteHook != ""
 }
 
 // DefaultSyncerConfig return default syncer config for task.
@@ -327,6 +844,209 @@ func (m *SyncerConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return nil
 }
 
+func (m *SyncerConfig) adjust() error {
+	if m.MultipleRowsBatch < 0 {
+		m.MultipleRowsBatch = 0
+	}
+	if m.PreparedStmtCacheSize < 0 {
+		m.PreparedStmtCacheSize = 0
+	}
+	if m.AutoSafeModeDuration < 0 {
+		m.AutoSafeModeDuration = 0
+	}
+	if m.MaxEventSize < 0 {
+		m.MaxEventSize = 0
+	}
+	if m.RelayReaderChannelCapacity < 0 {
+		m.RelayReaderChannelCapacity = 0
+	}
+	if m.RelayReaderPrefetchEvents < 0 {
+		m.RelayReaderPrefetchEvents = 0
+	}
+	if m.RelayReaderEventsPerSecond < 0 {
+		m.RelayReaderEventsPerSecond = 0
+	}
+	if m.RelayReaderBytesPerSecond < 0 {
+		m.RelayReaderBytesPerSecond = 0
+	}
+	if m.MaxEventSizePolicy == "" {
+		m.MaxEventSizePolicy = MaxEventSizeError
+	}
+	m.MaxEventSizePolicy = MaxEventSizePolicy(strings.ToLower(string(m.MaxEventSizePolicy)))
+	if m.MaxEventSizePolicy != MaxEventSizeError && m.MaxEventSizePolicy != MaxEventSizeSkip && m.MaxEventSizePolicy != MaxEventSizeChunk {
+		return terror.ErrConfigInvalidMaxEventSizePolicy.Generate(m.MaxEventSizePolicy)
+	}
+
+	if m.CheckpointFlushPolicy == "" {
+		m.CheckpointFlushPolicy = CheckpointFlushByInterval
+	}
+	m.CheckpointFlushPolicy = CheckpointFlushPolicy(strings.ToLower(string(m.CheckpointFlushPolicy)))
+	switch m.CheckpointFlushPolicy {
+	case CheckpointFlushByInterval, CheckpointFlushManually:
+	case CheckpointFlushByTxn:
+		if m.CheckpointFlushTxnCount <= 0 {
+			m.CheckpointFlushTxnCount = defaultCheckpointFlushTxnCount
+		}
+	case CheckpointFlushByBytes:
+		if m.CheckpointFlushBytes <= 0 {
+			m.CheckpointFlushBytes = defaultCheckpointFlushBytes
+		}
+	default:
+		return terror.ErrConfigInvalidCheckpointFlushPolicy.Generate(m.CheckpointFlushPolicy)
+	}
+
+	// empty sink-dispatcher means dispatch by table.
+	m.SinkDispatcher = SinkDispatcher(strings.ToLower(string(m.SinkDispatcher)))
+	if m.SinkDispatcher != "" && m.SinkDispatcher != SinkDispatchByTable && m.SinkDispatcher != SinkDispatchByPK {
+		return terror.ErrConfigInvalidSinkDispatcher.Generate(m.SinkDispatcher)
+	}
+	if m.SinkURI != "" {
+		if err := checkSinkURI(m.SinkURI); err != nil {
+			return err
+		}
+		if m.DryRunDir != "" {
+			return terror.ErrConfigDryRunNotSupport.Generate("sink-uri")
+		}
+	}
+
+	for _, rule := range m.CharsetRules {
+		if err := rule.adjust(); err != nil {
+			return err
+		}
+	}
+
+	if m.GeneratedColumnPolicy == "" {
+		m.GeneratedColumnPolicy = ColumnPolicySkip
+	}
+	m.GeneratedColumnPolicy = ColumnPolicy(strings.ToLower(string(m.GeneratedColumnPolicy)))
+	switch m.GeneratedColumnPolicy {
+	case ColumnPolicySkip, ColumnPolicyMaterialize, ColumnPolicyMaterializeStored:
+	default:
+		return terror.ErrConfigInvalidColumnPolicy.Generate("generated-column-policy", m.GeneratedColumnPolicy)
+	}
+	if m.InvisibleColumnPolicy == "" {
+		m.InvisibleColumnPolicy = ColumnPolicyMaterialize
+	}
+	m.InvisibleColumnPolicy = ColumnPolicy(strings.ToLower(string(m.InvisibleColumnPolicy)))
+	if m.InvisibleColumnPolicy != ColumnPolicySkip && m.InvisibleColumnPolicy != ColumnPolicyMaterialize {
+		return terror.ErrConfigInvalidColumnPolicy.Generate("invisible-column-policy", m.InvisibleColumnPolicy)
+	}
+
+	if m.ForeignKeyPolicy == "" {
+		m.ForeignKeyPolicy = ForeignKeyPolicyNone
+	}
+	m.ForeignKeyPolicy = ForeignKeyPolicy(strings.ToLower(string(m.ForeignKeyPolicy)))
+	switch m.ForeignKeyPolicy {
+	case ForeignKeyPolicyNone, ForeignKeyPolicyDisableChecks:
+	case ForeignKeyPolicyOrdered:
+		// the compactor moves the merged DML of a row to the position of its last DML, which breaks the order.
+		if m.Compact {
+			return terror.ErrConfigInvalidForeignKeyPolicy.Generate(m.ForeignKeyPolicy, ", it can't be used with compact")
+		}
+	default:
+		return terror.ErrConfigInvalidForeignKeyPolicy.Generate(m.ForeignKeyPolicy, "")
+	}
+
+	if m.SchemaSnapshotInterval < 0 {
+		m.SchemaSnapshotInterval = 0
+	}
+
+	if m.SchemaTrackerMemoryBudget < 0 {
+		m.SchemaTrackerMemoryBudget = 0
+	}
+
+	if m.DMLType == "" {
+		m.DMLType = DMLTypeStandard
+	}
+	m.DMLType = DMLType(strings.ToLower(string(m.DMLType)))
+	if m.DMLType != DMLTypeStandard && m.DMLType != DMLTypeBulk {
+		return terror.ErrConfigInvalidDMLType.Generate(m.DMLType)
+	}
+
+	for _, rule := range m.DDLRewriteRules {
+		if _, err := regexp.Compile(rule.Pattern); err != nil {
+			return terror.ErrConfigInvalidDDLRewrite.Generate("ddl-rewrite-rules pattern", rule.Pattern, err.Error())
+		}
+	}
+	if m.DDLRewriteHook != "" {
+		u, err := url.Parse(m.DDLRewriteHook)
+		if err != nil {
+			return terror.ErrConfigInvalidDDLRewrite.Generate("ddl-rewrite-hook", m.DDLRewriteHook, err.Error())
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return terror.ErrConfigInvalidDDLRewrite.Generate("ddl-rewrite-hook", m.DDLRewriteHook, "only http and https are supported")
+		}
+	}
+
+	// the time zone doesn't matter when checking the time window
+	var startTime, stopTime time.Time
+	if m.StartTime != "" {
+		t, err := ParseStartTime(m.StartTime, time.UTC)
+		if err != nil {
+			return terror.ErrConfigInvalidTimeWindow.Generate(fmt.Sprintf("start-time %s: %s", m.StartTime, err.Error()))
+		}
+		startTime = t
+	}
+	if m.StopTime != "" {
+		t, err := ParseStartTime(m.StopTime, time.UTC)
+		if err != nil {
+			return terror.ErrConfigInvalidTimeWindow.Generate(fmt.Sprintf("stop-time %s: %s", m.StopTime, err.Error()))
+		}
+		stopTime = t
+	}
+	if m.StartTime != "" && m.StopTime != "" && !startTime.Before(stopTime) {
+		return terror.ErrConfigInvalidTimeWindow.Generate(fmt.Sprintf("start-time %s is not earlier than stop-time %s", m.StartTime, m.StopTime))
+	}
+
+	if m.OnBinlogGap == "" {
+		m.OnBinlogGap = BinlogGapError
+	}
+	m.OnBinlogGap = BinlogGapPolicy(strings.ToLower(string(m.OnBinlogGap)))
+	if m.OnBinlogGap != BinlogGapError && m.OnBinlogGap != BinlogGapRedump {
+		return terror.ErrConfigInvalidOnBinlogGap.Generate(m.OnBinlogGap)
+	}
+
+	if m.ValidationMode == "" {
+		m.ValidationMode = ValidationNone
+	}
+	m.ValidationMode = ValidationMode(strings.ToLower(string(m.ValidationMode)))
+	if m.ValidationMode != ValidationNone && m.ValidationMode != ValidationRow && m.ValidationMode != ValidationChunk {
+		return terror.ErrConfigInvalidValidationMode.Generate(m.ValidationMode)
+	}
+	if m.ValidationChunkSize <= 0 {
+		m.ValidationChunkSize = defaultValidationChunkSize
+	}
+	if m.ValidationInterval <= 0 {
+		m.ValidationInterval = defaultValidationInterval
+	}
+	if m.ValidationMaxRetry <= 0 {
+		m.ValidationMaxRetry = defaultValidationMaxRetry
+	}
+	return nil
+}
+
+// checkSinkURI checks the URI of Kafka, only canal-json and open-protocol are supported.
+func checkSinkURI(sinkURI string) error {
+	u, err := url.Parse(sinkURI)
+	if err != nil {
+		return terror.ErrConfigInvalidSinkURI.Delegate(err, sinkURI, "fail to parse")
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "kafka", "kafka+ssl":
+	default:
+		return terror.ErrConfigInvalidSinkURI.Generate(sinkURI, fmt.Sprintf("scheme '%s' is not supported", u.Scheme))
+	}
+	if strings.Trim(u.Path, "/") == "" {
+		return terror.ErrConfigInvalidSinkURI.Generate(sinkURI, "no topic is specified")
+	}
+	switch protocol := u.Query().Get("protocol"); protocol {
+	case "", "default", "open-protocol", "canal-json":
+	default:
+		return terror.ErrConfigInvalidSinkURI.Generate(sinkURI, fmt.Sprintf("protocol '%s' is not supported", protocol))
+	}
+	return nil
+}
+
 // TaskConfig is the configuration for Task.
 type TaskConfig struct {
 	*flag.FlagSet `yaml:"-" toml:"-" json:"-"`
@@ -335,6 +1055,10 @@ type TaskConfig struct {
 	TaskMode   string `yaml:"task-mode" toml:"task-mode" json:"task-mode"`
 	IsSharding bool   `yaml:"is-sharding" toml:"is-sharding" json:"is-sharding"`
 	ShardMode  string `yaml:"shard-mode" toml:"shard-mode" json:"shard-mode"` // when `shard-mode` set, we always enable sharding support.
+	// the pessimistic shard DDL lock waiting for the lagging sources longer than the timeout (in seconds) is unlocked
+	// automatically by the strategy, 0 means never.
+	ShardDDLLockTimeout         int    `yaml:"shard-ddl-lock-timeout" toml:"shard-ddl-lock-timeout" json:"shard-ddl-lock-timeout"`
+	ShardDDLLockTimeoutStrategy string `yaml:"shard-ddl-lock-timeout-strategy" toml:"shard-ddl-lock-timeout-strategy" json:"shard-ddl-lock-timeout-strategy"`
 	// treat it as hidden configuration
 	IgnoreCheckingItems []string `yaml:"ignore-checking-items" toml:"ignore-checking-items" json:"ignore-checking-items"`
 	// we store detail status in meta
@@ -364,14 +1088,27 @@ type TaskConfig struct {
 	// pt/gh-ost name rule,support regex
 	ShadowTableRules []string `yaml:"shadow-table-rules" toml:"shadow-table-rules" json:"shadow-table-rules"`
 	TrashTableRules  []string `yaml:"trash-table-rules" toml:"trash-table-rules" json:"trash-table-rules"`
+	// other online schema change tools whose tables are recognized besides the rules above, like spirit, fb-osc, lhm
+	OnlineDDLTools []string `yaml:"online-ddl-tools" toml:"online-ddl-tools" json:"online-ddl-tools"`
 
 	// deprecated
 	OnlineDDLScheme string `yaml:"online-ddl-scheme" toml:"online-ddl-scheme" json:"online-ddl-scheme"`
 
-	Routes         map[string]*router.TableRule   `yaml:"routes" toml:"routes" json:"routes"`
-	Filters        map[string]*bf.BinlogEventRule `yaml:"filters" toml:"filters" json:"filters"`
-	ColumnMappings map[string]*column.Rule        `yaml:"column-mappings" toml:"column-mappings" json:"column-mappings"`
-	ExprFilter     map[string]*ExpressionFilter   `yaml:"expression-filter" toml:"expression-filter" json:"expression-filter"`
+	Routes         map[string]*router.TableRule `yaml:"routes" toml:"routes" json:"routes"`
+	Filters        map[string]*BinlogEventRule  `yaml:"filters" toml:"filters" json:"filters"`
+	ColumnMappings map[string]*column.Rule      `yaml:"column-mappings" toml:"column-mappings" json:"column-mappings"`
+	ExprFilter     map[string]*ExpressionFilter `yaml:"expression-filter" toml:"expression-filter" json:"expression-filter"`
+
+	// SourceTagColumn is an extra column of all downstream tables, which is populated with the source ID of the rows,
+	// so the merged tables keep the provenance of rows. `extract-source` is added to the route rules without it for
+	// the full data, and the syncer populates it for all tables. the column should be created in downstream tables
+	// manually.
+	SourceTagColumn string `yaml:"source-tag-column" toml:"source-tag-column" json:"source-tag-column"`
+	// AutoIDConflictStrategy is the strategy to handle the conflicts of the auto-increment keys of the merged shard
+	// tables, it can be `check`, `source-prefix` or `extra-column`.
+	AutoIDConflictStrategy string `yaml:"auto-id-conflict-strategy" toml:"auto-id-conflict-strategy" json:"auto-id-conflict-strategy"`
+	// Priority is the priority of the task, it can be `high`, `normal` or `low`, and can be changed at runtime.
+	Priority string `yaml:"priority" toml:"priority" json:"priority"`
 
 	// black-white-list is deprecated, use block-allow-list instead
 	BWList map[string]*filter.Rules `yaml:"black-white-list" toml:"black-white-list" json:"black-white-list"`
@@ -405,7 +1142,7 @@ func NewTaskConfig() *TaskConfig {
 		MySQLInstances:          make([]*MySQLInstance, 0, 5),
 		IsSharding:              defaultIsSharding,
 		Routes:                  make(map[string]*router.TableRule),
-		Filters:                 make(map[string]*bf.BinlogEventRule),
+		Filters:                 make(map[string]*BinlogEventRule),
 		ColumnMappings:          make(map[string]*column.Rule),
 		ExprFilter:              make(map[string]*ExpressionFilter),
 		BWList:                  make(map[string]*filter.Rules),
@@ -497,6 +1234,19 @@ func (c *TaskConfig) adjust() error {
 		c.ShardMode = ShardPessimistic // use the pessimistic mode as default for back compatible.
 	}
 
+	if c.ShardDDLLockTimeout < 0 {
+		c.ShardDDLLockTimeout = 0
+	}
+	switch c.ShardDDLLockTimeoutStrategy {
+	case ShardDDLLockTimeoutSkip, ShardDDLLockTimeoutForceExec:
+	case "":
+		if c.ShardDDLLockTimeout > 0 {
+			c.ShardDDLLockTimeoutStrategy = ShardDDLLockTimeoutSkip
+		}
+	default:
+		return terror.ErrConfigShardDDLLockTimeoutStrategyNotSupport.Generate(c.ShardDDLLockTimeoutStrategy)
+	}
+
 	if c.CollationCompatible != "" && c.CollationCompatible != LooseCollationCompatible && c.CollationCompatible != StrictCollationCompatible {
 		return terror.ErrConfigCollationCompatibleNotSupport.Generate(c.CollationCompatible)
 	} else if c.CollationCompatible == "" {
@@ -509,6 +1259,46 @@ func (c *TaskConfig) adjust() error {
 		}
 	}
 
+	if c.SourceTagColumn != "" {
+		for _, rule := range c.Routes {
+			if rule != nil && rule.SourceExtractor == nil {
+				rule.SourceExtractor = &router.SourceExtractor{TargetColumn: c.SourceTagColumn, SourceRegexp: "(.*)"}
+			}
+		}
+	}
+
+	switch c.AutoIDConflictStrategy {
+	case "", AutoIDConflictCheck:
+	case AutoIDConflictSourcePrefix:
+		if len(c.MySQLInstances) > maxAutoIDSourceCount {
+			return terror.ErrConfigInvalidAutoIDConflictStrategy.Generate(c.AutoIDConflictStrategy,
+				fmt.Sprintf("at most %d sources are supported", maxAutoIDSourceCount))
+		}
+		for _, inst := range c.MySQLInstances {
+			if inst != nil && len(inst.ColumnMappingRules) > 0 {
+				return terror.ErrConfigInvalidAutoIDConflictStrategy.Generate(c.AutoIDConflictStrategy,
+					fmt.Sprintf("column-mapping-rules of source %s can't be used together", inst.SourceID))
+			}
+		}
+	case AutoIDConflictExtraColumn:
+		hasExtractor := c.SourceTagColumn != ""
+		for _, rule := range c.Routes {
+			if rule != nil && (rule.TableExtractor != nil || rule.SchemaExtractor != nil || rule.SourceExtractor != nil) {
+				hasExtractor = true
+				break
+			}
+		}
+		if !hasExtractor {
+			return terror.ErrConfigInvalidAutoIDConflictStrategy.Generate(c.AutoIDConflictStrategy,
+				"no extra column is extracted by route rules or source-tag-column")
+		}
+	default:
+		return terror.ErrConfigInvalidAutoIDConflictStrategy.Generate(c.AutoIDConflictStrategy, "not supported")
+	}
+	if err := AdjustTaskPriority(&c.Priority); err != nil {
+		return err
+	}
+
 	if c.OnlineDDLScheme != "" && c.OnlineDDLScheme != PT && c.OnlineDDLScheme != GHOST {
 		return terror.ErrConfigOnlineSchemeNotSupport.Generate(c.OnlineDDLScheme)
 	} else if c.OnlineDDLScheme == PT || c.OnlineDDLScheme == GHOST {
@@ -524,6 +1314,16 @@ func (c *TaskConfig) adjust() error {
 		return terror.ErrConfigMySQLInstsAtLeastOne.Generate()
 	}
 
+	for name, rule := range c.Filters {
+		if rule == nil || rule.RowValueExpr == "" {
+			continue
+		}
+		rule.Name = name
+		if err := rule.validate(); err != nil {
+			return err
+		}
+	}
+
 	for name, exprFilter := range c.ExprFilter {
 		if exprFilter.Schema == "" {
 			return terror.ErrConfigExprFilterEmptyName.Generate(name, "schema")
@@ -557,6 +1357,9 @@ func (c *TaskConfig) adjust() error {
 			}
 			setFields = append(setFields, "delete: ["+exprFilter.DeleteValueExpr+"]")
 		}
+		if exprFilter.Plugin != "" {
+			setFields = append(setFields, "plugin: ["+exprFilter.Plugin+"]")
+		}
 		if len(setFields) > 1 {
 			return terror.ErrConfigExprFilterManyExpr.Generate(name, setFields)
 		}
@@ -576,7 +1379,12 @@ func (c *TaskConfig) adjust() error {
 
 		switch c.TaskMode {
 		case ModeFull, ModeAll:
-			if inst.Meta != nil {
+			loaderCfg := inst.Loader
+			if loaderCfg == nil {
+				loaderCfg = c.Loaders[inst.LoaderConfigName]
+			}
+			// the meta is used to sync incrementally after importing the external data
+			if inst.Meta != nil && (loaderCfg == nil || loaderCfg.External == nil) {
 				log.L().Warn("metadata will not be used. for Full mode, incremental sync will never occur; for All mode, the meta dumped by MyDumper will be used", zap.Int("mysql instance", i), zap.String("task mode", c.TaskMode))
 			}
 		case ModeIncrement:
@@ -663,6 +1471,12 @@ func (c *TaskConfig) adjust() error {
 		if inst.LoaderThread != 0 {
 			inst.Loader.PoolSize = inst.LoaderThread
 		}
+		// there is no binlog location in the external data, the incremental sync starts from the meta.
+		if inst.Loader.External != nil && c.TaskMode == ModeAll {
+			if inst.Meta == nil || len(inst.Meta.BinLogName) == 0 || inst.Meta.BinLogPos == 0 {
+				return terror.ErrConfigInvalidExternalData.Generate(fmt.Sprintf("binlog-name and binlog-pos of meta should be set for mysql-instance(%d) to sync incrementally after importing external data", i))
+			}
+		}
 
 		if len(inst.SyncerConfigName) > 0 {
 			rule, ok := c.Syncers[inst.SyncerConfigName]
@@ -732,7 +1546,10 @@ func (c *TaskConfig) adjust() error {
 			unusedConfigs = append(unusedConfigs, columnMapping)
 		}
 	}
-	for mydumper := range c.Mydumpers {
+	for mydumper, cfg := range c.Mydumpers {
+		if err1 := cfg.adjust(); err1 != nil {
+			return err1
+		}
 		if globalConfigReferCount[configRefPrefixes[mydumperIdx]+mydumper] == 0 {
 			unusedConfigs = append(unusedConfigs, mydumper)
 		}
@@ -745,7 +1562,10 @@ func (c *TaskConfig) adjust() error {
 			unusedConfigs = append(unusedConfigs, loader)
 		}
 	}
-	for syncer := range c.Syncers {
+	for syncer, cfg := range c.Syncers {
+		if err1 := cfg.adjust(); err1 != nil {
+			return err1
+		}
 		if globalConfigReferCount[configRefPrefixes[syncerIdx]+syncer] == 0 {
 			unusedConfigs = append(unusedConfigs, syncer)
 		}
@@ -938,8 +1758,62 @@ type SyncerConfigForDowngrade struct {
 	SafeMode                bool   `yaml:"safe-mode"`
 	EnableANSIQuotes        bool   `yaml:"enable-ansi-quotes"`
 
-	Compact      bool `yaml:"compact,omitempty"`
-	MultipleRows bool `yaml:"multipleRows,omitempty"`
+	Compact               bool               `yaml:"compact,omitempty"`
+	MultipleRows          bool               `yaml:"multipleRows,omitempty"`
+	MultipleRowsBatch     int                `yaml:"multiple-rows-batch,omitempty"`
+	PreparedStmtCacheSize int                `yaml:"prepared-stmt-cache-size,omitempty"`
+	AutoSafeModeDuration  int                `yaml:"auto-safe-mode-duration,omitempty"`
+	MaxEventSize          int64              `yaml:"max-event-size,omitempty"`
+	MaxEventSizePolicy    MaxEventSizePolicy `yaml:"max-event-size-policy,omitempty"`
+
+	RelayReaderChannelCapacity  int     `yaml:"relay-reader-channel-capacity,omitempty"`
+	RelayReaderPrefetchEvents   int     `yaml:"relay-reader-prefetch-events,omitempty"`
+	RelayReaderRecoverTornEvent bool    `yaml:"relay-reader-recover-torn-event,omitempty"`
+	RelayReaderEventsPerSecond  float64 `yaml:"relay-reader-events-per-second,omitempty"`
+	RelayReaderBytesPerSecond   float64 `yaml:"relay-reader-bytes-per-second,omitempty"`
+	RelayReaderRebuildUUIDIndex bool    `yaml:"relay-reader-rebuild-uuid-index,omitempty"`
+
+	CheckpointFlushPolicy   CheckpointFlushPolicy `yaml:"checkpoint-flush-policy,omitempty"`
+	CheckpointFlushTxnCount int                   `yaml:"checkpoint-flush-txn-count,omitempty"`
+	CheckpointFlushBytes    int64                 `yaml:"checkpoint-flush-bytes,omitempty"`
+
+	SinkURI        string         `yaml:"sink-uri,omitempty"`
+	SinkDispatcher SinkDispatcher `yaml:"sink-dispatcher,omitempty"`
+
+	StatementDMLFallback bool `yaml:"statement-dml-fallback,omitempty"`
+
+	DDLRewriteRules []*DDLRewriteRule `yaml:"ddl-rewrite-rules,omitempty"`
+	DDLRewriteHook  string            `yaml:"ddl-rewrite-hook,omitempty"`
+
+	DDLApproval bool `yaml:"ddl-approval,omitempty"`
+
+	StartTime string `yaml:"start-time,omitempty"`
+	StopTime  string `yaml:"stop-time,omitempty"`
+
+	ValidationMode      ValidationMode `yaml:"validation-mode,omitempty"`
+	ValidationChunkSize int            `yaml:"validation-chunk-size,omitempty"`
+	ValidationInterval  int            `yaml:"validation-interval,omitempty"`
+	ValidationMaxRetry  int            `yaml:"validation-max-retry,omitempty"`
+
+	RowsPerSecond  int64 `yaml:"rows-per-second,omitempty"`
+	BytesPerSecond int64 `yaml:"bytes-per-second,omitempty"`
+
+	MemoryQuota int64 `yaml:"memory-quota,omitempty"`
+
+	CharsetRules []*CharsetRule `yaml:"charset-rules,omitempty"`
+
+	GeneratedColumnPolicy ColumnPolicy `yaml:"generated-column-policy,omitempty"`
+	InvisibleColumnPolicy ColumnPolicy `yaml:"invisible-column-policy,omitempty"`
+
+	ForeignKeyPolicy ForeignKeyPolicy `yaml:"foreign-key-policy,omitempty"`
+
+	DryRunDir string `yaml:"dry-run-dir,omitempty"`
+
+	DMLType DMLType `yaml:"dml-type,omitempty"`
+
+	SchemaSnapshotInterval int `yaml:"schema-snapshot-interval,omitempty"`
+
+	SchemaTrackerMemoryBudget int `yaml:"schema-tracker-memory-budget,omitempty"`
 }
 
 // NewSyncerConfigsForDowngrade converts SyncerConfig to SyncerConfigForDowngrade.
@@ -947,25 +1821,75 @@ func NewSyncerConfigsForDowngrade(syncerConfigs map[string]*SyncerConfig) map[st
 	syncerConfigsForDowngrade := make(map[string]*SyncerConfigForDowngrade, len(syncerConfigs))
 	for configName, syncerConfig := range syncerConfigs {
 		newSyncerConfig := &SyncerConfigForDowngrade{
-			MetaFile:                syncerConfig.MetaFile,
-			WorkerCount:             syncerConfig.WorkerCount,
-			Batch:                   syncerConfig.Batch,
-			QueueSize:               syncerConfig.QueueSize,
-			CheckpointFlushInterval: syncerConfig.CheckpointFlushInterval,
-			MaxRetry:                syncerConfig.MaxRetry,
-			AutoFixGTID:             syncerConfig.AutoFixGTID,
-			EnableGTID:              syncerConfig.EnableGTID,
-			DisableCausality:        syncerConfig.DisableCausality,
-			SafeMode:                syncerConfig.SafeMode,
-			EnableANSIQuotes:        syncerConfig.EnableANSIQuotes,
-			Compact:                 syncerConfig.Compact,
-			MultipleRows:            syncerConfig.MultipleRows,
+			MetaFile:                    syncerConfig.MetaFile,
+			WorkerCount:                 syncerConfig.WorkerCount,
+			Batch:                       syncerConfig.Batch,
+			QueueSize:                   syncerConfig.QueueSize,
+			CheckpointFlushInterval:     syncerConfig.CheckpointFlushInterval,
+			MaxRetry:                    syncerConfig.MaxRetry,
+			AutoFixGTID:                 syncerConfig.AutoFixGTID,
+			EnableGTID:                  syncerConfig.EnableGTID,
+			DisableCausality:            syncerConfig.DisableCausality,
+			SafeMode:                    syncerConfig.SafeMode,
+			EnableANSIQuotes:            syncerConfig.EnableANSIQuotes,
+			Compact:                     syncerConfig.Compact,
+			MultipleRows:                syncerConfig.MultipleRows,
+			MultipleRowsBatch:           syncerConfig.MultipleRowsBatch,
+			PreparedStmtCacheSize:       syncerConfig.PreparedStmtCacheSize,
+			AutoSafeModeDuration:        syncerConfig.AutoSafeModeDuration,
+			MaxEventSize:                syncerConfig.MaxEventSize,
+			MaxEventSizePolicy:          syncerConfig.MaxEventSizePolicy,
+			RelayReaderChannelCapacity:  syncerConfig.RelayReaderChannelCapacity,
+			RelayReaderPrefetchEvents:   syncerConfig.RelayReaderPrefetchEvents,
+			RelayReaderRecoverTornEvent: syncerConfig.RelayReaderRecoverTornEvent,
+			RelayReaderEventsPerSecond:  syncerConfig.RelayReaderEventsPerSecond,
+			RelayReaderBytesPerSecond:   syncerConfig.RelayReaderBytesPerSecond,
+			RelayReaderRebuildUUIDIndex: syncerConfig.RelayReaderRebuildUUIDIndex,
+			CheckpointFlushPolicy:       syncerConfig.CheckpointFlushPolicy,
+			CheckpointFlushTxnCount:     syncerConfig.CheckpointFlushTxnCount,
+			CheckpointFlushBytes:        syncerConfig.CheckpointFlushBytes,
+			SinkURI:                     syncerConfig.SinkURI,
+			SinkDispatcher:              syncerConfig.SinkDispatcher,
+			StatementDMLFallback:        syncerConfig.StatementDMLFallback,
+			DDLRewriteRules:             syncerConfig.DDLRewriteRules,
+			DDLRewriteHook:              syncerConfig.DDLRewriteHook,
+			DDLApproval:                 syncerConfig.DDLApproval,
+			StartTime:                   syncerConfig.StartTime,
+			StopTime:                    syncerConfig.StopTime,
+			ValidationMode:              syncerConfig.ValidationMode,
+			ValidationChunkSize:         syncerConfig.ValidationChunkSize,
+			ValidationInterval:          syncerConfig.ValidationInterval,
+			ValidationMaxRetry:          syncerConfig.ValidationMaxRetry,
+			RowsPerSecond:               syncerConfig.RowsPerSecond,
+			BytesPerSecond:              syncerConfig.BytesPerSecond,
+			MemoryQuota:                 syncerConfig.MemoryQuota,
+			CharsetRules:                syncerConfig.CharsetRules,
+			GeneratedColumnPolicy:       syncerConfig.GeneratedColumnPolicy,
+			InvisibleColumnPolicy:       syncerConfig.InvisibleColumnPolicy,
+			ForeignKeyPolicy:            syncerConfig.ForeignKeyPolicy,
+			DryRunDir:                   syncerConfig.DryRunDir,
+			DMLType:                     syncerConfig.DMLType,
+			SchemaSnapshotInterval:      syncerConfig.SchemaSnapshotInterval,
+			SchemaTrackerMemoryBudget:   syncerConfig.SchemaTrackerMemoryBudget,
 		}
 		syncerConfigsForDowngrade[configName] = newSyncerConfig
 	}
 	return syncerConfigsForDowngrade
 }
 
+// NewFiltersForDowngrade converts BinlogEventRule to the rule of binlog event filter. the rules with RowValueExpr are
+// removed, otherwise they will filter all the matched events in the older versions. so the older versions will
+// report the rules referred by mysql-instances are not found, rather than replicate the rows should be filtered.
+func NewFiltersForDowngrade(filters map[string]*BinlogEventRule) map[string]*bf.BinlogEventRule {
+	filtersForDowngrade := make(map[string]*bf.BinlogEventRule, len(filters))
+	for name, rule := range filters {
+		if rule.RowValueExpr == "" {
+			filtersForDowngrade[name] = &rule.BinlogEventRule
+		}
+	}
+	return filtersForDowngrade
+}
+
 // TaskConfigForDowngrade is the base configuration for task in v2.0.
 // This config is used for downgrade(config export) from a higher dmctl version.
 // When we add any new config item into SourceConfig, we should update it also.
@@ -995,45 +1919,57 @@ type TaskConfigForDowngrade struct {
 	EnableANSIQuotes        bool                                 `yaml:"ansi-quotes"`
 	RemoveMeta              bool                                 `yaml:"remove-meta"`
 	// new config item
-	MySQLInstances   []*MySQLInstanceForDowngrade `yaml:"mysql-instances"`
-	ExprFilter       map[string]*ExpressionFilter `yaml:"expression-filter,omitempty"`
-	OnlineDDL        bool                         `yaml:"online-ddl,omitempty"`
-	ShadowTableRules []string                     `yaml:"shadow-table-rules,omitempty"`
-	TrashTableRules  []string                     `yaml:"trash-table-rules,omitempty"`
+	MySQLInstances              []*MySQLInstanceForDowngrade `yaml:"mysql-instances"`
+	ExprFilter                  map[string]*ExpressionFilter `yaml:"expression-filter,omitempty"`
+	OnlineDDL                   bool                         `yaml:"online-ddl,omitempty"`
+	ShadowTableRules            []string                     `yaml:"shadow-table-rules,omitempty"`
+	TrashTableRules             []string                     `yaml:"trash-table-rules,omitempty"`
+	OnlineDDLTools              []string                     `yaml:"online-ddl-tools,omitempty"`
+	ShardDDLLockTimeout         int                          `yaml:"shard-ddl-lock-timeout,omitempty"`
+	ShardDDLLockTimeoutStrategy string                       `yaml:"shard-ddl-lock-timeout-strategy,omitempty"`
+	SourceTagColumn             string                       `yaml:"source-tag-column,omitempty"`
+	AutoIDConflictStrategy      string                       `yaml:"auto-id-conflict-strategy,omitempty"`
+	Priority                    string                       `yaml:"priority,omitempty"`
 }
 
 // NewTaskConfigForDowngrade create new TaskConfigForDowngrade.
 func NewTaskConfigForDowngrade(taskConfig *TaskConfig) *TaskConfigForDowngrade {
 	return &TaskConfigForDowngrade{
-		Name:                    taskConfig.Name,
-		TaskMode:                taskConfig.TaskMode,
-		IsSharding:              taskConfig.IsSharding,
-		ShardMode:               taskConfig.ShardMode,
-		IgnoreCheckingItems:     taskConfig.IgnoreCheckingItems,
-		MetaSchema:              taskConfig.MetaSchema,
-		EnableHeartbeat:         taskConfig.EnableHeartbeat,
-		HeartbeatUpdateInterval: taskConfig.HeartbeatUpdateInterval,
-		HeartbeatReportInterval: taskConfig.HeartbeatReportInterval,
-		Timezone:                taskConfig.Timezone,
-		CaseSensitive:           taskConfig.CaseSensitive,
-		TargetDB:                taskConfig.TargetDB,
-		OnlineDDLScheme:         taskConfig.OnlineDDLScheme,
-		Routes:                  taskConfig.Routes,
-		Filters:                 taskConfig.Filters,
-		ColumnMappings:          taskConfig.ColumnMappings,
-		BWList:                  taskConfig.BWList,
-		BAList:                  taskConfig.BAList,
-		Mydumpers:               taskConfig.Mydumpers,
-		Loaders:                 taskConfig.Loaders,
-		Syncers:                 NewSyncerConfigsForDowngrade(taskConfig.Syncers),
-		CleanDumpFile:           taskConfig.CleanDumpFile,
-		EnableANSIQuotes:        taskConfig.EnableANSIQuotes,
-		RemoveMeta:              taskConfig.RemoveMeta,
-		MySQLInstances:          NewMySQLInstancesForDowngrade(taskConfig.MySQLInstances),
-		ExprFilter:              taskConfig.ExprFilter,
-		OnlineDDL:               taskConfig.OnlineDDL,
-		ShadowTableRules:        taskConfig.ShadowTableRules,
-		TrashTableRules:         taskConfig.TrashTableRules,
+		Name:                        taskConfig.Name,
+		TaskMode:                    taskConfig.TaskMode,
+		IsSharding:                  taskConfig.IsSharding,
+		ShardMode:                   taskConfig.ShardMode,
+		IgnoreCheckingItems:         taskConfig.IgnoreCheckingItems,
+		MetaSchema:                  taskConfig.MetaSchema,
+		EnableHeartbeat:             taskConfig.EnableHeartbeat,
+		HeartbeatUpdateInterval:     taskConfig.HeartbeatUpdateInterval,
+		HeartbeatReportInterval:     taskConfig.HeartbeatReportInterval,
+		Timezone:                    taskConfig.Timezone,
+		CaseSensitive:               taskConfig.CaseSensitive,
+		TargetDB:                    taskConfig.TargetDB,
+		OnlineDDLScheme:             taskConfig.OnlineDDLScheme,
+		Routes:                      taskConfig.Routes,
+		Filters:                     NewFiltersForDowngrade(taskConfig.Filters),
+		ColumnMappings:              taskConfig.ColumnMappings,
+		BWList:                      taskConfig.BWList,
+		BAList:                      taskConfig.BAList,
+		Mydumpers:                   taskConfig.Mydumpers,
+		Loaders:                     taskConfig.Loaders,
+		Syncers:                     NewSyncerConfigsForDowngrade(taskConfig.Syncers),
+		CleanDumpFile:               taskConfig.CleanDumpFile,
+		EnableANSIQuotes:            taskConfig.EnableANSIQuotes,
+		RemoveMeta:                  taskConfig.RemoveMeta,
+		MySQLInstances:              NewMySQLInstancesForDowngrade(taskConfig.MySQLInstances),
+		ExprFilter:                  taskConfig.ExprFilter,
+		OnlineDDL:                   taskConfig.OnlineDDL,
+		ShadowTableRules:            taskConfig.ShadowTableRules,
+		TrashTableRules:             taskConfig.TrashTableRules,
+		OnlineDDLTools:              taskConfig.OnlineDDLTools,
+		ShardDDLLockTimeout:         taskConfig.ShardDDLLockTimeout,
+		ShardDDLLockTimeoutStrategy: taskConfig.ShardDDLLockTimeoutStrategy,
+		SourceTagColumn:             taskConfig.SourceTagColumn,
+		AutoIDConflictStrategy:      taskConfig.AutoIDConflictStrategy,
+		Priority:                    taskConfig.Priority,
 	}
 }
 
@@ -20,6 +20,21 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 )
 
+// StartTimeFormat and StartTimeFormat2 are the layouts of TaskCliArgs.StartTime.
+const (
+	StartTimeFormat  = "2006-01-02 15:04:05"
+	StartTimeFormat2 = "2006-01-02T15:04:05"
+)
+
+// ParseStartTime parses the time in the layout of StartTimeFormat or StartTimeFormat2 in the given location.
+func ParseStartTime(timeStr string, loc *time.Location) (time.Time, error) {
+	t, err := time.ParseInLocation(StartTimeFormat, timeStr, loc)
+	if err != nil {
+		t, err = time.ParseInLocation(StartTimeFormat2, timeStr, loc)
+	}
+	return t, err
+}
+
 // TaskCliArgs is the task command line arguments, these arguments have higher priority than the config file and
 // downstream checkpoint, but may need to be removed after the first time they take effect.
 type TaskCliArgs struct {
@@ -46,10 +61,10 @@ func (t *TaskCliArgs) Verify() error {
 	if t.StartTime == "" {
 		return nil
 	}
-	_, err := time.Parse("2006-01-02 15:04:05", t.StartTime)
+	_, err := time.Parse(StartTimeFormat, t.StartTime)
 	if err == nil {
 		return nil
 	}
-	_, err = time.Parse("2006-01-02T15:04:05", t.StartTime)
+	_, err = time.Parse(StartTimeFormat2, t.StartTime)
 	return terror.Annotate(err, "error while parse start-time, expected in the format like '2006-01-02 15:04:05'")
 }
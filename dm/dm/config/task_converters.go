@@ -15,7 +15,6 @@ package config
 
 import (
 	"fmt"
-	"strings"
 
 	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
 	"github.com/pingcap/tidb-tools/pkg/column-mapping"
@@ -24,6 +23,7 @@ import (
 
 	"github.com/pingcap/tiflow/dm/openapi"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/storage"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 )
 
@@ -39,9 +39,12 @@ func TaskConfigToSubTaskConfigs(c *TaskConfig, sources map[string]DBConfig) ([]*
 		cfg := NewSubTaskConfig()
 		cfg.IsSharding = c.IsSharding
 		cfg.ShardMode = c.ShardMode
+		cfg.ShardDDLLockTimeout = c.ShardDDLLockTimeout
+		cfg.ShardDDLLockTimeoutStrategy = c.ShardDDLLockTimeoutStrategy
 		cfg.OnlineDDL = c.OnlineDDL
 		cfg.TrashTableRules = c.TrashTableRules
 		cfg.ShadowTableRules = c.ShadowTableRules
+		cfg.OnlineDDLTools = c.OnlineDDLTools
 		cfg.IgnoreCheckingItems = c.IgnoreCheckingItems
 		cfg.Name = c.Name
 		cfg.Mode = c.TaskMode
@@ -73,7 +76,7 @@ func TaskConfigToSubTaskConfigs(c *TaskConfig, sources map[string]DBConfig) ([]*
 			cfg.RouteRules[j] = c.Routes[name]
 		}
 
-		cfg.FilterRules = make([]*bf.BinlogEventRule, len(inst.FilterRules))
+		cfg.FilterRules = make([]*BinlogEventRule, len(inst.FilterRules))
 		for j, name := range inst.FilterRules {
 			cfg.FilterRules[j] = c.Filters[name]
 		}
@@ -88,6 +91,11 @@ func TaskConfigToSubTaskConfigs(c *TaskConfig, sources map[string]DBConfig) ([]*
 			cfg.ExprFilter[j] = c.ExprFilter[name]
 		}
 
+		cfg.SourceTagColumn = c.SourceTagColumn
+		cfg.AutoIDConflictStrategy = c.AutoIDConflictStrategy
+		cfg.Priority = c.Priority
+		cfg.SourceIndex = i
+
 		cfg.BAList = c.BAList[inst.BAListName]
 
 		cfg.MydumperConfig = *inst.Mydumper
@@ -110,28 +118,17 @@ func TaskConfigToSubTaskConfigs(c *TaskConfig, sources map[string]DBConfig) ([]*
 // OpenAPITaskToSubTaskConfigs generates sub task configs by openapi.Task.
 func OpenAPITaskToSubTaskConfigs(task *openapi.Task, toDBCfg *DBConfig, sourceCfgMap map[string]*SourceConfig) (
 	[]*SubTaskConfig, error) {
-	// source name -> migrate rule list
-	tableMigrateRuleMap := make(map[string][]openapi.TaskTableMigrateRule)
-	for _, rule := range task.TableMigrateRule {
-		tableMigrateRuleMap[rule.Source.SourceName] = append(tableMigrateRuleMap[rule.Source.SourceName], rule)
-	}
-	// rule name -> rule template
-	eventFilterTemplateMap := make(map[string]bf.BinlogEventRule)
+	var binlogFilterRules map[string]openapi.TaskBinLogFilterRule
 	if task.BinlogFilterRule != nil {
-		for ruleName, rule := range task.BinlogFilterRule.AdditionalProperties {
-			ruleT := bf.BinlogEventRule{Action: bf.Ignore}
-			if rule.IgnoreEvent != nil {
-				events := make([]bf.EventType, len(*rule.IgnoreEvent))
-				for i, eventStr := range *rule.IgnoreEvent {
-					events[i] = bf.EventType(eventStr)
-				}
-				ruleT.Events = events
-			}
-			if rule.IgnoreSql != nil {
-				ruleT.SQLPattern = *rule.IgnoreSql
-			}
-			eventFilterTemplateMap[ruleName] = ruleT
-		}
+		binlogFilterRules = task.BinlogFilterRule.AdditionalProperties
+	}
+	sourceNames := make([]string, 0, len(task.SourceConfig.SourceConf))
+	for _, sourceCfg := range task.SourceConfig.SourceConf {
+		sourceNames = append(sourceNames, sourceCfg.SourceName)
+	}
+	rulesMap, err := OpenAPIRulesToSubTaskRules(sourceNames, task.TableMigrateRule, binlogFilterRules)
+	if err != nil {
+		return nil, err
 	}
 	// start to generate sub task configs
 	subTaskCfgList := make([]*SubTaskConfig, len(task.SourceConfig.SourceConf))
@@ -206,13 +203,61 @@ func OpenAPITaskToSubTaskConfigs(task *openapi.Task, toDBCfg *DBConfig, sourceCf
 			}
 		}
 		// set route,blockAllowList,filter config
-		doCnt := len(tableMigrateRuleMap[sourceCfg.SourceName])
-		doDBs := make([]string, doCnt)
-		doTables := make([]*filter.Table, doCnt)
+		rules := rulesMap[sourceCfg.SourceName]
+		subTaskCfg.RouteRules = rules.RouteRules
+		subTaskCfg.FilterRules = rules.FilterRules
+		subTaskCfg.BAList = rules.BAList
+		// adjust sub task config
+		if err := subTaskCfg.Adjust(true); err != nil {
+			return nil, terror.Annotatef(err, "source name %s", sourceCfg.SourceName)
+		}
+		subTaskCfgList[i] = subTaskCfg
+	}
+	return subTaskCfgList, nil
+}
+
+// SubTaskRules is the block-allow-list, route and binlog filter rules of a subtask.
+type SubTaskRules struct {
+	BAList      *filter.Rules
+	RouteRules  []*router.TableRule
+	FilterRules []*BinlogEventRule
+}
 
+// OpenAPIRulesToSubTaskRules generates the block-allow-list, route and binlog filter rules of the subtasks by the
+// openapi table migrate rules and binlog filter rules for the sources, source name -> rules. the sources without table
+// migrate rules get the rules which allow nothing.
+func OpenAPIRulesToSubTaskRules(sourceNames []string, tableMigrateRules []openapi.TaskTableMigrateRule,
+	binlogFilterRules map[string]openapi.TaskBinLogFilterRule) (map[string]SubTaskRules, error) {
+	// source name -> migrate rule list
+	tableMigrateRuleMap := make(map[string][]openapi.TaskTableMigrateRule)
+	for _, rule := range tableMigrateRules {
+		tableMigrateRuleMap[rule.Source.SourceName] = append(tableMigrateRuleMap[rule.Source.SourceName], rule)
+	}
+	// rule name -> rule template
+	eventFilterTemplateMap := make(map[string]bf.BinlogEventRule)
+	for ruleName, rule := range binlogFilterRules {
+		ruleT := bf.BinlogEventRule{Action: bf.Ignore}
+		if rule.IgnoreEvent != nil {
+			events := make([]bf.EventType, len(*rule.IgnoreEvent))
+			for i, eventStr := range *rule.IgnoreEvent {
+				events[i] = bf.EventType(eventStr)
+			}
+			ruleT.Events = events
+		}
+		if rule.IgnoreSql != nil {
+			ruleT.SQLPattern = *rule.IgnoreSql
+		}
+		eventFilterTemplateMap[ruleName] = ruleT
+	}
+
+	rulesMap := make(map[string]SubTaskRules, len(sourceNames))
+	for _, sourceName := range sourceNames {
+		migrateRules := tableMigrateRuleMap[sourceName]
+		doDBs := make([]string, len(migrateRules))
+		doTables := make([]*filter.Table, len(migrateRules))
 		routeRules := []*router.TableRule{}
-		filterRules := []*bf.BinlogEventRule{}
-		for j, rule := range tableMigrateRuleMap[sourceCfg.SourceName] {
+		filterRules := []*BinlogEventRule{}
+		for j, rule := range migrateRules {
 			// route
 			if rule.Target != nil {
 				routeRules = append(routeRules, &router.TableRule{
@@ -229,23 +274,20 @@ func OpenAPITaskToSubTaskConfigs(task *openapi.Task, toDBCfg *DBConfig, sourceCf
 					}
 					filterRule.SchemaPattern = rule.Source.Schema
 					filterRule.TablePattern = rule.Source.Table
-					filterRules = append(filterRules, &filterRule)
+					filterRules = append(filterRules, &BinlogEventRule{BinlogEventRule: filterRule})
 				}
 			}
 			// BlockAllowList
 			doDBs[j] = rule.Source.Schema
 			doTables[j] = &filter.Table{Schema: rule.Source.Schema, Name: rule.Source.Table}
 		}
-		subTaskCfg.RouteRules = routeRules
-		subTaskCfg.FilterRules = filterRules
-		subTaskCfg.BAList = &filter.Rules{DoDBs: removeDuplication(doDBs), DoTables: doTables}
-		// adjust sub task config
-		if err := subTaskCfg.Adjust(true); err != nil {
-			return nil, terror.Annotatef(err, "source name %s", sourceCfg.SourceName)
+		rulesMap[sourceName] = SubTaskRules{
+			BAList:      &filter.Rules{DoDBs: removeDuplication(doDBs), DoTables: doTables},
+			RouteRules:  routeRules,
+			FilterRules: filterRules,
 		}
-		subTaskCfgList[i] = subTaskCfg
 	}
-	return subTaskCfgList, nil
+	return rulesMap, nil
 }
 
 // GetTargetDBCfgFromOpenAPITask gets target db config.
@@ -280,6 +322,11 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 	c.TaskMode = stCfg0.Mode
 	c.IsSharding = stCfg0.IsSharding
 	c.ShardMode = stCfg0.ShardMode
+	c.ShardDDLLockTimeout = stCfg0.ShardDDLLockTimeout
+	c.ShardDDLLockTimeoutStrategy = stCfg0.ShardDDLLockTimeoutStrategy
+	c.SourceTagColumn = stCfg0.SourceTagColumn
+	c.AutoIDConflictStrategy = stCfg0.AutoIDConflictStrategy
+	c.Priority = stCfg0.Priority
 	c.IgnoreCheckingItems = stCfg0.IgnoreCheckingItems
 	c.MetaSchema = stCfg0.MetaSchema
 	c.EnableHeartbeat = stCfg0.EnableHeartbeat
@@ -295,7 +342,7 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 	c.MySQLInstances = make([]*MySQLInstance, 0, len(stCfgs))
 	c.BAList = make(map[string]*filter.Rules)
 	c.Routes = make(map[string]*router.TableRule)
-	c.Filters = make(map[string]*bf.BinlogEventRule)
+	c.Filters = make(map[string]*BinlogEventRule)
 	c.ColumnMappings = make(map[string]*column.Rule)
 	c.Mydumpers = make(map[string]*MydumperConfig)
 	c.Loaders = make(map[string]*LoaderConfig)
@@ -339,9 +386,8 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 
 		loadName, loadIdx = getGenerateName(stCfg.LoaderConfig, loadIdx, "load", loadMap)
 		loaderCfg := stCfg.LoaderConfig
-		dirSuffix := "." + c.Name
-		// if ends with the task name, we remove to get user input dir.
-		loaderCfg.Dir = strings.TrimSuffix(loaderCfg.Dir, dirSuffix)
+		// if ends with the suffix added in adjust, we remove it to get user input dir.
+		loaderCfg.Dir = storage.TrimPath(loaderCfg.Dir, loaderDirSuffix(loaderCfg.Dir, c.Name, stCfg.SourceID))
 		c.Loaders[loadName] = &loaderCfg
 
 		syncName, syncIdx = getGenerateName(stCfg.SyncerConfig, syncIdx, "sync", syncMap)
@@ -388,7 +434,7 @@ func SubTaskConfigsToOpenAPITask(subTaskConfigMap map[string]map[string]SubTaskC
 		taskSourceConfig := openapi.TaskSourceConfig{}
 		sourceConfList := []openapi.TaskSourceConf{}
 		// source name -> filter rule list
-		filterMap := make(map[string][]*bf.BinlogEventRule)
+		filterMap := make(map[string][]*BinlogEventRule)
 		// source name -> route rule list
 		routeMap := make(map[string][]*router.TableRule)
 		for sourceName, cfg := range sourceMap {
@@ -412,9 +458,9 @@ func SubTaskConfigsToOpenAPITask(subTaskConfigMap map[string]map[string]SubTaskC
 		}
 		taskSourceConfig.SourceConf = sourceConfList
 
-		dirSuffix := "." + oneSubtaskConfig.Name
-		// if ends with the task name, we remove to get user input dir.
-		oneSubtaskConfig.LoaderConfig.Dir = strings.TrimSuffix(oneSubtaskConfig.LoaderConfig.Dir, dirSuffix)
+		dirSuffix := loaderDirSuffix(oneSubtaskConfig.LoaderConfig.Dir, oneSubtaskConfig.Name, oneSubtaskConfig.SourceID)
+		// if ends with the suffix added in adjust, we remove it to get user input dir.
+		oneSubtaskConfig.LoaderConfig.Dir = storage.TrimPath(oneSubtaskConfig.LoaderConfig.Dir, dirSuffix)
 		taskSourceConfig.FullMigrateConf = &openapi.TaskFullMigrateConf{
 			DataDir:       &oneSubtaskConfig.LoaderConfig.Dir,
 			ExportThreads: &oneSubtaskConfig.MydumperConfig.Threads,
@@ -189,7 +189,7 @@ func testShardAndFilterTaskToSubTaskConfigs(c *check.C) {
 	}
 	c.Assert(filterRulesFromOpenAPITask.Valid(), check.IsNil)
 	c.Assert(subTask1Config.FilterRules, check.HasLen, 1)
-	c.Assert(subTask1Config.FilterRules[0], check.DeepEquals, filterRulesFromOpenAPITask)
+	c.Assert(&subTask1Config.FilterRules[0].BinlogEventRule, check.DeepEquals, filterRulesFromOpenAPITask)
 
 	// check balist
 	c.Assert(subTask1Config.BAList, check.NotNil)
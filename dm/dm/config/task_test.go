@@ -14,6 +14,7 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"path"
 	"reflect"
@@ -25,6 +26,7 @@ import (
 	"github.com/pingcap/tidb-tools/pkg/filter"
 	router "github.com/pingcap/tidb-tools/pkg/table-router"
 
+	dmrouter "github.com/pingcap/tiflow/dm/pkg/router"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 
 	"github.com/coreos/go-semver/semver"
@@ -592,18 +594,18 @@ func (t *testConfig) TestGenAndFromSubTaskConfigs(c *C) {
 			TargetTable:   "tbs",
 		}
 
-		filterRule1 = bf.BinlogEventRule{
+		filterRule1 = BinlogEventRule{BinlogEventRule: bf.BinlogEventRule{
 			SchemaPattern: "db*",
 			TablePattern:  "tbl1*",
 			Events:        []bf.EventType{bf.CreateIndex, bf.AlertTable},
 			Action:        bf.Do,
-		}
-		filterRule2 = bf.BinlogEventRule{
+		}}
+		filterRule2 = BinlogEventRule{BinlogEventRule: bf.BinlogEventRule{
 			SchemaPattern: "db*",
 			TablePattern:  "tbl2",
 			SQLPattern:    []string{"^DROP\\s+PROCEDURE", "^CREATE\\s+PROCEDURE"},
 			Action:        bf.Ignore,
-		}
+		}}
 		baList1 = filter.Rules{
 			DoDBs: []string{"db1", "db2"},
 			DoTables: []*filter.Table{
@@ -660,6 +662,7 @@ func (t *testConfig) TestGenAndFromSubTaskConfigs(c *C) {
 			HeartbeatReportInterval: heartbeatRI,
 			EnableHeartbeat:         true,
 			CollationCompatible:     LooseCollationCompatible,
+			Priority:                TaskPriorityNormal,
 			Meta: &Meta{
 				BinLogName: "mysql-bin.000123",
 				BinLogPos:  456,
@@ -677,7 +680,7 @@ func (t *testConfig) TestGenAndFromSubTaskConfigs(c *C) {
 				RawDBCfg:         &rawDBCfg,
 			},
 			RouteRules:  []*router.TableRule{&routeRule2, &routeRule1, &routeRule3},
-			FilterRules: []*bf.BinlogEventRule{&filterRule1, &filterRule2},
+			FilterRules: []*BinlogEventRule{&filterRule1, &filterRule2},
 			BAList:      &baList1,
 			MydumperConfig: MydumperConfig{
 				MydumperPath:  "",
@@ -704,6 +707,17 @@ func (t *testConfig) TestGenAndFromSubTaskConfigs(c *C) {
 				AutoFixGTID:             true,
 				EnableGTID:              true,
 				SafeMode:                true,
+				MaxEventSizePolicy:      MaxEventSizeError,
+				CheckpointFlushPolicy:   CheckpointFlushByInterval,
+				OnBinlogGap:             BinlogGapError,
+				ValidationMode:          ValidationNone,
+				ValidationChunkSize:     defaultValidationChunkSize,
+				ValidationInterval:      defaultValidationInterval,
+				ValidationMaxRetry:      defaultValidationMaxRetry,
+				GeneratedColumnPolicy:   ColumnPolicySkip,
+				InvisibleColumnPolicy:   ColumnPolicyMaterialize,
+				ForeignKeyPolicy:        ForeignKeyPolicyNone,
+				DMLType:                 DMLTypeStandard,
 			},
 			CleanDumpFile:    true,
 			EnableANSIQuotes: true,
@@ -714,6 +728,7 @@ func (t *testConfig) TestGenAndFromSubTaskConfigs(c *C) {
 	stCfg2, err := stCfg1.Clone()
 	c.Assert(err, IsNil)
 	stCfg2.SourceID = source2
+	stCfg2.SourceIndex = 1
 	stCfg2.Meta = &Meta{
 		BinLogName: "mysql-bin.000321",
 		BinLogPos:  123,
@@ -739,6 +754,7 @@ func (t *testConfig) TestGenAndFromSubTaskConfigs(c *C) {
 		CaseSensitive:           stCfg1.CaseSensitive,
 		TargetDB:                &stCfg1.To,
 		CollationCompatible:     LooseCollationCompatible,
+		Priority:                TaskPriorityNormal,
 		MySQLInstances: []*MySQLInstance{
 			{
 				SourceID:           source1,
@@ -785,7 +801,7 @@ func (t *testConfig) TestGenAndFromSubTaskConfigs(c *C) {
 			"route-03": &routeRule3,
 			"route-04": &routeRule4,
 		},
-		Filters: map[string]*bf.BinlogEventRule{
+		Filters: map[string]*BinlogEventRule{
 			"filter-01": &filterRule1,
 			"filter-02": &filterRule2,
 		},
@@ -1009,6 +1025,18 @@ func (t *testConfig) TestExclusiveAndWrongExprFilterFields(c *C) {
 	err := cfg.adjust()
 	c.Assert(terror.ErrConfigExprFilterManyExpr.Equal(err), IsTrue)
 
+	cfg.ExprFilter["both-field"] = &ExpressionFilter{
+		Schema:          "db",
+		Table:           "tbl",
+		InsertValueExpr: "a > 1",
+		Plugin:          "/path/to/filter.so",
+	}
+	err = cfg.adjust()
+	c.Assert(terror.ErrConfigExprFilterManyExpr.Equal(err), IsTrue)
+
+	cfg.ExprFilter["both-field"].InsertValueExpr = ""
+	c.Assert(cfg.adjust(), IsNil)
+
 	delete(cfg.ExprFilter, "both-field")
 	cfg.ExprFilter["wrong"] = &ExpressionFilter{
 		Schema:          "db",
@@ -1021,6 +1049,257 @@ func (t *testConfig) TestExclusiveAndWrongExprFilterFields(c *C) {
 	c.Assert(terror.ErrConfigExprFilterWrongGrammar.Equal(err), IsTrue)
 }
 
+func (t *testConfig) TestRowValueFilterRules(c *C) {
+	cfg := NewTaskConfig()
+	c.Assert(cfg.Decode(`---
+name: test
+task-mode: all
+target-database:
+  host: "127.0.0.1"
+  port: 4000
+  user: "root"
+  password: ""
+mysql-instances:
+  - source-id: "mysql-replica-01"
+    filter-rules: ["filter-rule-1", "filter-rule-2"]
+filters:
+  filter-rule-1:
+    schema-pattern: "db"
+    table-pattern: "tbl"
+    events: ["insert", "update"]
+    row-value-expr: "tenant_id = 42"
+    action: Ignore
+  filter-rule-2:
+    schema-pattern: "db"
+    events: ["truncate table"]
+    action: Ignore
+`), IsNil)
+	rule := cfg.Filters["filter-rule-1"]
+	c.Assert(rule.Name, Equals, "filter-rule-1")
+	c.Assert(rule.RowValueExpr, Equals, "tenant_id = 42")
+	c.Assert(rule.Events, DeepEquals, []bf.EventType{bf.InsertEvent, bf.UpdateEvent})
+	c.Assert(cfg.Filters["filter-rule-2"].Name, Equals, "")
+
+	// the rules with row-value-expr are removed for the older versions
+	filtersForDowngrade := NewFiltersForDowngrade(cfg.Filters)
+	c.Assert(filtersForDowngrade, HasLen, 1)
+	c.Assert(filtersForDowngrade["filter-rule-2"], Equals, &cfg.Filters["filter-rule-2"].BinlogEventRule)
+
+	stCfgs, err := TaskConfigToSubTaskConfigs(cfg, map[string]DBConfig{"mysql-replica-01": {}})
+	c.Assert(err, IsNil)
+	c.Assert(stCfgs[0].FilterRules, HasLen, 2)
+	c.Assert(BinlogFilterRules(stCfgs[0].FilterRules), DeepEquals, []*bf.BinlogEventRule{&cfg.Filters["filter-rule-2"].BinlogEventRule})
+	c.Assert(RowValueFilterRules(stCfgs[0].FilterRules), DeepEquals, []*BinlogEventRule{rule})
+
+	// the name of rule is kept in the sub task config
+	data, err := stCfgs[0].Toml()
+	c.Assert(err, IsNil)
+	stCfg := &SubTaskConfig{}
+	c.Assert(stCfg.Decode(data, false), IsNil)
+	c.Assert(RowValueFilterRules(stCfg.FilterRules), DeepEquals, []*BinlogEventRule{rule})
+
+	cases := []func(){
+		func() { rule.SQLPattern = []string{"^DROP"} },
+		func() { rule.Events = nil },
+		func() { rule.Events = []bf.EventType{bf.InsertEvent, bf.CreateTable} },
+		func() { rule.RowValueExpr = "tenant_id >" },
+	}
+	for _, modify := range cases {
+		rule.SQLPattern, rule.Events, rule.RowValueExpr = nil, []bf.EventType{bf.InsertEvent}, "tenant_id = 42"
+		c.Assert(cfg.adjust(), IsNil)
+		modify()
+		err = cfg.adjust()
+		c.Assert(terror.ErrConfigInvalidRowValueFilter.Equal(err), IsTrue, Commentf("err: %v", err))
+	}
+}
+
+func (t *testConfig) TestSourceTagColumn(c *C) {
+	cfg := NewTaskConfig()
+	c.Assert(cfg.Decode(`---
+name: test
+task-mode: all
+is-sharding: true
+source-tag-column: "_dm_source"
+target-database:
+  host: "127.0.0.1"
+  port: 4000
+  user: "root"
+  password: ""
+mysql-instances:
+  - source-id: "mysql-replica-01"
+    route-rules: ["route-rule-1", "route-rule-2"]
+routes:
+  route-rule-1:
+    schema-pattern: "db_*"
+    table-pattern: "tbl_*"
+    target-schema: "db"
+    target-table: "tbl"
+  route-rule-2:
+    schema-pattern: "db_*"
+    target-schema: "db"
+    extract-source:
+      source-regexp: "mysql-(.*)"
+      target-column: "c_source"
+`), IsNil)
+	c.Assert(cfg.Routes["route-rule-1"].SourceExtractor, DeepEquals, &router.SourceExtractor{TargetColumn: "_dm_source", SourceRegexp: "(.*)"})
+	c.Assert(cfg.Routes["route-rule-2"].SourceExtractor.TargetColumn, Equals, "c_source")
+
+	stCfgs, err := TaskConfigToSubTaskConfigs(cfg, map[string]DBConfig{"mysql-replica-01": {}})
+	c.Assert(err, IsNil)
+	r, err := dmrouter.NewTableRouter(stCfgs[0].CaseSensitive, stCfgs[0].RouteRules)
+	c.Assert(err, IsNil)
+	cols, vals := r.FetchExtendColumn("db_1", "tbl_1", stCfgs[0].SourceID)
+	c.Assert(cols, DeepEquals, []string{"_dm_source"})
+	c.Assert(vals, DeepEquals, []string{"mysql-replica-01"})
+	cols, vals = r.FetchExtendColumn("db_1", "t_1", stCfgs[0].SourceID)
+	c.Assert(cols, DeepEquals, []string{"c_source"})
+	c.Assert(vals, DeepEquals, []string{"replica-01"})
+
+	// the tables not matched by any route rule are tagged by the syncer with the column of the subtask config
+	c.Assert(stCfgs[0].SourceTagColumn, Equals, "_dm_source")
+	cols, _ = r.FetchExtendColumn("other", "tbl_1", stCfgs[0].SourceID)
+	c.Assert(cols, HasLen, 0)
+	r.SetSourceTagColumn(stCfgs[0].SourceTagColumn)
+	cols, vals = r.FetchExtendColumn("other", "tbl_1", stCfgs[0].SourceID)
+	c.Assert(cols, DeepEquals, []string{"_dm_source"})
+	c.Assert(vals, DeepEquals, []string{"mysql-replica-01"})
+	c.Assert(SubTaskConfigsToTaskConfig(stCfgs...).SourceTagColumn, Equals, "_dm_source")
+}
+
+func (t *testConfig) TestShardDDLLockTimeout(c *C) {
+	taskConfig := `---
+name: test
+task-mode: all
+is-sharding: true
+%s
+target-database:
+  host: "127.0.0.1"
+  port: 4000
+  user: "root"
+  password: ""
+mysql-instances:
+  - source-id: "mysql-replica-01"
+`
+	cfg := NewTaskConfig()
+	c.Assert(cfg.Decode(fmt.Sprintf(taskConfig, "")), IsNil)
+	c.Assert(cfg.ShardDDLLockTimeout, Equals, 0)
+	c.Assert(cfg.ShardDDLLockTimeoutStrategy, Equals, "")
+
+	// the strategy is `skip` by default when the timeout is set.
+	cfg = NewTaskConfig()
+	c.Assert(cfg.Decode(fmt.Sprintf(taskConfig, "shard-ddl-lock-timeout: 600")), IsNil)
+	c.Assert(cfg.ShardDDLLockTimeout, Equals, 600)
+	c.Assert(cfg.ShardDDLLockTimeoutStrategy, Equals, ShardDDLLockTimeoutSkip)
+
+	cfg = NewTaskConfig()
+	c.Assert(cfg.Decode(fmt.Sprintf(taskConfig, "shard-ddl-lock-timeout: 600\nshard-ddl-lock-timeout-strategy: force-exec")), IsNil)
+	c.Assert(cfg.ShardDDLLockTimeoutStrategy, Equals, ShardDDLLockTimeoutForceExec)
+	stCfgs, err := TaskConfigToSubTaskConfigs(cfg, map[string]DBConfig{"mysql-replica-01": {}})
+	c.Assert(err, IsNil)
+	c.Assert(stCfgs[0].ShardDDLLockTimeout, Equals, 600)
+	c.Assert(stCfgs[0].ShardDDLLockTimeoutStrategy, Equals, ShardDDLLockTimeoutForceExec)
+
+	cfg = NewTaskConfig()
+	err = cfg.Decode(fmt.Sprintf(taskConfig, "shard-ddl-lock-timeout: 600\nshard-ddl-lock-timeout-strategy: wait"))
+	c.Assert(terror.ErrConfigShardDDLLockTimeoutStrategyNotSupport.Equal(err), IsTrue)
+}
+
+func (t *testConfig) TestAutoIDConflictStrategy(c *C) {
+	taskConfig := `---
+name: test
+task-mode: all
+is-sharding: true
+%s
+target-database:
+  host: "127.0.0.1"
+  port: 4000
+  user: "root"
+  password: ""
+mysql-instances:
+  - source-id: "mysql-replica-01"
+    route-rules: ["route-rule-1"]
+%s
+  - source-id: "mysql-replica-02"
+    route-rules: ["route-rule-1"]
+routes:
+  route-rule-1:
+    schema-pattern: "db_*"
+    target-schema: "db"
+%s
+`
+	columnMappings := `column-mappings:
+  cm-rule-1:
+    schema-pattern: "db_*"
+    table-pattern: "tbl_*"
+    expression: "partition id"
+    source-column: "id"
+    target-column: "id"
+    arguments: ["1", "db_", "tbl_"]`
+	cfg := NewTaskConfig()
+	c.Assert(cfg.Decode(fmt.Sprintf(taskConfig, "auto-id-conflict-strategy: check", "", "")), IsNil)
+	c.Assert(cfg.AutoIDConflictStrategy, Equals, AutoIDConflictCheck)
+
+	cfg = NewTaskConfig()
+	c.Assert(cfg.Decode(fmt.Sprintf(taskConfig, "auto-id-conflict-strategy: source-prefix", "", "")), IsNil)
+	stCfgs, err := TaskConfigToSubTaskConfigs(cfg, map[string]DBConfig{"mysql-replica-01": {}, "mysql-replica-02": {}})
+	c.Assert(err, IsNil)
+	c.Assert(stCfgs, HasLen, 2)
+	for i, stCfg := range stCfgs {
+		c.Assert(stCfg.AutoIDConflictStrategy, Equals, AutoIDConflictSourcePrefix)
+		c.Assert(stCfg.SourceIndex, Equals, i)
+	}
+	c.Assert(SubTaskConfigsToTaskConfig(stCfgs...).AutoIDConflictStrategy, Equals, AutoIDConflictSourcePrefix)
+
+	// source-prefix can't be used together with column mapping.
+	cfg = NewTaskConfig()
+	err = cfg.Decode(fmt.Sprintf(taskConfig, "auto-id-conflict-strategy: source-prefix", `    column-mapping-rules: ["cm-rule-1"]`, columnMappings))
+	c.Assert(terror.ErrConfigInvalidAutoIDConflictStrategy.Equal(err), IsTrue)
+
+	// extra-column needs the extra columns extracted by route rules.
+	cfg = NewTaskConfig()
+	err = cfg.Decode(fmt.Sprintf(taskConfig, "auto-id-conflict-strategy: extra-column", "", ""))
+	c.Assert(terror.ErrConfigInvalidAutoIDConflictStrategy.Equal(err), IsTrue)
+	cfg = NewTaskConfig()
+	c.Assert(cfg.Decode(fmt.Sprintf(taskConfig, "auto-id-conflict-strategy: extra-column\nsource-tag-column: _dm_source", "", "")), IsNil)
+
+	cfg = NewTaskConfig()
+	err = cfg.Decode(fmt.Sprintf(taskConfig, "auto-id-conflict-strategy: ignore", "", ""))
+	c.Assert(terror.ErrConfigInvalidAutoIDConflictStrategy.Equal(err), IsTrue)
+}
+
+func (t *testConfig) TestTaskPriority(c *C) {
+	taskConfig := `---
+name: test
+task-mode: all
+%s
+target-database:
+  host: "127.0.0.1"
+  port: 4000
+  user: "root"
+  password: ""
+mysql-instances:
+  - source-id: "mysql-replica-01"
+`
+	cfg := NewTaskConfig()
+	c.Assert(cfg.Decode(fmt.Sprintf(taskConfig, "")), IsNil)
+	c.Assert(cfg.Priority, Equals, TaskPriorityNormal)
+
+	cfg = NewTaskConfig()
+	c.Assert(cfg.Decode(fmt.Sprintf(taskConfig, "priority: HIGH")), IsNil)
+	c.Assert(cfg.Priority, Equals, TaskPriorityHigh)
+	stCfgs, err := TaskConfigToSubTaskConfigs(cfg, map[string]DBConfig{"mysql-replica-01": {}})
+	c.Assert(err, IsNil)
+	c.Assert(stCfgs[0].Priority, Equals, TaskPriorityHigh)
+	c.Assert(SubTaskConfigsToTaskConfig(stCfgs...).Priority, Equals, TaskPriorityHigh)
+
+	cfg = NewTaskConfig()
+	err = cfg.Decode(fmt.Sprintf(taskConfig, "priority: urgent"))
+	c.Assert(terror.ErrConfigInvalidTaskPriority.Equal(err), IsTrue)
+
+	c.Assert(TaskPriorityLevel(TaskPriorityLow), Less, TaskPriorityLevel(""))
+	c.Assert(TaskPriorityLevel(TaskPriorityNormal), Less, TaskPriorityLevel(TaskPriorityHigh))
+}
+
 func (t *testConfig) TestTaskConfigForDowngrade(c *C) {
 	cfg := NewTaskConfig()
 	err := cfg.Decode(correctTaskConfig)
@@ -1091,6 +1370,15 @@ func cloneValues(dest, src reflect.Value) {
 		destFieldsMap[destType.Field(i).Name] = i
 	}
 	for i := 0; i < srcType.NumField(); i++ {
+		if !srcType.Field(i).IsExported() {
+			continue
+		}
+		// fields of the embedded struct are cloned as the fields of src
+		if _, ok := destFieldsMap[srcType.Field(i).Name]; !ok && srcType.Field(i).Anonymous &&
+			srcType.Field(i).Type.Kind() == reflect.Struct {
+			cloneValues(dest, src.Elem().Field(i).Addr())
+			continue
+		}
 		if j, ok := destFieldsMap[srcType.Field(i).Name]; ok {
 			destField := dest.Elem().Field(j)
 			srcField := src.Elem().Field(i)
@@ -1110,3 +1398,117 @@ func cloneValues(dest, src reflect.Value) {
 		}
 	}
 }
+
+func (t *testConfig) TestLoaderImportMode(c *C) {
+	cfg := DefaultLoaderConfig()
+	c.Assert(cfg.adjust(), IsNil)
+	c.Assert(cfg.ImportMode, Equals, LoadModeSQL)
+
+	cfg.ImportMode = "Physical"
+	c.Assert(cfg.adjust(), IsNil)
+	c.Assert(cfg.ImportMode, Equals, LoadModePhysical)
+
+	cfg.ImportMode = "tikv"
+	c.Assert(terror.ErrConfigInvalidLoadMode.Equal(cfg.adjust()), IsTrue)
+}
+
+func (t *testConfig) TestMydumperTableWheres(c *C) {
+	cfg := DefaultMydumperConfig()
+	c.Assert(cfg.adjust(), IsNil)
+
+	cfg.TableWheres = []*TableWhere{
+		{Schema: "shop", Table: "orders", Where: "created_at > '2022-01-01'"},
+		{Schema: "~^shop_\\d+$", Table: "*", Where: "id > 100"},
+	}
+	c.Assert(cfg.adjust(), IsNil)
+
+	cfg.TableWheres = []*TableWhere{{Schema: "shop", Where: "id > 100"}}
+	c.Assert(terror.ErrConfigInvalidTableWhere.Equal(cfg.adjust()), IsTrue)
+	cfg.TableWheres = []*TableWhere{{Schema: "shop", Table: "orders", Where: "  "}}
+	c.Assert(terror.ErrConfigInvalidTableWhere.Equal(cfg.adjust()), IsTrue)
+	cfg.TableWheres = []*TableWhere{{Schema: "~(", Table: "orders", Where: "id > 100"}}
+	c.Assert(terror.ErrConfigInvalidTableWhere.Equal(cfg.adjust()), IsTrue)
+}
+
+func (t *testConfig) TestMydumperCompress(c *C) {
+	cfg := DefaultMydumperConfig()
+	cfg.Compress = "GZ"
+	c.Assert(cfg.adjust(), IsNil)
+	c.Assert(cfg.Compress, Equals, DumpCompressGzip)
+	cfg.Compress = "no-compression"
+	c.Assert(cfg.adjust(), IsNil)
+	c.Assert(cfg.Compress, Equals, "")
+	cfg.Compress = "zstd"
+	c.Assert(terror.ErrConfigInvalidDumpCompress.Equal(cfg.adjust()), IsTrue)
+}
+
+func (t *testConfig) TestLoaderSkipCreateTable(c *C) {
+	cfg := DefaultLoaderConfig()
+	cfg.ColumnNameMappings = []*ColumnNameMapping{{Schema: "shop", Table: "orders", Columns: map[string]string{"id": "order_id"}}}
+	c.Assert(terror.ErrConfigInvalidSkipCreateTable.Equal(cfg.adjust()), IsTrue)
+
+	cfg.SkipCreateTable = true
+	c.Assert(cfg.adjust(), IsNil)
+	cfg.ColumnNameMappings = append(cfg.ColumnNameMappings, &ColumnNameMapping{Schema: "~^shop_\\d+$", Table: "*", Columns: map[string]string{"c": "d"}})
+	c.Assert(cfg.adjust(), IsNil)
+
+	cfg.ColumnNameMappings = []*ColumnNameMapping{{Schema: "shop", Columns: map[string]string{"id": "order_id"}}}
+	c.Assert(terror.ErrConfigInvalidSkipCreateTable.Equal(cfg.adjust()), IsTrue)
+	cfg.ColumnNameMappings = []*ColumnNameMapping{{Schema: "shop", Table: "orders"}}
+	c.Assert(terror.ErrConfigInvalidSkipCreateTable.Equal(cfg.adjust()), IsTrue)
+	cfg.ColumnNameMappings = []*ColumnNameMapping{{Schema: "~(", Table: "orders", Columns: map[string]string{"id": "order_id"}}}
+	c.Assert(terror.ErrConfigInvalidSkipCreateTable.Equal(cfg.adjust()), IsTrue)
+}
+
+func (t *testConfig) TestLoaderExternal(c *C) {
+	cfg := DefaultLoaderConfig()
+	cfg.External = &ExternalDataConfig{
+		Format: "CSV",
+		Tables: []*ExternalTable{{Schema: "shop", Table: "orders", CreateTable: "CREATE TABLE orders (id INT PRIMARY KEY)"}},
+	}
+	c.Assert(terror.ErrConfigInvalidExternalData.Equal(cfg.adjust()), IsTrue)
+
+	cfg.External.Dir = "/data/external"
+	c.Assert(cfg.adjust(), IsNil)
+	c.Assert(cfg.External.Format, Equals, ExternalFormatCSV)
+	c.Assert(cfg.External.CSV, DeepEquals, ExternalCSVConfig{Separator: ",", Delimiter: `"`, Null: `\N`})
+
+	cfg.External.CSV.Separator = `"`
+	c.Assert(terror.ErrConfigInvalidExternalData.Equal(cfg.adjust()), IsTrue)
+	cfg.External.CSV.Separator = "|"
+	cfg.External.Format = "json"
+	c.Assert(terror.ErrConfigInvalidExternalData.Equal(cfg.adjust()), IsTrue)
+	cfg.External.Format = ExternalFormatParquet
+	c.Assert(cfg.adjust(), IsNil)
+
+	cfg.External.Tables = append(cfg.External.Tables, &ExternalTable{Schema: "shop", Table: "orders", CreateTable: "CREATE TABLE orders (id INT)"})
+	c.Assert(terror.ErrConfigInvalidExternalData.Equal(cfg.adjust()), IsTrue)
+	cfg.External.Tables[1] = &ExternalTable{Schema: "shop", Table: "items"}
+	c.Assert(terror.ErrConfigInvalidExternalData.Equal(cfg.adjust()), IsTrue)
+	cfg.External.Tables[1] = &ExternalTable{Schema: "shop", Table: "items", CreateTable: "CREATE TABLE items (id INT)", FilePattern: "("}
+	c.Assert(terror.ErrConfigInvalidExternalData.Equal(cfg.adjust()), IsTrue)
+	cfg.External.Tables[1].FilePattern = `^items\..*\.parquet$`
+	c.Assert(cfg.adjust(), IsNil)
+	cfg.External.Tables = nil
+	c.Assert(terror.ErrConfigInvalidExternalData.Equal(cfg.adjust()), IsTrue)
+}
+
+func (t *testConfig) TestLoaderMaxError(c *C) {
+	cfg := DefaultLoaderConfig()
+	c.Assert(cfg.adjust(), IsNil)
+	cfg.MaxError = 100
+	c.Assert(cfg.adjust(), IsNil)
+	cfg.MaxError = -1
+	c.Assert(terror.ErrConfigInvalidMaxError.Equal(cfg.adjust()), IsTrue)
+}
+
+func (t *testConfig) TestSyncerOnBinlogGap(c *C) {
+	cfg := DefaultSyncerConfig()
+	c.Assert(cfg.adjust(), IsNil)
+	c.Assert(cfg.OnBinlogGap, Equals, BinlogGapError)
+	cfg.OnBinlogGap = "ReDump"
+	c.Assert(cfg.adjust(), IsNil)
+	c.Assert(cfg.OnBinlogGap, Equals, BinlogGapRedump)
+	cfg.OnBinlogGap = "skip"
+	c.Assert(terror.ErrConfigInvalidOnBinlogGap.Equal(cfg.adjust()), IsTrue)
+}
@@ -40,6 +40,10 @@ const (
 	EncryptCmdName = "encrypt"
 	// DecryptCmdName is special command.
 	DecryptCmdName = "decrypt"
+	// RotateRelayKeyCmdName is special command which doesn't connect to DM-master.
+	RotateRelayKeyCmdName = "rotate-relay-key"
+	// BinlogCatCmdName is special sub command of binlog which doesn't connect to DM-master.
+	BinlogCatCmdName = "cat"
 
 	// Master specifies member master type.
 	Master = "master"
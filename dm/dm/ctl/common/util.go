@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"os/user"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -36,8 +37,10 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	dmcommon "github.com/pingcap/tiflow/dm/dm/common"
 	"github.com/pingcap/tiflow/dm/dm/config"
 	"github.com/pingcap/tiflow/dm/dm/pb"
 	"github.com/pingcap/tiflow/dm/pkg/log"
@@ -98,6 +101,7 @@ func (c *CtlClient) sendRequest(
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
+	ctx = metadata.AppendToOutgoingContext(ctx, dmcommon.AuditLogUserKey, auditUser())
 	params := []reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(req)}
 	for _, o := range opts {
 		params = append(params, reflect.ValueOf(o))
@@ -113,6 +117,14 @@ func (c *CtlClient) sendRequest(
 	return errInterface.(error)
 }
 
+// auditUser returns the OS user running dmctl, which is recorded in the audit log of DM-master.
+func auditUser() string {
+	if u, err := user.Current(); err == nil {
+		return u.Username
+	}
+	return os.Getenv("USER")
+}
+
 // SendRequest send request to master.
 func SendRequest(ctx context.Context, reqName string, req interface{}, respPointer interface{}) error {
 	err := GlobalCtlClient.sendRequest(ctx, reqName, req, respPointer)
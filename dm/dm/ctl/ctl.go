@@ -14,6 +14,7 @@
 package ctl
 
 import (
+	"encoding/hex"
 	"fmt"
 	"io"
 	"os"
@@ -23,6 +24,7 @@ import (
 	"github.com/pingcap/tiflow/dm/dm/ctl/master"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/pingcap/tiflow/dm/relay"
 
 	"github.com/chzyer/readline"
 	"github.com/pingcap/errors"
@@ -85,6 +87,7 @@ func NewRootCmd() *cobra.Command {
 		master.NewConfigCmd(),
 		newDecryptCmd(),
 		newEncryptCmd(),
+		newRotateRelayKeyCmd(),
 	)
 	// copied from (*cobra.Command).InitDefaultHelpCmd
 	helpCmd := &cobra.Command{
@@ -212,7 +215,8 @@ func MainStart(args []string) {
 			os.Exit(0)
 		}
 
-		if cmd.Name() == common.DecryptCmdName || cmd.Name() == common.EncryptCmdName {
+		if cmd.Name() == common.DecryptCmdName || cmd.Name() == common.EncryptCmdName || cmd.Name() == common.RotateRelayKeyCmdName ||
+			cmd.Name() == common.BinlogCatCmdName {
 			return nil
 		}
 
@@ -275,3 +279,87 @@ func newDecryptCmd() *cobra.Command {
 		},
 	}
 }
+
+// rotateRelayKeyResult is the output of rotate-relay-key.
+type rotateRelayKeyResult struct {
+	Result  bool   `json:"result"`
+	Msg     string `json:"msg"`
+	KeyID   string `json:"key-id"`
+	Rotated int    `json:"rotated"`
+}
+
+func newRotateRelayKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-relay-key --relay-dir <relay-dir> --key-id <new-key-id> --key <new-key> --old-key <old-key-id>=<old-key>",
+		Short: "Re-wraps the data keys of encrypted relay log files with a new master key",
+		Long: `Re-wraps the data keys of encrypted relay log files in the relay directory with a new master key,
+the relay log files themselves are not rewritten. keys are hex encoded, and the relay of the
+relay directory should be stopped before rotating, then restarted with the new master key.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return cmd.Help()
+			}
+			relayDir, err := cmd.Flags().GetString("relay-dir")
+			if err != nil {
+				return errors.Trace(err)
+			}
+			keyID, err := cmd.Flags().GetString("key-id")
+			if err != nil {
+				return errors.Trace(err)
+			}
+			hexKey, err := cmd.Flags().GetString("key")
+			if err != nil {
+				return errors.Trace(err)
+			}
+			oldKeys, err := cmd.Flags().GetStringSlice("old-key")
+			if err != nil {
+				return errors.Trace(err)
+			}
+			if relayDir == "" || keyID == "" || hexKey == "" {
+				return cmd.Help()
+			}
+
+			newKey, err := parseRelayMasterKey(keyID, hexKey)
+			if err != nil {
+				return err
+			}
+			oldMasterKeys := make([]relay.MasterKey, 0, len(oldKeys))
+			for _, oldKey := range oldKeys {
+				parts := strings.SplitN(oldKey, "=", 2)
+				if len(parts) != 2 {
+					return errors.Errorf("old key %s should be in format <key-id>=<key>", oldKey)
+				}
+				mk, err2 := parseRelayMasterKey(parts[0], parts[1])
+				if err2 != nil {
+					return err2
+				}
+				oldMasterKeys = append(oldMasterKeys, mk)
+			}
+
+			rotated, err := relay.RotateRelayLogKeys(relayDir, newKey, oldMasterKeys...)
+			if err != nil {
+				return err
+			}
+			common.PrettyPrintInterface(&rotateRelayKeyResult{
+				Result:  true,
+				Msg:     fmt.Sprintf("%d data keys re-wrapped by master key %s", rotated, keyID),
+				KeyID:   keyID,
+				Rotated: rotated,
+			})
+			return nil
+		},
+	}
+	cmd.Flags().String("relay-dir", "", "the relay directory of the source")
+	cmd.Flags().String("key-id", "", "ID of the new master key")
+	cmd.Flags().String("key", "", "the new master key")
+	cmd.Flags().StringSlice("old-key", nil, "the old master keys which wrapped the data keys, in format <key-id>=<key>")
+	return cmd
+}
+
+func parseRelayMasterKey(id, hexKey string) (relay.MasterKey, error) {
+	key, err := hex.DecodeString(hexKey)
+	if err != nil {
+		return nil, errors.Annotatef(err, "decode master key %s", id)
+	}
+	return relay.NewLocalMasterKey(id, key)
+}
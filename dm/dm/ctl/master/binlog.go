@@ -33,6 +33,8 @@ func NewBinlogCmd() *cobra.Command {
 		newBinlogRevertCmd(),
 		newBinlogInjectCmd(),
 		newBinlogListCmd(),
+		newBinlogCatCmd(),
+		newBinlogApproveCmd(),
 	)
 
 	return cmd
@@ -145,3 +147,23 @@ func newBinlogInjectCmd() *cobra.Command {
 	}
 	return cmd
 }
+
+func newBinlogApproveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve <task-name>",
+		Short: "approve the DDLs waiting for approval at the current error event or a specific binlog position (binlog-pos) event",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return cmd.Help()
+			}
+			taskName := common.GetTaskNameFromArgOrFile(cmd.Flags().Arg(0))
+			request := &pb.HandleErrorRequest{
+				Op:   pb.ErrorOp_Approve,
+				Task: taskName,
+				Sqls: nil,
+			}
+			return sendHandleErrorRequest(cmd, request)
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,340 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	tfilter "github.com/pingcap/tidb-tools/pkg/table-filter"
+	"github.com/spf13/cobra"
+
+	"github.com/pingcap/tiflow/dm/dm/ctl/common"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/binlog/event"
+	"github.com/pingcap/tiflow/dm/pkg/binlog/reader"
+	"github.com/pingcap/tiflow/dm/pkg/gtid"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/pingcap/tiflow/dm/relay"
+)
+
+const binlogCatTimeLayout = "2006-01-02 15:04:05"
+
+// binlogCatFilter decides which events are printed by `binlog cat`.
+type binlogCatFilter struct {
+	startTime, stopTime int64 // in seconds, 0 means unlimited
+	tables              tfilter.Filter
+	eventTypes          map[string]struct{} // lower case names of event types, empty means all types
+}
+
+// match returns whether the event should be printed.
+func (f *binlogCatFilter) match(e *replication.BinlogEvent) bool {
+	ts := int64(e.Header.Timestamp)
+	if (f.startTime > 0 && ts < f.startTime) || (f.stopTime > 0 && ts > f.stopTime) {
+		return false
+	}
+	if len(f.eventTypes) > 0 {
+		if _, ok := f.eventTypes[strings.ToLower(e.Header.EventType.String())]; !ok {
+			return false
+		}
+	}
+	if f.tables == nil {
+		return true
+	}
+	switch ev := e.Event.(type) {
+	case *replication.RowsEvent:
+		return f.tables.MatchTable(string(ev.Table.Schema), string(ev.Table.Table))
+	case *replication.TableMapEvent:
+		return f.tables.MatchTable(string(ev.Schema), string(ev.Table))
+	case *replication.QueryEvent:
+		// the tables in the query are not parsed, only the default schema is matched
+		return f.tables.MatchSchema(string(ev.Schema))
+	default:
+		// events not about tables like GTID and XID events are not filtered
+		return true
+	}
+}
+
+// binlogCatter prints the events read from a relay directory.
+type binlogCatter struct {
+	out         io.Writer
+	filter      *binlogCatFilter
+	sql         bool          // print pseudo SQL instead of the dump of events
+	idleTimeout time.Duration // stop reading if no event is read within it
+
+	filename string // name of the relay log file being read
+}
+
+// run prints the events got from the streamer until no event is read within the idle timeout.
+func (c *binlogCatter) run(s reader.Streamer) error {
+	for {
+		e, err := s.GetEventWithTimeout(c.idleTimeout)
+		if err != nil {
+			if errors.Cause(err) == context.DeadlineExceeded {
+				return nil
+			}
+			return err
+		}
+		if ev, ok := e.Event.(*replication.RotateEvent); ok {
+			c.filename = string(ev.NextLogName)
+		}
+		// skip the events generated by the reader
+		if e.Header.EventType == replication.HEARTBEAT_EVENT || utils.IsFakeRotateEvent(e.Header) {
+			continue
+		}
+		if !c.filter.match(e) {
+			continue
+		}
+		if err = c.print(e); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *binlogCatter) print(e *replication.BinlogEvent) error {
+	startPos := e.Header.LogPos - e.Header.EventSize
+	_, err := fmt.Fprintf(c.out, "# at %s:%d\n#%s server id %d end_log_pos %d %s\n", c.filename, startPos,
+		time.Unix(int64(e.Header.Timestamp), 0).Format(binlogCatTimeLayout), e.Header.ServerID, e.Header.LogPos, e.Header.EventType)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !c.sql {
+		e.Dump(c.out)
+		return nil
+	}
+	for _, sql := range pseudoSQLs(e) {
+		if _, err = fmt.Fprintln(c.out, sql); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// pseudoSQLs returns the pseudo SQL statements of the event. the names of columns are not kept in binlog events
+// without `binlog_row_metadata=FULL`, so the columns are referred as @1, @2... like mysqlbinlog.
+func pseudoSQLs(e *replication.BinlogEvent) []string {
+	switch ev := e.Event.(type) {
+	case *replication.QueryEvent:
+		if len(ev.Schema) == 0 {
+			return []string{string(ev.Query) + ";"}
+		}
+		return []string{fmt.Sprintf("USE %s; %s;", dbutil.ColumnName(string(ev.Schema)), ev.Query)}
+	case *replication.XIDEvent:
+		return []string{fmt.Sprintf("COMMIT; /* xid=%d */", ev.XID)}
+	case *replication.GTIDEvent, *replication.MariadbGTIDEvent:
+		gtidStr, err := event.GetGTIDStr(e)
+		if err != nil {
+			return []string{fmt.Sprintf("# %v", err)}
+		}
+		return []string{fmt.Sprintf("SET @@SESSION.GTID_NEXT= '%s';", gtidStr)}
+	case *replication.RowsEvent:
+		return rowsPseudoSQLs(e.Header.EventType, ev)
+	default:
+		return nil
+	}
+}
+
+func rowsPseudoSQLs(tp replication.EventType, ev *replication.RowsEvent) []string {
+	table := dbutil.TableName(string(ev.Table.Schema), string(ev.Table.Table))
+	sqls := make([]string, 0, len(ev.Rows))
+	switch tp {
+	case replication.WRITE_ROWS_EVENTv0, replication.WRITE_ROWS_EVENTv1, replication.WRITE_ROWS_EVENTv2:
+		for _, row := range ev.Rows {
+			sqls = append(sqls, fmt.Sprintf("INSERT INTO %s VALUES (%s);", table, pseudoValues(row, ", ", false)))
+		}
+	case replication.DELETE_ROWS_EVENTv0, replication.DELETE_ROWS_EVENTv1, replication.DELETE_ROWS_EVENTv2:
+		for _, row := range ev.Rows {
+			sqls = append(sqls, fmt.Sprintf("DELETE FROM %s WHERE %s;", table, pseudoValues(row, " AND ", true)))
+		}
+	case replication.UPDATE_ROWS_EVENTv0, replication.UPDATE_ROWS_EVENTv1, replication.UPDATE_ROWS_EVENTv2:
+		// rows of update events are pairs of the before and after images
+		for i := 0; i+1 < len(ev.Rows); i += 2 {
+			sqls = append(sqls, fmt.Sprintf("UPDATE %s SET %s WHERE %s;", table,
+				pseudoValues(ev.Rows[i+1], ", ", true), pseudoValues(ev.Rows[i], " AND ", true)))
+		}
+	}
+	return sqls
+}
+
+// pseudoValues joins the values of a row by `sep`, with `@N=` prepended to the Nth value if `withColumn` is true.
+func pseudoValues(row []interface{}, sep string, withColumn bool) string {
+	values := make([]string, 0, len(row))
+	for i, v := range row {
+		var value string
+		switch v2 := v.(type) {
+		case nil:
+			value = "NULL"
+		case string:
+			value = quoteString(v2)
+		case []byte:
+			value = quoteString(string(v2))
+		default:
+			value = fmt.Sprintf("%v", v2)
+		}
+		if withColumn {
+			value = fmt.Sprintf("@%d=%s", i+1, value)
+		}
+		values = append(values, value)
+	}
+	return strings.Join(values, sep)
+}
+
+func quoteString(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
+
+// parseBinlogCatTime parses the time in local time zone, empty string means unlimited.
+func parseBinlogCatTime(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	t, err := time.ParseInLocation(binlogCatTimeLayout, s, time.Local)
+	if err != nil {
+		return 0, errors.Annotatef(err, "time %s should be in format %s", s, binlogCatTimeLayout)
+	}
+	return t.Unix(), nil
+}
+
+func newBinlogCatFilter(cmd *cobra.Command) (*binlogCatFilter, error) {
+	f := &binlogCatFilter{eventTypes: make(map[string]struct{})}
+	startTime, err := cmd.Flags().GetString("start-time")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if f.startTime, err = parseBinlogCatTime(startTime); err != nil {
+		return nil, err
+	}
+	stopTime, err := cmd.Flags().GetString("stop-time")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if f.stopTime, err = parseBinlogCatTime(stopTime); err != nil {
+		return nil, err
+	}
+	tables, err := cmd.Flags().GetStringSlice("tables")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(tables) > 0 {
+		if f.tables, err = tfilter.Parse(tables); err != nil {
+			return nil, errors.Annotate(err, "parse tables")
+		}
+	}
+	eventTypes, err := cmd.Flags().GetStringSlice("event-types")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, tp := range eventTypes {
+		f.eventTypes[strings.ToLower(tp)] = struct{}{}
+	}
+	return f, nil
+}
+
+// startBinlogCat starts reading from the binlog position, the GTID sets or the start time, in order of priority.
+func startBinlogCat(cmd *cobra.Command, r *relay.BinlogReader, flavor string, startTime int64) (reader.Streamer, error) {
+	binlogPos, err := cmd.Flags().GetString("binlog-pos")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if binlogPos != "" {
+		pos, err2 := binlog.VerifyBinlogPos(binlogPos)
+		if err2 != nil {
+			return nil, err2
+		}
+		return r.StartSyncByPos(*pos)
+	}
+
+	gtidStr, err := cmd.Flags().GetString("gtid")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if gtidStr != "" {
+		gset, err2 := gtid.ParserGTID(flavor, gtidStr)
+		if err2 != nil {
+			return nil, err2
+		}
+		return r.StartSyncByGTID(gset.Origin())
+	}
+
+	// from the earliest relay log file if the start time is not specified
+	pos, err := r.SeekByTime(time.Unix(startTime, 0))
+	if err != nil {
+		return nil, err
+	}
+	return r.StartSyncByPos(pos)
+}
+
+func newBinlogCatCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   common.BinlogCatCmdName + " <relay-dir>",
+		Short: "print the events in the relay log files of a relay directory, like mysqlbinlog",
+		Long: `Print the events in the relay log files of a relay directory, like mysqlbinlog.
+it reads the relay directory in local directly without connecting to DM-master, reading starts from the position
+with UUID suffix (binlog-pos), the GTID sets or the start time, or the earliest relay log file if none of them is
+specified, and stops if no more event is read within the idle timeout.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return cmd.Help()
+			}
+			flavor, err := cmd.Flags().GetString("flavor")
+			if err != nil {
+				return errors.Trace(err)
+			}
+			sql, err := cmd.Flags().GetBool("sql")
+			if err != nil {
+				return errors.Trace(err)
+			}
+			idleTimeout, err := cmd.Flags().GetDuration("idle-timeout")
+			if err != nil {
+				return errors.Trace(err)
+			}
+			filter, err := newBinlogCatFilter(cmd)
+			if err != nil {
+				return err
+			}
+
+			r := relay.NewOfflineReader(log.L(), &relay.BinlogReaderConfig{
+				RelayDir:         args[0],
+				Timezone:         time.Local,
+				Flavor:           flavor,
+				RecoverTornEvent: true,
+			})
+			defer r.Close()
+			s, err := startBinlogCat(cmd, r, flavor, filter.startTime)
+			if err != nil {
+				return err
+			}
+			c := &binlogCatter{out: os.Stdout, filter: filter, sql: sql, idleTimeout: idleTimeout}
+			return c.run(s)
+		},
+	}
+	cmd.Flags().String("flavor", gmysql.MySQLFlavor, "flavor of the upstream, mysql or mariadb")
+	cmd.Flags().String("gtid", "", "GTID sets to start from, the events contained in it are skipped")
+	cmd.Flags().String("start-time", "", "print the events not earlier than it, in format "+binlogCatTimeLayout)
+	cmd.Flags().String("stop-time", "", "print the events not later than it, in format "+binlogCatTimeLayout)
+	cmd.Flags().StringSlice("tables", nil, "print the events of these tables only, like db.tbl or db.*")
+	cmd.Flags().StringSlice("event-types", nil, "print the events of these types only, like QueryEvent or WriteRowsEventV2")
+	cmd.Flags().Bool("sql", false, "print pseudo SQL statements instead of the dump of events")
+	cmd.Flags().Duration("idle-timeout", time.Second, "stop if no more event is read within it")
+	return cmd
+}
@@ -0,0 +1,85 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/pkg/binlog/event"
+)
+
+func (t *testCtlMaster) TestBinlogCat(c *check.C) {
+	header := &replication.EventHeader{
+		Timestamp: uint32(time.Date(2022, 1, 1, 10, 0, 0, 0, time.Local).Unix()),
+		ServerID:  11,
+	}
+	queryEv, err := event.GenQueryEvent(header, 100, 0, 0, 0, nil, []byte("db1"), []byte("CREATE TABLE tbl (c INT)"))
+	c.Assert(err, check.IsNil)
+	xidEv, err := event.GenXIDEvent(header, 200, 10)
+	c.Assert(err, check.IsNil)
+	newRowsEv := func(tp replication.EventType, rows ...[]interface{}) *replication.BinlogEvent {
+		h := *header
+		h.EventType = tp
+		return &replication.BinlogEvent{Header: &h, Event: &replication.RowsEvent{
+			Table: &replication.TableMapEvent{Schema: []byte("db2"), Table: []byte("tbl")},
+			Rows:  rows,
+		}}
+	}
+	insertEv := newRowsEv(replication.WRITE_ROWS_EVENTv2, []interface{}{int32(1), "it's", nil})
+	updateEv := newRowsEv(replication.UPDATE_ROWS_EVENTv2, []interface{}{int32(1), []byte("a")}, []interface{}{int32(2), []byte("b")})
+	deleteEv := newRowsEv(replication.DELETE_ROWS_EVENTv2, []interface{}{int32(2), []byte("b")})
+
+	c.Assert(pseudoSQLs(queryEv), check.DeepEquals, []string{"USE `db1`; CREATE TABLE tbl (c INT);"})
+	c.Assert(pseudoSQLs(xidEv), check.DeepEquals, []string{"COMMIT; /* xid=10 */"})
+	c.Assert(pseudoSQLs(insertEv), check.DeepEquals, []string{"INSERT INTO `db2`.`tbl` VALUES (1, 'it\\'s', NULL);"})
+	c.Assert(pseudoSQLs(updateEv), check.DeepEquals, []string{"UPDATE `db2`.`tbl` SET @1=2, @2='b' WHERE @1=1 AND @2='a';"})
+	c.Assert(pseudoSQLs(deleteEv), check.DeepEquals, []string{"DELETE FROM `db2`.`tbl` WHERE @1=2 AND @2='b';"})
+
+	// no filter
+	cmd := newBinlogCatCmd()
+	f, err := newBinlogCatFilter(cmd)
+	c.Assert(err, check.IsNil)
+	for _, e := range []*replication.BinlogEvent{queryEv, xidEv, insertEv} {
+		c.Assert(f.match(e), check.IsTrue)
+	}
+
+	// filter by table and event type
+	cmd = newBinlogCatCmd()
+	c.Assert(cmd.ParseFlags([]string{"--tables", "db2.*", "--event-types", "writerowseventv2,QueryEvent"}), check.IsNil)
+	f, err = newBinlogCatFilter(cmd)
+	c.Assert(err, check.IsNil)
+	c.Assert(f.match(queryEv), check.IsFalse) // schema not matched
+	c.Assert(f.match(xidEv), check.IsFalse)   // type not matched
+	c.Assert(f.match(insertEv), check.IsTrue)
+	c.Assert(f.match(updateEv), check.IsFalse)
+
+	// filter by time
+	cmd = newBinlogCatCmd()
+	c.Assert(cmd.ParseFlags([]string{"--start-time", "2022-01-01 10:00:01"}), check.IsNil)
+	f, err = newBinlogCatFilter(cmd)
+	c.Assert(err, check.IsNil)
+	c.Assert(f.match(queryEv), check.IsFalse)
+	cmd = newBinlogCatCmd()
+	c.Assert(cmd.ParseFlags([]string{"--start-time", "2022-01-01 10:00:00", "--stop-time", "2022-01-01 10:00:00"}), check.IsNil)
+	f, err = newBinlogCatFilter(cmd)
+	c.Assert(err, check.IsNil)
+	c.Assert(f.match(queryEv), check.IsTrue)
+	cmd = newBinlogCatCmd()
+	c.Assert(cmd.ParseFlags([]string{"--stop-time", "2022/01/01"}), check.IsNil)
+	_, err = newBinlogCatFilter(cmd)
+	c.Assert(err, check.ErrorMatches, ".*should be in format.*")
+}
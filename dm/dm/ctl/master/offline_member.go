@@ -27,13 +27,14 @@ import (
 // NewOfflineMemberCmd creates an OfflineWorker command.
 func NewOfflineMemberCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "offline-member <--master/--worker> <--name master-name/worker-name>",
+		Use:   "offline-member <--master/--worker> <--name master-name/worker-name> [--drain]",
 		Short: "Offlines member which has been closed",
 		RunE:  offlineMemberFunc,
 	}
 	cmd.Flags().BoolP("master", "m", false, "to offline a master")
 	cmd.Flags().BoolP("worker", "w", false, "to offline a worker")
 	cmd.Flags().StringP("name", "n", "", "specify member name for choosing type")
+	cmd.Flags().Bool("drain", false, "transfer the source and relay of an online worker to other workers before offline it")
 	return cmd
 }
 
@@ -68,6 +69,14 @@ func offlineMemberFunc(cmd *cobra.Command, _ []string) error {
 		common.PrintLinesf("get offline type failed")
 		return err
 	}
+	drain, err := cmd.Flags().GetBool("drain")
+	if err != nil {
+		common.PrintLinesf("get drain flag failed")
+		return err
+	} else if drain && offlineType != common.Worker {
+		common.PrintLinesf("only a worker can be drained")
+		return errors.New("please check output to see error")
+	}
 	name, err := cmd.Flags().GetString("name")
 	if err != nil {
 		common.PrintLinesf("get offline name failed")
@@ -84,8 +93,9 @@ func offlineMemberFunc(cmd *cobra.Command, _ []string) error {
 		ctx,
 		"OfflineMember",
 		&pb.OfflineMemberRequest{
-			Type: offlineType,
-			Name: name,
+			Type:  offlineType,
+			Name:  name,
+			Drain: drain,
 		},
 		&resp,
 	)
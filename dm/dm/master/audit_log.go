@@ -0,0 +1,516 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"io/ioutil"
+	"log/syslog"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/pingcap/tiflow/dm/dm/common"
+	"github.com/pingcap/tiflow/dm/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/ha"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+const (
+	defaultAuditLogRetention = "720h" // 30 days
+	// the interval to delete the expired audit logs.
+	auditLogCleanInterval = time.Hour
+
+	auditLogAPIGRPC     = "grpc"
+	auditLogAPIOpenAPI  = "openapi"
+	auditLogSyslogLocal = "local"
+	auditLogSyslogTag   = "dm-master"
+	auditLogHiddenValue = "******"
+	// the metadata key to pass the address of the original client when the request is forwarded to the leader.
+	auditLogForwardedForKey = "dm-audit-forwarded-for"
+)
+
+// AuditLogConfig is the config of the audit log, which records the control-plane mutations handled by the DM-master.
+type AuditLogConfig struct {
+	Enable bool `toml:"enable" json:"enable"`
+	// the file to export the audit logs to in JSON lines, empty means not exported.
+	File string `toml:"file" json:"file"`
+	// the syslog to export the audit logs to, e.g. "udp://127.0.0.1:514", or "local" for the local syslog daemon.
+	// empty means not exported.
+	Syslog string `toml:"syslog" json:"syslog"`
+	// how long the audit logs are kept in etcd, 0 means kept forever.
+	RetentionStr string        `toml:"retention" json:"retention"`
+	Retention    time.Duration `toml:"-" json:"-"`
+}
+
+// adjust checks and adjusts the config.
+func (c *AuditLogConfig) adjust() error {
+	if c.RetentionStr == "" {
+		c.RetentionStr = defaultAuditLogRetention
+	}
+	retention, err := time.ParseDuration(c.RetentionStr)
+	if err != nil {
+		return terror.ErrMasterInvalidAuditLogConfig.Delegate(err, "retention "+c.RetentionStr)
+	}
+	if retention < 0 {
+		return terror.ErrMasterInvalidAuditLogConfig.Generate("retention should not be negative")
+	}
+	c.Retention = retention
+
+	if c.Syslog != "" {
+		if _, _, err = parseAuditLogSyslog(c.Syslog); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseAuditLogSyslog parses the network and the address of the syslog, both are empty for the local syslog daemon.
+func parseAuditLogSyslog(s string) (network, addr string, err error) {
+	if s == auditLogSyslogLocal {
+		return "", "", nil
+	}
+	parts := strings.SplitN(s, "://", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return "", "", terror.ErrMasterInvalidAuditLogConfig.Generatef("syslog %s should be like 'udp://host:port' or 'local'", s)
+	}
+	switch parts[0] {
+	case "tcp", "udp", "unix", "unixgram":
+	default:
+		return "", "", terror.ErrMasterInvalidAuditLogConfig.Generatef("unsupported syslog network %s", parts[0])
+	}
+	return parts[0], parts[1], nil
+}
+
+// auditLogger exports the audit logs to the file and the syslog.
+type auditLogger struct {
+	mu     sync.Mutex
+	file   *os.File
+	syslog *syslog.Writer
+}
+
+// newAuditLogger creates an auditLogger and opens the sinks in the config.
+func newAuditLogger(cfg AuditLogConfig) (*auditLogger, error) {
+	a := &auditLogger{}
+	if cfg.File != "" {
+		f, err := os.OpenFile(cfg.File, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return nil, terror.ErrMasterOpenAuditLogSink.Delegate(err, cfg.File)
+		}
+		a.file = f
+	}
+	if cfg.Syslog != "" {
+		network, addr, err := parseAuditLogSyslog(cfg.Syslog)
+		if err != nil {
+			a.close()
+			return nil, err
+		}
+		a.syslog, err = syslog.Dial(network, addr, syslog.LOG_INFO|syslog.LOG_LOCAL0, auditLogSyslogTag)
+		if err != nil {
+			a.close()
+			return nil, terror.ErrMasterOpenAuditLogSink.Delegate(err, cfg.Syslog)
+		}
+	}
+	return a, nil
+}
+
+// export writes the audit log to the sinks, the failures are only logged because the mutation is already done.
+func (a *auditLogger) export(l ha.AuditLog) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	s := l.String()
+	if a.file != nil {
+		if _, err := a.file.WriteString(s + "\n"); err != nil {
+			log.L().Warn("fail to write audit log to file", zap.String("audit log", s), zap.Error(err))
+		}
+	}
+	if a.syslog != nil {
+		if err := a.syslog.Info(s); err != nil {
+			log.L().Warn("fail to write audit log to syslog", zap.String("audit log", s), zap.Error(err))
+		}
+	}
+}
+
+// close closes the sinks.
+func (a *auditLogger) close() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.file != nil {
+		if err := a.file.Close(); err != nil {
+			log.L().Warn("fail to close audit log file", zap.Error(err))
+		}
+		a.file = nil
+	}
+	if a.syslog != nil {
+		if err := a.syslog.Close(); err != nil {
+			log.L().Warn("fail to close audit log syslog", zap.Error(err))
+		}
+		a.syslog = nil
+	}
+}
+
+// recordAuditLog puts the audit log into etcd and exports it to the sinks.
+func (s *Server) recordAuditLog(l ha.AuditLog) {
+	if _, err := ha.PutAuditLog(s.etcdClient, l); err != nil {
+		log.L().Warn("fail to put audit log into etcd", zap.Stringer("audit log", l), zap.Error(err))
+	}
+	s.auditLogger.export(l)
+}
+
+// cleanAuditLogs deletes the expired audit logs periodically when the DM-master is the leader.
+func (s *Server) cleanAuditLogs(ctx context.Context) {
+	ticker := time.NewTicker(auditLogCleanInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !s.election.IsLeader() {
+				continue
+			}
+			if _, err := ha.DeleteAuditLogsBefore(s.etcdClient, time.Now().Add(-s.cfg.AuditLog.Retention)); err != nil {
+				log.L().Warn("fail to delete expired audit logs", zap.Error(err))
+			}
+		}
+	}
+}
+
+// auditLogParams returns the JSON of the parameters with the passwords hidden.
+func auditLogParams(params interface{}) string {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return ""
+	}
+	var v interface{}
+	if err = json.Unmarshal(data, &v); err == nil {
+		hideJSONPasswords(v)
+		if hidden, err2 := json.Marshal(v); err2 == nil {
+			data = hidden
+		}
+	}
+	// the passwords in the YAML content of the task and source configs.
+	return utils.HidePassword(string(data))
+}
+
+// hideJSONPasswords replaces the values of the password and secret fields in the decoded JSON.
+func hideJSONPasswords(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if lowerKey := strings.ToLower(key); strings.Contains(lowerKey, "password") || strings.Contains(lowerKey, "secret") {
+				v[key] = auditLogHiddenValue
+				continue
+			}
+			hideJSONPasswords(val)
+		}
+	case []interface{}:
+		for _, val := range v {
+			hideJSONPasswords(val)
+		}
+	}
+}
+
+// tlsCommonName returns the common name of the client certificate, which is used as the user identity.
+func tlsCommonName(state tls.ConnectionState) string {
+	if len(state.PeerCertificates) == 0 {
+		return ""
+	}
+	return state.PeerCertificates[0].Subject.CommonName
+}
+
+// grpcAuditIdentity returns the user identity and the client address of the gRPC request. the user is the common name
+// of the client certificate if TLS is enabled, otherwise it's passed by the client in the metadata. the identity of the
+// original client passed by another DM-master when forwarding the request is only used if `isMaster` returns true for
+// the peer, otherwise any client can forge the user and the address in the audit logs.
+func grpcAuditIdentity(ctx context.Context, isMaster func(addr net.Addr) bool) (user, client string) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	p, ok := peer.FromContext(ctx)
+	// the request is forwarded by another DM-master, which has passed the identity of the original client.
+	if forwardedFor := md.Get(auditLogForwardedForKey); len(forwardedFor) > 0 && ok && isMaster(p.Addr) {
+		if users := md.Get(common.AuditLogUserKey); len(users) > 0 {
+			user = users[0]
+		}
+		return user, forwardedFor[0]
+	}
+
+	if ok {
+		client = p.Addr.String()
+		if tlsInfo, ok2 := p.AuthInfo.(credentials.TLSInfo); ok2 {
+			user = tlsCommonName(tlsInfo.State)
+		}
+	}
+	if users := md.Get(common.AuditLogUserKey); user == "" && len(users) > 0 {
+		user = users[0]
+	}
+	return user, client
+}
+
+// grpcAuditIdentity returns the identity of the gRPC request, the forwarded identity is trusted if the peer is a
+// member of the DM-master cluster.
+func (s *Server) grpcAuditIdentity(ctx context.Context) (user, client string) {
+	return grpcAuditIdentity(ctx, func(addr net.Addr) bool {
+		return s.isMasterMemberAddr(ctx, addr)
+	})
+}
+
+// isMasterMemberAddr returns whether the address is on the host of a member of the DM-master cluster, the hosts of
+// the client and peer URLs of the etcd members are checked.
+func (s *Server) isMasterMemberAddr(ctx context.Context, addr net.Addr) bool {
+	if addr == nil || s.etcdClient == nil {
+		return false
+	}
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	listResp, err := s.etcdClient.MemberList(ctx)
+	if err != nil {
+		log.L().Warn("fail to list members to check the forwarded audit identity", zap.Error(err))
+		return false
+	}
+	for _, m := range listResp.Members {
+		for _, u := range append(m.GetClientURLs(), m.GetPeerURLs()...) {
+			memberHost, _, err2 := net.SplitHostPort(utils.UnwrapScheme(u))
+			if err2 != nil {
+				continue
+			}
+			if memberIP := net.ParseIP(memberHost); memberIP != nil {
+				if memberIP.Equal(ip) {
+					return true
+				}
+				continue
+			}
+			ipAddrs, err2 := net.DefaultResolver.LookupIPAddr(ctx, memberHost)
+			if err2 != nil {
+				continue
+			}
+			for _, ipAddr := range ipAddrs {
+				if ipAddr.IP.Equal(ip) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// withForwardedAuditIdentity passes the identity of the client to the leader when the request is forwarded.
+func (s *Server) withForwardedAuditIdentity(ctx context.Context) context.Context {
+	user, client := s.grpcAuditIdentity(ctx)
+	return metadata.AppendToOutgoingContext(ctx, common.AuditLogUserKey, user, auditLogForwardedForKey, client)
+}
+
+// auditResponse is the common part of the responses of the audited gRPC methods.
+type auditResponse interface {
+	GetResult() bool
+	GetMsg() string
+}
+
+// auditServer wraps the Server to record the control-plane mutations requested by the gRPC API into the audit log.
+type auditServer struct {
+	*Server
+}
+
+// record records the audit log of the gRPC request.
+func (s *auditServer) record(ctx context.Context, operation string, req interface{}, resp auditResponse, err error) {
+	// the request forwarded to the leader is recorded by the leader.
+	if s.leader.Load() != oneselfLeader {
+		return
+	}
+	l := ha.AuditLog{
+		Time:      time.Now(),
+		API:       auditLogAPIGRPC,
+		Operation: operation,
+		Params:    auditLogParams(req),
+	}
+	l.User, l.Client = s.grpcAuditIdentity(ctx)
+	switch {
+	case err != nil:
+		l.Error = err.Error()
+	case !resp.GetResult():
+		l.Error = resp.GetMsg()
+	default:
+		l.Result = true
+	}
+	s.recordAuditLog(l)
+}
+
+// StartTask implements MasterServer.StartTask.
+func (s *auditServer) StartTask(ctx context.Context, req *pb.StartTaskRequest) (*pb.StartTaskResponse, error) {
+	resp, err := s.Server.StartTask(ctx, req)
+	s.record(ctx, "StartTask", req, resp, err)
+	return resp, err
+}
+
+// OperateTask implements MasterServer.OperateTask.
+func (s *auditServer) OperateTask(ctx context.Context, req *pb.OperateTaskRequest) (*pb.OperateTaskResponse, error) {
+	resp, err := s.Server.OperateTask(ctx, req)
+	s.record(ctx, "OperateTask", req, resp, err)
+	return resp, err
+}
+
+// UpdateTask implements MasterServer.UpdateTask.
+func (s *auditServer) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb.UpdateTaskResponse, error) {
+	resp, err := s.Server.UpdateTask(ctx, req)
+	s.record(ctx, "UpdateTask", req, resp, err)
+	return resp, err
+}
+
+// UnlockDDLLock implements MasterServer.UnlockDDLLock.
+func (s *auditServer) UnlockDDLLock(ctx context.Context, req *pb.UnlockDDLLockRequest) (*pb.UnlockDDLLockResponse, error) {
+	resp, err := s.Server.UnlockDDLLock(ctx, req)
+	s.record(ctx, "UnlockDDLLock", req, resp, err)
+	return resp, err
+}
+
+// OperateWorkerRelayTask implements MasterServer.OperateWorkerRelayTask.
+func (s *auditServer) OperateWorkerRelayTask(ctx context.Context, req *pb.OperateWorkerRelayRequest) (*pb.OperateWorkerRelayResponse, error) {
+	resp, err := s.Server.OperateWorkerRelayTask(ctx, req)
+	s.record(ctx, "OperateWorkerRelayTask", req, resp, err)
+	return resp, err
+}
+
+// PurgeWorkerRelay implements MasterServer.PurgeWorkerRelay.
+func (s *auditServer) PurgeWorkerRelay(ctx context.Context, req *pb.PurgeWorkerRelayRequest) (*pb.PurgeWorkerRelayResponse, error) {
+	resp, err := s.Server.PurgeWorkerRelay(ctx, req)
+	s.record(ctx, "PurgeWorkerRelay", req, resp, err)
+	return resp, err
+}
+
+// OperateSource implements MasterServer.OperateSource.
+func (s *auditServer) OperateSource(ctx context.Context, req *pb.OperateSourceRequest) (*pb.OperateSourceResponse, error) {
+	resp, err := s.Server.OperateSource(ctx, req)
+	s.record(ctx, "OperateSource", req, resp, err)
+	return resp, err
+}
+
+// OfflineMember implements MasterServer.OfflineMember.
+func (s *auditServer) OfflineMember(ctx context.Context, req *pb.OfflineMemberRequest) (*pb.OfflineMemberResponse, error) {
+	resp, err := s.Server.OfflineMember(ctx, req)
+	s.record(ctx, "OfflineMember", req, resp, err)
+	return resp, err
+}
+
+// OperateLeader implements MasterServer.OperateLeader.
+func (s *auditServer) OperateLeader(ctx context.Context, req *pb.OperateLeaderRequest) (*pb.OperateLeaderResponse, error) {
+	resp, err := s.Server.OperateLeader(ctx, req)
+	s.record(ctx, "OperateLeader", req, resp, err)
+	return resp, err
+}
+
+// OperateSchema implements MasterServer.OperateSchema.
+func (s *auditServer) OperateSchema(ctx context.Context, req *pb.OperateSchemaRequest) (*pb.OperateSchemaResponse, error) {
+	resp, err := s.Server.OperateSchema(ctx, req)
+	s.record(ctx, "OperateSchema", req, resp, err)
+	return resp, err
+}
+
+// HandleError implements MasterServer.HandleError.
+func (s *auditServer) HandleError(ctx context.Context, req *pb.HandleErrorRequest) (*pb.HandleErrorResponse, error) {
+	resp, err := s.Server.HandleError(ctx, req)
+	s.record(ctx, "HandleError", req, resp, err)
+	return resp, err
+}
+
+// TransferSource implements MasterServer.TransferSource.
+func (s *auditServer) TransferSource(ctx context.Context, req *pb.TransferSourceRequest) (*pb.TransferSourceResponse, error) {
+	resp, err := s.Server.TransferSource(ctx, req)
+	s.record(ctx, "TransferSource", req, resp, err)
+	return resp, err
+}
+
+// OperateRelay implements MasterServer.OperateRelay.
+func (s *auditServer) OperateRelay(ctx context.Context, req *pb.OperateRelayRequest) (*pb.OperateRelayResponse, error) {
+	resp, err := s.Server.OperateRelay(ctx, req)
+	s.record(ctx, "OperateRelay", req, resp, err)
+	return resp, err
+}
+
+// auditLogMW is a middleware to record the control-plane mutations requested by the OpenAPI into the audit log.
+// the requests are handled by the leader only, because the followers redirect them to the leader.
+func (s *Server) auditLogMW() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if s.auditLogger == nil || c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead ||
+			c.Request.Method == http.MethodOptions {
+			c.Next()
+			return
+		}
+
+		var body []byte
+		if c.Request.Body != nil {
+			body, _ = ioutil.ReadAll(c.Request.Body)
+			c.Request.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+		c.Next()
+
+		operation := c.FullPath()
+		if operation == "" {
+			operation = c.Request.URL.Path
+		}
+		params := map[string]interface{}{"path": c.Request.URL.Path}
+		if c.Request.URL.RawQuery != "" {
+			params["query"] = c.Request.URL.RawQuery
+		}
+		if len(body) > 0 {
+			if json.Valid(body) {
+				params["body"] = json.RawMessage(body)
+			} else {
+				params["body"] = string(body)
+			}
+		}
+		l := ha.AuditLog{
+			Time:      time.Now(),
+			User:      c.GetHeader(common.AuditLogUserKey),
+			Client:    c.Request.RemoteAddr,
+			API:       auditLogAPIOpenAPI,
+			Operation: c.Request.Method + " " + operation,
+			Params:    auditLogParams(params),
+		}
+		if c.Request.TLS != nil {
+			if cn := tlsCommonName(*c.Request.TLS); cn != "" {
+				l.User = cn
+			}
+		}
+		if status := c.Writer.Status(); status < http.StatusBadRequest {
+			l.Result = true
+		} else if gErr := c.Errors.Last(); gErr != nil {
+			l.Error = gErr.Error()
+		} else {
+			l.Error = http.StatusText(status)
+		}
+		s.recordAuditLog(l)
+	}
+}
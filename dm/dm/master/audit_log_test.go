@@ -0,0 +1,240 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/testutil"
+	"github.com/pingcap/check"
+	"github.com/tikv/pd/pkg/tempurl"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/pingcap/tiflow/dm/dm/common"
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/dm/pb"
+	"github.com/pingcap/tiflow/dm/openapi"
+	"github.com/pingcap/tiflow/dm/pkg/ha"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+func (t *testConfigSuite) TestAuditLogConfig(c *check.C) {
+	cfg := AuditLogConfig{}
+	c.Assert(cfg.adjust(), check.IsNil)
+	c.Assert(cfg.RetentionStr, check.Equals, defaultAuditLogRetention)
+	c.Assert(cfg.Retention, check.Equals, 30*24*time.Hour)
+
+	cfg = AuditLogConfig{RetentionStr: "0s", Syslog: "local"}
+	c.Assert(cfg.adjust(), check.IsNil)
+	c.Assert(cfg.Retention, check.Equals, time.Duration(0))
+	cfg = AuditLogConfig{Syslog: "udp://127.0.0.1:514"}
+	c.Assert(cfg.adjust(), check.IsNil)
+
+	for _, cfg = range []AuditLogConfig{
+		{RetentionStr: "30d"},
+		{RetentionStr: "-1h"},
+		{Syslog: "127.0.0.1:514"},
+		{Syslog: "http://127.0.0.1:514"},
+		{Syslog: "udp://"},
+	} {
+		c.Assert(terror.ErrMasterInvalidAuditLogConfig.Equal(cfg.adjust()), check.IsTrue, check.Commentf("%+v", cfg))
+	}
+}
+
+func (t *testConfigSuite) TestAuditLogParams(c *check.C) {
+	// the passwords and secrets in the JSON fields and the passwords in the YAML contents are hidden.
+	params := auditLogParams(map[string]interface{}{
+		"password": "123456",
+		"secret":   "qwerty",
+		"to":       map[string]interface{}{"user": "root", "Password": "abcdef"},
+		"task":     "name: test\ntarget-database:\n  user: root\n  password: \"abcdef\"\n",
+	})
+	c.Assert(params, check.Not(check.Matches), ".*(123456|abcdef|qwerty).*")
+	c.Assert(strings.Count(params, auditLogHiddenValue), check.Equals, 4)
+	c.Assert(params, check.Matches, `.*"user":"root".*`)
+
+	req := &pb.OperateSourceRequest{Op: pb.SourceOp_StartSource, Config: []string{"source-id: s1\nfrom:\n  password: \"123456\"\n"}}
+	params = auditLogParams(req)
+	c.Assert(params, check.Not(check.Matches), ".*123456.*")
+	c.Assert(params, check.Matches, ".*source-id: s1.*")
+}
+
+func (t *testConfigSuite) TestGRPCAuditIdentity(c *check.C) {
+	var (
+		masterIP = net.ParseIP("127.0.0.2")
+		isMaster = func(addr net.Addr) bool {
+			tcpAddr, ok := addr.(*net.TCPAddr)
+			return ok && tcpAddr.IP.Equal(masterIP)
+		}
+	)
+	ctx := context.Background()
+	user, client := grpcAuditIdentity(ctx, isMaster)
+	c.Assert(user, check.Equals, "")
+	c.Assert(client, check.Equals, "")
+
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(common.AuditLogUserKey, "admin"))
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 1234}})
+	user, client = grpcAuditIdentity(ctx, isMaster)
+	c.Assert(user, check.Equals, "admin")
+	c.Assert(client, check.Equals, "127.0.0.1:1234")
+
+	// the identity of the original client is passed to the leader when forwarded.
+	md, ok := metadata.FromOutgoingContext(metadata.AppendToOutgoingContext(context.Background(),
+		common.AuditLogUserKey, user, auditLogForwardedForKey, client))
+	c.Assert(ok, check.IsTrue)
+	forwardedCtx := metadata.NewIncomingContext(context.Background(), md)
+	ctx = peer.NewContext(forwardedCtx, &peer.Peer{Addr: &net.TCPAddr{IP: masterIP, Port: 8261}})
+	user, client = grpcAuditIdentity(ctx, isMaster)
+	c.Assert(user, check.Equals, "admin")
+	c.Assert(client, check.Equals, "127.0.0.1:1234")
+
+	// the forwarded identity sent by a client which is not a DM-master is ignored, the common name of its certificate
+	// and its address are recorded.
+	tlsInfo := credentials.TLSInfo{State: tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "alice"}}},
+	}}
+	ctx = peer.NewContext(forwardedCtx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.10"), Port: 5678}, AuthInfo: tlsInfo})
+	user, client = grpcAuditIdentity(ctx, isMaster)
+	c.Assert(user, check.Equals, "alice")
+	c.Assert(client, check.Equals, "192.0.2.10:5678")
+}
+
+func (t *openAPISuite) TestAuditLog(c *check.C) {
+	ctx, cancel := context.WithCancel(context.Background())
+	auditFile := filepath.Join(c.MkDir(), "audit.log")
+	cfg := NewConfig()
+	c.Assert(cfg.Parse([]string{"-config=./dm-master.toml"}), check.IsNil)
+	cfg.Name = "dm-master-1"
+	cfg.DataDir = c.MkDir()
+	cfg.MasterAddr = tempurl.Alloc()[len("http://"):]
+	cfg.PeerUrls = tempurl.Alloc()
+	cfg.AdvertisePeerUrls = cfg.PeerUrls
+	cfg.AdvertiseAddr = cfg.MasterAddr
+	cfg.InitialCluster = fmt.Sprintf("%s=%s", cfg.Name, cfg.AdvertisePeerUrls)
+	cfg.OpenAPI = true
+	cfg.AuditLog = AuditLogConfig{Enable: true, File: auditFile}
+	c.Assert(cfg.AuditLog.adjust(), check.IsNil)
+
+	s := NewServer(cfg)
+	c.Assert(s.Start(ctx), check.IsNil)
+	defer func() {
+		cancel()
+		s.Close()
+	}()
+	c.Assert(utils.WaitSomething(30, 100*time.Millisecond, func() bool {
+		return s.election.IsLeader() && s.scheduler.Started()
+	}), check.IsTrue)
+
+	// the mutation requested by the OpenAPI is recorded.
+	dbCfg := config.GetDBConfigForTest()
+	source := openapi.Source{
+		SourceName: source1Name,
+		Host:       dbCfg.Host,
+		Password:   "123456",
+		Port:       dbCfg.Port,
+		User:       dbCfg.User,
+	}
+	result := testutil.NewRequest().Post("/api/v1/sources").WithHeader(common.AuditLogUserKey, "admin").
+		WithJsonBody(source).GoWithHTTPHandler(t.testT, s.openapiHandles)
+	c.Assert(result.Code(), check.Equals, http.StatusCreated)
+
+	// the mutation requested by the gRPC API is recorded.
+	grpcCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(common.AuditLogUserKey, "dmctl-user"))
+	grpcCtx = peer.NewContext(grpcCtx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 1234}})
+	resp, err := (&auditServer{Server: s}).OperateTask(grpcCtx, &pb.OperateTaskRequest{Op: pb.TaskOp_Pause, Name: "not-exist"})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsFalse)
+
+	getAuditLogs := func(query string) openapi.GetAuditLogListResponse {
+		result = testutil.NewRequest().Get("/api/v1/cluster/audit-logs"+query).GoWithHTTPHandler(t.testT, s.openapiHandles)
+		c.Assert(result.Code(), check.Equals, http.StatusOK)
+		var logs openapi.GetAuditLogListResponse
+		c.Assert(result.UnmarshalBodyToObject(&logs), check.IsNil)
+		return logs
+	}
+	logs := getAuditLogs("")
+	c.Assert(logs.Total, check.Equals, 2)
+	l := logs.Data[0]
+	c.Assert(l.Api, check.Equals, openapi.AuditLogApiOpenapi)
+	c.Assert(l.Operation, check.Equals, "POST /api/v1/sources")
+	c.Assert(*l.User, check.Equals, "admin")
+	c.Assert(l.Client, check.Equals, "192.0.2.1:1234")
+	c.Assert(l.Result, check.IsTrue)
+	c.Assert(l.Params, check.Matches, ".*"+source1Name+".*")
+	c.Assert(l.Params, check.Not(check.Matches), ".*123456.*")
+	l = logs.Data[1]
+	c.Assert(l.Api, check.Equals, openapi.AuditLogApiGrpc)
+	c.Assert(l.Operation, check.Equals, "OperateTask")
+	c.Assert(*l.User, check.Equals, "dmctl-user")
+	c.Assert(l.Client, check.Equals, "127.0.0.1:1234")
+	c.Assert(l.Result, check.IsFalse)
+	c.Assert(*l.Error, check.Matches, ".*not-exist.*")
+
+	// filter the audit logs.
+	logs = getAuditLogs("?user=admin")
+	c.Assert(logs.Data, check.HasLen, 1)
+	c.Assert(logs.Data[0].Operation, check.Equals, "POST /api/v1/sources")
+	logs = getAuditLogs("?operation=OperateTask")
+	c.Assert(logs.Data, check.HasLen, 1)
+	c.Assert(*logs.Data[0].User, check.Equals, "dmctl-user")
+	logs = getAuditLogs("?limit=1")
+	c.Assert(logs.Data, check.HasLen, 1)
+	c.Assert(logs.Data[0].Operation, check.Equals, "OperateTask")
+	logs = getAuditLogs("?start_time=" + time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+	c.Assert(logs.Data, check.HasLen, 0)
+
+	// the audit logs are exported to the file.
+	content, err := ioutil.ReadFile(auditFile)
+	c.Assert(err, check.IsNil)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	c.Assert(lines, check.HasLen, 2)
+	var fileLog ha.AuditLog
+	c.Assert(json.Unmarshal([]byte(lines[1]), &fileLog), check.IsNil)
+	c.Assert(fileLog.Operation, check.Equals, "OperateTask")
+
+	// the forwarded identity is only trusted from the DM-master members, a plain client can't forge it.
+	forwardedMD := metadata.Pairs(common.AuditLogUserKey, "forged-user", auditLogForwardedForKey, "10.0.0.1:1234")
+	tlsInfo := credentials.TLSInfo{State: tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "alice"}}},
+	}}
+	grpcCtx = peer.NewContext(metadata.NewIncomingContext(ctx, forwardedMD),
+		&peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.10"), Port: 5678}, AuthInfo: tlsInfo})
+	_, err = (&auditServer{Server: s}).OperateTask(grpcCtx, &pb.OperateTaskRequest{Op: pb.TaskOp_Pause, Name: "not-exist"})
+	c.Assert(err, check.IsNil)
+	// the request forwarded by the member on 127.0.0.1 keeps the identity of the original client.
+	grpcCtx = peer.NewContext(metadata.NewIncomingContext(ctx, forwardedMD),
+		&peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 5678}})
+	_, err = (&auditServer{Server: s}).OperateTask(grpcCtx, &pb.OperateTaskRequest{Op: pb.TaskOp_Pause, Name: "not-exist"})
+	c.Assert(err, check.IsNil)
+	logs = getAuditLogs("?user=alice")
+	c.Assert(logs.Data, check.HasLen, 1)
+	c.Assert(logs.Data[0].Client, check.Equals, "192.0.2.10:5678")
+	logs = getAuditLogs("?user=forged-user")
+	c.Assert(logs.Data, check.HasLen, 1)
+	c.Assert(logs.Data[0].Client, check.Equals, "10.0.0.1:1234")
+}
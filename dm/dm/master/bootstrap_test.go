@@ -231,8 +231,8 @@ func (t *testMaster) TestSubtaskCfgsStagesV1Import(c *C) {
 	defer ctrl.Finish()
 	mockWCli1 := pbmock.NewMockWorkerClient(ctrl)
 	mockWCli2 := pbmock.NewMockWorkerClient(ctrl)
-	c.Assert(s.scheduler.AddWorker(worker1Name, worker1Addr), IsNil)
-	c.Assert(s.scheduler.AddWorker(worker2Name, worker2Addr), IsNil)
+	c.Assert(s.scheduler.AddWorker(worker1Name, worker1Addr, nil), IsNil)
+	c.Assert(s.scheduler.AddWorker(worker2Name, worker2Addr, nil), IsNil)
 	s.scheduler.SetWorkerClientForTest(worker1Name, newMockRPCClient(mockWCli1))
 	s.scheduler.SetWorkerClientForTest(worker2Name, newMockRPCClient(mockWCli2))
 
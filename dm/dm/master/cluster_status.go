@@ -0,0 +1,189 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"sort"
+
+	"github.com/pingcap/tiflow/dm/dm/master/scheduler"
+	"github.com/pingcap/tiflow/dm/dm/pb"
+	"github.com/pingcap/tiflow/dm/openapi"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/ha"
+)
+
+// binlogGap returns how many binlog files and bytes the syncer binlog position is behind the master binlog position,
+// the bytes are only calculated when they are in the same binlog file.
+func binlogGap(masterBinlog, syncerBinlog string) (fileGap, posGap int64) {
+	masterPos, err := binlog.PositionFromPosStr(masterBinlog)
+	if err != nil {
+		return 0, 0
+	}
+	syncerPos, err := binlog.PositionFromPosStr(syncerBinlog)
+	if err != nil {
+		return 0, 0
+	}
+	// the syncer binlog may be a relay position with the UUID suffix.
+	syncerPos, err = binlog.RealMySQLPos(syncerPos)
+	if err != nil {
+		return 0, 0
+	}
+	masterFile, err := binlog.ParseFilename(masterPos.Name)
+	if err != nil {
+		return 0, 0
+	}
+	syncerFile, err := binlog.ParseFilename(syncerPos.Name)
+	if err != nil || syncerFile.BaseName != masterFile.BaseName {
+		return 0, 0
+	}
+	fileGap = masterFile.SeqInt64 - syncerFile.SeqInt64
+	if fileGap < 0 {
+		return 0, 0
+	}
+	if fileGap == 0 && masterPos.Pos > syncerPos.Pos {
+		posGap = int64(masterPos.Pos - syncerPos.Pos)
+	}
+	return fileGap, posGap
+}
+
+// aggregateClusterStatus aggregates the metrics of the subtasks and the DM-workers into one response.
+// taskSources is task name -> source names of the task, statusList is the query-status responses of the sources,
+// workers and loads are the DM-workers and their reported loads.
+func aggregateClusterStatus(
+	taskSources map[string][]string,
+	statusList []*pb.QueryStatusResponse,
+	workers []*pb.WorkerInfo,
+	loads map[string]ha.WorkerLoad,
+) openapi.GetClusterStatusResponse {
+	statusBySource := make(map[string]*pb.QueryStatusResponse, len(statusList))
+	for _, status := range statusList {
+		if status == nil || status.SourceStatus == nil {
+			continue
+		}
+		statusBySource[status.SourceStatus.Source] = status
+	}
+
+	resp := openapi.GetClusterStatusResponse{
+		Tasks:   make([]openapi.TaskMetrics, 0, len(taskSources)),
+		Workers: make([]openapi.WorkerMetrics, 0, len(workers)),
+	}
+	subTaskCountByWorker := make(map[string]int)
+
+	taskNames := make([]string, 0, len(taskSources))
+	for task := range taskSources {
+		taskNames = append(taskNames, task)
+	}
+	sort.Strings(taskNames)
+	for _, task := range taskNames {
+		sources := append([]string{}, taskSources[task]...)
+		sort.Strings(sources)
+		taskMetrics := openapi.TaskMetrics{
+			Name:     task,
+			Subtasks: make([]openapi.SubTaskMetrics, 0, len(sources)),
+		}
+		for _, source := range sources {
+			status, ok := statusBySource[source]
+			if !ok {
+				continue
+			}
+			subTaskMetrics := openapi.SubTaskMetrics{
+				SourceName: source,
+				WorkerName: status.SourceStatus.Worker,
+			}
+			if !status.Result {
+				errMsg := status.Msg
+				subTaskMetrics.ErrorMsg = &errMsg
+				subTaskMetrics.ErrorCount = 1
+			} else {
+				var subTaskStatus *pb.SubTaskStatus
+				for _, st := range status.SubTaskStatus {
+					if st.Name == task {
+						subTaskStatus = st
+					}
+				}
+				if subTaskStatus == nil {
+					continue
+				}
+				subTaskMetrics.Stage = subTaskStatus.Stage.String()
+				subTaskMetrics.Unit = subTaskStatus.Unit.String()
+				if subTaskStatus.Result != nil {
+					subTaskMetrics.ErrorCount = len(subTaskStatus.Result.Errors)
+				}
+				switch subTaskStatus.Stage {
+				case pb.Stage_Running:
+					resp.Summary.RunningSubtaskCount++
+				case pb.Stage_Paused:
+					resp.Summary.PausedSubtaskCount++
+				}
+				if syncS := subTaskStatus.GetSync(); syncS != nil {
+					subTaskMetrics.SecondsBehindMaster = syncS.SecondsBehindMaster
+					subTaskMetrics.BinlogFileGap, subTaskMetrics.BinlogPosGap = binlogGap(syncS.MasterBinlog, syncS.SyncerBinlog)
+					if v := syncS.Validation; v != nil {
+						subTaskMetrics.Validator = &openapi.ValidatorStats{
+							ValidatedRows: v.ValidatedRows,
+							PendingRows:   v.PendingRows,
+							FailedRows:    v.FailedRows,
+						}
+						if taskMetrics.Validator == nil {
+							taskMetrics.Validator = &openapi.ValidatorStats{}
+						}
+						taskMetrics.Validator.ValidatedRows += v.ValidatedRows
+						taskMetrics.Validator.PendingRows += v.PendingRows
+						taskMetrics.Validator.FailedRows += v.FailedRows
+					}
+				}
+				subTaskCountByWorker[subTaskMetrics.WorkerName]++
+			}
+
+			if subTaskMetrics.SecondsBehindMaster > taskMetrics.MaxSecondsBehindMaster {
+				taskMetrics.MaxSecondsBehindMaster = subTaskMetrics.SecondsBehindMaster
+			}
+			if subTaskMetrics.BinlogFileGap > taskMetrics.MaxBinlogFileGap {
+				taskMetrics.MaxBinlogFileGap = subTaskMetrics.BinlogFileGap
+			}
+			taskMetrics.ErrorCount += subTaskMetrics.ErrorCount
+			taskMetrics.Subtasks = append(taskMetrics.Subtasks, subTaskMetrics)
+		}
+
+		resp.Summary.SubtaskCount += len(taskMetrics.Subtasks)
+		resp.Summary.ErrorCount += taskMetrics.ErrorCount
+		if taskMetrics.MaxSecondsBehindMaster > resp.Summary.MaxSecondsBehindMaster {
+			resp.Summary.MaxSecondsBehindMaster = taskMetrics.MaxSecondsBehindMaster
+		}
+		resp.Tasks = append(resp.Tasks, taskMetrics)
+	}
+	resp.Summary.TaskCount = len(resp.Tasks)
+
+	for _, w := range workers {
+		workerMetrics := openapi.WorkerMetrics{
+			Name:            w.Name,
+			Addr:            w.Addr,
+			Stage:           w.Stage,
+			BoundSourceName: w.Source,
+			SubtaskCount:    subTaskCountByWorker[w.Name],
+		}
+		if load, ok := loads[w.Name]; ok {
+			cpuUsage, memoryUsage, relayDiskUsage := load.CPUUsage, load.MemoryUsage, load.RelayDiskUsage
+			workerMetrics.CpuUsage = &cpuUsage
+			workerMetrics.MemoryUsage = &memoryUsage
+			workerMetrics.RelayDiskUsage = &relayDiskUsage
+		}
+		if w.Stage == string(scheduler.WorkerOffline) {
+			resp.Summary.OfflineWorkerCount++
+		}
+		resp.Workers = append(resp.Workers, workerMetrics)
+	}
+	resp.Summary.WorkerCount = len(resp.Workers)
+	return resp
+}
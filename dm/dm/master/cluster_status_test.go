@@ -0,0 +1,179 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/testutil"
+	"github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/dm/pb"
+	"github.com/pingcap/tiflow/dm/openapi"
+	"github.com/pingcap/tiflow/dm/pkg/ha"
+)
+
+func (t *testConfigSuite) TestBinlogGap(c *check.C) {
+	cases := []struct {
+		master, syncer  string
+		fileGap, posGap int64
+	}{
+		{"(mysql-bin.000003, 1000)", "(mysql-bin.000003, 400)", 0, 600},
+		{"(mysql-bin.000005, 1000)", "(mysql-bin.000003, 400)", 2, 0},
+		{"(mysql-bin.000005, 1000)", "(mysql-bin|000001.000004, 400)", 1, 0},
+		{"(mysql-bin.000003, 400)", "(mysql-bin.000003, 1000)", 0, 0},
+		{"(mysql-bin.000003, 1000)", "(other-bin.000001, 400)", 0, 0},
+		{"", "(mysql-bin.000003, 400)", 0, 0},
+	}
+	for _, cs := range cases {
+		fileGap, posGap := binlogGap(cs.master, cs.syncer)
+		c.Assert(fileGap, check.Equals, cs.fileGap, check.Commentf("%+v", cs))
+		c.Assert(posGap, check.Equals, cs.posGap, check.Commentf("%+v", cs))
+	}
+}
+
+func (t *testConfigSuite) TestAggregateClusterStatus(c *check.C) {
+	taskSources := map[string][]string{
+		"task1": {"source2", "source1"},
+		"task2": {"source1", "source3"},
+	}
+	statusList := []*pb.QueryStatusResponse{
+		{
+			Result:       true,
+			SourceStatus: &pb.SourceStatus{Source: "source1", Worker: "worker1"},
+			SubTaskStatus: []*pb.SubTaskStatus{
+				{
+					Name:  "task1",
+					Stage: pb.Stage_Running,
+					Unit:  pb.UnitType_Sync,
+					Status: &pb.SubTaskStatus_Sync{Sync: &pb.SyncStatus{
+						MasterBinlog:        "(mysql-bin.000002, 1000)",
+						SyncerBinlog:        "(mysql-bin.000002, 100)",
+						SecondsBehindMaster: 3,
+						Validation:          &pb.ValidationStatus{ValidatedRows: 10, PendingRows: 2, FailedRows: 1},
+					}},
+				},
+				{
+					Name:   "task2",
+					Stage:  pb.Stage_Paused,
+					Unit:   pb.UnitType_Load,
+					Result: &pb.ProcessResult{Errors: []*pb.ProcessError{{Message: "err1"}, {Message: "err2"}}},
+				},
+			},
+		},
+		{
+			Result:       true,
+			SourceStatus: &pb.SourceStatus{Source: "source2", Worker: "worker2"},
+			SubTaskStatus: []*pb.SubTaskStatus{
+				{
+					Name:  "task1",
+					Stage: pb.Stage_Running,
+					Unit:  pb.UnitType_Sync,
+					Status: &pb.SubTaskStatus_Sync{Sync: &pb.SyncStatus{
+						MasterBinlog:        "(mysql-bin.000004, 1000)",
+						SyncerBinlog:        "(mysql-bin.000001, 100)",
+						SecondsBehindMaster: 30,
+						Validation:          &pb.ValidationStatus{ValidatedRows: 5, PendingRows: 1},
+					}},
+				},
+			},
+		},
+		{
+			Result:       false,
+			Msg:          "source3 relevant worker-client not found",
+			SourceStatus: &pb.SourceStatus{Source: "source3"},
+		},
+	}
+	workers := []*pb.WorkerInfo{
+		{Name: "worker1", Addr: "127.0.0.1:8262", Stage: "bound", Source: "source1"},
+		{Name: "worker2", Addr: "127.0.0.1:8263", Stage: "bound", Source: "source2"},
+		{Name: "worker3", Addr: "127.0.0.1:8264", Stage: "offline"},
+	}
+	loads := map[string]ha.WorkerLoad{
+		"worker1": {Worker: "worker1", CPUUsage: 12.5, MemoryUsage: 1024, RelayDiskUsage: 2048},
+	}
+
+	resp := aggregateClusterStatus(taskSources, statusList, workers, loads)
+	c.Assert(resp.Summary, check.DeepEquals, openapi.ClusterStatusSummary{
+		TaskCount:              2,
+		SubtaskCount:           4,
+		RunningSubtaskCount:    2,
+		PausedSubtaskCount:     1,
+		ErrorCount:             3,
+		MaxSecondsBehindMaster: 30,
+		WorkerCount:            3,
+		OfflineWorkerCount:     1,
+	})
+
+	c.Assert(resp.Tasks, check.HasLen, 2)
+	task1 := resp.Tasks[0]
+	c.Assert(task1.Name, check.Equals, "task1")
+	c.Assert(task1.MaxSecondsBehindMaster, check.Equals, int64(30))
+	c.Assert(task1.MaxBinlogFileGap, check.Equals, int64(3))
+	c.Assert(task1.ErrorCount, check.Equals, 0)
+	c.Assert(task1.Validator, check.DeepEquals, &openapi.ValidatorStats{ValidatedRows: 15, PendingRows: 3, FailedRows: 1})
+	c.Assert(task1.Subtasks, check.HasLen, 2)
+	c.Assert(task1.Subtasks[0].SourceName, check.Equals, "source1")
+	c.Assert(task1.Subtasks[0].BinlogFileGap, check.Equals, int64(0))
+	c.Assert(task1.Subtasks[0].BinlogPosGap, check.Equals, int64(900))
+	c.Assert(task1.Subtasks[1].SourceName, check.Equals, "source2")
+	c.Assert(task1.Subtasks[1].BinlogFileGap, check.Equals, int64(3))
+
+	task2 := resp.Tasks[1]
+	c.Assert(task2.Name, check.Equals, "task2")
+	c.Assert(task2.ErrorCount, check.Equals, 3)
+	c.Assert(task2.Validator, check.IsNil)
+	c.Assert(task2.Subtasks, check.HasLen, 2)
+	c.Assert(task2.Subtasks[0].Stage, check.Equals, pb.Stage_Paused.String())
+	c.Assert(task2.Subtasks[0].Unit, check.Equals, pb.UnitType_Load.String())
+	c.Assert(task2.Subtasks[0].ErrorCount, check.Equals, 2)
+	c.Assert(task2.Subtasks[1].SourceName, check.Equals, "source3")
+	c.Assert(*task2.Subtasks[1].ErrorMsg, check.Equals, "source3 relevant worker-client not found")
+	c.Assert(task2.Subtasks[1].ErrorCount, check.Equals, 1)
+
+	c.Assert(resp.Workers, check.HasLen, 3)
+	c.Assert(resp.Workers[0].SubtaskCount, check.Equals, 2)
+	c.Assert(*resp.Workers[0].CpuUsage, check.Equals, 12.5)
+	c.Assert(*resp.Workers[0].MemoryUsage, check.Equals, uint64(1024))
+	c.Assert(*resp.Workers[0].RelayDiskUsage, check.Equals, int64(2048))
+	c.Assert(resp.Workers[1].SubtaskCount, check.Equals, 1)
+	c.Assert(resp.Workers[1].CpuUsage, check.IsNil)
+	c.Assert(resp.Workers[2].SubtaskCount, check.Equals, 0)
+}
+
+func (t *openAPISuite) TestClusterStatusAPI(c *check.C) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := setupServer(ctx, c)
+	defer func() {
+		cancel()
+		s.Close()
+	}()
+
+	c.Assert(s.scheduler.AddWorker("worker1", "127.0.0.1:8262", nil), check.IsNil)
+	_, err := ha.PutWorkerLoad(s.etcdClient, ha.WorkerLoad{Worker: "worker1", CPUUsage: 10, UpdateTime: time.Now()})
+	c.Assert(err, check.IsNil)
+
+	result := testutil.NewRequest().Get("/api/v1/cluster/status").GoWithHTTPHandler(t.testT, s.openapiHandles)
+	c.Assert(result.Code(), check.Equals, http.StatusOK)
+	var resp openapi.GetClusterStatusResponse
+	c.Assert(result.UnmarshalBodyToObject(&resp), check.IsNil)
+	c.Assert(resp.Summary, check.DeepEquals, openapi.ClusterStatusSummary{WorkerCount: 1, OfflineWorkerCount: 1})
+	c.Assert(resp.Tasks, check.HasLen, 0)
+	c.Assert(resp.Workers, check.HasLen, 1)
+	c.Assert(resp.Workers[0].Name, check.Equals, "worker1")
+	c.Assert(resp.Workers[0].Stage, check.Equals, "offline")
+	c.Assert(*resp.Workers[0].CpuUsage, check.Equals, float64(10))
+}
@@ -135,6 +135,12 @@ type Config struct {
 	// if this path set, DM-master leader will try to upgrade from v1.0.x to the current version.
 	V1SourcesPath string `toml:"v1-sources-path" json:"v1-sources-path"`
 
+	// audit log of the control-plane mutations
+	AuditLog AuditLogConfig `toml:"audit-log" json:"audit-log"`
+
+	// notifications of DM events sent to the webhooks
+	Webhook WebhookConfig `toml:"webhook" json:"webhook"`
+
 	// tls config
 	config.Security
 
@@ -315,6 +321,13 @@ func (c *Config) adjust() error {
 		c.QuotaBackendBytes = quotaBackendBytesLowerBound
 	}
 
+	if err = c.AuditLog.adjust(); err != nil {
+		return err
+	}
+	if err = c.Webhook.adjust(); err != nil {
+		return err
+	}
+
 	if c.ExperimentalFeatures.OpenAPI {
 		c.OpenAPI = true
 		c.ExperimentalFeatures.OpenAPI = false
@@ -20,9 +20,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	ginmiddleware "github.com/deepmap/oapi-codegen/pkg/gin-middleware"
 	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tidb-tools/pkg/filter"
 	"go.uber.org/zap"
 
 	"github.com/pingcap/tiflow/dm/checker"
@@ -32,9 +34,11 @@ import (
 	"github.com/pingcap/tiflow/dm/dm/master/workerrpc"
 	"github.com/pingcap/tiflow/dm/dm/pb"
 	"github.com/pingcap/tiflow/dm/openapi"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
 	"github.com/pingcap/tiflow/dm/pkg/conn"
 	"github.com/pingcap/tiflow/dm/pkg/ha"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/shardddl/optimism"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 )
@@ -78,6 +82,7 @@ func (s *Server) InitOpenAPIHandles() error {
 	r.Use(gin.Recovery())
 	r.Use(openapi.ZapLogger(log.L().WithFields(zap.String("component", "openapi")).Logger))
 	r.Use(s.redirectRequestToLeaderMW())
+	r.Use(s.auditLogMW())
 	r.Use(terrorHTTPErrorHandler())
 	// use validation middleware to check all requests against the OpenAPI schema.
 	r.Use(ginmiddleware.OapiRequestValidator(swagger))
@@ -170,6 +175,58 @@ func (s *Server) DMAPIOfflineWorkerNode(c *gin.Context, workerName string) {
 	c.Status(http.StatusNoContent)
 }
 
+// DMAPIGetRebalancePlan url is: (GET /api/v1/cluster/rebalance).
+func (s *Server) DMAPIGetRebalancePlan(c *gin.Context) {
+	plan, err := s.scheduler.GetRebalancePlan()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.IndentedJSON(http.StatusOK, rebalancePlanToOpenAPI(plan))
+}
+
+// DMAPIRebalance url is: (POST /api/v1/cluster/rebalance).
+func (s *Server) DMAPIRebalance(c *gin.Context) {
+	plan, err := s.scheduler.Rebalance(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.IndentedJSON(http.StatusOK, rebalancePlanToOpenAPI(plan))
+}
+
+func rebalancePlanToOpenAPI(plan *scheduler.RebalancePlan) *openapi.RebalancePlan {
+	resp := &openapi.RebalancePlan{
+		Workers: make([]openapi.WorkerLoad, 0, len(plan.Workers)),
+		Moves:   make([]openapi.RebalanceMove, 0, len(plan.Moves)),
+	}
+	for _, w := range plan.Workers {
+		load := openapi.WorkerLoad{
+			WorkerName:     w.Worker,
+			Host:           w.Host,
+			CpuUsage:       w.CPUUsage,
+			MemoryUsage:    w.MemoryUsage,
+			RelayDiskUsage: w.RelayDiskUsage,
+			TaskQps:        w.TaskQPS,
+			Score:          w.Score,
+		}
+		if !w.UpdateTime.IsZero() {
+			updateTime := w.UpdateTime
+			load.UpdateTime = &updateTime
+		}
+		resp.Workers = append(resp.Workers, load)
+	}
+	for _, m := range plan.Moves {
+		resp.Moves = append(resp.Moves, openapi.RebalanceMove{
+			SourceName: m.Source,
+			FromWorker: m.FromWorker,
+			ToWorker:   m.ToWorker,
+			Score:      m.Score,
+		})
+	}
+	return resp
+}
+
 // DMAPICreateSource url is:(POST /api/v1/sources).
 func (s *Server) DMAPICreateSource(c *gin.Context) {
 	var createSourceReq openapi.Source
@@ -611,6 +668,502 @@ func (s *Server) DMAPIResumeTask(c *gin.Context, taskName string) {
 	}
 }
 
+// DMAPISetTaskRateLimit change the rate limit of task url is: (PUT /api/v1/tasks/{task-name}/rate-limit).
+func (s *Server) DMAPISetTaskRateLimit(c *gin.Context, taskName string) {
+	var req openapi.SetRateLimitRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	var sourceNameList []string
+	if req.SourceNameList != nil {
+		sourceNameList = *req.SourceNameList
+	}
+	if len(sourceNameList) == 0 {
+		sourceNameList = s.getTaskResources(taskName)
+	}
+	if len(sourceNameList) == 0 {
+		_ = c.Error(terror.ErrSchedulerTaskNotExist.Generate(taskName))
+		return
+	}
+	for _, sourceName := range sourceNameList {
+		worker := s.scheduler.GetWorkerBySource(sourceName)
+		if worker == nil {
+			_ = c.Error(terror.ErrWorkerNoStart)
+			return
+		}
+		workerReq := workerrpc.Request{
+			Type: workerrpc.CmdSetRateLimit,
+			SetRateLimit: &pb.SetRateLimitRequest{
+				Task:           taskName,
+				RowsPerSecond:  req.RowsPerSecond,
+				BytesPerSecond: req.BytesPerSecond,
+			},
+		}
+		resp, err := worker.SendRequest(c.Request.Context(), &workerReq, s.cfg.RPCTimeout)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+		if !resp.SetRateLimit.Result {
+			_ = c.Error(terror.ErrOpenAPICommonError.Generatef("failed to set rate limit of source %s: %s", sourceName, resp.SetRateLimit.Msg))
+			return
+		}
+	}
+}
+
+// DMAPISetTaskPriority change the priority of task url is: (PUT /api/v1/tasks/{task-name}/priority).
+func (s *Server) DMAPISetTaskPriority(c *gin.Context, taskName string) {
+	var req openapi.SetTaskPriorityRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	priority := string(req.Priority)
+	if err := config.AdjustTaskPriority(&priority); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	subTaskCfgM := s.scheduler.GetSubTaskCfgsByTask(taskName)
+	if len(subTaskCfgM) == 0 {
+		_ = c.Error(terror.ErrSchedulerTaskNotExist.Generate(taskName))
+		return
+	}
+
+	// persist the priority in the subtask configs, the scheduler binds the unbound sources by it and the subtasks
+	// restarted later get it.
+	subTaskCfgs := make([]config.SubTaskConfig, 0, len(subTaskCfgM))
+	for _, subTaskCfg := range subTaskCfgM {
+		subTaskCfg.Priority = priority
+		subTaskCfgs = append(subTaskCfgs, *subTaskCfg)
+	}
+	if err := s.scheduler.UpdateSubTaskCfgs(subTaskCfgs...); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	for _, subTaskCfg := range subTaskCfgs {
+		worker := s.scheduler.GetWorkerBySource(subTaskCfg.SourceID)
+		if worker == nil {
+			// the source is not bound yet, the subtask gets the priority from its config when it's started.
+			continue
+		}
+		workerReq := workerrpc.Request{
+			Type:            workerrpc.CmdSetTaskPriority,
+			SetTaskPriority: &pb.SetTaskPriorityRequest{Task: taskName, Priority: priority},
+		}
+		resp, err := worker.SendRequest(c.Request.Context(), &workerReq, s.cfg.RPCTimeout)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+		if !resp.SetTaskPriority.Result {
+			_ = c.Error(terror.ErrOpenAPICommonError.Generatef("failed to set priority of source %s: %s", subTaskCfg.SourceID, resp.SetTaskPriority.Msg))
+			return
+		}
+	}
+}
+
+// DMAPIUpdateTaskRules hot reloads the table migrate rules and binlog filter rules of task url is: (PUT /api/v1/tasks/{task-name}/rules).
+func (s *Server) DMAPIUpdateTaskRules(c *gin.Context, taskName string) {
+	var req openapi.UpdateTaskRulesRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	subTaskCfgM := s.scheduler.GetSubTaskCfgsByTask(taskName)
+	if len(subTaskCfgM) == 0 {
+		_ = c.Error(terror.ErrSchedulerTaskNotExist.Generate(taskName))
+		return
+	}
+	var sourceNameList []string
+	if req.SourceNameList != nil {
+		sourceNameList = *req.SourceNameList
+	}
+	if len(sourceNameList) == 0 {
+		sourceNameList = s.getTaskResources(taskName)
+	}
+	var binlogFilterRules map[string]openapi.TaskBinLogFilterRule
+	if req.BinlogFilterRule != nil {
+		binlogFilterRules = req.BinlogFilterRule.AdditionalProperties
+	}
+	rulesMap, err := config.OpenAPIRulesToSubTaskRules(sourceNameList, req.TableMigrateRule, binlogFilterRules)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	// update the rules in the subtask configs and persist them, so the rules still take effect after the subtasks are
+	// restarted even if the DM-workers fail to hot reload them.
+	subTaskCfgs := make([]config.SubTaskConfig, 0, len(sourceNameList))
+	for _, sourceName := range sourceNameList {
+		subTaskCfg, ok := subTaskCfgM[sourceName]
+		if !ok {
+			_ = c.Error(terror.ErrSchedulerSubTaskOpSourceNotExist.Generate([]string{sourceName}))
+			return
+		}
+		if subTaskCfg.ShardMode != "" {
+			_ = c.Error(terror.ErrSyncerRulesNotHotReloadable.Generate(subTaskCfg.ShardMode))
+			return
+		}
+		rules := rulesMap[sourceName]
+		subTaskCfg.BAList = rules.BAList
+		subTaskCfg.BWList = nil
+		subTaskCfg.RouteRules = rules.RouteRules
+		subTaskCfg.FilterRules = rules.FilterRules
+		subTaskCfg.RulesVersion++
+		if err = subTaskCfg.Adjust(true); err != nil {
+			_ = c.Error(terror.Annotatef(err, "source name %s", sourceName))
+			return
+		}
+		subTaskCfgs = append(subTaskCfgs, *subTaskCfg)
+	}
+	if err = s.scheduler.UpdateSubTaskCfgs(subTaskCfgs...); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	for _, subTaskCfg := range subTaskCfgs {
+		worker := s.scheduler.GetWorkerBySource(subTaskCfg.SourceID)
+		if worker == nil {
+			_ = c.Error(terror.ErrWorkerNoStart)
+			return
+		}
+		cfgStr, err2 := subTaskCfg.Toml()
+		if err2 != nil {
+			_ = c.Error(err2)
+			return
+		}
+		workerReq := workerrpc.Request{
+			Type:               workerrpc.CmdUpdateSubTaskRules,
+			UpdateSubTaskRules: &pb.UpdateSubTaskRulesRequest{Task: taskName, SubtaskCfg: cfgStr},
+		}
+		resp, err2 := worker.SendRequest(c.Request.Context(), &workerReq, s.cfg.RPCTimeout)
+		if err2 != nil {
+			_ = c.Error(err2)
+			return
+		}
+		if !resp.UpdateSubTaskRules.Result {
+			_ = c.Error(terror.ErrOpenAPICommonError.Generatef("failed to update rules of source %s: %s", subTaskCfg.SourceID, resp.UpdateSubTaskRules.Msg))
+			return
+		}
+	}
+}
+
+// DMAPIGetShardDDLLockList get the optimistic shard DDL locks of task url is: (GET /api/v1/tasks/{task-name}/shard-ddl-locks).
+func (s *Server) DMAPIGetShardDDLLockList(c *gin.Context, taskName string) {
+	if len(s.getTaskResources(taskName)) == 0 {
+		_ = c.Error(terror.ErrSchedulerTaskNotExist.Generate(taskName))
+		return
+	}
+	inspections := s.optimist.InspectLocks(taskName)
+	lockList := make([]openapi.ShardDDLLock, 0, len(inspections))
+	for _, inspection := range inspections {
+		lockList = append(lockList, shardDDLLockToOpenAPI(inspection))
+	}
+	resp := openapi.GetShardDDLLockListResponse{Total: len(lockList), Data: lockList}
+	c.IndentedJSON(http.StatusOK, resp)
+}
+
+// DMAPIResolveShardDDLLock resolve the conflicts of an optimistic shard DDL lock url is: (POST /api/v1/tasks/{task-name}/shard-ddl-locks/resolve).
+func (s *Server) DMAPIResolveShardDDLLock(c *gin.Context, taskName string) {
+	var req openapi.ResolveShardDDLLockRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if utils.ExtractTaskFromLockID(req.LockId) != taskName {
+		_ = c.Error(terror.ErrMasterLockNotFound.Generate(req.LockId))
+		return
+	}
+	inspection, err := s.optimist.ResolveLockConflict(req.LockId, req.SourceName, req.SchemaName, req.TableName)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.IndentedJSON(http.StatusOK, shardDDLLockToOpenAPI(inspection))
+}
+
+// DMAPIApproveDDL approve the DDLs waiting for approval url is: (POST /api/v1/tasks/{task-name}/sources/{source-name}/approve-ddl).
+func (s *Server) DMAPIApproveDDL(c *gin.Context, taskName string, sourceName string) {
+	var req openapi.ApproveDDLRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	var binlogPos string
+	if req.BinlogPos != nil {
+		binlogPos = *req.BinlogPos
+	}
+	workerReq := &pb.HandleWorkerErrorRequest{
+		Op:        pb.ErrorOp_Approve,
+		Task:      taskName,
+		BinlogPos: binlogPos,
+	}
+	if _, err := s.sendHandleErrorRequest(c.Request.Context(), sourceName, workerReq); err != nil {
+		_ = c.Error(err)
+	}
+}
+
+// DMAPIGetBinlogOperations get the current error and the binlog operations url is: (GET /api/v1/tasks/{task-name}/sources/{source-name}/binlog-operations).
+func (s *Server) DMAPIGetBinlogOperations(c *gin.Context, taskName string, sourceName string) {
+	workerReq := &pb.HandleWorkerErrorRequest{
+		Op:   pb.ErrorOp_Show,
+		Task: taskName,
+	}
+	msg, err := s.sendHandleErrorRequest(c.Request.Context(), sourceName, workerReq)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	var resp openapi.GetBinlogOperationsResponse
+	if err := json.Unmarshal([]byte(msg), &resp); err != nil {
+		_ = c.Error(terror.ErrOpenAPICommonError.Delegate(err, "failed to unmarshal binlog operations %s", msg))
+		return
+	}
+	c.IndentedJSON(http.StatusOK, resp)
+}
+
+// DMAPICreateBinlogOperation handle the error event or a specific binlog position event url is: (POST /api/v1/tasks/{task-name}/sources/{source-name}/binlog-operations).
+func (s *Server) DMAPICreateBinlogOperation(c *gin.Context, taskName string, sourceName string) {
+	var req openapi.BinlogOperationRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	op, ok := openapiBinlogOps[req.Op]
+	if !ok {
+		_ = c.Error(terror.ErrOpenAPICommonError.Generatef("invalid operation '%s'", req.Op))
+		return
+	}
+	var sqls []string
+	if req.Sqls != nil {
+		sqls = *req.Sqls
+	}
+	needSQLs := op == pb.ErrorOp_Replace || op == pb.ErrorOp_Inject
+	if needSQLs && len(sqls) == 0 {
+		_ = c.Error(terror.ErrOpenAPICommonError.Generatef("must specify the sqls for %s operation", req.Op))
+		return
+	}
+	if !needSQLs && len(sqls) > 0 {
+		_ = c.Error(terror.ErrOpenAPICommonError.Generatef("sqls can not be used for %s operation", req.Op))
+		return
+	}
+	var binlogPos string
+	if req.BinlogPos != nil {
+		binlogPos = *req.BinlogPos
+	}
+	workerReq := &pb.HandleWorkerErrorRequest{
+		Op:        op,
+		Task:      taskName,
+		BinlogPos: binlogPos,
+		Sqls:      sqls,
+	}
+	if _, err := s.sendHandleErrorRequest(c.Request.Context(), sourceName, workerReq); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Status(http.StatusCreated)
+}
+
+// openapiBinlogOps maps the operations of OpenAPI to the handle-error operations.
+var openapiBinlogOps = map[openapi.BinlogOperationRequestOp]pb.ErrorOp{
+	openapi.BinlogOperationRequestOpSkip:    pb.ErrorOp_Skip,
+	openapi.BinlogOperationRequestOpReplace: pb.ErrorOp_Replace,
+	openapi.BinlogOperationRequestOpInject:  pb.ErrorOp_Inject,
+	openapi.BinlogOperationRequestOpRevert:  pb.ErrorOp_Revert,
+	openapi.BinlogOperationRequestOpApprove: pb.ErrorOp_Approve,
+}
+
+// sendHandleErrorRequest sends the handle-error request to the worker of the source and returns the message of the response.
+func (s *Server) sendHandleErrorRequest(ctx context.Context, sourceName string, req *pb.HandleWorkerErrorRequest) (string, error) {
+	if req.BinlogPos != "" {
+		if _, err := binlog.VerifyBinlogPos(req.BinlogPos); err != nil {
+			return "", err
+		}
+	}
+	worker := s.scheduler.GetWorkerBySource(sourceName)
+	if worker == nil {
+		return "", terror.ErrWorkerNoStart
+	}
+	workerReq := workerrpc.Request{
+		Type:        workerrpc.CmdHandleError,
+		HandleError: req,
+	}
+	resp, err := worker.SendRequest(ctx, &workerReq, s.cfg.RPCTimeout)
+	if err != nil {
+		return "", err
+	}
+	if !resp.HandleError.Result {
+		return "", terror.ErrOpenAPICommonError.New(resp.HandleError.Msg)
+	}
+	return resp.HandleError.Msg, nil
+}
+
+// DMAPIGetSkipGTIDs get the GTIDs of the transactions to skip url is: (GET /api/v1/tasks/{task-name}/sources/{source-name}/skip-gtids).
+func (s *Server) DMAPIGetSkipGTIDs(c *gin.Context, taskName string, sourceName string) {
+	s.operateSkipGTIDs(c, sourceName, &pb.OperateSkipGTIDRequest{Op: pb.SkipGTIDOp_ListSkipGTID, Task: taskName})
+}
+
+// DMAPIOperateSkipGTIDs add or remove the GTIDs of the transactions to skip url is: (POST /api/v1/tasks/{task-name}/sources/{source-name}/skip-gtids).
+func (s *Server) DMAPIOperateSkipGTIDs(c *gin.Context, taskName string, sourceName string) {
+	var req openapi.SkipGTIDsRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	var op pb.SkipGTIDOp
+	switch req.Op {
+	case openapi.SkipGTIDsRequestOpAdd:
+		op = pb.SkipGTIDOp_AddSkipGTID
+	case openapi.SkipGTIDsRequestOpRemove:
+		op = pb.SkipGTIDOp_RemoveSkipGTID
+	default:
+		_ = c.Error(terror.ErrOpenAPICommonError.Generatef("invalid operation '%s'", req.Op))
+		return
+	}
+	if req.GtidSet == "" {
+		_ = c.Error(terror.ErrOpenAPICommonError.Generatef("must specify the gtid_set for %s operation", req.Op))
+		return
+	}
+	s.operateSkipGTIDs(c, sourceName, &pb.OperateSkipGTIDRequest{Op: op, Task: taskName, GtidSet: req.GtidSet})
+}
+
+func (s *Server) operateSkipGTIDs(c *gin.Context, sourceName string, req *pb.OperateSkipGTIDRequest) {
+	worker := s.scheduler.GetWorkerBySource(sourceName)
+	if worker == nil {
+		_ = c.Error(terror.ErrWorkerNoStart)
+		return
+	}
+	workerReq := workerrpc.Request{
+		Type:            workerrpc.CmdOperateSkipGTID,
+		OperateSkipGTID: req,
+	}
+	resp, err := worker.SendRequest(c.Request.Context(), &workerReq, s.cfg.RPCTimeout)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if !resp.OperateSkipGTID.Result {
+		_ = c.Error(terror.ErrOpenAPICommonError.New(resp.OperateSkipGTID.Msg))
+		return
+	}
+	c.IndentedJSON(http.StatusOK, openapi.SkipGTIDsResponse{GtidSet: resp.OperateSkipGTID.Msg})
+}
+
+// DMAPIGetPausedTables get the paused upstream tables of task source url is: (GET /api/v1/tasks/{task-name}/sources/{source-name}/paused-tables).
+func (s *Server) DMAPIGetPausedTables(c *gin.Context, taskName string, sourceName string) {
+	s.operatePausedTables(c, sourceName, &pb.OperatePausedTableRequest{Op: pb.PauseTableOp_ListPausedTable, Task: taskName})
+}
+
+// DMAPIOperatePausedTables pause or resume the upstream tables of task source url is: (POST /api/v1/tasks/{task-name}/sources/{source-name}/paused-tables).
+func (s *Server) DMAPIOperatePausedTables(c *gin.Context, taskName string, sourceName string) {
+	var req openapi.PausedTablesRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if len(req.Tables) == 0 {
+		_ = c.Error(terror.ErrOpenAPICommonError.Generatef("must specify the tables for %s operation", req.Op))
+		return
+	}
+	subTaskCfg, ok := s.scheduler.GetSubTaskCfgsByTask(taskName)[sourceName]
+	if !ok {
+		_ = c.Error(terror.ErrSchedulerSubTaskOpSourceNotExist.Generate([]string{sourceName}))
+		return
+	}
+	if subTaskCfg.ShardMode != "" {
+		_ = c.Error(terror.ErrSyncerPauseTableNotSupported.Generate(subTaskCfg.ShardMode))
+		return
+	}
+
+	// update the paused tables in the subtask config and persist it, so the tables are still paused or resumed after
+	// the subtask is restarted.
+	tableIDs := make([]string, 0, len(req.Tables))
+	operated := make(map[string]struct{}, len(req.Tables))
+	for _, t := range req.Tables {
+		tableID := utils.GenTableID(&filter.Table{Schema: t.SchemaName, Name: t.TableName})
+		tableIDs = append(tableIDs, tableID)
+		operated[tableID] = struct{}{}
+	}
+	pausedTables := make([]*config.PausedTable, 0, len(subTaskCfg.PausedTables)+len(req.Tables))
+	var (
+		op     pb.PauseTableOp
+		policy config.PauseTablePolicy
+	)
+	switch req.Op {
+	case openapi.PausedTablesRequestOpPause:
+		op = pb.PauseTableOp_PauseTable
+		policy = config.PauseTablePolicyBuffer
+		if req.Policy != nil {
+			policy = config.PauseTablePolicy(*req.Policy)
+		}
+		for _, pt := range subTaskCfg.PausedTables {
+			tableID := utils.GenTableID(&filter.Table{Schema: pt.Schema, Name: pt.Table})
+			if _, ok = operated[tableID]; ok && pt.Policy != policy {
+				_ = c.Error(terror.ErrSyncerTableAlreadyPaused.Generate(tableID, pt.Policy))
+				return
+			}
+			delete(operated, tableID)
+			clone := *pt
+			pausedTables = append(pausedTables, &clone)
+		}
+		for _, t := range req.Tables {
+			if _, ok = operated[utils.GenTableID(&filter.Table{Schema: t.SchemaName, Name: t.TableName})]; ok {
+				pausedTables = append(pausedTables, &config.PausedTable{Schema: t.SchemaName, Table: t.TableName, Policy: policy})
+			}
+		}
+	case openapi.PausedTablesRequestOpResume:
+		op = pb.PauseTableOp_ResumeTable
+		for _, pt := range subTaskCfg.PausedTables {
+			if _, ok = operated[utils.GenTableID(&filter.Table{Schema: pt.Schema, Name: pt.Table})]; !ok {
+				clone := *pt
+				pausedTables = append(pausedTables, &clone)
+			}
+		}
+	default:
+		_ = c.Error(terror.ErrOpenAPICommonError.Generatef("invalid operation '%s'", req.Op))
+		return
+	}
+	subTaskCfg.PausedTables = pausedTables
+	if err := subTaskCfg.Adjust(true); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if err := s.scheduler.UpdateSubTaskCfgs(*subTaskCfg); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	s.operatePausedTables(c, sourceName, &pb.OperatePausedTableRequest{Op: op, Task: taskName, Tables: tableIDs, Policy: string(policy)})
+}
+
+func (s *Server) operatePausedTables(c *gin.Context, sourceName string, req *pb.OperatePausedTableRequest) {
+	worker := s.scheduler.GetWorkerBySource(sourceName)
+	if worker == nil {
+		_ = c.Error(terror.ErrWorkerNoStart)
+		return
+	}
+	workerReq := workerrpc.Request{
+		Type:               workerrpc.CmdOperatePausedTable,
+		OperatePausedTable: req,
+	}
+	resp, err := worker.SendRequest(c.Request.Context(), &workerReq, s.cfg.RPCTimeout)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if !resp.OperatePausedTable.Result {
+		_ = c.Error(terror.ErrOpenAPICommonError.New(resp.OperatePausedTable.Msg))
+		return
+	}
+	var pausedTables []openapi.PausedTable
+	if err = json.Unmarshal([]byte(resp.OperatePausedTable.Msg), &pausedTables); err != nil {
+		_ = c.Error(terror.ErrOpenAPICommonError.Delegate(err, "failed to unmarshal paused tables %s", resp.OperatePausedTable.Msg))
+		return
+	}
+	c.IndentedJSON(http.StatusOK, openapi.GetPausedTablesResponse{Total: len(pausedTables), Data: pausedTables})
+}
+
 // DMAPIGetSchemaListByTaskAndSource get task source schema list url is: (GET /api/v1/tasks/{task-name}/sources/{source-name}/schemas).
 func (s *Server) DMAPIGetSchemaListByTaskAndSource(c *gin.Context, taskName string, sourceName string) {
 	worker := s.scheduler.GetWorkerBySource(sourceName)
@@ -908,6 +1461,145 @@ func (s *Server) DMAPIGetClusterInfo(c *gin.Context) {
 	c.IndentedJSON(http.StatusOK, r)
 }
 
+// DMAPIGetAuditLogList url is:(GET /api/v1/cluster/audit-logs).
+func (s *Server) DMAPIGetAuditLogList(c *gin.Context, params openapi.DMAPIGetAuditLogListParams) {
+	var start, end time.Time
+	if params.StartTime != nil {
+		start = *params.StartTime
+	}
+	if params.EndTime != nil {
+		end = *params.EndTime
+	}
+	logs, _, err := ha.GetAuditLogs(s.etcdClient, start, end)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	auditLogs := make([]openapi.AuditLog, 0, len(logs))
+	for _, l := range logs {
+		if params.Operation != nil && l.Operation != *params.Operation {
+			continue
+		}
+		if params.User != nil && l.User != *params.User {
+			continue
+		}
+		auditLog := openapi.AuditLog{
+			Time:      l.Time,
+			Client:    l.Client,
+			Api:       openapi.AuditLogApi(l.API),
+			Operation: l.Operation,
+			Params:    l.Params,
+			Result:    l.Result,
+		}
+		if l.User != "" {
+			user := l.User
+			auditLog.User = &user
+		}
+		if l.Error != "" {
+			errMsg := l.Error
+			auditLog.Error = &errMsg
+		}
+		auditLogs = append(auditLogs, auditLog)
+	}
+	// keep the latest ones.
+	if params.Limit != nil && *params.Limit > 0 && len(auditLogs) > *params.Limit {
+		auditLogs = auditLogs[len(auditLogs)-*params.Limit:]
+	}
+	resp := openapi.GetAuditLogListResponse{Total: len(auditLogs), Data: auditLogs}
+	c.IndentedJSON(http.StatusOK, resp)
+}
+
+// DMAPICreateWebhook url is:(POST /api/v1/cluster/webhooks).
+func (s *Server) DMAPICreateWebhook(c *gin.Context) {
+	var req openapi.CreateWebhookRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	w := ha.Webhook{Name: req.Name, URL: req.Url}
+	if req.Secret != nil {
+		w.Secret = *req.Secret
+	}
+	if req.Events != nil {
+		for _, ev := range *req.Events {
+			w.Events = append(w.Events, string(ev))
+		}
+	}
+	if err := adjustWebhook(&w); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if _, err := ha.PutWebhook(s.etcdClient, w); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.IndentedJSON(http.StatusCreated, webhookToOpenAPI(w))
+}
+
+// DMAPIGetWebhookList url is:(GET /api/v1/cluster/webhooks).
+func (s *Server) DMAPIGetWebhookList(c *gin.Context) {
+	hooks, _, err := ha.GetAllWebhooks(s.etcdClient)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	webhookList := make([]openapi.Webhook, 0, len(hooks))
+	for _, w := range hooks {
+		webhookList = append(webhookList, webhookToOpenAPI(w))
+	}
+	resp := openapi.GetWebhookListResponse{Total: len(webhookList), Data: webhookList}
+	c.IndentedJSON(http.StatusOK, resp)
+}
+
+// DMAPIDeleteWebhook url is:(DELETE /api/v1/cluster/webhooks/{webhook-name}).
+func (s *Server) DMAPIDeleteWebhook(c *gin.Context, webhookName string) {
+	if _, err := ha.DeleteWebhook(s.etcdClient, webhookName); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// webhookToOpenAPI converts the webhook to the OpenAPI model with the secret hidden.
+func webhookToOpenAPI(w ha.Webhook) openapi.Webhook {
+	events := make([]openapi.WebhookEvent, 0, len(w.Events))
+	for _, ev := range w.Events {
+		events = append(events, openapi.WebhookEvent(ev))
+	}
+	return openapi.Webhook{Name: w.Name, Url: w.URL, Signed: w.Secret != "", Events: events}
+}
+
+// DMAPIGetClusterStatus url is:(GET /api/v1/cluster/status).
+func (s *Server) DMAPIGetClusterStatus(c *gin.Context) {
+	// get source list for all task
+	taskSources := make(map[string][]string)
+	sourceNameM := make(map[string]struct{}) // use map to avoid duplicate source name
+	for task, cfgM := range s.scheduler.GetSubTaskCfgs() {
+		for source := range cfgM {
+			taskSources[task] = append(taskSources[task], source)
+			sourceNameM[source] = struct{}{}
+		}
+	}
+	var workerStatusList []*pb.QueryStatusResponse
+	if len(sourceNameM) > 0 {
+		workerStatusList = s.getStatusFromWorkers(c.Request.Context(), utils.SetToSlice(sourceNameM), "", false)
+	}
+
+	memberWorkers := s.listMemberWorker(nil)
+	if memberWorkers.Worker.Msg != "" {
+		_ = c.Error(terror.ErrOpenAPICommonError.New(memberWorkers.Worker.Msg))
+		return
+	}
+	loads, err := ha.GetAllWorkerLoads(s.etcdClient)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	resp := aggregateClusterStatus(taskSources, workerStatusList, memberWorkers.Worker.Workers, loads)
+	c.IndentedJSON(http.StatusOK, resp)
+}
+
 func terrorHTTPErrorHandler() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Next()
@@ -969,6 +1661,7 @@ func getOpenAPISubtaskStatusByTaskName(taskName string,
 				Progress:       loadS.Progress,
 				TotalBytes:     loadS.TotalBytes,
 			}
+			fillLoadProgress(openapiSubTaskStatus.LoadStatus, loadS)
 		}
 		// add sync status
 		if syncerS := subTaskStatus.GetSync(); syncerS != nil {
@@ -985,6 +1678,9 @@ func getOpenAPISubtaskStatusByTaskName(taskName string,
 				TotalEvents:         syncerS.TotalEvents,
 				TotalTps:            syncerS.TotalTps,
 			}
+			if dmlType := syncerS.GetDmlType(); dmlType != "" {
+				openapiSubTaskStatus.SyncStatus.DmlType = &dmlType
+			}
 			if unResolvedGroups := syncerS.GetUnresolvedGroups(); len(unResolvedGroups) > 0 {
 				openapiSubTaskStatus.SyncStatus.UnresolvedGroups = make([]openapi.ShardingGroup, len(unResolvedGroups))
 				for i, unResolvedGroup := range unResolvedGroups {
@@ -1012,3 +1708,80 @@ func getOpenAPISubtaskStatusByTaskName(taskName string,
 	}
 	return subTaskStatusList, nil
 }
+
+// fillLoadProgress fills the progress of load unit, the chunks and tables are only reported by import-mode `loader`.
+func fillLoadProgress(status *openapi.LoadStatus, loadS *pb.LoadStatus) {
+	bytesPerSecond, rowsPerSecond, remainingSeconds := loadS.BytesPerSecond, loadS.RowsPerSecond, loadS.RemainingSeconds
+	status.BytesPerSecond = &bytesPerSecond
+	status.RowsPerSecond = &rowsPerSecond
+	status.RemainingSeconds = &remainingSeconds
+	if remainingSeconds > 0 {
+		finishTime := time.Now().Add(time.Duration(remainingSeconds) * time.Second).Format(time.RFC3339)
+		status.EstimatedFinishTime = &finishTime
+	}
+	if loadS.Bottleneck != "" {
+		bottleneck := openapi.LoadStatusBottleneck(loadS.Bottleneck)
+		status.Bottleneck = &bottleneck
+	}
+	if loadS.BadRows > 0 {
+		badRows := loadS.BadRows
+		status.BadRows = &badRows
+	}
+	if loadS.TotalChunks > 0 {
+		finishedChunks, totalChunks := loadS.FinishedChunks, loadS.TotalChunks
+		status.FinishedChunks = &finishedChunks
+		status.TotalChunks = &totalChunks
+	}
+	if len(loadS.Tables) > 0 {
+		tables := make([]openapi.LoadTableProgress, 0, len(loadS.Tables))
+		for _, t := range loadS.Tables {
+			tables = append(tables, openapi.LoadTableProgress{
+				Schema:         t.Schema,
+				Table:          t.Table,
+				FinishedChunks: t.FinishedChunks,
+				TotalChunks:    t.TotalChunks,
+				FinishedBytes:  t.FinishedBytes,
+				TotalBytes:     t.TotalBytes,
+			})
+		}
+		status.Tables = &tables
+	}
+}
+
+func shardDDLLockToOpenAPI(inspection optimism.LockInspection) openapi.ShardDDLLock {
+	lock := openapi.ShardDDLLock{
+		LockId:          inspection.ID,
+		JoinedSchema:    inspection.Joined.String(),
+		Tables:          make([]openapi.ShardDDLLockTable, 0, len(inspection.Tables)),
+		ConflictColumns: make([]openapi.ShardDDLConflictColumn, 0, len(inspection.ConflictColumns)),
+	}
+	for _, ts := range inspection.Tables {
+		table := openapi.ShardDDLLockTable{
+			SourceName:    ts.Source,
+			SchemaName:    ts.Schema,
+			TableName:     ts.Table,
+			TrackedSchema: ts.Tracked.String(),
+		}
+		if ts.Conflict != nil {
+			conflictSchema := ts.Conflict.String()
+			table.ConflictSchema = &conflictSchema
+		}
+		lock.Tables = append(lock.Tables, table)
+	}
+	for _, col := range inspection.ConflictColumns {
+		conflictColumn := openapi.ShardDDLConflictColumn{
+			ColumnName:  col.Name,
+			ColumnTypes: make([]openapi.ShardDDLConflictColumnType, 0, len(col.Types)),
+		}
+		for _, tp := range col.Types {
+			conflictColumn.ColumnTypes = append(conflictColumn.ColumnTypes, openapi.ShardDDLConflictColumnType{
+				SourceName: tp.Source,
+				SchemaName: tp.Schema,
+				TableName:  tp.Table,
+				ColumnType: tp.Type,
+			})
+		}
+		lock.ConflictColumns = append(lock.ConflictColumns, conflictColumn)
+	}
+	return lock
+}
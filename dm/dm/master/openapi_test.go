@@ -303,7 +303,7 @@ func (t *openAPISuite) TestRelayAPI(c *check.C) {
 	ctx1, cancel1 := context.WithCancel(ctx)
 	defer cancel1()
 	workerName1 := "worker1"
-	c.Assert(s.scheduler.AddWorker(workerName1, "172.16.10.72:8262"), check.IsNil)
+	c.Assert(s.scheduler.AddWorker(workerName1, "172.16.10.72:8262", nil), check.IsNil)
 	go func(ctx context.Context, workerName string) {
 		c.Assert(ha.KeepAlive(ctx, s.etcdClient, workerName, keepAliveTTL), check.IsNil)
 	}(ctx1, workerName1)
@@ -455,7 +455,7 @@ func (t *openAPISuite) TestTaskAPI(c *check.C) {
 	ctx1, cancel1 := context.WithCancel(ctx)
 	defer cancel1()
 	workerName1 := "worker-1"
-	c.Assert(s.scheduler.AddWorker(workerName1, "172.16.10.72:8262"), check.IsNil)
+	c.Assert(s.scheduler.AddWorker(workerName1, "172.16.10.72:8262", nil), check.IsNil)
 	go func(ctx context.Context, workerName string) {
 		c.Assert(ha.KeepAlive(ctx, s.etcdClient, workerName, keepAliveTTL), check.IsNil)
 	}(ctx1, workerName1)
@@ -682,7 +682,7 @@ func (t *openAPISuite) TestClusterAPI(c *check.C) {
 	c.Assert(resultMasters.Total, check.Equals, 1)
 
 	workerName1 := "worker1"
-	c.Assert(s1.scheduler.AddWorker(workerName1, "172.16.10.72:8262"), check.IsNil)
+	c.Assert(s1.scheduler.AddWorker(workerName1, "172.16.10.72:8262", nil), check.IsNil)
 	// list worker node
 	workerURL := baseURL + "workers"
 	result = testutil.NewRequest().Get(workerURL).GoWithHTTPHandler(t.testT, s1.openapiHandles)
@@ -691,6 +691,17 @@ func (t *openAPISuite) TestClusterAPI(c *check.C) {
 	c.Assert(err, check.IsNil)
 	c.Assert(resultWorkers.Total, check.Equals, 1)
 
+	// the offline worker node is not in the rebalancing plan
+	rebalanceURL := baseURL + "rebalance"
+	result = testutil.NewRequest().Get(rebalanceURL).GoWithHTTPHandler(t.testT, s1.openapiHandles)
+	c.Assert(result.Code(), check.Equals, http.StatusOK)
+	var plan openapi.RebalancePlan
+	c.Assert(result.UnmarshalBodyToObject(&plan), check.IsNil)
+	c.Assert(plan.Workers, check.HasLen, 0)
+	c.Assert(plan.Moves, check.HasLen, 0)
+	result = testutil.NewRequest().Post(rebalanceURL).GoWithHTTPHandler(t.testT, s1.openapiHandles)
+	c.Assert(result.Code(), check.Equals, http.StatusOK)
+
 	// offline worker-1
 	result = testutil.NewRequest().Delete(fmt.Sprintf("%s/%s", workerURL, workerName1)).GoWithHTTPHandler(t.testT, s1.openapiHandles)
 	c.Assert(result.Code(), check.Equals, http.StatusNoContent)
@@ -0,0 +1,72 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+// matchAffinity returns whether the worker satisfies the required and excluded labels in the worker affinity of the
+// source. the sources without config match all workers.
+// caller should hold the lock of the scheduler.
+func (s *Scheduler) matchAffinity(source string, w *Worker) bool {
+	cfg, ok := s.sourceCfgs[source]
+	if !ok {
+		return true
+	}
+	return cfg.Affinity.Match(w.Labels())
+}
+
+// preferredScore returns the number of the preferred labels in the worker affinity of the source the worker has.
+// caller should hold the lock of the scheduler.
+func (s *Scheduler) preferredScore(source string, w *Worker) int {
+	cfg, ok := s.sourceCfgs[source]
+	if !ok {
+		return 0
+	}
+	return cfg.Affinity.PreferredScore(w.Labels())
+}
+
+// pickUnboundSource returns an unbound source whose worker affinity is matched by the worker, the sources of the tasks
+// with higher priorities are picked first, and then the sources which prefer the worker more. it returns empty string
+// if no source matched.
+// caller should hold the lock of the scheduler.
+func (s *Scheduler) pickUnboundSource(w *Worker) string {
+	var (
+		picked       string
+		bestPriority = -1
+		bestScore    = -1
+	)
+	for source := range s.unbounds {
+		if !s.matchAffinity(source, w) {
+			continue
+		}
+		priority, score := s.sourcePriorityLevel(source), s.preferredScore(source, w)
+		switch {
+		case priority > bestPriority,
+			priority == bestPriority && score > bestScore,
+			priority == bestPriority && score == bestScore && source < picked:
+			picked, bestPriority, bestScore = source, priority, score
+		}
+	}
+	return picked
+}
+
+func labelsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
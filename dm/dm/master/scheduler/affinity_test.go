@@ -0,0 +1,105 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/ha"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+func (t *testScheduler) TestWorkerAffinity(c *C) {
+	defer clearTestInfoOperation(c)
+
+	var (
+		logger  = log.L()
+		s       = NewScheduler(&logger, config.Security{})
+		ctx     = context.Background()
+		sources = []string{"mysql-replica-1", "mysql-replica-2", "mysql-replica-3"}
+		labels  = []map[string]string{
+			{"zone": "zone-1", "tier": "ssd"},
+			{"zone": "zone-2", "tier": "ssd"},
+			{"zone": "zone-1", "tier": "hdd"},
+		}
+		workers = make([]*Worker, 0, len(labels))
+	)
+	s.started.Store(true)
+	s.etcdCli = etcdTestCli
+	for i, addr := range []string{"127.0.0.1:8262", "127.0.0.1:8263", "127.0.0.1:8264"} {
+		name := fmt.Sprintf("dm-worker-%d", i+1)
+		c.Assert(s.AddWorker(name, addr, labels[i]), IsNil)
+		w := s.workers[name]
+		w.ToFree()
+		workers = append(workers, w)
+	}
+	s.sourceCfgs[sources[0]] = &config.SourceConfig{SourceID: sources[0], Affinity: config.WorkerAffinity{
+		Required:  map[string]string{"tier": "ssd"},
+		Preferred: map[string]string{"zone": "zone-1"},
+	}}
+	s.sourceCfgs[sources[1]] = &config.SourceConfig{SourceID: sources[1], Affinity: config.WorkerAffinity{
+		Excluded: map[string]string{"zone": "zone-1"},
+	}}
+	s.sourceCfgs[sources[2]] = &config.SourceConfig{SourceID: sources[2]}
+
+	// the labels are persisted and can be updated when the worker registers again.
+	infos, _, err := ha.GetAllWorkerInfo(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(infos["dm-worker-1"].Labels, DeepEquals, labels[0])
+	c.Assert(s.AddWorker("dm-worker-1", "127.0.0.1:8262", map[string]string{"zone": "zone-1"}), IsNil)
+	c.Assert(workers[0].Labels(), DeepEquals, map[string]string{"zone": "zone-1"})
+	c.Assert(s.AddWorker("dm-worker-1", "127.0.0.1:8262", labels[0]), IsNil)
+	c.Assert(workers[0].Labels(), DeepEquals, labels[0])
+	infos, _, err = ha.GetAllWorkerInfo(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(infos["dm-worker-1"].Labels, DeepEquals, labels[0])
+
+	// the worker with the preferred labels is picked.
+	c.Assert(s.leastLoadedFreeWorker(sources[0]), Equals, workers[0])
+	c.Assert(s.leastLoadedFreeWorker(sources[1]), Equals, workers[1])
+	s.unbounds[sources[0]] = struct{}{}
+	s.unbounds[sources[1]] = struct{}{}
+	bounded, err := s.tryBoundForSource(sources[0])
+	c.Assert(err, IsNil)
+	c.Assert(bounded, IsTrue)
+	c.Assert(s.bounds[sources[0]], Equals, workers[0])
+
+	// the source is not bound or transferred to the worker not matching the affinity.
+	c.Assert(s.pickUnboundSource(workers[2]), Equals, "")
+	bounded, err = s.tryBoundForWorker(workers[2])
+	c.Assert(err, IsNil)
+	c.Assert(bounded, IsFalse)
+	c.Assert(terror.ErrSchedulerWorkerNotMatchAffinity.Equal(s.TransferSource(ctx, sources[0], "dm-worker-3")), IsTrue)
+	c.Assert(terror.ErrSchedulerWorkerNotMatchAffinity.Equal(s.StartRelay(sources[0], []string{"dm-worker-3"})), IsTrue)
+
+	// failover to the worker matching the affinity, even if it's not in the preferred zone.
+	c.Assert(s.handleWorkerOffline(ha.WorkerEvent{WorkerName: "dm-worker-1"}, true), IsNil)
+	c.Assert(s.bounds[sources[0]], Equals, workers[1])
+	c.Assert(workers[2].Stage(), Equals, WorkerFree)
+
+	// the source without affinity can be bound to any worker.
+	_, ok := s.unbounds[sources[1]]
+	c.Assert(ok, IsTrue)
+	s.unbounds[sources[2]] = struct{}{}
+	c.Assert(s.pickUnboundSource(workers[2]), Equals, sources[2])
+	bounded, err = s.tryBoundForWorker(workers[2])
+	c.Assert(err, IsNil)
+	c.Assert(bounded, IsTrue)
+	c.Assert(s.bounds[sources[2]], Equals, workers[2])
+}
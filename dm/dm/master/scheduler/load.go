@@ -0,0 +1,303 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"context"
+	"net"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/pkg/ha"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+const (
+	// the loads reported before workerLoadTTL are ignored, the DM-worker may be hung.
+	workerLoadTTL = time.Minute
+	// a source is moved only if the load score of the busiest host decreases at least rebalanceThreshold, to avoid
+	// moving sources back and forth for small differences.
+	rebalanceThreshold = 0.1
+)
+
+// WorkerLoadInfo is the load of an online DM-worker with its load score.
+type WorkerLoadInfo struct {
+	ha.WorkerLoad
+	// the host of the DM-worker, the DM-workers deployed in the same host share the resources.
+	Host string
+	// the load score of the DM-worker, which is the average of the CPU usage, memory usage, relay disk usage and task
+	// QPS normalized by their maximum among the DM-workers. it's 0 if the DM-worker hasn't reported its load recently.
+	Score float64
+}
+
+// RebalanceMove is a move of a source from a DM-worker to a free DM-worker.
+type RebalanceMove struct {
+	Source     string
+	FromWorker string
+	ToWorker   string
+	// the load score moved with the source, which is the score of the DM-worker the source is bound to.
+	Score float64
+}
+
+// RebalancePlan is the plan to move sources to the DM-workers in less loaded hosts.
+type RebalancePlan struct {
+	Workers []WorkerLoadInfo // sorted by the name of the DM-worker.
+	Moves   []RebalanceMove
+}
+
+// workerHost returns the host of the address of the DM-worker.
+func workerHost(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
+
+// workerLoadInfos returns the loads of the online DM-workers, worker name -> load info.
+// caller should hold the lock of the scheduler.
+func (s *Scheduler) workerLoadInfos() (map[string]*WorkerLoadInfo, error) {
+	loads, err := ha.GetAllWorkerLoads(s.etcdCli)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	infos := make(map[string]*WorkerLoadInfo, len(s.workers))
+	for name, w := range s.workers {
+		if w.Stage() == WorkerOffline {
+			continue
+		}
+		info := &WorkerLoadInfo{WorkerLoad: ha.WorkerLoad{Worker: name}, Host: workerHost(w.BaseInfo().Addr)}
+		if load, ok := loads[name]; ok && now.Sub(load.UpdateTime) <= workerLoadTTL {
+			info.WorkerLoad = load
+		}
+		infos[name] = info
+	}
+	calculateLoadScores(infos)
+	return infos, nil
+}
+
+// calculateLoadScores calculates the load scores of the DM-workers.
+func calculateLoadScores(infos map[string]*WorkerLoadInfo) {
+	var maxCPU, maxMemory, maxRelayDisk, maxQPS float64
+	for _, info := range infos {
+		maxCPU = maxFloat(maxCPU, info.CPUUsage)
+		maxMemory = maxFloat(maxMemory, float64(info.MemoryUsage))
+		maxRelayDisk = maxFloat(maxRelayDisk, float64(info.RelayDiskUsage))
+		maxQPS = maxFloat(maxQPS, info.TaskQPS)
+	}
+	ratio := func(v, max float64) float64 {
+		if max == 0 {
+			return 0
+		}
+		return v / max
+	}
+	for _, info := range infos {
+		info.Score = (ratio(info.CPUUsage, maxCPU) + ratio(float64(info.MemoryUsage), maxMemory) +
+			ratio(float64(info.RelayDiskUsage), maxRelayDisk) + ratio(info.TaskQPS, maxQPS)) / 4
+	}
+}
+
+func maxFloat(a, b float64) float64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// hostLoadScores returns the load scores of the hosts, which are the sum of the scores of the DM-workers in them.
+func hostLoadScores(infos map[string]*WorkerLoadInfo) map[string]float64 {
+	scores := make(map[string]float64)
+	for _, info := range infos {
+		scores[info.Host] += info.Score
+	}
+	return scores
+}
+
+// leastLoadedFreeWorker returns the Free worker for the source, which has the most preferred labels in the worker
+// affinity of the source and is in the least loaded host, or nil if no Free worker. the load is ignored if the loads
+// can't be got. the workers being drained or not matching the worker affinity are not returned.
+// caller should hold the lock of the scheduler.
+func (s *Scheduler) leastLoadedFreeWorker(source string) *Worker {
+	var free []*Worker
+	for name, w := range s.workers {
+		if w.Stage() == WorkerFree && !s.isDraining(name) && s.matchAffinity(source, w) {
+			free = append(free, w)
+		}
+	}
+	if len(free) <= 1 {
+		if len(free) == 0 {
+			return nil
+		}
+		return free[0]
+	}
+
+	infos, err := s.workerLoadInfos()
+	if err != nil {
+		s.logger.Warn("fail to get the loads of workers, ignore the loads", zap.Error(err))
+		infos = make(map[string]*WorkerLoadInfo)
+	}
+	hostScores := hostLoadScores(infos)
+	host := func(w *Worker) string {
+		if info, ok := infos[w.BaseInfo().Name]; ok {
+			return info.Host
+		}
+		return workerHost(w.BaseInfo().Addr)
+	}
+	sort.Slice(free, func(i, j int) bool {
+		pi, pj := s.preferredScore(source, free[i]), s.preferredScore(source, free[j])
+		if pi != pj {
+			return pi > pj
+		}
+		si, sj := hostScores[host(free[i])], hostScores[host(free[j])]
+		if si != sj {
+			return si < sj
+		}
+		return free[i].BaseInfo().Name < free[j].BaseInfo().Name
+	})
+	return free[0]
+}
+
+// makeRebalancePlan moves the sources from the busiest hosts to the Free workers in the least loaded hosts greedily,
+// as long as the load score of the source host is still higher than the target host after the move. the light sources
+// are moved first, because they disturb less and are more likely to fit in the target host.
+// the sources which have relay enabled or have unfinished load tasks are not moved, because their relay log or dump
+// files are in the local disk of the DM-workers.
+// caller should hold the lock of the scheduler.
+func (s *Scheduler) makeRebalancePlan(infos map[string]*WorkerLoadInfo) []RebalanceMove {
+	type candidate struct {
+		source string
+		info   *WorkerLoadInfo
+	}
+	var (
+		sources []candidate
+		free    []*WorkerLoadInfo
+	)
+	for source, w := range s.bounds {
+		info, ok := infos[w.BaseInfo().Name]
+		if !ok || info.UpdateTime.IsZero() {
+			continue
+		}
+		if cfg, ok2 := s.sourceCfgs[source]; w.RelaySourceID() != "" || len(s.relayWorkers[source]) > 0 || (ok2 && cfg.EnableRelay) {
+			s.logger.Debug("skip the source with relay enabled in rebalancing", zap.String("source", source))
+			continue
+		}
+		if s.hasLoadTaskByWorkerAndSource(w.BaseInfo().Name, source) {
+			s.logger.Debug("skip the source with unfinished load task in rebalancing", zap.String("source", source))
+			continue
+		}
+		sources = append(sources, candidate{source: source, info: info})
+	}
+	for name, info := range infos {
+		if w := s.workers[name]; w.Stage() == WorkerFree && w.RelaySourceID() == "" && !s.isDraining(name) {
+			free = append(free, info)
+		}
+	}
+	sort.Slice(free, func(i, j int) bool {
+		return free[i].Worker < free[j].Worker
+	})
+	sort.Slice(sources, func(i, j int) bool {
+		if sources[i].info.Score != sources[j].info.Score {
+			return sources[i].info.Score < sources[j].info.Score
+		}
+		return sources[i].source < sources[j].source
+	})
+
+	hostScores := hostLoadScores(infos)
+	moves := make([]RebalanceMove, 0)
+	for {
+		var (
+			best   *RebalanceMove
+			bestTo int
+			// the decrease of the load score of the busiest one of the source host and the target host.
+			bestGain float64
+		)
+		for _, src := range sources {
+			from := hostScores[src.info.Host]
+			for i, to := range free {
+				if to.Host == src.info.Host || !s.matchAffinity(src.source, s.workers[to.Worker]) {
+					continue
+				}
+				after := maxFloat(from-src.info.Score, hostScores[to.Host]+src.info.Score)
+				if gain := from - after; gain >= rebalanceThreshold && gain > bestGain {
+					best = &RebalanceMove{Source: src.source, FromWorker: src.info.Worker, ToWorker: to.Worker, Score: src.info.Score}
+					bestTo, bestGain = i, gain
+				}
+			}
+		}
+		if best == nil {
+			return moves
+		}
+
+		moves = append(moves, *best)
+		hostScores[infos[best.FromWorker].Host] -= best.Score
+		hostScores[free[bestTo].Host] += best.Score
+		free = append(free[:bestTo], free[bestTo+1:]...)
+		for i := range sources {
+			if sources[i].source == best.Source {
+				sources = append(sources[:i], sources[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// GetRebalancePlan returns the loads of the online DM-workers and the plan to move sources to the DM-workers in less
+// loaded hosts.
+func (s *Scheduler) GetRebalancePlan() (*RebalancePlan, error) {
+	if !s.started.Load() {
+		return nil, terror.ErrSchedulerNotStarted.Generate()
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	infos, err := s.workerLoadInfos()
+	if err != nil {
+		return nil, err
+	}
+	plan := &RebalancePlan{
+		Workers: make([]WorkerLoadInfo, 0, len(infos)),
+		Moves:   s.makeRebalancePlan(infos),
+	}
+	for _, info := range infos {
+		plan.Workers = append(plan.Workers, *info)
+	}
+	sort.Slice(plan.Workers, func(i, j int) bool {
+		return plan.Workers[i].Worker < plan.Workers[j].Worker
+	})
+	return plan, nil
+}
+
+// Rebalance makes a rebalancing plan and transfers the sources in it, it returns the plan with the moves done.
+func (s *Scheduler) Rebalance(ctx context.Context) (*RebalancePlan, error) {
+	plan, err := s.GetRebalancePlan()
+	if err != nil {
+		return nil, err
+	}
+	moves := plan.Moves
+	plan.Moves = make([]RebalanceMove, 0, len(moves))
+	for _, move := range moves {
+		s.logger.Info("transfer source for rebalancing", zap.String("source", move.Source),
+			zap.String("from worker", move.FromWorker), zap.String("to worker", move.ToWorker), zap.Float64("score", move.Score))
+		if err = s.TransferSource(ctx, move.Source, move.ToWorker); err != nil {
+			return plan, terror.Annotatef(err, "fail to transfer source %s from worker %s to worker %s for rebalancing",
+				move.Source, move.FromWorker, move.ToWorker)
+		}
+		plan.Moves = append(plan.Moves, move)
+	}
+	return plan, nil
+}
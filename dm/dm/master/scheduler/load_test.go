@@ -0,0 +1,106 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/ha"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+)
+
+func (t *testScheduler) TestRebalance(c *C) {
+	defer clearTestInfoOperation(c)
+
+	var (
+		logger  = log.L()
+		s       = NewScheduler(&logger, config.Security{})
+		now     = time.Now()
+		sources = []string{"mysql-replica-1", "mysql-replica-2", "mysql-replica-3"}
+		workers = []*Worker{
+			{baseInfo: ha.NewWorkerInfo("dm-worker-1", "192.168.0.1:8262")},
+			{baseInfo: ha.NewWorkerInfo("dm-worker-2", "192.168.0.1:8263")},
+			{baseInfo: ha.NewWorkerInfo("dm-worker-3", "192.168.0.2:8262")},
+			{baseInfo: ha.NewWorkerInfo("dm-worker-4", "192.168.0.3:8262")},
+			{baseInfo: ha.NewWorkerInfo("dm-worker-5", "192.168.0.1:8264")},
+		}
+	)
+	s.started.Store(true)
+	s.etcdCli = etcdTestCli
+	for _, w := range workers {
+		s.workers[w.BaseInfo().Name] = w
+		w.ToFree()
+	}
+	for _, source := range sources {
+		s.sourceCfgs[source] = &config.SourceConfig{SourceID: source}
+	}
+	// dm-worker-1 and dm-worker-2 are in the same host, dm-worker-3 and dm-worker-5 are free.
+	c.Assert(s.boundSourceToWorker(sources[0], workers[0]), IsNil)
+	c.Assert(s.boundSourceToWorker(sources[1], workers[1]), IsNil)
+	c.Assert(s.boundSourceToWorker(sources[2], workers[3]), IsNil)
+
+	loads := []ha.WorkerLoad{
+		{Worker: "dm-worker-1", CPUUsage: 100, MemoryUsage: 100, TaskQPS: 100, UpdateTime: now},
+		{Worker: "dm-worker-2", CPUUsage: 50, MemoryUsage: 50, TaskQPS: 50, UpdateTime: now},
+		{Worker: "dm-worker-3", MemoryUsage: 10, UpdateTime: now},
+		// the load reported long ago is ignored
+		{Worker: "dm-worker-4", CPUUsage: 200, MemoryUsage: 200, TaskQPS: 200, UpdateTime: now.Add(-2 * workerLoadTTL)},
+		{Worker: "dm-worker-5", MemoryUsage: 10, UpdateTime: now},
+	}
+	for _, load := range loads {
+		_, err := ha.PutWorkerLoad(etcdTestCli, load)
+		c.Assert(err, IsNil)
+	}
+
+	plan, err := s.GetRebalancePlan()
+	c.Assert(err, IsNil)
+	c.Assert(plan.Workers, HasLen, 5)
+	c.Assert(plan.Workers[0].Host, Equals, "192.168.0.1")
+	c.Assert(plan.Workers[0].Score, Equals, 0.75)
+	c.Assert(plan.Workers[1].Score, Equals, 0.375)
+	c.Assert(plan.Workers[2].Score, Equals, 0.025)
+	c.Assert(plan.Workers[3].UpdateTime.IsZero(), IsTrue)
+	c.Assert(plan.Workers[3].Score, Equals, float64(0))
+	// the lighter source is moved out of the busiest host to the least loaded host
+	c.Assert(plan.Moves, DeepEquals, []RebalanceMove{
+		{Source: sources[1], FromWorker: "dm-worker-2", ToWorker: "dm-worker-3", Score: 0.375},
+	})
+
+	// the free worker in the least loaded host is picked for a new source
+	s.mu.Lock()
+	c.Assert(s.leastLoadedFreeWorker(sources[0]), Equals, workers[2])
+	s.mu.Unlock()
+
+	// the source with relay enabled is not moved
+	s.sourceCfgs[sources[1]].EnableRelay = true
+	plan, err = s.GetRebalancePlan()
+	c.Assert(err, IsNil)
+	c.Assert(plan.Moves, DeepEquals, []RebalanceMove{
+		{Source: sources[0], FromWorker: "dm-worker-1", ToWorker: "dm-worker-3", Score: 0.75},
+	})
+	s.sourceCfgs[sources[1]].EnableRelay = false
+
+	plan, err = s.Rebalance(context.Background())
+	c.Assert(err, IsNil)
+	c.Assert(plan.Moves, HasLen, 1)
+	c.Assert(s.bounds[sources[1]], Equals, workers[2])
+	c.Assert(workers[1].Stage(), Equals, WorkerFree)
+	bounds, _, err := ha.GetSourceBound(etcdTestCli, "dm-worker-3")
+	c.Assert(err, IsNil)
+	c.Assert(bounds["dm-worker-3"].Source, Equals, sources[1])
+}
@@ -0,0 +1,34 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"github.com/pingcap/tiflow/dm/dm/config"
+)
+
+// sourcePriorityLevel returns the highest priority level of the subtasks of the source, the sources of the tasks with
+// higher priorities are bound to the Free workers first. it returns 0 if the source has no subtask.
+// caller should hold the lock of the scheduler.
+func (s *Scheduler) sourcePriorityLevel(source string) int {
+	level := 0
+	s.subTaskCfgs.Range(func(_, v interface{}) bool {
+		if cfg, ok := v.(map[string]config.SubTaskConfig)[source]; ok {
+			if l := config.TaskPriorityLevel(cfg.Priority); l > level {
+				level = l
+			}
+		}
+		return true
+	})
+	return level
+}
@@ -0,0 +1,60 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/ha"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+)
+
+func (t *testScheduler) TestPickUnboundSourceByPriority(c *C) {
+	var (
+		logger  = log.L()
+		s       = NewScheduler(&logger, config.Security{})
+		sources = []string{"mysql-replica-1", "mysql-replica-2", "mysql-replica-3"}
+	)
+	w, err := NewWorker(ha.NewWorkerInfo("dm-worker-1", "127.0.0.1:8262"), config.Security{})
+	c.Assert(err, IsNil)
+	for _, source := range sources {
+		s.unbounds[source] = struct{}{}
+	}
+	// the source without subtask has the lowest priority.
+	s.subTaskCfgs.Store("task-1", map[string]config.SubTaskConfig{
+		sources[0]: {Name: "task-1", SourceID: sources[0], Priority: config.TaskPriorityLow},
+		sources[1]: {Name: "task-1", SourceID: sources[1], Priority: config.TaskPriorityLow},
+	})
+	c.Assert(s.sourcePriorityLevel(sources[2]), Equals, 0)
+	c.Assert(s.pickUnboundSource(w), Equals, sources[0])
+
+	// the highest priority of the subtasks of a source is used.
+	s.subTaskCfgs.Store("task-2", map[string]config.SubTaskConfig{
+		sources[1]: {Name: "task-2", SourceID: sources[1], Priority: config.TaskPriorityHigh},
+	})
+	c.Assert(s.sourcePriorityLevel(sources[1]), Equals, config.TaskPriorityLevel(config.TaskPriorityHigh))
+	c.Assert(s.pickUnboundSource(w), Equals, sources[1])
+
+	// the preferred labels are compared after the priorities.
+	s.subTaskCfgs.Store("task-2", map[string]config.SubTaskConfig{
+		sources[1]: {Name: "task-2", SourceID: sources[1]},
+		sources[2]: {Name: "task-2", SourceID: sources[2]},
+	})
+	s.sourceCfgs[sources[2]] = &config.SourceConfig{SourceID: sources[2], Affinity: config.WorkerAffinity{
+		Preferred: map[string]string{"zone": "zone-1"},
+	}}
+	w.labels = map[string]string{"zone": "zone-1"}
+	c.Assert(s.pickUnboundSource(w), Equals, sources[2])
+}
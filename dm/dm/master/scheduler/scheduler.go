@@ -63,6 +63,8 @@ const (
 //   - lost keep-alive.
 // - a source removed:
 //   - remove source request from user.
+// - a worker being drained:
+//   - transfer its source to another worker by `offline-member --drain`.
 // TODO: try to handle the return `err` of etcd operations,
 //   because may put into etcd, but the response to the etcd client interrupted.
 // Relay scheduling:
@@ -84,6 +86,7 @@ const (
 //   remove UpstreamRelayWorkerKeyAdapter:
 //   - use `stop-relay -s source -w worker`
 //   - remove worker by `offline-member`
+//   - drain worker by `offline-member --drain`, the relay is moved to the new bound worker of the source
 type Scheduler struct {
 	mu sync.RWMutex
 
@@ -179,6 +182,14 @@ type Scheduler struct {
 	// task -> source -> worker
 	loadTasks map[string]map[string]string
 
+	// the DM-workers being drained, which are not bound to any source until they become offline.
+	// add:
+	// - drain worker by user request (calling `DrainWorker`).
+	// delete:
+	// - when the worker become offline, in handleWorkerOffline.
+	// - remove worker by user request (calling `RemoveWorker`).
+	drainingWorkers map[string]struct{}
+
 	securityCfg config.Security
 }
 
@@ -195,6 +206,7 @@ func NewScheduler(pLogger *log.Logger, securityCfg config.Security) *Scheduler {
 		expectRelayStages: make(map[string]ha.Stage),
 		relayWorkers:      make(map[string]map[string]struct{}),
 		loadTasks:         make(map[string]map[string]string),
+		drainingWorkers:   make(map[string]struct{}),
 		securityCfg:       securityCfg,
 	}
 }
@@ -675,6 +687,14 @@ func (s *Scheduler) TransferSource(ctx context.Context, source, worker string) e
 		s.mu.RUnlock()
 		return terror.ErrSchedulerWorkerNotExist.Generate(worker)
 	}
+	if s.isDraining(worker) {
+		s.mu.RUnlock()
+		return terror.ErrSchedulerWorkerDraining.Generate(worker)
+	}
+	if !s.matchAffinity(source, w) {
+		s.mu.RUnlock()
+		return terror.ErrSchedulerWorkerNotMatchAffinity.Generate(worker, w.Labels(), source)
+	}
 	oldWorker, hasOldWorker := s.bounds[source]
 	if hasOldWorker && oldWorker.BaseInfo().Name == worker {
 		s.mu.RUnlock()
@@ -1004,6 +1024,62 @@ func (s *Scheduler) RemoveSubTasks(task string, sources ...string) error {
 	return nil
 }
 
+// UpdateSubTaskCfgs updates the configs of one or more existing subtasks for one task, the expectant stages are
+// not changed. it's used to hot reload the rules of the subtasks, the DM-workers are not notified by it.
+func (s *Scheduler) UpdateSubTaskCfgs(cfgs ...config.SubTaskConfig) error {
+	if !s.started.Load() {
+		return terror.ErrSchedulerNotStarted.Generate()
+	}
+
+	if len(cfgs) == 0 {
+		return nil
+	}
+
+	taskNamesM := make(map[string]struct{}, 1)
+	for _, cfg := range cfgs {
+		taskNamesM[cfg.Name] = struct{}{}
+	}
+	taskNames := strMapToSlice(taskNamesM)
+	if len(taskNames) > 1 {
+		// only subtasks from one task supported now.
+		return terror.ErrSchedulerMultiTask.Generate(taskNames)
+	}
+	task := taskNames[0]
+
+	release, err := s.subtaskLatch.tryAcquire(task)
+	if err != nil {
+		return terror.ErrSchedulerLatchInUse.Generate("UpdateSubTaskCfgs", task)
+	}
+	defer release()
+
+	// 1. check the subtasks exist.
+	cfgsMapV, ok := s.subTaskCfgs.Load(task)
+	if !ok {
+		return terror.ErrSchedulerSubTaskOpTaskNotExist.Generate(task)
+	}
+	cfgsM := cfgsMapV.(map[string]config.SubTaskConfig)
+	notExistSourcesM := make(map[string]struct{})
+	for _, cfg := range cfgs {
+		if _, ok = cfgsM[cfg.SourceID]; !ok {
+			notExistSourcesM[cfg.SourceID] = struct{}{}
+		}
+	}
+	if notExistSources := strMapToSlice(notExistSourcesM); len(notExistSources) > 0 {
+		return terror.ErrSchedulerSubTaskOpSourceNotExist.Generate(notExistSources)
+	}
+
+	// 2. put the configs into etcd.
+	if _, err = ha.PutSubTaskCfgStage(s.etcdCli, cfgs, nil); err != nil {
+		return err
+	}
+
+	// 3. record the configs.
+	for _, cfg := range cfgs {
+		cfgsM[cfg.SourceID] = cfg
+	}
+	return nil
+}
+
 // getSubTaskCfgByTaskSource gets subtask config by task name and source ID. Only used in tests.
 func (s *Scheduler) getSubTaskCfgByTaskSource(task, source string) *config.SubTaskConfig {
 	v, ok := s.subTaskCfgs.Load(task)
@@ -1089,7 +1165,7 @@ func (s *Scheduler) GetTaskNameListBySourceName(sourceName string) []string {
 // This only adds the information of the DM-worker,
 // in order to know whether it's online (ready to handle works),
 // we need to wait for its healthy status through keep-alive.
-func (s *Scheduler) AddWorker(name, addr string) error {
+func (s *Scheduler) AddWorker(name, addr string, labels map[string]string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -1102,8 +1178,19 @@ func (s *Scheduler) AddWorker(name, addr string) error {
 		// NOTE: we do not support add the worker with different address now, support if needed later.
 		// but we support add the worker with all the same information multiple times, and only the first one take effect,
 		// because this is needed when restarting the worker.
+		// the labels may be changed when restarting the worker, they take effect in the next scheduling.
 		if addr == w.BaseInfo().Addr {
-			s.logger.Warn("add the same worker again", zap.Stringer("worker info", w.BaseInfo()))
+			if labelsEqual(labels, w.Labels()) {
+				s.logger.Warn("add the same worker again", zap.Stringer("worker info", w.BaseInfo()))
+				return nil
+			}
+			info := ha.NewWorkerInfo(name, addr)
+			info.Labels = labels
+			if _, err := ha.PutWorkerInfo(s.etcdCli, info); err != nil {
+				return err
+			}
+			s.logger.Info("update the labels of worker", zap.Stringer("worker info", info))
+			w.setLabels(labels)
 			return nil
 		}
 		return terror.ErrSchedulerWorkerExist.Generate(w.BaseInfo())
@@ -1111,6 +1198,7 @@ func (s *Scheduler) AddWorker(name, addr string) error {
 
 	// 2. put the base info into etcd.
 	info := ha.NewWorkerInfo(name, addr)
+	info.Labels = labels
 	_, err := ha.PutWorkerInfo(s.etcdCli, info)
 	if err != nil {
 		return err
@@ -1147,6 +1235,89 @@ func (s *Scheduler) RemoveWorker(name string) error {
 	return nil
 }
 
+// DrainWorker transfers the source bound to the DM-worker to another DM-worker, and keeps the DM-worker from being
+// bound to any source until it becomes offline, so the DM-worker can be restarted or removed without interrupting the
+// tasks. the running tasks are paused before the transfer, which flushes their checkpoints, and are resumed in the new
+// DM-worker. if the DM-worker has started relay by `start-relay`, the relay is moved to the new DM-worker too.
+func (s *Scheduler) DrainWorker(ctx context.Context, name string) (err error) {
+	if !s.started.Load() {
+		return terror.ErrSchedulerNotStarted.Generate()
+	}
+
+	s.mu.Lock()
+	w, ok := s.workers[name]
+	if !ok {
+		s.mu.Unlock()
+		return terror.ErrSchedulerWorkerNotExist.Generate(name)
+	}
+	if w.Stage() == WorkerOffline {
+		// an Offline worker has nothing bound.
+		s.mu.Unlock()
+		return nil
+	}
+	source := w.Bound().Source
+	var target *Worker
+	if source != "" {
+		if target = s.drainTarget(source, name); target == nil {
+			s.mu.Unlock()
+			return terror.ErrSchedulerNoWorkerToDrain.Generate(source, name)
+		}
+	}
+	s.drainingWorkers[name] = struct{}{}
+	s.mu.Unlock()
+	s.logger.Info("start to drain worker", zap.String("worker", name), zap.String("source", source))
+
+	defer func() {
+		if err != nil {
+			// let the worker be scheduled again, the user can retry the drain later.
+			s.mu.Lock()
+			delete(s.drainingWorkers, name)
+			s.mu.Unlock()
+		}
+	}()
+
+	if target != nil {
+		if err = s.TransferSource(ctx, source, target.BaseInfo().Name); err != nil {
+			return terror.Annotatef(err, "fail to transfer source %s from worker %s to worker %s for draining",
+				source, name, target.BaseInfo().Name)
+		}
+		s.logger.Info("transfer source for draining", zap.String("source", source),
+			zap.String("from worker", name), zap.String("to worker", target.BaseInfo().Name))
+	}
+
+	relaySource := w.RelaySourceID()
+	if relaySource == "" {
+		return nil
+	}
+	s.mu.RLock()
+	bw, hasBound := s.bounds[relaySource]
+	var started bool
+	if hasBound {
+		_, started = s.relayWorkers[relaySource][bw.BaseInfo().Name]
+	}
+	s.mu.RUnlock()
+	// start relay in the new bound worker before stopping it in the drained worker, to keep the relay stage of the source.
+	if hasBound && !started {
+		if err = s.StartRelay(relaySource, []string{bw.BaseInfo().Name}); err != nil {
+			return err
+		}
+	}
+	return s.StopRelay(relaySource, []string{name})
+}
+
+// drainTarget returns the worker to take over the source from the draining worker, the relay worker of the source is
+// preferred, then the Free worker in the least loaded host. it returns nil if no worker is available.
+// caller should hold the lock of the scheduler.
+func (s *Scheduler) drainTarget(source, draining string) *Worker {
+	for name := range s.relayWorkers[source] {
+		if w, ok := s.workers[name]; ok && name != draining && w.Stage() == WorkerRelay && !s.isDraining(name) && s.matchAffinity(source, w) {
+			return w
+		}
+	}
+	// the draining worker is Bound, so it's not picked.
+	return s.leastLoadedFreeWorker(source)
+}
+
 // GetAllWorkers gets all worker agent.
 func (s *Scheduler) GetAllWorkers() ([]*Worker, error) {
 	s.mu.RLock()
@@ -1267,6 +1438,10 @@ func (s *Scheduler) StartRelay(source string, workers []string) error {
 		if _, ok = startedWorkers[workerName]; ok {
 			alreadyStarted = append(alreadyStarted, workerName)
 		}
+		// the relay worker may be bound to the source later.
+		if !s.matchAffinity(source, worker) {
+			return terror.ErrSchedulerWorkerNotMatchAffinity.Generate(workerName, worker.Labels(), source)
+		}
 
 		// for Bound and Offline worker
 		if worker.Bound().Source != "" && worker.Bound().Source != source {
@@ -2025,8 +2200,9 @@ func (s *Scheduler) handleWorkerOffline(ev ha.WorkerEvent, toLock bool) error {
 		return nil
 	}
 
-	// 2. find the bound relationship.
+	// 2. find the bound relationship, the worker is drained if it was being drained.
 	bound := w.Bound()
+	delete(s.drainingWorkers, ev.WorkerName)
 
 	// 3. check whether bound before.
 	if bound.Source == "" {
@@ -2059,10 +2235,16 @@ func (s *Scheduler) handleWorkerOffline(ev ha.WorkerEvent, toLock bool) error {
 // - try to bind sources on which the worker has unfinished load task
 // - try to bind the last bound source
 // - if enabled relay, bind to the relay source or keep unbound
-// - try to bind any unbound sources
+// - try to bind any unbound sources, which prefer the worker more by the worker affinity
+// except the load task source, the source must match the worker affinity.
 // if the source is bound to a relay enabled worker, we must check that the source is also the relay source of worker.
 // pulling binlog using relay or not is determined by whether the worker has enabled relay.
 func (s *Scheduler) tryBoundForWorker(w *Worker) (bounded bool, err error) {
+	if s.isDraining(w.BaseInfo().Name) {
+		s.logger.Info("worker is being drained, no need to bound", zap.Stringer("worker", w.BaseInfo()))
+		return false, nil
+	}
+
 	// 1. handle this worker has unfinished load task.
 	worker, sourceID := s.getNextLoadTaskTransfer(w.BaseInfo().Name, "")
 	if sourceID != "" {
@@ -2078,7 +2260,7 @@ func (s *Scheduler) tryBoundForWorker(w *Worker) (bounded bool, err error) {
 	// NOTE: if worker isn't in lastBound, we'll get "zero" SourceBound and it's OK, because "zero" string is not in
 	// unbounds
 	source := s.lastBound[w.baseInfo.Name].Source
-	if _, ok := s.unbounds[source]; !ok {
+	if _, ok := s.unbounds[source]; !ok || !s.matchAffinity(source, w) {
 		source = ""
 	}
 
@@ -2112,13 +2294,13 @@ func (s *Scheduler) tryBoundForWorker(w *Worker) (bounded bool, err error) {
 		}
 	}
 
-	// randomly pick one from unbounds
+	// pick one from unbounds which matches the worker affinity
 	if source == "" {
-		for source = range s.unbounds {
+		source = s.pickUnboundSource(w)
+		if source != "" {
 			s.logger.Info("found unbound source when worker bound",
 				zap.String("worker", w.BaseInfo().Name),
 				zap.String("source", source))
-			break // got a source.
 		}
 	}
 
@@ -2135,12 +2317,13 @@ func (s *Scheduler) tryBoundForWorker(w *Worker) (bounded bool, err error) {
 	return true, nil
 }
 
-// tryBoundForSource tries to bound a source to a random Free worker. The order of picking worker is
+// tryBoundForSource tries to bound a source to a Free worker. The order of picking worker is
 // - try to bind a worker which has unfinished load task
 // - try to bind a relay worker which has be bound to this source before
 // - try to bind any relay worker
 // - try to bind any worker which has be bound to this source before
-// - try to bind any free worker
+// - try to bind a free worker which has the most preferred labels and is in the least loaded host
+// except the load task worker, the worker must match the worker affinity of the source.
 // pulling binlog using relay or not is determined by whether the worker has enabled relay.
 // caller should update the s.unbounds.
 // caller should make sure this source has source config.
@@ -2166,7 +2349,7 @@ func (s *Scheduler) tryBoundForSource(source string) (bool, error) {
 					continue
 				}
 				// the worker is not Offline
-				if _, ok2 := relayWorkers[workerName]; ok2 && w.Stage() == WorkerRelay {
+				if _, ok2 := relayWorkers[workerName]; ok2 && w.Stage() == WorkerRelay && !s.isDraining(workerName) && s.matchAffinity(source, w) {
 					worker = w
 					s.logger.Info("found history relay worker when source bound",
 						zap.String("worker", workerName),
@@ -2186,7 +2369,7 @@ func (s *Scheduler) tryBoundForSource(source string) (bool, error) {
 				continue
 			}
 			// the worker is not Offline
-			if w.Stage() == WorkerRelay {
+			if w.Stage() == WorkerRelay && !s.isDraining(workerName) && s.matchAffinity(source, w) {
 				worker = w
 				s.logger.Info("found relay worker when source bound",
 					zap.String("worker", workerName),
@@ -2204,7 +2387,7 @@ func (s *Scheduler) tryBoundForSource(source string) (bool, error) {
 					// a not found worker
 					continue
 				}
-				if w.Stage() == WorkerFree {
+				if w.Stage() == WorkerFree && !s.isDraining(workerName) && s.matchAffinity(source, w) {
 					worker = w
 					s.logger.Info("found history worker when source bound",
 						zap.String("worker", workerName),
@@ -2215,16 +2398,13 @@ func (s *Scheduler) tryBoundForSource(source string) (bool, error) {
 		}
 	}
 
-	// and then a random Free worker.
+	// and then a Free worker in the least loaded host.
 	if worker == nil {
-		for _, w := range s.workers {
-			if w.Stage() == WorkerFree {
-				worker = w
-				s.logger.Info("found free worker when source bound",
-					zap.String("worker", w.BaseInfo().Name),
-					zap.String("source", source))
-				break
-			}
+		worker = s.leastLoadedFreeWorker(source)
+		if worker != nil {
+			s.logger.Info("found free worker when source bound",
+				zap.String("worker", worker.BaseInfo().Name),
+				zap.String("source", source))
 		}
 	}
 
@@ -2293,9 +2473,17 @@ func (s *Scheduler) deleteWorker(name string) {
 	}
 	w.Close()
 	delete(s.workers, name)
+	delete(s.drainingWorkers, name)
 	metrics.RemoveWorkerState(w.baseInfo.Name)
 }
 
+// isDraining returns whether the worker is being drained.
+// caller should hold the lock of the scheduler.
+func (s *Scheduler) isDraining(name string) bool {
+	_, ok := s.drainingWorkers[name]
+	return ok
+}
+
 // updateStatusToBound updates the in-memory status for bound, including:
 // - update the stage of worker to `Bound`.
 // - record the bound relationship and last bound relationship in the scheduler.
@@ -2339,6 +2527,7 @@ func (s *Scheduler) reset() {
 	s.expectRelayStages = make(map[string]ha.Stage)
 	s.expectSubTaskStages = sync.Map{}
 	s.loadTasks = make(map[string]map[string]string)
+	s.drainingWorkers = make(map[string]struct{})
 }
 
 // strMapToSlice converts a `map[string]struct{}` to `[]string` in increasing order.
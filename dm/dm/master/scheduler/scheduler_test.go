@@ -140,7 +140,7 @@ func (t *testScheduler) testSchedulerProgress(c *C, restart int) {
 	c.Assert(terror.ErrSchedulerNotStarted.Equal(s.RemoveSourceCfg(sourceID1)), IsTrue)
 	c.Assert(terror.ErrSchedulerNotStarted.Equal(s.AddSubTasks(false, subtaskCfg1)), IsTrue)
 	c.Assert(terror.ErrSchedulerNotStarted.Equal(s.RemoveSubTasks(taskName1, sourceID1)), IsTrue)
-	c.Assert(terror.ErrSchedulerNotStarted.Equal(s.AddWorker(workerName1, workerAddr1)), IsTrue)
+	c.Assert(terror.ErrSchedulerNotStarted.Equal(s.AddWorker(workerName1, workerAddr1, nil)), IsTrue)
 	c.Assert(terror.ErrSchedulerNotStarted.Equal(s.RemoveWorker(workerName1)), IsTrue)
 	c.Assert(terror.ErrSchedulerNotStarted.Equal(s.UpdateExpectRelayStage(pb.Stage_Running, sourceID1)), IsTrue)
 	c.Assert(terror.ErrSchedulerNotStarted.Equal(s.UpdateExpectSubTaskStage(pb.Stage_Running, taskName1, sourceID1)), IsTrue)
@@ -190,9 +190,9 @@ func (t *testScheduler) testSchedulerProgress(c *C, restart int) {
 	// no worker exist before added.
 	t.workerNotExist(c, s, workerName1)
 	// add worker1.
-	c.Assert(s.AddWorker(workerName1, workerAddr1), IsNil)
-	c.Assert(terror.ErrSchedulerWorkerExist.Equal(s.AddWorker(workerName1, workerAddr2)), IsTrue) // can't add with different address now.
-	c.Assert(s.AddWorker(workerName1, workerAddr1), IsNil)                                        // but can add the worker multiple times (like restart the worker).
+	c.Assert(s.AddWorker(workerName1, workerAddr1, nil), IsNil)
+	c.Assert(terror.ErrSchedulerWorkerExist.Equal(s.AddWorker(workerName1, workerAddr2, nil)), IsTrue) // can't add with different address now.
+	c.Assert(s.AddWorker(workerName1, workerAddr1, nil), IsNil)                                        // but can add the worker multiple times (like restart the worker).
 	// the worker added.
 	t.workerExist(c, s, workerInfo1)
 	t.workerOffline(c, s, workerName1)
@@ -369,7 +369,7 @@ func (t *testScheduler) testSchedulerProgress(c *C, restart int) {
 	// worker2 not exists before added.
 	t.workerNotExist(c, s, workerName2)
 	// add worker2.
-	c.Assert(s.AddWorker(workerName2, workerAddr2), IsNil)
+	c.Assert(s.AddWorker(workerName2, workerAddr2, nil), IsNil)
 	// the worker added, but is offline.
 	t.workerExist(c, s, workerInfo2)
 	t.workerOffline(c, s, workerName2)
@@ -793,7 +793,7 @@ func (t *testScheduler) TestRestartScheduler(c *C) {
 	// step 1.1: add sourceCfg and worker
 	c.Assert(s.AddSourceCfg(sourceCfg1), IsNil)
 	t.sourceCfgExist(c, s, sourceCfg1)
-	c.Assert(s.AddWorker(workerName1, workerAddr1), IsNil)
+	c.Assert(s.AddWorker(workerName1, workerAddr1, nil), IsNil)
 	t.workerExist(c, s, workerInfo1)
 	// step 2: start a worker
 	// step 2.1: worker start watching source bound
@@ -897,7 +897,7 @@ func (t *testScheduler) TestRestartScheduler(c *C) {
 	)
 
 	// add another worker
-	c.Assert(s.AddWorker(workerName2, workerAddr2), IsNil)
+	c.Assert(s.AddWorker(workerName2, workerAddr2, nil), IsNil)
 	t.workerExist(c, s, workerInfo2)
 
 	// step 2.2: worker start keepAlive
@@ -962,10 +962,10 @@ func (t *testScheduler) TestWatchWorkerEventEtcdCompact(c *C) {
 	c.Assert(s.unbounds, HasKey, sourceID1)
 	c.Assert(s.unbounds, HasKey, sourceID2)
 
-	c.Assert(s.AddWorker(workerName1, workerAddr1), IsNil)
-	c.Assert(s.AddWorker(workerName2, workerAddr2), IsNil)
-	c.Assert(s.AddWorker(workerName3, workerAddr3), IsNil)
-	c.Assert(s.AddWorker(workerName4, workerAddr4), IsNil)
+	c.Assert(s.AddWorker(workerName1, workerAddr1, nil), IsNil)
+	c.Assert(s.AddWorker(workerName2, workerAddr2, nil), IsNil)
+	c.Assert(s.AddWorker(workerName3, workerAddr3, nil), IsNil)
+	c.Assert(s.AddWorker(workerName4, workerAddr4, nil), IsNil)
 	c.Assert(s.workers, HasLen, 4)
 	c.Assert(s.workers, HasKey, workerName1)
 	c.Assert(s.workers, HasKey, workerName2)
@@ -1574,8 +1574,8 @@ func (t *testScheduler) TestStartSourcesWithoutSourceConfigsInEtcd(c *C) {
 	s.sourceCfgs[sourceID2] = &config.SourceConfig{}
 	s.unbounds[sourceID1] = struct{}{}
 	s.unbounds[sourceID2] = struct{}{}
-	c.Assert(s.AddWorker(workerName1, workerAddr1), IsNil)
-	c.Assert(s.AddWorker(workerName2, workerAddr2), IsNil)
+	c.Assert(s.AddWorker(workerName1, workerAddr1, nil), IsNil)
+	c.Assert(s.AddWorker(workerName2, workerAddr2, nil), IsNil)
 
 	wg.Add(2)
 	go func() {
@@ -1941,3 +1941,107 @@ func (t *testScheduler) TestUpgradeCauseConflictRelayType(c *C) {
 	c.Assert(worker.RelaySourceID(), HasLen, 0)
 	c.Assert(s.workers[workerName2].Stage(), Equals, WorkerFree)
 }
+
+func (t *testScheduler) TestDrainWorker(c *C) {
+	defer clearTestInfoOperation(c)
+
+	var (
+		logger  = log.L()
+		s       = NewScheduler(&logger, config.Security{})
+		ctx     = context.Background()
+		sources = []string{"mysql-replica-1", "mysql-replica-2"}
+		workers = []*Worker{
+			{baseInfo: ha.NewWorkerInfo("dm-worker-1", "192.168.0.1:8262")},
+			{baseInfo: ha.NewWorkerInfo("dm-worker-2", "192.168.0.2:8262")},
+			{baseInfo: ha.NewWorkerInfo("dm-worker-3", "192.168.0.3:8262")},
+			{baseInfo: ha.NewWorkerInfo("dm-worker-4", "192.168.0.4:8262")},
+		}
+	)
+	s.started.Store(true)
+	s.etcdCli = etcdTestCli
+	for _, w := range workers[:3] {
+		s.workers[w.BaseInfo().Name] = w
+		w.ToFree()
+	}
+	for _, source := range sources {
+		s.sourceCfgs[source] = &config.SourceConfig{SourceID: source}
+	}
+	c.Assert(s.boundSourceToWorker(sources[0], workers[0]), IsNil)
+	c.Assert(s.boundSourceToWorker(sources[1], workers[1]), IsNil)
+
+	c.Assert(terror.ErrSchedulerWorkerNotExist.Equal(s.DrainWorker(ctx, "not-exist")), IsTrue)
+
+	// the source is transferred to the free worker, and the drained worker is not bound again.
+	c.Assert(s.DrainWorker(ctx, workers[0].BaseInfo().Name), IsNil)
+	c.Assert(s.bounds[sources[0]], Equals, workers[2])
+	c.Assert(workers[0].Stage(), Equals, WorkerFree)
+	c.Assert(s.isDraining(workers[0].BaseInfo().Name), IsTrue)
+	s.updateStatusToUnbound(sources[1])
+	bounded, err := s.tryBoundForWorker(workers[0])
+	c.Assert(err, IsNil)
+	c.Assert(bounded, IsFalse)
+	c.Assert(s.boundSourceToWorker(sources[1], workers[1]), IsNil)
+	c.Assert(terror.ErrSchedulerWorkerDraining.Equal(s.TransferSource(ctx, sources[1], workers[0].BaseInfo().Name)), IsTrue)
+
+	// no free worker to take over the source.
+	c.Assert(terror.ErrSchedulerNoWorkerToDrain.Equal(s.DrainWorker(ctx, workers[1].BaseInfo().Name)), IsTrue)
+	c.Assert(s.isDraining(workers[1].BaseInfo().Name), IsFalse)
+	c.Assert(s.bounds[sources[1]], Equals, workers[1])
+
+	// the drained worker becomes schedulable after it's offline.
+	c.Assert(s.handleWorkerOffline(ha.WorkerEvent{WorkerName: workers[0].BaseInfo().Name}, true), IsNil)
+	c.Assert(s.isDraining(workers[0].BaseInfo().Name), IsFalse)
+	c.Assert(workers[0].Stage(), Equals, WorkerOffline)
+
+	// the relay is moved with the source.
+	c.Assert(s.StartRelay(sources[1], []string{workers[1].BaseInfo().Name}), IsNil)
+	s.workers[workers[3].BaseInfo().Name] = workers[3]
+	workers[3].ToFree()
+	c.Assert(s.DrainWorker(ctx, workers[1].BaseInfo().Name), IsNil)
+	c.Assert(s.bounds[sources[1]], Equals, workers[3])
+	c.Assert(workers[1].Stage(), Equals, WorkerFree)
+	c.Assert(workers[1].RelaySourceID(), Equals, "")
+	c.Assert(workers[3].RelaySourceID(), Equals, sources[1])
+	c.Assert(s.relayWorkers[sources[1]], DeepEquals, map[string]struct{}{workers[3].BaseInfo().Name: {}})
+	relayCfgs, _, err := ha.GetAllRelayConfig(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(relayCfgs, DeepEquals, map[string]map[string]struct{}{sources[1]: {workers[3].BaseInfo().Name: {}}})
+}
+
+func (t *testScheduler) TestUpdateSubTaskCfgs(c *C) {
+	defer clearTestInfoOperation(c)
+
+	var (
+		logger   = log.L()
+		s        = NewScheduler(&logger, config.Security{})
+		task     = "task-1"
+		source1  = "mysql-replica-1"
+		source2  = "mysql-replica-2"
+		cfg1     config.SubTaskConfig
+		notExist = "not-exist"
+	)
+	c.Assert(terror.ErrSchedulerNotStarted.Equal(s.UpdateSubTaskCfgs(cfg1)), IsTrue)
+	s.started.Store(true)
+	s.etcdCli = etcdTestCli
+
+	c.Assert(cfg1.DecodeFile(subTaskSampleFile, true), IsNil)
+	cfg1.SourceID = source1
+	cfg1.Name = task
+	c.Assert(cfg1.Adjust(true), IsNil)
+	cfg2 := cfg1
+	cfg2.SourceID = source2
+	c.Assert(terror.ErrSchedulerSubTaskOpTaskNotExist.Equal(s.UpdateSubTaskCfgs(cfg1)), IsTrue)
+	s.subTaskCfgs.Store(task, map[string]config.SubTaskConfig{source1: cfg1})
+
+	// only the existing subtasks of one task can be updated
+	cfg3 := cfg1
+	cfg3.Name = notExist
+	c.Assert(terror.ErrSchedulerMultiTask.Equal(s.UpdateSubTaskCfgs(cfg1, cfg3)), IsTrue)
+	c.Assert(terror.ErrSchedulerSubTaskOpSourceNotExist.Equal(s.UpdateSubTaskCfgs(cfg1, cfg2)), IsTrue)
+
+	// the config is updated in etcd and memory, the stage is not changed
+	cfg1.RulesVersion = 1
+	c.Assert(s.UpdateSubTaskCfgs(cfg1), IsNil)
+	t.subTaskCfgExist(c, s, cfg1)
+	c.Assert(s.GetExpectSubTaskStage(task, source1).Expect, Equals, pb.Stage_InvalidStage)
+}
@@ -86,6 +86,9 @@ type Worker struct {
 
 	// the source ID from which the worker is pulling relay log. should keep consistent with Scheduler.relayWorkers
 	relaySource string
+
+	// the labels of the DM-worker, it may be changed when the DM-worker registers again.
+	labels map[string]string
 }
 
 // NewWorker creates a new Worker instance with Offline stage.
@@ -99,6 +102,7 @@ func NewWorker(baseInfo ha.WorkerInfo, securityCfg config.Security) (*Worker, er
 		cli:      cli,
 		baseInfo: baseInfo,
 		stage:    WorkerOffline,
+		labels:   baseInfo.Labels,
 	}
 	w.reportMetrics()
 	return w, nil
@@ -239,6 +243,19 @@ func (w *Worker) RelaySourceID() string {
 	return w.relaySource
 }
 
+// Labels returns the labels of the worker.
+func (w *Worker) Labels() map[string]string {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.labels
+}
+
+func (w *Worker) setLabels(labels map[string]string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.labels = labels
+}
+
 // SendRequest sends request to the DM-worker instance.
 func (w *Worker) SendRequest(ctx context.Context, req *workerrpc.Request, d time.Duration) (*workerrpc.Response, error) {
 	return w.cli.SendRequest(ctx, req, d)
@@ -124,6 +124,9 @@ type Server struct {
 
 	openapiHandles *gin.Engine // injected in `InitOpenAPIHandles`
 
+	// exports the audit logs to the sinks, nil if the audit log is disabled.
+	auditLogger *auditLogger
+
 	clusterID atomic.Uint64
 }
 
@@ -135,7 +138,7 @@ func NewServer(cfg *Config) *Server {
 		scheduler: scheduler.NewScheduler(&logger, cfg.Security),
 		ap:        NewAgentPool(&RateLimitConfig{rate: cfg.RPCRateLimit, burst: cfg.RPCRateBurst}),
 	}
-	server.pessimist = shardddl.NewPessimist(&logger, server.getTaskResources)
+	server.pessimist = shardddl.NewPessimist(&logger, server.getTaskResources, server.getTaskShardDDLLockTimeout)
 	server.optimist = shardddl.NewOptimist(&logger, server.scheduler.GetDownstreamMetaByTask)
 	server.closed.Store(true)
 	setUseTLS(&cfg.Security)
@@ -183,6 +186,13 @@ func (s *Server) Start(ctx context.Context) (err error) {
 
 	registerOnce.Do(metrics.RegistryMetrics)
 
+	if s.cfg.AuditLog.Enable {
+		s.auditLogger, err = newAuditLogger(s.cfg.AuditLog)
+		if err != nil {
+			return
+		}
+	}
+
 	// HTTP handlers on etcd's client IP:port. etcd will add a builtin `/metrics` route
 	// NOTE: after received any HTTP request from chrome browser,
 	// the server may be blocked when closing sometime.
@@ -205,7 +215,11 @@ func (s *Server) Start(ctx context.Context) (err error) {
 	}
 
 	// gRPC API server
-	gRPCSvr := func(gs *grpc.Server) { pb.RegisterMasterServer(gs, s) }
+	var masterSvr pb.MasterServer = s
+	if s.auditLogger != nil {
+		masterSvr = &auditServer{Server: s}
+	}
+	gRPCSvr := func(gs *grpc.Server) { pb.RegisterMasterServer(gs, masterSvr) }
 
 	// start embed etcd server, gRPC API server and HTTP (API, status and debug) server.
 	s.etcd, err = startEtcd(etcdCfg, gRPCSvr, userHandles, etcdStartTimeout)
@@ -241,6 +255,20 @@ func (s *Server) Start(ctx context.Context) (err error) {
 		s.electionNotify(ctx)
 	}()
 
+	s.bgFunWg.Add(1)
+	go func() {
+		defer s.bgFunWg.Done()
+		s.runWebhookNotifier(ctx)
+	}()
+
+	if s.auditLogger != nil && s.cfg.AuditLog.Retention > 0 {
+		s.bgFunWg.Add(1)
+		go func() {
+			defer s.bgFunWg.Done()
+			s.cleanAuditLogs(ctx)
+		}()
+	}
+
 	runBackgroundOnce.Do(func() {
 		s.bgFunWg.Add(1)
 		go func() {
@@ -289,6 +317,10 @@ func (s *Server) Close() {
 	if s.etcd != nil {
 		s.etcd.Close()
 	}
+
+	if s.auditLogger != nil {
+		s.auditLogger.close()
+	}
 	s.closed.Store(true)
 }
 
@@ -314,7 +346,7 @@ func (s *Server) RegisterWorker(ctx context.Context, req *pb.RegisterWorkerReque
 		return resp2, err2
 	}
 
-	err := s.scheduler.AddWorker(req.Name, req.Address)
+	err := s.scheduler.AddWorker(req.Name, req.Address, req.Labels)
 	if err != nil {
 		// nolint:nilerr
 		return &pb.RegisterWorkerResponse{
@@ -329,6 +361,7 @@ func (s *Server) RegisterWorker(ctx context.Context, req *pb.RegisterWorkerReque
 }
 
 // OfflineMember removes info of the master/worker which has been Closed
+// an online worker can be drained before removed
 // all the masters are store in etcd member list
 // all the workers are store in the path:
 // key:   /dm-worker/r
@@ -345,6 +378,23 @@ func (s *Server) OfflineMember(ctx context.Context, req *pb.OfflineMemberRequest
 
 	switch req.Type {
 	case ctlcommon.Worker:
+		if req.Drain {
+			if err := s.scheduler.DrainWorker(ctx, req.Name); err != nil {
+				// nolint:nilerr
+				return &pb.OfflineMemberResponse{
+					Result: false,
+					Msg:    err.Error(),
+				}, nil
+			}
+			// the drained worker is still alive, it's not bound to any source until it restarts or shuts down.
+			if w := s.scheduler.GetWorkerByName(req.Name); w != nil && w.Stage() != scheduler.WorkerOffline {
+				log.L().Info("drain worker successfully", zap.String("name", req.Name))
+				return &pb.OfflineMemberResponse{
+					Result: true,
+					Msg:    fmt.Sprintf("worker %s is drained, please shutdown it and offline it again to remove it, or restart it to make it schedulable", req.Name),
+				}, nil
+			}
+		}
 		err := s.scheduler.RemoveWorker(req.Name)
 		if err != nil {
 			// nolint:nilerr
@@ -1076,6 +1126,16 @@ func (s *Server) getTaskResources(task string) []string {
 	return ret
 }
 
+// getTaskShardDDLLockTimeout gets the timeout and the strategy to unlock the pessimistic shard DDL locks of the task.
+func (s *Server) getTaskShardDDLLockTimeout(task string) (int, string) {
+	s.Lock()
+	defer s.Unlock()
+	for _, cfg := range s.scheduler.GetSubTaskCfgsByTask(task) {
+		return cfg.ShardDDLLockTimeout, cfg.ShardDDLLockTimeoutStrategy
+	}
+	return 0, ""
+}
+
 // getStatusFromWorkers does RPC request to get status from dm-workers.
 func (s *Server) getStatusFromWorkers(
 	ctx context.Context, sources []string, taskName string, specifiedSource bool) []*pb.QueryStatusResponse {
@@ -1641,7 +1701,7 @@ func (s *Server) removeMetaData(ctx context.Context, taskName, metaSchema string
 
 	ctctx := tcontext.NewContext(ctx, log.With(zap.String("job", "remove metadata")))
 
-	sqls := make([]string, 0, 4)
+	sqls := make([]string, 0, 10)
 	// clear loader and syncer checkpoints
 	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
 		dbutil.TableName(metaSchema, cputil.LoaderCheckpoint(taskName))))
@@ -1651,6 +1711,16 @@ func (s *Server) removeMetaData(ctx context.Context, taskName, metaSchema string
 		dbutil.TableName(metaSchema, cputil.SyncerCheckpoint(taskName))))
 	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
 		dbutil.TableName(metaSchema, cputil.SyncerShardMeta(taskName))))
+	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
+		dbutil.TableName(metaSchema, cputil.SyncerDDLHistory(taskName))))
+	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
+		dbutil.TableName(metaSchema, cputil.SyncerGTIDSkipList(taskName))))
+	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
+		dbutil.TableName(metaSchema, cputil.SyncerSchemaSnapshot(taskName))))
+	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
+		dbutil.TableName(metaSchema, cputil.SyncerRules(taskName))))
+	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
+		dbutil.TableName(metaSchema, cputil.SyncerPausedTables(taskName))))
 	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
 		dbutil.TableName(metaSchema, cputil.SyncerOnlineDDL(taskName))))
 
@@ -2562,7 +2632,7 @@ func (s *Server) sharedLogic(ctx context.Context, req interface{}, respPointer i
 	if needForward {
 		log.L().Info("will forward after a short interval", zap.String("from", s.cfg.Name), zap.String("to", s.leader.Load()), zap.String("request", methodName))
 		time.Sleep(100 * time.Millisecond)
-		params := []reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(req)}
+		params := []reflect.Value{reflect.ValueOf(s.withForwardedAuditIdentity(ctx)), reflect.ValueOf(req)}
 		results := reflect.ValueOf(s.leaderClient).MethodByName(methodName).Call(params)
 		// result's inner types should be (*pb.XXResponse, error), which is same as s.leaderClient.XXRPCMethod
 		reflect.ValueOf(respPointer).Elem().Set(results[0])
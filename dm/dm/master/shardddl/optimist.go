@@ -161,6 +161,39 @@ func (o *Optimist) ShowLocks(task string, sources []string) []*pb.DDLLock {
 	return ret
 }
 
+// InspectLocks returns the details of the shard DDL locks of the task, it's used to diagnose the conflicts.
+func (o *Optimist) InspectLocks(task string) []optimism.LockInspection {
+	locks := o.lk.Locks()
+	ret := make([]optimism.LockInspection, 0, len(locks))
+	for _, lock := range locks {
+		if lock.Task == task {
+			ret = append(ret, lock.Inspect())
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].ID < ret[j].ID
+	})
+	return ret
+}
+
+// ResolveLockConflict resolves the conflicts of the shard DDL lock by picking the schema of the table as the winning
+// schema, the DDLs rejected because of conflicts can be synced again after the task is resumed.
+func (o *Optimist) ResolveLockConflict(lockID, source, schema, table string) (optimism.LockInspection, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	lock := o.lk.FindLock(lockID)
+	if lock == nil {
+		return optimism.LockInspection{}, terror.ErrMasterLockNotFound.Generate(lockID)
+	}
+	if err := lock.ResolveConflict(source, schema, table); err != nil {
+		return optimism.LockInspection{}, err
+	}
+	o.logger.Info("the conflicts of the shard DDL lock have been resolved", zap.String("lock", lockID),
+		zap.String("source", source), zap.String("schema", schema), zap.String("table", table))
+	return lock.Inspect(), nil
+}
+
 // RemoveMetaDataWithTask removes meta data for a specified task
 // NOTE: this function can only be used when the specified task is not running.
 // This function only be used when --remove-meta or stop-task
@@ -20,6 +20,7 @@ import (
 
 	. "github.com/pingcap/check"
 	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	"github.com/pingcap/tidb-tools/pkg/schemacmp"
 	tiddl "github.com/pingcap/tidb/ddl"
 	"github.com/pingcap/tidb/parser"
 	"github.com/pingcap/tidb/parser/ast"
@@ -33,6 +34,7 @@ import (
 	"github.com/pingcap/tiflow/dm/dm/pb"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/pingcap/tiflow/dm/pkg/shardddl/optimism"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 )
 
@@ -692,6 +694,25 @@ func (t *testOptimist) TestOptimistLockConflict(c *C) {
 	c.Assert(len(opCh), Equals, 0)
 	c.Assert(len(errCh), Equals, 0)
 
+	// inspect the conflicts.
+	lockID := utils.GenDDLLockID(task, downSchema, downTable)
+	inspections := o.InspectLocks(task)
+	c.Assert(inspections, HasLen, 1)
+	c.Assert(inspections[0].ID, Equals, lockID)
+	c.Assert(inspections[0].Tables, HasLen, 2)
+	c.Assert(inspections[0].Tables[1].Conflict, NotNil)
+	c.Assert(inspections[0].ConflictColumns, HasLen, 1)
+	c.Assert(inspections[0].ConflictColumns[0].Name, Equals, "c1")
+	c.Assert(o.InspectLocks("not-exist"), HasLen, 0)
+
+	// resolve the conflicts by picking the schema of the first table.
+	_, err = o.ResolveLockConflict("not-exist", source1, "foo", "bar-1")
+	c.Assert(terror.ErrMasterLockNotFound.Equal(err), IsTrue)
+	inspection, err := o.ResolveLockConflict(lockID, source1, "foo", "bar-1")
+	c.Assert(err, IsNil)
+	c.Assert(inspection.ConflictColumns, HasLen, 0)
+	c.Assert(inspection.Joined.String(), Equals, schemacmp.Encode(ti1).String())
+
 	// PUT i3, no conflict now.
 	// case for handle-error replace
 	rev3, err := optimism.PutInfo(etcdTestCli, i3)
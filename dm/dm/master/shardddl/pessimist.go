@@ -37,6 +37,9 @@ var (
 	// variables to control the behavior of waiting for the operation to be done for `UnlockLock`.
 	unlockWaitInterval = time.Second
 	unlockWaitNum      = 10
+
+	// the interval to check whether some locks should be unlocked automatically after timeout.
+	autoUnlockCheckInterval = 10 * time.Second
 )
 
 // Pessimist used to coordinate the shard DDL migration in pessimism mode.
@@ -54,17 +57,21 @@ type Pessimist struct {
 
 	// taskSources used to get all sources relative to the given task.
 	taskSources func(task string) []string
+	// lockTimeout used to get the timeout (in seconds) and the strategy to unlock the locks of the given task automatically.
+	lockTimeout func(task string) (int, string)
 
 	infoOpMu sync.Mutex
 }
 
 // NewPessimist creates a new Pessimist instance.
-func NewPessimist(pLogger *log.Logger, taskSources func(task string) []string) *Pessimist {
+// lockTimeout can be nil if the locks should never be unlocked automatically.
+func NewPessimist(pLogger *log.Logger, taskSources func(task string) []string, lockTimeout func(task string) (int, string)) *Pessimist {
 	return &Pessimist{
 		logger:      pLogger.WithFields(zap.String("component", "shard DDL pessimist")),
 		closed:      true, // mark as closed before started.
 		lk:          pessimism.NewLockKeeper(),
 		taskSources: taskSources,
+		lockTimeout: lockTimeout,
 	}
 }
 
@@ -89,6 +96,13 @@ func (p *Pessimist) Start(pCtx context.Context, etcdCli *clientv3.Client) error
 		//nolint:errcheck
 		p.run(ctx, etcdCli, rev1, rev2)
 	}()
+	if p.lockTimeout != nil {
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			p.autoUnlockLocks(ctx)
+		}()
+	}
 
 	p.closed = false // started now.
 	p.cancel = cancel
@@ -378,6 +392,69 @@ func (p *Pessimist) UnlockLock(ctx context.Context, id, replaceOwner string, for
 	return nil
 }
 
+// AutoUnlockRecords returns the audit records of the locks of the task unlocked automatically after timeout.
+func (p *Pessimist) AutoUnlockRecords(task string) ([]pessimism.AutoUnlockRecord, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return nil, terror.ErrMasterPessimistNotStarted.Generate()
+	}
+	records, _, err := pessimism.GetAutoUnlockRecords(p.cli, task)
+	return records, err
+}
+
+// autoUnlockLocks checks and unlocks the locks waiting for the lagging sources longer than the timeout periodically.
+func (p *Pessimist) autoUnlockLocks(ctx context.Context) {
+	ticker := time.NewTicker(autoUnlockCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.unlockTimeoutLocks(ctx)
+		}
+	}
+}
+
+// unlockTimeoutLocks unlocks the un-synced locks which have waited longer than the timeout of their tasks by the
+// strategies of the tasks, and records what is auto-resolved into etcd.
+func (p *Pessimist) unlockTimeoutLocks(ctx context.Context) {
+	for id, lock := range p.lk.Locks() {
+		timeout, strategy := p.lockTimeout(lock.Task)
+		if timeout <= 0 || time.Since(lock.CreateTime) < time.Duration(timeout)*time.Second {
+			continue
+		}
+		// the synced lock is resolving by the sources now.
+		if synced, _ := lock.IsSynced(); synced || lock.IsResolved() {
+			continue
+		}
+
+		var synced, unsynced []string
+		for source, isSynced := range lock.Ready() {
+			if isSynced {
+				synced = append(synced, source)
+			} else {
+				unsynced = append(unsynced, source)
+			}
+		}
+		sort.Strings(synced)
+		sort.Strings(unsynced)
+		p.logger.Warn("unlock the lock automatically after timeout", zap.String("lock", id), zap.String("strategy", strategy),
+			zap.Int("timeout", timeout), zap.Strings("un-synced", unsynced), zap.Strings("synced", synced))
+
+		err := p.UnlockLock(ctx, id, "", strategy == config.ShardDDLLockTimeoutForceExec)
+		record := pessimism.NewAutoUnlockRecord(lock, synced, unsynced, strategy, timeout)
+		if err != nil {
+			record.Error = err.Error()
+			p.logger.Error("fail to unlock the lock automatically", zap.String("lock", id), zap.Error(err))
+		}
+		if _, err = pessimism.PutAutoUnlockRecord(p.cli, record); err != nil {
+			p.logger.Error("fail to put the auto-unlock record", zap.Reflect("record", record), zap.Error(err))
+		}
+	}
+}
+
 // RemoveMetaData removes meta data for a specified task
 // NOTE: this function can only be used when the specified task is not running.
 func (p *Pessimist) RemoveMetaData(task string) error {
@@ -24,6 +24,7 @@ import (
 	"go.etcd.io/etcd/clientv3"
 	v3rpc "go.etcd.io/etcd/etcdserver/api/v3rpc/rpctypes"
 	"go.etcd.io/etcd/integration"
+	"go.uber.org/atomic"
 
 	"github.com/pingcap/tiflow/dm/dm/common"
 	"github.com/pingcap/tiflow/dm/dm/config"
@@ -69,7 +70,8 @@ func TestShardDDL(t *testing.T) {
 func clearTestInfoOperation(c *C) {
 	clearInfo := clientv3.OpDelete(common.ShardDDLPessimismInfoKeyAdapter.Path(), clientv3.WithPrefix())
 	clearOp := clientv3.OpDelete(common.ShardDDLPessimismOperationKeyAdapter.Path(), clientv3.WithPrefix())
-	_, err := etcdTestCli.Txn(context.Background()).Then(clearInfo, clearOp).Commit()
+	clearRecord := clientv3.OpDelete(common.ShardDDLPessimismAutoUnlockKeyAdapter.Path(), clientv3.WithPrefix())
+	_, err := etcdTestCli.Txn(context.Background()).Then(clearInfo, clearOp, clearRecord).Commit()
 	c.Assert(err, IsNil)
 }
 
@@ -111,7 +113,7 @@ func (t *testPessimist) testPessimistProgress(c *C, restart int) {
 			return []string{}
 		}
 		logger = log.L()
-		p      = NewPessimist(&logger, sources)
+		p      = NewPessimist(&logger, sources, nil)
 
 		rebuildPessimist = func(ctx context.Context) {
 			switch restart {
@@ -120,7 +122,7 @@ func (t *testPessimist) testPessimistProgress(c *C, restart int) {
 				c.Assert(p.Start(ctx, etcdTestCli), IsNil)
 			case restartNewInstance:
 				p.Close()
-				p = NewPessimist(&logger, sources)
+				p = NewPessimist(&logger, sources, nil)
 				c.Assert(p.Start(ctx, etcdTestCli), IsNil)
 			}
 		}
@@ -368,7 +370,7 @@ func (t *testPessimist) TestSourceReEntrant(c *C) {
 			return []string{}
 		}
 		logger = log.L()
-		p      = NewPessimist(&logger, sources)
+		p      = NewPessimist(&logger, sources, nil)
 	)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -564,7 +566,7 @@ func (t *testPessimist) TestUnlockSourceMissBeforeSynced(c *C) {
 			return []string{}
 		}
 		logger = log.L()
-		p      = NewPessimist(&logger, sources)
+		p      = NewPessimist(&logger, sources, nil)
 	)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -656,7 +658,7 @@ func (t *testPessimist) TestUnlockSourceInterrupt(c *C) {
 			return []string{}
 		}
 		logger = log.L()
-		p      = NewPessimist(&logger, sources)
+		p      = NewPessimist(&logger, sources, nil)
 	)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -773,7 +775,7 @@ func (t *testPessimist) TestUnlockSourceOwnerRemoved(c *C) {
 			return []string{}
 		}
 		logger = log.L()
-		p      = NewPessimist(&logger, sources)
+		p      = NewPessimist(&logger, sources, nil)
 	)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -829,6 +831,83 @@ func (t *testPessimist) TestUnlockSourceOwnerRemoved(c *C) {
 	t.noLockExist(c, p)
 }
 
+func (t *testPessimist) TestAutoUnlockAfterTimeout(c *C) {
+	defer clearTestInfoOperation(c)
+
+	oriUnlockWaitInterval, oriAutoUnlockCheckInterval := unlockWaitInterval, autoUnlockCheckInterval
+	unlockWaitInterval, autoUnlockCheckInterval = 50*time.Millisecond, 100*time.Millisecond
+	defer func() {
+		unlockWaitInterval, autoUnlockCheckInterval = oriUnlockWaitInterval, oriAutoUnlockCheckInterval
+	}()
+
+	var (
+		task          = "task-auto-unlock-after-timeout"
+		source1       = "mysql-replica-1"
+		source2       = "mysql-replica-2"
+		schema, table = "foo", "bar"
+		DDLs          = []string{"ALTER TABLE bar ADD COLUMN c1 INT"}
+		ID            = fmt.Sprintf("%s-`%s`.`%s`", task, schema, table)
+		i11           = pessimism.NewInfo(task, source1, schema, table, DDLs)
+
+		sources = func(string) []string {
+			return []string{source1, source2}
+		}
+		timeout     = 1
+		timeoutSet  atomic.Bool
+		lockTimeout = func(string) (int, string) {
+			if !timeoutSet.Load() {
+				return 0, ""
+			}
+			return timeout, config.ShardDDLLockTimeoutForceExec
+		}
+		logger = log.L()
+		p      = NewPessimist(&logger, sources, lockTimeout)
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c.Assert(p.Start(ctx, etcdTestCli), IsNil)
+	defer p.Close()
+
+	// 1. PUT i11, will create a lock but not synced.
+	_, err := pessimism.PutInfo(etcdTestCli, i11)
+	c.Assert(err, IsNil)
+	c.Assert(utils.WaitSomething(30, 100*time.Millisecond, func() bool {
+		return len(p.Locks()) == 1
+	}), IsTrue)
+	c.Assert(p.Locks(), HasKey, ID)
+
+	// 2. the lock is kept if no timeout is set for the task.
+	time.Sleep(time.Duration(timeout)*time.Second + 2*autoUnlockCheckInterval)
+	c.Assert(p.Locks(), HasKey, ID)
+
+	// 3. the lock is unlocked by force after timeout even no `done` marked for the owner.
+	timeoutSet.Store(true)
+	c.Assert(utils.WaitSomething(30, 100*time.Millisecond, func() bool {
+		return len(p.Locks()) == 0
+	}), IsTrue)
+	t.noLockExist(c, p)
+
+	records, err := p.AutoUnlockRecords(task)
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 1)
+	c.Assert(records[0].ID, Equals, ID)
+	c.Assert(records[0].Owner, Equals, source1)
+	c.Assert(records[0].DDLs, DeepEquals, DDLs)
+	c.Assert(records[0].Synced, DeepEquals, []string{source1})
+	c.Assert(records[0].Unsynced, DeepEquals, []string{source2})
+	c.Assert(records[0].Strategy, Equals, config.ShardDDLLockTimeoutForceExec)
+	c.Assert(records[0].Timeout, Equals, timeout)
+	c.Assert(records[0].Error, Equals, "")
+
+	// 4. the records are removed along with the meta data of the task.
+	c.Assert(p.RemoveMetaData(task), IsNil)
+	records, err = p.AutoUnlockRecords(task)
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 0)
+}
+
 func (t *testPessimist) TestMeetEtcdCompactError(c *C) {
 	defer clearTestInfoOperation(c)
 
@@ -864,7 +943,7 @@ func (t *testPessimist) TestMeetEtcdCompactError(c *C) {
 			return []string{}
 		}
 		logger = log.L()
-		p      = NewPessimist(&logger, sources)
+		p      = NewPessimist(&logger, sources, nil)
 	)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -0,0 +1,414 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/docker/go-units"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/dm/master/scheduler"
+	"github.com/pingcap/tiflow/dm/dm/pb"
+	"github.com/pingcap/tiflow/dm/openapi"
+	"github.com/pingcap/tiflow/dm/pkg/ha"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+const (
+	defaultWebhookCheckInterval = "30s"
+	defaultWebhookTimeout       = "5s"
+	defaultWebhookMaxRetry      = 3
+
+	// webhookEventHeader is the HTTP header of the event name of the notification.
+	webhookEventHeader = "X-DM-Event"
+	// webhookSignatureHeader is the HTTP header of the HMAC-SHA256 signature of the notification body.
+	webhookSignatureHeader = "X-DM-Signature"
+)
+
+// the interval before the first retry to send the notification, it doubles for each retry.
+var webhookRetryInterval = time.Second
+
+// allWebhookEvents are the events that can be notified by the webhooks.
+var allWebhookEvents = []openapi.WebhookEvent{
+	openapi.WebhookEventTaskPausedOnError,
+	openapi.WebhookEventShardDdlWaiting,
+	openapi.WebhookEventRelayDiskAlarm,
+	openapi.WebhookEventWorkerOffline,
+}
+
+// WebhookConfig is the config of the notifications sent to the webhooks.
+type WebhookConfig struct {
+	// the interval to check the states of the tasks, sources and workers for the events.
+	CheckIntervalStr string        `toml:"check-interval" json:"check-interval"`
+	CheckInterval    time.Duration `toml:"-" json:"-"`
+	// the timeout of each request to the webhook.
+	TimeoutStr string        `toml:"timeout" json:"timeout"`
+	Timeout    time.Duration `toml:"-" json:"-"`
+	// the max retries when failing to send a notification.
+	MaxRetry int `toml:"max-retry" json:"max-retry"`
+	// the relay disk alarm is raised when the relay log files of a DM-worker exceed the size, e.g. "100GiB".
+	// empty means never alarm.
+	RelayDiskAlarm      string `toml:"relay-disk-alarm" json:"relay-disk-alarm"`
+	RelayDiskAlarmBytes int64  `toml:"-" json:"-"`
+}
+
+// adjust checks and adjusts the config.
+func (c *WebhookConfig) adjust() error {
+	var err error
+	if c.CheckIntervalStr == "" {
+		c.CheckIntervalStr = defaultWebhookCheckInterval
+	}
+	if c.CheckInterval, err = time.ParseDuration(c.CheckIntervalStr); err != nil || c.CheckInterval <= 0 {
+		return terror.ErrMasterInvalidWebhookConfig.Generatef("check-interval %s should be a positive duration", c.CheckIntervalStr)
+	}
+	if c.TimeoutStr == "" {
+		c.TimeoutStr = defaultWebhookTimeout
+	}
+	if c.Timeout, err = time.ParseDuration(c.TimeoutStr); err != nil || c.Timeout <= 0 {
+		return terror.ErrMasterInvalidWebhookConfig.Generatef("timeout %s should be a positive duration", c.TimeoutStr)
+	}
+	if c.MaxRetry < 0 {
+		return terror.ErrMasterInvalidWebhookConfig.Generate("max-retry should not be negative")
+	}
+	if c.MaxRetry == 0 {
+		c.MaxRetry = defaultWebhookMaxRetry
+	}
+	if c.RelayDiskAlarm != "" {
+		if c.RelayDiskAlarmBytes, err = units.RAMInBytes(c.RelayDiskAlarm); err != nil || c.RelayDiskAlarmBytes <= 0 {
+			return terror.ErrMasterInvalidWebhookConfig.Generatef("relay-disk-alarm %s should be a positive size", c.RelayDiskAlarm)
+		}
+	}
+	return nil
+}
+
+// adjustWebhook checks the webhook to register, all events are notified if no event is specified.
+func adjustWebhook(w *ha.Webhook) error {
+	if w.Name == "" {
+		return terror.ErrMasterInvalidWebhook.Generate(w.Name, "the name should not be empty")
+	}
+	u, err := url.Parse(w.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return terror.ErrMasterInvalidWebhook.Generate(w.Name, "the URL should be an absolute HTTP or HTTPS URL")
+	}
+	if len(w.Events) == 0 {
+		for _, ev := range allWebhookEvents {
+			w.Events = append(w.Events, string(ev))
+		}
+		return nil
+	}
+	for _, ev := range w.Events {
+		valid := false
+		for _, ev2 := range allWebhookEvents {
+			valid = valid || ev == string(ev2)
+		}
+		if !valid {
+			return terror.ErrMasterInvalidWebhook.Generate(w.Name, "unknown event "+ev)
+		}
+	}
+	return nil
+}
+
+// webhookEvent is the notification posted to the webhooks in JSON.
+type webhookEvent struct {
+	Event   string    `json:"event"`
+	Time    time.Time `json:"time"`
+	Task    string    `json:"task,omitempty"`
+	Source  string    `json:"source,omitempty"`
+	Worker  string    `json:"worker,omitempty"`
+	Lock    string    `json:"lock,omitempty"`
+	Message string    `json:"message"`
+}
+
+// key identifies the state which the event is raised for.
+func (e webhookEvent) key() string {
+	return strings.Join([]string{e.Event, e.Task, e.Source, e.Worker, e.Lock}, "/")
+}
+
+// webhookNotifier notifies the webhooks when the tasks, sources and workers transit into the states of the events.
+// an event is notified once when the state is entered, and notified again only after the state is left and entered
+// again. the states are not kept across the leader changes, so the new leader notifies the states once again.
+type webhookNotifier struct {
+	cfg    WebhookConfig
+	client *http.Client
+	// the events raised in the last check, by their keys.
+	raised map[string]webhookEvent
+}
+
+func newWebhookNotifier(cfg WebhookConfig) *webhookNotifier {
+	return &webhookNotifier{
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+		raised: make(map[string]webhookEvent),
+	}
+}
+
+// transit returns the events newly raised compared to the last check. the states matched by `unknown` are failed to
+// be collected in this check, the events raised for them in the last check are kept so they are not notified again
+// after the states are collected successfully.
+func (n *webhookNotifier) transit(events []webhookEvent, unknown ...webhookEvent) []webhookEvent {
+	raised := make(map[string]webhookEvent, len(events))
+	for key, ev := range n.raised {
+		for _, u := range unknown {
+			if ev.Event == u.Event && (u.Source == "" || ev.Source == u.Source) {
+				raised[key] = ev
+				break
+			}
+		}
+	}
+	newEvents := make([]webhookEvent, 0, len(events))
+	for _, ev := range events {
+		key := ev.key()
+		raised[key] = ev
+		if _, ok := n.raised[key]; !ok {
+			newEvents = append(newEvents, ev)
+		}
+	}
+	n.raised = raised
+	return newEvents
+}
+
+// reset forgets the raised events, it's called when the DM-master is not the leader.
+func (n *webhookNotifier) reset() {
+	n.raised = make(map[string]webhookEvent)
+}
+
+// send posts the event to the webhook, and retries with doubling intervals if failed.
+func (n *webhookNotifier) send(ctx context.Context, w ha.Webhook, ev webhookEvent) error {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	interval := webhookRetryInterval
+	for i := 0; ; i++ {
+		if err = n.post(ctx, w, ev.Event, body); err == nil || i >= n.cfg.MaxRetry {
+			return err
+		}
+		log.L().Warn("fail to send notification to webhook, will retry", zap.String("webhook", w.Name),
+			zap.String("event", ev.Event), zap.Int("retry", i+1), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+		interval *= 2
+	}
+}
+
+// post posts the body to the webhook once.
+func (n *webhookNotifier) post(ctx context.Context, w ha.Webhook, event string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhookEventHeader, event)
+	if w.Secret != "" {
+		req.Header.Set(webhookSignatureHeader, "sha256="+signWebhookBody(w.Secret, body))
+	}
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// signWebhookBody returns the hex of the HMAC-SHA256 of the body with the secret.
+func signWebhookBody(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// runWebhookNotifier checks the events periodically and notifies the webhooks when the DM-master is the leader.
+func (s *Server) runWebhookNotifier(ctx context.Context) {
+	n := newWebhookNotifier(s.cfg.Webhook)
+	ticker := time.NewTicker(s.cfg.Webhook.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !s.election.IsLeader() {
+				n.reset()
+				continue
+			}
+			s.notifyWebhooks(ctx, n)
+		}
+	}
+}
+
+// notifyWebhooks sends the newly raised events to the webhooks which subscribe them.
+func (s *Server) notifyWebhooks(ctx context.Context, n *webhookNotifier) {
+	hooks, _, err := ha.GetAllWebhooks(s.etcdClient)
+	if err != nil {
+		log.L().Warn("fail to get webhooks", zap.Error(err))
+		return
+	}
+	subscribed := make(map[string]struct{})
+	for _, w := range hooks {
+		for _, ev := range w.Events {
+			subscribed[ev] = struct{}{}
+		}
+	}
+	events, unknown := s.collectWebhookEvents(ctx, subscribed)
+	events = n.transit(events, unknown...)
+
+	for _, w := range hooks {
+		for _, ev := range events {
+			if !webhookSubscribes(w, ev.Event) {
+				continue
+			}
+			s.bgFunWg.Add(1)
+			go func(w ha.Webhook, ev webhookEvent) {
+				defer s.bgFunWg.Done()
+				if err2 := n.send(ctx, w, ev); err2 != nil {
+					log.L().Error("fail to send notification to webhook", zap.String("webhook", w.Name),
+						zap.String("event", ev.Event), zap.Error(err2))
+				}
+			}(w, ev)
+		}
+	}
+}
+
+// webhookSubscribes returns whether the webhook subscribes the event.
+func webhookSubscribes(w ha.Webhook, event string) bool {
+	for _, ev := range w.Events {
+		if ev == event {
+			return true
+		}
+	}
+	return false
+}
+
+// collectWebhookEvents collects the events of the current states of the tasks, sources and workers, only the
+// subscribed events are collected. the states failed to be collected are returned as `unknown`, which only have the
+// event name, and the source if only the states of the source are failed to be collected.
+func (s *Server) collectWebhookEvents(ctx context.Context, subscribed map[string]struct{}) (events, unknown []webhookEvent) {
+	now := time.Now()
+	isSubscribed := func(ev openapi.WebhookEvent) bool {
+		_, ok := subscribed[string(ev)]
+		return ok
+	}
+
+	if isSubscribed(openapi.WebhookEventTaskPausedOnError) {
+		sourceM := make(map[string]struct{})
+		for _, stCfgs := range s.scheduler.GetSubTaskCfgs() {
+			for source := range stCfgs {
+				sourceM[source] = struct{}{}
+			}
+		}
+		for _, resp := range s.getStatusFromWorkers(ctx, utils.SetToSlice(sourceM), "", false) {
+			if resp.SourceStatus == nil {
+				continue
+			}
+			if !resp.Result {
+				unknown = append(unknown, webhookEvent{
+					Event:  string(openapi.WebhookEventTaskPausedOnError),
+					Source: resp.SourceStatus.Source,
+				})
+				continue
+			}
+			for _, st := range resp.SubTaskStatus {
+				if st == nil || st.Stage != pb.Stage_Paused || st.Result == nil || len(st.Result.Errors) == 0 {
+					continue
+				}
+				events = append(events, webhookEvent{
+					Event:   string(openapi.WebhookEventTaskPausedOnError),
+					Time:    now,
+					Task:    st.Name,
+					Source:  resp.SourceStatus.Source,
+					Worker:  resp.SourceStatus.Worker,
+					Message: st.Result.Errors[0].Message + " " + st.Result.Errors[0].RawCause,
+				})
+			}
+		}
+	}
+
+	if isSubscribed(openapi.WebhookEventShardDdlWaiting) {
+		locks := append(s.pessimist.ShowLocks("", nil), s.optimist.ShowLocks("", nil)...)
+		for _, lock := range locks {
+			if len(lock.Unsynced) == 0 {
+				continue
+			}
+			events = append(events, webhookEvent{
+				Event: string(openapi.WebhookEventShardDdlWaiting),
+				Time:  now,
+				Task:  lock.Task,
+				Lock:  lock.ID,
+				Message: fmt.Sprintf("DDLs %v are waiting for the unsynced %v, synced %v",
+					lock.DDLs, lock.Unsynced, lock.Synced),
+			})
+		}
+	}
+
+	if isSubscribed(openapi.WebhookEventRelayDiskAlarm) && s.cfg.Webhook.RelayDiskAlarmBytes > 0 {
+		loads, err := ha.GetAllWorkerLoads(s.etcdClient)
+		if err != nil {
+			log.L().Warn("fail to get worker loads", zap.Error(err))
+			unknown = append(unknown, webhookEvent{Event: string(openapi.WebhookEventRelayDiskAlarm)})
+		}
+		for worker, load := range loads {
+			if load.RelayDiskUsage <= s.cfg.Webhook.RelayDiskAlarmBytes {
+				continue
+			}
+			events = append(events, webhookEvent{
+				Event:  string(openapi.WebhookEventRelayDiskAlarm),
+				Time:   now,
+				Worker: worker,
+				Message: fmt.Sprintf("relay log files take %s, exceeding the alarm size %s",
+					units.BytesSize(float64(load.RelayDiskUsage)), s.cfg.Webhook.RelayDiskAlarm),
+			})
+		}
+	}
+
+	if isSubscribed(openapi.WebhookEventWorkerOffline) {
+		workers, err := s.scheduler.GetAllWorkers()
+		if err != nil {
+			log.L().Warn("fail to get workers", zap.Error(err))
+			unknown = append(unknown, webhookEvent{Event: string(openapi.WebhookEventWorkerOffline)})
+		}
+		for _, w := range workers {
+			if w.Stage() != scheduler.WorkerOffline {
+				continue
+			}
+			info := w.BaseInfo()
+			events = append(events, webhookEvent{
+				Event:   string(openapi.WebhookEventWorkerOffline),
+				Time:    now,
+				Worker:  info.Name,
+				Message: fmt.Sprintf("DM-worker %s (%s) is offline", info.Name, info.Addr),
+			})
+		}
+	}
+	return events, unknown
+}
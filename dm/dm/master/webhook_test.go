@@ -0,0 +1,219 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/testutil"
+	"github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/openapi"
+	"github.com/pingcap/tiflow/dm/pkg/ha"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+func (t *testConfigSuite) TestWebhookConfig(c *check.C) {
+	cfg := WebhookConfig{}
+	c.Assert(cfg.adjust(), check.IsNil)
+	c.Assert(cfg.CheckInterval, check.Equals, 30*time.Second)
+	c.Assert(cfg.Timeout, check.Equals, 5*time.Second)
+	c.Assert(cfg.MaxRetry, check.Equals, defaultWebhookMaxRetry)
+	c.Assert(cfg.RelayDiskAlarmBytes, check.Equals, int64(0))
+
+	cfg = WebhookConfig{RelayDiskAlarm: "100GiB"}
+	c.Assert(cfg.adjust(), check.IsNil)
+	c.Assert(cfg.RelayDiskAlarmBytes, check.Equals, int64(100<<30))
+
+	for _, cfg = range []WebhookConfig{
+		{CheckIntervalStr: "0s"},
+		{CheckIntervalStr: "30"},
+		{TimeoutStr: "-1s"},
+		{MaxRetry: -1},
+		{RelayDiskAlarm: "many"},
+	} {
+		c.Assert(terror.ErrMasterInvalidWebhookConfig.Equal(cfg.adjust()), check.IsTrue, check.Commentf("%+v", cfg))
+	}
+}
+
+func (t *testConfigSuite) TestAdjustWebhook(c *check.C) {
+	// all events are notified by default.
+	w := ha.Webhook{Name: "hook", URL: "https://example.com/dm"}
+	c.Assert(adjustWebhook(&w), check.IsNil)
+	c.Assert(w.Events, check.HasLen, len(allWebhookEvents))
+
+	w = ha.Webhook{Name: "hook", URL: "http://127.0.0.1:8080", Events: []string{"worker-offline"}}
+	c.Assert(adjustWebhook(&w), check.IsNil)
+	c.Assert(w.Events, check.DeepEquals, []string{"worker-offline"})
+
+	for _, w = range []ha.Webhook{
+		{URL: "https://example.com/dm"},
+		{Name: "hook", URL: "example.com/dm"},
+		{Name: "hook", URL: "ftp://example.com/dm"},
+		{Name: "hook", URL: "https://example.com/dm", Events: []string{"task-stopped"}},
+	} {
+		c.Assert(terror.ErrMasterInvalidWebhook.Equal(adjustWebhook(&w)), check.IsTrue, check.Commentf("%+v", w))
+	}
+}
+
+func (t *testConfigSuite) TestWebhookNotifier(c *check.C) {
+	cfg := WebhookConfig{MaxRetry: 2}
+	c.Assert(cfg.adjust(), check.IsNil)
+	n := newWebhookNotifier(cfg)
+
+	// the events are notified once when raised.
+	ev1 := webhookEvent{Event: string(openapi.WebhookEventWorkerOffline), Worker: "worker1"}
+	ev2 := webhookEvent{Event: string(openapi.WebhookEventTaskPausedOnError), Task: "task1", Source: "source1"}
+	c.Assert(n.transit([]webhookEvent{ev1}), check.DeepEquals, []webhookEvent{ev1})
+	c.Assert(n.transit([]webhookEvent{ev1, ev2}), check.DeepEquals, []webhookEvent{ev2})
+	c.Assert(n.transit([]webhookEvent{ev2}), check.HasLen, 0)
+	// raised again after recovered.
+	c.Assert(n.transit([]webhookEvent{ev1, ev2}), check.DeepEquals, []webhookEvent{ev1})
+	n.reset()
+	c.Assert(n.transit([]webhookEvent{ev1, ev2}), check.DeepEquals, []webhookEvent{ev1, ev2})
+	// the raised events are kept when failing to collect their states, and not notified again after collected.
+	unknown1 := webhookEvent{Event: string(openapi.WebhookEventWorkerOffline)}
+	unknown2 := webhookEvent{Event: string(openapi.WebhookEventTaskPausedOnError), Source: "source2"}
+	c.Assert(n.transit(nil, unknown1, unknown2), check.HasLen, 0)
+	c.Assert(n.transit([]webhookEvent{ev1, ev2}), check.DeepEquals, []webhookEvent{ev2})
+	unknown2.Source = "source1"
+	c.Assert(n.transit([]webhookEvent{ev1}, unknown2), check.HasLen, 0)
+	c.Assert(n.transit([]webhookEvent{ev1, ev2}), check.HasLen, 0)
+
+	// the notification is signed and retried until succeeded.
+	originRetryInterval := webhookRetryInterval
+	webhookRetryInterval = 10 * time.Millisecond
+	defer func() {
+		webhookRetryInterval = originRetryInterval
+	}()
+	var (
+		mu        sync.Mutex
+		requests  int
+		failTimes int
+		received  webhookEvent
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests++
+		body, err := ioutil.ReadAll(r.Body)
+		c.Assert(err, check.IsNil)
+		c.Assert(r.Header.Get(webhookEventHeader), check.Equals, ev1.Event)
+		c.Assert(r.Header.Get(webhookSignatureHeader), check.Equals, "sha256="+signWebhookBody("secret", body))
+		if requests <= failTimes {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		c.Assert(json.Unmarshal(body, &received), check.IsNil)
+	}))
+	defer ts.Close()
+	hook := ha.Webhook{Name: "hook", URL: ts.URL, Secret: "secret"}
+
+	failTimes = 2
+	c.Assert(n.send(context.Background(), hook, ev1), check.IsNil)
+	c.Assert(requests, check.Equals, 3)
+	c.Assert(received, check.DeepEquals, ev1)
+
+	// fail after the max retries.
+	requests = 0
+	failTimes = 3
+	c.Assert(n.send(context.Background(), hook, ev1), check.ErrorMatches, ".*500.*")
+	c.Assert(requests, check.Equals, 3)
+}
+
+func (t *openAPISuite) TestWebhookAPI(c *check.C) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := setupServer(ctx, c)
+	defer func() {
+		cancel()
+		s.Close()
+	}()
+
+	received := make(chan webhookEvent, 10)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var ev webhookEvent
+		c.Assert(json.NewDecoder(r.Body).Decode(&ev), check.IsNil)
+		received <- ev
+	}))
+	defer ts.Close()
+
+	baseURL := "/api/v1/cluster/webhooks"
+	secret := "secret"
+	events := []openapi.WebhookEvent{openapi.WebhookEventWorkerOffline}
+	req := openapi.CreateWebhookRequest{Name: "hook1", Url: ts.URL, Secret: &secret, Events: &events}
+	result := testutil.NewRequest().Post(baseURL).WithJsonBody(req).GoWithHTTPHandler(t.testT, s.openapiHandles)
+	c.Assert(result.Code(), check.Equals, http.StatusCreated)
+	var hook openapi.Webhook
+	c.Assert(result.UnmarshalBodyToObject(&hook), check.IsNil)
+	c.Assert(hook, check.DeepEquals, openapi.Webhook{Name: "hook1", Url: ts.URL, Signed: true, Events: events})
+
+	// the webhook with the same name or an invalid URL can't be created.
+	result = testutil.NewRequest().Post(baseURL).WithJsonBody(req).GoWithHTTPHandler(t.testT, s.openapiHandles)
+	c.Assert(result.Code(), check.Equals, http.StatusBadRequest)
+	var errResp openapi.ErrorWithMessage
+	c.Assert(result.UnmarshalBodyToObject(&errResp), check.IsNil)
+	c.Assert(errResp.ErrorCode, check.Equals, int(terror.ErrMasterWebhookExist.Code()))
+	req2 := openapi.CreateWebhookRequest{Name: "hook2", Url: "not-a-url"}
+	result = testutil.NewRequest().Post(baseURL).WithJsonBody(req2).GoWithHTTPHandler(t.testT, s.openapiHandles)
+	c.Assert(result.Code(), check.Equals, http.StatusBadRequest)
+	c.Assert(result.UnmarshalBodyToObject(&errResp), check.IsNil)
+	c.Assert(errResp.ErrorCode, check.Equals, int(terror.ErrMasterInvalidWebhook.Code()))
+
+	result = testutil.NewRequest().Get(baseURL).GoWithHTTPHandler(t.testT, s.openapiHandles)
+	c.Assert(result.Code(), check.Equals, http.StatusOK)
+	var hookList openapi.GetWebhookListResponse
+	c.Assert(result.UnmarshalBodyToObject(&hookList), check.IsNil)
+	c.Assert(hookList.Total, check.Equals, 1)
+	c.Assert(hookList.Data[0], check.DeepEquals, hook)
+
+	// the worker which is not online is notified once.
+	c.Assert(s.scheduler.AddWorker("worker1", "127.0.0.1:8262", nil), check.IsNil)
+	n := newWebhookNotifier(s.cfg.Webhook)
+	s.notifyWebhooks(ctx, n)
+	select {
+	case ev := <-received:
+		c.Assert(ev.Event, check.Equals, string(openapi.WebhookEventWorkerOffline))
+		c.Assert(ev.Worker, check.Equals, "worker1")
+	case <-time.After(10 * time.Second):
+		c.Fatal("webhook is not notified")
+	}
+	s.notifyWebhooks(ctx, n)
+	c.Assert(utils.WaitSomething(10, 10*time.Millisecond, func() bool {
+		return len(received) > 0
+	}), check.IsFalse)
+
+	// the worker is not notified again after failing to get the workers in a check.
+	s.scheduler.Close()
+	s.notifyWebhooks(ctx, n)
+	c.Assert(s.scheduler.Start(ctx, s.etcdClient), check.IsNil)
+	s.notifyWebhooks(ctx, n)
+	c.Assert(utils.WaitSomething(10, 10*time.Millisecond, func() bool {
+		return len(received) > 0
+	}), check.IsFalse)
+
+	result = testutil.NewRequest().Delete(fmt.Sprintf("%s/%s", baseURL, "hook1")).GoWithHTTPHandler(t.testT, s.openapiHandles)
+	c.Assert(result.Code(), check.Equals, http.StatusNoContent)
+	result = testutil.NewRequest().Delete(fmt.Sprintf("%s/%s", baseURL, "hook1")).GoWithHTTPHandler(t.testT, s.openapiHandles)
+	c.Assert(result.Code(), check.Equals, http.StatusBadRequest)
+	c.Assert(result.UnmarshalBodyToObject(&errResp), check.IsNil)
+	c.Assert(errResp.ErrorCode, check.Equals, int(terror.ErrMasterWebhookNotExist.Code()))
+}
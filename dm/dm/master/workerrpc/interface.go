@@ -47,6 +47,11 @@ const (
 	CmdOperateV1Meta
 	CmdHandleError
 	CmdGetWorkerCfg
+	CmdSetRateLimit
+	CmdOperateSkipGTID
+	CmdUpdateSubTaskRules
+	CmdOperatePausedTable
+	CmdSetTaskPriority
 )
 
 // Request wraps all dm-worker rpc requests.
@@ -62,6 +67,12 @@ type Request struct {
 	OperateV1Meta *pb.OperateV1MetaRequest
 	HandleError   *pb.HandleWorkerErrorRequest
 	GetWorkerCfg  *pb.GetWorkerCfgRequest
+	SetRateLimit  *pb.SetRateLimitRequest
+
+	OperateSkipGTID    *pb.OperateSkipGTIDRequest
+	UpdateSubTaskRules *pb.UpdateSubTaskRulesRequest
+	OperatePausedTable *pb.OperatePausedTableRequest
+	SetTaskPriority    *pb.SetTaskPriorityRequest
 }
 
 // Response wraps all dm-worker rpc responses.
@@ -77,6 +88,12 @@ type Response struct {
 	OperateV1Meta *pb.OperateV1MetaResponse
 	HandleError   *pb.CommonWorkerResponse
 	GetWorkerCfg  *pb.GetWorkerCfgResponse
+	SetRateLimit  *pb.CommonWorkerResponse
+
+	OperateSkipGTID    *pb.CommonWorkerResponse
+	UpdateSubTaskRules *pb.CommonWorkerResponse
+	OperatePausedTable *pb.CommonWorkerResponse
+	SetTaskPriority    *pb.CommonWorkerResponse
 }
 
 // Client is a client that sends RPC.
@@ -117,6 +117,16 @@ func callRPC(ctx context.Context, client pb.WorkerClient, req *Request) (*Respon
 		resp.HandleError, err = client.HandleError(ctx, req.HandleError)
 	case CmdGetWorkerCfg:
 		resp.GetWorkerCfg, err = client.GetWorkerCfg(ctx, req.GetWorkerCfg)
+	case CmdSetRateLimit:
+		resp.SetRateLimit, err = client.SetRateLimit(ctx, req.SetRateLimit)
+	case CmdOperateSkipGTID:
+		resp.OperateSkipGTID, err = client.OperateSkipGTID(ctx, req.OperateSkipGTID)
+	case CmdUpdateSubTaskRules:
+		resp.UpdateSubTaskRules, err = client.UpdateSubTaskRules(ctx, req.UpdateSubTaskRules)
+	case CmdOperatePausedTable:
+		resp.OperatePausedTable, err = client.OperatePausedTable(ctx, req.OperatePausedTable)
+	case CmdSetTaskPriority:
+		resp.SetTaskPriority, err = client.SetTaskPriority(ctx, req.SetTaskPriority)
 	default:
 		return nil, terror.ErrMasterGRPCInvalidReqType.Generate(req.Type)
 	}
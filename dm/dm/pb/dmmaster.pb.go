@@ -1485,8 +1485,9 @@ func (m *OperateSourceResponse) GetSources() []*CommonWorkerResponse {
 }
 
 type RegisterWorkerRequest struct {
-	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Name    string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address string            `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Labels  map[string]string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *RegisterWorkerRequest) Reset()         { *m = RegisterWorkerRequest{} }
@@ -1536,6 +1537,13 @@ func (m *RegisterWorkerRequest) GetAddress() string {
 	return ""
 }
 
+func (m *RegisterWorkerRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 type RegisterWorkerResponse struct {
 	Result bool   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
 	Msg    string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
@@ -1589,8 +1597,9 @@ func (m *RegisterWorkerResponse) GetMsg() string {
 }
 
 type OfflineMemberRequest struct {
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type  string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Drain bool   `protobuf:"varint,3,opt,name=drain,proto3" json:"drain,omitempty"`
 }
 
 func (m *OfflineMemberRequest) Reset()         { *m = OfflineMemberRequest{} }
@@ -1640,6 +1649,13 @@ func (m *OfflineMemberRequest) GetName() string {
 	return ""
 }
 
+func (m *OfflineMemberRequest) GetDrain() bool {
+	if m != nil {
+		return m.Drain
+	}
+	return false
+}
+
 type OfflineMemberResponse struct {
 	Result bool   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
 	Msg    string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
@@ -3182,6 +3198,7 @@ func init() {
 	proto.RegisterType((*OperateSourceRequest)(nil), "pb.OperateSourceRequest")
 	proto.RegisterType((*OperateSourceResponse)(nil), "pb.OperateSourceResponse")
 	proto.RegisterType((*RegisterWorkerRequest)(nil), "pb.RegisterWorkerRequest")
+	proto.RegisterMapType((map[string]string)(nil), "pb.RegisterWorkerRequest.LabelsEntry")
 	proto.RegisterType((*RegisterWorkerResponse)(nil), "pb.RegisterWorkerResponse")
 	proto.RegisterType((*OfflineMemberRequest)(nil), "pb.OfflineMemberRequest")
 	proto.RegisterType((*OfflineMemberResponse)(nil), "pb.OfflineMemberResponse")
@@ -3214,139 +3231,142 @@ func init() {
 func init() { proto.RegisterFile("dmmaster.proto", fileDescriptor_f9bef11f2a341f03) }
 
 var fileDescriptor_f9bef11f2a341f03 = []byte{
-	// 2101 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6f, 0xe3, 0xc6,
-	0x15, 0x17, 0x25, 0x59, 0x96, 0x9e, 0x6c, 0x45, 0x1e, 0xcb, 0x32, 0x97, 0xeb, 0x68, 0x1d, 0x36,
-	0x09, 0x0c, 0xa3, 0x58, 0x63, 0xdd, 0x9e, 0x02, 0xa4, 0x40, 0x56, 0xda, 0x6c, 0x8c, 0x7a, 0xe3,
-	0x94, 0xf6, 0xa6, 0x08, 0x0a, 0x14, 0xa5, 0xa4, 0x91, 0x2c, 0x98, 0x22, 0xb9, 0x24, 0x65, 0xd7,
-	0x58, 0xa4, 0x87, 0x9e, 0x7a, 0xea, 0x07, 0x0a, 0x34, 0xc7, 0x1e, 0xfa, 0x9f, 0xf4, 0xd4, 0x63,
-	0x80, 0x5e, 0x7a, 0x2c, 0x76, 0xfb, 0x87, 0x14, 0xf3, 0x66, 0x86, 0x1c, 0x7e, 0xc8, 0xa9, 0x02,
-	0xd4, 0x37, 0xbe, 0xf7, 0x46, 0xef, 0xfd, 0xde, 0xc7, 0xbc, 0x79, 0x33, 0x82, 0xd6, 0x78, 0x3e,
-	0xb7, 0xc3, 0x88, 0x06, 0x8f, 0xfd, 0xc0, 0x8b, 0x3c, 0x52, 0xf6, 0x87, 0x46, 0x6b, 0x3c, 0xbf,
-	0xf1, 0x82, 0x2b, 0xc9, 0x33, 0xf6, 0xa6, 0x9e, 0x37, 0x75, 0xe8, 0x91, 0xed, 0xcf, 0x8e, 0x6c,
-	0xd7, 0xf5, 0x22, 0x3b, 0x9a, 0x79, 0x6e, 0xc8, 0xa5, 0xe6, 0x6f, 0xa0, 0x7d, 0x1e, 0xd9, 0x41,
-	0x74, 0x61, 0x87, 0x57, 0x16, 0x7d, 0xb5, 0xa0, 0x61, 0x44, 0x08, 0x54, 0x23, 0x3b, 0xbc, 0xd2,
-	0xb5, 0x7d, 0xed, 0xa0, 0x61, 0xe1, 0x37, 0xd1, 0x61, 0x3d, 0xf4, 0x16, 0xc1, 0x88, 0x86, 0x7a,
-	0x79, 0xbf, 0x72, 0xd0, 0xb0, 0x24, 0x49, 0x7a, 0x00, 0x01, 0x9d, 0x7b, 0xd7, 0xf4, 0x05, 0x8d,
-	0x6c, 0xbd, 0xb2, 0xaf, 0x1d, 0xd4, 0x2d, 0x85, 0x43, 0xf6, 0xa0, 0x11, 0xa2, 0x85, 0xd9, 0x9c,
-	0xea, 0x55, 0x54, 0x99, 0x30, 0xcc, 0x57, 0xb0, 0xa5, 0xd8, 0x0f, 0x7d, 0xcf, 0x0d, 0x29, 0xe9,
-	0x42, 0x2d, 0xa0, 0xe1, 0xc2, 0x89, 0x10, 0x42, 0xdd, 0x12, 0x14, 0x69, 0x43, 0x65, 0x1e, 0x4e,
-	0xf5, 0x32, 0x2a, 0x61, 0x9f, 0xe4, 0x38, 0x81, 0x55, 0xd9, 0xaf, 0x1c, 0x34, 0x8f, 0xf5, 0xc7,
-	0xfe, 0xf0, 0x71, 0xdf, 0x9b, 0xcf, 0x3d, 0xf7, 0xe7, 0x18, 0x05, 0xa9, 0x34, 0x06, 0x6c, 0xfe,
-	0x12, 0xc8, 0x99, 0x4f, 0x03, 0x3b, 0xa2, 0xaa, 0xd3, 0x06, 0x94, 0x3d, 0x1f, 0xed, 0xb5, 0x8e,
-	0x81, 0x29, 0x61, 0xc2, 0x33, 0xdf, 0x2a, 0x7b, 0x3e, 0x0b, 0x88, 0x6b, 0xcf, 0xa9, 0x30, 0x8c,
-	0xdf, 0x6a, 0x40, 0x2a, 0xa9, 0x80, 0x98, 0x7f, 0xd0, 0x60, 0x3b, 0x65, 0x40, 0x78, 0x75, 0x97,
-	0x85, 0xc4, 0xe3, 0x72, 0x91, 0xc7, 0x95, 0x42, 0x8f, 0xab, 0xff, 0xab, 0xc7, 0x9f, 0xc0, 0xd6,
-	0x4b, 0x7f, 0x9c, 0x71, 0x78, 0xa5, 0x2c, 0x9b, 0x01, 0x10, 0x55, 0xc5, 0xbd, 0x24, 0xea, 0x53,
-	0xe8, 0xfe, 0x6c, 0x41, 0x83, 0xdb, 0xf3, 0xc8, 0x8e, 0x16, 0xe1, 0xe9, 0x2c, 0x8c, 0x14, 0xec,
-	0x98, 0x10, 0xad, 0x38, 0x21, 0x19, 0xec, 0xd7, 0xb0, 0x9b, 0xd3, 0xb3, 0xb2, 0x03, 0x4f, 0xb2,
-	0x0e, 0xec, 0x32, 0x07, 0x14, 0xbd, 0x79, 0xfc, 0x7d, 0xd8, 0x3e, 0xbf, 0xf4, 0x6e, 0x06, 0x83,
-	0xd3, 0x53, 0x6f, 0x74, 0x15, 0x7e, 0xbf, 0xc0, 0xff, 0x55, 0x83, 0x75, 0xa1, 0x81, 0xb4, 0xa0,
-	0x7c, 0x32, 0x10, 0xbf, 0x2b, 0x9f, 0x0c, 0x62, 0x4d, 0x65, 0x45, 0x13, 0x81, 0xea, 0xdc, 0x1b,
-	0x53, 0x51, 0x32, 0xf8, 0x4d, 0x3a, 0xb0, 0xe6, 0xdd, 0xb8, 0x34, 0x10, 0xdb, 0x8f, 0x13, 0x6c,
-	0xe5, 0x60, 0x70, 0x1a, 0xea, 0x6b, 0x68, 0x10, 0xbf, 0x59, 0x3c, 0xc2, 0x5b, 0x77, 0x44, 0xc7,
-	0x7a, 0x0d, 0xb9, 0x82, 0x22, 0x06, 0xd4, 0x17, 0xae, 0x90, 0xac, 0xa3, 0x24, 0xa6, 0xcd, 0x11,
-	0x74, 0xd2, 0x6e, 0xae, 0x1c, 0xdb, 0xf7, 0x60, 0xcd, 0x61, 0x3f, 0x15, 0x91, 0x6d, 0xb2, 0xc8,
-	0x0a, 0x75, 0x16, 0x97, 0x98, 0x0e, 0x74, 0x5e, 0xba, 0xec, 0x53, 0xf2, 0x45, 0x30, 0xb3, 0x21,
-	0x31, 0x61, 0x23, 0xa0, 0xbe, 0x63, 0x8f, 0xe8, 0x19, 0x7a, 0xcc, 0xad, 0xa4, 0x78, 0x64, 0x1f,
-	0x9a, 0x13, 0x2f, 0x18, 0x51, 0x0b, 0x9b, 0x94, 0x68, 0x59, 0x2a, 0xcb, 0xfc, 0x04, 0x76, 0x32,
-	0xd6, 0x56, 0xf5, 0xc9, 0xb4, 0xe0, 0x81, 0x68, 0x02, 0xb2, 0xbc, 0x1d, 0xfb, 0x56, 0xa2, 0x7e,
-	0xa8, 0xb4, 0x02, 0xf4, 0x16, 0xa5, 0xa2, 0x17, 0x2c, 0xaf, 0x85, 0x6f, 0x34, 0x30, 0x8a, 0x94,
-	0x0a, 0x70, 0x77, 0x6a, 0xfd, 0xff, 0x76, 0x98, 0x6f, 0x34, 0xd8, 0xfd, 0x62, 0x11, 0x4c, 0x8b,
-	0x9c, 0x55, 0xfc, 0xd1, 0xd2, 0x47, 0x87, 0x01, 0xf5, 0x99, 0x6b, 0x8f, 0xa2, 0xd9, 0x35, 0x15,
-	0xa8, 0x62, 0x1a, 0x6b, 0x9b, 0x9d, 0x18, 0x0c, 0x58, 0xc5, 0xc2, 0x6f, 0xb6, 0x7e, 0x32, 0x73,
-	0x28, 0x6e, 0x7d, 0x5e, 0xca, 0x31, 0x8d, 0x95, 0xbb, 0x18, 0x0e, 0x66, 0x81, 0xbe, 0x86, 0x12,
-	0x41, 0x99, 0xbf, 0x06, 0x3d, 0x0f, 0xec, 0x5e, 0xda, 0xd7, 0x35, 0xb4, 0xfb, 0x97, 0x74, 0x74,
-	0xf5, 0x5d, 0x4d, 0xb7, 0x0b, 0x35, 0x1a, 0x04, 0x7d, 0x97, 0x67, 0xa6, 0x62, 0x09, 0x8a, 0xc5,
-	0xed, 0xc6, 0x0e, 0x5c, 0x26, 0xe0, 0x41, 0x90, 0xe4, 0x77, 0x1c, 0xa9, 0x1f, 0xc3, 0x96, 0x62,
-	0x77, 0xe5, 0xc2, 0xfd, 0x9d, 0x06, 0x1d, 0x51, 0x64, 0xe7, 0xe8, 0x89, 0xc4, 0xbe, 0xa7, 0x94,
-	0xd7, 0x06, 0x73, 0x9f, 0x8b, 0x93, 0xfa, 0x1a, 0x79, 0xee, 0x64, 0x36, 0x15, 0x45, 0x2b, 0x28,
-	0x96, 0x33, 0x1e, 0x90, 0x93, 0x81, 0x38, 0x28, 0x63, 0x9a, 0x8d, 0x0e, 0x7c, 0x54, 0xf9, 0x3c,
-	0xc9, 0xa8, 0xc2, 0x31, 0x17, 0xb0, 0x93, 0x41, 0x72, 0x2f, 0x89, 0x7b, 0x06, 0x3b, 0x16, 0x9d,
-	0xce, 0xd8, 0x5c, 0x25, 0x97, 0xdc, 0x79, 0xec, 0xd8, 0xe3, 0x71, 0x40, 0xc3, 0x50, 0x98, 0x95,
-	0xa4, 0xf9, 0x14, 0xba, 0x59, 0x35, 0x2b, 0x27, 0xe3, 0x27, 0xd0, 0x39, 0x9b, 0x4c, 0x9c, 0x99,
-	0x4b, 0x5f, 0xd0, 0xf9, 0x30, 0x85, 0x24, 0xba, 0xf5, 0x63, 0x24, 0xec, 0xbb, 0x68, 0x4a, 0x61,
-	0x8d, 0x2c, 0xf3, 0xfb, 0x95, 0x21, 0xfc, 0x38, 0x2e, 0x87, 0x53, 0x6a, 0x8f, 0x13, 0x08, 0xb9,
-	0x72, 0xe0, 0x62, 0x5e, 0x0e, 0x68, 0x38, 0xfd, 0xab, 0x95, 0x0d, 0xff, 0x5e, 0x03, 0x78, 0x81,
-	0xd3, 0xed, 0x89, 0x3b, 0xf1, 0x0a, 0x83, 0x6f, 0x40, 0x7d, 0x8e, 0x7e, 0x9d, 0x0c, 0xf0, 0x97,
-	0x55, 0x2b, 0xa6, 0xd9, 0xa1, 0x67, 0x3b, 0xb3, 0xb8, 0xbf, 0x73, 0x82, 0xfd, 0xc2, 0xa7, 0x34,
-	0x78, 0x69, 0x9d, 0xf2, 0xee, 0xd6, 0xb0, 0x62, 0x9a, 0x95, 0xe3, 0xc8, 0x99, 0x51, 0x37, 0x42,
-	0x29, 0x3f, 0x16, 0x15, 0x8e, 0x39, 0x04, 0xe0, 0x89, 0x5c, 0x8a, 0x87, 0x40, 0x95, 0x65, 0x5f,
-	0xa6, 0x80, 0x7d, 0x33, 0x1c, 0x61, 0x64, 0x4f, 0xe5, 0x89, 0xcc, 0x09, 0x6c, 0x57, 0x58, 0x6e,
-	0xa2, 0xec, 0x05, 0x65, 0x9e, 0x42, 0x9b, 0x0d, 0x28, 0x3c, 0x68, 0x3c, 0x67, 0x32, 0x34, 0x5a,
-	0x52, 0xd5, 0x45, 0x03, 0xa9, 0xb4, 0x5d, 0x49, 0x6c, 0x9b, 0x9f, 0x73, 0x6d, 0x3c, 0x8a, 0x4b,
-	0xb5, 0x1d, 0xc0, 0x3a, 0xbf, 0x45, 0xf0, 0x03, 0xa7, 0x79, 0xdc, 0x62, 0xe9, 0x4c, 0x42, 0x6f,
-	0x49, 0xb1, 0xd4, 0xc7, 0xa3, 0x70, 0x97, 0x3e, 0xbe, 0x89, 0x53, 0xfa, 0x92, 0xd0, 0x59, 0x52,
-	0x6c, 0xfe, 0x4d, 0x83, 0x75, 0xae, 0x26, 0x24, 0x8f, 0xa1, 0xe6, 0xa0, 0xd7, 0xa8, 0xaa, 0x79,
-	0xdc, 0xc1, 0x9a, 0xca, 0xc4, 0xe2, 0xb3, 0x92, 0x25, 0x56, 0xb1, 0xf5, 0x1c, 0x16, 0x46, 0x41,
-	0x59, 0xaf, 0x7a, 0xcb, 0xd6, 0xf3, 0x55, 0x6c, 0x3d, 0x37, 0x8b, 0x11, 0x52, 0xd6, 0xab, 0xde,
-	0xb0, 0xf5, 0x7c, 0xd5, 0xd3, 0x3a, 0xd4, 0x78, 0x2d, 0xb1, 0x3b, 0x0a, 0xea, 0x4d, 0xed, 0xc0,
-	0x6e, 0x0a, 0x6e, 0x3d, 0x86, 0xd5, 0x4d, 0xc1, 0xaa, 0xc7, 0xe6, 0xbb, 0x29, 0xf3, 0x75, 0x69,
-	0x86, 0x95, 0x07, 0x4b, 0x9f, 0xac, 0x46, 0x4e, 0x98, 0x14, 0x88, 0x6a, 0x72, 0xe5, 0xb6, 0xf7,
-	0x01, 0xac, 0x73, 0xf0, 0xa9, 0x99, 0x4a, 0x84, 0xda, 0x92, 0x32, 0xf3, 0x2f, 0xe5, 0xa4, 0xd7,
-	0x8f, 0x2e, 0xe9, 0xdc, 0x5e, 0xde, 0xeb, 0x51, 0x9c, 0xdc, 0x87, 0x72, 0x73, 0xe7, 0xd2, 0xfb,
-	0x10, 0xdb, 0x72, 0x63, 0x3b, 0xb2, 0x87, 0x76, 0x18, 0x9f, 0xda, 0x92, 0x66, 0xde, 0x47, 0xf6,
-	0xd0, 0xa1, 0xe2, 0xd0, 0xe6, 0x04, 0x6e, 0x0e, 0xb4, 0xa7, 0xd7, 0xc4, 0xe6, 0x40, 0x8a, 0xad,
-	0x9e, 0x38, 0x8b, 0xf0, 0x52, 0x5f, 0xe7, 0x5b, 0x1a, 0x09, 0x86, 0x86, 0x4d, 0xa2, 0x7a, 0x1d,
-	0x99, 0xf8, 0xcd, 0xb6, 0xf2, 0x24, 0xf0, 0xe6, 0xfc, 0xd8, 0xd0, 0x1b, 0xfc, 0x52, 0x9a, 0x70,
-	0xa4, 0xfc, 0xc2, 0x0e, 0xa6, 0x34, 0xd2, 0x21, 0x91, 0x73, 0x8e, 0x7a, 0xf2, 0x88, 0xb8, 0xdc,
-	0xcb, 0xc9, 0x73, 0x08, 0x9d, 0xe7, 0x34, 0x3a, 0x5f, 0x0c, 0xd9, 0xd9, 0xdd, 0x9f, 0x4c, 0xef,
-	0x38, 0x78, 0xcc, 0x97, 0xb0, 0x93, 0x59, 0xbb, 0x32, 0x44, 0x02, 0xd5, 0xd1, 0x64, 0x2a, 0x13,
-	0x86, 0xdf, 0xe6, 0x00, 0x36, 0x9f, 0xd3, 0x48, 0xb1, 0xfd, 0x48, 0x39, 0x6a, 0xc4, 0x5c, 0xd9,
-	0x9f, 0x4c, 0x2f, 0x6e, 0x7d, 0x7a, 0xc7, 0xb9, 0x73, 0x0a, 0x2d, 0xa9, 0x65, 0x65, 0x54, 0x6d,
-	0xa8, 0x8c, 0x26, 0xf1, 0x44, 0x3a, 0x9a, 0x4c, 0xcd, 0x1d, 0xd8, 0x7e, 0x4e, 0xc5, 0xbe, 0x4e,
-	0x90, 0x99, 0x07, 0x18, 0x2d, 0x85, 0x2d, 0x4c, 0x09, 0x05, 0x5a, 0xa2, 0xe0, 0x4f, 0x1a, 0x90,
-	0xcf, 0x6c, 0x77, 0xec, 0xd0, 0x67, 0x41, 0xe0, 0x05, 0x4b, 0xc7, 0x70, 0x94, 0x7e, 0xaf, 0x22,
-	0xdf, 0x83, 0xc6, 0x70, 0xe6, 0x3a, 0xde, 0xf4, 0x0b, 0x2f, 0x94, 0x23, 0x59, 0xcc, 0xc0, 0x12,
-	0x7d, 0xe5, 0xc4, 0x57, 0x2d, 0xf6, 0x6d, 0x86, 0xb0, 0x9d, 0x82, 0x74, 0x2f, 0x05, 0xf6, 0x1c,
-	0x76, 0x2e, 0x02, 0xdb, 0x0d, 0x27, 0x34, 0x48, 0x0f, 0x77, 0xc9, 0x79, 0xa4, 0xa9, 0xe7, 0x91,
-	0xd2, 0xb6, 0xb8, 0x65, 0x41, 0xb1, 0xe1, 0x26, 0xab, 0x68, 0xe5, 0x03, 0x7e, 0x1c, 0xbf, 0x93,
-	0xa4, 0xee, 0x0b, 0xef, 0x2a, 0x59, 0xd9, 0x54, 0xae, 0x31, 0x5f, 0x1e, 0xcb, 0x41, 0x53, 0x20,
-	0x2d, 0x2f, 0x41, 0xca, 0x53, 0x23, 0x91, 0x46, 0x71, 0x8b, 0xbb, 0xc7, 0xe1, 0xff, 0x70, 0x08,
-	0x75, 0x39, 0x1e, 0x93, 0x6d, 0x78, 0xe7, 0xc4, 0xbd, 0xb6, 0x9d, 0xd9, 0x58, 0xb2, 0xda, 0x25,
-	0xf2, 0x0e, 0x34, 0xf1, 0xe1, 0x8b, 0xb3, 0xda, 0x1a, 0x69, 0xc3, 0x06, 0x7f, 0x61, 0x11, 0x9c,
-	0x32, 0x69, 0x01, 0x9c, 0x47, 0x9e, 0x2f, 0xe8, 0x0a, 0xd2, 0x97, 0xde, 0x8d, 0xa0, 0xab, 0x87,
-	0x3f, 0x85, 0xba, 0x9c, 0xb9, 0x14, 0x1b, 0x92, 0xd5, 0x2e, 0x91, 0x2d, 0xd8, 0x7c, 0x76, 0x3d,
-	0x1b, 0x45, 0x31, 0x4b, 0x23, 0xbb, 0xb0, 0xdd, 0xb7, 0xdd, 0x11, 0x75, 0xd2, 0x82, 0xf2, 0xa1,
-	0x0b, 0xeb, 0x62, 0x5b, 0x33, 0x68, 0x42, 0x17, 0x23, 0xdb, 0x25, 0xb2, 0x01, 0x75, 0xd6, 0x64,
-	0x90, 0xd2, 0x18, 0x0c, 0xbe, 0xe7, 0x90, 0x46, 0x98, 0x3c, 0x0a, 0x48, 0x73, 0x98, 0x08, 0x11,
-	0xe9, 0x2a, 0xe9, 0x40, 0x1b, 0x7f, 0x4d, 0xe7, 0xbe, 0x63, 0x47, 0x9c, 0xbb, 0x76, 0x38, 0x80,
-	0x46, 0x9c, 0x57, 0xb6, 0x44, 0x58, 0x8c, 0x79, 0xed, 0x12, 0x8b, 0x08, 0x86, 0x08, 0x79, 0x5f,
-	0x1e, 0xb7, 0x35, 0x1e, 0x34, 0xcf, 0x97, 0x8c, 0xf2, 0xf1, 0xdf, 0x5b, 0x50, 0xe3, 0x60, 0xc8,
-	0x57, 0xd0, 0x88, 0x5f, 0x12, 0x09, 0x1e, 0xee, 0xd9, 0x87, 0x4d, 0x63, 0x27, 0xc3, 0xe5, 0x49,
-	0x33, 0x1f, 0xfd, 0xf6, 0x9f, 0xff, 0xf9, 0x73, 0xf9, 0x81, 0xd9, 0x39, 0xb2, 0xfd, 0x59, 0x78,
-	0x74, 0xfd, 0xc4, 0x76, 0xfc, 0x4b, 0xfb, 0xc9, 0x11, 0xdb, 0xf2, 0xe1, 0x47, 0xda, 0x21, 0x99,
-	0x40, 0x53, 0x79, 0xd0, 0x23, 0x5d, 0xa6, 0x26, 0xff, 0x84, 0x68, 0xec, 0xe6, 0xf8, 0xc2, 0xc0,
-	0x87, 0x68, 0x60, 0xdf, 0x78, 0x58, 0x64, 0xe0, 0xe8, 0x35, 0xeb, 0x98, 0x5f, 0x33, 0x3b, 0x1f,
-	0x03, 0x24, 0x8f, 0x6c, 0x04, 0xd1, 0xe6, 0xde, 0xed, 0x8c, 0x6e, 0x96, 0x2d, 0x8c, 0x94, 0x88,
-	0x03, 0x4d, 0xe5, 0x3d, 0x8a, 0x18, 0x99, 0x07, 0x2a, 0xe5, 0x01, 0xcd, 0x78, 0x58, 0x28, 0x13,
-	0x9a, 0xde, 0x47, 0xb8, 0x3d, 0xb2, 0x97, 0x81, 0x1b, 0xe2, 0x52, 0x81, 0x97, 0xf4, 0x61, 0x43,
-	0x7d, 0xf6, 0x21, 0xe8, 0x7d, 0xc1, 0x7b, 0x97, 0xa1, 0xe7, 0x05, 0x31, 0xe4, 0x4f, 0x61, 0x33,
-	0xf5, 0xd0, 0x42, 0x70, 0x71, 0xd1, 0x4b, 0x8f, 0xf1, 0xa0, 0x40, 0x12, 0xeb, 0xf9, 0x0a, 0xba,
-	0xf9, 0x87, 0x11, 0x8c, 0xe2, 0xbb, 0x4a, 0x52, 0xf2, 0x8f, 0x13, 0x46, 0x6f, 0x99, 0x38, 0x56,
-	0x7d, 0x06, 0xed, 0xec, 0x03, 0x02, 0xc1, 0xf0, 0x2d, 0x79, 0xef, 0x30, 0xf6, 0x8a, 0x85, 0xb1,
-	0xc2, 0x8f, 0xa0, 0x11, 0xdf, 0xcf, 0x79, 0xa1, 0x66, 0x9f, 0x09, 0x78, 0xa1, 0xe6, 0x2e, 0xf1,
-	0x66, 0x89, 0x4c, 0x61, 0x33, 0x75, 0x23, 0xe6, 0xf1, 0x2a, 0xba, 0xae, 0xf3, 0x78, 0x15, 0x5e,
-	0x9f, 0xcd, 0xf7, 0x30, 0xc1, 0x0f, 0x8d, 0x6e, 0x36, 0xc1, 0xbc, 0x79, 0xb1, 0x52, 0x3c, 0x81,
-	0x56, 0xfa, 0xf2, 0x4a, 0x1e, 0xf0, 0x56, 0x5c, 0x70, 0x2f, 0x36, 0x8c, 0x22, 0x51, 0x8c, 0x39,
-	0x80, 0xcd, 0xd4, 0x1d, 0x54, 0x60, 0x2e, 0xb8, 0xd6, 0x0a, 0xcc, 0x45, 0x17, 0x56, 0xf3, 0x87,
-	0x88, 0xf9, 0xc3, 0xc3, 0xf7, 0x33, 0x98, 0xc5, 0x28, 0x7b, 0xf4, 0x9a, 0xcd, 0x22, 0x5f, 0xcb,
-	0xe2, 0xbc, 0x8a, 0xe3, 0xc4, 0x5b, 0x5c, 0x2a, 0x4e, 0xa9, 0x7b, 0x6c, 0x2a, 0x4e, 0xe9, 0xbb,
-	0xaa, 0xf9, 0x01, 0xda, 0x7c, 0x64, 0x18, 0x19, 0x9b, 0x7c, 0xd4, 0x3f, 0x7a, 0xed, 0xf9, 0xb8,
-	0x6d, 0x7f, 0x01, 0x90, 0x0c, 0xeb, 0x7c, 0xdb, 0xe6, 0xee, 0x0b, 0x7c, 0xdb, 0xe6, 0x67, 0x7a,
-	0xb3, 0x87, 0x36, 0x74, 0xd2, 0x2d, 0xf6, 0x8b, 0x4c, 0x92, 0x8c, 0xf3, 0x21, 0x38, 0x95, 0x71,
-	0x75, 0x68, 0x4f, 0x67, 0x3c, 0x35, 0xb6, 0x9a, 0xfb, 0x68, 0xc5, 0x30, 0x76, 0xb2, 0x19, 0xc7,
-	0x65, 0xcc, 0x09, 0x07, 0xe7, 0xbe, 0x64, 0x9c, 0xe4, 0x76, 0x8a, 0xa6, 0x51, 0x6e, 0xa7, 0x70,
-	0xf6, 0x94, 0x9d, 0x8e, 0xf4, 0xb2, 0x76, 0x16, 0x43, 0xb5, 0xd9, 0x91, 0x0b, 0xa8, 0xf1, 0xf9,
-	0x90, 0x6c, 0x09, 0x65, 0x8a, 0x7e, 0xa2, 0xb2, 0x84, 0xe2, 0x1f, 0xa0, 0xe2, 0x77, 0xc9, 0x5d,
-	0x2d, 0x94, 0xfc, 0x0a, 0x9a, 0xca, 0x48, 0xc5, 0xfb, 0x74, 0x7e, 0xec, 0xe3, 0x7d, 0xba, 0x60,
-	0xf6, 0x5a, 0x1a, 0x25, 0xca, 0x56, 0xe1, 0xb6, 0xe8, 0xc3, 0x86, 0x3a, 0x72, 0xf2, 0xa6, 0x57,
-	0x30, 0x9b, 0x1a, 0x7a, 0x5e, 0x10, 0x6f, 0x88, 0x13, 0x68, 0xa5, 0x67, 0x27, 0xbe, 0xb7, 0x0a,
-	0x07, 0x33, 0xbe, 0xb7, 0x8a, 0x47, 0x2d, 0xb3, 0xc4, 0xf0, 0xa8, 0xc3, 0x0d, 0x51, 0x8f, 0xa0,
-	0x54, 0x53, 0xd2, 0xf3, 0x02, 0xa9, 0xe4, 0xa9, 0xfe, 0x8f, 0x37, 0x3d, 0xed, 0xdb, 0x37, 0x3d,
-	0xed, 0xdf, 0x6f, 0x7a, 0xda, 0x1f, 0xdf, 0xf6, 0x4a, 0xdf, 0xbe, 0xed, 0x95, 0xfe, 0xf5, 0xb6,
-	0x57, 0x1a, 0xd6, 0xf0, 0x4f, 0xc2, 0x1f, 0xfd, 0x37, 0x00, 0x00, 0xff, 0xff, 0xab, 0x51, 0x94,
-	0xcf, 0x68, 0x1c, 0x00, 0x00,
+	// 2150 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x19, 0x4d, 0x6f, 0xdb, 0xc8,
+	0xd5, 0x94, 0x6c, 0x59, 0x7e, 0xb2, 0xb5, 0xf2, 0x58, 0x96, 0xe9, 0x89, 0xa3, 0x78, 0xd9, 0xcd,
+	0xc2, 0x30, 0x0a, 0x1b, 0x71, 0x7b, 0x68, 0x03, 0xe4, 0xb0, 0x91, 0xb2, 0x59, 0xa3, 0xce, 0x7a,
+	0x4b, 0xdb, 0x5b, 0x2c, 0x0a, 0x14, 0xa5, 0xa4, 0x91, 0x2c, 0x98, 0x22, 0x19, 0x92, 0xb2, 0x6b,
+	0x04, 0xdb, 0x43, 0x4f, 0x3d, 0xf5, 0x03, 0x05, 0xba, 0xc7, 0x1e, 0xfa, 0x43, 0x0a, 0xf4, 0xd4,
+	0xe3, 0x02, 0xbd, 0xf4, 0x58, 0x24, 0xfd, 0x21, 0xc5, 0xbc, 0x99, 0xa1, 0x86, 0x14, 0xe5, 0x54,
+	0x0b, 0xd4, 0xb7, 0x79, 0xef, 0x0d, 0xdf, 0xf7, 0xbc, 0x79, 0xf3, 0x08, 0xd5, 0xde, 0x68, 0xe4,
+	0x44, 0x31, 0x0b, 0x0f, 0x82, 0xd0, 0x8f, 0x7d, 0x52, 0x08, 0x3a, 0xb4, 0xda, 0x1b, 0xdd, 0xf8,
+	0xe1, 0x95, 0xc2, 0xd1, 0x9d, 0x81, 0xef, 0x0f, 0x5c, 0x76, 0xe8, 0x04, 0xc3, 0x43, 0xc7, 0xf3,
+	0xfc, 0xd8, 0x89, 0x87, 0xbe, 0x17, 0x09, 0xaa, 0xf5, 0x6b, 0xa8, 0x9d, 0xc5, 0x4e, 0x18, 0x9f,
+	0x3b, 0xd1, 0x95, 0xcd, 0x5e, 0x8f, 0x59, 0x14, 0x13, 0x02, 0x8b, 0xb1, 0x13, 0x5d, 0x99, 0xc6,
+	0xae, 0xb1, 0xb7, 0x62, 0xe3, 0x9a, 0x98, 0xb0, 0x1c, 0xf9, 0xe3, 0xb0, 0xcb, 0x22, 0xb3, 0xb0,
+	0x5b, 0xdc, 0x5b, 0xb1, 0x15, 0x48, 0x9a, 0x00, 0x21, 0x1b, 0xf9, 0xd7, 0xec, 0x15, 0x8b, 0x1d,
+	0xb3, 0xb8, 0x6b, 0xec, 0x95, 0x6d, 0x0d, 0x43, 0x76, 0x60, 0x25, 0x42, 0x09, 0xc3, 0x11, 0x33,
+	0x17, 0x91, 0xe5, 0x04, 0x61, 0xbd, 0x86, 0x75, 0x4d, 0x7e, 0x14, 0xf8, 0x5e, 0xc4, 0x48, 0x03,
+	0x4a, 0x21, 0x8b, 0xc6, 0x6e, 0x8c, 0x2a, 0x94, 0x6d, 0x09, 0x91, 0x1a, 0x14, 0x47, 0xd1, 0xc0,
+	0x2c, 0x20, 0x13, 0xbe, 0x24, 0x47, 0x13, 0xb5, 0x8a, 0xbb, 0xc5, 0xbd, 0xca, 0x91, 0x79, 0x10,
+	0x74, 0x0e, 0x5a, 0xfe, 0x68, 0xe4, 0x7b, 0x3f, 0x43, 0x2f, 0x28, 0xa6, 0x89, 0xc2, 0xd6, 0x2f,
+	0x80, 0x9c, 0x06, 0x2c, 0x74, 0x62, 0xa6, 0x1b, 0x4d, 0xa1, 0xe0, 0x07, 0x28, 0xaf, 0x7a, 0x04,
+	0x9c, 0x09, 0x27, 0x9e, 0x06, 0x76, 0xc1, 0x0f, 0xb8, 0x43, 0x3c, 0x67, 0xc4, 0xa4, 0x60, 0x5c,
+	0x13, 0x33, 0x2d, 0x79, 0xe2, 0x10, 0xeb, 0xf7, 0x06, 0x6c, 0xa4, 0x04, 0x48, 0xab, 0xee, 0x92,
+	0x30, 0xb1, 0xb8, 0x90, 0x67, 0x71, 0x31, 0xd7, 0xe2, 0xc5, 0xff, 0xd5, 0xe2, 0x4f, 0x60, 0xfd,
+	0x22, 0xe8, 0x65, 0x0c, 0x9e, 0x2b, 0xca, 0x56, 0x08, 0x44, 0x67, 0x71, 0x2f, 0x81, 0xfa, 0x14,
+	0x1a, 0x3f, 0x1d, 0xb3, 0xf0, 0xf6, 0x2c, 0x76, 0xe2, 0x71, 0x74, 0x32, 0x8c, 0x62, 0x4d, 0x77,
+	0x0c, 0x88, 0x91, 0x1f, 0x90, 0x8c, 0xee, 0xd7, 0xb0, 0x35, 0xc5, 0x67, 0x6e, 0x03, 0x9e, 0x64,
+	0x0d, 0xd8, 0xe2, 0x06, 0x68, 0x7c, 0xa7, 0xf5, 0x6f, 0xc1, 0xc6, 0xd9, 0xa5, 0x7f, 0xd3, 0x6e,
+	0x9f, 0x9c, 0xf8, 0xdd, 0xab, 0xe8, 0xbb, 0x39, 0xfe, 0x2f, 0x06, 0x2c, 0x4b, 0x0e, 0xa4, 0x0a,
+	0x85, 0xe3, 0xb6, 0xfc, 0xae, 0x70, 0xdc, 0x4e, 0x38, 0x15, 0x34, 0x4e, 0x04, 0x16, 0x47, 0x7e,
+	0x8f, 0xc9, 0x94, 0xc1, 0x35, 0xa9, 0xc3, 0x92, 0x7f, 0xe3, 0xb1, 0x50, 0x1e, 0x3f, 0x01, 0xf0,
+	0x9d, 0xed, 0xf6, 0x49, 0x64, 0x2e, 0xa1, 0x40, 0x5c, 0x73, 0x7f, 0x44, 0xb7, 0x5e, 0x97, 0xf5,
+	0xcc, 0x12, 0x62, 0x25, 0x44, 0x28, 0x94, 0xc7, 0x9e, 0xa4, 0x2c, 0x23, 0x25, 0x81, 0xad, 0x2e,
+	0xd4, 0xd3, 0x66, 0xce, 0xed, 0xdb, 0x0f, 0x61, 0xc9, 0xe5, 0x9f, 0x4a, 0xcf, 0x56, 0xb8, 0x67,
+	0x25, 0x3b, 0x5b, 0x50, 0x2c, 0x17, 0xea, 0x17, 0x1e, 0x5f, 0x2a, 0xbc, 0x74, 0x66, 0xd6, 0x25,
+	0x16, 0xac, 0x86, 0x2c, 0x70, 0x9d, 0x2e, 0x3b, 0x45, 0x8b, 0x85, 0x94, 0x14, 0x8e, 0xec, 0x42,
+	0xa5, 0xef, 0x87, 0x5d, 0x66, 0x63, 0x91, 0x92, 0x25, 0x4b, 0x47, 0x59, 0x9f, 0xc0, 0x66, 0x46,
+	0xda, 0xbc, 0x36, 0x59, 0x36, 0x6c, 0xcb, 0x22, 0xa0, 0xd2, 0xdb, 0x75, 0x6e, 0x95, 0xd6, 0x0f,
+	0xb4, 0x52, 0x80, 0xd6, 0x22, 0x55, 0xd6, 0x82, 0xd9, 0xb9, 0xf0, 0x8d, 0x01, 0x34, 0x8f, 0xa9,
+	0x54, 0xee, 0x4e, 0xae, 0xff, 0xdf, 0x0a, 0xf3, 0x8d, 0x01, 0x5b, 0x5f, 0x8c, 0xc3, 0x41, 0x9e,
+	0xb1, 0x9a, 0x3d, 0x46, 0xfa, 0xea, 0xa0, 0x50, 0x1e, 0x7a, 0x4e, 0x37, 0x1e, 0x5e, 0x33, 0xa9,
+	0x55, 0x02, 0x63, 0x6e, 0xf3, 0x1b, 0x83, 0x2b, 0x56, 0xb4, 0x71, 0xcd, 0xf7, 0xf7, 0x87, 0x2e,
+	0xc3, 0xa3, 0x2f, 0x52, 0x39, 0x81, 0x31, 0x73, 0xc7, 0x9d, 0xf6, 0x30, 0x34, 0x97, 0x90, 0x22,
+	0x21, 0xeb, 0x57, 0x60, 0x4e, 0x2b, 0x76, 0x2f, 0xe5, 0xeb, 0x1a, 0x6a, 0xad, 0x4b, 0xd6, 0xbd,
+	0x7a, 0x5f, 0xd1, 0x6d, 0x40, 0x89, 0x85, 0x61, 0xcb, 0x13, 0x91, 0x29, 0xda, 0x12, 0xe2, 0x7e,
+	0xbb, 0x71, 0x42, 0x8f, 0x13, 0x84, 0x13, 0x14, 0xf8, 0x9e, 0x2b, 0xf5, 0x19, 0xac, 0x6b, 0x72,
+	0xe7, 0x4e, 0xdc, 0xdf, 0x1a, 0x50, 0x97, 0x49, 0x76, 0x86, 0x96, 0x28, 0xdd, 0x77, 0xb4, 0xf4,
+	0x5a, 0xe5, 0xe6, 0x0b, 0xf2, 0x24, 0xbf, 0xba, 0xbe, 0xd7, 0x1f, 0x0e, 0x64, 0xd2, 0x4a, 0x88,
+	0xc7, 0x4c, 0x38, 0xe4, 0xb8, 0x2d, 0x2f, 0xca, 0x04, 0xe6, 0xad, 0x83, 0x68, 0x55, 0x3e, 0x9f,
+	0x44, 0x54, 0xc3, 0x58, 0x63, 0xd8, 0xcc, 0x68, 0x72, 0x2f, 0x81, 0xfb, 0x9b, 0x01, 0x9b, 0x36,
+	0x1b, 0x0c, 0xa3, 0x98, 0x85, 0x6a, 0xcf, 0x9d, 0xf7, 0x8e, 0xd3, 0xeb, 0x85, 0x2c, 0x8a, 0xa4,
+	0x5c, 0x05, 0x92, 0x67, 0x50, 0x72, 0x9d, 0x0e, 0x73, 0x95, 0xe8, 0xc7, 0xe2, 0x4c, 0xe6, 0x30,
+	0x3e, 0x38, 0xc1, 0x7d, 0x2f, 0xbc, 0x38, 0xbc, 0xb5, 0xe5, 0x47, 0xf4, 0xc7, 0x50, 0xd1, 0xd0,
+	0xdc, 0xb6, 0x2b, 0x76, 0x2b, 0x45, 0xf3, 0x25, 0x2f, 0xeb, 0xd7, 0x8e, 0x3b, 0x56, 0x7d, 0x89,
+	0x00, 0x9e, 0x16, 0x7e, 0x64, 0x58, 0xcf, 0xa1, 0x91, 0x95, 0x33, 0x77, 0x1e, 0x9c, 0x43, 0xfd,
+	0xb4, 0xdf, 0x77, 0x87, 0x1e, 0x7b, 0xc5, 0x46, 0x9d, 0x94, 0x0f, 0xe2, 0xdb, 0x20, 0xf1, 0x01,
+	0x5f, 0xe7, 0x36, 0x48, 0x75, 0x58, 0xea, 0x85, 0xce, 0xd0, 0x93, 0xf5, 0x55, 0x00, 0xbc, 0xb2,
+	0x66, 0xb8, 0xce, 0xad, 0xd8, 0x0f, 0x93, 0xfc, 0x3c, 0x61, 0x4e, 0x8f, 0x85, 0x33, 0xf3, 0x53,
+	0x90, 0x45, 0x7e, 0xa2, 0xe0, 0xf4, 0x57, 0x73, 0x0b, 0xfe, 0x9d, 0x01, 0xf0, 0x0a, 0xdb, 0xed,
+	0x63, 0xaf, 0xef, 0xe7, 0x26, 0x03, 0x85, 0xf2, 0x08, 0xed, 0x3a, 0x6e, 0xe3, 0x97, 0x8b, 0x76,
+	0x02, 0x73, 0x87, 0x38, 0xee, 0x30, 0xb9, 0x70, 0x04, 0xc0, 0xbf, 0x08, 0x18, 0x0b, 0x2f, 0xec,
+	0x13, 0x51, 0x6e, 0x57, 0xec, 0x04, 0xe6, 0xe7, 0xa3, 0xeb, 0x0e, 0x99, 0x17, 0x5f, 0xd8, 0xc9,
+	0x3d, 0xad, 0x61, 0xac, 0x0e, 0x80, 0x08, 0xef, 0x4c, 0x7d, 0x08, 0x2c, 0xf2, 0x6c, 0x54, 0x81,
+	0xe1, 0x6b, 0xae, 0x47, 0x14, 0x3b, 0x03, 0xd5, 0x22, 0x08, 0x00, 0xeb, 0x27, 0xe6, 0xbf, 0x3c,
+	0x87, 0x12, 0xb2, 0x4e, 0xa0, 0xc6, 0x3b, 0x26, 0xe1, 0x34, 0x11, 0x33, 0xe5, 0x1a, 0x63, 0x72,
+	0xcc, 0xf2, 0x12, 0x40, 0xc9, 0x2e, 0x4e, 0x64, 0x5b, 0x9f, 0x0b, 0x6e, 0xc2, 0x8b, 0x33, 0xb9,
+	0xed, 0xc1, 0xb2, 0x78, 0xd6, 0x88, 0x1b, 0xb0, 0x72, 0x54, 0xe5, 0xe1, 0x9c, 0xb8, 0xde, 0x56,
+	0x64, 0xc5, 0x4f, 0x78, 0xe1, 0x2e, 0x7e, 0xa2, 0xaa, 0xa4, 0xf8, 0x4d, 0x5c, 0x67, 0x2b, 0xb2,
+	0xf5, 0x57, 0x03, 0x96, 0x05, 0x9b, 0x88, 0x1c, 0x40, 0xc9, 0x45, 0xab, 0x91, 0x55, 0xe5, 0xa8,
+	0x8e, 0x39, 0x95, 0xf1, 0xc5, 0x67, 0x0b, 0xb6, 0xdc, 0xc5, 0xf7, 0x0b, 0xb5, 0xcc, 0x42, 0x7a,
+	0xbf, 0x6e, 0x2d, 0xdf, 0x2f, 0x76, 0xf1, 0xfd, 0x42, 0xac, 0x59, 0x4c, 0xef, 0xd7, 0xad, 0xe1,
+	0xfb, 0xc5, 0xae, 0xe7, 0x65, 0x28, 0x89, 0x5c, 0xe2, 0x8f, 0x26, 0xe4, 0x9b, 0x3a, 0x97, 0x8d,
+	0x94, 0xba, 0xe5, 0x44, 0xad, 0x46, 0x4a, 0xad, 0x72, 0x22, 0xbe, 0x91, 0x12, 0x5f, 0x56, 0x62,
+	0x78, 0x7a, 0xf0, 0xf0, 0xa9, 0x6c, 0x14, 0x80, 0xc5, 0x80, 0xe8, 0x22, 0xe7, 0xae, 0xc3, 0x8f,
+	0x61, 0x59, 0x28, 0x9f, 0x6a, 0xf2, 0xa4, 0xab, 0x6d, 0x45, 0xb3, 0xfe, 0x5c, 0x98, 0x5c, 0x3e,
+	0xdd, 0x4b, 0x36, 0x72, 0x66, 0x5f, 0x3e, 0x48, 0x9e, 0x3c, 0xd0, 0xa6, 0x1a, 0xe1, 0x99, 0x0f,
+	0x34, 0x7e, 0xe4, 0x7a, 0x4e, 0xec, 0x74, 0x9c, 0x28, 0x69, 0x23, 0x14, 0xcc, 0xad, 0x8f, 0x9d,
+	0x8e, 0xcb, 0x64, 0x17, 0x21, 0x00, 0x3c, 0x1c, 0x28, 0xcf, 0x2c, 0xc9, 0xc3, 0x81, 0x10, 0xdf,
+	0xdd, 0x77, 0xc7, 0xd1, 0xa5, 0xb9, 0x2c, 0x8e, 0x34, 0x02, 0x5c, 0x1b, 0xde, 0x1a, 0x9b, 0x65,
+	0x44, 0xe2, 0x9a, 0x1f, 0xe5, 0x7e, 0xe8, 0x8f, 0xc4, 0x3d, 0x66, 0xae, 0x20, 0x45, 0xc3, 0x28,
+	0xfa, 0xb9, 0x13, 0x0e, 0x58, 0x6c, 0xc2, 0x84, 0x2e, 0x30, 0xfa, 0x55, 0x28, 0xfd, 0x72, 0x2f,
+	0x57, 0xe1, 0x3e, 0xd4, 0x5f, 0xb2, 0xf8, 0x6c, 0xdc, 0xe1, 0xcd, 0x44, 0xab, 0x3f, 0xb8, 0xe3,
+	0x22, 0xb4, 0x2e, 0x60, 0x33, 0xb3, 0x77, 0x6e, 0x15, 0x09, 0x2c, 0x76, 0xfb, 0x03, 0x15, 0x30,
+	0x5c, 0x5b, 0x6d, 0x58, 0x7b, 0xc9, 0x62, 0x4d, 0xf6, 0x23, 0xed, 0x02, 0x92, 0x8d, 0x6e, 0xab,
+	0x3f, 0x38, 0xbf, 0x0d, 0xd8, 0xec, 0xdb, 0xc8, 0x3a, 0x81, 0xaa, 0xe2, 0x32, 0xb7, 0x56, 0x35,
+	0x28, 0x76, 0xfb, 0x49, 0x8b, 0xdc, 0xed, 0x0f, 0xac, 0x4d, 0xd8, 0x78, 0xc9, 0xe4, 0xb9, 0x9e,
+	0x68, 0x66, 0xed, 0x41, 0x3d, 0x8d, 0x96, 0xa2, 0x24, 0x03, 0x63, 0xc2, 0xe0, 0x8f, 0x06, 0x90,
+	0xcf, 0x1c, 0xaf, 0xe7, 0xb2, 0x17, 0x61, 0xe8, 0x87, 0x33, 0xdf, 0x05, 0x48, 0xfd, 0x4e, 0x49,
+	0xbe, 0x03, 0x2b, 0x9d, 0xa1, 0xe7, 0xfa, 0x83, 0x2f, 0xfc, 0x48, 0xf5, 0x88, 0x09, 0x02, 0x53,
+	0xf4, 0xb5, 0x9b, 0xbc, 0xfd, 0xf8, 0xda, 0x8a, 0x60, 0x23, 0xa5, 0xd2, 0xbd, 0x24, 0xd8, 0x4b,
+	0xd8, 0x3c, 0x0f, 0x1d, 0x2f, 0xea, 0xb3, 0x30, 0xdd, 0x6d, 0x4e, 0xee, 0x23, 0x43, 0xbf, 0x8f,
+	0xb4, 0xb2, 0x25, 0x24, 0x4b, 0x88, 0xb7, 0x3c, 0x59, 0x46, 0x73, 0x5f, 0xf0, 0xbd, 0x64, 0x70,
+	0x93, 0x7a, 0xc0, 0x3c, 0xd4, 0xa2, 0xb2, 0xa6, 0xbd, 0xab, 0xbe, 0x3c, 0x52, 0x9d, 0xaf, 0xd4,
+	0xb4, 0x30, 0x43, 0x53, 0x11, 0x1a, 0xa5, 0x69, 0x9c, 0x94, 0xb8, 0x7b, 0x7c, 0x8d, 0xec, 0x77,
+	0xa0, 0xac, 0xfa, 0x75, 0xb2, 0x01, 0x1f, 0x1c, 0x7b, 0xd7, 0x8e, 0x3b, 0xec, 0x29, 0x54, 0x6d,
+	0x81, 0x7c, 0x00, 0x15, 0x9c, 0xc4, 0x09, 0x54, 0xcd, 0x20, 0x35, 0x58, 0x15, 0x23, 0x1f, 0x89,
+	0x29, 0x90, 0x2a, 0xc0, 0x59, 0xec, 0x07, 0x12, 0x2e, 0x22, 0x7c, 0xe9, 0xdf, 0x48, 0x78, 0x71,
+	0xff, 0x27, 0x50, 0x56, 0x3d, 0x97, 0x26, 0x43, 0xa1, 0x6a, 0x0b, 0x64, 0x1d, 0xd6, 0x5e, 0x5c,
+	0x0f, 0xbb, 0x71, 0x82, 0x32, 0xc8, 0x16, 0x6c, 0xb4, 0x1c, 0xaf, 0xcb, 0xdc, 0x34, 0xa1, 0xb0,
+	0xef, 0xc1, 0xb2, 0x3c, 0xd6, 0x5c, 0x35, 0xc9, 0x8b, 0x83, 0xb5, 0x05, 0xb2, 0x0a, 0x65, 0x5e,
+	0x64, 0x10, 0x32, 0xb8, 0x1a, 0xe2, 0xcc, 0x21, 0x8c, 0x6a, 0x0a, 0x2f, 0x20, 0x2c, 0xd4, 0x44,
+	0x15, 0x11, 0x5e, 0x24, 0x75, 0xa8, 0xe1, 0xd7, 0x6c, 0x14, 0xb8, 0x4e, 0x2c, 0xb0, 0x4b, 0xfb,
+	0x6d, 0x58, 0x49, 0xe2, 0xca, 0xb7, 0x48, 0x89, 0x09, 0xae, 0xb6, 0xc0, 0x3d, 0x82, 0x2e, 0x42,
+	0xdc, 0x97, 0x47, 0x35, 0x43, 0x38, 0xcd, 0x0f, 0x14, 0xa2, 0x70, 0xf4, 0xf7, 0x2a, 0x94, 0x84,
+	0x32, 0xe4, 0x2b, 0x58, 0x49, 0x46, 0x9b, 0x04, 0x2f, 0xf7, 0xec, 0xa4, 0x95, 0x6e, 0x66, 0xb0,
+	0x22, 0x68, 0xd6, 0xa3, 0xdf, 0xfc, 0xf3, 0x3f, 0x7f, 0x2a, 0x6c, 0x5b, 0x75, 0x3e, 0xb4, 0x8d,
+	0x0e, 0xaf, 0x9f, 0x38, 0x6e, 0x70, 0xe9, 0x3c, 0x39, 0xe4, 0x47, 0x3e, 0x7a, 0x6a, 0xec, 0x93,
+	0x3e, 0x54, 0xb4, 0x09, 0x23, 0x69, 0x70, 0x36, 0xd3, 0x33, 0x4d, 0xba, 0x35, 0x85, 0x97, 0x02,
+	0x3e, 0x46, 0x01, 0xbb, 0xf4, 0x41, 0x9e, 0x80, 0xc3, 0x37, 0xbc, 0x62, 0x7e, 0xcd, 0xe5, 0x3c,
+	0x03, 0x98, 0x4c, 0xfd, 0x08, 0x6a, 0x3b, 0x35, 0x48, 0xa4, 0x8d, 0x2c, 0x5a, 0x0a, 0x59, 0x20,
+	0x2e, 0x54, 0xb4, 0x01, 0x19, 0xa1, 0x99, 0x89, 0x99, 0x36, 0xd1, 0xa3, 0x0f, 0x72, 0x69, 0x92,
+	0xd3, 0x47, 0xa8, 0x6e, 0x93, 0xec, 0x64, 0xd4, 0x8d, 0x70, 0xab, 0xd4, 0x97, 0xb4, 0x60, 0x55,
+	0x9f, 0x43, 0x11, 0xb4, 0x3e, 0x67, 0x00, 0x47, 0xcd, 0x69, 0x42, 0xa2, 0xf2, 0xa7, 0xb0, 0x96,
+	0x9a, 0xfc, 0x10, 0xdc, 0x9c, 0x37, 0x7a, 0xa2, 0xdb, 0x39, 0x94, 0x84, 0xcf, 0x57, 0xd0, 0x98,
+	0x9e, 0xd4, 0xa0, 0x17, 0x1f, 0x6a, 0x41, 0x99, 0x9e, 0x96, 0xd0, 0xe6, 0x2c, 0x72, 0xc2, 0xfa,
+	0x14, 0x6a, 0xd9, 0x89, 0x06, 0x41, 0xf7, 0xcd, 0x18, 0xc0, 0xd0, 0x9d, 0x7c, 0x62, 0xc2, 0xf0,
+	0x29, 0xac, 0x24, 0x03, 0x03, 0x91, 0xa8, 0xd9, 0xb9, 0x05, 0xdd, 0xcc, 0x60, 0x93, 0x6f, 0x07,
+	0xb0, 0x96, 0x7a, 0xa2, 0x0b, 0x7f, 0xe5, 0xcd, 0x0f, 0xe8, 0x76, 0x0e, 0x45, 0xf2, 0xf9, 0x10,
+	0x03, 0xfc, 0x80, 0x36, 0xb2, 0x01, 0xc6, 0x6d, 0x98, 0xf2, 0xc7, 0x50, 0x4d, 0x3f, 0x69, 0xc9,
+	0xf6, 0xcc, 0xe7, 0x34, 0xa5, 0x79, 0xa4, 0x44, 0xe7, 0x10, 0xd6, 0x52, 0x6f, 0x50, 0xa9, 0x73,
+	0xce, 0x63, 0x97, 0x6e, 0xe7, 0x50, 0x24, 0x9f, 0xef, 0xa3, 0xce, 0x1f, 0xef, 0x7f, 0x94, 0xd1,
+	0x59, 0xb6, 0xb2, 0x87, 0x6f, 0x78, 0x2f, 0xf2, 0xb5, 0x4a, 0xce, 0xab, 0xc4, 0x4f, 0xa2, 0xc4,
+	0xa5, 0xfc, 0x94, 0x7a, 0xc7, 0xd2, 0xed, 0x1c, 0x8a, 0x94, 0xf9, 0x18, 0x65, 0x3e, 0xa2, 0x34,
+	0x23, 0x53, 0xb4, 0xfa, 0x87, 0x6f, 0xfc, 0x00, 0x8f, 0xed, 0xcf, 0x01, 0x26, 0xcd, 0xba, 0x38,
+	0xb6, 0x53, 0xef, 0x05, 0xda, 0xc8, 0xa2, 0xa5, 0x8c, 0x26, 0xca, 0x30, 0x49, 0x23, 0xdf, 0x2e,
+	0xd2, 0x87, 0xb5, 0x54, 0x27, 0x9a, 0x8e, 0xb8, 0xde, 0xb4, 0xd3, 0xed, 0x1c, 0x8a, 0x94, 0xb2,
+	0x8b, 0x52, 0x28, 0xdd, 0xcc, 0x46, 0x1c, 0xb7, 0x71, 0x23, 0x5c, 0x58, 0x4b, 0xb5, 0x93, 0x42,
+	0x4e, 0x5e, 0x37, 0x4a, 0xb7, 0x73, 0x28, 0xe9, 0x4a, 0x47, 0x9a, 0x59, 0x39, 0xe3, 0x8e, 0x5e,
+	0xec, 0xc8, 0x39, 0x94, 0x44, 0x7f, 0x48, 0xd6, 0x25, 0x33, 0x8d, 0x3f, 0xd1, 0x51, 0x92, 0xf1,
+	0xf7, 0x90, 0xf1, 0x43, 0x72, 0x57, 0x09, 0x25, 0xbf, 0x84, 0x8a, 0xd6, 0x52, 0x89, 0x3a, 0x3d,
+	0xdd, 0xf6, 0xd1, 0xad, 0x29, 0xfc, 0x7b, 0xbc, 0xc4, 0xf8, 0x2e, 0x3c, 0x16, 0x2d, 0x58, 0xd5,
+	0x5b, 0x4e, 0x51, 0xf4, 0x72, 0x7a, 0x53, 0x6a, 0x4e, 0x13, 0x92, 0x03, 0x71, 0x0c, 0xd5, 0x74,
+	0xef, 0x24, 0xce, 0x56, 0x6e, 0x63, 0x46, 0x69, 0x1e, 0x29, 0x61, 0xd5, 0x82, 0x55, 0xbd, 0xb9,
+	0x21, 0xfa, 0x15, 0x94, 0x2a, 0x4a, 0xe6, 0x34, 0x41, 0x31, 0x79, 0x6e, 0xfe, 0xe3, 0x6d, 0xd3,
+	0xf8, 0xf6, 0x6d, 0xd3, 0xf8, 0xf7, 0xdb, 0xa6, 0xf1, 0x87, 0x77, 0xcd, 0x85, 0x6f, 0xdf, 0x35,
+	0x17, 0xfe, 0xf5, 0xae, 0xb9, 0xd0, 0x29, 0xe1, 0x5f, 0xcb, 0x1f, 0xfc, 0x77, 0x00, 0x12, 0xbb,
+	0xbc, 0x0e, 0xf9, 0x1c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5257,6 +5277,25 @@ func (m *RegisterWorkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDmmaster(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -5334,6 +5373,16 @@ func (m *OfflineMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Drain {
+		i--
+		if m.Drain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
@@ -7083,6 +7132,14 @@ func (m *RegisterWorkerRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDmmaster(uint64(l))
 	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDmmaster(uint64(len(k))) + 1 + len(v) + sovDmmaster(uint64(len(v)))
+			n += mapEntrySize + 1 + sovDmmaster(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -7116,6 +7173,9 @@ func (m *OfflineMemberRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDmmaster(uint64(l))
 	}
+	if m.Drain {
+		n += 2
+	}
 	return n
 }
 
@@ -10745,6 +10805,133 @@ func (m *RegisterWorkerRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDmmaster
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDmmaster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthDmmaster
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthDmmaster
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDmmaster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthDmmaster
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthDmmaster
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDmmaster(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthDmmaster
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -10961,6 +11148,26 @@ func (m *OfflineMemberRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Drain = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -279,6 +279,8 @@ const (
 	ErrorOp_Revert         ErrorOp = 3
 	ErrorOp_Inject         ErrorOp = 4
 	ErrorOp_List           ErrorOp = 5
+	ErrorOp_Approve        ErrorOp = 6
+	ErrorOp_Show           ErrorOp = 7
 )
 
 var ErrorOp_name = map[int32]string{
@@ -288,6 +290,8 @@ var ErrorOp_name = map[int32]string{
 	3: "Revert",
 	4: "Inject",
 	5: "List",
+	6: "Approve",
+	7: "Show",
 }
 
 var ErrorOp_value = map[string]int32{
@@ -297,6 +301,8 @@ var ErrorOp_value = map[string]int32{
 	"Revert":         3,
 	"Inject":         4,
 	"List":           5,
+	"Approve":        6,
+	"Show":           7,
 }
 
 func (x ErrorOp) String() string {
@@ -307,6 +313,68 @@ func (ErrorOp) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_51a1b9e17fd67b10, []int{6}
 }
 
+type SkipGTIDOp int32
+
+const (
+	SkipGTIDOp_InvalidSkipGTIDOp SkipGTIDOp = 0
+	SkipGTIDOp_ListSkipGTID      SkipGTIDOp = 1
+	SkipGTIDOp_AddSkipGTID       SkipGTIDOp = 2
+	SkipGTIDOp_RemoveSkipGTID    SkipGTIDOp = 3
+)
+
+var SkipGTIDOp_name = map[int32]string{
+	0: "InvalidSkipGTIDOp",
+	1: "ListSkipGTID",
+	2: "AddSkipGTID",
+	3: "RemoveSkipGTID",
+}
+
+var SkipGTIDOp_value = map[string]int32{
+	"InvalidSkipGTIDOp": 0,
+	"ListSkipGTID":      1,
+	"AddSkipGTID":       2,
+	"RemoveSkipGTID":    3,
+}
+
+func (x SkipGTIDOp) String() string {
+	return proto.EnumName(SkipGTIDOp_name, int32(x))
+}
+
+func (SkipGTIDOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{7}
+}
+
+type PauseTableOp int32
+
+const (
+	PauseTableOp_InvalidPauseTableOp PauseTableOp = 0
+	PauseTableOp_ListPausedTable     PauseTableOp = 1
+	PauseTableOp_PauseTable          PauseTableOp = 2
+	PauseTableOp_ResumeTable         PauseTableOp = 3
+)
+
+var PauseTableOp_name = map[int32]string{
+	0: "InvalidPauseTableOp",
+	1: "ListPausedTable",
+	2: "PauseTable",
+	3: "ResumeTable",
+}
+
+var PauseTableOp_value = map[string]int32{
+	"InvalidPauseTableOp": 0,
+	"ListPausedTable":     1,
+	"PauseTable":          2,
+	"ResumeTable":         3,
+}
+
+func (x PauseTableOp) String() string {
+	return proto.EnumName(PauseTableOp_name, int32(x))
+}
+
+func (PauseTableOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{8}
+}
+
 type QueryStatusRequest struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
@@ -655,11 +723,19 @@ func (m *DumpStatus) GetEstimateTotalRows() float64 {
 
 // LoadStatus represents status for load unit
 type LoadStatus struct {
-	FinishedBytes  int64  `protobuf:"varint,1,opt,name=finishedBytes,proto3" json:"finishedBytes,omitempty"`
-	TotalBytes     int64  `protobuf:"varint,2,opt,name=totalBytes,proto3" json:"totalBytes,omitempty"`
-	Progress       string `protobuf:"bytes,3,opt,name=progress,proto3" json:"progress,omitempty"`
-	MetaBinlog     string `protobuf:"bytes,4,opt,name=metaBinlog,proto3" json:"metaBinlog,omitempty"`
-	MetaBinlogGTID string `protobuf:"bytes,5,opt,name=metaBinlogGTID,proto3" json:"metaBinlogGTID,omitempty"`
+	FinishedBytes    int64                `protobuf:"varint,1,opt,name=finishedBytes,proto3" json:"finishedBytes,omitempty"`
+	TotalBytes       int64                `protobuf:"varint,2,opt,name=totalBytes,proto3" json:"totalBytes,omitempty"`
+	Progress         string               `protobuf:"bytes,3,opt,name=progress,proto3" json:"progress,omitempty"`
+	MetaBinlog       string               `protobuf:"bytes,4,opt,name=metaBinlog,proto3" json:"metaBinlog,omitempty"`
+	MetaBinlogGTID   string               `protobuf:"bytes,5,opt,name=metaBinlogGTID,proto3" json:"metaBinlogGTID,omitempty"`
+	FinishedChunks   int64                `protobuf:"varint,6,opt,name=finishedChunks,proto3" json:"finishedChunks,omitempty"`
+	TotalChunks      int64                `protobuf:"varint,7,opt,name=totalChunks,proto3" json:"totalChunks,omitempty"`
+	RowsPerSecond    int64                `protobuf:"varint,8,opt,name=rowsPerSecond,proto3" json:"rowsPerSecond,omitempty"`
+	BytesPerSecond   int64                `protobuf:"varint,9,opt,name=bytesPerSecond,proto3" json:"bytesPerSecond,omitempty"`
+	RemainingSeconds int64                `protobuf:"varint,10,opt,name=remainingSeconds,proto3" json:"remainingSeconds,omitempty"`
+	Bottleneck       string               `protobuf:"bytes,11,opt,name=bottleneck,proto3" json:"bottleneck,omitempty"`
+	Tables           []*LoadTableProgress `protobuf:"bytes,12,rep,name=tables,proto3" json:"tables,omitempty"`
+	BadRows          int64                `protobuf:"varint,13,opt,name=badRows,proto3" json:"badRows,omitempty"`
 }
 
 func (m *LoadStatus) Reset()         { *m = LoadStatus{} }
@@ -730,6 +806,147 @@ func (m *LoadStatus) GetMetaBinlogGTID() string {
 	return ""
 }
 
+func (m *LoadStatus) GetFinishedChunks() int64 {
+	if m != nil {
+		return m.FinishedChunks
+	}
+	return 0
+}
+
+func (m *LoadStatus) GetTotalChunks() int64 {
+	if m != nil {
+		return m.TotalChunks
+	}
+	return 0
+}
+
+func (m *LoadStatus) GetRowsPerSecond() int64 {
+	if m != nil {
+		return m.RowsPerSecond
+	}
+	return 0
+}
+
+func (m *LoadStatus) GetBytesPerSecond() int64 {
+	if m != nil {
+		return m.BytesPerSecond
+	}
+	return 0
+}
+
+func (m *LoadStatus) GetRemainingSeconds() int64 {
+	if m != nil {
+		return m.RemainingSeconds
+	}
+	return 0
+}
+
+func (m *LoadStatus) GetBottleneck() string {
+	if m != nil {
+		return m.Bottleneck
+	}
+	return ""
+}
+
+func (m *LoadStatus) GetTables() []*LoadTableProgress {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+func (m *LoadStatus) GetBadRows() int64 {
+	if m != nil {
+		return m.BadRows
+	}
+	return 0
+}
+
+// LoadTableProgress represents the progress of a source table in load unit, the data files of the table are its chunks
+type LoadTableProgress struct {
+	Schema         string `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Table          string `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	FinishedChunks int64  `protobuf:"varint,3,opt,name=finishedChunks,proto3" json:"finishedChunks,omitempty"`
+	TotalChunks    int64  `protobuf:"varint,4,opt,name=totalChunks,proto3" json:"totalChunks,omitempty"`
+	FinishedBytes  int64  `protobuf:"varint,5,opt,name=finishedBytes,proto3" json:"finishedBytes,omitempty"`
+	TotalBytes     int64  `protobuf:"varint,6,opt,name=totalBytes,proto3" json:"totalBytes,omitempty"`
+}
+
+func (m *LoadTableProgress) Reset()         { *m = LoadTableProgress{} }
+func (m *LoadTableProgress) String() string { return proto.CompactTextString(m) }
+func (*LoadTableProgress) ProtoMessage()    {}
+func (*LoadTableProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{6}
+}
+func (m *LoadTableProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LoadTableProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LoadTableProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LoadTableProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadTableProgress.Merge(m, src)
+}
+func (m *LoadTableProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *LoadTableProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadTableProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadTableProgress proto.InternalMessageInfo
+
+func (m *LoadTableProgress) GetSchema() string {
+	if m != nil {
+		return m.Schema
+	}
+	return ""
+}
+
+func (m *LoadTableProgress) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *LoadTableProgress) GetFinishedChunks() int64 {
+	if m != nil {
+		return m.FinishedChunks
+	}
+	return 0
+}
+
+func (m *LoadTableProgress) GetTotalChunks() int64 {
+	if m != nil {
+		return m.TotalChunks
+	}
+	return 0
+}
+
+func (m *LoadTableProgress) GetFinishedBytes() int64 {
+	if m != nil {
+		return m.FinishedBytes
+	}
+	return 0
+}
+
+func (m *LoadTableProgress) GetTotalBytes() int64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
 // ShardingGroup represents a DDL sharding group, this is used by SyncStatus, and is differ from ShardingGroup in syncer pkg
 // target: target table name
 // DDL: in syncing DDL
@@ -748,7 +965,7 @@ func (m *ShardingGroup) Reset()         { *m = ShardingGroup{} }
 func (m *ShardingGroup) String() string { return proto.CompactTextString(m) }
 func (*ShardingGroup) ProtoMessage()    {}
 func (*ShardingGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{6}
+	return fileDescriptor_51a1b9e17fd67b10, []int{7}
 }
 func (m *ShardingGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -814,25 +1031,27 @@ func (m *ShardingGroup) GetUnsynced() []string {
 
 // SyncStatus represents status for sync unit
 type SyncStatus struct {
-	TotalEvents         int64            `protobuf:"varint,1,opt,name=totalEvents,proto3" json:"totalEvents,omitempty"`
-	TotalTps            int64            `protobuf:"varint,2,opt,name=totalTps,proto3" json:"totalTps,omitempty"`
-	RecentTps           int64            `protobuf:"varint,3,opt,name=recentTps,proto3" json:"recentTps,omitempty"`
-	MasterBinlog        string           `protobuf:"bytes,4,opt,name=masterBinlog,proto3" json:"masterBinlog,omitempty"`
-	MasterBinlogGtid    string           `protobuf:"bytes,5,opt,name=masterBinlogGtid,proto3" json:"masterBinlogGtid,omitempty"`
-	SyncerBinlog        string           `protobuf:"bytes,6,opt,name=syncerBinlog,proto3" json:"syncerBinlog,omitempty"`
-	SyncerBinlogGtid    string           `protobuf:"bytes,7,opt,name=syncerBinlogGtid,proto3" json:"syncerBinlogGtid,omitempty"`
-	BlockingDDLs        []string         `protobuf:"bytes,8,rep,name=blockingDDLs,proto3" json:"blockingDDLs,omitempty"`
-	UnresolvedGroups    []*ShardingGroup `protobuf:"bytes,9,rep,name=unresolvedGroups,proto3" json:"unresolvedGroups,omitempty"`
-	Synced              bool             `protobuf:"varint,10,opt,name=synced,proto3" json:"synced,omitempty"`
-	BinlogType          string           `protobuf:"bytes,11,opt,name=binlogType,proto3" json:"binlogType,omitempty"`
-	SecondsBehindMaster int64            `protobuf:"varint,12,opt,name=secondsBehindMaster,proto3" json:"secondsBehindMaster,omitempty"`
+	TotalEvents         int64             `protobuf:"varint,1,opt,name=totalEvents,proto3" json:"totalEvents,omitempty"`
+	TotalTps            int64             `protobuf:"varint,2,opt,name=totalTps,proto3" json:"totalTps,omitempty"`
+	RecentTps           int64             `protobuf:"varint,3,opt,name=recentTps,proto3" json:"recentTps,omitempty"`
+	MasterBinlog        string            `protobuf:"bytes,4,opt,name=masterBinlog,proto3" json:"masterBinlog,omitempty"`
+	MasterBinlogGtid    string            `protobuf:"bytes,5,opt,name=masterBinlogGtid,proto3" json:"masterBinlogGtid,omitempty"`
+	SyncerBinlog        string            `protobuf:"bytes,6,opt,name=syncerBinlog,proto3" json:"syncerBinlog,omitempty"`
+	SyncerBinlogGtid    string            `protobuf:"bytes,7,opt,name=syncerBinlogGtid,proto3" json:"syncerBinlogGtid,omitempty"`
+	BlockingDDLs        []string          `protobuf:"bytes,8,rep,name=blockingDDLs,proto3" json:"blockingDDLs,omitempty"`
+	UnresolvedGroups    []*ShardingGroup  `protobuf:"bytes,9,rep,name=unresolvedGroups,proto3" json:"unresolvedGroups,omitempty"`
+	Synced              bool              `protobuf:"varint,10,opt,name=synced,proto3" json:"synced,omitempty"`
+	BinlogType          string            `protobuf:"bytes,11,opt,name=binlogType,proto3" json:"binlogType,omitempty"`
+	SecondsBehindMaster int64             `protobuf:"varint,12,opt,name=secondsBehindMaster,proto3" json:"secondsBehindMaster,omitempty"`
+	Validation          *ValidationStatus `protobuf:"bytes,13,opt,name=validation,proto3" json:"validation,omitempty"`
+	DmlType             string            `protobuf:"bytes,14,opt,name=dmlType,proto3" json:"dmlType,omitempty"`
 }
 
 func (m *SyncStatus) Reset()         { *m = SyncStatus{} }
 func (m *SyncStatus) String() string { return proto.CompactTextString(m) }
 func (*SyncStatus) ProtoMessage()    {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{7}
+	return fileDescriptor_51a1b9e17fd67b10, []int{8}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -945,6 +1164,97 @@ func (m *SyncStatus) GetSecondsBehindMaster() int64 {
 	return 0
 }
 
+func (m *SyncStatus) GetValidation() *ValidationStatus {
+	if m != nil {
+		return m.Validation
+	}
+	return nil
+}
+
+func (m *SyncStatus) GetDmlType() string {
+	if m != nil {
+		return m.DmlType
+	}
+	return ""
+}
+
+// ValidationStatus represents status for the continuous data validation of sync unit
+type ValidationStatus struct {
+	Mode          string   `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	ValidatedRows int64    `protobuf:"varint,2,opt,name=validatedRows,proto3" json:"validatedRows,omitempty"`
+	PendingRows   int64    `protobuf:"varint,3,opt,name=pendingRows,proto3" json:"pendingRows,omitempty"`
+	FailedRows    int64    `protobuf:"varint,4,opt,name=failedRows,proto3" json:"failedRows,omitempty"`
+	FailedRowKeys []string `protobuf:"bytes,5,rep,name=failedRowKeys,proto3" json:"failedRowKeys,omitempty"`
+}
+
+func (m *ValidationStatus) Reset()         { *m = ValidationStatus{} }
+func (m *ValidationStatus) String() string { return proto.CompactTextString(m) }
+func (*ValidationStatus) ProtoMessage()    {}
+func (*ValidationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{9}
+}
+func (m *ValidationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidationStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidationStatus.Merge(m, src)
+}
+func (m *ValidationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidationStatus proto.InternalMessageInfo
+
+func (m *ValidationStatus) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+func (m *ValidationStatus) GetValidatedRows() int64 {
+	if m != nil {
+		return m.ValidatedRows
+	}
+	return 0
+}
+
+func (m *ValidationStatus) GetPendingRows() int64 {
+	if m != nil {
+		return m.PendingRows
+	}
+	return 0
+}
+
+func (m *ValidationStatus) GetFailedRows() int64 {
+	if m != nil {
+		return m.FailedRows
+	}
+	return 0
+}
+
+func (m *ValidationStatus) GetFailedRowKeys() []string {
+	if m != nil {
+		return m.FailedRowKeys
+	}
+	return nil
+}
+
 // SourceStatus represents status for source runing on dm-worker
 type SourceStatus struct {
 	Source      string         `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
@@ -957,7 +1267,7 @@ func (m *SourceStatus) Reset()         { *m = SourceStatus{} }
 func (m *SourceStatus) String() string { return proto.CompactTextString(m) }
 func (*SourceStatus) ProtoMessage()    {}
 func (*SourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{8}
+	return fileDescriptor_51a1b9e17fd67b10, []int{10}
 }
 func (m *SourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1030,7 +1340,7 @@ func (m *RelayStatus) Reset()         { *m = RelayStatus{} }
 func (m *RelayStatus) String() string { return proto.CompactTextString(m) }
 func (*RelayStatus) ProtoMessage()    {}
 func (*RelayStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{9}
+	return fileDescriptor_51a1b9e17fd67b10, []int{11}
 }
 func (m *RelayStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1144,7 +1454,7 @@ func (m *SubTaskStatus) Reset()         { *m = SubTaskStatus{} }
 func (m *SubTaskStatus) String() string { return proto.CompactTextString(m) }
 func (*SubTaskStatus) ProtoMessage()    {}
 func (*SubTaskStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{10}
+	return fileDescriptor_51a1b9e17fd67b10, []int{12}
 }
 func (m *SubTaskStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1298,7 +1608,7 @@ func (m *SubTaskStatusList) Reset()         { *m = SubTaskStatusList{} }
 func (m *SubTaskStatusList) String() string { return proto.CompactTextString(m) }
 func (*SubTaskStatusList) ProtoMessage()    {}
 func (*SubTaskStatusList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{11}
+	return fileDescriptor_51a1b9e17fd67b10, []int{13}
 }
 func (m *SubTaskStatusList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1344,7 +1654,7 @@ func (m *CheckError) Reset()         { *m = CheckError{} }
 func (m *CheckError) String() string { return proto.CompactTextString(m) }
 func (*CheckError) ProtoMessage()    {}
 func (*CheckError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{12}
+	return fileDescriptor_51a1b9e17fd67b10, []int{14}
 }
 func (m *CheckError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1390,7 +1700,7 @@ func (m *DumpError) Reset()         { *m = DumpError{} }
 func (m *DumpError) String() string { return proto.CompactTextString(m) }
 func (*DumpError) ProtoMessage()    {}
 func (*DumpError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{13}
+	return fileDescriptor_51a1b9e17fd67b10, []int{15}
 }
 func (m *DumpError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1435,7 +1745,7 @@ func (m *LoadError) Reset()         { *m = LoadError{} }
 func (m *LoadError) String() string { return proto.CompactTextString(m) }
 func (*LoadError) ProtoMessage()    {}
 func (*LoadError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{14}
+	return fileDescriptor_51a1b9e17fd67b10, []int{16}
 }
 func (m *LoadError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1482,7 +1792,7 @@ func (m *SyncSQLError) Reset()         { *m = SyncSQLError{} }
 func (m *SyncSQLError) String() string { return proto.CompactTextString(m) }
 func (*SyncSQLError) ProtoMessage()    {}
 func (*SyncSQLError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{15}
+	return fileDescriptor_51a1b9e17fd67b10, []int{17}
 }
 func (m *SyncSQLError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1541,7 +1851,7 @@ func (m *SyncError) Reset()         { *m = SyncError{} }
 func (m *SyncError) String() string { return proto.CompactTextString(m) }
 func (*SyncError) ProtoMessage()    {}
 func (*SyncError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{16}
+	return fileDescriptor_51a1b9e17fd67b10, []int{18}
 }
 func (m *SyncError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1589,7 +1899,7 @@ func (m *SourceError) Reset()         { *m = SourceError{} }
 func (m *SourceError) String() string { return proto.CompactTextString(m) }
 func (*SourceError) ProtoMessage()    {}
 func (*SourceError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{17}
+	return fileDescriptor_51a1b9e17fd67b10, []int{19}
 }
 func (m *SourceError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1655,7 +1965,7 @@ func (m *RelayError) Reset()         { *m = RelayError{} }
 func (m *RelayError) String() string { return proto.CompactTextString(m) }
 func (*RelayError) ProtoMessage()    {}
 func (*RelayError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{18}
+	return fileDescriptor_51a1b9e17fd67b10, []int{20}
 }
 func (m *RelayError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1714,7 +2024,7 @@ func (m *SubTaskError) Reset()         { *m = SubTaskError{} }
 func (m *SubTaskError) String() string { return proto.CompactTextString(m) }
 func (*SubTaskError) ProtoMessage()    {}
 func (*SubTaskError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{19}
+	return fileDescriptor_51a1b9e17fd67b10, []int{21}
 }
 func (m *SubTaskError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1854,7 +2164,7 @@ func (m *SubTaskErrorList) Reset()         { *m = SubTaskErrorList{} }
 func (m *SubTaskErrorList) String() string { return proto.CompactTextString(m) }
 func (*SubTaskErrorList) ProtoMessage()    {}
 func (*SubTaskErrorList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{20}
+	return fileDescriptor_51a1b9e17fd67b10, []int{22}
 }
 func (m *SubTaskErrorList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1904,7 +2214,7 @@ func (m *ProcessResult) Reset()         { *m = ProcessResult{} }
 func (m *ProcessResult) String() string { return proto.CompactTextString(m) }
 func (*ProcessResult) ProtoMessage()    {}
 func (*ProcessResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{21}
+	return fileDescriptor_51a1b9e17fd67b10, []int{23}
 }
 func (m *ProcessResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1971,7 +2281,7 @@ func (m *ProcessError) Reset()         { *m = ProcessError{} }
 func (m *ProcessError) String() string { return proto.CompactTextString(m) }
 func (*ProcessError) ProtoMessage()    {}
 func (*ProcessError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{22}
+	return fileDescriptor_51a1b9e17fd67b10, []int{24}
 }
 func (m *ProcessError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2065,7 +2375,7 @@ func (m *PurgeRelayRequest) Reset()         { *m = PurgeRelayRequest{} }
 func (m *PurgeRelayRequest) String() string { return proto.CompactTextString(m) }
 func (*PurgeRelayRequest) ProtoMessage()    {}
 func (*PurgeRelayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{23}
+	return fileDescriptor_51a1b9e17fd67b10, []int{25}
 }
 func (m *PurgeRelayRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2139,7 +2449,7 @@ func (m *OperateWorkerSchemaRequest) Reset()         { *m = OperateWorkerSchemaR
 func (m *OperateWorkerSchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateWorkerSchemaRequest) ProtoMessage()    {}
 func (*OperateWorkerSchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{24}
+	return fileDescriptor_51a1b9e17fd67b10, []int{26}
 }
 func (m *OperateWorkerSchemaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2250,7 +2560,7 @@ func (m *V1SubTaskMeta) Reset()         { *m = V1SubTaskMeta{} }
 func (m *V1SubTaskMeta) String() string { return proto.CompactTextString(m) }
 func (*V1SubTaskMeta) ProtoMessage()    {}
 func (*V1SubTaskMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{25}
+	return fileDescriptor_51a1b9e17fd67b10, []int{27}
 }
 func (m *V1SubTaskMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2315,7 +2625,7 @@ func (m *OperateV1MetaRequest) Reset()         { *m = OperateV1MetaRequest{} }
 func (m *OperateV1MetaRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateV1MetaRequest) ProtoMessage()    {}
 func (*OperateV1MetaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{26}
+	return fileDescriptor_51a1b9e17fd67b10, []int{28}
 }
 func (m *OperateV1MetaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2361,7 +2671,7 @@ func (m *OperateV1MetaResponse) Reset()         { *m = OperateV1MetaResponse{} }
 func (m *OperateV1MetaResponse) String() string { return proto.CompactTextString(m) }
 func (*OperateV1MetaResponse) ProtoMessage()    {}
 func (*OperateV1MetaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{27}
+	return fileDescriptor_51a1b9e17fd67b10, []int{29}
 }
 func (m *OperateV1MetaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2422,7 +2732,7 @@ func (m *HandleWorkerErrorRequest) Reset()         { *m = HandleWorkerErrorReque
 func (m *HandleWorkerErrorRequest) String() string { return proto.CompactTextString(m) }
 func (*HandleWorkerErrorRequest) ProtoMessage()    {}
 func (*HandleWorkerErrorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{28}
+	return fileDescriptor_51a1b9e17fd67b10, []int{30}
 }
 func (m *HandleWorkerErrorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2486,7 +2796,7 @@ func (m *GetWorkerCfgRequest) Reset()         { *m = GetWorkerCfgRequest{} }
 func (m *GetWorkerCfgRequest) String() string { return proto.CompactTextString(m) }
 func (*GetWorkerCfgRequest) ProtoMessage()    {}
 func (*GetWorkerCfgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{29}
+	return fileDescriptor_51a1b9e17fd67b10, []int{31}
 }
 func (m *GetWorkerCfgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2523,7 +2833,7 @@ func (m *GetWorkerCfgResponse) Reset()         { *m = GetWorkerCfgResponse{} }
 func (m *GetWorkerCfgResponse) String() string { return proto.CompactTextString(m) }
 func (*GetWorkerCfgResponse) ProtoMessage()    {}
 func (*GetWorkerCfgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{30}
+	return fileDescriptor_51a1b9e17fd67b10, []int{32}
 }
 func (m *GetWorkerCfgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2559,35 +2869,333 @@ func (m *GetWorkerCfgResponse) GetCfg() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("pb.TaskOp", TaskOp_name, TaskOp_value)
-	proto.RegisterEnum("pb.Stage", Stage_name, Stage_value)
-	proto.RegisterEnum("pb.UnitType", UnitType_name, UnitType_value)
-	proto.RegisterEnum("pb.RelayOp", RelayOp_name, RelayOp_value)
-	proto.RegisterEnum("pb.SchemaOp", SchemaOp_name, SchemaOp_value)
-	proto.RegisterEnum("pb.V1MetaOp", V1MetaOp_name, V1MetaOp_value)
-	proto.RegisterEnum("pb.ErrorOp", ErrorOp_name, ErrorOp_value)
-	proto.RegisterType((*QueryStatusRequest)(nil), "pb.QueryStatusRequest")
-	proto.RegisterType((*CommonWorkerResponse)(nil), "pb.CommonWorkerResponse")
-	proto.RegisterType((*QueryStatusResponse)(nil), "pb.QueryStatusResponse")
-	proto.RegisterType((*CheckStatus)(nil), "pb.CheckStatus")
-	proto.RegisterType((*DumpStatus)(nil), "pb.DumpStatus")
-	proto.RegisterType((*LoadStatus)(nil), "pb.LoadStatus")
-	proto.RegisterType((*ShardingGroup)(nil), "pb.ShardingGroup")
-	proto.RegisterType((*SyncStatus)(nil), "pb.SyncStatus")
-	proto.RegisterType((*SourceStatus)(nil), "pb.SourceStatus")
-	proto.RegisterType((*RelayStatus)(nil), "pb.RelayStatus")
-	proto.RegisterType((*SubTaskStatus)(nil), "pb.SubTaskStatus")
-	proto.RegisterType((*SubTaskStatusList)(nil), "pb.SubTaskStatusList")
-	proto.RegisterType((*CheckError)(nil), "pb.CheckError")
-	proto.RegisterType((*DumpError)(nil), "pb.DumpError")
-	proto.RegisterType((*LoadError)(nil), "pb.LoadError")
-	proto.RegisterType((*SyncSQLError)(nil), "pb.SyncSQLError")
-	proto.RegisterType((*SyncError)(nil), "pb.SyncError")
-	proto.RegisterType((*SourceError)(nil), "pb.SourceError")
-	proto.RegisterType((*RelayError)(nil), "pb.RelayError")
-	proto.RegisterType((*SubTaskError)(nil), "pb.SubTaskError")
-	proto.RegisterType((*SubTaskErrorList)(nil), "pb.SubTaskErrorList")
+// SetRateLimitRequest changes the rows/sec and bytes/sec limits of a subtask, non-positive limit means unlimited.
+type SetRateLimitRequest struct {
+	Task           string `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	RowsPerSecond  int64  `protobuf:"varint,2,opt,name=rowsPerSecond,proto3" json:"rowsPerSecond,omitempty"`
+	BytesPerSecond int64  `protobuf:"varint,3,opt,name=bytesPerSecond,proto3" json:"bytesPerSecond,omitempty"`
+}
+
+func (m *SetRateLimitRequest) Reset()         { *m = SetRateLimitRequest{} }
+func (m *SetRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*SetRateLimitRequest) ProtoMessage()    {}
+func (*SetRateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{33}
+}
+func (m *SetRateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetRateLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRateLimitRequest.Merge(m, src)
+}
+func (m *SetRateLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRateLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRateLimitRequest proto.InternalMessageInfo
+
+func (m *SetRateLimitRequest) GetTask() string {
+	if m != nil {
+		return m.Task
+	}
+	return ""
+}
+
+func (m *SetRateLimitRequest) GetRowsPerSecond() int64 {
+	if m != nil {
+		return m.RowsPerSecond
+	}
+	return 0
+}
+
+func (m *SetRateLimitRequest) GetBytesPerSecond() int64 {
+	if m != nil {
+		return m.BytesPerSecond
+	}
+	return 0
+}
+
+// OperateSkipGTIDRequest operates the GTID skip list of a subtask, the transactions of the GTIDs in the list are skipped.
+type OperateSkipGTIDRequest struct {
+	Op      SkipGTIDOp `protobuf:"varint,1,opt,name=op,proto3,enum=pb.SkipGTIDOp" json:"op,omitempty"`
+	Task    string     `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	GtidSet string     `protobuf:"bytes,3,opt,name=gtidSet,proto3" json:"gtidSet,omitempty"`
+}
+
+func (m *OperateSkipGTIDRequest) Reset()         { *m = OperateSkipGTIDRequest{} }
+func (m *OperateSkipGTIDRequest) String() string { return proto.CompactTextString(m) }
+func (*OperateSkipGTIDRequest) ProtoMessage()    {}
+func (*OperateSkipGTIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{34}
+}
+func (m *OperateSkipGTIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperateSkipGTIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperateSkipGTIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperateSkipGTIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperateSkipGTIDRequest.Merge(m, src)
+}
+func (m *OperateSkipGTIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperateSkipGTIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperateSkipGTIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperateSkipGTIDRequest proto.InternalMessageInfo
+
+func (m *OperateSkipGTIDRequest) GetOp() SkipGTIDOp {
+	if m != nil {
+		return m.Op
+	}
+	return SkipGTIDOp_InvalidSkipGTIDOp
+}
+
+func (m *OperateSkipGTIDRequest) GetTask() string {
+	if m != nil {
+		return m.Task
+	}
+	return ""
+}
+
+func (m *OperateSkipGTIDRequest) GetGtidSet() string {
+	if m != nil {
+		return m.GtidSet
+	}
+	return ""
+}
+
+type UpdateSubTaskRulesRequest struct {
+	Task       string `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	SubtaskCfg string `protobuf:"bytes,2,opt,name=subtaskCfg,proto3" json:"subtaskCfg,omitempty"`
+}
+
+func (m *UpdateSubTaskRulesRequest) Reset()         { *m = UpdateSubTaskRulesRequest{} }
+func (m *UpdateSubTaskRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateSubTaskRulesRequest) ProtoMessage()    {}
+func (*UpdateSubTaskRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{35}
+}
+func (m *UpdateSubTaskRulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateSubTaskRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateSubTaskRulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateSubTaskRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateSubTaskRulesRequest.Merge(m, src)
+}
+func (m *UpdateSubTaskRulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateSubTaskRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateSubTaskRulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateSubTaskRulesRequest proto.InternalMessageInfo
+
+func (m *UpdateSubTaskRulesRequest) GetTask() string {
+	if m != nil {
+		return m.Task
+	}
+	return ""
+}
+
+func (m *UpdateSubTaskRulesRequest) GetSubtaskCfg() string {
+	if m != nil {
+		return m.SubtaskCfg
+	}
+	return ""
+}
+
+type OperatePausedTableRequest struct {
+	Op     PauseTableOp `protobuf:"varint,1,opt,name=op,proto3,enum=pb.PauseTableOp" json:"op,omitempty"`
+	Task   string       `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	Tables []string     `protobuf:"bytes,3,rep,name=tables,proto3" json:"tables,omitempty"`
+	Policy string       `protobuf:"bytes,4,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (m *OperatePausedTableRequest) Reset()         { *m = OperatePausedTableRequest{} }
+func (m *OperatePausedTableRequest) String() string { return proto.CompactTextString(m) }
+func (*OperatePausedTableRequest) ProtoMessage()    {}
+func (*OperatePausedTableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{36}
+}
+func (m *OperatePausedTableRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatePausedTableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatePausedTableRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatePausedTableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatePausedTableRequest.Merge(m, src)
+}
+func (m *OperatePausedTableRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatePausedTableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatePausedTableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatePausedTableRequest proto.InternalMessageInfo
+
+func (m *OperatePausedTableRequest) GetOp() PauseTableOp {
+	if m != nil {
+		return m.Op
+	}
+	return PauseTableOp_InvalidPauseTableOp
+}
+
+func (m *OperatePausedTableRequest) GetTask() string {
+	if m != nil {
+		return m.Task
+	}
+	return ""
+}
+
+func (m *OperatePausedTableRequest) GetTables() []string {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+func (m *OperatePausedTableRequest) GetPolicy() string {
+	if m != nil {
+		return m.Policy
+	}
+	return ""
+}
+
+type SetTaskPriorityRequest struct {
+	Task     string `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Priority string `protobuf:"bytes,2,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (m *SetTaskPriorityRequest) Reset()         { *m = SetTaskPriorityRequest{} }
+func (m *SetTaskPriorityRequest) String() string { return proto.CompactTextString(m) }
+func (*SetTaskPriorityRequest) ProtoMessage()    {}
+func (*SetTaskPriorityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{37}
+}
+func (m *SetTaskPriorityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetTaskPriorityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetTaskPriorityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetTaskPriorityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTaskPriorityRequest.Merge(m, src)
+}
+func (m *SetTaskPriorityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetTaskPriorityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTaskPriorityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTaskPriorityRequest proto.InternalMessageInfo
+
+func (m *SetTaskPriorityRequest) GetTask() string {
+	if m != nil {
+		return m.Task
+	}
+	return ""
+}
+
+func (m *SetTaskPriorityRequest) GetPriority() string {
+	if m != nil {
+		return m.Priority
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("pb.TaskOp", TaskOp_name, TaskOp_value)
+	proto.RegisterEnum("pb.Stage", Stage_name, Stage_value)
+	proto.RegisterEnum("pb.UnitType", UnitType_name, UnitType_value)
+	proto.RegisterEnum("pb.RelayOp", RelayOp_name, RelayOp_value)
+	proto.RegisterEnum("pb.SchemaOp", SchemaOp_name, SchemaOp_value)
+	proto.RegisterEnum("pb.V1MetaOp", V1MetaOp_name, V1MetaOp_value)
+	proto.RegisterEnum("pb.ErrorOp", ErrorOp_name, ErrorOp_value)
+	proto.RegisterEnum("pb.SkipGTIDOp", SkipGTIDOp_name, SkipGTIDOp_value)
+	proto.RegisterEnum("pb.PauseTableOp", PauseTableOp_name, PauseTableOp_value)
+	proto.RegisterType((*QueryStatusRequest)(nil), "pb.QueryStatusRequest")
+	proto.RegisterType((*CommonWorkerResponse)(nil), "pb.CommonWorkerResponse")
+	proto.RegisterType((*QueryStatusResponse)(nil), "pb.QueryStatusResponse")
+	proto.RegisterType((*CheckStatus)(nil), "pb.CheckStatus")
+	proto.RegisterType((*DumpStatus)(nil), "pb.DumpStatus")
+	proto.RegisterType((*LoadStatus)(nil), "pb.LoadStatus")
+	proto.RegisterType((*LoadTableProgress)(nil), "pb.LoadTableProgress")
+	proto.RegisterType((*ShardingGroup)(nil), "pb.ShardingGroup")
+	proto.RegisterType((*SyncStatus)(nil), "pb.SyncStatus")
+	proto.RegisterType((*ValidationStatus)(nil), "pb.ValidationStatus")
+	proto.RegisterType((*SourceStatus)(nil), "pb.SourceStatus")
+	proto.RegisterType((*RelayStatus)(nil), "pb.RelayStatus")
+	proto.RegisterType((*SubTaskStatus)(nil), "pb.SubTaskStatus")
+	proto.RegisterType((*SubTaskStatusList)(nil), "pb.SubTaskStatusList")
+	proto.RegisterType((*CheckError)(nil), "pb.CheckError")
+	proto.RegisterType((*DumpError)(nil), "pb.DumpError")
+	proto.RegisterType((*LoadError)(nil), "pb.LoadError")
+	proto.RegisterType((*SyncSQLError)(nil), "pb.SyncSQLError")
+	proto.RegisterType((*SyncError)(nil), "pb.SyncError")
+	proto.RegisterType((*SourceError)(nil), "pb.SourceError")
+	proto.RegisterType((*RelayError)(nil), "pb.RelayError")
+	proto.RegisterType((*SubTaskError)(nil), "pb.SubTaskError")
+	proto.RegisterType((*SubTaskErrorList)(nil), "pb.SubTaskErrorList")
 	proto.RegisterType((*ProcessResult)(nil), "pb.ProcessResult")
 	proto.RegisterType((*ProcessError)(nil), "pb.ProcessError")
 	proto.RegisterType((*PurgeRelayRequest)(nil), "pb.PurgeRelayRequest")
@@ -2599,145 +3207,183 @@ func init() {
 	proto.RegisterType((*HandleWorkerErrorRequest)(nil), "pb.HandleWorkerErrorRequest")
 	proto.RegisterType((*GetWorkerCfgRequest)(nil), "pb.GetWorkerCfgRequest")
 	proto.RegisterType((*GetWorkerCfgResponse)(nil), "pb.GetWorkerCfgResponse")
+	proto.RegisterType((*SetRateLimitRequest)(nil), "pb.SetRateLimitRequest")
+	proto.RegisterType((*OperateSkipGTIDRequest)(nil), "pb.OperateSkipGTIDRequest")
+	proto.RegisterType((*UpdateSubTaskRulesRequest)(nil), "pb.UpdateSubTaskRulesRequest")
+	proto.RegisterType((*OperatePausedTableRequest)(nil), "pb.OperatePausedTableRequest")
+	proto.RegisterType((*SetTaskPriorityRequest)(nil), "pb.SetTaskPriorityRequest")
 }
 
 func init() { proto.RegisterFile("dmworker.proto", fileDescriptor_51a1b9e17fd67b10) }
 
 var fileDescriptor_51a1b9e17fd67b10 = []byte{
-	// 2124 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x73, 0xdc, 0x4a,
-	0x11, 0x5f, 0xad, 0x76, 0xd7, 0xbb, 0xbd, 0x6b, 0x47, 0x99, 0x24, 0x8f, 0xc5, 0x84, 0xc5, 0xa5,
-	0xbc, 0x0a, 0xc6, 0x45, 0xb9, 0x5e, 0xcc, 0xa3, 0x1e, 0xf5, 0xaa, 0x80, 0x47, 0xec, 0x3c, 0x27,
-	0xe0, 0xe0, 0x44, 0x76, 0xc2, 0x91, 0x92, 0xa5, 0xf1, 0x5a, 0x58, 0x2b, 0x29, 0x9a, 0x91, 0x5d,
-	0x7b, 0xa0, 0xf8, 0x08, 0x70, 0xe1, 0x00, 0xc5, 0x95, 0xeb, 0x3b, 0xf2, 0x11, 0x80, 0x63, 0x8a,
-	0x2a, 0xaa, 0x38, 0x52, 0xc9, 0xd7, 0xe0, 0x40, 0x75, 0xcf, 0x48, 0x1a, 0xd9, 0xbb, 0x09, 0x39,
-	0x70, 0x53, 0xff, 0xba, 0xa7, 0xbb, 0xa7, 0xa7, 0xff, 0xcc, 0x08, 0xd6, 0xc2, 0xd9, 0x65, 0x9a,
-	0x9f, 0xf3, 0x7c, 0x3b, 0xcb, 0x53, 0x99, 0xb2, 0x76, 0x76, 0xe2, 0x6e, 0x02, 0x7b, 0x5e, 0xf0,
-	0x7c, 0x7e, 0x24, 0x7d, 0x59, 0x08, 0x8f, 0xbf, 0x2a, 0xb8, 0x90, 0x8c, 0x41, 0x27, 0xf1, 0x67,
-	0x7c, 0x6c, 0x6d, 0x58, 0x9b, 0x03, 0x8f, 0xbe, 0xdd, 0x0c, 0x6e, 0xef, 0xa6, 0xb3, 0x59, 0x9a,
-	0xfc, 0x82, 0x74, 0x78, 0x5c, 0x64, 0x69, 0x22, 0x38, 0xfb, 0x08, 0x7a, 0x39, 0x17, 0x45, 0x2c,
-	0x49, 0xba, 0xef, 0x69, 0x8a, 0x39, 0x60, 0xcf, 0xc4, 0x74, 0xdc, 0x26, 0x15, 0xf8, 0x89, 0x92,
-	0x22, 0x2d, 0xf2, 0x80, 0x8f, 0x6d, 0x02, 0x35, 0x85, 0xb8, 0xf2, 0x6b, 0xdc, 0x51, 0xb8, 0xa2,
-	0xdc, 0xaf, 0x2c, 0xb8, 0xd5, 0x70, 0xee, 0x83, 0x2d, 0x7e, 0x0a, 0x23, 0x65, 0x43, 0x69, 0x20,
-	0xbb, 0xc3, 0x1d, 0x67, 0x3b, 0x3b, 0xd9, 0x3e, 0x32, 0x70, 0xaf, 0x21, 0xc5, 0x3e, 0x83, 0x55,
-	0x51, 0x9c, 0x1c, 0xfb, 0xe2, 0x5c, 0x2f, 0xeb, 0x6c, 0xd8, 0x9b, 0xc3, 0x9d, 0x9b, 0xb4, 0xcc,
-	0x64, 0x78, 0x4d, 0x39, 0xf7, 0xcf, 0x16, 0x0c, 0x77, 0xcf, 0x78, 0xa0, 0x69, 0x74, 0x34, 0xf3,
-	0x85, 0xe0, 0x61, 0xe9, 0xa8, 0xa2, 0xd8, 0x6d, 0xe8, 0xca, 0x54, 0xfa, 0x31, 0xb9, 0xda, 0xf5,
-	0x14, 0xc1, 0x26, 0x00, 0xa2, 0x08, 0x02, 0x2e, 0xc4, 0x69, 0x11, 0x93, 0xab, 0x5d, 0xcf, 0x40,
-	0x50, 0xdb, 0xa9, 0x1f, 0xc5, 0x3c, 0xa4, 0x30, 0x75, 0x3d, 0x4d, 0xb1, 0x31, 0xac, 0x5c, 0xfa,
-	0x79, 0x12, 0x25, 0xd3, 0x71, 0x97, 0x18, 0x25, 0x89, 0x2b, 0x42, 0x2e, 0xfd, 0x28, 0x1e, 0xf7,
-	0x36, 0xac, 0xcd, 0x91, 0xa7, 0x29, 0xf7, 0xb5, 0x05, 0xb0, 0x57, 0xcc, 0x32, 0xed, 0xe6, 0x06,
-	0x0c, 0xc9, 0x83, 0x63, 0xff, 0x24, 0xe6, 0x82, 0x7c, 0xb5, 0x3d, 0x13, 0x62, 0x9b, 0x70, 0x23,
-	0x48, 0x67, 0x59, 0xcc, 0x25, 0x0f, 0xb5, 0x14, 0xba, 0x6e, 0x79, 0x57, 0x61, 0xf6, 0x31, 0xac,
-	0x9e, 0x46, 0x49, 0x24, 0xce, 0x78, 0xf8, 0x70, 0x2e, 0xb9, 0x0a, 0xb9, 0xe5, 0x35, 0x41, 0xe6,
-	0xc2, 0xa8, 0x04, 0xbc, 0xf4, 0x52, 0xd0, 0x86, 0x2c, 0xaf, 0x81, 0xb1, 0xef, 0xc2, 0x4d, 0x2e,
-	0x64, 0x34, 0xf3, 0x25, 0x3f, 0x46, 0x57, 0x48, 0xb0, 0x4b, 0x82, 0xd7, 0x19, 0xee, 0x5f, 0x2c,
-	0x80, 0x83, 0xd4, 0x0f, 0xf5, 0x96, 0xae, 0xb9, 0xa1, 0x36, 0x75, 0xc5, 0x8d, 0x09, 0x00, 0xed,
-	0x52, 0x89, 0xb4, 0x49, 0xc4, 0x40, 0xd8, 0x3a, 0xf4, 0xb3, 0x3c, 0x9d, 0xe6, 0x5c, 0x08, 0x9d,
-	0xb2, 0x15, 0x8d, 0x6b, 0x67, 0x5c, 0xfa, 0x0f, 0xa3, 0x24, 0x4e, 0xa7, 0x3a, 0x71, 0x0d, 0x84,
-	0xdd, 0x87, 0xb5, 0x9a, 0xda, 0x3f, 0x7e, 0xb2, 0x47, 0xbe, 0x0f, 0xbc, 0x2b, 0xa8, 0xfb, 0x7b,
-	0x0b, 0x56, 0x8f, 0xce, 0xfc, 0x3c, 0x8c, 0x92, 0xe9, 0x7e, 0x9e, 0x16, 0x19, 0x9e, 0x9a, 0xf4,
-	0xf3, 0x29, 0x97, 0xba, 0xfc, 0x34, 0x85, 0x45, 0xb9, 0xb7, 0x77, 0x80, 0x7e, 0xda, 0x58, 0x94,
-	0xf8, 0xad, 0xf6, 0x99, 0x0b, 0x79, 0x90, 0x06, 0xbe, 0x8c, 0xd2, 0x44, 0xbb, 0xd9, 0x04, 0xa9,
-	0xf0, 0xe6, 0x49, 0x40, 0x99, 0x63, 0x53, 0xe1, 0x11, 0x85, 0xfb, 0x2b, 0x12, 0xcd, 0xe9, 0x12,
-	0xa7, 0xa2, 0xdd, 0x7f, 0xda, 0x00, 0x47, 0xf3, 0x24, 0xb8, 0x92, 0x23, 0x8f, 0x2e, 0x78, 0x22,
-	0x9b, 0x39, 0xa2, 0x20, 0x54, 0xa6, 0x52, 0x26, 0x2b, 0x43, 0x59, 0xd1, 0xec, 0x2e, 0x0c, 0x72,
-	0x1e, 0xf0, 0x44, 0x22, 0xd3, 0x26, 0x66, 0x0d, 0x60, 0x36, 0xcc, 0x7c, 0x21, 0x79, 0xde, 0x08,
-	0x66, 0x03, 0x63, 0x5b, 0xe0, 0x98, 0xf4, 0xbe, 0x8c, 0x42, 0x1d, 0xd0, 0x6b, 0x38, 0xea, 0xa3,
-	0x4d, 0x94, 0xfa, 0x7a, 0x4a, 0x9f, 0x89, 0xa1, 0x3e, 0x93, 0x26, 0x7d, 0x2b, 0x4a, 0xdf, 0x55,
-	0x1c, 0xf5, 0x9d, 0xc4, 0x69, 0x70, 0x1e, 0x25, 0x53, 0x3a, 0x80, 0x3e, 0x85, 0xaa, 0x81, 0xb1,
-	0x1f, 0x82, 0x53, 0x24, 0x39, 0x17, 0x69, 0x7c, 0xc1, 0x43, 0x3a, 0x47, 0x31, 0x1e, 0x18, 0x6d,
-	0xc3, 0x3c, 0x61, 0xef, 0x9a, 0xa8, 0x71, 0x42, 0xa0, 0x3a, 0x85, 0x3e, 0xa1, 0x09, 0xc0, 0x09,
-	0x39, 0x72, 0x3c, 0xcf, 0xf8, 0x78, 0xa8, 0xb2, 0xac, 0x46, 0xd8, 0x27, 0x70, 0x4b, 0xf0, 0x20,
-	0x4d, 0x42, 0xf1, 0x90, 0x9f, 0x45, 0x49, 0xf8, 0x94, 0x62, 0x31, 0x1e, 0x51, 0x88, 0x17, 0xb1,
-	0xdc, 0x3f, 0x59, 0x30, 0x32, 0x7b, 0x9f, 0xd1, 0x95, 0xad, 0x25, 0x5d, 0xb9, 0x6d, 0x76, 0x65,
-	0xf6, 0x9d, 0xaa, 0xfb, 0xaa, 0x6e, 0x4a, 0xfb, 0x7b, 0x96, 0xa7, 0xd8, 0xa6, 0x3c, 0x62, 0x54,
-	0x0d, 0xf9, 0x01, 0x0c, 0x73, 0x1e, 0xfb, 0xf3, 0xaa, 0x8d, 0xa2, 0xfc, 0x0d, 0x94, 0xf7, 0x6a,
-	0xd8, 0x33, 0x65, 0xdc, 0xbf, 0xb5, 0x61, 0x68, 0x30, 0xaf, 0xe5, 0x86, 0xf5, 0x3f, 0xe6, 0x46,
-	0x7b, 0x49, 0x6e, 0x6c, 0x94, 0x2e, 0x15, 0x27, 0x7b, 0x51, 0xae, 0xcb, 0xc5, 0x84, 0x2a, 0x89,
-	0x46, 0x32, 0x9a, 0x10, 0x76, 0x43, 0x83, 0x34, 0x52, 0xf1, 0x2a, 0xcc, 0xb6, 0x81, 0x11, 0xb4,
-	0xeb, 0xcb, 0xe0, 0xec, 0x45, 0xa6, 0x4f, 0xa7, 0x47, 0x47, 0xbc, 0x80, 0xc3, 0xbe, 0x05, 0x5d,
-	0x21, 0xfd, 0x29, 0xa7, 0x54, 0x5c, 0xdb, 0x19, 0x50, 0xea, 0x20, 0xe0, 0x29, 0xdc, 0x08, 0x7e,
-	0xff, 0x3d, 0xc1, 0x77, 0xff, 0xd3, 0x86, 0xd5, 0xc6, 0xb4, 0x5a, 0x34, 0xd5, 0x6b, 0x8b, 0xed,
-	0x25, 0x16, 0x37, 0xa0, 0x53, 0x24, 0x91, 0x3a, 0xec, 0xb5, 0x9d, 0x11, 0xf2, 0x5f, 0x24, 0x91,
-	0xc4, 0xec, 0xf3, 0x88, 0x63, 0xf8, 0xd4, 0x79, 0x5f, 0x42, 0x7c, 0x02, 0xb7, 0xea, 0xd4, 0xdf,
-	0xdb, 0x3b, 0x38, 0x48, 0x83, 0xf3, 0xaa, 0x33, 0x2e, 0x62, 0x31, 0xa6, 0x66, 0x3a, 0x95, 0xf0,
-	0xe3, 0x96, 0x9a, 0xea, 0xdf, 0x86, 0x6e, 0x80, 0x53, 0x96, 0xa2, 0xa4, 0x13, 0xca, 0x18, 0xbb,
-	0x8f, 0x5b, 0x9e, 0xe2, 0xb3, 0x8f, 0xa1, 0x13, 0x16, 0xb3, 0x4c, 0xc7, 0x6a, 0x0d, 0xe5, 0xea,
-	0xb1, 0xf7, 0xb8, 0xe5, 0x11, 0x17, 0xa5, 0xe2, 0xd4, 0x0f, 0xc7, 0x83, 0x5a, 0xaa, 0x9e, 0x24,
-	0x28, 0x85, 0x5c, 0x94, 0xc2, 0x9a, 0xa4, 0xfa, 0xd4, 0x52, 0x75, 0x7b, 0x44, 0x29, 0xe4, 0x3e,
-	0xec, 0x43, 0x4f, 0xa8, 0x44, 0xfe, 0x11, 0xdc, 0x6c, 0x44, 0xff, 0x20, 0x12, 0x14, 0x2a, 0xc5,
-	0x1e, 0x5b, 0xcb, 0xae, 0x14, 0xe5, 0xfa, 0x09, 0x00, 0xed, 0xe9, 0x51, 0x9e, 0xa7, 0x79, 0x79,
-	0xb5, 0xb1, 0xaa, 0xab, 0x8d, 0xfb, 0x4d, 0x18, 0xe0, 0x5e, 0xde, 0xc1, 0xc6, 0x4d, 0x2c, 0x63,
-	0x67, 0x30, 0x22, 0xef, 0x9f, 0x1f, 0x2c, 0x91, 0x60, 0x3b, 0x70, 0x5b, 0xdd, 0x2f, 0x54, 0x3a,
-	0x3f, 0x4b, 0x45, 0x44, 0x03, 0x46, 0x15, 0xd6, 0x42, 0x1e, 0x8e, 0x00, 0x8e, 0xea, 0x8e, 0x9e,
-	0x1f, 0x94, 0xf3, 0xb2, 0xa4, 0xdd, 0xef, 0xc3, 0x00, 0x2d, 0x2a, 0x73, 0x9b, 0xd0, 0x23, 0x46,
-	0x19, 0x07, 0xa7, 0x0a, 0xa7, 0x76, 0xc8, 0xd3, 0x7c, 0xf7, 0xb7, 0x16, 0x0c, 0x55, 0xbb, 0x52,
-	0x2b, 0x3f, 0xb4, 0x5b, 0x6d, 0x34, 0x96, 0x97, 0xf5, 0x6e, 0x6a, 0xdc, 0x06, 0xa0, 0x86, 0xa3,
-	0x04, 0x3a, 0xf5, 0xf1, 0xd6, 0xa8, 0x67, 0x48, 0xe0, 0xc1, 0xd4, 0xd4, 0x82, 0xd0, 0xfe, 0xa1,
-	0x0d, 0x23, 0x7d, 0xa4, 0x4a, 0xe4, 0xff, 0x54, 0x76, 0xba, 0x32, 0x3a, 0x66, 0x65, 0xdc, 0x2f,
-	0x2b, 0xa3, 0x5b, 0x6f, 0xa3, 0xce, 0xa2, 0xba, 0x30, 0xee, 0xe9, 0xc2, 0xe8, 0x91, 0xd8, 0x6a,
-	0x59, 0x18, 0xa5, 0x94, 0xaa, 0x8b, 0x7b, 0xba, 0x2e, 0x56, 0x6a, 0xa1, 0x2a, 0xa5, 0xaa, 0xb2,
-	0xb8, 0xa7, 0xcb, 0xa2, 0x5f, 0x0b, 0x55, 0xc7, 0x5c, 0x55, 0xc5, 0x0a, 0x74, 0xe9, 0x38, 0xdd,
-	0xcf, 0xc1, 0x31, 0x43, 0x43, 0x35, 0x71, 0x5f, 0x33, 0x1b, 0xa9, 0x60, 0x08, 0x79, 0x7a, 0xed,
-	0x2b, 0x58, 0x6d, 0x34, 0x15, 0x9c, 0x8d, 0x91, 0xd8, 0xf5, 0x93, 0x80, 0xc7, 0xd5, 0x0d, 0xdb,
-	0x40, 0x8c, 0x24, 0x6b, 0xd7, 0x9a, 0xb5, 0x8a, 0x46, 0x92, 0x19, 0xf7, 0x64, 0xbb, 0x71, 0x4f,
-	0xfe, 0x87, 0x05, 0x23, 0x73, 0x01, 0x5e, 0xb5, 0x1f, 0xe5, 0xf9, 0x6e, 0x1a, 0xaa, 0xd3, 0xec,
-	0x7a, 0x25, 0x89, 0xa9, 0x8f, 0x9f, 0xb1, 0x2f, 0x84, 0xce, 0xc0, 0x8a, 0xd6, 0xbc, 0xa3, 0x20,
-	0xcd, 0xca, 0x97, 0x4f, 0x45, 0x6b, 0xde, 0x01, 0xbf, 0xe0, 0xb1, 0x1e, 0x35, 0x15, 0x8d, 0xd6,
-	0x9e, 0x72, 0x21, 0x30, 0x4d, 0x54, 0x87, 0x2c, 0x49, 0x5c, 0xe5, 0xf9, 0x97, 0xbb, 0x7e, 0x21,
-	0xb8, 0xbe, 0xdd, 0x54, 0x34, 0x86, 0x05, 0x5f, 0x68, 0x7e, 0x9e, 0x16, 0x49, 0x79, 0xa7, 0x31,
-	0x10, 0xf7, 0x12, 0x6e, 0x3e, 0x2b, 0xf2, 0x29, 0xa7, 0x24, 0x2e, 0x1f, 0x7c, 0xeb, 0xd0, 0x8f,
-	0x12, 0x3f, 0x90, 0xd1, 0x05, 0xd7, 0x91, 0xac, 0x68, 0xcc, 0x5f, 0x19, 0xcd, 0xb8, 0xbe, 0xd4,
-	0xd1, 0x37, 0xca, 0x9f, 0x46, 0x31, 0xa7, 0xbc, 0xd6, 0x5b, 0x2a, 0x69, 0x2a, 0x51, 0x35, 0x5d,
-	0xf5, 0x73, 0x4e, 0x51, 0xee, 0x1f, 0xdb, 0xb0, 0x7e, 0x98, 0xf1, 0xdc, 0x97, 0x5c, 0x3d, 0x21,
-	0x8f, 0x82, 0x33, 0x3e, 0xf3, 0x4b, 0x17, 0xee, 0x42, 0x3b, 0xcd, 0xc8, 0xb8, 0xce, 0x77, 0xc5,
-	0x3e, 0xcc, 0xbc, 0x76, 0x9a, 0x91, 0x13, 0xbe, 0x38, 0xd7, 0xb1, 0xa5, 0xef, 0xa5, 0xef, 0xc9,
-	0x75, 0xe8, 0x87, 0xbe, 0xf4, 0x4f, 0x7c, 0xc1, 0xcb, 0x98, 0x96, 0x34, 0x3d, 0xbd, 0xf0, 0xa5,
-	0xa2, 0x23, 0xaa, 0x08, 0xd2, 0x44, 0xd6, 0x74, 0x34, 0x35, 0x85, 0xd2, 0xa7, 0x71, 0x21, 0xce,
-	0x28, 0x8c, 0x7d, 0x4f, 0x11, 0xe8, 0x4b, 0x95, 0xf3, 0x7d, 0x95, 0xe2, 0x18, 0xf5, 0xd3, 0x3c,
-	0x9d, 0xa9, 0xc6, 0x42, 0xa3, 0xa4, 0xef, 0x19, 0x48, 0xc9, 0x3f, 0x56, 0x17, 0x7b, 0xa8, 0xf9,
-	0x0a, 0x71, 0x25, 0xac, 0xbe, 0x7c, 0xa0, 0xd3, 0xfe, 0x29, 0x97, 0x3e, 0x5b, 0x37, 0xc2, 0x01,
-	0x18, 0x0e, 0xe4, 0xe8, 0x60, 0xbc, 0xb7, 0x7b, 0x94, 0x2d, 0xc7, 0x36, 0x5a, 0x4e, 0x19, 0xc1,
-	0x0e, 0xa5, 0x38, 0x7d, 0xbb, 0x9f, 0xc2, 0x6d, 0x7d, 0x22, 0x2f, 0x1f, 0xa0, 0xd5, 0xa5, 0x67,
-	0xa1, 0xd8, 0xca, 0xbc, 0xfb, 0x57, 0x0b, 0xee, 0x5c, 0x59, 0xf6, 0xc1, 0x2f, 0xf3, 0xcf, 0xa0,
-	0x83, 0x0f, 0xa1, 0xb1, 0x4d, 0xa5, 0x79, 0x0f, 0x6d, 0x2c, 0x54, 0xb9, 0x8d, 0xc4, 0xa3, 0x44,
-	0xe6, 0x73, 0x8f, 0x16, 0xac, 0xff, 0x14, 0x06, 0x15, 0x84, 0x7a, 0xcf, 0xf9, 0xbc, 0xec, 0xbe,
-	0xe7, 0x7c, 0x8e, 0x77, 0x83, 0x0b, 0x3f, 0x2e, 0x54, 0x68, 0xf4, 0x80, 0x6d, 0x04, 0xd6, 0x53,
-	0xfc, 0xcf, 0xdb, 0x3f, 0xb0, 0xdc, 0x5f, 0xc3, 0xf8, 0xb1, 0x9f, 0x84, 0xb1, 0xce, 0x47, 0xd5,
-	0x14, 0x74, 0x08, 0xbe, 0x61, 0x84, 0x60, 0x88, 0x5a, 0x88, 0xfb, 0x8e, 0x6c, 0xbc, 0x0b, 0x83,
-	0x93, 0x72, 0x1c, 0xea, 0xc0, 0xd7, 0x00, 0xe5, 0xcc, 0xab, 0x58, 0xe8, 0x07, 0x18, 0x7d, 0xbb,
-	0x77, 0xe0, 0xd6, 0x3e, 0x97, 0xca, 0xf6, 0xee, 0xe9, 0x54, 0x5b, 0x76, 0x37, 0xe1, 0x76, 0x13,
-	0xd6, 0xc1, 0x75, 0xc0, 0x0e, 0x4e, 0xab, 0x51, 0x13, 0x9c, 0x4e, 0xb7, 0x7e, 0x09, 0x3d, 0x95,
-	0x15, 0x6c, 0x15, 0x06, 0x4f, 0x92, 0x0b, 0x3f, 0x8e, 0xc2, 0xc3, 0xcc, 0x69, 0xb1, 0x3e, 0x74,
-	0x8e, 0x64, 0x9a, 0x39, 0x16, 0x1b, 0x40, 0xf7, 0x19, 0xb6, 0x05, 0xa7, 0xcd, 0x00, 0x7a, 0xd8,
-	0x39, 0x67, 0xdc, 0xb1, 0x11, 0x3e, 0x92, 0x7e, 0x2e, 0x9d, 0x0e, 0xc2, 0x2f, 0xb2, 0xd0, 0x97,
-	0xdc, 0xe9, 0xb2, 0x35, 0x80, 0x9f, 0x14, 0x32, 0xd5, 0x62, 0xbd, 0xad, 0xdf, 0x90, 0xd8, 0x14,
-	0x6d, 0x8f, 0xb4, 0x7e, 0xa2, 0x9d, 0x16, 0x5b, 0x01, 0xfb, 0xe7, 0xfc, 0xd2, 0xb1, 0xd8, 0x10,
-	0x56, 0xbc, 0x22, 0x49, 0xa2, 0x64, 0xaa, 0x6c, 0x90, 0xb9, 0xd0, 0xb1, 0x91, 0x81, 0x4e, 0x64,
-	0x3c, 0x74, 0x3a, 0x6c, 0x04, 0xfd, 0x2f, 0xf5, 0xdb, 0xdb, 0xe9, 0x22, 0x0b, 0xc5, 0x70, 0x4d,
-	0x0f, 0x59, 0x64, 0x10, 0xa9, 0x15, 0xa4, 0x68, 0x15, 0x52, 0xfd, 0xad, 0x43, 0xe8, 0x97, 0x63,
-	0x8f, 0xdd, 0x80, 0xa1, 0xf6, 0x01, 0x21, 0xa7, 0x85, 0x9b, 0xa0, 0xe1, 0xe6, 0x58, 0xb8, 0x61,
-	0x1c, 0x60, 0x4e, 0x1b, 0xbf, 0x70, 0x4a, 0x39, 0x36, 0x05, 0x61, 0x9e, 0x04, 0x4e, 0x07, 0x05,
-	0xa9, 0xdb, 0x39, 0xe1, 0xd6, 0x53, 0x58, 0xa1, 0xcf, 0x43, 0x3c, 0xc4, 0x35, 0xad, 0x4f, 0x23,
-	0x4e, 0x0b, 0xe3, 0x88, 0xd6, 0x95, 0xb4, 0x85, 0xf1, 0xa0, 0xed, 0x28, 0xba, 0x8d, 0x2e, 0xa8,
-	0xd8, 0x28, 0xc0, 0xde, 0x4a, 0xa0, 0x5f, 0xb6, 0x29, 0x76, 0x0b, 0x6e, 0x94, 0x31, 0xd2, 0x90,
-	0x52, 0xb8, 0xcf, 0xa5, 0x02, 0x1c, 0x8b, 0xf4, 0x57, 0x64, 0x1b, 0xc3, 0xea, 0xf1, 0x59, 0x7a,
-	0xc1, 0x35, 0x62, 0xa3, 0x45, 0x9c, 0x8a, 0x9a, 0xee, 0xe0, 0x02, 0xa4, 0xe9, 0xef, 0x8a, 0xd3,
-	0xdd, 0xfa, 0x02, 0xfa, 0x65, 0x29, 0x1a, 0xf6, 0x4a, 0xa8, 0xb2, 0xa7, 0x00, 0xc7, 0xaa, 0x0d,
-	0x68, 0xa4, 0xbd, 0xf5, 0x92, 0x46, 0x18, 0x66, 0xb2, 0x11, 0x00, 0x8d, 0xe8, 0xcc, 0x39, 0x8f,
-	0x32, 0x7d, 0xae, 0x3c, 0x8b, 0xfd, 0xa0, 0xca, 0x9d, 0x0b, 0x9e, 0x4b, 0xc7, 0xc6, 0xef, 0x27,
-	0xc9, 0xaf, 0x78, 0x80, 0xc9, 0x83, 0xd1, 0x8e, 0x84, 0x74, 0xba, 0x3b, 0x5f, 0xd9, 0xd0, 0x53,
-	0x39, 0xcb, 0xbe, 0x80, 0xa1, 0xf1, 0xdb, 0x8e, 0x7d, 0x84, 0xd5, 0x73, 0xfd, 0x27, 0xe3, 0xfa,
-	0xd7, 0xae, 0xe1, 0x2a, 0xd1, 0xdd, 0x16, 0xfb, 0x31, 0x40, 0x3d, 0xa3, 0xd8, 0x1d, 0x1a, 0xdc,
-	0x57, 0x67, 0xd6, 0xfa, 0x98, 0x6e, 0x37, 0x0b, 0x7e, 0x49, 0xba, 0x2d, 0xf6, 0x33, 0x58, 0xd5,
-	0xed, 0x44, 0x45, 0x92, 0x4d, 0x8c, 0x0e, 0xb3, 0x60, 0xfa, 0xbc, 0x53, 0xd9, 0x97, 0x95, 0x32,
-	0x15, 0x45, 0x36, 0x5e, 0xd0, 0xae, 0x94, 0x9a, 0xaf, 0x2f, 0x6d, 0x64, 0x6e, 0x8b, 0xed, 0xc3,
-	0x50, 0xb5, 0x1b, 0x75, 0x99, 0xb8, 0x8b, 0xb2, 0xcb, 0xfa, 0xcf, 0x3b, 0x1d, 0xda, 0x85, 0x91,
-	0xd9, 0x21, 0x18, 0x45, 0x72, 0x41, 0x2b, 0x51, 0x4a, 0x16, 0x35, 0x13, 0xb7, 0xf5, 0x70, 0xfc,
-	0xf7, 0x37, 0x13, 0xeb, 0xf5, 0x9b, 0x89, 0xf5, 0xef, 0x37, 0x13, 0xeb, 0x77, 0x6f, 0x27, 0xad,
-	0xd7, 0x6f, 0x27, 0xad, 0x7f, 0xbd, 0x9d, 0xb4, 0x4e, 0x7a, 0xf4, 0x7b, 0xf8, 0x7b, 0xff, 0x0d,
-	0x00, 0x00, 0xff, 0xff, 0xa7, 0x6e, 0x71, 0xea, 0x30, 0x16, 0x00, 0x00,
+	// 2652 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcf, 0x6f, 0x24, 0x47,
+	0xf5, 0x9f, 0x9e, 0x9e, 0x5f, 0x7e, 0x33, 0xf6, 0xf6, 0x96, 0x77, 0x37, 0x13, 0x7f, 0x37, 0xfe,
+	0x5a, 0x9d, 0x28, 0x18, 0x0b, 0x56, 0xc9, 0x12, 0x14, 0x14, 0x09, 0x48, 0xd6, 0xde, 0xec, 0x2e,
+	0xf1, 0x62, 0xa7, 0xc7, 0x09, 0x12, 0x12, 0x42, 0x3d, 0x3d, 0xe5, 0x71, 0xe3, 0x9e, 0xee, 0x4e,
+	0x77, 0xb5, 0xad, 0x39, 0x20, 0xc4, 0x5f, 0x00, 0x17, 0x0e, 0x20, 0xae, 0x39, 0x21, 0x71, 0xe2,
+	0x6f, 0x00, 0x8e, 0x81, 0x13, 0x47, 0x94, 0xfd, 0x37, 0x38, 0xa0, 0xf7, 0xaa, 0xaa, 0xbb, 0x7a,
+	0x7e, 0x78, 0x93, 0x03, 0xb7, 0x79, 0x9f, 0xf7, 0xea, 0xd5, 0xab, 0x57, 0xef, 0x57, 0xd7, 0xc0,
+	0xd6, 0x64, 0x76, 0x9d, 0x64, 0x97, 0x3c, 0x7b, 0x90, 0x66, 0x89, 0x48, 0x58, 0x33, 0x1d, 0xbb,
+	0xfb, 0xc0, 0x3e, 0x2e, 0x78, 0x36, 0x1f, 0x09, 0x5f, 0x14, 0xb9, 0xc7, 0x3f, 0x2b, 0x78, 0x2e,
+	0x18, 0x83, 0x56, 0xec, 0xcf, 0xf8, 0xd0, 0xda, 0xb3, 0xf6, 0x37, 0x3c, 0xfa, 0xed, 0xa6, 0x70,
+	0xe7, 0x30, 0x99, 0xcd, 0x92, 0xf8, 0x27, 0xa4, 0xc3, 0xe3, 0x79, 0x9a, 0xc4, 0x39, 0x67, 0xf7,
+	0xa0, 0x93, 0xf1, 0xbc, 0x88, 0x04, 0x49, 0xf7, 0x3c, 0x45, 0x31, 0x07, 0xec, 0x59, 0x3e, 0x1d,
+	0x36, 0x49, 0x05, 0xfe, 0x44, 0xc9, 0x3c, 0x29, 0xb2, 0x80, 0x0f, 0x6d, 0x02, 0x15, 0x85, 0xb8,
+	0xb4, 0x6b, 0xd8, 0x92, 0xb8, 0xa4, 0xdc, 0x3f, 0x5b, 0xb0, 0x5d, 0x33, 0xee, 0x6b, 0xef, 0xf8,
+	0x0e, 0x0c, 0xe4, 0x1e, 0x52, 0x03, 0xed, 0xdb, 0x7f, 0xe8, 0x3c, 0x48, 0xc7, 0x0f, 0x46, 0x06,
+	0xee, 0xd5, 0xa4, 0xd8, 0xbb, 0xb0, 0x99, 0x17, 0xe3, 0x33, 0x3f, 0xbf, 0x54, 0xcb, 0x5a, 0x7b,
+	0xf6, 0x7e, 0xff, 0xe1, 0x6d, 0x5a, 0x66, 0x32, 0xbc, 0xba, 0x9c, 0xfb, 0xb9, 0x05, 0xfd, 0xc3,
+	0x0b, 0x1e, 0x28, 0x1a, 0x0d, 0x4d, 0xfd, 0x3c, 0xe7, 0x13, 0x6d, 0xa8, 0xa4, 0xd8, 0x1d, 0x68,
+	0x8b, 0x44, 0xf8, 0x11, 0x99, 0xda, 0xf6, 0x24, 0xc1, 0x76, 0x01, 0xf2, 0x22, 0x08, 0x78, 0x9e,
+	0x9f, 0x17, 0x11, 0x99, 0xda, 0xf6, 0x0c, 0x04, 0xb5, 0x9d, 0xfb, 0x61, 0xc4, 0x27, 0xe4, 0xa6,
+	0xb6, 0xa7, 0x28, 0x36, 0x84, 0xee, 0xb5, 0x9f, 0xc5, 0x61, 0x3c, 0x1d, 0xb6, 0x89, 0xa1, 0x49,
+	0x5c, 0x31, 0xe1, 0xc2, 0x0f, 0xa3, 0x61, 0x67, 0xcf, 0xda, 0x1f, 0x78, 0x8a, 0x72, 0xbf, 0xb0,
+	0x00, 0x8e, 0x8a, 0x59, 0xaa, 0xcc, 0xdc, 0x83, 0x3e, 0x59, 0x70, 0xe6, 0x8f, 0x23, 0x9e, 0x93,
+	0xad, 0xb6, 0x67, 0x42, 0x6c, 0x1f, 0x6e, 0x05, 0xc9, 0x2c, 0x8d, 0xb8, 0xe0, 0x13, 0x25, 0x85,
+	0xa6, 0x5b, 0xde, 0x22, 0xcc, 0xde, 0x80, 0xcd, 0xf3, 0x30, 0x0e, 0xf3, 0x0b, 0x3e, 0x79, 0x34,
+	0x17, 0x5c, 0xba, 0xdc, 0xf2, 0xea, 0x20, 0x73, 0x61, 0xa0, 0x01, 0x2f, 0xb9, 0xce, 0xe9, 0x40,
+	0x96, 0x57, 0xc3, 0xd8, 0xb7, 0xe0, 0x36, 0xcf, 0x45, 0x38, 0xf3, 0x05, 0x3f, 0x43, 0x53, 0x48,
+	0xb0, 0x4d, 0x82, 0xcb, 0x0c, 0xf7, 0x85, 0x0d, 0x70, 0x9c, 0xf8, 0x13, 0x75, 0xa4, 0x25, 0x33,
+	0xe4, 0xa1, 0x16, 0xcc, 0xd8, 0x05, 0xa0, 0x53, 0x4a, 0x91, 0x26, 0x89, 0x18, 0x08, 0xdb, 0x81,
+	0x5e, 0x9a, 0x25, 0xd3, 0x8c, 0xe7, 0xb9, 0x0a, 0xd9, 0x92, 0xc6, 0xb5, 0x33, 0x2e, 0xfc, 0x47,
+	0x61, 0x1c, 0x25, 0x53, 0x15, 0xb8, 0x06, 0xc2, 0xde, 0x84, 0xad, 0x8a, 0x7a, 0x72, 0xf6, 0xec,
+	0x88, 0x6c, 0xdf, 0xf0, 0x16, 0x50, 0x94, 0xd3, 0x46, 0x1d, 0x5e, 0x14, 0xf1, 0x65, 0x4e, 0x77,
+	0x65, 0x7b, 0x0b, 0x68, 0x79, 0x49, 0x4a, 0xa8, 0x6b, 0x5c, 0x92, 0x92, 0x78, 0x03, 0x36, 0xb3,
+	0xe4, 0x3a, 0x3f, 0xe5, 0xd9, 0x88, 0x07, 0x49, 0x3c, 0x19, 0xf6, 0xe4, 0x99, 0x6b, 0x20, 0xee,
+	0x37, 0xc6, 0xc3, 0x55, 0x62, 0x1b, 0x72, 0xbf, 0x3a, 0xca, 0x0e, 0xc0, 0xc9, 0xf8, 0xcc, 0x0f,
+	0x31, 0x90, 0x24, 0x94, 0x0f, 0x81, 0x24, 0x97, 0x70, 0xf4, 0xc5, 0x38, 0x11, 0x22, 0xe2, 0x31,
+	0x0f, 0x2e, 0x87, 0x7d, 0xe9, 0x8b, 0x0a, 0x61, 0xdf, 0x86, 0x8e, 0x90, 0x51, 0x33, 0xa0, 0x4c,
+	0xba, 0x8b, 0x99, 0x84, 0xb7, 0x45, 0x41, 0x73, 0xaa, 0x5c, 0xea, 0x29, 0x21, 0x0c, 0xe8, 0xb1,
+	0x2f, 0x03, 0x63, 0x93, 0x76, 0xd4, 0xa4, 0xfb, 0x0f, 0x0b, 0x6e, 0x2f, 0xad, 0xa3, 0xba, 0x12,
+	0x5c, 0xf0, 0x99, 0xaf, 0xea, 0x95, 0xa2, 0x28, 0xcd, 0x50, 0x50, 0x55, 0x04, 0x49, 0xac, 0x70,
+	0xb8, 0xfd, 0x55, 0x1c, 0xde, 0x5a, 0xe9, 0xf0, 0x7a, 0x90, 0xb5, 0x5f, 0x1e, 0x64, 0x9d, 0xc5,
+	0x20, 0x73, 0x7f, 0x67, 0xc1, 0xe6, 0xe8, 0xc2, 0xcf, 0x26, 0x61, 0x3c, 0x7d, 0x92, 0x25, 0x45,
+	0x8a, 0xe7, 0x11, 0x7e, 0x36, 0xe5, 0x42, 0x9f, 0x47, 0x52, 0x58, 0x95, 0x8f, 0x8e, 0x8e, 0x31,
+	0x50, 0x6d, 0xac, 0xca, 0xf8, 0x5b, 0xda, 0x90, 0xe5, 0xe2, 0x38, 0x09, 0x7c, 0x11, 0x26, 0xb1,
+	0x8a, 0xd3, 0x3a, 0x48, 0x1e, 0x9a, 0xc7, 0x01, 0x95, 0x0e, 0x9b, 0x3c, 0x44, 0x14, 0x06, 0x78,
+	0x11, 0x2b, 0x4e, 0x9b, 0x38, 0x25, 0xed, 0x7e, 0xde, 0x02, 0x18, 0xcd, 0xe3, 0x60, 0xa1, 0x48,
+	0x3c, 0xbe, 0xe2, 0xb1, 0xa8, 0x17, 0x09, 0x09, 0xa1, 0x32, 0x22, 0xcf, 0x52, 0x9d, 0x4b, 0x25,
+	0xcd, 0xee, 0xc3, 0x46, 0xc6, 0x03, 0x1e, 0x0b, 0x64, 0x4a, 0x7f, 0x57, 0x00, 0x96, 0x83, 0x99,
+	0x9f, 0x0b, 0x9e, 0xd5, 0xb2, 0xa9, 0x86, 0x61, 0x3c, 0x9a, 0xf4, 0x13, 0x11, 0x4e, 0x54, 0x46,
+	0x2d, 0xe1, 0xa8, 0x8f, 0x0e, 0xa1, 0xf5, 0x75, 0xa4, 0x3e, 0x13, 0x43, 0x7d, 0x26, 0x4d, 0xfa,
+	0xba, 0x52, 0xdf, 0x22, 0x8e, 0xfa, 0xc6, 0x51, 0x12, 0x5c, 0x86, 0xf1, 0x94, 0x2e, 0xa0, 0x47,
+	0xae, 0xaa, 0x61, 0xec, 0xfb, 0xe0, 0x14, 0x71, 0xc6, 0xf3, 0x24, 0xba, 0xe2, 0x13, 0xba, 0xc7,
+	0x7c, 0xb8, 0x61, 0xf4, 0x0d, 0xf3, 0x86, 0xbd, 0x25, 0x51, 0xe3, 0x86, 0x40, 0xb6, 0x0a, 0x49,
+	0x51, 0x6a, 0x91, 0x21, 0x67, 0xf3, 0x94, 0x97, 0xa9, 0x55, 0x22, 0xec, 0x2d, 0xd8, 0xce, 0x65,
+	0x16, 0x3e, 0xe2, 0x17, 0x61, 0x3c, 0x79, 0x4e, 0xbe, 0x18, 0x0e, 0xc8, 0xc5, 0xab, 0x58, 0xec,
+	0x1d, 0x80, 0x2b, 0x3f, 0x0a, 0x27, 0x32, 0x5c, 0x36, 0xa9, 0x23, 0xde, 0x41, 0x13, 0x3f, 0x2d,
+	0x51, 0xd5, 0xdd, 0x0c, 0x39, 0xcc, 0xc9, 0xc9, 0x2c, 0x22, 0x23, 0xb6, 0xc8, 0x08, 0x4d, 0xba,
+	0x7f, 0xb1, 0xc0, 0x59, 0x5c, 0x8a, 0xa1, 0x3a, 0x4b, 0x26, 0xe5, 0x00, 0x81, 0xbf, 0x31, 0x54,
+	0x95, 0x42, 0x55, 0xf5, 0x65, 0x90, 0xd4, 0x41, 0x8c, 0xb3, 0x94, 0xc7, 0xe8, 0x2a, 0x92, 0x91,
+	0xb1, 0x62, 0x42, 0xe8, 0x12, 0xd9, 0xf9, 0xca, 0xd6, 0x61, 0x7b, 0x06, 0x42, 0x29, 0xa1, 0xa9,
+	0x8f, 0xf8, 0x3c, 0x57, 0x91, 0x5d, 0x07, 0xdd, 0x3f, 0x5a, 0x30, 0x30, 0x67, 0x00, 0x63, 0x3a,
+	0xb1, 0xd6, 0x4c, 0x27, 0x4d, 0x73, 0x3a, 0x61, 0xdf, 0x2c, 0xa7, 0x10, 0x39, 0x55, 0xd0, 0x35,
+	0x9f, 0x66, 0x09, 0xb6, 0x6b, 0x8f, 0x18, 0xe5, 0x60, 0xf2, 0x36, 0xf4, 0x33, 0x1e, 0xf9, 0xf3,
+	0x72, 0x9c, 0x40, 0xf9, 0x5b, 0x28, 0xef, 0x55, 0xb0, 0x67, 0xca, 0xb8, 0x7f, 0x6b, 0x42, 0xdf,
+	0x60, 0x2e, 0xa5, 0x88, 0xf5, 0x15, 0x53, 0xa4, 0xb9, 0x26, 0x45, 0xf6, 0xb4, 0x49, 0xc5, 0xf8,
+	0x28, 0xcc, 0x54, 0xd5, 0x30, 0xa1, 0x52, 0xa2, 0x96, 0x93, 0x26, 0x84, 0x53, 0x81, 0x41, 0x1a,
+	0x19, 0xb9, 0x08, 0xb3, 0x07, 0xc0, 0x08, 0x3a, 0xf4, 0x45, 0x70, 0xf1, 0x49, 0xaa, 0x82, 0xb4,
+	0x43, 0x91, 0xbe, 0x82, 0xc3, 0xfe, 0x1f, 0xda, 0xb9, 0xf0, 0xa7, 0x9c, 0x32, 0x72, 0xeb, 0xe1,
+	0x06, 0x65, 0x10, 0x02, 0x9e, 0xc4, 0x0d, 0xe7, 0xf7, 0x5e, 0xe2, 0x7c, 0xf7, 0x3f, 0x4d, 0xd8,
+	0xac, 0x4d, 0x6d, 0xab, 0xa6, 0xdb, 0x6a, 0xc7, 0xe6, 0x9a, 0x1d, 0xf7, 0xa0, 0x55, 0xc4, 0xa1,
+	0xbc, 0xec, 0xad, 0x87, 0x03, 0xe4, 0x7f, 0x12, 0x87, 0x02, 0x53, 0xc0, 0x23, 0x8e, 0x61, 0x53,
+	0xeb, 0x65, 0x01, 0xf1, 0x16, 0x6c, 0x57, 0x15, 0xe0, 0xe8, 0xe8, 0xf8, 0x38, 0x09, 0x2e, 0xcb,
+	0x09, 0x61, 0x15, 0x8b, 0x31, 0x39, 0xdb, 0x52, 0x25, 0x7b, 0xda, 0x90, 0xd3, 0xed, 0x37, 0xa0,
+	0x1d, 0xe0, 0xb4, 0x39, 0xec, 0x56, 0x01, 0x65, 0x8c, 0x9f, 0x4f, 0x1b, 0x9e, 0xe4, 0xb3, 0x37,
+	0xa0, 0x35, 0x29, 0x66, 0xa9, 0xf2, 0xd5, 0x16, 0xca, 0x55, 0xe3, 0xdf, 0xd3, 0x86, 0x47, 0x5c,
+	0x94, 0x8a, 0x12, 0x5f, 0xce, 0x03, 0x4a, 0xaa, 0x9a, 0xa8, 0x50, 0x0a, 0xb9, 0x28, 0x85, 0xa5,
+	0x69, 0x08, 0x95, 0x54, 0xd5, 0x25, 0x50, 0x0a, 0xb9, 0x8f, 0x7a, 0xd0, 0xc9, 0x65, 0x20, 0xff,
+	0x00, 0x6e, 0xd7, 0xbc, 0x7f, 0x1c, 0xe6, 0xe4, 0x2a, 0xc9, 0x1e, 0x5a, 0xeb, 0x46, 0x6b, 0xbd,
+	0x7e, 0x17, 0x80, 0xce, 0xf4, 0x38, 0xcb, 0x92, 0x4c, 0x8f, 0xf8, 0x56, 0x39, 0xe2, 0xbb, 0xaf,
+	0xc1, 0x06, 0x9e, 0xe5, 0x06, 0x36, 0x1e, 0x62, 0x1d, 0x3b, 0x85, 0x01, 0x59, 0xff, 0xf1, 0xf1,
+	0x1a, 0x09, 0xf6, 0x10, 0xee, 0xc8, 0xc2, 0x21, 0xc3, 0xf9, 0x34, 0xc9, 0x43, 0x2a, 0x9c, 0x32,
+	0xb1, 0x56, 0xf2, 0xb0, 0x13, 0x72, 0x54, 0x37, 0xfa, 0xf8, 0x58, 0xcf, 0x8d, 0x9a, 0x76, 0xbf,
+	0x0b, 0x1b, 0xb8, 0xa3, 0xdc, 0x6e, 0x1f, 0x3a, 0xc4, 0xd0, 0x7e, 0x70, 0x4a, 0x77, 0x2a, 0x83,
+	0x3c, 0xc5, 0x77, 0x7f, 0x63, 0x41, 0x5f, 0x96, 0x2b, 0xb9, 0xf2, 0xeb, 0x56, 0xab, 0xbd, 0xda,
+	0x72, 0x9d, 0xef, 0xa6, 0xc6, 0x07, 0x00, 0x54, 0x70, 0xa4, 0x40, 0xab, 0xba, 0xde, 0x0a, 0xf5,
+	0x0c, 0x09, 0xbc, 0x98, 0x8a, 0x5a, 0xe1, 0xda, 0xdf, 0x37, 0x61, 0xa0, 0xae, 0x54, 0x8a, 0xfc,
+	0x8f, 0xd2, 0x4e, 0x65, 0x46, 0xcb, 0xcc, 0x8c, 0x37, 0x75, 0x66, 0xb4, 0xab, 0x63, 0x54, 0x51,
+	0x54, 0x25, 0xc6, 0xeb, 0x2a, 0x31, 0x3a, 0x24, 0xb6, 0xa9, 0x13, 0x43, 0x4b, 0x11, 0x13, 0x85,
+	0x28, 0x2f, 0xba, 0x95, 0x50, 0x19, 0x52, 0x65, 0x5a, 0xbc, 0xae, 0xd2, 0xa2, 0x57, 0x09, 0x95,
+	0xd7, 0x5c, 0x66, 0x45, 0x17, 0xda, 0x74, 0x9d, 0xee, 0x7b, 0xe0, 0x98, 0xae, 0xa1, 0x9c, 0x78,
+	0x53, 0x31, 0x6b, 0xa1, 0x60, 0x08, 0x79, 0x6a, 0xed, 0x67, 0xb0, 0x59, 0x2b, 0x2a, 0xd8, 0x0f,
+	0xc3, 0xfc, 0xd0, 0x8f, 0x03, 0x1e, 0x95, 0x5f, 0x9a, 0x06, 0x62, 0x04, 0x59, 0xb3, 0xd2, 0xac,
+	0x54, 0xd4, 0x82, 0xcc, 0xf8, 0x5e, 0xb4, 0x6b, 0xdf, 0x8b, 0xff, 0xb4, 0x60, 0x60, 0x2e, 0xc0,
+	0x69, 0xe0, 0x71, 0x96, 0x1d, 0xea, 0x0e, 0xdf, 0xf6, 0x34, 0x89, 0xa1, 0x8f, 0x3f, 0x23, 0x3f,
+	0xcf, 0x55, 0x04, 0x96, 0xb4, 0xe2, 0x8d, 0x82, 0x24, 0xd5, 0x2f, 0x00, 0x25, 0xad, 0x78, 0xc7,
+	0xfc, 0x8a, 0x47, 0xaa, 0xd5, 0x94, 0x34, 0xee, 0xf6, 0x9c, 0xe7, 0x39, 0x86, 0x89, 0xac, 0x90,
+	0x9a, 0xc4, 0x55, 0x9e, 0x7f, 0x7d, 0xe8, 0x17, 0x39, 0x57, 0x43, 0x5e, 0x49, 0xa3, 0x5b, 0xf0,
+	0xa5, 0xc2, 0xcf, 0x92, 0x22, 0xd6, 0xa3, 0x9d, 0x81, 0xb8, 0xd7, 0x70, 0xfb, 0xb4, 0xc8, 0xa6,
+	0x9c, 0x82, 0x58, 0x3f, 0x7c, 0xec, 0x40, 0x2f, 0x8c, 0xfd, 0x40, 0x84, 0x57, 0x5c, 0x79, 0xb2,
+	0xa4, 0x31, 0x7e, 0x45, 0x38, 0xe3, 0x6a, 0x6c, 0xa1, 0xdf, 0x28, 0x7f, 0x1e, 0x46, 0x9c, 0xe2,
+	0x5a, 0x1d, 0x49, 0xd3, 0x94, 0xa2, 0xb2, 0xbb, 0xaa, 0x67, 0x0d, 0x49, 0xb9, 0x7f, 0x68, 0xc2,
+	0xce, 0x49, 0xca, 0x33, 0x5f, 0x70, 0xf9, 0x94, 0x32, 0xa2, 0xcf, 0x15, 0x6d, 0xc2, 0x7d, 0x68,
+	0x26, 0xe9, 0xd0, 0xaa, 0xe2, 0x5d, 0xb2, 0x4f, 0x52, 0xaf, 0x99, 0xa4, 0x64, 0x84, 0x9f, 0x5f,
+	0x2a, 0xdf, 0xd2, 0xef, 0xb5, 0xef, 0x2a, 0x3b, 0xd0, 0x9b, 0xf8, 0xc2, 0x1f, 0xfb, 0x39, 0xd7,
+	0x3e, 0xd5, 0x74, 0xf5, 0x6d, 0xd4, 0x36, 0xbf, 0x8d, 0xaa, 0x2f, 0xa9, 0xce, 0xe2, 0x97, 0xd4,
+	0x79, 0x54, 0xe4, 0x17, 0xe4, 0xc6, 0x9e, 0x27, 0x09, 0xb4, 0xa5, 0x8c, 0xf9, 0x9e, 0x0c, 0x71,
+	0x1a, 0xce, 0xb2, 0x64, 0x26, 0x0b, 0x0b, 0xb5, 0x92, 0x9e, 0x67, 0x20, 0x9a, 0x7f, 0x26, 0xbf,
+	0x6f, 0xa0, 0xe2, 0x4b, 0xc4, 0x15, 0xb0, 0xf9, 0xe9, 0xdb, 0x2a, 0xec, 0x9f, 0x73, 0xe1, 0xb3,
+	0x1d, 0xc3, 0x1d, 0x80, 0xee, 0x40, 0x8e, 0x72, 0xc6, 0x4b, 0xab, 0x87, 0x2e, 0x39, 0xb6, 0x51,
+	0x72, 0xb4, 0x07, 0x5b, 0x14, 0xe2, 0xf4, 0xdb, 0x7d, 0x07, 0xee, 0xa8, 0x1b, 0xf9, 0xf4, 0x6d,
+	0xdc, 0x75, 0xed, 0x5d, 0x48, 0xb6, 0xdc, 0xde, 0xfd, 0xab, 0x05, 0x77, 0x17, 0x96, 0x7d, 0xed,
+	0x17, 0xaa, 0x77, 0xa1, 0x85, 0x0f, 0x02, 0x43, 0x9b, 0x52, 0xf3, 0x75, 0xdc, 0x63, 0xa5, 0xca,
+	0x07, 0x48, 0x3c, 0x8e, 0x45, 0x36, 0xf7, 0x68, 0xc1, 0xce, 0x8f, 0x60, 0xa3, 0x84, 0x50, 0xef,
+	0x25, 0x9f, 0xeb, 0xea, 0x7b, 0xc9, 0xe7, 0x38, 0x1b, 0x5c, 0xf9, 0x51, 0x21, 0x5d, 0xa3, 0x1a,
+	0x6c, 0xcd, 0xb1, 0x9e, 0xe4, 0xbf, 0xd7, 0xfc, 0x9e, 0xe5, 0xfe, 0x12, 0x86, 0x4f, 0xfd, 0x78,
+	0x12, 0xa9, 0x78, 0x94, 0x45, 0x41, 0xb9, 0xe0, 0xff, 0x0c, 0x17, 0xf4, 0x51, 0x0b, 0x71, 0x6f,
+	0x88, 0xc6, 0xfb, 0xb0, 0x31, 0xd6, 0xed, 0x50, 0x39, 0xbe, 0x02, 0x70, 0x45, 0xfe, 0x59, 0x94,
+	0xab, 0xef, 0x50, 0xfa, 0xed, 0xde, 0x85, 0xed, 0x27, 0x5c, 0xc8, 0xbd, 0x0f, 0xcf, 0xa7, 0x6a,
+	0x67, 0x77, 0x1f, 0xee, 0xd4, 0x61, 0xe5, 0x5c, 0x07, 0xec, 0xe0, 0xbc, 0x6c, 0x35, 0xc1, 0xf9,
+	0xd4, 0xbd, 0x86, 0xed, 0x11, 0x17, 0x9e, 0x2f, 0xf8, 0x71, 0x38, 0x0b, 0x85, 0xf1, 0x8a, 0x49,
+	0xd6, 0x59, 0x86, 0x75, 0x4b, 0x8f, 0x24, 0xcd, 0xaf, 0xf6, 0x48, 0x62, 0xaf, 0x7a, 0x24, 0x71,
+	0xcf, 0xe1, 0x9e, 0xba, 0xad, 0xd1, 0x65, 0x98, 0xe2, 0x7b, 0x8e, 0xde, 0x7b, 0xd7, 0x70, 0x9b,
+	0x1c, 0x92, 0x94, 0xc0, 0x0d, 0x9e, 0x1b, 0x42, 0x77, 0x2a, 0xc2, 0xc9, 0x88, 0x0b, 0xe5, 0x37,
+	0x4d, 0xba, 0x27, 0xf0, 0xea, 0x27, 0x29, 0x7e, 0x23, 0xa9, 0x0b, 0xf4, 0x8a, 0x88, 0xe7, 0x37,
+	0x1d, 0x93, 0xde, 0x12, 0xc7, 0xf8, 0xf3, 0xf0, 0x5c, 0xc7, 0x9b, 0x81, 0xb8, 0xbf, 0xb6, 0xe0,
+	0x55, 0x65, 0xf9, 0x29, 0x56, 0x4b, 0xf9, 0xa2, 0xa2, 0x35, 0xee, 0x19, 0xc6, 0xcb, 0x6e, 0x81,
+	0x32, 0x24, 0x72, 0x73, 0x19, 0x52, 0xaf, 0x3c, 0xb6, 0x7c, 0x64, 0x90, 0x14, 0xe2, 0x69, 0x12,
+	0x85, 0xc1, 0x5c, 0xd7, 0x41, 0x49, 0xb9, 0x4f, 0xe1, 0xde, 0x88, 0x0b, 0x3c, 0xce, 0x69, 0x16,
+	0x26, 0x59, 0x28, 0xe6, 0x37, 0x9d, 0x88, 0xde, 0xe2, 0xa4, 0x98, 0x6e, 0x2c, 0x9a, 0x3e, 0xf8,
+	0x39, 0x74, 0x64, 0x55, 0x60, 0x9b, 0xb0, 0xf1, 0x2c, 0xa6, 0x0f, 0xca, 0x93, 0xd4, 0x69, 0xb0,
+	0x1e, 0xb4, 0x46, 0x22, 0x49, 0x1d, 0x8b, 0x6d, 0x40, 0x9b, 0x0e, 0xe1, 0x34, 0x19, 0x40, 0x07,
+	0x3b, 0xe7, 0x8c, 0x3b, 0x36, 0xc2, 0x23, 0xe1, 0x67, 0xc2, 0x69, 0x21, 0x2c, 0x7d, 0xec, 0xb4,
+	0xd9, 0x16, 0xc0, 0x07, 0x85, 0x48, 0x94, 0x58, 0xe7, 0xe0, 0x57, 0x24, 0x36, 0xc5, 0xd8, 0x1b,
+	0x28, 0xfd, 0x44, 0x3b, 0x0d, 0xd6, 0x05, 0xfb, 0xc7, 0xfc, 0xda, 0xb1, 0x58, 0x1f, 0xba, 0x5e,
+	0x11, 0xe3, 0xb3, 0x98, 0xdc, 0x43, 0xfa, 0xd5, 0xb1, 0x91, 0x81, 0x46, 0xa4, 0x7c, 0xe2, 0xb4,
+	0xd8, 0x00, 0x7a, 0x1f, 0xaa, 0xe7, 0x21, 0xa7, 0x8d, 0x2c, 0x14, 0xc3, 0x35, 0x1d, 0x64, 0xd1,
+	0x86, 0x48, 0x75, 0x91, 0xa2, 0x55, 0x48, 0xf5, 0x0e, 0x4e, 0xa0, 0xa7, 0xc7, 0x1e, 0x76, 0x0b,
+	0xfa, 0xca, 0x06, 0x84, 0x9c, 0x06, 0x1e, 0x82, 0x86, 0x1b, 0xc7, 0xc2, 0x03, 0xe3, 0x00, 0xe3,
+	0x34, 0xf1, 0x17, 0x4e, 0x29, 0x8e, 0x4d, 0x4e, 0x98, 0xc7, 0x81, 0xd3, 0x42, 0x41, 0xea, 0x76,
+	0xce, 0xe4, 0xe0, 0x39, 0x74, 0xe9, 0xe7, 0x09, 0xde, 0xe5, 0x96, 0xd2, 0xa7, 0x10, 0xa7, 0x81,
+	0x7e, 0xc4, 0xdd, 0xa5, 0xb4, 0x85, 0xfe, 0xa0, 0xe3, 0x48, 0xba, 0x89, 0x26, 0x48, 0xdf, 0x48,
+	0xc0, 0x3e, 0x88, 0xa1, 0xa7, 0xdb, 0x14, 0xdb, 0x86, 0x5b, 0xda, 0x47, 0x0a, 0x92, 0x0a, 0x9f,
+	0x70, 0x21, 0x01, 0xc7, 0x22, 0xfd, 0x25, 0xd9, 0x44, 0xb7, 0x7a, 0x7c, 0x96, 0x5c, 0x71, 0x85,
+	0xd8, 0xb8, 0x23, 0x4e, 0x45, 0x8a, 0x6e, 0xe1, 0x02, 0xa4, 0x29, 0x06, 0x9d, 0xf6, 0xc1, 0xfb,
+	0xd0, 0xd3, 0xa5, 0xd8, 0xd8, 0x4f, 0x43, 0xe5, 0x7e, 0x12, 0x70, 0xac, 0x6a, 0x03, 0x85, 0x34,
+	0x0f, 0x66, 0xd0, 0x55, 0x95, 0xcc, 0x70, 0x80, 0x42, 0x54, 0xe4, 0x5c, 0x86, 0xa9, 0xba, 0x57,
+	0x9e, 0x46, 0x7e, 0x50, 0xc6, 0xce, 0x15, 0xcf, 0x84, 0x63, 0xe3, 0xef, 0x67, 0xf1, 0x2f, 0x78,
+	0x80, 0xc1, 0x83, 0xde, 0x0e, 0x73, 0x21, 0xaf, 0xf4, 0x83, 0x34, 0xcd, 0x92, 0x2b, 0xee, 0x74,
+	0x48, 0xcb, 0x45, 0x72, 0xed, 0x74, 0x0f, 0x7e, 0x0a, 0x50, 0x55, 0x00, 0x76, 0x17, 0x6e, 0x6b,
+	0x17, 0x95, 0xa0, 0xd3, 0x40, 0x2b, 0xe9, 0xd0, 0x0a, 0x73, 0x2c, 0x74, 0xf4, 0x07, 0x93, 0x52,
+	0xc8, 0x69, 0xa2, 0xad, 0xca, 0x53, 0x1a, 0xb3, 0x0f, 0x7e, 0x06, 0x03, 0x33, 0x41, 0xd9, 0x2b,
+	0xb0, 0xad, 0xb4, 0x9b, 0xb0, 0xd3, 0x40, 0x4f, 0xa1, 0x7e, 0x23, 0xe3, 0x8d, 0xbb, 0x95, 0xb4,
+	0x71, 0xb7, 0x12, 0xb0, 0x1f, 0xfe, 0xa9, 0x03, 0x1d, 0x59, 0x85, 0xd9, 0xfb, 0xd0, 0x37, 0xfe,
+	0x90, 0x61, 0xf7, 0xb0, 0x36, 0x2c, 0xff, 0x7d, 0xb4, 0xf3, 0xca, 0x12, 0x2e, 0x4b, 0xb7, 0xdb,
+	0x60, 0x3f, 0x04, 0xa8, 0xa6, 0x2e, 0x46, 0x0f, 0xc1, 0x4b, 0x53, 0xd8, 0xce, 0x90, 0xe6, 0xf5,
+	0x15, 0x7f, 0x36, 0xb9, 0x0d, 0xf6, 0x11, 0x6c, 0xea, 0x92, 0x2b, 0x67, 0x93, 0x5d, 0xa3, 0x67,
+	0xae, 0x98, 0xa7, 0x6e, 0x54, 0xf6, 0x61, 0xa9, 0x4c, 0xc6, 0x05, 0x1b, 0xae, 0x68, 0xc0, 0x52,
+	0xcd, 0xab, 0x6b, 0x5b, 0xb3, 0xdb, 0x60, 0x4f, 0xa0, 0x2f, 0x1b, 0xa8, 0x1c, 0x8f, 0xef, 0xa3,
+	0xec, 0xba, 0x8e, 0x7a, 0xa3, 0x41, 0x87, 0x30, 0x30, 0x7b, 0x1e, 0x23, 0x4f, 0xae, 0x68, 0x8e,
+	0x3b, 0xc3, 0x65, 0x86, 0xa9, 0xc4, 0x6c, 0x87, 0x52, 0xc9, 0x8a, 0x06, 0x79, 0xa3, 0x25, 0xcf,
+	0xe0, 0xd6, 0x42, 0x6b, 0x63, 0x3b, 0x86, 0x0b, 0x16, 0xfa, 0xdd, 0x8d, 0xaa, 0x4e, 0x80, 0x2d,
+	0x77, 0x2f, 0xf6, 0x1a, 0x7d, 0xcb, 0xad, 0xeb, 0x6a, 0x2f, 0x53, 0xb8, 0xdc, 0xbc, 0xa4, 0xc2,
+	0xb5, 0x4d, 0xed, 0x65, 0x87, 0x5d, 0x68, 0x45, 0xf2, 0xb0, 0xab, 0xfb, 0xd3, 0x4d, 0xaa, 0x1e,
+	0x0d, 0xff, 0xfe, 0xe5, 0xae, 0xf5, 0xc5, 0x97, 0xbb, 0xd6, 0xbf, 0xbf, 0xdc, 0xb5, 0x7e, 0xfb,
+	0x62, 0xb7, 0xf1, 0xc5, 0x8b, 0xdd, 0xc6, 0xbf, 0x5e, 0xec, 0x36, 0xc6, 0x1d, 0xfa, 0xd7, 0xf5,
+	0x3b, 0xff, 0x1d, 0x00, 0xb7, 0x73, 0x41, 0x8d, 0x87, 0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2762,6 +3408,13 @@ type WorkerClient interface {
 	OperateV1Meta(ctx context.Context, in *OperateV1MetaRequest, opts ...grpc.CallOption) (*OperateV1MetaResponse, error)
 	HandleError(ctx context.Context, in *HandleWorkerErrorRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error)
 	GetWorkerCfg(ctx context.Context, in *GetWorkerCfgRequest, opts ...grpc.CallOption) (*GetWorkerCfgResponse, error)
+	// SetRateLimit changes the rate limit of a subtask at runtime.
+	SetRateLimit(ctx context.Context, in *SetRateLimitRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error)
+	// OperateSkipGTID lists, adds or removes the GTIDs of the transactions to skip of a subtask.
+	OperateSkipGTID(ctx context.Context, in *OperateSkipGTIDRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error)
+	UpdateSubTaskRules(ctx context.Context, in *UpdateSubTaskRulesRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error)
+	OperatePausedTable(ctx context.Context, in *OperatePausedTableRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error)
+	SetTaskPriority(ctx context.Context, in *SetTaskPriorityRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error)
 }
 
 type workerClient struct {
@@ -2826,6 +3479,51 @@ func (c *workerClient) GetWorkerCfg(ctx context.Context, in *GetWorkerCfgRequest
 	return out, nil
 }
 
+func (c *workerClient) SetRateLimit(ctx context.Context, in *SetRateLimitRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error) {
+	out := new(CommonWorkerResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/SetRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) OperateSkipGTID(ctx context.Context, in *OperateSkipGTIDRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error) {
+	out := new(CommonWorkerResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/OperateSkipGTID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) UpdateSubTaskRules(ctx context.Context, in *UpdateSubTaskRulesRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error) {
+	out := new(CommonWorkerResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/UpdateSubTaskRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) OperatePausedTable(ctx context.Context, in *OperatePausedTableRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error) {
+	out := new(CommonWorkerResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/OperatePausedTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) SetTaskPriority(ctx context.Context, in *SetTaskPriorityRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error) {
+	out := new(CommonWorkerResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/SetTaskPriority", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkerServer is the server API for Worker service.
 type WorkerServer interface {
 	QueryStatus(context.Context, *QueryStatusRequest) (*QueryStatusResponse, error)
@@ -2838,6 +3536,13 @@ type WorkerServer interface {
 	OperateV1Meta(context.Context, *OperateV1MetaRequest) (*OperateV1MetaResponse, error)
 	HandleError(context.Context, *HandleWorkerErrorRequest) (*CommonWorkerResponse, error)
 	GetWorkerCfg(context.Context, *GetWorkerCfgRequest) (*GetWorkerCfgResponse, error)
+	// SetRateLimit changes the rate limit of a subtask at runtime.
+	SetRateLimit(context.Context, *SetRateLimitRequest) (*CommonWorkerResponse, error)
+	// OperateSkipGTID lists, adds or removes the GTIDs of the transactions to skip of a subtask.
+	OperateSkipGTID(context.Context, *OperateSkipGTIDRequest) (*CommonWorkerResponse, error)
+	UpdateSubTaskRules(context.Context, *UpdateSubTaskRulesRequest) (*CommonWorkerResponse, error)
+	OperatePausedTable(context.Context, *OperatePausedTableRequest) (*CommonWorkerResponse, error)
+	SetTaskPriority(context.Context, *SetTaskPriorityRequest) (*CommonWorkerResponse, error)
 }
 
 // UnimplementedWorkerServer can be embedded to have forward compatible implementations.
@@ -2862,6 +3567,21 @@ func (*UnimplementedWorkerServer) HandleError(ctx context.Context, req *HandleWo
 func (*UnimplementedWorkerServer) GetWorkerCfg(ctx context.Context, req *GetWorkerCfgRequest) (*GetWorkerCfgResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerCfg not implemented")
 }
+func (*UnimplementedWorkerServer) SetRateLimit(ctx context.Context, req *SetRateLimitRequest) (*CommonWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRateLimit not implemented")
+}
+func (*UnimplementedWorkerServer) OperateSkipGTID(ctx context.Context, req *OperateSkipGTIDRequest) (*CommonWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateSkipGTID not implemented")
+}
+func (*UnimplementedWorkerServer) UpdateSubTaskRules(ctx context.Context, req *UpdateSubTaskRulesRequest) (*CommonWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubTaskRules not implemented")
+}
+func (*UnimplementedWorkerServer) OperatePausedTable(ctx context.Context, req *OperatePausedTableRequest) (*CommonWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatePausedTable not implemented")
+}
+func (*UnimplementedWorkerServer) SetTaskPriority(ctx context.Context, req *SetTaskPriorityRequest) (*CommonWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTaskPriority not implemented")
+}
 
 func RegisterWorkerServer(s *grpc.Server, srv WorkerServer) {
 	s.RegisterService(&_Worker_serviceDesc, srv)
@@ -2975,13 +3695,103 @@ func _Worker_GetWorkerCfg_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Worker_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.Worker",
-	HandlerType: (*WorkerServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "QueryStatus",
-			Handler:    _Worker_QueryStatus_Handler,
+func _Worker_SetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).SetRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/SetRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).SetRateLimit(ctx, req.(*SetRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_OperateSkipGTID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateSkipGTIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).OperateSkipGTID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/OperateSkipGTID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).OperateSkipGTID(ctx, req.(*OperateSkipGTIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_UpdateSubTaskRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubTaskRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).UpdateSubTaskRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/UpdateSubTaskRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).UpdateSubTaskRules(ctx, req.(*UpdateSubTaskRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_OperatePausedTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperatePausedTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).OperatePausedTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/OperatePausedTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).OperatePausedTable(ctx, req.(*OperatePausedTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_SetTaskPriority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTaskPriorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).SetTaskPriority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/SetTaskPriority",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).SetTaskPriority(ctx, req.(*SetTaskPriorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Worker_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Worker",
+	HandlerType: (*WorkerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryStatus",
+			Handler:    _Worker_QueryStatus_Handler,
 		},
 		{
 			MethodName: "PurgeRelay",
@@ -3003,6 +3813,26 @@ var _Worker_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetWorkerCfg",
 			Handler:    _Worker_GetWorkerCfg_Handler,
 		},
+		{
+			MethodName: "SetRateLimit",
+			Handler:    _Worker_SetRateLimit_Handler,
+		},
+		{
+			MethodName: "OperateSkipGTID",
+			Handler:    _Worker_OperateSkipGTID_Handler,
+		},
+		{
+			MethodName: "UpdateSubTaskRules",
+			Handler:    _Worker_UpdateSubTaskRules_Handler,
+		},
+		{
+			MethodName: "OperatePausedTable",
+			Handler:    _Worker_OperatePausedTable_Handler,
+		},
+		{
+			MethodName: "SetTaskPriority",
+			Handler:    _Worker_SetTaskPriority_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dmworker.proto",
@@ -3290,6 +4120,57 @@ func (m *LoadStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BadRows != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.BadRows))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Tables) > 0 {
+		for iNdEx := len(m.Tables) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tables[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmworker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Bottleneck) > 0 {
+		i -= len(m.Bottleneck)
+		copy(dAtA[i:], m.Bottleneck)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Bottleneck)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.RemainingSeconds != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.RemainingSeconds))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.BytesPerSecond != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.BytesPerSecond))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RowsPerSecond != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.RowsPerSecond))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TotalChunks != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.TotalChunks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FinishedChunks != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.FinishedChunks))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.MetaBinlogGTID) > 0 {
 		i -= len(m.MetaBinlogGTID)
 		copy(dAtA[i:], m.MetaBinlogGTID)
@@ -3324,6 +4205,63 @@ func (m *LoadStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LoadTableProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoadTableProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LoadTableProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalBytes != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.TotalBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FinishedBytes != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.FinishedBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalChunks != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.TotalChunks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FinishedChunks != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.FinishedChunks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ShardingGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3408,6 +4346,25 @@ func (m *SyncStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DmlType) > 0 {
+		i -= len(m.DmlType)
+		copy(dAtA[i:], m.DmlType)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.DmlType)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Validation != nil {
+		{
+			size, err := m.Validation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	if m.SecondsBehindMaster != 0 {
 		i = encodeVarintDmworker(dAtA, i, uint64(m.SecondsBehindMaster))
 		i--
@@ -3499,6 +4456,60 @@ func (m *SyncStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailedRowKeys) > 0 {
+		for iNdEx := len(m.FailedRowKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FailedRowKeys[iNdEx])
+			copy(dAtA[i:], m.FailedRowKeys[iNdEx])
+			i = encodeVarintDmworker(dAtA, i, uint64(len(m.FailedRowKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.FailedRows != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.FailedRows))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PendingRows != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.PendingRows))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ValidatedRows != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.ValidatedRows))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Mode) > 0 {
+		i -= len(m.Mode)
+		copy(dAtA[i:], m.Mode)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Mode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SourceStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4813,46 +5824,253 @@ func (m *GetWorkerCfgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDmworker(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDmworker(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SetRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	return n
+
+func (m *SetRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommonWorkerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SetRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Result {
-		n += 2
+	if m.BytesPerSecond != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.BytesPerSecond))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.RowsPerSecond != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.RowsPerSecond))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperateSkipGTIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperateSkipGTIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateSkipGTIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GtidSet) > 0 {
+		i -= len(m.GtidSet)
+		copy(dAtA[i:], m.GtidSet)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.GtidSet)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Op != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateSubTaskRulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateSubTaskRulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateSubTaskRulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubtaskCfg) > 0 {
+		i -= len(m.SubtaskCfg)
+		copy(dAtA[i:], m.SubtaskCfg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SubtaskCfg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatePausedTableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatePausedTableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatePausedTableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Policy) > 0 {
+		i -= len(m.Policy)
+		copy(dAtA[i:], m.Policy)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Policy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Tables) > 0 {
+		for iNdEx := len(m.Tables) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tables[iNdEx])
+			copy(dAtA[i:], m.Tables[iNdEx])
+			i = encodeVarintDmworker(dAtA, i, uint64(len(m.Tables[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Op != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetTaskPriorityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetTaskPriorityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetTaskPriorityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Priority) > 0 {
+		i -= len(m.Priority)
+		copy(dAtA[i:], m.Priority)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Priority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDmworker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDmworker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *CommonWorkerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
 	}
 	l = len(m.Worker)
 	if l > 0 {
@@ -4963,6 +6181,63 @@ func (m *LoadStatus) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDmworker(uint64(l))
 	}
+	if m.FinishedChunks != 0 {
+		n += 1 + sovDmworker(uint64(m.FinishedChunks))
+	}
+	if m.TotalChunks != 0 {
+		n += 1 + sovDmworker(uint64(m.TotalChunks))
+	}
+	if m.RowsPerSecond != 0 {
+		n += 1 + sovDmworker(uint64(m.RowsPerSecond))
+	}
+	if m.BytesPerSecond != 0 {
+		n += 1 + sovDmworker(uint64(m.BytesPerSecond))
+	}
+	if m.RemainingSeconds != 0 {
+		n += 1 + sovDmworker(uint64(m.RemainingSeconds))
+	}
+	l = len(m.Bottleneck)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if len(m.Tables) > 0 {
+		for _, e := range m.Tables {
+			l = e.Size()
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	if m.BadRows != 0 {
+		n += 1 + sovDmworker(uint64(m.BadRows))
+	}
+	return n
+}
+
+func (m *LoadTableProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.FinishedChunks != 0 {
+		n += 1 + sovDmworker(uint64(m.FinishedChunks))
+	}
+	if m.TotalChunks != 0 {
+		n += 1 + sovDmworker(uint64(m.TotalChunks))
+	}
+	if m.FinishedBytes != 0 {
+		n += 1 + sovDmworker(uint64(m.FinishedBytes))
+	}
+	if m.TotalBytes != 0 {
+		n += 1 + sovDmworker(uint64(m.TotalBytes))
+	}
 	return n
 }
 
@@ -5054,6 +6329,42 @@ func (m *SyncStatus) Size() (n int) {
 	if m.SecondsBehindMaster != 0 {
 		n += 1 + sovDmworker(uint64(m.SecondsBehindMaster))
 	}
+	if m.Validation != nil {
+		l = m.Validation.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.DmlType)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.ValidatedRows != 0 {
+		n += 1 + sovDmworker(uint64(m.ValidatedRows))
+	}
+	if m.PendingRows != 0 {
+		n += 1 + sovDmworker(uint64(m.PendingRows))
+	}
+	if m.FailedRows != 0 {
+		n += 1 + sovDmworker(uint64(m.FailedRows))
+	}
+	if len(m.FailedRowKeys) > 0 {
+		for _, s := range m.FailedRowKeys {
+			l = len(s)
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -5669,18 +6980,117 @@ func (m *GetWorkerCfgResponse) Size() (n int) {
 	return n
 }
 
-func sovDmworker(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDmworker(x uint64) (n int) {
-	return sovDmworker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryStatusRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+func (m *SetRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.RowsPerSecond != 0 {
+		n += 1 + sovDmworker(uint64(m.RowsPerSecond))
+	}
+	if m.BytesPerSecond != 0 {
+		n += 1 + sovDmworker(uint64(m.BytesPerSecond))
+	}
+	return n
+}
+
+func (m *OperateSkipGTIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmworker(uint64(m.Op))
+	}
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.GtidSet)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateSubTaskRulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.SubtaskCfg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *OperatePausedTableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmworker(uint64(m.Op))
+	}
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if len(m.Tables) > 0 {
+		for _, s := range m.Tables {
+			l = len(s)
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	l = len(m.Policy)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *SetTaskPriorityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Priority)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func sovDmworker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDmworker(x uint64) (n int) {
+	return sovDmworker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowDmworker
@@ -6551,61 +7961,30 @@ func (m *LoadStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.MetaBinlogGTID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmworker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedChunks", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.FinishedChunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinishedChunks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ShardingGroup: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShardingGroup: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalChunks", wireType)
 			}
-			var stringLen uint64
+			m.TotalChunks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6615,29 +7994,35 @@ func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TotalChunks |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowsPerSecond", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.RowsPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowsPerSecond |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Target = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DDLs", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesPerSecond", wireType)
 			}
-			var stringLen uint64
+			m.BytesPerSecond = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6647,27 +8032,33 @@ func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BytesPerSecond |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSeconds", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.RemainingSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.DDLs = append(m.DDLs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstLocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bottleneck", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6695,13 +8086,13 @@ func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FirstLocation = string(dAtA[iNdEx:postIndex])
+			m.Bottleneck = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Synced", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tables", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6711,29 +8102,31 @@ func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Synced = append(m.Synced, string(dAtA[iNdEx:postIndex]))
+			m.Tables = append(m.Tables, &LoadTableProgress{})
+			if err := m.Tables[len(m.Tables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unsynced", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadRows", wireType)
 			}
-			var stringLen uint64
+			m.BadRows = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6743,31 +8136,18 @@ func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BadRows |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Unsynced = append(m.Unsynced, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if (iNdEx + skippy) > l {
@@ -6782,7 +8162,7 @@ func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncStatus) Unmarshal(dAtA []byte) error {
+func (m *LoadTableProgress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6805,17 +8185,17 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: LoadTableProgress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LoadTableProgress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalEvents", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			m.TotalEvents = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6825,16 +8205,29 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalEvents |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalTps", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
 			}
-			m.TotalTps = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6844,16 +8237,29 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalTps |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentTps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedChunks", wireType)
 			}
-			m.RecentTps = 0
+			m.FinishedChunks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6863,16 +8269,16 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RecentTps |= int64(b&0x7F) << shift
+				m.FinishedChunks |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlog", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalChunks", wireType)
 			}
-			var stringLen uint64
+			m.TotalChunks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6882,29 +8288,16 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TotalChunks |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MasterBinlog = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlogGtid", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedBytes", wireType)
 			}
-			var stringLen uint64
+			m.FinishedBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6914,29 +8307,16 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.FinishedBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MasterBinlogGtid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncerBinlog", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBytes", wireType)
 			}
-			var stringLen uint64
+			m.TotalBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6946,27 +8326,64 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TotalBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmworker
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncerBinlog = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardingGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardingGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncerBinlogGtid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6994,11 +8411,11 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncerBinlogGtid = string(dAtA[iNdEx:postIndex])
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockingDDLs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DDLs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7026,13 +8443,13 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockingDDLs = append(m.BlockingDDLs, string(dAtA[iNdEx:postIndex]))
+			m.DDLs = append(m.DDLs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnresolvedGroups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstLocation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7042,31 +8459,29 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnresolvedGroups = append(m.UnresolvedGroups, &ShardingGroup{})
-			if err := m.UnresolvedGroups[len(m.UnresolvedGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FirstLocation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
+		case 4:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Synced", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7076,15 +8491,27 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Synced = bool(v != 0)
-		case 11:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Synced = append(m.Synced, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinlogType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unsynced", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7112,27 +8539,8 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BinlogType = string(dAtA[iNdEx:postIndex])
+			m.Unsynced = append(m.Unsynced, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondsBehindMaster", wireType)
-			}
-			m.SecondsBehindMaster = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SecondsBehindMaster |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -7154,7 +8562,7 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SourceStatus) Unmarshal(dAtA []byte) error {
+func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7177,17 +8585,17 @@ func (m *SourceStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SourceStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEvents", wireType)
 			}
-			var stringLen uint64
+			m.TotalEvents = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7197,29 +8605,16 @@ func (m *SourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TotalEvents |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Source = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTps", wireType)
 			}
-			var stringLen uint64
+			m.TotalTps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7229,29 +8624,16 @@ func (m *SourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TotalTps |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Worker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentTps", wireType)
 			}
-			var msglen int
+			m.RecentTps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7261,33 +8643,16 @@ func (m *SourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RecentTps |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Result == nil {
-				m.Result = &ProcessResult{}
-			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlog", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7297,81 +8662,27 @@ func (m *SourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RelayStatus == nil {
-				m.RelayStatus = &RelayStatus{}
-			}
-			if err := m.RelayStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MasterBinlog = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RelayStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmworker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RelayStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RelayStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlog", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlogGtid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7399,11 +8710,11 @@ func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterBinlog = string(dAtA[iNdEx:postIndex])
+			m.MasterBinlogGtid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlogGtid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncerBinlog", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7431,11 +8742,11 @@ func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterBinlogGtid = string(dAtA[iNdEx:postIndex])
+			m.SyncerBinlog = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelaySubDir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncerBinlogGtid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7463,11 +8774,11 @@ func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelaySubDir = string(dAtA[iNdEx:postIndex])
+			m.SyncerBinlogGtid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayBinlog", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockingDDLs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7495,13 +8806,13 @@ func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelayBinlog = string(dAtA[iNdEx:postIndex])
+			m.BlockingDDLs = append(m.BlockingDDLs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayBinlogGtid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnresolvedGroups", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7511,27 +8822,29 @@ func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelayBinlogGtid = string(dAtA[iNdEx:postIndex])
+			m.UnresolvedGroups = append(m.UnresolvedGroups, &ShardingGroup{})
+			if err := m.UnresolvedGroups[len(m.UnresolvedGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayCatchUpMaster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Synced", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -7548,12 +8861,44 @@ func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.RelayCatchUpMaster = bool(v != 0)
-		case 7:
+			m.Synced = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinlogType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinlogType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsBehindMaster", wireType)
 			}
-			m.Stage = 0
+			m.SecondsBehindMaster = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7563,14 +8908,14 @@ func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Stage |= Stage(b&0x7F) << shift
+				m.SecondsBehindMaster |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7597,13 +8942,45 @@ func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Result == nil {
-				m.Result = &ProcessResult{}
+			if m.Validation == nil {
+				m.Validation = &ValidationStatus{}
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Validation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DmlType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DmlType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -7625,7 +9002,7 @@ func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
+func (m *ValidationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7648,15 +9025,15 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubTaskStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubTaskStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7684,13 +9061,13 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Mode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatedRows", wireType)
 			}
-			m.Stage = 0
+			m.ValidatedRows = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7700,16 +9077,16 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Stage |= Stage(b&0x7F) << shift
+				m.ValidatedRows |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRows", wireType)
 			}
-			m.Unit = 0
+			m.PendingRows = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7719,16 +9096,16 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Unit |= UnitType(b&0x7F) << shift
+				m.PendingRows |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedRows", wireType)
 			}
-			var msglen int
+			m.FailedRows = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7738,31 +9115,14 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FailedRows |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Result == nil {
-				m.Result = &ProcessResult{}
-			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnresolvedDDLLockID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedRowKeys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7790,45 +9150,63 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnresolvedDDLLockID = string(dAtA[iNdEx:postIndex])
+			m.FailedRowKeys = append(m.FailedRowKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SourceStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = &SubTaskStatus_Msg{string(dAtA[iNdEx:postIndex])}
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Check", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7838,32 +9216,29 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CheckStatus{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Status = &SubTaskStatus_Check{v}
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dump", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7873,30 +9248,27 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DumpStatus{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Status = &SubTaskStatus_Dump{v}
+			m.Worker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Load", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7923,15 +9295,16 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &LoadStatus{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Result == nil {
+				m.Result = &ProcessResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Status = &SubTaskStatus_Load{v}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7958,11 +9331,12 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &SyncStatus{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RelayStatus == nil {
+				m.RelayStatus = &RelayStatus{}
+			}
+			if err := m.RelayStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Status = &SubTaskStatus_Sync{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7985,7 +9359,7 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubTaskStatusList) Unmarshal(dAtA []byte) error {
+func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8008,17 +9382,17 @@ func (m *SubTaskStatusList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubTaskStatusList: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubTaskStatusList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlog", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8028,79 +9402,59 @@ func (m *SubTaskStatusList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = append(m.Status, &SubTaskStatus{})
-			if err := m.Status[len(m.Status)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MasterBinlog = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlogGtid", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDmworker
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CheckError) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmworker
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CheckError: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckError: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.MasterBinlogGtid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelaySubDir", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8128,61 +9482,43 @@ func (m *CheckError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.RelaySubDir = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayBinlog", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDmworker
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DumpError) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmworker
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DumpError: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DumpError: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.RelayBinlog = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayBinlogGtid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8210,63 +9546,52 @@ func (m *DumpError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.RelayBinlogGtid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayCatchUpMaster", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LoadError) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmworker
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.RelayCatchUpMaster = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Stage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stage |= Stage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LoadError: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LoadError: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8276,23 +9601,27 @@ func (m *LoadError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			if m.Result == nil {
+				m.Result = &ProcessResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8315,7 +9644,7 @@ func (m *LoadError) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncSQLError) Unmarshal(dAtA []byte) error {
+func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8338,15 +9667,15 @@ func (m *SyncSQLError) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncSQLError: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubTaskStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncSQLError: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubTaskStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8374,13 +9703,51 @@ func (m *SyncSQLError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			}
+			m.Stage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stage |= Stage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			}
+			m.Unit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unit |= UnitType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedBinlogPosition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8390,27 +9757,31 @@ func (m *SyncSQLError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailedBinlogPosition = string(dAtA[iNdEx:postIndex])
+			if m.Result == nil {
+				m.Result = &ProcessResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorSQL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnresolvedDDLLockID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8438,61 +9809,43 @@ func (m *SyncSQLError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrorSQL = string(dAtA[iNdEx:postIndex])
+			m.UnresolvedDDLLockID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDmworker
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyncError) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmworker
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncError: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncError: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Status = &SubTaskStatus_Msg{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Check", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8519,66 +9872,17 @@ func (m *SyncError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Errors = append(m.Errors, &SyncSQLError{})
-			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &CheckStatus{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Status = &SubTaskStatus_Check{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SourceError) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmworker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SourceError: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SourceError: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dump", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8588,29 +9892,32 @@ func (m *SourceError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			v := &DumpStatus{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Status = &SubTaskStatus_Dump{v}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Load", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8620,29 +9927,32 @@ func (m *SourceError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Worker = string(dAtA[iNdEx:postIndex])
+			v := &LoadStatus{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Status = &SubTaskStatus_Load{v}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8652,27 +9962,80 @@ func (m *SourceError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceError = string(dAtA[iNdEx:postIndex])
+			v := &SyncStatus{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Status = &SubTaskStatus_Sync{v}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubTaskStatusList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubTaskStatusList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubTaskStatusList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8699,10 +10062,8 @@ func (m *SourceError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RelayError == nil {
-				m.RelayError = &RelayError{}
-			}
-			if err := m.RelayError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Status = append(m.Status, &SubTaskStatus{})
+			if err := m.Status[len(m.Status)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8727,7 +10088,7 @@ func (m *SourceError) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RelayError) Unmarshal(dAtA []byte) error {
+func (m *CheckError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8750,10 +10111,10 @@ func (m *RelayError) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RelayError: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RelayError: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8809,7 +10170,7 @@ func (m *RelayError) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubTaskError) Unmarshal(dAtA []byte) error {
+func (m *DumpError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8832,15 +10193,15 @@ func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubTaskError: wiretype end group for non-group")
+			return fmt.Errorf("proto: DumpError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubTaskError: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DumpError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8868,47 +10229,59 @@ func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Stage = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Stage |= Stage(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Unit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Unit |= UnitType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LoadError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
 			}
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoadError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoadError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
@@ -8938,48 +10311,63 @@ func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = &SubTaskError_Msg{string(dAtA[iNdEx:postIndex])}
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Check", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncSQLError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CheckError{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Error = &SubTaskError_Check{v}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncSQLError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncSQLError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dump", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8989,32 +10377,29 @@ func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DumpError{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Error = &SubTaskError_Dump{v}
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Load", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedBinlogPosition", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9024,32 +10409,29 @@ func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &LoadError{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Error = &SubTaskError_Load{v}
+			m.FailedBinlogPosition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorSQL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9059,26 +10441,23 @@ func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &SyncError{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Error = &SubTaskError_Sync{v}
+			m.ErrorSQL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9101,7 +10480,7 @@ func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubTaskErrorList) Unmarshal(dAtA []byte) error {
+func (m *SyncError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9124,15 +10503,15 @@ func (m *SubTaskErrorList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubTaskErrorList: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubTaskErrorList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9159,8 +10538,8 @@ func (m *SubTaskErrorList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = append(m.Error, &SubTaskError{})
-			if err := m.Error[len(m.Error)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Errors = append(m.Errors, &SyncSQLError{})
+			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9185,7 +10564,7 @@ func (m *SubTaskErrorList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProcessResult) Unmarshal(dAtA []byte) error {
+func (m *SourceError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9208,17 +10587,17 @@ func (m *ProcessResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: SourceError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SourceError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsCanceled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9228,17 +10607,29 @@ func (m *ProcessResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsCanceled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9248,31 +10639,29 @@ func (m *ProcessResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Errors = append(m.Errors, &ProcessError{})
-			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Worker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceError", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9282,24 +10671,58 @@ func (m *ProcessResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Detail = append(m.Detail[:0], dAtA[iNdEx:postIndex]...)
-			if m.Detail == nil {
-				m.Detail = []byte{}
+			m.SourceError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayError", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RelayError == nil {
+				m.RelayError = &RelayError{}
+			}
+			if err := m.RelayError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -9323,7 +10746,7 @@ func (m *ProcessResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProcessError) Unmarshal(dAtA []byte) error {
+func (m *RelayError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9346,17 +10769,17 @@ func (m *ProcessError) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessError: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessError: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrCode", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			m.ErrCode = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9366,14 +10789,77 @@ func (m *ProcessError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ErrCode |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubTaskError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubTaskError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubTaskError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrClass", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9401,13 +10887,13 @@ func (m *ProcessError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrClass = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrScope", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
 			}
-			var stringLen uint64
+			m.Stage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9417,27 +10903,33 @@ func (m *ProcessError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Stage |= Stage(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Unit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unit |= UnitType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ErrScope = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrLevel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9465,13 +10957,13 @@ func (m *ProcessError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrLevel = string(dAtA[iNdEx:postIndex])
+			m.Error = &SubTaskError_Msg{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Check", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9481,29 +10973,32 @@ func (m *ProcessError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			v := &CheckError{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Error = &SubTaskError_Check{v}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawCause", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dump", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9513,29 +11008,32 @@ func (m *ProcessError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawCause = string(dAtA[iNdEx:postIndex])
+			v := &DumpError{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Error = &SubTaskError_Dump{v}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Workaround", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Load", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9545,46 +11043,84 @@ func (m *ProcessError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Workaround = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
+			v := &LoadError{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.Error = &SubTaskError_Load{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SyncError{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Error = &SubTaskError_Sync{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *PurgeRelayRequest) Unmarshal(dAtA []byte) error {
+func (m *SubTaskErrorList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9607,17 +11143,17 @@ func (m *PurgeRelayRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeRelayRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubTaskErrorList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeRelayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubTaskErrorList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inactive", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9627,17 +11163,81 @@ func (m *PurgeRelayRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Inactive = bool(v != 0)
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = append(m.Error, &SubTaskError{})
+			if err := m.Error[len(m.Error)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProcessResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProcessResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProcessResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCanceled", wireType)
 			}
-			m.Time = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9647,16 +11247,17 @@ func (m *PurgeRelayRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Time |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			m.IsCanceled = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9666,29 +11267,31 @@ func (m *PurgeRelayRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filename = string(dAtA[iNdEx:postIndex])
+			m.Errors = append(m.Errors, &ProcessError{})
+			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubDir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9698,23 +11301,25 @@ func (m *PurgeRelayRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubDir = string(dAtA[iNdEx:postIndex])
+			m.Detail = append(m.Detail[:0], dAtA[iNdEx:postIndex]...)
+			if m.Detail == nil {
+				m.Detail = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9737,7 +11342,7 @@ func (m *PurgeRelayRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
+func (m *ProcessError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9760,17 +11365,17 @@ func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperateWorkerSchemaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProcessError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperateWorkerSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProcessError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrCode", wireType)
 			}
-			m.Op = 0
+			m.ErrCode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9780,14 +11385,14 @@ func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= SchemaOp(b&0x7F) << shift
+				m.ErrCode |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrClass", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9815,11 +11420,11 @@ func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Task = string(dAtA[iNdEx:postIndex])
+			m.ErrClass = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrScope", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9847,11 +11452,11 @@ func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.ErrScope = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrLevel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9879,11 +11484,11 @@ func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Database = string(dAtA[iNdEx:postIndex])
+			m.ErrLevel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9911,11 +11516,11 @@ func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Table = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RawCause", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9943,13 +11548,13 @@ func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schema = string(dAtA[iNdEx:postIndex])
+			m.RawCause = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flush", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workaround", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9959,72 +11564,24 @@ func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Flush = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
 			}
-			m.Sync = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromSource", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.FromSource = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromTarget", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.FromTarget = bool(v != 0)
+			m.Workaround = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -10046,7 +11603,7 @@ func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *V1SubTaskMeta) Unmarshal(dAtA []byte) error {
+func (m *PurgeRelayRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10069,17 +11626,17 @@ func (m *V1SubTaskMeta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: V1SubTaskMeta: wiretype end group for non-group")
+			return fmt.Errorf("proto: PurgeRelayRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: V1SubTaskMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PurgeRelayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inactive", wireType)
 			}
-			m.Op = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -10089,16 +11646,17 @@ func (m *V1SubTaskMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= TaskOp(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Inactive = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			m.Stage = 0
+			m.Time = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -10108,14 +11666,14 @@ func (m *V1SubTaskMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Stage |= Stage(b&0x7F) << shift
+				m.Time |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10143,13 +11701,13 @@ func (m *V1SubTaskMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Filename = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubDir", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -10159,25 +11717,23 @@ func (m *V1SubTaskMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Task = append(m.Task[:0], dAtA[iNdEx:postIndex]...)
-			if m.Task == nil {
-				m.Task = []byte{}
-			}
+			m.SubDir = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10200,7 +11756,7 @@ func (m *V1SubTaskMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperateV1MetaRequest) Unmarshal(dAtA []byte) error {
+func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10219,21 +11775,1252 @@ func (m *OperateV1MetaRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OperateV1MetaRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperateV1MetaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperateWorkerSchemaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperateWorkerSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= SchemaOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Task = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flush", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Flush = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sync = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSource", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FromSource = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTarget", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FromTarget = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *V1SubTaskMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: V1SubTaskMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: V1SubTaskMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= TaskOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			}
+			m.Stage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stage |= Stage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Task = append(m.Task[:0], dAtA[iNdEx:postIndex]...)
+			if m.Task == nil {
+				m.Task = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperateV1MetaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperateV1MetaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperateV1MetaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= V1MetaOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperateV1MetaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperateV1MetaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperateV1MetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Meta == nil {
+				m.Meta = make(map[string]*V1SubTaskMeta)
+			}
+			var mapkey string
+			var mapvalue *V1SubTaskMeta
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDmworker
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDmworker
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthDmworker
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthDmworker
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDmworker
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthDmworker
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthDmworker
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &V1SubTaskMeta{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDmworker(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthDmworker
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Meta[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HandleWorkerErrorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandleWorkerErrorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandleWorkerErrorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= ErrorOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Task = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinlogPos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinlogPos = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sqls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sqls = append(m.Sqls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWorkerCfgRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetWorkerCfgRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWorkerCfgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWorkerCfgResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetWorkerCfgResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWorkerCfgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cfg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cfg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetRateLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetRateLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Task = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowsPerSecond", wireType)
+			}
+			m.RowsPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowsPerSecond |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesPerSecond", wireType)
+			}
+			m.BytesPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesPerSecond |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperateSkipGTIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperateSkipGTIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperateSkipGTIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= SkipGTIDOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Task = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GtidSet", wireType)
 			}
-			m.Op = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -10243,11 +13030,24 @@ func (m *OperateV1MetaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= V1MetaOp(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GtidSet = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -10269,7 +13069,7 @@ func (m *OperateV1MetaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperateV1MetaResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateSubTaskRulesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10292,35 +13092,15 @@ func (m *OperateV1MetaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperateV1MetaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateSubTaskRulesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperateV1MetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateSubTaskRulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Result = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10348,13 +13128,13 @@ func (m *OperateV1MetaResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.Task = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubtaskCfg", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -10364,120 +13144,23 @@ func (m *OperateV1MetaResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Meta == nil {
-				m.Meta = make(map[string]*V1SubTaskMeta)
-			}
-			var mapkey string
-			var mapvalue *V1SubTaskMeta
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowDmworker
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowDmworker
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthDmworker
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthDmworker
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowDmworker
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthDmworker
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthDmworker
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &V1SubTaskMeta{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipDmworker(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthDmworker
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Meta[mapkey] = mapvalue
+			m.SubtaskCfg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10500,7 +13183,7 @@ func (m *OperateV1MetaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HandleWorkerErrorRequest) Unmarshal(dAtA []byte) error {
+func (m *OperatePausedTableRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10523,10 +13206,10 @@ func (m *HandleWorkerErrorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HandleWorkerErrorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperatePausedTableRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HandleWorkerErrorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperatePausedTableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10543,7 +13226,7 @@ func (m *HandleWorkerErrorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= ErrorOp(b&0x7F) << shift
+				m.Op |= PauseTableOp(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10582,7 +13265,7 @@ func (m *HandleWorkerErrorRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinlogPos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tables", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10610,11 +13293,11 @@ func (m *HandleWorkerErrorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BinlogPos = string(dAtA[iNdEx:postIndex])
+			m.Tables = append(m.Tables, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sqls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10642,7 +13325,7 @@ func (m *HandleWorkerErrorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sqls = append(m.Sqls, string(dAtA[iNdEx:postIndex]))
+			m.Policy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10665,7 +13348,7 @@ func (m *HandleWorkerErrorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetWorkerCfgRequest) Unmarshal(dAtA []byte) error {
+func (m *SetTaskPriorityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10688,65 +13371,47 @@ func (m *GetWorkerCfgRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetWorkerCfgRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetTaskPriorityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetWorkerCfgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetTaskPriorityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDmworker
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetWorkerCfgResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmworker
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetWorkerCfgResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetWorkerCfgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Task = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cfg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10774,7 +13439,7 @@ func (m *GetWorkerCfgResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cfg = string(dAtA[iNdEx:postIndex])
+			m.Priority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -76,6 +76,26 @@ func (mr *MockWorkerClientMockRecorder) HandleError(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleError", reflect.TypeOf((*MockWorkerClient)(nil).HandleError), varargs...)
 }
 
+// OperatePausedTable mocks base method.
+func (m *MockWorkerClient) OperatePausedTable(arg0 context.Context, arg1 *pb.OperatePausedTableRequest, arg2 ...grpc.CallOption) (*pb.CommonWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "OperatePausedTable", varargs...)
+	ret0, _ := ret[0].(*pb.CommonWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OperatePausedTable indicates an expected call of OperatePausedTable.
+func (mr *MockWorkerClientMockRecorder) OperatePausedTable(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperatePausedTable", reflect.TypeOf((*MockWorkerClient)(nil).OperatePausedTable), varargs...)
+}
+
 // OperateSchema mocks base method.
 func (m *MockWorkerClient) OperateSchema(arg0 context.Context, arg1 *pb.OperateWorkerSchemaRequest, arg2 ...grpc.CallOption) (*pb.CommonWorkerResponse, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +116,26 @@ func (mr *MockWorkerClientMockRecorder) OperateSchema(arg0, arg1 interface{}, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateSchema", reflect.TypeOf((*MockWorkerClient)(nil).OperateSchema), varargs...)
 }
 
+// OperateSkipGTID mocks base method.
+func (m *MockWorkerClient) OperateSkipGTID(arg0 context.Context, arg1 *pb.OperateSkipGTIDRequest, arg2 ...grpc.CallOption) (*pb.CommonWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "OperateSkipGTID", varargs...)
+	ret0, _ := ret[0].(*pb.CommonWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OperateSkipGTID indicates an expected call of OperateSkipGTID.
+func (mr *MockWorkerClientMockRecorder) OperateSkipGTID(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateSkipGTID", reflect.TypeOf((*MockWorkerClient)(nil).OperateSkipGTID), varargs...)
+}
+
 // OperateV1Meta mocks base method.
 func (m *MockWorkerClient) OperateV1Meta(arg0 context.Context, arg1 *pb.OperateV1MetaRequest, arg2 ...grpc.CallOption) (*pb.OperateV1MetaResponse, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +196,66 @@ func (mr *MockWorkerClientMockRecorder) QueryStatus(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryStatus", reflect.TypeOf((*MockWorkerClient)(nil).QueryStatus), varargs...)
 }
 
+// SetRateLimit mocks base method.
+func (m *MockWorkerClient) SetRateLimit(arg0 context.Context, arg1 *pb.SetRateLimitRequest, arg2 ...grpc.CallOption) (*pb.CommonWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetRateLimit", varargs...)
+	ret0, _ := ret[0].(*pb.CommonWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRateLimit indicates an expected call of SetRateLimit.
+func (mr *MockWorkerClientMockRecorder) SetRateLimit(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRateLimit", reflect.TypeOf((*MockWorkerClient)(nil).SetRateLimit), varargs...)
+}
+
+// SetTaskPriority mocks base method.
+func (m *MockWorkerClient) SetTaskPriority(arg0 context.Context, arg1 *pb.SetTaskPriorityRequest, arg2 ...grpc.CallOption) (*pb.CommonWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetTaskPriority", varargs...)
+	ret0, _ := ret[0].(*pb.CommonWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTaskPriority indicates an expected call of SetTaskPriority.
+func (mr *MockWorkerClientMockRecorder) SetTaskPriority(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTaskPriority", reflect.TypeOf((*MockWorkerClient)(nil).SetTaskPriority), varargs...)
+}
+
+// UpdateSubTaskRules mocks base method.
+func (m *MockWorkerClient) UpdateSubTaskRules(arg0 context.Context, arg1 *pb.UpdateSubTaskRulesRequest, arg2 ...grpc.CallOption) (*pb.CommonWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSubTaskRules", varargs...)
+	ret0, _ := ret[0].(*pb.CommonWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSubTaskRules indicates an expected call of UpdateSubTaskRules.
+func (mr *MockWorkerClientMockRecorder) UpdateSubTaskRules(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubTaskRules", reflect.TypeOf((*MockWorkerClient)(nil).UpdateSubTaskRules), varargs...)
+}
+
 // MockWorkerServer is a mock of WorkerServer interface.
 type MockWorkerServer struct {
 	ctrl     *gomock.Controller
@@ -209,6 +309,21 @@ func (mr *MockWorkerServerMockRecorder) HandleError(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleError", reflect.TypeOf((*MockWorkerServer)(nil).HandleError), arg0, arg1)
 }
 
+// OperatePausedTable mocks base method.
+func (m *MockWorkerServer) OperatePausedTable(arg0 context.Context, arg1 *pb.OperatePausedTableRequest) (*pb.CommonWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OperatePausedTable", arg0, arg1)
+	ret0, _ := ret[0].(*pb.CommonWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OperatePausedTable indicates an expected call of OperatePausedTable.
+func (mr *MockWorkerServerMockRecorder) OperatePausedTable(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperatePausedTable", reflect.TypeOf((*MockWorkerServer)(nil).OperatePausedTable), arg0, arg1)
+}
+
 // OperateSchema mocks base method.
 func (m *MockWorkerServer) OperateSchema(arg0 context.Context, arg1 *pb.OperateWorkerSchemaRequest) (*pb.CommonWorkerResponse, error) {
 	m.ctrl.T.Helper()
@@ -224,6 +339,21 @@ func (mr *MockWorkerServerMockRecorder) OperateSchema(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateSchema", reflect.TypeOf((*MockWorkerServer)(nil).OperateSchema), arg0, arg1)
 }
 
+// OperateSkipGTID mocks base method.
+func (m *MockWorkerServer) OperateSkipGTID(arg0 context.Context, arg1 *pb.OperateSkipGTIDRequest) (*pb.CommonWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OperateSkipGTID", arg0, arg1)
+	ret0, _ := ret[0].(*pb.CommonWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OperateSkipGTID indicates an expected call of OperateSkipGTID.
+func (mr *MockWorkerServerMockRecorder) OperateSkipGTID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateSkipGTID", reflect.TypeOf((*MockWorkerServer)(nil).OperateSkipGTID), arg0, arg1)
+}
+
 // OperateV1Meta mocks base method.
 func (m *MockWorkerServer) OperateV1Meta(arg0 context.Context, arg1 *pb.OperateV1MetaRequest) (*pb.OperateV1MetaResponse, error) {
 	m.ctrl.T.Helper()
@@ -268,3 +398,48 @@ func (mr *MockWorkerServerMockRecorder) QueryStatus(arg0, arg1 interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryStatus", reflect.TypeOf((*MockWorkerServer)(nil).QueryStatus), arg0, arg1)
 }
+
+// SetRateLimit mocks base method.
+func (m *MockWorkerServer) SetRateLimit(arg0 context.Context, arg1 *pb.SetRateLimitRequest) (*pb.CommonWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRateLimit", arg0, arg1)
+	ret0, _ := ret[0].(*pb.CommonWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRateLimit indicates an expected call of SetRateLimit.
+func (mr *MockWorkerServerMockRecorder) SetRateLimit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRateLimit", reflect.TypeOf((*MockWorkerServer)(nil).SetRateLimit), arg0, arg1)
+}
+
+// SetTaskPriority mocks base method.
+func (m *MockWorkerServer) SetTaskPriority(arg0 context.Context, arg1 *pb.SetTaskPriorityRequest) (*pb.CommonWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTaskPriority", arg0, arg1)
+	ret0, _ := ret[0].(*pb.CommonWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTaskPriority indicates an expected call of SetTaskPriority.
+func (mr *MockWorkerServerMockRecorder) SetTaskPriority(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTaskPriority", reflect.TypeOf((*MockWorkerServer)(nil).SetTaskPriority), arg0, arg1)
+}
+
+// UpdateSubTaskRules mocks base method.
+func (m *MockWorkerServer) UpdateSubTaskRules(arg0 context.Context, arg1 *pb.UpdateSubTaskRulesRequest) (*pb.CommonWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubTaskRules", arg0, arg1)
+	ret0, _ := ret[0].(*pb.CommonWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSubTaskRules indicates an expected call of UpdateSubTaskRules.
+func (mr *MockWorkerServerMockRecorder) UpdateSubTaskRules(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubTaskRules", reflect.TypeOf((*MockWorkerServer)(nil).UpdateSubTaskRules), arg0, arg1)
+}
@@ -101,6 +101,14 @@ type Config struct {
 
 	RelayDir string `toml:"relay-dir" json:"relay-dir"`
 
+	// the labels of the DM-worker like zone, rack, used by DM-master to bind sources by their worker affinity
+	Labels map[string]string `toml:"labels" json:"labels"`
+
+	// the resource limits of each subtask in the DM-worker
+	TaskResourceLimits TaskResourceLimits `toml:"task-resource-limits" json:"task-resource-limits"`
+	// throttle the subtasks of lower priorities when the DM-worker is busy
+	PriorityThrottle PriorityThrottle `toml:"priority-throttle" json:"priority-throttle"`
+
 	// tls config
 	config.Security
 
@@ -208,7 +216,10 @@ func (c *Config) adjust() error {
 		c.Join = utils.WrapSchemes(c.Join, c.SSLCA != "")
 	}
 
-	return nil
+	if err = c.TaskResourceLimits.adjust(); err != nil {
+		return err
+	}
+	return c.PriorityThrottle.adjust()
 }
 
 // configFromFile loads config from file.
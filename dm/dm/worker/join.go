@@ -51,6 +51,7 @@ func (s *Server) JoinMaster(endpoints []string) error {
 	req := &pb.RegisterWorkerRequest{
 		Name:    s.cfg.Name,
 		Address: s.cfg.AdvertiseAddr,
+		Labels:  s.cfg.Labels,
 	}
 
 	var errorStr string
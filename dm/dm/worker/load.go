@@ -0,0 +1,65 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"runtime"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/pkg/ha"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+)
+
+// loadReporter collects the load of the DM-worker, which is reported to etcd periodically to let DM-master schedule
+// sources to the under-utilized DM-workers.
+type loadReporter struct {
+	worker string
+
+	// the number of binlog events processed by the sync units at the last collection, to calculate the QPS.
+	lastEvents int64
+	lastTime   time.Time
+}
+
+// collect builds the load of the DM-worker, the source worker is nil if no source is bound.
+func (r *loadReporter) collect(cpuUsage float64, w *SourceWorker, now time.Time) ha.WorkerLoad {
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+	load := ha.WorkerLoad{
+		Worker:      r.worker,
+		CPUUsage:    cpuUsage,
+		MemoryUsage: ms.Sys,
+		UpdateTime:  now,
+	}
+
+	var events int64
+	if w != nil {
+		load.RelayDiskUsage, events = w.loadStat()
+	}
+	// the number decreases if a subtask is stopped or restarted, skip the QPS of this round.
+	if !r.lastTime.IsZero() && now.After(r.lastTime) && events >= r.lastEvents {
+		load.TaskQPS = float64(events-r.lastEvents) / now.Sub(r.lastTime).Seconds()
+	}
+	r.lastEvents, r.lastTime = events, now
+	return load
+}
+
+// reportLoad collects the load of the DM-worker and puts it into etcd.
+func (s *Server) reportLoad(r *loadReporter, cpuUsage float64) {
+	load := r.collect(cpuUsage, s.getWorker(true), time.Now())
+	if _, err := ha.PutWorkerLoad(s.etcdClient, load); err != nil {
+		log.L().Warn("fail to report the load of DM-worker", zap.Stringer("load", load), zap.Error(err))
+	}
+}
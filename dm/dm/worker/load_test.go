@@ -0,0 +1,49 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"time"
+
+	. "github.com/pingcap/check"
+)
+
+var _ = Suite(&testLoadReporter{})
+
+type testLoadReporter struct{}
+
+func (t *testLoadReporter) TestCollect(c *C) {
+	r := &loadReporter{worker: "worker-1"}
+	now := time.Now()
+
+	// no source is bound
+	load := r.collect(12.5, nil, now)
+	c.Assert(load.Worker, Equals, "worker-1")
+	c.Assert(load.CPUUsage, Equals, 12.5)
+	c.Assert(load.MemoryUsage, Greater, uint64(0))
+	c.Assert(load.RelayDiskUsage, Equals, int64(0))
+	c.Assert(load.TaskQPS, Equals, float64(0))
+	c.Assert(load.UpdateTime, Equals, now)
+
+	// calculate the QPS from the number of events at the last collection
+	r.lastEvents = -100
+	load = r.collect(0, &SourceWorker{subTaskHolder: newSubTaskHolder()}, now.Add(10*time.Second))
+	c.Assert(load.TaskQPS, Equals, float64(10))
+	c.Assert(r.lastEvents, Equals, int64(0))
+
+	// skip the QPS if the number decreases
+	r.lastEvents = 100
+	load = r.collect(0, &SourceWorker{subTaskHolder: newSubTaskHolder()}, now.Add(20*time.Second))
+	c.Assert(load.TaskQPS, Equals, float64(0))
+}
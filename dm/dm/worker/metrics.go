@@ -78,21 +78,27 @@ func (h *statusHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
+// collectMetrics returns the CPU usage, which is also reported as the load of the DM-worker.
 // Note: handle error inside the function with returning it.
-func (s *Server) collectMetrics() {
+func (s *Server) collectMetrics() float64 {
 	// CPU usage metric
 	cpuUsage := cpu.GetCPUPercentage()
 	cpuUsageGauge.Set(cpuUsage)
+	return cpuUsage
 }
 
 func (s *Server) runBackgroundJob(ctx context.Context) {
 	ticker := time.NewTicker(time.Second * 10)
 	defer ticker.Stop()
 
+	reporter := &loadReporter{worker: s.cfg.Name}
+	throttler := &priorityThrottler{cfg: s.cfg.PriorityThrottle}
 	for {
 		select {
 		case <-ticker.C:
-			s.collectMetrics()
+			cpuUsage := s.collectMetrics()
+			s.reportLoad(reporter, cpuUsage)
+			s.throttleByPriority(throttler, cpuUsage)
 
 		case <-ctx.Done():
 			return
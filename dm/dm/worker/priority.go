@@ -0,0 +1,139 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+const (
+	// the default max rows replicated per second of a throttled subtask.
+	defaultThrottledRowsPerSecond = 1000
+	// the throttle is released when the CPU usage falls below this ratio of the threshold, to avoid throttling and
+	// releasing the subtasks back and forth when the CPU usage fluctuates around the threshold.
+	priorityReleaseRatio = 0.8
+)
+
+// PriorityThrottle is the config to throttle the subtasks of lower priorities when the DM-worker is busy, so the
+// subtasks of higher priorities get more resources.
+type PriorityThrottle struct {
+	// the subtasks are throttled when the CPU usage in percent of the DM-worker is higher than it, it can be larger
+	// than 100 for multiple cores. 0 means never throttle.
+	CPUUsage float64 `toml:"cpu-usage" json:"cpu-usage"`
+	// the max rows replicated per second of a throttled subtask, 0 means the default value.
+	RowsPerSecond int64 `toml:"rows-per-second" json:"rows-per-second"`
+}
+
+// adjust checks the config.
+func (t *PriorityThrottle) adjust() error {
+	if t.CPUUsage < 0 || t.RowsPerSecond < 0 {
+		return terror.ErrWorkerInvalidPriorityThrottle.Generate("the settings should not be negative")
+	}
+	return nil
+}
+
+// priorityThrottler decides which subtasks are throttled by the CPU usage of the DM-worker. when the CPU usage is
+// higher than the threshold, the subtasks are throttled level by level from the lowest priority in each round, and
+// when the CPU usage falls, they're released level by level from the highest throttled priority. the subtasks of
+// high priority are never throttled.
+type priorityThrottler struct {
+	cfg PriorityThrottle
+	// the subtasks whose priority levels are not higher than it are throttled, 0 means none is throttled.
+	level int
+}
+
+// next updates the throttled level by the CPU usage and the priority levels of the running subtasks.
+func (t *priorityThrottler) next(cpuUsage float64, levels []int) {
+	highLevel := config.TaskPriorityLevel(config.TaskPriorityHigh)
+	candidates := make([]int, 0, len(levels))
+	for _, l := range levels {
+		if l > 0 && l < highLevel {
+			candidates = append(candidates, l)
+		}
+	}
+	sort.Ints(candidates)
+
+	switch {
+	case cpuUsage > t.cfg.CPUUsage:
+		for _, l := range candidates {
+			if l > t.level {
+				t.level = l
+				return
+			}
+		}
+	case cpuUsage < t.cfg.CPUUsage*priorityReleaseRatio:
+		released := 0
+		for _, l := range candidates {
+			if l >= t.level {
+				break
+			}
+			released = l
+		}
+		t.level = released
+	}
+}
+
+// throttledRows returns the rows/sec limit of the subtask with the priority level, 0 means not throttled.
+func (t *priorityThrottler) throttledRows(level int) int64 {
+	if t.level == 0 || level > t.level {
+		return 0
+	}
+	if t.cfg.RowsPerSecond > 0 {
+		return t.cfg.RowsPerSecond
+	}
+	return defaultThrottledRowsPerSecond
+}
+
+// throttleByPriority throttles the subtasks of the bound source by their priorities and the CPU usage.
+func (s *Server) throttleByPriority(t *priorityThrottler, cpuUsage float64) {
+	if t.cfg.CPUUsage <= 0 {
+		return
+	}
+	w := s.getWorker(true)
+	if w == nil {
+		t.level = 0
+		return
+	}
+	w.throttleSubTasks(t, cpuUsage)
+}
+
+// throttleSubTasks throttles the subtasks by their priorities and the CPU usage.
+func (w *SourceWorker) throttleSubTasks(t *priorityThrottler, cpuUsage float64) {
+	w.RLock()
+	defer w.RUnlock()
+
+	if w.closed.Load() {
+		return
+	}
+	subTasks := w.subTaskHolder.getAllSubTasks()
+	levels := make([]int, 0, len(subTasks))
+	for _, st := range subTasks {
+		levels = append(levels, st.priorityLevel())
+	}
+	prevLevel := t.level
+	t.next(cpuUsage, levels)
+	if t.level != prevLevel {
+		log.L().Info("change the throttled priority level of subtasks", zap.Float64("cpu usage", cpuUsage),
+			zap.Int("previous level", prevLevel), zap.Int("level", t.level))
+	}
+	for _, st := range subTasks {
+		st.setThrottle(t.throttledRows(st.priorityLevel()))
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+var _ = Suite(&testPriorityThrottler{})
+
+type testPriorityThrottler struct{}
+
+func (t *testPriorityThrottler) TestAdjust(c *C) {
+	cfg := PriorityThrottle{}
+	c.Assert(cfg.adjust(), IsNil)
+	cfg.CPUUsage = -1
+	c.Assert(terror.ErrWorkerInvalidPriorityThrottle.Equal(cfg.adjust()), IsTrue)
+	cfg.CPUUsage = 300
+	cfg.RowsPerSecond = -1
+	c.Assert(terror.ErrWorkerInvalidPriorityThrottle.Equal(cfg.adjust()), IsTrue)
+}
+
+func (t *testPriorityThrottler) TestNext(c *C) {
+	var (
+		low    = config.TaskPriorityLevel(config.TaskPriorityLow)
+		normal = config.TaskPriorityLevel(config.TaskPriorityNormal)
+		high   = config.TaskPriorityLevel(config.TaskPriorityHigh)
+		levels = []int{high, normal, low, low}
+	)
+	th := &priorityThrottler{cfg: PriorityThrottle{CPUUsage: 100}}
+	th.next(50, levels)
+	c.Assert(th.level, Equals, 0)
+	c.Assert(th.throttledRows(low), Equals, int64(0))
+
+	// the lower priorities are throttled first, and the high priority is never throttled
+	th.next(120, levels)
+	c.Assert(th.level, Equals, low)
+	c.Assert(th.throttledRows(low), Equals, int64(defaultThrottledRowsPerSecond))
+	c.Assert(th.throttledRows(normal), Equals, int64(0))
+	th.next(120, levels)
+	c.Assert(th.level, Equals, normal)
+	c.Assert(th.throttledRows(normal), Equals, int64(defaultThrottledRowsPerSecond))
+	th.next(120, levels)
+	c.Assert(th.level, Equals, normal)
+	c.Assert(th.throttledRows(high), Equals, int64(0))
+
+	// keep the throttle until the CPU usage is low enough, and release the higher priorities first
+	th.cfg.RowsPerSecond = 100
+	th.next(90, levels)
+	c.Assert(th.level, Equals, normal)
+	c.Assert(th.throttledRows(low), Equals, int64(100))
+	th.next(50, levels)
+	c.Assert(th.level, Equals, low)
+	th.next(50, levels)
+	c.Assert(th.level, Equals, 0)
+
+	// the priorities without subtasks are skipped
+	levels = []int{high, normal}
+	th.next(120, levels)
+	c.Assert(th.level, Equals, normal)
+	th.next(50, levels)
+	c.Assert(th.level, Equals, 0)
+}
@@ -50,6 +50,8 @@ type RelayHolder interface {
 	Result() *pb.ProcessResult
 	// Update updates relay config online
 	Update(ctx context.Context, cfg *config.SourceConfig) error
+	// SwitchUpstream switches the upstream master of relay online
+	SwitchUpstream(ctx context.Context, from config.DBConfig, binlogName, binlogGTID string) error
 	// Relay returns relay object
 	Relay() relay.Process
 }
@@ -303,11 +305,58 @@ func (h *realRelayHolder) Update(ctx context.Context, sourceCfg *config.SourceCo
 	return nil
 }
 
+// SwitchUpstream switches the upstream master of relay online, relay is paused and resumed if it's running.
+func (h *realRelayHolder) SwitchUpstream(ctx context.Context, from config.DBConfig, binlogName, binlogGTID string) error {
+	stage := h.Stage()
+	switch stage {
+	case pb.Stage_Paused:
+	case pb.Stage_Running:
+		if err := h.Operate(ctx, pb.RelayOp_PauseRelay); err != nil {
+			return err
+		}
+	default:
+		return terror.ErrWorkerRelayStageNotValid.Generatef("current stage is %s, relay should be running or paused to switch upstream", stage)
+	}
+
+	err := h.relay.SwitchUpstream(ctx, from, binlogName, binlogGTID)
+	if stage == pb.Stage_Running {
+		// resume to pull relay log from the previous upstream if failed
+		if err2 := h.Operate(ctx, pb.RelayOp_ResumeRelay); err2 != nil && err == nil {
+			err = err2
+		}
+	}
+	return err
+}
+
 // EarliestActiveRelayLog implements Operator.EarliestActiveRelayLog.
 func (h *realRelayHolder) EarliestActiveRelayLog() *streamer.RelayLogInfo {
 	return h.relay.ActiveRelayLog()
 }
 
+// ArchivedRelayLogs implements relay.ArchiveOperator.ArchivedRelayLogs.
+func (h *realRelayHolder) ArchivedRelayLogs() []string {
+	if ao, ok := h.relay.(relay.ArchiveOperator); ok {
+		return ao.ArchivedRelayLogs()
+	}
+	return nil
+}
+
+// ActiveReaderRelayLogs implements relay.ReaderOperator.ActiveReaderRelayLogs.
+func (h *realRelayHolder) ActiveReaderRelayLogs() []*streamer.RelayLogInfo {
+	if ro, ok := h.relay.(relay.ReaderOperator); ok {
+		return ro.ActiveReaderRelayLogs()
+	}
+	return nil
+}
+
+// TrimUUIDs implements relay.UUIDIndexOperator.TrimUUIDs.
+func (h *realRelayHolder) TrimUUIDs() ([]string, error) {
+	if uo, ok := h.relay.(relay.UUIDIndexOperator); ok {
+		return uo.TrimUUIDs()
+	}
+	return nil, nil
+}
+
 func (h *realRelayHolder) Relay() relay.Process {
 	return h.relay
 }
@@ -422,6 +471,11 @@ func (d *dummyRelayHolder) Update(ctx context.Context, cfg *config.SourceConfig)
 	return nil
 }
 
+// SwitchUpstream implements interface of RelayHolder.
+func (d *dummyRelayHolder) SwitchUpstream(ctx context.Context, from config.DBConfig, binlogName, binlogGTID string) error {
+	return nil
+}
+
 func (d *dummyRelayHolder) EarliestActiveRelayLog() *streamer.RelayLogInfo {
 	return nil
 }
@@ -97,6 +97,11 @@ func (d *DummyRelay) Reload(newCfg *relay.Config) error {
 	return d.reloadErr
 }
 
+// SwitchUpstream implements Process interface.
+func (d *DummyRelay) SwitchUpstream(ctx context.Context, from config.DBConfig, binlogName, binlogGTID string) error {
+	return d.reloadErr
+}
+
 // InjectReloadError injects reload error.
 func (d *DummyRelay) InjectReloadError(err error) {
 	d.reloadErr = err
@@ -169,6 +174,7 @@ func (t *testRelay) TestRelay(c *C) {
 	t.testInit(c, holder)
 	t.testStart(c, holder)
 	t.testPauseAndResume(c, holder)
+	t.testSwitchUpstream(c, holder)
 	t.testClose(c, holder)
 	t.testStop(c, holder)
 }
@@ -284,6 +290,22 @@ func (t *testRelay) testPauseAndResume(c *C, holder *realRelayHolder) {
 	c.Assert(err, ErrorMatches, ".*not supported.*")
 }
 
+func (t *testRelay) testSwitchUpstream(c *C, holder *realRelayHolder) {
+	from := config.DBConfig{Host: "127.0.0.2", Port: 3306, User: "root"}
+	c.Assert(holder.Stage(), Equals, pb.Stage_Running)
+	c.Assert(holder.SwitchUpstream(context.Background(), from, "mysql-bin.000001", ""), IsNil)
+	c.Assert(waitRelayStage(holder, pb.Stage_Running, 10), IsTrue)
+
+	// resumed even if failed
+	r, ok := holder.relay.(*DummyRelay)
+	c.Assert(ok, IsTrue)
+	switchErr := errors.New("switch error")
+	r.InjectReloadError(switchErr)
+	defer r.InjectReloadError(nil)
+	c.Assert(holder.SwitchUpstream(context.Background(), from, "mysql-bin.000001", ""), ErrorMatches, ".*"+switchErr.Error()+".*")
+	c.Assert(waitRelayStage(holder, pb.Stage_Running, 10), IsTrue)
+}
+
 func (t *testRelay) testUpdate(c *C, holder *realRelayHolder) {
 	cfg := &config.SourceConfig{
 		From: config.DBConfig{
@@ -0,0 +1,86 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// the downstream connections of the units besides the ones applying the data. the sync unit uses 2 connections for
+// DDL and schema tracker, 1 for checkpoint, 1 for online DDL and 1 for sharding group. the load unit uses 1 for
+// checkpoint.
+const (
+	syncerReservedDownstreamConns = 5
+	loaderReservedDownstreamConns = 1
+)
+
+// TaskResourceLimits is the resource limits of each subtask in the DM-worker. the resource settings in the subtask
+// configs beyond the limits are lowered when the subtasks start, so a subtask can't exhaust the memory or downstream
+// connections shared by the other subtasks in the DM-worker. 0 means unlimited.
+type TaskResourceLimits struct {
+	// the max bytes of the decoded row changes buffered in the sync unit, it caps `memory-quota` of the task, the
+	// binlog reading is throttled when it's used up.
+	MemoryQuota int64 `toml:"memory-quota" json:"memory-quota"`
+	// the max estimated memory in MiB of the table infos held by the schema tracker, it caps
+	// `schema-tracker-memory-budget` of the task, the cold tables are evicted when it's exceeded.
+	SchemaTrackerMemoryBudget int `toml:"schema-tracker-memory-budget" json:"schema-tracker-memory-budget"`
+	// the max connections to downstream of a unit, including the ones reserved for DDL and checkpoint.
+	MaxDownstreamConnections int `toml:"max-downstream-connections" json:"max-downstream-connections"`
+	// the max concurrency of applying the data to downstream, it caps `worker-count` of the sync unit and `pool-size`
+	// of the load unit.
+	MaxApplyConcurrency int `toml:"max-apply-concurrency" json:"max-apply-concurrency"`
+}
+
+// adjust checks the limits.
+func (l *TaskResourceLimits) adjust() error {
+	if l.MemoryQuota < 0 || l.SchemaTrackerMemoryBudget < 0 || l.MaxDownstreamConnections < 0 || l.MaxApplyConcurrency < 0 {
+		return terror.ErrWorkerInvalidTaskResourceLimits.Generate("the limits should not be negative")
+	}
+	if l.MaxDownstreamConnections > 0 && l.MaxDownstreamConnections <= syncerReservedDownstreamConns {
+		return terror.ErrWorkerInvalidTaskResourceLimits.Generatef(
+			"max-downstream-connections should be larger than %d, which are reserved by the sync unit", syncerReservedDownstreamConns)
+	}
+	return nil
+}
+
+// applyTo lowers the resource settings of the subtask config to the limits.
+func (l TaskResourceLimits) applyTo(cfg *config.SubTaskConfig) {
+	logger := log.L().WithFields(zap.String("task", cfg.Name), zap.String("source", cfg.SourceID))
+	lower := func(name string, value, limit int64) int64 {
+		if limit <= 0 || (value > 0 && value <= limit) {
+			return value
+		}
+		logger.Warn("lower the resource setting of subtask to the limit of DM-worker",
+			zap.String("setting", name), zap.Int64("value", value), zap.Int64("limit", limit))
+		return limit
+	}
+	maxConcurrency := func(reservedConns int) int64 {
+		limit := int64(l.MaxApplyConcurrency)
+		if l.MaxDownstreamConnections > 0 {
+			if byConns := int64(l.MaxDownstreamConnections - reservedConns); limit <= 0 || byConns < limit {
+				limit = byConns
+			}
+		}
+		return limit
+	}
+
+	cfg.MemoryQuota = lower("memory-quota", cfg.MemoryQuota, l.MemoryQuota)
+	cfg.SchemaTrackerMemoryBudget = int(lower("schema-tracker-memory-budget", int64(cfg.SchemaTrackerMemoryBudget), int64(l.SchemaTrackerMemoryBudget)))
+	cfg.WorkerCount = int(lower("worker-count", int64(cfg.WorkerCount), maxConcurrency(syncerReservedDownstreamConns)))
+	cfg.PoolSize = int(lower("pool-size", int64(cfg.PoolSize), maxConcurrency(loaderReservedDownstreamConns)))
+}
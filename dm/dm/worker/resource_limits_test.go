@@ -0,0 +1,75 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+var _ = Suite(&testTaskResourceLimits{})
+
+type testTaskResourceLimits struct{}
+
+func (t *testTaskResourceLimits) TestAdjust(c *C) {
+	l := TaskResourceLimits{}
+	c.Assert(l.adjust(), IsNil)
+	l.MemoryQuota = -1
+	c.Assert(terror.ErrWorkerInvalidTaskResourceLimits.Equal(l.adjust()), IsTrue)
+	l.MemoryQuota = 0
+	l.MaxDownstreamConnections = syncerReservedDownstreamConns
+	c.Assert(terror.ErrWorkerInvalidTaskResourceLimits.Equal(l.adjust()), IsTrue)
+	l.MaxDownstreamConnections = syncerReservedDownstreamConns + 1
+	c.Assert(l.adjust(), IsNil)
+}
+
+func (t *testTaskResourceLimits) TestApplyTo(c *C) {
+	newCfg := func() *config.SubTaskConfig {
+		cfg := config.NewSubTaskConfig()
+		cfg.MemoryQuota = 0
+		cfg.SchemaTrackerMemoryBudget = 128
+		cfg.WorkerCount = 32
+		cfg.PoolSize = 32
+		return cfg
+	}
+
+	// no limit
+	cfg := newCfg()
+	TaskResourceLimits{}.applyTo(cfg)
+	c.Assert(cfg, DeepEquals, newCfg())
+
+	// the unlimited and larger settings are lowered, the smaller ones are kept
+	cfg = newCfg()
+	TaskResourceLimits{
+		MemoryQuota:               1 << 30,
+		SchemaTrackerMemoryBudget: 256,
+		MaxApplyConcurrency:       16,
+	}.applyTo(cfg)
+	c.Assert(cfg.MemoryQuota, Equals, int64(1<<30))
+	c.Assert(cfg.SchemaTrackerMemoryBudget, Equals, 128)
+	c.Assert(cfg.WorkerCount, Equals, 16)
+	c.Assert(cfg.PoolSize, Equals, 16)
+
+	// the concurrency is limited by the downstream connections besides the reserved ones
+	cfg = newCfg()
+	TaskResourceLimits{MaxDownstreamConnections: 20, MaxApplyConcurrency: 16}.applyTo(cfg)
+	c.Assert(cfg.WorkerCount, Equals, 20-syncerReservedDownstreamConns)
+	c.Assert(cfg.PoolSize, Equals, 16)
+	cfg = newCfg()
+	TaskResourceLimits{MaxDownstreamConnections: 20}.applyTo(cfg)
+	c.Assert(cfg.WorkerCount, Equals, 20-syncerReservedDownstreamConns)
+	c.Assert(cfg.PoolSize, Equals, 20-loaderReservedDownstreamConns)
+}
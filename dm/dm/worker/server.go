@@ -826,6 +826,7 @@ func (s *Server) getOrStartWorker(cfg *config.SourceConfig, needLock bool) (*Sou
 	if err != nil {
 		return nil, err
 	}
+	w.taskResourceLimits = s.cfg.TaskResourceLimits
 	s.setWorker(w, false)
 
 	go w.Start()
@@ -921,6 +922,108 @@ func (s *Server) HandleError(ctx context.Context, req *pb.HandleWorkerErrorReque
 	}, nil
 }
 
+// SetRateLimit changes the rate limit of a subtask.
+func (s *Server) SetRateLimit(ctx context.Context, req *pb.SetRateLimitRequest) (*pb.CommonWorkerResponse, error) {
+	log.L().Info("", zap.String("request", "SetRateLimit"), zap.Stringer("payload", req))
+
+	w := s.getWorker(true)
+	if w == nil {
+		log.L().Warn("fail to call SetRateLimit, because no mysql source is being handled in the worker")
+		return makeCommonWorkerResponse(terror.ErrWorkerNoStart.Generate()), nil
+	}
+
+	err := w.SetRateLimit(req)
+	if err != nil {
+		return makeCommonWorkerResponse(err), nil
+	}
+	return &pb.CommonWorkerResponse{
+		Result: true,
+		Worker: s.cfg.Name,
+	}, nil
+}
+
+// SetTaskPriority changes the priority of a subtask at runtime.
+func (s *Server) SetTaskPriority(ctx context.Context, req *pb.SetTaskPriorityRequest) (*pb.CommonWorkerResponse, error) {
+	log.L().Info("", zap.String("request", "SetTaskPriority"), zap.Stringer("payload", req))
+
+	w := s.getWorker(true)
+	if w == nil {
+		log.L().Warn("fail to call SetTaskPriority, because no mysql source is being handled in the worker")
+		return makeCommonWorkerResponse(terror.ErrWorkerNoStart.Generate()), nil
+	}
+
+	err := w.SetSubTaskPriority(req)
+	if err != nil {
+		return makeCommonWorkerResponse(err), nil
+	}
+	return &pb.CommonWorkerResponse{
+		Result: true,
+		Worker: s.cfg.Name,
+	}, nil
+}
+
+// OperateSkipGTID lists, adds or removes the GTIDs of the transactions to skip of a subtask.
+func (s *Server) OperateSkipGTID(ctx context.Context, req *pb.OperateSkipGTIDRequest) (*pb.CommonWorkerResponse, error) {
+	log.L().Info("", zap.String("request", "OperateSkipGTID"), zap.Stringer("payload", req))
+
+	w := s.getWorker(true)
+	if w == nil {
+		log.L().Warn("fail to call OperateSkipGTID, because no mysql source is being handled in the worker")
+		return makeCommonWorkerResponse(terror.ErrWorkerNoStart.Generate()), nil
+	}
+
+	msg, err := w.OperateSkipGTID(req)
+	if err != nil {
+		return makeCommonWorkerResponse(err), nil
+	}
+	return &pb.CommonWorkerResponse{
+		Result: true,
+		Worker: s.cfg.Name,
+		Msg:    msg,
+	}, nil
+}
+
+// OperatePausedTable lists, pauses or resumes the upstream tables of a subtask.
+func (s *Server) OperatePausedTable(ctx context.Context, req *pb.OperatePausedTableRequest) (*pb.CommonWorkerResponse, error) {
+	log.L().Info("", zap.String("request", "OperatePausedTable"), zap.Stringer("payload", req))
+
+	w := s.getWorker(true)
+	if w == nil {
+		log.L().Warn("fail to call OperatePausedTable, because no mysql source is being handled in the worker")
+		return makeCommonWorkerResponse(terror.ErrWorkerNoStart.Generate()), nil
+	}
+
+	msg, err := w.OperatePausedTable(req)
+	if err != nil {
+		return makeCommonWorkerResponse(err), nil
+	}
+	return &pb.CommonWorkerResponse{
+		Result: true,
+		Worker: s.cfg.Name,
+		Msg:    msg,
+	}, nil
+}
+
+// UpdateSubTaskRules hot reloads the block-allow-list, route and binlog filter rules of a subtask.
+func (s *Server) UpdateSubTaskRules(ctx context.Context, req *pb.UpdateSubTaskRulesRequest) (*pb.CommonWorkerResponse, error) {
+	log.L().Info("", zap.String("request", "UpdateSubTaskRules"), zap.String("task", req.Task))
+
+	w := s.getWorker(true)
+	if w == nil {
+		log.L().Warn("fail to call UpdateSubTaskRules, because no mysql source is being handled in the worker")
+		return makeCommonWorkerResponse(terror.ErrWorkerNoStart.Generate()), nil
+	}
+
+	cfg := config.NewSubTaskConfig()
+	if err := cfg.Decode(req.SubtaskCfg, true); err != nil {
+		return makeCommonWorkerResponse(err), nil
+	}
+	if cfg.Name != req.Task {
+		return makeCommonWorkerResponse(terror.ErrWorkerSubTaskNotFound.Generate(req.Task)), nil
+	}
+	return makeCommonWorkerResponse(w.UpdateSubTaskRules(cfg)), nil
+}
+
 // GetWorkerCfg get worker config.
 func (s *Server) GetWorkerCfg(ctx context.Context, req *pb.GetWorkerCfgRequest) (*pb.GetWorkerCfgResponse, error) {
 	log.L().Info("", zap.String("request", "GetWorkerCfg"), zap.Stringer("payload", req))
@@ -17,6 +17,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -24,6 +25,7 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
 	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
+	"github.com/pingcap/tidb-tools/pkg/filter"
 	"go.etcd.io/etcd/clientv3"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -87,6 +89,8 @@ type SourceWorker struct {
 	etcdClient *clientv3.Client
 
 	name string
+	// the resource limits of each subtask, set by the DM-worker server before starting.
+	taskResourceLimits TaskResourceLimits
 }
 
 // NewSourceWorker creates a new SourceWorker. The functionality of relay and subtask is disabled by default, need call EnableRelay
@@ -357,6 +361,11 @@ func (w *SourceWorker) EnableRelay(startBySourceCfg bool) (err error) {
 	}
 
 	w.relayHolder = NewRelayHolder(w.cfg)
+	if w.cfg.RelayMetaInEtcd {
+		if r, ok := w.relayHolder.Relay().(*relay.Relay); ok {
+			r.UseEtcdMeta(w.etcdClient, w.cfg.SourceID, w.name)
+		}
+	}
 	relayPurger, err := w.relayHolder.Init(w.relayCtx, []relay.PurgeInterceptor{
 		w,
 	})
@@ -537,6 +546,7 @@ func (w *SourceWorker) StartSubTask(cfg *config.SubTaskConfig, expectStage pb.St
 	if err != nil {
 		return err
 	}
+	w.taskResourceLimits.applyTo(cfg)
 
 	// directly put cfg into subTaskHolder
 	// the unique of subtask should be assured by etcd
@@ -589,6 +599,7 @@ func (w *SourceWorker) UpdateSubTask(ctx context.Context, cfg *config.SubTaskCon
 		return terror.ErrWorkerSubTaskNotFound.Generate(cfg.Name)
 	}
 
+	w.taskResourceLimits.applyTo(cfg)
 	w.l.Info("update sub task", zap.String("task", cfg.Name))
 	return st.Update(ctx, cfg)
 }
@@ -941,6 +952,40 @@ func (w *SourceWorker) operateRelay(ctx context.Context, op pb.RelayOp) error {
 	return nil
 }
 
+// SwitchRelayUpstream switches the upstream master of relay to `from` without restarting the worker, relay log is
+// pulled from `binlogName` or `binlogGTID` of the new master into a new sub directory, and subtasks continue reading it
+// after the relay log of the previous master. the source config in DM-master should also be updated to `from`,
+// otherwise relay switches back to the previous master after the source is bound again.
+func (w *SourceWorker) SwitchRelayUpstream(ctx context.Context, from config.DBConfig, binlogName, binlogGTID string) error {
+	if w.closed.Load() {
+		return terror.ErrWorkerAlreadyClosed.Generate()
+	}
+
+	w.Lock()
+	defer w.Unlock()
+	if !w.relayEnabled.Load() || w.relayHolder == nil {
+		return terror.ErrWorkerRelayUnitStage.Generate("relay not enabled")
+	}
+	decrypted := from
+	if len(decrypted.Password) > 0 {
+		decrypted.Password = utils.DecryptOrPlaintext(decrypted.Password)
+	}
+	if err := w.relayHolder.SwitchUpstream(ctx, decrypted, binlogName, binlogGTID); err != nil {
+		return err
+	}
+	w.cfg.From = from
+
+	// the source status is queried from the new master later
+	w.sourceDBMu.Lock()
+	if w.sourceDB != nil {
+		w.sourceDB.Close()
+		w.sourceDB = nil
+	}
+	w.sourceDBMu.Unlock()
+	w.l.Info("relay upstream switched", zap.String("host", from.Host), zap.Int("port", from.Port))
+	return nil
+}
+
 // PurgeRelay purges relay log files.
 func (w *SourceWorker) PurgeRelay(ctx context.Context, req *pb.PurgeRelayRequest) error {
 	if w.closed.Load() {
@@ -1013,6 +1058,23 @@ func (w *SourceWorker) OperateSchema(ctx context.Context, req *pb.OperateWorkerS
 	return st.OperateSchema(ctx, req)
 }
 
+// FlushCheckpoint requests the subtask `name` to flush its checkpoint of binlog replication on demand.
+func (w *SourceWorker) FlushCheckpoint(name string) error {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.closed.Load() {
+		return terror.ErrWorkerAlreadyClosed.Generate()
+	}
+
+	st := w.subTaskHolder.findSubTask(name)
+	if st == nil {
+		return terror.ErrWorkerSubTaskNotFound.Generate(name)
+	}
+
+	return st.FlushCheckpoint()
+}
+
 // copyConfigFromSource copies config items from source config and worker's relayEnabled to sub task.
 func copyConfigFromSource(cfg *config.SubTaskConfig, sourceCfg *config.SourceConfig, enableRelay bool) error {
 	cfg.From = sourceCfg.From
@@ -1020,6 +1082,11 @@ func copyConfigFromSource(cfg *config.SubTaskConfig, sourceCfg *config.SourceCon
 	cfg.Flavor = sourceCfg.Flavor
 	cfg.ServerID = sourceCfg.ServerID
 	cfg.RelayDir = sourceCfg.RelayDir
+	relayEncryptKey, err := sourceCfg.RelayEncryptKeyBytes()
+	if err != nil {
+		return err
+	}
+	cfg.RelayEncryptKey = relayEncryptKey
 	cfg.EnableGTID = sourceCfg.EnableGTID
 	cfg.UseRelay = enableRelay
 
@@ -1030,7 +1097,7 @@ func copyConfigFromSource(cfg *config.SubTaskConfig, sourceCfg *config.SourceCon
 		log.L().Warn("different case-sensitive config between task config and source config, use `true` for it.")
 	}
 	cfg.CaseSensitive = cfg.CaseSensitive || sourceCfg.CaseSensitive
-	filter, err := bf.NewBinlogEvent(cfg.CaseSensitive, cfg.FilterRules)
+	filter, err := bf.NewBinlogEvent(cfg.CaseSensitive, config.BinlogFilterRules(cfg.FilterRules))
 	if err != nil {
 		return err
 	}
@@ -1044,7 +1111,7 @@ func copyConfigFromSource(cfg *config.SubTaskConfig, sourceCfg *config.SourceCon
 			}
 			return err
 		}
-		cfg.FilterRules = append(cfg.FilterRules, filterRule)
+		cfg.FilterRules = append(cfg.FilterRules, &config.BinlogEventRule{BinlogEventRule: *filterRule})
 	}
 	return nil
 }
@@ -1081,6 +1148,28 @@ func (w *SourceWorker) getAllSubTaskStatus() map[string]*pb.SubTaskStatus {
 	return result
 }
 
+// loadStat returns the bytes of the relay log files and the number of binlog events processed by the sync units of
+// the worker, which are reported as the load of the DM-worker.
+func (w *SourceWorker) loadStat() (relayDiskUsage, syncedEvents int64) {
+	w.RLock()
+	defer w.RUnlock()
+
+	if w.closed.Load() {
+		return 0, 0
+	}
+	if w.relayEnabled.Load() {
+		size, err := utils.GetDirSize(w.cfg.RelayDir)
+		if err != nil {
+			w.l.Warn("fail to get the size of relay directory", zap.String("relay dir", w.cfg.RelayDir), zap.Error(err))
+		}
+		relayDiskUsage = size
+	}
+	for _, st := range w.subTaskHolder.getAllSubTasks() {
+		syncedEvents += st.syncedEvents()
+	}
+	return relayDiskUsage, syncedEvents
+}
+
 // HandleError handle worker error.
 func (w *SourceWorker) HandleError(ctx context.Context, req *pb.HandleWorkerErrorRequest) (string, error) {
 	w.Lock()
@@ -1097,3 +1186,102 @@ func (w *SourceWorker) HandleError(ctx context.Context, req *pb.HandleWorkerErro
 
 	return st.HandleError(ctx, req, w.getRelayWithoutLock())
 }
+
+// SetRateLimit changes the rate limit of a subtask.
+func (w *SourceWorker) SetRateLimit(req *pb.SetRateLimitRequest) error {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.closed.Load() {
+		return terror.ErrWorkerAlreadyClosed.Generate()
+	}
+
+	st := w.subTaskHolder.findSubTask(req.Task)
+	if st == nil {
+		return terror.ErrWorkerSubTaskNotFound.Generate(req.Task)
+	}
+
+	return st.SetRateLimit(req.RowsPerSecond, req.BytesPerSecond)
+}
+
+// SetSubTaskPriority changes the priority of a subtask, it takes effect in the next round of throttling.
+func (w *SourceWorker) SetSubTaskPriority(req *pb.SetTaskPriorityRequest) error {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.closed.Load() {
+		return terror.ErrWorkerAlreadyClosed.Generate()
+	}
+
+	priority := req.Priority
+	if err := config.AdjustTaskPriority(&priority); err != nil {
+		return err
+	}
+	st := w.subTaskHolder.findSubTask(req.Task)
+	if st == nil {
+		return terror.ErrWorkerSubTaskNotFound.Generate(req.Task)
+	}
+
+	st.SetPriority(priority)
+	w.l.Info("subtask priority changed", zap.String("task", req.Task), zap.String("priority", priority))
+	return nil
+}
+
+// UpdateSubTaskRules hot reloads the block-allow-list, route and binlog filter rules of a subtask.
+func (w *SourceWorker) UpdateSubTaskRules(cfg *config.SubTaskConfig) error {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.closed.Load() {
+		return terror.ErrWorkerAlreadyClosed.Generate()
+	}
+
+	st := w.subTaskHolder.findSubTask(cfg.Name)
+	if st == nil {
+		return terror.ErrWorkerSubTaskNotFound.Generate(cfg.Name)
+	}
+
+	return st.UpdateRules(cfg)
+}
+
+// OperatePausedTable lists, pauses or resumes the upstream tables of a subtask.
+func (w *SourceWorker) OperatePausedTable(req *pb.OperatePausedTableRequest) (string, error) {
+	tables := make([]*filter.Table, 0, len(req.Tables))
+	for _, tableID := range req.Tables {
+		if !strings.Contains(tableID, "`.`") {
+			return "", terror.ErrConfigInvalidPausedTable.Generate(tableID, "it should be like `schema`.`table`")
+		}
+		tables = append(tables, utils.UnpackTableID(tableID))
+	}
+
+	w.Lock()
+	defer w.Unlock()
+
+	if w.closed.Load() {
+		return "", terror.ErrWorkerAlreadyClosed.Generate()
+	}
+
+	st := w.subTaskHolder.findSubTask(req.Task)
+	if st == nil {
+		return "", terror.ErrWorkerSubTaskNotFound.Generate(req.Task)
+	}
+
+	return st.OperatePausedTable(req.Op, tables, config.PauseTablePolicy(req.Policy))
+}
+
+// OperateSkipGTID lists, adds or removes the GTIDs of the transactions to skip of a subtask.
+func (w *SourceWorker) OperateSkipGTID(req *pb.OperateSkipGTIDRequest) (string, error) {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.closed.Load() {
+		return "", terror.ErrWorkerAlreadyClosed.Generate()
+	}
+
+	st := w.subTaskHolder.findSubTask(req.Task)
+	if st == nil {
+		return "", terror.ErrWorkerSubTaskNotFound.Generate(req.Task)
+	}
+
+	return st.OperateSkipGTID(req.Op, req.GtidSet)
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/go-mysql-org/go-mysql/mysql"
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb-tools/pkg/filter"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.etcd.io/etcd/clientv3"
 	"go.uber.org/atomic"
@@ -34,6 +35,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/binlog"
 	"github.com/pingcap/tiflow/dm/pkg/gtid"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/router"
 	"github.com/pingcap/tiflow/dm/pkg/shardddl/pessimism"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
@@ -60,12 +62,17 @@ func createRealUnits(cfg *config.SubTaskConfig, etcdClient *clientv3.Client, wor
 	us := make([]unit.Unit, 0, 3)
 	switch cfg.Mode {
 	case config.ModeAll:
-		us = append(us, dumpling.NewDumpling(cfg))
+		// the external data is imported instead of the dumped data
+		if cfg.External == nil {
+			us = append(us, dumpling.NewDumpling(cfg))
+		}
 		us = append(us, newLoadUnit(cfg, etcdClient, workerName))
 		us = append(us, syncer.NewSyncer(cfg, etcdClient, relay))
 	case config.ModeFull:
 		// NOTE: maybe need another checker in the future?
-		us = append(us, dumpling.NewDumpling(cfg))
+		if cfg.External == nil {
+			us = append(us, dumpling.NewDumpling(cfg))
+		}
 		us = append(us, newLoadUnit(cfg, etcdClient, workerName))
 	case config.ModeIncrement:
 		us = append(us, syncer.NewSyncer(cfg, etcdClient, relay))
@@ -76,15 +83,21 @@ func createRealUnits(cfg *config.SubTaskConfig, etcdClient *clientv3.Client, wor
 }
 
 func newLoadUnit(cfg *config.SubTaskConfig, etcdClient *clientv3.Client, workerName string) unit.Unit {
+	// only tidb-lightning can read the external CSV and parquet files
+	if cfg.External != nil {
+		return loader.NewLightning(cfg, etcdClient, workerName)
+	}
 	hasAutoGenColumn := false
 	for _, rule := range cfg.RouteRules {
-		if rule.SchemaExtractor != nil || rule.TableExtractor != nil || rule.SourceExtractor != nil {
+		// tidb-lightning doesn't support the expressions in the target names of route rules
+		if rule.SchemaExtractor != nil || rule.TableExtractor != nil || rule.SourceExtractor != nil || router.HasExpression(rule) {
 			hasAutoGenColumn = true
 			break
 		}
 	}
-	// tidb-lightning doesn't support column mapping currently
-	if cfg.ImportMode == config.LoadModeLoader || cfg.OnDuplicate == config.OnDuplicateError || hasAutoGenColumn || len(cfg.ColumnMappingRules) > 0 {
+	// tidb-lightning doesn't support column mapping and rate limit currently
+	if cfg.ImportMode == config.LoadModeLoader || cfg.OnDuplicate == config.OnDuplicateError || hasAutoGenColumn || len(cfg.ColumnMappingRules) > 0 ||
+		cfg.ImportRowsPerSecond > 0 || cfg.ImportBytesPerSecond > 0 {
 		return loader.NewLoader(cfg, etcdClient, workerName)
 	}
 	return loader.NewLightning(cfg, etcdClient, workerName)
@@ -149,7 +162,7 @@ func (st *SubTask) initUnits(relay relay.Process) error {
 	// NOTE: because lightning not support init tls with raw certs bytes, we write the certs data to a file.
 	if st.cfg.NeedUseLightning() && st.cfg.To.Security != nil {
 		// NOTE: LoaderConfig.Dir is always not empty because we only dump certs when we use lightning.
-		if err := st.cfg.To.Security.DumpTLSContent(filepath.Join(st.cfg.LoaderConfig.Dir, "..")); err != nil {
+		if err := st.cfg.To.Security.DumpTLSContent(filepath.Join(st.cfg.LoaderLocalDir(), "..")); err != nil {
 			return terror.Annotatef(err, "fail to dump tls cert data for lightning, subtask %s ", st.cfg.Name)
 		}
 	}
@@ -338,7 +351,35 @@ func (st *SubTask) fetchResultAndUpdateStage(pr chan pb.ProcessResult) {
 			st.l.Error("unit process error", zap.Stringer("unit", cu.Type()), zap.Any("error information", err))
 		}
 		st.l.Info("paused", zap.Stringer("unit", cu.Type()))
+		if needRedump(result.Errors) {
+			st.prepareRedump(cu)
+		}
+	}
+}
+
+// needRedump returns whether the sync unit found the binlog from the dumped location purged in upstream and the data
+// should be dumped again.
+func needRedump(errs []*pb.ProcessError) bool {
+	for _, err := range errs {
+		if err.ErrCode == int32(terror.ErrSyncerRedumpForBinlogGap.Code()) {
+			return true
+		}
 	}
+	return false
+}
+
+// prepareRedump resets the checkpoint of the load unit and closes the sync unit, so the units are initialized again
+// from the dump unit when the subtask is resumed.
+func (st *SubTask) prepareRedump(cu unit.Unit) {
+	ctx, cancel := context.WithTimeout(st.ctx, unit.DefaultInitTimeout)
+	defer cancel()
+	if err := loader.ResetCheckpoint(ctx, st.cfg); err != nil {
+		st.l.Error("fail to reset the checkpoint of load unit to dump data again", log.ShortError(err))
+		return
+	}
+	cu.Close()
+	st.initialized.Store(false)
+	st.l.Info("the data will be dumped again after the subtask is resumed")
 }
 
 // setCurrUnit set current dm unit to ut.
@@ -581,6 +622,30 @@ func (st *SubTask) OperateSchema(ctx context.Context, req *pb.OperateWorkerSchem
 	return syncUnit.OperateSchema(ctx, req)
 }
 
+// FlushCheckpoint requests the syncer unit to flush its checkpoint at the next transaction boundary.
+func (st *SubTask) FlushCheckpoint() error {
+	if st.Stage() != pb.Stage_Running {
+		return terror.ErrWorkerNotRunningStage.Generate(st.Stage().String())
+	}
+
+	syncUnit, ok := st.CurrUnit().(*syncer.Syncer)
+	if !ok {
+		return terror.ErrWorkerOperSyncUnitOnly.Generate(st.CurrUnit().Type())
+	}
+	syncUnit.FlushCheckpoint()
+	return nil
+}
+
+// syncedEvents returns the number of binlog events processed by the sync unit, it's 0 if the subtask is not in the
+// sync unit.
+func (st *SubTask) syncedEvents() int64 {
+	syncUnit, ok := st.CurrUnit().(*syncer.Syncer)
+	if !ok {
+		return 0
+	}
+	return syncUnit.TotalEvents()
+}
+
 // UpdateFromConfig updates config for `From`.
 func (st *SubTask) UpdateFromConfig(cfg *config.SubTaskConfig) error {
 	st.Lock()
@@ -635,7 +700,9 @@ func (st *SubTask) unitTransWaitCondition(subTaskCtx context.Context) error {
 		gset2 gtid.Set
 		pos1  *mysql.Position
 		pos2  *mysql.Position
-		err   error
+		// transactions in the load end GTID sets but not relayed yet
+		missing string
+		err     error
 	)
 	pu := st.PrevUnit()
 	cu := st.CurrUnit()
@@ -679,6 +746,9 @@ func (st *SubTask) unitTransWaitCondition(subTaskCtx context.Context) error {
 				if rc <= 0 {
 					break
 				}
+				if missing, err = gtid.MissingTransactions(gset1, gset2); err != nil {
+					return terror.WithClass(err, terror.ClassDMWorker)
+				}
 			} else {
 				pos2, err = utils.DecodeBinlogPosition(relayStatus.RelayBinlog)
 				if err != nil {
@@ -689,7 +759,7 @@ func (st *SubTask) unitTransWaitCondition(subTaskCtx context.Context) error {
 				}
 			}
 
-			st.l.Debug("wait relay to catchup", zap.Bool("enableGTID", st.cfg.EnableGTID), zap.Stringer("load end position", pos1), zap.String("load end gtid", loadStatus.MetaBinlogGTID), zap.Stringer("relay position", pos2), zap.String("relay gtid", relayStatus.RelayBinlogGtid))
+			st.l.Debug("wait relay to catchup", zap.Bool("enableGTID", st.cfg.EnableGTID), zap.Stringer("load end position", pos1), zap.String("load end gtid", loadStatus.MetaBinlogGTID), zap.Stringer("relay position", pos2), zap.String("relay gtid", relayStatus.RelayBinlogGtid), zap.String("missing gtid", missing))
 
 			select {
 			case <-ctxWait.Done():
@@ -727,12 +797,126 @@ func (st *SubTask) HandleError(ctx context.Context, req *pb.HandleWorkerErrorReq
 		return "", err
 	}
 
-	if st.Stage() == pb.Stage_Paused && req.Op != pb.ErrorOp_List {
+	if st.Stage() == pb.Stage_Paused && req.Op != pb.ErrorOp_List && req.Op != pb.ErrorOp_Show {
 		err = st.Resume(relay)
 	}
 	return msg, err
 }
 
+// rateLimitUnit is the unit whose replication rate can be limited at runtime.
+type rateLimitUnit interface {
+	SetRateLimit(rowsPerSecond, bytesPerSecond int64)
+}
+
+// SetRateLimit changes the rate limit of the load and sync units of the subtask.
+func (st *SubTask) SetRateLimit(rowsPerSecond, bytesPerSecond int64) error {
+	st.RLock()
+	defer st.RUnlock()
+
+	limited := false
+	for _, u := range st.units {
+		if lu, ok := u.(rateLimitUnit); ok {
+			lu.SetRateLimit(rowsPerSecond, bytesPerSecond)
+			limited = true
+		}
+	}
+	if !limited {
+		unitType := pb.UnitType_InvalidUnit
+		if st.currUnit != nil {
+			unitType = st.currUnit.Type()
+		}
+		return terror.ErrWorkerRateLimitNotSupported.Generate(unitType, st.cfg.Name)
+	}
+	return nil
+}
+
+// skipGTIDUnit is the unit which skips the transactions by a GTID skip list.
+type skipGTIDUnit interface {
+	OperateSkipGTID(op pb.SkipGTIDOp, gtidSet string) (string, error)
+}
+
+// OperateSkipGTID lists, adds or removes the GTIDs of the transactions to skip of the sync unit, and returns the GTID
+// skip list after the operation.
+func (st *SubTask) OperateSkipGTID(op pb.SkipGTIDOp, gtidSet string) (string, error) {
+	st.RLock()
+	defer st.RUnlock()
+
+	for _, u := range st.units {
+		if su, ok := u.(skipGTIDUnit); ok {
+			return su.OperateSkipGTID(op, gtidSet)
+		}
+	}
+	return "", terror.ErrWorkerSkipGTIDNotSupported.Generate(st.cfg.Name)
+}
+
+// rulesUnit is the unit whose block-allow-list, route and binlog filter rules can be hot reloaded.
+type rulesUnit interface {
+	UpdateRules(cfg *config.SubTaskConfig) error
+}
+
+// UpdateRules hot reloads the block-allow-list, route and binlog filter rules of the sync unit.
+func (st *SubTask) UpdateRules(cfg *config.SubTaskConfig) error {
+	st.RLock()
+	defer st.RUnlock()
+
+	for _, u := range st.units {
+		if ru, ok := u.(rulesUnit); ok {
+			return ru.UpdateRules(cfg)
+		}
+	}
+	return terror.ErrWorkerRulesNotHotReloadable.Generate(st.cfg.Name)
+}
+
+// pausedTableUnit is the unit whose upstream tables can be paused and resumed separately.
+type pausedTableUnit interface {
+	OperatePausedTable(op pb.PauseTableOp, tables []*filter.Table, policy config.PauseTablePolicy) (string, error)
+}
+
+// OperatePausedTable lists, pauses or resumes the upstream tables of the sync unit, and returns the paused tables
+// after the operation.
+func (st *SubTask) OperatePausedTable(op pb.PauseTableOp, tables []*filter.Table, policy config.PauseTablePolicy) (string, error) {
+	st.RLock()
+	defer st.RUnlock()
+
+	for _, u := range st.units {
+		if pu, ok := u.(pausedTableUnit); ok {
+			return pu.OperatePausedTable(op, tables, policy)
+		}
+	}
+	return "", terror.ErrWorkerPauseTableNotSupported.Generate(st.cfg.Name)
+}
+
+// SetPriority changes the priority of the subtask.
+func (st *SubTask) SetPriority(priority string) {
+	st.Lock()
+	defer st.Unlock()
+	st.cfg.Priority = priority
+}
+
+// priorityLevel returns the level of the priority of the subtask.
+func (st *SubTask) priorityLevel() int {
+	st.RLock()
+	defer st.RUnlock()
+	return config.TaskPriorityLevel(st.cfg.Priority)
+}
+
+// throttleUnit is the unit which can be throttled by the DM-worker besides the rate limit.
+type throttleUnit interface {
+	SetThrottle(rowsPerSecond int64)
+}
+
+// setThrottle throttles the rows replicated per second of the subtask, non-positive value removes the throttle.
+func (st *SubTask) setThrottle(rowsPerSecond int64) {
+	st.RLock()
+	defer st.RUnlock()
+
+	for _, u := range st.units {
+		if tu, ok := u.(throttleUnit); ok {
+			tu.SetThrottle(rowsPerSecond)
+		}
+	}
+}
+
 func updateTaskMetric(task, sourceID string, stage pb.Stage, workerName string) {
 	if stage == pb.Stage_Stopped || stage == pb.Stage_Finished {
 		taskState.DeleteAllAboutLabels(prometheus.Labels{"task": task, "source_id": sourceID})
@@ -24,6 +24,7 @@ import (
 	"github.com/pingcap/tiflow/dm/dumpling"
 	"github.com/pingcap/tiflow/dm/loader"
 	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/dm/relay"
 	"github.com/pingcap/tiflow/dm/syncer"
@@ -73,6 +74,16 @@ func (t *testSubTask) TestCreateUnits(c *C) {
 	c.Assert(ok, IsTrue)
 	_, ok = unitsAll[2].(*syncer.Syncer)
 	c.Assert(ok, IsTrue)
+
+	// the external data is imported by lightning without dump unit
+	cfg.External = &config.ExternalDataConfig{Dir: "/data", Format: config.ExternalFormatCSV}
+	cfg.ImportMode = config.LoadModeLoader
+	unitsAll = createUnits(cfg, nil, worker, nil)
+	c.Assert(unitsAll, HasLen, 2)
+	_, ok = unitsAll[0].(*loader.LightningLoader)
+	c.Assert(ok, IsTrue)
+	_, ok = unitsAll[1].(*syncer.Syncer)
+	c.Assert(ok, IsTrue)
 }
 
 type MockUnit struct {
@@ -172,6 +183,7 @@ func (t *testSubTask) TestSubTaskNormalUsage(c *C) {
 
 	st := NewSubTask(cfg, nil, "worker")
 	c.Assert(st.Stage(), DeepEquals, pb.Stage_New)
+	c.Assert(terror.ErrWorkerNotRunningStage.Equal(st.FlushCheckpoint()), IsTrue)
 
 	// test empty and fail
 	defer func() {
@@ -194,6 +206,7 @@ func (t *testSubTask) TestSubTaskNormalUsage(c *C) {
 	c.Assert(st.Stage(), Equals, pb.Stage_Running)
 	c.Assert(st.CurrUnit(), Equals, mockDumper)
 	c.Assert(st.Result(), IsNil)
+	c.Assert(terror.ErrWorkerOperSyncUnitOnly.Equal(st.FlushCheckpoint()), IsTrue)
 
 	// finish dump
 	c.Assert(mockDumper.InjectProcessError(context.Background(), nil), IsNil)
@@ -520,3 +533,94 @@ func (t *testSubTask) TestSubtaskFastQuit(c *C) {
 	}
 	c.Assert(st.Stage(), Equals, pb.Stage_Stopped)
 }
+
+func (t *testSubTask) TestSubTaskSetRateLimit(c *C) {
+	cfg := &config.SubTaskConfig{
+		Name: "testSubtaskScene",
+		Mode: config.ModeAll,
+	}
+	st := NewSubTask(cfg, nil, "worker")
+	st.units = []unit.Unit{NewMockUnit(pb.UnitType_Dump)}
+	st.currUnit = st.units[0]
+	err := st.SetRateLimit(100, 1024)
+	c.Assert(terror.ErrWorkerRateLimitNotSupported.Equal(err), IsTrue)
+
+	st.units = append(st.units, loader.NewLoader(cfg, nil, "worker"), syncer.NewSyncer(cfg, nil, nil))
+	c.Assert(st.SetRateLimit(100, 1024), IsNil)
+	c.Assert(st.SetRateLimit(0, 0), IsNil)
+}
+
+func (t *testSubTask) TestSubTaskOperateSkipGTID(c *C) {
+	cfg := &config.SubTaskConfig{
+		Name: "testSubtaskScene",
+		Mode: config.ModeFull,
+	}
+	st := NewSubTask(cfg, nil, "worker")
+	st.units = []unit.Unit{NewMockUnit(pb.UnitType_Dump), NewMockUnit(pb.UnitType_Load)}
+	_, err := st.OperateSkipGTID(pb.SkipGTIDOp_ListSkipGTID, "")
+	c.Assert(terror.ErrWorkerSkipGTIDNotSupported.Equal(err), IsTrue)
+
+	// the sync unit without GTID enabled rejects the operation
+	st.units = append(st.units, syncer.NewSyncer(cfg, nil, nil))
+	_, err = st.OperateSkipGTID(pb.SkipGTIDOp_ListSkipGTID, "")
+	c.Assert(terror.ErrSyncerGTIDSkipListNotSupported.Equal(err), IsTrue)
+}
+
+func (t *testSubTask) TestSubTaskUpdateRules(c *C) {
+	cfg := &config.SubTaskConfig{
+		Name: "testSubtaskScene",
+		Mode: config.ModeFull,
+	}
+	st := NewSubTask(cfg, nil, "worker")
+	st.units = []unit.Unit{NewMockUnit(pb.UnitType_Dump), NewMockUnit(pb.UnitType_Load)}
+	c.Assert(terror.ErrWorkerRulesNotHotReloadable.Equal(st.UpdateRules(cfg)), IsTrue)
+
+	// the sync unit in shard mode rejects the rules
+	cfg.ShardMode = config.ShardPessimistic
+	st.units = append(st.units, syncer.NewSyncer(cfg, nil, nil))
+	c.Assert(terror.ErrSyncerRulesNotHotReloadable.Equal(st.UpdateRules(cfg)), IsTrue)
+}
+
+func (t *testSubTask) TestSubTaskOperatePausedTable(c *C) {
+	cfg := &config.SubTaskConfig{
+		Name: "testSubtaskScene",
+		Mode: config.ModeFull,
+	}
+	st := NewSubTask(cfg, nil, "worker")
+	st.units = []unit.Unit{NewMockUnit(pb.UnitType_Dump), NewMockUnit(pb.UnitType_Load)}
+	_, err := st.OperatePausedTable(pb.PauseTableOp_ListPausedTable, nil, "")
+	c.Assert(terror.ErrWorkerPauseTableNotSupported.Equal(err), IsTrue)
+
+	// the sync unit in shard mode rejects the operation
+	cfg.ShardMode = config.ShardPessimistic
+	st.units = append(st.units, syncer.NewSyncer(cfg, nil, nil))
+	_, err = st.OperatePausedTable(pb.PauseTableOp_ListPausedTable, nil, "")
+	c.Assert(terror.ErrSyncerPauseTableNotSupported.Equal(err), IsTrue)
+}
+
+func (t *testSubTask) TestSubTaskPriority(c *C) {
+	cfg := &config.SubTaskConfig{
+		Name: "testSubtaskScene",
+		Mode: config.ModeIncrement,
+	}
+	st := NewSubTask(cfg, nil, "worker")
+	c.Assert(st.priorityLevel(), Equals, config.TaskPriorityLevel(config.TaskPriorityNormal))
+	st.SetPriority(config.TaskPriorityLow)
+	c.Assert(st.priorityLevel(), Equals, config.TaskPriorityLevel(config.TaskPriorityLow))
+
+	// the units not supporting throttle are ignored
+	st.units = []unit.Unit{NewMockUnit(pb.UnitType_Load), syncer.NewSyncer(cfg, nil, nil)}
+	st.setThrottle(100)
+	st.setThrottle(0)
+}
+
+func (t *testSubTask) TestNeedRedump(c *C) {
+	c.Assert(needRedump(nil), IsFalse)
+	c.Assert(needRedump([]*pb.ProcessError{
+		unit.NewProcessError(terror.ErrSyncerBinlogGap.Generate("position: (mysql-bin.000001, 4)", "binlog file mysql-bin.000001 is purged")),
+	}), IsFalse)
+	c.Assert(needRedump([]*pb.ProcessError{
+		unit.NewProcessError(errors.New("connection refused")),
+		unit.NewProcessError(terror.ErrSyncerRedumpForBinlogGap.Generate("position: (mysql-bin.000001, 4)", "binlog file mysql-bin.000001 is purged")),
+	}), IsTrue)
+}
@@ -15,7 +15,6 @@ package dumpling
 
 import (
 	"context"
-	"os"
 	"strings"
 	"sync"
 	"time"
@@ -35,6 +34,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/conn"
 	dutils "github.com/pingcap/tiflow/dm/pkg/dumpling"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/storage"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 )
@@ -46,9 +46,11 @@ type Dumpling struct {
 	logger log.Logger
 
 	dumpConfig *export.Config
-	closed     atomic.Bool
-	core       *export.Dumper
-	mu         sync.RWMutex
+	// the configs of the dump passes split by the conditions of the tables, see splitByTableWheres.
+	passConfigs []*export.Config
+	closed      atomic.Bool
+	core        *export.Dumper
+	mu          sync.RWMutex
 }
 
 // NewDumpling creates a new Dumpling.
@@ -66,7 +68,10 @@ func (m *Dumpling) Init(ctx context.Context) error {
 	if m.dumpConfig, err = m.constructArgs(ctx); err != nil {
 		return err
 	}
-	m.logger.Info("create dumpling", zap.Stringer("config", m.dumpConfig))
+	if m.passConfigs, err = splitByTableWheres(m.dumpConfig, m.cfg.TableWheres, m.cfg.CaseSensitive); err != nil {
+		return err
+	}
+	m.logger.Info("create dumpling", zap.Stringer("config", m.dumpConfig), zap.Int("passes", len(m.passConfigs)))
 	return nil
 }
 
@@ -102,7 +107,7 @@ func (m *Dumpling) Process(ctx context.Context, pr chan pb.ProcessResult) {
 
 	// NOTE: remove output dir before start dumping
 	// every time re-dump, loader should re-prepare
-	err := os.RemoveAll(m.cfg.Dir)
+	err := storage.RemoveAll(ctx, m.cfg.Dir)
 	if err != nil {
 		m.logger.Error("fail to remove output directory", zap.String("directory", m.cfg.Dir), log.ShortError(err))
 		errs = append(errs, unit.NewProcessError(terror.ErrDumpUnitRuntime.Delegate(err, "fail to remove output directory: "+m.cfg.Dir)))
@@ -119,15 +124,7 @@ func (m *Dumpling) Process(ctx context.Context, pr chan pb.ProcessResult) {
 	})
 
 	newCtx, cancel := context.WithCancel(ctx)
-	var dumpling *export.Dumper
-
-	if dumpling, err = export.NewDumper(newCtx, m.dumpConfig); err == nil {
-		m.mu.Lock()
-		m.core = dumpling
-		m.mu.Unlock()
-		err = dumpling.Dump()
-		dumpling.Close()
-	}
+	err = m.dump(newCtx)
 	cancel()
 
 	if err != nil {
@@ -164,6 +161,43 @@ func (m *Dumpling) Process(ctx context.Context, pr chan pb.ProcessResult) {
 	}
 }
 
+// dump dumps the data by the passes in sequence, and merges the metadata of them if there are several passes.
+func (m *Dumpling) dump(ctx context.Context) error {
+	metas := make([]string, 0, len(m.passConfigs))
+	for i, passCfg := range m.passConfigs {
+		if len(m.passConfigs) > 1 {
+			m.logger.Info("start dump pass", zap.Int("pass", i), zap.String("where", passCfg.Where))
+		}
+		dumpling, err := export.NewDumper(ctx, passCfg)
+		if err != nil {
+			return err
+		}
+		m.mu.Lock()
+		m.core = dumpling
+		m.mu.Unlock()
+		err = dumpling.Dump()
+		dumpling.Close()
+		if err != nil {
+			return err
+		}
+		if len(m.passConfigs) > 1 {
+			meta, err := storage.ReadFile(ctx, m.cfg.Dir, metadataFile)
+			if err != nil {
+				return terror.ErrDumpUnitRuntime.Delegate(err, "fail to read metadata of dump pass")
+			}
+			metas = append(metas, string(meta))
+		}
+	}
+	if len(metas) == 0 {
+		return nil
+	}
+	merged := mergeMetadata(metas, m.passConfigs)
+	if err := storage.WriteFile(ctx, m.cfg.Dir, metadataFile, []byte(merged)); err != nil {
+		return terror.ErrDumpUnitRuntime.Delegate(err, "fail to write merged metadata")
+	}
+	return nil
+}
+
 // Close implements Unit.Close.
 func (m *Dumpling) Close() {
 	if m.closed.Load() {
@@ -285,6 +319,12 @@ func (m *Dumpling) constructArgs(ctx context.Context) (*export.Config, error) {
 	if len(cfg.Where) > 0 {
 		dumpConfig.Where = cfg.Where
 	}
+	if len(cfg.Compress) > 0 {
+		dumpConfig.CompressType, err = export.ParseCompressType(cfg.Compress)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if db.Security != nil {
 		dumpConfig.Security.CAPath = db.Security.SSLCA
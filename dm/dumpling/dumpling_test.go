@@ -23,6 +23,7 @@ import (
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb-tools/pkg/filter"
 	tfilter "github.com/pingcap/tidb-tools/pkg/table-filter"
+	"github.com/pingcap/tidb/br/pkg/storage"
 	"github.com/pingcap/tidb/dumpling/export"
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -190,6 +191,7 @@ func (t *testDumplingSuite) TestConstructArgs(c *C) {
 
 	cfg := &config.SubTaskConfig{}
 	cfg.ExtraArgs = `--statement-size=100 --where "t>10" --threads 8 -F 50B`
+	cfg.Compress = config.DumpCompressGzip
 	d := NewDumpling(cfg)
 	exportCfg, err := d.constructArgs(ctx)
 	c.Assert(err, IsNil)
@@ -199,4 +201,5 @@ func (t *testDumplingSuite) TestConstructArgs(c *C) {
 	c.Assert(exportCfg.FileSize, Equals, uint64(50))
 	c.Assert(exportCfg.SessionParams, NotNil)
 	c.Assert(exportCfg.SessionParams["time_zone"], Equals, "+01:00")
+	c.Assert(exportCfg.CompressType, Equals, storage.Gzip)
 }
@@ -0,0 +1,163 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dumpling
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	filter "github.com/pingcap/tidb-tools/pkg/table-filter"
+	"github.com/pingcap/tidb/dumpling/export"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+const (
+	// metadataFile is the metadata file written by dumpling.
+	metadataFile            = "metadata"
+	metadataAfterConnHeader = "SHOW MASTER STATUS: /* AFTER CONNECTION POOL ESTABLISHED */"
+	metadataFinishedPrefix  = "Finished dump at:"
+	metadataWhereHeader     = "TABLE WHERE CONDITIONS:"
+)
+
+// tableWhereFilter matches the tables dumped with the same condition, which are the tables matched by the filter of
+// the task and `include`, but not matched by `exclude`.
+type tableWhereFilter struct {
+	// the filter of the task, the unexported method of filter.Filter is promoted from it too, so don't make this
+	// filter case-insensitive again, the filters should be case-insensitive already if needed.
+	filter.Filter
+
+	include filter.Filter // nil means all tables
+	exclude []filter.Filter
+}
+
+// MatchTable implements filter.Filter.
+func (f *tableWhereFilter) MatchTable(schema, table string) bool {
+	if !f.Filter.MatchTable(schema, table) {
+		return false
+	}
+	if f.include != nil && !f.include.MatchTable(schema, table) {
+		return false
+	}
+	for _, e := range f.exclude {
+		if e.MatchTable(schema, table) {
+			return false
+		}
+	}
+	return true
+}
+
+// combineWhere combines the global condition and the condition of the tables.
+func combineWhere(global, where string) string {
+	if len(global) == 0 {
+		return where
+	}
+	return fmt.Sprintf("(%s) AND (%s)", global, where)
+}
+
+// splitByTableWheres splits the dump config into several configs by the conditions of the tables, each config dumps
+// the tables with the same condition, and the last one dumps the tables without conditions. they are dumped in
+// sequence, so the data of the tables are not in the same snapshot, the location after connection pool established
+// is recorded to enable safe mode in sync unit until all data are consistent.
+func splitByTableWheres(base *export.Config, tableWheres []*config.TableWhere, caseSensitive bool) ([]*export.Config, error) {
+	if len(tableWheres) == 0 {
+		return []*export.Config{base}, nil
+	}
+
+	filters := make([]filter.Filter, 0, len(tableWheres))
+	for _, tw := range tableWheres {
+		f, err := filter.ParseMySQLReplicationRules(&filter.MySQLReplicationRules{
+			DoTables: []*filter.Table{{Schema: tw.Schema, Name: tw.Table}},
+		})
+		if err != nil {
+			return nil, terror.ErrConfigInvalidTableWhere.Delegate(err, fmt.Sprintf("invalid pattern %s.%s", tw.Schema, tw.Table))
+		}
+		if !caseSensitive {
+			f = filter.CaseInsensitive(f)
+		}
+		filters = append(filters, f)
+	}
+
+	configs := make([]*export.Config, 0, len(tableWheres)+1)
+	for i, tw := range tableWheres {
+		cfg := *base
+		cfg.TableFilter = &tableWhereFilter{Filter: base.TableFilter, include: filters[i], exclude: filters[:i]}
+		cfg.Where = combineWhere(base.Where, tw.Where)
+		cfg.PosAfterConnect = true
+		configs = append(configs, &cfg)
+	}
+	cfg := *base
+	cfg.TableFilter = &tableWhereFilter{Filter: base.TableFilter, exclude: filters}
+	cfg.PosAfterConnect = true
+	configs = append(configs, &cfg)
+	return configs, nil
+}
+
+// splitMetadata splits the metadata written by dumpling into the location after connection pool established, the
+// finish time and the rest part.
+func splitMetadata(meta string) (rest, afterConn, finished string) {
+	var restB, afterConnB strings.Builder
+	inAfterConn := false
+	for _, line := range strings.SplitAfter(meta, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == metadataAfterConnHeader:
+			inAfterConn = true
+			afterConnB.WriteString(line)
+		case inAfterConn:
+			afterConnB.WriteString(line)
+			if len(trimmed) == 0 {
+				inAfterConn = false
+			}
+		case strings.HasPrefix(trimmed, metadataFinishedPrefix):
+			finished = line
+		default:
+			restB.WriteString(line)
+		}
+	}
+	return restB.String(), afterConnB.String(), finished
+}
+
+// mergeMetadata merges the metadata of the dump passes, the location of the first pass is used to start syncing and
+// the location after connection pool established of the last pass is used to exit safe mode. the conditions of the
+// dumped tables are recorded too.
+func mergeMetadata(metas []string, configs []*export.Config) string {
+	first, _, _ := splitMetadata(metas[0])
+	_, afterConn, finished := splitMetadata(metas[len(metas)-1])
+
+	var b strings.Builder
+	b.WriteString(first)
+	b.WriteString(afterConn)
+	b.WriteString(metadataWhereHeader + "\n")
+	for _, cfg := range configs {
+		if len(cfg.Where) == 0 {
+			continue
+		}
+		tables := make([]string, 0)
+		for schema, infos := range cfg.Tables {
+			for _, info := range infos {
+				tables = append(tables, fmt.Sprintf("`%s`.`%s`", schema, info.Name))
+			}
+		}
+		sort.Strings(tables)
+		for _, table := range tables {
+			fmt.Fprintf(&b, "\t%s: %s\n", table, cfg.Where)
+		}
+	}
+	b.WriteString("\n")
+	b.WriteString(finished)
+	return b.String()
+}
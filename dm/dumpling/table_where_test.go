@@ -0,0 +1,142 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dumpling
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/pingcap/check"
+	tfilter "github.com/pingcap/tidb-tools/pkg/table-filter"
+	"github.com/pingcap/tidb/dumpling/export"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	dutils "github.com/pingcap/tiflow/dm/pkg/dumpling"
+)
+
+var _ = Suite(&testTableWhereSuite{})
+
+type testTableWhereSuite struct{}
+
+func (t *testTableWhereSuite) TestSplitByTableWheres(c *C) {
+	base := export.DefaultConfig()
+	var err error
+	base.TableFilter, err = tfilter.Parse([]string{"shop*.*", "!shop.ignored"})
+	c.Assert(err, IsNil)
+	base.TableFilter = tfilter.CaseInsensitive(base.TableFilter)
+	base.Where = "id > 0"
+
+	configs, err := splitByTableWheres(base, nil, false)
+	c.Assert(err, IsNil)
+	c.Assert(configs, DeepEquals, []*export.Config{base})
+
+	tableWheres := []*config.TableWhere{
+		{Schema: "shop", Table: "orders", Where: "created_at > '2022-01-01'"},
+		{Schema: "~^shop", Table: "~^order", Where: "created_at > '2021-01-01'"},
+	}
+	configs, err = splitByTableWheres(base, tableWheres, false)
+	c.Assert(err, IsNil)
+	c.Assert(configs, HasLen, 3)
+	c.Assert(configs[0].Where, Equals, "(id > 0) AND (created_at > '2022-01-01')")
+	c.Assert(configs[1].Where, Equals, "(id > 0) AND (created_at > '2021-01-01')")
+	c.Assert(configs[2].Where, Equals, "id > 0")
+
+	cases := []struct {
+		schema, table string
+		pass          int // -1 means not dumped
+	}{
+		{"shop", "orders", 0},
+		{"SHOP", "Orders", 0},
+		{"shop", "order_items", 1},
+		{"shop_1", "orders", 1},
+		{"shop", "users", 2},
+		{"shop", "ignored", -1},
+		{"other", "orders", -1},
+	}
+	for _, cs := range cases {
+		for i, cfg := range configs {
+			c.Assert(cfg.TableFilter.MatchTable(cs.schema, cs.table), Equals, i == cs.pass, Commentf("%s.%s in pass %d", cs.schema, cs.table, i))
+			c.Assert(cfg.PosAfterConnect, IsTrue)
+		}
+	}
+	// the base config is not changed
+	c.Assert(base.Where, Equals, "id > 0")
+	c.Assert(base.TableFilter.MatchTable("shop", "orders"), IsTrue)
+
+	_, err = splitByTableWheres(base, []*config.TableWhere{{Schema: "~(", Table: "t", Where: "a > 1"}}, false)
+	c.Assert(err, NotNil)
+}
+
+func (t *testTableWhereSuite) TestMergeMetadata(c *C) {
+	metas := []string{
+		`Started dump at: 2022-01-01 00:00:00
+SHOW MASTER STATUS:
+	Log: mysql-bin.000001
+	Pos: 100
+	GTID:
+
+SHOW MASTER STATUS: /* AFTER CONNECTION POOL ESTABLISHED */
+	Log: mysql-bin.000001
+	Pos: 200
+	GTID:
+
+Finished dump at: 2022-01-01 00:01:00
+`,
+		`Started dump at: 2022-01-01 00:01:00
+SHOW MASTER STATUS:
+	Log: mysql-bin.000001
+	Pos: 300
+	GTID:
+
+SHOW MASTER STATUS: /* AFTER CONNECTION POOL ESTABLISHED */
+	Log: mysql-bin.000002
+	Pos: 400
+	GTID:
+
+Finished dump at: 2022-01-01 00:02:00
+`,
+	}
+	configs := []*export.Config{
+		{Where: "created_at > '2022-01-01'", Tables: export.DatabaseTables{}.AppendTables("shop", []string{"orders", "items"}, []uint64{0, 0})},
+		{Where: "", Tables: export.DatabaseTables{}.AppendTables("shop", []string{"users"}, []uint64{0})},
+	}
+	merged := mergeMetadata(metas, configs)
+	c.Assert(merged, Equals, `Started dump at: 2022-01-01 00:00:00
+SHOW MASTER STATUS:
+	Log: mysql-bin.000001
+	Pos: 100
+	GTID:
+
+SHOW MASTER STATUS: /* AFTER CONNECTION POOL ESTABLISHED */
+	Log: mysql-bin.000002
+	Pos: 400
+	GTID:
+
+TABLE WHERE CONDITIONS:
+	`+"`shop`.`items`"+`: created_at > '2022-01-01'
+	`+"`shop`.`orders`"+`: created_at > '2022-01-01'
+
+Finished dump at: 2022-01-01 00:02:00
+`)
+
+	// the merged metadata can be parsed by sync unit
+	filename := filepath.Join(c.MkDir(), metadataFile)
+	c.Assert(os.WriteFile(filename, []byte(merged), 0o644), IsNil)
+	loc, loc2, err := dutils.ParseMetaData(filename, "mysql")
+	c.Assert(err, IsNil)
+	c.Assert(loc.Position.Name, Equals, "mysql-bin.000001")
+	c.Assert(loc.Position.Pos, Equals, uint32(100))
+	c.Assert(loc2.Position.Name, Equals, "mysql-bin.000002")
+	c.Assert(loc2.Position.Pos, Equals, uint32(400))
+}
@@ -0,0 +1,224 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/pingcap/errors"
+	tmysql "github.com/pingcap/tidb/parser/mysql"
+	"go.uber.org/atomic"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+const (
+	// the bad-row files are not in the dump dir, which may be removed after the data is imported.
+	badRowDirSuffix = ".bad-rows"
+	// the prefix of the line of the error before a rejected row in the bad-row files.
+	badRowErrorPrefix = "-- "
+)
+
+// rejectedRowErrors are the errors of the rows which can't be imported by downstream because of their values, other
+// errors like the connection errors fail the task as usual.
+var rejectedRowErrors = map[uint16]struct{}{
+	tmysql.ErrBadNull:                     {},
+	tmysql.ErrWrongValueCountOnRow:        {},
+	tmysql.ErrWarnDataOutOfRange:          {},
+	tmysql.ErrTruncatedWrongValue:         {},
+	tmysql.ErrInvalidCharacterString:      {},
+	tmysql.ErrTruncatedWrongValueForField: {},
+	tmysql.ErrDataTooLong:                 {},
+	tmysql.ErrNoReferencedRow2:            {},
+	tmysql.ErrDataOutOfRange:              {},
+	tmysql.ErrInvalidJSONText:             {},
+}
+
+func isRejectedRowError(err error) bool {
+	e, ok := errors.Cause(err).(*mysql.MySQLError)
+	if !ok {
+		return false
+	}
+	_, ok = rejectedRowErrors[e.Number]
+	return ok
+}
+
+// rejectedRow is a row rejected by downstream.
+type rejectedRow struct {
+	query string
+	err   error
+}
+
+// badRowDir returns the dir of the bad-row files of the subtask.
+func badRowDir(cfg *config.SubTaskConfig) string {
+	return filepath.Clean(cfg.Dir) + badRowDirSuffix
+}
+
+// badRowRecorder writes the rows rejected by downstream to the bad-row files, a file for a target table, and limits
+// the number of rejected rows by max-error.
+type badRowRecorder struct {
+	dir      string
+	maxError int64
+	count    atomic.Int64
+
+	mu sync.Mutex // protects the bad-row files
+}
+
+// newBadRowRecorder creates a badRowRecorder, the rows recorded before are counted if the task is resumed, otherwise
+// they're removed.
+func newBadRowRecorder(dir string, maxError int64, fresh bool) (*badRowRecorder, error) {
+	r := &badRowRecorder{dir: dir, maxError: maxError}
+	if fresh {
+		if err := os.RemoveAll(dir); err != nil {
+			return nil, errors.Annotatef(err, "remove bad-row dir %s", dir)
+		}
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, errors.Annotatef(err, "create bad-row dir %s", dir)
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Annotatef(err, "read bad-row dir %s", dir)
+	}
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		count, err := countBadRows(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		r.count.Add(count)
+	}
+	return r, nil
+}
+
+// countBadRows counts the rejected rows in a bad-row file.
+func countBadRows(file string) (int64, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return 0, errors.Annotatef(err, "open bad-row file %s", file)
+	}
+	defer f.Close()
+
+	var count int64
+	br := bufio.NewReader(f)
+	for {
+		line, err := br.ReadString('\n')
+		if strings.HasPrefix(line, badRowErrorPrefix) {
+			count++
+		}
+		if err != nil {
+			break
+		}
+	}
+	return count, nil
+}
+
+// reserve takes a row from the budget of max-error, it returns false if the budget is exhausted.
+func (r *badRowRecorder) reserve() bool {
+	for {
+		count := r.count.Load()
+		if count >= r.maxError {
+			return false
+		}
+		if r.count.CAS(count, count+1) {
+			return true
+		}
+	}
+}
+
+// release returns the reserved rows to the budget, it's called if the rejected rows are not recorded.
+func (r *badRowRecorder) release(n int) {
+	r.count.Sub(int64(n))
+}
+
+// rejectedCount returns the number of rejected rows.
+func (r *badRowRecorder) rejectedCount() int64 {
+	if r == nil {
+		return 0
+	}
+	return r.count.Load()
+}
+
+// record appends the rejected rows of the target table to its bad-row file, each row is preceded by its error.
+func (r *badRowRecorder) record(schema, table string, rows []rejectedRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	for _, row := range rows {
+		// keep the error in one line
+		msg := strings.Join(strings.Fields(errors.Cause(row.err).Error()), " ")
+		fmt.Fprintf(&buf, "%s%s\n%s\n", badRowErrorPrefix, msg, row.query)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	file := filepath.Join(r.dir, fmt.Sprintf("%s.%s.sql", schema, table))
+	f, err := os.OpenFile(file, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return errors.Annotatef(err, "open bad-row file %s", file)
+	}
+	defer f.Close()
+	_, err = f.Write(buf.Bytes())
+	return errors.Annotatef(err, "write bad-row file %s", file)
+}
+
+// executeRowByRow executes the rows of the job one by one in a transaction after the job is rejected by downstream.
+// the rejected rows are removed from the transaction and written to the bad-row file after it's committed, until the
+// budget of max-error is exhausted. it returns the number of rejected rows.
+func (w *Worker) executeRowByRow(tctx *tcontext.Context, job *dataJob, useSQL, offsetSQL string) (int, error) {
+	rows, err := parseInsertStmt([]byte(job.data), job.info, w.loader.columnMapping)
+	if err != nil {
+		return 0, err
+	}
+	queries := make([]string, 0, len(rows)+2)
+	queries = append(queries, useSQL)
+	for _, row := range rows {
+		queries = append(queries, fmt.Sprintf("%s(%s);", job.info.insertHeadStmt, strings.Join(row, ",")))
+	}
+	queries = append(queries, offsetSQL)
+
+	badRows := w.loader.badRows
+	rejected := make([]rejectedRow, 0, 1)
+	for {
+		idx, err := w.conn.executeSQLWithFailedIndex(tctx, queries)
+		if err == nil {
+			break
+		}
+		// only the INSERT statements can be rejected
+		if idx <= 0 || idx >= len(queries)-1 || !isRejectedRowError(err) {
+			badRows.release(len(rejected))
+			return 0, err
+		}
+		if !badRows.reserve() {
+			badRows.release(len(rejected))
+			return 0, terror.ErrLoadUnitExceedMaxError.Delegate(err, badRows.maxError)
+		}
+		rejected = append(rejected, rejectedRow{query: queries[idx], err: err})
+		queries = append(queries[:idx], queries[idx+1:]...)
+	}
+	return len(rejected), badRows.record(job.schema, job.table, rejected)
+}
@@ -0,0 +1,154 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+var _ = Suite(&testBadRowsSuite{})
+
+type testBadRowsSuite struct{}
+
+func (t *testBadRowsSuite) TestIsRejectedRowError(c *C) {
+	c.Assert(isRejectedRowError(&mysql.MySQLError{Number: 1366, Message: "Incorrect string value"}), IsTrue)
+	c.Assert(isRejectedRowError(terror.ErrDBExecuteFailed.Delegate(&mysql.MySQLError{Number: 1264}, "INSERT")), IsTrue)
+	c.Assert(isRejectedRowError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"}), IsFalse)
+	c.Assert(isRejectedRowError(errors.New("invalid connection")), IsFalse)
+}
+
+func (t *testBadRowsSuite) TestBadRowRecorder(c *C) {
+	dir := filepath.Join(c.MkDir(), "bad-rows")
+	r, err := newBadRowRecorder(dir, 3, true)
+	c.Assert(err, IsNil)
+	c.Assert(r.rejectedCount(), Equals, int64(0))
+
+	c.Assert(r.reserve(), IsTrue)
+	c.Assert(r.reserve(), IsTrue)
+	c.Assert(r.record("shop", "orders", []rejectedRow{
+		{query: "INSERT INTO `orders` VALUES(1,'a');", err: &mysql.MySQLError{Number: 1366, Message: "Incorrect string value\nfor column"}},
+		{query: "INSERT INTO `orders` VALUES(2,'b');", err: &mysql.MySQLError{Number: 1264, Message: "Out of range value"}},
+	}), IsNil)
+	content, err := os.ReadFile(filepath.Join(dir, "shop.orders.sql"))
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Equals, "-- Error 1366: Incorrect string value for column\nINSERT INTO `orders` VALUES(1,'a');\n"+
+		"-- Error 1264: Out of range value\nINSERT INTO `orders` VALUES(2,'b');\n")
+
+	c.Assert(r.reserve(), IsTrue)
+	c.Assert(r.reserve(), IsFalse)
+	r.release(1)
+	c.Assert(r.rejectedCount(), Equals, int64(2))
+
+	// the recorded rows are counted when resuming
+	r, err = newBadRowRecorder(dir, 3, false)
+	c.Assert(err, IsNil)
+	c.Assert(r.rejectedCount(), Equals, int64(2))
+	// and removed for a fresh task
+	r, err = newBadRowRecorder(dir, 3, true)
+	c.Assert(err, IsNil)
+	c.Assert(r.rejectedCount(), Equals, int64(0))
+	_, err = os.Stat(filepath.Join(dir, "shop.orders.sql"))
+	c.Assert(os.IsNotExist(err), IsTrue)
+
+	var nilRecorder *badRowRecorder
+	c.Assert(nilRecorder.rejectedCount(), Equals, int64(0))
+}
+
+func (t *testBadRowsSuite) TestExecuteRowByRow(c *C) {
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	dbConn, err := db.Conn(context.Background())
+	c.Assert(err, IsNil)
+
+	dir := c.MkDir()
+	badRows, err := newBadRowRecorder(dir, 2, true)
+	c.Assert(err, IsNil)
+	w := &Worker{
+		conn:   &DBConn{baseConn: conn.NewBaseConn(dbConn, nil)},
+		loader: &Loader{badRows: badRows},
+		logger: log.L(),
+	}
+	job := &dataJob{
+		schema: "shop",
+		table:  "orders",
+		data:   "INSERT INTO `orders_1` VALUES\n(1,'a'),\n(2,'b'),\n(3,'c'),\n(4,'d');\n",
+		info: &tableInfo{
+			sourceTable:    "orders_1",
+			targetTable:    "orders",
+			columnNameList: []string{"id", "name"},
+			insertHeadStmt: "INSERT INTO `orders` VALUES",
+		},
+	}
+	tctx := tcontext.Background()
+	useSQL, offsetSQL := "USE `shop`;", "UPDATE checkpoint SET offset=100"
+	insert := func(row string) string {
+		return regexp.QuoteMeta("INSERT INTO `orders` VALUES(" + row + ");")
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(useSQL).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(insert("1,'a'")).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(insert("2,'b'")).WillReturnError(&mysql.MySQLError{Number: 1366, Message: "Incorrect string value"})
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectExec(useSQL).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(insert("1,'a'")).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(insert("3,'c'")).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(insert("4,'d'")).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(offsetSQL).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	rejected, err := w.executeRowByRow(tctx, job, useSQL, offsetSQL)
+	c.Assert(err, IsNil)
+	c.Assert(rejected, Equals, 1)
+	c.Assert(badRows.rejectedCount(), Equals, int64(1))
+	content, err := os.ReadFile(filepath.Join(dir, "shop.orders.sql"))
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Equals, "-- Error 1366: Incorrect string value\nINSERT INTO `orders` VALUES(2,'b');\n")
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// the budget is exhausted
+	mock.ExpectBegin()
+	mock.ExpectExec(useSQL).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(insert("1,'a'")).WillReturnError(&mysql.MySQLError{Number: 1264, Message: "Out of range value"})
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectExec(useSQL).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(insert("2,'b'")).WillReturnError(&mysql.MySQLError{Number: 1366, Message: "Incorrect string value"})
+	mock.ExpectRollback()
+	_, err = w.executeRowByRow(tctx, job, useSQL, offsetSQL)
+	c.Assert(terror.ErrLoadUnitExceedMaxError.Equal(err), IsTrue)
+	c.Assert(badRows.rejectedCount(), Equals, int64(1))
+
+	// other errors are returned directly
+	mock.ExpectBegin()
+	mock.ExpectExec(useSQL).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(insert("1,'a'")).WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
+	mock.ExpectRollback()
+	_, err = w.executeRowByRow(tctx, job, useSQL, offsetSQL)
+	c.Assert(err, ErrorMatches, ".*Duplicate entry.*")
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+}
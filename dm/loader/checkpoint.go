@@ -15,6 +15,7 @@ package loader
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -28,6 +29,7 @@ import (
 	fr "github.com/pingcap/tiflow/dm/pkg/func-rollback"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
 
 	"github.com/pingcap/tidb-tools/pkg/dbutil"
 	"go.uber.org/zap"
@@ -46,6 +48,10 @@ type CheckPoint interface {
 	// GetAllRestoringFileInfo return all restoring files position
 	GetAllRestoringFileInfo() map[string][]int64
 
+	// GetChecksum returns the checksum of the imported part of the data file, which is the part before the offset.
+	// returns false if the checksum is not recorded, like the checkpoints saved by older versions
+	GetChecksum(filename string) (uint32, bool)
+
 	// IsTableCreated checks if db / table was created. set `table` to "" when check db
 	IsTableCreated(db, table string) bool
 
@@ -71,11 +77,11 @@ type CheckPoint interface {
 	Count(tctx *tcontext.Context) (int, error)
 
 	// GenSQL generates sql to update checkpoint to DB
-	GenSQL(filename string, offset int64) string
+	GenSQL(filename string, offset int64, checksum uint32) string
 
 	// UpdateOffset keeps `cp.restoringFiles` in memory same with checkpoint in DB,
 	// should be called after update checkpoint in DB
-	UpdateOffset(filename string, offset int64) error
+	UpdateOffset(filename string, offset int64, checksum uint32) error
 
 	// AllFinished returns `true` when all restoring job are finished
 	AllFinished() bool
@@ -95,7 +101,8 @@ type RemoteCheckPoint struct {
 	tableName      string // tableName contains schema name
 	restoringFiles struct {
 		sync.RWMutex
-		pos map[string]map[string]FilePosSet // schema -> table -> FilePosSet(filename -> [cur, end])
+		pos       map[string]map[string]FilePosSet // schema -> table -> FilePosSet(filename -> [cur, end])
+		checksums map[string]uint32                // filename -> checksum of the data before cur
 	}
 	finishedTables map[string]struct{}
 	logger         log.Logger
@@ -128,6 +135,7 @@ func newRemoteCheckPoint(tctx *tcontext.Context, cfg *config.SubTaskConfig, id s
 		logger:         tctx.L().WithFields(zap.String("component", "remote checkpoint")),
 	}
 	cp.restoringFiles.pos = make(map[string]map[string]FilePosSet)
+	cp.restoringFiles.checksums = make(map[string]uint32)
 	rollbackHolder.Add(fr.FuncRollback{Name: "CloseRemoteCheckPoint", Fn: cp.Close})
 
 	err = cp.prepare(tctx)
@@ -144,7 +152,10 @@ func (cp *RemoteCheckPoint) prepare(tctx *tcontext.Context) error {
 		return err
 	}
 	// create table
-	return cp.createTable(tctx)
+	if err := cp.createTable(tctx); err != nil {
+		return err
+	}
+	return cp.addChecksumColumn(tctx)
 }
 
 func (cp *RemoteCheckPoint) createSchema(tctx *tcontext.Context) error {
@@ -163,6 +174,7 @@ func (cp *RemoteCheckPoint) createTable(tctx *tcontext.Context) error {
 		cp_table varchar(128) NOT NULL,
 		offset bigint NOT NULL,
 		end_pos bigint NOT NULL,
+		checksum int unsigned DEFAULT NULL,
 		create_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
 		update_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
 		UNIQUE KEY uk_id_f (id,filename)
@@ -175,6 +187,19 @@ func (cp *RemoteCheckPoint) createTable(tctx *tcontext.Context) error {
 	return terror.WithScope(err, terror.ScopeDownstream)
 }
 
+// addChecksumColumn adds the checksum column for the checkpoint table created by older versions, the checksum of the
+// checkpoints saved by older versions is NULL.
+func (cp *RemoteCheckPoint) addChecksumColumn(tctx *tcontext.Context) error {
+	sql2 := fmt.Sprintf("ALTER TABLE %s ADD COLUMN checksum int unsigned DEFAULT NULL AFTER end_pos", cp.tableName)
+	cp.connMutex.Lock()
+	err := cp.conn.executeSQL(tctx, []string{sql2})
+	cp.connMutex.Unlock()
+	if err != nil && !utils.IgnoreErrorCheckpoint(err) {
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+	return nil
+}
+
 // Load implements CheckPoint.Load.
 func (cp *RemoteCheckPoint) Load(tctx *tcontext.Context) error {
 	begin := time.Now()
@@ -182,7 +207,7 @@ func (cp *RemoteCheckPoint) Load(tctx *tcontext.Context) error {
 		cp.logger.Info("load checkpoint", zap.Duration("cost time", time.Since(begin)))
 	}()
 
-	query := fmt.Sprintf("SELECT `filename`,`cp_schema`,`cp_table`,`offset`,`end_pos` from %s where `id`=?", cp.tableName)
+	query := fmt.Sprintf("SELECT `filename`,`cp_schema`,`cp_table`,`offset`,`end_pos`,`checksum` from %s where `id`=?", cp.tableName)
 	cp.connMutex.Lock()
 	rows, err := cp.conn.querySQL(tctx, query, cp.id)
 	cp.connMutex.Unlock()
@@ -197,13 +222,15 @@ func (cp *RemoteCheckPoint) Load(tctx *tcontext.Context) error {
 		table    string
 		offset   int64
 		endPos   int64
+		checksum sql.NullInt64
 	)
 
 	cp.restoringFiles.Lock()
 	defer cp.restoringFiles.Unlock()
 	cp.restoringFiles.pos = make(map[string]map[string]FilePosSet) // reset to empty
+	cp.restoringFiles.checksums = make(map[string]uint32)
 	for rows.Next() {
-		err := rows.Scan(&filename, &schema, &table, &offset, &endPos)
+		err := rows.Scan(&filename, &schema, &table, &offset, &endPos, &checksum)
 		if err != nil {
 			return terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeDownstream)
 		}
@@ -217,6 +244,9 @@ func (cp *RemoteCheckPoint) Load(tctx *tcontext.Context) error {
 		}
 		restoringFiles := tables[table]
 		restoringFiles[filename] = []int64{offset, endPos}
+		if checksum.Valid {
+			cp.restoringFiles.checksums[filename] = uint32(checksum.Int64)
+		}
 	}
 
 	return terror.WithScope(terror.DBErrorAdapt(rows.Err(), terror.ErrDBDriverError), terror.ScopeDownstream)
@@ -256,6 +286,14 @@ func (cp *RemoteCheckPoint) GetAllRestoringFileInfo() map[string][]int64 {
 	return results
 }
 
+// GetChecksum implements CheckPoint.GetChecksum.
+func (cp *RemoteCheckPoint) GetChecksum(filename string) (uint32, bool) {
+	cp.restoringFiles.RLock()
+	defer cp.restoringFiles.RUnlock()
+	checksum, ok := cp.restoringFiles.checksums[filename]
+	return checksum, ok
+}
+
 // IsTableCreated implements CheckPoint.IsTableCreated.
 func (cp *RemoteCheckPoint) IsTableCreated(db, table string) bool {
 	cp.restoringFiles.RLock()
@@ -350,7 +388,7 @@ func (cp *RemoteCheckPoint) Init(tctx *tcontext.Context, filename string, endPos
 	if err != nil {
 		return terror.ErrCheckpointInvalidTableFile.Generate(filename)
 	}
-	sql2 := fmt.Sprintf("INSERT INTO %s (`id`, `filename`, `cp_schema`, `cp_table`, `offset`, `end_pos`) VALUES(?,?,?,?,?,?)", cp.tableName)
+	sql2 := fmt.Sprintf("INSERT INTO %s (`id`, `filename`, `cp_schema`, `cp_table`, `offset`, `end_pos`, `checksum`) VALUES(?,?,?,?,?,?,?)", cp.tableName)
 	cp.logger.Info("initial checkpoint record",
 		zap.String("sql", sql2),
 		zap.String("id", cp.id),
@@ -359,7 +397,7 @@ func (cp *RemoteCheckPoint) Init(tctx *tcontext.Context, filename string, endPos
 		zap.String("table", table),
 		zap.Int64("offset", 0),
 		zap.Int64("end position", endPos))
-	args := []interface{}{cp.id, filename, schema, table, 0, endPos}
+	args := []interface{}{cp.id, filename, schema, table, 0, endPos, 0}
 	cp.connMutex.Lock()
 	err = cp.conn.executeSQL(tctx, []string{sql2}, args)
 	cp.connMutex.Unlock()
@@ -383,6 +421,7 @@ func (cp *RemoteCheckPoint) Init(tctx *tcontext.Context, filename string, endPos
 	restoringFiles := tables[table]
 	if _, ok := restoringFiles[filename]; !ok {
 		restoringFiles[filename] = []int64{0, endPos}
+		cp.restoringFiles.checksums[filename] = 0
 	}
 	return nil
 }
@@ -402,14 +441,14 @@ func (cp *RemoteCheckPoint) Close() {
 }
 
 // GenSQL implements CheckPoint.GenSQL.
-func (cp *RemoteCheckPoint) GenSQL(filename string, offset int64) string {
-	sql := fmt.Sprintf("UPDATE %s SET `offset`=%d WHERE `id` ='%s' AND `filename`='%s';",
-		cp.tableName, offset, cp.id, filename)
+func (cp *RemoteCheckPoint) GenSQL(filename string, offset int64, checksum uint32) string {
+	sql := fmt.Sprintf("UPDATE %s SET `offset`=%d, `checksum`=%d WHERE `id` ='%s' AND `filename`='%s';",
+		cp.tableName, offset, checksum, cp.id, filename)
 	return sql
 }
 
 // UpdateOffset implements CheckPoint.UpdateOffset.
-func (cp *RemoteCheckPoint) UpdateOffset(filename string, offset int64) error {
+func (cp *RemoteCheckPoint) UpdateOffset(filename string, offset int64, checksum uint32) error {
 	cp.restoringFiles.Lock()
 	defer cp.restoringFiles.Unlock()
 	db, table, err := getDBAndTableFromFilename(filename)
@@ -421,6 +460,7 @@ func (cp *RemoteCheckPoint) UpdateOffset(filename string, offset int64) error {
 		if _, ok := cp.restoringFiles.pos[db][table]; ok {
 			if _, ok := cp.restoringFiles.pos[db][table][filename]; ok {
 				cp.restoringFiles.pos[db][table][filename][0] = offset
+				cp.restoringFiles.checksums[filename] = checksum
 				return nil
 			}
 		}
@@ -624,6 +664,25 @@ func (cp *LightningCheckpointList) taskStatus(ctx context.Context) (lightingLoad
 	return lightningStatusInit, nil
 }
 
+// Remove removes the status of the subtask, so it's taken as a fresh task.
+func (cp *LightningCheckpointList) Remove(ctx context.Context) error {
+	connection, err := cp.db.GetBaseConn(ctx)
+	if err != nil {
+		return terror.WithScope(terror.Annotate(err, "initialize connection"), terror.ScopeDownstream)
+	}
+	defer conn.CloseBaseConnWithoutErr(cp.db, connection)
+
+	sql := fmt.Sprintf("DELETE FROM %s WHERE `task_name` = ? AND `source_name` = ?", cp.tableName)
+	cp.logger.Info("remove lightning loader status", zap.String("task", cp.taskName), zap.String("source", cp.sourceName))
+	tctx := tcontext.NewContext(ctx, log.With(zap.String("job", "lightning-checkpoint")))
+	_, err = connection.ExecuteSQL(tctx, nil, "lightning-checkpoint", []string{sql},
+		[]interface{}{cp.taskName, cp.sourceName})
+	if err != nil {
+		return terror.WithScope(terror.Annotate(err, "remove lightning status"), terror.ScopeDownstream)
+	}
+	return nil
+}
+
 // Close implements CheckPoint.Close.
 func (cp *LightningCheckpointList) Close() {
 	if err := cp.db.Close(); err != nil {
@@ -21,7 +21,9 @@ import (
 	"strconv"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
 	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/errno"
 
 	"github.com/pingcap/tiflow/dm/dm/config"
 	"github.com/pingcap/tiflow/dm/pkg/conn"
@@ -37,6 +39,7 @@ var (
 var (
 	schemaCreateSQL     = ""
 	tableCreateSQL      = ""
+	addChecksumSQL      = ""
 	clearCheckPointSQL  = ""
 	loadCheckPointSQL   = ""
 	countCheckPointSQL  = ""
@@ -76,8 +79,9 @@ func (t *testCheckPointSuite) SetUpSuite(c *C) {
 
 	schemaCreateSQL = fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS `%s`", t.cfg.MetaSchema)
 	tableCreateSQL = fmt.Sprintf("CREATE TABLE IF NOT EXISTS `%s`.`%s` .*", t.cfg.MetaSchema, cputil.LoaderCheckpoint(t.cfg.Name))
+	addChecksumSQL = fmt.Sprintf("ALTER TABLE `%s`.`%s` ADD COLUMN checksum .*", t.cfg.MetaSchema, cputil.LoaderCheckpoint(t.cfg.Name))
 	clearCheckPointSQL = fmt.Sprintf("DELETE FROM `%s`.`%s` WHERE `id` = .*", t.cfg.MetaSchema, cputil.LoaderCheckpoint(t.cfg.Name))
-	loadCheckPointSQL = fmt.Sprintf("SELECT `filename`,`cp_schema`,`cp_table`,`offset`,`end_pos` from `%s`.`%s` where `id`.*", t.cfg.MetaSchema, cputil.LoaderCheckpoint(t.cfg.Name))
+	loadCheckPointSQL = fmt.Sprintf("SELECT `filename`,`cp_schema`,`cp_table`,`offset`,`end_pos`,`checksum` from `%s`.`%s` where `id`.*", t.cfg.MetaSchema, cputil.LoaderCheckpoint(t.cfg.Name))
 	countCheckPointSQL = fmt.Sprintf("SELECT COUNT.* FROM `%s`.`%s` WHERE `id` = ?", t.cfg.MetaSchema, cputil.LoaderCheckpoint(t.cfg.Name))
 	flushCheckPointSQL = fmt.Sprintf("INSERT INTO `%s`.`%s` .* VALUES.*", t.cfg.MetaSchema, cputil.LoaderCheckpoint(t.cfg.Name))
 	deleteCheckPointSQL = fmt.Sprintf("DELETE FROM `%s`.`%s` WHERE `id` = .*", t.cfg.MetaSchema, cputil.LoaderCheckpoint(t.cfg.Name))
@@ -113,6 +117,10 @@ func (t *testCheckPointSuite) TestForDB(c *C) {
 	mock.ExpectBegin()
 	mock.ExpectExec(tableCreateSQL).WillReturnResult(sqlmock.NewResult(0, 1))
 	mock.ExpectCommit()
+	// the checksum column already exists
+	mock.ExpectBegin()
+	mock.ExpectExec(addChecksumSQL).WillReturnError(&mysql.MySQLError{Number: errno.ErrDupFieldName})
+	mock.ExpectRollback()
 
 	id := "test_for_db"
 	tctx := tcontext.Background()
@@ -164,11 +172,20 @@ func (t *testCheckPointSuite) TestForDB(c *C) {
 	info := cp.GetRestoringFileInfo("db1", "tbl1")
 	c.Assert(info, HasLen, 1)
 	c.Assert(info[cases[0].filename], DeepEquals, []int64{0, cases[0].endPos})
-
-	// mock cp load
-	rows := sqlmock.NewRows([]string{"filename", "cp_schema", "cp_table", "offset", "end_pos"})
+	checksum, ok := cp.GetChecksum(cases[0].filename)
+	c.Assert(ok, IsTrue)
+	c.Assert(checksum, Equals, uint32(0))
+
+	c.Assert(cp.GenSQL(cases[0].filename, 100, 12345), Matches, "UPDATE .* SET `offset`=100, `checksum`=12345 WHERE .*")
+	c.Assert(cp.UpdateOffset(cases[0].filename, 100, 12345), IsNil)
+	checksum, ok = cp.GetChecksum(cases[0].filename)
+	c.Assert(ok, IsTrue)
+	c.Assert(checksum, Equals, uint32(12345))
+
+	// mock cp load, the checksum of the checkpoint saved by older versions is NULL
+	rows := sqlmock.NewRows([]string{"filename", "cp_schema", "cp_table", "offset", "end_pos", "checksum"})
 	for i, cs := range cases {
-		rows = rows.AddRow(cs.filename, "db1", fmt.Sprintf("tbl%d", i+1), 0, cs.endPos)
+		rows = rows.AddRow(cs.filename, "db1", fmt.Sprintf("tbl%d", i+1), 0, cs.endPos, nil)
 	}
 	mock.ExpectQuery(loadCheckPointSQL).WillReturnRows(rows)
 	err = cp.Load(tctx)
@@ -181,6 +198,8 @@ func (t *testCheckPointSuite) TestForDB(c *C) {
 		c.Assert(len(info), Equals, 2)
 		c.Assert(info[0], Equals, int64(0))
 		c.Assert(info[1], Equals, cs.endPos)
+		_, ok = cp.GetChecksum(cs.filename)
+		c.Assert(ok, IsFalse)
 	}
 
 	mock.ExpectQuery(countCheckPointSQL).WillReturnRows(sqlmock.NewRows([]string{"COUNT(id)"}).AddRow(3))
@@ -189,9 +208,9 @@ func (t *testCheckPointSuite) TestForDB(c *C) {
 	c.Assert(count, Equals, len(cases))
 
 	// update checkpoint to finished
-	rows = sqlmock.NewRows([]string{"filename", "cp_schema", "cp_table", "offset", "end_pos"})
+	rows = sqlmock.NewRows([]string{"filename", "cp_schema", "cp_table", "offset", "end_pos", "checksum"})
 	for i, cs := range cases {
-		rows = rows.AddRow(cs.filename, "db1", fmt.Sprintf("tbl%d", i+1), cs.endPos, cs.endPos)
+		rows = rows.AddRow(cs.filename, "db1", fmt.Sprintf("tbl%d", i+1), cs.endPos, cs.endPos, i)
 	}
 	mock.ExpectQuery(loadCheckPointSQL).WillReturnRows(rows)
 	err = cp.Load(tctx)
@@ -207,12 +226,15 @@ func (t *testCheckPointSuite) TestForDB(c *C) {
 
 	infos = cp.GetAllRestoringFileInfo()
 	c.Assert(len(infos), Equals, len(cases))
-	for _, cs := range cases {
+	for i, cs := range cases {
 		info, ok := infos[cs.filename]
 		c.Assert(ok, IsTrue)
 		c.Assert(len(info), Equals, 2)
 		c.Assert(info[0], Equals, cs.endPos)
 		c.Assert(info[1], Equals, cs.endPos)
+		checksum, ok = cp.GetChecksum(cs.filename)
+		c.Assert(ok, IsTrue)
+		c.Assert(checksum, Equals, uint32(i))
 	}
 
 	mock.ExpectQuery(countCheckPointSQL).WillReturnRows(sqlmock.NewRows([]string{"COUNT(id)"}).AddRow(3))
@@ -0,0 +1,105 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"bufio"
+	"compress/gzip"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pingcap/errors"
+)
+
+const (
+	gzipDumpFileExt = ".gz"
+	zstdDumpFileExt = ".zst"
+)
+
+// trimCompressExt returns the name of the dump file without the extension of the compression algorithm, a compressed
+// dump file `db.tbl.0.sql.gz` has the same name as `db.tbl.0.sql` when collecting dump files.
+func trimCompressExt(filename string) string {
+	for _, ext := range []string{gzipDumpFileExt, zstdDumpFileExt} {
+		if strings.HasSuffix(filename, ext) {
+			return strings.TrimSuffix(filename, ext)
+		}
+	}
+	return filename
+}
+
+// dumpFileReader reads a dump file, compressed dump files are decompressed on the fly, so the offsets in it are the
+// offsets in the decompressed stream.
+type dumpFileReader struct {
+	io.Reader
+	f     *os.File
+	close func()
+}
+
+// Close implements io.Closer.
+func (r *dumpFileReader) Close() error {
+	if r.close != nil {
+		r.close()
+	}
+	return r.f.Close()
+}
+
+// openDumpFile opens the dump file, it's decompressed by the extension of the file name.
+func openDumpFile(path string) (io.ReadCloser, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	r := &dumpFileReader{Reader: f, f: f}
+	switch {
+	case strings.HasSuffix(path, gzipDumpFileExt):
+		gr, err2 := gzip.NewReader(bufio.NewReader(f))
+		if err2 != nil {
+			f.Close()
+			return nil, errors.Annotatef(err2, "open gzip compressed dump file %s", path)
+		}
+		r.Reader = gr
+		r.close = func() { gr.Close() }
+	case strings.HasSuffix(path, zstdDumpFileExt):
+		zr, err2 := zstd.NewReader(bufio.NewReader(f), zstd.WithDecoderConcurrency(1))
+		if err2 != nil {
+			f.Close()
+			return nil, errors.Annotatef(err2, "open zstd compressed dump file %s", path)
+		}
+		r.Reader = zr
+		r.close = zr.Close
+	}
+	return r, nil
+}
+
+// getDumpFileSize returns the size of the dump file, which is the size after decompressed for compressed dump files,
+// so it can be compared with the offsets in the file. the compressed dump file is decompressed once to get the size.
+func getDumpFileSize(path string) (int64, error) {
+	if trimCompressExt(path) == path {
+		stat, err := os.Stat(path)
+		if err != nil {
+			return 0, err
+		}
+		return stat.Size(), nil
+	}
+
+	r, err := openDumpFile(path)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+	size, err := io.Copy(io.Discard, r)
+	return size, errors.Annotatef(err, "decompress dump file %s", path)
+}
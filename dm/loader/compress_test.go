@@ -0,0 +1,127 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/klauspost/compress/zstd"
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/pkg/log"
+)
+
+var _ = Suite(&testCompressSuite{})
+
+type testCompressSuite struct{}
+
+func writeCompressedFile(c *C, path string, data []byte) {
+	var buf bytes.Buffer
+	switch filepath.Ext(path) {
+	case gzipDumpFileExt:
+		w := gzip.NewWriter(&buf)
+		_, err := w.Write(data)
+		c.Assert(err, IsNil)
+		c.Assert(w.Close(), IsNil)
+	case zstdDumpFileExt:
+		w, err := zstd.NewWriter(&buf)
+		c.Assert(err, IsNil)
+		_, err = w.Write(data)
+		c.Assert(err, IsNil)
+		c.Assert(w.Close(), IsNil)
+	default:
+		buf.Write(data)
+	}
+	c.Assert(os.WriteFile(path, buf.Bytes(), 0o644), IsNil)
+}
+
+func (t *testCompressSuite) TestOpenDumpFile(c *C) {
+	c.Assert(trimCompressExt("db.tbl.0.sql.gz"), Equals, "db.tbl.0.sql")
+	c.Assert(trimCompressExt("db.tbl-schema.sql.zst"), Equals, "db.tbl-schema.sql")
+	c.Assert(trimCompressExt("db.tbl.0.sql"), Equals, "db.tbl.0.sql")
+
+	dir := c.MkDir()
+	data := bytes.Repeat([]byte("INSERT INTO `tbl` VALUES\n(1);\n"), 1000)
+	for _, name := range []string{"db.tbl.0.sql", "db.tbl.0.sql.gz", "db.tbl.0.sql.zst"} {
+		path := filepath.Join(dir, name)
+		writeCompressedFile(c, path, data)
+
+		r, err := openDumpFile(path)
+		c.Assert(err, IsNil)
+		content, err := io.ReadAll(r)
+		c.Assert(err, IsNil)
+		c.Assert(r.Close(), IsNil)
+		c.Assert(content, DeepEquals, data, Commentf("file %s", name))
+
+		size, err := getDumpFileSize(path)
+		c.Assert(err, IsNil)
+		c.Assert(size, Equals, int64(len(data)))
+	}
+
+	// not a valid compressed file
+	path := filepath.Join(dir, "db.tbl.1.sql.gz")
+	c.Assert(os.WriteFile(path, data, 0o644), IsNil)
+	_, err := openDumpFile(path)
+	c.Assert(err, NotNil)
+}
+
+func (t *testCompressSuite) TestDispatchCompressedSQL(c *C) {
+	ctx := context.Background()
+	dir := c.MkDir()
+	data := "/*!40101 SET NAMES binary*/;\nINSERT INTO `tbl` VALUES\n(1),\n(2);\nINSERT INTO `tbl` VALUES\n(3);\n"
+	table := &tableInfo{sourceSchema: "db", sourceTable: "tbl", targetSchema: "db", targetTable: "tbl"}
+
+	for _, filename := range []string{"db.tbl.0.sql.gz", "db.tbl.0.sql.zst"} {
+		file := filepath.Join(dir, filename)
+		writeCompressedFile(c, file, []byte(data))
+
+		cp := &RemoteCheckPoint{}
+		cp.restoringFiles.pos = map[string]map[string]FilePosSet{
+			"db": {"tbl": {filename: {0, int64(len(data))}}},
+		}
+		cp.restoringFiles.checksums = map[string]uint32{filename: 0}
+		w := &Worker{
+			checkPoint: cp,
+			jobQueue:   make(chan *dataJob, 10),
+			loader:     &Loader{},
+			logger:     log.L(),
+		}
+		c.Assert(w.dispatchSQL(ctx, file, 0, int64(len(data)), table), IsNil)
+		close(w.jobQueue)
+		jobs := make([]*dataJob, 0, 2)
+		for job := range w.jobQueue {
+			jobs = append(jobs, job)
+		}
+		// the offsets are in the decompressed stream
+		c.Assert(jobs, HasLen, 2)
+		c.Assert(jobs[0].lastOffset, Equals, int64(0))
+		c.Assert(jobs[1].offset, Equals, int64(len(data)))
+		c.Assert(jobs[0].file, Equals, filename)
+
+		// resume from the first job
+		c.Assert(cp.UpdateOffset(filename, jobs[0].offset, jobs[0].checksum), IsNil)
+		w.jobQueue = make(chan *dataJob, 10)
+		c.Assert(w.dispatchSQL(ctx, file, jobs[0].offset, int64(len(data)), table), IsNil)
+		close(w.jobQueue)
+		resumed := <-w.jobQueue
+		c.Assert(resumed.sql, Equals, jobs[1].sql)
+		c.Assert(resumed.lastOffset, Equals, jobs[0].offset)
+		c.Assert(resumed.checksum, Equals, jobs[1].checksum)
+	}
+}
@@ -18,18 +18,17 @@ import (
 	"bytes"
 	"fmt"
 	"io"
-	"os"
 	"strings"
 	"unsafe"
 
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
 	parserpkg "github.com/pingcap/tiflow/dm/pkg/parser"
+	"github.com/pingcap/tiflow/dm/pkg/router"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 
 	"github.com/pingcap/errors"
 	cm "github.com/pingcap/tidb-tools/pkg/column-mapping"
-	router "github.com/pingcap/tidb-tools/pkg/table-router"
 	"github.com/pingcap/tidb/parser/ast"
 )
 
@@ -190,20 +189,20 @@ func parseRowValues(str []byte, table *tableInfo, columnMapping *cm.Mapping) ([]
 
 // exportStatement returns schema structure in sqlFile.
 func exportStatement(sqlFile string) ([]byte, error) {
-	fd, err := os.Open(sqlFile)
+	fd, err := openDumpFile(sqlFile)
 	if err != nil {
 		return nil, terror.ErrLoadUnitReadSchemaFile.Delegate(err, sqlFile)
 	}
 	defer fd.Close()
 
 	br := bufio.NewReader(fd)
-	f, err := os.Stat(sqlFile)
+	size, err := getDumpFileSize(sqlFile)
 	if err != nil {
 		return nil, terror.ErrLoadUnitReadSchemaFile.Delegate(err, sqlFile)
 	}
 
-	data := make([]byte, 0, f.Size()+1)
-	buffer := make([]byte, 0, f.Size()+1)
+	data := make([]byte, 0, size+1)
+	buffer := make([]byte, 0, size+1)
 	for {
 		line, err := br.ReadString('\n')
 		if errors.Cause(err) == io.EOF {
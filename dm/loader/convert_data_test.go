@@ -15,9 +15,9 @@ package loader
 
 import (
 	cm "github.com/pingcap/tidb-tools/pkg/column-mapping"
-	router "github.com/pingcap/tidb-tools/pkg/table-router"
 
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/router"
 
 	. "github.com/pingcap/check"
 )
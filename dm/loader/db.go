@@ -111,12 +111,19 @@ func (conn *DBConn) querySQL(ctx *tcontext.Context, query string, args ...interf
 }
 
 func (conn *DBConn) executeSQL(ctx *tcontext.Context, queries []string, args ...[]interface{}) error {
+	_, err := conn.executeSQLWithFailedIndex(ctx, queries, args...)
+	return err
+}
+
+// executeSQLWithFailedIndex executes the statements in a transaction like executeSQL, and returns the index of the
+// failed statement if it fails.
+func (conn *DBConn) executeSQLWithFailedIndex(ctx *tcontext.Context, queries []string, args ...[]interface{}) (int, error) {
 	if len(queries) == 0 {
-		return nil
+		return 0, nil
 	}
 
 	if conn == nil || conn.baseConn == nil {
-		return terror.ErrDBUnExpect.Generate("database connection not valid")
+		return 0, terror.ErrDBUnExpect.Generate("database connection not valid")
 	}
 
 	params := retry.Params{
@@ -147,12 +154,12 @@ func (conn *DBConn) executeSQL(ctx *tcontext.Context, queries []string, args ...
 		},
 	}
 
-	_, _, err := conn.baseConn.ApplyRetryStrategy(
+	ret, _, err := conn.baseConn.ApplyRetryStrategy(
 		ctx,
 		params,
 		func(ctx *tcontext.Context) (interface{}, error) {
 			startTime := time.Now()
-			_, err := conn.baseConn.ExecuteSQL(ctx, stmtHistogram, conn.name, queries, args...)
+			idx, err := conn.baseConn.ExecuteSQL(ctx, stmtHistogram, conn.name, queries, args...)
 			failpoint.Inject("LoadExecCreateTableFailed", func(val failpoint.Value) {
 				errCode, err1 := strconv.ParseUint(val.(string), 10, 16)
 				if err1 != nil {
@@ -175,7 +182,7 @@ func (conn *DBConn) executeSQL(ctx *tcontext.Context, queries []string, args ...
 						zap.String("argument", utils.TruncateInterface(args, -1)))
 				}
 			}
-			return nil, err
+			return idx, err
 		})
 	if err != nil {
 		ctx.L().ErrorFilterContextCanceled("execute statements failed after retry",
@@ -184,7 +191,8 @@ func (conn *DBConn) executeSQL(ctx *tcontext.Context, queries []string, args ...
 			log.ShortError(err))
 	}
 
-	return err
+	idx, _ := ret.(int)
+	return idx, err
 }
 
 // resetConn reset one worker connection from specify *BaseDB.
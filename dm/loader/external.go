@@ -0,0 +1,151 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	lcfg "github.com/pingcap/tidb/br/pkg/lightning/config"
+	"github.com/pingcap/tidb/parser/ast"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	parserpkg "github.com/pingcap/tiflow/dm/pkg/parser"
+	"github.com/pingcap/tiflow/dm/pkg/router"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+// externalFileRouters returns the file route rules of lightning to import the external data files, only the files of
+// the declared tables are imported.
+func externalFileRouters(ext *config.ExternalDataConfig) []*lcfg.FileRouteRule {
+	rules := make([]*lcfg.FileRouteRule, 0, len(ext.Tables))
+	for _, t := range ext.Tables {
+		pattern := t.FilePattern
+		if len(pattern) == 0 {
+			pattern = fmt.Sprintf(`^%s\.%s\.(?:.*\.)?%s$`, regexp.QuoteMeta(t.Schema), regexp.QuoteMeta(t.Table), ext.Format)
+		}
+		rules = append(rules, &lcfg.FileRouteRule{
+			Pattern: pattern,
+			// the names are expanded as templates by lightning
+			Schema: strings.ReplaceAll(t.Schema, "$", "$$"),
+			Table:  strings.ReplaceAll(t.Table, "$", "$$"),
+			Type:   ext.Format,
+		})
+	}
+	return rules
+}
+
+// adjustExternalConfig makes lightning import the external data files instead of the dumped files.
+func adjustExternalConfig(cfg *lcfg.Config, ext *config.ExternalDataConfig) {
+	cfg.Mydumper.SourceDir = ext.Dir
+	cfg.Mydumper.DefaultFileRules = false
+	// the tables are created by DM before importing
+	cfg.Mydumper.NoSchema = true
+	cfg.Mydumper.FileRouters = externalFileRouters(ext)
+	if ext.Format == config.ExternalFormatCSV {
+		cfg.Mydumper.CSV.Separator = ext.CSV.Separator
+		cfg.Mydumper.CSV.Delimiter = ext.CSV.Delimiter
+		cfg.Mydumper.CSV.Terminator = ext.CSV.Terminator
+		cfg.Mydumper.CSV.Null = ext.CSV.Null
+		cfg.Mydumper.CSV.Header = ext.CSV.Header
+		cfg.Mydumper.CSV.BackslashEscape = ext.CSV.BackslashEscape
+	}
+}
+
+// prepareExternalDir writes the metadata and the schema files of the external data into the dir of the subtask, so
+// they are read by load unit and sync unit like the dumped files. the binlog location in the metadata is the meta of
+// the task, from which the incremental sync starts.
+func prepareExternalDir(cfg *config.SubTaskConfig) error {
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return terror.ErrLoadUnitDumpDirNotFound.Delegate(err, cfg.Dir)
+	}
+
+	now := time.Now().Format("2006-01-02 15:04:05")
+	var metadata strings.Builder
+	fmt.Fprintf(&metadata, "Started dump at: %s\n", now)
+	if cfg.Meta != nil && len(cfg.Meta.BinLogName) > 0 {
+		fmt.Fprintf(&metadata, "SHOW MASTER STATUS:\n\tLog: %s\n\tPos: %d\n\tGTID:%s\n\n", cfg.Meta.BinLogName, cfg.Meta.BinLogPos, cfg.Meta.BinLogGTID)
+	}
+	fmt.Fprintf(&metadata, "Finished dump at: %s\n", now)
+	files := map[string]string{"metadata": metadata.String()}
+
+	for _, t := range cfg.External.Tables {
+		files[t.Schema+"-schema-create.sql"] = fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s;\n", dbutil.ColumnName(t.Schema))
+		createTable := strings.TrimSpace(t.CreateTable)
+		if !strings.HasSuffix(createTable, ";") {
+			createTable += ";"
+		}
+		files[t.Schema+"."+t.Table+"-schema.sql"] = createTable + "\n"
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(cfg.Dir, name), []byte(content), 0o644); err != nil {
+			return terror.ErrLoadUnitReadSchemaFile.Delegate(err, name)
+		}
+	}
+	return nil
+}
+
+// createExternalTables creates the tables of the external data in the downstream by the declared statements and
+// route rules, the tables already exist are skipped.
+func (l *LightningLoader) createExternalTables(ctx context.Context) error {
+	tctx := tcontext.NewContext(ctx, l.logger)
+	tableRouter, err := router.NewTableRouter(l.cfg.CaseSensitive, l.cfg.RouteRules)
+	if err != nil {
+		return terror.ErrLoadUnitGenTableRouter.Delegate(err)
+	}
+	parser2, err := utils.GetParserFromSQLModeStr(l.sqlMode)
+	if err != nil {
+		return err
+	}
+
+	createdSchemas := make(map[string]struct{})
+	for _, t := range l.cfg.External.Tables {
+		stmt, err := parser2.ParseOneStmt(t.CreateTable, "", "")
+		if err != nil {
+			return terror.ErrLoadUnitParseStatement.Delegate(err, t.CreateTable)
+		}
+		ct, ok := stmt.(*ast.CreateTableStmt)
+		if !ok {
+			return terror.ErrLoadUnitNotCreateTable.Generate(t.CreateTable, t.Schema, t.Table)
+		}
+		ct.IfNotExists = true
+
+		targetSchema, targetTable := fetchMatchedLiteral(tctx, tableRouter, t.Schema, t.Table)
+		if _, ok := createdSchemas[targetSchema]; !ok {
+			if err = l.toDBConns[0].executeSQL(tctx, []string{"CREATE DATABASE IF NOT EXISTS " + dbutil.ColumnName(targetSchema)}); err != nil {
+				return terror.WithScope(err, terror.ScopeDownstream)
+			}
+			createdSchemas[targetSchema] = struct{}{}
+		}
+		query, err := parserpkg.RenameDDLTable(ct, []*filter.Table{{Schema: targetSchema, Name: targetTable}})
+		if err != nil {
+			return err
+		}
+		l.logger.Info("create table of external data", zap.String("table", dbutil.TableName(t.Schema, t.Table)), zap.String("sql", query))
+		if err = l.toDBConns[0].executeSQL(tctx, []string{query}); err != nil {
+			return terror.WithScope(err, terror.ScopeDownstream)
+		}
+	}
+	return nil
+}
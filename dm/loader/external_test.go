@@ -0,0 +1,141 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-mysql-org/go-mysql/mysql"
+	. "github.com/pingcap/check"
+	router "github.com/pingcap/tidb-tools/pkg/table-router"
+	lcfg "github.com/pingcap/tidb/br/pkg/lightning/config"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/pingcap/tiflow/dm/pkg/dumpling"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+)
+
+var _ = Suite(&testExternalSuite{})
+
+type testExternalSuite struct{}
+
+func (t *testExternalSuite) TestAdjustExternalConfig(c *C) {
+	ext := &config.ExternalDataConfig{
+		Dir:    "/data/external",
+		Format: config.ExternalFormatCSV,
+		CSV:    config.ExternalCSVConfig{Separator: "|", Delimiter: `"`, Null: `\N`, Header: true},
+		Tables: []*config.ExternalTable{
+			{Schema: "shop", Table: "orders"},
+			{Schema: "shop", Table: "t$1", FilePattern: `^items/.*\.csv$`},
+		},
+	}
+	cfg := lcfg.NewConfig()
+	adjustExternalConfig(cfg, ext)
+	c.Assert(cfg.Mydumper.SourceDir, Equals, "/data/external")
+	c.Assert(cfg.Mydumper.DefaultFileRules, IsFalse)
+	c.Assert(cfg.Mydumper.NoSchema, IsTrue)
+	c.Assert(cfg.Mydumper.CSV.Separator, Equals, "|")
+	c.Assert(cfg.Mydumper.CSV.Header, IsTrue)
+	c.Assert(cfg.Mydumper.FileRouters, DeepEquals, []*lcfg.FileRouteRule{
+		{Pattern: `^shop\.orders\.(?:.*\.)?csv$`, Schema: "shop", Table: "orders", Type: "csv"},
+		{Pattern: `^items/.*\.csv$`, Schema: "shop", Table: "t$$1", Type: "csv"},
+	})
+
+	pattern := regexp.MustCompile(cfg.Mydumper.FileRouters[0].Pattern)
+	c.Assert(pattern.MatchString("shop.orders.csv"), IsTrue)
+	c.Assert(pattern.MatchString("shop.orders.000000001.csv"), IsTrue)
+	c.Assert(pattern.MatchString("shop.orders_bak.csv"), IsFalse)
+	c.Assert(pattern.MatchString("shop.orders.parquet"), IsFalse)
+}
+
+func (t *testExternalSuite) TestPrepareExternalDir(c *C) {
+	cfg := &config.SubTaskConfig{Flavor: mysql.MySQLFlavor}
+	cfg.Dir = filepath.Join(c.MkDir(), "task.source")
+	cfg.Meta = &config.Meta{BinLogName: "mysql-bin.000003", BinLogPos: 1234}
+	cfg.External = &config.ExternalDataConfig{
+		Tables: []*config.ExternalTable{
+			{Schema: "shop", Table: "orders", CreateTable: "CREATE TABLE orders (id INT PRIMARY KEY)"},
+			{Schema: "shop", Table: "items", CreateTable: "CREATE TABLE items (id INT PRIMARY KEY);\n"},
+		},
+	}
+	c.Assert(prepareExternalDir(cfg), IsNil)
+
+	loc, loc2, err := dumpling.ParseMetaData(filepath.Join(cfg.Dir, "metadata"), cfg.Flavor)
+	c.Assert(err, IsNil)
+	c.Assert(loc2, IsNil)
+	c.Assert(loc.Position, Equals, mysql.Position{Name: "mysql-bin.000003", Pos: 1234})
+
+	content, err := os.ReadFile(filepath.Join(cfg.Dir, "shop-schema-create.sql"))
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Equals, "CREATE DATABASE IF NOT EXISTS `shop`;\n")
+	content, err = os.ReadFile(filepath.Join(cfg.Dir, "shop.orders-schema.sql"))
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Equals, "CREATE TABLE orders (id INT PRIMARY KEY);\n")
+	content, err = os.ReadFile(filepath.Join(cfg.Dir, "shop.items-schema.sql"))
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Equals, "CREATE TABLE items (id INT PRIMARY KEY);\n")
+
+	// no binlog location is written without meta
+	cfg.Meta = nil
+	c.Assert(prepareExternalDir(cfg), IsNil)
+	_, _, err = dumpling.ParseMetaData(filepath.Join(cfg.Dir, "metadata"), cfg.Flavor)
+	c.Assert(err, ErrorMatches, ".*didn't found binlog location in dumped metadata file.*")
+}
+
+func (t *testExternalSuite) TestCreateExternalTables(c *C) {
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	dbConn, err := db.Conn(context.Background())
+	c.Assert(err, IsNil)
+
+	cfg := &config.SubTaskConfig{}
+	cfg.RouteRules = []*router.TableRule{{SchemaPattern: "shop_*", TablePattern: "orders_*", TargetSchema: "shop", TargetTable: "orders"}}
+	cfg.External = &config.ExternalDataConfig{
+		Tables: []*config.ExternalTable{
+			{Schema: "shop_1", Table: "orders_1", CreateTable: "CREATE TABLE orders_1 (id INT PRIMARY KEY)"},
+			{Schema: "shop_1", Table: "items", CreateTable: "CREATE TABLE IF NOT EXISTS items (id INT PRIMARY KEY)"},
+		},
+	}
+	l := &LightningLoader{
+		cfg:       cfg,
+		logger:    log.L(),
+		toDBConns: []*DBConn{{baseConn: conn.NewBaseConn(dbConn, nil)}},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("CREATE DATABASE IF NOT EXISTS `shop`").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS `shop`.`orders` (`id` INT PRIMARY KEY)")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("CREATE DATABASE IF NOT EXISTS `shop_1`").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS `shop_1`.`items` (`id` INT PRIMARY KEY)")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	c.Assert(l.createExternalTables(context.Background()), IsNil)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// not a CREATE TABLE statement
+	cfg.External.Tables = []*config.ExternalTable{{Schema: "db", Table: "t", CreateTable: "DROP TABLE t"}}
+	err = l.createExternalTables(context.Background())
+	c.Assert(err, ErrorMatches, ".*is not create table statement.*")
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+}
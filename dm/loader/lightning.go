@@ -15,15 +15,21 @@ package loader
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
 	"github.com/pingcap/tidb/br/pkg/lightning"
 	"github.com/pingcap/tidb/br/pkg/lightning/checkpoints"
 	lcfg "github.com/pingcap/tidb/br/pkg/lightning/config"
+	tmysql "github.com/pingcap/tidb/parser/mysql"
 	"go.etcd.io/etcd/clientv3"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -35,6 +41,9 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/conn"
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/router"
+	"github.com/pingcap/tiflow/dm/pkg/storage"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 )
 
@@ -65,6 +74,7 @@ type LightningLoader struct {
 
 	workerName     string
 	finish         atomic.Bool
+	speed          speedMeter
 	closed         atomic.Bool
 	metaBinlog     atomic.String
 	metaBinlogGTID atomic.String
@@ -99,8 +109,13 @@ func makeGlobalConfig(cfg *config.SubTaskConfig) *lcfg.GlobalConfig {
 	lightningCfg.TiDB.Port = cfg.To.Port
 	lightningCfg.TikvImporter.Backend = lcfg.BackendTiDB
 	lightningCfg.PostRestore.Checksum = lcfg.OpLevelOff
+	if cfg.ImportMode == config.LoadModePhysical {
+		lightningCfg.TikvImporter.Backend = lcfg.BackendLocal
+		// the KV pairs are ingested into TiKV directly, so verify them by checksum.
+		lightningCfg.PostRestore.Checksum = lcfg.OpLevelRequired
+	}
 	if lightningCfg.TikvImporter.Backend == lcfg.BackendLocal {
-		lightningCfg.TikvImporter.SortedKVDir = cfg.Dir
+		lightningCfg.TikvImporter.SortedKVDir = cfg.LoaderLocalDir()
 	}
 	lightningCfg.Mydumper.SourceDir = cfg.Dir
 	lightningCfg.App.Config.File = "" // make lightning not init logger, see more in https://github.com/pingcap/tidb/pull/29291
@@ -197,6 +212,8 @@ func (l *LightningLoader) runLightning(ctx context.Context, cfg *lcfg.Config) er
 	if err = l.checkPointList.UpdateStatus(ctx, lightningStatusRunning); err != nil {
 		return err
 	}
+	// the finished bytes of lightning are counted from 0 in every run
+	l.speed.reset(progressSample{time: time.Now()})
 	err = l.core.RunOnce(taskCtx, cfg, nil)
 	failpoint.Inject("LoadDataSlowDown", nil)
 	failpoint.Inject("LoadDataSlowDownByTask", func(val failpoint.Value) {
@@ -232,10 +249,23 @@ func (l *LightningLoader) restore(ctx context.Context) error {
 		}
 		cfg.Routes = l.cfg.RouteRules
 		cfg.Checkpoint.Driver = lcfg.CheckpointDriverFile
-		cpPath := filepath.Join(l.cfg.LoaderConfig.Dir, lightningCheckpointFileName)
+		// the checkpoint file is written by the file driver of lightning, which only supports the local filesystem
+		if err = os.MkdirAll(l.cfg.LoaderLocalDir(), 0o755); err != nil {
+			return terror.ErrLoadUnitDumpDirNotFound.Delegate(err, l.cfg.LoaderLocalDir())
+		}
+		cpPath := filepath.Join(l.cfg.LoaderLocalDir(), lightningCheckpointFileName)
 		cfg.Checkpoint.DSN = cpPath
 		cfg.Checkpoint.KeepAfterSuccess = lcfg.CheckpointOrigin
 		cfg.TikvImporter.OnDuplicate = string(l.cfg.OnDuplicate)
+		if l.cfg.External != nil {
+			if err = l.createExternalTables(ctx); err != nil {
+				return err
+			}
+			adjustExternalConfig(cfg, l.cfg.External)
+		}
+		if err = l.adjustBackend(ctx, cfg); err != nil {
+			return err
+		}
 		cfg.TiDB.Vars = make(map[string]string)
 		cfg.Routes = l.cfg.RouteRules
 		if l.cfg.To.Session != nil {
@@ -266,12 +296,116 @@ func (l *LightningLoader) restore(ctx context.Context) error {
 	}
 	if l.finish.Load() {
 		if l.cfg.CleanDumpFile {
-			cleanDumpFiles(l.cfg)
+			cleanDumpFiles(ctx, l.cfg)
 		}
 	}
 	return err
 }
 
+// adjustBackend decides the backend used to import data for physical import mode. the local backend requires the
+// target tables to be empty, so it falls back to the tidb backend if some target tables already have data. the
+// backend recorded in lightning checkpoint is used when resuming, because the backend can't be changed midway.
+func (l *LightningLoader) adjustBackend(ctx context.Context, cfg *lcfg.Config) error {
+	if cfg.TikvImporter.Backend != lcfg.BackendLocal {
+		return nil
+	}
+
+	exist, err := checkpoints.IsCheckpointsDBExists(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	if exist {
+		cpdb, err2 := checkpoints.OpenCheckpointsDB(ctx, cfg)
+		if err2 != nil {
+			return err2
+		}
+		taskCp, err2 := cpdb.TaskCheckpoint(ctx)
+		_ = cpdb.Close()
+		if err2 != nil {
+			return errors.Trace(err2)
+		}
+		if taskCp != nil {
+			if taskCp.Backend != cfg.TikvImporter.Backend {
+				l.logger.Info("use the backend in lightning checkpoint", zap.String("backend", taskCp.Backend))
+				useTiDBBackend(cfg)
+			}
+			return nil
+		}
+	}
+
+	tables, err := l.nonEmptyTargetTables(ctx)
+	if err != nil {
+		l.logger.Warn("fail to check whether target tables are empty, fallback to sql import mode", log.ShortError(err))
+		useTiDBBackend(cfg)
+		return nil
+	}
+	if len(tables) > 0 {
+		l.logger.Warn("target tables are not empty, fallback to sql import mode", zap.Strings("tables", tables))
+		useTiDBBackend(cfg)
+	}
+	return nil
+}
+
+func useTiDBBackend(cfg *lcfg.Config) {
+	cfg.TikvImporter.Backend = lcfg.BackendTiDB
+	cfg.PostRestore.Checksum = lcfg.OpLevelOff
+}
+
+// nonEmptyTargetTables returns the target tables of the dumped tables which already have data in downstream.
+func (l *LightningLoader) nonEmptyTargetTables(ctx context.Context) ([]string, error) {
+	tableRouter, err := router.NewTableRouter(l.cfg.CaseSensitive, l.cfg.RouteRules)
+	if err != nil {
+		return nil, terror.ErrLoadUnitGenTableRouter.Delegate(err)
+	}
+	files, err := storage.CollectDirFiles(ctx, l.cfg.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	tctx := tcontext.NewContext(ctx, l.logger)
+	checked := make(map[string]struct{})
+	tables := make([]string, 0)
+	for file := range files {
+		schema, table, ok := utils.GetTableFromDumpFilename(file)
+		if !ok {
+			continue
+		}
+		targetSchema, targetTable, err := tableRouter.Route(schema, table)
+		if err != nil {
+			return nil, terror.ErrLoadUnitGenTableRouter.Delegate(err)
+		}
+		target := dbutil.TableName(targetSchema, targetTable)
+		if _, ok := checked[target]; ok {
+			continue
+		}
+		checked[target] = struct{}{}
+
+		empty, err := isTableEmpty(tctx, l.toDBConns[0], target)
+		if err != nil {
+			return nil, err
+		}
+		if !empty {
+			tables = append(tables, target)
+		}
+	}
+	sort.Strings(tables)
+	return tables, nil
+}
+
+// isTableEmpty returns whether the table has no rows, the table which doesn't exist is empty.
+func isTableEmpty(tctx *tcontext.Context, conn *DBConn, table string) (bool, error) {
+	rows, err := conn.querySQL(tctx, fmt.Sprintf("SELECT 1 FROM %s LIMIT 1", table))
+	if err != nil {
+		if utils.IsMySQLError(err, tmysql.ErrNoSuchTable) {
+			return true, nil
+		}
+		return false, err
+	}
+	defer rows.Close()
+	empty := !rows.Next()
+	return empty, terror.DBErrorAdapt(rows.Err(), terror.ErrDBDriverError)
+}
+
 // Process implements Unit.Process.
 func (l *LightningLoader) Process(ctx context.Context, pr chan pb.ProcessResult) {
 	l.logger.Info("lightning load start")
@@ -283,7 +417,14 @@ func (l *LightningLoader) Process(ctx context.Context, pr chan pb.ProcessResult)
 		}
 		failpoint.Return()
 	})
-	binlog, gtid, err := getMydumpMetadata(l.cli, l.cfg, l.workerName)
+	var err error
+	if l.cfg.External != nil {
+		err = prepareExternalDir(l.cfg)
+	}
+	var binlog, gtid string
+	if err == nil {
+		binlog, gtid, err = getMydumpMetadata(ctx, l.cli, l.cfg, l.workerName)
+	}
 	if err != nil {
 		loaderExitWithErrorCounter.WithLabelValues(l.cfg.Name, l.cfg.SourceID).Inc()
 		pr <- pb.ProcessResult{
@@ -331,6 +472,7 @@ func (l *LightningLoader) IsFreshTask(ctx context.Context) (bool, error) {
 func (l *LightningLoader) Close() {
 	l.Pause()
 	l.checkPointList.Close()
+	removeProgressMetrics(l.cfg.Name)
 	l.closed.Store(true)
 }
 
@@ -376,17 +518,23 @@ func (l *LightningLoader) Update(ctx context.Context, cfg *config.SubTaskConfig)
 func (l *LightningLoader) status() *pb.LoadStatus {
 	finished, total := l.core.Status()
 	progress := percent(finished, total, l.finish.Load())
+	// lightning only reports the finished bytes, so the chunks, rows and bottleneck are unknown.
+	bytesPerSecond, _, _ := l.speed.update(progressSample{time: time.Now(), bytes: finished})
 	s := &pb.LoadStatus{
-		FinishedBytes:  finished,
-		TotalBytes:     total,
-		Progress:       progress,
-		MetaBinlog:     l.metaBinlog.Load(),
-		MetaBinlogGTID: l.metaBinlogGTID.Load(),
+		FinishedBytes:    finished,
+		TotalBytes:       total,
+		Progress:         progress,
+		MetaBinlog:       l.metaBinlog.Load(),
+		MetaBinlogGTID:   l.metaBinlogGTID.Load(),
+		BytesPerSecond:   bytesPerSecond,
+		RemainingSeconds: remainingSeconds(finished, total, bytesPerSecond),
 	}
 	return s
 }
 
 // Status returns the unit's current status.
 func (l *LightningLoader) Status(_ *binlog.SourceStatus) interface{} {
-	return l.status()
+	s := l.status()
+	setProgressMetrics(l.cfg, s)
+	return s
 }
@@ -0,0 +1,112 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	. "github.com/pingcap/check"
+	lcfg "github.com/pingcap/tidb/br/pkg/lightning/config"
+	tmysql "github.com/pingcap/tidb/parser/mysql"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/router"
+)
+
+var _ = Suite(&testLightningSuite{})
+
+type testLightningSuite struct{}
+
+func (t *testLightningSuite) TestMakeGlobalConfig(c *C) {
+	cfg := &config.SubTaskConfig{}
+	cfg.Dir = c.MkDir()
+	cfg.ImportMode = config.LoadModeSQL
+	lightningCfg := makeGlobalConfig(cfg)
+	c.Assert(lightningCfg.TikvImporter.Backend, Equals, lcfg.BackendTiDB)
+	c.Assert(lightningCfg.PostRestore.Checksum, Equals, lcfg.OpLevelOff)
+
+	cfg.ImportMode = config.LoadModePhysical
+	lightningCfg = makeGlobalConfig(cfg)
+	c.Assert(lightningCfg.TikvImporter.Backend, Equals, lcfg.BackendLocal)
+	c.Assert(lightningCfg.PostRestore.Checksum, Equals, lcfg.OpLevelRequired)
+	c.Assert(lightningCfg.TikvImporter.SortedKVDir, Equals, cfg.Dir)
+}
+
+func (t *testLightningSuite) TestAdjustBackend(c *C) {
+	ctx := context.Background()
+	dir := c.MkDir()
+	for _, file := range []string{
+		"db_1-schema-create.sql", "db_1.t-schema.sql", "db_1.t.000000000.sql",
+		"db_2.t-schema.sql", "db_2.t.000000000.sql", "db_3.t-schema.sql",
+	} {
+		c.Assert(os.WriteFile(filepath.Join(dir, file), nil, 0o644), IsNil)
+	}
+
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	// the dump files are collected into a map, so the target tables are checked in random order.
+	mock.MatchExpectationsInOrder(false)
+	baseConn, err := conn.NewBaseDB(db).GetBaseConn(ctx)
+	c.Assert(err, IsNil)
+
+	cfg := &config.SubTaskConfig{
+		RouteRules: []*router.TableRule{
+			{SchemaPattern: "db_[12]", TablePattern: "t", TargetSchema: "db", TargetTable: "t"},
+		},
+	}
+	cfg.Dir = dir
+	cfg.ImportMode = config.LoadModePhysical
+	l := &LightningLoader{
+		cfg:       cfg,
+		toDBConns: []*DBConn{{baseConn: baseConn}},
+		logger:    log.L(),
+	}
+	newConfig := func() *lcfg.Config {
+		lightningCfg := lcfg.NewConfig()
+		c.Assert(lightningCfg.LoadFromGlobal(makeGlobalConfig(cfg)), IsNil)
+		lightningCfg.Checkpoint.Driver = lcfg.CheckpointDriverFile
+		lightningCfg.Checkpoint.DSN = filepath.Join(dir, lightningCheckpointFileName)
+		return lightningCfg
+	}
+
+	// all target tables are empty, and the merged table is checked only once.
+	mock.ExpectQuery("SELECT 1 FROM `db`.`t` LIMIT 1").WillReturnRows(sqlmock.NewRows([]string{"1"}))
+	mock.ExpectQuery("SELECT 1 FROM `db_3`.`t` LIMIT 1").WillReturnError(&mysql.MySQLError{Number: tmysql.ErrNoSuchTable})
+	lightningCfg := newConfig()
+	c.Assert(l.adjustBackend(ctx, lightningCfg), IsNil)
+	c.Assert(lightningCfg.TikvImporter.Backend, Equals, lcfg.BackendLocal)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// fallback to the tidb backend if some target tables are not empty.
+	mock.ExpectQuery("SELECT 1 FROM `db`.`t` LIMIT 1").WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+	mock.ExpectQuery("SELECT 1 FROM `db_3`.`t` LIMIT 1").WillReturnRows(sqlmock.NewRows([]string{"1"}))
+	lightningCfg = newConfig()
+	c.Assert(l.adjustBackend(ctx, lightningCfg), IsNil)
+	c.Assert(lightningCfg.TikvImporter.Backend, Equals, lcfg.BackendTiDB)
+	c.Assert(lightningCfg.PostRestore.Checksum, Equals, lcfg.OpLevelOff)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// the target tables are not checked for sql import mode.
+	cfg.ImportMode = config.LoadModeSQL
+	lightningCfg = newConfig()
+	c.Assert(l.adjustBackend(ctx, lightningCfg), IsNil)
+	c.Assert(lightningCfg.TikvImporter.Backend, Equals, lcfg.BackendTiDB)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+}
@@ -18,8 +18,8 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"hash/crc32"
 	"io"
-	"os"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -35,6 +35,8 @@ import (
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
 	fr "github.com/pingcap/tiflow/dm/pkg/func-rollback"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/ratelimit"
+	"github.com/pingcap/tiflow/dm/pkg/router"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 
@@ -42,7 +44,6 @@ import (
 	"github.com/pingcap/failpoint"
 	cm "github.com/pingcap/tidb-tools/pkg/column-mapping"
 	"github.com/pingcap/tidb-tools/pkg/filter"
-	router "github.com/pingcap/tidb-tools/pkg/table-router"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
@@ -71,6 +72,11 @@ type dataJob struct {
 	absPath      string
 	offset       int64
 	lastOffset   int64
+	checksum     uint32 // checksum of the data file before offset
+	// the statement in the data file and the table, to import the rows one by one if some rows are rejected by
+	// downstream. only set if max-error is set.
+	data string
+	info *tableInfo
 }
 
 type fileJob struct {
@@ -78,6 +84,7 @@ type fileJob struct {
 	table    string
 	dataFile string
 	offset   int64
+	size     int64
 	info     *tableInfo
 }
 
@@ -152,7 +159,9 @@ func (w *Worker) run(ctx context.Context, fileJobQueue chan *fileJob, runFatalCh
 	doJob := func() {
 		hasError := false
 		for {
+			waitStart := time.Now()
 			job, ok := <-w.jobQueue
+			w.loader.writerWaitTime.Add(time.Since(waitStart))
 			if !ok {
 				w.logger.Info("job queue was closed, execution goroutine exits")
 				return
@@ -169,7 +178,7 @@ func (w *Worker) run(ctx context.Context, fileJobQueue chan *fileJob, runFatalCh
 			sqls = append(sqls, "USE `"+unescapePercent(job.schema, w.logger)+"`;")
 			sqls = append(sqls, job.sql)
 
-			offsetSQL := w.checkPoint.GenSQL(job.file, job.offset)
+			offsetSQL := w.checkPoint.GenSQL(job.file, job.offset, job.checksum)
 			sqls = append(sqls, offsetSQL)
 
 			failpoint.Inject("LoadExceedOffsetExit", func(val failpoint.Value) {
@@ -193,12 +202,26 @@ func (w *Worker) run(ctx context.Context, fileJobQueue chan *fileJob, runFatalCh
 				}
 			})
 
+			rows := countInsertRows(job.sql)
+			err := w.loader.rateLimiter.WaitN(newCtx, rows, len(job.sql))
+			if err != nil {
+				// the context is canceled
+				hasError = true
+				continue
+			}
+
 			startTime := time.Now()
-			err := w.conn.executeSQL(ctctx, sqls)
+			err = w.conn.executeSQL(ctctx, sqls)
 			failpoint.Inject("executeSQLError", func(_ failpoint.Value) {
 				w.logger.Info("", zap.String("failpoint", "executeSQLError"))
 				err = errors.New("inject failpoint executeSQLError")
 			})
+			rejected := 0
+			if err != nil && w.loader.badRows != nil && isRejectedRowError(err) {
+				w.logger.Warn("some rows are rejected by downstream, import the rows one by one",
+					zap.String("data file", job.file), zap.Int64("offset", job.offset), log.ShortError(err))
+				rejected, err = w.executeRowByRow(ctctx, job, sqls[0], offsetSQL)
+			}
 			if err != nil {
 				// expect pause rather than exit
 				err = terror.WithScope(terror.Annotatef(err, "file %s", job.file), terror.ScopeDownstream)
@@ -216,16 +239,17 @@ func (w *Worker) run(ctx context.Context, fileJobQueue chan *fileJob, runFatalCh
 			failpoint.Inject("loaderCPUpdateOffsetError", func(_ failpoint.Value) {
 				job.file = "notafile" + job.file
 			})
-			if err := w.loader.checkPoint.UpdateOffset(job.file, job.offset); err != nil {
+			if err := w.loader.checkPoint.UpdateOffset(job.file, job.offset, job.checksum); err != nil {
 				runFatalChan <- unit.NewProcessError(err)
 				hasError = true
 				continue
 			}
 			// update finished offset after checkpoint updated
 			w.loader.finishedDataSize.Add(job.offset - job.lastOffset)
+			w.loader.finishedRows.Add(int64(rows - rejected))
 			if _, ok := w.loader.dbTableDataFinishedSize[job.sourceSchema]; ok {
 				if _, ok := w.loader.dbTableDataFinishedSize[job.sourceSchema][job.sourceTable]; ok {
-					w.loader.dbTableDataFinishedSize[job.sourceSchema][job.sourceTable].Store(job.offset)
+					w.loader.dbTableDataFinishedSize[job.sourceSchema][job.sourceTable].Add(job.offset - job.lastOffset)
 				}
 			}
 		}
@@ -250,7 +274,7 @@ func (w *Worker) run(ctx context.Context, fileJobQueue chan *fileJob, runFatalCh
 			}()
 
 			// restore a table
-			if err := w.restoreDataFile(ctx, filepath.Join(w.cfg.Dir, job.dataFile), job.offset, job.info); err != nil {
+			if err := w.restoreDataFile(ctx, filepath.Join(w.cfg.Dir, job.dataFile), job.offset, job.size, job.info); err != nil {
 				// expect pause rather than exit
 				err = terror.Annotatef(err, "restore data file (%v) failed", job.dataFile)
 				if !utils.IsContextCanceledError(err) {
@@ -262,9 +286,9 @@ func (w *Worker) run(ctx context.Context, fileJobQueue chan *fileJob, runFatalCh
 	}
 }
 
-func (w *Worker) restoreDataFile(ctx context.Context, filePath string, offset int64, table *tableInfo) error {
+func (w *Worker) restoreDataFile(ctx context.Context, filePath string, offset, size int64, table *tableInfo) error {
 	w.logger.Info("start to restore dump sql file", zap.String("data file", filePath))
-	err := w.dispatchSQL(ctx, filePath, offset, table)
+	err := w.dispatchSQL(ctx, filePath, offset, size, table)
 	if err != nil {
 		return err
 	}
@@ -284,16 +308,18 @@ func (w *Worker) restoreDataFile(ctx context.Context, filePath string, offset in
 	return nil
 }
 
-func (w *Worker) dispatchSQL(ctx context.Context, file string, offset int64, table *tableInfo) error {
+// dispatchSQL dispatches the SQL statements in the data file from offset, size is the size of the data file, both of
+// them are in the decompressed stream for compressed data files.
+func (w *Worker) dispatchSQL(ctx context.Context, file string, offset, size int64, table *tableInfo) error {
 	var (
-		f   *os.File
+		f   io.ReadCloser
 		err error
 		cur int64
 	)
 
 	baseFile := filepath.Base(file)
 
-	f, err = os.Open(file)
+	f, err = openDumpFile(file)
 	if err != nil {
 		return terror.ErrLoadUnitDispatchSQLFromFile.Delegate(err)
 	}
@@ -303,13 +329,8 @@ func (w *Worker) dispatchSQL(ctx context.Context, file string, offset int64, tab
 	if offset == uninitializedOffset {
 		offset = 0
 
-		finfo, err2 := f.Stat()
-		if err2 != nil {
-			return terror.ErrLoadUnitDispatchSQLFromFile.Delegate(err2)
-		}
-
 		tctx := tcontext.NewContext(ctx, w.logger)
-		err2 = w.checkPoint.Init(tctx, baseFile, finfo.Size())
+		err2 := w.checkPoint.Init(tctx, baseFile, size)
 		failpoint.Inject("WaitLoaderStopAfterInitCheckpoint", func(v failpoint.Value) {
 			t := v.(int)
 			w.logger.Info("wait loader stop after init checkpoint")
@@ -324,11 +345,22 @@ func (w *Worker) dispatchSQL(ctx context.Context, file string, offset int64, tab
 		}
 	}
 
-	cur, err = f.Seek(offset, io.SeekStart)
+	// read the imported part of the file to calculate its checksum, and verify it with the checksum in checkpoint
+	hasher := crc32.NewIEEE()
+	cur, err = io.CopyN(hasher, f, offset)
 	if err != nil {
 		return terror.ErrLoadUnitDispatchSQLFromFile.Delegate(err)
 	}
-	w.logger.Debug("read file", zap.String("data file", file), zap.Int64("offset", offset))
+	checksum := hasher.Sum32()
+	if expected, ok := w.checkPoint.GetChecksum(baseFile); ok {
+		if expected != checksum {
+			return terror.ErrLoadUnitChecksumMismatch.Generate(baseFile, offset, expected, checksum)
+		}
+	} else if offset > 0 {
+		w.logger.Warn("checksum of the imported part of data file is not recorded in checkpoint, skip verifying it",
+			zap.String("data file", file), zap.Int64("offset", offset))
+	}
+	w.logger.Debug("read file", zap.String("data file", file), zap.Int64("offset", offset), zap.Uint32("checksum", checksum))
 
 	lastOffset := cur
 
@@ -348,6 +380,7 @@ func (w *Worker) dispatchSQL(ctx context.Context, file string, offset int64, tab
 			break
 		}
 		cur += int64(len(line))
+		checksum = crc32.Update(checksum, crc32.IEEETable, []byte(line))
 
 		realLine := strings.TrimSpace(line[:len(line)-1])
 		if len(realLine) == 0 {
@@ -363,7 +396,7 @@ func (w *Worker) dispatchSQL(ctx context.Context, file string, offset int64, tab
 			}
 
 			// extend column also need use reassemble to write SQL and the table name has been renamed
-			if w.loader.columnMapping != nil || len(table.extendCol) > 0 {
+			if w.loader.columnMapping != nil || len(table.extendCol) > 0 || table.explicitColumns {
 				// column mapping and route table
 				query, err = reassemble(data, table, w.loader.columnMapping)
 				if err != nil {
@@ -379,8 +412,6 @@ func (w *Worker) dispatchSQL(ctx context.Context, file string, offset int64, tab
 				return terror.ErrLoadUnitInvalidInsertSQL.Generate(query)
 			}
 
-			data = data[0:0]
-
 			j := &dataJob{
 				sql:          query,
 				schema:       table.targetSchema,
@@ -391,10 +422,18 @@ func (w *Worker) dispatchSQL(ctx context.Context, file string, offset int64, tab
 				absPath:      file,
 				offset:       cur,
 				lastOffset:   lastOffset,
+				checksum:     checksum,
+			}
+			if w.loader.badRows != nil {
+				j.data = string(data)
+				j.info = table
 			}
+			data = data[0:0]
 			lastOffset = cur
 
+			waitStart := time.Now()
 			w.jobQueue <- j
+			w.loader.readerWaitTime.Add(time.Since(waitStart))
 		}
 	}
 
@@ -410,6 +449,9 @@ type tableInfo struct {
 	insertHeadStmt string
 	extendCol      []string
 	extendVal      []string
+	// whether the INSERT statements should be rewritten with the columns in insertHeadStmt, it's set when the
+	// columns of the pre-created downstream table are different from the dumped columns.
+	explicitColumns bool
 }
 
 // Loader can load your mydumper data into TiDB database.
@@ -427,12 +469,21 @@ type Loader struct {
 	// table -> data files
 	db2Tables  map[string]Tables2DataFiles
 	tableInfos map[string]*tableInfo
+	// the names of dump files without the extension of compression algorithm -> the real names of dump files
+	dumpFileNames map[string]string
+	// data file -> size of the data file, which is the size after decompressed for compressed data files
+	dataFileSizes map[string]int64
 
 	fileJobQueue chan *fileJob
 
 	tableRouter   *router.Table
 	baList        *filter.Filter
 	columnMapping *cm.Mapping
+	rateLimiter   *ratelimit.Limiter
+	// nil if there are no column name mappings of the pre-created tables
+	columnNameMapper *columnNameMapper
+	// nil if max-error is not set
+	badRows *badRowRecorder
 
 	toDB      *conn.BaseDB
 	toDBConns []*DBConn
@@ -440,6 +491,12 @@ type Loader struct {
 	totalFileCount   atomic.Int64 // schema + table + data
 	totalDataSize    atomic.Int64
 	finishedDataSize atomic.Int64
+	finishedRows     atomic.Int64
+
+	// the time of the readers of data files waiting for the writers to downstream and vice versa, to find the bottleneck
+	readerWaitTime atomic.Duration
+	writerWaitTime atomic.Duration
+	speed          speedMeter
 
 	// to calculate remainingTimeGauge metric, map will be init in `l.prepare.prepareDataFiles`
 	dbTableDataTotalSize        map[string]map[string]*atomic.Int64
@@ -474,6 +531,7 @@ func NewLoader(cfg *config.SubTaskConfig, cli *clientv3.Client, workerName strin
 		logger:     log.With(zap.String("task", cfg.Name), zap.String("unit", "load")),
 		workerName: workerName,
 	}
+	loader.rateLimiter = ratelimit.NewLimiter(cfg.ImportRowsPerSecond, cfg.ImportBytesPerSecond)
 	loader.fileJobQueueClosed.Store(true) // not open yet
 	return loader
 }
@@ -523,6 +581,24 @@ func (l *Loader) Init(ctx context.Context) (err error) {
 		}
 	}
 
+	if len(l.cfg.ColumnNameMappings) > 0 {
+		l.columnNameMapper, err = newColumnNameMapper(l.cfg.ColumnNameMappings, l.cfg.CaseSensitive)
+		if err != nil {
+			return err
+		}
+	}
+
+	if l.cfg.MaxError > 0 {
+		fresh, err2 := l.IsFreshTask(ctx)
+		if err2 != nil {
+			return err2
+		}
+		l.badRows, err = newBadRowRecorder(badRowDir(l.cfg), l.cfg.MaxError, fresh)
+		if err != nil {
+			return err
+		}
+	}
+
 	dbCfg := l.cfg.To
 	dbCfg.RawDBCfg = config.DefaultRawDBConfig().
 		SetMaxIdleConns(l.cfg.PoolSize)
@@ -581,7 +657,7 @@ func (l *Loader) Process(ctx context.Context, pr chan pb.ProcessResult) {
 	defer cancel()
 
 	l.newFileJobQueue()
-	binlog, gtid, err := getMydumpMetadata(l.cli, l.cfg, l.workerName)
+	binlog, gtid, err := getMydumpMetadata(ctx, l.cli, l.cfg, l.workerName)
 	if err != nil {
 		loaderExitWithErrorCounter.WithLabelValues(l.cfg.Name, l.cfg.SourceID).Inc()
 		pr <- pb.ProcessResult{
@@ -712,7 +788,10 @@ func (l *Loader) Restore(ctx context.Context) error {
 		return err
 	}
 
-	if err := l.prepare(); err != nil {
+	l.Lock()
+	err := l.prepare()
+	l.Unlock()
+	if err != nil {
 		l.logger.Error("scan directory failed", zap.String("directory", l.cfg.Dir), log.ShortError(err))
 		return err
 	}
@@ -726,7 +805,7 @@ func (l *Loader) Restore(ctx context.Context) error {
 	})
 
 	// not update checkpoint in memory when restoring, so when re-Restore, we need to load checkpoint from DB
-	err := l.checkPoint.Load(tcontext.NewContext(ctx, l.logger))
+	err = l.checkPoint.Load(tcontext.NewContext(ctx, l.logger))
 	if err != nil {
 		return err
 	}
@@ -736,6 +815,7 @@ func (l *Loader) Restore(ctx context.Context) error {
 		return err
 	}
 	l.loadFinishedSize()
+	l.speed.reset(l.progressSample())
 	if err2 := l.initAndStartWorkerPool(ctx); err2 != nil {
 		l.logger.Error("initial and start worker pools failed", log.ShortError(err))
 		return err2
@@ -763,7 +843,7 @@ func (l *Loader) Restore(ctx context.Context) error {
 				}
 			}
 			if l.cfg.CleanDumpFile {
-				cleanDumpFiles(l.cfg)
+				cleanDumpFiles(ctx, l.cfg)
 			}
 		}
 	} else if errors.Cause(err) != context.Canceled {
@@ -923,6 +1003,12 @@ func (l *Loader) Update(ctx context.Context, cfg *config.SubTaskConfig) error {
 	return nil
 }
 
+// SetRateLimit changes the max rows and bytes imported per second, non-positive value means unlimited.
+func (l *Loader) SetRateLimit(rowsPerSecond, bytesPerSecond int64) {
+	l.rateLimiter.SetLimit(rowsPerSecond, bytesPerSecond)
+	l.logger.Info("rate limit changed", zap.Int64("rows per second", rowsPerSecond), zap.Int64("bytes per second", bytesPerSecond))
+}
+
 func (l *Loader) genRouter(rules []*router.TableRule) error {
 	l.tableRouter, _ = router.NewTableRouter(l.cfg.CaseSensitive, []*router.TableRule{})
 	for _, rule := range rules {
@@ -1047,10 +1133,13 @@ func (l *Loader) prepareDataFiles(files map[string]struct{}) error {
 			return terror.ErrLoadUnitNoTableFile.Generate(file)
 		}
 
-		size, err := utils.GetFileSize(filepath.Join(l.cfg.Dir, file))
+		// the real name of data file is used in checkpoint
+		file = l.dumpFileNames[file]
+		size, err := getDumpFileSize(filepath.Join(l.cfg.Dir, file))
 		if err != nil {
-			return err
+			return terror.ErrGetFileSize.Delegate(err, file)
 		}
+		l.dataFileSizes[file] = size
 		l.totalDataSize.Add(size)
 		l.totalFileCount.Add(1) // for data
 		if _, ok := l.dbTableDataTotalSize[db]; !ok {
@@ -1114,6 +1203,28 @@ func (l *Loader) prepare() error {
 
 	l.logger.Debug("collected files", zap.Reflect("files", files))
 
+	// compressed dump files are collected by the names without compression extension
+	l.dumpFileNames = make(map[string]string, len(files))
+	l.dataFileSizes = make(map[string]int64)
+	trimmedFiles := make(map[string]struct{}, len(files))
+	for file := range files {
+		trimmed := trimCompressExt(file)
+		if realName, ok := l.dumpFileNames[trimmed]; ok {
+			// the uncompressed one is preferred, like relay log files
+			if trimmed == realName {
+				l.logger.Warn("ignore compressed dump file, because the uncompressed one exists", zap.String("file", file))
+				continue
+			}
+			if trimmed != file {
+				return terror.ErrLoadUnitDuplicateTableFile.Generate(file)
+			}
+			l.logger.Warn("ignore compressed dump file, because the uncompressed one exists", zap.String("file", realName))
+		}
+		l.dumpFileNames[trimmed] = file
+		trimmedFiles[trimmed] = struct{}{}
+	}
+	files = trimmedFiles
+
 	/* Mydumper file names format
 	 * db    {db}-schema-create.sql
 	 * table {db}.{table}-schema.sql
@@ -1136,6 +1247,10 @@ func (l *Loader) prepare() error {
 
 // restoreSchema creates schema.
 func (l *Loader) restoreSchema(ctx context.Context, conn *DBConn, sqlFile, schema string) error {
+	if l.cfg.SkipCreateTable {
+		l.logger.Info("skip creating database for skip-create-table", zap.String("schema", schema))
+		return nil
+	}
 	if l.checkPoint.IsTableCreated(schema, "") {
 		l.logger.Info("database already exists in checkpoint, skip creating it", zap.String("schema", schema), zap.String("db schema file", sqlFile))
 		return nil
@@ -1170,7 +1285,7 @@ func (l *Loader) restoreTable(ctx context.Context, conn *DBConn, sqlFile, schema
 
 // restoreStruture creates schema or table.
 func (l *Loader) restoreStructure(ctx context.Context, conn *DBConn, sqlFile string, schema string, table string) error {
-	f, err := os.Open(sqlFile)
+	f, err := openDumpFile(sqlFile)
 	if err != nil {
 		return terror.ErrLoadUnitReadSchemaFile.Delegate(err)
 	}
@@ -1259,9 +1374,10 @@ func fetchMatchedLiteral(ctx *tcontext.Context, router *router.Table, schema, ta
 type restoreSchemaJob struct {
 	loader   *Loader
 	session  *DBConn
-	database string // database name
-	table    string // table name, empty if it's a schema of database
-	filepath string // file path of dumpped schema file
+	database string     // database name
+	table    string     // table name, empty if it's a schema of database
+	filepath string     // file path of dumpped schema file
+	info     *tableInfo // info of the table, nil if it's a schema of database
 }
 
 // `jobQueue` of schema restoring which (only) support consumptions concurrently.
@@ -1381,7 +1497,7 @@ func (l *Loader) restoreData(ctx context.Context) error {
 
 	// push database schema restoring jobs to the queue
 	for _, db := range dbs {
-		schemaFile := l.cfg.Dir + "/" + db + "-schema-create.sql" // cache friendly
+		schemaFile := l.dumpFilePath(db + "-schema-create.sql")
 		err = dbRestoreQueue.push(&restoreSchemaJob{
 			loader:   l,
 			database: db,
@@ -1410,6 +1526,10 @@ func (l *Loader) restoreData(ctx context.Context) error {
 	// run consumers of restore table schema queue
 	tblRestoreQueue := newJobQueue(ctx, concurrency, concurrency /** length of queue */)
 	tblRestoreQueue.startConsumers(func(ctx context.Context, job *restoreSchemaJob) error {
+		if job.loader.cfg.SkipCreateTable {
+			// the INSERT statements are rewritten by the downstream columns, so check the table even if it's resumed
+			return job.loader.checkPreCreatedTable(ctx, job.session, job.filepath, job.info)
+		}
 		job.loader.logger.Info("start to create table", zap.String("table file", job.filepath))
 		err2 := job.loader.restoreTable(ctx, job.session, job.filepath, job.database, job.table)
 		if err2 != nil {
@@ -1423,7 +1543,7 @@ func (l *Loader) restoreData(ctx context.Context) error {
 tblSchemaLoop:
 	for _, db := range dbs {
 		for table := range l.db2Tables[db] {
-			schemaFile := l.cfg.Dir + "/" + db + "." + table + "-schema.sql" // cache friendly
+			schemaFile := l.dumpFilePath(db + "." + table + "-schema.sql")
 			if _, ok := l.tableInfos[tableName(db, table)]; !ok {
 				l.tableInfos[tableName(db, table)], err = parseTable(tctx, l.tableRouter, db, table, schemaFile, l.cfg.LoaderConfig.SQLMode, l.cfg.SourceID)
 				if err != nil {
@@ -1440,6 +1560,7 @@ tblSchemaLoop:
 				database: db,
 				table:    table,
 				filepath: schemaFile,
+				info:     l.tableInfos[tableName(db, table)],
 			})
 			if err != nil {
 				break tblSchemaLoop
@@ -1491,6 +1612,7 @@ tblSchemaLoop:
 					table:    table,
 					dataFile: file,
 					offset:   offset,
+					size:     l.dataFileSizes[file],
 					info:     l.tableInfos[tableName(db, table)],
 				}
 			}
@@ -1511,6 +1633,14 @@ tblSchemaLoop:
 	return nil
 }
 
+// dumpFilePath returns the path of the dump file by the name without compression extension.
+func (l *Loader) dumpFilePath(name string) string {
+	if realName, ok := l.dumpFileNames[name]; ok {
+		name = realName
+	}
+	return filepath.Join(l.cfg.Dir, name)
+}
+
 // checkpointID returns ID which used for checkpoint table.
 func (l *Loader) checkpointID() string {
 	if len(l.cfg.SourceID) > 0 {
@@ -15,9 +15,15 @@ package loader
 
 import (
 	"context"
+	"hash/crc32"
+	"os"
+	"path/filepath"
 
 	. "github.com/pingcap/check"
 	"github.com/pingcap/errors"
+
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 )
 
 var _ = Suite(&testLoaderSuite{})
@@ -80,3 +86,63 @@ func (*testLoaderSuite) TestJobQueue(c *C) {
 		c.Assert(err, Equals, testcase.exceptedErr)
 	}
 }
+
+func (*testLoaderSuite) TestDispatchSQLChecksum(c *C) {
+	ctx := context.Background()
+	dir := c.MkDir()
+	filename := "db.tbl.0.sql"
+	file := filepath.Join(dir, filename)
+	data := "/*!40101 SET NAMES binary*/;\nINSERT INTO `tbl` VALUES\n(1),\n(2);\nINSERT INTO `tbl` VALUES\n(3);\nINSERT INTO `tbl` VALUES\n(4);\n"
+	c.Assert(os.WriteFile(file, []byte(data), 0o644), IsNil)
+
+	cp := &RemoteCheckPoint{}
+	cp.restoringFiles.pos = map[string]map[string]FilePosSet{
+		"db": {"tbl": {filename: {0, int64(len(data))}}},
+	}
+	cp.restoringFiles.checksums = map[string]uint32{filename: 0}
+	w := &Worker{
+		checkPoint: cp,
+		jobQueue:   make(chan *dataJob, 10),
+		loader:     &Loader{},
+		logger:     log.L(),
+	}
+	table := &tableInfo{sourceSchema: "db", sourceTable: "tbl", targetSchema: "db", targetTable: "tbl"}
+	dispatch := func(offset int64) ([]*dataJob, error) {
+		err := w.dispatchSQL(ctx, file, offset, int64(len(data)), table)
+		close(w.jobQueue)
+		jobs := make([]*dataJob, 0, 3)
+		for job := range w.jobQueue {
+			jobs = append(jobs, job)
+		}
+		w.jobQueue = make(chan *dataJob, 10)
+		return jobs, err
+	}
+
+	jobs, err := dispatch(0)
+	c.Assert(err, IsNil)
+	c.Assert(jobs, HasLen, 3)
+	for _, job := range jobs {
+		c.Assert(job.checksum, Equals, crc32.ChecksumIEEE([]byte(data[:job.offset])))
+	}
+
+	// resume from the first job
+	c.Assert(cp.UpdateOffset(filename, jobs[0].offset, jobs[0].checksum), IsNil)
+	resumed, err := dispatch(jobs[0].offset)
+	c.Assert(err, IsNil)
+	c.Assert(resumed, HasLen, 2)
+	c.Assert(resumed[0].sql, Equals, jobs[1].sql)
+	c.Assert(resumed[1].offset, Equals, jobs[2].offset)
+	c.Assert(resumed[1].checksum, Equals, jobs[2].checksum)
+
+	// the imported part of the data file is changed
+	c.Assert(cp.UpdateOffset(filename, jobs[0].offset, jobs[0].checksum+1), IsNil)
+	_, err = dispatch(jobs[0].offset)
+	c.Assert(terror.ErrLoadUnitChecksumMismatch.Equal(err), IsTrue)
+
+	// the checksum is not recorded by the checkpoint saved by older versions
+	delete(cp.restoringFiles.checksums, filename)
+	resumed, err = dispatch(jobs[1].offset)
+	c.Assert(err, IsNil)
+	c.Assert(resumed, HasLen, 1)
+	c.Assert(resumed[0].checksum, Equals, jobs[2].checksum)
+}
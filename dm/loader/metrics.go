@@ -16,6 +16,8 @@ package loader
 import (
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/dm/pb"
 	"github.com/pingcap/tiflow/dm/pkg/metricsproxy"
 )
 
@@ -88,6 +90,38 @@ var (
 			Help:      "the processing progress of loader in percentage",
 		}, []string{"task", "source_id"})
 
+	finishedDataFileGauge = metricsproxy.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "finished_data_file_gauge",
+			Help:      "finished data files in total",
+		}, []string{"task", "source_id"})
+
+	speedGauge = metricsproxy.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "speed",
+			Help:      "the recent speed of loader in rows or bytes per second",
+		}, []string{"task", "source_id", "type"})
+
+	estimatedRemainingTimeGauge = metricsproxy.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "estimated_remaining_time",
+			Help:      "the remaining time in second to finish load process of the task estimated by the recent speed",
+		}, []string{"task", "source_id"})
+
+	bottleneckGauge = metricsproxy.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "bottleneck",
+			Help:      "whether reading data files or writing to downstream is the bottleneck of loader, 1 means it is",
+		}, []string{"task", "source_id", "type"})
+
 	// should alert.
 	loaderExitWithErrorCounter = metricsproxy.NewCounterVec(
 		prometheus.CounterOpts{
@@ -116,6 +150,10 @@ func RegisterMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(tableGauge)
 	registry.MustRegister(dataSizeGauge)
 	registry.MustRegister(progressGauge)
+	registry.MustRegister(finishedDataFileGauge)
+	registry.MustRegister(speedGauge)
+	registry.MustRegister(estimatedRemainingTimeGauge)
+	registry.MustRegister(bottleneckGauge)
 	registry.MustRegister(loaderExitWithErrorCounter)
 	registry.MustRegister(remainingTimeGauge)
 }
@@ -129,6 +167,30 @@ func (l *Loader) removeLabelValuesWithTaskInMetrics(task string) {
 	tableGauge.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 	dataSizeGauge.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 	progressGauge.DeleteAllAboutLabels(prometheus.Labels{"task": task})
+	removeProgressMetrics(task)
 	loaderExitWithErrorCounter.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 	remainingTimeGauge.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 }
+
+// setProgressMetrics sets the metrics of the progress in load status.
+func setProgressMetrics(cfg *config.SubTaskConfig, s *pb.LoadStatus) {
+	finishedDataFileGauge.WithLabelValues(cfg.Name, cfg.SourceID).Set(float64(s.FinishedChunks))
+	speedGauge.WithLabelValues(cfg.Name, cfg.SourceID, "rows").Set(float64(s.RowsPerSecond))
+	speedGauge.WithLabelValues(cfg.Name, cfg.SourceID, "bytes").Set(float64(s.BytesPerSecond))
+	estimatedRemainingTimeGauge.WithLabelValues(cfg.Name, cfg.SourceID).Set(float64(s.RemainingSeconds))
+	for _, tp := range []string{bottleneckRead, bottleneckWrite} {
+		v := 0.0
+		if s.Bottleneck == tp {
+			v = 1
+		}
+		bottleneckGauge.WithLabelValues(cfg.Name, cfg.SourceID, tp).Set(v)
+	}
+}
+
+// removeProgressMetrics removes the metrics set by setProgressMetrics.
+func removeProgressMetrics(task string) {
+	finishedDataFileGauge.DeleteAllAboutLabels(prometheus.Labels{"task": task})
+	speedGauge.DeleteAllAboutLabels(prometheus.Labels{"task": task})
+	estimatedRemainingTimeGauge.DeleteAllAboutLabels(prometheus.Labels{"task": task})
+	bottleneckGauge.DeleteAllAboutLabels(prometheus.Labels{"task": task})
+}
@@ -0,0 +1,258 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/types"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+// columnNameMapper finds the column name mapping of the pre-created downstream tables.
+type columnNameMapper struct {
+	filters  []*filter.Filter
+	mappings []map[string]string
+}
+
+func newColumnNameMapper(mappings []*config.ColumnNameMapping, caseSensitive bool) (*columnNameMapper, error) {
+	m := &columnNameMapper{
+		filters:  make([]*filter.Filter, 0, len(mappings)),
+		mappings: make([]map[string]string, 0, len(mappings)),
+	}
+	for _, mapping := range mappings {
+		f, err := filter.New(caseSensitive, &filter.Rules{DoTables: []*filter.Table{{Schema: mapping.Schema, Name: mapping.Table}}})
+		if err != nil {
+			return nil, terror.ErrConfigInvalidSkipCreateTable.Delegate(err, fmt.Sprintf("invalid pattern %s.%s", mapping.Schema, mapping.Table))
+		}
+		// column names are case-insensitive
+		columns := make(map[string]string, len(mapping.Columns))
+		for from, to := range mapping.Columns {
+			columns[strings.ToLower(from)] = to
+		}
+		m.filters = append(m.filters, f)
+		m.mappings = append(m.mappings, columns)
+	}
+	return m, nil
+}
+
+// columns returns the column name mapping of the downstream table, the keys are lower case dumped column names.
+func (m *columnNameMapper) columns(schema, table string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	for i, f := range m.filters {
+		if f.Match(&filter.Table{Schema: schema, Name: table}) {
+			return m.mappings[i]
+		}
+	}
+	return nil
+}
+
+// checkPreCreatedTable checks the pre-created downstream table is compatible with the dumped table, and rewrites the
+// INSERT statements of the table with the downstream column names if they're different from the dumped columns.
+func (l *Loader) checkPreCreatedTable(ctx context.Context, conn *DBConn, sqlFile string, table *tableInfo) error {
+	tctx := tcontext.NewContext(ctx, l.logger)
+	sourceName := tableName(table.sourceSchema, table.sourceTable)
+	targetName := tableName(table.targetSchema, table.targetTable)
+
+	parser2, err := utils.GetParserFromSQLModeStr(l.cfg.SQLMode)
+	if err != nil {
+		return err
+	}
+	statement, err := exportStatement(sqlFile)
+	if err != nil {
+		return err
+	}
+	source, err := dbutil.GetTableInfoBySQL(string(statement), parser2)
+	if err != nil {
+		return terror.ErrLoadUnitParseStatement.Delegate(err, statement)
+	}
+
+	createSQL, err := getTableCreateSQL(tctx, conn, targetName)
+	if err != nil {
+		return terror.ErrLoadUnitIncompatibleTable.Delegate(err, targetName, sourceName, "fail to get the structure of downstream table")
+	}
+	target, err := dbutil.GetTableInfoBySQL(createSQL, parser2)
+	if err != nil {
+		return terror.ErrLoadUnitParseStatement.Delegate(err, createSQL)
+	}
+
+	columns, err := mapDumpedColumns(source, target, table.columnNameList, l.columnNameMapper.columns(table.targetSchema, table.targetTable))
+	if err != nil {
+		return terror.ErrLoadUnitIncompatibleTable.Generate(targetName, sourceName, err.Error())
+	}
+
+	// the dumped values are imported by position if the columns are the same as the downstream table, otherwise the
+	// INSERT statements are rewritten with the column names.
+	insertable := make([]string, 0, len(target.Columns))
+	for _, col := range target.Columns {
+		if !col.IsGenerated() {
+			insertable = append(insertable, col.Name.L)
+		}
+	}
+	table.explicitColumns = strings.Join(columns, ",") != strings.Join(insertable, ",")
+	if table.explicitColumns {
+		quoted := make([]string, 0, len(columns))
+		for _, col := range columns {
+			quoted = append(quoted, dbutil.ColumnName(col))
+		}
+		table.insertHeadStmt = fmt.Sprintf("INSERT INTO `%s` (%s) VALUES", table.targetTable, strings.Join(quoted, ","))
+	}
+	l.logger.Info("pre-created table is compatible with dumped table",
+		zap.String("source table", sourceName),
+		zap.String("target table", targetName),
+		zap.Strings("columns", columns),
+		zap.Bool("explicit columns", table.explicitColumns))
+	return nil
+}
+
+// getTableCreateSQL gets the statement to create the table by `SHOW CREATE TABLE`.
+func getTableCreateSQL(tctx *tcontext.Context, conn *DBConn, tableID string) (string, error) {
+	rows, err := conn.querySQL(tctx, fmt.Sprintf("SHOW CREATE TABLE %s", tableID))
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	var table, createSQL string
+	if rows.Next() {
+		if err = rows.Scan(&table, &createSQL); err != nil {
+			return "", terror.DBErrorAdapt(err, terror.ErrDBDriverError)
+		}
+	}
+	return createSQL, terror.DBErrorAdapt(rows.Err(), terror.ErrDBDriverError)
+}
+
+// mapDumpedColumns maps the dumped columns to the columns of the downstream table and checks they're compatible, the
+// dumped columns are the non-generated columns of the source table and the extended columns. it returns the lower
+// case names of the downstream columns in the same order as the dumped columns.
+func mapDumpedColumns(source, target *model.TableInfo, dumped []string, nameMapping map[string]string) ([]string, error) {
+	columns := make([]string, 0, len(dumped))
+	used := make(map[string]struct{}, len(dumped))
+	for _, name := range dumped {
+		targetName := name
+		if mapped, ok := nameMapping[strings.ToLower(name)]; ok {
+			targetName = mapped
+		}
+		targetCol := model.FindColumnInfo(target.Columns, targetName)
+		if targetCol == nil {
+			return nil, errors.Errorf("column %s is not found in downstream table for dumped column %s", targetName, name)
+		}
+		if targetCol.IsGenerated() {
+			return nil, errors.Errorf("column %s of downstream table is a generated column", targetCol.Name.O)
+		}
+		if _, ok := used[targetCol.Name.L]; ok {
+			return nil, errors.Errorf("column %s of downstream table is mapped from multiple dumped columns", targetCol.Name.O)
+		}
+		used[targetCol.Name.L] = struct{}{}
+
+		// the extended columns are not in the source table
+		if sourceCol := model.FindColumnInfo(source.Columns, name); sourceCol != nil && !isTypeCompatible(&sourceCol.FieldType, &targetCol.FieldType) {
+			return nil, errors.Errorf("type %s of column %s of downstream table is not compatible with type %s of dumped column %s",
+				targetCol.FieldType.InfoSchemaStr(), targetCol.Name.O, sourceCol.FieldType.InfoSchemaStr(), name)
+		}
+		columns = append(columns, targetCol.Name.L)
+	}
+
+	for _, col := range target.Columns {
+		if _, ok := used[col.Name.L]; ok || col.IsGenerated() {
+			continue
+		}
+		if mysql.HasNotNullFlag(col.Flag) && mysql.HasNoDefaultValueFlag(col.Flag) && !mysql.HasAutoIncrementFlag(col.Flag) {
+			return nil, errors.Errorf("column %s of downstream table is NOT NULL without default value, but it's not dumped", col.Name.O)
+		}
+	}
+	return columns, nil
+}
+
+// integerTypeSize is the storage size of the integer types.
+var integerTypeSize = map[byte]int{
+	mysql.TypeTiny:     1,
+	mysql.TypeShort:    2,
+	mysql.TypeInt24:    3,
+	mysql.TypeLong:     4,
+	mysql.TypeLonglong: 8,
+}
+
+// isTypeCompatible checks whether the values of the source type can be stored in the target type without losing
+// precision or being truncated.
+func isTypeCompatible(source, target *types.FieldType) bool {
+	sourceEval, targetEval := source.EvalType(), target.EvalType()
+	// the values of timestamp can be stored in datetime
+	if sourceEval != targetEval && !(sourceEval == types.ETTimestamp && targetEval == types.ETDatetime) {
+		return false
+	}
+
+	switch sourceEval {
+	case types.ETInt:
+		sourceSize, ok1 := integerTypeSize[source.Tp]
+		targetSize, ok2 := integerTypeSize[target.Tp]
+		if !ok1 || !ok2 {
+			// BIT, YEAR and ENUM/SET as integer
+			return source.Tp == target.Tp && source.Flen <= target.Flen
+		}
+		sourceUnsigned, targetUnsigned := mysql.HasUnsignedFlag(source.Flag), mysql.HasUnsignedFlag(target.Flag)
+		switch {
+		case sourceUnsigned == targetUnsigned:
+			return sourceSize <= targetSize
+		case sourceUnsigned:
+			// signed integer needs one more bit to store the unsigned values
+			return sourceSize < targetSize
+		default:
+			// negative values can't be stored in unsigned integer
+			return false
+		}
+	case types.ETReal:
+		return !(source.Tp == mysql.TypeDouble && target.Tp == mysql.TypeFloat)
+	case types.ETDecimal:
+		return source.Flen-source.Decimal <= target.Flen-target.Decimal && source.Decimal <= target.Decimal
+	case types.ETDatetime, types.ETTimestamp, types.ETDuration:
+		if source.Tp != mysql.TypeDate && target.Tp == mysql.TypeDate {
+			return false
+		}
+		return source.Decimal == types.UnspecifiedLength || target.Decimal == types.UnspecifiedLength || source.Decimal <= target.Decimal
+	case types.ETString:
+		if target.Tp == mysql.TypeEnum || target.Tp == mysql.TypeSet {
+			if source.Tp != target.Tp {
+				return false
+			}
+			// all elements of the source type should be in the target type
+			elems := make(map[string]struct{}, len(target.Elems))
+			for _, e := range target.Elems {
+				elems[strings.ToLower(e)] = struct{}{}
+			}
+			for _, e := range source.Elems {
+				if _, ok := elems[strings.ToLower(e)]; !ok {
+					return false
+				}
+			}
+			return true
+		}
+		return source.Flen == types.UnspecifiedLength || target.Flen == types.UnspecifiedLength || source.Flen <= target.Flen
+	}
+	return true
+}
@@ -0,0 +1,223 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/model"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+var _ = Suite(&testPreCreatedTableSuite{})
+
+type testPreCreatedTableSuite struct{}
+
+func mustTableInfo(c *C, createSQL string) *model.TableInfo {
+	info, err := dbutil.GetTableInfoBySQL(createSQL, parser.New())
+	c.Assert(err, IsNil)
+	return info
+}
+
+func (t *testPreCreatedTableSuite) TestColumnNameMapper(c *C) {
+	var m *columnNameMapper
+	c.Assert(m.columns("shop", "orders"), IsNil)
+
+	m, err := newColumnNameMapper([]*config.ColumnNameMapping{
+		{Schema: "shop", Table: "orders", Columns: map[string]string{"ID": "order_id"}},
+		{Schema: "shop", Table: "~^order", Columns: map[string]string{"id": "item_id"}},
+	}, false)
+	c.Assert(err, IsNil)
+	c.Assert(m.columns("shop", "Orders"), DeepEquals, map[string]string{"id": "order_id"})
+	c.Assert(m.columns("shop", "order_items"), DeepEquals, map[string]string{"id": "item_id"})
+	c.Assert(m.columns("shop", "users"), IsNil)
+}
+
+func (t *testPreCreatedTableSuite) TestMapDumpedColumns(c *C) {
+	source := mustTableInfo(c, "CREATE TABLE t (id INT PRIMARY KEY, name VARCHAR(20), price DECIMAL(10,2), total INT AS (id * 2))")
+
+	cases := []struct {
+		target  string
+		dumped  []string
+		mapping map[string]string
+		columns []string
+		err     string
+	}{
+		{
+			target:  "CREATE TABLE t (id INT PRIMARY KEY, name VARCHAR(20), price DECIMAL(10,2))",
+			dumped:  []string{"id", "name", "price"},
+			columns: []string{"id", "name", "price"},
+		},
+		{
+			// different order, wider types, and extra columns with default values
+			target:  "CREATE TABLE t (ID BIGINT PRIMARY KEY, price DECIMAL(12,3), name TEXT, c1 INT, c2 INT NOT NULL DEFAULT 1, c3 INT AUTO_INCREMENT UNIQUE)",
+			dumped:  []string{"id", "name", "price"},
+			columns: []string{"id", "name", "price"},
+		},
+		{
+			target:  "CREATE TABLE t (order_id INT PRIMARY KEY, name VARCHAR(20), price DECIMAL(10,2), c_table VARCHAR(20))",
+			dumped:  []string{"id", "name", "price", "c_table"},
+			mapping: map[string]string{"id": "order_id"},
+			columns: []string{"order_id", "name", "price", "c_table"},
+		},
+		{
+			target: "CREATE TABLE t (order_id INT PRIMARY KEY, name VARCHAR(20), price DECIMAL(10,2))",
+			dumped: []string{"id", "name", "price"},
+			err:    "column id is not found in downstream table for dumped column id",
+		},
+		{
+			target:  "CREATE TABLE t (id INT PRIMARY KEY, name VARCHAR(20), price DECIMAL(10,2))",
+			dumped:  []string{"id", "name", "price"},
+			mapping: map[string]string{"name": "id"},
+			err:     "column id of downstream table is mapped from multiple dumped columns",
+		},
+		{
+			target: "CREATE TABLE t (id INT PRIMARY KEY, name VARCHAR(10), price DECIMAL(10,2))",
+			dumped: []string{"id", "name", "price"},
+			err:    "type varchar\\(10\\) of column name of downstream table is not compatible with type varchar\\(20\\) of dumped column name",
+		},
+		{
+			target: "CREATE TABLE t (id INT PRIMARY KEY, name VARCHAR(20), price DECIMAL(10,2) AS (id + 1))",
+			dumped: []string{"id", "name", "price"},
+			err:    "column price of downstream table is a generated column",
+		},
+		{
+			target: "CREATE TABLE t (id INT PRIMARY KEY, name VARCHAR(20), price DECIMAL(10,2), c1 INT NOT NULL)",
+			dumped: []string{"id", "name", "price"},
+			err:    "column c1 of downstream table is NOT NULL without default value, but it's not dumped",
+		},
+	}
+	for i, cs := range cases {
+		columns, err := mapDumpedColumns(source, mustTableInfo(c, cs.target), cs.dumped, cs.mapping)
+		if cs.err != "" {
+			c.Assert(err, ErrorMatches, cs.err, Commentf("case %d", i))
+			continue
+		}
+		c.Assert(err, IsNil, Commentf("case %d", i))
+		c.Assert(columns, DeepEquals, cs.columns, Commentf("case %d", i))
+	}
+}
+
+func (t *testPreCreatedTableSuite) TestIsTypeCompatible(c *C) {
+	cases := []struct {
+		source, target string
+		compatible     bool
+	}{
+		{"INT", "INT", true},
+		{"INT", "BIGINT", true},
+		{"BIGINT", "INT", false},
+		{"INT UNSIGNED", "BIGINT", true},
+		{"INT UNSIGNED", "INT", false},
+		{"INT", "INT UNSIGNED", false},
+		{"INT", "VARCHAR(20)", false},
+		{"YEAR", "YEAR", true},
+		{"BIT(8)", "BIT(4)", false},
+		{"FLOAT", "DOUBLE", true},
+		{"DOUBLE", "FLOAT", false},
+		{"DECIMAL(10,2)", "DECIMAL(11,2)", true},
+		{"DECIMAL(10,2)", "DECIMAL(10,3)", false},
+		{"DECIMAL(10,2)", "DOUBLE", false},
+		{"TIMESTAMP", "DATETIME", true},
+		{"DATETIME", "TIMESTAMP", false},
+		{"DATETIME(6)", "DATETIME(3)", false},
+		{"DATETIME", "DATE", false},
+		{"DATE", "DATETIME", true},
+		{"CHAR(10)", "VARCHAR(20)", true},
+		{"VARCHAR(20)", "TEXT", true},
+		{"TEXT", "VARCHAR(20)", false},
+		{"ENUM('a','b')", "ENUM('b','a','c')", true},
+		{"ENUM('a','b')", "ENUM('a')", false},
+		{"VARCHAR(1)", "ENUM('a')", false},
+		{"ENUM('a','b')", "VARCHAR(10)", true},
+		{"JSON", "JSON", true},
+		{"JSON", "TEXT", false},
+	}
+	for _, cs := range cases {
+		source := mustTableInfo(c, "CREATE TABLE t (c "+cs.source+")").Columns[0]
+		target := mustTableInfo(c, "CREATE TABLE t (c "+cs.target+")").Columns[0]
+		c.Assert(isTypeCompatible(&source.FieldType, &target.FieldType), Equals, cs.compatible, Commentf("%s -> %s", cs.source, cs.target))
+	}
+}
+
+func (t *testPreCreatedTableSuite) TestCheckPreCreatedTable(c *C) {
+	schemaFile := filepath.Join(c.MkDir(), "shop.orders-schema.sql")
+	c.Assert(os.WriteFile(schemaFile, []byte("/*!40101 SET NAMES binary*/;\nCREATE TABLE `orders` (\n`id` int(11) NOT NULL,\n`name` varchar(20) DEFAULT NULL,\nPRIMARY KEY (`id`)\n);\n"), 0o644), IsNil)
+
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	dbConn, err := db.Conn(context.Background())
+	c.Assert(err, IsNil)
+	session := &DBConn{baseConn: conn.NewBaseConn(dbConn, nil)}
+
+	cfg := &config.SubTaskConfig{}
+	cfg.SkipCreateTable = true
+	l := &Loader{cfg: cfg, logger: log.L()}
+	l.columnNameMapper, err = newColumnNameMapper([]*config.ColumnNameMapping{
+		{Schema: "shop", Table: "orders", Columns: map[string]string{"id": "order_id"}},
+	}, false)
+	c.Assert(err, IsNil)
+
+	newTableInfo := func() *tableInfo {
+		return &tableInfo{
+			sourceSchema:   "shop",
+			sourceTable:    "orders",
+			targetSchema:   "shop",
+			targetTable:    "orders",
+			columnNameList: []string{"id", "name"},
+			insertHeadStmt: "INSERT INTO `orders` VALUES",
+		}
+	}
+	showCreateRows := func(createSQL string) *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"Table", "Create Table"}).AddRow("orders", createSQL)
+	}
+
+	// the INSERT statements are rewritten with the mapped columns
+	table := newTableInfo()
+	mock.ExpectQuery("SHOW CREATE TABLE `shop`.`orders`").WillReturnRows(
+		showCreateRows("CREATE TABLE `orders` (`name` varchar(50), `order_id` bigint NOT NULL, PRIMARY KEY (`order_id`))"))
+	c.Assert(l.checkPreCreatedTable(context.Background(), session, schemaFile, table), IsNil)
+	c.Assert(table.explicitColumns, IsTrue)
+	c.Assert(table.insertHeadStmt, Equals, "INSERT INTO `orders` (`order_id`,`name`) VALUES")
+
+	// the columns are the same, import the values by position
+	table = newTableInfo()
+	mock.ExpectQuery("SHOW CREATE TABLE `shop`.`orders`").WillReturnRows(
+		showCreateRows("CREATE TABLE `orders` (`order_id` int NOT NULL, `name` varchar(20), PRIMARY KEY (`order_id`))"))
+	c.Assert(l.checkPreCreatedTable(context.Background(), session, schemaFile, table), IsNil)
+	c.Assert(table.explicitColumns, IsFalse)
+	c.Assert(table.insertHeadStmt, Equals, "INSERT INTO `orders` VALUES")
+
+	// incompatible table
+	mock.ExpectQuery("SHOW CREATE TABLE `shop`.`orders`").WillReturnRows(
+		showCreateRows("CREATE TABLE `orders` (`order_id` int NOT NULL, `name` varchar(10), PRIMARY KEY (`order_id`))"))
+	err = l.checkPreCreatedTable(context.Background(), session, schemaFile, newTableInfo())
+	c.Assert(terror.ErrLoadUnitIncompatibleTable.Equal(err), IsTrue)
+
+	// table not exists
+	mock.ExpectQuery("SHOW CREATE TABLE `shop`.`orders`").WillReturnError(&mysql.MySQLError{Number: 1146, Message: "Table 'shop.orders' doesn't exist"})
+	err = l.checkPreCreatedTable(context.Background(), session, schemaFile, newTableInfo())
+	c.Assert(terror.ErrLoadUnitIncompatibleTable.Equal(err), IsTrue)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+}
@@ -0,0 +1,146 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/dm/pb"
+)
+
+const (
+	// bottleneckRead means the data files are read slower than written to downstream.
+	bottleneckRead = "read"
+	// bottleneckWrite means the data are written to downstream slower than read from the data files.
+	bottleneckWrite = "write"
+
+	// speedSampleInterval is the minimal interval of the samples to calculate the recent speed, the speed is not
+	// changed in the interval to avoid fluctuation.
+	speedSampleInterval = 10 * time.Second
+)
+
+// progressSample is a sample of the finished work of load unit.
+type progressSample struct {
+	time  time.Time
+	bytes int64
+	rows  int64
+	// the time of readers waiting for writers, which means writing is the bottleneck
+	readerWait time.Duration
+	// the time of writers waiting for readers, which means reading is the bottleneck
+	writerWait time.Duration
+}
+
+// speedMeter calculates the recent speed and bottleneck of load unit by the samples.
+type speedMeter struct {
+	sync.Mutex
+	last           progressSample
+	bytesPerSecond int64
+	rowsPerSecond  int64
+	bottleneck     string
+}
+
+// reset resets the speed meter with the first sample.
+func (m *speedMeter) reset(first progressSample) {
+	m.Lock()
+	defer m.Unlock()
+	m.last = first
+	m.bytesPerSecond, m.rowsPerSecond, m.bottleneck = 0, 0, ""
+}
+
+// update updates the speed and bottleneck by the current sample if the last sample is old enough, and returns them.
+func (m *speedMeter) update(cur progressSample) (bytesPerSecond, rowsPerSecond int64, bottleneck string) {
+	m.Lock()
+	defer m.Unlock()
+	if m.last.time.IsZero() {
+		m.last = cur
+		return 0, 0, ""
+	}
+	if interval := cur.time.Sub(m.last.time); interval >= speedSampleInterval {
+		seconds := interval.Seconds()
+		m.bytesPerSecond = int64(float64(cur.bytes-m.last.bytes) / seconds)
+		m.rowsPerSecond = int64(float64(cur.rows-m.last.rows) / seconds)
+		readerWait, writerWait := cur.readerWait-m.last.readerWait, cur.writerWait-m.last.writerWait
+		switch {
+		case readerWait > writerWait:
+			m.bottleneck = bottleneckWrite
+		case writerWait > readerWait:
+			m.bottleneck = bottleneckRead
+		default:
+			m.bottleneck = ""
+		}
+		m.last = cur
+	}
+	return m.bytesPerSecond, m.rowsPerSecond, m.bottleneck
+}
+
+// remainingSeconds estimates the remaining seconds by the speed, 0 means unknown or finished.
+func remainingSeconds(finished, total, bytesPerSecond int64) int64 {
+	if bytesPerSecond <= 0 || finished >= total {
+		return 0
+	}
+	return (total - finished + bytesPerSecond - 1) / bytesPerSecond
+}
+
+// progressSample samples the finished work of the loader.
+func (l *Loader) progressSample() progressSample {
+	return progressSample{
+		time:       time.Now(),
+		bytes:      l.finishedDataSize.Load(),
+		rows:       l.finishedRows.Load(),
+		readerWait: l.readerWaitTime.Load(),
+		writerWait: l.writerWaitTime.Load(),
+	}
+}
+
+// tableProgress returns the progress of the source tables sorted by names, and the number of finished and total
+// chunks of all tables. a chunk is a data file, which is finished when its offset in checkpoint reaches the end.
+func (l *Loader) tableProgress() ([]*pb.LoadTableProgress, int64, int64) {
+	l.RLock()
+	defer l.RUnlock()
+	if l.checkPoint == nil {
+		return nil, 0, 0
+	}
+
+	var (
+		tables                      = make([]*pb.LoadTableProgress, 0, len(l.db2Tables))
+		finishedChunks, totalChunks int64
+	)
+	for db, tbls := range l.db2Tables {
+		for table, files := range tbls {
+			p := &pb.LoadTableProgress{Schema: db, Table: table, TotalChunks: int64(len(files))}
+			restoringFiles := l.checkPoint.GetRestoringFileInfo(db, table)
+			for _, file := range files {
+				p.TotalBytes += l.dataFileSizes[file]
+				if pos, ok := restoringFiles[file]; ok {
+					p.FinishedBytes += pos[0]
+					if pos[0] >= pos[1] {
+						p.FinishedChunks++
+					}
+				}
+			}
+			finishedChunks += p.FinishedChunks
+			totalChunks += p.TotalChunks
+			tables = append(tables, p)
+		}
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		if tables[i].Schema != tables[j].Schema {
+			return tables[i].Schema < tables[j].Schema
+		}
+		return tables[i].Table < tables[j].Table
+	})
+	return tables, finishedChunks, totalChunks
+}
@@ -0,0 +1,105 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"time"
+
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/dm/pb"
+)
+
+var _ = Suite(&testProgressSuite{})
+
+type testProgressSuite struct{}
+
+func (t *testProgressSuite) TestSpeedMeter(c *C) {
+	var m speedMeter
+	now := time.Now()
+	m.reset(progressSample{time: now})
+
+	// not long enough to calculate the speed
+	bps, rps, bottleneck := m.update(progressSample{time: now.Add(time.Second), bytes: 100, rows: 10})
+	c.Assert(bps, Equals, int64(0))
+	c.Assert(rps, Equals, int64(0))
+	c.Assert(bottleneck, Equals, "")
+
+	bps, rps, bottleneck = m.update(progressSample{
+		time:       now.Add(speedSampleInterval),
+		bytes:      1000,
+		rows:       100,
+		readerWait: 5 * time.Second,
+		writerWait: time.Second,
+	})
+	c.Assert(bps, Equals, int64(100))
+	c.Assert(rps, Equals, int64(10))
+	c.Assert(bottleneck, Equals, bottleneckWrite)
+
+	// the speed is kept in the interval
+	bps, rps, bottleneck = m.update(progressSample{time: now.Add(speedSampleInterval + time.Second), bytes: 5000})
+	c.Assert(bps, Equals, int64(100))
+	c.Assert(rps, Equals, int64(10))
+	c.Assert(bottleneck, Equals, bottleneckWrite)
+
+	bps, _, bottleneck = m.update(progressSample{
+		time:       now.Add(2 * speedSampleInterval),
+		bytes:      3000,
+		rows:       200,
+		readerWait: 6 * time.Second,
+		writerWait: 8 * time.Second,
+	})
+	c.Assert(bps, Equals, int64(200))
+	c.Assert(bottleneck, Equals, bottleneckRead)
+
+	c.Assert(remainingSeconds(3000, 10000, 0), Equals, int64(0))
+	c.Assert(remainingSeconds(10000, 10000, 200), Equals, int64(0))
+	c.Assert(remainingSeconds(3000, 10000, 200), Equals, int64(35))
+	c.Assert(remainingSeconds(3000, 10001, 200), Equals, int64(36))
+}
+
+func (t *testProgressSuite) TestTableProgress(c *C) {
+	l := &Loader{}
+	tables, finished, total := l.tableProgress()
+	c.Assert(tables, HasLen, 0)
+	c.Assert(finished, Equals, int64(0))
+	c.Assert(total, Equals, int64(0))
+
+	cp := &RemoteCheckPoint{}
+	cp.restoringFiles.pos = map[string]map[string]FilePosSet{
+		"db1": {"t1": {
+			"db1.t1.0.sql": {100, 100},
+			"db1.t1.1.sql": {50, 200},
+		}},
+		"db2": {"t2": {}},
+	}
+	l.checkPoint = cp
+	l.db2Tables = map[string]Tables2DataFiles{
+		"db2": {"t2": {"db2.t2.0.sql"}},
+		"db1": {"t1": {"db1.t1.0.sql", "db1.t1.1.sql", "db1.t1.2.sql"}},
+	}
+	l.dataFileSizes = map[string]int64{
+		"db1.t1.0.sql": 100,
+		"db1.t1.1.sql": 200,
+		"db1.t1.2.sql": 300,
+		"db2.t2.0.sql": 400,
+	}
+	tables, finished, total = l.tableProgress()
+	c.Assert(finished, Equals, int64(1))
+	c.Assert(total, Equals, int64(4))
+	c.Assert(tables, DeepEquals, []*pb.LoadTableProgress{
+		{Schema: "db1", Table: "t1", FinishedChunks: 1, TotalChunks: 3, FinishedBytes: 150, TotalBytes: 600},
+		{Schema: "db2", Table: "t2", FinishedChunks: 0, TotalChunks: 1, FinishedBytes: 0, TotalBytes: 400},
+	})
+}
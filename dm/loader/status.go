@@ -27,19 +27,29 @@ func (l *Loader) Status(_ *binlog.SourceStatus) interface{} {
 	finishedSize := l.finishedDataSize.Load()
 	totalSize := l.totalDataSize.Load()
 	progress := percent(finishedSize, totalSize, l.finish.Load())
+	bytesPerSecond, rowsPerSecond, bottleneck := l.speed.update(l.progressSample())
+	tables, finishedChunks, totalChunks := l.tableProgress()
 	s := &pb.LoadStatus{
-		FinishedBytes:  finishedSize,
-		TotalBytes:     totalSize,
-		Progress:       progress,
-		MetaBinlog:     l.metaBinlog.Load(),
-		MetaBinlogGTID: l.metaBinlogGTID.Load(),
+		FinishedBytes:    finishedSize,
+		TotalBytes:       totalSize,
+		Progress:         progress,
+		MetaBinlog:       l.metaBinlog.Load(),
+		MetaBinlogGTID:   l.metaBinlogGTID.Load(),
+		FinishedChunks:   finishedChunks,
+		TotalChunks:      totalChunks,
+		RowsPerSecond:    rowsPerSecond,
+		BytesPerSecond:   bytesPerSecond,
+		RemainingSeconds: remainingSeconds(finishedSize, totalSize, bytesPerSecond),
+		Bottleneck:       bottleneck,
+		Tables:           tables,
+		BadRows:          l.badRows.rejectedCount(),
 	}
-	go l.printStatus()
+	go l.printStatus(s)
 	return s
 }
 
 // printStatus prints status like progress percentage.
-func (l *Loader) printStatus() {
+func (l *Loader) printStatus(s *pb.LoadStatus) {
 	finishedSize := l.finishedDataSize.Load()
 	totalSize := l.totalDataSize.Load()
 	totalFileCount := l.totalFileCount.Load()
@@ -53,7 +63,7 @@ func (l *Loader) printStatus() {
 	for db, tables := range l.dbTableDataFinishedSize {
 		for table, size := range tables {
 			curFinished := size.Load()
-			lastFinished := l.dbTableDataFinishedSize[db][table].Load()
+			lastFinished := l.dbTableDataLastFinishedSize[db][table].Load()
 			speed := float64(curFinished-lastFinished) / intervalSecond
 			l.dbTableDataLastFinishedSize[db][table].Store(curFinished)
 			if speed > 0 {
@@ -68,6 +78,11 @@ func (l *Loader) printStatus() {
 		zap.Int64("finished_bytes", finishedSize),
 		zap.Int64("total_bytes", totalSize),
 		zap.Int64("total_file_count", totalFileCount),
-		zap.String("progress", percent(finishedSize, totalSize, l.finish.Load())))
+		zap.String("progress", percent(finishedSize, totalSize, l.finish.Load())),
+		zap.Int64("bytes_per_second", s.BytesPerSecond),
+		zap.Int64("rows_per_second", s.RowsPerSecond),
+		zap.Int64("remaining_seconds", s.RemainingSeconds),
+		zap.String("bottleneck", s.Bottleneck))
 	progressGauge.WithLabelValues(l.cfg.Name, l.cfg.SourceID).Set(progress(finishedSize, totalSize, l.finish.Load()))
+	setProgressMetrics(l.cfg, s)
 }
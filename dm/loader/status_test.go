@@ -36,6 +36,12 @@ func (*testLoaderSuite) TestConcurrentStatus(c *C) {
 			"table2": atomic.NewInt64(20),
 		},
 	}
+	l.dbTableDataTotalSize = map[string]map[string]*atomic.Int64{
+		"db1": {
+			"table1": atomic.NewInt64(50),
+			"table2": atomic.NewInt64(150),
+		},
+	}
 	l.dbTableDataLastFinishedSize = map[string]map[string]*atomic.Int64{
 		"db1": {
 			"table1": atomic.NewInt64(0),
@@ -14,6 +14,7 @@
 package loader
 
 import (
+	"context"
 	"crypto/sha1"
 	"fmt"
 	"os"
@@ -25,11 +26,13 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
 	"github.com/pingcap/tiflow/dm/pkg/dumpling"
 	"github.com/pingcap/tiflow/dm/pkg/ha"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/storage"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
-	"github.com/pingcap/tiflow/dm/pkg/utils"
 )
 
 // SQLReplace works like strings.Replace but only supports one replacement.
@@ -99,6 +102,12 @@ func escapeName(name string) string {
 	return strings.ReplaceAll(name, "`", "``")
 }
 
+// countInsertRows counts the rows of an INSERT statement dumped by dumpling like "INSERT INTO `t` VALUES\n(1),\n(2);".
+// it's an approximate value because the separator may appear in the string values.
+func countInsertRows(sql string) int {
+	return strings.Count(sql, "),\n(") + strings.Count(sql, "),(") + 1
+}
+
 // input filename is like `all_mode.t1.0.sql` or `all_mode.t1.sql`.
 func getDBAndTableFromFilename(filename string) (string, string, error) {
 	idx := strings.LastIndex(filename, ".sql")
@@ -112,9 +121,8 @@ func getDBAndTableFromFilename(filename string) (string, string, error) {
 	return fields[0], fields[1], nil
 }
 
-func getMydumpMetadata(cli *clientv3.Client, cfg *config.SubTaskConfig, workerName string) (string, string, error) {
-	metafile := filepath.Join(cfg.LoaderConfig.Dir, "metadata")
-	loc, _, err := dumpling.ParseMetaData(metafile, cfg.Flavor)
+func getMydumpMetadata(ctx context.Context, cli *clientv3.Client, cfg *config.SubTaskConfig, workerName string) (string, string, error) {
+	loc, _, err := dumpling.ParseMetaDataInDir(ctx, cfg.LoaderConfig.Dir, cfg.Flavor)
 	if err != nil {
 		if os.IsNotExist(err) {
 			worker, _, err2 := ha.GetLoadTask(cli, cfg.Name, cfg.SourceID)
@@ -130,7 +138,7 @@ func getMydumpMetadata(cli *clientv3.Client, cfg *config.SubTaskConfig, workerNa
 			return "", "", nil
 		}
 
-		toPrint, err2 := os.ReadFile(metafile)
+		toPrint, err2 := storage.ReadFile(ctx, cfg.LoaderConfig.Dir, "metadata")
 		if err2 != nil {
 			toPrint = []byte(err2.Error())
 		}
@@ -142,26 +150,26 @@ func getMydumpMetadata(cli *clientv3.Client, cfg *config.SubTaskConfig, workerNa
 }
 
 // cleanDumpFiles is called when finish restoring data, to clean useless files.
-func cleanDumpFiles(cfg *config.SubTaskConfig) {
+func cleanDumpFiles(ctx context.Context, cfg *config.SubTaskConfig) {
 	log.L().Info("clean dump files")
 	if cfg.Mode == config.ModeFull {
 		// in full-mode all files won't be need in the future
-		if err := os.RemoveAll(cfg.Dir); err != nil {
+		if err := storage.RemoveAll(ctx, cfg.Dir); err != nil {
 			log.L().Warn("error when remove loaded dump folder", zap.String("data folder", cfg.Dir), zap.Error(err))
 		}
 	} else {
 		// leave metadata file and table structure files, only delete data files
-		files, err := utils.CollectDirFiles(cfg.Dir)
+		files, err := storage.CollectDirFiles(ctx, cfg.Dir)
 		if err != nil {
 			log.L().Warn("fail to collect files", zap.String("data folder", cfg.Dir), zap.Error(err))
 		}
 		var lastErr error
 		for f := range files {
-			if strings.HasSuffix(f, ".sql") {
-				if strings.HasSuffix(f, "-schema-create.sql") || strings.HasSuffix(f, "-schema.sql") {
+			if name := trimCompressExt(f); strings.HasSuffix(name, ".sql") {
+				if strings.HasSuffix(name, "-schema-create.sql") || strings.HasSuffix(name, "-schema.sql") {
 					continue
 				}
-				lastErr = os.Remove(filepath.Join(cfg.Dir, f))
+				lastErr = storage.RemoveFile(ctx, cfg.Dir, f)
 			}
 		}
 		if lastErr != nil {
@@ -170,8 +178,42 @@ func cleanDumpFiles(cfg *config.SubTaskConfig) {
 	}
 }
 
+// ResetCheckpoint removes the checkpoint of the load unit of the subtask, so the load unit is taken as a fresh task and
+// the subtask starts from the dump unit after it's initialized again.
+func ResetCheckpoint(ctx context.Context, cfg *config.SubTaskConfig) error {
+	tctx := tcontext.NewContext(ctx, log.With(zap.String("job", "reset load checkpoint")))
+	if !cfg.NeedUseLightning() {
+		cp, err := newRemoteCheckPoint(tctx, cfg, cfg.SourceID)
+		if err != nil {
+			return err
+		}
+		defer cp.Close()
+		return cp.Clear(tctx)
+	}
+
+	db, err := conn.DefaultDBProvider.Apply(&cfg.To)
+	if err != nil {
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+	cpList := NewLightningCheckpointList(db, cfg.Name, cfg.SourceID, cfg.MetaSchema)
+	defer cpList.Close()
+	if err = cpList.Prepare(ctx); err != nil {
+		return err
+	}
+	if err = cpList.Remove(ctx); err != nil {
+		return err
+	}
+	// the checkpoint file of lightning is removed along with the dump files by the dump unit, except the dump files
+	// are in an external storage.
+	return os.RemoveAll(filepath.Join(cfg.LoaderLocalDir(), lightningCheckpointFileName))
+}
+
 // putLoadTask is called when start restoring data, to put load worker in etcd.
+// the dump files in an external storage can be loaded by any worker, so the load worker isn't put.
 func putLoadTask(cli *clientv3.Client, cfg *config.SubTaskConfig, workerName string) error {
+	if storage.IsExternalPath(cfg.Dir) {
+		return nil
+	}
 	_, err := ha.PutLoadTask(cli, cfg.Name, cfg.SourceID, workerName)
 	if err != nil {
 		return err
@@ -182,6 +224,9 @@ func putLoadTask(cli *clientv3.Client, cfg *config.SubTaskConfig, workerName str
 
 // delLoadTask is called when finish restoring data, to delete load worker in etcd.
 func delLoadTask(cli *clientv3.Client, cfg *config.SubTaskConfig, workerName string) error {
+	if storage.IsExternalPath(cfg.Dir) {
+		return nil
+	}
 	_, _, err := ha.DelLoadTask(cli, cfg.Name, cfg.SourceID)
 	if err != nil {
 		return err
@@ -124,3 +124,9 @@ func (t *testUtilSuite) TestGetDBAndTableFromFilename(c *C) {
 		}
 	}
 }
+
+func (t *testUtilSuite) TestCountInsertRows(c *C) {
+	c.Assert(countInsertRows("INSERT INTO `t` VALUES\n(1,'a');"), Equals, 1)
+	c.Assert(countInsertRows("INSERT INTO `t` VALUES\n(1,'a'),\n(2,'b'),\n(3,'c');"), Equals, 3)
+	c.Assert(countInsertRows("INSERT INTO `t` VALUES (1,'a'),(2,'b');"), Equals, 2)
+}
@@ -90,6 +90,9 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// DMAPIGetAuditLogList request
+	DMAPIGetAuditLogList(ctx context.Context, params *DMAPIGetAuditLogListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DMAPIGetClusterInfo request
 	DMAPIGetClusterInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -99,6 +102,26 @@ type ClientInterface interface {
 	// DMAPIOfflineMasterNode request
 	DMAPIOfflineMasterNode(ctx context.Context, masterName string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DMAPIGetRebalancePlan request
+	DMAPIGetRebalancePlan(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIRebalance request
+	DMAPIRebalance(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIGetClusterStatus request
+	DMAPIGetClusterStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIGetWebhookList request
+	DMAPIGetWebhookList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPICreateWebhook request with any body
+	DMAPICreateWebhookWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPICreateWebhook(ctx context.Context, body DMAPICreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIDeleteWebhook request
+	DMAPIDeleteWebhook(ctx context.Context, webhookName string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DMAPIGetClusterWorkerList request
 	DMAPIGetClusterWorkerList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -190,11 +213,55 @@ type ClientInterface interface {
 
 	DMAPIPauseTask(ctx context.Context, taskName string, body DMAPIPauseTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DMAPISetTaskPriority request with any body
+	DMAPISetTaskPriorityWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPISetTaskPriority(ctx context.Context, taskName string, body DMAPISetTaskPriorityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPISetTaskRateLimit request with any body
+	DMAPISetTaskRateLimitWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPISetTaskRateLimit(ctx context.Context, taskName string, body DMAPISetTaskRateLimitJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DMAPIResumeTask request with any body
 	DMAPIResumeTaskWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	DMAPIResumeTask(ctx context.Context, taskName string, body DMAPIResumeTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DMAPIUpdateTaskRules request with any body
+	DMAPIUpdateTaskRulesWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPIUpdateTaskRules(ctx context.Context, taskName string, body DMAPIUpdateTaskRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIGetShardDDLLockList request
+	DMAPIGetShardDDLLockList(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIResolveShardDDLLock request with any body
+	DMAPIResolveShardDDLLockWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPIResolveShardDDLLock(ctx context.Context, taskName string, body DMAPIResolveShardDDLLockJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIApproveDDL request with any body
+	DMAPIApproveDDLWithBody(ctx context.Context, taskName string, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPIApproveDDL(ctx context.Context, taskName string, sourceName string, body DMAPIApproveDDLJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIGetBinlogOperations request
+	DMAPIGetBinlogOperations(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPICreateBinlogOperation request with any body
+	DMAPICreateBinlogOperationWithBody(ctx context.Context, taskName string, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPICreateBinlogOperation(ctx context.Context, taskName string, sourceName string, body DMAPICreateBinlogOperationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIGetPausedTables request
+	DMAPIGetPausedTables(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIOperatePausedTables request with any body
+	DMAPIOperatePausedTablesWithBody(ctx context.Context, taskName string, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPIOperatePausedTables(ctx context.Context, taskName string, sourceName string, body DMAPIOperatePausedTablesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DMAPIGetSchemaListByTaskAndSource request
 	DMAPIGetSchemaListByTaskAndSource(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -212,10 +279,30 @@ type ClientInterface interface {
 
 	DMAPIOperateTableStructure(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, body DMAPIOperateTableStructureJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DMAPIGetSkipGTIDs request
+	DMAPIGetSkipGTIDs(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIOperateSkipGTIDs request with any body
+	DMAPIOperateSkipGTIDsWithBody(ctx context.Context, taskName string, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPIOperateSkipGTIDs(ctx context.Context, taskName string, sourceName string, body DMAPIOperateSkipGTIDsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DMAPIGetTaskStatus request
 	DMAPIGetTaskStatus(ctx context.Context, taskName string, params *DMAPIGetTaskStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
+func (c *Client) DMAPIGetAuditLogList(ctx context.Context, params *DMAPIGetAuditLogListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIGetAuditLogListRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) DMAPIGetClusterInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDMAPIGetClusterInfoRequest(c.Server)
 	if err != nil {
@@ -252,6 +339,90 @@ func (c *Client) DMAPIOfflineMasterNode(ctx context.Context, masterName string,
 	return c.Client.Do(req)
 }
 
+func (c *Client) DMAPIGetRebalancePlan(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIGetRebalancePlanRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIRebalance(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIRebalanceRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIGetClusterStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIGetClusterStatusRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIGetWebhookList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIGetWebhookListRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPICreateWebhookWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPICreateWebhookRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPICreateWebhook(ctx context.Context, body DMAPICreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPICreateWebhookRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIDeleteWebhook(ctx context.Context, webhookName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIDeleteWebhookRequest(c.Server, webhookName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) DMAPIGetClusterWorkerList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDMAPIGetClusterWorkerListRequest(c.Server)
 	if err != nil {
@@ -648,8 +819,8 @@ func (c *Client) DMAPIPauseTask(ctx context.Context, taskName string, body DMAPI
 	return c.Client.Do(req)
 }
 
-func (c *Client) DMAPIResumeTaskWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDMAPIResumeTaskRequestWithBody(c.Server, taskName, contentType, body)
+func (c *Client) DMAPISetTaskPriorityWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPISetTaskPriorityRequestWithBody(c.Server, taskName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -660,8 +831,8 @@ func (c *Client) DMAPIResumeTaskWithBody(ctx context.Context, taskName string, c
 	return c.Client.Do(req)
 }
 
-func (c *Client) DMAPIResumeTask(ctx context.Context, taskName string, body DMAPIResumeTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDMAPIResumeTaskRequest(c.Server, taskName, body)
+func (c *Client) DMAPISetTaskPriority(ctx context.Context, taskName string, body DMAPISetTaskPriorityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPISetTaskPriorityRequest(c.Server, taskName, body)
 	if err != nil {
 		return nil, err
 	}
@@ -672,8 +843,8 @@ func (c *Client) DMAPIResumeTask(ctx context.Context, taskName string, body DMAP
 	return c.Client.Do(req)
 }
 
-func (c *Client) DMAPIGetSchemaListByTaskAndSource(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDMAPIGetSchemaListByTaskAndSourceRequest(c.Server, taskName, sourceName)
+func (c *Client) DMAPISetTaskRateLimitWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPISetTaskRateLimitRequestWithBody(c.Server, taskName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -684,8 +855,8 @@ func (c *Client) DMAPIGetSchemaListByTaskAndSource(ctx context.Context, taskName
 	return c.Client.Do(req)
 }
 
-func (c *Client) DMAPIGetTableListByTaskAndSource(ctx context.Context, taskName string, sourceName string, schemaName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDMAPIGetTableListByTaskAndSourceRequest(c.Server, taskName, sourceName, schemaName)
+func (c *Client) DMAPISetTaskRateLimit(ctx context.Context, taskName string, body DMAPISetTaskRateLimitJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPISetTaskRateLimitRequest(c.Server, taskName, body)
 	if err != nil {
 		return nil, err
 	}
@@ -696,8 +867,8 @@ func (c *Client) DMAPIGetTableListByTaskAndSource(ctx context.Context, taskName
 	return c.Client.Do(req)
 }
 
-func (c *Client) DMAPIDeleteTableStructure(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDMAPIDeleteTableStructureRequest(c.Server, taskName, sourceName, schemaName, tableName)
+func (c *Client) DMAPIResumeTaskWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIResumeTaskRequestWithBody(c.Server, taskName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -708,8 +879,8 @@ func (c *Client) DMAPIDeleteTableStructure(ctx context.Context, taskName string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) DMAPIGetTableStructure(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDMAPIGetTableStructureRequest(c.Server, taskName, sourceName, schemaName, tableName)
+func (c *Client) DMAPIResumeTask(ctx context.Context, taskName string, body DMAPIResumeTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIResumeTaskRequest(c.Server, taskName, body)
 	if err != nil {
 		return nil, err
 	}
@@ -720,8 +891,8 @@ func (c *Client) DMAPIGetTableStructure(ctx context.Context, taskName string, so
 	return c.Client.Do(req)
 }
 
-func (c *Client) DMAPIOperateTableStructureWithBody(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDMAPIOperateTableStructureRequestWithBody(c.Server, taskName, sourceName, schemaName, tableName, contentType, body)
+func (c *Client) DMAPIUpdateTaskRulesWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIUpdateTaskRulesRequestWithBody(c.Server, taskName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -732,8 +903,8 @@ func (c *Client) DMAPIOperateTableStructureWithBody(ctx context.Context, taskNam
 	return c.Client.Do(req)
 }
 
-func (c *Client) DMAPIOperateTableStructure(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, body DMAPIOperateTableStructureJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDMAPIOperateTableStructureRequest(c.Server, taskName, sourceName, schemaName, tableName, body)
+func (c *Client) DMAPIUpdateTaskRules(ctx context.Context, taskName string, body DMAPIUpdateTaskRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIUpdateTaskRulesRequest(c.Server, taskName, body)
 	if err != nil {
 		return nil, err
 	}
@@ -744,8 +915,8 @@ func (c *Client) DMAPIOperateTableStructure(ctx context.Context, taskName string
 	return c.Client.Do(req)
 }
 
-func (c *Client) DMAPIGetTaskStatus(ctx context.Context, taskName string, params *DMAPIGetTaskStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDMAPIGetTaskStatusRequest(c.Server, taskName, params)
+func (c *Client) DMAPIGetShardDDLLockList(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIGetShardDDLLockListRequest(c.Server, taskName)
 	if err != nil {
 		return nil, err
 	}
@@ -756,211 +927,248 @@ func (c *Client) DMAPIGetTaskStatus(ctx context.Context, taskName string, params
 	return c.Client.Do(req)
 }
 
-// NewDMAPIGetClusterInfoRequest generates requests for DMAPIGetClusterInfo
-func NewDMAPIGetClusterInfoRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) DMAPIResolveShardDDLLockWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIResolveShardDDLLockRequestWithBody(c.Server, taskName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/cluster/info")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DMAPIResolveShardDDLLock(ctx context.Context, taskName string, body DMAPIResolveShardDDLLockJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIResolveShardDDLLockRequest(c.Server, taskName, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDMAPIGetClusterMasterListRequest generates requests for DMAPIGetClusterMasterList
-func NewDMAPIGetClusterMasterListRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) DMAPIApproveDDLWithBody(ctx context.Context, taskName string, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIApproveDDLRequestWithBody(c.Server, taskName, sourceName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/cluster/masters")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DMAPIApproveDDL(ctx context.Context, taskName string, sourceName string, body DMAPIApproveDDLJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIApproveDDLRequest(c.Server, taskName, sourceName, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDMAPIOfflineMasterNodeRequest generates requests for DMAPIOfflineMasterNode
-func NewDMAPIOfflineMasterNodeRequest(server string, masterName string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "master-name", runtime.ParamLocationPath, masterName)
+func (c *Client) DMAPIGetBinlogOperations(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIGetBinlogOperationsRequest(c.Server, taskName, sourceName)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/v1/cluster/masters/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DMAPICreateBinlogOperationWithBody(ctx context.Context, taskName string, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPICreateBinlogOperationRequestWithBody(c.Server, taskName, sourceName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDMAPIGetClusterWorkerListRequest generates requests for DMAPIGetClusterWorkerList
-func NewDMAPIGetClusterWorkerListRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) DMAPICreateBinlogOperation(ctx context.Context, taskName string, sourceName string, body DMAPICreateBinlogOperationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPICreateBinlogOperationRequest(c.Server, taskName, sourceName, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/cluster/workers")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DMAPIGetPausedTables(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIGetPausedTablesRequest(c.Server, taskName, sourceName)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDMAPIOfflineWorkerNodeRequest generates requests for DMAPIOfflineWorkerNode
-func NewDMAPIOfflineWorkerNodeRequest(server string, workerName string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker-name", runtime.ParamLocationPath, workerName)
+func (c *Client) DMAPIOperatePausedTablesWithBody(ctx context.Context, taskName string, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIOperatePausedTablesRequestWithBody(c.Server, taskName, sourceName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/v1/cluster/workers/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DMAPIOperatePausedTables(ctx context.Context, taskName string, sourceName string, body DMAPIOperatePausedTablesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIOperatePausedTablesRequest(c.Server, taskName, sourceName, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetDocJSONRequest generates requests for GetDocJSON
-func NewGetDocJSONRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) DMAPIGetSchemaListByTaskAndSource(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIGetSchemaListByTaskAndSourceRequest(c.Server, taskName, sourceName)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/dm.json")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DMAPIGetTableListByTaskAndSource(ctx context.Context, taskName string, sourceName string, schemaName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIGetTableListByTaskAndSourceRequest(c.Server, taskName, sourceName, schemaName)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) DMAPIDeleteTableStructure(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIDeleteTableStructureRequest(c.Server, taskName, sourceName, schemaName, tableName)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) DMAPIGetTableStructure(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIGetTableStructureRequest(c.Server, taskName, sourceName, schemaName, tableName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetDocHTMLRequest generates requests for GetDocHTML
-func NewGetDocHTMLRequest(server string) (*http.Request, error) {
-	var err error
+func (c *Client) DMAPIOperateTableStructureWithBody(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIOperateTableStructureRequestWithBody(c.Server, taskName, sourceName, schemaName, tableName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DMAPIOperateTableStructure(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, body DMAPIOperateTableStructureJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIOperateTableStructureRequest(c.Server, taskName, sourceName, schemaName, tableName, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/v1/docs")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) DMAPIGetSkipGTIDs(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIGetSkipGTIDsRequest(c.Server, taskName, sourceName)
+	if err != nil {
+		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DMAPIOperateSkipGTIDsWithBody(ctx context.Context, taskName string, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIOperateSkipGTIDsRequestWithBody(c.Server, taskName, sourceName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) DMAPIOperateSkipGTIDs(ctx context.Context, taskName string, sourceName string, body DMAPIOperateSkipGTIDsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIOperateSkipGTIDsRequest(c.Server, taskName, sourceName, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) DMAPIGetTaskStatus(ctx context.Context, taskName string, params *DMAPIGetTaskStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIGetTaskStatusRequest(c.Server, taskName, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDMAPIGetSourceListRequest generates requests for DMAPIGetSourceList
-func NewDMAPIGetSourceListRequest(server string, params *DMAPIGetSourceListParams) (*http.Request, error) {
+// NewDMAPIGetAuditLogListRequest generates requests for DMAPIGetAuditLogList
+func NewDMAPIGetAuditLogListRequest(server string, params *DMAPIGetAuditLogListParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -968,7 +1176,7 @@ func NewDMAPIGetSourceListRequest(server string, params *DMAPIGetSourceListParam
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/sources")
+	operationPath := fmt.Sprintf("/api/v1/cluster/audit-logs")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -980,8 +1188,73 @@ func NewDMAPIGetSourceListRequest(server string, params *DMAPIGetSourceListParam
 
 	queryValues := queryURL.Query()
 
-	if params.WithStatus != nil {
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "with_status", runtime.ParamLocationQuery, *params.WithStatus); err != nil {
+	if params.StartTime != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_time", runtime.ParamLocationQuery, *params.StartTime); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.EndTime != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_time", runtime.ParamLocationQuery, *params.EndTime); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Operation != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "operation", runtime.ParamLocationQuery, *params.Operation); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.User != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user", runtime.ParamLocationQuery, *params.User); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -992,6 +1265,7 @@ func NewDMAPIGetSourceListRequest(server string, params *DMAPIGetSourceListParam
 				}
 			}
 		}
+
 	}
 
 	queryURL.RawQuery = queryValues.Encode()
@@ -1004,19 +1278,8 @@ func NewDMAPIGetSourceListRequest(server string, params *DMAPIGetSourceListParam
 	return req, nil
 }
 
-// NewDMAPICreateSourceRequest calls the generic DMAPICreateSource builder with application/json body
-func NewDMAPICreateSourceRequest(server string, body DMAPICreateSourceJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewDMAPICreateSourceRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewDMAPICreateSourceRequestWithBody generates requests for DMAPICreateSource with any type of body
-func NewDMAPICreateSourceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDMAPIGetClusterInfoRequest generates requests for DMAPIGetClusterInfo
+func NewDMAPIGetClusterInfoRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1024,7 +1287,7 @@ func NewDMAPICreateSourceRequestWithBody(server string, contentType string, body
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/sources")
+	operationPath := fmt.Sprintf("/api/v1/cluster/info")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1034,33 +1297,24 @@ func NewDMAPICreateSourceRequestWithBody(server string, contentType string, body
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDMAPIDeleteSourceRequest generates requests for DMAPIDeleteSource
-func NewDMAPIDeleteSourceRequest(server string, sourceName string, params *DMAPIDeleteSourceParams) (*http.Request, error) {
+// NewDMAPIGetClusterMasterListRequest generates requests for DMAPIGetClusterMasterList
+func NewDMAPIGetClusterMasterListRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/sources/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/cluster/masters")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1070,25 +1324,7 @@ func NewDMAPIDeleteSourceRequest(server string, sourceName string, params *DMAPI
 		return nil, err
 	}
 
-	queryValues := queryURL.Query()
-
-	if params.Force != nil {
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-	}
-
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1096,13 +1332,13 @@ func NewDMAPIDeleteSourceRequest(server string, sourceName string, params *DMAPI
 	return req, nil
 }
 
-// NewDMAPIPauseRelayRequest generates requests for DMAPIPauseRelay
-func NewDMAPIPauseRelayRequest(server string, sourceName string) (*http.Request, error) {
+// NewDMAPIOfflineMasterNodeRequest generates requests for DMAPIOfflineMasterNode
+func NewDMAPIOfflineMasterNodeRequest(server string, masterName string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "master-name", runtime.ParamLocationPath, masterName)
 	if err != nil {
 		return nil, err
 	}
@@ -1112,7 +1348,7 @@ func NewDMAPIPauseRelayRequest(server string, sourceName string) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/sources/%s/pause-relay", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/cluster/masters/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1122,7 +1358,7 @@ func NewDMAPIPauseRelayRequest(server string, sourceName string) (*http.Request,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1130,23 +1366,16 @@ func NewDMAPIPauseRelayRequest(server string, sourceName string) (*http.Request,
 	return req, nil
 }
 
-// NewDMAPIResumeRelayRequest generates requests for DMAPIResumeRelay
-func NewDMAPIResumeRelayRequest(server string, sourceName string) (*http.Request, error) {
+// NewDMAPIGetRebalancePlanRequest generates requests for DMAPIGetRebalancePlan
+func NewDMAPIGetRebalancePlanRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/sources/%s/resume-relay", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/cluster/rebalance")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1156,7 +1385,7 @@ func NewDMAPIResumeRelayRequest(server string, sourceName string) (*http.Request
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1164,23 +1393,16 @@ func NewDMAPIResumeRelayRequest(server string, sourceName string) (*http.Request
 	return req, nil
 }
 
-// NewDMAPIGetSourceSchemaListRequest generates requests for DMAPIGetSourceSchemaList
-func NewDMAPIGetSourceSchemaListRequest(server string, sourceName string) (*http.Request, error) {
+// NewDMAPIRebalanceRequest generates requests for DMAPIRebalance
+func NewDMAPIRebalanceRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/sources/%s/schemas", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/cluster/rebalance")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1190,7 +1412,7 @@ func NewDMAPIGetSourceSchemaListRequest(server string, sourceName string) (*http
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1198,30 +1420,43 @@ func NewDMAPIGetSourceSchemaListRequest(server string, sourceName string) (*http
 	return req, nil
 }
 
-// NewDMAPIGetSourceTableListRequest generates requests for DMAPIGetSourceTableList
-func NewDMAPIGetSourceTableListRequest(server string, sourceName string, schemaName string) (*http.Request, error) {
+// NewDMAPIGetClusterStatusRequest generates requests for DMAPIGetClusterStatus
+func NewDMAPIGetClusterStatusRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	operationPath := fmt.Sprintf("/api/v1/cluster/status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "schema-name", runtime.ParamLocationPath, schemaName)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	return req, nil
+}
+
+// NewDMAPIGetWebhookListRequest generates requests for DMAPIGetWebhookList
+func NewDMAPIGetWebhookListRequest(server string) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/sources/%s/schemas/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/cluster/webhooks")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1239,34 +1474,27 @@ func NewDMAPIGetSourceTableListRequest(server string, sourceName string, schemaN
 	return req, nil
 }
 
-// NewDMAPIStartRelayRequest calls the generic DMAPIStartRelay builder with application/json body
-func NewDMAPIStartRelayRequest(server string, sourceName string, body DMAPIStartRelayJSONRequestBody) (*http.Request, error) {
+// NewDMAPICreateWebhookRequest calls the generic DMAPICreateWebhook builder with application/json body
+func NewDMAPICreateWebhookRequest(server string, body DMAPICreateWebhookJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewDMAPIStartRelayRequestWithBody(server, sourceName, "application/json", bodyReader)
+	return NewDMAPICreateWebhookRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewDMAPIStartRelayRequestWithBody generates requests for DMAPIStartRelay with any type of body
-func NewDMAPIStartRelayRequestWithBody(server string, sourceName string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDMAPICreateWebhookRequestWithBody generates requests for DMAPICreateWebhook with any type of body
+func NewDMAPICreateWebhookRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/sources/%s/start-relay", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/cluster/webhooks")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1286,13 +1514,13 @@ func NewDMAPIStartRelayRequestWithBody(server string, sourceName string, content
 	return req, nil
 }
 
-// NewDMAPIGetSourceStatusRequest generates requests for DMAPIGetSourceStatus
-func NewDMAPIGetSourceStatusRequest(server string, sourceName string) (*http.Request, error) {
+// NewDMAPIDeleteWebhookRequest generates requests for DMAPIDeleteWebhook
+func NewDMAPIDeleteWebhookRequest(server string, webhookName string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhook-name", runtime.ParamLocationPath, webhookName)
 	if err != nil {
 		return nil, err
 	}
@@ -1302,7 +1530,7 @@ func NewDMAPIGetSourceStatusRequest(server string, sourceName string) (*http.Req
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/sources/%s/status", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/cluster/webhooks/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1312,7 +1540,7 @@ func NewDMAPIGetSourceStatusRequest(server string, sourceName string) (*http.Req
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1320,34 +1548,16 @@ func NewDMAPIGetSourceStatusRequest(server string, sourceName string) (*http.Req
 	return req, nil
 }
 
-// NewDMAPIStopRelayRequest calls the generic DMAPIStopRelay builder with application/json body
-func NewDMAPIStopRelayRequest(server string, sourceName string, body DMAPIStopRelayJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewDMAPIStopRelayRequestWithBody(server, sourceName, "application/json", bodyReader)
-}
-
-// NewDMAPIStopRelayRequestWithBody generates requests for DMAPIStopRelay with any type of body
-func NewDMAPIStopRelayRequestWithBody(server string, sourceName string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDMAPIGetClusterWorkerListRequest generates requests for DMAPIGetClusterWorkerList
+func NewDMAPIGetClusterWorkerListRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/sources/%s/stop-relay", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/cluster/workers")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1357,34 +1567,21 @@ func NewDMAPIStopRelayRequestWithBody(server string, sourceName string, contentT
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDMAPITransferSourceRequest calls the generic DMAPITransferSource builder with application/json body
-func NewDMAPITransferSourceRequest(server string, sourceName string, body DMAPITransferSourceJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewDMAPITransferSourceRequestWithBody(server, sourceName, "application/json", bodyReader)
-}
-
-// NewDMAPITransferSourceRequestWithBody generates requests for DMAPITransferSource with any type of body
-func NewDMAPITransferSourceRequestWithBody(server string, sourceName string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDMAPIOfflineWorkerNodeRequest generates requests for DMAPIOfflineWorkerNode
+func NewDMAPIOfflineWorkerNodeRequest(server string, workerName string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker-name", runtime.ParamLocationPath, workerName)
 	if err != nil {
 		return nil, err
 	}
@@ -1394,7 +1591,7 @@ func NewDMAPITransferSourceRequestWithBody(server string, sourceName string, con
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/sources/%s/transfer", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/cluster/workers/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1404,18 +1601,16 @@ func NewDMAPITransferSourceRequestWithBody(server string, sourceName string, con
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDMAPIGetTaskListRequest generates requests for DMAPIGetTaskList
-func NewDMAPIGetTaskListRequest(server string, params *DMAPIGetTaskListParams) (*http.Request, error) {
+// NewGetDocJSONRequest generates requests for GetDocJSON
+func NewGetDocJSONRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1423,7 +1618,7 @@ func NewDMAPIGetTaskListRequest(server string, params *DMAPIGetTaskListParams) (
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks")
+	operationPath := fmt.Sprintf("/api/v1/dm.json")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1433,24 +1628,6 @@ func NewDMAPIGetTaskListRequest(server string, params *DMAPIGetTaskListParams) (
 		return nil, err
 	}
 
-	queryValues := queryURL.Query()
-
-	if params.WithStatus != nil {
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "with_status", runtime.ParamLocationQuery, *params.WithStatus); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-	}
-
-	queryURL.RawQuery = queryValues.Encode()
-
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1459,19 +1636,8 @@ func NewDMAPIGetTaskListRequest(server string, params *DMAPIGetTaskListParams) (
 	return req, nil
 }
 
-// NewDMAPIStartTaskRequest calls the generic DMAPIStartTask builder with application/json body
-func NewDMAPIStartTaskRequest(server string, body DMAPIStartTaskJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewDMAPIStartTaskRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewDMAPIStartTaskRequestWithBody generates requests for DMAPIStartTask with any type of body
-func NewDMAPIStartTaskRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetDocHTMLRequest generates requests for GetDocHTML
+func NewGetDocHTMLRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1479,7 +1645,7 @@ func NewDMAPIStartTaskRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks")
+	operationPath := fmt.Sprintf("/api/v1/docs")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1489,18 +1655,16 @@ func NewDMAPIStartTaskRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDMAPIGetTaskTemplateListRequest generates requests for DMAPIGetTaskTemplateList
-func NewDMAPIGetTaskTemplateListRequest(server string) (*http.Request, error) {
+// NewDMAPIGetSourceListRequest generates requests for DMAPIGetSourceList
+func NewDMAPIGetSourceListRequest(server string, params *DMAPIGetSourceListParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1508,7 +1672,7 @@ func NewDMAPIGetTaskTemplateListRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/templates")
+	operationPath := fmt.Sprintf("/api/v1/sources")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1518,6 +1682,26 @@ func NewDMAPIGetTaskTemplateListRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	queryValues := queryURL.Query()
+
+	if params.WithStatus != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "with_status", runtime.ParamLocationQuery, *params.WithStatus); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1526,19 +1710,19 @@ func NewDMAPIGetTaskTemplateListRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewDMAPICreateTaskTemplateRequest calls the generic DMAPICreateTaskTemplate builder with application/json body
-func NewDMAPICreateTaskTemplateRequest(server string, body DMAPICreateTaskTemplateJSONRequestBody) (*http.Request, error) {
+// NewDMAPICreateSourceRequest calls the generic DMAPICreateSource builder with application/json body
+func NewDMAPICreateSourceRequest(server string, body DMAPICreateSourceJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewDMAPICreateTaskTemplateRequestWithBody(server, "application/json", bodyReader)
+	return NewDMAPICreateSourceRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewDMAPICreateTaskTemplateRequestWithBody generates requests for DMAPICreateTaskTemplate with any type of body
-func NewDMAPICreateTaskTemplateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDMAPICreateSourceRequestWithBody generates requests for DMAPICreateSource with any type of body
+func NewDMAPICreateSourceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1546,7 +1730,7 @@ func NewDMAPICreateTaskTemplateRequestWithBody(server string, contentType string
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/templates")
+	operationPath := fmt.Sprintf("/api/v1/sources")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1566,27 +1750,23 @@ func NewDMAPICreateTaskTemplateRequestWithBody(server string, contentType string
 	return req, nil
 }
 
-// NewDMAPIImportTaskTemplateRequest calls the generic DMAPIImportTaskTemplate builder with application/json body
-func NewDMAPIImportTaskTemplateRequest(server string, body DMAPIImportTaskTemplateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewDMAPIDeleteSourceRequest generates requests for DMAPIDeleteSource
+func NewDMAPIDeleteSourceRequest(server string, sourceName string, params *DMAPIDeleteSourceParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewDMAPIImportTaskTemplateRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewDMAPIImportTaskTemplateRequestWithBody generates requests for DMAPIImportTaskTemplate with any type of body
-func NewDMAPIImportTaskTemplateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/templates/import")
+	operationPath := fmt.Sprintf("/api/v1/sources/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1596,23 +1776,41 @@ func NewDMAPIImportTaskTemplateRequestWithBody(server string, contentType string
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	queryValues := queryURL.Query()
+
+	if params.Force != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDMAPIDeleteTaskTemplateRequest generates requests for DMAPIDeleteTaskTemplate
-func NewDMAPIDeleteTaskTemplateRequest(server string, taskName string) (*http.Request, error) {
+// NewDMAPIPauseRelayRequest generates requests for DMAPIPauseRelay
+func NewDMAPIPauseRelayRequest(server string, sourceName string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
 	if err != nil {
 		return nil, err
 	}
@@ -1622,7 +1820,7 @@ func NewDMAPIDeleteTaskTemplateRequest(server string, taskName string) (*http.Re
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/templates/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/sources/%s/pause-relay", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1632,7 +1830,7 @@ func NewDMAPIDeleteTaskTemplateRequest(server string, taskName string) (*http.Re
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1640,13 +1838,13 @@ func NewDMAPIDeleteTaskTemplateRequest(server string, taskName string) (*http.Re
 	return req, nil
 }
 
-// NewDMAPIGetTaskTemplateRequest generates requests for DMAPIGetTaskTemplate
-func NewDMAPIGetTaskTemplateRequest(server string, taskName string) (*http.Request, error) {
+// NewDMAPIResumeRelayRequest generates requests for DMAPIResumeRelay
+func NewDMAPIResumeRelayRequest(server string, sourceName string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
 	if err != nil {
 		return nil, err
 	}
@@ -1656,7 +1854,7 @@ func NewDMAPIGetTaskTemplateRequest(server string, taskName string) (*http.Reque
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/templates/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/sources/%s/resume-relay", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1666,7 +1864,7 @@ func NewDMAPIGetTaskTemplateRequest(server string, taskName string) (*http.Reque
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1674,13 +1872,13 @@ func NewDMAPIGetTaskTemplateRequest(server string, taskName string) (*http.Reque
 	return req, nil
 }
 
-// NewDMAPUpdateTaskTemplateRequest generates requests for DMAPUpdateTaskTemplate
-func NewDMAPUpdateTaskTemplateRequest(server string, taskName string) (*http.Request, error) {
+// NewDMAPIGetSourceSchemaListRequest generates requests for DMAPIGetSourceSchemaList
+func NewDMAPIGetSourceSchemaListRequest(server string, sourceName string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
 	if err != nil {
 		return nil, err
 	}
@@ -1690,7 +1888,7 @@ func NewDMAPUpdateTaskTemplateRequest(server string, taskName string) (*http.Req
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/templates/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/sources/%s/schemas", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1700,7 +1898,7 @@ func NewDMAPUpdateTaskTemplateRequest(server string, taskName string) (*http.Req
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1708,23 +1906,30 @@ func NewDMAPUpdateTaskTemplateRequest(server string, taskName string) (*http.Req
 	return req, nil
 }
 
-// NewDMAPIDeleteTaskRequest generates requests for DMAPIDeleteTask
-func NewDMAPIDeleteTaskRequest(server string, taskName string, params *DMAPIDeleteTaskParams) (*http.Request, error) {
+// NewDMAPIGetSourceTableListRequest generates requests for DMAPIGetSourceTableList
+func NewDMAPIGetSourceTableListRequest(server string, sourceName string, schemaName string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
 	if err != nil {
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "schema-name", runtime.ParamLocationPath, schemaName)
+	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s", pathParam0)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/sources/%s/schemas/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1734,25 +1939,7 @@ func NewDMAPIDeleteTaskRequest(server string, taskName string, params *DMAPIDele
 		return nil, err
 	}
 
-	queryValues := queryURL.Query()
-
-	if params.SourceNameList != nil {
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source_name_list", runtime.ParamLocationQuery, *params.SourceNameList); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-	}
-
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1760,24 +1947,24 @@ func NewDMAPIDeleteTaskRequest(server string, taskName string, params *DMAPIDele
 	return req, nil
 }
 
-// NewDMAPIPauseTaskRequest calls the generic DMAPIPauseTask builder with application/json body
-func NewDMAPIPauseTaskRequest(server string, taskName string, body DMAPIPauseTaskJSONRequestBody) (*http.Request, error) {
+// NewDMAPIStartRelayRequest calls the generic DMAPIStartRelay builder with application/json body
+func NewDMAPIStartRelayRequest(server string, sourceName string, body DMAPIStartRelayJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewDMAPIPauseTaskRequestWithBody(server, taskName, "application/json", bodyReader)
+	return NewDMAPIStartRelayRequestWithBody(server, sourceName, "application/json", bodyReader)
 }
 
-// NewDMAPIPauseTaskRequestWithBody generates requests for DMAPIPauseTask with any type of body
-func NewDMAPIPauseTaskRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDMAPIStartRelayRequestWithBody generates requests for DMAPIStartRelay with any type of body
+func NewDMAPIStartRelayRequestWithBody(server string, sourceName string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
 	if err != nil {
 		return nil, err
 	}
@@ -1787,7 +1974,7 @@ func NewDMAPIPauseTaskRequestWithBody(server string, taskName string, contentTyp
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s/pause", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/sources/%s/start-relay", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1807,24 +1994,13 @@ func NewDMAPIPauseTaskRequestWithBody(server string, taskName string, contentTyp
 	return req, nil
 }
 
-// NewDMAPIResumeTaskRequest calls the generic DMAPIResumeTask builder with application/json body
-func NewDMAPIResumeTaskRequest(server string, taskName string, body DMAPIResumeTaskJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewDMAPIResumeTaskRequestWithBody(server, taskName, "application/json", bodyReader)
-}
-
-// NewDMAPIResumeTaskRequestWithBody generates requests for DMAPIResumeTask with any type of body
-func NewDMAPIResumeTaskRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDMAPIGetSourceStatusRequest generates requests for DMAPIGetSourceStatus
+func NewDMAPIGetSourceStatusRequest(server string, sourceName string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
 	if err != nil {
 		return nil, err
 	}
@@ -1834,7 +2010,7 @@ func NewDMAPIResumeTaskRequestWithBody(server string, taskName string, contentTy
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s/resume", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/sources/%s/status", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1844,30 +2020,32 @@ func NewDMAPIResumeTaskRequestWithBody(server string, taskName string, contentTy
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDMAPIGetSchemaListByTaskAndSourceRequest generates requests for DMAPIGetSchemaListByTaskAndSource
-func NewDMAPIGetSchemaListByTaskAndSourceRequest(server string, taskName string, sourceName string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+// NewDMAPIStopRelayRequest calls the generic DMAPIStopRelay builder with application/json body
+func NewDMAPIStopRelayRequest(server string, sourceName string, body DMAPIStopRelayJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIStopRelayRequestWithBody(server, sourceName, "application/json", bodyReader)
+}
 
-	var pathParam1 string
+// NewDMAPIStopRelayRequestWithBody generates requests for DMAPIStopRelay with any type of body
+func NewDMAPIStopRelayRequestWithBody(server string, sourceName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
 	if err != nil {
 		return nil, err
 	}
@@ -1877,7 +2055,7 @@ func NewDMAPIGetSchemaListByTaskAndSourceRequest(server string, taskName string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/sources/%s/stop-relay", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1887,35 +2065,34 @@ func NewDMAPIGetSchemaListByTaskAndSourceRequest(server string, taskName string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewDMAPIGetTableListByTaskAndSourceRequest generates requests for DMAPIGetTableListByTaskAndSource
-func NewDMAPIGetTableListByTaskAndSourceRequest(server string, taskName string, sourceName string, schemaName string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+// NewDMAPITransferSourceRequest calls the generic DMAPITransferSource builder with application/json body
+func NewDMAPITransferSourceRequest(server string, sourceName string, body DMAPITransferSourceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPITransferSourceRequestWithBody(server, sourceName, "application/json", bodyReader)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
-	if err != nil {
-		return nil, err
-	}
+// NewDMAPITransferSourceRequestWithBody generates requests for DMAPITransferSource with any type of body
+func NewDMAPITransferSourceRequestWithBody(server string, sourceName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	var pathParam2 string
+	var pathParam0 string
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "schema-name", runtime.ParamLocationPath, schemaName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
 	if err != nil {
 		return nil, err
 	}
@@ -1925,7 +2102,7 @@ func NewDMAPIGetTableListByTaskAndSourceRequest(server string, taskName string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/v1/sources/%s/transfer", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1935,52 +2112,84 @@ func NewDMAPIGetTableListByTaskAndSourceRequest(server string, taskName string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewDMAPIDeleteTableStructureRequest generates requests for DMAPIDeleteTableStructure
-func NewDMAPIDeleteTableStructureRequest(server string, taskName string, sourceName string, schemaName string, tableName string) (*http.Request, error) {
+// NewDMAPIGetTaskListRequest generates requests for DMAPIGetTaskList
+func NewDMAPIGetTaskListRequest(server string, params *DMAPIGetTaskListParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	operationPath := fmt.Sprintf("/api/v1/tasks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam2 string
+	queryValues := queryURL.Query()
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "schema-name", runtime.ParamLocationPath, schemaName)
+	if params.WithStatus != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "with_status", runtime.ParamLocationQuery, *params.WithStatus); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam3 string
+	return req, nil
+}
 
-	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "table-name", runtime.ParamLocationPath, tableName)
+// NewDMAPIStartTaskRequest calls the generic DMAPIStartTask builder with application/json body
+func NewDMAPIStartTaskRequest(server string, body DMAPIStartTaskJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIStartTaskRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDMAPIStartTaskRequestWithBody generates requests for DMAPIStartTask with any type of body
+func NewDMAPIStartTaskRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/api/v1/tasks")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1990,52 +2199,64 @@ func NewDMAPIDeleteTableStructureRequest(server string, taskName string, sourceN
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewDMAPIGetTableStructureRequest generates requests for DMAPIGetTableStructure
-func NewDMAPIGetTableStructureRequest(server string, taskName string, sourceName string, schemaName string, tableName string) (*http.Request, error) {
+// NewDMAPIGetTaskTemplateListRequest generates requests for DMAPIGetTaskTemplateList
+func NewDMAPIGetTaskTemplateListRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	operationPath := fmt.Sprintf("/api/v1/tasks/templates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "schema-name", runtime.ParamLocationPath, schemaName)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam3 string
+	return req, nil
+}
 
-	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "table-name", runtime.ParamLocationPath, tableName)
+// NewDMAPICreateTaskTemplateRequest calls the generic DMAPICreateTaskTemplate builder with application/json body
+func NewDMAPICreateTaskTemplateRequest(server string, body DMAPICreateTaskTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPICreateTaskTemplateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDMAPICreateTaskTemplateRequestWithBody generates requests for DMAPICreateTaskTemplate with any type of body
+func NewDMAPICreateTaskTemplateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/api/v1/tasks/templates")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2045,54 +2266,64 @@ func NewDMAPIGetTableStructureRequest(server string, taskName string, sourceName
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewDMAPIOperateTableStructureRequest calls the generic DMAPIOperateTableStructure builder with application/json body
-func NewDMAPIOperateTableStructureRequest(server string, taskName string, sourceName string, schemaName string, tableName string, body DMAPIOperateTableStructureJSONRequestBody) (*http.Request, error) {
+// NewDMAPIImportTaskTemplateRequest calls the generic DMAPIImportTaskTemplate builder with application/json body
+func NewDMAPIImportTaskTemplateRequest(server string, body DMAPIImportTaskTemplateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewDMAPIOperateTableStructureRequestWithBody(server, taskName, sourceName, schemaName, tableName, "application/json", bodyReader)
+	return NewDMAPIImportTaskTemplateRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewDMAPIOperateTableStructureRequestWithBody generates requests for DMAPIOperateTableStructure with any type of body
-func NewDMAPIOperateTableStructureRequestWithBody(server string, taskName string, sourceName string, schemaName string, tableName string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDMAPIImportTaskTemplateRequestWithBody generates requests for DMAPIImportTaskTemplate with any type of body
+func NewDMAPIImportTaskTemplateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	operationPath := fmt.Sprintf("/api/v1/tasks/templates/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "schema-name", runtime.ParamLocationPath, schemaName)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam3 string
+	req.Header.Add("Content-Type", contentType)
 
-	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "table-name", runtime.ParamLocationPath, tableName)
-	if err != nil {
+	return req, nil
+}
+
+// NewDMAPIDeleteTaskTemplateRequest generates requests for DMAPIDeleteTaskTemplate
+func NewDMAPIDeleteTaskTemplateRequest(server string, taskName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
 		return nil, err
 	}
 
@@ -2101,7 +2332,7 @@ func NewDMAPIOperateTableStructureRequestWithBody(server string, taskName string
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/api/v1/tasks/templates/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2111,18 +2342,50 @@ func NewDMAPIOperateTableStructureRequestWithBody(server string, taskName string
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	return req, nil
+}
+
+// NewDMAPIGetTaskTemplateRequest generates requests for DMAPIGetTaskTemplate
+func NewDMAPIGetTaskTemplateRequest(server string, taskName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/templates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
 
-// NewDMAPIGetTaskStatusRequest generates requests for DMAPIGetTaskStatus
-func NewDMAPIGetTaskStatusRequest(server string, taskName string, params *DMAPIGetTaskStatusParams) (*http.Request, error) {
+// NewDMAPUpdateTaskTemplateRequest generates requests for DMAPUpdateTaskTemplate
+func NewDMAPUpdateTaskTemplateRequest(server string, taskName string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2137,7 +2400,41 @@ func NewDMAPIGetTaskStatusRequest(server string, taskName string, params *DMAPIG
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s/status", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tasks/templates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDMAPIDeleteTaskRequest generates requests for DMAPIDeleteTask
+func NewDMAPIDeleteTaskRequest(server string, taskName string, params *DMAPIDeleteTaskParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2150,6 +2447,7 @@ func NewDMAPIGetTaskStatusRequest(server string, taskName string, params *DMAPIG
 	queryValues := queryURL.Query()
 
 	if params.SourceNameList != nil {
+
 		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source_name_list", runtime.ParamLocationQuery, *params.SourceNameList); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
@@ -2161,11 +2459,12 @@ func NewDMAPIGetTaskStatusRequest(server string, taskName string, params *DMAPIG
 				}
 			}
 		}
+
 	}
 
 	queryURL.RawQuery = queryValues.Encode()
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2173,183 +2472,2088 @@ func NewDMAPIGetTaskStatusRequest(server string, taskName string, params *DMAPIG
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewDMAPIPauseTaskRequest calls the generic DMAPIPauseTask builder with application/json body
+func NewDMAPIPauseTaskRequest(server string, taskName string, body DMAPIPauseTaskJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIPauseTaskRequestWithBody(server, taskName, "application/json", bodyReader)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewDMAPIPauseTaskRequestWithBody generates requests for DMAPIPauseTask with any type of body
+func NewDMAPIPauseTaskRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// DMAPIGetClusterInfo request
-	DMAPIGetClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterInfoResponse, error)
 
-	// DMAPIGetClusterMasterList request
-	DMAPIGetClusterMasterListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterMasterListResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/pause", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DMAPIOfflineMasterNode request
-	DMAPIOfflineMasterNodeWithResponse(ctx context.Context, masterName string, reqEditors ...RequestEditorFn) (*DMAPIOfflineMasterNodeResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPIGetClusterWorkerList request
-	DMAPIGetClusterWorkerListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterWorkerListResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPIOfflineWorkerNode request
-	DMAPIOfflineWorkerNodeWithResponse(ctx context.Context, workerName string, reqEditors ...RequestEditorFn) (*DMAPIOfflineWorkerNodeResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// GetDocJSON request
-	GetDocJSONWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocJSONResponse, error)
+	return req, nil
+}
 
-	// GetDocHTML request
-	GetDocHTMLWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocHTMLResponse, error)
+// NewDMAPISetTaskPriorityRequest calls the generic DMAPISetTaskPriority builder with application/json body
+func NewDMAPISetTaskPriorityRequest(server string, taskName string, body DMAPISetTaskPriorityJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPISetTaskPriorityRequestWithBody(server, taskName, "application/json", bodyReader)
+}
 
-	// DMAPIGetSourceList request
-	DMAPIGetSourceListWithResponse(ctx context.Context, params *DMAPIGetSourceListParams, reqEditors ...RequestEditorFn) (*DMAPIGetSourceListResponse, error)
+// NewDMAPISetTaskPriorityRequestWithBody generates requests for DMAPISetTaskPriority with any type of body
+func NewDMAPISetTaskPriorityRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// DMAPICreateSource request with any body
-	DMAPICreateSourceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateSourceResponse, error)
+	var pathParam0 string
 
-	DMAPICreateSourceWithResponse(ctx context.Context, body DMAPICreateSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateSourceResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPIDeleteSource request
-	DMAPIDeleteSourceWithResponse(ctx context.Context, sourceName string, params *DMAPIDeleteSourceParams, reqEditors ...RequestEditorFn) (*DMAPIDeleteSourceResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPIPauseRelay request
-	DMAPIPauseRelayWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIPauseRelayResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/priority", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DMAPIResumeRelay request
-	DMAPIResumeRelayWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIResumeRelayResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPIGetSourceSchemaList request
-	DMAPIGetSourceSchemaListWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSourceSchemaListResponse, error)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPIGetSourceTableList request
-	DMAPIGetSourceTableListWithResponse(ctx context.Context, sourceName string, schemaName string, reqEditors ...RequestEditorFn) (*DMAPIGetSourceTableListResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// DMAPIStartRelay request with any body
-	DMAPIStartRelayWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStartRelayResponse, error)
+	return req, nil
+}
 
-	DMAPIStartRelayWithResponse(ctx context.Context, sourceName string, body DMAPIStartRelayJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStartRelayResponse, error)
+// NewDMAPISetTaskRateLimitRequest calls the generic DMAPISetTaskRateLimit builder with application/json body
+func NewDMAPISetTaskRateLimitRequest(server string, taskName string, body DMAPISetTaskRateLimitJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPISetTaskRateLimitRequestWithBody(server, taskName, "application/json", bodyReader)
+}
 
-	// DMAPIGetSourceStatus request
-	DMAPIGetSourceStatusWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSourceStatusResponse, error)
+// NewDMAPISetTaskRateLimitRequestWithBody generates requests for DMAPISetTaskRateLimit with any type of body
+func NewDMAPISetTaskRateLimitRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// DMAPIStopRelay request with any body
-	DMAPIStopRelayWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStopRelayResponse, error)
+	var pathParam0 string
 
-	DMAPIStopRelayWithResponse(ctx context.Context, sourceName string, body DMAPIStopRelayJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStopRelayResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPITransferSource request with any body
-	DMAPITransferSourceWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPITransferSourceResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	DMAPITransferSourceWithResponse(ctx context.Context, sourceName string, body DMAPITransferSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPITransferSourceResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/rate-limit", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DMAPIGetTaskList request
-	DMAPIGetTaskListWithResponse(ctx context.Context, params *DMAPIGetTaskListParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskListResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDMAPIResumeTaskRequest calls the generic DMAPIResumeTask builder with application/json body
+func NewDMAPIResumeTaskRequest(server string, taskName string, body DMAPIResumeTaskJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIResumeTaskRequestWithBody(server, taskName, "application/json", bodyReader)
+}
+
+// NewDMAPIResumeTaskRequestWithBody generates requests for DMAPIResumeTask with any type of body
+func NewDMAPIResumeTaskRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/resume", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDMAPIUpdateTaskRulesRequest calls the generic DMAPIUpdateTaskRules builder with application/json body
+func NewDMAPIUpdateTaskRulesRequest(server string, taskName string, body DMAPIUpdateTaskRulesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIUpdateTaskRulesRequestWithBody(server, taskName, "application/json", bodyReader)
+}
+
+// NewDMAPIUpdateTaskRulesRequestWithBody generates requests for DMAPIUpdateTaskRules with any type of body
+func NewDMAPIUpdateTaskRulesRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/rules", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDMAPIGetShardDDLLockListRequest generates requests for DMAPIGetShardDDLLockList
+func NewDMAPIGetShardDDLLockListRequest(server string, taskName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/shard-ddl-locks", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDMAPIResolveShardDDLLockRequest calls the generic DMAPIResolveShardDDLLock builder with application/json body
+func NewDMAPIResolveShardDDLLockRequest(server string, taskName string, body DMAPIResolveShardDDLLockJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIResolveShardDDLLockRequestWithBody(server, taskName, "application/json", bodyReader)
+}
+
+// NewDMAPIResolveShardDDLLockRequestWithBody generates requests for DMAPIResolveShardDDLLock with any type of body
+func NewDMAPIResolveShardDDLLockRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/shard-ddl-locks/resolve", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDMAPIApproveDDLRequest calls the generic DMAPIApproveDDL builder with application/json body
+func NewDMAPIApproveDDLRequest(server string, taskName string, sourceName string, body DMAPIApproveDDLJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIApproveDDLRequestWithBody(server, taskName, sourceName, "application/json", bodyReader)
+}
+
+// NewDMAPIApproveDDLRequestWithBody generates requests for DMAPIApproveDDL with any type of body
+func NewDMAPIApproveDDLRequestWithBody(server string, taskName string, sourceName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/approve-ddl", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDMAPIGetBinlogOperationsRequest generates requests for DMAPIGetBinlogOperations
+func NewDMAPIGetBinlogOperationsRequest(server string, taskName string, sourceName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/binlog-operations", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDMAPICreateBinlogOperationRequest calls the generic DMAPICreateBinlogOperation builder with application/json body
+func NewDMAPICreateBinlogOperationRequest(server string, taskName string, sourceName string, body DMAPICreateBinlogOperationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPICreateBinlogOperationRequestWithBody(server, taskName, sourceName, "application/json", bodyReader)
+}
+
+// NewDMAPICreateBinlogOperationRequestWithBody generates requests for DMAPICreateBinlogOperation with any type of body
+func NewDMAPICreateBinlogOperationRequestWithBody(server string, taskName string, sourceName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/binlog-operations", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDMAPIGetPausedTablesRequest generates requests for DMAPIGetPausedTables
+func NewDMAPIGetPausedTablesRequest(server string, taskName string, sourceName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/paused-tables", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDMAPIOperatePausedTablesRequest calls the generic DMAPIOperatePausedTables builder with application/json body
+func NewDMAPIOperatePausedTablesRequest(server string, taskName string, sourceName string, body DMAPIOperatePausedTablesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIOperatePausedTablesRequestWithBody(server, taskName, sourceName, "application/json", bodyReader)
+}
+
+// NewDMAPIOperatePausedTablesRequestWithBody generates requests for DMAPIOperatePausedTables with any type of body
+func NewDMAPIOperatePausedTablesRequestWithBody(server string, taskName string, sourceName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/paused-tables", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDMAPIGetSchemaListByTaskAndSourceRequest generates requests for DMAPIGetSchemaListByTaskAndSource
+func NewDMAPIGetSchemaListByTaskAndSourceRequest(server string, taskName string, sourceName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDMAPIGetTableListByTaskAndSourceRequest generates requests for DMAPIGetTableListByTaskAndSource
+func NewDMAPIGetTableListByTaskAndSourceRequest(server string, taskName string, sourceName string, schemaName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "schema-name", runtime.ParamLocationPath, schemaName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDMAPIDeleteTableStructureRequest generates requests for DMAPIDeleteTableStructure
+func NewDMAPIDeleteTableStructureRequest(server string, taskName string, sourceName string, schemaName string, tableName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "schema-name", runtime.ParamLocationPath, schemaName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "table-name", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDMAPIGetTableStructureRequest generates requests for DMAPIGetTableStructure
+func NewDMAPIGetTableStructureRequest(server string, taskName string, sourceName string, schemaName string, tableName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "schema-name", runtime.ParamLocationPath, schemaName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "table-name", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDMAPIOperateTableStructureRequest calls the generic DMAPIOperateTableStructure builder with application/json body
+func NewDMAPIOperateTableStructureRequest(server string, taskName string, sourceName string, schemaName string, tableName string, body DMAPIOperateTableStructureJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIOperateTableStructureRequestWithBody(server, taskName, sourceName, schemaName, tableName, "application/json", bodyReader)
+}
+
+// NewDMAPIOperateTableStructureRequestWithBody generates requests for DMAPIOperateTableStructure with any type of body
+func NewDMAPIOperateTableStructureRequestWithBody(server string, taskName string, sourceName string, schemaName string, tableName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "schema-name", runtime.ParamLocationPath, schemaName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "table-name", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDMAPIGetSkipGTIDsRequest generates requests for DMAPIGetSkipGTIDs
+func NewDMAPIGetSkipGTIDsRequest(server string, taskName string, sourceName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/skip-gtids", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDMAPIOperateSkipGTIDsRequest calls the generic DMAPIOperateSkipGTIDs builder with application/json body
+func NewDMAPIOperateSkipGTIDsRequest(server string, taskName string, sourceName string, body DMAPIOperateSkipGTIDsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIOperateSkipGTIDsRequestWithBody(server, taskName, sourceName, "application/json", bodyReader)
+}
+
+// NewDMAPIOperateSkipGTIDsRequestWithBody generates requests for DMAPIOperateSkipGTIDs with any type of body
+func NewDMAPIOperateSkipGTIDsRequestWithBody(server string, taskName string, sourceName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/skip-gtids", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDMAPIGetTaskStatusRequest generates requests for DMAPIGetTaskStatus
+func NewDMAPIGetTaskStatusRequest(server string, taskName string, params *DMAPIGetTaskStatusParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.SourceNameList != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source_name_list", runtime.ParamLocationQuery, *params.SourceNameList); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// DMAPIGetAuditLogList request
+	DMAPIGetAuditLogListWithResponse(ctx context.Context, params *DMAPIGetAuditLogListParams, reqEditors ...RequestEditorFn) (*DMAPIGetAuditLogListResponse, error)
+
+	// DMAPIGetClusterInfo request
+	DMAPIGetClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterInfoResponse, error)
+
+	// DMAPIGetClusterMasterList request
+	DMAPIGetClusterMasterListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterMasterListResponse, error)
+
+	// DMAPIOfflineMasterNode request
+	DMAPIOfflineMasterNodeWithResponse(ctx context.Context, masterName string, reqEditors ...RequestEditorFn) (*DMAPIOfflineMasterNodeResponse, error)
+
+	// DMAPIGetRebalancePlan request
+	DMAPIGetRebalancePlanWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetRebalancePlanResponse, error)
+
+	// DMAPIRebalance request
+	DMAPIRebalanceWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIRebalanceResponse, error)
+
+	// DMAPIGetClusterStatus request
+	DMAPIGetClusterStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterStatusResponse, error)
+
+	// DMAPIGetWebhookList request
+	DMAPIGetWebhookListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetWebhookListResponse, error)
+
+	// DMAPICreateWebhook request with any body
+	DMAPICreateWebhookWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateWebhookResponse, error)
+
+	DMAPICreateWebhookWithResponse(ctx context.Context, body DMAPICreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateWebhookResponse, error)
+
+	// DMAPIDeleteWebhook request
+	DMAPIDeleteWebhookWithResponse(ctx context.Context, webhookName string, reqEditors ...RequestEditorFn) (*DMAPIDeleteWebhookResponse, error)
+
+	// DMAPIGetClusterWorkerList request
+	DMAPIGetClusterWorkerListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterWorkerListResponse, error)
+
+	// DMAPIOfflineWorkerNode request
+	DMAPIOfflineWorkerNodeWithResponse(ctx context.Context, workerName string, reqEditors ...RequestEditorFn) (*DMAPIOfflineWorkerNodeResponse, error)
+
+	// GetDocJSON request
+	GetDocJSONWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocJSONResponse, error)
+
+	// GetDocHTML request
+	GetDocHTMLWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocHTMLResponse, error)
+
+	// DMAPIGetSourceList request
+	DMAPIGetSourceListWithResponse(ctx context.Context, params *DMAPIGetSourceListParams, reqEditors ...RequestEditorFn) (*DMAPIGetSourceListResponse, error)
+
+	// DMAPICreateSource request with any body
+	DMAPICreateSourceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateSourceResponse, error)
+
+	DMAPICreateSourceWithResponse(ctx context.Context, body DMAPICreateSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateSourceResponse, error)
+
+	// DMAPIDeleteSource request
+	DMAPIDeleteSourceWithResponse(ctx context.Context, sourceName string, params *DMAPIDeleteSourceParams, reqEditors ...RequestEditorFn) (*DMAPIDeleteSourceResponse, error)
+
+	// DMAPIPauseRelay request
+	DMAPIPauseRelayWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIPauseRelayResponse, error)
+
+	// DMAPIResumeRelay request
+	DMAPIResumeRelayWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIResumeRelayResponse, error)
+
+	// DMAPIGetSourceSchemaList request
+	DMAPIGetSourceSchemaListWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSourceSchemaListResponse, error)
+
+	// DMAPIGetSourceTableList request
+	DMAPIGetSourceTableListWithResponse(ctx context.Context, sourceName string, schemaName string, reqEditors ...RequestEditorFn) (*DMAPIGetSourceTableListResponse, error)
+
+	// DMAPIStartRelay request with any body
+	DMAPIStartRelayWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStartRelayResponse, error)
+
+	DMAPIStartRelayWithResponse(ctx context.Context, sourceName string, body DMAPIStartRelayJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStartRelayResponse, error)
+
+	// DMAPIGetSourceStatus request
+	DMAPIGetSourceStatusWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSourceStatusResponse, error)
+
+	// DMAPIStopRelay request with any body
+	DMAPIStopRelayWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStopRelayResponse, error)
+
+	DMAPIStopRelayWithResponse(ctx context.Context, sourceName string, body DMAPIStopRelayJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStopRelayResponse, error)
+
+	// DMAPITransferSource request with any body
+	DMAPITransferSourceWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPITransferSourceResponse, error)
+
+	DMAPITransferSourceWithResponse(ctx context.Context, sourceName string, body DMAPITransferSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPITransferSourceResponse, error)
+
+	// DMAPIGetTaskList request
+	DMAPIGetTaskListWithResponse(ctx context.Context, params *DMAPIGetTaskListParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskListResponse, error)
 
 	// DMAPIStartTask request with any body
 	DMAPIStartTaskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStartTaskResponse, error)
 
-	DMAPIStartTaskWithResponse(ctx context.Context, body DMAPIStartTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStartTaskResponse, error)
+	DMAPIStartTaskWithResponse(ctx context.Context, body DMAPIStartTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStartTaskResponse, error)
+
+	// DMAPIGetTaskTemplateList request
+	DMAPIGetTaskTemplateListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetTaskTemplateListResponse, error)
+
+	// DMAPICreateTaskTemplate request with any body
+	DMAPICreateTaskTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateTaskTemplateResponse, error)
+
+	DMAPICreateTaskTemplateWithResponse(ctx context.Context, body DMAPICreateTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateTaskTemplateResponse, error)
+
+	// DMAPIImportTaskTemplate request with any body
+	DMAPIImportTaskTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIImportTaskTemplateResponse, error)
+
+	DMAPIImportTaskTemplateWithResponse(ctx context.Context, body DMAPIImportTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIImportTaskTemplateResponse, error)
+
+	// DMAPIDeleteTaskTemplate request
+	DMAPIDeleteTaskTemplateWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPIDeleteTaskTemplateResponse, error)
+
+	// DMAPIGetTaskTemplate request
+	DMAPIGetTaskTemplateWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPIGetTaskTemplateResponse, error)
+
+	// DMAPUpdateTaskTemplate request
+	DMAPUpdateTaskTemplateWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPUpdateTaskTemplateResponse, error)
+
+	// DMAPIDeleteTask request
+	DMAPIDeleteTaskWithResponse(ctx context.Context, taskName string, params *DMAPIDeleteTaskParams, reqEditors ...RequestEditorFn) (*DMAPIDeleteTaskResponse, error)
+
+	// DMAPIPauseTask request with any body
+	DMAPIPauseTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIPauseTaskResponse, error)
+
+	DMAPIPauseTaskWithResponse(ctx context.Context, taskName string, body DMAPIPauseTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIPauseTaskResponse, error)
+
+	// DMAPISetTaskPriority request with any body
+	DMAPISetTaskPriorityWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPISetTaskPriorityResponse, error)
+
+	DMAPISetTaskPriorityWithResponse(ctx context.Context, taskName string, body DMAPISetTaskPriorityJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPISetTaskPriorityResponse, error)
+
+	// DMAPISetTaskRateLimit request with any body
+	DMAPISetTaskRateLimitWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPISetTaskRateLimitResponse, error)
+
+	DMAPISetTaskRateLimitWithResponse(ctx context.Context, taskName string, body DMAPISetTaskRateLimitJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPISetTaskRateLimitResponse, error)
+
+	// DMAPIResumeTask request with any body
+	DMAPIResumeTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIResumeTaskResponse, error)
+
+	DMAPIResumeTaskWithResponse(ctx context.Context, taskName string, body DMAPIResumeTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIResumeTaskResponse, error)
+
+	// DMAPIUpdateTaskRules request with any body
+	DMAPIUpdateTaskRulesWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskRulesResponse, error)
+
+	DMAPIUpdateTaskRulesWithResponse(ctx context.Context, taskName string, body DMAPIUpdateTaskRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskRulesResponse, error)
+
+	// DMAPIGetShardDDLLockList request
+	DMAPIGetShardDDLLockListWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPIGetShardDDLLockListResponse, error)
+
+	// DMAPIResolveShardDDLLock request with any body
+	DMAPIResolveShardDDLLockWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIResolveShardDDLLockResponse, error)
+
+	DMAPIResolveShardDDLLockWithResponse(ctx context.Context, taskName string, body DMAPIResolveShardDDLLockJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIResolveShardDDLLockResponse, error)
+
+	// DMAPIApproveDDL request with any body
+	DMAPIApproveDDLWithBodyWithResponse(ctx context.Context, taskName string, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIApproveDDLResponse, error)
+
+	DMAPIApproveDDLWithResponse(ctx context.Context, taskName string, sourceName string, body DMAPIApproveDDLJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIApproveDDLResponse, error)
+
+	// DMAPIGetBinlogOperations request
+	DMAPIGetBinlogOperationsWithResponse(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetBinlogOperationsResponse, error)
+
+	// DMAPICreateBinlogOperation request with any body
+	DMAPICreateBinlogOperationWithBodyWithResponse(ctx context.Context, taskName string, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateBinlogOperationResponse, error)
+
+	DMAPICreateBinlogOperationWithResponse(ctx context.Context, taskName string, sourceName string, body DMAPICreateBinlogOperationJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateBinlogOperationResponse, error)
+
+	// DMAPIGetPausedTables request
+	DMAPIGetPausedTablesWithResponse(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetPausedTablesResponse, error)
+
+	// DMAPIOperatePausedTables request with any body
+	DMAPIOperatePausedTablesWithBodyWithResponse(ctx context.Context, taskName string, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIOperatePausedTablesResponse, error)
+
+	DMAPIOperatePausedTablesWithResponse(ctx context.Context, taskName string, sourceName string, body DMAPIOperatePausedTablesJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIOperatePausedTablesResponse, error)
+
+	// DMAPIGetSchemaListByTaskAndSource request
+	DMAPIGetSchemaListByTaskAndSourceWithResponse(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSchemaListByTaskAndSourceResponse, error)
+
+	// DMAPIGetTableListByTaskAndSource request
+	DMAPIGetTableListByTaskAndSourceWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, reqEditors ...RequestEditorFn) (*DMAPIGetTableListByTaskAndSourceResponse, error)
+
+	// DMAPIDeleteTableStructure request
+	DMAPIDeleteTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, reqEditors ...RequestEditorFn) (*DMAPIDeleteTableStructureResponse, error)
+
+	// DMAPIGetTableStructure request
+	DMAPIGetTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, reqEditors ...RequestEditorFn) (*DMAPIGetTableStructureResponse, error)
+
+	// DMAPIOperateTableStructure request with any body
+	DMAPIOperateTableStructureWithBodyWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIOperateTableStructureResponse, error)
+
+	DMAPIOperateTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, body DMAPIOperateTableStructureJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIOperateTableStructureResponse, error)
+
+	// DMAPIGetSkipGTIDs request
+	DMAPIGetSkipGTIDsWithResponse(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSkipGTIDsResponse, error)
+
+	// DMAPIOperateSkipGTIDs request with any body
+	DMAPIOperateSkipGTIDsWithBodyWithResponse(ctx context.Context, taskName string, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIOperateSkipGTIDsResponse, error)
+
+	DMAPIOperateSkipGTIDsWithResponse(ctx context.Context, taskName string, sourceName string, body DMAPIOperateSkipGTIDsJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIOperateSkipGTIDsResponse, error)
+
+	// DMAPIGetTaskStatus request
+	DMAPIGetTaskStatusWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskStatusParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskStatusResponse, error)
+}
+
+type DMAPIGetAuditLogListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetAuditLogListResponse
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetAuditLogListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetAuditLogListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIGetClusterInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetClusterInfoResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetClusterInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetClusterInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIGetClusterMasterListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetClusterMasterListResponse
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetClusterMasterListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetClusterMasterListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIOfflineMasterNodeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIOfflineMasterNodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIOfflineMasterNodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIGetRebalancePlanResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RebalancePlan
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetRebalancePlanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetRebalancePlanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIRebalanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RebalancePlan
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIRebalanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIRebalanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIGetClusterStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetClusterStatusResponse
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetClusterStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetClusterStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIGetWebhookListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetWebhookListResponse
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetWebhookListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetWebhookListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPICreateWebhookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Webhook
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPICreateWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPICreateWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIDeleteWebhookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIDeleteWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIDeleteWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIGetClusterWorkerListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetClusterWorkerListResponse
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetClusterWorkerListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetClusterWorkerListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIOfflineWorkerNodeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIOfflineWorkerNodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIOfflineWorkerNodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDocJSONResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDocJSONResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDocJSONResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDocHTMLResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDocHTMLResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDocHTMLResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIGetSourceListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetSourceListResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetSourceListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetSourceListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPICreateSourceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Source
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPICreateSourceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPICreateSourceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIDeleteSourceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON204      *DeleteSourceResponse
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIDeleteSourceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIDeleteSourceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIPauseRelayResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIPauseRelayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIPauseRelayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIResumeRelayResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIResumeRelayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIResumeRelayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIGetSourceSchemaListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SchemaNameList
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetSourceSchemaListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetSourceSchemaListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIGetSourceTableListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TableNameList
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetSourceTableListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetSourceTableListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIStartRelayResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIStartRelayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIStartRelayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIGetSourceStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetSourceStatusResponse
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetSourceStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// DMAPIGetTaskTemplateList request
-	DMAPIGetTaskTemplateListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetTaskTemplateListResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetSourceStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// DMAPICreateTaskTemplate request with any body
-	DMAPICreateTaskTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateTaskTemplateResponse, error)
+type DMAPIStopRelayResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
 
-	DMAPICreateTaskTemplateWithResponse(ctx context.Context, body DMAPICreateTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateTaskTemplateResponse, error)
+// Status returns HTTPResponse.Status
+func (r DMAPIStopRelayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// DMAPIImportTaskTemplate request with any body
-	DMAPIImportTaskTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIImportTaskTemplateResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIStopRelayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	DMAPIImportTaskTemplateWithResponse(ctx context.Context, body DMAPIImportTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIImportTaskTemplateResponse, error)
+type DMAPITransferSourceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
 
-	// DMAPIDeleteTaskTemplate request
-	DMAPIDeleteTaskTemplateWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPIDeleteTaskTemplateResponse, error)
+// Status returns HTTPResponse.Status
+func (r DMAPITransferSourceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPITransferSourceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIGetTaskListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetTaskListResponse
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetTaskListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetTaskListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIStartTaskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Task
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIStartTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIStartTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIGetTaskTemplateListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetTaskListResponse
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetTaskTemplateListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetTaskTemplateListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPICreateTaskTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Task
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPICreateTaskTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPICreateTaskTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIImportTaskTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *TaskTemplateResponse
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIImportTaskTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIImportTaskTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIDeleteTaskTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIDeleteTaskTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIDeleteTaskTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIGetTaskTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Task
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetTaskTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetTaskTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPUpdateTaskTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Task
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPUpdateTaskTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPUpdateTaskTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIDeleteTaskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
 
-	// DMAPIGetTaskTemplate request
-	DMAPIGetTaskTemplateWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPIGetTaskTemplateResponse, error)
+// Status returns HTTPResponse.Status
+func (r DMAPIDeleteTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// DMAPUpdateTaskTemplate request
-	DMAPUpdateTaskTemplateWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPUpdateTaskTemplateResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIDeleteTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// DMAPIDeleteTask request
-	DMAPIDeleteTaskWithResponse(ctx context.Context, taskName string, params *DMAPIDeleteTaskParams, reqEditors ...RequestEditorFn) (*DMAPIDeleteTaskResponse, error)
+type DMAPIPauseTaskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
 
-	// DMAPIPauseTask request with any body
-	DMAPIPauseTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIPauseTaskResponse, error)
+// Status returns HTTPResponse.Status
+func (r DMAPIPauseTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	DMAPIPauseTaskWithResponse(ctx context.Context, taskName string, body DMAPIPauseTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIPauseTaskResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIPauseTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// DMAPIResumeTask request with any body
-	DMAPIResumeTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIResumeTaskResponse, error)
+type DMAPISetTaskPriorityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
 
-	DMAPIResumeTaskWithResponse(ctx context.Context, taskName string, body DMAPIResumeTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIResumeTaskResponse, error)
+// Status returns HTTPResponse.Status
+func (r DMAPISetTaskPriorityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// DMAPIGetSchemaListByTaskAndSource request
-	DMAPIGetSchemaListByTaskAndSourceWithResponse(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSchemaListByTaskAndSourceResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPISetTaskPriorityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// DMAPIGetTableListByTaskAndSource request
-	DMAPIGetTableListByTaskAndSourceWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, reqEditors ...RequestEditorFn) (*DMAPIGetTableListByTaskAndSourceResponse, error)
+type DMAPISetTaskRateLimitResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
 
-	// DMAPIDeleteTableStructure request
-	DMAPIDeleteTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, reqEditors ...RequestEditorFn) (*DMAPIDeleteTableStructureResponse, error)
+// Status returns HTTPResponse.Status
+func (r DMAPISetTaskRateLimitResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// DMAPIGetTableStructure request
-	DMAPIGetTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, reqEditors ...RequestEditorFn) (*DMAPIGetTableStructureResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPISetTaskRateLimitResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// DMAPIOperateTableStructure request with any body
-	DMAPIOperateTableStructureWithBodyWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIOperateTableStructureResponse, error)
+type DMAPIResumeTaskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
 
-	DMAPIOperateTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, body DMAPIOperateTableStructureJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIOperateTableStructureResponse, error)
+// Status returns HTTPResponse.Status
+func (r DMAPIResumeTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// DMAPIGetTaskStatus request
-	DMAPIGetTaskStatusWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskStatusParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskStatusResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIResumeTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type DMAPIGetClusterInfoResponse struct {
+type DMAPIUpdateTaskRulesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *GetClusterInfoResponse
+	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIGetClusterInfoResponse) Status() string {
+func (r DMAPIUpdateTaskRulesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2357,22 +4561,22 @@ func (r DMAPIGetClusterInfoResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetClusterInfoResponse) StatusCode() int {
+func (r DMAPIUpdateTaskRulesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIGetClusterMasterListResponse struct {
+type DMAPIGetShardDDLLockListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *GetClusterMasterListResponse
+	JSON200      *GetShardDDLLockListResponse
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIGetClusterMasterListResponse) Status() string {
+func (r DMAPIGetShardDDLLockListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2380,21 +4584,22 @@ func (r DMAPIGetClusterMasterListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetClusterMasterListResponse) StatusCode() int {
+func (r DMAPIGetShardDDLLockListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIOfflineMasterNodeResponse struct {
+type DMAPIResolveShardDDLLockResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *ShardDDLLock
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIOfflineMasterNodeResponse) Status() string {
+func (r DMAPIResolveShardDDLLockResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2402,22 +4607,21 @@ func (r DMAPIOfflineMasterNodeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIOfflineMasterNodeResponse) StatusCode() int {
+func (r DMAPIResolveShardDDLLockResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIGetClusterWorkerListResponse struct {
+type DMAPIApproveDDLResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *GetClusterWorkerListResponse
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIGetClusterWorkerListResponse) Status() string {
+func (r DMAPIApproveDDLResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2425,21 +4629,22 @@ func (r DMAPIGetClusterWorkerListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetClusterWorkerListResponse) StatusCode() int {
+func (r DMAPIApproveDDLResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIOfflineWorkerNodeResponse struct {
+type DMAPIGetBinlogOperationsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *GetBinlogOperationsResponse
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIOfflineWorkerNodeResponse) Status() string {
+func (r DMAPIGetBinlogOperationsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2447,20 +4652,21 @@ func (r DMAPIOfflineWorkerNodeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIOfflineWorkerNodeResponse) StatusCode() int {
+func (r DMAPIGetBinlogOperationsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetDocJSONResponse struct {
+type DMAPICreateBinlogOperationResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r GetDocJSONResponse) Status() string {
+func (r DMAPICreateBinlogOperationResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2468,20 +4674,22 @@ func (r GetDocJSONResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetDocJSONResponse) StatusCode() int {
+func (r DMAPICreateBinlogOperationResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetDocHTMLResponse struct {
+type DMAPIGetPausedTablesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *GetPausedTablesResponse
+	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r GetDocHTMLResponse) Status() string {
+func (r DMAPIGetPausedTablesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2489,21 +4697,22 @@ func (r GetDocHTMLResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetDocHTMLResponse) StatusCode() int {
+func (r DMAPIGetPausedTablesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIGetSourceListResponse struct {
+type DMAPIOperatePausedTablesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *GetSourceListResponse
+	JSON200      *GetPausedTablesResponse
+	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIGetSourceListResponse) Status() string {
+func (r DMAPIOperatePausedTablesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2511,22 +4720,22 @@ func (r DMAPIGetSourceListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetSourceListResponse) StatusCode() int {
+func (r DMAPIOperatePausedTablesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPICreateSourceResponse struct {
+type DMAPIGetSchemaListByTaskAndSourceResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *Source
+	JSON200      *SchemaNameList
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPICreateSourceResponse) Status() string {
+func (r DMAPIGetSchemaListByTaskAndSourceResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2534,22 +4743,22 @@ func (r DMAPICreateSourceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPICreateSourceResponse) StatusCode() int {
+func (r DMAPIGetSchemaListByTaskAndSourceResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIDeleteSourceResponse struct {
+type DMAPIGetTableListByTaskAndSourceResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON204      *DeleteSourceResponse
+	JSON200      *TableNameList
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIDeleteSourceResponse) Status() string {
+func (r DMAPIGetTableListByTaskAndSourceResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2557,21 +4766,21 @@ func (r DMAPIDeleteSourceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIDeleteSourceResponse) StatusCode() int {
+func (r DMAPIGetTableListByTaskAndSourceResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIPauseRelayResponse struct {
+type DMAPIDeleteTableStructureResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIPauseRelayResponse) Status() string {
+func (r DMAPIDeleteTableStructureResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2579,21 +4788,22 @@ func (r DMAPIPauseRelayResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIPauseRelayResponse) StatusCode() int {
+func (r DMAPIDeleteTableStructureResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIResumeRelayResponse struct {
+type DMAPIGetTableStructureResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *GetTaskTableStructureResponse
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIResumeRelayResponse) Status() string {
+func (r DMAPIGetTableStructureResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2601,22 +4811,21 @@ func (r DMAPIResumeRelayResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIResumeRelayResponse) StatusCode() int {
+func (r DMAPIGetTableStructureResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIGetSourceSchemaListResponse struct {
+type DMAPIOperateTableStructureResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *SchemaNameList
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIGetSourceSchemaListResponse) Status() string {
+func (r DMAPIOperateTableStructureResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2624,22 +4833,22 @@ func (r DMAPIGetSourceSchemaListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetSourceSchemaListResponse) StatusCode() int {
+func (r DMAPIOperateTableStructureResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIGetSourceTableListResponse struct {
+type DMAPIGetSkipGTIDsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TableNameList
+	JSON200      *SkipGTIDsResponse
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIGetSourceTableListResponse) Status() string {
+func (r DMAPIGetSkipGTIDsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2647,21 +4856,22 @@ func (r DMAPIGetSourceTableListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetSourceTableListResponse) StatusCode() int {
+func (r DMAPIGetSkipGTIDsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIStartRelayResponse struct {
+type DMAPIOperateSkipGTIDsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *SkipGTIDsResponse
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIStartRelayResponse) Status() string {
+func (r DMAPIOperateSkipGTIDsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2669,901 +4879,1267 @@ func (r DMAPIStartRelayResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIStartRelayResponse) StatusCode() int {
+func (r DMAPIOperateSkipGTIDsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DMAPIGetSourceStatusResponse struct {
+type DMAPIGetTaskStatusResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *GetSourceStatusResponse
+	JSON200      *GetTaskStatusResponse
 	JSON400      *ErrorWithMessage
 }
 
 // Status returns HTTPResponse.Status
-func (r DMAPIGetSourceStatusResponse) Status() string {
+func (r DMAPIGetTaskStatusResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetTaskStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// DMAPIGetAuditLogListWithResponse request returning *DMAPIGetAuditLogListResponse
+func (c *ClientWithResponses) DMAPIGetAuditLogListWithResponse(ctx context.Context, params *DMAPIGetAuditLogListParams, reqEditors ...RequestEditorFn) (*DMAPIGetAuditLogListResponse, error) {
+	rsp, err := c.DMAPIGetAuditLogList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetAuditLogListResponse(rsp)
+}
+
+// DMAPIGetClusterInfoWithResponse request returning *DMAPIGetClusterInfoResponse
+func (c *ClientWithResponses) DMAPIGetClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterInfoResponse, error) {
+	rsp, err := c.DMAPIGetClusterInfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetClusterInfoResponse(rsp)
+}
+
+// DMAPIGetClusterMasterListWithResponse request returning *DMAPIGetClusterMasterListResponse
+func (c *ClientWithResponses) DMAPIGetClusterMasterListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterMasterListResponse, error) {
+	rsp, err := c.DMAPIGetClusterMasterList(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetClusterMasterListResponse(rsp)
+}
+
+// DMAPIOfflineMasterNodeWithResponse request returning *DMAPIOfflineMasterNodeResponse
+func (c *ClientWithResponses) DMAPIOfflineMasterNodeWithResponse(ctx context.Context, masterName string, reqEditors ...RequestEditorFn) (*DMAPIOfflineMasterNodeResponse, error) {
+	rsp, err := c.DMAPIOfflineMasterNode(ctx, masterName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIOfflineMasterNodeResponse(rsp)
+}
+
+// DMAPIGetRebalancePlanWithResponse request returning *DMAPIGetRebalancePlanResponse
+func (c *ClientWithResponses) DMAPIGetRebalancePlanWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetRebalancePlanResponse, error) {
+	rsp, err := c.DMAPIGetRebalancePlan(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetRebalancePlanResponse(rsp)
+}
+
+// DMAPIRebalanceWithResponse request returning *DMAPIRebalanceResponse
+func (c *ClientWithResponses) DMAPIRebalanceWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIRebalanceResponse, error) {
+	rsp, err := c.DMAPIRebalance(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIRebalanceResponse(rsp)
+}
+
+// DMAPIGetClusterStatusWithResponse request returning *DMAPIGetClusterStatusResponse
+func (c *ClientWithResponses) DMAPIGetClusterStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterStatusResponse, error) {
+	rsp, err := c.DMAPIGetClusterStatus(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetClusterStatusResponse(rsp)
+}
+
+// DMAPIGetWebhookListWithResponse request returning *DMAPIGetWebhookListResponse
+func (c *ClientWithResponses) DMAPIGetWebhookListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetWebhookListResponse, error) {
+	rsp, err := c.DMAPIGetWebhookList(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetWebhookListResponse(rsp)
+}
+
+// DMAPICreateWebhookWithBodyWithResponse request with arbitrary body returning *DMAPICreateWebhookResponse
+func (c *ClientWithResponses) DMAPICreateWebhookWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateWebhookResponse, error) {
+	rsp, err := c.DMAPICreateWebhookWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPICreateWebhookResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetSourceStatusResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) DMAPICreateWebhookWithResponse(ctx context.Context, body DMAPICreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateWebhookResponse, error) {
+	rsp, err := c.DMAPICreateWebhook(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPICreateWebhookResponse(rsp)
 }
 
-type DMAPIStopRelayResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorWithMessage
+// DMAPIDeleteWebhookWithResponse request returning *DMAPIDeleteWebhookResponse
+func (c *ClientWithResponses) DMAPIDeleteWebhookWithResponse(ctx context.Context, webhookName string, reqEditors ...RequestEditorFn) (*DMAPIDeleteWebhookResponse, error) {
+	rsp, err := c.DMAPIDeleteWebhook(ctx, webhookName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIDeleteWebhookResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPIStopRelayResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DMAPIGetClusterWorkerListWithResponse request returning *DMAPIGetClusterWorkerListResponse
+func (c *ClientWithResponses) DMAPIGetClusterWorkerListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterWorkerListResponse, error) {
+	rsp, err := c.DMAPIGetClusterWorkerList(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPIGetClusterWorkerListResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIStopRelayResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DMAPIOfflineWorkerNodeWithResponse request returning *DMAPIOfflineWorkerNodeResponse
+func (c *ClientWithResponses) DMAPIOfflineWorkerNodeWithResponse(ctx context.Context, workerName string, reqEditors ...RequestEditorFn) (*DMAPIOfflineWorkerNodeResponse, error) {
+	rsp, err := c.DMAPIOfflineWorkerNode(ctx, workerName, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPIOfflineWorkerNodeResponse(rsp)
 }
 
-type DMAPITransferSourceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorWithMessage
+// GetDocJSONWithResponse request returning *GetDocJSONResponse
+func (c *ClientWithResponses) GetDocJSONWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocJSONResponse, error) {
+	rsp, err := c.GetDocJSON(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDocJSONResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPITransferSourceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetDocHTMLWithResponse request returning *GetDocHTMLResponse
+func (c *ClientWithResponses) GetDocHTMLWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocHTMLResponse, error) {
+	rsp, err := c.GetDocHTML(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetDocHTMLResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPITransferSourceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DMAPIGetSourceListWithResponse request returning *DMAPIGetSourceListResponse
+func (c *ClientWithResponses) DMAPIGetSourceListWithResponse(ctx context.Context, params *DMAPIGetSourceListParams, reqEditors ...RequestEditorFn) (*DMAPIGetSourceListResponse, error) {
+	rsp, err := c.DMAPIGetSourceList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPIGetSourceListResponse(rsp)
 }
 
-type DMAPIGetTaskListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetTaskListResponse
-	JSON400      *ErrorWithMessage
+// DMAPICreateSourceWithBodyWithResponse request with arbitrary body returning *DMAPICreateSourceResponse
+func (c *ClientWithResponses) DMAPICreateSourceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateSourceResponse, error) {
+	rsp, err := c.DMAPICreateSourceWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPICreateSourceResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPIGetTaskListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) DMAPICreateSourceWithResponse(ctx context.Context, body DMAPICreateSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateSourceResponse, error) {
+	rsp, err := c.DMAPICreateSource(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPICreateSourceResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetTaskListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DMAPIDeleteSourceWithResponse request returning *DMAPIDeleteSourceResponse
+func (c *ClientWithResponses) DMAPIDeleteSourceWithResponse(ctx context.Context, sourceName string, params *DMAPIDeleteSourceParams, reqEditors ...RequestEditorFn) (*DMAPIDeleteSourceResponse, error) {
+	rsp, err := c.DMAPIDeleteSource(ctx, sourceName, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPIDeleteSourceResponse(rsp)
 }
 
-type DMAPIStartTaskResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Task
-	JSON400      *ErrorWithMessage
+// DMAPIPauseRelayWithResponse request returning *DMAPIPauseRelayResponse
+func (c *ClientWithResponses) DMAPIPauseRelayWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIPauseRelayResponse, error) {
+	rsp, err := c.DMAPIPauseRelay(ctx, sourceName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIPauseRelayResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPIStartTaskResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DMAPIResumeRelayWithResponse request returning *DMAPIResumeRelayResponse
+func (c *ClientWithResponses) DMAPIResumeRelayWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIResumeRelayResponse, error) {
+	rsp, err := c.DMAPIResumeRelay(ctx, sourceName, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPIResumeRelayResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIStartTaskResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DMAPIGetSourceSchemaListWithResponse request returning *DMAPIGetSourceSchemaListResponse
+func (c *ClientWithResponses) DMAPIGetSourceSchemaListWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSourceSchemaListResponse, error) {
+	rsp, err := c.DMAPIGetSourceSchemaList(ctx, sourceName, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPIGetSourceSchemaListResponse(rsp)
 }
 
-type DMAPIGetTaskTemplateListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetTaskListResponse
-	JSON400      *ErrorWithMessage
+// DMAPIGetSourceTableListWithResponse request returning *DMAPIGetSourceTableListResponse
+func (c *ClientWithResponses) DMAPIGetSourceTableListWithResponse(ctx context.Context, sourceName string, schemaName string, reqEditors ...RequestEditorFn) (*DMAPIGetSourceTableListResponse, error) {
+	rsp, err := c.DMAPIGetSourceTableList(ctx, sourceName, schemaName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetSourceTableListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPIGetTaskTemplateListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DMAPIStartRelayWithBodyWithResponse request with arbitrary body returning *DMAPIStartRelayResponse
+func (c *ClientWithResponses) DMAPIStartRelayWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStartRelayResponse, error) {
+	rsp, err := c.DMAPIStartRelayWithBody(ctx, sourceName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPIStartRelayResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetTaskTemplateListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) DMAPIStartRelayWithResponse(ctx context.Context, sourceName string, body DMAPIStartRelayJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStartRelayResponse, error) {
+	rsp, err := c.DMAPIStartRelay(ctx, sourceName, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPIStartRelayResponse(rsp)
 }
 
-type DMAPICreateTaskTemplateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Task
-	JSON400      *ErrorWithMessage
+// DMAPIGetSourceStatusWithResponse request returning *DMAPIGetSourceStatusResponse
+func (c *ClientWithResponses) DMAPIGetSourceStatusWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSourceStatusResponse, error) {
+	rsp, err := c.DMAPIGetSourceStatus(ctx, sourceName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetSourceStatusResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPICreateTaskTemplateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DMAPIStopRelayWithBodyWithResponse request with arbitrary body returning *DMAPIStopRelayResponse
+func (c *ClientWithResponses) DMAPIStopRelayWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStopRelayResponse, error) {
+	rsp, err := c.DMAPIStopRelayWithBody(ctx, sourceName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPIStopRelayResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPICreateTaskTemplateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) DMAPIStopRelayWithResponse(ctx context.Context, sourceName string, body DMAPIStopRelayJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStopRelayResponse, error) {
+	rsp, err := c.DMAPIStopRelay(ctx, sourceName, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPIStopRelayResponse(rsp)
 }
 
-type DMAPIImportTaskTemplateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON202      *TaskTemplateResponse
-	JSON400      *ErrorWithMessage
+// DMAPITransferSourceWithBodyWithResponse request with arbitrary body returning *DMAPITransferSourceResponse
+func (c *ClientWithResponses) DMAPITransferSourceWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPITransferSourceResponse, error) {
+	rsp, err := c.DMAPITransferSourceWithBody(ctx, sourceName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPITransferSourceResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPIImportTaskTemplateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) DMAPITransferSourceWithResponse(ctx context.Context, sourceName string, body DMAPITransferSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPITransferSourceResponse, error) {
+	rsp, err := c.DMAPITransferSource(ctx, sourceName, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPITransferSourceResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIImportTaskTemplateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DMAPIGetTaskListWithResponse request returning *DMAPIGetTaskListResponse
+func (c *ClientWithResponses) DMAPIGetTaskListWithResponse(ctx context.Context, params *DMAPIGetTaskListParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskListResponse, error) {
+	rsp, err := c.DMAPIGetTaskList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPIGetTaskListResponse(rsp)
 }
 
-type DMAPIDeleteTaskTemplateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorWithMessage
+// DMAPIStartTaskWithBodyWithResponse request with arbitrary body returning *DMAPIStartTaskResponse
+func (c *ClientWithResponses) DMAPIStartTaskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStartTaskResponse, error) {
+	rsp, err := c.DMAPIStartTaskWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIStartTaskResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPIDeleteTaskTemplateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) DMAPIStartTaskWithResponse(ctx context.Context, body DMAPIStartTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStartTaskResponse, error) {
+	rsp, err := c.DMAPIStartTask(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPIStartTaskResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIDeleteTaskTemplateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DMAPIGetTaskTemplateListWithResponse request returning *DMAPIGetTaskTemplateListResponse
+func (c *ClientWithResponses) DMAPIGetTaskTemplateListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetTaskTemplateListResponse, error) {
+	rsp, err := c.DMAPIGetTaskTemplateList(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type DMAPIGetTaskTemplateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Task
-	JSON400      *ErrorWithMessage
+	return ParseDMAPIGetTaskTemplateListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPIGetTaskTemplateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DMAPICreateTaskTemplateWithBodyWithResponse request with arbitrary body returning *DMAPICreateTaskTemplateResponse
+func (c *ClientWithResponses) DMAPICreateTaskTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateTaskTemplateResponse, error) {
+	rsp, err := c.DMAPICreateTaskTemplateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPICreateTaskTemplateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetTaskTemplateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) DMAPICreateTaskTemplateWithResponse(ctx context.Context, body DMAPICreateTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateTaskTemplateResponse, error) {
+	rsp, err := c.DMAPICreateTaskTemplate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPICreateTaskTemplateResponse(rsp)
 }
 
-type DMAPUpdateTaskTemplateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Task
-	JSON400      *ErrorWithMessage
+// DMAPIImportTaskTemplateWithBodyWithResponse request with arbitrary body returning *DMAPIImportTaskTemplateResponse
+func (c *ClientWithResponses) DMAPIImportTaskTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIImportTaskTemplateResponse, error) {
+	rsp, err := c.DMAPIImportTaskTemplateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIImportTaskTemplateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPUpdateTaskTemplateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) DMAPIImportTaskTemplateWithResponse(ctx context.Context, body DMAPIImportTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIImportTaskTemplateResponse, error) {
+	rsp, err := c.DMAPIImportTaskTemplate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPIImportTaskTemplateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPUpdateTaskTemplateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DMAPIDeleteTaskTemplateWithResponse request returning *DMAPIDeleteTaskTemplateResponse
+func (c *ClientWithResponses) DMAPIDeleteTaskTemplateWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPIDeleteTaskTemplateResponse, error) {
+	rsp, err := c.DMAPIDeleteTaskTemplate(ctx, taskName, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPIDeleteTaskTemplateResponse(rsp)
 }
 
-type DMAPIDeleteTaskResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorWithMessage
+// DMAPIGetTaskTemplateWithResponse request returning *DMAPIGetTaskTemplateResponse
+func (c *ClientWithResponses) DMAPIGetTaskTemplateWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPIGetTaskTemplateResponse, error) {
+	rsp, err := c.DMAPIGetTaskTemplate(ctx, taskName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetTaskTemplateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPIDeleteTaskResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DMAPUpdateTaskTemplateWithResponse request returning *DMAPUpdateTaskTemplateResponse
+func (c *ClientWithResponses) DMAPUpdateTaskTemplateWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPUpdateTaskTemplateResponse, error) {
+	rsp, err := c.DMAPUpdateTaskTemplate(ctx, taskName, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPUpdateTaskTemplateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIDeleteTaskResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DMAPIDeleteTaskWithResponse request returning *DMAPIDeleteTaskResponse
+func (c *ClientWithResponses) DMAPIDeleteTaskWithResponse(ctx context.Context, taskName string, params *DMAPIDeleteTaskParams, reqEditors ...RequestEditorFn) (*DMAPIDeleteTaskResponse, error) {
+	rsp, err := c.DMAPIDeleteTask(ctx, taskName, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPIDeleteTaskResponse(rsp)
 }
 
-type DMAPIPauseTaskResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorWithMessage
+// DMAPIPauseTaskWithBodyWithResponse request with arbitrary body returning *DMAPIPauseTaskResponse
+func (c *ClientWithResponses) DMAPIPauseTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIPauseTaskResponse, error) {
+	rsp, err := c.DMAPIPauseTaskWithBody(ctx, taskName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIPauseTaskResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPIPauseTaskResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) DMAPIPauseTaskWithResponse(ctx context.Context, taskName string, body DMAPIPauseTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIPauseTaskResponse, error) {
+	rsp, err := c.DMAPIPauseTask(ctx, taskName, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPIPauseTaskResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIPauseTaskResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DMAPISetTaskPriorityWithBodyWithResponse request with arbitrary body returning *DMAPISetTaskPriorityResponse
+func (c *ClientWithResponses) DMAPISetTaskPriorityWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPISetTaskPriorityResponse, error) {
+	rsp, err := c.DMAPISetTaskPriorityWithBody(ctx, taskName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPISetTaskPriorityResponse(rsp)
 }
 
-type DMAPIResumeTaskResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorWithMessage
+func (c *ClientWithResponses) DMAPISetTaskPriorityWithResponse(ctx context.Context, taskName string, body DMAPISetTaskPriorityJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPISetTaskPriorityResponse, error) {
+	rsp, err := c.DMAPISetTaskPriority(ctx, taskName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPISetTaskPriorityResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPIResumeTaskResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DMAPISetTaskRateLimitWithBodyWithResponse request with arbitrary body returning *DMAPISetTaskRateLimitResponse
+func (c *ClientWithResponses) DMAPISetTaskRateLimitWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPISetTaskRateLimitResponse, error) {
+	rsp, err := c.DMAPISetTaskRateLimitWithBody(ctx, taskName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPISetTaskRateLimitResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIResumeTaskResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) DMAPISetTaskRateLimitWithResponse(ctx context.Context, taskName string, body DMAPISetTaskRateLimitJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPISetTaskRateLimitResponse, error) {
+	rsp, err := c.DMAPISetTaskRateLimit(ctx, taskName, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPISetTaskRateLimitResponse(rsp)
 }
 
-type DMAPIGetSchemaListByTaskAndSourceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SchemaNameList
-	JSON400      *ErrorWithMessage
+// DMAPIResumeTaskWithBodyWithResponse request with arbitrary body returning *DMAPIResumeTaskResponse
+func (c *ClientWithResponses) DMAPIResumeTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIResumeTaskResponse, error) {
+	rsp, err := c.DMAPIResumeTaskWithBody(ctx, taskName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIResumeTaskResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPIGetSchemaListByTaskAndSourceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) DMAPIResumeTaskWithResponse(ctx context.Context, taskName string, body DMAPIResumeTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIResumeTaskResponse, error) {
+	rsp, err := c.DMAPIResumeTask(ctx, taskName, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPIResumeTaskResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetSchemaListByTaskAndSourceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DMAPIUpdateTaskRulesWithBodyWithResponse request with arbitrary body returning *DMAPIUpdateTaskRulesResponse
+func (c *ClientWithResponses) DMAPIUpdateTaskRulesWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskRulesResponse, error) {
+	rsp, err := c.DMAPIUpdateTaskRulesWithBody(ctx, taskName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPIUpdateTaskRulesResponse(rsp)
 }
 
-type DMAPIGetTableListByTaskAndSourceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TableNameList
-	JSON400      *ErrorWithMessage
+func (c *ClientWithResponses) DMAPIUpdateTaskRulesWithResponse(ctx context.Context, taskName string, body DMAPIUpdateTaskRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskRulesResponse, error) {
+	rsp, err := c.DMAPIUpdateTaskRules(ctx, taskName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIUpdateTaskRulesResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPIGetTableListByTaskAndSourceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DMAPIGetShardDDLLockListWithResponse request returning *DMAPIGetShardDDLLockListResponse
+func (c *ClientWithResponses) DMAPIGetShardDDLLockListWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPIGetShardDDLLockListResponse, error) {
+	rsp, err := c.DMAPIGetShardDDLLockList(ctx, taskName, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPIGetShardDDLLockListResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetTableListByTaskAndSourceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DMAPIResolveShardDDLLockWithBodyWithResponse request with arbitrary body returning *DMAPIResolveShardDDLLockResponse
+func (c *ClientWithResponses) DMAPIResolveShardDDLLockWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIResolveShardDDLLockResponse, error) {
+	rsp, err := c.DMAPIResolveShardDDLLockWithBody(ctx, taskName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPIResolveShardDDLLockResponse(rsp)
 }
 
-type DMAPIDeleteTableStructureResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorWithMessage
+func (c *ClientWithResponses) DMAPIResolveShardDDLLockWithResponse(ctx context.Context, taskName string, body DMAPIResolveShardDDLLockJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIResolveShardDDLLockResponse, error) {
+	rsp, err := c.DMAPIResolveShardDDLLock(ctx, taskName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIResolveShardDDLLockResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPIDeleteTableStructureResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DMAPIApproveDDLWithBodyWithResponse request with arbitrary body returning *DMAPIApproveDDLResponse
+func (c *ClientWithResponses) DMAPIApproveDDLWithBodyWithResponse(ctx context.Context, taskName string, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIApproveDDLResponse, error) {
+	rsp, err := c.DMAPIApproveDDLWithBody(ctx, taskName, sourceName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPIApproveDDLResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIDeleteTableStructureResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) DMAPIApproveDDLWithResponse(ctx context.Context, taskName string, sourceName string, body DMAPIApproveDDLJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIApproveDDLResponse, error) {
+	rsp, err := c.DMAPIApproveDDL(ctx, taskName, sourceName, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPIApproveDDLResponse(rsp)
 }
 
-type DMAPIGetTableStructureResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetTaskTableStructureResponse
-	JSON400      *ErrorWithMessage
+// DMAPIGetBinlogOperationsWithResponse request returning *DMAPIGetBinlogOperationsResponse
+func (c *ClientWithResponses) DMAPIGetBinlogOperationsWithResponse(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetBinlogOperationsResponse, error) {
+	rsp, err := c.DMAPIGetBinlogOperations(ctx, taskName, sourceName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetBinlogOperationsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPIGetTableStructureResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DMAPICreateBinlogOperationWithBodyWithResponse request with arbitrary body returning *DMAPICreateBinlogOperationResponse
+func (c *ClientWithResponses) DMAPICreateBinlogOperationWithBodyWithResponse(ctx context.Context, taskName string, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateBinlogOperationResponse, error) {
+	rsp, err := c.DMAPICreateBinlogOperationWithBody(ctx, taskName, sourceName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPICreateBinlogOperationResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetTableStructureResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) DMAPICreateBinlogOperationWithResponse(ctx context.Context, taskName string, sourceName string, body DMAPICreateBinlogOperationJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateBinlogOperationResponse, error) {
+	rsp, err := c.DMAPICreateBinlogOperation(ctx, taskName, sourceName, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPICreateBinlogOperationResponse(rsp)
 }
 
-type DMAPIOperateTableStructureResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorWithMessage
+// DMAPIGetPausedTablesWithResponse request returning *DMAPIGetPausedTablesResponse
+func (c *ClientWithResponses) DMAPIGetPausedTablesWithResponse(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetPausedTablesResponse, error) {
+	rsp, err := c.DMAPIGetPausedTables(ctx, taskName, sourceName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetPausedTablesResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPIOperateTableStructureResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DMAPIOperatePausedTablesWithBodyWithResponse request with arbitrary body returning *DMAPIOperatePausedTablesResponse
+func (c *ClientWithResponses) DMAPIOperatePausedTablesWithBodyWithResponse(ctx context.Context, taskName string, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIOperatePausedTablesResponse, error) {
+	rsp, err := c.DMAPIOperatePausedTablesWithBody(ctx, taskName, sourceName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPIOperatePausedTablesResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIOperateTableStructureResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) DMAPIOperatePausedTablesWithResponse(ctx context.Context, taskName string, sourceName string, body DMAPIOperatePausedTablesJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIOperatePausedTablesResponse, error) {
+	rsp, err := c.DMAPIOperatePausedTables(ctx, taskName, sourceName, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPIOperatePausedTablesResponse(rsp)
 }
 
-type DMAPIGetTaskStatusResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetTaskStatusResponse
-	JSON400      *ErrorWithMessage
+// DMAPIGetSchemaListByTaskAndSourceWithResponse request returning *DMAPIGetSchemaListByTaskAndSourceResponse
+func (c *ClientWithResponses) DMAPIGetSchemaListByTaskAndSourceWithResponse(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSchemaListByTaskAndSourceResponse, error) {
+	rsp, err := c.DMAPIGetSchemaListByTaskAndSource(ctx, taskName, sourceName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetSchemaListByTaskAndSourceResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DMAPIGetTaskStatusResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DMAPIGetTableListByTaskAndSourceWithResponse request returning *DMAPIGetTableListByTaskAndSourceResponse
+func (c *ClientWithResponses) DMAPIGetTableListByTaskAndSourceWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, reqEditors ...RequestEditorFn) (*DMAPIGetTableListByTaskAndSourceResponse, error) {
+	rsp, err := c.DMAPIGetTableListByTaskAndSource(ctx, taskName, sourceName, schemaName, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDMAPIGetTableListByTaskAndSourceResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DMAPIGetTaskStatusResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DMAPIDeleteTableStructureWithResponse request returning *DMAPIDeleteTableStructureResponse
+func (c *ClientWithResponses) DMAPIDeleteTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, reqEditors ...RequestEditorFn) (*DMAPIDeleteTableStructureResponse, error) {
+	rsp, err := c.DMAPIDeleteTableStructure(ctx, taskName, sourceName, schemaName, tableName, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDMAPIDeleteTableStructureResponse(rsp)
 }
 
-// DMAPIGetClusterInfoWithResponse request returning *DMAPIGetClusterInfoResponse
-func (c *ClientWithResponses) DMAPIGetClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterInfoResponse, error) {
-	rsp, err := c.DMAPIGetClusterInfo(ctx, reqEditors...)
+// DMAPIGetTableStructureWithResponse request returning *DMAPIGetTableStructureResponse
+func (c *ClientWithResponses) DMAPIGetTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, reqEditors ...RequestEditorFn) (*DMAPIGetTableStructureResponse, error) {
+	rsp, err := c.DMAPIGetTableStructure(ctx, taskName, sourceName, schemaName, tableName, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetClusterInfoResponse(rsp)
+	return ParseDMAPIGetTableStructureResponse(rsp)
 }
 
-// DMAPIGetClusterMasterListWithResponse request returning *DMAPIGetClusterMasterListResponse
-func (c *ClientWithResponses) DMAPIGetClusterMasterListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterMasterListResponse, error) {
-	rsp, err := c.DMAPIGetClusterMasterList(ctx, reqEditors...)
+// DMAPIOperateTableStructureWithBodyWithResponse request with arbitrary body returning *DMAPIOperateTableStructureResponse
+func (c *ClientWithResponses) DMAPIOperateTableStructureWithBodyWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIOperateTableStructureResponse, error) {
+	rsp, err := c.DMAPIOperateTableStructureWithBody(ctx, taskName, sourceName, schemaName, tableName, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetClusterMasterListResponse(rsp)
+	return ParseDMAPIOperateTableStructureResponse(rsp)
 }
 
-// DMAPIOfflineMasterNodeWithResponse request returning *DMAPIOfflineMasterNodeResponse
-func (c *ClientWithResponses) DMAPIOfflineMasterNodeWithResponse(ctx context.Context, masterName string, reqEditors ...RequestEditorFn) (*DMAPIOfflineMasterNodeResponse, error) {
-	rsp, err := c.DMAPIOfflineMasterNode(ctx, masterName, reqEditors...)
+func (c *ClientWithResponses) DMAPIOperateTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, body DMAPIOperateTableStructureJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIOperateTableStructureResponse, error) {
+	rsp, err := c.DMAPIOperateTableStructure(ctx, taskName, sourceName, schemaName, tableName, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIOfflineMasterNodeResponse(rsp)
+	return ParseDMAPIOperateTableStructureResponse(rsp)
 }
 
-// DMAPIGetClusterWorkerListWithResponse request returning *DMAPIGetClusterWorkerListResponse
-func (c *ClientWithResponses) DMAPIGetClusterWorkerListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterWorkerListResponse, error) {
-	rsp, err := c.DMAPIGetClusterWorkerList(ctx, reqEditors...)
+// DMAPIGetSkipGTIDsWithResponse request returning *DMAPIGetSkipGTIDsResponse
+func (c *ClientWithResponses) DMAPIGetSkipGTIDsWithResponse(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSkipGTIDsResponse, error) {
+	rsp, err := c.DMAPIGetSkipGTIDs(ctx, taskName, sourceName, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetClusterWorkerListResponse(rsp)
+	return ParseDMAPIGetSkipGTIDsResponse(rsp)
 }
 
-// DMAPIOfflineWorkerNodeWithResponse request returning *DMAPIOfflineWorkerNodeResponse
-func (c *ClientWithResponses) DMAPIOfflineWorkerNodeWithResponse(ctx context.Context, workerName string, reqEditors ...RequestEditorFn) (*DMAPIOfflineWorkerNodeResponse, error) {
-	rsp, err := c.DMAPIOfflineWorkerNode(ctx, workerName, reqEditors...)
+// DMAPIOperateSkipGTIDsWithBodyWithResponse request with arbitrary body returning *DMAPIOperateSkipGTIDsResponse
+func (c *ClientWithResponses) DMAPIOperateSkipGTIDsWithBodyWithResponse(ctx context.Context, taskName string, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIOperateSkipGTIDsResponse, error) {
+	rsp, err := c.DMAPIOperateSkipGTIDsWithBody(ctx, taskName, sourceName, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIOfflineWorkerNodeResponse(rsp)
+	return ParseDMAPIOperateSkipGTIDsResponse(rsp)
 }
 
-// GetDocJSONWithResponse request returning *GetDocJSONResponse
-func (c *ClientWithResponses) GetDocJSONWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocJSONResponse, error) {
-	rsp, err := c.GetDocJSON(ctx, reqEditors...)
+func (c *ClientWithResponses) DMAPIOperateSkipGTIDsWithResponse(ctx context.Context, taskName string, sourceName string, body DMAPIOperateSkipGTIDsJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIOperateSkipGTIDsResponse, error) {
+	rsp, err := c.DMAPIOperateSkipGTIDs(ctx, taskName, sourceName, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDocJSONResponse(rsp)
+	return ParseDMAPIOperateSkipGTIDsResponse(rsp)
 }
 
-// GetDocHTMLWithResponse request returning *GetDocHTMLResponse
-func (c *ClientWithResponses) GetDocHTMLWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocHTMLResponse, error) {
-	rsp, err := c.GetDocHTML(ctx, reqEditors...)
+// DMAPIGetTaskStatusWithResponse request returning *DMAPIGetTaskStatusResponse
+func (c *ClientWithResponses) DMAPIGetTaskStatusWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskStatusParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskStatusResponse, error) {
+	rsp, err := c.DMAPIGetTaskStatus(ctx, taskName, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDocHTMLResponse(rsp)
+	return ParseDMAPIGetTaskStatusResponse(rsp)
 }
 
-// DMAPIGetSourceListWithResponse request returning *DMAPIGetSourceListResponse
-func (c *ClientWithResponses) DMAPIGetSourceListWithResponse(ctx context.Context, params *DMAPIGetSourceListParams, reqEditors ...RequestEditorFn) (*DMAPIGetSourceListResponse, error) {
-	rsp, err := c.DMAPIGetSourceList(ctx, params, reqEditors...)
+// ParseDMAPIGetAuditLogListResponse parses an HTTP response from a DMAPIGetAuditLogListWithResponse call
+func ParseDMAPIGetAuditLogListResponse(rsp *http.Response) (*DMAPIGetAuditLogListResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetSourceListResponse(rsp)
+
+	response := &DMAPIGetAuditLogListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetAuditLogListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
 }
 
-// DMAPICreateSourceWithBodyWithResponse request with arbitrary body returning *DMAPICreateSourceResponse
-func (c *ClientWithResponses) DMAPICreateSourceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateSourceResponse, error) {
-	rsp, err := c.DMAPICreateSourceWithBody(ctx, contentType, body, reqEditors...)
+// ParseDMAPIGetClusterInfoResponse parses an HTTP response from a DMAPIGetClusterInfoWithResponse call
+func ParseDMAPIGetClusterInfoResponse(rsp *http.Response) (*DMAPIGetClusterInfoResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPICreateSourceResponse(rsp)
+
+	response := &DMAPIGetClusterInfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetClusterInfoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) DMAPICreateSourceWithResponse(ctx context.Context, body DMAPICreateSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateSourceResponse, error) {
-	rsp, err := c.DMAPICreateSource(ctx, body, reqEditors...)
+// ParseDMAPIGetClusterMasterListResponse parses an HTTP response from a DMAPIGetClusterMasterListWithResponse call
+func ParseDMAPIGetClusterMasterListResponse(rsp *http.Response) (*DMAPIGetClusterMasterListResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPICreateSourceResponse(rsp)
+
+	response := &DMAPIGetClusterMasterListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetClusterMasterListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
 }
 
-// DMAPIDeleteSourceWithResponse request returning *DMAPIDeleteSourceResponse
-func (c *ClientWithResponses) DMAPIDeleteSourceWithResponse(ctx context.Context, sourceName string, params *DMAPIDeleteSourceParams, reqEditors ...RequestEditorFn) (*DMAPIDeleteSourceResponse, error) {
-	rsp, err := c.DMAPIDeleteSource(ctx, sourceName, params, reqEditors...)
+// ParseDMAPIOfflineMasterNodeResponse parses an HTTP response from a DMAPIOfflineMasterNodeWithResponse call
+func ParseDMAPIOfflineMasterNodeResponse(rsp *http.Response) (*DMAPIOfflineMasterNodeResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIDeleteSourceResponse(rsp)
+
+	response := &DMAPIOfflineMasterNodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
 }
 
-// DMAPIPauseRelayWithResponse request returning *DMAPIPauseRelayResponse
-func (c *ClientWithResponses) DMAPIPauseRelayWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIPauseRelayResponse, error) {
-	rsp, err := c.DMAPIPauseRelay(ctx, sourceName, reqEditors...)
+// ParseDMAPIGetRebalancePlanResponse parses an HTTP response from a DMAPIGetRebalancePlanWithResponse call
+func ParseDMAPIGetRebalancePlanResponse(rsp *http.Response) (*DMAPIGetRebalancePlanResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIPauseRelayResponse(rsp)
+
+	response := &DMAPIGetRebalancePlanResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RebalancePlan
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDMAPIRebalanceResponse parses an HTTP response from a DMAPIRebalanceWithResponse call
+func ParseDMAPIRebalanceResponse(rsp *http.Response) (*DMAPIRebalanceResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DMAPIRebalanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RebalancePlan
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
 }
 
-// DMAPIResumeRelayWithResponse request returning *DMAPIResumeRelayResponse
-func (c *ClientWithResponses) DMAPIResumeRelayWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIResumeRelayResponse, error) {
-	rsp, err := c.DMAPIResumeRelay(ctx, sourceName, reqEditors...)
+// ParseDMAPIGetClusterStatusResponse parses an HTTP response from a DMAPIGetClusterStatusWithResponse call
+func ParseDMAPIGetClusterStatusResponse(rsp *http.Response) (*DMAPIGetClusterStatusResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIResumeRelayResponse(rsp)
-}
 
-// DMAPIGetSourceSchemaListWithResponse request returning *DMAPIGetSourceSchemaListResponse
-func (c *ClientWithResponses) DMAPIGetSourceSchemaListWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSourceSchemaListResponse, error) {
-	rsp, err := c.DMAPIGetSourceSchemaList(ctx, sourceName, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DMAPIGetClusterStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDMAPIGetSourceSchemaListResponse(rsp)
-}
 
-// DMAPIGetSourceTableListWithResponse request returning *DMAPIGetSourceTableListResponse
-func (c *ClientWithResponses) DMAPIGetSourceTableListWithResponse(ctx context.Context, sourceName string, schemaName string, reqEditors ...RequestEditorFn) (*DMAPIGetSourceTableListResponse, error) {
-	rsp, err := c.DMAPIGetSourceTableList(ctx, sourceName, schemaName, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetClusterStatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseDMAPIGetSourceTableListResponse(rsp)
+
+	return response, nil
 }
 
-// DMAPIStartRelayWithBodyWithResponse request with arbitrary body returning *DMAPIStartRelayResponse
-func (c *ClientWithResponses) DMAPIStartRelayWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStartRelayResponse, error) {
-	rsp, err := c.DMAPIStartRelayWithBody(ctx, sourceName, contentType, body, reqEditors...)
+// ParseDMAPIGetWebhookListResponse parses an HTTP response from a DMAPIGetWebhookListWithResponse call
+func ParseDMAPIGetWebhookListResponse(rsp *http.Response) (*DMAPIGetWebhookListResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIStartRelayResponse(rsp)
-}
 
-func (c *ClientWithResponses) DMAPIStartRelayWithResponse(ctx context.Context, sourceName string, body DMAPIStartRelayJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStartRelayResponse, error) {
-	rsp, err := c.DMAPIStartRelay(ctx, sourceName, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DMAPIGetWebhookListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDMAPIStartRelayResponse(rsp)
-}
 
-// DMAPIGetSourceStatusWithResponse request returning *DMAPIGetSourceStatusResponse
-func (c *ClientWithResponses) DMAPIGetSourceStatusWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSourceStatusResponse, error) {
-	rsp, err := c.DMAPIGetSourceStatus(ctx, sourceName, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetWebhookListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseDMAPIGetSourceStatusResponse(rsp)
+
+	return response, nil
 }
 
-// DMAPIStopRelayWithBodyWithResponse request with arbitrary body returning *DMAPIStopRelayResponse
-func (c *ClientWithResponses) DMAPIStopRelayWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStopRelayResponse, error) {
-	rsp, err := c.DMAPIStopRelayWithBody(ctx, sourceName, contentType, body, reqEditors...)
+// ParseDMAPICreateWebhookResponse parses an HTTP response from a DMAPICreateWebhookWithResponse call
+func ParseDMAPICreateWebhookResponse(rsp *http.Response) (*DMAPICreateWebhookResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIStopRelayResponse(rsp)
-}
 
-func (c *ClientWithResponses) DMAPIStopRelayWithResponse(ctx context.Context, sourceName string, body DMAPIStopRelayJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStopRelayResponse, error) {
-	rsp, err := c.DMAPIStopRelay(ctx, sourceName, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DMAPICreateWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDMAPIStopRelayResponse(rsp)
-}
 
-// DMAPITransferSourceWithBodyWithResponse request with arbitrary body returning *DMAPITransferSourceResponse
-func (c *ClientWithResponses) DMAPITransferSourceWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPITransferSourceResponse, error) {
-	rsp, err := c.DMAPITransferSourceWithBody(ctx, sourceName, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseDMAPITransferSourceResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) DMAPITransferSourceWithResponse(ctx context.Context, sourceName string, body DMAPITransferSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPITransferSourceResponse, error) {
-	rsp, err := c.DMAPITransferSource(ctx, sourceName, body, reqEditors...)
+// ParseDMAPIDeleteWebhookResponse parses an HTTP response from a DMAPIDeleteWebhookWithResponse call
+func ParseDMAPIDeleteWebhookResponse(rsp *http.Response) (*DMAPIDeleteWebhookResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPITransferSourceResponse(rsp)
-}
 
-// DMAPIGetTaskListWithResponse request returning *DMAPIGetTaskListResponse
-func (c *ClientWithResponses) DMAPIGetTaskListWithResponse(ctx context.Context, params *DMAPIGetTaskListParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskListResponse, error) {
-	rsp, err := c.DMAPIGetTaskList(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DMAPIDeleteWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDMAPIGetTaskListResponse(rsp)
-}
 
-// DMAPIStartTaskWithBodyWithResponse request with arbitrary body returning *DMAPIStartTaskResponse
-func (c *ClientWithResponses) DMAPIStartTaskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStartTaskResponse, error) {
-	rsp, err := c.DMAPIStartTaskWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseDMAPIStartTaskResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) DMAPIStartTaskWithResponse(ctx context.Context, body DMAPIStartTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStartTaskResponse, error) {
-	rsp, err := c.DMAPIStartTask(ctx, body, reqEditors...)
+// ParseDMAPIGetClusterWorkerListResponse parses an HTTP response from a DMAPIGetClusterWorkerListWithResponse call
+func ParseDMAPIGetClusterWorkerListResponse(rsp *http.Response) (*DMAPIGetClusterWorkerListResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIStartTaskResponse(rsp)
-}
 
-// DMAPIGetTaskTemplateListWithResponse request returning *DMAPIGetTaskTemplateListResponse
-func (c *ClientWithResponses) DMAPIGetTaskTemplateListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetTaskTemplateListResponse, error) {
-	rsp, err := c.DMAPIGetTaskTemplateList(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DMAPIGetClusterWorkerListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDMAPIGetTaskTemplateListResponse(rsp)
-}
 
-// DMAPICreateTaskTemplateWithBodyWithResponse request with arbitrary body returning *DMAPICreateTaskTemplateResponse
-func (c *ClientWithResponses) DMAPICreateTaskTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateTaskTemplateResponse, error) {
-	rsp, err := c.DMAPICreateTaskTemplateWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetClusterWorkerListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseDMAPICreateTaskTemplateResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) DMAPICreateTaskTemplateWithResponse(ctx context.Context, body DMAPICreateTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateTaskTemplateResponse, error) {
-	rsp, err := c.DMAPICreateTaskTemplate(ctx, body, reqEditors...)
+// ParseDMAPIOfflineWorkerNodeResponse parses an HTTP response from a DMAPIOfflineWorkerNodeWithResponse call
+func ParseDMAPIOfflineWorkerNodeResponse(rsp *http.Response) (*DMAPIOfflineWorkerNodeResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPICreateTaskTemplateResponse(rsp)
-}
 
-// DMAPIImportTaskTemplateWithBodyWithResponse request with arbitrary body returning *DMAPIImportTaskTemplateResponse
-func (c *ClientWithResponses) DMAPIImportTaskTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIImportTaskTemplateResponse, error) {
-	rsp, err := c.DMAPIImportTaskTemplateWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DMAPIOfflineWorkerNodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDMAPIImportTaskTemplateResponse(rsp)
-}
 
-func (c *ClientWithResponses) DMAPIImportTaskTemplateWithResponse(ctx context.Context, body DMAPIImportTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIImportTaskTemplateResponse, error) {
-	rsp, err := c.DMAPIImportTaskTemplate(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseDMAPIImportTaskTemplateResponse(rsp)
+
+	return response, nil
 }
 
-// DMAPIDeleteTaskTemplateWithResponse request returning *DMAPIDeleteTaskTemplateResponse
-func (c *ClientWithResponses) DMAPIDeleteTaskTemplateWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPIDeleteTaskTemplateResponse, error) {
-	rsp, err := c.DMAPIDeleteTaskTemplate(ctx, taskName, reqEditors...)
+// ParseGetDocJSONResponse parses an HTTP response from a GetDocJSONWithResponse call
+func ParseGetDocJSONResponse(rsp *http.Response) (*GetDocJSONResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIDeleteTaskTemplateResponse(rsp)
+
+	response := &GetDocJSONResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// DMAPIGetTaskTemplateWithResponse request returning *DMAPIGetTaskTemplateResponse
-func (c *ClientWithResponses) DMAPIGetTaskTemplateWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPIGetTaskTemplateResponse, error) {
-	rsp, err := c.DMAPIGetTaskTemplate(ctx, taskName, reqEditors...)
+// ParseGetDocHTMLResponse parses an HTTP response from a GetDocHTMLWithResponse call
+func ParseGetDocHTMLResponse(rsp *http.Response) (*GetDocHTMLResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetTaskTemplateResponse(rsp)
+
+	response := &GetDocHTMLResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// DMAPUpdateTaskTemplateWithResponse request returning *DMAPUpdateTaskTemplateResponse
-func (c *ClientWithResponses) DMAPUpdateTaskTemplateWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPUpdateTaskTemplateResponse, error) {
-	rsp, err := c.DMAPUpdateTaskTemplate(ctx, taskName, reqEditors...)
+// ParseDMAPIGetSourceListResponse parses an HTTP response from a DMAPIGetSourceListWithResponse call
+func ParseDMAPIGetSourceListResponse(rsp *http.Response) (*DMAPIGetSourceListResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPUpdateTaskTemplateResponse(rsp)
-}
 
-// DMAPIDeleteTaskWithResponse request returning *DMAPIDeleteTaskResponse
-func (c *ClientWithResponses) DMAPIDeleteTaskWithResponse(ctx context.Context, taskName string, params *DMAPIDeleteTaskParams, reqEditors ...RequestEditorFn) (*DMAPIDeleteTaskResponse, error) {
-	rsp, err := c.DMAPIDeleteTask(ctx, taskName, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DMAPIGetSourceListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDMAPIDeleteTaskResponse(rsp)
-}
 
-// DMAPIPauseTaskWithBodyWithResponse request with arbitrary body returning *DMAPIPauseTaskResponse
-func (c *ClientWithResponses) DMAPIPauseTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIPauseTaskResponse, error) {
-	rsp, err := c.DMAPIPauseTaskWithBody(ctx, taskName, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetSourceListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseDMAPIPauseTaskResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) DMAPIPauseTaskWithResponse(ctx context.Context, taskName string, body DMAPIPauseTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIPauseTaskResponse, error) {
-	rsp, err := c.DMAPIPauseTask(ctx, taskName, body, reqEditors...)
+// ParseDMAPICreateSourceResponse parses an HTTP response from a DMAPICreateSourceWithResponse call
+func ParseDMAPICreateSourceResponse(rsp *http.Response) (*DMAPICreateSourceResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIPauseTaskResponse(rsp)
-}
 
-// DMAPIResumeTaskWithBodyWithResponse request with arbitrary body returning *DMAPIResumeTaskResponse
-func (c *ClientWithResponses) DMAPIResumeTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIResumeTaskResponse, error) {
-	rsp, err := c.DMAPIResumeTaskWithBody(ctx, taskName, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DMAPICreateSourceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDMAPIResumeTaskResponse(rsp)
-}
 
-func (c *ClientWithResponses) DMAPIResumeTaskWithResponse(ctx context.Context, taskName string, body DMAPIResumeTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIResumeTaskResponse, error) {
-	rsp, err := c.DMAPIResumeTask(ctx, taskName, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Source
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseDMAPIResumeTaskResponse(rsp)
+
+	return response, nil
 }
 
-// DMAPIGetSchemaListByTaskAndSourceWithResponse request returning *DMAPIGetSchemaListByTaskAndSourceResponse
-func (c *ClientWithResponses) DMAPIGetSchemaListByTaskAndSourceWithResponse(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSchemaListByTaskAndSourceResponse, error) {
-	rsp, err := c.DMAPIGetSchemaListByTaskAndSource(ctx, taskName, sourceName, reqEditors...)
+// ParseDMAPIDeleteSourceResponse parses an HTTP response from a DMAPIDeleteSourceWithResponse call
+func ParseDMAPIDeleteSourceResponse(rsp *http.Response) (*DMAPIDeleteSourceResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetSchemaListByTaskAndSourceResponse(rsp)
-}
 
-// DMAPIGetTableListByTaskAndSourceWithResponse request returning *DMAPIGetTableListByTaskAndSourceResponse
-func (c *ClientWithResponses) DMAPIGetTableListByTaskAndSourceWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, reqEditors ...RequestEditorFn) (*DMAPIGetTableListByTaskAndSourceResponse, error) {
-	rsp, err := c.DMAPIGetTableListByTaskAndSource(ctx, taskName, sourceName, schemaName, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DMAPIDeleteSourceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDMAPIGetTableListByTaskAndSourceResponse(rsp)
-}
 
-// DMAPIDeleteTableStructureWithResponse request returning *DMAPIDeleteTableStructureResponse
-func (c *ClientWithResponses) DMAPIDeleteTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, reqEditors ...RequestEditorFn) (*DMAPIDeleteTableStructureResponse, error) {
-	rsp, err := c.DMAPIDeleteTableStructure(ctx, taskName, sourceName, schemaName, tableName, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 204:
+		var dest DeleteSourceResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON204 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseDMAPIDeleteTableStructureResponse(rsp)
+
+	return response, nil
 }
 
-// DMAPIGetTableStructureWithResponse request returning *DMAPIGetTableStructureResponse
-func (c *ClientWithResponses) DMAPIGetTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, reqEditors ...RequestEditorFn) (*DMAPIGetTableStructureResponse, error) {
-	rsp, err := c.DMAPIGetTableStructure(ctx, taskName, sourceName, schemaName, tableName, reqEditors...)
+// ParseDMAPIPauseRelayResponse parses an HTTP response from a DMAPIPauseRelayWithResponse call
+func ParseDMAPIPauseRelayResponse(rsp *http.Response) (*DMAPIPauseRelayResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetTableStructureResponse(rsp)
-}
 
-// DMAPIOperateTableStructureWithBodyWithResponse request with arbitrary body returning *DMAPIOperateTableStructureResponse
-func (c *ClientWithResponses) DMAPIOperateTableStructureWithBodyWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIOperateTableStructureResponse, error) {
-	rsp, err := c.DMAPIOperateTableStructureWithBody(ctx, taskName, sourceName, schemaName, tableName, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DMAPIPauseRelayResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDMAPIOperateTableStructureResponse(rsp)
-}
 
-func (c *ClientWithResponses) DMAPIOperateTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, body DMAPIOperateTableStructureJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIOperateTableStructureResponse, error) {
-	rsp, err := c.DMAPIOperateTableStructure(ctx, taskName, sourceName, schemaName, tableName, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseDMAPIOperateTableStructureResponse(rsp)
+
+	return response, nil
 }
 
-// DMAPIGetTaskStatusWithResponse request returning *DMAPIGetTaskStatusResponse
-func (c *ClientWithResponses) DMAPIGetTaskStatusWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskStatusParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskStatusResponse, error) {
-	rsp, err := c.DMAPIGetTaskStatus(ctx, taskName, params, reqEditors...)
+// ParseDMAPIResumeRelayResponse parses an HTTP response from a DMAPIResumeRelayWithResponse call
+func ParseDMAPIResumeRelayResponse(rsp *http.Response) (*DMAPIResumeRelayResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetTaskStatusResponse(rsp)
+
+	response := &DMAPIResumeRelayResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseDMAPIGetClusterInfoResponse parses an HTTP response from a DMAPIGetClusterInfoWithResponse call
-func ParseDMAPIGetClusterInfoResponse(rsp *http.Response) (*DMAPIGetClusterInfoResponse, error) {
+// ParseDMAPIGetSourceSchemaListResponse parses an HTTP response from a DMAPIGetSourceSchemaListWithResponse call
+func ParseDMAPIGetSourceSchemaListResponse(rsp *http.Response) (*DMAPIGetSourceSchemaListResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetClusterInfoResponse{
+	response := &DMAPIGetSourceSchemaListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetClusterInfoResponse
+		var dest SchemaNameList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIGetClusterMasterListResponse parses an HTTP response from a DMAPIGetClusterMasterListWithResponse call
-func ParseDMAPIGetClusterMasterListResponse(rsp *http.Response) (*DMAPIGetClusterMasterListResponse, error) {
+// ParseDMAPIGetSourceTableListResponse parses an HTTP response from a DMAPIGetSourceTableListWithResponse call
+func ParseDMAPIGetSourceTableListResponse(rsp *http.Response) (*DMAPIGetSourceTableListResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetClusterMasterListResponse{
+	response := &DMAPIGetSourceTableListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetClusterMasterListResponse
+		var dest TableNameList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3581,15 +6157,15 @@ func ParseDMAPIGetClusterMasterListResponse(rsp *http.Response) (*DMAPIGetCluste
 	return response, nil
 }
 
-// ParseDMAPIOfflineMasterNodeResponse parses an HTTP response from a DMAPIOfflineMasterNodeWithResponse call
-func ParseDMAPIOfflineMasterNodeResponse(rsp *http.Response) (*DMAPIOfflineMasterNodeResponse, error) {
+// ParseDMAPIStartRelayResponse parses an HTTP response from a DMAPIStartRelayWithResponse call
+func ParseDMAPIStartRelayResponse(rsp *http.Response) (*DMAPIStartRelayResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIOfflineMasterNodeResponse{
+	response := &DMAPIStartRelayResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3601,27 +6177,28 @@ func ParseDMAPIOfflineMasterNodeResponse(rsp *http.Response) (*DMAPIOfflineMaste
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIGetClusterWorkerListResponse parses an HTTP response from a DMAPIGetClusterWorkerListWithResponse call
-func ParseDMAPIGetClusterWorkerListResponse(rsp *http.Response) (*DMAPIGetClusterWorkerListResponse, error) {
+// ParseDMAPIGetSourceStatusResponse parses an HTTP response from a DMAPIGetSourceStatusWithResponse call
+func ParseDMAPIGetSourceStatusResponse(rsp *http.Response) (*DMAPIGetSourceStatusResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetClusterWorkerListResponse{
+	response := &DMAPIGetSourceStatusResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetClusterWorkerListResponse
+		var dest GetSourceStatusResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3639,15 +6216,15 @@ func ParseDMAPIGetClusterWorkerListResponse(rsp *http.Response) (*DMAPIGetCluste
 	return response, nil
 }
 
-// ParseDMAPIOfflineWorkerNodeResponse parses an HTTP response from a DMAPIOfflineWorkerNodeWithResponse call
-func ParseDMAPIOfflineWorkerNodeResponse(rsp *http.Response) (*DMAPIOfflineWorkerNodeResponse, error) {
+// ParseDMAPIStopRelayResponse parses an HTTP response from a DMAPIStopRelayWithResponse call
+func ParseDMAPIStopRelayResponse(rsp *http.Response) (*DMAPIStopRelayResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIOfflineWorkerNodeResponse{
+	response := &DMAPIStopRelayResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3659,88 +6236,124 @@ func ParseDMAPIOfflineWorkerNodeResponse(rsp *http.Response) (*DMAPIOfflineWorke
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetDocJSONResponse parses an HTTP response from a GetDocJSONWithResponse call
-func ParseGetDocJSONResponse(rsp *http.Response) (*GetDocJSONResponse, error) {
+// ParseDMAPITransferSourceResponse parses an HTTP response from a DMAPITransferSourceWithResponse call
+func ParseDMAPITransferSourceResponse(rsp *http.Response) (*DMAPITransferSourceResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDocJSONResponse{
+	response := &DMAPITransferSourceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseGetDocHTMLResponse parses an HTTP response from a GetDocHTMLWithResponse call
-func ParseGetDocHTMLResponse(rsp *http.Response) (*GetDocHTMLResponse, error) {
+// ParseDMAPIGetTaskListResponse parses an HTTP response from a DMAPIGetTaskListWithResponse call
+func ParseDMAPIGetTaskListResponse(rsp *http.Response) (*DMAPIGetTaskListResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDocHTMLResponse{
+	response := &DMAPIGetTaskListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetTaskListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseDMAPIGetSourceListResponse parses an HTTP response from a DMAPIGetSourceListWithResponse call
-func ParseDMAPIGetSourceListResponse(rsp *http.Response) (*DMAPIGetSourceListResponse, error) {
+// ParseDMAPIStartTaskResponse parses an HTTP response from a DMAPIStartTaskWithResponse call
+func ParseDMAPIStartTaskResponse(rsp *http.Response) (*DMAPIStartTaskResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetSourceListResponse{
+	response := &DMAPIStartTaskResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetSourceListResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Task
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPICreateSourceResponse parses an HTTP response from a DMAPICreateSourceWithResponse call
-func ParseDMAPICreateSourceResponse(rsp *http.Response) (*DMAPICreateSourceResponse, error) {
+// ParseDMAPIGetTaskTemplateListResponse parses an HTTP response from a DMAPIGetTaskTemplateListWithResponse call
+func ParseDMAPIGetTaskTemplateListResponse(rsp *http.Response) (*DMAPIGetTaskTemplateListResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPICreateSourceResponse{
+	response := &DMAPIGetTaskTemplateListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Source
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetTaskListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
@@ -3754,26 +6367,26 @@ func ParseDMAPICreateSourceResponse(rsp *http.Response) (*DMAPICreateSourceRespo
 	return response, nil
 }
 
-// ParseDMAPIDeleteSourceResponse parses an HTTP response from a DMAPIDeleteSourceWithResponse call
-func ParseDMAPIDeleteSourceResponse(rsp *http.Response) (*DMAPIDeleteSourceResponse, error) {
+// ParseDMAPICreateTaskTemplateResponse parses an HTTP response from a DMAPICreateTaskTemplateWithResponse call
+func ParseDMAPICreateTaskTemplateResponse(rsp *http.Response) (*DMAPICreateTaskTemplateResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIDeleteSourceResponse{
+	response := &DMAPICreateTaskTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 204:
-		var dest DeleteSourceResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Task
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON204 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
@@ -3787,40 +6400,48 @@ func ParseDMAPIDeleteSourceResponse(rsp *http.Response) (*DMAPIDeleteSourceRespo
 	return response, nil
 }
 
-// ParseDMAPIPauseRelayResponse parses an HTTP response from a DMAPIPauseRelayWithResponse call
-func ParseDMAPIPauseRelayResponse(rsp *http.Response) (*DMAPIPauseRelayResponse, error) {
+// ParseDMAPIImportTaskTemplateResponse parses an HTTP response from a DMAPIImportTaskTemplateWithResponse call
+func ParseDMAPIImportTaskTemplateResponse(rsp *http.Response) (*DMAPIImportTaskTemplateResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIPauseRelayResponse{
+	response := &DMAPIImportTaskTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest TaskTemplateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIResumeRelayResponse parses an HTTP response from a DMAPIResumeRelayWithResponse call
-func ParseDMAPIResumeRelayResponse(rsp *http.Response) (*DMAPIResumeRelayResponse, error) {
+// ParseDMAPIDeleteTaskTemplateResponse parses an HTTP response from a DMAPIDeleteTaskTemplateWithResponse call
+func ParseDMAPIDeleteTaskTemplateResponse(rsp *http.Response) (*DMAPIDeleteTaskTemplateResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIResumeRelayResponse{
+	response := &DMAPIDeleteTaskTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3832,27 +6453,28 @@ func ParseDMAPIResumeRelayResponse(rsp *http.Response) (*DMAPIResumeRelayRespons
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIGetSourceSchemaListResponse parses an HTTP response from a DMAPIGetSourceSchemaListWithResponse call
-func ParseDMAPIGetSourceSchemaListResponse(rsp *http.Response) (*DMAPIGetSourceSchemaListResponse, error) {
+// ParseDMAPIGetTaskTemplateResponse parses an HTTP response from a DMAPIGetTaskTemplateWithResponse call
+func ParseDMAPIGetTaskTemplateResponse(rsp *http.Response) (*DMAPIGetTaskTemplateResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetSourceSchemaListResponse{
+	response := &DMAPIGetTaskTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SchemaNameList
+		var dest Task
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3870,22 +6492,22 @@ func ParseDMAPIGetSourceSchemaListResponse(rsp *http.Response) (*DMAPIGetSourceS
 	return response, nil
 }
 
-// ParseDMAPIGetSourceTableListResponse parses an HTTP response from a DMAPIGetSourceTableListWithResponse call
-func ParseDMAPIGetSourceTableListResponse(rsp *http.Response) (*DMAPIGetSourceTableListResponse, error) {
+// ParseDMAPUpdateTaskTemplateResponse parses an HTTP response from a DMAPUpdateTaskTemplateWithResponse call
+func ParseDMAPUpdateTaskTemplateResponse(rsp *http.Response) (*DMAPUpdateTaskTemplateResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetSourceTableListResponse{
+	response := &DMAPUpdateTaskTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TableNameList
+		var dest Task
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3903,15 +6525,15 @@ func ParseDMAPIGetSourceTableListResponse(rsp *http.Response) (*DMAPIGetSourceTa
 	return response, nil
 }
 
-// ParseDMAPIStartRelayResponse parses an HTTP response from a DMAPIStartRelayWithResponse call
-func ParseDMAPIStartRelayResponse(rsp *http.Response) (*DMAPIStartRelayResponse, error) {
+// ParseDMAPIDeleteTaskResponse parses an HTTP response from a DMAPIDeleteTaskWithResponse call
+func ParseDMAPIDeleteTaskResponse(rsp *http.Response) (*DMAPIDeleteTaskResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIStartRelayResponse{
+	response := &DMAPIDeleteTaskResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3923,32 +6545,26 @@ func ParseDMAPIStartRelayResponse(rsp *http.Response) (*DMAPIStartRelayResponse,
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIGetSourceStatusResponse parses an HTTP response from a DMAPIGetSourceStatusWithResponse call
-func ParseDMAPIGetSourceStatusResponse(rsp *http.Response) (*DMAPIGetSourceStatusResponse, error) {
+// ParseDMAPIPauseTaskResponse parses an HTTP response from a DMAPIPauseTaskWithResponse call
+func ParseDMAPIPauseTaskResponse(rsp *http.Response) (*DMAPIPauseTaskResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetSourceStatusResponse{
+	response := &DMAPIPauseTaskResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetSourceStatusResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3961,15 +6577,15 @@ func ParseDMAPIGetSourceStatusResponse(rsp *http.Response) (*DMAPIGetSourceStatu
 	return response, nil
 }
 
-// ParseDMAPIStopRelayResponse parses an HTTP response from a DMAPIStopRelayWithResponse call
-func ParseDMAPIStopRelayResponse(rsp *http.Response) (*DMAPIStopRelayResponse, error) {
+// ParseDMAPISetTaskPriorityResponse parses an HTTP response from a DMAPISetTaskPriorityWithResponse call
+func ParseDMAPISetTaskPriorityResponse(rsp *http.Response) (*DMAPISetTaskPriorityResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIStopRelayResponse{
+	response := &DMAPISetTaskPriorityResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3981,20 +6597,21 @@ func ParseDMAPIStopRelayResponse(rsp *http.Response) (*DMAPIStopRelayResponse, e
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPITransferSourceResponse parses an HTTP response from a DMAPITransferSourceWithResponse call
-func ParseDMAPITransferSourceResponse(rsp *http.Response) (*DMAPITransferSourceResponse, error) {
+// ParseDMAPISetTaskRateLimitResponse parses an HTTP response from a DMAPISetTaskRateLimitWithResponse call
+func ParseDMAPISetTaskRateLimitResponse(rsp *http.Response) (*DMAPISetTaskRateLimitResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPITransferSourceResponse{
+	response := &DMAPISetTaskRateLimitResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4006,32 +6623,26 @@ func ParseDMAPITransferSourceResponse(rsp *http.Response) (*DMAPITransferSourceR
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIGetTaskListResponse parses an HTTP response from a DMAPIGetTaskListWithResponse call
-func ParseDMAPIGetTaskListResponse(rsp *http.Response) (*DMAPIGetTaskListResponse, error) {
+// ParseDMAPIResumeTaskResponse parses an HTTP response from a DMAPIResumeTaskWithResponse call
+func ParseDMAPIResumeTaskResponse(rsp *http.Response) (*DMAPIResumeTaskResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetTaskListResponse{
+	response := &DMAPIResumeTaskResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetTaskListResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4044,27 +6655,20 @@ func ParseDMAPIGetTaskListResponse(rsp *http.Response) (*DMAPIGetTaskListRespons
 	return response, nil
 }
 
-// ParseDMAPIStartTaskResponse parses an HTTP response from a DMAPIStartTaskWithResponse call
-func ParseDMAPIStartTaskResponse(rsp *http.Response) (*DMAPIStartTaskResponse, error) {
+// ParseDMAPIUpdateTaskRulesResponse parses an HTTP response from a DMAPIUpdateTaskRulesWithResponse call
+func ParseDMAPIUpdateTaskRulesResponse(rsp *http.Response) (*DMAPIUpdateTaskRulesResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIStartTaskResponse{
+	response := &DMAPIUpdateTaskRulesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Task
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4077,22 +6681,22 @@ func ParseDMAPIStartTaskResponse(rsp *http.Response) (*DMAPIStartTaskResponse, e
 	return response, nil
 }
 
-// ParseDMAPIGetTaskTemplateListResponse parses an HTTP response from a DMAPIGetTaskTemplateListWithResponse call
-func ParseDMAPIGetTaskTemplateListResponse(rsp *http.Response) (*DMAPIGetTaskTemplateListResponse, error) {
+// ParseDMAPIGetShardDDLLockListResponse parses an HTTP response from a DMAPIGetShardDDLLockListWithResponse call
+func ParseDMAPIGetShardDDLLockListResponse(rsp *http.Response) (*DMAPIGetShardDDLLockListResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetTaskTemplateListResponse{
+	response := &DMAPIGetShardDDLLockListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetTaskListResponse
+		var dest GetShardDDLLockListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4110,26 +6714,26 @@ func ParseDMAPIGetTaskTemplateListResponse(rsp *http.Response) (*DMAPIGetTaskTem
 	return response, nil
 }
 
-// ParseDMAPICreateTaskTemplateResponse parses an HTTP response from a DMAPICreateTaskTemplateWithResponse call
-func ParseDMAPICreateTaskTemplateResponse(rsp *http.Response) (*DMAPICreateTaskTemplateResponse, error) {
+// ParseDMAPIResolveShardDDLLockResponse parses an HTTP response from a DMAPIResolveShardDDLLockWithResponse call
+func ParseDMAPIResolveShardDDLLockResponse(rsp *http.Response) (*DMAPIResolveShardDDLLockResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPICreateTaskTemplateResponse{
+	response := &DMAPIResolveShardDDLLockResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Task
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ShardDDLLock
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
@@ -4143,27 +6747,20 @@ func ParseDMAPICreateTaskTemplateResponse(rsp *http.Response) (*DMAPICreateTaskT
 	return response, nil
 }
 
-// ParseDMAPIImportTaskTemplateResponse parses an HTTP response from a DMAPIImportTaskTemplateWithResponse call
-func ParseDMAPIImportTaskTemplateResponse(rsp *http.Response) (*DMAPIImportTaskTemplateResponse, error) {
+// ParseDMAPIApproveDDLResponse parses an HTTP response from a DMAPIApproveDDLWithResponse call
+func ParseDMAPIApproveDDLResponse(rsp *http.Response) (*DMAPIApproveDDLResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIImportTaskTemplateResponse{
+	response := &DMAPIApproveDDLResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest TaskTemplateResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON202 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4176,52 +6773,53 @@ func ParseDMAPIImportTaskTemplateResponse(rsp *http.Response) (*DMAPIImportTaskT
 	return response, nil
 }
 
-// ParseDMAPIDeleteTaskTemplateResponse parses an HTTP response from a DMAPIDeleteTaskTemplateWithResponse call
-func ParseDMAPIDeleteTaskTemplateResponse(rsp *http.Response) (*DMAPIDeleteTaskTemplateResponse, error) {
+// ParseDMAPIGetBinlogOperationsResponse parses an HTTP response from a DMAPIGetBinlogOperationsWithResponse call
+func ParseDMAPIGetBinlogOperationsResponse(rsp *http.Response) (*DMAPIGetBinlogOperationsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIDeleteTaskTemplateResponse{
+	response := &DMAPIGetBinlogOperationsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetBinlogOperationsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIGetTaskTemplateResponse parses an HTTP response from a DMAPIGetTaskTemplateWithResponse call
-func ParseDMAPIGetTaskTemplateResponse(rsp *http.Response) (*DMAPIGetTaskTemplateResponse, error) {
+// ParseDMAPICreateBinlogOperationResponse parses an HTTP response from a DMAPICreateBinlogOperationWithResponse call
+func ParseDMAPICreateBinlogOperationResponse(rsp *http.Response) (*DMAPICreateBinlogOperationResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetTaskTemplateResponse{
+	response := &DMAPICreateBinlogOperationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Task
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4234,22 +6832,22 @@ func ParseDMAPIGetTaskTemplateResponse(rsp *http.Response) (*DMAPIGetTaskTemplat
 	return response, nil
 }
 
-// ParseDMAPUpdateTaskTemplateResponse parses an HTTP response from a DMAPUpdateTaskTemplateWithResponse call
-func ParseDMAPUpdateTaskTemplateResponse(rsp *http.Response) (*DMAPUpdateTaskTemplateResponse, error) {
+// ParseDMAPIGetPausedTablesResponse parses an HTTP response from a DMAPIGetPausedTablesWithResponse call
+func ParseDMAPIGetPausedTablesResponse(rsp *http.Response) (*DMAPIGetPausedTablesResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPUpdateTaskTemplateResponse{
+	response := &DMAPIGetPausedTablesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Task
+		var dest GetPausedTablesResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4267,102 +6865,119 @@ func ParseDMAPUpdateTaskTemplateResponse(rsp *http.Response) (*DMAPUpdateTaskTem
 	return response, nil
 }
 
-// ParseDMAPIDeleteTaskResponse parses an HTTP response from a DMAPIDeleteTaskWithResponse call
-func ParseDMAPIDeleteTaskResponse(rsp *http.Response) (*DMAPIDeleteTaskResponse, error) {
+// ParseDMAPIOperatePausedTablesResponse parses an HTTP response from a DMAPIOperatePausedTablesWithResponse call
+func ParseDMAPIOperatePausedTablesResponse(rsp *http.Response) (*DMAPIOperatePausedTablesResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIDeleteTaskResponse{
+	response := &DMAPIOperatePausedTablesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetPausedTablesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIPauseTaskResponse parses an HTTP response from a DMAPIPauseTaskWithResponse call
-func ParseDMAPIPauseTaskResponse(rsp *http.Response) (*DMAPIPauseTaskResponse, error) {
+// ParseDMAPIGetSchemaListByTaskAndSourceResponse parses an HTTP response from a DMAPIGetSchemaListByTaskAndSourceWithResponse call
+func ParseDMAPIGetSchemaListByTaskAndSourceResponse(rsp *http.Response) (*DMAPIGetSchemaListByTaskAndSourceResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIPauseTaskResponse{
+	response := &DMAPIGetSchemaListByTaskAndSourceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaNameList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIResumeTaskResponse parses an HTTP response from a DMAPIResumeTaskWithResponse call
-func ParseDMAPIResumeTaskResponse(rsp *http.Response) (*DMAPIResumeTaskResponse, error) {
+// ParseDMAPIGetTableListByTaskAndSourceResponse parses an HTTP response from a DMAPIGetTableListByTaskAndSourceWithResponse call
+func ParseDMAPIGetTableListByTaskAndSourceResponse(rsp *http.Response) (*DMAPIGetTableListByTaskAndSourceResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIResumeTaskResponse{
+	response := &DMAPIGetTableListByTaskAndSourceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TableNameList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIGetSchemaListByTaskAndSourceResponse parses an HTTP response from a DMAPIGetSchemaListByTaskAndSourceWithResponse call
-func ParseDMAPIGetSchemaListByTaskAndSourceResponse(rsp *http.Response) (*DMAPIGetSchemaListByTaskAndSourceResponse, error) {
+// ParseDMAPIDeleteTableStructureResponse parses an HTTP response from a DMAPIDeleteTableStructureWithResponse call
+func ParseDMAPIDeleteTableStructureResponse(rsp *http.Response) (*DMAPIDeleteTableStructureResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetSchemaListByTaskAndSourceResponse{
+	response := &DMAPIDeleteTableStructureResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SchemaNameList
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4375,22 +6990,22 @@ func ParseDMAPIGetSchemaListByTaskAndSourceResponse(rsp *http.Response) (*DMAPIG
 	return response, nil
 }
 
-// ParseDMAPIGetTableListByTaskAndSourceResponse parses an HTTP response from a DMAPIGetTableListByTaskAndSourceWithResponse call
-func ParseDMAPIGetTableListByTaskAndSourceResponse(rsp *http.Response) (*DMAPIGetTableListByTaskAndSourceResponse, error) {
+// ParseDMAPIGetTableStructureResponse parses an HTTP response from a DMAPIGetTableStructureWithResponse call
+func ParseDMAPIGetTableStructureResponse(rsp *http.Response) (*DMAPIGetTableStructureResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetTableListByTaskAndSourceResponse{
+	response := &DMAPIGetTableStructureResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TableNameList
+		var dest GetTaskTableStructureResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4408,15 +7023,15 @@ func ParseDMAPIGetTableListByTaskAndSourceResponse(rsp *http.Response) (*DMAPIGe
 	return response, nil
 }
 
-// ParseDMAPIDeleteTableStructureResponse parses an HTTP response from a DMAPIDeleteTableStructureWithResponse call
-func ParseDMAPIDeleteTableStructureResponse(rsp *http.Response) (*DMAPIDeleteTableStructureResponse, error) {
+// ParseDMAPIOperateTableStructureResponse parses an HTTP response from a DMAPIOperateTableStructureWithResponse call
+func ParseDMAPIOperateTableStructureResponse(rsp *http.Response) (*DMAPIOperateTableStructureResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIDeleteTableStructureResponse{
+	response := &DMAPIOperateTableStructureResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4428,27 +7043,28 @@ func ParseDMAPIDeleteTableStructureResponse(rsp *http.Response) (*DMAPIDeleteTab
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDMAPIGetTableStructureResponse parses an HTTP response from a DMAPIGetTableStructureWithResponse call
-func ParseDMAPIGetTableStructureResponse(rsp *http.Response) (*DMAPIGetTableStructureResponse, error) {
+// ParseDMAPIGetSkipGTIDsResponse parses an HTTP response from a DMAPIGetSkipGTIDsWithResponse call
+func ParseDMAPIGetSkipGTIDsResponse(rsp *http.Response) (*DMAPIGetSkipGTIDsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIGetTableStructureResponse{
+	response := &DMAPIGetSkipGTIDsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetTaskTableStructureResponse
+		var dest SkipGTIDsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4466,26 +7082,34 @@ func ParseDMAPIGetTableStructureResponse(rsp *http.Response) (*DMAPIGetTableStru
 	return response, nil
 }
 
-// ParseDMAPIOperateTableStructureResponse parses an HTTP response from a DMAPIOperateTableStructureWithResponse call
-func ParseDMAPIOperateTableStructureResponse(rsp *http.Response) (*DMAPIOperateTableStructureResponse, error) {
+// ParseDMAPIOperateSkipGTIDsResponse parses an HTTP response from a DMAPIOperateSkipGTIDsWithResponse call
+func ParseDMAPIOperateSkipGTIDsResponse(rsp *http.Response) (*DMAPIOperateSkipGTIDsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DMAPIOperateTableStructureResponse{
+	response := &DMAPIOperateSkipGTIDsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SkipGTIDsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON400 = &dest
+
 	}
 
 	return response, nil
@@ -20,6 +20,9 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// get the audit logs of the control-plane mutations
+	// (GET /api/v1/cluster/audit-logs)
+	DMAPIGetAuditLogList(c *gin.Context, params DMAPIGetAuditLogListParams)
 	// get cluster info such as cluster id
 	// (GET /api/v1/cluster/info)
 	DMAPIGetClusterInfo(c *gin.Context)
@@ -29,6 +32,24 @@ type ServerInterface interface {
 	// offline master node
 	// (DELETE /api/v1/cluster/masters/{master-name})
 	DMAPIOfflineMasterNode(c *gin.Context, masterName string)
+	// get the loads of worker nodes and the plan to move sources to the worker nodes in less loaded hosts
+	// (GET /api/v1/cluster/rebalance)
+	DMAPIGetRebalancePlan(c *gin.Context)
+	// move sources to the worker nodes in less loaded hosts by a new rebalancing plan
+	// (POST /api/v1/cluster/rebalance)
+	DMAPIRebalance(c *gin.Context)
+	// get the aggregated status of all tasks and worker nodes for dashboards
+	// (GET /api/v1/cluster/status)
+	DMAPIGetClusterStatus(c *gin.Context)
+	// get webhook list
+	// (GET /api/v1/cluster/webhooks)
+	DMAPIGetWebhookList(c *gin.Context)
+	// register a webhook to receive the notifications of DM events
+	// (POST /api/v1/cluster/webhooks)
+	DMAPICreateWebhook(c *gin.Context)
+	// delete a webhook
+	// (DELETE /api/v1/cluster/webhooks/{webhook-name})
+	DMAPIDeleteWebhook(c *gin.Context, webhookName string)
 	// get cluster worker node list
 	// (GET /api/v1/cluster/workers)
 	DMAPIGetClusterWorkerList(c *gin.Context)
@@ -104,9 +125,39 @@ type ServerInterface interface {
 	// pause task
 	// (POST /api/v1/tasks/{task-name}/pause)
 	DMAPIPauseTask(c *gin.Context, taskName string)
+	// change the priority of task at runtime
+	// (PUT /api/v1/tasks/{task-name}/priority)
+	DMAPISetTaskPriority(c *gin.Context, taskName string)
+	// change the rate limit of task at runtime
+	// (PUT /api/v1/tasks/{task-name}/rate-limit)
+	DMAPISetTaskRateLimit(c *gin.Context, taskName string)
 	// resume task
 	// (POST /api/v1/tasks/{task-name}/resume)
 	DMAPIResumeTask(c *gin.Context, taskName string)
+	// hot reload the table migrate rules and binlog filter rules of task at runtime
+	// (PUT /api/v1/tasks/{task-name}/rules)
+	DMAPIUpdateTaskRules(c *gin.Context, taskName string)
+	// get the optimistic shard DDL locks of task with the tracked schemas of tables, the joined schema and the conflicting columns
+	// (GET /api/v1/tasks/{task-name}/shard-ddl-locks)
+	DMAPIGetShardDDLLockList(c *gin.Context, taskName string)
+	// resolve the conflicts of an optimistic shard DDL lock by picking the schema of a table as the winning schema
+	// (POST /api/v1/tasks/{task-name}/shard-ddl-locks/resolve)
+	DMAPIResolveShardDDLLock(c *gin.Context, taskName string)
+	// approve the DDLs waiting for approval of task source
+	// (POST /api/v1/tasks/{task-name}/sources/{source-name}/approve-ddl)
+	DMAPIApproveDDL(c *gin.Context, taskName string, sourceName string)
+	// get the current error and the binlog operations of task source
+	// (GET /api/v1/tasks/{task-name}/sources/{source-name}/binlog-operations)
+	DMAPIGetBinlogOperations(c *gin.Context, taskName string, sourceName string)
+	// skip/replace/inject/revert/approve the current error event or a specific binlog position event of task source
+	// (POST /api/v1/tasks/{task-name}/sources/{source-name}/binlog-operations)
+	DMAPICreateBinlogOperation(c *gin.Context, taskName string, sourceName string)
+	// get the paused upstream tables of task source
+	// (GET /api/v1/tasks/{task-name}/sources/{source-name}/paused-tables)
+	DMAPIGetPausedTables(c *gin.Context, taskName string, sourceName string)
+	// pause or resume the upstream tables of task source, the other tables continue to be replicated
+	// (POST /api/v1/tasks/{task-name}/sources/{source-name}/paused-tables)
+	DMAPIOperatePausedTables(c *gin.Context, taskName string, sourceName string)
 	// get task source schema list
 	// (GET /api/v1/tasks/{task-name}/sources/{source-name}/schemas)
 	DMAPIGetSchemaListByTaskAndSource(c *gin.Context, taskName string, sourceName string)
@@ -122,6 +173,12 @@ type ServerInterface interface {
 	// operate task source table structure
 	// (PUT /api/v1/tasks/{task-name}/sources/{source-name}/schemas/{schema-name}/{table-name})
 	DMAPIOperateTableStructure(c *gin.Context, taskName string, sourceName string, schemaName string, tableName string)
+	// get the GTIDs of the transactions to skip of task source
+	// (GET /api/v1/tasks/{task-name}/sources/{source-name}/skip-gtids)
+	DMAPIGetSkipGTIDs(c *gin.Context, taskName string, sourceName string)
+	// add or remove the GTIDs of the transactions to skip of task source, the GTIDs are persisted with the checkpoint
+	// (POST /api/v1/tasks/{task-name}/sources/{source-name}/skip-gtids)
+	DMAPIOperateSkipGTIDs(c *gin.Context, taskName string, sourceName string)
 	// get task status
 	// (GET /api/v1/tasks/{task-name}/status)
 	DMAPIGetTaskStatus(c *gin.Context, taskName string, params DMAPIGetTaskStatusParams)
@@ -135,8 +192,79 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
+// DMAPIGetAuditLogList operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIGetAuditLogList(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DMAPIGetAuditLogListParams
+
+	// ------------- Optional query parameter "start_time" -------------
+	if paramValue := c.Query("start_time"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "start_time", c.Request.URL.Query(), &params.StartTime)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter start_time: %s", err)})
+		return
+	}
+
+	// ------------- Optional query parameter "end_time" -------------
+	if paramValue := c.Query("end_time"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "end_time", c.Request.URL.Query(), &params.EndTime)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter end_time: %s", err)})
+		return
+	}
+
+	// ------------- Optional query parameter "operation" -------------
+	if paramValue := c.Query("operation"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "operation", c.Request.URL.Query(), &params.Operation)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter operation: %s", err)})
+		return
+	}
+
+	// ------------- Optional query parameter "user" -------------
+	if paramValue := c.Query("user"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "user", c.Request.URL.Query(), &params.User)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter user: %s", err)})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := c.Query("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter limit: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIGetAuditLogList(c, params)
+}
+
 // DMAPIGetClusterInfo operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIGetClusterInfo(c *gin.Context) {
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 	}
@@ -146,6 +274,7 @@ func (siw *ServerInterfaceWrapper) DMAPIGetClusterInfo(c *gin.Context) {
 
 // DMAPIGetClusterMasterList operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIGetClusterMasterList(c *gin.Context) {
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 	}
@@ -155,6 +284,7 @@ func (siw *ServerInterfaceWrapper) DMAPIGetClusterMasterList(c *gin.Context) {
 
 // DMAPIOfflineMasterNode operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIOfflineMasterNode(c *gin.Context) {
+
 	var err error
 
 	// ------------- Path parameter "master-name" -------------
@@ -173,8 +303,80 @@ func (siw *ServerInterfaceWrapper) DMAPIOfflineMasterNode(c *gin.Context) {
 	siw.Handler.DMAPIOfflineMasterNode(c, masterName)
 }
 
+// DMAPIGetRebalancePlan operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIGetRebalancePlan(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIGetRebalancePlan(c)
+}
+
+// DMAPIRebalance operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIRebalance(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIRebalance(c)
+}
+
+// DMAPIGetClusterStatus operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIGetClusterStatus(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIGetClusterStatus(c)
+}
+
+// DMAPIGetWebhookList operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIGetWebhookList(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIGetWebhookList(c)
+}
+
+// DMAPICreateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DMAPICreateWebhook(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPICreateWebhook(c)
+}
+
+// DMAPIDeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIDeleteWebhook(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "webhook-name" -------------
+	var webhookName string
+
+	err = runtime.BindStyledParameter("simple", false, "webhook-name", c.Param("webhook-name"), &webhookName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter webhook-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIDeleteWebhook(c, webhookName)
+}
+
 // DMAPIGetClusterWorkerList operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIGetClusterWorkerList(c *gin.Context) {
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 	}
@@ -184,6 +386,7 @@ func (siw *ServerInterfaceWrapper) DMAPIGetClusterWorkerList(c *gin.Context) {
 
 // DMAPIOfflineWorkerNode operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIOfflineWorkerNode(c *gin.Context) {
+
 	var err error
 
 	// ------------- Path parameter "worker-name" -------------
@@ -204,6 +407,7 @@ func (siw *ServerInterfaceWrapper) DMAPIOfflineWorkerNode(c *gin.Context) {
 
 // GetDocJSON operation middleware
 func (siw *ServerInterfaceWrapper) GetDocJSON(c *gin.Context) {
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 	}
@@ -213,6 +417,7 @@ func (siw *ServerInterfaceWrapper) GetDocJSON(c *gin.Context) {
 
 // GetDocHTML operation middleware
 func (siw *ServerInterfaceWrapper) GetDocHTML(c *gin.Context) {
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 	}
@@ -222,6 +427,7 @@ func (siw *ServerInterfaceWrapper) GetDocHTML(c *gin.Context) {
 
 // DMAPIGetSourceList operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIGetSourceList(c *gin.Context) {
+
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
@@ -229,6 +435,7 @@ func (siw *ServerInterfaceWrapper) DMAPIGetSourceList(c *gin.Context) {
 
 	// ------------- Optional query parameter "with_status" -------------
 	if paramValue := c.Query("with_status"); paramValue != "" {
+
 	}
 
 	err = runtime.BindQueryParameter("form", true, false, "with_status", c.Request.URL.Query(), &params.WithStatus)
@@ -246,6 +453,7 @@ func (siw *ServerInterfaceWrapper) DMAPIGetSourceList(c *gin.Context) {
 
 // DMAPICreateSource operation middleware
 func (siw *ServerInterfaceWrapper) DMAPICreateSource(c *gin.Context) {
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 	}
@@ -255,6 +463,7 @@ func (siw *ServerInterfaceWrapper) DMAPICreateSource(c *gin.Context) {
 
 // DMAPIDeleteSource operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIDeleteSource(c *gin.Context) {
+
 	var err error
 
 	// ------------- Path parameter "source-name" -------------
@@ -271,6 +480,7 @@ func (siw *ServerInterfaceWrapper) DMAPIDeleteSource(c *gin.Context) {
 
 	// ------------- Optional query parameter "force" -------------
 	if paramValue := c.Query("force"); paramValue != "" {
+
 	}
 
 	err = runtime.BindQueryParameter("form", true, false, "force", c.Request.URL.Query(), &params.Force)
@@ -288,6 +498,7 @@ func (siw *ServerInterfaceWrapper) DMAPIDeleteSource(c *gin.Context) {
 
 // DMAPIPauseRelay operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIPauseRelay(c *gin.Context) {
+
 	var err error
 
 	// ------------- Path parameter "source-name" -------------
@@ -308,6 +519,7 @@ func (siw *ServerInterfaceWrapper) DMAPIPauseRelay(c *gin.Context) {
 
 // DMAPIResumeRelay operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIResumeRelay(c *gin.Context) {
+
 	var err error
 
 	// ------------- Path parameter "source-name" -------------
@@ -328,6 +540,7 @@ func (siw *ServerInterfaceWrapper) DMAPIResumeRelay(c *gin.Context) {
 
 // DMAPIGetSourceSchemaList operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIGetSourceSchemaList(c *gin.Context) {
+
 	var err error
 
 	// ------------- Path parameter "source-name" -------------
@@ -348,6 +561,7 @@ func (siw *ServerInterfaceWrapper) DMAPIGetSourceSchemaList(c *gin.Context) {
 
 // DMAPIGetSourceTableList operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIGetSourceTableList(c *gin.Context) {
+
 	var err error
 
 	// ------------- Path parameter "source-name" -------------
@@ -377,6 +591,7 @@ func (siw *ServerInterfaceWrapper) DMAPIGetSourceTableList(c *gin.Context) {
 
 // DMAPIStartRelay operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIStartRelay(c *gin.Context) {
+
 	var err error
 
 	// ------------- Path parameter "source-name" -------------
@@ -397,6 +612,7 @@ func (siw *ServerInterfaceWrapper) DMAPIStartRelay(c *gin.Context) {
 
 // DMAPIGetSourceStatus operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIGetSourceStatus(c *gin.Context) {
+
 	var err error
 
 	// ------------- Path parameter "source-name" -------------
@@ -417,6 +633,7 @@ func (siw *ServerInterfaceWrapper) DMAPIGetSourceStatus(c *gin.Context) {
 
 // DMAPIStopRelay operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIStopRelay(c *gin.Context) {
+
 	var err error
 
 	// ------------- Path parameter "source-name" -------------
@@ -437,6 +654,7 @@ func (siw *ServerInterfaceWrapper) DMAPIStopRelay(c *gin.Context) {
 
 // DMAPITransferSource operation middleware
 func (siw *ServerInterfaceWrapper) DMAPITransferSource(c *gin.Context) {
+
 	var err error
 
 	// ------------- Path parameter "source-name" -------------
@@ -457,6 +675,7 @@ func (siw *ServerInterfaceWrapper) DMAPITransferSource(c *gin.Context) {
 
 // DMAPIGetTaskList operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIGetTaskList(c *gin.Context) {
+
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
@@ -464,6 +683,7 @@ func (siw *ServerInterfaceWrapper) DMAPIGetTaskList(c *gin.Context) {
 
 	// ------------- Optional query parameter "with_status" -------------
 	if paramValue := c.Query("with_status"); paramValue != "" {
+
 	}
 
 	err = runtime.BindQueryParameter("form", true, false, "with_status", c.Request.URL.Query(), &params.WithStatus)
@@ -481,6 +701,7 @@ func (siw *ServerInterfaceWrapper) DMAPIGetTaskList(c *gin.Context) {
 
 // DMAPIStartTask operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIStartTask(c *gin.Context) {
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 	}
@@ -490,6 +711,7 @@ func (siw *ServerInterfaceWrapper) DMAPIStartTask(c *gin.Context) {
 
 // DMAPIGetTaskTemplateList operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIGetTaskTemplateList(c *gin.Context) {
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 	}
@@ -499,6 +721,7 @@ func (siw *ServerInterfaceWrapper) DMAPIGetTaskTemplateList(c *gin.Context) {
 
 // DMAPICreateTaskTemplate operation middleware
 func (siw *ServerInterfaceWrapper) DMAPICreateTaskTemplate(c *gin.Context) {
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 	}
@@ -508,6 +731,7 @@ func (siw *ServerInterfaceWrapper) DMAPICreateTaskTemplate(c *gin.Context) {
 
 // DMAPIImportTaskTemplate operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIImportTaskTemplate(c *gin.Context) {
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 	}
@@ -517,6 +741,7 @@ func (siw *ServerInterfaceWrapper) DMAPIImportTaskTemplate(c *gin.Context) {
 
 // DMAPIDeleteTaskTemplate operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIDeleteTaskTemplate(c *gin.Context) {
+
 	var err error
 
 	// ------------- Path parameter "task-name" -------------
@@ -537,6 +762,7 @@ func (siw *ServerInterfaceWrapper) DMAPIDeleteTaskTemplate(c *gin.Context) {
 
 // DMAPIGetTaskTemplate operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIGetTaskTemplate(c *gin.Context) {
+
 	var err error
 
 	// ------------- Path parameter "task-name" -------------
@@ -557,6 +783,7 @@ func (siw *ServerInterfaceWrapper) DMAPIGetTaskTemplate(c *gin.Context) {
 
 // DMAPUpdateTaskTemplate operation middleware
 func (siw *ServerInterfaceWrapper) DMAPUpdateTaskTemplate(c *gin.Context) {
+
 	var err error
 
 	// ------------- Path parameter "task-name" -------------
@@ -577,6 +804,7 @@ func (siw *ServerInterfaceWrapper) DMAPUpdateTaskTemplate(c *gin.Context) {
 
 // DMAPIDeleteTask operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIDeleteTask(c *gin.Context) {
+
 	var err error
 
 	// ------------- Path parameter "task-name" -------------
@@ -593,6 +821,7 @@ func (siw *ServerInterfaceWrapper) DMAPIDeleteTask(c *gin.Context) {
 
 	// ------------- Optional query parameter "source_name_list" -------------
 	if paramValue := c.Query("source_name_list"); paramValue != "" {
+
 	}
 
 	err = runtime.BindQueryParameter("form", true, false, "source_name_list", c.Request.URL.Query(), &params.SourceNameList)
@@ -610,6 +839,7 @@ func (siw *ServerInterfaceWrapper) DMAPIDeleteTask(c *gin.Context) {
 
 // DMAPIPauseTask operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIPauseTask(c *gin.Context) {
+
 	var err error
 
 	// ------------- Path parameter "task-name" -------------
@@ -628,8 +858,51 @@ func (siw *ServerInterfaceWrapper) DMAPIPauseTask(c *gin.Context) {
 	siw.Handler.DMAPIPauseTask(c, taskName)
 }
 
+// DMAPISetTaskPriority operation middleware
+func (siw *ServerInterfaceWrapper) DMAPISetTaskPriority(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPISetTaskPriority(c, taskName)
+}
+
+// DMAPISetTaskRateLimit operation middleware
+func (siw *ServerInterfaceWrapper) DMAPISetTaskRateLimit(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPISetTaskRateLimit(c, taskName)
+}
+
 // DMAPIResumeTask operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIResumeTask(c *gin.Context) {
+
 	var err error
 
 	// ------------- Path parameter "task-name" -------------
@@ -648,8 +921,222 @@ func (siw *ServerInterfaceWrapper) DMAPIResumeTask(c *gin.Context) {
 	siw.Handler.DMAPIResumeTask(c, taskName)
 }
 
+// DMAPIUpdateTaskRules operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIUpdateTaskRules(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIUpdateTaskRules(c, taskName)
+}
+
+// DMAPIGetShardDDLLockList operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIGetShardDDLLockList(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIGetShardDDLLockList(c, taskName)
+}
+
+// DMAPIResolveShardDDLLock operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIResolveShardDDLLock(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIResolveShardDDLLock(c, taskName)
+}
+
+// DMAPIApproveDDL operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIApproveDDL(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	// ------------- Path parameter "source-name" -------------
+	var sourceName string
+
+	err = runtime.BindStyledParameter("simple", false, "source-name", c.Param("source-name"), &sourceName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter source-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIApproveDDL(c, taskName, sourceName)
+}
+
+// DMAPIGetBinlogOperations operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIGetBinlogOperations(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	// ------------- Path parameter "source-name" -------------
+	var sourceName string
+
+	err = runtime.BindStyledParameter("simple", false, "source-name", c.Param("source-name"), &sourceName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter source-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIGetBinlogOperations(c, taskName, sourceName)
+}
+
+// DMAPICreateBinlogOperation operation middleware
+func (siw *ServerInterfaceWrapper) DMAPICreateBinlogOperation(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	// ------------- Path parameter "source-name" -------------
+	var sourceName string
+
+	err = runtime.BindStyledParameter("simple", false, "source-name", c.Param("source-name"), &sourceName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter source-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPICreateBinlogOperation(c, taskName, sourceName)
+}
+
+// DMAPIGetPausedTables operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIGetPausedTables(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	// ------------- Path parameter "source-name" -------------
+	var sourceName string
+
+	err = runtime.BindStyledParameter("simple", false, "source-name", c.Param("source-name"), &sourceName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter source-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIGetPausedTables(c, taskName, sourceName)
+}
+
+// DMAPIOperatePausedTables operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIOperatePausedTables(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	// ------------- Path parameter "source-name" -------------
+	var sourceName string
+
+	err = runtime.BindStyledParameter("simple", false, "source-name", c.Param("source-name"), &sourceName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter source-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIOperatePausedTables(c, taskName, sourceName)
+}
+
 // DMAPIGetSchemaListByTaskAndSource operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIGetSchemaListByTaskAndSource(c *gin.Context) {
+
 	var err error
 
 	// ------------- Path parameter "task-name" -------------
@@ -679,6 +1166,7 @@ func (siw *ServerInterfaceWrapper) DMAPIGetSchemaListByTaskAndSource(c *gin.Cont
 
 // DMAPIGetTableListByTaskAndSource operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIGetTableListByTaskAndSource(c *gin.Context) {
+
 	var err error
 
 	// ------------- Path parameter "task-name" -------------
@@ -717,6 +1205,7 @@ func (siw *ServerInterfaceWrapper) DMAPIGetTableListByTaskAndSource(c *gin.Conte
 
 // DMAPIDeleteTableStructure operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIDeleteTableStructure(c *gin.Context) {
+
 	var err error
 
 	// ------------- Path parameter "task-name" -------------
@@ -764,6 +1253,7 @@ func (siw *ServerInterfaceWrapper) DMAPIDeleteTableStructure(c *gin.Context) {
 
 // DMAPIGetTableStructure operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIGetTableStructure(c *gin.Context) {
+
 	var err error
 
 	// ------------- Path parameter "task-name" -------------
@@ -811,6 +1301,7 @@ func (siw *ServerInterfaceWrapper) DMAPIGetTableStructure(c *gin.Context) {
 
 // DMAPIOperateTableStructure operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIOperateTableStructure(c *gin.Context) {
+
 	var err error
 
 	// ------------- Path parameter "task-name" -------------
@@ -856,8 +1347,69 @@ func (siw *ServerInterfaceWrapper) DMAPIOperateTableStructure(c *gin.Context) {
 	siw.Handler.DMAPIOperateTableStructure(c, taskName, sourceName, schemaName, tableName)
 }
 
+// DMAPIGetSkipGTIDs operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIGetSkipGTIDs(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	// ------------- Path parameter "source-name" -------------
+	var sourceName string
+
+	err = runtime.BindStyledParameter("simple", false, "source-name", c.Param("source-name"), &sourceName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter source-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIGetSkipGTIDs(c, taskName, sourceName)
+}
+
+// DMAPIOperateSkipGTIDs operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIOperateSkipGTIDs(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	// ------------- Path parameter "source-name" -------------
+	var sourceName string
+
+	err = runtime.BindStyledParameter("simple", false, "source-name", c.Param("source-name"), &sourceName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter source-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIOperateSkipGTIDs(c, taskName, sourceName)
+}
+
 // DMAPIGetTaskStatus operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIGetTaskStatus(c *gin.Context) {
+
 	var err error
 
 	// ------------- Path parameter "task-name" -------------
@@ -874,6 +1426,7 @@ func (siw *ServerInterfaceWrapper) DMAPIGetTaskStatus(c *gin.Context) {
 
 	// ------------- Optional query parameter "source_name_list" -------------
 	if paramValue := c.Query("source_name_list"); paramValue != "" {
+
 	}
 
 	err = runtime.BindQueryParameter("form", true, false, "source_name_list", c.Request.URL.Query(), &params.SourceNameList)
@@ -907,12 +1460,26 @@ func RegisterHandlersWithOptions(router *gin.Engine, si ServerInterface, options
 		HandlerMiddlewares: options.Middlewares,
 	}
 
+	router.GET(options.BaseURL+"/api/v1/cluster/audit-logs", wrapper.DMAPIGetAuditLogList)
+
 	router.GET(options.BaseURL+"/api/v1/cluster/info", wrapper.DMAPIGetClusterInfo)
 
 	router.GET(options.BaseURL+"/api/v1/cluster/masters", wrapper.DMAPIGetClusterMasterList)
 
 	router.DELETE(options.BaseURL+"/api/v1/cluster/masters/:master-name", wrapper.DMAPIOfflineMasterNode)
 
+	router.GET(options.BaseURL+"/api/v1/cluster/rebalance", wrapper.DMAPIGetRebalancePlan)
+
+	router.POST(options.BaseURL+"/api/v1/cluster/rebalance", wrapper.DMAPIRebalance)
+
+	router.GET(options.BaseURL+"/api/v1/cluster/status", wrapper.DMAPIGetClusterStatus)
+
+	router.GET(options.BaseURL+"/api/v1/cluster/webhooks", wrapper.DMAPIGetWebhookList)
+
+	router.POST(options.BaseURL+"/api/v1/cluster/webhooks", wrapper.DMAPICreateWebhook)
+
+	router.DELETE(options.BaseURL+"/api/v1/cluster/webhooks/:webhook-name", wrapper.DMAPIDeleteWebhook)
+
 	router.GET(options.BaseURL+"/api/v1/cluster/workers", wrapper.DMAPIGetClusterWorkerList)
 
 	router.DELETE(options.BaseURL+"/api/v1/cluster/workers/:worker-name", wrapper.DMAPIOfflineWorkerNode)
@@ -963,8 +1530,28 @@ func RegisterHandlersWithOptions(router *gin.Engine, si ServerInterface, options
 
 	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/pause", wrapper.DMAPIPauseTask)
 
+	router.PUT(options.BaseURL+"/api/v1/tasks/:task-name/priority", wrapper.DMAPISetTaskPriority)
+
+	router.PUT(options.BaseURL+"/api/v1/tasks/:task-name/rate-limit", wrapper.DMAPISetTaskRateLimit)
+
 	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/resume", wrapper.DMAPIResumeTask)
 
+	router.PUT(options.BaseURL+"/api/v1/tasks/:task-name/rules", wrapper.DMAPIUpdateTaskRules)
+
+	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/shard-ddl-locks", wrapper.DMAPIGetShardDDLLockList)
+
+	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/shard-ddl-locks/resolve", wrapper.DMAPIResolveShardDDLLock)
+
+	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/sources/:source-name/approve-ddl", wrapper.DMAPIApproveDDL)
+
+	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/sources/:source-name/binlog-operations", wrapper.DMAPIGetBinlogOperations)
+
+	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/sources/:source-name/binlog-operations", wrapper.DMAPICreateBinlogOperation)
+
+	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/sources/:source-name/paused-tables", wrapper.DMAPIGetPausedTables)
+
+	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/sources/:source-name/paused-tables", wrapper.DMAPIOperatePausedTables)
+
 	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/sources/:source-name/schemas", wrapper.DMAPIGetSchemaListByTaskAndSource)
 
 	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/sources/:source-name/schemas/:schema-name", wrapper.DMAPIGetTableListByTaskAndSource)
@@ -975,6 +1562,10 @@ func RegisterHandlersWithOptions(router *gin.Engine, si ServerInterface, options
 
 	router.PUT(options.BaseURL+"/api/v1/tasks/:task-name/sources/:source-name/schemas/:schema-name/:table-name", wrapper.DMAPIOperateTableStructure)
 
+	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/sources/:source-name/skip-gtids", wrapper.DMAPIGetSkipGTIDs)
+
+	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/sources/:source-name/skip-gtids", wrapper.DMAPIOperateSkipGTIDs)
+
 	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/status", wrapper.DMAPIGetTaskStatus)
 
 	return router
@@ -983,92 +1574,163 @@ func RegisterHandlersWithOptions(router *gin.Engine, si ServerInterface, options
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+w9W3Pbtpp/Bavdh7YjWZLtOIl3zoNjuznetZ2MrU73TCerQCQk4ZgEaAC0q5Pxfz+D",
-	"C0mQBEjKt1iN+9C6Igh8+O43gN96AY0TShARvLf/rceDJYqh+vMwSrlA7AzKf8sfEkYTxARG6jEMQ/Vr",
-	"iHjAcCIwJb199SviHNA5EEsEgpQxRASI1SSA0BD1+j30J4yTCPX2e+Ptt1ujrdHWeP/d9t641++JVSJ/",
-	"54Jhsujd9Xswwjeovg4lESYIcAFFalbD3CxjryBYivJZZ5RGCBI5bYRgiBzwY27PpPZghnaYlMBYgVrs",
-	"T0/j2Nhdv8fQdYoZCnv7f+g3s83m0PU1kr/kb9PZP1Eg5FKGOL9TdvUdiTOjKQmnnKYsQNNs9+U11RCg",
-	"hwA5JCfWrYK9vmy84tfRYNS0oIAL/1LyYesiaqxrhToN9RTdaShRX4bUhSgnURmCAk0gv7pA1yniok5Y",
-	"hmJ6g6YxElAjYA7TSPT25zDiqF9ByO0SiaXkYgr0e0C+B0Io4AxyBDABIb0lXDAE4/znnou1LdCnEdaQ",
-	"/RdD895+7z+HhQoZGv0xvFTjz2GMTuXou35PQH7V9pbceg2v9pbNNC7kHaEICaTXvUA8oYSjOv7k6913",
-	"IeEp9nDnWjWNk0ulhOr8WCinMI0TkBIsev0KPHJRCXc4FXAW6d/mlMVQ9PZ7IU1nkUUPksYzxOSyiAsc",
-	"Q4GmggoYTRm97frmHBPMlyiczlYCrf3SGgtpyBy7wkTs7RZvYCLQQr5SIXvp/X4dUbWtVMF0Y8nFOseM",
-	"UfY7FsszxLlTtUiSQfk3QHJsjYzq12kgtUztXfUMBFoDVTfdN6/GfOF7MzZAtemfYqK+DY9rwx+RMAbk",
-	"hMypX1oCPWiKwzpw5hnAUo3mxE07UteauRlA7X5IAfSDKTWX/C8WKOZtMl12awqZhozBVc64Sld0YNBe",
-	"X6/evAltph9/E8b8P/EmtE59ROj1hM8DtlbOjwq40fdPDL60PY+Ic21anx7kx8V3OivmfA7oJ9K2XAqW",
-	"BiJlDW6EBnAaKIdtyq+jsst4eHF8MDkGk4MPp8fgqxh/BT99xeFXgIn4aTz+GZx/moDz305PwcFvk0/T",
-	"k/PDi+Oz4/NJ//PFydnBxT/A/x7/Q7/xMxj+MvmPP4y2ROEUkxD9+QUcnv52OTm+OD4Cvwx/BsfnH0/O",
-	"j/92Qgg9+gCOjn89+O10Ag7/fnBxeTz5Wyrm7+LZLjj8dHp6MDnO/n86w8TlAJut1f3gcOZ0yZU5dgxX",
-	"v7d7zdbr2VwWVl2kOqUwbHe5IgpDt8vV4AH5jFe/J71PibGILix2K7BgPZ8uhLaXtUEJowsZgDkfah+l",
-	"O0wVPNacIXs+a+nyVhyAu1D+Sbk/yCUheZxSCTUD5S0JCrTrhICiLWDmhRpRopQvSxGNDrLLs/7OsEBc",
-	"Ba+aTeUCKpRdouAqoZgIwOUvUICjMxBAovkACwDn0llhiAvIBCYL9ZoKJpzhznU0DSgRiDj2xq8jsKIp",
-	"uIVEWDssxZcODQC+BuNCBWRSKtVAH3wNtv2PdtyPHiD3/+0U/BUJ6pv9LQlhhnOaCBxjLnAA+BKyUKJR",
-	"8o/UquAWi6WOuQ1pKIlWIOUoBLdLRAA0vjOgQZAyLoNP35xHR6cgLvnLOWkqXG/TycW4n1PmcucZiuAK",
-	"RHQBAjltmoCERjhYgYCSOV6k2teve/l/JpgZM5ax6ajKo2qQjhUE1umOfDnbU87kmqRRJEWjklaydI/8",
-	"k91oO5evu7M3qi09WcqYXg+WjJkghmmIAxhFKy0iAOvUT4EAzIHeVtgHZnJwA6MU7QO1hKQTRwElIb8f",
-	"9AzFEJMpT2CASjsYv6nCf4YJjtMYzBlCIMT8Cqi3FAwfP9xneVfIfiH3fqgIXecMpUzUs5xwdTYgykzp",
-	"h4Uqt9SHelQ1BzVLpfXQx8nJUZaRSxOTi8nVc6FR0Hs4ngfb2wMUjN4NxmP0fjDbhsFgtL27DYPxeDQa",
-	"7eyPB2/f7b73I6aQ9hKI7tRdDuIcR6hI3TWDqbN3M0y2RvKf7e6whJiV+KO3NdQP9BJ1OoWYoUBQtpIK",
-	"hqE6Y3NBpZ5ohcDLJe1uhi3aZS7RWVXLZyhPUcGhwjHARHO4Vj4FUn+qYHXcB+P3b9//7FLjpXU9zOfi",
-	"uQcwWzNzuUHQiMtS0BKgxwcggCJYTtNkGuc1DG+CVI0FaaLtWE4dy2/yiXnOt/fnz2LfW0OeztSULgvt",
-	"zntnSNRcWZruIiVEvtzmhZeZ1clE9nZdFPYhPQPbZZ4vlaeQZ1jrcqY9CaV7VL62X8SN7YFJJVa8REHK",
-	"sFjVl1H+iylRcB6VvYC+otscoygEtziKwAyBJQ5DRLRfs0Ai9yftiUqTgDmjsRqi7PNc2sK6Wqok3xAT",
-	"UxhF9BaF04DUwT6kcUwJODea+fLyFMh38BwHUHv9ObJakcN5NA2g3+e1JtaqKhtpc5uTZ+XEcifeqX+1",
-	"ppP7+Hx8BrQaHP7fm9F783d1a+2rXqGVf9HDYj1JlYThG7m1K7TKNDGwFm9Zr+qUlnHpwEEdQKd0GH/4",
-	"I6Np4sikhFFexOhO6DlmXEwjGmgr43pFBgIoXG9aAdkCCefQlKw/YS1JoGbvF3uubSQH21rQiVSde6yr",
-	"Gv27x9crbFjHelvKkfbspBueSq2hVCXXisBlc82MdSuzpE7VqLWMKem667YuyUgg57eUhd4Z8wHlKXd2",
-	"3+w556PMD516aM2zszPac8UISRamNSUDdSxXGPfcg296yXb2JWtbNqAx8ZiNK5c+vRs1+atuRWxtp+uy",
-	"+5DMc8pd/o2BTj6sQcgoFe2qzNq74URDcrOkxVD9krD4Za/B3FtdAn5zr0cNutl8G22+9XK/yVXTay/M",
-	"aTeA0xiJpXQEbhl1eVwZ3/IcmFa+Lcj9AB5kKIlwAD28qJsbPBNPlihroDA+ZrQCusvC5N1yrVltlxiM",
-	"1+QtGxAn78hgVGGlQ85RRa4AGm/bl3N8jdI3Kkov8Uin9g1dHi01cNgMWJvOzXc06c52NGnluu+yiVId",
-	"re5JpnHSUS9Z/S5r9C50VpERhWFHSKwykNW2VeE/yK8yvVg3w9116j0i8UUuh6bIYFne1B2Taxe24/Yv",
-	"VyQotq8KXe7ty0dArWTDoJL9fZezzhCn0Q0Kp8rXpsHV1FPNajQdWd+dE3/uxjm/Pcjwbfbp5PACHQ3Z",
-	"OrlrkKaOoqBRsXpex2ZnEhOYLCRWXEvYpYvbJQ6WeWoLc5C9vFZEXssfdsz0OfR2gIiYiqRrrdOk+6cz",
-	"tMQktJJnXd7NQz1HUU0+a9xRaYR/R7q0iW6ypuUOcJluss44sORgIcPvJprrARWyQ4ZASgbZLDbpG8W6",
-	"FPO3xsU2IuxNlqje75beK5PHSYyqHLjwZAXitlD52MolzKrG/NCsoK//oC5pE9OZWleePjUxx5HEH0sj",
-	"ZLqtsXwLRp9Lo9v6cT5gckoXv6rJLuRcrkIEIktIAjTVHe/TrPNkCckCtRbMrYyEDs4ATxMZv4E51d3t",
-	"ppE+DCOQROkCky6N7qppQENSdgbDeGD6dCsZ1nqbsYJA+n5ZFdlb/Sgm9XZr+82+zRD8yh01UjINUxUl",
-	"CcdsS3or8beEJNSJynmEA4FCtRMV9aaxFEZ6g9gtw7oRQHWJfnGZeCng09jZKSrpcQtXqhxBqdQDUCBp",
-	"UqxVEsS5KZj3+r2ieu5eTJvUbqkS5SGqF6x8yX1SFa1NWypLEOMFgwLlQlQloWRWMwaoMf3ujW5KgZzp",
-	"lyuCVclYroGbiXrhCAr4AXKUtbR7SJlBbtoYMurN0yiSGyEBQzEiuikNRqrRqeBUqAZ1cpoKEFo0RYXL",
-	"q/t3UqXKQG5d7dBjrhy/QErS5cQcQJHVPSN0g6KansULQhnSls0RW8ifM582Z4qGMSXUgjCOupgFA4Np",
-	"7qu3ACVQCMRU4KftgR8Y3/ACrv8/YjRph+rOQ4Ff0ygy/C6F1xGZlKpRdA4kJ+byJbmIO84mEI65QCRw",
-	"1MyUjiKC0QhkagsT4wOpMphuLKFMasq5aoDPZwOQ85RJXi3TJhXUhQI5nbvKKs2HjLRCzOr6fmuYrT81",
-	"mro2sx4wFUuGYFju69mtmjCFMP2CxF9AiXH1nP4jjr0zj/ecU+s3Wqf2ccAJCdh6HGApIQ8DMJRE0xkU",
-	"Qbkzb1zvPLLnku7fklGC/5UvpeYA6E8UpOonKQ/XKSQCq6XcbUNJ1BF91Y3cG4d+lzP3KBodTp9/4XI4",
-	"C0tbz8dU4p9iidHOPBht7+0Mtt8FbwfjMXo7gHtvdgZ7wWj2bjd8836+M9ofD96Odse72zv90Zvdt7vh",
-	"TmANf7fzZnuwPdoJZ9u7e2G4E+6PB+O3I+dZu3LW0To7px6YTpSGNxNaxtCuM2B8msx2Q67ZZ8VKvo8H",
-	"lAFDEZQarblRUAp0bkoDQ+M2/6Kqw++0n7D2PFVNUPYDvUiu7qizs2Vxclu8asPhI0PNd/O3TmknUVD7",
-	"FKPtMvKO8VtFG6uHaoKM8xzSLh93k3beWHHuyFF2sOWJhfvgFkdhAFmYBXnlKGo2+OWBadBaDc6XHhU6",
-	"je926jvAKpywNtaPDIKytV3cVfQo+ILTxyRGSBEHhIo84s52zCtkGd8Tgx0XELMO6rENeU7UN4hwKVJq",
-	"QHiRDWjG+Cb2QKzXAnGfzoQnKvo3l/m9REdxIoXHe3S9yI+s00iTv6VdO2FWyf9oPyNQrNsOuu+81xzi",
-	"SB095lf1ZEhD24BDrs35c8fTWtNTNtQutDkVW9XipEGAOPeAu17rVX2ufh0bLqAqdcumClGDU+3vJqhv",
-	"u1jRW6s1RVkOMkUvqOlw4E2F2rb61j26H5r7He5UcCqkBEdHNHCkFI7OwKcEkYPPJ+Do06GUUxb19ntL",
-	"IRK+PxyGNOBbCSaLACZbAY2H/1oOBQ5nA6lwB9pJwpQMudb4ytecU8UeWKid1Ba4QYzrtd9s7WyNVOI0",
-	"QQQmuLff25HaVqkJsVTQDmGChzfjoTm6OMymNxY4P1B/Eqq1Dj6flA+lqxKGFkc13/ZoZHISWUMnTHQy",
-	"S27jn1z3MhaWuUmHeo6/K6xXdKnmfkU9nsYxZKvevtwDyI+/kzkFPA2WAHJQOhMv4IJbR917X+QkVbTo",
-	"KgjvipniNPzz4Mdx+r4JS/3e7iOCUbuWwbG0VkUN9LEut8nUzDqEGX7TfyhX906LYYS0DXNQ6tN8HmGC",
-	"NNrOdVo2gQzGSFP5j1qe2AIvCzbk71KOell9o2fB0LPViK7PFNjscvHQlxrj7Dp8iBdGUarxWrmqqBMh",
-	"M/XeUcKKqxqeR8IcV0NsmIRZVyytJWGGMMNvxmauJWHG1neQMBs8v4RZMPzYEla+MKuRkGG8lQHnlKyP",
-	"SBzR4H8uP517RKkMlpwrP1ZSZ7eQBkAtV0AV0qACkXGVGsD5++TstBM4cmALOEuhK0k+cHQk1q56igtW",
-	"2phZrmziO3VOLW9dVix9nSK2sngai+U0H+HgYXd538G/j6r4HNfJOJjUPkoVZY2PFRJUhxSkyBIUKjjn",
-	"PtTr+9Yusx5mExx8oOHq0fabXXVT36BZDczkcnc1lI+fAYSXpoP0xR+AoFubti6y1oVs+M3KSbabEfu2",
-	"uFahi+hMHaFPCb5Oy6f8/BalnCLtZFG8Z0bu+rUkNdUnF2ii8yIw4qb/OOuvVtGtKeu5tIOa4YF6YffR",
-	"eMZ5e98GsKxmMgAfyrDDBKZcFwOU8mnQWp/lyIvsZoIXzrhfupjal0ZURQvrkMI8JbrHP2uaeyixGeJp",
-	"3I3aF2roK7mfkNyaGk9Jb+tW5Q6OoD4V38UdfALi+k/XPalfWLkJYENC4OwAoa77+XzQruwx/Kb/KFyY",
-	"DsyiyuUvj1f6DbVRz/LF3jsu7yydPimXlhvTN4tJden4/jwqIBOdLFZxUnNTDNYThH2106p35QKQBPZu",
-	"E42lOUbwlMYyP8bVxVbmZ7dfDqM19qU9S3Klcgnrhigq+5sE9ocdHoOlaNJRd5nTvj+y6qoceP6raK4Q",
-	"86dWXYJBwufmgxx+LpuYYRuTfnoiVqs3bPxVeC1jhNz5ogDqay11faWFu3Ters0CZjeEdykaqCThxpYM",
-	"anehO+igdhiZj428HJuWQ1VQXH/CpLk0oRzIiT6C+hSiV//UzPcsUZjvvmxKgQLqzwwxkV8gXaZsVZKH",
-	"WbNiN5nO2hGfoQlhwwUr7wa9h4QVEjApWkmfQtR8zP0qXW7pKlF2HeEa6iN0Lc7XiRr0THSvNkWvzwbb",
-	"TwTP5oSG5mDk/dnimzqZt05duMIda7nn9uUDDr88h6WjV+47VbiZXUamXOpv5a8q8M7GcnPINPrhFHvd",
-	"XjeRPEk9JNefongl+mYQPVXU6kz3mv6+n9Z+qRzR73LXqSMgr3130173QZejbrQB0RGYaX5aj5l0p02X",
-	"HpuXzE9fnrJd0a5w3m1uA889eEO3gnRqyXnljk3lDtPvcw/2eGB3T97X82ElueeAhPerALwEo/Xab/S9",
-	"nOjGpqMHc/GaTUh5+9ErS7+2RW2sLDl7ox5ZlOR7switGdHYH9t8lakXJlN9//UsPpRnHNAZ556P6G58",
-	"9q8kedxi8XVTgK8S8ioh36HRruFr4RtrABvF0JuWPcm/Dv0qimsv/qMI4uMnI1q/Sf5X6SUrPqC+hrw2",
-	"e63dOqytW7x/pKz6WhmwZ7AyG9rMrbg1454qd6oL79hNxk3lu6tWNN0KaQwxUTdX9SSSzQTerzY2X5YV",
-	"0uCBN2QNr1McXA30KRjdqDIwi99V2KrnUrbmE0DPAqQBL386UMvflcTPAWR2x0c+Lvvh7svdvwMAAP//",
-	"cJ+7WyeSAAA=",
+	"H4sIAAAAAAAC/+x9a3PbOLbgX8Fqt2qmp6j4maTbW/eDY7l7fNdOsrZ7e25NZRWIhCSMSYIBQLs9Kf/3",
+	"W3iRIAmQkGzHVuL+0OWIeBycFw7OOTj4OopJVpAc5ZyNDr6OWLxEGZR/HhYFJddoMjk9R19KxLj4MUEs",
+	"prjgmOSjgxFVHwAnAKrWgC8RmExOGbiBmON8AeaE6o8wHUWjgpICUY6RnGOG85QspgVh3cHVN1AQhsUv",
+	"gMyrwaN6Gv1rXFKKcg4QpWI+igxACcBzgPlfGEBZwW9H0Qj9CbMiRaODUXbLvqTjGc5fbYv/dg72dt9u",
+	"j6IRvy3EZ8Ypzheju7vqFzL7F4r56C4aHZYJ5qdkIcBuLgkWuLsWAeLhxxMJqkFaDDME5pRkAqa8zEYH",
+	"/xwtaBGPohEpUC7G+dSBJRrFKUY5d08Bk4QiViNFNgU3SxwvARN/WvOPHGNL7LmHVojNEGNwgQROxY9Z",
+	"yaGkzRziFCWuIQVqoBrHNezi/OMRyBBfkgQQKgf9UKBc4IqSkiNAmjO5pigghRlzjy+/IY5ohRSDfpyD",
+	"/7z48D4CN5gvgWrL2A2hCQNLnCTIORdFrEwd2L9ZIr5EtIkVVsYxQomNmBkhKYK5GIrjDLmBFl/AzRLl",
+	"zeEwA0uYJwrRc0IzyEcHowRyNJZjOcAtGfLQEyco55jfGrSIluBmSQx+UDKAd4mMLyWmKBGcqyHQ3BlJ",
+	"MbCpX5GpwuEnh1i9kzL/wc8zWik0xu3RKCvLugC52ZFd4cLVjn1J5RSYI8V8nRb6B0gpvF2F4NXiBMUZ",
+	"4oHUbhGECKgtbOj5A7Aeou4VI4JaM6BroV+E7gWsQDGe4xi0Nbhs8xB7gBwocih+BUUtKPdX/oYhmnDV",
+	"JJLNawWuuYWiIoWx+IBziWXx0zWiSjLkxjT61MNUXRa5+L+nTAhpAma3QA8PYJ4ANUHNNILSFU/Wyz08",
+	"vTw+B5eH706PwedktvP51Wc+2/kMDicTcPTh9Pez9+BzvPsZnLy/dCGhyctdVnOx1VFaMo7oGRT/d2yU",
+	"SeLQTe0tTJM3k4OAnCSoQcWd3bevtl9tv9o5+Hn3zY4Lcpjia4fYkTzFOQKMQ17q2TDT09gzcFoil/ZO",
+	"EUxcuhUzeyS5Bt00YNAcKg1hcakcZmdQ2mVPs9gKukghuYc4F3L9F2WWQXrbpZGUqmlMSp/FkZfZDFGB",
+	"P9lUYhKmKWDljEN2xWrAcc7RAlExdwb/nDIUkzxh0xla4jyZZhWTVKoO5/zNvrM/mc8F8aY3hF4hC7xu",
+	"ywIKkZlqaPpa0jLPcb4IaRrQZOj7EOTtzbUerj29D3LP2qMGSftI0QLSg/Ue1vpDtnsyuZ+RMk+mjJQ0",
+	"RlMjWK3dRTQBqgkQTSo9oNbYnVZtGtt9E3K48E8lPg5OItu6ZuiqBzVEuHoQqG9C6kKUk6gUQY4uIbuy",
+	"zIMmYSnKyDWaZohDhYA5lLbyHKYMRT6DmQDVT5wCIEgghzPIkDDPE3KTM04RzKqfnYa0Bfo0xQqy/0XR",
+	"fHQw+p9b9fF2S59tty5k+/cwQ6eitRbXoV5i6R282kvWw/iR9weaLQnx408aL57tX30T2MoJx/PbSGpZ",
+	"/SukSP2MHRZPZQv0LU6DdizGc5mubvmR54Ycfylt6UHgRg3W4Gn9m1NwGIop8uwv6ptYN8MLZR6rlcbK",
+	"2BHW0N/PDo/GF38/3H39BmDV5h/jydn4Ai9yyEuKwFJuh5Gju0adHN2BvO6BiqZuQH8/PxVQFoRxxzSc",
+	"NLCx5LxgB1tb+pdXMcm2kmysGSBQmAUoLmaboBRxpJj8HLGC5Ax1mU3uCcEiI5i/FhiXQ2RSZoUyJrr4",
+	"qY2spMwKwTLdU4CYVMCdTDmcpYg1TIGElLPUEn5ldYhpEeM4gxxNOeEwnVJyE9pzjnPMliiZzm45WrnT",
+	"ChMpyByr8hk47c3f7h91EdVZShtMN5ZcrHNMKaF/YL48U36evoOP8hVFHoMxcfSV30CstruuTaS6Zmzh",
+	"66mdT4PyUQ8U2fC4Fvwb4saTJ1jbLy5iA2qc9vukxQzpdAIICoSYfLJdpCb2gN46tzM/+GucsR0H60jp",
+	"Re39o+gvDOSkYoQ1jtZLzDiht12Y6tMsYEi6FdTxGSWAohjlPL2N1GlYuJ4FupueExa68bVQ6KKYNWgf",
+	"nMbfzQmYSe9zilHykGBQvMD5lH1xbEDqm/AR9FAvwGNkYc+QxsN62sY/yefEz3WxajTFSRdk/Q3ghjOz",
+	"DNSJ1sj9ACrnwwPKdmPcxxbwxiHdvwBWH98DQG8e+bX1y4IRIEyBM8Qpjplr+epEEj6cOiV6B2whzazU",
+	"AF3P149BNcvDs4Ea97HZ4KM8yV/K7f6B4LeGfGzoL5aQJpPJ6SmJrx6QAvawj74EaUw/JPBywG8D9pD2",
+	"WANwNeRjgy80zQPiXB3gHx/kh8V3OavH/BbQS5VwwWkZ85L2nB8VgNNYejaMVVKbgEfnx4eXxybewHc+",
+	"g79+xslngHP+152dn8D7D5fg/e+np+Dw98sP05P3R+fHZ8fvL6OP5ydnh+f/Bf7P8X+pHj+Brb9d/o9/",
+	"6g0fJVOcJ+jPT+Do9PeLy+Pz4wn429ZP4Pj9byfvj//jJM/J5B2YHP96+PvpJTj6++H5xfHlf5R8/nM2",
+	"2xdxjtPDy2Pz7+kMO2O8emldb1syc/ov5DnM0Vz+Puybs7qbsSysekilHTYPKB56xEflsVMCk2EnQUpg",
+	"4nYSzGB98u6LRYg2gCIxrQqYWa5EETO7oZhzlAtbXXScwWRMyQ2YY32eHg5BzAjnKcpRfOWGhaXkRsCS",
+	"S68YRTARZwOBGDVLBYY+MtQAWoesMr/KyU1uhRfFQMLooZi7A4jSAzAtENVefVcQN0Y5B6xAKBGw4awg",
+	"VKGJh67e+BOSqfI0TN2h7aoZUM1UnHt2q/Mwajgi4bc7//Vob2/vF6Dndyyux2Xjh7XqFC/L/MrBOjXb",
+	"mKYWncLwkSEOp+oc7cwEsL5PFxwnzkYFJQuKmDuXgKIMYhXmkXRlfdiuGgPd2I3z7SaPBaxTyNV6zKUd",
+	"UQFz1I6yILUlVIrcsz4a9PkU2Epco3oMs8xqnNLSlx2/nQ2nxRBNBnOwk0/XNhHTWYaZQCwEmjiYxL+Q",
+	"R78afig5DOilyOwUCQmo+8u9yb0qJTWYBqjualtTRP20d9FTOYeQy0bzpOvAmMsMFaL9Yoa2dQJgi6xp",
+	"yZaNyJ3KU2iO+gfFHDGpTtSqzS4aL1F8VRAs5F/8AjmYnIEYak7CHMA5RxRQxDikauNbCpjYlTus9yWd",
+	"xiTnzmxH9iUFt6QENzDn1gpHUb8NCj7HO7URauxEYYhGKv3F92nP/ekeluf/dpqet3ncXezvRQINzknB",
+	"cYYZxzFg4gQs0Cj0gVREOpURM0Makqe3yk8qs8ugdtsDEsclZSZa5hpzMjkFWcNVX5GmzfsWnVyMa7sa",
+	"ujwKVJoCKAttnxkJavJmSuL+jEDTQGbU6pRXvjQsj5mep+nCrr+KGKCGBHIAAacwZ1qAZIAc0ltwi3iD",
+	"wYzL/AD8te3yjoDwef8UAaGfxwzxA+Cid0FSHEvHXSuBbFbO54g6TTyKWJmJv3uTUKuVyebCvCu5XOXQ",
+	"8hyC+FDnoVk6fBqyJ4uaZyONrKhmBgsZA5z33hnAhnmL72QIV/RSIQdWqoldJ98nRsfAellIEmdrpSoP",
+	"uIEPnWlvhTkgrYIbgpXE1xz9yd0cpYMRQqtJS0KeeozSF9pHqhupZ4wQsrJQJmMH7yvlYcqlGf5wH5Vq",
+	"2WuOuSQ3ej+D+QJVGUlaOyi0SDToHNMIfBZC+xkklBRKSU/O6psJBo+flUh/BkuSCoscxld1W4GXyUT/",
+	"QVGR4tho/Exvm/VYcnIt1q+A3qwF/swU3XzUHnWyor3dlqmA3NBqDifPltQVZ6YohbdAKPZYqKGyAIpc",
+	"ICb5HC9KT/Y3+rPAFLGGEbPdtmBkI801ONMHdD2dy4rPyzRVu1cjb9MyH8Wf9BqmjXn33mx3pr5cImAa",
+	"SwlEFJMExzBNb5UBZXamGgGYAbWsJKqIfQ3TEh0AOYWUI30oXAt6dVycsgLGqLGCnddt+M9wjrMyA3OK",
+	"EEgwuwKyl4Tht3frTO/KJTlHM5jCPEZn5Nrh1hI7vM5CDMyGE3sYoQ42k5ap/AYyeVmougyiTkOjKCTD",
+	"o5VkGJYuyEnPGnaHNwZrzqiBEntos/BPfVj+mMK8i2WBj3C10CTZg8UFxSF2UMWYoSMNs3uxKbw9krrD",
+	"7bBTeqXSBV3Nksu9V308+Oowk+Sntpen4+NUB5/fLk8m1c0bs+NW5/uaG9AvcGce7+6OUbz983hnB/0y",
+	"nu3CeLy9u78L452d7e3tvYOd8duf93/xy5ptOlogunP6KhCFU8PO6usDs2X97obDkmDaUDmjV1vqg5qi",
+	"S6cEUxRzQm/FiYairq5knFCUDENw5+OSYQe1vVu0hEamBViuwOYQLRxKHAOcKxWj9rMaqY4zxc4vb3/5",
+	"yaVMGvN6mM/Fc/dgtn7mcoOgEGdyUwRADw9ADHm8nJaFdaXAm3ks24KyUGq/oo7lePOJeYIdI6/Gn/W6",
+	"X22xciaHdO1c7oRyg0TFlY3hztWFgMEtpMmsTiayl+uisA/pBmy3KmYkvUZ2/D7ksEJVN2CUdYpjXhnZ",
+	"6hwhfBYpia/UoYVTGF+hRLtCqpspDRtaXqNKjHvcOE10GjOWaPQ7JK50YpMd8WNX453x52Qmr1elnwPC",
+	"iy0lo0BopFO34Bg8cPbeeejcduibQDUeBxxrW4IgvvnniADmVnxOr1n4aCiaI0pVIjbMmxf2gs7LhixR",
+	"y0Ba6Rx9IRtX+c69RJLZ084bdx7qtA2jCxSXFHPHQVS69DS9GEubRx/F43OMUmG0pimYIX1tWbn6FohX",
+	"LlZ7oMYgylnGzaFkrq4stjfOVlIfonwKUxHfTKaxwyF3RLKM5OC9pv7FxSkQfVQ2PGpkZg4ih7F0GkO/",
+	"G9gaWG2mpqXNOE4ZEQOLlXiH/tUaTqzj4/GZDk1u/eP1tglTtpc2POsVuvVPelTPJ30OFF+LpV2hW2Mr",
+	"AGvygfnaJ4YmLh046ALolA7EzyFHpzjDPERxKz+K2gjFYlLRUa4QMq2q5U9M+pVuTThBHs2EO6TyHtXa",
+	"vr5c2MoPGIx+Z/BPFeiuvSwJKBDVJ2gRFM0QzBkocwlUc6fe2d7/+fXbN9sPEywVsOhMhXVA2d4Og+O+",
+	"16NabNReliPnwMM1InT1kWIitN2KjFPobk22USuzPG3siilTbokXwsTT3bDe79UlPM1f0oWhj45gjinj",
+	"UeWeNAymBlOpHK2x+JLKFJBE9a1v75sh5YQl654kzVpsr7+AdxSNckHNVHq4b0afLGqbBv0SXo3sJIC2",
+	"t4607XRE0jJzqO9Y/i5KQTAkvapVmKjlGhahvb9wse/8i+Dc4bBVIzncIrFzY9TN5ZQrZ4E2V3Upxh5y",
+	"G9jgtWYPx5+cqWv/3BaV5WNsVbEZa9xiGYkbiHhZALXsCsiRr97HqrEJr+cq3PJbNaARZpY1CNJLD5n/",
+	"6wgMNEOa1dnAgWdFn6ma8L6s57JilHxM61wGpzmpWtUmWe/5pS/QPUtNtuXJ+8tGtmUkw9+Xx/+4dHoR",
+	"1j/TrBhEsGnnyUH32vVNZFZTR11CDnGNLyTdCQo6D5l+Rhqgsx0XaiQpKqsnvgIzFKvI3Lw+6UbqYiqc",
+	"yTpOeC5uXpmP92GGyeHl8eXJ2fFPG6JOopGWi5XQbGTJwvIjSNC6qq61Ii/f4nzxGyVl4cj0TdLKtgs/",
+	"XUnLZWqnVjgTUlCy2rAc0gXizqZlvvqAnXRpOXpUr7mzkApsa0InUq9wIZyQQcFymCQqVJ6ZMneya8Vn",
+	"dfxbXtPXgdcmmYTPbMpcd93FWOqqY3e4SKXxnN2K+32yIWaNOHnNx3txHO+/fT0b7+7t7wkP6tvxDO3u",
+	"jN/E27Of95PXv8z3tg9ej38+2Nm9d6kjmCj/X+YuZuSKA1frH6CGL6F9PfwZcli5PzmxW0h8XuGieEhs",
+	"ttbfv3QVbPQ463yxqNrHHlhoQ+wpElEi8lwKn5F05TJlc7hiAnrErhd8SRj3wWvKELoLtrgr+KnKe94R",
+	"qwbNIff2X79xjkeoHzr50Rpnb2/7jevcXJjMhN50CNmoDj5UEcb+YGkdjFT1LyoPYK/VZNqt4OMNrl6j",
+	"4gjdTeQ+l8Hc1Qc1dOJjB0JKCF9xX5WcqEmup7QYKmoIi1/2epy9NTJ7nL29Bk7b42ujzTdfFddx1VcY",
+	"LpKg/BGMZIgvxcnzhhJXRMjwLauAGeTbmtz34EHt8fLwoq4w5R74svKxmEBietv07FRas51VMV71eGoD",
+	"4uQdDimXWAlIwpaRdQB1NNCXhP2SRbBRWQQNHglyr6rEFq97tTOcm+9IEc52pBjkuidZhLraaq7++2qW",
+	"CA6aLmAxdOfPSqlgraxTzICqbdfOfQi56FdVTvEDoQIKIXPqU7y052OYxmUqvf7GeXwrvcba3cqE2Fir",
+	"CoN3rYqNls971dI83trQdmXNavTaZzxHPF66S0Z7S0N2ZzaRE8HrKVxYqZCd3OPRqsGS8FS+KkUjIAdD",
+	"HEkxbza+uM1jV8trmOIEckKHxPH/mYZic2aOLXT1ioH+jdCsV6/ER6+oI8AdYWpya4+OqG2lltujzIpA",
+	"28WqT7ZCralgM0pEKwMhsS5B+yvrCVnR2F47v2O9bKIFsuNpTeu89PG0kLLA5Qtmr5dvhMGxfPGp8jlU",
+	"MDCPrJS5ThBKptIxVLuyVzMv1UcP/tyF+IJFxcnhNTp6Mg6VFiuxt3q2CRN1FjsTmBD3hpPEVVq6cd9L",
+	"3ZoyvCB2MN15pZyNJEsrcLoae3J2KolaVe9Cf6K45PIDa1b9jAQ7iosk0vE2K9OrwdRHJ4Du5EjeNSVj",
+	"lPMpL4Lvpt6jhHHtBnXctxLfelfUaOFfkbpSWpcVDb4IG44DS+wWwjXdx2KqQYvLIEWgzMdmlNCCaU1/",
+	"+KDP2EaEvcgG1aOwjMgmeZzEaIudC0+Wk9qWYR9buXRHdQvnPmlqviolXcG+1FVyu7rap5XmOBX4o6WK",
+	"s8EkkfclYfqx0Xqoas87nJ+Sxa9ysPOyES2skYHyJcxjNFWF3aemPo3MIRm81Gw5SZW/yPjX5fM18gqc",
+	"HBYkSQqKtFzgPKSeu7yob8epNAijJBvrmsFNOBwljyUEjBNqbvp6E8brQb2Vo/1WRjvg6xqF5NOk1KlK",
+	"nntz1csQJjapq2lYcQNyjagqYKLtMPdLCELAp5mzkKhMs4EyUywmROgByOVzQNYsBWJMZwCMIisdwD2Z",
+	"2sHDvLfSIJUdLBfuOt7TwdJO0nGZ4QWFHFVC5Mq01W2AbBOFl8OSCuRMdW4JViuatwJuLmWHCeTwHWSo",
+	"qobvJqWBPNNF0DX15mWaioXkMUUZylVZIZimzaQoKBsF2Wg1CAOaosXl7fU7qdJmILeudugxV9IpR1LS",
+	"xcAMQG48ACm6Rt33s/AiJxSpna07mvzZmNAVU/S0aaAWJFkasi1oGJyFSUWZhgJyjqj0Ran9wA+Mr3kN",
+	"1/+fUFIEPVDipMCvZZpqfhfC68p+s9OjRU0gESczXOrOOo1JzjDjKHfdJpY6KueUpMCoLZxrG0jmZavr",
+	"napKzlzWR65GA5CxkgpebdKm5MSFAjGc+2KK2D7EwS7BtKvvX22Z+adaU3dGVg2mfEkRTJq3a/fbW5hE",
+	"mOqgE1i0qee0H3HmHXnnjXNonAUN7eOAkzymq3GApYQ8DEBRkU5n4gpMcwHd+7/2WML8W1KS439XU8kx",
+	"9IlI/CTk4UsJcy7zTkfuy7tFGoi+9kLWxqHXZdtyPrpffnH4dUNKbN3zzRhXeT7meY/ApJevuJP3VMVd",
+	"25Pn3s36Xm1xobj96ku1Qt9O5T9UVDZj75HCZ0G6jhS1LeWNAZgTbj3F9t483t59szfe/Tl+q1Iz4JvX",
+	"e83UjJ3x2+39nf3dvWj79f7b/WQvtpr/vPd6d7y7vZfMdvffJMlecrAz3nGXKW+Fumoo1Ad9PbOnZ/s1",
+	"uv0A1/NDhVN7/Lo+6jesWw8oY4pU7KK/IINQ2ZWxFGsaD1mQ7V36TlmCK4/T1vVNS9+L5PaKgs1pi5MH",
+	"C1lbcPjI0LHO/feJq+I11gHSPhSwwBN6a7+VH+UAhvMc0i4+h0k7601zCuSogbRPAad40zNNYuE41Mf4",
+	"1rXJ8d/u6VfvJH74/O3cnWNcX5AcgJU7YQ1INDUnFSd31RmaPvfDQxIjIahR26daMQu5zRqAwcAJ+Cy0",
+	"3lIP8pyo7xHhxlm4B+G1v6cf45uYeLda3t066XCPlGnWn1vmJTrKCiE83oe+ag/YKtmbVS9lvHM9S/XH",
+	"cKW+et5h0H0puOql56n0p3TcXT25ag651g9QOb66nj80FKwGdSq29o5TxjFizAPuaonn3bGiLjZcQKny",
+	"jfLpvDKwMtuScECRvAIr0xxEv+bdR/UTh1cIoPkcxby+ONSpx9ZOhWD3qsP2BL79h3jh7+l9qc667w2Q",
+	"XNzTOhG602DIjcy3kR7uKvWlPnRGbiHuPGLmPz4XKBfhtlW66OlXmaeFodYILSiixjJcqDMl7d0BBPUx",
+	"AtYzg9h6+n39txmf+NHFrl0r3zbsLxfafRVR93KFte7zCGLw04YV3JFBfg+Jj90O8Io6AkuTswaJTKhB",
+	"OitUcccxycfmNTOpEcdJko71w16m1s1YlL0bwxTSrMr2GOu3cZ3BJauSWfeFrKKclu6X9o4+/g7kp4rC",
+	"chxQUBIjJlMlCkRj5bkLKFLnNhzFr60JcpKgqP0Da2Qlyl4CQyZzVt/EDzc8M5QReutbu0qoJHOgmgEy",
+	"47B5OfbDhbnL10RL0GNiddowuxoEoZkarNJLuwgLzC4crELoIoUKFmCVYQqvEbWYAs7EbTS5BgZU+QD8",
+	"7+qiI6Ygg3/Kio0wI/miPTYLYx1p3nwp/G8Hag2oaYDqgkq1xVEXtgibs5RGk+dhCTG2+FJXXZAolNWO",
+	"ldViEm0zzLkqJ9RaOlhCJlJRTQdZkkiOYh4YbMAJORr7Lt3fO9eymTKmDx21amhJi4N7LRL1VXxsvrXm",
+	"fZjb88r2bvAr22FZsw+i+9ancxgbPrGeWoGnnk6pdbOfvS+Yd57O78tP140Z0C/cA5I7IBywIZsvn/vf",
+	"PG/D5pcff2zEyh3tiY747yJ1TcB6xqFTIwPGY8cJqCuieq95DGW+rnF3qv+21J2MI3NEc5hOSOzYTCZn",
+	"4EOB8sOPJ2Dy4UibgfVj2QmJ2asC54sYFvLF7H8vtzhOZmPhORurcxQm+RbjpqKESJQS03DMU+Sa4BpR",
+	"puZ+/Wrv1bZ+7TWHBRa3f4Xqk/4evpTQbsECb13vbOm3yLbku7NjcYFWfNUO1eq69EkiZzz8eNJ6ZFgO",
+	"SWGGOKJsdPDPjrdMXA6Re7voJARU0DYmNFEl1wm1K4KLXVDVMuQlVeYyFqN8KZF8J1PRWl3ImuodrPba",
+	"hmxwd9FKEM7QnGi7MBQ4lCePCZpWbBVlQkCqGjdgsq5sCHxeNu7LrAVUyRANgUf7Hr3P4fRMKrQC4/bc",
+	"ZWGuSmplawNQ1x3LiSrM5gHJfHOgR2Y+dBTzp2hEtV9RSszu9rZOnzHF8OTDAuq4uPUvpkpS1MP3naV9",
+	"D3lLtdNETIUIpaPvotH+AwLSeULdAYHyW0j9WT3dO1og7uERnT80LlKYI5CVvHqkmcMFs55DHn0SQ7bV",
+	"lNGCvQrKes959Lhkcr0c7cCR9rY6kFS9HJ3PCWBlvASQgcZz0kFoUQkTLBQz9UPS3wY/joer+7D0jJjY",
+	"kEIhWBnh2hpahTBbX9UfMrR6p6yFFHHkodQH5YFRaHuvTMPebdYGzwS3xe9iu6+VnAXDyLZ2VMa3a2dQ",
+	"PVzWUlf/7TtiVs+MotqzZVMzlJDUvAkwKGPNpwgeUb6aE22IQBn/htwQGj45E8YRO4PY0mVpIlMVU2/x",
+	"bSdeipg6AKNE+vHc+0g0KgjzUazC4nMglXJVipVXPkqJDkgRSEiOng0p1yKO8B5AkKMbYKRJHIQLJSZB",
+	"UljfygzZ5vRthG+yxbUeynbg0WwlrLoj8bxMtcWCooU8dNdXNVWNRnalpLNBYJHenUC2nBGdmxJEQB3Z",
+	"GSah9Sb0IxPQ9fq0A3ka8udnZzcAW1n9HVEEOfqjCrhpD8w7ktw+2PIacxjfj2OJem4wE5Pfdai+82AA",
+	"meVuwJ5J0QJLtQErQsv3I2KEdeW+ZlxSxQbr26GrCOXWV/1XoJk6kR9r3uk1UQ30fvPUnjzQPtVdvhsD",
+	"VaG7pnUwBesHqUI2Rh3F/Wbnv3q+TT3/2UGYVc5/mjBbX9Ufq53/tMM84Pxng9cjYDUMP/b5rxV06SNk",
+	"kr0ywDkl6zfEJyT+z4sP7z2i1ARLjFW969Flt4TEQE5XQ5WQuAWRjjf0gPP3y7PTIHBEwwFwljxL+8Ax",
+	"2RJDqkelsYXEDMTMalT1ZEFVPdDluBUtplULBw+7r7M/tv+2Xm2fxrPfskkxc5Kg3aQmhUnXDjHwFDyP",
+	"ZN/pwZ/SovOD8Nx0UCzpIU/BFm1dZO0K2dZX64ZGqH1Wkb5f6FIykw+36nQ8m+/8O0rzwkjQjuJNouhG",
+	"feaEyuKhpFC5vTBlugSgCfnLELG+xurSDnKEe+qF/QfjGZsem2QIVVbpPRl2S2YmjqtnR3u0lnyZ+dw8",
+	"XvrMGfdTyFb73IgqaWHnzZS5SrA3RWLuS2z1qHcQtc9l0xdyP6ofQaD4MemtQQw0BNWzhCHm4CMQ11/g",
+	"+lHtwtZTjBtyBNb4V2N5bdBQ9tj6qv6oTZgAZpHXUZ4fr0Q9N0U909drD5w+mX1rLm0WYtssJlW3ntbn",
+	"UQ4pD9qx6mLpm7JhPcKxr1Mw/u7urg3s3SZulrps3mNulmGBzMbrCs+K0XqrdHwT58pwxPW55j80nzs3",
+	"mXH3ZylSBOouXXD/R1ZdrTcHvhfNlWD22KpLXsaeIzrAZZe62ca4nx6J1bq3Hr4XXjOMUBlfBED7JeIB",
+	"7qrKkvXugCItPTRoIEbc3JCBWWnfjiZX+PwyvSuoaoqL34ZCE/a9g8fLO5G1Kp5B0olc6OYEKORr9YJA",
+	"5umEFmXbkrxlSreEybQpzvINkhA2XLAMXteRsFoCLuvCOo8haj7mfpEut3Q1KLuKcG2pkrEDxteJbPSN",
+	"6N4uEbU6G+w+EjybczRUVL0HW3wVP6wUF25xx0rmuV1s32GXV7AEWuW+GqsbncTnL2zWVuDBm+XmkGn7",
+	"h1Ps3f26j+RF6SF5XV/thejPn+iqsEsw3Tv6ez2t/Vw5Igp5bth1n79dEc+e917vE292Fnie6GynsAOY",
+	"xUwq0yYkx+Y589Onx0xXtCOcd5ubwLMOb1BMTE1a7z50cqFMj4+m8Q/GI83Vf2+uW/U+kLpfqpdoKrIC",
+	"yAEtc1OjZAW+opCjsSqkEcJZ59L7k2H+47FWtfTvmK+oclllmD8AZ8nktaAkwpf9bFP3M0XkdTY09ShE",
+	"r85pla3+wTjEU7T7e9E6rQLj3dLX6vp6/fy8eQiE3V811aV1xSOpARk9ov1kcnpK4rC45nd4unegYRPT",
+	"eOp3OHXJefHstOSCiq1kMFq05RTGVyjRWbS6wSxFuuLHvwjOq69VJRTz7KiokhGTtMwadbLW4M4t/W7v",
+	"8E4qWtk0+sEUpgMDqyvNh9ncbSJsRL0CibkG/0p2h7lfYERhmALLN6ZlRy0HopfW5lAVjL7Bqniq9bLN",
+	"CsLgTGeCRUHJNRJCMiAWh6qlepp34xxw3+ruxMMLY413SwY30lDRvCZZeTI5ZUCX45e5eeojTKvNo5Oj",
+	"tz6TK9NnXDH1sJ3yTvb4UHd4YfknMJPaVNjkbGckBqpsG22M1xw5yPYBiS4tdL3w7LdU0y3kr2Qv7Tx/",
+	"3c2ucLFFUZHCGG3hXNQB36LoGlG+Zav1Jrfr91oogIAVKBZ1mgznF4TJN6bqN10eWunrd2DUOWNQ4cto",
+	"UHKpGr8IzhMoe5sCm6joFb+B5juu99TrSp2gF+Z8Kq3eZMonOQJvsGioGC2hwDi3l2hAPpQ/iKi3zFQD",
+	"sQScl/KCxQwBffNOvWZ4/10i+Np+dWH/3a3wJB/myXpXe142ix+0kIDF5Z5qAvfm4hWrC1R1BV5Y+qXe",
+	"wcbKkrPowQOLkug3S9GKqYqzFF1wWsa8pC8y9dxkKvK/Qu9DueGAYJzPUvRdpvU3JI9ZLL5qbv+LhLxI",
+	"yBO4Gqo3vivm2ziHw2piWJQDHoYXUVxj8h9FEB/erVJxXVcOv6/cLCVxK26b61itV7gYLzhOAjwZV7j4",
+	"7fJk8uJJfALPhcH9Jjq4JeCmXJOsfgJjFbvkBAgGfBBf9wt7Pomj2+LMp0n02kTJgEmivNuZiX+uKiOR",
+	"1QtSBApEGWYcJXXuZLxE8VVBcL6yfyOsyJ7YhNcrsbfRFytXulLwDc4jG1rPT7Ky4Z42d4rmiF4bbmq+",
+	"AX5LylcJySDO5Qvgo7tP1QBueo+GHh1PSHzPl8a3vpQ4vhqrQqiqVslYT37XYquRyyxnV98OSA1e9XUs",
+	"p79riJ8DSPPMS9XO/HD36e6/BwB9vhm5QgoBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -1103,7 +1765,7 @@ func decodeSpecCached() func() ([]byte, error) {
 
 // Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
 func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
-	res := make(map[string]func() ([]byte, error))
+	var res = make(map[string]func() ([]byte, error))
 	if len(pathToFile) > 0 {
 		res[pathToFile] = rawSpec
 	}
@@ -1117,12 +1779,12 @@ func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
 // Externally referenced files must be embedded in the corresponding golang packages.
 // Urls can be supported but this task was out of the scope.
 func GetSwagger() (swagger *openapi3.T, err error) {
-	resolvePath := PathToRawSpec("")
+	var resolvePath = PathToRawSpec("")
 
 	loader := openapi3.NewLoader()
 	loader.IsExternalRefsAllowed = true
 	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
-		pathToFile := url.String()
+		var pathToFile = url.String()
 		pathToFile = path.Clean(pathToFile)
 		getSpec, ok := resolvePath[pathToFile]
 		if !ok {
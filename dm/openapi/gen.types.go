@@ -6,6 +6,71 @@ package openapi
 import (
 	"encoding/json"
 	"fmt"
+	"time"
+)
+
+// Defines values for AuditLogApi.
+const (
+	AuditLogApiGrpc AuditLogApi = "grpc"
+
+	AuditLogApiOpenapi AuditLogApi = "openapi"
+)
+
+// Defines values for BinlogOperationRequestOp.
+const (
+	BinlogOperationRequestOpApprove BinlogOperationRequestOp = "approve"
+
+	BinlogOperationRequestOpInject BinlogOperationRequestOp = "inject"
+
+	BinlogOperationRequestOpReplace BinlogOperationRequestOp = "replace"
+
+	BinlogOperationRequestOpRevert BinlogOperationRequestOp = "revert"
+
+	BinlogOperationRequestOpSkip BinlogOperationRequestOp = "skip"
+)
+
+// Defines values for LoadStatusBottleneck.
+const (
+	LoadStatusBottleneckRead LoadStatusBottleneck = "read"
+
+	LoadStatusBottleneckWrite LoadStatusBottleneck = "write"
+)
+
+// Defines values for PausedTablePolicy.
+const (
+	PausedTablePolicyBuffer PausedTablePolicy = "buffer"
+
+	PausedTablePolicySkip PausedTablePolicy = "skip"
+)
+
+// Defines values for PausedTablesRequestOp.
+const (
+	PausedTablesRequestOpPause PausedTablesRequestOp = "pause"
+
+	PausedTablesRequestOpResume PausedTablesRequestOp = "resume"
+)
+
+// Defines values for PausedTablesRequestPolicy.
+const (
+	PausedTablesRequestPolicyBuffer PausedTablesRequestPolicy = "buffer"
+
+	PausedTablesRequestPolicySkip PausedTablesRequestPolicy = "skip"
+)
+
+// Defines values for SetTaskPriorityRequestPriority.
+const (
+	SetTaskPriorityRequestPriorityHigh SetTaskPriorityRequestPriority = "high"
+
+	SetTaskPriorityRequestPriorityLow SetTaskPriorityRequestPriority = "low"
+
+	SetTaskPriorityRequestPriorityNormal SetTaskPriorityRequestPriority = "normal"
+)
+
+// Defines values for SkipGTIDsRequestOp.
+const (
+	SkipGTIDsRequestOpAdd SkipGTIDsRequestOp = "add"
+
+	SkipGTIDsRequestOpRemove SkipGTIDsRequestOp = "remove"
 )
 
 // Defines values for TaskOnDuplicate.
@@ -31,6 +96,78 @@ const (
 	TaskTaskModeIncremental TaskTaskMode = "incremental"
 )
 
+// Defines values for WebhookEvent.
+const (
+	WebhookEventRelayDiskAlarm WebhookEvent = "relay-disk-alarm"
+
+	WebhookEventShardDdlWaiting WebhookEvent = "shard-ddl-waiting"
+
+	WebhookEventTaskPausedOnError WebhookEvent = "task-paused-on-error"
+
+	WebhookEventWorkerOffline WebhookEvent = "worker-offline"
+)
+
+// request to approve the DDLs waiting for approval
+type ApproveDDLRequest struct {
+	// binlog position of the DDLs, the DDLs of the current error are approved if it's empty
+	BinlogPos *string `json:"binlog_pos,omitempty"`
+}
+
+// AuditLog defines model for AuditLog.
+type AuditLog struct {
+	// the API the request came from
+	Api AuditLogApi `json:"api"`
+
+	// the address of the client which sent the request
+	Client string `json:"client"`
+
+	// the error message if the mutation failed
+	Error *string `json:"error,omitempty"`
+
+	// the gRPC method or the OpenAPI route of the mutation
+	Operation string `json:"operation"`
+
+	// the parameters of the request in JSON, with the passwords hidden
+	Params string `json:"params"`
+
+	// whether the mutation succeeded
+	Result bool `json:"result"`
+
+	// the time when the mutation is handled
+	Time time.Time `json:"time"`
+
+	// the identity of the user who requested the mutation
+	User *string `json:"user,omitempty"`
+}
+
+// the API the request came from
+type AuditLogApi string
+
+// binlog operation
+type BinlogOperation struct {
+	BinlogPos string    `json:"binlog_pos"`
+	Op        string    `json:"op"`
+	Sqls      *[]string `json:"sqls,omitempty"`
+
+	// the time when the operation is set
+	Time time.Time `json:"time"`
+}
+
+// request to handle the error event or a specific binlog position event
+type BinlogOperationRequest struct {
+	// binlog position of the event, the current error event is handled if it's empty
+	BinlogPos *string `json:"binlog_pos,omitempty"`
+
+	// operation type
+	Op BinlogOperationRequestOp `json:"op"`
+
+	// the SQLs used by replace and inject operations
+	Sqls *[]string `json:"sqls,omitempty"`
+}
+
+// operation type
+type BinlogOperationRequestOp string
+
 // ClusterMaster defines model for ClusterMaster.
 type ClusterMaster struct {
 	// address of the current master node
@@ -44,6 +181,19 @@ type ClusterMaster struct {
 	Name   string `json:"name"`
 }
 
+// ClusterStatusSummary defines model for ClusterStatusSummary.
+type ClusterStatusSummary struct {
+	// the number of errors of all subtasks
+	ErrorCount             int   `json:"error_count"`
+	MaxSecondsBehindMaster int64 `json:"max_seconds_behind_master"`
+	OfflineWorkerCount     int   `json:"offline_worker_count"`
+	PausedSubtaskCount     int   `json:"paused_subtask_count"`
+	RunningSubtaskCount    int   `json:"running_subtask_count"`
+	SubtaskCount           int   `json:"subtask_count"`
+	TaskCount              int   `json:"task_count"`
+	WorkerCount            int   `json:"worker_count"`
+}
+
 // ClusterWorker defines model for ClusterWorker.
 type ClusterWorker struct {
 	// address of the current master node
@@ -69,6 +219,21 @@ type CreateTaskRequest struct {
 	Task Task `json:"task"`
 }
 
+// CreateWebhookRequest defines model for CreateWebhookRequest.
+type CreateWebhookRequest struct {
+	// the events to notify, all events are notified if it's empty
+	Events *[]WebhookEvent `json:"events,omitempty"`
+
+	// the unique name of the webhook
+	Name string `json:"name"`
+
+	// the secret to sign the notifications by HMAC-SHA256 in the X-DM-Signature header, the notifications are not signed if it's empty
+	Secret *string `json:"secret,omitempty"`
+
+	// the URL to post the notifications to
+	Url string `json:"url"`
+}
+
 // DeleteSourceResponse defines model for DeleteSourceResponse.
 type DeleteSourceResponse struct {
 	// task name list
@@ -93,6 +258,27 @@ type ErrorWithMessage struct {
 	ErrorMsg string `json:"error_msg"`
 }
 
+// GetAuditLogListResponse defines model for GetAuditLogListResponse.
+type GetAuditLogListResponse struct {
+	Data  []AuditLog `json:"data"`
+	Total int        `json:"total"`
+}
+
+// GetBinlogOperationsResponse defines model for GetBinlogOperationsResponse.
+type GetBinlogOperationsResponse struct {
+	// binlog position of the current error event, empty if there's no error
+	BinlogPos *string `json:"binlog_pos,omitempty"`
+
+	// operations set or reverted recently, used to audit the operations
+	History []BinlogOperation `json:"history"`
+
+	// operations waiting to be applied
+	Operations []BinlogOperation `json:"operations"`
+
+	// origin SQL of the current error event
+	OriginSql *string `json:"origin_sql,omitempty"`
+}
+
 // GetClusterInfoResponse defines model for GetClusterInfoResponse.
 type GetClusterInfoResponse struct {
 	// cluster id
@@ -105,12 +291,31 @@ type GetClusterMasterListResponse struct {
 	Total int             `json:"total"`
 }
 
+// GetClusterStatusResponse defines model for GetClusterStatusResponse.
+type GetClusterStatusResponse struct {
+	Summary ClusterStatusSummary `json:"summary"`
+	Tasks   []TaskMetrics        `json:"tasks"`
+	Workers []WorkerMetrics      `json:"workers"`
+}
+
 // GetClusterWorkerListResponse defines model for GetClusterWorkerListResponse.
 type GetClusterWorkerListResponse struct {
 	Data  []ClusterWorker `json:"data"`
 	Total int             `json:"total"`
 }
 
+// GetPausedTablesResponse defines model for GetPausedTablesResponse.
+type GetPausedTablesResponse struct {
+	Data  []PausedTable `json:"data"`
+	Total int           `json:"total"`
+}
+
+// GetShardDDLLockListResponse defines model for GetShardDDLLockListResponse.
+type GetShardDDLLockListResponse struct {
+	Data  []ShardDDLLock `json:"data"`
+	Total int            `json:"total"`
+}
+
 // GetSourceListResponse defines model for GetSourceListResponse.
 type GetSourceListResponse struct {
 	Data  []Source `json:"data"`
@@ -142,13 +347,56 @@ type GetTaskTableStructureResponse struct {
 	TableName       string  `json:"table_name"`
 }
 
+// GetWebhookListResponse defines model for GetWebhookListResponse.
+type GetWebhookListResponse struct {
+	Data  []Webhook `json:"data"`
+	Total int       `json:"total"`
+}
+
 // status of load unit
 type LoadStatus struct {
-	FinishedBytes  int64  `json:"finished_bytes"`
+	// the number of rows rejected by downstream and written to the bad-row files
+	BadRows *int64 `json:"bad_rows,omitempty"`
+
+	// the slower one of reading data files and writing to downstream, empty if unknown
+	Bottleneck *LoadStatusBottleneck `json:"bottleneck,omitempty"`
+
+	// recent speed of imported bytes
+	BytesPerSecond *int64 `json:"bytes_per_second,omitempty"`
+
+	// estimated finish time by the recent speed, in RFC3339 format
+	EstimatedFinishTime *string `json:"estimated_finish_time,omitempty"`
+	FinishedBytes       int64   `json:"finished_bytes"`
+
+	// number of finished data files
+	FinishedChunks *int64 `json:"finished_chunks,omitempty"`
 	MetaBinlog     string `json:"meta_binlog"`
 	MetaBinlogGtid string `json:"meta_binlog_gtid"`
 	Progress       string `json:"progress"`
+
+	// estimated remaining seconds by the recent speed, 0 if unknown
+	RemainingSeconds *int64 `json:"remaining_seconds,omitempty"`
+
+	// recent speed of imported rows
+	RowsPerSecond *int64               `json:"rows_per_second,omitempty"`
+	Tables        *[]LoadTableProgress `json:"tables,omitempty"`
+	TotalBytes    int64                `json:"total_bytes"`
+
+	// number of data files
+	TotalChunks *int64 `json:"total_chunks,omitempty"`
+}
+
+// the slower one of reading data files and writing to downstream, empty if unknown
+type LoadStatusBottleneck string
+
+// progress of a source table in load unit
+type LoadTableProgress struct {
+	FinishedBytes  int64  `json:"finished_bytes"`
+	FinishedChunks int64  `json:"finished_chunks"`
+	Schema         string `json:"schema"`
+	Table          string `json:"table"`
 	TotalBytes     int64  `json:"total_bytes"`
+	TotalChunks    int64  `json:"total_chunks"`
 }
 
 // action to operate table request
@@ -163,6 +411,43 @@ type OperateTaskTableStructureRequest struct {
 	Sync *bool `json:"sync,omitempty"`
 }
 
+// a paused upstream table
+type PausedTable struct {
+	// binlog location from which the table is paused, empty if the table is not paused at a transaction boundary yet
+	Location string            `json:"location"`
+	Policy   PausedTablePolicy `json:"policy"`
+
+	// whether the table is resumed but not at a transaction boundary yet
+	Resuming   bool   `json:"resuming"`
+	SchemaName string `json:"schema_name"`
+	TableName  string `json:"table_name"`
+}
+
+// PausedTablePolicy defines model for PausedTable.Policy.
+type PausedTablePolicy string
+
+// an upstream table to pause or resume
+type PausedTableName struct {
+	SchemaName string `json:"schema_name"`
+	TableName  string `json:"table_name"`
+}
+
+// request to pause or resume the upstream tables, the operations are applied at the next transaction boundary of the sync unit and the task in shard mode is not supported
+type PausedTablesRequest struct {
+	// operation type
+	Op PausedTablesRequestOp `json:"op"`
+
+	// how the changes of the paused tables are handled, `skip` drops the DMLs of the tables, `buffer` holds back the DMLs and DDLs and replicates them after the tables are resumed. default is `buffer`
+	Policy *PausedTablesRequestPolicy `json:"policy,omitempty"`
+	Tables []PausedTableName          `json:"tables"`
+}
+
+// operation type
+type PausedTablesRequestOp string
+
+// how the changes of the paused tables are handled, `skip` drops the DMLs of the tables, `buffer` holds back the DMLs and DDLs and replicates them after the tables are resumed. default is `buffer`
+type PausedTablesRequestPolicy string
+
 // relay log cleanup policy configuration
 type Purge struct {
 	// expiration time of relay log
@@ -175,6 +460,22 @@ type Purge struct {
 	RemainSpace *int64 `json:"remain_space"`
 }
 
+// RebalanceMove defines model for RebalanceMove.
+type RebalanceMove struct {
+	FromWorker string `json:"from_worker"`
+
+	// load score moved with the source
+	Score      float64 `json:"score"`
+	SourceName string  `json:"source_name"`
+	ToWorker   string  `json:"to_worker"`
+}
+
+// RebalancePlan defines model for RebalancePlan.
+type RebalancePlan struct {
+	Moves   []RebalanceMove `json:"moves"`
+	Workers []WorkerLoad    `json:"workers"`
+}
+
 // the config of relay
 type RelayConfig struct {
 	EnableRelay *bool `json:"enable_relay,omitempty"`
@@ -210,6 +511,20 @@ type RelayStatus struct {
 	Stage string `json:"stage"`
 }
 
+// request to resolve the conflicts of the shard DDL lock, the tracked schemas of all tables are replaced by the schema of the winning table
+type ResolveShardDDLLockRequest struct {
+	LockId string `json:"lock_id"`
+
+	// schema name of the winning table
+	SchemaName string `json:"schema_name"`
+
+	// source name of the winning table
+	SourceName string `json:"source_name"`
+
+	// table name of the winning table, its rejected schema is preferred if any
+	TableName string `json:"table_name"`
+}
+
 // schema name list
 type SchemaNameList []string
 
@@ -228,6 +543,62 @@ type Security struct {
 	SslKeyContent string `json:"ssl_key_content"`
 }
 
+// request to change the rate limit of task, the limits apply to the load and sync units of the subtasks
+type SetRateLimitRequest struct {
+	// max bytes replicated per second, 0 means unlimited
+	BytesPerSecond int64 `json:"bytes_per_second"`
+
+	// max rows replicated per second, 0 means unlimited
+	RowsPerSecond int64 `json:"rows_per_second"`
+
+	// source name list
+	SourceNameList *SourceNameList `json:"source_name_list,omitempty"`
+}
+
+// request to change the priority of task, the sources of the tasks with higher priorities are bound to the free workers first, and the subtasks with lower priorities are throttled first when the workers are busy
+type SetTaskPriorityRequest struct {
+	Priority SetTaskPriorityRequestPriority `json:"priority"`
+}
+
+// SetTaskPriorityRequestPriority defines model for SetTaskPriorityRequest.Priority.
+type SetTaskPriorityRequestPriority string
+
+// column whose types in the upstream tables can't be joined
+type ShardDDLConflictColumn struct {
+	ColumnName  string                       `json:"column_name"`
+	ColumnTypes []ShardDDLConflictColumnType `json:"column_types"`
+}
+
+// type of the conflicting column in an upstream table
+type ShardDDLConflictColumnType struct {
+	ColumnType string `json:"column_type"`
+	SchemaName string `json:"schema_name"`
+	SourceName string `json:"source_name"`
+	TableName  string `json:"table_name"`
+}
+
+// optimistic shard DDL lock
+type ShardDDLLock struct {
+	ConflictColumns []ShardDDLConflictColumn `json:"conflict_columns"`
+
+	// schema joined from the tracked schemas of all tables
+	JoinedSchema string              `json:"joined_schema"`
+	LockId       string              `json:"lock_id"`
+	Tables       []ShardDDLLockTable `json:"tables"`
+}
+
+// an upstream table of the shard DDL lock
+type ShardDDLLockTable struct {
+	// schema of the table rejected by the lock because of conflicts, it's absent if no conflict
+	ConflictSchema *string `json:"conflict_schema,omitempty"`
+	SchemaName     string  `json:"schema_name"`
+	SourceName     string  `json:"source_name"`
+	TableName      string  `json:"table_name"`
+
+	// schema of the table tracked by the lock
+	TrackedSchema string `json:"tracked_schema"`
+}
+
 // ShardingGroup defines model for ShardingGroup.
 type ShardingGroup struct {
 	DdlList       []string `json:"ddl_list"`
@@ -237,6 +608,24 @@ type ShardingGroup struct {
 	Unsynced      []string `json:"unsynced"`
 }
 
+// request to add or remove the GTIDs of the transactions to skip
+type SkipGTIDsRequest struct {
+	// GTID set of the transactions, only MySQL GTID is supported
+	GtidSet string `json:"gtid_set"`
+
+	// operation type
+	Op SkipGTIDsRequestOp `json:"op"`
+}
+
+// operation type
+type SkipGTIDsRequestOp string
+
+// SkipGTIDsResponse defines model for SkipGTIDsResponse.
+type SkipGTIDsResponse struct {
+	// GTID set of the transactions to skip, empty if no transaction is skipped
+	GtidSet string `json:"gtid_set"`
+}
+
 // source
 type Source struct {
 	// whether to use GTID to pull binlogs from upstream
@@ -307,6 +696,31 @@ type StopRelayRequest struct {
 	WorkerNameList WorkerNameList `json:"worker_name_list"`
 }
 
+// SubTaskMetrics defines model for SubTaskMetrics.
+type SubTaskMetrics struct {
+	// the number of binlog files the sync unit is behind the upstream
+	BinlogFileGap int64 `json:"binlog_file_gap"`
+
+	// the bytes the sync unit is behind the upstream, it's only calculated when they are in the same binlog file
+	BinlogPosGap int64 `json:"binlog_pos_gap"`
+
+	// the number of errors of the subtask
+	ErrorCount int `json:"error_count"`
+
+	// the error message if the status of the subtask can't be fetched
+	ErrorMsg *string `json:"error_msg,omitempty"`
+
+	// the replication lag in seconds of the sync unit
+	SecondsBehindMaster int64  `json:"seconds_behind_master"`
+	SourceName          string `json:"source_name"`
+	Stage               string `json:"stage"`
+	Unit                string `json:"unit"`
+
+	// the row statistics of the validator
+	Validator  *ValidatorStats `json:"validator,omitempty"`
+	WorkerName string          `json:"worker_name"`
+}
+
 // SubTaskStatus defines model for SubTaskStatus.
 type SubTaskStatus struct {
 	// status of dump unit
@@ -343,16 +757,19 @@ type SyncStatus struct {
 	BinlogType string `json:"binlog_type"`
 
 	// sharding DDL which current is blocking
-	BlockingDdls        []string `json:"blocking_ddls"`
-	MasterBinlog        string   `json:"master_binlog"`
-	MasterBinlogGtid    string   `json:"master_binlog_gtid"`
-	RecentTps           int64    `json:"recent_tps"`
-	SecondsBehindMaster int64    `json:"seconds_behind_master"`
-	Synced              bool     `json:"synced"`
-	SyncerBinlog        string   `json:"syncer_binlog"`
-	SyncerBinlogGtid    string   `json:"syncer_binlog_gtid"`
-	TotalEvents         int64    `json:"total_events"`
-	TotalTps            int64    `json:"total_tps"`
+	BlockingDdls []string `json:"blocking_ddls"`
+
+	// the DML type used to execute DMLs in downstream, standard or bulk
+	DmlType             *string `json:"dml_type,omitempty"`
+	MasterBinlog        string  `json:"master_binlog"`
+	MasterBinlogGtid    string  `json:"master_binlog_gtid"`
+	RecentTps           int64   `json:"recent_tps"`
+	SecondsBehindMaster int64   `json:"seconds_behind_master"`
+	Synced              bool    `json:"synced"`
+	SyncerBinlog        string  `json:"syncer_binlog"`
+	SyncerBinlogGtid    string  `json:"syncer_binlog_gtid"`
+	TotalEvents         int64   `json:"total_events"`
+	TotalTps            int64   `json:"total_tps"`
 
 	// sharding groups which current are un-resolved
 	UnresolvedGroups []ShardingGroup `json:"unresolved_groups"`
@@ -441,6 +858,18 @@ type TaskIncrMigrateConf struct {
 	ReplThreads *int `json:"repl_threads,omitempty"`
 }
 
+// TaskMetrics defines model for TaskMetrics.
+type TaskMetrics struct {
+	ErrorCount             int              `json:"error_count"`
+	MaxBinlogFileGap       int64            `json:"max_binlog_file_gap"`
+	MaxSecondsBehindMaster int64            `json:"max_seconds_behind_master"`
+	Name                   string           `json:"name"`
+	Subtasks               []SubTaskMetrics `json:"subtasks"`
+
+	// the row statistics of the validator
+	Validator *ValidatorStats `json:"validator,omitempty"`
+}
+
 // task name list
 type TaskNameList []string
 
@@ -526,6 +955,92 @@ type TaskTemplateResponse struct {
 	SuccessTaskList []string `json:"success_task_list"`
 }
 
+// request to hot reload the rules of task, the rules take effect from the next transaction of the sync units and the task in shard mode is not supported
+type UpdateTaskRulesRequest struct {
+	BinlogFilterRule *UpdateTaskRulesRequest_BinlogFilterRule `json:"binlog_filter_rule,omitempty"`
+
+	// source name list
+	SourceNameList *SourceNameList `json:"source_name_list,omitempty"`
+
+	// table migrate rule
+	TableMigrateRule []TaskTableMigrateRule `json:"table_migrate_rule"`
+}
+
+// UpdateTaskRulesRequest_BinlogFilterRule defines model for UpdateTaskRulesRequest.BinlogFilterRule.
+type UpdateTaskRulesRequest_BinlogFilterRule struct {
+	AdditionalProperties map[string]TaskBinLogFilterRule `json:"-"`
+}
+
+// the row statistics of the validator
+type ValidatorStats struct {
+	FailedRows    int64 `json:"failed_rows"`
+	PendingRows   int64 `json:"pending_rows"`
+	ValidatedRows int64 `json:"validated_rows"`
+}
+
+// the webhook, the secret is hidden
+type Webhook struct {
+	// the events to notify
+	Events []WebhookEvent `json:"events"`
+
+	// the unique name of the webhook
+	Name string `json:"name"`
+
+	// whether the notifications are signed
+	Signed bool `json:"signed"`
+
+	// the URL to post the notifications to
+	Url string `json:"url"`
+}
+
+// the event of DM to notify
+type WebhookEvent string
+
+// WorkerLoad defines model for WorkerLoad.
+type WorkerLoad struct {
+	// CPU usage of the worker process in percent
+	CpuUsage float64 `json:"cpu_usage"`
+
+	// host of the worker node, the worker nodes in the same host share the resources
+	Host string `json:"host"`
+
+	// bytes of memory obtained from the OS by the worker process
+	MemoryUsage uint64 `json:"memory_usage"`
+
+	// bytes of the relay log files of the worker node
+	RelayDiskUsage int64 `json:"relay_disk_usage"`
+
+	// load score of the worker node, which is the average of the above usages normalized by their maximum among the worker nodes
+	Score float64 `json:"score"`
+
+	// binlog events processed by the sync units per second
+	TaskQps float64 `json:"task_qps"`
+
+	// the time when the load is reported, it's omitted if the worker node hasn't reported its load recently
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	WorkerName string     `json:"worker_name"`
+}
+
+// WorkerMetrics defines model for WorkerMetrics.
+type WorkerMetrics struct {
+	Addr            string `json:"addr"`
+	BoundSourceName string `json:"bound_source_name"`
+
+	// CPU usage of the worker process in percent, it's omitted if the worker node hasn't reported its load
+	CpuUsage *float64 `json:"cpu_usage,omitempty"`
+
+	// bytes of memory obtained from the OS by the worker process
+	MemoryUsage *uint64 `json:"memory_usage,omitempty"`
+	Name        string  `json:"name"`
+
+	// bytes of the relay log files of the worker node
+	RelayDiskUsage *int64 `json:"relay_disk_usage,omitempty"`
+	Stage          string `json:"stage"`
+
+	// the number of subtasks running on the worker node
+	SubtaskCount int `json:"subtask_count"`
+}
+
 // worker name list
 type WorkerNameList []string
 
@@ -535,6 +1050,27 @@ type WorkerNameRequest struct {
 	WorkerName string `json:"worker_name"`
 }
 
+// DMAPIGetAuditLogListParams defines parameters for DMAPIGetAuditLogList.
+type DMAPIGetAuditLogListParams struct {
+	// only the audit logs recorded at or after the time are returned
+	StartTime *time.Time `json:"start_time,omitempty"`
+
+	// only the audit logs recorded before the time are returned
+	EndTime *time.Time `json:"end_time,omitempty"`
+
+	// only the audit logs of the operation are returned
+	Operation *string `json:"operation,omitempty"`
+
+	// only the audit logs of the user are returned
+	User *string `json:"user,omitempty"`
+
+	// only the latest audit logs up to the number are returned, 0 means no limit
+	Limit *int `json:"limit,omitempty"`
+}
+
+// DMAPICreateWebhookJSONBody defines parameters for DMAPICreateWebhook.
+type DMAPICreateWebhookJSONBody CreateWebhookRequest
+
 // DMAPIGetSourceListParams defines parameters for DMAPIGetSourceList.
 type DMAPIGetSourceListParams struct {
 	// get source with status
@@ -583,18 +1119,45 @@ type DMAPIDeleteTaskParams struct {
 // DMAPIPauseTaskJSONBody defines parameters for DMAPIPauseTask.
 type DMAPIPauseTaskJSONBody SourceNameList
 
+// DMAPISetTaskPriorityJSONBody defines parameters for DMAPISetTaskPriority.
+type DMAPISetTaskPriorityJSONBody SetTaskPriorityRequest
+
+// DMAPISetTaskRateLimitJSONBody defines parameters for DMAPISetTaskRateLimit.
+type DMAPISetTaskRateLimitJSONBody SetRateLimitRequest
+
 // DMAPIResumeTaskJSONBody defines parameters for DMAPIResumeTask.
 type DMAPIResumeTaskJSONBody SourceNameList
 
+// DMAPIUpdateTaskRulesJSONBody defines parameters for DMAPIUpdateTaskRules.
+type DMAPIUpdateTaskRulesJSONBody UpdateTaskRulesRequest
+
+// DMAPIResolveShardDDLLockJSONBody defines parameters for DMAPIResolveShardDDLLock.
+type DMAPIResolveShardDDLLockJSONBody ResolveShardDDLLockRequest
+
+// DMAPIApproveDDLJSONBody defines parameters for DMAPIApproveDDL.
+type DMAPIApproveDDLJSONBody ApproveDDLRequest
+
+// DMAPICreateBinlogOperationJSONBody defines parameters for DMAPICreateBinlogOperation.
+type DMAPICreateBinlogOperationJSONBody BinlogOperationRequest
+
+// DMAPIOperatePausedTablesJSONBody defines parameters for DMAPIOperatePausedTables.
+type DMAPIOperatePausedTablesJSONBody PausedTablesRequest
+
 // DMAPIOperateTableStructureJSONBody defines parameters for DMAPIOperateTableStructure.
 type DMAPIOperateTableStructureJSONBody OperateTaskTableStructureRequest
 
+// DMAPIOperateSkipGTIDsJSONBody defines parameters for DMAPIOperateSkipGTIDs.
+type DMAPIOperateSkipGTIDsJSONBody SkipGTIDsRequest
+
 // DMAPIGetTaskStatusParams defines parameters for DMAPIGetTaskStatus.
 type DMAPIGetTaskStatusParams struct {
 	// source name list
 	SourceNameList *SourceNameList `json:"source_name_list,omitempty"`
 }
 
+// DMAPICreateWebhookJSONRequestBody defines body for DMAPICreateWebhook for application/json ContentType.
+type DMAPICreateWebhookJSONRequestBody DMAPICreateWebhookJSONBody
+
 // DMAPICreateSourceJSONRequestBody defines body for DMAPICreateSource for application/json ContentType.
 type DMAPICreateSourceJSONRequestBody DMAPICreateSourceJSONBody
 
@@ -619,12 +1182,36 @@ type DMAPIImportTaskTemplateJSONRequestBody DMAPIImportTaskTemplateJSONBody
 // DMAPIPauseTaskJSONRequestBody defines body for DMAPIPauseTask for application/json ContentType.
 type DMAPIPauseTaskJSONRequestBody DMAPIPauseTaskJSONBody
 
+// DMAPISetTaskPriorityJSONRequestBody defines body for DMAPISetTaskPriority for application/json ContentType.
+type DMAPISetTaskPriorityJSONRequestBody DMAPISetTaskPriorityJSONBody
+
+// DMAPISetTaskRateLimitJSONRequestBody defines body for DMAPISetTaskRateLimit for application/json ContentType.
+type DMAPISetTaskRateLimitJSONRequestBody DMAPISetTaskRateLimitJSONBody
+
 // DMAPIResumeTaskJSONRequestBody defines body for DMAPIResumeTask for application/json ContentType.
 type DMAPIResumeTaskJSONRequestBody DMAPIResumeTaskJSONBody
 
+// DMAPIUpdateTaskRulesJSONRequestBody defines body for DMAPIUpdateTaskRules for application/json ContentType.
+type DMAPIUpdateTaskRulesJSONRequestBody DMAPIUpdateTaskRulesJSONBody
+
+// DMAPIResolveShardDDLLockJSONRequestBody defines body for DMAPIResolveShardDDLLock for application/json ContentType.
+type DMAPIResolveShardDDLLockJSONRequestBody DMAPIResolveShardDDLLockJSONBody
+
+// DMAPIApproveDDLJSONRequestBody defines body for DMAPIApproveDDL for application/json ContentType.
+type DMAPIApproveDDLJSONRequestBody DMAPIApproveDDLJSONBody
+
+// DMAPICreateBinlogOperationJSONRequestBody defines body for DMAPICreateBinlogOperation for application/json ContentType.
+type DMAPICreateBinlogOperationJSONRequestBody DMAPICreateBinlogOperationJSONBody
+
+// DMAPIOperatePausedTablesJSONRequestBody defines body for DMAPIOperatePausedTables for application/json ContentType.
+type DMAPIOperatePausedTablesJSONRequestBody DMAPIOperatePausedTablesJSONBody
+
 // DMAPIOperateTableStructureJSONRequestBody defines body for DMAPIOperateTableStructure for application/json ContentType.
 type DMAPIOperateTableStructureJSONRequestBody DMAPIOperateTableStructureJSONBody
 
+// DMAPIOperateSkipGTIDsJSONRequestBody defines body for DMAPIOperateSkipGTIDs for application/json ContentType.
+type DMAPIOperateSkipGTIDsJSONRequestBody DMAPIOperateSkipGTIDsJSONBody
+
 // Getter for additional properties for Task_BinlogFilterRule. Returns the specified
 // element and whether it was found
 func (a Task_BinlogFilterRule) Get(fieldName string) (value TaskBinLogFilterRule, found bool) {
@@ -677,3 +1264,56 @@ func (a Task_BinlogFilterRule) MarshalJSON() ([]byte, error) {
 	}
 	return json.Marshal(object)
 }
+
+// Getter for additional properties for UpdateTaskRulesRequest_BinlogFilterRule. Returns the specified
+// element and whether it was found
+func (a UpdateTaskRulesRequest_BinlogFilterRule) Get(fieldName string) (value TaskBinLogFilterRule, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for UpdateTaskRulesRequest_BinlogFilterRule
+func (a *UpdateTaskRulesRequest_BinlogFilterRule) Set(fieldName string, value TaskBinLogFilterRule) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]TaskBinLogFilterRule)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for UpdateTaskRulesRequest_BinlogFilterRule to handle AdditionalProperties
+func (a *UpdateTaskRulesRequest_BinlogFilterRule) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]TaskBinLogFilterRule)
+		for fieldName, fieldBuf := range object {
+			var fieldVal TaskBinLogFilterRule
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for UpdateTaskRulesRequest_BinlogFilterRule to handle AdditionalProperties
+func (a UpdateTaskRulesRequest_BinlogFilterRule) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
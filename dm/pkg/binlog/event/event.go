@@ -875,3 +875,19 @@ func GenHeartbeatEvent(header *replication.EventHeader) *replication.BinlogEvent
 
 	return ev
 }
+
+// GenHeartbeatEventWithPosition generates a heartbeat event carrying the binlog position `pos`, like the one sent by
+// MySQL, the end log position in the header is `pos.Pos` and the body is the binlog filename `pos.Name`.
+func GenHeartbeatEventWithPosition(header *replication.EventHeader, pos gmysql.Position) *replication.BinlogEvent {
+	headerClone := *header // do a copy
+	headerClone.Flags = 0
+	headerClone.EventSize = uint32(eventHeaderLen) + uint32(len(pos.Name))
+	headerClone.Timestamp = 0
+	headerClone.EventType = replication.HEARTBEAT_EVENT
+	headerClone.LogPos = pos.Pos
+
+	eventBytes := []byte(pos.Name)
+	ev := &replication.BinlogEvent{Header: &headerClone, Event: &replication.GenericEvent{Data: eventBytes}}
+
+	return ev
+}
@@ -14,6 +14,8 @@
 package event
 
 import (
+	"bytes"
+
 	gmysql "github.com/go-mysql-org/go-mysql/mysql"
 	"github.com/go-mysql-org/go-mysql/replication"
 
@@ -69,3 +71,26 @@ func GTIDsFromMariaDBGTIDListEvent(e *replication.BinlogEvent) (gtid.Set, error)
 
 	return gSet, nil
 }
+
+// IsCommitQuery returns whether the query of a QueryEvent is `COMMIT`, which ends a transaction of non-transactional
+// engines like MyISAM, transactional engines like InnoDB end a transaction with an XIDEvent.
+func IsCommitQuery(query []byte) bool {
+	return bytes.EqualFold(bytes.TrimSpace(query), []byte("COMMIT"))
+}
+
+// PositionFromHeartbeatEvent gets the binlog position carried by a heartbeat event, which is the position of the
+// latest event sent before it. the second return value is false if no position is carried.
+func PositionFromHeartbeatEvent(e *replication.BinlogEvent) (gmysql.Position, bool) {
+	if e.Header.EventType != replication.HEARTBEAT_EVENT || e.Header.LogPos == 0 {
+		return gmysql.Position{}, false
+	}
+	ev, ok := e.Event.(*replication.GenericEvent)
+	if !ok {
+		return gmysql.Position{}, false
+	}
+	name := bytes.TrimRight(ev.Data, "\x00")
+	if len(name) == 0 {
+		return gmysql.Position{}, false
+	}
+	return gmysql.Position{Name: string(name), Pos: e.Header.LogPos}, true
+}
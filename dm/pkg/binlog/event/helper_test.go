@@ -81,3 +81,25 @@ func (t *testHelperSuite) TestGTIDsFromMariaDBGTIDListEvent(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(gSet, DeepEquals, gSetExpect)
 }
+
+func (t *testHelperSuite) TestPositionFromHeartbeatEvent(c *C) {
+	header := &replication.EventHeader{ServerID: 11}
+
+	// not carrying any position
+	_, ok := PositionFromHeartbeatEvent(GenHeartbeatEvent(header))
+	c.Assert(ok, IsFalse)
+
+	// not a heartbeat event
+	xidEv, err := GenXIDEvent(header, 4, 123)
+	c.Assert(err, IsNil)
+	_, ok = PositionFromHeartbeatEvent(xidEv)
+	c.Assert(ok, IsFalse)
+
+	pos := gmysql.Position{Name: "mysql-bin.000003", Pos: 1234}
+	ev := GenHeartbeatEventWithPosition(header, pos)
+	c.Assert(ev.Header.EventType, Equals, replication.HEARTBEAT_EVENT)
+	c.Assert(ev.Header.EventSize, Equals, uint32(replication.EventHeaderSize+len(pos.Name)))
+	pos2, ok := PositionFromHeartbeatEvent(ev)
+	c.Assert(ok, IsTrue)
+	c.Assert(pos2, DeepEquals, pos)
+}
@@ -0,0 +1,216 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// TransactionPayloadEvent is the TRANSACTION_PAYLOAD_EVENT written by MySQL 8.0.20+ when `binlog_transaction_compression`
+// is ON, it wraps all events of a transaction except the GTID event. go-mysql doesn't know it and parses it as a GenericEvent.
+// ref: https://dev.mysql.com/doc/refman/8.0/en/binary-log-transaction-compression.html
+const TransactionPayloadEvent replication.EventType = 0x28
+
+// compression types of TransactionPayloadEvent.
+const (
+	TransactionPayloadCompressionZstd uint64 = 0
+	TransactionPayloadCompressionNone uint64 = 255
+)
+
+// field types in the post-header of TransactionPayloadEvent.
+// ref: https://github.com/mysql/mysql-server/blob/8.0/libbinlogevents/include/control_events.h
+const (
+	payloadHeaderEndMark uint64 = iota
+	payloadSizeField
+	payloadCompressionTypeField
+	payloadUncompressedSizeField
+)
+
+var (
+	payloadEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	payloadDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+)
+
+// GenTransactionPayloadEvent generates a TransactionPayloadEvent which wraps `events`.
+// the inner events are written without checksum like MySQL does, and all of them must have CRC32 checksum (as generated by this package).
+func GenTransactionPayloadEvent(header *replication.EventHeader, latestPos uint32, compressionType uint64, events []*replication.BinlogEvent) (*replication.BinlogEvent, error) {
+	var inner bytes.Buffer
+	for _, e := range events {
+		raw := make([]byte, len(e.RawData)-int(crc32Len))
+		copy(raw, e.RawData)
+		binary.LittleEndian.PutUint32(raw[9:], uint32(len(raw))) // event size
+		inner.Write(raw)
+	}
+
+	var payload []byte
+	switch compressionType {
+	case TransactionPayloadCompressionZstd:
+		payload = payloadEncoder.EncodeAll(inner.Bytes(), nil)
+	case TransactionPayloadCompressionNone:
+		payload = inner.Bytes()
+	default:
+		return nil, terror.ErrBinlogTransactionPayloadNotValid.Generate(fmt.Sprintf("compression type %d not supported", compressionType))
+	}
+
+	postHeader := new(bytes.Buffer)
+	writePayloadField(postHeader, payloadSizeField, uint64(len(payload)))
+	writePayloadField(postHeader, payloadCompressionTypeField, compressionType)
+	if compressionType != TransactionPayloadCompressionNone {
+		writePayloadField(postHeader, payloadUncompressedSizeField, uint64(inner.Len()))
+	}
+	postHeader.Write(encodeNetFieldLength(payloadHeaderEndMark))
+
+	buf := new(bytes.Buffer)
+	event := &replication.GenericEvent{}
+	ev, err := assembleEvent(buf, event, false, *header, TransactionPayloadEvent, latestPos, postHeader.Bytes(), payload)
+	return ev, err
+}
+
+// DecodeTransactionPayload decodes the body (without checksum) of a TransactionPayloadEvent,
+// and returns the decompressed inner events which have no checksum. the returned data never shares memory with `data`.
+func DecodeTransactionPayload(data []byte) ([]byte, error) {
+	var (
+		payloadSize      = -1
+		compressionType  = TransactionPayloadCompressionNone
+		uncompressedSize uint64
+	)
+	for {
+		fieldType, n := decodeNetFieldLength(data)
+		if n == 0 {
+			return nil, terror.ErrBinlogTransactionPayloadNotValid.Generate("incomplete post-header")
+		}
+		data = data[n:]
+		if fieldType == payloadHeaderEndMark {
+			break
+		}
+		length, n := decodeNetFieldLength(data)
+		if n == 0 || uint64(len(data)-n) < length {
+			return nil, terror.ErrBinlogTransactionPayloadNotValid.Generate(fmt.Sprintf("incomplete field %d", fieldType))
+		}
+		value := data[n : n+int(length)]
+		data = data[n+int(length):]
+
+		switch fieldType {
+		case payloadSizeField, payloadCompressionTypeField, payloadUncompressedSizeField:
+			v, n2 := decodeNetFieldLength(value)
+			if n2 == 0 {
+				return nil, terror.ErrBinlogTransactionPayloadNotValid.Generate(fmt.Sprintf("incomplete field %d", fieldType))
+			}
+			switch fieldType {
+			case payloadSizeField:
+				payloadSize = int(v)
+			case payloadCompressionTypeField:
+				compressionType = v
+			default:
+				uncompressedSize = v
+			}
+		default:
+			// skip unknown fields, they may be added by newer versions of MySQL
+		}
+	}
+
+	if payloadSize >= 0 {
+		if payloadSize > len(data) {
+			return nil, terror.ErrBinlogTransactionPayloadNotValid.Generate(fmt.Sprintf("payload size %d exceeds the event size", payloadSize))
+		}
+		data = data[:payloadSize]
+	}
+
+	switch compressionType {
+	case TransactionPayloadCompressionZstd:
+		decompressed, err := payloadDecoder.DecodeAll(data, make([]byte, 0, uncompressedSize))
+		if err != nil {
+			return nil, terror.ErrBinlogTransactionPayloadNotValid.Generate(fmt.Sprintf("decompress payload: %v", err))
+		}
+		return decompressed, nil
+	case TransactionPayloadCompressionNone:
+		return append([]byte(nil), data...), nil
+	default:
+		return nil, terror.ErrBinlogTransactionPayloadNotValid.Generate(fmt.Sprintf("compression type %d not supported", compressionType))
+	}
+}
+
+// SplitTransactionPayload splits the decompressed payload of a TransactionPayloadEvent into the raw data of inner events.
+func SplitTransactionPayload(payload []byte) ([][]byte, error) {
+	var events [][]byte
+	for len(payload) > 0 {
+		if len(payload) < replication.EventHeaderSize {
+			return nil, terror.ErrBinlogTransactionPayloadNotValid.Generate("incomplete inner event header")
+		}
+		size := binary.LittleEndian.Uint32(payload[9:])
+		if size < replication.EventHeaderSize || uint64(size) > uint64(len(payload)) {
+			return nil, terror.ErrBinlogTransactionPayloadNotValid.Generate(fmt.Sprintf("inner event size %d not valid", size))
+		}
+		events = append(events, payload[:size:size])
+		payload = payload[size:]
+	}
+	return events, nil
+}
+
+func writePayloadField(buf *bytes.Buffer, fieldType, value uint64) {
+	encoded := encodeNetFieldLength(value)
+	buf.Write(encodeNetFieldLength(fieldType))
+	buf.Write(encodeNetFieldLength(uint64(len(encoded))))
+	buf.Write(encoded)
+}
+
+// encodeNetFieldLength encodes an integer as net_field_length of MySQL.
+func encodeNetFieldLength(v uint64) []byte {
+	switch {
+	case v < 251:
+		return []byte{byte(v)}
+	case v < 1<<16:
+		return []byte{0xfc, byte(v), byte(v >> 8)}
+	case v < 1<<24:
+		return []byte{0xfd, byte(v), byte(v >> 8), byte(v >> 16)}
+	default:
+		b := make([]byte, 9)
+		b[0] = 0xfe
+		binary.LittleEndian.PutUint64(b[1:], v)
+		return b
+	}
+}
+
+// decodeNetFieldLength decodes a net_field_length of MySQL, it returns 0 bytes read if `b` is incomplete.
+func decodeNetFieldLength(b []byte) (uint64, int) {
+	if len(b) == 0 {
+		return 0, 0
+	}
+	var n int
+	switch b[0] {
+	case 0xfc:
+		n = 3
+	case 0xfd:
+		n = 4
+	case 0xfe:
+		n = 9
+	default:
+		return uint64(b[0]), 1
+	}
+	if len(b) < n {
+		return 0, 0
+	}
+	var v uint64
+	for i := n - 1; i >= 1; i-- {
+		v = v<<8 | uint64(b[i])
+	}
+	return v, n
+}
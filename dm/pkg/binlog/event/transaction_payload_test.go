@@ -0,0 +1,88 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+var _ = Suite(&testTransactionPayloadSuite{})
+
+type testTransactionPayloadSuite struct{}
+
+func (t *testTransactionPayloadSuite) TestNetFieldLength(c *C) {
+	for _, v := range []uint64{0, 1, 250, 251, 255, 1<<16 - 1, 1 << 16, 1<<24 - 1, 1 << 24, 1<<64 - 1} {
+		encoded := encodeNetFieldLength(v)
+		decoded, n := decodeNetFieldLength(encoded)
+		c.Assert(n, Equals, len(encoded))
+		c.Assert(decoded, Equals, v)
+
+		// incomplete
+		_, n = decodeNetFieldLength(encoded[:len(encoded)-1])
+		c.Assert(n, Equals, 0)
+	}
+}
+
+func (t *testTransactionPayloadSuite) TestGenAndDecodeTransactionPayload(c *C) {
+	var (
+		header = &replication.EventHeader{
+			Timestamp: uint32(time.Now().Unix()),
+			ServerID:  11,
+		}
+		latestPos uint32 = 4
+	)
+	queryEv, err := GenQueryEvent(header, latestPos, 0, 0, 0, nil, []byte("db"), []byte("BEGIN"))
+	c.Assert(err, IsNil)
+	xidEv, err := GenXIDEvent(header, queryEv.Header.LogPos, 123)
+	c.Assert(err, IsNil)
+	events := []*replication.BinlogEvent{queryEv, xidEv}
+
+	for _, compressionType := range []uint64{TransactionPayloadCompressionZstd, TransactionPayloadCompressionNone} {
+		payloadEv, err2 := GenTransactionPayloadEvent(header, latestPos, compressionType, events)
+		c.Assert(err2, IsNil)
+		verifyHeader(c, payloadEv.Header, header, TransactionPayloadEvent, latestPos, uint32(len(payloadEv.RawData)))
+
+		body := payloadEv.RawData[eventHeaderLen : len(payloadEv.RawData)-int(crc32Len)]
+		payload, err2 := DecodeTransactionPayload(body)
+		c.Assert(err2, IsNil)
+		inner, err2 := SplitTransactionPayload(payload)
+		c.Assert(err2, IsNil)
+		c.Assert(inner, HasLen, len(events))
+		for i, e := range events {
+			c.Assert(inner[i], HasLen, len(e.RawData)-int(crc32Len))
+			c.Assert(inner[i][replication.EventHeaderSize:], DeepEquals, e.RawData[replication.EventHeaderSize:len(e.RawData)-int(crc32Len)])
+		}
+
+		// incomplete event
+		_, err2 = DecodeTransactionPayload(body[:len(body)-1])
+		c.Assert(terror.ErrBinlogTransactionPayloadNotValid.Equal(err2), IsTrue)
+		_, err2 = DecodeTransactionPayload(body[:2])
+		c.Assert(terror.ErrBinlogTransactionPayloadNotValid.Equal(err2), IsTrue)
+	}
+
+	// not supported compression type
+	_, err = GenTransactionPayloadEvent(header, latestPos, 1, events)
+	c.Assert(terror.ErrBinlogTransactionPayloadNotValid.Equal(err), IsTrue)
+
+	// invalid inner events
+	_, err = SplitTransactionPayload(queryEv.RawData[:replication.EventHeaderSize-1])
+	c.Assert(terror.ErrBinlogTransactionPayloadNotValid.Equal(err), IsTrue)
+	_, err = SplitTransactionPayload(queryEv.RawData[:len(queryEv.RawData)-1])
+	c.Assert(terror.ErrBinlogTransactionPayloadNotValid.Equal(err), IsTrue)
+}
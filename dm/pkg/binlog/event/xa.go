@@ -0,0 +1,102 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// length of the fixed part of the XA_PREPARE_LOG_EVENT body, one_phase(1) + formatID(4) + gtrid_length(4) + bqual_length(4).
+const xaPrepareFixedLen = 13
+
+// XAPrepare is the decoded XA_PREPARE_LOG_EVENT, which is written after `XA END` when `XA PREPARE` or
+// `XA COMMIT ... ONE PHASE` is executed. go-mysql parses it as a GenericEvent.
+// ref: https://github.com/mysql/mysql-server/blob/8.0/libbinlogevents/include/control_events.h
+type XAPrepare struct {
+	OnePhase bool
+	FormatID uint32
+	Gtrid    []byte
+	Bqual    []byte
+}
+
+// XID returns the xid of the XA transaction in the same format as the one in `XA START` and `XA COMMIT` query
+// events, which can be compared after normalized by NormalizeXID.
+func (x *XAPrepare) XID() string {
+	return fmt.Sprintf("X'%x',X'%x',%d", x.Gtrid, x.Bqual, x.FormatID)
+}
+
+// GenXAPrepareEvent generates a XA_PREPARE_LOG_EVENT.
+func GenXAPrepareEvent(header *replication.EventHeader, latestPos uint32, onePhase bool, formatID uint32, gtrid, bqual []byte) (*replication.BinlogEvent, error) {
+	payload := new(bytes.Buffer)
+	if onePhase {
+		payload.WriteByte(1)
+	} else {
+		payload.WriteByte(0)
+	}
+	for _, v := range []uint32{formatID, uint32(len(gtrid)), uint32(len(bqual))} {
+		err := binary.Write(payload, binary.LittleEndian, v)
+		if err != nil {
+			return nil, terror.ErrBinlogWriteBinaryData.AnnotateDelegate(err, "write XA prepare field %d", v)
+		}
+	}
+	payload.Write(gtrid)
+	payload.Write(bqual)
+
+	buf := new(bytes.Buffer)
+	event := &replication.GenericEvent{}
+	ev, err := assembleEvent(buf, event, false, *header, replication.XA_PREPARE_LOG_EVENT, latestPos, nil, payload.Bytes())
+	return ev, err
+}
+
+// DecodeXAPrepareEvent decodes a XA_PREPARE_LOG_EVENT.
+func DecodeXAPrepareEvent(e *replication.BinlogEvent) (*XAPrepare, error) {
+	if e.Header.EventType != replication.XA_PREPARE_LOG_EVENT {
+		return nil, terror.ErrBinlogXAPrepareEventNotValid.Generate(fmt.Sprintf("event type %s", e.Header.EventType))
+	}
+	ev, ok := e.Event.(*replication.GenericEvent)
+	if !ok {
+		return nil, terror.ErrBinlogXAPrepareEventNotValid.Generate(fmt.Sprintf("event %T", e.Event))
+	}
+
+	data := ev.Data
+	if len(data) < xaPrepareFixedLen {
+		return nil, terror.ErrBinlogXAPrepareEventNotValid.Generate(fmt.Sprintf("body length %d", len(data)))
+	}
+	x := &XAPrepare{
+		OnePhase: data[0] != 0,
+		FormatID: binary.LittleEndian.Uint32(data[1:]),
+	}
+	gtridLen := uint64(binary.LittleEndian.Uint32(data[5:]))
+	bqualLen := uint64(binary.LittleEndian.Uint32(data[9:]))
+	data = data[xaPrepareFixedLen:]
+	// data may be followed by the checksum
+	if gtridLen+bqualLen > uint64(len(data)) {
+		return nil, terror.ErrBinlogXAPrepareEventNotValid.Generate(fmt.Sprintf("gtrid length %d and bqual length %d exceed the body", gtridLen, bqualLen))
+	}
+	x.Gtrid = append([]byte(nil), data[:gtridLen]...)
+	x.Bqual = append([]byte(nil), data[gtridLen:gtridLen+bqualLen]...)
+	return x, nil
+}
+
+// NormalizeXID normalizes a xid like `X'6162',X'6364',1` in binlog to compare it regardless of spaces and case.
+func NormalizeXID(xid string) string {
+	return strings.ToLower(strings.Join(strings.Fields(xid), ""))
+}
@@ -0,0 +1,62 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+var _ = Suite(&testXASuite{})
+
+type testXASuite struct{}
+
+func (t *testXASuite) TestGenAndDecodeXAPrepareEvent(c *C) {
+	header := &replication.EventHeader{
+		Timestamp: uint32(time.Now().Unix()),
+		ServerID:  11,
+	}
+	for _, onePhase := range []bool{false, true} {
+		ev, err := GenXAPrepareEvent(header, 4, onePhase, 1, []byte("ab"), nil)
+		c.Assert(err, IsNil)
+		c.Assert(ev.Header.EventType, Equals, replication.XA_PREPARE_LOG_EVENT)
+		c.Assert(ev.Header.LogPos, Equals, 4+ev.Header.EventSize)
+
+		x, err := DecodeXAPrepareEvent(ev)
+		c.Assert(err, IsNil)
+		c.Assert(x.OnePhase, Equals, onePhase)
+		c.Assert(x.FormatID, Equals, uint32(1))
+		c.Assert(x.Gtrid, DeepEquals, []byte("ab"))
+		c.Assert(x.Bqual, HasLen, 0)
+		c.Assert(x.XID(), Equals, "X'6162',X'',1")
+		c.Assert(NormalizeXID(x.XID()), Equals, NormalizeXID("X'6162', X'', 1"))
+	}
+
+	// truncated body
+	ev, err := GenXAPrepareEvent(header, 4, false, 1, []byte("ab"), []byte("c"))
+	c.Assert(err, IsNil)
+	ev.Event.(*replication.GenericEvent).Data = ev.Event.(*replication.GenericEvent).Data[:xaPrepareFixedLen+2]
+	_, err = DecodeXAPrepareEvent(ev)
+	c.Assert(terror.ErrBinlogXAPrepareEventNotValid.Equal(err), IsTrue)
+
+	// not a XA prepare event
+	xidEv, err := GenXIDEvent(header, 4, 1)
+	c.Assert(err, IsNil)
+	_, err = DecodeXAPrepareEvent(xidEv)
+	c.Assert(terror.ErrBinlogXAPrepareEventNotValid.Equal(err), IsTrue)
+}
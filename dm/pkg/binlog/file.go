@@ -16,6 +16,7 @@ package binlog
 import (
 	"os"
 	"sort"
+	"strings"
 
 	"go.uber.org/zap"
 
@@ -25,6 +26,13 @@ import (
 
 // ReadSortedBinlogFromDir reads and returns all binlog files (sorted ascending by binlog filename and sequence number).
 func ReadSortedBinlogFromDir(dirpath string) ([]string, error) {
+	return ReadSortedBinlogFromDirWithExt(dirpath)
+}
+
+// ReadSortedBinlogFromDirWithExt is like ReadSortedBinlogFromDir, but also treats files with one of the
+// extensions `exts` (like `.zst`) as binlog files, the extension is trimmed in the returned filenames.
+// if both `mysql-bin.000001` and `mysql-bin.000001.zst` exist, only one `mysql-bin.000001` is returned.
+func ReadSortedBinlogFromDirWithExt(dirpath string, exts ...string) ([]string, error) {
 	dir, err := os.Open(dirpath)
 	if err != nil {
 		return nil, terror.ErrReadDir.Delegate(err, dirpath)
@@ -35,8 +43,14 @@ func ReadSortedBinlogFromDir(dirpath string) ([]string, error) {
 	if err != nil {
 		return nil, terror.ErrReadDir.Delegate(err, dirpath)
 	}
+	return SortBinlogFilenamesWithExt(names, exts...), nil
+}
+
+// SortBinlogFilenamesWithExt returns the binlog files in `names` (sorted ascending by binlog filename and sequence number),
+// files with one of the extensions `exts` are treated as binlog files like ReadSortedBinlogFromDirWithExt.
+func SortBinlogFilenamesWithExt(names []string, exts ...string) []string {
 	if len(names) == 0 {
-		return nil, nil
+		return nil
 	}
 
 	// sorting bin.100000, ..., bin.1000000, ..., bin.999999
@@ -45,14 +59,25 @@ func ReadSortedBinlogFromDir(dirpath string) ([]string, error) {
 		parsed   Filename
 	}
 	tmp := make([]tuple, 0, len(names)-1)
+	seen := make(map[string]struct{}, len(names))
 
 	for _, f := range names {
+		for _, ext := range exts {
+			if strings.HasSuffix(f, ext) {
+				f = strings.TrimSuffix(f, ext)
+				break
+			}
+		}
+		if _, ok := seen[f]; ok {
+			continue
+		}
 		p, err2 := ParseFilename(f)
 		if err2 != nil {
 			// may contain some file that can't be parsed, like relay meta. ignore them
 			log.L().Info("collecting binlog file, ignore invalid file", zap.String("file", f))
 			continue
 		}
+		seen[f] = struct{}{}
 		tmp = append(tmp, tuple{
 			filename: f,
 			parsed:   p,
@@ -71,5 +96,5 @@ func ReadSortedBinlogFromDir(dirpath string) ([]string, error) {
 		ret[i] = tmp[i].filename
 	}
 
-	return ret, nil
+	return ret
 }
@@ -39,3 +39,20 @@ func (t *testFileSuite) TestReadSortedBinlogFromDir(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(ret, DeepEquals, expected)
 }
+
+func (t *testFileSuite) TestReadSortedBinlogFromDirWithExt(c *C) {
+	dir := c.MkDir()
+	filenames := []string{
+		"bin.000001.zst", "bin.000002.zst", "bin.000002", "bin.000003", "bin.000004.gz", "relay.meta",
+	}
+	for _, f := range filenames {
+		c.Assert(os.WriteFile(filepath.Join(dir, f), nil, 0o600), IsNil)
+	}
+	ret, err := ReadSortedBinlogFromDirWithExt(dir, ".zst")
+	c.Assert(err, IsNil)
+	c.Assert(ret, DeepEquals, []string{"bin.000001", "bin.000002", "bin.000003"})
+
+	ret, err = ReadSortedBinlogFromDir(dir)
+	c.Assert(err, IsNil)
+	c.Assert(ret, DeepEquals, []string{"bin.000002", "bin.000003"})
+}
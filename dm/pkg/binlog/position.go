@@ -318,6 +318,147 @@ func CompareLocation(location1, location2 Location, cmpGTID bool) int {
 	return compareIndex(location1.Suffix, location2.Suffix)
 }
 
+// LocationComparator compares Locations which may be recorded in different modes, like the locations saved before
+// and after GTID is enabled for a task, where some of them have GTID sets but the others only have file positions.
+// unlike CompareLocation, a location without GTID sets is not treated as the smallest one when comparing GTID, the
+// positions are compared instead. and if the GTID sets can't be compared by containing (e.g. after the upstream
+// switched to another server), the relay server-uuid.index is consulted to find which one is generated later.
+type LocationComparator struct {
+	cmpGTID   bool
+	uuidIndex func() ([]string, error)
+}
+
+// NewLocationComparator creates a LocationComparator, `uuidIndex` returns the UUIDs (with suffix) in the relay
+// server-uuid.index, from older to newer. it's only called when the GTID sets can't be compared, and can be nil if
+// no relay log is used.
+func NewLocationComparator(cmpGTID bool, uuidIndex func() ([]string, error)) *LocationComparator {
+	return &LocationComparator{
+		cmpGTID:   cmpGTID,
+		uuidIndex: uuidIndex,
+	}
+}
+
+// Compare returns:
+//   1 if location1 is bigger than location2
+//   0 if location1 is equal to location2
+//   -1 if location1 is less than location2
+// the GTID sets are used only when both locations have them, otherwise the positions are compared.
+func (c *LocationComparator) Compare(location1, location2 Location) int {
+	if c.cmpGTID && !isEmptyGTIDSet(location1.gtidSet) && !isEmptyGTIDSet(location2.gtidSet) {
+		cmp, canCmp := CompareGTID(location1.gtidSet, location2.gtidSet)
+		if !canCmp {
+			cmp, canCmp = c.compareGTIDByServer(location1.gtidSet, location2.gtidSet)
+		}
+		if canCmp {
+			if cmp != 0 {
+				return cmp
+			}
+			return compareIndex(location1.Suffix, location2.Suffix)
+		}
+		log.L().Warn("gtidSet can't be compared, will compare by position", zap.Stringer("location1", location1), zap.Stringer("location2", location2))
+	}
+
+	cmp := ComparePosition(location1.Position, location2.Position)
+	if cmp != 0 {
+		return cmp
+	}
+	return compareIndex(location1.Suffix, location2.Suffix)
+}
+
+// compareGTIDByServer compares the GTID sets by the newest upstream server in the relay server-uuid.index which
+// generates the transactions in them, returns false if they can't be compared in this way.
+func (c *LocationComparator) compareGTIDByServer(gSet1, gSet2 gtid.Set) (int, bool) {
+	if c.uuidIndex == nil {
+		return 0, false
+	}
+	uuids, err := c.uuidIndex()
+	if err != nil {
+		log.L().Warn("fail to get relay UUID index to compare gtidSet", zap.Error(err))
+		return 0, false
+	}
+	newest1, newest2 := newestServerInGTIDSet(gSet1, uuids), newestServerInGTIDSet(gSet2, uuids)
+	if newest1 < 0 || newest2 < 0 || newest1 == newest2 {
+		return 0, false
+	}
+	return compareIndex(newest1, newest2), true
+}
+
+// Max returns the bigger one of the two locations.
+func (c *LocationComparator) Max(location1, location2 Location) Location {
+	if c.Compare(location1, location2) >= 0 {
+		return location1
+	}
+	return location2
+}
+
+// Min returns the smaller one of the two locations.
+func (c *LocationComparator) Min(location1, location2 Location) Location {
+	if c.Compare(location1, location2) <= 0 {
+		return location1
+	}
+	return location2
+}
+
+// Merge returns a clone of the bigger one of the two locations, and if GTID is compared, the transactions in the
+// GTID sets of the smaller one but not in the bigger one (like the ones generated by the server before the upstream
+// switched) are merged into it, so no executed transaction is lost when resuming from the merged location.
+func (c *LocationComparator) Merge(location1, location2 Location) (Location, error) {
+	bigger, smaller := location1, location2
+	if c.Compare(location1, location2) < 0 {
+		bigger, smaller = location2, location1
+	}
+	merged := bigger.Clone()
+	if !c.cmpGTID || isEmptyGTIDSet(smaller.gtidSet) {
+		return merged, nil
+	}
+	if isEmptyGTIDSet(merged.gtidSet) {
+		// the bigger one is recorded without GTID, keep it as is rather than pairing its position with an older GTID set.
+		return merged, nil
+	}
+	gset, err := gtid.Merge(merged.gtidSet, smaller.gtidSet)
+	if err != nil {
+		return Location{}, terror.Annotatef(err, "merge GTID set %s into %s", smaller.gtidSet, merged.gtidSet)
+	}
+	merged.gtidSet = gset
+	return merged, nil
+}
+
+func isEmptyGTIDSet(gSet gtid.Set) bool {
+	return gSet == nil || len(gSet.String()) == 0
+}
+
+// newestServerInGTIDSet returns the index of the newest UUID in `uuids` whose server generates transactions in
+// `gSet`, or -1 if there isn't one.
+func newestServerInGTIDSet(gSet gtid.Set, uuids []string) int {
+	for i := len(uuids) - 1; i >= 0; i-- {
+		uuid, _, err := utils.ParseSuffixForUUID(uuids[i])
+		if err != nil {
+			continue
+		}
+		if gtidSetHasServer(gSet.Origin(), uuid) {
+			return i
+		}
+	}
+	return -1
+}
+
+// gtidSetHasServer returns whether the server with `serverUUID` generates transactions in `gSet`, `serverUUID` is
+// the `server_uuid` for MySQL and the `gtid_domain_id` joined `server_id` for MariaDB, see utils.GetServerUUID.
+func gtidSetHasServer(gSet gmysql.GTIDSet, serverUUID string) bool {
+	switch set := gSet.(type) {
+	case *gmysql.MysqlGTIDSet:
+		_, ok := set.Sets[serverUUID]
+		return ok
+	case *gmysql.MariadbGTIDSet:
+		for _, g := range set.Sets {
+			if fmt.Sprintf("%d-%d", g.DomainID, g.ServerID) == serverUUID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // IsFreshPosition returns true when location1 is a fresh location without any info.
 func IsFreshPosition(location1 Location, flavor string, cmpGTID bool) bool {
 	location2 := NewLocation(flavor)
@@ -685,6 +685,60 @@ func (t *testPositionSuite) TestCompareCompareLocation(c *C) {
 	}
 }
 
+func (t *testPositionSuite) TestLocationComparator(c *C) {
+	var (
+		uuid1 = "3ccc475b-2343-11e7-be21-6c0b84d59f30"
+		uuid2 = "53ea0ed1-9bf8-11e6-8bea-64006a897c73"
+		pos1  = gmysql.Position{Name: "mysql-bin.000002", Pos: 1000}
+		pos2  = gmysql.Position{Name: "mysql-bin.000003", Pos: 4}
+	)
+	newLoc := func(pos gmysql.Position, gsetStr string) Location {
+		gset, err := gtid.ParserGTID(gmysql.MySQLFlavor, gsetStr)
+		c.Assert(err, IsNil)
+		return InitLocation(pos, gset)
+	}
+
+	// the location saved before GTID enabled only has position, it's not treated as the smallest one
+	posOnly := newLoc(pos2, "")
+	withGTID := newLoc(pos1, uuid1+":1-10")
+	c.Assert(CompareLocation(posOnly, withGTID, true), Equals, -1)
+	cmp := NewLocationComparator(true, nil)
+	c.Assert(cmp.Compare(posOnly, withGTID), Equals, 1)
+	c.Assert(cmp.Compare(withGTID, posOnly), Equals, -1)
+	c.Assert(cmp.Max(posOnly, withGTID), DeepEquals, posOnly)
+	c.Assert(cmp.Min(posOnly, withGTID), DeepEquals, withGTID)
+
+	// GTID sets are compared if both have them
+	newer := newLoc(pos1, uuid1+":1-11")
+	c.Assert(cmp.Compare(withGTID, newer), Equals, -1)
+	c.Assert(NewLocationComparator(false, nil).Compare(withGTID, newer), Equals, 0)
+
+	// GTID sets can't be compared after the upstream switched, fall back to position without UUID index
+	switched := newLoc(pos1, uuid1+":1-5,"+uuid2+":1-3")
+	c.Assert(cmp.Compare(withGTID, switched), Equals, 0)
+
+	// the server of the newer sub directory in the UUID index generates the newer transactions
+	uuids := []string{uuid1 + ".000001", uuid2 + ".000002"}
+	cmp = NewLocationComparator(true, func() ([]string, error) { return uuids, nil })
+	c.Assert(cmp.Compare(withGTID, switched), Equals, -1)
+	c.Assert(cmp.Compare(switched, withGTID), Equals, 1)
+	uuids = []string{uuid2 + ".000001", uuid1 + ".000002"}
+	c.Assert(cmp.Compare(withGTID, switched), Equals, 0) // both contain transactions of the newest server
+
+	// merge
+	merged, err := cmp.Merge(withGTID, switched)
+	c.Assert(err, IsNil)
+	c.Assert(merged.Position, DeepEquals, pos1)
+	c.Assert(merged.GTIDSetStr(), Equals, uuid1+":1-10,"+uuid2+":1-3")
+	c.Assert(withGTID.GTIDSetStr(), Equals, uuid1+":1-10") // not changed
+	merged, err = cmp.Merge(posOnly, withGTID)
+	c.Assert(err, IsNil)
+	c.Assert(merged, DeepEquals, posOnly)
+	merged, err = cmp.Merge(newer, withGTID)
+	c.Assert(err, IsNil)
+	c.Assert(merged.GTIDSetStr(), Equals, uuid1+":1-11")
+}
+
 func (t *testPositionSuite) TestVerifyBinlogPos(c *C) {
 	cases := []struct {
 		input  string
@@ -15,6 +15,7 @@ package reader
 
 import (
 	"context"
+	"time"
 
 	gmysql "github.com/go-mysql-org/go-mysql/mysql"
 	"github.com/go-mysql-org/go-mysql/replication"
@@ -43,8 +44,48 @@ type Reader interface {
 	Status() interface{}
 }
 
-// Streamer provides the ability to get binlog event from remote server or local file.
-type Streamer interface {
+// EventGetter gets binlog events one by one, like the BinlogStreamer of go-mysql.
+type EventGetter interface {
 	// GetEvent returns binlog event
 	GetEvent(ctx context.Context) (*replication.BinlogEvent, error)
 }
+
+// Streamer provides the ability to get binlog event from remote server or local file.
+type Streamer interface {
+	EventGetter
+
+	// GetEventWithTimeout returns binlog event, it returns context.DeadlineExceeded if no event is got within `d`.
+	GetEventWithTimeout(d time.Duration) (*replication.BinlogEvent, error)
+
+	// TryGetEvent returns binlog event without blocking, it returns a nil event and nil error if no event is available now.
+	TryGetEvent() (*replication.BinlogEvent, error)
+}
+
+// NewStreamer wraps an EventGetter (like the BinlogStreamer of go-mysql) to a Streamer.
+// as the EventGetter can only be polled with a done context, TryGetEvent of the returned Streamer may miss an event
+// which is available at the same time, and the event will be returned by the next call.
+func NewStreamer(getter EventGetter) Streamer {
+	return &eventGetterStreamer{EventGetter: getter}
+}
+
+type eventGetterStreamer struct {
+	EventGetter
+}
+
+// GetEventWithTimeout implements Streamer.GetEventWithTimeout.
+func (s *eventGetterStreamer) GetEventWithTimeout(d time.Duration) (*replication.BinlogEvent, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+	return s.GetEvent(ctx)
+}
+
+// TryGetEvent implements Streamer.TryGetEvent.
+func (s *eventGetterStreamer) TryGetEvent() (*replication.BinlogEvent, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	e, err := s.GetEvent(ctx)
+	if err == context.Canceled {
+		return nil, nil
+	}
+	return e, err
+}
@@ -0,0 +1,82 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reader
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	. "github.com/pingcap/check"
+)
+
+var _ = Suite(&testStreamerSuite{})
+
+type testStreamerSuite struct{}
+
+// chanEventGetter gets events from a channel, and checks the context first like the BinlogStreamer of go-mysql.
+type chanEventGetter struct {
+	ch  chan *replication.BinlogEvent
+	err error
+}
+
+func (g *chanEventGetter) GetEvent(ctx context.Context) (*replication.BinlogEvent, error) {
+	if g.err != nil {
+		return nil, g.err
+	}
+	select {
+	case ev := <-g.ch:
+		return ev, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (t *testStreamerSuite) TestNewStreamer(c *C) {
+	getter := &chanEventGetter{ch: make(chan *replication.BinlogEvent, 1)}
+	s := NewStreamer(getter)
+
+	// no event
+	ev, err := s.TryGetEvent()
+	c.Assert(err, IsNil)
+	c.Assert(ev, IsNil)
+	ev, err = s.GetEventWithTimeout(10 * time.Millisecond)
+	c.Assert(err, Equals, context.DeadlineExceeded)
+	c.Assert(ev, IsNil)
+
+	expected := &replication.BinlogEvent{RawData: []byte{1}}
+	getter.ch <- expected
+	ev, err = s.GetEventWithTimeout(time.Second)
+	c.Assert(err, IsNil)
+	c.Assert(ev, Equals, expected)
+
+	// TryGetEvent may miss the event, but it's returned finally
+	getter.ch <- expected
+	ev = nil
+	for ev == nil {
+		ev, err = s.TryGetEvent()
+		c.Assert(err, IsNil)
+	}
+	c.Assert(ev, Equals, expected)
+
+	// errors are returned
+	getter.err = errors.New("streamer error")
+	ev, err = s.TryGetEvent()
+	c.Assert(err, Equals, getter.err)
+	c.Assert(ev, IsNil)
+	ev, err = s.GetEvent(context.Background())
+	c.Assert(err, Equals, getter.err)
+	c.Assert(ev, IsNil)
+}
@@ -31,12 +31,13 @@ import (
 )
 
 // GetGTIDsForPosFromStreamer tries to get GTID sets for the specified binlog position (for the corresponding txn) from a Streamer.
-func GetGTIDsForPosFromStreamer(ctx context.Context, r Streamer, endPos gmysql.Position) (gtid.Set, error) {
+func GetGTIDsForPosFromStreamer(ctx context.Context, r EventGetter, endPos gmysql.Position) (gtid.Set, error) {
 	var (
 		flavor      string
 		latestPos   uint32
 		latestGSet  gmysql.GTIDSet
 		nextGTIDStr string // can be recorded if the coming transaction completed
+		standalone  bool   // whether the coming MariaDB event group is standalone
 		err         error
 	)
 	for {
@@ -55,7 +56,7 @@ func GetGTIDsForPosFromStreamer(ctx context.Context, r Streamer, endPos gmysql.P
 			}
 
 			isDDL := parser.CheckIsDDL(string(ev.Query), parser2)
-			if isDDL {
+			if isDDL || standalone || event.IsCommitQuery(ev.Query) {
 				if latestGSet == nil {
 					// GTID not enabled, can't get GTIDs for the position.
 					return nil, errors.Errorf("should have a GTIDEvent before the DDL QueryEvent %+v", e.Header)
@@ -84,6 +85,7 @@ func GetGTIDsForPosFromStreamer(ctx context.Context, r Streamer, endPos gmysql.P
 			if err != nil {
 				return nil, err
 			}
+			standalone = false
 		case *replication.MariadbGTIDEvent:
 			if latestGSet == nil {
 				return nil, errors.Errorf("should have a MariadbGTIDListEvent before the MariadbGTIDEvent %+v", e.Header)
@@ -92,6 +94,8 @@ func GetGTIDsForPosFromStreamer(ctx context.Context, r Streamer, endPos gmysql.P
 			if err != nil {
 				return nil, err
 			}
+			// a standalone event group has only one QueryEvent without COMMIT or XIDEvent.
+			standalone = ev.IsStandalone()
 		case *replication.PreviousGTIDsEvent:
 			// if GTID enabled, we can get a PreviousGTIDEvent after the FormatDescriptionEvent
 			// ref: https://github.com/mysql/mysql-server/blob/8cc757da3d87bf4a1f07dcfb2d3c96fed3806870/sql/binlog.cc#L4549
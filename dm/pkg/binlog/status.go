@@ -95,6 +95,16 @@ func (b FileSizes) After(fromFile gmysql.Position) int64 {
 	return total
 }
 
+// Contains returns whether the binlog file of `pos` is in FileSizes, the binlog from `pos` is purged if it's not.
+func (b FileSizes) Contains(pos gmysql.Position) bool {
+	for _, file := range b {
+		if file.name == pos.Name {
+			return file.size >= int64(pos.Pos)
+		}
+	}
+	return false
+}
+
 func GetLocalBinaryLogs(dir string) (FileSizes, error) {
 	fileNames, err := ReadSortedBinlogFromDir(dir)
 	if err != nil {
@@ -109,3 +109,17 @@ func (t *testStatusSuite) TestBinlogSizesAfter(c *C) {
 		c.Assert(sizes.After(ca.position), Equals, ca.expected)
 	}
 }
+
+func (t *testStatusSuite) TestBinlogSizesContains(c *C) {
+	sizes := FileSizes{
+		{name: "mysql-bin.000002", size: 100},
+		{name: "mysql-bin.000003", size: 200},
+	}
+
+	c.Assert(sizes.Contains(gmysql.Position{Name: "mysql-bin.000002", Pos: 4}), IsTrue)
+	c.Assert(sizes.Contains(gmysql.Position{Name: "mysql-bin.000003", Pos: 200}), IsTrue)
+	// the position is beyond the end of the file
+	c.Assert(sizes.Contains(gmysql.Position{Name: "mysql-bin.000003", Pos: 201}), IsFalse)
+	// the file is purged
+	c.Assert(sizes.Contains(gmysql.Position{Name: "mysql-bin.000001", Pos: 4}), IsFalse)
+}
@@ -0,0 +1,161 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+)
+
+// CharsetChecker checks the tables matched by the charset rules, the charset of their columns in upstream should be
+// the from-charset of the rule, and the to-charset and to-collation should be supported by downstream.
+type CharsetChecker struct {
+	sourceDB     *sql.DB
+	sourceDBInfo *dbutil.DBConfig
+	targetDB     *sql.DB
+	targetDBInfo *dbutil.DBConfig
+	// schema => table => rule
+	tables map[string]map[string]*config.CharsetRule
+}
+
+// NewCharsetChecker returns a RealChecker.
+func NewCharsetChecker(sourceDB *sql.DB, sourceDBInfo *dbutil.DBConfig, targetDB *sql.DB, targetDBInfo *dbutil.DBConfig,
+	tables map[string]map[string]*config.CharsetRule) RealChecker {
+	return &CharsetChecker{
+		sourceDB:     sourceDB,
+		sourceDBInfo: sourceDBInfo,
+		targetDB:     targetDB,
+		targetDBInfo: targetDBInfo,
+		tables:       tables,
+	}
+}
+
+// Check implements the RealChecker interface.
+func (c *CharsetChecker) Check(ctx context.Context) *Result {
+	r := &Result{
+		Name:  c.Name(),
+		Desc:  "check whether the charset rules are compatible with upstream and downstream",
+		State: StateSuccess,
+		Extra: fmt.Sprintf("address of source db - %s:%d, address of target db - %s:%d",
+			c.sourceDBInfo.Host, c.sourceDBInfo.Port, c.targetDBInfo.Host, c.targetDBInfo.Port),
+	}
+
+	schemas := make([]string, 0, len(c.tables))
+	charsets := make(map[string]struct{})
+	collations := make(map[string]struct{})
+	for schema, tables := range c.tables {
+		schemas = append(schemas, schema)
+		for _, rule := range tables {
+			charsets[rule.ToCharset] = struct{}{}
+			if rule.ToCollation != "" {
+				collations[rule.ToCollation] = struct{}{}
+			}
+		}
+	}
+	sort.Strings(schemas)
+
+	for _, cs := range sortedKeys(charsets) {
+		supported, err := c.supported(ctx, "SELECT COUNT(*) FROM information_schema.CHARACTER_SETS WHERE CHARACTER_SET_NAME = ?", cs)
+		if err != nil {
+			markCheckError(r, err)
+			return r
+		}
+		if !supported {
+			r.State = StateFailure
+			e := NewError("charset %s is not supported by downstream", cs)
+			e.Instruction = "please use a charset supported by downstream as to-charset of the charset rules"
+			r.Errors = append(r.Errors, e)
+		}
+	}
+	for _, collation := range sortedKeys(collations) {
+		supported, err := c.supported(ctx, "SELECT COUNT(*) FROM information_schema.COLLATIONS WHERE COLLATION_NAME = ?", collation)
+		if err != nil {
+			markCheckError(r, err)
+			return r
+		}
+		if !supported {
+			r.State = StateFailure
+			e := NewError("collation %s is not supported by downstream", collation)
+			e.Instruction = "please use a collation supported by downstream as to-collation of the charset rules"
+			r.Errors = append(r.Errors, e)
+		}
+	}
+
+	for _, schema := range schemas {
+		if err := c.checkSchema(ctx, schema, r); err != nil {
+			markCheckError(r, err)
+			return r
+		}
+	}
+	return r
+}
+
+func (c *CharsetChecker) supported(ctx context.Context, query, name string) (bool, error) {
+	var count int
+	if err := c.targetDB.QueryRowContext(ctx, query, name).Scan(&count); err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// checkSchema warns the text columns of the matched tables whose charset is not the from-charset of the rule.
+func (c *CharsetChecker) checkSchema(ctx context.Context, schema string, r *Result) error {
+	rows, err := c.sourceDB.QueryContext(ctx, "SELECT TABLE_NAME, COLUMN_NAME, CHARACTER_SET_NAME FROM information_schema.COLUMNS "+
+		"WHERE TABLE_SCHEMA = ? AND CHARACTER_SET_NAME IS NOT NULL ORDER BY TABLE_NAME, ORDINAL_POSITION", schema)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	tables := c.tables[schema]
+	for rows.Next() {
+		var table, column, cs string
+		if err = rows.Scan(&table, &column, &cs); err != nil {
+			return err
+		}
+		rule, ok := tables[table]
+		if !ok || strings.EqualFold(cs, rule.FromCharset) {
+			continue
+		}
+		if r.State != StateFailure {
+			r.State = StateWarning
+		}
+		e := NewWarn("column %s of table %s is %s, but the from-charset of the charset rule is %s",
+			column, dbutil.TableName(schema, table), cs, rule.FromCharset)
+		e.Instruction = "the values of the column will be transcoded from the from-charset, please check the charset rules"
+		r.Errors = append(r.Errors, e)
+	}
+	return rows.Err()
+}
+
+// Name implements the RealChecker interface.
+func (c *CharsetChecker) Name() string {
+	return "charset"
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
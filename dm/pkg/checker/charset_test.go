@@ -0,0 +1,65 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"context"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	tc "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+)
+
+func (t *testCheckSuite) TestCharsetChecker(c *tc.C) {
+	sourceDB, sourceMock, err := sqlmock.New()
+	c.Assert(err, tc.IsNil)
+	targetDB, targetMock, err := sqlmock.New()
+	c.Assert(err, tc.IsNil)
+	ctx := context.Background()
+
+	rule := &config.CharsetRule{SchemaPattern: "db", FromCharset: config.CharsetLatin1, ToCharset: config.CharsetUTF8MB4}
+	checker := NewCharsetChecker(sourceDB, &dbutil.DBConfig{}, targetDB, &dbutil.DBConfig{},
+		map[string]map[string]*config.CharsetRule{"db": {"t1": rule, "t2": rule}})
+
+	// 1. success
+	targetMock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM information_schema.CHARACTER_SETS").WithArgs("utf8mb4").
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT(*)"}).AddRow(1))
+	sourceMock.ExpectQuery("SELECT TABLE_NAME, COLUMN_NAME, CHARACTER_SET_NAME FROM information_schema.COLUMNS").WithArgs("db").
+		WillReturnRows(sqlmock.NewRows([]string{"TABLE_NAME", "COLUMN_NAME", "CHARACTER_SET_NAME"}).
+			AddRow("t1", "c1", "latin1").AddRow("t2", "c1", "latin1").AddRow("t3", "c1", "gbk"))
+	result := checker.Check(ctx)
+	c.Assert(result.State, tc.Equals, StateSuccess)
+	c.Assert(sourceMock.ExpectationsWereMet(), tc.IsNil)
+	c.Assert(targetMock.ExpectationsWereMet(), tc.IsNil)
+
+	// 2. the column of other charset is warned, the unsupported collation is failed
+	rule.ToCollation = "utf8mb4_0900_ai_ci"
+	targetMock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM information_schema.CHARACTER_SETS").WithArgs("utf8mb4").
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT(*)"}).AddRow(1))
+	targetMock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM information_schema.COLLATIONS").WithArgs("utf8mb4_0900_ai_ci").
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT(*)"}).AddRow(0))
+	sourceMock.ExpectQuery("SELECT TABLE_NAME, COLUMN_NAME, CHARACTER_SET_NAME FROM information_schema.COLUMNS").WithArgs("db").
+		WillReturnRows(sqlmock.NewRows([]string{"TABLE_NAME", "COLUMN_NAME", "CHARACTER_SET_NAME"}).
+			AddRow("t1", "c1", "latin1").AddRow("t2", "c2", "utf8mb4"))
+	result = checker.Check(ctx)
+	c.Assert(result.State, tc.Equals, StateFailure)
+	c.Assert(result.Errors, tc.HasLen, 2)
+	c.Assert(result.Errors[0].ShortErr, tc.Equals, "collation utf8mb4_0900_ai_ci is not supported by downstream")
+	c.Assert(result.Errors[1].Severity, tc.Equals, StateWarning)
+	c.Assert(result.Errors[1].ShortErr, tc.Equals, "column c2 of table `db`.`t2` is utf8mb4, but the from-charset of the charset rule is latin1")
+	c.Assert(sourceMock.ExpectationsWereMet(), tc.IsNil)
+	c.Assert(targetMock.ExpectationsWereMet(), tc.IsNil)
+}
@@ -16,7 +16,9 @@ package checker
 import (
 	"context"
 	"database/sql"
+	"sort"
 
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
 	"github.com/pingcap/tidb-tools/pkg/filter"
 
 	"github.com/pingcap/tiflow/dm/pkg/utils"
@@ -78,3 +80,64 @@ func (c *OnlineDDLChecker) Check(ctx context.Context) *Result {
 func (c *OnlineDDLChecker) Name() string {
 	return "online ddl checker"
 }
+
+// OnlineDDLRulesChecker checks the tables to migrate against the shadow-table-rules and trash-table-rules. the changes
+// of the tables matched by the rules are handled as the ghost or trash tables of online DDL and not migrated, so a
+// table matched by the rules is reported unless its real table exists, which means the online DDL is in progress and
+// it's checked by OnlineDDLChecker.
+type OnlineDDLRulesChecker struct {
+	onlineDDL onlineddl.OnlinePlugin
+	// schema => [tables]
+	checkTables map[string][]string
+}
+
+// NewOnlineDDLRulesChecker returns a RealChecker.
+func NewOnlineDDLRulesChecker(checkTables map[string][]string, onlineDDL onlineddl.OnlinePlugin) RealChecker {
+	return &OnlineDDLRulesChecker{
+		onlineDDL:   onlineDDL,
+		checkTables: checkTables,
+	}
+}
+
+// Check implements the RealChecker interface.
+func (c *OnlineDDLRulesChecker) Check(ctx context.Context) *Result {
+	r := &Result{
+		Name:  c.Name(),
+		Desc:  "check whether the shadow-table-rules and trash-table-rules match the tables to migrate",
+		State: StateSuccess,
+		Extra: "online ddl",
+	}
+
+	schemas := make([]string, 0, len(c.checkTables))
+	for schema := range c.checkTables {
+		schemas = append(schemas, schema)
+	}
+	sort.Strings(schemas)
+	for _, schema := range schemas {
+		tables := make(map[string]struct{}, len(c.checkTables[schema]))
+		for _, table := range c.checkTables[schema] {
+			tables[table] = struct{}{}
+		}
+		for _, table := range c.checkTables[schema] {
+			tp := c.onlineDDL.TableType(table)
+			if tp == onlineddl.RealTable {
+				continue
+			}
+			if _, ok := tables[c.onlineDDL.RealName(table)]; ok {
+				continue
+			}
+			r.State = StateWarning
+			r.Errors = append(r.Errors, NewWarn("table %s is matched as the %s of online DDL, its changes will not be migrated",
+				dbutil.TableName(schema, table), tp))
+		}
+	}
+	if r.State != StateSuccess {
+		r.Instruction = "please adjust `shadow-table-rules` and `trash-table-rules` to match only the ghost and trash tables of the online DDL tool, or exclude the tables by block-allow-list"
+	}
+	return r
+}
+
+// Name implements the RealChecker interface.
+func (c *OnlineDDLRulesChecker) Name() string {
+	return "online ddl rules checker"
+}
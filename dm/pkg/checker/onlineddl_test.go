@@ -0,0 +1,72 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"context"
+	"regexp"
+
+	tc "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	onlineddl "github.com/pingcap/tiflow/dm/syncer/online-ddl-tools"
+)
+
+// mockOnlinePlugin matches the tables by the rules like onlineddl.RealOnlinePlugin, other methods are not implemented.
+type mockOnlinePlugin struct {
+	onlineddl.OnlinePlugin
+	shadowReg *regexp.Regexp
+	trashReg  *regexp.Regexp
+}
+
+func (p *mockOnlinePlugin) TableType(table string) onlineddl.TableType {
+	switch {
+	case p.shadowReg.MatchString(table):
+		return onlineddl.GhostTable
+	case p.trashReg.MatchString(table):
+		return onlineddl.TrashTable
+	default:
+		return onlineddl.RealTable
+	}
+}
+
+func (p *mockOnlinePlugin) RealName(table string) string {
+	for _, reg := range []*regexp.Regexp{p.shadowReg, p.trashReg} {
+		if res := reg.FindStringSubmatch(table); len(res) > 1 {
+			return res[1]
+		}
+	}
+	return table
+}
+
+func (t *testCheckSuite) TestOnlineDDLRulesChecker(c *tc.C) {
+	plugin := &mockOnlinePlugin{
+		shadowReg: regexp.MustCompile(config.DefaultShadowTableRules),
+		trashReg:  regexp.MustCompile(config.DefaultTrashTableRules),
+	}
+
+	// the ghost table of an online DDL in progress is not reported
+	checker := NewOnlineDDLRulesChecker(map[string][]string{"db": {"t1", "_t1_gho", "t2"}}, plugin)
+	result := checker.Check(context.Background())
+	c.Assert(result.State, tc.Equals, StateSuccess)
+
+	// the tables matched by the rules without real tables are reported
+	checker = NewOnlineDDLRulesChecker(map[string][]string{"db": {"t1", "_orders_new"}, "db2": {"_log_old"}}, plugin)
+	result = checker.Check(context.Background())
+	c.Assert(result.State, tc.Equals, StateWarning)
+	c.Assert(result.Errors, tc.HasLen, 2)
+	c.Assert(result.Errors[0].ShortErr, tc.Equals, "table `db`.`_orders_new` is matched as the ghost table of online DDL, its changes will not be migrated")
+	c.Assert(result.Errors[1].ShortErr, tc.Equals, "table `db2`.`_log_old` is matched as the trash table of online DDL, its changes will not be migrated")
+	c.Assert(result.Instruction, tc.Not(tc.Equals), "")
+}
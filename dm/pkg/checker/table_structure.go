@@ -18,6 +18,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/pingcap/errors"
@@ -27,6 +28,9 @@ import (
 	"github.com/pingcap/tidb/parser/charset"
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/mysql"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/router"
 )
 
 // AutoIncrementKeyChecking is an identification for auto increment key checking.
@@ -269,7 +273,8 @@ func (c *TablesChecker) checkTableOption(opt *ast.TableOption) *incompatibilityO
 
 // ShardingTablesChecker checks consistency of table structures of one sharding group
 // * check whether they have same column list
-// * check whether they have auto_increment key.
+// * check whether they have auto_increment key, and whether the conflicts of the keys are handled by
+// `auto-id-conflict-strategy`.
 type ShardingTablesChecker struct {
 	name string
 
@@ -277,19 +282,31 @@ type ShardingTablesChecker struct {
 	tables                       map[string]map[string][]string // instance => {schema: [table1, table2, ...]}
 	mapping                      map[string]*column.Mapping
 	checkAutoIncrementPrimaryKey bool
+	autoIDConflictStrategy       string
+	routers                      map[string]*router.Table // instance => table router, used to find the extra columns
 }
 
 // NewShardingTablesChecker returns a RealChecker.
-func NewShardingTablesChecker(name string, dbs map[string]*sql.DB, tables map[string]map[string][]string, mapping map[string]*column.Mapping, checkAutoIncrementPrimaryKey bool) RealChecker {
+func NewShardingTablesChecker(name string, dbs map[string]*sql.DB, tables map[string]map[string][]string, mapping map[string]*column.Mapping, checkAutoIncrementPrimaryKey bool, autoIDConflictStrategy string, routers map[string]*router.Table) RealChecker {
 	return &ShardingTablesChecker{
 		name:                         name,
 		dbs:                          dbs,
 		tables:                       tables,
 		mapping:                      mapping,
 		checkAutoIncrementPrimaryKey: checkAutoIncrementPrimaryKey,
+		autoIDConflictStrategy:       autoIDConflictStrategy,
+		routers:                      routers,
 	}
 }
 
+// autoIDRange is the value range of an auto-increment key of a shard table.
+type autoIDRange struct {
+	instance string
+	table    string
+	column   string
+	min, max int64
+}
+
 // Check implements RealChecker interface.
 func (c *ShardingTablesChecker) Check(ctx context.Context) *Result {
 	r := &Result{
@@ -303,6 +320,7 @@ func (c *ShardingTablesChecker) Check(ctx context.Context) *Result {
 		stmtNode      *ast.CreateTableStmt
 		firstTable    string
 		firstInstance string
+		ranges        []autoIDRange
 	)
 
 	for instance, schemas := range c.tables {
@@ -353,9 +371,19 @@ func (c *ShardingTablesChecker) Check(ctx context.Context) *Result {
 				}
 
 				if c.checkAutoIncrementPrimaryKey {
-					passed := c.checkAutoIncrementKey(instance, schema, table, ctStmt, info, r)
-					if !passed {
-						return r
+					if c.autoIDConflictStrategy == config.AutoIDConflictCheck {
+						tableRanges, err := c.fetchAutoIDRanges(ctx, db, instance, schema, table, ctStmt, info)
+						if err != nil {
+							markCheckError(r, err)
+							r.Extra = fmt.Sprintf("instance %s on sharding %s", instance, c.name)
+							return r
+						}
+						ranges = append(ranges, tableRanges...)
+					} else {
+						passed := c.checkAutoIncrementKey(instance, schema, table, ctStmt, info, r)
+						if !passed {
+							return r
+						}
 					}
 				}
 
@@ -378,12 +406,92 @@ func (c *ShardingTablesChecker) Check(ctx context.Context) *Result {
 		}
 	}
 
+	c.checkAutoIDRanges(ranges, r)
 	return r
 }
 
+// fetchAutoIDRanges returns the value ranges of the auto-increment keys of the table, the keys of empty tables are
+// skipped.
+func (c *ShardingTablesChecker) fetchAutoIDRanges(ctx context.Context, db *sql.DB, instance, schema, table string, ctStmt *ast.CreateTableStmt, info *model.TableInfo) ([]autoIDRange, error) {
+	autoIncrementKeys := c.findAutoIncrementKey(ctStmt, info)
+	ranges := make([]autoIDRange, 0, len(autoIncrementKeys))
+	for columnName := range autoIncrementKeys {
+		var minID, maxID sql.NullInt64
+		query := fmt.Sprintf("SELECT MIN(%[1]s), MAX(%[1]s) FROM %[2]s", dbutil.ColumnName(columnName), dbutil.TableName(schema, table))
+		if err := db.QueryRowContext(ctx, query).Scan(&minID, &maxID); err != nil {
+			return nil, errors.Annotatef(err, "statement %s", query)
+		}
+		if !minID.Valid || !maxID.Valid {
+			continue
+		}
+		ranges = append(ranges, autoIDRange{
+			instance: instance,
+			table:    dbutil.TableName(schema, table),
+			column:   columnName,
+			min:      minID.Int64,
+			max:      maxID.Int64,
+		})
+	}
+	return ranges, nil
+}
+
+// checkAutoIDRanges checks whether the value ranges of the auto-increment keys of the shard tables overlap, the keys
+// in the overlapped ranges may conflict after the tables are merged.
+func (c *ShardingTablesChecker) checkAutoIDRanges(ranges []autoIDRange, r *Result) {
+	byColumn := make(map[string][]autoIDRange)
+	for _, rg := range ranges {
+		byColumn[rg.column] = append(byColumn[rg.column], rg)
+	}
+	columns := make([]string, 0, len(byColumn))
+	for col := range byColumn {
+		columns = append(columns, col)
+	}
+	sort.Strings(columns)
+
+	for _, col := range columns {
+		colRanges := byColumn[col]
+		sort.Slice(colRanges, func(i, j int) bool { return colRanges[i].min < colRanges[j].min })
+		for i := 1; i < len(colRanges); i++ {
+			prev, cur := colRanges[i-1], colRanges[i]
+			if cur.min > prev.max {
+				continue
+			}
+			r.State = StateFailure
+			r.Errors = append(r.Errors, NewError("auto-increment key %s of instance %s table %s [%d, %d] overlaps with instance %s table %s [%d, %d] of sharding %s",
+				col, prev.instance, prev.table, prev.min, prev.max, cur.instance, cur.table, cur.min, cur.max, c.name))
+			r.Instruction = "please use another auto-id-conflict-strategy, or make the auto-increment keys of the sharding tables not overlap"
+			r.Extra = AutoIncrementKeyChecking
+			return
+		}
+	}
+}
+
 func (c *ShardingTablesChecker) checkAutoIncrementKey(instance, schema, table string, ctStmt *ast.CreateTableStmt, info *model.TableInfo, r *Result) bool {
 	autoIncrementKeys := c.findAutoIncrementKey(ctStmt, info)
 	for columnName, isBigInt := range autoIncrementKeys {
+		switch c.autoIDConflictStrategy {
+		case config.AutoIDConflictSourcePrefix:
+			if !isBigInt {
+				r.State = StateFailure
+				r.Errors = append(r.Errors, NewError("instance %s table `%s`.`%s` of sharding %s have auto-increment key %s, but type of %s should be bigint to be prefixed", instance, schema, table, c.name, columnName, columnName))
+				r.Instruction = "please set auto-increment key type to bigint"
+				r.Extra = AutoIncrementKeyChecking
+				return false
+			}
+			continue
+		case config.AutoIDConflictExtraColumn:
+			if rt, ok := c.routers[instance]; ok {
+				if extendCol, _ := rt.FetchExtendColumn(schema, table, instance); len(extendCol) > 0 {
+					continue
+				}
+			}
+			r.State = StateFailure
+			r.Errors = append(r.Errors, NewError("instance %s table `%s`.`%s` of sharding %s have auto-increment key %s, but no extra column is extracted by route rules", instance, schema, table, c.name, columnName))
+			r.Instruction = "please add table, schema or source extractors to the route rule of the table, or set source-tag-column"
+			r.Extra = AutoIncrementKeyChecking
+			return false
+		}
+
 		hasMatchedRule := false
 		if cm, ok1 := c.mapping[instance]; ok1 {
 			ruleSet := cm.Selector.Match(schema, table)
@@ -22,6 +22,9 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	tc "github.com/pingcap/check"
 	"github.com/pingcap/tidb-tools/pkg/dbutil"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/router"
 )
 
 func (t *testCheckSuite) TestShardingTablesChecker(c *tc.C) {
@@ -57,7 +60,9 @@ func (t *testCheckSuite) TestShardingTablesChecker(c *tc.C) {
 		map[string]*sql.DB{"test-source": db},
 		map[string]map[string][]string{"test-source": {"test-db": []string{"test-table-1", "test-table-2"}}},
 		nil,
-		false)
+		false,
+		"",
+		nil)
 	result := checker.Check(ctx)
 
 	c.Assert(result.State, tc.Equals, StateSuccess)
@@ -114,6 +119,90 @@ func (t *testCheckSuite) TestShardingTablesChecker(c *tc.C) {
 	printJSON(result)
 }
 
+func (t *testCheckSuite) TestShardingTablesCheckerAutoIDConflictStrategy(c *tc.C) {
+	db, mock, err := sqlmock.New()
+	c.Assert(err, tc.IsNil)
+	ctx := context.Background()
+
+	// the check returns at the first failed table
+	expectCreateTables := func(tp string, n int) {
+		sqlModeRow := sqlmock.NewRows([]string{"Variable_name", "Value"}).
+			AddRow("sql_mode", "ANSI_QUOTES")
+		mock.ExpectQuery("SHOW VARIABLES LIKE 'sql_mode'").WillReturnRows(sqlModeRow)
+		for _, table := range []string{"test-table-1", "test-table-2"}[:n] {
+			createTableRow := sqlmock.NewRows([]string{"Table", "Create Table"}).
+				AddRow(table, fmt.Sprintf(`CREATE TABLE "%s" (
+  "id" %s NOT NULL AUTO_INCREMENT,
+  UNIQUE KEY "id" ("id")
+) ENGINE=InnoDB DEFAULT CHARSET=latin1`, table, tp))
+			mock.ExpectQuery(fmt.Sprintf("SHOW CREATE TABLE `test-db`.`%s`", table)).WillReturnRows(createTableRow)
+		}
+	}
+	dbs := map[string]*sql.DB{"test-source": db}
+	tables := map[string]map[string][]string{"test-source": {"test-db": []string{"test-table-1", "test-table-2"}}}
+
+	// 1. the auto-increment keys should be bigint to be prefixed
+	checker := NewShardingTablesChecker("test-name", dbs, tables, nil, true, config.AutoIDConflictSourcePrefix, nil)
+	expectCreateTables("bigint(20)", 2)
+	result := checker.Check(ctx)
+	c.Assert(result.State, tc.Equals, StateSuccess)
+	expectCreateTables("int(11)", 1)
+	result = checker.Check(ctx)
+	c.Assert(result.State, tc.Equals, StateFailure)
+	c.Assert(result.Errors, tc.HasLen, 1)
+	c.Assert(mock.ExpectationsWereMet(), tc.IsNil)
+
+	// 2. the extra columns should be extracted by route rules
+	r, err := router.NewTableRouter(false, []*router.TableRule{{
+		SchemaPattern: "test-db", TargetSchema: "db", TablePattern: "test-table-*", TargetTable: "tbl",
+		TableExtractor: &router.TableExtractor{TargetColumn: "c_table", TableRegexp: "test-table-(.*)"},
+	}})
+	c.Assert(err, tc.IsNil)
+	checker = NewShardingTablesChecker("test-name", dbs, tables, nil, true, config.AutoIDConflictExtraColumn,
+		map[string]*router.Table{"test-source": r})
+	expectCreateTables("int(11)", 2)
+	result = checker.Check(ctx)
+	c.Assert(result.State, tc.Equals, StateSuccess)
+	checker = NewShardingTablesChecker("test-name", dbs, tables, nil, true, config.AutoIDConflictExtraColumn, nil)
+	expectCreateTables("int(11)", 1)
+	result = checker.Check(ctx)
+	c.Assert(result.State, tc.Equals, StateFailure)
+	c.Assert(mock.ExpectationsWereMet(), tc.IsNil)
+
+	// 3. the value ranges of the auto-increment keys shouldn't overlap
+	expectRanges := func(ranges ...[]int64) {
+		sqlModeRow := sqlmock.NewRows([]string{"Variable_name", "Value"}).
+			AddRow("sql_mode", "ANSI_QUOTES")
+		mock.ExpectQuery("SHOW VARIABLES LIKE 'sql_mode'").WillReturnRows(sqlModeRow)
+		for i, table := range []string{"test-table-1", "test-table-2"} {
+			createTableRow := sqlmock.NewRows([]string{"Table", "Create Table"}).
+				AddRow(table, fmt.Sprintf(`CREATE TABLE "%s" (
+  "id" int(11) NOT NULL AUTO_INCREMENT,
+  UNIQUE KEY "id" ("id")
+) ENGINE=InnoDB DEFAULT CHARSET=latin1`, table))
+			mock.ExpectQuery(fmt.Sprintf("SHOW CREATE TABLE `test-db`.`%s`", table)).WillReturnRows(createTableRow)
+			rangeRow := sqlmock.NewRows([]string{"MIN(`id`)", "MAX(`id`)"}).AddRow(nil, nil)
+			if ranges[i] != nil {
+				rangeRow = sqlmock.NewRows([]string{"MIN(`id`)", "MAX(`id`)"}).AddRow(ranges[i][0], ranges[i][1])
+			}
+			mock.ExpectQuery(fmt.Sprintf("SELECT MIN\\(`id`\\), MAX\\(`id`\\) FROM `test-db`.`%s`", table)).WillReturnRows(rangeRow)
+		}
+	}
+	checker = NewShardingTablesChecker("test-name", dbs, tables, nil, true, config.AutoIDConflictCheck, nil)
+	expectRanges([]int64{1, 100}, []int64{101, 200})
+	result = checker.Check(ctx)
+	c.Assert(result.State, tc.Equals, StateSuccess)
+	expectRanges([]int64{1, 100}, nil)
+	result = checker.Check(ctx)
+	c.Assert(result.State, tc.Equals, StateSuccess)
+	expectRanges([]int64{50, 150}, []int64{1, 100})
+	result = checker.Check(ctx)
+	c.Assert(result.State, tc.Equals, StateFailure)
+	c.Assert(result.Errors, tc.HasLen, 1)
+	c.Assert(result.Extra, tc.Equals, AutoIncrementKeyChecking)
+	c.Assert(mock.ExpectationsWereMet(), tc.IsNil)
+}
+
 func (t *testCheckSuite) TestTablesChecker(c *tc.C) {
 	db, mock, err := sqlmock.New()
 	c.Assert(err, tc.IsNil)
@@ -14,6 +14,7 @@
 package conn
 
 import (
+	"context"
 	"database/sql"
 	"database/sql/driver"
 	"fmt"
@@ -72,6 +73,10 @@ type BaseConn struct {
 	DBConn *sql.Conn
 
 	RetryStrategy retry.Strategy
+
+	// StmtCache caches the prepared statements of DBConn if it's not nil, the statements with arguments are executed
+	// by them to reuse the server-side prepares.
+	StmtCache *StmtCache
 }
 
 // NewBaseConn builds BaseConn to connect real DB.
@@ -79,7 +84,7 @@ func NewBaseConn(conn *sql.Conn, strategy retry.Strategy) *BaseConn {
 	if strategy == nil {
 		strategy = &retry.FiniteRetryStrategy{}
 	}
-	return &BaseConn{conn, strategy}
+	return &BaseConn{DBConn: conn, RetryStrategy: strategy}
 }
 
 // SetRetryStrategy set retry strategy for baseConn.
@@ -167,7 +172,7 @@ func (conn *BaseConn) ExecuteSQLWithIgnoreError(tctx *tcontext.Context, hVec *me
 		}
 
 		startTime = time.Now()
-		_, err = txn.ExecContext(tctx.Context(), query, arg...)
+		err = conn.exec(tctx, txn, query, arg)
 		if err == nil {
 			if hVec != nil {
 				hVec.WithLabelValues("stmt", task).Observe(time.Since(startTime).Seconds())
@@ -210,6 +215,85 @@ func (conn *BaseConn) ExecuteSQLWithIgnoreError(tctx *tcontext.Context, hVec *me
 	return l, nil
 }
 
+// ExecuteSQLAutocommit executes the statements one by one in autocommit mode rather than in one transaction, so the
+// statements executed before the failed one are committed.
+// return
+// 1. failed: (the index of sqls executed error, error)
+// 2. succeed: (len(sqls), nil).
+func (conn *BaseConn) ExecuteSQLAutocommit(tctx *tcontext.Context, hVec *metricsproxy.HistogramVecProxy, task string, ignoreErr func(error) bool, queries []string, args ...[]interface{}) (int, error) {
+	if len(queries) == 0 {
+		return 0, nil
+	}
+	if conn == nil || conn.DBConn == nil {
+		return 0, terror.ErrDBUnExpect.Generate("database connection not valid")
+	}
+
+	for i, query := range queries {
+		var arg []interface{}
+		if len(args) > i {
+			arg = args[i]
+		}
+
+		if tctx.L().Core().Enabled(zap.DebugLevel) {
+			tctx.L().Debug("execute statement in autocommit mode",
+				zap.String("query", utils.TruncateString(query, -1)),
+				zap.String("argument", utils.TruncateInterface(arg, -1)))
+		}
+
+		startTime := time.Now()
+		err := conn.exec(tctx, conn.DBConn, query, arg)
+		if err == nil {
+			if hVec != nil {
+				hVec.WithLabelValues("stmt", task).Observe(time.Since(startTime).Seconds())
+			}
+			continue
+		}
+		if ignoreErr != nil && ignoreErr(err) {
+			tctx.L().Warn("execute statement failed and will ignore this error",
+				zap.String("query", utils.TruncateString(query, -1)),
+				zap.String("argument", utils.TruncateInterface(arg, -1)),
+				log.ShortError(err))
+			continue
+		}
+		tctx.L().ErrorFilterContextCanceled("execute statement failed",
+			zap.String("query", utils.TruncateString(query, -1)),
+			zap.String("argument", utils.TruncateInterface(arg, -1)), log.ShortError(err))
+		return i, terror.ErrDBExecuteFailed.Delegate(err, utils.TruncateString(query, -1))
+	}
+	return len(queries), nil
+}
+
+// execer executes statements, it's implemented by sql.Tx and sql.Conn.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// exec executes a statement by e, with the cached prepared statement if StmtCache is enabled.
+func (conn *BaseConn) exec(tctx *tcontext.Context, e execer, query string, arg []interface{}) error {
+	if conn.StmtCache == nil || len(arg) == 0 {
+		_, err := e.ExecContext(tctx.Context(), query, arg...)
+		return err
+	}
+
+	prepared := false
+	// e runs on DBConn, so the statements prepared on the driver connection are executed by e.
+	err := conn.DBConn.Raw(func(dc interface{}) error {
+		driverConn := dc.(driver.Conn)
+		stmt, err := conn.StmtCache.get(tctx.Context(), driverConn, query)
+		if err != nil {
+			// some statements can't be prepared, execute them directly.
+			tctx.L().Debug("fail to prepare statement", zap.String("query", utils.TruncateString(query, -1)), log.ShortError(err))
+			return nil
+		}
+		prepared = true
+		return execStmt(tctx.Context(), driverConn, stmt, arg)
+	})
+	if !prepared && err == nil {
+		_, err = e.ExecContext(tctx.Context(), query, arg...)
+	}
+	return err
+}
+
 // ExecuteSQL executes sql on real DB,
 // return
 // 1. failed: (the index of sqls executed error, error)
@@ -228,6 +312,9 @@ func (conn *BaseConn) close() error {
 	if conn == nil || conn.DBConn == nil {
 		return nil
 	}
+	if conn.StmtCache != nil {
+		conn.StmtCache.Clear()
+	}
 
 	err := conn.DBConn.Raw(func(dc interface{}) error {
 		// return an `ErrBadConn` to ensure close the connection, but do not put it back to the pool.
@@ -64,7 +64,7 @@ func (t *testBaseConnSuite) TestBaseConn(c *C) {
 	dbConn, err := db.Conn(tctx.Context())
 	c.Assert(err, IsNil)
 
-	baseConn = &BaseConn{dbConn, nil}
+	baseConn = &BaseConn{DBConn: dbConn}
 
 	err = baseConn.SetRetryStrategy(&retry.FiniteRetryStrategy{})
 	c.Assert(err, IsNil)
@@ -120,6 +120,15 @@ func (t *testBaseConnSuite) TestBaseConn(c *C) {
 	c.Assert(strings.Contains(err.Error(), "don't ignore me"), IsTrue)
 	c.Assert(affected, Equals, 1)
 
+	// the statements are executed without transaction in autocommit mode
+	mock.ExpectExec("insert into t values \\(1\\)").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("insert into t values \\(2\\)").WillReturnError(errors.New("ignore me"))
+	mock.ExpectExec("insert into t values \\(3\\)").WillReturnError(errors.New("don't ignore me"))
+	affected, err = baseConn.ExecuteSQLAutocommit(tctx, testStmtHistogram, "test", ignoreF,
+		[]string{"insert into t values (1)", "insert into t values (2)", "insert into t values (3)", "insert into t values (4)"})
+	c.Assert(terror.ErrDBExecuteFailed.Equal(err), IsTrue)
+	c.Assert(affected, Equals, 2)
+
 	if err = mock.ExpectationsWereMet(); err != nil {
 		c.Fatal("there were unexpected:", err)
 	}
@@ -18,13 +18,16 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"net"
 	"net/url"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq" // for PostgreSQL target database
 	"github.com/pingcap/failpoint"
 	toolutils "github.com/pingcap/tidb-tools/pkg/utils"
 	"go.uber.org/zap"
@@ -58,6 +61,10 @@ var mockDB sqlmock.Sqlmock
 
 // Apply will build BaseDB with DBConfig.
 func (d *DefaultDBProviderImpl) Apply(config *config.DBConfig) (*BaseDB, error) {
+	if config.IsPostgres() {
+		return applyPostgres(config)
+	}
+
 	// maxAllowedPacket=0 can be used to automatically fetch the max_allowed_packet variable from server on every connection.
 	// https://github.com/go-sql-driver/mysql#maxallowedpacket
 	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/?charset=utf8mb4&interpolateParams=true&maxAllowedPacket=0",
@@ -138,6 +145,79 @@ func (d *DefaultDBProviderImpl) Apply(config *config.DBConfig) (*BaseDB, error)
 	return NewBaseDB(db, doFuncInClose), nil
 }
 
+// applyPostgres connects to a PostgreSQL database.
+func applyPostgres(config *config.DBConfig) (*BaseDB, error) {
+	db, err := sql.Open("postgres", postgresDSN(config))
+	if err != nil {
+		return nil, terror.DBErrorAdapt(err, terror.ErrDBDriverError)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), utils.DefaultDBTimeout)
+	defer cancel()
+	err = db.PingContext(ctx)
+	if err != nil {
+		db.Close()
+		return nil, terror.DBErrorAdapt(err, terror.ErrDBDriverError)
+	}
+
+	if config.RawDBCfg != nil {
+		db.SetMaxIdleConns(config.RawDBCfg.MaxIdleConns)
+	}
+	return NewBaseDB(db, func() {}), nil
+}
+
+// postgresDSN generates the DSN for lib/pq. the session variables are passed as run-time parameters, and the
+// database to connect can be specified by `dbname` in session, which is the user name by default.
+func postgresDSN(config *config.DBConfig) string {
+	params := url.Values{}
+	params.Set("sslmode", "disable")
+	if config.Security != nil && config.Security.SSLCA != "" {
+		params.Set("sslmode", "verify-full")
+		params.Set("sslrootcert", config.Security.SSLCA)
+		if config.Security.SSLCert != "" && config.Security.SSLKey != "" {
+			params.Set("sslcert", config.Security.SSLCert)
+			params.Set("sslkey", config.Security.SSLKey)
+		}
+	}
+
+	for key, val := range config.Session {
+		switch strings.ToLower(key) {
+		case "sql_mode":
+			// only valid in MySQL, it's always added for the target database by DM.
+			continue
+		case "time_zone":
+			key, val = "timezone", postgresTimeZone(val)
+			if val == "" {
+				continue
+			}
+		}
+		params.Set(key, val)
+	}
+
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(config.User, config.Password),
+		Host:     net.JoinHostPort(config.Host, strconv.Itoa(config.Port)),
+		Path:     "/",
+		RawQuery: params.Encode(),
+	}
+	return u.String()
+}
+
+// postgresTimeZone converts the time zone of MySQL to PostgreSQL. the offset like `+08:00` is converted to `UTC-08:00`
+// because PostgreSQL takes it as a POSIX time zone whose positive offsets are west of Greenwich.
+func postgresTimeZone(tz string) string {
+	switch {
+	case strings.EqualFold(tz, "SYSTEM"):
+		return ""
+	case strings.HasPrefix(tz, "+"):
+		return "UTC-" + tz[1:]
+	case strings.HasPrefix(tz, "-"):
+		return "UTC+" + tz[1:]
+	}
+	return tz
+}
+
 // BaseDB wraps *sql.DB, control the BaseConn.
 type BaseDB struct {
 	DB *sql.DB
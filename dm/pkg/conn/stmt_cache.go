@@ -0,0 +1,137 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conn
+
+import (
+	"container/list"
+	"context"
+	"database/sql/driver"
+)
+
+// StmtCache is a LRU cache of the prepared statements of a connection, the statements are keyed by the query text,
+// which is generated from the table, the DML type and the column set for DMLs.
+// the statements are prepared on the driver connection directly, so they can be executed in any transaction of the
+// connection. database/sql re-prepares a statement of sql.Conn for every transaction.
+// it's not thread-safe, like the connection it belongs to.
+type StmtCache struct {
+	capacity int
+	ll       *list.List
+	items    map[string]*list.Element
+
+	// OnHit, OnMiss and OnEvict are called for metrics if not nil.
+	OnHit   func()
+	OnMiss  func()
+	OnEvict func()
+}
+
+type stmtCacheEntry struct {
+	query string
+	stmt  driver.Stmt
+}
+
+// NewStmtCache creates a StmtCache which holds at most `capacity` statements.
+func NewStmtCache(capacity int) *StmtCache {
+	return &StmtCache{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// Len returns the number of the cached statements.
+func (c *StmtCache) Len() int {
+	return c.ll.Len()
+}
+
+// get returns the prepared statement of `query` on the driver connection `dc`, the statement is prepared if it's not
+// cached. `dc` must be the same connection for all calls.
+func (c *StmtCache) get(ctx context.Context, dc driver.Conn, query string) (driver.Stmt, error) {
+	if e, ok := c.items[query]; ok {
+		c.ll.MoveToFront(e)
+		if c.OnHit != nil {
+			c.OnHit()
+		}
+		return e.Value.(*stmtCacheEntry).stmt, nil
+	}
+	if c.OnMiss != nil {
+		c.OnMiss()
+	}
+
+	var (
+		stmt driver.Stmt
+		err  error
+	)
+	if preparer, ok := dc.(driver.ConnPrepareContext); ok {
+		stmt, err = preparer.PrepareContext(ctx, query)
+	} else {
+		stmt, err = dc.Prepare(query)
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.items[query] = c.ll.PushFront(&stmtCacheEntry{query: query, stmt: stmt})
+	for c.ll.Len() > c.capacity {
+		c.removeElement(c.ll.Back())
+		if c.OnEvict != nil {
+			c.OnEvict()
+		}
+	}
+	return stmt, nil
+}
+
+func (c *StmtCache) removeElement(e *list.Element) {
+	entry := c.ll.Remove(e).(*stmtCacheEntry)
+	delete(c.items, entry.query)
+	// the error can be ignored, the statement is closed with the connection anyway.
+	_ = entry.stmt.Close()
+}
+
+// Clear closes and removes all the statements, it should be called when the connection is closed or reset.
+func (c *StmtCache) Clear() {
+	for c.ll.Len() > 0 {
+		c.removeElement(c.ll.Back())
+	}
+}
+
+// execStmt executes the prepared statement `stmt` of the driver connection `dc` with `args`.
+func execStmt(ctx context.Context, dc driver.Conn, stmt driver.Stmt, args []interface{}) error {
+	checker, _ := dc.(driver.NamedValueChecker)
+	values := make([]driver.NamedValue, 0, len(args))
+	for i, arg := range args {
+		nv := driver.NamedValue{Ordinal: i + 1, Value: arg}
+		var err error
+		if checker != nil {
+			err = checker.CheckNamedValue(&nv)
+		}
+		if checker == nil || err == driver.ErrSkip {
+			nv.Value, err = driver.DefaultParameterConverter.ConvertValue(arg)
+		}
+		if err != nil {
+			return err
+		}
+		values = append(values, nv)
+	}
+
+	if execer, ok := stmt.(driver.StmtExecContext); ok {
+		_, err := execer.ExecContext(ctx, values)
+		return err
+	}
+	plain := make([]driver.Value, 0, len(values))
+	for _, nv := range values {
+		plain = append(plain, nv.Value)
+	}
+	//nolint:staticcheck
+	_, err := stmt.Exec(plain)
+	return err
+}
@@ -0,0 +1,82 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conn
+
+import (
+	"errors"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	. "github.com/pingcap/check"
+
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+)
+
+func (t *testBaseConnSuite) TestStmtCache(c *C) {
+	tctx := tcontext.Background()
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	dbConn, err := db.Conn(tctx.Context())
+	c.Assert(err, IsNil)
+
+	var hit, miss, evict int
+	cache := NewStmtCache(1)
+	cache.OnHit = func() { hit++ }
+	cache.OnMiss = func() { miss++ }
+	cache.OnEvict = func() { evict++ }
+	baseConn := NewBaseConn(dbConn, nil)
+	baseConn.StmtCache = cache
+
+	insertSQL := "INSERT INTO `db`.`tb` (`a`) VALUES (?)"
+	deleteSQL := "DELETE FROM `db`.`tb` WHERE `a` = ?"
+
+	// prepare the statement at the first time, and reuse it later
+	mock.ExpectBegin()
+	insertStmt := mock.ExpectPrepare("INSERT INTO")
+	insertStmt.ExpectExec().WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	insertStmt.ExpectExec().WithArgs(2).WillReturnResult(sqlmock.NewResult(1, 1))
+	// statements without arguments are not prepared
+	mock.ExpectExec("SET @a = 1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	_, err = baseConn.ExecuteSQL(tctx, testStmtHistogram, "test",
+		[]string{insertSQL, insertSQL, "SET @a = 1"}, []interface{}{1}, []interface{}{2}, nil)
+	c.Assert(err, IsNil)
+	c.Assert(cache.Len(), Equals, 1)
+	c.Assert(hit, Equals, 1)
+	c.Assert(miss, Equals, 1)
+
+	// the least recently used statement is evicted and closed
+	mock.ExpectBegin()
+	deleteStmt := mock.ExpectPrepare("DELETE FROM")
+	insertStmt.WillBeClosed()
+	deleteStmt.ExpectExec().WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	_, err = baseConn.ExecuteSQL(tctx, testStmtHistogram, "test", []string{deleteSQL}, []interface{}{1})
+	c.Assert(err, IsNil)
+	c.Assert(cache.Len(), Equals, 1)
+	c.Assert(miss, Equals, 2)
+	c.Assert(evict, Equals, 1)
+
+	// fall back to execute the statement directly if it can't be prepared
+	mock.ExpectBegin()
+	mock.ExpectPrepare("INSERT INTO").WillReturnError(errors.New("not supported"))
+	mock.ExpectExec("INSERT INTO").WithArgs(3).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	_, err = baseConn.ExecuteSQL(tctx, testStmtHistogram, "test", []string{insertSQL}, []interface{}{3})
+	c.Assert(err, IsNil)
+	c.Assert(cache.Len(), Equals, 1)
+
+	cache.Clear()
+	c.Assert(cache.Len(), Equals, 0)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+}
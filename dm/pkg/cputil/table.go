@@ -33,6 +33,32 @@ func SyncerShardMeta(task string) string {
 	return task + "_syncer_sharding_meta"
 }
 
+// SyncerDDLHistory returns syncer's DDL history table name, which records the rewritten DDLs.
+func SyncerDDLHistory(task string) string {
+	return task + "_syncer_ddl_history"
+}
+
+// SyncerGTIDSkipList returns syncer's GTID skip list table name, which records the GTIDs of the transactions to skip.
+func SyncerGTIDSkipList(task string) string {
+	return task + "_syncer_gtid_skip_list"
+}
+
+// SyncerSchemaSnapshot returns syncer's schema snapshot table name, which records the table infos of all tables.
+func SyncerSchemaSnapshot(task string) string {
+	return task + "_syncer_schema_snapshot"
+}
+
+// SyncerRules returns syncer's rules table name, which records the versions of the hot reloaded rules.
+func SyncerRules(task string) string {
+	return task + "_syncer_rules"
+}
+
+// SyncerPausedTables returns syncer's paused tables table name, which records the locations from which the tables are
+// paused with buffer policy.
+func SyncerPausedTables(task string) string {
+	return task + "_syncer_paused_tables"
+}
+
 // SyncerOnlineDDL returns syncer's onlineddl checkpoint table name.
 func SyncerOnlineDDL(task string) string {
 	return task + "_onlineddl"
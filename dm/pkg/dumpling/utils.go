@@ -15,10 +15,13 @@ package dumpling
 
 import (
 	"bufio"
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 
@@ -30,23 +33,42 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/binlog"
 	"github.com/pingcap/tiflow/dm/pkg/gtid"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/storage"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 )
 
+// metadataFile is the name of the meta file written by dumpling.
+const metadataFile = "metadata"
+
 var DumplingDefaultTableFilter = []string{"*.*", export.DefaultTableFilter}
 
 // ParseMetaData parses mydumper's output meta file and returns binlog location.
 // since v2.0.0, dumpling maybe configured to output master status after connection pool is established,
 // we return this location as well.
 func ParseMetaData(filename, flavor string) (*binlog.Location, *binlog.Location, error) {
-	invalidErr := fmt.Errorf("file %s invalid format", filename)
 	fd, err := os.Open(filename)
 	if err != nil {
 		return nil, nil, err
 	}
 	defer fd.Close()
+	return parseMetaData(fd, filename, flavor)
+}
+
+// ParseMetaDataInDir parses the meta file in the dump dir like ParseMetaData, the dir may be in an external storage.
+func ParseMetaDataInDir(ctx context.Context, dir, flavor string) (*binlog.Location, *binlog.Location, error) {
+	if !storage.IsExternalPath(dir) {
+		return ParseMetaData(filepath.Join(dir, metadataFile), flavor)
+	}
+	data, err := storage.ReadFile(ctx, dir, metadataFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	return parseMetaData(bytes.NewReader(data), dir+"/"+metadataFile, flavor)
+}
 
+func parseMetaData(rd io.Reader, filename, flavor string) (*binlog.Location, *binlog.Location, error) {
+	invalidErr := fmt.Errorf("file %s invalid format", filename)
 	var (
 		pos          mysql.Position
 		gtidStr      string
@@ -58,7 +80,7 @@ func ParseMetaData(filename, flavor string) (*binlog.Location, *binlog.Location,
 		locPtr2 *binlog.Location
 	)
 
-	br := bufio.NewReader(fd)
+	br := bufio.NewReader(rd)
 
 	parsePosAndGTID := func(pos *mysql.Position, gtid *string) error {
 		for {
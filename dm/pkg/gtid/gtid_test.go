@@ -405,3 +405,69 @@ func (s *testGTIDSuite) TestMariaDBGTIDTruncate(c *C) {
 		}
 	}
 }
+
+func (s *testGTIDSuite) TestSetOperations(c *C) {
+	var (
+		uuid1 = "3ccc475b-2343-11e7-be21-6c0b84d59f30"
+		uuid2 = "53ea0ed1-9bf8-11e6-8bea-64006a897c73"
+	)
+	parse := func(flavor, str string) Set {
+		gset, err := ParserGTID(flavor, str)
+		c.Assert(err, IsNil)
+		return gset
+	}
+
+	// MySQL
+	set1 := parse(mysql.MySQLFlavor, uuid1+":1-10:15-20,"+uuid2+":1-5")
+	set2 := parse(mysql.MySQLFlavor, uuid1+":3-16")
+	origin1, origin2 := set1.String(), set2.String()
+
+	merged, err := Merge(set1, nil, set2)
+	c.Assert(err, IsNil)
+	c.Assert(merged.String(), Equals, uuid1+":1-20,"+uuid2+":1-5")
+	c.Assert(IsSubset(set1, merged), IsTrue)
+	c.Assert(IsSubset(set2, merged), IsTrue)
+	c.Assert(IsSubset(merged, set1), IsFalse)
+	c.Assert(IsSubset(nil, set1), IsTrue)
+	c.Assert(IsSubset(set1, nil), IsFalse)
+
+	only1, only2, err := Diff(set1, set2)
+	c.Assert(err, IsNil)
+	c.Assert(only1.String(), Equals, uuid1+":1-2:17-20,"+uuid2+":1-5")
+	c.Assert(only2.String(), Equals, uuid1+":11-14")
+
+	report, err := MissingTransactions(set1, set2)
+	c.Assert(err, IsNil)
+	c.Assert(report, Equals, "11 transactions missing: "+uuid1+":1-2:17-20,"+uuid2+":1-5")
+	report, err = MissingTransactions(set2, merged)
+	c.Assert(err, IsNil)
+	c.Assert(report, Equals, "")
+
+	// the GTID sets passed in are not changed
+	c.Assert(set1.String(), Equals, origin1)
+	c.Assert(set2.String(), Equals, origin2)
+
+	// MariaDB
+	mSet1 := parse(mysql.MariaDBFlavor, "1-1-10,2-2-20")
+	mSet2 := parse(mysql.MariaDBFlavor, "1-3-15,3-3-5")
+	merged, err = Merge(mSet1, mSet2)
+	c.Assert(err, IsNil)
+	c.Assert(merged.String(), Equals, "1-3-15,2-2-20,3-3-5")
+	merged, err = Merge(mSet2, mSet1)
+	c.Assert(err, IsNil)
+	c.Assert(merged.String(), Equals, "1-3-15,2-2-20,3-3-5") // not moved backward
+	c.Assert(IsSubset(mSet1, merged), IsTrue)
+	only1, only2, err = Diff(mSet1, mSet2)
+	c.Assert(err, IsNil)
+	c.Assert(only1.String(), Equals, "2-2-20")
+	c.Assert(only2.String(), Equals, "1-3-15,3-3-5")
+	report, err = MissingTransactions(mSet2, mSet1)
+	c.Assert(err, IsNil)
+	c.Assert(report, Equals, "10 transactions missing: 1-3-15,3-3-5")
+
+	// mismatched flavors
+	_, err = Merge(set1, mSet1)
+	c.Assert(terror.ErrNotMySQLGTID.Equal(err), IsTrue)
+	_, err = Subtract(mSet1, set1)
+	c.Assert(terror.ErrNotMariaDBGTID.Equal(err), IsTrue)
+}
@@ -0,0 +1,205 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gtid
+
+import (
+	"fmt"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// the operations below never change the GTID sets passed in, and always return new GTID sets.
+// NOTE: a MariaDB GTID set only records the latest GTID of each domain, so the transactions in a domain are
+// treated as the sequence numbers from 1 to the latest one, and the server ID of the latest GTID is kept.
+
+// IsSubset returns whether all transactions in `sub` are contained in `set`.
+func IsSubset(sub, set Set) bool {
+	if isNilSet(sub) {
+		return true
+	}
+	if isNilSet(set) {
+		return len(sub.String()) == 0
+	}
+	return set.Contain(sub)
+}
+
+// Merge returns the union of the GTID sets, the nil ones are skipped, and nil is returned if all of them are nil.
+func Merge(sets ...Set) (Set, error) {
+	var merged Set
+	for _, set := range sets {
+		if isNilSet(set) {
+			continue
+		}
+		if merged == nil {
+			merged = set.Clone()
+			continue
+		}
+		switch m := merged.(type) {
+		case *MySQLGTIDSet:
+			other, ok := set.(*MySQLGTIDSet)
+			if !ok {
+				return nil, terror.ErrNotMySQLGTID.Generate(set)
+			}
+			for _, uuidSet := range other.set.Sets {
+				m.set.AddSet(uuidSet.Clone())
+			}
+		case *MariadbGTIDSet:
+			other, ok := set.(*MariadbGTIDSet)
+			if !ok {
+				return nil, terror.ErrNotMariaDBGTID.Generate(set)
+			}
+			for did, oGTID := range other.set.Sets {
+				// not use `AddSet` because it moves the domain backward for a smaller sequence number.
+				if mGTID, ok := m.set.Sets[did]; !ok || mGTID.SequenceNumber < oGTID.SequenceNumber {
+					m.set.Sets[did] = cloneMariadbGTID(oGTID)
+				}
+			}
+		}
+	}
+	return merged, nil
+}
+
+// Subtract returns the transactions in `set` but not in `other`.
+func Subtract(set, other Set) (Set, error) {
+	if isNilSet(set) {
+		return set, nil
+	}
+	if isNilSet(other) {
+		return set.Clone(), nil
+	}
+	switch s := set.(type) {
+	case *MySQLGTIDSet:
+		o, ok := other.(*MySQLGTIDSet)
+		if !ok {
+			return nil, terror.ErrNotMySQLGTID.Generate(other)
+		}
+		result := &mysql.MysqlGTIDSet{Sets: make(map[string]*mysql.UUIDSet)}
+		for sid, uuidSet := range s.set.Sets {
+			intervals := uuidSet.Intervals
+			if oUUIDSet, ok := o.set.Sets[sid]; ok {
+				intervals = subtractIntervals(intervals, oUUIDSet.Intervals)
+			}
+			if len(intervals) > 0 {
+				result.Sets[sid] = mysql.NewUUIDSet(uuidSet.SID, intervals...)
+			}
+		}
+		return &MySQLGTIDSet{set: result}, nil
+	case *MariadbGTIDSet:
+		o, ok := other.(*MariadbGTIDSet)
+		if !ok {
+			return nil, terror.ErrNotMariaDBGTID.Generate(other)
+		}
+		result := &mysql.MariadbGTIDSet{Sets: make(map[uint32]*mysql.MariadbGTID)}
+		for did, sGTID := range s.set.Sets {
+			if oGTID, ok := o.set.Sets[did]; ok && oGTID.SequenceNumber >= sGTID.SequenceNumber {
+				continue
+			}
+			result.Sets[did] = cloneMariadbGTID(sGTID)
+		}
+		return &MariadbGTIDSet{set: result}, nil
+	}
+	return nil, terror.ErrNotSupportedFlavor.Generate(fmt.Sprintf("%T", set))
+}
+
+// Diff returns the transactions only in `set1` and the ones only in `set2`.
+func Diff(set1, set2 Set) (only1, only2 Set, err error) {
+	if only1, err = Subtract(set1, set2); err != nil {
+		return nil, nil, err
+	}
+	if only2, err = Subtract(set2, set1); err != nil {
+		return nil, nil, err
+	}
+	return only1, only2, nil
+}
+
+// MissingTransactions returns a human-readable report of the transactions in `expected` but not in `actual`,
+// like `5 transactions missing: 3ccc475b-2343-11e7-be21-6c0b84d59f30:6-10`, or an empty string if nothing is missing.
+func MissingTransactions(expected, actual Set) (string, error) {
+	missing, err := Subtract(expected, actual)
+	if err != nil {
+		return "", err
+	}
+	if isNilSet(missing) || len(missing.String()) == 0 {
+		return "", nil
+	}
+
+	var count int64
+	switch m := missing.(type) {
+	case *MySQLGTIDSet:
+		for _, uuidSet := range m.set.Sets {
+			for _, in := range uuidSet.Intervals {
+				count += in.Stop - in.Start
+			}
+		}
+	case *MariadbGTIDSet:
+		a, _ := actual.(*MariadbGTIDSet)
+		for did, mGTID := range m.set.Sets {
+			count += int64(mGTID.SequenceNumber)
+			if !isNilSet(a) {
+				if aGTID, ok := a.set.Sets[did]; ok {
+					count -= int64(aGTID.SequenceNumber)
+				}
+			}
+		}
+	}
+	return fmt.Sprintf("%d transactions missing: %s", count, missing), nil
+}
+
+// subtractIntervals returns the intervals in `s` but not in `o`.
+func subtractIntervals(s, o mysql.IntervalSlice) mysql.IntervalSlice {
+	s, o = s.Normalize(), o.Normalize()
+	result := make(mysql.IntervalSlice, 0, len(s))
+	for _, in := range s {
+		start := in.Start
+		for _, out := range o {
+			if out.Stop <= start || out.Start >= in.Stop {
+				continue
+			}
+			if out.Start > start {
+				result = append(result, mysql.Interval{Start: start, Stop: out.Start})
+			}
+			start = out.Stop
+			if start >= in.Stop {
+				break
+			}
+		}
+		if start < in.Stop {
+			result = append(result, mysql.Interval{Start: start, Stop: in.Stop})
+		}
+	}
+	return result
+}
+
+func cloneMariadbGTID(g *mysql.MariadbGTID) *mysql.MariadbGTID {
+	return &mysql.MariadbGTID{
+		DomainID:       g.DomainID,
+		ServerID:       g.ServerID,
+		SequenceNumber: g.SequenceNumber,
+	}
+}
+
+// isNilSet returns whether `set` is nil or wraps nothing.
+func isNilSet(set Set) bool {
+	switch s := set.(type) {
+	case nil:
+		return true
+	case *MySQLGTIDSet:
+		return s == nil || s.set == nil
+	case *MariadbGTIDSet:
+		return s == nil || s.set == nil
+	}
+	return false
+}
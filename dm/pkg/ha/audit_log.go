@@ -0,0 +1,107 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.etcd.io/etcd/clientv3"
+
+	"github.com/pingcap/tiflow/dm/dm/common"
+	"github.com/pingcap/tiflow/dm/pkg/etcdutil"
+)
+
+// AuditLog is the record of a control-plane mutation handled by the DM-master leader.
+type AuditLog struct {
+	Time      time.Time `json:"time"`            // the time when the mutation is handled.
+	User      string    `json:"user,omitempty"`  // the identity of the user who requested the mutation.
+	Client    string    `json:"client"`          // the address of the client which sent the request.
+	API       string    `json:"api"`             // the API the request came from, "grpc" or "openapi".
+	Operation string    `json:"operation"`       // the name of the mutation, e.g. the gRPC method or the OpenAPI route.
+	Params    string    `json:"params"`          // the parameters of the request in JSON, with the passwords hidden.
+	Result    bool      `json:"result"`          // whether the mutation succeeded.
+	Error     string    `json:"error,omitempty"` // the error message if the mutation failed.
+}
+
+// String implements Stringer interface.
+func (l AuditLog) String() string {
+	s, _ := l.toJSON()
+	return s
+}
+
+// toJSON returns the string of JSON represent.
+func (l AuditLog) toJSON() (string, error) {
+	data, err := json.Marshal(l)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// auditLogKey returns the key of the audit log at the time, the keys are sorted by the time.
+func auditLogKey(t time.Time) string {
+	return common.AuditLogKeyAdapter.Encode(fmt.Sprintf("%020d", t.UnixNano()))
+}
+
+// PutAuditLog puts the audit log into etcd.
+// k/v: the time of the audit log -> the audit log.
+func PutAuditLog(cli *clientv3.Client, l AuditLog) (int64, error) {
+	value, err := l.toJSON()
+	if err != nil {
+		return 0, err
+	}
+	_, rev, err := etcdutil.DoOpsInOneTxnWithRetry(cli, clientv3.OpPut(auditLogKey(l.Time), value))
+	return rev, err
+}
+
+// GetAuditLogs gets the audit logs in the time range [start, end) sorted by the time, zero start or end means the
+// range is unbounded on that side.
+func GetAuditLogs(cli *clientv3.Client, start, end time.Time) ([]AuditLog, int64, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	startKey := common.AuditLogKeyAdapter.Path()
+	if !start.IsZero() {
+		startKey = auditLogKey(start)
+	}
+	endKey := clientv3.GetPrefixRangeEnd(common.AuditLogKeyAdapter.Path())
+	if !end.IsZero() {
+		endKey = auditLogKey(end)
+	}
+	resp, err := cli.Get(ctx, startKey, clientv3.WithRange(endKey),
+		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	logs := make([]AuditLog, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var l AuditLog
+		if err = json.Unmarshal(kv.Value, &l); err != nil {
+			return nil, 0, err
+		}
+		logs = append(logs, l)
+	}
+	return logs, resp.Header.Revision, nil
+}
+
+// DeleteAuditLogsBefore deletes the audit logs before the time, it's used to clean the expired audit logs.
+func DeleteAuditLogsBefore(cli *clientv3.Client, t time.Time) (int64, error) {
+	_, rev, err := etcdutil.DoOpsInOneTxnWithRetry(cli,
+		clientv3.OpDelete(common.AuditLogKeyAdapter.Path(), clientv3.WithRange(auditLogKey(t))))
+	return rev, err
+}
@@ -0,0 +1,62 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"time"
+
+	. "github.com/pingcap/check"
+)
+
+func (t *testForEtcd) TestAuditLogEtcd(c *C) {
+	defer clearTestInfoOperation(c)
+
+	var (
+		now  = time.Now().UTC().Truncate(time.Second)
+		log1 = AuditLog{Time: now, User: "admin", Client: "127.0.0.1:1234", API: "grpc", Operation: "StartTask", Params: `{"name":"task1"}`, Result: true}
+		log2 = AuditLog{Time: now.Add(time.Second), Client: "127.0.0.1:1235", API: "openapi", Operation: "POST /api/v1/sources", Params: "{}", Error: "source already exists"}
+		log3 = AuditLog{Time: now.Add(2 * time.Second), User: "admin", Client: "127.0.0.1:1234", API: "grpc", Operation: "OperateTask", Params: "{}", Result: true}
+	)
+
+	logs, _, err := GetAuditLogs(etcdTestCli, time.Time{}, time.Time{})
+	c.Assert(err, IsNil)
+	c.Assert(logs, HasLen, 0)
+
+	// put the logs out of order, they're sorted by the time.
+	for _, l := range []AuditLog{log3, log1, log2} {
+		_, err = PutAuditLog(etcdTestCli, l)
+		c.Assert(err, IsNil)
+	}
+	logs, _, err = GetAuditLogs(etcdTestCli, time.Time{}, time.Time{})
+	c.Assert(err, IsNil)
+	c.Assert(logs, DeepEquals, []AuditLog{log1, log2, log3})
+
+	// the time range is [start, end).
+	logs, _, err = GetAuditLogs(etcdTestCli, log2.Time, log3.Time)
+	c.Assert(err, IsNil)
+	c.Assert(logs, DeepEquals, []AuditLog{log2})
+	logs, _, err = GetAuditLogs(etcdTestCli, log2.Time, time.Time{})
+	c.Assert(err, IsNil)
+	c.Assert(logs, DeepEquals, []AuditLog{log2, log3})
+	logs, _, err = GetAuditLogs(etcdTestCli, time.Time{}, log2.Time)
+	c.Assert(err, IsNil)
+	c.Assert(logs, DeepEquals, []AuditLog{log1})
+
+	// the expired logs are deleted.
+	_, err = DeleteAuditLogsBefore(etcdTestCli, log3.Time)
+	c.Assert(err, IsNil)
+	logs, _, err = GetAuditLogs(etcdTestCli, time.Time{}, time.Time{})
+	c.Assert(err, IsNil)
+	c.Assert(logs, DeepEquals, []AuditLog{log3})
+}
@@ -0,0 +1,109 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.etcd.io/etcd/clientv3"
+
+	"github.com/pingcap/tiflow/dm/dm/common"
+	"github.com/pingcap/tiflow/dm/pkg/etcdutil"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// RelayMeta represents the relay meta of a source, which is the position relay has pulled to.
+type RelayMeta struct {
+	Source     string `json:"source"`      // the source ID of the upstream.
+	Worker     string `json:"worker"`      // the DM-worker which pulled relay log last time.
+	UUID       string `json:"uuid"`        // the server UUID with suffix of the current relay sub directory.
+	BinLogName string `json:"binlog-name"` // the binlog file name relay has pulled to.
+	BinLogPos  uint32 `json:"binlog-pos"`  // the binlog position relay has pulled to.
+	BinlogGTID string `json:"binlog-gtid"` // the GTID sets relay has pulled.
+
+	// record the etcd ModRevision of this RelayMeta, do not marsh it.
+	Revision int64 `json:"-"`
+}
+
+// String implements Stringer interface.
+func (m RelayMeta) String() string {
+	s, _ := m.toJSON()
+	return s
+}
+
+// toJSON returns the string of JSON represent.
+func (m RelayMeta) toJSON() (string, error) {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// GetRelayMeta gets the relay meta of the source, returns a RelayMeta with zero Revision if not exists.
+// k/v: source-id -> relay meta.
+func GetRelayMeta(cli *clientv3.Client, source string) (RelayMeta, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	resp, err := cli.Get(ctx, common.RelayMetaKeyAdapter.Encode(source))
+	if err != nil {
+		return RelayMeta{}, err
+	}
+	return relayMetaFromResp(source, resp)
+}
+
+// PutRelayMetaIfRevision puts the relay meta only if its ModRevision in etcd equals to `revision`, zero `revision`
+// means the relay meta should not exist. it returns whether put successfully and the relay meta in etcd after that.
+func PutRelayMetaIfRevision(cli *clientv3.Client, meta RelayMeta, revision int64) (RelayMeta, bool, error) {
+	value, err := meta.toJSON()
+	if err != nil {
+		return RelayMeta{}, false, err
+	}
+	key := common.RelayMetaKeyAdapter.Encode(meta.Source)
+	cmp := clientv3.Compare(clientv3.ModRevision(key), "=", revision)
+	resp, rev, err := etcdutil.DoOpsInOneCmpsTxnWithRetry(cli, []clientv3.Cmp{cmp},
+		[]clientv3.Op{clientv3.OpPut(key, value)}, []clientv3.Op{clientv3.OpGet(key)})
+	if err != nil {
+		return RelayMeta{}, false, err
+	}
+	if resp.Succeeded {
+		meta.Revision = rev
+		return meta, true, nil
+	}
+	current, err := relayMetaFromResp(meta.Source, (*clientv3.GetResponse)(resp.Responses[0].GetResponseRange()))
+	return current, false, err
+}
+
+// DeleteRelayMeta deletes the relay meta of the source.
+func DeleteRelayMeta(cli *clientv3.Client, source string) (int64, error) {
+	_, rev, err := etcdutil.DoOpsInOneTxnWithRetry(cli, clientv3.OpDelete(common.RelayMetaKeyAdapter.Encode(source)))
+	return rev, err
+}
+
+func relayMetaFromResp(source string, resp *clientv3.GetResponse) (RelayMeta, error) {
+	var meta RelayMeta
+	if resp.Count == 0 {
+		return meta, nil
+	} else if resp.Count > 1 {
+		// this should not happen.
+		return meta, terror.ErrConfigMoreThanOne.Generate(resp.Count, "relay meta", "source: "+source)
+	}
+	if err := json.Unmarshal(resp.Kvs[0].Value, &meta); err != nil {
+		return meta, err
+	}
+	meta.Revision = resp.Kvs[0].ModRevision
+	return meta, nil
+}
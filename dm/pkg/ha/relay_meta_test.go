@@ -0,0 +1,71 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	. "github.com/pingcap/check"
+)
+
+func (t *testForEtcd) TestRelayMetaEtcd(c *C) {
+	defer clearTestInfoOperation(c)
+
+	source := "mysql-replica-1"
+	meta, err := GetRelayMeta(etcdTestCli, source)
+	c.Assert(err, IsNil)
+	c.Assert(meta.Revision, Equals, int64(0))
+
+	meta1 := RelayMeta{
+		Source:     source,
+		Worker:     "worker-1",
+		UUID:       "server-uuid.000001",
+		BinLogName: "mysql-bin.000001",
+		BinLogPos:  1234,
+	}
+
+	// put with a wrong revision when not exists
+	_, ok, err := PutRelayMetaIfRevision(etcdTestCli, meta1, 100)
+	c.Assert(err, IsNil)
+	c.Assert(ok, IsFalse)
+
+	// put when not exists
+	put1, ok, err := PutRelayMetaIfRevision(etcdTestCli, meta1, 0)
+	c.Assert(err, IsNil)
+	c.Assert(ok, IsTrue)
+	c.Assert(put1.Revision, Greater, int64(0))
+	meta, err = GetRelayMeta(etcdTestCli, source)
+	c.Assert(err, IsNil)
+	c.Assert(meta, DeepEquals, put1)
+
+	// another worker takes over with the revision it got
+	meta2 := meta1
+	meta2.Worker = "worker-2"
+	meta2.BinLogPos = 5678
+	put2, ok, err := PutRelayMetaIfRevision(etcdTestCli, meta2, put1.Revision)
+	c.Assert(err, IsNil)
+	c.Assert(ok, IsTrue)
+	c.Assert(put2.Revision, Greater, put1.Revision)
+
+	// the previous worker can't update it anymore
+	meta1.BinLogPos = 9999
+	current, ok, err := PutRelayMetaIfRevision(etcdTestCli, meta1, put1.Revision)
+	c.Assert(err, IsNil)
+	c.Assert(ok, IsFalse)
+	c.Assert(current, DeepEquals, put2)
+
+	_, err = DeleteRelayMeta(etcdTestCli, source)
+	c.Assert(err, IsNil)
+	meta, err = GetRelayMeta(etcdTestCli, source)
+	c.Assert(err, IsNil)
+	c.Assert(meta.Revision, Equals, int64(0))
+}
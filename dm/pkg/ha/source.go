@@ -135,7 +135,12 @@ func ClearTestInfoOperation(cli *clientv3.Client) error {
 	clearRelayConfig := clientv3.OpDelete(common.UpstreamRelayWorkerKeyAdapter.Path(), clientv3.WithPrefix())
 	clearSubTaskStage := clientv3.OpDelete(common.StageSubTaskKeyAdapter.Path(), clientv3.WithPrefix())
 	clearLoadTasks := clientv3.OpDelete(common.LoadTaskKeyAdapter.Path(), clientv3.WithPrefix())
+	clearRelayMeta := clientv3.OpDelete(common.RelayMetaKeyAdapter.Path(), clientv3.WithPrefix())
+	clearWorkerLoad := clientv3.OpDelete(common.WorkerLoadKeyAdapter.Path(), clientv3.WithPrefix())
+	clearAuditLog := clientv3.OpDelete(common.AuditLogKeyAdapter.Path(), clientv3.WithPrefix())
+	clearWebhook := clientv3.OpDelete(common.WebhookKeyAdapter.Path(), clientv3.WithPrefix())
 	_, _, err := etcdutil.DoOpsInOneTxnWithRetry(cli, clearSource, clearSubTask, clearWorkerInfo, clearBound,
-		clearLastBound, clearWorkerKeepAlive, clearRelayStage, clearRelayConfig, clearSubTaskStage, clearLoadTasks)
+		clearLastBound, clearWorkerKeepAlive, clearRelayStage, clearRelayConfig, clearSubTaskStage, clearLoadTasks,
+		clearRelayMeta, clearWorkerLoad, clearAuditLog, clearWebhook)
 	return err
 }
@@ -0,0 +1,109 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.etcd.io/etcd/clientv3"
+	"go.etcd.io/etcd/clientv3/clientv3util"
+
+	"github.com/pingcap/tiflow/dm/dm/common"
+	"github.com/pingcap/tiflow/dm/pkg/etcdutil"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// Webhook is the URL registered to receive the notifications of DM events.
+type Webhook struct {
+	Name   string   `json:"name"`             // the unique name of the webhook.
+	URL    string   `json:"url"`              // the URL to post the notifications to.
+	Secret string   `json:"secret,omitempty"` // the secret to sign the notifications, empty means not signed.
+	Events []string `json:"events"`           // the events to notify.
+}
+
+// String implements Stringer interface, the secret is not included.
+func (w Webhook) String() string {
+	w.Secret = ""
+	s, _ := w.toJSON()
+	return s
+}
+
+// toJSON returns the string of JSON represent.
+func (w Webhook) toJSON() (string, error) {
+	data, err := json.Marshal(w)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// PutWebhook puts the webhook into etcd if no webhook with the same name exists.
+// k/v: webhook-name -> the webhook.
+func PutWebhook(cli *clientv3.Client, w Webhook) (int64, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	value, err := w.toJSON()
+	if err != nil {
+		return 0, err
+	}
+	key := common.WebhookKeyAdapter.Encode(w.Name)
+	resp, err := cli.Txn(ctx).If(clientv3util.KeyMissing(key)).Then(clientv3.OpPut(key, value)).Commit()
+	if err != nil {
+		return 0, err
+	}
+	if !resp.Succeeded {
+		return resp.Header.Revision, terror.ErrMasterWebhookExist.Generate(w.Name)
+	}
+	return resp.Header.Revision, nil
+}
+
+// GetAllWebhooks gets all the webhooks in etcd, sorted by the names.
+// k/v: webhook-name -> the webhook.
+func GetAllWebhooks(cli *clientv3.Client) ([]Webhook, int64, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	resp, err := cli.Get(ctx, common.WebhookKeyAdapter.Path(), clientv3.WithPrefix(),
+		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	webhooks := make([]Webhook, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var w Webhook
+		if err = json.Unmarshal(kv.Value, &w); err != nil {
+			return nil, 0, err
+		}
+		webhooks = append(webhooks, w)
+	}
+	return webhooks, resp.Header.Revision, nil
+}
+
+// DeleteWebhook deletes the webhook in etcd.
+func DeleteWebhook(cli *clientv3.Client, name string) (int64, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	resp, err := cli.Delete(ctx, common.WebhookKeyAdapter.Encode(name))
+	if err != nil {
+		return 0, err
+	}
+	if resp.Deleted == 0 {
+		return resp.Header.Revision, terror.ErrMasterWebhookNotExist.Generate(name)
+	}
+	return resp.Header.Revision, nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+func (t *testForEtcd) TestWebhookEtcd(c *C) {
+	defer clearTestInfoOperation(c)
+
+	var (
+		hook1 = Webhook{Name: "hook1", URL: "http://127.0.0.1:8080/dm", Secret: "secret", Events: []string{"worker-offline"}}
+		hook2 = Webhook{Name: "hook2", URL: "https://example.com/dm", Events: []string{"relay-disk-alarm", "shard-ddl-waiting"}}
+	)
+
+	hooks, _, err := GetAllWebhooks(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(hooks, HasLen, 0)
+
+	_, err = PutWebhook(etcdTestCli, hook2)
+	c.Assert(err, IsNil)
+	_, err = PutWebhook(etcdTestCli, hook1)
+	c.Assert(err, IsNil)
+	hooks, _, err = GetAllWebhooks(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(hooks, DeepEquals, []Webhook{hook1, hook2})
+	c.Assert(hook1.String(), Not(Matches), ".*secret.*")
+
+	// the webhook with the same name can't be put again.
+	_, err = PutWebhook(etcdTestCli, Webhook{Name: "hook1", URL: "http://127.0.0.1:8081"})
+	c.Assert(terror.ErrMasterWebhookExist.Equal(err), IsTrue)
+
+	_, err = DeleteWebhook(etcdTestCli, "hook1")
+	c.Assert(err, IsNil)
+	_, err = DeleteWebhook(etcdTestCli, "hook1")
+	c.Assert(terror.ErrMasterWebhookNotExist.Equal(err), IsTrue)
+	hooks, _, err = GetAllWebhooks(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(hooks, DeepEquals, []Webhook{hook2})
+}
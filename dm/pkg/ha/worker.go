@@ -25,8 +25,9 @@ import (
 
 // WorkerInfo represents the node information of the DM-worker.
 type WorkerInfo struct {
-	Name string `json:"name"` // the name of the node.
-	Addr string `json:"addr"` // the client address of the node to advertise.
+	Name   string            `json:"name"`             // the name of the node.
+	Addr   string            `json:"addr"`             // the client address of the node to advertise.
+	Labels map[string]string `json:"labels,omitempty"` // the labels of the node like zone, rack.
 }
 
 // NewWorkerInfo creates a new WorkerInfo instance.
@@ -94,11 +95,12 @@ func GetAllWorkerInfo(cli *clientv3.Client) (map[string]WorkerInfo, int64, error
 	return ifm, resp.Header.Revision, nil
 }
 
-// DeleteWorkerInfoRelayConfig deletes the specified DM-worker information and its relay config.
+// DeleteWorkerInfoRelayConfig deletes the specified DM-worker information, its relay config and its reported load.
 func DeleteWorkerInfoRelayConfig(cli *clientv3.Client, worker string) (int64, error) {
 	ops := []clientv3.Op{
 		clientv3.OpDelete(common.WorkerRegisterKeyAdapter.Encode(worker)),
 		clientv3.OpDelete(common.UpstreamRelayWorkerKeyAdapter.Encode(worker)),
+		clientv3.OpDelete(common.WorkerLoadKeyAdapter.Encode(worker)),
 	}
 	_, rev, err := etcdutil.DoOpsInOneTxnWithRetry(cli, ops...)
 	return rev, err
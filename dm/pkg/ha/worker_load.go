@@ -0,0 +1,83 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"go.etcd.io/etcd/clientv3"
+
+	"github.com/pingcap/tiflow/dm/dm/common"
+	"github.com/pingcap/tiflow/dm/pkg/etcdutil"
+)
+
+// WorkerLoad represents the load of a DM-worker, which is reported by the DM-worker periodically.
+type WorkerLoad struct {
+	Worker         string    `json:"worker"`           // the name of the DM-worker.
+	CPUUsage       float64   `json:"cpu-usage"`        // the CPU usage of the DM-worker process in percent.
+	MemoryUsage    uint64    `json:"memory-usage"`     // the bytes of memory obtained from the OS by the DM-worker process.
+	RelayDiskUsage int64     `json:"relay-disk-usage"` // the bytes of the relay log files of the DM-worker.
+	TaskQPS        float64   `json:"task-qps"`         // the binlog events processed by the sync units per second.
+	UpdateTime     time.Time `json:"update-time"`      // the time when the load is reported.
+}
+
+// String implements Stringer interface.
+func (l WorkerLoad) String() string {
+	s, _ := l.toJSON()
+	return s
+}
+
+// toJSON returns the string of JSON represent.
+func (l WorkerLoad) toJSON() (string, error) {
+	data, err := json.Marshal(l)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// PutWorkerLoad puts the load of the DM-worker into etcd.
+// k/v: worker-name -> the load of the DM-worker.
+func PutWorkerLoad(cli *clientv3.Client, load WorkerLoad) (int64, error) {
+	value, err := load.toJSON()
+	if err != nil {
+		return 0, err
+	}
+	_, rev, err := etcdutil.DoOpsInOneTxnWithRetry(cli, clientv3.OpPut(common.WorkerLoadKeyAdapter.Encode(load.Worker), value))
+	return rev, err
+}
+
+// GetAllWorkerLoads gets the loads of all DM-workers in etcd currently.
+// k/v: worker-name -> the load of the DM-worker.
+func GetAllWorkerLoads(cli *clientv3.Client) (map[string]WorkerLoad, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	resp, err := cli.Get(ctx, common.WorkerLoadKeyAdapter.Path(), clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+
+	loads := make(map[string]WorkerLoad, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var load WorkerLoad
+		if err = json.Unmarshal(kv.Value, &load); err != nil {
+			return nil, err
+		}
+		loads[load.Worker] = load
+	}
+	return loads, nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"time"
+
+	. "github.com/pingcap/check"
+)
+
+func (t *testForEtcd) TestWorkerLoadEtcd(c *C) {
+	defer clearTestInfoOperation(c)
+
+	var (
+		worker1 = "dm-worker-1"
+		worker2 = "dm-worker-2"
+		now     = time.Now().UTC().Truncate(time.Second)
+		load1   = WorkerLoad{Worker: worker1, CPUUsage: 12.5, MemoryUsage: 1 << 30, RelayDiskUsage: 1 << 20, TaskQPS: 100, UpdateTime: now}
+		load2   = WorkerLoad{Worker: worker2, CPUUsage: 50, MemoryUsage: 2 << 30, UpdateTime: now}
+	)
+
+	loads, err := GetAllWorkerLoads(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(loads, HasLen, 0)
+
+	_, err = PutWorkerLoad(etcdTestCli, load1)
+	c.Assert(err, IsNil)
+	_, err = PutWorkerLoad(etcdTestCli, load2)
+	c.Assert(err, IsNil)
+	loads, err = GetAllWorkerLoads(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(loads, DeepEquals, map[string]WorkerLoad{worker1: load1, worker2: load2})
+
+	// the load is replaced by the latest report.
+	load1.TaskQPS = 200
+	_, err = PutWorkerLoad(etcdTestCli, load1)
+	c.Assert(err, IsNil)
+	loads, err = GetAllWorkerLoads(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(loads[worker1], DeepEquals, load1)
+
+	// the load is deleted with the worker info.
+	_, err = DeleteWorkerInfoRelayConfig(etcdTestCli, worker1)
+	c.Assert(err, IsNil)
+	loads, err = GetAllWorkerLoads(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(loads, DeepEquals, map[string]WorkerLoad{worker2: load2})
+}
@@ -0,0 +1,158 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+)
+
+// InvisibleColumnComment is the comment which replaces the INVISIBLE attribute of columns in MySQL 8.0, because
+// the parser doesn't support invisible columns.
+const InvisibleColumnComment = "dm:invisible-column"
+
+const invisibleKeyword = "INVISIBLE"
+
+var (
+	// the format of parse error is `line %d column %d near "%s"%s %s`, and the text after near is the rest of SQL
+	// from the rejected token. it's truncated with `(total length %d)` if it's too long.
+	parseErrNearRegexp  = regexp.MustCompile(`(?s)^line \d+ column \d+ near "(.*)" $`)
+	parseErrTotalRegexp = regexp.MustCompile(`(?s)^line \d+ column \d+ near ".*"\(total length (\d+)\)$`)
+)
+
+// ReplaceInvisibleColumns replaces the INVISIBLE attributes of columns rejected by the parser with
+// `COMMENT 'dm:invisible-column'`, so the invisible columns are parsed as normal columns. the INVISIBLE attributes of
+// indexes are supported by the parser and kept. it returns the replaced SQL and whether anything is replaced.
+// NOTE: `ALTER COLUMN c SET INVISIBLE` can't be replaced.
+func ReplaceInvisibleColumns(p *parser.Parser, sql string) (string, bool) {
+	replaced := false
+	for {
+		_, _, err := p.Parse(sql, "", "")
+		if err == nil {
+			return sql, replaced
+		}
+		offset := parseErrOffset(sql, err.Error())
+		if offset < 0 || !isKeywordAt(sql, offset, invisibleKeyword) || isKeywordBefore(sql, offset, "SET") {
+			return sql, false
+		}
+		sql = sql[:offset] + "COMMENT '" + InvisibleColumnComment + "'" + sql[offset+len(invisibleKeyword):]
+		replaced = true
+	}
+}
+
+// parseErrOffset returns the offset of the token rejected by the parser in sql, or -1 if it's unknown.
+func parseErrOffset(sql, errMsg string) int {
+	if m := parseErrTotalRegexp.FindStringSubmatch(errMsg); m != nil {
+		total, err := strconv.Atoi(m[1])
+		if err != nil || total > len(sql) {
+			return -1
+		}
+		return len(sql) - total
+	}
+	if m := parseErrNearRegexp.FindStringSubmatch(errMsg); m != nil && strings.HasSuffix(sql, m[1]) {
+		return len(sql) - len(m[1])
+	}
+	return -1
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c == '$' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isKeywordAt(sql string, offset int, keyword string) bool {
+	end := offset + len(keyword)
+	if end > len(sql) || !strings.EqualFold(sql[offset:end], keyword) {
+		return false
+	}
+	return end == len(sql) || !isIdentChar(sql[end])
+}
+
+func isKeywordBefore(sql string, offset int, keyword string) bool {
+	prev := strings.TrimRight(sql[:offset], " \t\r\n")
+	start := len(prev) - len(keyword)
+	if start < 0 || !strings.EqualFold(prev[start:], keyword) {
+		return false
+	}
+	return start == 0 || !isIdentChar(prev[start-1])
+}
+
+// IsInvisibleColumn returns whether the column is marked as invisible by ReplaceInvisibleColumns.
+func IsInvisibleColumn(col *ast.ColumnDef) bool {
+	for _, opt := range col.Options {
+		if opt.Tp == ast.ColumnOptionComment && isInvisibleComment(opt.Expr) {
+			return true
+		}
+	}
+	return false
+}
+
+func isInvisibleComment(expr ast.ExprNode) bool {
+	v, ok := expr.(ast.ValueExpr)
+	return ok && v.GetString() == InvisibleColumnComment
+}
+
+// invisibleColumnCollector collects the column definitions marked as invisible.
+type invisibleColumnCollector struct {
+	cols []*ast.ColumnDef
+}
+
+func (c *invisibleColumnCollector) Enter(in ast.Node) (ast.Node, bool) {
+	if col, ok := in.(*ast.ColumnDef); ok {
+		if IsInvisibleColumn(col) {
+			c.cols = append(c.cols, col)
+		}
+		return in, true
+	}
+	return in, false
+}
+
+func (c *invisibleColumnCollector) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+// UnmarkInvisibleColumns removes the comments added by ReplaceInvisibleColumns from the column definitions in stmt,
+// and returns the unmarked columns, which can be marked again by MarkInvisibleColumns.
+func UnmarkInvisibleColumns(stmt ast.StmtNode) []*ast.ColumnDef {
+	collector := &invisibleColumnCollector{}
+	stmt.Accept(collector)
+	for _, col := range collector.cols {
+		options := col.Options[:0]
+		for _, opt := range col.Options {
+			if opt.Tp == ast.ColumnOptionComment && isInvisibleComment(opt.Expr) {
+				continue
+			}
+			options = append(options, opt)
+		}
+		col.Options = options
+	}
+	return collector.cols
+}
+
+// MarkInvisibleColumns replaces the comments of the columns by InvisibleColumnComment, so the invisible columns can be
+// recognized by the comment of the columns in the table info.
+func MarkInvisibleColumns(cols []*ast.ColumnDef) {
+	for _, col := range cols {
+		options := col.Options[:0]
+		for _, opt := range col.Options {
+			if opt.Tp != ast.ColumnOptionComment {
+				options = append(options, opt)
+			}
+		}
+		col.Options = append(options, &ast.ColumnOption{Tp: ast.ColumnOptionComment, Expr: ast.NewValueExpr(InvisibleColumnComment, "", "")})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"strings"
+
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/format"
+)
+
+func (t *testParserSuite) TestInvisibleColumns(c *C) {
+	p := parser.New()
+	cases := []struct {
+		sql      string
+		replaced string
+		ok       bool
+	}{
+		{
+			"create table t (a int, b int invisible)",
+			"create table t (a int, b int COMMENT 'dm:invisible-column')",
+			true,
+		},
+		{
+			"CREATE TABLE `t` (`a` int, `b` varchar(10) COMMENT 'invisible' /*!80023 INVISIBLE */, KEY `idx` (`a`) /*!80000 INVISIBLE */)",
+			"CREATE TABLE `t` (`a` int, `b` varchar(10) COMMENT 'invisible' /*!80023 COMMENT 'dm:invisible-column' */, KEY `idx` (`a`) /*!80000 INVISIBLE */)",
+			true,
+		},
+		{
+			"alter table t add column c int invisible, add column d int",
+			"alter table t add column c int COMMENT 'dm:invisible-column', add column d int",
+			true,
+		},
+		{
+			"alter table t alter index idx invisible",
+			"alter table t alter index idx invisible",
+			false,
+		},
+		{
+			"alter table t alter column c set invisible",
+			"alter table t alter column c set invisible",
+			false,
+		},
+		{
+			"create table t (a int, b int invalid)",
+			"create table t (a int, b int invalid)",
+			false,
+		},
+	}
+	for _, cs := range cases {
+		replaced, ok := ReplaceInvisibleColumns(p, cs.sql)
+		c.Assert(replaced, Equals, cs.replaced)
+		c.Assert(ok, Equals, cs.ok)
+	}
+
+	stmt, err := p.ParseOneStmt(cases[1].replaced, "", "")
+	c.Assert(err, IsNil)
+	cols := UnmarkInvisibleColumns(stmt)
+	c.Assert(cols, HasLen, 1)
+	c.Assert(cols[0].Name.Name.O, Equals, "b")
+	var sb strings.Builder
+	c.Assert(stmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)), IsNil)
+	c.Assert(sb.String(), Equals, "CREATE TABLE `t` (`a` INT,`b` VARCHAR(10) COMMENT 'invisible',INDEX `idx`(`a`) INVISIBLE)")
+
+	MarkInvisibleColumns(cols)
+	c.Assert(IsInvisibleColumn(cols[0]), IsTrue)
+	sb.Reset()
+	c.Assert(stmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)), IsNil)
+	c.Assert(sb.String(), Equals, "CREATE TABLE `t` (`a` INT,`b` VARCHAR(10) COMMENT 'dm:invisible-column',INDEX `idx`(`a`) INVISIBLE)")
+}
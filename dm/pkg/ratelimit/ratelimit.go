@@ -0,0 +1,119 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// Limiter limits the rate of replication in both rows/sec and bytes/sec, the limits can be changed at runtime.
+type Limiter struct {
+	rows  *rate.Limiter
+	bytes *rate.Limiter
+
+	mu             sync.Mutex
+	rowsPerSecond  int64
+	bytesPerSecond int64
+	// the extra rows/sec limit set by the throttling of the DM-worker, it's kept apart from the limits set by users.
+	throttledRows int64
+}
+
+// NewLimiter creates a Limiter, non-positive limit means unlimited.
+func NewLimiter(rowsPerSecond, bytesPerSecond int64) *Limiter {
+	l := &Limiter{
+		rows:  rate.NewLimiter(rate.Inf, 1),
+		bytes: rate.NewLimiter(rate.Inf, 1),
+	}
+	l.SetLimit(rowsPerSecond, bytesPerSecond)
+	return l
+}
+
+// toLimit converts the limit per second to rate.Limit and burst.
+func toLimit(perSecond int64) (rate.Limit, int) {
+	if perSecond <= 0 {
+		return rate.Inf, 1
+	}
+	return rate.Limit(perSecond), int(perSecond)
+}
+
+// SetLimit changes the limits, it's safe to be called concurrently with WaitN.
+func (l *Limiter) SetLimit(rowsPerSecond, bytesPerSecond int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rowsPerSecond, l.bytesPerSecond = rowsPerSecond, bytesPerSecond
+	l.apply()
+}
+
+// SetThrottle sets an extra rows/sec limit besides the one set by SetLimit, the stricter one takes effect.
+// non-positive value removes the throttle.
+func (l *Limiter) SetThrottle(rowsPerSecond int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.throttledRows = rowsPerSecond
+	l.apply()
+}
+
+// Throttle returns the rows/sec limit set by SetThrottle.
+func (l *Limiter) Throttle() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.throttledRows
+}
+
+// apply updates the underlying limiters, caller should hold the lock.
+func (l *Limiter) apply() {
+	rows := l.rowsPerSecond
+	if l.throttledRows > 0 && (rows <= 0 || l.throttledRows < rows) {
+		rows = l.throttledRows
+	}
+	limit, burst := toLimit(rows)
+	l.rows.SetBurst(burst)
+	l.rows.SetLimit(limit)
+	limit, burst = toLimit(l.bytesPerSecond)
+	l.bytes.SetBurst(burst)
+	l.bytes.SetLimit(limit)
+}
+
+// Limits returns the limits of rows/sec and bytes/sec.
+func (l *Limiter) Limits() (rowsPerSecond, bytesPerSecond int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.rowsPerSecond, l.bytesPerSecond
+}
+
+// WaitN blocks until `rows` rows with `size` bytes can be replicated or the context is done.
+func (l *Limiter) WaitN(ctx context.Context, rows, size int) error {
+	if err := waitN(ctx, l.rows, rows); err != nil {
+		return err
+	}
+	return waitN(ctx, l.bytes, size)
+}
+
+// waitN waits for n tokens, they're waited by pieces if n is larger than the burst.
+func waitN(ctx context.Context, limiter *rate.Limiter, n int) error {
+	for n > 0 {
+		m := n
+		if burst := limiter.Burst(); limiter.Limit() != rate.Inf && m > burst {
+			m = burst
+		}
+		if err := limiter.WaitN(ctx, m); err != nil {
+			return err
+		}
+		n -= m
+	}
+	return nil
+}
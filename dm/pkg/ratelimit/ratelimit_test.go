@@ -0,0 +1,81 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/pingcap/check"
+	"golang.org/x/time/rate"
+)
+
+func TestSuite(t *testing.T) {
+	TestingT(t)
+}
+
+var _ = Suite(&testRateLimitSuite{})
+
+type testRateLimitSuite struct{}
+
+func (t *testRateLimitSuite) TestLimiter(c *C) {
+	ctx := context.Background()
+
+	// unlimited
+	l := NewLimiter(0, 0)
+	start := time.Now()
+	for i := 0; i < 1000; i++ {
+		c.Assert(l.WaitN(ctx, 100, 1<<20), IsNil)
+	}
+	c.Assert(time.Since(start), Less, time.Second)
+
+	// limit rows, no tokens are available after changing from unlimited
+	l.SetLimit(100, 0)
+	rowsPerSecond, bytesPerSecond := l.Limits()
+	c.Assert(rowsPerSecond, Equals, int64(100))
+	c.Assert(bytesPerSecond, Equals, int64(0))
+	start = time.Now()
+	c.Assert(l.WaitN(ctx, 50, 1<<20), IsNil)
+	c.Assert(time.Since(start), GreaterEqual, 400*time.Millisecond)
+
+	// limit bytes, the bytes larger than the burst are waited by pieces
+	l.SetLimit(0, 1000)
+	start = time.Now()
+	c.Assert(l.WaitN(ctx, 1000, 1200), IsNil)
+	c.Assert(time.Since(start), GreaterEqual, time.Second)
+
+	// the context is done
+	ctx2, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	c.Assert(l.WaitN(ctx2, 1, 10000), NotNil)
+
+	// change to unlimited at runtime
+	l.SetLimit(0, 0)
+	start = time.Now()
+	c.Assert(l.WaitN(ctx, 1<<20, 1<<30), IsNil)
+	c.Assert(time.Since(start), Less, time.Second)
+	// the throttle takes effect along with the limits set by users
+	l.SetThrottle(100)
+	c.Assert(l.Throttle(), Equals, int64(100))
+	rowsPerSecond, _ = l.Limits()
+	c.Assert(rowsPerSecond, Equals, int64(0))
+	start = time.Now()
+	c.Assert(l.WaitN(ctx, 150, 1), IsNil)
+	c.Assert(time.Since(start), GreaterEqual, 400*time.Millisecond)
+	l.SetLimit(1<<20, 0)
+	c.Assert(l.rows.Limit(), Equals, rate.Limit(100))
+	l.SetThrottle(0)
+	c.Assert(l.rows.Limit(), Equals, rate.Limit(1<<20))
+}
@@ -68,6 +68,8 @@ var (
 		int32(terror.ErrDumpUnitGlobalLock.Code()):          {},
 		int32(terror.ErrDumpUnitRuntime.Code()):             {},
 		int32(terror.ErrSyncerUnitDMLColumnNotMatch.Code()): {},
+		int32(terror.ErrSyncerDDLNeedApproval.Code()):       {},
+		int32(terror.ErrSyncerBinlogGap.Code()):             {},
 	}
 
 	// UnresumableRelayErrCodes is a set of unresumeable relay unit err codes.
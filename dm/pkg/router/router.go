@@ -0,0 +1,400 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package router extends the table router of tidb-tools with expressions in the target schema and table names of the
+// route rules, like `tbl_p${1 % 8}`, so the tables can be re-sharded during migration without a rule for each table.
+//
+// an expression is `${operand}` or `${operand op N}`, the operand is the index of a wildcard (`*`, `?` or a range
+// like `[0-9]`) in the schema and table patterns starting from 1, which is counted from the schema pattern to the
+// table pattern, or `schema` and `table` for the names of the source schema and table. the op is one of `+`, `-`,
+// `*`, `/` and `%`, and the value matched by the wildcard should be an integer when the op is used. for example, the
+// table `db_12`.`tbl` is routed to `merged`.`tbl_p4` by the rule with `db_*` as schema pattern, `tbl` as table
+// pattern, `merged` as target schema and `tbl_p${1 % 8}` as target table.
+package router
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/errors"
+	router "github.com/pingcap/tidb-tools/pkg/table-router"
+)
+
+// TableRule is a rule to route schema/table to target schema/table.
+type TableRule = router.TableRule
+
+// TableExtractor extracts table name to column.
+type TableExtractor = router.TableExtractor
+
+// SchemaExtractor extracts schema name to column.
+type SchemaExtractor = router.SchemaExtractor
+
+// SourceExtractor extracts source name to column.
+type SourceExtractor = router.SourceExtractor
+
+const (
+	exprPrefix = "${"
+	exprSuffix = "}"
+)
+
+var exprRegexp = regexp.MustCompile(`^\s*(\d+|schema|table)\s*(?:([-+*/%])\s*(\d+)\s*)?$`)
+
+// expr is an expression in the target name.
+type expr struct {
+	group int    // index of the wildcard, 0 if the operand is the schema or table name
+	name  string // `schema` or `table`
+	op    byte
+	value int64
+}
+
+func (e *expr) eval(schema, table string, groups []string) (string, error) {
+	var operand string
+	switch {
+	case e.name == "schema":
+		operand = schema
+	case e.name == "table":
+		operand = table
+	case e.group < len(groups):
+		operand = groups[e.group]
+	default:
+		return "", errors.NotValidf("wildcard %d of `%s`.`%s`", e.group, schema, table)
+	}
+	if e.op == 0 {
+		return operand, nil
+	}
+
+	v, err := strconv.ParseInt(operand, 10, 64)
+	if err != nil {
+		return "", errors.NotValidf("%s matched by wildcard %d of `%s`.`%s` is not an integer, which", operand, e.group, schema, table)
+	}
+	switch e.op {
+	case '+':
+		v += e.value
+	case '-':
+		v -= e.value
+	case '*':
+		v *= e.value
+	case '/':
+		v /= e.value
+	case '%':
+		v %= e.value
+	}
+	return strconv.FormatInt(v, 10), nil
+}
+
+// template is a target name with expressions, the literals and the expressions are interleaved.
+type template struct {
+	literals []string // len(literals) == len(exprs) + 1
+	exprs    []*expr
+}
+
+func hasExpr(name string) bool {
+	return strings.Contains(name, exprPrefix)
+}
+
+// parseTemplate parses the target name, groups is the number of wildcards of the rule.
+func parseTemplate(name string, groups int) (*template, error) {
+	t := &template{}
+	rest := name
+	for {
+		start := strings.Index(rest, exprPrefix)
+		if start < 0 {
+			t.literals = append(t.literals, rest)
+			return t, nil
+		}
+		end := strings.Index(rest[start:], exprSuffix)
+		if end < 0 {
+			return nil, errors.NotValidf("unclosed expression in %s, which", name)
+		}
+		end += start
+
+		matches := exprRegexp.FindStringSubmatch(rest[start+len(exprPrefix) : end])
+		if matches == nil {
+			return nil, errors.NotValidf("expression %s in %s", rest[start:end+len(exprSuffix)], name)
+		}
+		e := &expr{}
+		if g, err := strconv.Atoi(matches[1]); err == nil {
+			if g < 1 || g > groups {
+				return nil, errors.NotValidf("wildcard %d in %s, there are %d wildcards in the patterns, which", g, name, groups)
+			}
+			e.group = g
+		} else {
+			e.name = matches[1]
+		}
+		if matches[2] != "" {
+			e.op = matches[2][0]
+			e.value, _ = strconv.ParseInt(matches[3], 10, 64)
+			if e.value == 0 && (e.op == '/' || e.op == '%') {
+				return nil, errors.NotValidf("division by zero in %s, which", name)
+			}
+		}
+		t.literals = append(t.literals, rest[:start])
+		t.exprs = append(t.exprs, e)
+		rest = rest[end+len(exprSuffix):]
+	}
+}
+
+func (t *template) eval(schema, table string, groups []string) (string, error) {
+	var b strings.Builder
+	for i, e := range t.exprs {
+		b.WriteString(t.literals[i])
+		v, err := e.eval(schema, table, groups)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(v)
+	}
+	b.WriteString(t.literals[len(t.literals)-1])
+	return b.String(), nil
+}
+
+// patternToRegexp converts the wildcards of the pattern to the capturing groups of a regexp, and returns the number
+// of the wildcards. the ranges like `[0-9]` and `[!a]` are wildcards too.
+func patternToRegexp(pattern string) (string, int) {
+	var (
+		b      strings.Builder
+		groups int
+	)
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			b.WriteString("(.*)")
+			groups++
+		case '?':
+			b.WriteString("(.)")
+			groups++
+		case '[':
+			end := strings.IndexByte(pattern[i:], ']')
+			if end < 0 {
+				b.WriteString(regexp.QuoteMeta(pattern[i:]))
+				return b.String(), groups
+			}
+			ranges := pattern[i+1 : i+end]
+			b.WriteString("([")
+			if strings.HasPrefix(ranges, "!") {
+				b.WriteString("^")
+				ranges = ranges[1:]
+			}
+			b.WriteString(strings.NewReplacer(`\`, `\\`, "^", `\^`, "[", `\[`).Replace(ranges))
+			b.WriteString("])")
+			groups++
+			i += end
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return b.String(), groups
+}
+
+// exprRule is a route rule with expressions in the target names.
+type exprRule struct {
+	schemaRegexp *regexp.Regexp
+	tableRegexp  *regexp.Regexp // matches the schema and table names, which is `schema.table`
+	targetSchema *template
+	targetTable  *template
+}
+
+func newExprRule(caseSensitive bool, rule *TableRule) (*exprRule, error) {
+	flags := ""
+	if !caseSensitive {
+		flags = "(?i)"
+	}
+	schemaRe, schemaGroups := patternToRegexp(rule.SchemaPattern)
+	tableRe, tableGroups := patternToRegexp(rule.TablePattern)
+
+	r := &exprRule{}
+	var err error
+	if r.schemaRegexp, err = regexp.Compile(fmt.Sprintf("%s^%s$", flags, schemaRe)); err != nil {
+		return nil, errors.Annotatef(err, "schema pattern %s", rule.SchemaPattern)
+	}
+	if r.tableRegexp, err = regexp.Compile(fmt.Sprintf(`%s^%s\.%s$`, flags, schemaRe, tableRe)); err != nil {
+		return nil, errors.Annotatef(err, "table pattern %s", rule.TablePattern)
+	}
+	if hasExpr(rule.TargetSchema) {
+		// the target schema is used when routing a schema, so it can only use the wildcards in the schema pattern.
+		if r.targetSchema, err = parseTemplate(rule.TargetSchema, schemaGroups); err != nil {
+			return nil, err
+		}
+	}
+	if hasExpr(rule.TargetTable) {
+		if r.targetTable, err = parseTemplate(rule.TargetTable, schemaGroups+tableGroups); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Table routes schema/table to target schema/table by given route rules, the expressions in the target names of the
+// rules are evaluated by the source schema and table.
+type Table struct {
+	*router.Table
+
+	caseSensitive   bool
+	exprRules       map[*TableRule]*exprRule
+	sourceTagColumn string
+}
+
+// NewTableRouter returns a table router.
+func NewTableRouter(caseSensitive bool, rules []*TableRule) (*Table, error) {
+	r, err := router.NewTableRouter(caseSensitive, nil)
+	if err != nil {
+		return nil, err
+	}
+	t := &Table{
+		Table:         r,
+		caseSensitive: caseSensitive,
+		exprRules:     make(map[*TableRule]*exprRule),
+	}
+	for _, rule := range rules {
+		if err := t.AddRule(rule); err != nil {
+			return nil, errors.Annotatef(err, "initial rule %+v in table router", rule)
+		}
+	}
+	return t, nil
+}
+
+// HasExpression returns whether the target schema or table name of the rule contains expressions.
+func HasExpression(rule *TableRule) bool {
+	return hasExpr(rule.TargetSchema) || hasExpr(rule.TargetTable)
+}
+
+// AddRule adds a rule into table router.
+func (t *Table) AddRule(rule *TableRule) error {
+	if err := t.Table.AddRule(rule); err != nil {
+		return err
+	}
+	return t.updateExprRule(rule)
+}
+
+// UpdateRule updates rule.
+func (t *Table) UpdateRule(rule *TableRule) error {
+	if err := t.Table.UpdateRule(rule); err != nil {
+		return err
+	}
+	return t.updateExprRule(rule)
+}
+
+// RemoveRule removes a rule from table router.
+func (t *Table) RemoveRule(rule *TableRule) error {
+	if err := t.Table.RemoveRule(rule); err != nil {
+		return err
+	}
+	delete(t.exprRules, rule)
+	return nil
+}
+
+// updateExprRule parses the expressions of the rule, it's called after the rule is added into the table router, so
+// the patterns are converted to lower case if the router is not case-sensitive.
+func (t *Table) updateExprRule(rule *TableRule) error {
+	delete(t.exprRules, rule)
+	if !HasExpression(rule) {
+		return nil
+	}
+	er, err := newExprRule(t.caseSensitive, rule)
+	if err != nil {
+		_ = t.Table.RemoveRule(rule)
+		return errors.Annotatef(err, "add rule %+v into table router", rule)
+	}
+	t.exprRules[rule] = er
+	return nil
+}
+
+// SetSourceTagColumn sets the extra column which is populated with the source ID for all tables, whether they are
+// matched by the route rules or not.
+func (t *Table) SetSourceTagColumn(column string) {
+	t.sourceTagColumn = column
+}
+
+// FetchExtendColumn returns the extended columns and their values of the table, which are extracted by the matched
+// route rule, and the source tag column if it's not extracted by the rule.
+func (t *Table) FetchExtendColumn(schema, table, source string) ([]string, []string) {
+	cols, vals := t.Table.FetchExtendColumn(schema, table, source)
+	if t.sourceTagColumn == "" {
+		return cols, vals
+	}
+	for _, col := range cols {
+		if col == t.sourceTagColumn {
+			return cols, vals
+		}
+	}
+	return append(cols, t.sourceTagColumn), append(vals, source)
+}
+
+// Route routes schema/table to target schema/table.
+func (t *Table) Route(schema, table string) (string, string, error) {
+	targetSchema, targetTable, err := t.Table.Route(schema, table)
+	if err != nil || len(t.exprRules) == 0 {
+		return targetSchema, targetTable, err
+	}
+	rule := t.matchedRule(schema, table)
+	if rule == nil {
+		return targetSchema, targetTable, nil
+	}
+	er, ok := t.exprRules[rule]
+	if !ok {
+		return targetSchema, targetTable, nil
+	}
+
+	if len(table) == 0 {
+		// route a schema
+		if er.targetSchema != nil {
+			groups := er.schemaRegexp.FindStringSubmatch(schema)
+			if targetSchema, err = er.targetSchema.eval(schema, table, groups); err != nil {
+				return "", "", err
+			}
+		}
+		return targetSchema, targetTable, nil
+	}
+
+	groups := er.tableRegexp.FindStringSubmatch(schema + "." + table)
+	if groups == nil {
+		// it's a schema level rule
+		groups = er.schemaRegexp.FindStringSubmatch(schema)
+	}
+	if er.targetSchema != nil {
+		if targetSchema, err = er.targetSchema.eval(schema, table, groups); err != nil {
+			return "", "", err
+		}
+	}
+	if er.targetTable != nil {
+		if targetTable, err = er.targetTable.eval(schema, table, groups); err != nil {
+			return "", "", err
+		}
+	}
+	return targetSchema, targetTable, nil
+}
+
+// matchedRule returns the rule used to route the schema/table, the table level rules have higher priority.
+func (t *Table) matchedRule(schema, table string) *TableRule {
+	if !t.caseSensitive {
+		schema, table = strings.ToLower(schema), strings.ToLower(table)
+	}
+	var schemaRule, tableRule *TableRule
+	for _, r := range t.Match(schema, table) {
+		rule, ok := r.(*TableRule)
+		if !ok {
+			continue
+		}
+		if len(rule.TablePattern) == 0 {
+			schemaRule = rule
+		} else {
+			tableRule = rule
+		}
+	}
+	if len(table) == 0 || tableRule == nil {
+		return schemaRule
+	}
+	return tableRule
+}
@@ -0,0 +1,133 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package router
+
+import (
+	"testing"
+
+	. "github.com/pingcap/check"
+)
+
+func TestSuite(t *testing.T) {
+	TestingT(t)
+}
+
+var _ = Suite(&testRouterSuite{})
+
+type testRouterSuite struct{}
+
+func (t *testRouterSuite) TestRoute(c *C) {
+	rules := []*TableRule{
+		{SchemaPattern: "db_*", TablePattern: "tbl", TargetSchema: "merged", TargetTable: "tbl_p${1 % 8}"},
+		{SchemaPattern: "db_*", TablePattern: "t_?_*", TargetSchema: "s_${1}", TargetTable: "${table}_${2}_${3 + 100}"},
+		{SchemaPattern: "db_*", TargetSchema: "db_${1 / 10}"},
+		{SchemaPattern: "Shop_[0-9]", TablePattern: "orders", TargetSchema: "shop", TargetTable: "orders_${1}"},
+		{SchemaPattern: "plain", TablePattern: "*", TargetSchema: "plain_merged", TargetTable: "t"},
+	}
+	r, err := NewTableRouter(false, rules)
+	c.Assert(err, IsNil)
+
+	cases := []struct {
+		schema, table             string
+		targetSchema, targetTable string
+	}{
+		{"db_12", "tbl", "merged", "tbl_p4"},
+		{"db_3", "tbl", "merged", "tbl_p3"},
+		{"DB_3", "TBL", "merged", "tbl_p3"},
+		{"db_3", "t_a_7", "s_3", "t_a_7_a_107"},
+		// the schema level rule
+		{"db_25", "others", "db_2", "others"},
+		{"db_25", "", "db_2", ""},
+		{"shop_5", "orders", "shop", "orders_5"},
+		{"plain", "t1", "plain_merged", "t"},
+		{"not_matched", "t1", "not_matched", "t1"},
+	}
+	for _, cs := range cases {
+		schema, table, err := r.Route(cs.schema, cs.table)
+		c.Assert(err, IsNil)
+		c.Assert(schema, Equals, cs.targetSchema, Commentf("%s.%s", cs.schema, cs.table))
+		c.Assert(table, Equals, cs.targetTable, Commentf("%s.%s", cs.schema, cs.table))
+	}
+
+	// the value matched by the wildcard is not an integer
+	_, _, err = r.Route("db_x", "tbl")
+	c.Assert(err, ErrorMatches, ".*is not an integer.*")
+
+	// the rules can be removed and added again
+	c.Assert(r.RemoveRule(rules[0]), IsNil)
+	schema, table, err := r.Route("db_12", "tbl")
+	c.Assert(err, IsNil)
+	c.Assert(schema, Equals, "db_1")
+	c.Assert(table, Equals, "tbl")
+	c.Assert(r.AddRule(rules[0]), IsNil)
+	schema, table, err = r.Route("db_12", "tbl")
+	c.Assert(err, IsNil)
+	c.Assert(schema, Equals, "merged")
+	c.Assert(table, Equals, "tbl_p4")
+}
+
+func (t *testRouterSuite) TestInvalidExpression(c *C) {
+	invalid := []*TableRule{
+		{SchemaPattern: "db_*", TablePattern: "tbl", TargetSchema: "merged", TargetTable: "tbl_${1 % 8"},
+		{SchemaPattern: "db_*", TablePattern: "tbl", TargetSchema: "merged", TargetTable: "tbl_${2}"},
+		{SchemaPattern: "db_*", TablePattern: "tbl_*", TargetSchema: "merged_${2}", TargetTable: "tbl"},
+		{SchemaPattern: "db_*", TablePattern: "tbl", TargetSchema: "merged", TargetTable: "tbl_${1 % 0}"},
+		{SchemaPattern: "db_*", TablePattern: "tbl", TargetSchema: "merged", TargetTable: "tbl_${1 ^ 2}"},
+		{SchemaPattern: "db_*", TablePattern: "tbl", TargetSchema: "merged", TargetTable: "tbl_${source}"},
+	}
+	for _, rule := range invalid {
+		_, err := NewTableRouter(false, []*TableRule{rule})
+		c.Assert(err, NotNil, Commentf("%+v", rule))
+	}
+
+	c.Assert(HasExpression(&TableRule{TargetSchema: "db", TargetTable: "tbl_${1}"}), IsTrue)
+	c.Assert(HasExpression(&TableRule{TargetSchema: "db", TargetTable: "tbl"}), IsFalse)
+}
+
+func (t *testRouterSuite) TestSourceTagColumn(c *C) {
+	rules := []*TableRule{
+		{SchemaPattern: "db_*", TablePattern: "tbl_*", TargetSchema: "db", TargetTable: "tbl"},
+		{
+			SchemaPattern: "db_*", TablePattern: "t_*", TargetSchema: "db", TargetTable: "t",
+			TableExtractor: &TableExtractor{TargetColumn: "c_table", TableRegexp: "t_(.*)"},
+		},
+		{
+			SchemaPattern: "db_*", TargetSchema: "db",
+			SourceExtractor: &SourceExtractor{TargetColumn: "_dm_source", SourceRegexp: "mysql-(.*)"},
+		},
+	}
+	r, err := NewTableRouter(false, rules)
+	c.Assert(err, IsNil)
+	cols, vals := r.FetchExtendColumn("other", "tbl", "mysql-01")
+	c.Assert(cols, HasLen, 0)
+	c.Assert(vals, HasLen, 0)
+
+	// the source tag column is populated for all tables, including the tables not matched by any rule
+	r.SetSourceTagColumn("_dm_source")
+	cases := []struct {
+		schema, table string
+		cols, vals    []string
+	}{
+		{"other", "tbl", []string{"_dm_source"}, []string{"mysql-01"}},
+		{"db_1", "tbl_1", []string{"_dm_source"}, []string{"mysql-01"}},
+		{"db_1", "t_1", []string{"c_table", "_dm_source"}, []string{"1", "mysql-01"}},
+		// the column is extracted by the rule already
+		{"db_1", "x", []string{"_dm_source"}, []string{"01"}},
+	}
+	for _, cs := range cases {
+		cols, vals = r.FetchExtendColumn(cs.schema, cs.table, "mysql-01")
+		c.Assert(cols, DeepEquals, cs.cols, Commentf("%+v", cs))
+		c.Assert(vals, DeepEquals, cs.vals, Commentf("%+v", cs))
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rowfilter defines the interface of the external row filters, which are loaded from Go plugins and decide
+// whether to keep, drop or rewrite the row changes.
+//
+// A plugin is built by `go build -buildmode=plugin` with the same version of Go and DM, and exports a function named
+// `NewRowFilter` with the type of NewFilterFunc, like
+//
+//	func NewRowFilter(args string) (rowfilter.RowFilter, error) {
+//		return &myFilter{}, nil
+//	}
+package rowfilter
+
+import (
+	"fmt"
+	"plugin"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// NewFilterSymbol is the name of the function exported by the plugin to create the RowFilter.
+const NewFilterSymbol = "NewRowFilter"
+
+// NewFilterFunc creates a RowFilter with the `plugin-args` of the expression filter.
+type NewFilterFunc = func(args string) (RowFilter, error)
+
+// ChangeType is the type of a row change.
+type ChangeType int
+
+// types of row changes.
+const (
+	Insert ChangeType = iota + 1
+	Update
+	Delete
+)
+
+func (t ChangeType) String() string {
+	switch t {
+	case Insert:
+		return "insert"
+	case Update:
+		return "update"
+	case Delete:
+		return "delete"
+	}
+	return "unknown"
+}
+
+// Action is the decision of a RowFilter on a row change.
+type Action int
+
+const (
+	// Keep replicates the row change as it is.
+	Keep Action = iota
+	// Drop skips the row change.
+	Drop
+	// Rewrite replicates the row change with the values modified by the RowFilter.
+	Rewrite
+)
+
+// RowChange is a decoded row change of the upstream table. OldValues is set for UPDATE and DELETE, NewValues is set
+// for INSERT and UPDATE, the values are in the order of Columns.
+type RowChange struct {
+	Schema    string
+	Table     string
+	Type      ChangeType
+	Columns   []string
+	OldValues []interface{}
+	NewValues []interface{}
+}
+
+// RowFilter decides what to do with the row changes. when it returns Rewrite, the values of RowChange are modified
+// in place and the length of them should not be changed.
+type RowFilter interface {
+	Filter(change *RowChange) (Action, error)
+}
+
+// Load opens the Go plugin and creates a RowFilter by its NewFilterFunc.
+func Load(path, args string) (RowFilter, error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, terror.ErrSyncerRowFilterPlugin.Delegate(err, "open", path)
+	}
+	sym, err := p.Lookup(NewFilterSymbol)
+	if err != nil {
+		return nil, terror.ErrSyncerRowFilterPlugin.Delegate(err, "lookup "+NewFilterSymbol, path)
+	}
+	newFilter, ok := sym.(NewFilterFunc)
+	if !ok {
+		return nil, terror.ErrSyncerRowFilterPlugin.Generate(fmt.Sprintf("use %s of type %T", NewFilterSymbol, sym), path)
+	}
+	filter, err := newFilter(args)
+	if err != nil {
+		return nil, terror.ErrSyncerRowFilterPlugin.Delegate(err, "create filter", path)
+	}
+	return filter, nil
+}
@@ -0,0 +1,166 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"container/list"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser/model"
+)
+
+// trackedTable is a table in the LRU list of the tracker.
+type trackedTable struct {
+	table *filter.Table
+	ti    *model.TableInfo
+	// size is the estimated memory of the table info, it's the size of the JSON encoded table info.
+	size int64
+}
+
+// tableLRU records the tables got from the tracker in the order of the last use, and the estimated memory of their
+// table infos, to evict the cold tables from the tracker.
+type tableLRU struct {
+	mu    sync.Mutex
+	list  *list.List                          // the most recently used table is at the front
+	elems map[string]map[string]*list.Element // lower-case schema -> lower-case table -> element
+	size  int64
+}
+
+func newTableLRU() *tableLRU {
+	return &tableLRU{
+		list:  list.New(),
+		elems: make(map[string]map[string]*list.Element),
+	}
+}
+
+func estimateTableInfoSize(ti *model.TableInfo) int64 {
+	data, err := json.Marshal(ti)
+	if err != nil {
+		return 0
+	}
+	return int64(len(data))
+}
+
+// touch moves the table to the front of the LRU list, and updates the estimated memory if the table info is changed.
+func (l *tableLRU) touch(table *filter.Table, ti *model.TableInfo) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	schemaL, tableL := strings.ToLower(table.Schema), strings.ToLower(table.Name)
+	if e, ok := l.elems[schemaL][tableL]; ok {
+		l.list.MoveToFront(e)
+		tt := e.Value.(*trackedTable)
+		if tt.ti != ti {
+			size := estimateTableInfoSize(ti)
+			l.size += size - tt.size
+			tt.ti, tt.size = ti, size
+		}
+		return
+	}
+
+	tt := &trackedTable{
+		table: &filter.Table{Schema: table.Schema, Name: table.Name},
+		ti:    ti,
+		size:  estimateTableInfoSize(ti),
+	}
+	if _, ok := l.elems[schemaL]; !ok {
+		l.elems[schemaL] = make(map[string]*list.Element)
+	}
+	l.elems[schemaL][tableL] = l.list.PushFront(tt)
+	l.size += tt.size
+}
+
+func (l *tableLRU) remove(table *filter.Table) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	schemaL, tableL := strings.ToLower(table.Schema), strings.ToLower(table.Name)
+	e, ok := l.elems[schemaL][tableL]
+	if !ok {
+		return
+	}
+	l.list.Remove(e)
+	l.size -= e.Value.(*trackedTable).size
+	delete(l.elems[schemaL], tableL)
+	if len(l.elems[schemaL]) == 0 {
+		delete(l.elems, schemaL)
+	}
+}
+
+func (l *tableLRU) reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.list.Init()
+	l.elems = make(map[string]map[string]*list.Element)
+	l.size = 0
+}
+
+func (l *tableLRU) totalSize() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.size
+}
+
+// coldest returns the tables from the least recently used one.
+func (l *tableLRU) coldest() []*filter.Table {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	tables := make([]*filter.Table, 0, l.list.Len())
+	for e := l.list.Back(); e != nil; e = e.Prev() {
+		tables = append(tables, e.Value.(*trackedTable).table)
+	}
+	return tables
+}
+
+// TrackedTablesMemory returns the estimated memory of the table infos got from the tracker.
+func (tr *Tracker) TrackedTablesMemory() int64 {
+	return tr.lru.totalSize()
+}
+
+// EvictColdTables drops the least recently used tables from the tracker until the estimated memory of the table infos
+// isn't larger than budget, and returns the number of evicted tables. a table is evicted only if canEvict returns true
+// for its current table info, the caller should make sure the table info can be loaded again, e.g. it's flushed with
+// the checkpoint.
+func (tr *Tracker) EvictColdTables(budget int64, canEvict func(table *filter.Table, ti *model.TableInfo) bool) (int, error) {
+	if tr.lru.totalSize() <= budget {
+		return 0, nil
+	}
+
+	evicted := 0
+	for _, table := range tr.lru.coldest() {
+		if tr.lru.totalSize() <= budget {
+			break
+		}
+		t, err := tr.dom.InfoSchema().TableByName(model.NewCIStr(table.Schema), model.NewCIStr(table.Name))
+		if err != nil {
+			if !IsTableNotExists(err) {
+				return evicted, err
+			}
+			// the table is dropped or renamed by DDLs.
+			tr.lru.remove(table)
+			continue
+		}
+		if !canEvict(table, t.Meta()) {
+			continue
+		}
+		if err = tr.DropTable(table); err != nil {
+			return evicted, err
+		}
+		evicted++
+	}
+	return evicted, nil
+}
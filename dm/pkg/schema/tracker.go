@@ -66,6 +66,7 @@ type Tracker struct {
 	dom       *domain.Domain
 	se        session.Session
 	dsTracker *downstreamTracker
+	lru       *tableLRU
 }
 
 // downstreamTracker tracks downstream schema.
@@ -80,6 +81,19 @@ type DownstreamTableInfo struct {
 	TableInfo            *model.TableInfo   // tableInfo which comes from parse create statement syntaxtree
 	AbsoluteUKIndexInfo  *model.IndexInfo   // absolute uk index is a pk/uk(not null)
 	AvailableUKIndexList []*model.IndexInfo // index list which is all uks
+	// HasUntrackedUK is true if some PK/UK can't be redirected to the upstream columns, e.g. it's an expression index
+	// or it contains columns only exist in downstream. the conflicts on such keys can't be detected by row values.
+	HasUntrackedUK bool
+	// ForeignKeys are the foreign keys whose columns can be redirected to the upstream columns.
+	ForeignKeys []*ForeignKeyInfo
+}
+
+// ForeignKeyInfo is a foreign key of downstream table, the columns are redirected to the upstream columns.
+type ForeignKeyInfo struct {
+	RefTable   model.CIStr
+	RefColumns []model.CIStr
+	// Offsets are the offsets of the columns in the upstream table info.
+	Offsets []int
 }
 
 // NewTracker creates a new tracker. `sessionCfg` will be set as tracker's session variables if specified, or retrieve
@@ -102,6 +116,10 @@ func NewTracker(ctx context.Context, task string, sessionCfg map[string]string,
 	// get variables if user doesn't specify
 	// all cfg in downstreamVars should be lower case
 	for _, k := range downstreamVars {
+		if isPostgres(downstreamConn) {
+			// the variables are MySQL specific.
+			break
+		}
 		if _, ok := sessionCfg[k]; !ok {
 			var ignoredColumn interface{}
 			rows, err2 := downstreamConn.QuerySQL(tctx, fmt.Sprintf("SHOW VARIABLES LIKE '%s'", k))
@@ -189,6 +207,7 @@ func NewTracker(ctx context.Context, task string, sessionCfg map[string]string,
 		dom:       dom,
 		se:        se,
 		dsTracker: dsTracker,
+		lru:       newTableLRU(),
 	}, nil
 }
 
@@ -207,6 +226,7 @@ func (tr *Tracker) GetTableInfo(table *filter.Table) (*model.TableInfo, error) {
 	if err != nil {
 		return nil, err
 	}
+	tr.lru.touch(table, t.Meta())
 	return t.Meta(), nil
 }
 
@@ -311,6 +331,7 @@ func (tr *Tracker) Reset() error {
 			return err
 		}
 	}
+	tr.lru.reset()
 	return nil
 }
 
@@ -327,6 +348,7 @@ func (tr *Tracker) DropTable(table *filter.Table) error {
 		Schema: model.NewCIStr(table.Schema),
 		Name:   model.NewCIStr(table.Name),
 	}
+	tr.lru.remove(table)
 	return tr.dom.DDL().DropTable(tr.se, tableIdent)
 }
 
@@ -397,10 +419,17 @@ func (tr *Tracker) GetDownStreamTableInfo(tctx *tcontext.Context, tableID string
 	dti, ok := tr.dsTracker.tableInfos[tableID]
 	if !ok {
 		tctx.Logger.Info("Downstream schema tracker init. ", zap.String("tableID", tableID))
-		ti, err := tr.getTableInfoByCreateStmt(tctx, tableID)
-		if err != nil {
-			tctx.Logger.Error("Init dowstream schema info error. ", zap.String("tableID", tableID), zap.Error(err))
-			return nil, err
+		// the table of PostgreSQL is created by the converted DDLs of the upstream, so they have the same keys.
+		// and there is no downstream table when the changes are published to Kafka, or the downstream table isn't
+		// changed by DDLs in dry-run mode.
+		ti := originTi
+		if !isPostgres(tr.dsTracker.downstreamConn) && !isSinkToMQ(tr.dsTracker.downstreamConn) && !isDryRun(tr.dsTracker.downstreamConn) {
+			var err error
+			ti, err = tr.getTableInfoByCreateStmt(tctx, tableID)
+			if err != nil {
+				tctx.Logger.Error("Init dowstream schema info error. ", zap.String("tableID", tableID), zap.Error(err))
+				return nil, err
+			}
 		}
 
 		dti = GetDownStreamTI(ti, originTi)
@@ -462,6 +491,21 @@ func (tr *Tracker) RemoveDownstreamSchema(tctx *tcontext.Context, targetTables [
 	}
 }
 
+// isPostgres returns whether the downstream database is PostgreSQL.
+func isPostgres(downstreamConn *dbconn.DBConn) bool {
+	return downstreamConn != nil && downstreamConn.Cfg != nil && downstreamConn.Cfg.To.IsPostgres()
+}
+
+// isSinkToMQ returns whether the changes are published to a message queue rather than the downstream database.
+func isSinkToMQ(downstreamConn *dbconn.DBConn) bool {
+	return downstreamConn != nil && downstreamConn.Cfg != nil && downstreamConn.Cfg.SinkURI != ""
+}
+
+// isDryRun returns whether the statements are written to file rather than executed in the downstream database.
+func isDryRun(downstreamConn *dbconn.DBConn) bool {
+	return downstreamConn != nil && downstreamConn.Cfg != nil && downstreamConn.Cfg.DryRunDir != ""
+}
+
 // getTableInfoByCreateStmt get downstream tableInfo by "SHOW CREATE TABLE" stmt.
 func (tr *Tracker) getTableInfoByCreateStmt(tctx *tcontext.Context, tableID string) (*model.TableInfo, error) {
 	if tr.dsTracker.stmtParser == nil {
@@ -510,6 +554,7 @@ func GetDownStreamTI(downstreamTI *model.TableInfo, originTi *model.TableInfo) *
 		absoluteUKIndexInfo  *model.IndexInfo
 		availableUKIndexList = []*model.IndexInfo{}
 		hasPk                = false
+		hasUntrackedUK       = false
 		absoluteUKPosition   = -1
 	)
 
@@ -524,6 +569,7 @@ func GetDownStreamTI(downstreamTI *model.TableInfo, originTi *model.TableInfo) *
 		}
 		indexRedirect := redirectIndexKeys(idx, originTi)
 		if indexRedirect == nil {
+			hasUntrackedUK = true
 			continue
 		}
 		availableUKIndexList = append(availableUKIndexList, indexRedirect)
@@ -558,10 +604,43 @@ func GetDownStreamTI(downstreamTI *model.TableInfo, originTi *model.TableInfo) *
 		TableInfo:            downstreamTI,
 		AbsoluteUKIndexInfo:  absoluteUKIndexInfo,
 		AvailableUKIndexList: availableUKIndexList,
+		HasUntrackedUK:       hasUntrackedUK,
+		ForeignKeys:          redirectForeignKeys(downstreamTI.ForeignKeys, originTi),
+	}
+}
+
+// redirectForeignKeys redirects the columns of foreign keys to the columns of origin tableinfo. the foreign keys with
+// some columns not in origin tableinfo are ignored.
+func redirectForeignKeys(fks []*model.FKInfo, originTi *model.TableInfo) []*ForeignKeyInfo {
+	if originTi == nil {
+		return nil
+	}
+	var foreignKeys []*ForeignKeyInfo
+OUTER:
+	for _, fk := range fks {
+		if len(fk.Cols) == 0 || len(fk.Cols) != len(fk.RefCols) {
+			continue
+		}
+		offsets := make([]int, 0, len(fk.Cols))
+		for _, col := range fk.Cols {
+			originColumn := model.FindColumnInfo(originTi.Columns, col.L)
+			if originColumn == nil || originColumn.Hidden {
+				continue OUTER
+			}
+			offsets = append(offsets, originColumn.Offset)
+		}
+		foreignKeys = append(foreignKeys, &ForeignKeyInfo{
+			RefTable:   fk.RefTable,
+			RefColumns: fk.RefCols,
+			Offsets:    offsets,
+		})
 	}
+	return foreignKeys
 }
 
 // redirectIndexKeys redirect index's columns offset in origin tableinfo.
+// returns nil if some columns are not in origin tableinfo or are hidden, the values of hidden columns (which are
+// generated for expression index) are not in binlog.
 func redirectIndexKeys(index *model.IndexInfo, originTi *model.TableInfo) *model.IndexInfo {
 	if index == nil || originTi == nil {
 		return nil
@@ -570,7 +649,7 @@ func redirectIndexKeys(index *model.IndexInfo, originTi *model.TableInfo) *model
 	columns := make([]*model.IndexColumn, 0, len(index.Columns))
 	for _, key := range index.Columns {
 		originColumn := model.FindColumnInfo(originTi.Columns, key.Name.L)
-		if originColumn == nil {
+		if originColumn == nil || originColumn.Hidden {
 			return nil
 		}
 		column := &model.IndexColumn{
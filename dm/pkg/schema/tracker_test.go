@@ -1040,3 +1040,60 @@ func (s *trackerSuite) TestReTrackDownStreamIndex(c *C) {
 	_, ok = tracker.dsTracker.tableInfos[tableID]
 	c.Assert(ok, IsTrue)
 }
+
+func (s *trackerSuite) TestEvictColdTables(c *C) {
+	log.SetLevel(zapcore.ErrorLevel)
+	ctx := context.Background()
+	tracker, err := NewTracker(ctx, "test-tracker", defaultTestSessionCfg, s.dbConn)
+	c.Assert(err, IsNil)
+	defer func() {
+		c.Assert(tracker.Close(), IsNil)
+	}()
+
+	c.Assert(tracker.CreateSchemaIfNotExists("testdb"), IsNil)
+	tables := make([]*filter.Table, 3)
+	for i := range tables {
+		tables[i] = &filter.Table{Schema: "testdb", Name: fmt.Sprintf("tb%d", i)}
+		c.Assert(tracker.Exec(ctx, "testdb", fmt.Sprintf("create table tb%d (a int, b varchar(10))", i)), IsNil)
+	}
+	c.Assert(tracker.TrackedTablesMemory(), Equals, int64(0))
+
+	// the tables are recorded when they're got, tb0 is the least recently used one
+	var size int64
+	for _, table := range tables {
+		ti, err2 := tracker.GetTableInfo(table)
+		c.Assert(err2, IsNil)
+		size = estimateTableInfoSize(ti)
+	}
+	c.Assert(size, Greater, int64(0))
+	c.Assert(tracker.TrackedTablesMemory(), Equals, 3*size)
+	_, err = tracker.GetTableInfo(tables[0])
+	c.Assert(err, IsNil)
+
+	// nothing is evicted within the budget
+	evicted, err := tracker.EvictColdTables(3*size, func(*filter.Table, *model.TableInfo) bool { return true })
+	c.Assert(err, IsNil)
+	c.Assert(evicted, Equals, 0)
+
+	// tb1 can't be evicted, so tb2 is evicted instead
+	evicted, err = tracker.EvictColdTables(2*size, func(table *filter.Table, _ *model.TableInfo) bool {
+		return table.Name != "tb1"
+	})
+	c.Assert(err, IsNil)
+	c.Assert(evicted, Equals, 1)
+	c.Assert(tracker.TrackedTablesMemory(), Equals, 2*size)
+	_, err = tracker.GetTableInfo(tables[2])
+	c.Assert(IsTableNotExists(err), IsTrue)
+	_, err = tracker.GetTableInfo(tables[1])
+	c.Assert(err, IsNil)
+
+	// the dropped tables are removed without being counted
+	c.Assert(tracker.Exec(ctx, "testdb", "drop table tb0"), IsNil)
+	evicted, err = tracker.EvictColdTables(size, func(*filter.Table, *model.TableInfo) bool { return true })
+	c.Assert(err, IsNil)
+	c.Assert(evicted, Equals, 0)
+	c.Assert(tracker.TrackedTablesMemory(), Equals, size)
+
+	c.Assert(tracker.Reset(), IsNil)
+	c.Assert(tracker.TrackedTablesMemory(), Equals, int64(0))
+}
@@ -0,0 +1,181 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package optimism
+
+import (
+	"sort"
+
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	"github.com/pingcap/tidb-tools/pkg/schemacmp"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// TableSchema is the schema of an upstream table in the shard DDL lock.
+type TableSchema struct {
+	Source string
+	Schema string
+	Table  string
+	// Tracked is the schema of the table tracked by the lock.
+	Tracked schemacmp.Table
+	// Conflict is the schema rejected by the lock because of conflicts, it's nil if no conflict for the table.
+	Conflict *schemacmp.Table
+}
+
+// ColumnType is the type of a column in an upstream table.
+type ColumnType struct {
+	Source string
+	Schema string
+	Table  string
+	Type   string
+}
+
+// ConflictColumn is a column whose types in the upstream tables can't be joined.
+type ConflictColumn struct {
+	Name  string
+	Types []ColumnType
+}
+
+// LockInspection is the detail of the shard DDL lock used to diagnose the conflicts.
+type LockInspection struct {
+	ID              string
+	Task            string
+	Joined          schemacmp.Table
+	Tables          []TableSchema
+	ConflictColumns []ConflictColumn
+}
+
+// addConflict records the table info rejected because of conflicts.
+func (l *Lock) addConflict(source, schema, table string, ti schemacmp.Table) {
+	if _, ok := l.conflicts[source]; !ok {
+		l.conflicts[source] = make(map[string]map[string]schemacmp.Table)
+	}
+	if _, ok := l.conflicts[source][schema]; !ok {
+		l.conflicts[source][schema] = make(map[string]schemacmp.Table)
+	}
+	l.conflicts[source][schema][table] = ti
+}
+
+// removeConflict removes the rejected table info.
+func (l *Lock) removeConflict(source, schema, table string) {
+	delete(l.conflicts[source][schema], table)
+}
+
+// Inspect returns the tracked schemas of the tables, the joined schema and the conflicting columns of the lock.
+// the column types of the rejected table infos are preferred when looking for the conflicting columns.
+func (l *Lock) Inspect() LockInspection {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	ret := LockInspection{
+		ID:     l.ID,
+		Task:   l.Task,
+		Joined: l.joined,
+	}
+	for source, schemaTables := range l.tables {
+		for schema, tables := range schemaTables {
+			for table, ti := range tables {
+				ts := TableSchema{Source: source, Schema: schema, Table: table, Tracked: ti}
+				if conflict, ok := l.conflicts[source][schema][table]; ok {
+					ts.Conflict = &conflict
+				}
+				ret.Tables = append(ret.Tables, ts)
+			}
+		}
+	}
+	sort.Slice(ret.Tables, func(i, j int) bool {
+		if ret.Tables[i].Source != ret.Tables[j].Source {
+			return ret.Tables[i].Source < ret.Tables[j].Source
+		}
+		if ret.Tables[i].Schema != ret.Tables[j].Schema {
+			return ret.Tables[i].Schema < ret.Tables[j].Schema
+		}
+		return ret.Tables[i].Table < ret.Tables[j].Table
+	})
+	ret.ConflictColumns = conflictColumns(ret.Tables)
+	return ret
+}
+
+// conflictColumns returns the columns whose types in the tables can't be joined, sorted by the column names.
+// the types of a column are in the order of the tables.
+func conflictColumns(tables []TableSchema) []ConflictColumn {
+	var (
+		names    []string
+		types    = make(map[string][]ColumnType)
+		conflict = make(map[string]bool)
+		joined   = make(map[string]schemacmp.Lattice)
+	)
+	for _, ts := range tables {
+		ti := ts.Tracked
+		if ts.Conflict != nil {
+			ti = *ts.Conflict
+		}
+		for col, ft := range schemacmp.DecodeColumnFieldTypes(ti) {
+			if _, ok := types[col]; !ok {
+				names = append(names, col)
+				joined[col] = schemacmp.Type(ft)
+			} else if !conflict[col] {
+				j, err := joined[col].Join(schemacmp.Type(ft))
+				if err != nil {
+					conflict[col] = true
+				} else {
+					joined[col] = j
+				}
+			}
+			types[col] = append(types[col], ColumnType{Source: ts.Source, Schema: ts.Schema, Table: ts.Table, Type: ft.CompactStr()})
+		}
+	}
+
+	sort.Strings(names)
+	ret := make([]ConflictColumn, 0, len(conflict))
+	for _, col := range names {
+		if conflict[col] {
+			ret = append(ret, ConflictColumn{Name: col, Types: types[col]})
+		}
+	}
+	return ret
+}
+
+// ResolveConflict resolves the conflicts of the lock by picking the schema of the table as the winning schema.
+// the rejected table info of the table is preferred as the winning schema, the tracked schemas of all tables and
+// the joined schema are replaced by it, and all rejected table infos are dropped, so the DDLs rejected before can be
+// synced again after the task is resumed.
+// NOTE: the lock is only changed in memory, it's rebuilt from the shard DDL infos after DM-master restarted.
+func (l *Lock) ResolveConflict(source, schema, table string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	winner, ok := l.conflicts[source][schema][table]
+	if !ok {
+		if winner, ok = l.tables[source][schema][table]; !ok {
+			return terror.ErrMasterOptimisticTableNotFound.Generate(dbutil.TableName(schema, table), source, l.ID)
+		}
+	}
+	for _, schemaTables := range l.tables {
+		for _, tables := range schemaTables {
+			for tbl := range tables {
+				tables[tbl] = winner
+			}
+		}
+	}
+	log.L().Info("resolve conflicts of the lock", zap.String("lock", l.ID), zap.String("source", source),
+		zap.String("schema", schema), zap.String("table", table), zap.Stringer("from", l.joined), zap.Stringer("to", winner))
+	l.joined = winner
+	l.conflicts = make(map[string]map[string]map[string]schemacmp.Table)
+	_, remain := l.syncStatus()
+	l.synced = remain == 0
+	return nil
+}
@@ -83,6 +83,10 @@ type Lock struct {
 	// column name -> source -> upSchema -> upTable -> int
 	columns map[string]map[string]map[string]map[string]DropColumnStage
 
+	// the table infos rejected because of conflicts, they're used to inspect the conflicts.
+	// upstream source ID -> upstream schema name -> upstream table name -> table info.
+	conflicts map[string]map[string]map[string]schemacmp.Table
+
 	downstreamMeta *DownstreamMeta
 }
 
@@ -100,6 +104,7 @@ func NewLock(cli *clientv3.Client, id, task, downSchema, downTable string, joine
 		synced:         true,
 		versions:       make(map[string]map[string]map[string]int64),
 		columns:        make(map[string]map[string]map[string]map[string]DropColumnStage),
+		conflicts:      make(map[string]map[string]map[string]schemacmp.Table),
 		downstreamMeta: downstreamMeta,
 	}
 	l.addTables(tts)
@@ -265,6 +270,9 @@ func (l *Lock) TrySync(info Info, tts []TargetTable) (newDDLs []string, cols []s
 			log.L().Info("update table info", zap.String("lock", l.ID), zap.String("source", callerSource), zap.String("schema", callerSchema), zap.String("table", callerTable),
 				zap.Stringer("from", l.tables[callerSource][callerSchema][callerTable]), zap.Stringer("to", lastTableInfo), zap.Strings("ddls", ddls))
 			l.tables[callerSource][callerSchema][callerTable] = lastTableInfo
+			l.removeConflict(callerSource, callerSchema, callerTable)
+		} else {
+			l.addConflict(callerSource, callerSchema, callerTable, lastTableInfo)
 		}
 	}()
 
@@ -463,6 +471,7 @@ func (l *Lock) TryRemoveTable(source, schema, table string) bool {
 	l.synced = remain == 0
 	delete(l.done[source][schema], table)
 	delete(l.versions[source][schema], table)
+	l.removeConflict(source, schema, table)
 	log.L().Info("table removed from the lock", zap.String("lock", l.ID),
 		zap.String("source", source), zap.String("schema", schema), zap.String("table", table),
 		zap.Stringer("table info", ti))
@@ -496,6 +505,7 @@ func (l *Lock) TryRemoveTableBySources(sources []string) []string {
 		l.synced = remain == 0
 		delete(l.done, source)
 		delete(l.versions, source)
+		delete(l.conflicts, source)
 		for _, sourceColumns := range l.columns {
 			delete(sourceColumns, source)
 		}
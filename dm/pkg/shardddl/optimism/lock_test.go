@@ -1009,6 +1009,89 @@ func (t *testLock) TestLockTrySyncConflictNonIntrusive(c *C) {
 	t.checkLockNoDone(c, l)
 }
 
+func (t *testLock) TestLockInspectAndResolveConflict(c *C) {
+	var (
+		ID               = "test_lock_inspect_and_resolve_conflict-`foo`.`bar`"
+		task             = "test_lock_inspect_and_resolve_conflict"
+		source           = "mysql-replica-1"
+		downSchema       = "foo"
+		downTable        = "bar"
+		db               = "foo"
+		tbls             = []string{"bar1", "bar2"}
+		p                = parser.New()
+		se               = mock.NewContext()
+		tblID      int64 = 111
+		DDLs1            = []string{"ALTER TABLE bar ADD COLUMN c1 TEXT"}
+		DDLs2            = []string{"ALTER TABLE bar ADD COLUMN c1 DATETIME", "ALTER TABLE bar ADD COLUMN c2 INT"}
+		ti0              = createTableInfo(c, p, se, tblID, `CREATE TABLE bar (id INT PRIMARY KEY)`)
+		ti1              = createTableInfo(c, p, se, tblID, `CREATE TABLE bar (id INT PRIMARY KEY, c1 TEXT)`)
+		ti2              = createTableInfo(c, p, se, tblID, `CREATE TABLE bar (id INT PRIMARY KEY, c1 DATETIME, c2 INT)`)
+		ti2_1            = createTableInfo(c, p, se, tblID, `CREATE TABLE bar (id INT PRIMARY KEY, c1 DATETIME)`)
+
+		tables = map[string]map[string]struct{}{db: {tbls[0]: struct{}{}, tbls[1]: struct{}{}}}
+		tts    = []TargetTable{newTargetTable(task, source, downSchema, downTable, tables)}
+		l      = NewLock(etcdTestCli, ID, task, downSchema, downTable, schemacmp.Encode(ti0), tts, nil)
+
+		vers = map[string]map[string]map[string]int64{
+			source: {
+				db: {tbls[0]: 0, tbls[1]: 0},
+			},
+		}
+	)
+
+	// no conflict for a synced lock.
+	inspection := l.Inspect()
+	c.Assert(inspection.ID, Equals, ID)
+	c.Assert(inspection.Task, Equals, task)
+	c.Assert(inspection.Tables, HasLen, 2)
+	c.Assert(inspection.ConflictColumns, HasLen, 0)
+
+	info := newInfoWithVersion(task, source, db, tbls[0], downSchema, downTable, DDLs1, ti0, []*model.TableInfo{ti1}, vers)
+	_, _, err := l.TrySync(info, tts)
+	c.Assert(err, IsNil)
+	info = newInfoWithVersion(task, source, db, tbls[1], downSchema, downTable, DDLs2, ti0, []*model.TableInfo{ti2_1, ti2}, vers)
+	_, _, err = l.TrySync(info, tts)
+	c.Assert(terror.ErrShardDDLOptimismTrySyncFail.Equal(err), IsTrue)
+
+	// the rejected table info is returned along with the tracked one.
+	inspection = l.Inspect()
+	c.Assert(inspection.Tables, HasLen, 2)
+	c.Assert(inspection.Tables[0].Table, Equals, tbls[0])
+	c.Assert(inspection.Tables[0].Tracked.String(), Equals, schemacmp.Encode(ti1).String())
+	c.Assert(inspection.Tables[0].Conflict, IsNil)
+	c.Assert(inspection.Tables[1].Table, Equals, tbls[1])
+	c.Assert(inspection.Tables[1].Tracked.String(), Equals, schemacmp.Encode(ti0).String())
+	c.Assert(inspection.Tables[1].Conflict, NotNil)
+	c.Assert(inspection.Tables[1].Conflict.String(), Equals, schemacmp.Encode(ti2).String())
+	c.Assert(inspection.Joined.String(), Equals, schemacmp.Encode(ti1).String())
+	c.Assert(inspection.ConflictColumns, DeepEquals, []ConflictColumn{{
+		Name: "c1",
+		Types: []ColumnType{
+			{Source: source, Schema: db, Table: tbls[0], Type: "text"},
+			{Source: source, Schema: db, Table: tbls[1], Type: "datetime"},
+		},
+	}})
+
+	c.Assert(terror.ErrMasterOptimisticTableNotFound.Equal(l.ResolveConflict(source, db, "bar3")), IsTrue)
+
+	// pick the rejected schema of the second table as the winning schema.
+	c.Assert(l.ResolveConflict(source, db, tbls[1]), IsNil)
+	t.checkLockSynced(c, l)
+	inspection = l.Inspect()
+	c.Assert(inspection.Joined.String(), Equals, schemacmp.Encode(ti2).String())
+	c.Assert(inspection.ConflictColumns, HasLen, 0)
+	for _, ts := range inspection.Tables {
+		c.Assert(ts.Tracked.String(), Equals, schemacmp.Encode(ti2).String())
+		c.Assert(ts.Conflict, IsNil)
+	}
+
+	// the rejected DDLs can be synced now.
+	info = newInfoWithVersion(task, source, db, tbls[1], downSchema, downTable, DDLs2, ti0, []*model.TableInfo{ti2_1, ti2}, vers)
+	_, _, err = l.TrySync(info, tts)
+	c.Assert(err, IsNil)
+	c.Assert(l.Inspect().ConflictColumns, HasLen, 0)
+}
+
 func (t *testLock) TestLockTrySyncConflictIntrusive(c *C) {
 	var (
 		ID               = "test_lock_try_sync_conflict_intrusive-`foo`.`bar`"
@@ -0,0 +1,92 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pessimism
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"go.etcd.io/etcd/clientv3"
+
+	"github.com/pingcap/tiflow/dm/dm/common"
+	"github.com/pingcap/tiflow/dm/pkg/etcdutil"
+)
+
+// AutoUnlockRecord is the audit record of a shard DDL lock unlocked automatically after timeout.
+type AutoUnlockRecord struct {
+	ID       string   `json:"id"`       // lock's ID
+	Task     string   `json:"task"`     // lock's corresponding task name
+	Owner    string   `json:"owner"`    // the source executed the DDLs
+	DDLs     []string `json:"ddls"`     // DDL statements
+	Synced   []string `json:"synced"`   // the sources skipped the DDLs
+	Unsynced []string `json:"unsynced"` // the lagging sources left to handle the DDLs by themselves
+	Strategy string   `json:"strategy"` // the strategy to unlock the lock
+	Timeout  int      `json:"timeout"`  // timeout in seconds
+
+	CreateTime time.Time `json:"create-time"`     // the time when the lock is created
+	UnlockTime time.Time `json:"unlock-time"`     // the time when the lock is unlocked
+	Error      string    `json:"error,omitempty"` // the error if fail to unlock the lock
+}
+
+// NewAutoUnlockRecord creates a new AutoUnlockRecord instance.
+func NewAutoUnlockRecord(lock *Lock, synced, unsynced []string, strategy string, timeout int) AutoUnlockRecord {
+	return AutoUnlockRecord{
+		ID:         lock.ID,
+		Task:       lock.Task,
+		Owner:      lock.Owner,
+		DDLs:       lock.DDLs,
+		Synced:     synced,
+		Unsynced:   unsynced,
+		Strategy:   strategy,
+		Timeout:    timeout,
+		CreateTime: lock.CreateTime,
+		UnlockTime: time.Now(),
+	}
+}
+
+// PutAutoUnlockRecord puts the audit record of the auto-unlocked lock into etcd.
+// This function should often be called by DM-master.
+func PutAutoUnlockRecord(cli *clientv3.Client, r AutoUnlockRecord) (int64, error) {
+	value, err := json.Marshal(r)
+	if err != nil {
+		return 0, err
+	}
+	key := common.ShardDDLPessimismAutoUnlockKeyAdapter.Encode(r.Task, r.ID, strconv.FormatInt(r.UnlockTime.UnixNano(), 10))
+	_, rev, err := etcdutil.DoOpsInOneTxnWithRetry(cli, clientv3.OpPut(key, string(value)))
+	return rev, err
+}
+
+// GetAutoUnlockRecords gets the audit records of the auto-unlocked locks of the task, sorted by the unlock time.
+func GetAutoUnlockRecords(cli *clientv3.Client, task string) ([]AutoUnlockRecord, int64, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	resp, err := cli.Get(ctx, common.ShardDDLPessimismAutoUnlockKeyAdapter.Encode(task), clientv3.WithPrefix(),
+		clientv3.WithSort(clientv3.SortByCreateRevision, clientv3.SortAscend))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	records := make([]AutoUnlockRecord, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var r AutoUnlockRecord
+		if err = json.Unmarshal(kv.Value, &r); err != nil {
+			return nil, 0, err
+		}
+		records = append(records, r)
+	}
+	return records, resp.Header.Revision, nil
+}
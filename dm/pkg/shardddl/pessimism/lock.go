@@ -15,6 +15,7 @@ package pessimism
 
 import (
 	"sync"
+	"time"
 
 	"github.com/pingcap/tiflow/dm/dm/master/metrics"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
@@ -32,6 +33,9 @@ type Lock struct {
 	DDLs   []string // DDL statements
 	remain int      // remain count of sources needed to receive DDL info
 
+	// the time when the lock is created, it's used to unlock the lock automatically after timeout.
+	CreateTime time.Time
+
 	// whether the DDL info received from the source.
 	// if all of them have been ready, then we call the lock `synced`.
 	ready map[string]bool
@@ -51,6 +55,8 @@ func NewLock(id, task, owner string, ddls, sources []string) *Lock {
 		remain: len(sources),
 		ready:  make(map[string]bool),
 		done:   make(map[string]bool),
+
+		CreateTime: time.Now(),
 	}
 	for _, s := range sources {
 		l.ready[s] = false
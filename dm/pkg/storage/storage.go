@@ -0,0 +1,169 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package storage accesses the dump files in the local filesystem or an external storage like S3, so the dump unit
+// and the load unit can exchange the dump files through the external storage.
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pingcap/errors"
+	brstorage "github.com/pingcap/tidb/br/pkg/storage"
+
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+// externalSchemes are the URL schemes of the external storages.
+var externalSchemes = map[string]struct{}{
+	"s3":  {},
+	"gcs": {},
+	"gs":  {},
+}
+
+// IsExternalPath returns whether the dir is the URL of an external storage like `s3://bucket/prefix`, otherwise
+// it's a local path.
+func IsExternalPath(dir string) bool {
+	u, err := brstorage.ParseRawURL(dir)
+	if err != nil {
+		return false
+	}
+	_, ok := externalSchemes[u.Scheme]
+	return ok
+}
+
+// AdjustPath appends the suffix to the path of the dir, the query parameters of the URL are kept. it does nothing if
+// the path already ends with the suffix.
+func AdjustPath(dir, suffix string) (string, error) {
+	if !IsExternalPath(dir) {
+		if strings.HasSuffix(dir, suffix) {
+			return dir, nil
+		}
+		return dir + suffix, nil
+	}
+	u, err := brstorage.ParseRawURL(dir)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if !strings.HasSuffix(u.Path, suffix) {
+		u.Path = strings.TrimSuffix(u.Path, "/") + suffix
+	}
+	return u.String(), nil
+}
+
+// TrimPath removes the suffix added by AdjustPath from the dir.
+func TrimPath(dir, suffix string) string {
+	if !IsExternalPath(dir) {
+		return strings.TrimSuffix(dir, suffix)
+	}
+	u, err := brstorage.ParseRawURL(dir)
+	if err != nil {
+		return dir
+	}
+	u.Path = strings.TrimSuffix(u.Path, suffix)
+	return u.String()
+}
+
+func newExternalStorage(ctx context.Context, dir string) (brstorage.ExternalStorage, error) {
+	backend, err := brstorage.ParseBackend(dir, &brstorage.BackendOptions{})
+	if err != nil {
+		return nil, errors.Annotatef(err, "parse storage url %s", dir)
+	}
+	s, err := brstorage.New(ctx, backend, &brstorage.ExternalStorageOptions{})
+	return s, errors.Annotatef(err, "create storage for %s", dir)
+}
+
+// ReadFile reads the file in the dir.
+func ReadFile(ctx context.Context, dir, name string) ([]byte, error) {
+	if !IsExternalPath(dir) {
+		return os.ReadFile(filepath.Join(dir, name))
+	}
+	s, err := newExternalStorage(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.ReadFile(ctx, name)
+	return data, errors.Trace(err)
+}
+
+// WriteFile writes the file in the dir, the file is replaced if it exists.
+func WriteFile(ctx context.Context, dir, name string, data []byte) error {
+	if !IsExternalPath(dir) {
+		return os.WriteFile(filepath.Join(dir, name), data, 0o644)
+	}
+	s, err := newExternalStorage(ctx, dir)
+	if err != nil {
+		return err
+	}
+	return errors.Trace(s.WriteFile(ctx, name, data))
+}
+
+// CollectDirFiles returns the names of the files in the dir, not including the sub dirs.
+func CollectDirFiles(ctx context.Context, dir string) (map[string]struct{}, error) {
+	if !IsExternalPath(dir) {
+		return utils.CollectDirFiles(dir)
+	}
+	s, err := newExternalStorage(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string]struct{})
+	err = s.WalkDir(ctx, &brstorage.WalkOption{}, func(name string, _ int64) error {
+		// the files in the sub dirs are walked too
+		if !strings.Contains(name, "/") {
+			files[name] = struct{}{}
+		}
+		return nil
+	})
+	return files, errors.Trace(err)
+}
+
+// RemoveFile removes the file in the dir.
+func RemoveFile(ctx context.Context, dir, name string) error {
+	if !IsExternalPath(dir) {
+		return os.Remove(filepath.Join(dir, name))
+	}
+	s, err := newExternalStorage(ctx, dir)
+	if err != nil {
+		return err
+	}
+	return errors.Trace(s.DeleteFile(ctx, name))
+}
+
+// RemoveAll removes the dir and all the files in it.
+func RemoveAll(ctx context.Context, dir string) error {
+	if !IsExternalPath(dir) {
+		return os.RemoveAll(dir)
+	}
+	s, err := newExternalStorage(ctx, dir)
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0)
+	err = s.WalkDir(ctx, &brstorage.WalkOption{}, func(name string, _ int64) error {
+		names = append(names, name)
+		return nil
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, name := range names {
+		if err = s.DeleteFile(ctx, name); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/pingcap/check"
+)
+
+var _ = Suite(&testStorageSuite{})
+
+func TestSuite(t *testing.T) {
+	TestingT(t)
+}
+
+type testStorageSuite struct{}
+
+func (t *testStorageSuite) TestIsExternalPath(c *C) {
+	c.Assert(IsExternalPath("s3://bucket/prefix"), IsTrue)
+	c.Assert(IsExternalPath("s3://bucket/prefix?endpoint=http://127.0.0.1:9000"), IsTrue)
+	c.Assert(IsExternalPath("gcs://bucket/prefix"), IsTrue)
+	c.Assert(IsExternalPath("./dumped_data"), IsFalse)
+	c.Assert(IsExternalPath("/data/dumped_data"), IsFalse)
+	c.Assert(IsExternalPath("file:///data/dumped_data"), IsFalse)
+	c.Assert(IsExternalPath(""), IsFalse)
+}
+
+func (t *testStorageSuite) TestAdjustPath(c *C) {
+	cases := []struct {
+		dir      string
+		suffix   string
+		adjusted string
+	}{
+		{"./dumped_data", ".task", "./dumped_data.task"},
+		{"./dumped_data.task", ".task", "./dumped_data.task"},
+		{"s3://bucket/prefix", "/task.source", "s3://bucket/prefix/task.source"},
+		{"s3://bucket/prefix/", "/task.source", "s3://bucket/prefix/task.source"},
+		{"s3://bucket/prefix/task.source", "/task.source", "s3://bucket/prefix/task.source"},
+		{"s3://bucket/prefix?endpoint=http://127.0.0.1:9000", "/task.source", "s3://bucket/prefix/task.source?endpoint=http://127.0.0.1:9000"},
+	}
+	for _, cs := range cases {
+		adjusted, err := AdjustPath(cs.dir, cs.suffix)
+		c.Assert(err, IsNil)
+		c.Assert(adjusted, Equals, cs.adjusted)
+		// calling multiple times is the same
+		adjusted, err = AdjustPath(adjusted, cs.suffix)
+		c.Assert(err, IsNil)
+		c.Assert(adjusted, Equals, cs.adjusted)
+	}
+	c.Assert(TrimPath("./dumped_data.task", ".task"), Equals, "./dumped_data")
+	c.Assert(TrimPath("s3://bucket/prefix/task.source?endpoint=http://127.0.0.1:9000", "/task.source"), Equals, "s3://bucket/prefix?endpoint=http://127.0.0.1:9000")
+}
+
+func (t *testStorageSuite) TestLocalFiles(c *C) {
+	ctx := context.Background()
+	dir := c.MkDir()
+	c.Assert(WriteFile(ctx, dir, "metadata", []byte("meta")), IsNil)
+	c.Assert(WriteFile(ctx, dir, "db.tbl.sql", []byte("data")), IsNil)
+	c.Assert(os.Mkdir(filepath.Join(dir, "sub"), 0o755), IsNil)
+
+	data, err := ReadFile(ctx, dir, "metadata")
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, "meta")
+	files, err := CollectDirFiles(ctx, dir)
+	c.Assert(err, IsNil)
+	c.Assert(files, DeepEquals, map[string]struct{}{"metadata": {}, "db.tbl.sql": {}})
+
+	c.Assert(RemoveFile(ctx, dir, "db.tbl.sql"), IsNil)
+	files, err = CollectDirFiles(ctx, dir)
+	c.Assert(err, IsNil)
+	c.Assert(files, DeepEquals, map[string]struct{}{"metadata": {}})
+
+	c.Assert(RemoveAll(ctx, dir), IsNil)
+	_, err = os.Stat(dir)
+	c.Assert(os.IsNotExist(err), IsTrue)
+}
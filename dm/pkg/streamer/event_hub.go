@@ -0,0 +1,289 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streamer
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/binlog/reader"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// hubEvent is an event kept in the ring of EventHub.
+type hubEvent struct {
+	event *replication.BinlogEvent
+	start mysql.Position // position of the event, also the end position of the previous event
+}
+
+// EventHub reads events from one streamer (like the one started by a BinlogReader of the relay log) and fans them
+// out to many subscribers, so the tasks of the same source don't parse the relay log files separately.
+// the latest events are kept in a bounded in-memory ring, a subscriber can start from any position in the ring or
+// after it, and consumes the events at its own pace without blocking the hub or other subscribers. a subscriber
+// which lags behind the ring is stopped with ErrStreamerHubSubscriberLagged.
+type EventHub struct {
+	getter   reader.EventGetter
+	capacity int
+	logger   log.Logger
+
+	mu     sync.Mutex
+	ring   []hubEvent
+	head   int    // index of the oldest event in ring
+	first  uint64 // sequence number of the oldest event in ring
+	next   uint64 // sequence number of the next event to be read
+	pos    mysql.Position
+	err    error         // the error met when reading events, the hub stops reading after it
+	notify chan struct{} // closed when a new event is read or the hub is stopped
+	subs   map[*Subscriber]struct{}
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewEventHub creates an EventHub which reads events from `getter` started at `pos`, and keeps
+// the latest `capacity` events.
+func NewEventHub(logger log.Logger, getter reader.EventGetter, pos mysql.Position, capacity int) *EventHub {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	h := &EventHub{
+		getter:   getter,
+		capacity: capacity,
+		logger:   logger.WithFields(zap.String("component", "streamer hub")),
+		ring:     make([]hubEvent, 0, capacity),
+		pos:      pos,
+		notify:   make(chan struct{}),
+		subs:     make(map[*Subscriber]struct{}),
+		cancel:   cancel,
+	}
+	h.wg.Add(1)
+	go func() {
+		defer h.wg.Done()
+		h.run(ctx)
+	}()
+	return h
+}
+
+func (h *EventHub) run(ctx context.Context) {
+	for {
+		e, err := h.getter.GetEvent(ctx)
+
+		h.mu.Lock()
+		if err != nil {
+			h.err = err
+			close(h.notify)
+			h.mu.Unlock()
+			if ctx.Err() == nil {
+				h.logger.Warn("stop reading events", zap.Stringer("position", h.pos), zap.Error(err))
+			}
+			return
+		}
+
+		he := hubEvent{event: e, start: h.pos}
+		if ev, ok := e.Event.(*replication.RotateEvent); ok {
+			h.pos = mysql.Position{Name: string(ev.NextLogName), Pos: uint32(ev.Position)}
+		} else if e.Header.LogPos > 0 {
+			h.pos.Pos = e.Header.LogPos
+		}
+		if len(h.ring) < h.capacity {
+			h.ring = append(h.ring, he)
+		} else {
+			h.ring[h.head] = he
+			h.head = (h.head + 1) % h.capacity
+			h.first++
+		}
+		h.next++
+		close(h.notify)
+		h.notify = make(chan struct{})
+		h.mu.Unlock()
+	}
+}
+
+// get returns the event with sequence number `seq`, it blocks until the event is read.
+func (h *EventHub) get(ctx context.Context, seq uint64) (hubEvent, error) {
+	for {
+		h.mu.Lock()
+		switch {
+		case seq < h.first:
+			h.mu.Unlock()
+			return hubEvent{}, terror.ErrStreamerHubSubscriberLagged.Generate(seq)
+		case seq < h.next:
+			e := h.ring[(h.head+int(seq-h.first))%h.capacity]
+			h.mu.Unlock()
+			return e, nil
+		case h.err != nil:
+			err := h.err
+			h.mu.Unlock()
+			return hubEvent{}, err
+		}
+		notify := h.notify
+		h.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return hubEvent{}, ctx.Err()
+		case <-notify:
+		}
+	}
+}
+
+// Subscribe creates a subscriber which receives the events from `pos`, at most `bufferSize` events are buffered
+// for it. `pos` should not be earlier than the oldest event kept by the hub.
+func (h *EventHub) Subscribe(pos mysql.Position, bufferSize int) (*Subscriber, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.err != nil {
+		return nil, h.err
+	}
+	oldest := h.pos
+	if len(h.ring) > 0 {
+		oldest = h.ring[h.head].start
+	}
+	if binlog.ComparePosition(pos, oldest) < 0 {
+		return nil, terror.ErrStreamerHubPositionNotInRing.Generate(pos, oldest)
+	}
+
+	// start from the first event not earlier than `pos`, or the next event to be read.
+	seq := h.next
+	for i := range h.ring {
+		if binlog.ComparePosition(h.ring[(h.head+i)%h.capacity].start, pos) >= 0 {
+			seq = h.first + uint64(i)
+			break
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Subscriber{
+		hub:    h,
+		pos:    pos,
+		seq:    seq,
+		ch:     make(chan *replication.BinlogEvent, bufferSize),
+		cancel: cancel,
+	}
+	h.subs[s] = struct{}{}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.run(ctx)
+	}()
+	h.logger.Info("add subscriber", zap.Stringer("position", pos), zap.Int("subscribers", len(h.subs)))
+	return s, nil
+}
+
+func (h *EventHub) unsubscribe(s *Subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.subs[s]; ok {
+		delete(h.subs, s)
+		h.logger.Info("remove subscriber", zap.Stringer("position", s.pos), zap.Int("subscribers", len(h.subs)))
+	}
+}
+
+// Position returns the end position of the latest event read by the hub.
+func (h *EventHub) Position() mysql.Position {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.pos
+}
+
+// Close stops reading events and closes all subscribers.
+func (h *EventHub) Close() {
+	h.cancel()
+	h.wg.Wait()
+
+	h.mu.Lock()
+	subs := make([]*Subscriber, 0, len(h.subs))
+	for s := range h.subs {
+		subs = append(subs, s)
+	}
+	h.mu.Unlock()
+	for _, s := range subs {
+		s.Close()
+	}
+}
+
+// Subscriber receives the events of an EventHub from a position.
+type Subscriber struct {
+	hub *EventHub
+	pos mysql.Position
+	seq uint64 // sequence number of the next event to get from the hub
+
+	ch  chan *replication.BinlogEvent
+	err error // available after ch closed
+
+	closeOnce sync.Once
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+func (s *Subscriber) run(ctx context.Context) {
+	defer close(s.ch)
+	for {
+		e, err := s.hub.get(ctx, s.seq)
+		if err != nil {
+			s.err = err
+			return
+		}
+		s.seq++
+		if binlog.ComparePosition(e.start, s.pos) < 0 {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			s.err = ctx.Err()
+			return
+		case s.ch <- e.event:
+		}
+	}
+}
+
+// Events returns the channel of the events, it's closed when the subscriber is stopped, and the reason is
+// returned by Err.
+func (s *Subscriber) Events() <-chan *replication.BinlogEvent {
+	return s.ch
+}
+
+// Err returns the reason why the subscriber is stopped, it should be called after the events channel is closed.
+func (s *Subscriber) Err() error {
+	return s.err
+}
+
+// GetEvent implements reader.EventGetter, it can be wrapped by reader.NewStreamer to be used as a reader.Streamer.
+func (s *Subscriber) GetEvent(ctx context.Context) (*replication.BinlogEvent, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case e, ok := <-s.ch:
+		if !ok {
+			return nil, s.err
+		}
+		return e, nil
+	}
+}
+
+// Close stops the subscriber and removes it from the hub.
+func (s *Subscriber) Close() {
+	s.closeOnce.Do(func() {
+		s.cancel()
+		s.wg.Wait()
+		s.hub.unsubscribe(s)
+	})
+}
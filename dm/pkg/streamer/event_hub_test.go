@@ -0,0 +1,115 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streamer
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/pkg/binlog/reader"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+func (t *testHubSuite) TestEventHub(c *C) {
+	var (
+		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+		r           = reader.NewMockReader().(*reader.MockReader)
+		hub         = NewEventHub(log.L(), r, mysql.Position{Name: "mysql-bin.000001", Pos: 4}, 3)
+		logPos      = uint32(0)
+	)
+	defer cancel()
+	push := func(n int) {
+		for i := 0; i < n; i++ {
+			logPos += 100
+			e := &replication.BinlogEvent{
+				Header: &replication.EventHeader{EventType: replication.XID_EVENT, LogPos: logPos},
+				Event:  &replication.XIDEvent{XID: uint64(logPos)},
+			}
+			c.Assert(r.PushEvent(ctx, e), IsNil)
+		}
+	}
+	expect := func(s *Subscriber, logPoses ...uint32) {
+		for _, pos := range logPoses {
+			e, err := s.GetEvent(ctx)
+			c.Assert(err, IsNil)
+			c.Assert(e.Header.LogPos, Equals, pos)
+		}
+	}
+
+	s1, err := hub.Subscribe(mysql.Position{Name: "mysql-bin.000001", Pos: 4}, 10)
+	c.Assert(err, IsNil)
+	for i := 1; i <= 5; i++ {
+		push(1)
+		expect(s1, uint32(i*100))
+	}
+	c.Assert(hub.Position(), DeepEquals, mysql.Position{Name: "mysql-bin.000001", Pos: 500})
+
+	// only the latest 3 events starting from 200 are kept
+	_, err = hub.Subscribe(mysql.Position{Name: "mysql-bin.000001", Pos: 100}, 10)
+	c.Assert(terror.ErrStreamerHubPositionNotInRing.Equal(err), IsTrue)
+	s2, err := hub.Subscribe(mysql.Position{Name: "mysql-bin.000001", Pos: 300}, 10)
+	c.Assert(err, IsNil)
+	expect(s2, 400, 500)
+
+	// a slow subscriber doesn't block others, and is stopped after it lags behind the ring
+	s3, err := hub.Subscribe(mysql.Position{Name: "mysql-bin.000001", Pos: 500}, 0)
+	c.Assert(err, IsNil)
+	for i := 6; i <= 10; i++ {
+		push(1)
+		expect(s1, uint32(i*100))
+		expect(s2, uint32(i*100))
+	}
+	received := 0
+	for ; ; received++ {
+		if _, err = s3.GetEvent(ctx); err != nil {
+			break
+		}
+	}
+	c.Assert(received, LessEqual, 1)
+	c.Assert(terror.ErrStreamerHubSubscriberLagged.Equal(err), IsTrue)
+	c.Assert(terror.ErrStreamerHubSubscriberLagged.Equal(s3.Err()), IsTrue)
+	s3.Close()
+
+	rotate := &replication.BinlogEvent{
+		Header: &replication.EventHeader{EventType: replication.ROTATE_EVENT},
+		Event:  &replication.RotateEvent{Position: 4, NextLogName: []byte("mysql-bin.000002")},
+	}
+	c.Assert(r.PushEvent(ctx, rotate), IsNil)
+	expect(s1, 0)
+	logPos = 0
+	push(1)
+	expect(s1, 100)
+	// subscribe a position later than the hub
+	s4, err := hub.Subscribe(mysql.Position{Name: "mysql-bin.000002", Pos: 200}, 10)
+	c.Assert(err, IsNil)
+	push(2)
+	expect(s1, 200, 300)
+	expect(s4, 300)
+	c.Assert(hub.Position(), DeepEquals, mysql.Position{Name: "mysql-bin.000002", Pos: 300})
+
+	// all subscribers are closed with the hub
+	hub.Close()
+	_, ok := <-s1.Events()
+	c.Assert(ok, IsFalse)
+	c.Assert(s1.Err(), Equals, context.Canceled)
+	_, err = s4.GetEvent(ctx)
+	c.Assert(err, Equals, context.Canceled)
+	_, err = hub.Subscribe(mysql.Position{Name: "mysql-bin.000002", Pos: 300}, 10)
+	c.Assert(err, Equals, context.Canceled)
+}
@@ -15,10 +15,15 @@ package streamer
 
 import (
 	"path/filepath"
+	"testing"
 
 	. "github.com/pingcap/check"
 )
 
+func TestSuite(t *testing.T) {
+	TestingT(t)
+}
+
 var _ = Suite(&testHubSuite{})
 
 type testHubSuite struct{}
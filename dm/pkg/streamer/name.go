@@ -0,0 +1,68 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streamer
+
+import (
+	"strconv"
+
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
+
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+// ToRelayName translates an upstream binlog filename to the name used in the positions of relay log (and checkpoints),
+// `uuidSuffix` is the suffix of the relay sub directory which the binlog file is in.
+// eg. `mysql-bin.000003` in `c6ae5afe-c7a3-11e8-a19d-0242ac130006.000002` => `mysql-bin|000002.000003`.
+func ToRelayName(upstreamName string, uuidSuffix int) (string, error) {
+	if uuidSuffix < binlog.MinUUIDSuffix {
+		return "", terror.ErrBinlogInvalidFilenameWithUUIDSuffix.Generatef("invalid UUID suffix %d", uuidSuffix)
+	}
+	parsed, err := binlog.ParseFilename(upstreamName)
+	if err != nil {
+		return "", err
+	}
+	if _, _, _, err = binlog.SplitFilenameWithUUIDSuffix(upstreamName); err == nil {
+		return "", terror.ErrBinlogInvalidFilenameWithUUIDSuffix.Generatef("%s already has UUID suffix", upstreamName)
+	}
+	return binlog.ConstructFilenameWithUUIDSuffix(parsed, utils.SuffixIntToStr(uuidSuffix)), nil
+}
+
+// ToUpstreamName translates a name used in the positions of relay log back to the upstream binlog filename and the
+// suffix of the relay sub directory. a name without UUID suffix is returned as it is, with the suffix 0.
+// eg. `mysql-bin|000002.000003` => (`mysql-bin.000003`, 2), `mysql-bin.000003` => (`mysql-bin.000003`, 0).
+func ToUpstreamName(relayName string) (string, int, error) {
+	if _, err := binlog.ParseFilename(relayName); err != nil {
+		return "", 0, err
+	}
+	baseName, uuidSuffix, seq, err := binlog.SplitFilenameWithUUIDSuffix(relayName)
+	if err != nil {
+		return relayName, 0, nil // no UUID suffix
+	}
+	suffix, err := strconv.Atoi(uuidSuffix)
+	if err != nil || suffix < binlog.MinUUIDSuffix {
+		return "", 0, terror.ErrBinlogInvalidFilenameWithUUIDSuffix.Generate(relayName)
+	}
+	return binlog.ConstructFilename(baseName, seq), suffix, nil
+}
+
+// ToUpstreamPosition translates the filename of a relay log position with ToUpstreamName.
+func ToUpstreamPosition(pos gmysql.Position) (gmysql.Position, error) {
+	name, _, err := ToUpstreamName(pos.Name)
+	if err != nil {
+		return pos, err
+	}
+	return gmysql.Position{Name: name, Pos: pos.Pos}, nil
+}
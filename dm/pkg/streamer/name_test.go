@@ -0,0 +1,71 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streamer
+
+import (
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+var _ = Suite(&testNameSuite{})
+
+type testNameSuite struct{}
+
+func (t *testNameSuite) TestTranslateName(c *C) {
+	cases := []struct {
+		upstreamName string
+		uuidSuffix   int
+		relayName    string
+	}{
+		{"mysql-bin.000003", 2, "mysql-bin|000002.000003"},
+		{"mysql-bin.000001", 1, "mysql-bin|000001.000001"},
+		{"bin.666888", 123, "bin|000123.666888"},
+	}
+	for _, cs := range cases {
+		relayName, err := ToRelayName(cs.upstreamName, cs.uuidSuffix)
+		c.Assert(err, IsNil)
+		c.Assert(relayName, Equals, cs.relayName)
+
+		upstreamName, uuidSuffix, err := ToUpstreamName(relayName)
+		c.Assert(err, IsNil)
+		c.Assert(upstreamName, Equals, cs.upstreamName)
+		c.Assert(uuidSuffix, Equals, cs.uuidSuffix)
+
+		// the upstream name is returned as it is
+		upstreamName, uuidSuffix, err = ToUpstreamName(cs.upstreamName)
+		c.Assert(err, IsNil)
+		c.Assert(upstreamName, Equals, cs.upstreamName)
+		c.Assert(uuidSuffix, Equals, 0)
+
+		pos, err := ToUpstreamPosition(gmysql.Position{Name: relayName, Pos: 123})
+		c.Assert(err, IsNil)
+		c.Assert(pos, DeepEquals, gmysql.Position{Name: cs.upstreamName, Pos: 123})
+	}
+
+	// invalid names or suffixes
+	_, err := ToRelayName("mysql-bin.000003", 0)
+	c.Assert(terror.ErrBinlogInvalidFilenameWithUUIDSuffix.Equal(err), IsTrue)
+	_, err = ToRelayName("mysql-bin|000002.000003", 2)
+	c.Assert(terror.ErrBinlogInvalidFilenameWithUUIDSuffix.Equal(err), IsTrue)
+	_, err = ToRelayName("mysql-bin", 2)
+	c.Assert(terror.ErrBinlogInvalidFilename.Equal(err), IsTrue)
+	_, _, err = ToUpstreamName("mysql-bin")
+	c.Assert(terror.ErrBinlogInvalidFilename.Equal(err), IsTrue)
+	_, _, err = ToUpstreamName("mysql-bin|abc.000003")
+	c.Assert(terror.ErrBinlogInvalidFilenameWithUUIDSuffix.Equal(err), IsTrue)
+	_, err = ToUpstreamPosition(gmysql.Position{Name: "mysql-bin", Pos: 4})
+	c.Assert(terror.ErrBinlogInvalidFilename.Equal(err), IsTrue)
+}
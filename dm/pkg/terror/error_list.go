@@ -191,6 +191,14 @@ const (
 
 	// pkg/binlog.
 	codeBinlogNotLogColumn
+	codeBinlogTransactionPayloadNotValid
+
+	// pkg/streamer.
+	codeStreamerHubPositionNotInRing
+	codeStreamerHubSubscriberLagged
+
+	// pkg/binlog.
+	codeBinlogXAPrepareEventNotValid
 )
 
 // Config related error code list.
@@ -249,6 +257,36 @@ const (
 	codeCollationCompatibleNotSupport
 	codeConfigInvalidLoadMode
 	codeConfigInvalidLoadDuplicateResolution
+	codeConfigInvalidMaxEventSizePolicy
+	codeConfigInvalidCheckpointFlushPolicy
+	codeConfigStartTimeTooLate
+	codeConfigInvalidDBType
+	codeConfigPostgresNotSupport
+	codeConfigInvalidSinkURI
+	codeConfigInvalidSinkDispatcher
+	codeConfigSinkNotSupport
+	codeConfigInvalidRowValueFilter
+	codeConfigInvalidDDLRewrite
+	codeConfigInvalidTimeWindow
+	codeConfigInvalidValidationMode
+	codeConfigInvalidCharsetRule
+	codeConfigInvalidColumnPolicy
+	codeConfigInvalidForeignKeyPolicy
+	codeConfigDryRunNotSupport
+	codeConfigInvalidDMLType
+	codeConfigOnlineDDLToolNotSupport
+	codeConfigShardDDLLockTimeoutStrategyNotSupport
+	codeConfigInvalidAutoIDConflictStrategy
+	codeConfigInvalidTableWhere
+	codeConfigInvalidDumpCompress
+	codeConfigInvalidSkipCreateTable
+	codeConfigInvalidExternalData
+	codeConfigInvalidMaxError
+	codeConfigInvalidLoadDir
+	codeConfigInvalidOnBinlogGap
+	codeConfigWorkerAffinityConflict
+	codeConfigInvalidPausedTable
+	codeConfigInvalidTaskPriority
 )
 
 // Binlog operation error code list.
@@ -334,6 +372,10 @@ const (
 	codeRelayPurgeArgsNotValid
 	codePreviousGTIDsNotValid
 	codeRotateEventWithDifferentServerID
+	codeRelayFlushEtcdMeta
+	codeRelayEtcdMetaConflict
+	codeRelaySwitchUpstreamPosNotValid
+	codeRelayEventTooLarge
 )
 
 // Dump unit error code.
@@ -364,6 +406,9 @@ const (
 	codeLoadUnitGenBAList
 	codeLoadTaskWorkerNotMatch
 	codeLoadCheckPointNotMatch
+	codeLoadUnitChecksumMismatch
+	codeLoadUnitIncompatibleTable
+	codeLoadUnitExceedMaxError
 )
 
 // Sync unit error code.
@@ -437,6 +482,21 @@ const (
 	codeSyncerParseDDL
 	codeSyncerUnsupportedStmt
 	codeSyncerGetEvent
+	codeSyncerUnsupportedDDLForPostgres
+	codeSyncerMQSink
+	codeSyncerRowFilterPlugin
+	codeSyncerDDLRewriteHook
+	codeSyncerDDLNeedApproval
+	codeSyncerTranscodeRow
+	codeSyncerGTIDSkipListNotSupported
+	codeSyncerDryRunWrite
+	codeSyncerSchemaSnapshot
+	codeSyncerBinlogGap
+	codeSyncerRedumpForBinlogGap
+	codeSyncerRulesNotHotReloadable
+	codeSyncerRulesHistory
+	codeSyncerPauseTableNotSupported
+	codeSyncerTableAlreadyPaused
 )
 
 // DM-master error code.
@@ -498,6 +558,13 @@ const (
 	codeMasterOptimisticTableInfobeforeNotExist
 	codeMasterOptimisticDownstreamMetaNotFound
 	codeMasterInvalidClusterID
+	codeMasterOptimisticTableNotFound
+	codeMasterInvalidAuditLogConfig
+	codeMasterOpenAuditLogSink
+	codeMasterInvalidWebhookConfig
+	codeMasterInvalidWebhook
+	codeMasterWebhookExist
+	codeMasterWebhookNotExist
 )
 
 // DM-worker error code.
@@ -581,6 +648,12 @@ const (
 	codeWorkerFailConnectMaster
 	codeWorkerWaitRelayCatchupGTID
 	codeWorkerRelayConfigChanging
+	codeWorkerRateLimitNotSupported
+	codeWorkerSkipGTIDNotSupported
+	codeWorkerRulesNotHotReloadable
+	codeWorkerInvalidTaskResourceLimits
+	codeWorkerPauseTableNotSupported
+	codeWorkerInvalidPriorityThrottle
 )
 
 // DM-tracer error code.
@@ -655,6 +728,9 @@ const (
 	codeSchedulerStopRelayOnBound
 	codeSchedulerPauseTaskForTransferSource
 	codeSchedulerWorkerNotFree
+	codeSchedulerNoWorkerToDrain
+	codeSchedulerWorkerDraining
+	codeSchedulerWorkerNotMatchAffinity
 )
 
 // dmctl error code.
@@ -851,7 +927,15 @@ var (
 	ErrNoMasterStatus = New(codeNoMasterStatus, ClassFunctional, ScopeUpstream, LevelMedium, "upstream returns an empty result for SHOW MASTER STATUS", "Please check the upstream settings like privileges, RDS settings to read data from SHOW MASTER STATUS.")
 
 	// pkg/binlog.
-	ErrBinlogNotLogColumn = New(codeBinlogNotLogColumn, ClassBinlogOp, ScopeUpstream, LevelHigh, "upstream didn't log enough columns in binlog", "Please check if session `binlog_row_image` variable is not FULL, restart task to the location from where FULL binlog_row_image is used.")
+	ErrBinlogNotLogColumn               = New(codeBinlogNotLogColumn, ClassBinlogOp, ScopeUpstream, LevelHigh, "upstream didn't log enough columns in binlog", "Please check if session `binlog_row_image` variable is not FULL, restart task to the location from where FULL binlog_row_image is used.")
+	ErrBinlogTransactionPayloadNotValid = New(codeBinlogTransactionPayloadNotValid, ClassBinlogOp, ScopeInternal, LevelHigh, "transaction payload event not valid, %s", "")
+
+	// pkg/streamer.
+	ErrStreamerHubPositionNotInRing = New(codeStreamerHubPositionNotInRing, ClassFunctional, ScopeInternal, LevelMedium, "position %s is earlier than the events kept by the streamer hub, which start from %s", "")
+	ErrStreamerHubSubscriberLagged  = New(codeStreamerHubSubscriberLagged, ClassFunctional, ScopeInternal, LevelMedium, "subscriber lagged too far behind the streamer hub, the event %d has been dropped", "")
+
+	// pkg/binlog.
+	ErrBinlogXAPrepareEventNotValid = New(codeBinlogXAPrepareEventNotValid, ClassBinlogOp, ScopeInternal, LevelHigh, "XA prepare event not valid, %s", "")
 
 	// Config related error.
 	ErrConfigCheckItemNotSupport    = New(codeConfigCheckItemNotSupport, ClassConfig, ScopeInternal, LevelMedium, "checking item %s is not supported\n%s", "Please check `ignore-checking-items` config in task configuration file, which can be set including `all`/`dump_privilege`/`replication_privilege`/`version`/`binlog_enable`/`binlog_format`/`binlog_row_image`/`table_schema`/`schema_of_shard_tables`/`auto_increment_ID`.")
@@ -905,11 +989,41 @@ var (
 		"config '%s' regex pattern '%s' invalid, reason: %s", "Please check if params is correctly in the configuration file.")
 	ErrConfigOnlineDDLMistakeRegex = New(codeConfigOnlineDDLMistakeRegex, ClassConfig, ScopeInternal, LevelHigh,
 		"online ddl sql '%s' invalid, table %s fail to match '%s' online ddl regex", "Please update your `shadow-table-rules` or `trash-table-rules` in the configuration file.")
-	ErrOpenAPITaskConfigExist              = New(codeConfigOpenAPITaskConfigExist, ClassConfig, ScopeInternal, LevelLow, "the openapi task config for '%s' already exist", "If you want to override it, please use the overwrite flag.")
-	ErrOpenAPITaskConfigNotExist           = New(codeConfigOpenAPITaskConfigNotExist, ClassConfig, ScopeInternal, LevelLow, "the openapi task config for '%s' does not exist", "")
-	ErrConfigCollationCompatibleNotSupport = New(codeCollationCompatibleNotSupport, ClassConfig, ScopeInternal, LevelMedium, "collation compatible %s not supported", "Please check the `collation_compatible` config in task configuration file, which can be set to `loose`/`strict`.")
-	ErrConfigInvalidLoadMode               = New(codeConfigInvalidLoadMode, ClassConfig, ScopeInternal, LevelMedium, "invalid load mode '%s'", "Please choose a valid value in ['sql', 'loader']")
-	ErrConfigInvalidDuplicateResolution    = New(codeConfigInvalidLoadDuplicateResolution, ClassConfig, ScopeInternal, LevelMedium, "invalid load on-duplicate '%s'", "Please choose a valid value in ['replace', 'error', 'ignore']")
+	ErrOpenAPITaskConfigExist                      = New(codeConfigOpenAPITaskConfigExist, ClassConfig, ScopeInternal, LevelLow, "the openapi task config for '%s' already exist", "If you want to override it, please use the overwrite flag.")
+	ErrOpenAPITaskConfigNotExist                   = New(codeConfigOpenAPITaskConfigNotExist, ClassConfig, ScopeInternal, LevelLow, "the openapi task config for '%s' does not exist", "")
+	ErrConfigCollationCompatibleNotSupport         = New(codeCollationCompatibleNotSupport, ClassConfig, ScopeInternal, LevelMedium, "collation compatible %s not supported", "Please check the `collation_compatible` config in task configuration file, which can be set to `loose`/`strict`.")
+	ErrConfigInvalidLoadMode                       = New(codeConfigInvalidLoadMode, ClassConfig, ScopeInternal, LevelMedium, "invalid load mode '%s'", "Please choose a valid value in ['sql', 'loader', 'physical']")
+	ErrConfigInvalidDuplicateResolution            = New(codeConfigInvalidLoadDuplicateResolution, ClassConfig, ScopeInternal, LevelMedium, "invalid load on-duplicate '%s'", "Please choose a valid value in ['replace', 'error', 'ignore']")
+	ErrConfigInvalidMaxEventSizePolicy             = New(codeConfigInvalidMaxEventSizePolicy, ClassConfig, ScopeInternal, LevelMedium, "invalid max-event-size-policy '%s'", "Please choose a valid value in ['error', 'skip', 'chunk']")
+	ErrConfigInvalidCheckpointFlushPolicy          = New(codeConfigInvalidCheckpointFlushPolicy, ClassConfig, ScopeInternal, LevelMedium, "invalid checkpoint-flush-policy '%s'", "Please choose a valid value in ['interval', 'txn', 'bytes', 'manual']")
+	ErrConfigStartTimeTooLate                      = New(codeConfigStartTimeTooLate, ClassConfig, ScopeInternal, LevelHigh, "start-time %s is too late, no binlog location matches it", "Please check the `--start-time` is expected or try again later.")
+	ErrConfigInvalidDBType                         = New(codeConfigInvalidDBType, ClassConfig, ScopeInternal, LevelMedium, "invalid database type '%s' of %s", "Please choose a valid value in ['mysql', 'postgres'], only the target database can be 'postgres'.")
+	ErrConfigPostgresNotSupport                    = New(codeConfigPostgresNotSupport, ClassConfig, ScopeInternal, LevelMedium, "%s is not supported when the target database is PostgreSQL", "Please disable it or use a MySQL compatible target database.")
+	ErrConfigInvalidSinkURI                        = New(codeConfigInvalidSinkURI, ClassConfig, ScopeInternal, LevelMedium, "invalid sink-uri '%s': %s", "Please use the URI of Kafka like `kafka://127.0.0.1:9092/topic-name?protocol=canal-json`, the protocol can be 'canal-json' or 'open-protocol'.")
+	ErrConfigInvalidSinkDispatcher                 = New(codeConfigInvalidSinkDispatcher, ClassConfig, ScopeInternal, LevelMedium, "invalid sink-dispatcher '%s'", "Please choose a valid value in ['table', 'pk']")
+	ErrConfigSinkNotSupport                        = New(codeConfigSinkNotSupport, ClassConfig, ScopeInternal, LevelMedium, "%s is not supported when publishing the changes to Kafka", "Please disable it or remove the `sink-uri`.")
+	ErrConfigInvalidRowValueFilter                 = New(codeConfigInvalidRowValueFilter, ClassConfig, ScopeInternal, LevelMedium, "binlog event filter rule %s with row-value-expr is invalid: %s", "Please check the `filters` config in task configuration file.")
+	ErrConfigInvalidDDLRewrite                     = New(codeConfigInvalidDDLRewrite, ClassConfig, ScopeInternal, LevelMedium, "invalid %s '%s': %s", "Please check the `ddl-rewrite-rules` and `ddl-rewrite-hook` config in task configuration file.")
+	ErrConfigInvalidTimeWindow                     = New(codeConfigInvalidTimeWindow, ClassConfig, ScopeInternal, LevelMedium, "invalid replication time window, %s", "Please check the `start-time` and `stop-time` of syncer config, they should be in the format like '2006-01-02 15:04:05' and `start-time` should be earlier than `stop-time`.")
+	ErrConfigInvalidValidationMode                 = New(codeConfigInvalidValidationMode, ClassConfig, ScopeInternal, LevelMedium, "invalid validation-mode '%s'", "Please choose a valid value in ['none', 'row', 'chunk']")
+	ErrConfigInvalidCharsetRule                    = New(codeConfigInvalidCharsetRule, ClassConfig, ScopeInternal, LevelMedium, "invalid charset rule: %s", "Please check the `charset-rules` config in task configuration file, `from-charset` should be one of ['latin1', 'gbk'] and `to-charset` should be one of ['utf8mb4', 'utf8'].")
+	ErrConfigInvalidColumnPolicy                   = New(codeConfigInvalidColumnPolicy, ClassConfig, ScopeInternal, LevelMedium, "invalid %s '%s'", "Please choose a valid value in ['skip', 'materialize', 'materialize-stored'] for `generated-column-policy`, or in ['skip', 'materialize'] for `invisible-column-policy`.")
+	ErrConfigInvalidForeignKeyPolicy               = New(codeConfigInvalidForeignKeyPolicy, ClassConfig, ScopeInternal, LevelMedium, "invalid foreign-key-policy '%s'%s", "Please choose a valid value in ['none', 'ordered', 'disable-checks'], and don't enable `compact` with 'ordered'.")
+	ErrConfigDryRunNotSupport                      = New(codeConfigDryRunNotSupport, ClassConfig, ScopeInternal, LevelMedium, "%s is not supported in dry-run mode", "Please disable it or remove the `dry-run-dir`.")
+	ErrConfigInvalidDMLType                        = New(codeConfigInvalidDMLType, ClassConfig, ScopeInternal, LevelMedium, "invalid dml-type '%s'", "Please choose a valid value in ['standard', 'bulk'].")
+	ErrConfigOnlineDDLToolNotSupport               = New(codeConfigOnlineDDLToolNotSupport, ClassConfig, ScopeInternal, LevelMedium, "online schema change tool %s not supported, supported tools are %v", "Please check the `online-ddl-tools` config in task configuration file.")
+	ErrConfigShardDDLLockTimeoutStrategyNotSupport = New(codeConfigShardDDLLockTimeoutStrategyNotSupport, ClassConfig, ScopeInternal, LevelMedium, "shard DDL lock timeout strategy %s not supported", "Please check the `shard-ddl-lock-timeout-strategy` config in task configuration file, which can be set to `skip`/`force-exec`.")
+	ErrConfigInvalidAutoIDConflictStrategy         = New(codeConfigInvalidAutoIDConflictStrategy, ClassConfig, ScopeInternal, LevelMedium, "invalid auto-id-conflict-strategy '%s': %s", "Please choose a valid value in ['check', 'source-prefix', 'extra-column'] and check the related configurations in task configuration file.")
+	ErrConfigInvalidTableWhere                     = New(codeConfigInvalidTableWhere, ClassConfig, ScopeInternal, LevelMedium, "invalid table-wheres config: %s", "Please check the `table-wheres` config of mydumpers in task configuration file.")
+	ErrConfigInvalidDumpCompress                   = New(codeConfigInvalidDumpCompress, ClassConfig, ScopeInternal, LevelMedium, "invalid compress config: %s", "Please check the `compress` config of mydumpers and the `import-mode` config of loaders in task configuration file.")
+	ErrConfigInvalidSkipCreateTable                = New(codeConfigInvalidSkipCreateTable, ClassConfig, ScopeInternal, LevelMedium, "invalid skip-create-table config: %s", "Please check the `skip-create-table`, `column-name-mappings` and `import-mode` config of loaders in task configuration file.")
+	ErrConfigInvalidExternalData                   = New(codeConfigInvalidExternalData, ClassConfig, ScopeInternal, LevelMedium, "invalid external config: %s", "Please check the `external` config of loaders and the `meta` config in task configuration file.")
+	ErrConfigInvalidMaxError                       = New(codeConfigInvalidMaxError, ClassConfig, ScopeInternal, LevelMedium, "invalid max-error config: %s", "Please check the `max-error` config of loaders in task configuration file.")
+	ErrConfigInvalidLoadDir                        = New(codeConfigInvalidLoadDir, ClassConfig, ScopeInternal, LevelMedium, "invalid dir config %s: %s", "Please check the `dir` config of loaders in task configuration file.")
+	ErrConfigInvalidOnBinlogGap                    = New(codeConfigInvalidOnBinlogGap, ClassConfig, ScopeInternal, LevelMedium, "invalid on-binlog-gap '%s'", "Please choose a valid value in ['error', 'redump']")
+	ErrConfigWorkerAffinityConflict                = New(codeConfigWorkerAffinityConflict, ClassConfig, ScopeInternal, LevelMedium, "label %s=%s is both required and excluded in worker affinity", "Please check the `affinity` config in source configuration file.")
+	ErrConfigInvalidPausedTable                    = New(codeConfigInvalidPausedTable, ClassConfig, ScopeInternal, LevelMedium, "invalid paused table %s: %s", "Please specify the schema and table name of the paused table, and choose a valid policy in ['skip', 'buffer'].")
+	ErrConfigInvalidTaskPriority                   = New(codeConfigInvalidTaskPriority, ClassConfig, ScopeInternal, LevelMedium, "invalid task priority '%s'", "Please choose a valid value in ['high', 'normal', 'low'].")
 
 	// Binlog operation error.
 	ErrBinlogExtractPosition = New(codeBinlogExtractPosition, ClassBinlogOp, ScopeInternal, LevelHigh, "", "")
@@ -985,6 +1099,10 @@ var (
 	ErrRelayPurgeArgsNotValid            = New(codeRelayPurgeArgsNotValid, ClassRelayUnit, ScopeInternal, LevelHigh, "args (%T) %+v not valid", "")
 	ErrPreviousGTIDsNotValid             = New(codePreviousGTIDsNotValid, ClassRelayUnit, ScopeInternal, LevelHigh, "previousGTIDs %s not valid", "")
 	ErrRotateEventWithDifferentServerID  = New(codeRotateEventWithDifferentServerID, ClassRelayUnit, ScopeInternal, LevelHigh, "receive fake rotate event with different server_id", "Please use `resume-relay` command if upstream database has changed")
+	ErrRelayFlushEtcdMeta                = New(codeRelayFlushEtcdMeta, ClassRelayUnit, ScopeInternal, LevelHigh, "flush relay meta into etcd", "")
+	ErrRelayEtcdMetaConflict             = New(codeRelayEtcdMetaConflict, ClassRelayUnit, ScopeInternal, LevelHigh, "relay meta of source %s in etcd has been updated by DM-worker %s at revision %d, expected revision %d", "Please check whether another DM-worker is pulling relay log for the same source.")
+	ErrRelaySwitchUpstreamPosNotValid    = New(codeRelaySwitchUpstreamPosNotValid, ClassRelayUnit, ScopeInternal, LevelHigh, "start position of the new upstream master not valid, %s", "Please specify the binlog name or GTID sets of the new upstream master to start from.")
+	ErrRelayEventTooLarge                = New(codeRelayEventTooLarge, ClassRelayUnit, ScopeInternal, LevelHigh, "size %d of event %s at position %d exceeds max-event-size %d", "Please increase `max-event-size` or change `max-event-size-policy` in the syncer config of task configuration file.")
 
 	// Dump unit error.
 	ErrDumpUnitRuntime        = New(codeDumpUnitRuntime, ClassDumpUnit, ScopeInternal, LevelHigh, "mydumper/dumpling runs with error, with output (may empty): %s", "")
@@ -1011,6 +1129,9 @@ var (
 	ErrLoadUnitGenBAList           = New(codeLoadUnitGenBAList, ClassLoadUnit, ScopeInternal, LevelHigh, "generate block allow list", "Please check the `block-allow-list` config in task configuration file.")
 	ErrLoadTaskWorkerNotMatch      = New(codeLoadTaskWorkerNotMatch, ClassFunctional, ScopeInternal, LevelHigh, "different worker in load stage, previous worker: %s, current worker: %s", "Please check if the previous worker is online.")
 	ErrLoadTaskCheckPointNotMatch  = New(codeLoadCheckPointNotMatch, ClassFunctional, ScopeInternal, LevelHigh, "inconsistent checkpoints between loader and target database", "If you want to redo the whole task, please check that you have not forgotten to add -remove-meta flag for start-task command.")
+	ErrLoadUnitChecksumMismatch    = New(codeLoadUnitChecksumMismatch, ClassLoadUnit, ScopeInternal, LevelHigh, "checksum of the imported part of data file %s mismatch, offset %d, checksum in checkpoint %d, checksum of data file %d", "The data file may be changed after it's partially imported, please check the dump files, or redo the whole task with -remove-meta flag for start-task command.")
+	ErrLoadUnitIncompatibleTable   = New(codeLoadUnitIncompatibleTable, ClassLoadUnit, ScopeDownstream, LevelHigh, "the pre-created downstream table %s is not compatible with the dumped table %s: %s", "Please alter the downstream table, or map the columns by `column-name-mappings` config of loaders in task configuration file.")
+	ErrLoadUnitExceedMaxError      = New(codeLoadUnitExceedMaxError, ClassLoadUnit, ScopeDownstream, LevelHigh, "the number of rows rejected by downstream exceeds max-error %d", "Please fix the rejected rows or the downstream tables, or increase the `max-error` config of loaders in task configuration file.")
 
 	// Sync unit error.
 	ErrSyncerUnitPanic                   = New(codeSyncerUnitPanic, ClassSyncUnit, ScopeInternal, LevelHigh, "panic error: %v", "")
@@ -1083,6 +1204,21 @@ var (
 	ErrSyncerParseDDL                       = New(codeSyncerParseDDL, ClassSyncUnit, ScopeInternal, LevelHigh, "parse DDL: %s", "Please confirm your DDL statement is correct and needed. For TiDB compatible DDL, see https://docs.pingcap.com/tidb/stable/mysql-compatibility#ddl. You can use `handle-error` command to skip or replace the DDL or add a binlog filter rule to ignore it if the DDL is not needed.")
 	ErrSyncerUnsupportedStmt                = New(codeSyncerUnsupportedStmt, ClassSyncUnit, ScopeInternal, LevelHigh, "`%s` statement not supported in %s mode", "")
 	ErrSyncerGetEvent                       = New(codeSyncerGetEvent, ClassSyncUnit, ScopeUpstream, LevelHigh, "get binlog event error: %v", "Please check if the binlog file could be parsed by `mysqlbinlog`.")
+	ErrSyncerUnsupportedDDLForPostgres      = New(codeSyncerUnsupportedDDLForPostgres, ClassSyncUnit, ScopeInternal, LevelHigh, "DDL %s is not supported when the target database is PostgreSQL: %s", "Please execute the equivalent statements in the downstream manually, then use `handle-error` command to skip the DDL.")
+	ErrSyncerMQSink                         = New(codeSyncerMQSink, ClassSyncUnit, ScopeDownstream, LevelHigh, "fail to %s with Kafka sink", "Please check the status of Kafka and the `sink-uri`.")
+	ErrSyncerRowFilterPlugin                = New(codeSyncerRowFilterPlugin, ClassSyncUnit, ScopeInternal, LevelHigh, "fail to %s with row filter plugin %s", "Please check the `plugin` of expression filter, the plugin should be built with the same version of Go and DM.")
+	ErrSyncerDDLRewriteHook                 = New(codeSyncerDDLRewriteHook, ClassSyncUnit, ScopeInternal, LevelHigh, "fail to rewrite DDLs %v by hook %s", "Please check the DDL rewrite hook service is available and responds the rewritten DDLs.")
+	ErrSyncerDDLNeedApproval                = New(codeSyncerDDLNeedApproval, ClassSyncUnit, ScopeInternal, LevelMedium, "DDLs %v at %s are waiting for approval", "Please review the DDLs, then use `binlog approve` command to apply them or `binlog skip` command to skip them.")
+	ErrSyncerTranscodeRow                   = New(codeSyncerTranscodeRow, ClassSyncUnit, ScopeInternal, LevelHigh, "transcode row data of table %v from charset %s", "Please check the `from-charset` of `charset-rules` matches the charset of the upstream table.")
+	ErrSyncerGTIDSkipListNotSupported       = New(codeSyncerGTIDSkipListNotSupported, ClassSyncUnit, ScopeInternal, LevelLow, "GTID skip list is not supported with enable-gtid %t and flavor %s", "Please set `enable-gtid: true` in the source config of MySQL to skip transactions by GTIDs.")
+	ErrSyncerDryRunWrite                    = New(codeSyncerDryRunWrite, ClassSyncUnit, ScopeInternal, LevelHigh, "fail to write the statements of dry-run to %s", "Please check the permission and free space of `dry-run-dir`.")
+	ErrSyncerSchemaSnapshot                 = New(codeSyncerSchemaSnapshot, ClassSyncUnit, ScopeInternal, LevelHigh, "fail to encode or decode the schema snapshot", "Please delete the schema snapshot in the downstream meta schema, the table infos are loaded from the checkpoints then.")
+	ErrSyncerBinlogGap                      = New(codeSyncerBinlogGap, ClassSyncUnit, ScopeUpstream, LevelHigh, "the binlog from the dumped location %s is not available in upstream: %s", "Please check the binlog retention of upstream, and restart the task with `start-task --remove-meta` to dump the data again, or set `on-binlog-gap` to `redump` to dump the data again automatically.")
+	ErrSyncerRedumpForBinlogGap             = New(codeSyncerRedumpForBinlogGap, ClassSyncUnit, ScopeUpstream, LevelMedium, "the binlog from the dumped location %s is not available in upstream, the data will be dumped again after the subtask is resumed: %s", "")
+	ErrSyncerRulesNotHotReloadable          = New(codeSyncerRulesNotHotReloadable, ClassSyncUnit, ScopeInternal, LevelLow, "the rules of the subtask in shard mode %s can't be hot reloaded", "Please stop the task and start it with the new rules, the sharding groups are decided by the route rules.")
+	ErrSyncerRulesHistory                   = New(codeSyncerRulesHistory, ClassSyncUnit, ScopeInternal, LevelHigh, "fail to encode or decode the rules of version %d in the checkpoint", "Please check the rules recorded in the downstream meta schema.")
+	ErrSyncerPauseTableNotSupported         = New(codeSyncerPauseTableNotSupported, ClassSyncUnit, ScopeInternal, LevelLow, "the tables of the subtask in shard mode %s can't be paused separately", "Please pause the whole task instead, the tables of a sharding group are coordinated with each other.")
+	ErrSyncerTableAlreadyPaused             = New(codeSyncerTableAlreadyPaused, ClassSyncUnit, ScopeInternal, LevelLow, "table %s is already paused with policy %s", "Please resume the table before pausing it with another policy.")
 
 	// DM-master error.
 	ErrMasterSQLOpNilRequest        = New(codeMasterSQLOpNilRequest, ClassDMMaster, ScopeInternal, LevelMedium, "nil request not valid", "")
@@ -1147,6 +1283,13 @@ var (
 	ErrMasterOptimisticTableInfoBeforeNotExist = New(codeMasterOptimisticTableInfobeforeNotExist, ClassDMMaster, ScopeInternal, LevelHigh, "table-info-before not exist in optimistic ddls: %v", "")
 	ErrMasterOptimisticDownstreamMetaNotFound  = New(codeMasterOptimisticDownstreamMetaNotFound, ClassDMMaster, ScopeInternal, LevelHigh, "downstream database config and meta for task %s not found", "")
 	ErrMasterInvalidClusterID                  = New(codeMasterInvalidClusterID, ClassDMMaster, ScopeInternal, LevelHigh, "invalid cluster id: %v", "")
+	ErrMasterOptimisticTableNotFound           = New(codeMasterOptimisticTableNotFound, ClassDMMaster, ScopeInternal, LevelMedium, "table %s of source %s not found in the shard DDL lock %s", "Please check the tables of the lock by the shard DDL locks API.")
+	ErrMasterInvalidAuditLogConfig             = New(codeMasterInvalidAuditLogConfig, ClassDMMaster, ScopeInternal, LevelMedium, "invalid audit log config: %s", "Please check the `audit-log` config in master configuration file.")
+	ErrMasterOpenAuditLogSink                  = New(codeMasterOpenAuditLogSink, ClassDMMaster, ScopeInternal, LevelHigh, "fail to open the audit log sink %s", "Please check the `audit-log` config in master configuration file and the permission of the sink.")
+	ErrMasterInvalidWebhookConfig              = New(codeMasterInvalidWebhookConfig, ClassDMMaster, ScopeInternal, LevelMedium, "invalid webhook config: %s", "Please check the `webhook` config in master configuration file.")
+	ErrMasterInvalidWebhook                    = New(codeMasterInvalidWebhook, ClassDMMaster, ScopeInternal, LevelLow, "invalid webhook '%s': %s", "")
+	ErrMasterWebhookExist                      = New(codeMasterWebhookExist, ClassDMMaster, ScopeInternal, LevelLow, "webhook '%s' already exists", "Please delete the webhook first or use another name.")
+	ErrMasterWebhookNotExist                   = New(codeMasterWebhookNotExist, ClassDMMaster, ScopeInternal, LevelLow, "webhook '%s' does not exist", "")
 
 	// DM-worker error.
 	ErrWorkerParseFlagSet            = New(codeWorkerParseFlagSet, ClassDMWorker, ScopeInternal, LevelMedium, "parse dm-worker config flag set", "")
@@ -1229,6 +1372,12 @@ var (
 	ErrWorkerTLSConfigNotValid              = New(codeWorkerTLSConfigNotValid, ClassDMWorker, ScopeInternal, LevelHigh, "TLS config not valid", "Please check the `ssl-ca`, `ssl-cert` and `ssl-key` config in worker configuration file.")
 	ErrWorkerFailConnectMaster              = New(codeWorkerFailConnectMaster, ClassDMWorker, ScopeInternal, LevelHigh, "cannot join with master endpoints: %v, error: %v", "Please check network connection of worker and check worker name is unique.")
 	ErrWorkerRelayConfigChanging            = New(codeWorkerRelayConfigChanging, ClassDMWorker, ScopeInternal, LevelLow, "relay config of worker %s is changed too frequently, last relay source %s:, new relay source %s", "Please try again later")
+	ErrWorkerRateLimitNotSupported          = New(codeWorkerRateLimitNotSupported, ClassDMWorker, ScopeInternal, LevelLow, "rate limit is not supported by the current unit %s of subtask %s", "Please set the rate limit when the subtask is in the load or sync unit.")
+	ErrWorkerSkipGTIDNotSupported           = New(codeWorkerSkipGTIDNotSupported, ClassDMWorker, ScopeInternal, LevelLow, "GTID skip list is not supported by subtask %s without sync unit", "Please operate the GTID skip list of the subtask in incremental or all mode.")
+	ErrWorkerRulesNotHotReloadable          = New(codeWorkerRulesNotHotReloadable, ClassDMWorker, ScopeInternal, LevelLow, "the rules of subtask %s without sync unit can't be hot reloaded", "Please hot reload the rules of the subtask in incremental or all mode.")
+	ErrWorkerInvalidTaskResourceLimits      = New(codeWorkerInvalidTaskResourceLimits, ClassDMWorker, ScopeInternal, LevelMedium, "invalid task resource limits: %s", "Please check the `task-resource-limits` in the config of DM-worker.")
+	ErrWorkerPauseTableNotSupported         = New(codeWorkerPauseTableNotSupported, ClassDMWorker, ScopeInternal, LevelLow, "pausing tables is not supported by subtask %s without sync unit", "Please pause the tables of the subtask in incremental or all mode.")
+	ErrWorkerInvalidPriorityThrottle        = New(codeWorkerInvalidPriorityThrottle, ClassDMWorker, ScopeInternal, LevelMedium, "invalid priority throttle: %s", "Please check the `priority-throttle` in the config of DM-worker.")
 
 	// DM-tracer error.
 	ErrTracerParseFlagSet        = New(codeTracerParseFlagSet, ClassDMTracer, ScopeInternal, LevelMedium, "parse dm-tracer config flag set", "")
@@ -1309,6 +1458,9 @@ var (
 	ErrSchedulerStopRelayOnBound             = New(codeSchedulerStopRelayOnBound, ClassScheduler, ScopeInternal, LevelLow, "the source has `start-relay` automatically for bound worker, so it can't `stop-relay` with worker name now", "Please use `stop-relay` without worker name.")
 	ErrSchedulerPauseTaskForTransferSource   = New(codeSchedulerPauseTaskForTransferSource, ClassScheduler, ScopeInternal, LevelLow, "failed to auto pause tasks %s when transfer-source", "Please pause task by `dmctl pause-task`.")
 	ErrSchedulerWorkerNotFree                = New(codeSchedulerWorkerNotFree, ClassScheduler, ScopeInternal, LevelLow, "dm-worker with name %s not free", "")
+	ErrSchedulerNoWorkerToDrain              = New(codeSchedulerNoWorkerToDrain, ClassScheduler, ScopeInternal, LevelMedium, "no free dm-worker to take over source %s when draining dm-worker %s", "Please add a new dm-worker or make another dm-worker free.")
+	ErrSchedulerWorkerDraining               = New(codeSchedulerWorkerDraining, ClassScheduler, ScopeInternal, LevelLow, "dm-worker with name %s is being drained and can't be bound to any source", "Please restart the dm-worker to make it schedulable again.")
+	ErrSchedulerWorkerNotMatchAffinity       = New(codeSchedulerWorkerNotMatchAffinity, ClassScheduler, ScopeInternal, LevelLow, "dm-worker with name %s and labels %v doesn't match the worker affinity of source %s", "Please check the `labels` config of the dm-worker and the `affinity` config of the source.")
 	// dmctl.
 	ErrCtlGRPCCreateConn = New(codeCtlGRPCCreateConn, ClassDMCtl, ScopeInternal, LevelHigh, "can not create grpc connection", "Please check your network connection.")
 	ErrCtlInvalidTLSCfg  = New(codeCtlInvalidTLSCfg, ClassDMCtl, ScopeInternal, LevelMedium, "invalid TLS config", "Please check the `ssl-ca`, `ssl-cert` and `ssl-key` config in command line.")
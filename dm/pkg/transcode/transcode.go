@@ -0,0 +1,270 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package transcode converts the tables of legacy charsets in upstream to the charset of downstream by the charset
+// rules, including the string values of row changes and the charset clauses of DDLs.
+package transcode
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/errors"
+	selector "github.com/pingcap/tidb-tools/pkg/table-rule-selector"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/charset"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/types"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/simplifiedchinese"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// Transcoder selects the charset rules of tables.
+type Transcoder struct {
+	caseSensitive bool
+	selector      selector.Selector
+}
+
+// NewTranscoder creates a Transcoder, returns nil if there are no rules.
+func NewTranscoder(caseSensitive bool, rules []*config.CharsetRule) (*Transcoder, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	t := &Transcoder{
+		caseSensitive: caseSensitive,
+		selector:      selector.NewTrieSelector(),
+	}
+	for _, rule := range rules {
+		schema, table := rule.SchemaPattern, rule.TablePattern
+		if !caseSensitive {
+			schema, table = strings.ToLower(schema), strings.ToLower(table)
+		}
+		if err := t.selector.Insert(schema, table, rule, selector.Insert); err != nil {
+			return nil, terror.ErrConfigInvalidCharsetRule.Delegate(err,
+				fmt.Sprintf("schema-pattern '%s' table-pattern '%s'", rule.SchemaPattern, rule.TablePattern))
+		}
+	}
+	return t, nil
+}
+
+// Match returns the charset rule of the table, or nil if no rule matches. the table level rule has higher priority
+// than the schema level rule. an empty table matches the schema level rules only.
+func (t *Transcoder) Match(schema, table string) *config.CharsetRule {
+	if t == nil {
+		return nil
+	}
+	if !t.caseSensitive {
+		schema, table = strings.ToLower(schema), strings.ToLower(table)
+	}
+	rules := t.selector.Match(schema, table)
+	// the schema level rules are followed by the table level rules
+	for i := len(rules) - 1; i >= 0; i-- {
+		rule := rules[i].(*config.CharsetRule)
+		if table != "" || rule.TablePattern == "" {
+			return rule
+		}
+	}
+	return nil
+}
+
+func newDecoder(cs string) (*encoding.Decoder, error) {
+	switch cs {
+	case config.CharsetLatin1:
+		// latin1 of MySQL is cp1252 actually
+		return charmap.Windows1252.NewDecoder(), nil
+	case config.CharsetGBK:
+		return simplifiedchinese.GBK.NewDecoder(), nil
+	}
+	return nil, errors.NotSupportedf("charset %s", cs)
+}
+
+// isTextColumn returns whether the values of the column are encoded by the charset of the column.
+func isTextColumn(col *model.ColumnInfo) bool {
+	tp := col.FieldType.Tp
+	if !types.IsTypeChar(tp) && !types.IsTypeBlob(tp) && tp != mysql.TypeVarString {
+		return false
+	}
+	return col.FieldType.Charset != charset.CharsetBin
+}
+
+// DecodeRows decodes the string values of the text columns in rows from FromCharset of the rule to UTF-8 in place,
+// the decoded values keep their types. the columns of tableInfo should be in the same order as the values.
+// NOTE: the charset of the columns in tableInfo is not used, because the table may be loaded from downstream.
+func DecodeRows(rule *config.CharsetRule, tableInfo *model.TableInfo, rows [][]interface{}) error {
+	decoder, err := newDecoder(rule.FromCharset)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		for i, value := range row {
+			if i >= len(tableInfo.Columns) || !isTextColumn(tableInfo.Columns[i]) {
+				continue
+			}
+			switch v := value.(type) {
+			case string:
+				if row[i], err = decoder.String(v); err != nil {
+					return errors.Annotatef(err, "decode column %s from %s", tableInfo.Columns[i].Name.O, rule.FromCharset)
+				}
+			case []byte:
+				if row[i], err = decoder.Bytes(v); err != nil {
+					return errors.Annotatef(err, "decode column %s from %s", tableInfo.Columns[i].Name.O, rule.FromCharset)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// RewriteDDL replaces FromCharset of the rule by ToCharset in the charset clauses of the DDL in place, and the
+// collations of FromCharset by ToCollation, or removes them if ToCollation is empty. CREATE TABLE and CREATE DATABASE
+// without a charset clause are added one, because the default charset of upstream may be FromCharset.
+// it returns whether the DDL is changed.
+func RewriteDDL(rule *config.CharsetRule, stmt ast.StmtNode) bool {
+	r := &rewriter{rule: rule}
+	switch s := stmt.(type) {
+	case *ast.CreateTableStmt:
+		if s.ReferTable != nil {
+			return false
+		}
+		for _, col := range s.Cols {
+			r.rewriteColumn(col)
+		}
+		s.Options = r.rewriteTableOptions(s.Options, true)
+	case *ast.AlterTableStmt:
+		for _, spec := range s.Specs {
+			for _, col := range spec.NewColumns {
+				r.rewriteColumn(col)
+			}
+			spec.Options = r.rewriteTableOptions(spec.Options, false)
+		}
+	case *ast.CreateDatabaseStmt:
+		s.Options = r.rewriteDatabaseOptions(s.Options, true)
+	case *ast.AlterDatabaseStmt:
+		s.Options = r.rewriteDatabaseOptions(s.Options, false)
+	}
+	return r.changed
+}
+
+type rewriter struct {
+	rule    *config.CharsetRule
+	changed bool
+}
+
+func (r *rewriter) isFromCharset(cs string) bool {
+	return strings.EqualFold(cs, r.rule.FromCharset)
+}
+
+func (r *rewriter) isFromCollation(collation string) bool {
+	return strings.HasPrefix(strings.ToLower(collation), r.rule.FromCharset+"_")
+}
+
+func (r *rewriter) rewriteColumn(col *ast.ColumnDef) {
+	if col.Tp == nil {
+		return
+	}
+	if r.isFromCharset(col.Tp.Charset) {
+		col.Tp.Charset = r.rule.ToCharset
+		r.changed = true
+	}
+	if r.isFromCollation(col.Tp.Collate) {
+		col.Tp.Collate = r.rule.ToCollation
+		r.changed = true
+	}
+	options := col.Options[:0]
+	for _, opt := range col.Options {
+		if opt.Tp == ast.ColumnOptionCollate && r.isFromCollation(opt.StrValue) {
+			r.changed = true
+			if r.rule.ToCollation == "" {
+				continue
+			}
+			opt.StrValue = r.rule.ToCollation
+		}
+		options = append(options, opt)
+	}
+	col.Options = options
+}
+
+func (r *rewriter) rewriteTableOptions(opts []*ast.TableOption, addCharset bool) []*ast.TableOption {
+	var hasCharset, hasCollation, removed bool
+	ret := opts[:0]
+	for _, opt := range opts {
+		switch opt.Tp {
+		case ast.TableOptionCharset:
+			hasCharset = true
+			if r.isFromCharset(opt.StrValue) {
+				opt.StrValue = r.rule.ToCharset
+				r.changed = true
+			}
+		case ast.TableOptionCollate:
+			if r.isFromCollation(opt.StrValue) {
+				r.changed = true
+				if r.rule.ToCollation == "" {
+					removed = true
+					continue
+				}
+				opt.StrValue = r.rule.ToCollation
+			}
+			hasCollation = true
+		}
+		ret = append(ret, opt)
+	}
+	// the removed collation is replaced by the charset to keep the clause
+	if (addCharset && !hasCharset && !hasCollation) || (removed && !hasCharset) {
+		ret = append(ret, &ast.TableOption{Tp: ast.TableOptionCharset, StrValue: r.rule.ToCharset})
+		if r.rule.ToCollation != "" {
+			ret = append(ret, &ast.TableOption{Tp: ast.TableOptionCollate, StrValue: r.rule.ToCollation})
+		}
+		r.changed = true
+	}
+	return ret
+}
+
+func (r *rewriter) rewriteDatabaseOptions(opts []*ast.DatabaseOption, addCharset bool) []*ast.DatabaseOption {
+	var hasCharset, hasCollation, removed bool
+	ret := opts[:0]
+	for _, opt := range opts {
+		switch opt.Tp {
+		case ast.DatabaseOptionCharset:
+			hasCharset = true
+			if r.isFromCharset(opt.Value) {
+				opt.Value = r.rule.ToCharset
+				r.changed = true
+			}
+		case ast.DatabaseOptionCollate:
+			if r.isFromCollation(opt.Value) {
+				r.changed = true
+				if r.rule.ToCollation == "" {
+					removed = true
+					continue
+				}
+				opt.Value = r.rule.ToCollation
+			}
+			hasCollation = true
+		}
+		ret = append(ret, opt)
+	}
+	// the removed collation is replaced by the charset to keep the clause
+	if (addCharset && !hasCharset && !hasCollation) || (removed && !hasCharset) {
+		ret = append(ret, &ast.DatabaseOption{Tp: ast.DatabaseOptionCharset, Value: r.rule.ToCharset})
+		if r.rule.ToCollation != "" {
+			ret = append(ret, &ast.DatabaseOption{Tp: ast.DatabaseOptionCollate, Value: r.rule.ToCollation})
+		}
+		r.changed = true
+	}
+	return ret
+}
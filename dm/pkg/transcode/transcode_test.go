@@ -0,0 +1,153 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transcode
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/format"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/types"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+func TestSuite(t *testing.T) {
+	TestingT(t)
+}
+
+var _ = Suite(&testTranscodeSuite{})
+
+type testTranscodeSuite struct{}
+
+func (t *testTranscodeSuite) TestMatch(c *C) {
+	tc, err := NewTranscoder(false, nil)
+	c.Assert(err, IsNil)
+	c.Assert(tc, IsNil)
+	c.Assert(tc.Match("db", "tb"), IsNil)
+
+	schemaRule := &config.CharsetRule{SchemaPattern: "DB*", FromCharset: config.CharsetLatin1, ToCharset: config.CharsetUTF8MB4}
+	tableRule := &config.CharsetRule{SchemaPattern: "db1", TablePattern: "tb*", FromCharset: config.CharsetGBK, ToCharset: config.CharsetUTF8MB4}
+	tc, err = NewTranscoder(false, []*config.CharsetRule{schemaRule, tableRule})
+	c.Assert(err, IsNil)
+	c.Assert(tc.Match("db1", "tb1"), Equals, tableRule)
+	c.Assert(tc.Match("Db1", "t1"), Equals, schemaRule)
+	c.Assert(tc.Match("db1", ""), Equals, schemaRule)
+	c.Assert(tc.Match("db2", "tb1"), Equals, schemaRule)
+	c.Assert(tc.Match("other", "tb1"), IsNil)
+
+	_, err = NewTranscoder(false, []*config.CharsetRule{schemaRule, schemaRule})
+	c.Assert(terror.ErrConfigInvalidCharsetRule.Equal(err), IsTrue)
+}
+
+func (t *testTranscodeSuite) TestDecodeRows(c *C) {
+	newCol := func(name string, tp byte, cs string) *model.ColumnInfo {
+		ft := types.NewFieldType(tp)
+		ft.Charset = cs
+		return &model.ColumnInfo{Name: model.NewCIStr(name), FieldType: *ft}
+	}
+	ti := &model.TableInfo{Columns: []*model.ColumnInfo{
+		newCol("id", mysql.TypeLong, "binary"),
+		newCol("c1", mysql.TypeVarchar, "latin1"),
+		newCol("c2", mysql.TypeBlob, "utf8mb4"),
+		newCol("c3", mysql.TypeVarchar, "binary"),
+	}}
+	rows := [][]interface{}{
+		{int32(1), "caf\xe9", []byte("\x80"), []byte("\xe9")},
+		{int32(2), nil, nil, nil},
+	}
+	rule := &config.CharsetRule{FromCharset: config.CharsetLatin1, ToCharset: config.CharsetUTF8MB4}
+	c.Assert(DecodeRows(rule, ti, rows), IsNil)
+	c.Assert(rows, DeepEquals, [][]interface{}{
+		{int32(1), "café", []byte("€"), []byte("\xe9")},
+		{int32(2), nil, nil, nil},
+	})
+
+	rows = [][]interface{}{{int32(1), "\xc4\xe3\xba\xc3", nil, nil}}
+	rule.FromCharset = config.CharsetGBK
+	c.Assert(DecodeRows(rule, ti, rows), IsNil)
+	c.Assert(rows[0][1], Equals, "你好")
+}
+
+func (t *testTranscodeSuite) TestRewriteDDL(c *C) {
+	rule := &config.CharsetRule{FromCharset: config.CharsetLatin1, ToCharset: config.CharsetUTF8MB4}
+	collationRule := &config.CharsetRule{FromCharset: config.CharsetLatin1, ToCharset: config.CharsetUTF8MB4, ToCollation: "utf8mb4_bin"}
+	cases := []struct {
+		rule     *config.CharsetRule
+		ddl      string
+		expected string
+	}{
+		{
+			rule,
+			"create table t (c1 varchar(10) charset latin1 collate latin1_bin, c2 varbinary(10)) default charset=latin1 collate=latin1_swedish_ci",
+			"CREATE TABLE `t` (`c1` VARCHAR(10) CHARACTER SET UTF8MB4,`c2` VARBINARY(10)) DEFAULT CHARACTER SET = UTF8MB4",
+		},
+		{
+			collationRule,
+			"create table t (c1 text collate latin1_bin)",
+			"CREATE TABLE `t` (`c1` TEXT COLLATE utf8mb4_bin) DEFAULT CHARACTER SET = UTF8MB4 DEFAULT COLLATE = UTF8MB4_BIN",
+		},
+		{
+			rule,
+			"create table t (c1 int) collate=latin1_bin",
+			"CREATE TABLE `t` (`c1` INT) DEFAULT CHARACTER SET = UTF8MB4",
+		},
+		{
+			rule,
+			"create table t like t1",
+			"CREATE TABLE `t` LIKE `t1`",
+		},
+		{
+			rule,
+			"alter table t add column c1 char(1) charset latin1, convert to character set latin1",
+			"ALTER TABLE `t` ADD COLUMN `c1` CHAR(1) CHARACTER SET UTF8MB4, CONVERT TO CHARACTER SET UTF8MB4",
+		},
+		{
+			rule,
+			"alter table t collate latin1_bin",
+			"ALTER TABLE `t` DEFAULT CHARACTER SET = UTF8MB4",
+		},
+		{
+			rule,
+			"alter table t add column c1 int",
+			"ALTER TABLE `t` ADD COLUMN `c1` INT",
+		},
+		{
+			collationRule,
+			"create database db",
+			"CREATE DATABASE `db` CHARACTER SET = utf8mb4 COLLATE = utf8mb4_bin",
+		},
+		{
+			rule,
+			"alter database db character set latin1",
+			"ALTER DATABASE `db` CHARACTER SET = utf8mb4",
+		},
+	}
+	p := parser.New()
+	for _, cs := range cases {
+		stmt, err := p.ParseOneStmt(cs.ddl, "", "")
+		c.Assert(err, IsNil)
+		changed := RewriteDDL(cs.rule, stmt)
+		var sb strings.Builder
+		c.Assert(stmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)), IsNil)
+		c.Assert(sb.String(), Equals, cs.expected, Commentf("ddl: %s", cs.ddl))
+		c.Assert(changed, Equals, cs.ddl != "create table t like t1" && cs.ddl != "alter table t add column c1 int",
+			Commentf("ddl: %s", cs.ddl))
+	}
+}
@@ -21,8 +21,9 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	. "github.com/pingcap/check"
 	"github.com/pingcap/tidb-tools/pkg/filter"
-	router "github.com/pingcap/tidb-tools/pkg/table-router"
 	"github.com/pingcap/tidb/parser"
+
+	"github.com/pingcap/tiflow/dm/pkg/router"
 )
 
 var _ = Suite(&testCommonSuite{})
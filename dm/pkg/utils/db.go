@@ -538,36 +538,31 @@ func AddGSetWithPurged(ctx context.Context, gset gtid.Set, conn *sql.Conn) (gtid
 		return gset, nil
 	}
 
-	var (
-		gtidStr string
-		row     *sql.Row
-		err     error
-	)
+	purged, err := GetGTIDPurged(ctx, conn)
+	if err != nil {
+		log.L().Error("can't get @@GLOBAL.gtid_purged when try to add it to gtid set", zap.Error(err))
+		return nil, err
+	}
+	if len(purged.String()) == 0 {
+		return gset, nil
+	}
+	return gtid.Merge(gset, purged)
+}
+
+// GetGTIDPurged gets @@GLOBAL.gtid_purged of MySQL, the transactions in it can't be replicated from the binlog.
+func GetGTIDPurged(ctx context.Context, conn *sql.Conn) (gtid.Set, error) {
+	var gtidStr string
 
 	failpoint.Inject("GetGTIDPurged", func(val failpoint.Value) {
 		str := val.(string)
 		gtidStr = str
 		failpoint.Goto("bypass")
 	})
-	row = conn.QueryRowContext(ctx, "select @@GLOBAL.gtid_purged")
-	err = row.Scan(&gtidStr)
-	if err != nil {
-		log.L().Error("can't get @@GLOBAL.gtid_purged when try to add it to gtid set", zap.Error(err))
-		return gset, terror.DBErrorAdapt(err, terror.ErrDBDriverError)
+	if err := conn.QueryRowContext(ctx, "select @@GLOBAL.gtid_purged").Scan(&gtidStr); err != nil {
+		return nil, terror.DBErrorAdapt(err, terror.ErrDBDriverError)
 	}
 	failpoint.Label("bypass")
-	if gtidStr == "" {
-		return gset, nil
-	}
-
-	newGset := gset.Origin()
-	err = newGset.Update(gtidStr)
-	if err != nil {
-		return nil, err
-	}
-	ret := &gtid.MySQLGTIDSet{}
-	_ = ret.Set(newGset)
-	return ret, nil
+	return gtid.ParserGTID(gmysql.MySQLFlavor, gtidStr)
 }
 
 // AdjustSQLModeCompatible adjust downstream sql mode to compatible.
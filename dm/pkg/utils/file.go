@@ -72,6 +72,25 @@ func GetFileSize(file string) (int64, error) {
 	return stat.Size(), nil
 }
 
+// GetDirSize returns the total size of the regular files in the directory recursively. symbolic links are not followed,
+// the relay logs moved to other volumes are not counted.
+func GetDirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.Walk(dir, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil // purged during walking
+			}
+			return err
+		}
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
+
 // WriteFileAtomic writes file to temp and atomically move when everything else succeeds.
 func WriteFileAtomic(filename string, data []byte, perm os.FileMode) error {
 	dir, name := path.Dir(filename), path.Base(filename)
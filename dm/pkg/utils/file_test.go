@@ -57,4 +57,11 @@ func (t *testFileSuite) TestFile(c *C) {
 	size, err = GetFileSize(f)
 	c.Assert(err, IsNil)
 	c.Assert(size, Equals, int64(len("some content")))
+
+	// the size of the dir includes the files in the sub dirs
+	c.Assert(os.Mkdir(filepath.Join(d, "sub"), 0o755), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(d, "sub", "text-file"), []byte("more"), 0o644), IsNil)
+	size, err = GetDirSize(d)
+	c.Assert(err, IsNil)
+	c.Assert(size, Equals, int64(len("some content")+len("more")))
 }
@@ -44,8 +44,13 @@ func ParseUUIDIndex(indexPath string) ([]string, error) {
 	}
 	defer fd.Close()
 
+	return ParseUUIDIndexFromReader(fd)
+}
+
+// ParseUUIDIndexFromReader is like ParseUUIDIndex, but parses the content of the index file read from `r`.
+func ParseUUIDIndexFromReader(r io.Reader) ([]string, error) {
 	uuids := make([]string, 0, 5)
-	br := bufio.NewReader(fd)
+	br := bufio.NewReader(r)
 	for {
 		line, err := br.ReadString('\n')
 		if err == io.EOF {
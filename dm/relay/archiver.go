@@ -0,0 +1,268 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+// archiveBufferSize is the size of data uploaded in a single write.
+const archiveBufferSize = 4 * 1024 * 1024
+
+// relayArchiver uploads the rotated relay log files to an external storage with the same layout as the relay
+// directory, and verifies them by reading them back. the archived relay log files can be purged by the purger
+// before readers have read them, as readers fall back to the archive (see archivedRelayStorage).
+type relayArchiver struct {
+	relayDir string
+	cfg      ArchiveConfig
+	logger   log.Logger
+
+	mu       sync.Mutex
+	archived map[string]struct{} // local paths of archived relay log files
+
+	wg     sync.WaitGroup
+	cancel context.CancelFunc
+}
+
+func newRelayArchiver(logger log.Logger, relayDir string, cfg ArchiveConfig) *relayArchiver {
+	cfg.adjust()
+	return &relayArchiver{
+		relayDir: relayDir,
+		cfg:      cfg,
+		logger:   logger.WithFields(zap.String("sub component", "relay archiver")),
+		archived: make(map[string]struct{}),
+	}
+}
+
+// start starts archiving in background until `ctx` is done or close is called.
+func (a *relayArchiver) start(ctx context.Context) {
+	ctx, a.cancel = context.WithCancel(ctx)
+	archive := &remoteRelayStorage{ctx: ctx, uri: a.cfg.URL}
+	a.logger.Info("starting relay archiver", zap.Reflect("config", a.cfg))
+
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		ticker := time.NewTicker(a.cfg.Interval)
+		defer ticker.Stop()
+		for {
+			if err := a.archiveOnce(ctx, archive); err != nil && ctx.Err() == nil {
+				a.logger.Warn("fail to archive relay log files", zap.Error(err))
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// close stops archiving and waits for the uploading relay log file.
+func (a *relayArchiver) close() {
+	if a.cancel != nil {
+		a.cancel()
+	}
+	a.wg.Wait()
+}
+
+// archivedRelayLogs returns the local paths of the relay log files which have been archived, sorted by paths.
+func (a *relayArchiver) archivedRelayLogs() []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	files := make([]string, 0, len(a.archived))
+	for f := range a.archived {
+		files = append(files, f)
+	}
+	sort.Strings(files)
+	return files
+}
+
+// archiveOnce uploads the relay log files not archived yet, except the newest one which the relay is writing.
+// a relay log file already in the archive with the same size is regarded as archived before.
+func (a *relayArchiver) archiveOnce(ctx context.Context, archive *remoteRelayStorage) error {
+	uuids, err := utils.ParseUUIDIndex(filepath.Join(a.relayDir, utils.UUIDIndexFilename))
+	if err != nil {
+		return err
+	}
+
+	archived := make(map[string]struct{})
+	defer func() {
+		// the purged relay log files are removed, and the ones not checked are checked again in the next round.
+		a.mu.Lock()
+		a.archived = archived
+		a.mu.Unlock()
+	}()
+
+	for i, uuid := range uuids {
+		dir := filepath.Join(a.relayDir, uuid)
+		if !utils.IsDirExists(dir) {
+			continue // purged
+		}
+		files, err2 := CollectAllBinlogFiles(dir)
+		if err2 != nil {
+			return err2
+		}
+		if i == len(uuids)-1 && len(files) > 0 {
+			files = files[:len(files)-1] // the active relay log file
+		}
+		if len(files) == 0 {
+			continue
+		}
+
+		// the keyring and gaps are uploaded before the relay log files which need them
+		if err2 = a.archiveSideFile(ctx, archive, uuid, relayLogKeyringFilename); err2 != nil {
+			return err2
+		}
+		if err2 = a.archiveSideFile(ctx, archive, uuid, relayLogGapsFilename); err2 != nil {
+			return err2
+		}
+
+		remoteSizes := make(map[string]int64)
+		err2 = archive.walkDir(uuid, func(name string, size int64) {
+			remoteSizes[name] = size
+		})
+		if err2 != nil {
+			return err2
+		}
+		for _, f := range files {
+			localPath, _ := resolveRelayLogFile(localRelayStorage{}, filepath.Join(dir, f))
+			fi, err3 := os.Stat(localPath)
+			if err3 != nil {
+				if os.IsNotExist(err3) {
+					continue // purged or compressed just now
+				}
+				return terror.ErrGetRelayLogStat.Delegate(err3, localPath)
+			}
+			name := filepath.Base(localPath)
+			if size, ok := remoteSizes[name]; !ok || size != fi.Size() {
+				if err3 = a.upload(ctx, archive, localPath, path.Join(uuid, name)); err3 != nil {
+					return err3
+				}
+			}
+			archived[localPath] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// archiveSideFile uploads the file about the relay log files in the sub directory if it's changed, like the keyring
+// of encrypted relay log files, so the archived relay log files can be read after purged locally.
+func (a *relayArchiver) archiveSideFile(ctx context.Context, archive *remoteRelayStorage, uuid, filename string) error {
+	localPath := filepath.Join(a.relayDir, uuid, filename)
+	local, err := os.ReadFile(localPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Trace(err)
+	}
+	remotePath := path.Join(uuid, filename)
+	if archive.exists(remotePath) {
+		remote, err2 := archive.readFile(remotePath)
+		if err2 == nil && bytes.Equal(local, remote) {
+			return nil
+		}
+	}
+	return a.upload(ctx, archive, localPath, remotePath)
+}
+
+// upload uploads the local relay log file to `remotePath` in the archive, and verifies it by the checksums.
+func (a *relayArchiver) upload(ctx context.Context, archive *remoteRelayStorage, localPath, remotePath string) error {
+	startTime := time.Now()
+	s, err := archive.externalStorage()
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(localPath)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer f.Close()
+
+	if ls, ok := s.(*storage.LocalStorage); ok {
+		// directories are not created automatically in the local storage
+		base := strings.TrimPrefix(ls.URI(), storage.LocalURIPrefix+"/")
+		if err = os.MkdirAll(filepath.Join(base, path.Dir(remotePath)), 0o755); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	w, err := s.Create(ctx, remotePath)
+	if err != nil {
+		return errors.Annotatef(err, "create %s in archive", remotePath)
+	}
+	h := sha256.New()
+	buf := make([]byte, archiveBufferSize)
+	for {
+		n, err2 := f.Read(buf)
+		if n > 0 {
+			h.Write(buf[:n])
+			if _, err3 := w.Write(ctx, buf[:n]); err3 != nil {
+				return errors.Annotatef(err3, "upload %s to archive", localPath)
+			}
+		}
+		if err2 == io.EOF {
+			break
+		} else if err2 != nil {
+			return errors.Trace(err2)
+		}
+	}
+	if err = w.Close(ctx); err != nil {
+		return errors.Annotatef(err, "upload %s to archive", localPath)
+	}
+
+	archivedSum, err := archiveChecksum(archive, remotePath)
+	if err != nil {
+		return err
+	}
+	if localSum := h.Sum(nil); !bytes.Equal(localSum, archivedSum) {
+		if err2 := s.DeleteFile(ctx, remotePath); err2 != nil {
+			a.logger.Warn("fail to delete the corrupted relay log file in archive", zap.String("file", remotePath), zap.Error(err2))
+		}
+		return errors.Errorf("checksum of archived relay log file %s mismatch, local %x, archived %x", remotePath, localSum, archivedSum)
+	}
+	a.logger.Info("relay log file archived", zap.String("file", localPath), zap.Duration("cost time", time.Since(startTime)))
+	return nil
+}
+
+func archiveChecksum(archive *remoteRelayStorage, remotePath string) ([]byte, error) {
+	f, err := archive.open(remotePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return nil, errors.Annotatef(err, "read %s from archive", remotePath)
+	}
+	return h.Sum(nil), nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+var _ = Suite(&testArchiverSuite{})
+
+type testArchiverSuite struct{}
+
+func (t *testArchiverSuite) TestRelayArchiver(c *C) {
+	var (
+		ctx        = context.Background()
+		relayDir   = c.MkDir()
+		archiveDir = c.MkDir()
+		uuids      = []string{
+			"b60868af-5a6f-11e9-9ea3-0242ac160006.000001",
+			"b60868af-5a6f-11e9-9ea3-0242ac160007.000002",
+		}
+		content = []byte("meaningless relay log content")
+		archive = &remoteRelayStorage{ctx: ctx, uri: "file://" + archiveDir}
+		a       = newRelayArchiver(log.L(), relayDir, ArchiveConfig{URL: archive.uri})
+	)
+	c.Assert(a.cfg.Interval, Equals, defaultArchiveInterval)
+	c.Assert(writeUUIDIndex(filepath.Join(relayDir, utils.UUIDIndexFilename), uuids), IsNil)
+	for _, uuid := range uuids {
+		c.Assert(os.Mkdir(filepath.Join(relayDir, uuid), 0o700), IsNil)
+		for _, name := range []string{"mysql-bin.000001", "mysql-bin.000002"} {
+			c.Assert(os.WriteFile(filepath.Join(relayDir, uuid, name), content, 0o600), IsNil)
+		}
+	}
+	c.Assert(compressRelayLogFile(filepath.Join(relayDir, uuids[1], "mysql-bin.000001")), IsNil)
+
+	// the active relay log file is not archived
+	c.Assert(a.archiveOnce(ctx, archive), IsNil)
+	expected := []string{
+		filepath.Join(relayDir, uuids[0], "mysql-bin.000001"),
+		filepath.Join(relayDir, uuids[0], "mysql-bin.000002"),
+		filepath.Join(relayDir, uuids[1], "mysql-bin.000001.zst"),
+	}
+	c.Assert(a.archivedRelayLogs(), DeepEquals, expected)
+	for _, f := range expected {
+		rel, err := filepath.Rel(relayDir, f)
+		c.Assert(err, IsNil)
+		c.Assert(utils.IsFileExists(filepath.Join(archiveDir, rel)), IsTrue)
+	}
+	c.Assert(utils.IsFileExists(filepath.Join(archiveDir, uuids[1], "mysql-bin.000002")), IsFalse)
+
+	// the relay log file not the same in the archive is uploaded again
+	archived := filepath.Join(archiveDir, uuids[0], "mysql-bin.000001")
+	c.Assert(os.WriteFile(archived, content[:10], 0o600), IsNil)
+	c.Assert(a.archiveOnce(ctx, archive), IsNil)
+	data, err := os.ReadFile(archived)
+	c.Assert(err, IsNil)
+	c.Assert(data, DeepEquals, content)
+
+	// the purged relay log files are not archived ones any more
+	c.Assert(os.Remove(expected[0]), IsNil)
+	c.Assert(a.archiveOnce(ctx, archive), IsNil)
+	c.Assert(a.archivedRelayLogs(), DeepEquals, expected[1:])
+
+	// archive in background
+	c.Assert(os.RemoveAll(filepath.Join(archiveDir, uuids[0])), IsNil)
+	a.cfg.Interval = 10 * time.Millisecond
+	a.start(ctx)
+	c.Assert(utils.WaitSomething(100, 10*time.Millisecond, func() bool {
+		return utils.IsFileExists(filepath.Join(archiveDir, uuids[0], "mysql-bin.000002"))
+	}), IsTrue)
+	a.close()
+}
+
+func (t *testArchiverSuite) TestArchivedRelayStorage(c *C) {
+	var (
+		ctx        = context.Background()
+		relayDir   = c.MkDir()
+		archiveDir = c.MkDir()
+		uuid       = "b60868af-5a6f-11e9-9ea3-0242ac160006.000001"
+		content    = []byte("meaningless relay log content")
+		dir        = filepath.Join(relayDir, uuid)
+	)
+	c.Assert(os.Mkdir(dir, 0o700), IsNil)
+	c.Assert(os.Mkdir(filepath.Join(archiveDir, uuid), 0o700), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(archiveDir, uuid, "mysql-bin.000001"), content, 0o600), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(dir, "mysql-bin.000002"), content[:10], 0o600), IsNil)
+
+	s, baseDir := newRelayStorage(ctx, relayDir, "file://"+archiveDir)
+	c.Assert(s.remote(), IsFalse)
+	c.Assert(baseDir, Equals, relayDir)
+
+	files, err := collectBinlogFiles(s, dir)
+	c.Assert(err, IsNil)
+	c.Assert(files, DeepEquals, []string{"mysql-bin.000001", "mysql-bin.000002"})
+
+	// the purged relay log file is read from the archive
+	purged := filepath.Join(dir, "mysql-bin.000001")
+	c.Assert(s.exists(purged), IsTrue)
+	size, err := s.size(purged)
+	c.Assert(err, IsNil)
+	c.Assert(size, Equals, int64(len(content)))
+	f, _, err := openRelayLogFile(s, purged, nil)
+	c.Assert(err, IsNil)
+	data, err := io.ReadAll(f)
+	c.Assert(err, IsNil)
+	c.Assert(data, DeepEquals, content)
+	c.Assert(f.Close(), IsNil)
+
+	// the local one is preferred
+	local := filepath.Join(dir, "mysql-bin.000002")
+	size, err = s.size(local)
+	c.Assert(err, IsNil)
+	c.Assert(size, Equals, int64(10))
+
+	// not exist in both
+	notExist := filepath.Join(dir, "mysql-bin.000003")
+	c.Assert(s.exists(notExist), IsFalse)
+	_, err = s.open(notExist)
+	c.Assert(os.IsNotExist(err), IsTrue)
+	_, err = s.open(filepath.Join(c.MkDir(), "mysql-bin.000001")) // not in the relay directory
+	c.Assert(os.IsNotExist(err), IsTrue)
+
+	// the sub directory is purged locally
+	c.Assert(os.RemoveAll(dir), IsNil)
+	files, err = collectBinlogFiles(s, dir)
+	c.Assert(err, IsNil)
+	c.Assert(files, DeepEquals, []string{"mysql-bin.000001"})
+}
@@ -14,13 +14,18 @@
 package relay
 
 import (
+	"crypto/cipher"
+	"crypto/rand"
 	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/pingcap/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.etcd.io/etcd/pkg/fileutil"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
@@ -38,6 +43,20 @@ type BinlogWriter struct {
 	uuid     string
 	filename string
 
+	// masterKey is used to wrap the data keys of new relay log files, nil means not encrypting them.
+	masterKey MasterKey
+	dataKey   []byte        // data key of the current file, nil if it's not encrypted
+	block     cipher.Block  // block cipher of dataKey
+	iv        []byte        // IV of the current file
+	stream    cipher.Stream // key stream at offset
+
+	preallocated bool // whether disk space is preallocated for the current file
+
+	syncCfg   SyncConfig
+	syncMu    sync.Mutex  // protects the fields below
+	unsynced  int64       // size of data written but not synced yet
+	syncTimer *time.Timer // syncs the file after the interval of group commit
+
 	logger log.Logger
 }
 
@@ -58,10 +77,12 @@ func (s *BinlogWriterStatus) String() string {
 }
 
 // NewBinlogWriter creates a BinlogWriter instance.
-func NewBinlogWriter(logger log.Logger, relayDir string) *BinlogWriter {
+func NewBinlogWriter(logger log.Logger, relayDir string, syncCfg SyncConfig) *BinlogWriter {
+	syncCfg.adjust()
 	return &BinlogWriter{
 		logger:   logger,
 		relayDir: relayDir,
+		syncCfg:  syncCfg,
 	}
 }
 
@@ -80,31 +101,128 @@ func (w *BinlogWriter) Open(uuid, filename string) error {
 		return terror.ErrBinlogWriterGetFileStat.Delegate(err, f.Name())
 	}
 
+	size := fs.Size()
+	preallocated := w.preallocate(f, size)
+	dataKey, iv, err := w.openEncryption(f, fullName, size)
+	if err != nil {
+		err2 := f.Close()
+		if err2 != nil {
+			w.logger.Error("fail to close file", zap.String("component", "file writer"), zap.Error(err2))
+		}
+		return err
+	}
+
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
-	w.offset.Store(fs.Size())
+	w.dataKey, w.iv, w.block, w.stream = dataKey, iv, nil, nil
+	if dataKey != nil {
+		if w.block, err = newRelayLogCipher(dataKey); err != nil {
+			return err
+		}
+		size = plainRelayLogSize(size)
+		w.stream = newRelayLogKeyStream(w.block, iv, size)
+	}
+	w.offset.Store(size)
 	w.file = f
+	w.preallocated = preallocated
 	w.uuid = uuid
 	w.filename = filename
 
 	return nil
 }
 
+// preallocate allocates disk space for the new (empty) file `f` without changing its size, returns whether it's done.
+// failing to preallocate doesn't fail the writing, so the error is only logged.
+func (w *BinlogWriter) preallocate(f *os.File, size int64) bool {
+	if w.syncCfg.Preallocate <= 0 || size > 0 {
+		return false
+	}
+	if err := fileutil.Preallocate(f, w.syncCfg.Preallocate, false); err != nil {
+		w.logger.Warn("fail to preallocate disk space", zap.String("component", "file writer"),
+			zap.String("file", f.Name()), zap.Int64("size", w.syncCfg.Preallocate), zap.Error(err))
+		return false
+	}
+	return true
+}
+
+// releasePreallocated releases the preallocated disk space beyond the end of the current file, the caller should hold w.mu.
+func (w *BinlogWriter) releasePreallocated() {
+	fs, err := w.file.Stat()
+	if err == nil {
+		// truncating to the current size frees the blocks allocated beyond it
+		err = w.file.Truncate(fs.Size())
+	}
+	if err != nil {
+		w.logger.Warn("fail to release preallocated disk space", zap.String("component", "file writer"),
+			zap.String("file", w.file.Name()), zap.Error(err))
+	}
+}
+
+// openEncryption prepares the encryption of the opened file `f` with size `size`, returns the data key and IV of it.
+// a new (or empty) file gets a new data key and the IV is written as the header of the file, an existing file keeps
+// its data key in the keyring, and nil is returned if it's not encrypted (like written before encryption enabled).
+func (w *BinlogWriter) openEncryption(f *os.File, fullName string, size int64) ([]byte, []byte, error) {
+	if w.masterKey == nil {
+		return nil, nil, nil
+	}
+
+	if size > 0 {
+		dataKey, err := loadRelayLogDataKey(localRelayStorage{}, fullName, w.masterKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		if dataKey == nil {
+			w.logger.Warn("relay log file is not encrypted, keep writing it in plain", zap.String("file", fullName))
+			return nil, nil, nil
+		}
+		if size >= encryptedRelayLogHeaderLen {
+			iv := make([]byte, encryptedRelayLogHeaderLen)
+			if _, err = f.ReadAt(iv, 0); err != nil {
+				return nil, nil, terror.ErrBinlogWriterOpenFile.Delegate(err)
+			}
+			return dataKey, iv, nil
+		}
+		// the IV is not written completely, start the file again
+		if err = f.Truncate(0); err != nil {
+			return nil, nil, terror.ErrBinlogWriterOpenFile.Delegate(err)
+		}
+	}
+
+	dataKey, err := newRelayLogDataKey(fullName, w.masterKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	iv := make([]byte, encryptedRelayLogHeaderLen)
+	if _, err = rand.Read(iv); err != nil {
+		return nil, nil, terror.ErrEncryptGenIV.Delegate(err)
+	}
+	if _, err = f.Write(iv); err != nil {
+		return nil, nil, terror.ErrBinlogWriterWriteDataLen.Delegate(err, len(iv))
+	}
+	return dataKey, iv, nil
+}
+
 func (w *BinlogWriter) Close() error {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
 	var err error
 	if w.file != nil {
-		err2 := w.file.Sync() // try sync manually before close.
+		err2 := w.syncFile() // try sync manually before close.
 		if err2 != nil {
 			w.logger.Error("fail to flush buffered data", zap.String("component", "file writer"), zap.Error(err2))
 		}
+		if w.preallocated {
+			w.releasePreallocated()
+		}
 		err = w.file.Close()
+		relayLogWriteBytesCounter.DeleteAllAboutLabels(prometheus.Labels{"file": w.filename})
 	}
 
 	w.file = nil
+	w.preallocated = false
+	w.dataKey, w.iv, w.block, w.stream = nil, nil, nil, nil
 	w.offset.Store(0)
 	w.uuid = ""
 	w.filename = ""
@@ -120,10 +238,90 @@ func (w *BinlogWriter) Write(rawData []byte) error {
 		return terror.ErrRelayWriterNotOpened.Delegate(errors.New("file not opened"))
 	}
 
-	n, err := w.file.Write(rawData)
+	data := rawData
+	if w.stream != nil {
+		data = make([]byte, len(rawData))
+		w.stream.XORKeyStream(data, rawData)
+	}
+	n, err := w.file.Write(data)
 	w.offset.Add(int64(n))
+	relayLogWriteBytesCounter.WithLabelValues(w.filename).Add(float64(n))
+	if err != nil {
+		if w.stream != nil && n != len(data) {
+			// the key stream has been advanced for all data, align it with the written data
+			w.stream = newRelayLogKeyStream(w.block, w.iv, w.offset.Load())
+		}
+		return terror.ErrBinlogWriterWriteDataLen.Delegate(err, len(rawData))
+	}
+
+	return w.afterWrite(n)
+}
+
+// afterWrite syncs the written data according to the sync config, the caller should hold w.mu.
+func (w *BinlogWriter) afterWrite(n int) error {
+	switch {
+	case w.syncCfg.Strict:
+		return w.syncFile()
+	case !w.syncCfg.GroupCommit:
+		return nil
+	}
+
+	w.syncMu.Lock()
+	w.unsynced += int64(n)
+	needSync := w.syncCfg.Bytes > 0 && w.unsynced >= w.syncCfg.Bytes
+	if !needSync && w.syncTimer == nil && w.syncCfg.Interval > 0 {
+		w.syncTimer = time.AfterFunc(w.syncCfg.Interval, w.syncByTimer)
+	}
+	w.syncMu.Unlock()
+
+	if needSync {
+		return w.syncFile()
+	}
+	return nil
+}
+
+// syncByTimer syncs the file after the interval of group commit elapsed.
+func (w *BinlogWriter) syncByTimer() {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if w.file == nil {
+		return
+	}
+	if err := w.syncFile(); err != nil {
+		w.logger.Error("fail to sync file", zap.String("component", "file writer"), zap.String("file", w.file.Name()), zap.Error(err))
+	}
+}
+
+// syncFile syncs the file and stops the pending timer of group commit, the caller should hold w.mu.
+func (w *BinlogWriter) syncFile() error {
+	w.syncMu.Lock()
+	defer w.syncMu.Unlock()
+
+	if w.syncTimer != nil {
+		w.syncTimer.Stop()
+		w.syncTimer = nil
+	}
+	size := w.unsynced
+	w.unsynced = 0
 
-	return terror.ErrBinlogWriterWriteDataLen.Delegate(err, len(rawData))
+	startTime := time.Now()
+	err := w.file.Sync()
+	if err != nil {
+		return terror.ErrBinlogWriterFileSync.Delegate(err)
+	}
+	relayLogFsyncDurationHistogram.Observe(time.Since(startTime).Seconds())
+	if w.syncCfg.GroupCommit && !w.syncCfg.Strict {
+		relayLogFsyncSizeHistogram.Observe(float64(size))
+	}
+	return nil
+}
+
+// encryptKey returns the data key of the current file, nil if it's not encrypted.
+func (w *BinlogWriter) encryptKey() []byte {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.dataKey
 }
 
 func (w *BinlogWriter) Status() *BinlogWriterStatus {
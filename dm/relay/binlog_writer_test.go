@@ -18,10 +18,12 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	. "github.com/pingcap/check"
 
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
 )
 
 var _ = Suite(&testBinlogWriterSuite{})
@@ -41,7 +43,7 @@ func (t *testBinlogWriterSuite) TestWrite(c *C) {
 	)
 
 	{
-		w := NewBinlogWriter(log.L(), dir)
+		w := NewBinlogWriter(log.L(), dir, SyncConfig{})
 		c.Assert(w, NotNil)
 		c.Assert(w.Open(uuid, filename), IsNil)
 		fwStatus := w.Status()
@@ -54,7 +56,7 @@ func (t *testBinlogWriterSuite) TestWrite(c *C) {
 
 	{
 		// not opened
-		w := NewBinlogWriter(log.L(), dir)
+		w := NewBinlogWriter(log.L(), dir, SyncConfig{})
 		err := w.Write(data1)
 		c.Assert(err, ErrorMatches, "*not opened")
 
@@ -65,7 +67,7 @@ func (t *testBinlogWriterSuite) TestWrite(c *C) {
 
 	{
 		// normal call flow
-		w := NewBinlogWriter(log.L(), dir)
+		w := NewBinlogWriter(log.L(), dir, SyncConfig{})
 		err := w.Open(uuid, filename)
 		c.Assert(err, IsNil)
 		c.Assert(w.file, NotNil)
@@ -103,3 +105,82 @@ func (t *testBinlogWriterSuite) TestWrite(c *C) {
 		c.Assert(dataInFile, DeepEquals, allData.Bytes())
 	}
 }
+
+func (t *testBinlogWriterSuite) TestSync(c *C) {
+	dir := c.MkDir()
+	uuid := "3ccc475b-2343-11e7-be21-6c0b84d59f30.000001"
+	c.Assert(os.Mkdir(filepath.Join(dir, uuid), 0o755), IsNil)
+	data := []byte("test-data")
+	unsynced := func(w *BinlogWriter) (int64, bool) {
+		w.syncMu.Lock()
+		defer w.syncMu.Unlock()
+		return w.unsynced, w.syncTimer != nil
+	}
+
+	// strict durability overrides group commit
+	w := NewBinlogWriter(log.L(), dir, SyncConfig{Strict: true, GroupCommit: true, Bytes: 1})
+	c.Assert(w.Open(uuid, "test-mysql-bin.000001"), IsNil)
+	c.Assert(w.Write(data), IsNil)
+	size, timerArmed := unsynced(w)
+	c.Assert(size, Equals, int64(0))
+	c.Assert(timerArmed, IsFalse)
+	c.Assert(w.Close(), IsNil)
+
+	// group commit by bytes
+	w = NewBinlogWriter(log.L(), dir, SyncConfig{GroupCommit: true, Bytes: int64(len(data)) * 2})
+	c.Assert(w.Open(uuid, "test-mysql-bin.000002"), IsNil)
+	c.Assert(w.Write(data), IsNil)
+	size, timerArmed = unsynced(w)
+	c.Assert(size, Equals, int64(len(data)))
+	c.Assert(timerArmed, IsFalse)
+	c.Assert(w.Write(data), IsNil)
+	size, _ = unsynced(w)
+	c.Assert(size, Equals, int64(0))
+	c.Assert(w.Write(data), IsNil)
+	c.Assert(w.Close(), IsNil) // synced when closed
+	size, _ = unsynced(w)
+	c.Assert(size, Equals, int64(0))
+
+	// group commit by time, the default interval is used
+	w = NewBinlogWriter(log.L(), dir, SyncConfig{GroupCommit: true})
+	c.Assert(w.syncCfg.Interval, Equals, defaultGroupCommitInterval)
+	c.Assert(w.Open(uuid, "test-mysql-bin.000003"), IsNil)
+	c.Assert(w.Write(data), IsNil)
+	c.Assert(w.Write(data), IsNil)
+	size, timerArmed = unsynced(w)
+	c.Assert(size, Equals, int64(len(data))*2)
+	c.Assert(timerArmed, IsTrue)
+	c.Assert(utils.WaitSomething(100, 10*time.Millisecond, func() bool {
+		size, timerArmed = unsynced(w)
+		return size == 0 && !timerArmed
+	}), IsTrue)
+	c.Assert(w.Close(), IsNil)
+}
+
+func (t *testBinlogWriterSuite) TestPreallocate(c *C) {
+	dir := c.MkDir()
+	uuid := "3ccc475b-2343-11e7-be21-6c0b84d59f30.000001"
+	c.Assert(os.Mkdir(filepath.Join(dir, uuid), 0o755), IsNil)
+	data := []byte("test-data")
+
+	w := NewBinlogWriter(log.L(), dir, SyncConfig{Preallocate: 1 << 20})
+	c.Assert(w.Open(uuid, "test-mysql-bin.000001"), IsNil)
+	c.Assert(w.preallocated, IsTrue)
+	c.Assert(w.Write(data), IsNil)
+	// the size of the file is not changed by preallocating
+	c.Assert(w.Offset(), Equals, int64(len(data)))
+	fs, err := w.file.Stat()
+	c.Assert(err, IsNil)
+	c.Assert(fs.Size(), Equals, int64(len(data)))
+	c.Assert(w.Close(), IsNil)
+	c.Assert(w.preallocated, IsFalse)
+
+	// not preallocate for an existing file
+	c.Assert(w.Open(uuid, "test-mysql-bin.000001"), IsNil)
+	c.Assert(w.preallocated, IsFalse)
+	c.Assert(w.Write(data), IsNil)
+	c.Assert(w.Close(), IsNil)
+	dataInFile, err := os.ReadFile(filepath.Join(dir, uuid, "test-mysql-bin.000001"))
+	c.Assert(err, IsNil)
+	c.Assert(dataInFile, DeepEquals, append(data, data...))
+}
@@ -0,0 +1,212 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"crypto/cipher"
+	"io"
+	"os"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pingcap/errors"
+)
+
+// compressedRelayLogExt is the extension of zstd compressed relay log files.
+// a compressed relay log file `mysql-bin.000001.zst` has the same logical name and positions as `mysql-bin.000001`.
+const compressedRelayLogExt = ".zst"
+
+// relayLogFile is a relay log file opened for reading.
+type relayLogFile interface {
+	io.ReadSeeker
+	io.Closer
+	Name() string
+}
+
+// resolveRelayLogFile returns the real path of the relay log file with logical path `fullPath` in `s`,
+// and whether it is compressed. if the uncompressed one exists, it's preferred.
+func resolveRelayLogFile(s relayStorage, fullPath string) (realPath string, compressed bool) {
+	if s.exists(fullPath) {
+		return fullPath, false
+	}
+	if compressedPath := fullPath + compressedRelayLogExt; s.exists(compressedPath) {
+		return compressedPath, true
+	}
+	return fullPath, false
+}
+
+// relayLogFileExists checks whether the relay log file with logical path `fullPath` exists, compressed or not.
+func relayLogFileExists(s relayStorage, fullPath string) bool {
+	realPath, _ := resolveRelayLogFile(s, fullPath)
+	return s.exists(realPath)
+}
+
+// openRelayLogFile opens the relay log file with logical path `fullPath` in `s`, compressed relay log files are
+// decompressed on the fly. if `encryptKey` is not empty, the relay log file is decrypted (before decompressed) with it.
+func openRelayLogFile(s relayStorage, fullPath string, encryptKey []byte) (relayLogFile, bool, error) {
+	var block cipher.Block
+	if len(encryptKey) > 0 {
+		var err error
+		if block, err = newRelayLogCipher(encryptKey); err != nil {
+			return nil, false, err
+		}
+	}
+
+	realPath, compressed := resolveRelayLogFile(s, fullPath)
+	f, err := s.open(realPath)
+	if err != nil && os.IsNotExist(err) && !compressed {
+		// the relay log file may be compressed after resolved
+		realPath, compressed = resolveRelayLogFile(s, fullPath)
+		f, err = s.open(realPath)
+	}
+	if err != nil {
+		return nil, false, errors.Trace(err)
+	}
+	var rf relayLogFile = f
+	if block != nil {
+		rf = newEncryptedRelayLogFile(f, block)
+	}
+	if !compressed {
+		return rf, false, nil
+	}
+	cf, err := newCompressedRelayLogFile(rf, fullPath)
+	if err != nil {
+		rf.Close()
+		return nil, false, err
+	}
+	return cf, true, nil
+}
+
+// compressedRelayLogFile reads a zstd compressed relay log file as the uncompressed one.
+// as the decompressed stream can't be seeked, seeking backward is done by decompressing from the beginning again.
+type compressedRelayLogFile struct {
+	f      relayLogFile
+	name   string
+	dec    *zstd.Decoder
+	offset int64 // offset in the decompressed stream
+}
+
+func newCompressedRelayLogFile(f relayLogFile, name string) (*compressedRelayLogFile, error) {
+	dec, err := zstd.NewReader(f, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &compressedRelayLogFile{f: f, name: name, dec: dec}, nil
+}
+
+// Name returns the logical path of the relay log file.
+func (c *compressedRelayLogFile) Name() string {
+	return c.name
+}
+
+// Read implements io.Reader.
+func (c *compressedRelayLogFile) Read(p []byte) (int, error) {
+	n, err := c.dec.Read(p)
+	c.offset += int64(n)
+	return n, err
+}
+
+// Seek implements io.Seeker, io.SeekEnd is not supported.
+func (c *compressedRelayLogFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += c.offset
+	default:
+		return 0, errors.NotSupportedf("seek whence %d for compressed relay log file", whence)
+	}
+	if offset < 0 {
+		return 0, errors.NotValidf("seek offset %d", offset)
+	}
+
+	if offset < c.offset {
+		// stop the decoder reading from the file in background before seeking it
+		if err := c.dec.Reset(nil); err != nil {
+			return 0, errors.Trace(err)
+		}
+		if _, err := c.f.Seek(0, io.SeekStart); err != nil {
+			return 0, errors.Trace(err)
+		}
+		if err := c.dec.Reset(c.f); err != nil {
+			return 0, errors.Trace(err)
+		}
+		c.offset = 0
+	}
+	if offset > c.offset {
+		n, err := io.CopyN(io.Discard, c.dec, offset-c.offset)
+		c.offset += n
+		if err != nil {
+			return c.offset, errors.Trace(err)
+		}
+	}
+	return c.offset, nil
+}
+
+// Close implements io.Closer.
+func (c *compressedRelayLogFile) Close() error {
+	c.dec.Close()
+	return c.f.Close()
+}
+
+// compressRelayLogFile compresses the relay log file `fullPath` into `fullPath.zst` and removes the uncompressed one.
+// the compressed data is written to a temporary file and renamed, so readers never see a partial compressed file,
+// and the modification time is kept as the purger checks it.
+func compressRelayLogFile(fullPath string) (err error) {
+	src, err := os.Open(fullPath)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer src.Close()
+	fi, err := src.Stat()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	compressedPath := fullPath + compressedRelayLogExt
+	tmpPath := compressedPath + ".tmp"
+	dst, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		if err != nil {
+			dst.Close()
+			os.Remove(tmpPath)
+		}
+	}()
+
+	enc, err := zstd.NewWriter(dst, zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if _, err = io.Copy(enc, src); err != nil {
+		enc.Close()
+		return errors.Trace(err)
+	}
+	if err = enc.Close(); err != nil {
+		return errors.Trace(err)
+	}
+	if err = dst.Sync(); err != nil {
+		return errors.Trace(err)
+	}
+	if err = dst.Close(); err != nil {
+		return errors.Trace(err)
+	}
+	if err = os.Chtimes(tmpPath, fi.ModTime(), fi.ModTime()); err != nil {
+		return errors.Trace(err)
+	}
+	if err = os.Rename(tmpPath, compressedPath); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(os.Remove(fullPath))
+}
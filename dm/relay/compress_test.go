@@ -0,0 +1,115 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/errors"
+
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+var _ = Suite(&testCompressSuite{})
+
+type testCompressSuite struct{}
+
+func (t *testCompressSuite) TestOpenRelayLogFile(c *C) {
+	var (
+		dir     = c.MkDir()
+		name    = filepath.Join(dir, "mysql-bin.000001")
+		content = []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+		buf     = make([]byte, 4)
+	)
+
+	// not exist
+	c.Assert(relayLogFileExists(localRelayStorage{}, name), IsFalse)
+	_, _, err := openRelayLogFile(localRelayStorage{}, name, nil)
+	c.Assert(os.IsNotExist(errors.Cause(err)), IsTrue)
+
+	enc, err := zstd.NewWriter(nil)
+	c.Assert(err, IsNil)
+	c.Assert(os.WriteFile(name+compressedRelayLogExt, enc.EncodeAll(content, nil), 0o600), IsNil)
+	c.Assert(relayLogFileExists(localRelayStorage{}, name), IsTrue)
+
+	f, compressed, err := openRelayLogFile(localRelayStorage{}, name, nil)
+	c.Assert(err, IsNil)
+	c.Assert(compressed, IsTrue)
+	c.Assert(f.Name(), Equals, name)
+
+	// seek forward
+	offset, err := f.Seek(10, io.SeekStart)
+	c.Assert(err, IsNil)
+	c.Assert(offset, Equals, int64(10))
+	_, err = io.ReadFull(f, buf)
+	c.Assert(err, IsNil)
+	c.Assert(buf, DeepEquals, content[10:14])
+	offset, err = f.Seek(2, io.SeekCurrent)
+	c.Assert(err, IsNil)
+	c.Assert(offset, Equals, int64(16))
+
+	// seek backward
+	offset, err = f.Seek(-12, io.SeekCurrent)
+	c.Assert(err, IsNil)
+	c.Assert(offset, Equals, int64(4))
+	_, err = io.ReadFull(f, buf)
+	c.Assert(err, IsNil)
+	c.Assert(buf, DeepEquals, content[4:8])
+
+	// invalid seeks
+	_, err = f.Seek(0, io.SeekEnd)
+	c.Assert(err, NotNil)
+	_, err = f.Seek(-1, io.SeekStart)
+	c.Assert(err, NotNil)
+	c.Assert(f.Close(), IsNil)
+
+	// the uncompressed one is preferred
+	c.Assert(os.WriteFile(name, content, 0o600), IsNil)
+	f, compressed, err = openRelayLogFile(localRelayStorage{}, name, nil)
+	c.Assert(err, IsNil)
+	c.Assert(compressed, IsFalse)
+	c.Assert(f.Close(), IsNil)
+}
+
+func (t *testCompressSuite) TestCompressRelayLogFile(c *C) {
+	var (
+		dir     = c.MkDir()
+		name    = filepath.Join(dir, "mysql-bin.000001")
+		content = []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+		modTime = time.Now().Add(-time.Hour).Truncate(time.Second)
+	)
+	c.Assert(compressRelayLogFile(name), NotNil)
+
+	c.Assert(os.WriteFile(name, content, 0o600), IsNil)
+	c.Assert(os.Chtimes(name, modTime, modTime), IsNil)
+	c.Assert(compressRelayLogFile(name), IsNil)
+	c.Assert(utils.IsFileExists(name), IsFalse)
+	c.Assert(utils.IsFileExists(name+compressedRelayLogExt+".tmp"), IsFalse)
+	fi, err := os.Stat(name + compressedRelayLogExt)
+	c.Assert(err, IsNil)
+	c.Assert(fi.ModTime().Equal(modTime), IsTrue)
+
+	f, compressed, err := openRelayLogFile(localRelayStorage{}, name, nil)
+	c.Assert(err, IsNil)
+	c.Assert(compressed, IsTrue)
+	data, err := io.ReadAll(f)
+	c.Assert(err, IsNil)
+	c.Assert(data, DeepEquals, content)
+	c.Assert(f.Close(), IsNil)
+}
@@ -15,6 +15,7 @@ package relay
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/pingcap/tiflow/dm/dm/config"
 	"github.com/pingcap/tiflow/dm/pkg/log"
@@ -38,6 +39,46 @@ type Config struct {
 
 	// for binlog reader retry
 	ReaderRetry ReaderRetryConfig `toml:"reader-retry" json:"reader-retry"`
+
+	// for syncing relay log files to disk
+	Sync SyncConfig `toml:"sync" json:"sync"`
+	// compress relay log files with zstd after rotated, to reduce the disk usage
+	CompressRotated bool `toml:"compress-rotated" json:"compress-rotated"`
+
+	// for archiving relay log files to an external storage
+	Archive ArchiveConfig `toml:"archive" json:"archive"`
+
+	// for pausing pulling binlog events from upstream before the relay directory uses up the disk
+	DiskQuota DiskQuotaConfig `toml:"disk-quota" json:"disk-quota"`
+
+	// for encrypting relay log files at rest
+	Encryption EncryptionConfig `toml:"encryption" json:"encryption"`
+}
+
+// defaultGroupCommitInterval is the max delay of fsync in group commit mode if neither interval nor bytes is set.
+const defaultGroupCommitInterval = 5 * time.Millisecond
+
+// SyncConfig is the configuration used for syncing relay log files to disk.
+// by default, relay log files are only synced when they are closed and flushed by the OS otherwise.
+type SyncConfig struct {
+	// Strict makes every write synced to disk before it returns, which overrides GroupCommit.
+	Strict bool `toml:"strict" json:"strict"`
+	// GroupCommit batches the fsyncs of writes, the written data is synced after Interval elapsed
+	// or Bytes accumulated since the first write not synced.
+	GroupCommit bool          `toml:"group-commit" json:"group-commit"`
+	Interval    time.Duration `toml:"interval" json:"interval"`
+	Bytes       int64         `toml:"bytes" json:"bytes"`
+	// Preallocate is the size of disk space allocated for a new relay log file in advance, 0 means not preallocating.
+	// it reduces the fragmentation and the latency spikes of appending, the file size is not changed by it
+	// and the unused space is released after the file closed.
+	Preallocate int64 `toml:"preallocate" json:"preallocate"`
+}
+
+// adjust sets the default interval for group commit.
+func (c *SyncConfig) adjust() {
+	if c.GroupCommit && c.Interval <= 0 && c.Bytes <= 0 {
+		c.Interval = defaultGroupCommitInterval
+	}
 }
 
 func (c *Config) String() string {
@@ -69,6 +110,25 @@ func FromSourceCfg(sourceCfg *config.SourceConfig) *Config {
 			BackoffJitter:   clone.Checker.BackoffJitter,
 			BackoffFactor:   clone.Checker.BackoffFactor,
 		},
+		Encryption: EncryptionConfig{EncryptKey: clone.RelayEncryptKey},
 	}
 	return cfg
 }
+
+// defaultArchiveInterval is the interval to archive the rotated relay log files if not set.
+const defaultArchiveInterval = 30 * time.Second
+
+// ArchiveConfig is the configuration used for archiving relay log files to an external storage.
+type ArchiveConfig struct {
+	// URL is the URL of the external storage, like `s3://bucket/prefix`, empty means not archiving.
+	URL string `toml:"url" json:"url"`
+	// Interval is the interval to upload the rotated relay log files.
+	Interval time.Duration `toml:"interval" json:"interval"`
+}
+
+// adjust sets the default interval for archiving.
+func (c *ArchiveConfig) adjust() {
+	if c.Interval <= 0 {
+		c.Interval = defaultArchiveInterval
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+const (
+	defaultDiskQuotaHighWatermark = 0.9
+	defaultDiskQuotaLowWatermark  = 0.8
+	defaultDiskQuotaCheckInterval = 10 * time.Second
+)
+
+// DiskQuotaConfig is the configuration used for limiting the disk usage of the relay directory.
+type DiskQuotaConfig struct {
+	// Bytes is the max total size of the relay directory, non-positive means no quota.
+	Bytes int64 `toml:"bytes" json:"bytes"`
+	// HighWatermark is the ratio of Bytes above which relay pauses pulling binlog events from upstream.
+	HighWatermark float64 `toml:"high-watermark" json:"high-watermark"`
+	// LowWatermark is the ratio of Bytes below which the paused relay resumes pulling binlog events.
+	LowWatermark float64 `toml:"low-watermark" json:"low-watermark"`
+	// CheckInterval is the interval to re-scan the relay directory for its real size.
+	CheckInterval time.Duration `toml:"check-interval" json:"check-interval"`
+}
+
+// adjust sets the default watermarks and check interval.
+func (c *DiskQuotaConfig) adjust() {
+	if c.HighWatermark <= 0 || c.HighWatermark > 1 {
+		c.HighWatermark = defaultDiskQuotaHighWatermark
+	}
+	if c.LowWatermark <= 0 || c.LowWatermark > c.HighWatermark {
+		c.LowWatermark = c.HighWatermark * defaultDiskQuotaLowWatermark / defaultDiskQuotaHighWatermark
+	}
+	if c.CheckInterval <= 0 {
+		c.CheckInterval = defaultDiskQuotaCheckInterval
+	}
+}
+
+// diskQuota tracks the size of the relay directory against the quota. the size is re-scanned every CheckInterval,
+// and the bytes written by relay between two scans are added to the last scanned size as an estimation.
+type diskQuota struct {
+	relayDir string
+	cfg      DiskQuotaConfig
+	logger   log.Logger
+
+	mu        sync.Mutex
+	scanned   int64 // size of the relay directory in the last scan
+	written   int64 // bytes written since the last scan
+	lastScan  time.Time
+	exhausted bool
+}
+
+// newDiskQuota creates a diskQuota, returns nil if no quota is configured.
+func newDiskQuota(logger log.Logger, relayDir string, cfg DiskQuotaConfig) *diskQuota {
+	if cfg.Bytes <= 0 {
+		return nil
+	}
+	cfg.adjust()
+	return &diskQuota{
+		relayDir: relayDir,
+		cfg:      cfg,
+		logger:   logger.WithFields(zap.String("sub component", "relay disk quota")),
+	}
+}
+
+// addWritten records the bytes written into the relay directory.
+func (q *diskQuota) addWritten(n int64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.written += n
+}
+
+// usage returns the estimated size of the relay directory, re-scans it if CheckInterval elapsed or `force` is set.
+func (q *diskQuota) usage(force bool) (int64, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if force || time.Since(q.lastScan) >= q.cfg.CheckInterval {
+		size, err := utils.GetDirSize(q.relayDir)
+		if err != nil {
+			return 0, err
+		}
+		q.scanned, q.written, q.lastScan = size, 0, time.Now()
+		relayDiskQuotaUsageGauge.Set(float64(size))
+	}
+	return q.scanned + q.written, nil
+}
+
+func (q *diskQuota) highBytes() int64 {
+	return int64(float64(q.cfg.Bytes) * q.cfg.HighWatermark)
+}
+
+func (q *diskQuota) lowBytes() int64 {
+	return int64(float64(q.cfg.Bytes) * q.cfg.LowWatermark)
+}
+
+// isExhausted returns whether relay is paused by the quota now.
+func (q *diskQuota) isExhausted() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.exhausted
+}
+
+func (q *diskQuota) setExhausted(exhausted bool) {
+	q.mu.Lock()
+	q.exhausted = exhausted
+	q.mu.Unlock()
+	if exhausted {
+		relayDiskQuotaExhaustedGauge.Set(1)
+	} else {
+		relayDiskQuotaExhaustedGauge.Set(0)
+	}
+}
+
+// wait blocks while the usage of the relay directory is above the high watermark, until the purger frees space
+// to make it below the low watermark or the context is done. the upstream connection is kept during waiting.
+func (q *diskQuota) wait(ctx context.Context) error {
+	used, err := q.usage(false)
+	if err != nil {
+		// not blocking relay if we can't get the size, the disk may still be full
+		q.logger.Warn("fail to get the size of relay directory", zap.String("relay dir", q.relayDir), zap.Error(err))
+		return nil
+	}
+	if used < q.highBytes() {
+		return nil
+	}
+	// the estimation may be larger than the real size, confirm it before pausing
+	if used, err = q.usage(true); err != nil || used < q.highBytes() {
+		return nil
+	}
+
+	relayDiskQuotaExhaustedCounter.Inc()
+	q.setExhausted(true)
+	q.logger.Warn("relay directory is approaching its disk quota, pause pulling binlog events from upstream",
+		zap.Int64("used bytes", used), zap.Int64("quota bytes", q.cfg.Bytes), zap.Float64("high watermark", q.cfg.HighWatermark))
+
+	ticker := time.NewTicker(q.cfg.CheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			q.setExhausted(false)
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		used, err = q.usage(true)
+		if err != nil {
+			q.logger.Warn("fail to get the size of relay directory", zap.String("relay dir", q.relayDir), zap.Error(err))
+			continue
+		}
+		if used < q.lowBytes() {
+			q.setExhausted(false)
+			q.logger.Info("space of relay directory is freed, resume pulling binlog events from upstream",
+				zap.Int64("used bytes", used), zap.Int64("quota bytes", q.cfg.Bytes), zap.Float64("low watermark", q.cfg.LowWatermark))
+			return nil
+		}
+	}
+}
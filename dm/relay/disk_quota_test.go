@@ -0,0 +1,101 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/pkg/log"
+)
+
+var _ = Suite(&testDiskQuotaSuite{})
+
+type testDiskQuotaSuite struct{}
+
+func (t *testDiskQuotaSuite) TestDiskQuotaConfig(c *C) {
+	c.Assert(newDiskQuota(log.L(), c.MkDir(), DiskQuotaConfig{}), IsNil)
+
+	cfg := DiskQuotaConfig{HighWatermark: 0.5}
+	cfg.adjust()
+	c.Assert(cfg.HighWatermark, Equals, 0.5)
+	c.Assert(cfg.LowWatermark, Less, 0.5)
+	c.Assert(cfg.CheckInterval, Equals, defaultDiskQuotaCheckInterval)
+
+	cfg = DiskQuotaConfig{HighWatermark: 2, LowWatermark: 0.95}
+	cfg.adjust()
+	c.Assert(cfg.HighWatermark, Equals, defaultDiskQuotaHighWatermark)
+	c.Assert(cfg.LowWatermark, Less, cfg.HighWatermark)
+}
+
+func (t *testDiskQuotaSuite) TestDiskQuotaWait(c *C) {
+	dir := c.MkDir()
+	subDir := filepath.Join(dir, "uuid.000001")
+	c.Assert(os.MkdirAll(subDir, 0o700), IsNil)
+	file1 := filepath.Join(subDir, "mysql-bin.000001")
+	c.Assert(os.WriteFile(file1, make([]byte, 600), 0o600), IsNil)
+
+	q := newDiskQuota(log.L(), dir, DiskQuotaConfig{
+		Bytes:         1000,
+		HighWatermark: 0.9,
+		LowWatermark:  0.5,
+		CheckInterval: 10 * time.Millisecond,
+	})
+	c.Assert(q, NotNil)
+	ctx := context.Background()
+
+	// below the high watermark
+	c.Assert(q.wait(ctx), IsNil)
+	c.Assert(q.isExhausted(), IsFalse)
+
+	// the estimation is above the high watermark, but the real size is not
+	q.addWritten(400)
+	c.Assert(q.wait(ctx), IsNil)
+	c.Assert(q.isExhausted(), IsFalse)
+
+	// above the high watermark, wait until space is freed below the low watermark
+	c.Assert(os.WriteFile(filepath.Join(subDir, "mysql-bin.000002"), make([]byte, 400), 0o600), IsNil)
+	q.addWritten(400)
+	done := make(chan error, 1)
+	go func() {
+		done <- q.wait(ctx)
+	}()
+	time.Sleep(100 * time.Millisecond)
+	c.Assert(q.isExhausted(), IsTrue)
+	select {
+	case <-done:
+		c.Fatal("should wait for the space freed")
+	default:
+	}
+	c.Assert(os.Remove(file1), IsNil)
+	select {
+	case err := <-done:
+		c.Assert(err, IsNil)
+	case <-time.After(time.Second):
+		c.Fatal("should resume after the space freed")
+	}
+	c.Assert(q.isExhausted(), IsFalse)
+
+	// canceled during waiting
+	c.Assert(os.WriteFile(file1, make([]byte, 600), 0o600), IsNil)
+	q.addWritten(600)
+	ctx2, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	c.Assert(q.wait(ctx2), Equals, context.DeadlineExceeded)
+	c.Assert(q.isExhausted(), IsFalse)
+}
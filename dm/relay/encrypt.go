@@ -0,0 +1,174 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"io"
+
+	"github.com/pingcap/errors"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// encryptedRelayLogHeaderLen is the length of the header of an encrypted relay log file.
+// an encrypted relay log file is the IV (one AES block) followed by the AES-CTR ciphertext of the plain relay log file,
+// so an offset in the plain relay log file is `encryptedRelayLogHeaderLen` less than the one in the encrypted file.
+const encryptedRelayLogHeaderLen = aes.BlockSize
+
+// newRelayLogCipher creates the block cipher used to decrypt relay log files.
+func newRelayLogCipher(key []byte) (cipher.Block, error) {
+	switch len(key) {
+	case 16, 24, 32:
+	default:
+		return nil, terror.ErrEncryptSecretKeyNotValid.Generate(len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, terror.ErrEncryptGenCipher.Delegate(err)
+	}
+	return block, nil
+}
+
+// plainRelayLogSize returns the size of the plain relay log file for an encrypted file with size `size`.
+// the IV may not be written completely when the file is being written, the plain size is 0 in this case.
+func plainRelayLogSize(size int64) int64 {
+	if size < encryptedRelayLogHeaderLen {
+		return 0
+	}
+	return size - encryptedRelayLogHeaderLen
+}
+
+// encryptedRelayLogFile reads an encrypted relay log file as the plain one.
+// AES-CTR is a stream cipher, so any (partially written) prefix of the file can be decrypted, and the
+// trailing partial event is left to the binlog parser as the plain relay log file does.
+type encryptedRelayLogFile struct {
+	f     storageFile
+	block cipher.Block
+	iv    []byte // nil if it has not been read
+
+	offset       int64 // offset in the plain relay log file
+	stream       cipher.Stream
+	streamOffset int64 // offset in the plain relay log file the key stream is at
+}
+
+func newEncryptedRelayLogFile(f storageFile, block cipher.Block) *encryptedRelayLogFile {
+	return &encryptedRelayLogFile{f: f, block: block}
+}
+
+// Name returns the path of the relay log file.
+func (e *encryptedRelayLogFile) Name() string {
+	return e.f.Name()
+}
+
+// readIV reads the IV from the file header, returns false if it has not been written completely.
+func (e *encryptedRelayLogFile) readIV() (bool, error) {
+	if e.iv != nil {
+		return true, nil
+	}
+	iv := make([]byte, encryptedRelayLogHeaderLen)
+	_, err := e.f.ReadAt(iv, 0)
+	if err == io.EOF {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Trace(err)
+	}
+	e.iv = iv
+	return true, nil
+}
+
+// keyStream returns the key stream at the current offset.
+func (e *encryptedRelayLogFile) keyStream() cipher.Stream {
+	if e.stream != nil && e.streamOffset == e.offset {
+		return e.stream
+	}
+
+	e.stream = newRelayLogKeyStream(e.block, e.iv, e.offset)
+	e.streamOffset = e.offset
+	return e.stream
+}
+
+// Read implements io.Reader.
+func (e *encryptedRelayLogFile) Read(p []byte) (int, error) {
+	ok, err := e.readIV()
+	if err != nil {
+		return 0, err
+	} else if !ok {
+		return 0, io.EOF
+	}
+
+	n, err := e.f.ReadAt(p, e.offset+encryptedRelayLogHeaderLen)
+	if n > 0 {
+		e.keyStream().XORKeyStream(p[:n], p[:n])
+		e.offset += int64(n)
+		e.streamOffset = e.offset
+	}
+	if err == io.EOF && n > 0 {
+		err = nil
+	}
+	if err != nil && err != io.EOF {
+		err = errors.Trace(err)
+	}
+	return n, err
+}
+
+// Seek implements io.Seeker.
+func (e *encryptedRelayLogFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += e.offset
+	case io.SeekEnd:
+		size, err := e.f.Seek(0, io.SeekEnd)
+		if err != nil {
+			return 0, errors.Trace(err)
+		}
+		offset += plainRelayLogSize(size)
+	default:
+		return 0, errors.NotValidf("seek whence %d", whence)
+	}
+	if offset < 0 {
+		return 0, errors.NotValidf("seek offset %d", offset)
+	}
+	e.offset = offset
+	return e.offset, nil
+}
+
+// Close implements io.Closer.
+func (e *encryptedRelayLogFile) Close() error {
+	return e.f.Close()
+}
+
+// newRelayLogKeyStream returns the AES-CTR key stream at `offset` of the plain relay log file encrypted with `iv`.
+func newRelayLogKeyStream(block cipher.Block, iv []byte, offset int64) cipher.Stream {
+	// the counter is the IV (as a big-endian integer) plus the block index
+	counter := make([]byte, encryptedRelayLogHeaderLen)
+	copy(counter, iv)
+	var carry uint64
+	blocks := uint64(offset / encryptedRelayLogHeaderLen)
+	for i := len(counter) - 1; i >= 0 && (blocks > 0 || carry > 0); i-- {
+		sum := uint64(counter[i]) + blocks&0xff + carry
+		counter[i] = byte(sum)
+		carry = sum >> 8
+		blocks >>= 8
+	}
+
+	stream := cipher.NewCTR(block, counter)
+	if skip := offset % encryptedRelayLogHeaderLen; skip > 0 {
+		discard := make([]byte, skip)
+		stream.XORKeyStream(discard, discard)
+	}
+	return stream
+}
@@ -0,0 +1,114 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/klauspost/compress/zstd"
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+var _ = Suite(&testEncryptSuite{})
+
+type testEncryptSuite struct{}
+
+// encryptRelayLog encrypts the content of a plain relay log file with `key` and `iv`.
+func encryptRelayLog(c *C, key, iv, plain []byte) []byte {
+	block, err := aes.NewCipher(key)
+	c.Assert(err, IsNil)
+	encrypted := make([]byte, encryptedRelayLogHeaderLen+len(plain))
+	copy(encrypted, iv)
+	cipher.NewCTR(block, iv).XORKeyStream(encrypted[encryptedRelayLogHeaderLen:], plain)
+	return encrypted
+}
+
+func (t *testEncryptSuite) TestOpenEncryptedRelayLogFile(c *C) {
+	var (
+		dir   = c.MkDir()
+		name  = filepath.Join(dir, "mysql-bin.000001")
+		key   = bytes.Repeat([]byte{0x12}, 32)
+		iv    = append(bytes.Repeat([]byte{0xff}, encryptedRelayLogHeaderLen-1), 0xfe) // counter overflows soon
+		plain = bytes.Repeat([]byte("0123456789abcdefghijklmnopqrstuvwxyz"), 10)
+	)
+	encrypted := encryptRelayLog(c, key, iv, plain)
+
+	// invalid key
+	_, _, err := openRelayLogFile(localRelayStorage{}, name, []byte("invalid"))
+	c.Assert(terror.ErrEncryptSecretKeyNotValid.Equal(err), IsTrue)
+
+	// the IV is written partially
+	c.Assert(os.WriteFile(name, encrypted[:encryptedRelayLogHeaderLen-1], 0o600), IsNil)
+	f, compressed, err := openRelayLogFile(localRelayStorage{}, name, key)
+	c.Assert(err, IsNil)
+	c.Assert(compressed, IsFalse)
+	c.Assert(f.Name(), Equals, name)
+	n, err := f.Read(make([]byte, 10))
+	c.Assert(err, Equals, io.EOF)
+	c.Assert(n, Equals, 0)
+	c.Assert(f.Close(), IsNil)
+
+	// the file is written partially, read a trailer
+	c.Assert(os.WriteFile(name, encrypted[:len(encrypted)-5], 0o600), IsNil)
+	f, _, err = openRelayLogFile(localRelayStorage{}, name, key)
+	c.Assert(err, IsNil)
+	offset, err := f.Seek(100, io.SeekStart)
+	c.Assert(err, IsNil)
+	c.Assert(offset, Equals, int64(100))
+	buf := make([]byte, len(plain))
+	n, err = io.ReadFull(f, buf)
+	c.Assert(err, Equals, io.ErrUnexpectedEOF)
+	c.Assert(buf[:n], DeepEquals, plain[100:len(plain)-5])
+
+	// the remaining part is written
+	c.Assert(os.WriteFile(name, encrypted, 0o600), IsNil)
+	n, err = io.ReadFull(f, buf[:5])
+	c.Assert(err, IsNil)
+	c.Assert(buf[:n], DeepEquals, plain[len(plain)-5:])
+
+	// seek at any offset
+	for _, off := range []int64{0, 1, 15, 16, 17, 33, 200} {
+		_, err = f.Seek(off, io.SeekStart)
+		c.Assert(err, IsNil)
+		n, err = io.ReadFull(f, buf[:20])
+		c.Assert(err, IsNil)
+		c.Assert(buf[:n], DeepEquals, plain[off:off+20])
+	}
+	offset, err = f.Seek(-10, io.SeekEnd)
+	c.Assert(err, IsNil)
+	c.Assert(offset, Equals, int64(len(plain)-10))
+	_, err = f.Seek(-1, io.SeekStart)
+	c.Assert(err, NotNil)
+	c.Assert(f.Close(), IsNil)
+
+	// compressed and encrypted
+	enc, err := zstd.NewWriter(nil)
+	c.Assert(err, IsNil)
+	c.Assert(os.Remove(name), IsNil)
+	c.Assert(os.WriteFile(name+compressedRelayLogExt, encryptRelayLog(c, key, iv, enc.EncodeAll(plain, nil)), 0o600), IsNil)
+	f, compressed, err = openRelayLogFile(localRelayStorage{}, name, key)
+	c.Assert(err, IsNil)
+	c.Assert(compressed, IsTrue)
+	got, err := io.ReadAll(f)
+	c.Assert(err, IsNil)
+	c.Assert(got, DeepEquals, plain)
+	c.Assert(f.Close(), IsNil)
+}
@@ -0,0 +1,225 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"go.etcd.io/etcd/clientv3"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/pkg/gtid"
+	"github.com/pingcap/tiflow/dm/pkg/ha"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+// EtcdMeta implements Meta by saving info in local like LocalMeta, and also persisting it into etcd when flushed.
+// the relay meta in etcd is updated with compare-and-swap on its revision, so when a DM-worker takes over pulling
+// relay log of the source from another one, the position is continued exactly and only one of them can update it.
+type EtcdMeta struct {
+	*LocalMeta
+	cli    *clientv3.Client
+	source string
+	worker string
+	logger log.Logger
+
+	mu          sync.Mutex
+	revision    int64 // ModRevision of the relay meta in etcd, zero means not exists
+	remoteDirty bool  // whether saved but not flushed into etcd
+}
+
+// NewEtcdMeta creates a new EtcdMeta.
+func NewEtcdMeta(cli *clientv3.Client, source, worker, flavor, baseDir string) Meta {
+	return &EtcdMeta{
+		LocalMeta: NewLocalMeta(flavor, baseDir).(*LocalMeta),
+		cli:       cli,
+		source:    source,
+		worker:    worker,
+		logger:    log.With(zap.String("component", "relay etcd meta"), zap.String("source", source)),
+	}
+}
+
+// reset returns a new EtcdMeta with empty local meta, the revision in etcd is kept.
+func (em *EtcdMeta) reset() *EtcdMeta {
+	em.mu.Lock()
+	defer em.mu.Unlock()
+	meta := NewEtcdMeta(em.cli, em.source, em.worker, em.flavor, em.baseDir).(*EtcdMeta)
+	meta.revision = em.revision
+	return meta
+}
+
+// Load implements Meta.Load.
+// if the relay meta in etcd is newer than the local one, like it's flushed by another DM-worker, it's taken over.
+func (em *EtcdMeta) Load() error {
+	if err := em.LocalMeta.Load(); err != nil {
+		return err
+	}
+
+	remote, err := ha.GetRelayMeta(em.cli, em.source)
+	if err != nil {
+		return terror.ErrRelayLoadMetaData.Delegate(err)
+	}
+	em.mu.Lock()
+	em.revision = remote.Revision
+	em.mu.Unlock()
+	if remote.Revision == 0 {
+		return nil
+	}
+
+	tookOver, err := em.LocalMeta.takeOver(remote.UUID, mysql.Position{Name: remote.BinLogName, Pos: remote.BinLogPos}, remote.BinlogGTID)
+	if err != nil {
+		return err
+	}
+	if tookOver {
+		em.logger.Info("take over relay meta from etcd", zap.Stringer("relay meta", remote))
+	}
+	return nil
+}
+
+// AdjustWithStartPos implements Meta.AdjustWithStartPos.
+func (em *EtcdMeta) AdjustWithStartPos(binlogName string, binlogGTID string, enableGTID bool, latestBinlogName string, latestBinlogGTID string) (bool, error) {
+	adjusted, err := em.LocalMeta.AdjustWithStartPos(binlogName, binlogGTID, enableGTID, latestBinlogName, latestBinlogGTID)
+	if err != nil || !adjusted {
+		return adjusted, err
+	}
+	return true, em.flushRemote()
+}
+
+// Save implements Meta.Save.
+func (em *EtcdMeta) Save(pos mysql.Position, gset gtid.Set) error {
+	if err := em.LocalMeta.Save(pos, gset); err != nil {
+		return err
+	}
+	em.mu.Lock()
+	em.remoteDirty = true
+	em.mu.Unlock()
+	return nil
+}
+
+// Flush implements Meta.Flush.
+func (em *EtcdMeta) Flush() error {
+	if err := em.LocalMeta.Flush(); err != nil {
+		return err
+	}
+	return em.flushRemote()
+}
+
+// Dirty implements Meta.Dirty.
+func (em *EtcdMeta) Dirty() bool {
+	em.mu.Lock()
+	remoteDirty := em.remoteDirty
+	em.mu.Unlock()
+	return remoteDirty || em.LocalMeta.Dirty()
+}
+
+// AddDir implements Meta.AddDir.
+func (em *EtcdMeta) AddDir(serverUUID string, newPos *mysql.Position, newGTID gtid.Set, uuidSuffix int) error {
+	if err := em.LocalMeta.AddDir(serverUUID, newPos, newGTID, uuidSuffix); err != nil {
+		return err
+	}
+	return em.flushRemote()
+}
+
+// flushRemote puts the current meta into etcd if the revision in etcd is not changed since the last load or flush.
+func (em *EtcdMeta) flushRemote() error {
+	uuid, pos := em.Pos()
+	_, gs := em.GTID()
+	meta := ha.RelayMeta{
+		Source:     em.source,
+		Worker:     em.worker,
+		UUID:       uuid,
+		BinLogName: pos.Name,
+		BinLogPos:  pos.Pos,
+	}
+	if gs != nil {
+		meta.BinlogGTID = gs.String()
+	}
+
+	em.mu.Lock()
+	defer em.mu.Unlock()
+	current, ok, err := ha.PutRelayMetaIfRevision(em.cli, meta, em.revision)
+	if err == nil && !ok && current.Worker == em.worker {
+		// updated by ourselves, like the previous txn is committed but reported as failed, try again
+		current, ok, err = ha.PutRelayMetaIfRevision(em.cli, meta, current.Revision)
+	}
+	if err != nil {
+		return terror.ErrRelayFlushEtcdMeta.Delegate(err)
+	}
+	if !ok {
+		return terror.ErrRelayEtcdMetaConflict.Generate(em.source, current.Worker, current.Revision, em.revision)
+	}
+	em.revision = current.Revision
+	em.remoteDirty = false
+	return nil
+}
+
+// takeOver replaces the current meta with the given one if it's newer, returns whether replaced.
+// the sub directory of `uuid` is created if not exists, and it's appended to the server-uuid.index file if its
+// suffix is continuous, or the index file only contains it.
+func (lm *LocalMeta) takeOver(uuid string, pos mysql.Position, gtidStr string) (bool, error) {
+	lm.Lock()
+	defer lm.Unlock()
+
+	_, suffix, err := utils.ParseSuffixForUUID(uuid)
+	if err != nil {
+		return false, err
+	}
+	if len(lm.currentUUID) > 0 {
+		_, currentSuffix, err2 := utils.ParseSuffixForUUID(lm.currentUUID)
+		if err2 != nil {
+			return false, err2
+		}
+		currentPos := mysql.Position{Name: lm.BinLogName, Pos: lm.BinLogPos}
+		if suffix < currentSuffix || (suffix == currentSuffix && (uuid != lm.currentUUID || pos.Compare(currentPos) <= 0)) {
+			return false, nil
+		}
+	}
+
+	gset, err := gtid.ParserGTID(lm.flavor, gtidStr)
+	if err != nil {
+		return false, terror.ErrRelayLoadMetaData.Delegate(err)
+	}
+
+	uuids := lm.uuids
+	if !utils.IsDirExists(filepath.Join(lm.baseDir, uuid)) {
+		if err = os.MkdirAll(filepath.Join(lm.baseDir, uuid), 0o744); err != nil {
+			return false, terror.ErrRelayMkdir.Delegate(err)
+		}
+	}
+	if uuid != lm.currentUUID {
+		_, currentSuffix, _ := utils.ParseSuffixForUUID(lm.currentUUID)
+		if len(lm.currentUUID) > 0 && suffix == currentSuffix+1 {
+			uuids = append(uuids, uuid)
+		} else {
+			// other sub directories are kept as is, but not in the index file
+			uuids = []string{uuid}
+		}
+		if err = lm.updateIndexFile(uuids); err != nil {
+			return false, err
+		}
+	}
+
+	lm.currentUUID = uuid
+	lm.uuids = uuids
+	lm.BinLogName = pos.Name
+	lm.BinLogPos = pos.Pos
+	lm.BinlogGTID = gtidStr
+	lm.gset = gset
+	return true, lm.doFlush()
+}
@@ -0,0 +1,579 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"bufio"
+	"encoding/binary"
+	"hash"
+	"hash/crc32"
+	"io"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/errors"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/binlog/event"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+var errRowTooLarge = errors.New("a single row is larger than max-event-size")
+
+// eventSizeGuard is read by the parser instead of the relay log file, it checks the size in the header of each event
+// before the parser reads the whole event into memory, and handles the events larger than MaxEventSize by policy:
+//   - error: returns ErrRelayEventTooLarge.
+//   - skip: replaces the event with a dummy event which has the same end log position, and logs a warning.
+//   - chunk: splits a rows event into several rows events not larger than MaxEventSize, they have the same end log
+//     position like the inner events of a TransactionPayloadEvent, and only the last one keeps the STMT_END_F flag.
+//     a single row larger than MaxEventSize can't be split, so it's reported as an error too.
+//
+// the rows events are split with the FormatDescriptionEvent and TableMapEvents parsed before them,
+// so all events parsed from the guard must be passed to `observe`.
+type eventSizeGuard struct {
+	maxSize int64
+	policy  config.MaxEventSizePolicy
+	logger  log.Logger
+
+	format *replication.FormatDescriptionEvent
+	tables map[uint64]*replication.TableMapEvent
+
+	r       io.ReadSeeker
+	pending []byte // bytes to be read before reading r
+	remain  int64  // bytes of the current event which can be read from r directly
+	chunker *rowsEventChunker
+	eof     bool // r is treated as ended, after the header of a torn event which is too large
+}
+
+// newEventSizeGuard creates an eventSizeGuard for cfg, nil is returned if the event size is not limited.
+// a nil eventSizeGuard can be used as a no-op one.
+func newEventSizeGuard(cfg *BinlogReaderConfig, logger log.Logger) *eventSizeGuard {
+	if cfg.MaxEventSize <= 0 {
+		return nil
+	}
+	policy := cfg.MaxEventSizePolicy
+	if policy == "" {
+		policy = config.MaxEventSizeError
+	}
+	return &eventSizeGuard{
+		maxSize: cfg.MaxEventSize,
+		policy:  policy,
+		logger:  logger,
+		tables:  make(map[uint64]*replication.TableMapEvent),
+	}
+}
+
+// wrap returns the reader to be parsed instead of r, r should be at the start of an event.
+func (g *eventSizeGuard) wrap(r io.ReadSeeker) io.Reader {
+	if g == nil {
+		return r
+	}
+	g.r, g.pending, g.remain, g.chunker, g.eof = r, nil, 0, nil, false
+	return g
+}
+
+// observe records the information used to split the rows events from the parsed event.
+func (g *eventSizeGuard) observe(e *replication.BinlogEvent) {
+	if g == nil {
+		return
+	}
+	switch ev := e.Event.(type) {
+	case *replication.FormatDescriptionEvent:
+		g.format = ev
+		g.tables = make(map[uint64]*replication.TableMapEvent)
+	case *replication.TableMapEvent:
+		g.tables[ev.TableID] = ev
+	}
+}
+
+// Read implements io.Reader.
+func (g *eventSizeGuard) Read(p []byte) (int, error) {
+	for {
+		switch {
+		case len(g.pending) > 0:
+			n := copy(p, g.pending)
+			g.pending = g.pending[n:]
+			return n, nil
+		case g.remain > 0:
+			if int64(len(p)) > g.remain {
+				p = p[:g.remain]
+			}
+			n, err := g.r.Read(p)
+			g.remain -= int64(n)
+			return n, err
+		case g.chunker != nil:
+			chunk, err := g.chunker.next()
+			if err != nil {
+				return 0, err
+			}
+			if chunk == nil {
+				g.chunker = nil
+			}
+			g.pending = chunk
+		case g.eof:
+			return 0, io.EOF
+		default:
+			if err := g.readHeader(); err != nil {
+				return 0, err
+			}
+		}
+	}
+}
+
+// readHeader reads the header of the next event, and decides how to read the event.
+func (g *eventSizeGuard) readHeader() error {
+	header := make([]byte, replication.EventHeaderSize)
+	n, err := io.ReadFull(g.r, header)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		// let the parser meet the incomplete header as before
+		g.pending, g.eof = header[:n], true
+		return nil
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+
+	size := int64(binary.LittleEndian.Uint32(header[9:]))
+	if size <= g.maxSize || size < replication.EventHeaderSize {
+		g.pending, g.remain = header, size-replication.EventHeaderSize
+		return nil
+	}
+
+	complete, err := g.isComplete(size - replication.EventHeaderSize)
+	if err != nil {
+		return err
+	}
+	if !complete {
+		// let the parser meet the torn event as before, but without reading it into memory
+		g.pending, g.eof = header, true
+		return nil
+	}
+	return g.handleTooLarge(header, size)
+}
+
+// isComplete returns whether the rest `size` bytes of the current event have been written.
+func (g *eventSizeGuard) isComplete(size int64) (bool, error) {
+	cur, err := g.r.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	end, err := g.r.Seek(0, io.SeekEnd)
+	if err != nil {
+		// compressed relay log files can't be seeked to the end, but they will not be written anymore
+		return true, nil
+	}
+	if _, err = g.r.Seek(cur, io.SeekStart); err != nil {
+		return false, errors.Trace(err)
+	}
+	return end-cur >= size, nil
+}
+
+func (g *eventSizeGuard) handleTooLarge(header []byte, size int64) error {
+	tp := replication.EventType(header[4])
+	logPos := binary.LittleEndian.Uint32(header[13:])
+	tooLarge := terror.ErrRelayEventTooLarge.Generate(size, tp, int64(logPos)-size, g.maxSize)
+
+	switch g.policy {
+	case config.MaxEventSizeSkip:
+		if _, err := g.r.Seek(size-replication.EventHeaderSize, io.SeekCurrent); err != nil {
+			return errors.Trace(err)
+		}
+		h := &replication.EventHeader{
+			Timestamp: binary.LittleEndian.Uint32(header),
+			ServerID:  binary.LittleEndian.Uint32(header[5:]),
+			Flags:     binary.LittleEndian.Uint16(header[17:]),
+		}
+		dummy, err := event.GenDummyEvent(h, logPos-event.MinUserVarEventLen, event.MinUserVarEventLen)
+		if err != nil {
+			return err
+		}
+		g.logger.Warn("skip too large event", zap.Stringer("type", tp), zap.Int64("size", size),
+			zap.Uint32("end log pos", logPos), zap.Int64("max event size", g.maxSize))
+		g.pending = dummy.RawData
+		return nil
+	case config.MaxEventSizeChunk:
+		if !isSplittableRowsEvent(tp) {
+			return terror.Annotate(tooLarge, "only rows events can be split")
+		}
+		chunker, err := newRowsEventChunker(g, header, size, tooLarge)
+		if err != nil {
+			return err
+		}
+		g.logger.Info("split too large rows event", zap.Stringer("type", tp), zap.Int64("size", size),
+			zap.Uint32("end log pos", logPos), zap.Int64("max event size", g.maxSize))
+		g.chunker = chunker
+		return nil
+	}
+	return tooLarge
+}
+
+func isSplittableRowsEvent(tp replication.EventType) bool {
+	switch tp {
+	case replication.WRITE_ROWS_EVENTv1, replication.UPDATE_ROWS_EVENTv1, replication.DELETE_ROWS_EVENTv1,
+		replication.WRITE_ROWS_EVENTv2, replication.UPDATE_ROWS_EVENTv2, replication.DELETE_ROWS_EVENTv2:
+		return true
+	}
+	return false
+}
+
+// rowsEventChunker reads a rows event row by row, and packs the rows into rows events not larger than maxSize.
+type rowsEventChunker struct {
+	src     io.Reader     // the relay log file, the checksum is read from it
+	body    *bufio.Reader // the body of the rows event except the checksum
+	crc     hash.Hash32   // checksum of the bytes read, nil if the checksum is disabled
+	maxSize int64
+
+	header      []byte // header of the rows event
+	postHeader  []byte // table ID, flags, extra data, column count and column bitmaps
+	flagsPos    int    // position of the flags in postHeader
+	table       *replication.TableMapEvent
+	columnCount int
+	bitmaps     [][]byte // one bitmap for each image of a row
+
+	row      []byte // the row read but not packed yet
+	done     bool
+	tooLarge error
+}
+
+func newRowsEventChunker(g *eventSizeGuard, header []byte, size int64, tooLarge error) (*rowsEventChunker, error) {
+	if g.format == nil {
+		return nil, terror.ErrBinlogExpectFormatDescEv.Generate(header)
+	}
+	bodySize := size - replication.EventHeaderSize
+	c := &rowsEventChunker{
+		src:      g.r,
+		maxSize:  g.maxSize,
+		header:   header,
+		tooLarge: tooLarge,
+	}
+	if g.format.ChecksumAlgorithm == replication.BINLOG_CHECKSUM_ALG_CRC32 {
+		bodySize -= replication.BinlogChecksumLength
+		c.crc = crc32.NewIEEE()
+		c.crc.Write(header)
+	}
+	var body io.Reader = io.LimitReader(g.r, bodySize)
+	if c.crc != nil {
+		body = io.TeeReader(body, c.crc)
+	}
+	c.body = bufio.NewReader(body)
+
+	// post-header, refer `RowsEvent.Decode` of go-mysql
+	tp := replication.EventType(header[4])
+	tableIDSize := 6
+	if int(tp) <= len(g.format.EventTypeHeaderLengths) && g.format.EventTypeHeaderLengths[tp-1] == 6 {
+		tableIDSize = 4
+	}
+	c.flagsPos = tableIDSize
+	if err := c.readPostHeader(tableIDSize + 2); err != nil {
+		return nil, err
+	}
+	if tp >= replication.WRITE_ROWS_EVENTv2 {
+		if err := c.readPostHeader(2); err != nil {
+			return nil, err
+		}
+		extraLen := int(binary.LittleEndian.Uint16(c.postHeader[len(c.postHeader)-2:]))
+		if err := c.readPostHeader(extraLen - 2); err != nil {
+			return nil, err
+		}
+	}
+	if err := c.readPostHeader(1); err != nil {
+		return nil, err
+	}
+	lenencPos := len(c.postHeader) - 1
+	switch c.postHeader[lenencPos] {
+	case 0xfc:
+		if err := c.readPostHeader(2); err != nil {
+			return nil, err
+		}
+	case 0xfd:
+		if err := c.readPostHeader(3); err != nil {
+			return nil, err
+		}
+	case 0xfe:
+		if err := c.readPostHeader(8); err != nil {
+			return nil, err
+		}
+	}
+	columnCount, _, _ := mysql.LengthEncodedInt(c.postHeader[lenencPos:])
+	c.columnCount = int(columnCount)
+	images := 1
+	if tp == replication.UPDATE_ROWS_EVENTv1 || tp == replication.UPDATE_ROWS_EVENTv2 {
+		images = 2
+	}
+	bitmapSize := (c.columnCount + 7) / 8
+	for i := 0; i < images; i++ {
+		if err := c.readPostHeader(bitmapSize); err != nil {
+			return nil, err
+		}
+		c.bitmaps = append(c.bitmaps, c.postHeader[len(c.postHeader)-bitmapSize:])
+	}
+
+	tableID := mysql.FixedLengthInt(c.postHeader[:tableIDSize])
+	table, ok := g.tables[tableID]
+	if !ok {
+		return nil, errors.NotFoundf("table map event for table ID %d of the rows event", tableID)
+	}
+	if len(table.ColumnType) < c.columnCount || len(table.ColumnMeta) < c.columnCount {
+		return nil, errors.NotValidf("column count %d of the rows event for table ID %d", c.columnCount, tableID)
+	}
+	c.table = table
+	if c.fixedSize() >= c.maxSize {
+		return nil, c.tooLarge
+	}
+	return c, nil
+}
+
+func (c *rowsEventChunker) readPostHeader(n int) error {
+	if n < 0 {
+		return errors.NotValidf("post-header length %d of the rows event", n)
+	}
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(c.body, buf); err != nil {
+		return errors.Trace(err)
+	}
+	c.postHeader = append(c.postHeader, buf...)
+	return nil
+}
+
+// fixedSize returns the size of a split rows event without rows.
+func (c *rowsEventChunker) fixedSize() int64 {
+	size := int64(len(c.header) + len(c.postHeader))
+	if c.crc != nil {
+		size += replication.BinlogChecksumLength
+	}
+	return size
+}
+
+// next returns the next split rows event, or nil if all rows have been packed.
+func (c *rowsEventChunker) next() ([]byte, error) {
+	if c.done {
+		return nil, nil
+	}
+	fixedSize := c.fixedSize()
+	rows := c.row
+	c.row = nil
+	for {
+		row, err := c.readRow(c.maxSize - fixedSize)
+		if err == io.EOF {
+			c.done = true
+			break
+		} else if err == errRowTooLarge {
+			return nil, terror.Annotate(c.tooLarge, err.Error())
+		} else if err != nil {
+			return nil, err
+		}
+		if fixedSize+int64(len(rows)+len(row)) > c.maxSize {
+			c.row = row
+			break
+		}
+		rows = append(rows, row...)
+	}
+	if c.done {
+		if err := c.verifyChecksum(); err != nil {
+			return nil, err
+		}
+	}
+
+	chunk := make([]byte, 0, fixedSize+int64(len(rows)))
+	chunk = append(chunk, c.header...)
+	chunk = append(chunk, c.postHeader...)
+	chunk = append(chunk, rows...)
+	if !c.done {
+		flagsPos := replication.EventHeaderSize + c.flagsPos
+		flags := binary.LittleEndian.Uint16(chunk[flagsPos:])
+		binary.LittleEndian.PutUint16(chunk[flagsPos:], flags&^replication.RowsEventStmtEndFlag)
+	}
+	if c.crc != nil {
+		binary.LittleEndian.PutUint32(chunk[9:], uint32(len(chunk)+replication.BinlogChecksumLength))
+		checksum := make([]byte, replication.BinlogChecksumLength)
+		binary.LittleEndian.PutUint32(checksum, crc32.ChecksumIEEE(chunk))
+		chunk = append(chunk, checksum...)
+	} else {
+		binary.LittleEndian.PutUint32(chunk[9:], uint32(len(chunk)))
+	}
+	return chunk, nil
+}
+
+func (c *rowsEventChunker) verifyChecksum() error {
+	if c.crc == nil {
+		return nil
+	}
+	checksum := make([]byte, replication.BinlogChecksumLength)
+	if _, err := io.ReadFull(c.src, checksum); err != nil {
+		return errors.Trace(err)
+	}
+	if binary.LittleEndian.Uint32(checksum) != c.crc.Sum32() {
+		return errors.Trace(replication.ErrChecksumMismatch)
+	}
+	return nil
+}
+
+// readRow reads all images of the next row, io.EOF is returned if there's no more rows.
+func (c *rowsEventChunker) readRow(limit int64) ([]byte, error) {
+	if _, err := c.body.Peek(1); err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, errors.Trace(err)
+	}
+
+	r := &rowReader{r: c.body, limit: limit}
+	for _, bitmap := range c.bitmaps {
+		count := 0
+		for i := 0; i < c.columnCount; i++ {
+			if isBitSet(bitmap, i) {
+				count++
+			}
+		}
+		nullBitmap, err := r.read(int64(count+7) / 8)
+		if err != nil {
+			return nil, err
+		}
+		nullBitmap = append([]byte(nil), nullBitmap...)
+
+		nullBitIndex := 0
+		for i := 0; i < c.columnCount; i++ {
+			if !isBitSet(bitmap, i) {
+				continue
+			}
+			isNull := isBitSet(nullBitmap, nullBitIndex)
+			nullBitIndex++
+			if isNull {
+				continue
+			}
+			if err = r.readValue(c.table.ColumnType[i], c.table.ColumnMeta[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return r.row, nil
+}
+
+func isBitSet(bitmap []byte, i int) bool {
+	return bitmap[i>>3]&(1<<(uint(i)&7)) > 0
+}
+
+// rowReader reads the bytes of a row, the row can't be longer than limit.
+type rowReader struct {
+	r     io.Reader
+	row   []byte
+	limit int64
+}
+
+// read reads n bytes and appends them to the row, and returns them.
+func (r *rowReader) read(n int64) ([]byte, error) {
+	if int64(len(r.row))+n > r.limit {
+		return nil, errRowTooLarge
+	}
+	start := len(r.row)
+	r.row = append(r.row, make([]byte, n)...)
+	if _, err := io.ReadFull(r.r, r.row[start:]); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, errors.Trace(err)
+	}
+	return r.row[start:], nil
+}
+
+// readValue reads a column value, refer `RowsEvent.decodeValue` of go-mysql.
+func (r *rowReader) readValue(tp byte, meta uint16) error {
+	n, prefix, err := columnValueLen(tp, meta)
+	if err != nil {
+		return err
+	}
+	if prefix > 0 {
+		lenBytes, err2 := r.read(int64(prefix))
+		if err2 != nil {
+			return err2
+		}
+		n = int64(mysql.FixedLengthInt(lenBytes))
+	}
+	_, err = r.read(n)
+	return err
+}
+
+// columnValueLen returns the length of a column value in rows events if it's fixed,
+// otherwise returns the length of the length prefix of the value.
+func columnValueLen(tp byte, meta uint16) (n int64, prefix int, err error) {
+	strLen := 0
+	if tp == mysql.MYSQL_TYPE_STRING {
+		if meta >= 256 {
+			b0, b1 := uint8(meta>>8), uint8(meta&0xFF)
+			if b0&0x30 != 0x30 {
+				strLen = int(uint16(b1) | (uint16((b0&0x30)^0x30) << 4))
+				tp = b0 | 0x30
+			} else {
+				strLen = int(meta & 0xFF)
+				tp = b0
+			}
+		} else {
+			strLen = int(meta)
+		}
+	}
+
+	switch tp {
+	case mysql.MYSQL_TYPE_NULL:
+		return 0, 0, nil
+	case mysql.MYSQL_TYPE_TINY, mysql.MYSQL_TYPE_YEAR:
+		return 1, 0, nil
+	case mysql.MYSQL_TYPE_SHORT:
+		return 2, 0, nil
+	case mysql.MYSQL_TYPE_INT24, mysql.MYSQL_TYPE_TIME, mysql.MYSQL_TYPE_DATE:
+		return 3, 0, nil
+	case mysql.MYSQL_TYPE_LONG, mysql.MYSQL_TYPE_FLOAT, mysql.MYSQL_TYPE_TIMESTAMP:
+		return 4, 0, nil
+	case mysql.MYSQL_TYPE_LONGLONG, mysql.MYSQL_TYPE_DOUBLE, mysql.MYSQL_TYPE_DATETIME:
+		return 8, 0, nil
+	case mysql.MYSQL_TYPE_NEWDECIMAL:
+		return decimalBinSize(int(meta>>8), int(meta&0xFF)), 0, nil
+	case mysql.MYSQL_TYPE_BIT:
+		nbits := int64(meta>>8)*8 + int64(meta&0xFF)
+		return (nbits + 7) / 8, 0, nil
+	case mysql.MYSQL_TYPE_TIMESTAMP2:
+		return 4 + int64(meta+1)/2, 0, nil
+	case mysql.MYSQL_TYPE_DATETIME2:
+		return 5 + int64(meta+1)/2, 0, nil
+	case mysql.MYSQL_TYPE_TIME2:
+		return 3 + int64(meta+1)/2, 0, nil
+	case mysql.MYSQL_TYPE_ENUM:
+		if l := meta & 0xFF; l == 1 || l == 2 {
+			return int64(l), 0, nil
+		}
+	case mysql.MYSQL_TYPE_SET:
+		return int64(meta & 0xFF), 0, nil
+	case mysql.MYSQL_TYPE_BLOB, mysql.MYSQL_TYPE_GEOMETRY, mysql.MYSQL_TYPE_JSON:
+		if meta >= 1 && meta <= 4 {
+			return 0, int(meta), nil
+		}
+	case mysql.MYSQL_TYPE_VARCHAR, mysql.MYSQL_TYPE_VAR_STRING:
+		strLen = int(meta)
+		fallthrough
+	case mysql.MYSQL_TYPE_STRING:
+		if strLen < 256 {
+			return 0, 1, nil
+		}
+		return 0, 2, nil
+	}
+	return 0, 0, errors.NotSupportedf("column type %d with meta %d in rows event", tp, meta)
+}
+
+var decimalCompressedBytes = []int64{0, 1, 1, 2, 2, 3, 3, 4, 4, 4}
+
+// decimalBinSize returns the length of a DECIMAL(precision, scale) value in rows events.
+func decimalBinSize(precision, scale int) int64 {
+	integral := precision - scale
+	return int64(integral/9*4+scale/9*4) + decimalCompressedBytes[integral%9] + decimalCompressedBytes[scale%9]
+}
@@ -0,0 +1,140 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/binlog/event"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+)
+
+var _ = Suite(&testEventSizeGuardSuite{})
+
+type testEventSizeGuardSuite struct{}
+
+func (t *testEventSizeGuardSuite) TestEventSizeGuard(c *C) {
+	var (
+		header = &replication.EventHeader{
+			Timestamp: uint32(time.Now().Unix()),
+			ServerID:  11,
+		}
+		tableID    uint64 = 8
+		columnType        = []byte{gmysql.MYSQL_TYPE_LONG, gmysql.MYSQL_TYPE_STRING}
+		rows              = make([][]interface{}, 0, 20)
+	)
+	for i := 0; i < 20; i++ {
+		rows = append(rows, []interface{}{int32(i), fmt.Sprintf("%-200s", fmt.Sprintf("value of row %d", i))})
+	}
+	fde, err := event.GenFormatDescriptionEvent(header, binlog.FileHeaderLen)
+	c.Assert(err, IsNil)
+	tableMapEv, err := event.GenTableMapEvent(header, fde.Header.LogPos, tableID, []byte("db"), []byte("tbl"), columnType)
+	c.Assert(err, IsNil)
+	rowsEv, err := event.GenRowsEvent(header, tableMapEv.Header.LogPos, replication.WRITE_ROWS_EVENTv2, tableID,
+		replication.RowsEventStmtEndFlag, rows, columnType, tableMapEv)
+	c.Assert(err, IsNil)
+	xidEv, err := event.GenXIDEvent(header, rowsEv.Header.LogPos, 123)
+	c.Assert(err, IsNil)
+
+	data := append([]byte(nil), replication.BinLogFileHeader...)
+	for _, e := range []*replication.BinlogEvent{fde, tableMapEv, rowsEv, xidEv} {
+		data = append(data, e.RawData...)
+	}
+	filename := filepath.Join(c.MkDir(), "mysql-bin.000001")
+	c.Assert(os.WriteFile(filename, data, 0o644), IsNil)
+
+	parse := func(cfg *BinlogReaderConfig, filename string) ([]*replication.BinlogEvent, error) {
+		f, err2 := os.Open(filename)
+		c.Assert(err2, IsNil)
+		defer f.Close()
+		_, err2 = f.Seek(binlog.FileHeaderLen, io.SeekStart)
+		c.Assert(err2, IsNil)
+
+		var (
+			parser = newRelayLogParser(cfg)
+			guard  = newEventSizeGuard(cfg, log.L())
+			events []*replication.BinlogEvent
+		)
+		err2 = parser.ParseReader(guard.wrap(f), func(e *replication.BinlogEvent) error {
+			guard.observe(e)
+			events = append(events, e)
+			return nil
+		})
+		return events, err2
+	}
+
+	// not limited or not too large
+	for _, maxSize := range []int64{0, int64(rowsEv.Header.EventSize)} {
+		events, err2 := parse(&BinlogReaderConfig{MaxEventSize: maxSize}, filename)
+		c.Assert(err2, IsNil)
+		c.Assert(events, HasLen, 4)
+		c.Assert(events[2].RawData, DeepEquals, rowsEv.RawData)
+	}
+
+	// error
+	maxSize := int64(rowsEv.Header.EventSize) - 1
+	_, err = parse(&BinlogReaderConfig{MaxEventSize: maxSize}, filename)
+	c.Assert(err, ErrorMatches, ".*exceeds max-event-size.*")
+
+	// skip, replaced with a dummy event
+	events, err := parse(&BinlogReaderConfig{MaxEventSize: maxSize, MaxEventSizePolicy: config.MaxEventSizeSkip}, filename)
+	c.Assert(err, IsNil)
+	c.Assert(events, HasLen, 4)
+	c.Assert(events[2].Header.EventType, Equals, replication.USER_VAR_EVENT)
+	c.Assert(events[2].Header.LogPos, Equals, rowsEv.Header.LogPos)
+	c.Assert(events[3].Header.EventType, Equals, replication.XID_EVENT)
+
+	// chunk, split into several rows events with the same end log position
+	maxSize = int64(rowsEv.Header.EventSize) / 3
+	events, err = parse(&BinlogReaderConfig{MaxEventSize: maxSize, MaxEventSizePolicy: config.MaxEventSizeChunk}, filename)
+	c.Assert(err, IsNil)
+	c.Assert(len(events), Greater, 5)
+	c.Assert(events[len(events)-1].Header.EventType, Equals, replication.XID_EVENT)
+	var splitRows [][]interface{}
+	for i, e := range events[2 : len(events)-1] {
+		c.Assert(int64(e.Header.EventSize), LessEqual, maxSize)
+		c.Assert(e.Header.LogPos, Equals, rowsEv.Header.LogPos)
+		ev, ok := e.Event.(*replication.RowsEvent)
+		c.Assert(ok, IsTrue)
+		c.Assert(ev.TableID, Equals, tableID)
+		c.Assert(ev.Flags&replication.RowsEventStmtEndFlag > 0, Equals, i == len(events)-4)
+		splitRows = append(splitRows, ev.Rows...)
+	}
+	c.Assert(splitRows, DeepEquals, rowsEv.Event.(*replication.RowsEvent).Rows)
+
+	// chunk, but a single row is too large
+	_, err = parse(&BinlogReaderConfig{MaxEventSize: 200, MaxEventSizePolicy: config.MaxEventSizeChunk}, filename)
+	c.Assert(err, ErrorMatches, ".*a single row is larger than max-event-size.*")
+
+	// chunk, but not a rows event
+	_, err = parse(&BinlogReaderConfig{MaxEventSize: int64(tableMapEv.Header.EventSize) - 1, MaxEventSizePolicy: config.MaxEventSizeChunk}, filename)
+	c.Assert(err, ErrorMatches, ".*only rows events can be split.*")
+
+	// torn event, not read into memory and reported as EOF
+	tornFilename := filepath.Join(c.MkDir(), "mysql-bin.000002")
+	c.Assert(os.WriteFile(tornFilename, data[:len(data)-int(xidEv.Header.EventSize)-10], 0o644), IsNil)
+	events, err = parse(&BinlogReaderConfig{MaxEventSize: maxSize, MaxEventSizePolicy: config.MaxEventSizeChunk}, tornFilename)
+	c.Assert(isIgnorableParseError(err), IsTrue)
+	c.Assert(events, HasLen, 2)
+}
@@ -22,7 +22,6 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/binlog"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
-	"github.com/pingcap/tiflow/dm/pkg/utils"
 )
 
 // FileCmp is a compare condition used when collecting binlog files.
@@ -44,31 +43,41 @@ type EventNotifier interface {
 }
 
 // CollectAllBinlogFiles collects all valid binlog files in dir, and returns filenames in binlog ascending order.
+// compressed relay log files are returned with their logical (uncompressed) filenames.
 func CollectAllBinlogFiles(dir string) ([]string, error) {
 	if dir == "" {
 		return nil, terror.ErrEmptyRelayDir.Generate()
 	}
-	return binlog.ReadSortedBinlogFromDir(dir)
+	return binlog.ReadSortedBinlogFromDirWithExt(dir, compressedRelayLogExt)
 }
 
 // CollectBinlogFilesCmp collects valid binlog files with a compare condition.
 func CollectBinlogFilesCmp(dir, baseFile string, cmp FileCmp) ([]string, error) {
+	return collectBinlogFilesCmp(localRelayStorage{}, dir, baseFile, cmp)
+}
+
+// collectBinlogFilesCmp is like CollectBinlogFilesCmp, but collects the files in `s`.
+func collectBinlogFilesCmp(s relayStorage, dir, baseFile string, cmp FileCmp) ([]string, error) {
 	if dir == "" {
 		return nil, terror.ErrEmptyRelayDir.Generate()
 	}
 
-	if bp := filepath.Join(dir, baseFile); !utils.IsFileExists(bp) {
+	if bp := filepath.Join(dir, baseFile); !relayLogFileExists(s, bp) {
 		return nil, terror.ErrBaseFileNotFound.Generate(baseFile, dir)
 	}
 
-	bf, err := binlog.ParseFilename(baseFile)
+	allFiles, err := collectBinlogFiles(s, dir)
 	if err != nil {
-		return nil, terror.Annotatef(err, "filename %s", baseFile)
+		return nil, err
 	}
+	return filterBinlogFilesCmp(dir, allFiles, baseFile, cmp)
+}
 
-	allFiles, err := CollectAllBinlogFiles(dir)
+// filterBinlogFilesCmp returns the binlog files in `allFiles` of `dir` with a compare condition.
+func filterBinlogFilesCmp(dir string, allFiles []string, baseFile string, cmp FileCmp) ([]string, error) {
+	bf, err := binlog.ParseFilename(baseFile)
 	if err != nil {
-		return nil, err
+		return nil, terror.Annotatef(err, "filename %s", baseFile)
 	}
 
 	results := make([]string, 0, len(allFiles))
@@ -106,9 +115,9 @@ func CollectBinlogFilesCmp(dir, baseFile string, cmp FileCmp) ([]string, error)
 }
 
 // getFirstBinlogName gets the first binlog file in relay sub directory.
-func getFirstBinlogName(baseDir, uuid string) (string, error) {
+func getFirstBinlogName(s relayStorage, baseDir, uuid string) (string, error) {
 	subDir := filepath.Join(baseDir, uuid)
-	files, err := binlog.ReadSortedBinlogFromDir(subDir)
+	files, err := collectBinlogFiles(s, subDir)
 	if err != nil {
 		return "", terror.Annotatef(err, "get binlog file for dir %s", subDir)
 	}
@@ -125,22 +134,41 @@ func getFirstBinlogName(baseDir, uuid string) (string, error) {
 //   1: update to larger
 //  -1: update to smaller, only happens in special case, for example we change
 //      relay.meta manually and start task before relay log catches up.
-func fileSizeUpdated(path string, latestSize int64) (int, error) {
-	fi, err := os.Stat(path)
+func fileSizeUpdated(s relayStorage, path string, latestSize int64) (int, error) {
+	curSize, err := s.size(path)
 	if err != nil {
 		return 0, terror.ErrGetRelayLogStat.Delegate(err, path)
 	}
-	curSize := fi.Size()
+	return compareFileSize(path, curSize, latestSize), nil
+}
+
+// openedFileSizeUpdated is like fileSizeUpdated, but checks the size of the opened local file which may have been removed.
+func openedFileSizeUpdated(f relayLogFile, latestSize int64) (int, error) {
+	if ef, ok := f.(*encryptedRelayLogFile); ok {
+		f = ef.f
+	}
+	of, ok := f.(*os.File)
+	if !ok {
+		return 0, terror.ErrGetRelayLogStat.Generatef("can't get size of the opened relay log file %s", f.Name())
+	}
+	fi, err := of.Stat()
+	if err != nil {
+		return 0, terror.ErrGetRelayLogStat.Delegate(err, f.Name())
+	}
+	return compareFileSize(f.Name(), fi.Size(), latestSize), nil
+}
+
+func compareFileSize(path string, curSize, latestSize int64) int {
 	switch {
 	case curSize == latestSize:
-		return 0, nil
+		return 0
 	case curSize > latestSize:
 		log.L().Debug("size of relay log file has been changed", zap.String("file", path),
 			zap.Int64("old size", latestSize), zap.Int64("size", curSize))
-		return 1, nil
+		return 1
 	default:
 		log.L().Error("size of relay log file has been changed", zap.String("file", path),
 			zap.Int64("old size", latestSize), zap.Int64("size", curSize))
-		return -1, nil
+		return -1
 	}
 }
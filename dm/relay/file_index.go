@@ -0,0 +1,141 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// relayFileIndex caches the sorted relay log files of relay sub directories, so a sub directory is not scanned
+// every time the reader looks for newer relay log files in it, which is costly if it contains thousands of files.
+//
+// the index is updated incrementally: after the relay writes a new relay log file (notified by its
+// FormatDescriptionEvent), the files following the newest indexed one are probed by their names. a sub directory
+// is scanned again only if the index is found inconsistent with it, like a file expected to exist is not indexed.
+// purged files may be still indexed, so the index is only used to find the files newer than an existing one.
+type relayFileIndex struct {
+	mu   sync.Mutex
+	dirs map[string]*dirFileIndex
+}
+
+type dirFileIndex struct {
+	files   []string // sorted relay log files
+	updated bool     // newer relay log files may have been written
+}
+
+func newRelayFileIndex() *relayFileIndex {
+	return &relayFileIndex{dirs: make(map[string]*dirFileIndex)}
+}
+
+// filesFrom returns the relay log files in `dir` not older than `baseFile`, like collectBinlogFilesCmp
+// with FileCmpBiggerEqual.
+func (i *relayFileIndex) filesFrom(s relayStorage, dir, baseFile string) ([]string, error) {
+	if dir == "" {
+		return nil, terror.ErrEmptyRelayDir.Generate()
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	idx, ok := i.dirs[dir]
+	switch {
+	case !ok || !containsFile(idx.files, baseFile):
+		if ok {
+			log.L().Info("relay log file not indexed, scan the relay sub directory again",
+				zap.String("directory", dir), zap.String("file", baseFile))
+		}
+		var err error
+		if idx, err = i.scan(s, dir); err != nil {
+			return nil, err
+		}
+		if !containsFile(idx.files, baseFile) {
+			return nil, terror.ErrBaseFileNotFound.Generate(baseFile, dir)
+		}
+	case idx.updated:
+		idx.updated = false
+		probeNewerFiles(s, dir, idx)
+	}
+	return filterBinlogFilesCmp(dir, idx.files, baseFile, FileCmpBiggerEqual)
+}
+
+// scan scans the relay sub directory and replaces its index.
+func (i *relayFileIndex) scan(s relayStorage, dir string) (*dirFileIndex, error) {
+	files, err := collectBinlogFiles(s, dir)
+	if err != nil {
+		delete(i.dirs, dir)
+		return nil, err
+	}
+	idx := &dirFileIndex{files: files}
+	i.dirs[dir] = idx
+	return idx, nil
+}
+
+// probeNewerFiles appends the existing relay log files which follow the newest indexed one in sequence.
+func probeNewerFiles(s relayStorage, dir string, idx *dirFileIndex) {
+	for len(idx.files) > 0 {
+		latest, err := binlog.ParseFilename(idx.files[len(idx.files)-1])
+		if err != nil {
+			return
+		}
+		next := binlog.ConstructFilename(latest.BaseName, fmt.Sprintf("%0*d", len(latest.Seq), latest.SeqInt64+1))
+		if !relayLogFileExists(s, filepath.Join(dir, next)) {
+			return
+		}
+		idx.files = append(idx.files, next)
+	}
+}
+
+// onFileCreated marks all indexed sub directories updated, as the relay has created a new relay log file in one of them.
+func (i *relayFileIndex) onFileCreated() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for _, idx := range i.dirs {
+		idx.updated = true
+	}
+}
+
+// expect checks whether the relay log file which should exist in `dir` is indexed. the newer files are probed if not,
+// and the sub directory will be scanned again if the file is still not indexed, as it may not follow the sequence.
+func (i *relayFileIndex) expect(s relayStorage, dir, file string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	idx, ok := i.dirs[dir]
+	if !ok || containsFile(idx.files, file) {
+		return
+	}
+	idx.updated = false
+	probeNewerFiles(s, dir, idx)
+	if !containsFile(idx.files, file) {
+		log.L().Info("relay log file not indexed, scan the relay sub directory again later",
+			zap.String("directory", dir), zap.String("file", file))
+		delete(i.dirs, dir)
+	}
+}
+
+func containsFile(files []string, file string) bool {
+	for j := len(files) - 1; j >= 0; j-- { // the newer files are checked more often
+		if files[j] == file {
+			return true
+		}
+	}
+	return false
+}
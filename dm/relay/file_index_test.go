@@ -0,0 +1,79 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+var _ = Suite(&testFileIndexSuite{})
+
+type testFileIndexSuite struct{}
+
+func (t *testFileIndexSuite) TestRelayFileIndex(c *C) {
+	var (
+		dir   = c.MkDir()
+		s     = localRelayStorage{}
+		index = newRelayFileIndex()
+	)
+	writeFile := func(name string) {
+		c.Assert(os.WriteFile(filepath.Join(dir, name), nil, 0o600), IsNil)
+	}
+	writeFile("mysql-bin.000001")
+	writeFile("mysql-bin.000002")
+
+	_, err := index.filesFrom(s, "", "mysql-bin.000001")
+	c.Assert(terror.ErrEmptyRelayDir.Equal(err), IsTrue)
+	_, err = index.filesFrom(s, dir, "mysql-bin.000003")
+	c.Assert(terror.ErrBaseFileNotFound.Equal(err), IsTrue)
+
+	files, err := index.filesFrom(s, dir, "mysql-bin.000001")
+	c.Assert(err, IsNil)
+	c.Assert(files, DeepEquals, []string{"mysql-bin.000001", "mysql-bin.000002"})
+
+	// new files are not found before notified
+	writeFile("mysql-bin.000003")
+	writeFile("mysql-bin.000004.zst")
+	files, err = index.filesFrom(s, dir, "mysql-bin.000002")
+	c.Assert(err, IsNil)
+	c.Assert(files, DeepEquals, []string{"mysql-bin.000002"})
+
+	// probed after a new file is created
+	index.onFileCreated()
+	files, err = index.filesFrom(s, dir, "mysql-bin.000002")
+	c.Assert(err, IsNil)
+	c.Assert(files, DeepEquals, []string{"mysql-bin.000002", "mysql-bin.000003", "mysql-bin.000004"})
+
+	// a file not in sequence is found after scanned again
+	writeFile("mysql-bin.000006")
+	index.expect(s, dir, "mysql-bin.000004") // already indexed
+	files, err = index.filesFrom(s, dir, "mysql-bin.000004")
+	c.Assert(err, IsNil)
+	c.Assert(files, DeepEquals, []string{"mysql-bin.000004"})
+	index.expect(s, dir, "mysql-bin.000006")
+	files, err = index.filesFrom(s, dir, "mysql-bin.000004")
+	c.Assert(err, IsNil)
+	c.Assert(files, DeepEquals, []string{"mysql-bin.000004", "mysql-bin.000006"})
+
+	// the base file not indexed makes the sub directory scanned again
+	writeFile("mysql-bin.000007")
+	files, err = index.filesFrom(s, dir, "mysql-bin.000007")
+	c.Assert(err, IsNil)
+	c.Assert(files, DeepEquals, []string{"mysql-bin.000007"})
+}
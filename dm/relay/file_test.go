@@ -211,14 +211,14 @@ func (t *testFileSuite) TestGetFirstBinlogName(c *C) {
 	)
 
 	// sub directory not exist
-	name, err := getFirstBinlogName(baseDir, uuid)
+	name, err := getFirstBinlogName(localRelayStorage{}, baseDir, uuid)
 	c.Assert(err, ErrorMatches, ".*(no such file or directory|The system cannot find the file specified).*")
 	c.Assert(name, Equals, "")
 
 	// empty directory
 	err = os.MkdirAll(subDir, 0o700)
 	c.Assert(err, IsNil)
-	name, err = getFirstBinlogName(baseDir, uuid)
+	name, err = getFirstBinlogName(localRelayStorage{}, baseDir, uuid)
 	c.Assert(err, ErrorMatches, ".*not found.*")
 	c.Assert(name, Equals, "")
 
@@ -226,7 +226,7 @@ func (t *testFileSuite) TestGetFirstBinlogName(c *C) {
 	filename := "invalid.bin"
 	err = os.WriteFile(filepath.Join(subDir, filename), nil, 0o600)
 	c.Assert(err, IsNil)
-	_, err = getFirstBinlogName(baseDir, uuid)
+	_, err = getFirstBinlogName(localRelayStorage{}, baseDir, uuid)
 	c.Assert(err, ErrorMatches, ".*not found.*")
 	err = os.Remove(filepath.Join(subDir, filename))
 	c.Assert(err, IsNil)
@@ -235,7 +235,7 @@ func (t *testFileSuite) TestGetFirstBinlogName(c *C) {
 	filename = "z-mysql-bin.000002" // z prefix, make it become not the _first_ if possible.
 	err = os.WriteFile(filepath.Join(subDir, filename), nil, 0o600)
 	c.Assert(err, IsNil)
-	name, err = getFirstBinlogName(baseDir, uuid)
+	name, err = getFirstBinlogName(localRelayStorage{}, baseDir, uuid)
 	c.Assert(err, IsNil)
 	c.Assert(name, Equals, filename)
 
@@ -243,14 +243,14 @@ func (t *testFileSuite) TestGetFirstBinlogName(c *C) {
 	filename = "z-mysql-bin.000001"
 	err = os.WriteFile(filepath.Join(subDir, filename), nil, 0o600)
 	c.Assert(err, IsNil)
-	name, err = getFirstBinlogName(baseDir, uuid)
+	name, err = getFirstBinlogName(localRelayStorage{}, baseDir, uuid)
 	c.Assert(err, IsNil)
 	c.Assert(name, Equals, filename)
 
 	// has a meta file
 	err = os.WriteFile(filepath.Join(subDir, utils.MetaFilename), nil, 0o600)
 	c.Assert(err, IsNil)
-	name, err = getFirstBinlogName(baseDir, uuid)
+	name, err = getFirstBinlogName(localRelayStorage{}, baseDir, uuid)
 	c.Assert(err, IsNil)
 	c.Assert(name, Equals, filename)
 }
@@ -264,7 +264,7 @@ func (t *testFileSuite) TestFileSizeUpdated(c *C) {
 	)
 
 	// file not exists
-	cmp, err := fileSizeUpdated(filePath, latestSize)
+	cmp, err := fileSizeUpdated(localRelayStorage{}, filePath, latestSize)
 	c.Assert(err, ErrorMatches, ".*(no such file or directory|The system cannot find the file specified).*")
 	c.Assert(cmp, Equals, 0)
 
@@ -273,17 +273,32 @@ func (t *testFileSuite) TestFileSizeUpdated(c *C) {
 	c.Assert(err, IsNil)
 
 	// equal
-	cmp, err = fileSizeUpdated(filePath, latestSize)
+	cmp, err = fileSizeUpdated(localRelayStorage{}, filePath, latestSize)
 	c.Assert(err, IsNil)
 	c.Assert(cmp, Equals, 0)
 
 	// less than
-	cmp, err = fileSizeUpdated(filePath, latestSize+1)
+	cmp, err = fileSizeUpdated(localRelayStorage{}, filePath, latestSize+1)
 	c.Assert(err, IsNil)
 	c.Assert(cmp, Equals, -1)
 
 	// greater than
-	cmp, err = fileSizeUpdated(filePath, latestSize-1)
+	cmp, err = fileSizeUpdated(localRelayStorage{}, filePath, latestSize-1)
 	c.Assert(err, IsNil)
 	c.Assert(cmp, Equals, 1)
+
+	// the opened file is compressed and removed
+	f, _, err := openRelayLogFile(localRelayStorage{}, filePath, nil)
+	c.Assert(err, IsNil)
+	defer f.Close()
+	c.Assert(compressRelayLogFile(filePath), IsNil)
+	_, err = fileSizeUpdated(localRelayStorage{}, filePath, latestSize)
+	c.Assert(err, NotNil)
+	cmp, err = openedFileSizeUpdated(f, latestSize-1)
+	c.Assert(err, IsNil)
+	c.Assert(cmp, Equals, 1)
+	r := &BinlogReader{storage: localRelayStorage{}}
+	cmp, err = r.fileSizeUpdated(&binlogFileParseState{fullPath: filePath, f: f, latestPos: latestSize})
+	c.Assert(err, IsNil)
+	c.Assert(cmp, Equals, 0)
 }
@@ -32,9 +32,10 @@ import (
 )
 
 // checkBinlogHeaderExist checks if the file has a binlog file header.
+// if `encryptKey` is not empty, the file is decrypted with it.
 // It is not safe if there other routine is writing the file.
-func checkBinlogHeaderExist(filename string) (bool, error) {
-	f, err := os.Open(filename)
+func checkBinlogHeaderExist(filename string, encryptKey []byte) (bool, error) {
+	f, _, err := openRelayLogFile(localRelayStorage{}, filename, encryptKey)
 	if err != nil {
 		return false, terror.Annotatef(terror.ErrRelayWriterFileOperate.New(err.Error()), "open file %s", filename)
 	}
@@ -45,7 +46,7 @@ func checkBinlogHeaderExist(filename string) (bool, error) {
 
 // checkBinlogHeaderExistFd checks if the file has a binlog file header.
 // It is not safe if there other routine is writing the file.
-func checkBinlogHeaderExistFd(fd *os.File) (bool, error) {
+func checkBinlogHeaderExistFd(fd relayLogFile) (bool, error) {
 	fileHeaderLen := len(replication.BinLogFileHeader)
 	buff := make([]byte, fileHeaderLen)
 	n, err := fd.Read(buff)
@@ -65,9 +66,10 @@ func checkBinlogHeaderExistFd(fd *os.File) (bool, error) {
 }
 
 // checkFormatDescriptionEventExist checks if the file has a valid FormatDescriptionEvent.
+// if `encryptKey` is not empty, the file is decrypted with it.
 // It is not safe if there other routine is writing the file.
-func checkFormatDescriptionEventExist(filename string) (bool, error) {
-	f, err := os.Open(filename)
+func checkFormatDescriptionEventExist(filename string, encryptKey []byte) (bool, error) {
+	f, _, err := openRelayLogFile(localRelayStorage{}, filename, encryptKey)
 	if err != nil {
 		return false, terror.Annotatef(terror.ErrRelayCheckFormatDescEventExist.New(err.Error()), "open file %s", filename)
 	}
@@ -83,10 +85,10 @@ func checkFormatDescriptionEventExist(filename string) (bool, error) {
 
 	// check whether only the file header
 	fileHeaderLen := len(replication.BinLogFileHeader)
-	fs, err := f.Stat()
+	size, err := f.Seek(0, io.SeekEnd)
 	if err != nil {
-		return false, terror.Annotatef(terror.ErrRelayCheckFormatDescEventExist.New(err.Error()), "get stat for %s", filename)
-	} else if fs.Size() == int64(fileHeaderLen) {
+		return false, terror.Annotatef(terror.ErrRelayCheckFormatDescEventExist.New(err.Error()), "get size for %s", filename)
+	} else if size == int64(fileHeaderLen) {
 		return false, nil // only the file header
 	}
 
@@ -121,34 +123,74 @@ func checkFormatDescriptionEventExist(filename string) (bool, error) {
 	return found, nil
 }
 
+// getFirstEventTimestamp gets the timestamp in the header of the first event (FormatDescriptionEvent) of a binlog file.
+// `exist` is false if the first event has not been written completely.
+func getFirstEventTimestamp(s relayStorage, filename string, encryptKey []byte) (ts uint32, exist bool, err error) {
+	f, _, err := openRelayLogFile(s, filename, encryptKey)
+	if err != nil {
+		return 0, false, terror.ErrGetRelayLogStat.Delegate(err, filename)
+	}
+	defer f.Close()
+
+	exist, err = checkBinlogHeaderExistFd(f)
+	if err != nil || !exist {
+		return 0, false, err
+	}
+
+	buf := make([]byte, replication.EventHeaderSize)
+	_, err = io.ReadFull(f, buf)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return 0, false, nil // event header not written completely
+	} else if err != nil {
+		return 0, false, terror.ErrParserParseRelayLog.Delegate(err, filename)
+	}
+
+	header := &replication.EventHeader{}
+	if err = header.Decode(buf); err != nil {
+		return 0, false, terror.ErrParserParseRelayLog.Delegate(err, filename)
+	}
+	if header.EventType != replication.FORMAT_DESCRIPTION_EVENT {
+		return 0, false, terror.ErrRelayCheckFormatDescEventExist.Generatef("got %+v, expect FormatDescriptionEvent", header)
+	}
+	return header.Timestamp, true, nil
+}
+
 // checkIsDuplicateEvent checks if the event is a duplicate event in the file.
+// if `encryptKey` is not empty, the file is decrypted with it.
 // It is not safe if there other routine is writing the file.
 // NOTE: handle cases when file size > 4GB.
-func checkIsDuplicateEvent(filename string, ev *replication.BinlogEvent) (bool, error) {
+func checkIsDuplicateEvent(filename string, ev *replication.BinlogEvent, encryptKey []byte) (bool, error) {
 	// 1. check event start/end pos with the file size, and it's enough for most cases
 	fs, err := os.Stat(filename)
 	if err != nil {
 		return false, terror.Annotatef(terror.ErrRelayCheckIsDuplicateEvent.New(err.Error()), "get stat for %s", filename)
 	}
+	size := fs.Size()
+	if len(encryptKey) > 0 {
+		size = plainRelayLogSize(size)
+	}
 	evStartPos := int64(ev.Header.LogPos - ev.Header.EventSize)
 	evEndPos := int64(ev.Header.LogPos)
-	if fs.Size() <= evStartPos {
+	if size <= evStartPos {
 		return false, nil // the event not in the file
-	} else if fs.Size() < evEndPos {
+	} else if size < evEndPos {
 		// the file can not hold the whole event, often because the file is corrupt
 		return false, terror.ErrRelayCheckIsDuplicateEvent.Generatef(
 			"file size %d is between event's start pos (%d) and end pos (%d)",
-			fs.Size(), evStartPos, evEndPos)
+			size, evStartPos, evEndPos)
 	}
 
 	// 2. compare the file data with the raw data of the event
-	f, err := os.Open(filename)
+	f, _, err := openRelayLogFile(localRelayStorage{}, filename, encryptKey)
 	if err != nil {
 		return false, terror.Annotate(terror.ErrRelayCheckIsDuplicateEvent.New(err.Error()), "open binlog file")
 	}
 	defer f.Close()
 	buf := make([]byte, ev.Header.EventSize)
-	_, err = f.ReadAt(buf, evStartPos)
+	_, err = f.Seek(evStartPos, io.SeekStart)
+	if err == nil {
+		_, err = io.ReadFull(f, buf)
+	}
 	if err != nil {
 		return false, terror.Annotatef(terror.ErrRelayCheckIsDuplicateEvent.New(err.Error()), "read data from %d in %s with length %d", evStartPos, filename, len(buf))
 	} else if !bytes.Equal(buf, ev.RawData) {
@@ -160,32 +202,66 @@ func checkIsDuplicateEvent(filename string, ev *replication.BinlogEvent) (bool,
 }
 
 // getTxnPosGTIDs gets position/GTID set for all completed transactions from a binlog file.
+// if `encryptKey` is not empty, the file is decrypted with it.
 // It is not safe if there other routine is writing the file.
 // NOTE: we use a int64 rather than a uint32 to represent the latest transaction's end log pos.
-func getTxnPosGTIDs(ctx context.Context, filename string, p *parser.Parser) (int64, gtid.Set, error) {
-	// use a FileReader to parse the binlog file.
-	rCfg := &reader.FileReaderConfig{
-		EnableRawMode: false, // in order to get GTID set, we always disable RawMode.
-	}
-	startPos := gmysql.Position{Name: filename, Pos: 0} // always start from the file header
-	r := reader.NewFileReader(rCfg)
-	defer r.Close()
-	err := r.StartSyncByPos(startPos) // we always parse the file by pos
-	if err != nil {
-		return 0, nil, terror.Annotatef(err, "start sync by pos %s for %s", startPos, filename)
+func getTxnPosGTIDs(ctx context.Context, filename string, p *parser.Parser, encryptKey []byte) (int64, gtid.Set, error) {
+	var nextEvent func() (*replication.BinlogEvent, error)
+	if len(encryptKey) > 0 {
+		// FileReader can only read plain files, so parse the decrypted events one by one.
+		f, _, err := openRelayLogFile(localRelayStorage{}, filename, encryptKey)
+		if err != nil {
+			return 0, nil, terror.Annotatef(terror.ErrRelayWriterFileOperate.New(err.Error()), "open %s", filename)
+		}
+		defer f.Close()
+		if _, err = f.Seek(int64(len(replication.BinLogFileHeader)), io.SeekStart); err != nil {
+			return 0, nil, terror.Annotatef(terror.ErrRelayWriterFileOperate.New(err.Error()), "seek %s", filename)
+		}
+		bp := replication.NewBinlogParser()
+		nextEvent = func() (*replication.BinlogEvent, error) {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			var e *replication.BinlogEvent
+			eof, err2 := bp.ParseSingleEvent(f, func(ev *replication.BinlogEvent) error {
+				e = ev
+				return nil
+			})
+			if err2 == nil && (eof || e == nil) {
+				err2 = io.EOF
+			}
+			return e, err2
+		}
+	} else {
+		// use a FileReader to parse the binlog file.
+		rCfg := &reader.FileReaderConfig{
+			EnableRawMode: false, // in order to get GTID set, we always disable RawMode.
+		}
+		startPos := gmysql.Position{Name: filename, Pos: 0} // always start from the file header
+		r := reader.NewFileReader(rCfg)
+		defer r.Close()
+		err := r.StartSyncByPos(startPos) // we always parse the file by pos
+		if err != nil {
+			return 0, nil, terror.Annotatef(err, "start sync by pos %s for %s", startPos, filename)
+		}
+		nextEvent = func() (*replication.BinlogEvent, error) {
+			ctx2, cancel2 := context.WithTimeout(ctx, time.Second)
+			defer cancel2()
+			return r.GetEvent(ctx2)
+		}
 	}
 
 	var (
 		latestPos   int64
 		latestGSet  gmysql.GTIDSet
 		nextGTIDStr string // can be recorded if the coming transaction completed
+		standalone  bool   // whether the coming MariaDB event group is standalone
 		flavor      string
+		err         error
 	)
 	for {
 		var e *replication.BinlogEvent
-		ctx2, cancel2 := context.WithTimeout(ctx, time.Second)
-		e, err = r.GetEvent(ctx2)
-		cancel2()
+		e, err = nextEvent()
 		if err != nil {
 			break // now, we stop to parse for any errors even is context done
 		}
@@ -196,7 +272,7 @@ func getTxnPosGTIDs(ctx context.Context, filename string, p *parser.Parser) (int
 			latestPos = int64(e.Header.LogPos)
 		case *replication.QueryEvent:
 			isDDL := parserpkg.CheckIsDDL(string(ev.Query), p)
-			if isDDL {
+			if isDDL || standalone || event.IsCommitQuery(ev.Query) {
 				if latestGSet != nil { // GTID may not be enabled in the binlog
 					err = latestGSet.Update(nextGTIDStr)
 					if err != nil {
@@ -221,6 +297,7 @@ func getTxnPosGTIDs(ctx context.Context, filename string, p *parser.Parser) (int
 			if err != nil {
 				return 0, nil, err
 			}
+			standalone = false
 		case *replication.MariadbGTIDEvent:
 			if latestGSet == nil {
 				return 0, nil, terror.ErrRelayNeedMaGTIDListEvBeforeGTIDEv.Generate(e.Header)
@@ -229,6 +306,8 @@ func getTxnPosGTIDs(ctx context.Context, filename string, p *parser.Parser) (int
 			if err != nil {
 				return 0, nil, err
 			}
+			// a standalone event group has only one QueryEvent without COMMIT or XIDEvent.
+			standalone = ev.IsStandalone()
 		case *replication.PreviousGTIDsEvent:
 			// if GTID enabled, we can get a PreviousGTIDEvent after the FormatDescriptionEvent
 			// ref: https://github.com/mysql/mysql-server/blob/8cc757da3d87bf4a1f07dcfb2d3c96fed3806870/sql/binlog.cc#L4549
@@ -16,6 +16,8 @@ package relay
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
+	"hash/crc32"
 	"io"
 	"os"
 	"path/filepath"
@@ -38,35 +40,35 @@ type testFileUtilSuite struct{}
 func (t *testFileUtilSuite) TestCheckBinlogHeaderExist(c *check.C) {
 	// file not exists
 	filename := filepath.Join(c.MkDir(), "test-mysql-bin.000001")
-	exist, err := checkBinlogHeaderExist(filename)
+	exist, err := checkBinlogHeaderExist(filename, nil)
 	c.Assert(err, check.ErrorMatches, ".*(no such file or directory|The system cannot find the file specified).*")
 	c.Assert(exist, check.IsFalse)
 
 	// empty file
 	err = os.WriteFile(filename, nil, 0o644)
 	c.Assert(err, check.IsNil)
-	exist, err = checkBinlogHeaderExist(filename)
+	exist, err = checkBinlogHeaderExist(filename, nil)
 	c.Assert(err, check.IsNil)
 	c.Assert(exist, check.IsFalse)
 
 	// no enough data
 	err = os.WriteFile(filename, replication.BinLogFileHeader[:len(replication.BinLogFileHeader)-1], 0o644)
 	c.Assert(err, check.IsNil)
-	exist, err = checkBinlogHeaderExist(filename)
+	exist, err = checkBinlogHeaderExist(filename, nil)
 	c.Assert(err, check.ErrorMatches, ".*has no enough data.*")
 	c.Assert(exist, check.IsFalse)
 
 	// equal
 	err = os.WriteFile(filename, replication.BinLogFileHeader, 0o644)
 	c.Assert(err, check.IsNil)
-	exist, err = checkBinlogHeaderExist(filename)
+	exist, err = checkBinlogHeaderExist(filename, nil)
 	c.Assert(err, check.IsNil)
 	c.Assert(exist, check.IsTrue)
 
 	// more data
 	err = os.WriteFile(filename, bytes.Repeat(replication.BinLogFileHeader, 2), 0o644)
 	c.Assert(err, check.IsNil)
-	exist, err = checkBinlogHeaderExist(filename)
+	exist, err = checkBinlogHeaderExist(filename, nil)
 	c.Assert(err, check.IsNil)
 	c.Assert(exist, check.IsTrue)
 
@@ -76,7 +78,7 @@ func (t *testFileUtilSuite) TestCheckBinlogHeaderExist(c *check.C) {
 	invalidData[0]++
 	err = os.WriteFile(filename, invalidData, 0o644)
 	c.Assert(err, check.IsNil)
-	exist, err = checkBinlogHeaderExist(filename)
+	exist, err = checkBinlogHeaderExist(filename, nil)
 	c.Assert(err, check.ErrorMatches, ".*header not valid.*")
 	c.Assert(exist, check.IsFalse)
 }
@@ -95,21 +97,21 @@ func (t *testFileUtilSuite) TestCheckFormatDescriptionEventExist(c *check.C) {
 
 	// file not exists
 	filename := filepath.Join(c.MkDir(), "test-mysql-bin.000001")
-	exist, err := checkFormatDescriptionEventExist(filename)
+	exist, err := checkFormatDescriptionEventExist(filename, nil)
 	c.Assert(err, check.ErrorMatches, ".*(no such file or directory|The system cannot find the file specified).*")
 	c.Assert(exist, check.IsFalse)
 
 	// empty file
 	err = os.WriteFile(filename, nil, 0o644)
 	c.Assert(err, check.IsNil)
-	exist, err = checkFormatDescriptionEventExist(filename)
+	exist, err = checkFormatDescriptionEventExist(filename, nil)
 	c.Assert(err, check.ErrorMatches, ".*no binlog file header at the beginning.*")
 	c.Assert(exist, check.IsFalse)
 
 	// only file header
 	err = os.WriteFile(filename, replication.BinLogFileHeader, 0o644)
 	c.Assert(err, check.IsNil)
-	exist, err = checkFormatDescriptionEventExist(filename)
+	exist, err = checkFormatDescriptionEventExist(filename, nil)
 	c.Assert(err, check.IsNil)
 	c.Assert(exist, check.IsFalse)
 
@@ -119,7 +121,7 @@ func (t *testFileUtilSuite) TestCheckFormatDescriptionEventExist(c *check.C) {
 	buff.Write(formatDescEv.RawData[:replication.EventHeaderSize-1])
 	err = os.WriteFile(filename, buff.Bytes(), 0o644)
 	c.Assert(err, check.IsNil)
-	exist, err = checkFormatDescriptionEventExist(filename)
+	exist, err = checkFormatDescriptionEventExist(filename, nil)
 	c.Assert(errors.Cause(err), check.Equals, io.EOF)
 	c.Assert(exist, check.IsFalse)
 
@@ -129,7 +131,7 @@ func (t *testFileUtilSuite) TestCheckFormatDescriptionEventExist(c *check.C) {
 	buff.Write(formatDescEv.RawData[:replication.EventHeaderSize])
 	err = os.WriteFile(filename, buff.Bytes(), 0o644)
 	c.Assert(err, check.IsNil)
-	exist, err = checkFormatDescriptionEventExist(filename)
+	exist, err = checkFormatDescriptionEventExist(filename, nil)
 	c.Assert(err, check.ErrorMatches, ".*get event err EOF.*")
 	c.Assert(exist, check.IsFalse)
 
@@ -139,7 +141,7 @@ func (t *testFileUtilSuite) TestCheckFormatDescriptionEventExist(c *check.C) {
 	buff.Write(formatDescEv.RawData[:replication.EventHeaderSize+1])
 	err = os.WriteFile(filename, buff.Bytes(), 0o644)
 	c.Assert(err, check.IsNil)
-	exist, err = checkFormatDescriptionEventExist(filename)
+	exist, err = checkFormatDescriptionEventExist(filename, nil)
 	c.Assert(err, check.ErrorMatches, ".*get event err EOF.*")
 	c.Assert(exist, check.IsFalse)
 
@@ -151,7 +153,7 @@ func (t *testFileUtilSuite) TestCheckFormatDescriptionEventExist(c *check.C) {
 	copy(dataCopy, buff.Bytes())
 	err = os.WriteFile(filename, buff.Bytes(), 0o644)
 	c.Assert(err, check.IsNil)
-	exist, err = checkFormatDescriptionEventExist(filename)
+	exist, err = checkFormatDescriptionEventExist(filename, nil)
 	c.Assert(err, check.IsNil)
 	c.Assert(exist, check.IsTrue)
 
@@ -159,7 +161,7 @@ func (t *testFileUtilSuite) TestCheckFormatDescriptionEventExist(c *check.C) {
 	buff.Write([]byte("more data"))
 	err = os.WriteFile(filename, buff.Bytes(), 0o644)
 	c.Assert(err, check.IsNil)
-	exist, err = checkFormatDescriptionEventExist(filename)
+	exist, err = checkFormatDescriptionEventExist(filename, nil)
 	c.Assert(err, check.IsNil)
 	c.Assert(exist, check.IsTrue)
 
@@ -171,7 +173,7 @@ func (t *testFileUtilSuite) TestCheckFormatDescriptionEventExist(c *check.C) {
 	buff.Write(queryEv.RawData)
 	err = os.WriteFile(filename, buff.Bytes(), 0o644)
 	c.Assert(err, check.IsNil)
-	exist, err = checkFormatDescriptionEventExist(filename)
+	exist, err = checkFormatDescriptionEventExist(filename, nil)
 	c.Assert(err, check.ErrorMatches, ".*expect FormatDescriptionEvent.*")
 	c.Assert(exist, check.IsFalse)
 }
@@ -218,7 +220,7 @@ func (t *testFileUtilSuite) TestCheckIsDuplicateEvent(c *check.C) {
 
 	// all events in the file
 	for _, ev := range allEvents {
-		duplicate, err2 := checkIsDuplicateEvent(filename, ev)
+		duplicate, err2 := checkIsDuplicateEvent(filename, ev, nil)
 		c.Assert(err2, check.IsNil)
 		c.Assert(duplicate, check.IsTrue)
 	}
@@ -226,7 +228,7 @@ func (t *testFileUtilSuite) TestCheckIsDuplicateEvent(c *check.C) {
 	// event not in the file, because its start pos > file size
 	events, _, err = g.GenDDLEvents("", "BEGIN", 0)
 	c.Assert(err, check.IsNil)
-	duplicate, err := checkIsDuplicateEvent(filename, events[0])
+	duplicate, err := checkIsDuplicateEvent(filename, events[0], nil)
 	c.Assert(err, check.IsNil)
 	c.Assert(duplicate, check.IsFalse)
 
@@ -237,7 +239,7 @@ func (t *testFileUtilSuite) TestCheckIsDuplicateEvent(c *check.C) {
 	eventSize := lastEvent.Header.EventSize + 1 // greater event size
 	dummyEv, err := event.GenDummyEvent(&header, latestPos, eventSize)
 	c.Assert(err, check.IsNil)
-	duplicate, err = checkIsDuplicateEvent(filename, dummyEv)
+	duplicate, err = checkIsDuplicateEvent(filename, dummyEv, nil)
 	c.Assert(err, check.ErrorMatches, ".*file size.*is between event's start pos.*")
 	c.Assert(duplicate, check.IsFalse)
 
@@ -246,7 +248,7 @@ func (t *testFileUtilSuite) TestCheckIsDuplicateEvent(c *check.C) {
 	eventSize = lastEvent.Header.EventSize
 	dummyEv, err = event.GenDummyEvent(&header, latestPos, eventSize)
 	c.Assert(err, check.IsNil)
-	duplicate, err = checkIsDuplicateEvent(filename, dummyEv)
+	duplicate, err = checkIsDuplicateEvent(filename, dummyEv, nil)
 	c.Assert(err, check.ErrorMatches, "*diff from passed-in event.*")
 	c.Assert(duplicate, check.IsFalse)
 
@@ -255,13 +257,13 @@ func (t *testFileUtilSuite) TestCheckIsDuplicateEvent(c *check.C) {
 	eventSize = lastEvent.Header.EventSize
 	dummyEv, err = event.GenDummyEvent(&header, latestPos, eventSize)
 	c.Assert(err, check.IsNil)
-	duplicate, err = checkIsDuplicateEvent(filename, dummyEv)
+	duplicate, err = checkIsDuplicateEvent(filename, dummyEv, nil)
 	c.Assert(err, check.ErrorMatches, ".*diff from passed-in event.*")
 	c.Assert(duplicate, check.IsFalse)
 
 	// file not exists, invalid
 	filename += ".no-exist"
-	duplicate, err = checkIsDuplicateEvent(filename, lastEvent)
+	duplicate, err = checkIsDuplicateEvent(filename, lastEvent, nil)
 	c.Assert(err, check.ErrorMatches, ".*get stat for.*")
 	c.Assert(duplicate, check.IsFalse)
 }
@@ -322,7 +324,7 @@ func (t *testFileUtilSuite) testGetTxnPosGTIDs(c *check.C, filename, flavor, pre
 	c.Assert(err, check.IsNil)
 
 	// not extra data exists
-	pos, gSet, err := getTxnPosGTIDs(context.Background(), filename, parser2)
+	pos, gSet, err := getTxnPosGTIDs(context.Background(), filename, parser2, nil)
 	c.Assert(err, check.IsNil)
 	c.Assert(pos, check.DeepEquals, expectedPos)
 	c.Assert(gSet, check.DeepEquals, expectedGTIDs)
@@ -359,7 +361,7 @@ func (t *testFileUtilSuite) testGetTxnPosGTIDs(c *check.C, filename, flavor, pre
 	c.Assert(f.Close(), check.IsNil)
 
 	// check again
-	pos, gSet, err = getTxnPosGTIDs(context.Background(), filename, parser2)
+	pos, gSet, err = getTxnPosGTIDs(context.Background(), filename, parser2, nil)
 	c.Assert(err, check.IsNil)
 	c.Assert(pos, check.DeepEquals, expectedPos)
 	c.Assert(gSet, check.DeepEquals, expectedGTIDs)
@@ -379,7 +381,7 @@ func (t *testFileUtilSuite) testGetTxnPosGTIDs(c *check.C, filename, flavor, pre
 		c.Assert(err, check.IsNil)
 		c.Assert(f.Close(), check.IsNil)
 		// check again
-		pos, gSet, err = getTxnPosGTIDs(context.Background(), filename, parser2)
+		pos, gSet, err = getTxnPosGTIDs(context.Background(), filename, parser2, nil)
 		c.Assert(err, check.IsNil)
 		c.Assert(pos, check.DeepEquals, expectedPos)
 		c.Assert(gSet, check.DeepEquals, expectedGTIDs)
@@ -396,12 +398,72 @@ func (t *testFileUtilSuite) testGetTxnPosGTIDs(c *check.C, filename, flavor, pre
 	expectedPos += int64(len(extraData))
 	expectedGTIDs, err = gtid.ParserGTID(flavor, expectedGTIDsStr2) // 3 DDL + 11 DML
 	c.Assert(err, check.IsNil)
-	pos, gSet, err = getTxnPosGTIDs(context.Background(), filename, parser2)
+	pos, gSet, err = getTxnPosGTIDs(context.Background(), filename, parser2, nil)
 	c.Assert(err, check.IsNil)
 	c.Assert(pos, check.DeepEquals, expectedPos)
 	c.Assert(gSet, check.DeepEquals, expectedGTIDs)
 }
 
+func (t *testFileUtilSuite) TestGetTxnPosGTIDsMariaDBStandaloneAndCommit(c *check.C) {
+	var (
+		header = &replication.EventHeader{
+			Timestamp: uint32(time.Now().Unix()),
+			ServerID:  11,
+		}
+		latestPos uint32 = 4
+		filename         = filepath.Join(c.MkDir(), "test-mysql-bin.000001")
+		data             = append([]byte(nil), replication.BinLogFileHeader...)
+	)
+	previousGSet, err := gtid.ParserGTID(gmysql.MariaDBFlavor, "0-1-5,1-2-3")
+	c.Assert(err, check.IsNil)
+
+	genQuery := func(query string) *replication.BinlogEvent {
+		ev, err2 := event.GenQueryEvent(header, latestPos, 0, 0, 0, nil, []byte("db"), []byte(query))
+		c.Assert(err2, check.IsNil)
+		latestPos = ev.Header.LogPos
+		return ev
+	}
+	verify := func(expectedPos uint32, expectedGSetStr string, events ...*replication.BinlogEvent) {
+		for _, ev := range events {
+			data = append(data, ev.RawData...)
+		}
+		c.Assert(os.WriteFile(filename, data, 0o644), check.IsNil)
+		expectedGSet, err2 := gtid.ParserGTID(gmysql.MariaDBFlavor, expectedGSetStr)
+		c.Assert(err2, check.IsNil)
+		pos, gSet, err2 := getTxnPosGTIDs(context.Background(), filename, parser.New(), nil)
+		c.Assert(err2, check.IsNil)
+		c.Assert(pos, check.Equals, int64(expectedPos))
+		c.Assert(gSet, check.DeepEquals, expectedGSet)
+	}
+
+	formatDescEv, err := event.GenFormatDescriptionEvent(header, latestPos)
+	c.Assert(err, check.IsNil)
+	gtidListEv, err := event.GenMariaDBGTIDListEvent(header, formatDescEv.Header.LogPos, previousGSet)
+	c.Assert(err, check.IsNil)
+	latestPos = gtidListEv.Header.LogPos
+	verify(latestPos, "0-1-5,1-2-3", formatDescEv, gtidListEv)
+
+	// a standalone event group of a non-DDL statement, without COMMIT or XIDEvent
+	standaloneGTIDEv, err := event.GenMariaDBGTIDEvent(header, latestPos, 6, 0)
+	c.Assert(err, check.IsNil)
+	raw := standaloneGTIDEv.RawData
+	raw[replication.EventHeaderSize+12] = replication.BINLOG_MARIADB_FL_STANDALONE
+	binary.LittleEndian.PutUint32(raw[len(raw)-4:], crc32.ChecksumIEEE(raw[:len(raw)-4]))
+	latestPos = standaloneGTIDEv.Header.LogPos
+	flushEv := genQuery("FLUSH PRIVILEGES")
+	verify(flushEv.Header.LogPos, "0-11-6,1-2-3", standaloneGTIDEv, flushEv)
+
+	// a transaction of non-transactional engines ends with COMMIT
+	gtidEv, err := event.GenMariaDBGTIDEvent(header, latestPos, 4, 1)
+	c.Assert(err, check.IsNil)
+	latestPos = gtidEv.Header.LogPos
+	beginEv := genQuery("BEGIN")
+	insertEv := genQuery("INSERT INTO db.tbl VALUES (1)")
+	commitEv := genQuery("COMMIT")
+	verify(flushEv.Header.LogPos, "0-11-6,1-2-3", gtidEv, beginEv, insertEv)
+	verify(commitEv.Header.LogPos, "0-11-6,1-11-4", commitEv)
+}
+
 func (t *testFileUtilSuite) TestGetTxnPosGTIDsNoGTID(c *check.C) {
 	// generate some events but without GTID enabled
 	var (
@@ -435,7 +497,7 @@ func (t *testFileUtilSuite) TestGetTxnPosGTIDsNoGTID(c *check.C) {
 	c.Assert(f.Close(), check.IsNil)
 
 	// check latest pos/GTID set
-	pos, gSet, err := getTxnPosGTIDs(context.Background(), filename, parser.New())
+	pos, gSet, err := getTxnPosGTIDs(context.Background(), filename, parser.New(), nil)
 	c.Assert(err, check.IsNil)
 	c.Assert(pos, check.Equals, int64(latestPos))
 	c.Assert(gSet, check.IsNil) // GTID not enabled
@@ -501,7 +563,7 @@ func (t *testFileUtilSuite) testGetTxnPosGTIDsIllegalGTID(c *check.C, gtidEv *re
 	c.Assert(f.Close(), check.IsNil)
 
 	// check latest pos/GTID set
-	pos, gSet, err := getTxnPosGTIDs(context.Background(), filename, parser.New())
+	pos, gSet, err := getTxnPosGTIDs(context.Background(), filename, parser.New(), nil)
 	c.Assert(err, check.ErrorMatches, errRegStr)
 	c.Assert(pos, check.Equals, int64(0))
 	c.Assert(gSet, check.IsNil)
@@ -546,7 +608,7 @@ func (t *testFileUtilSuite) testDontTruncate(c *check.C, events []*replication.B
 		c.Assert(err, check.IsNil)
 
 		stat, _ := f.Stat()
-		pos, _, err := getTxnPosGTIDs(context.Background(), filename, parser2)
+		pos, _, err := getTxnPosGTIDs(context.Background(), filename, parser2, nil)
 		c.Assert(err, check.IsNil)
 		c.Assert(pos, check.Equals, stat.Size())
 	}
@@ -0,0 +1,98 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"encoding/json"
+	"path/filepath"
+
+	"github.com/pingcap/errors"
+
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+// relayLogGapsFilename is the name of the file in each relay sub directory which records the GTID sets of the holes
+// filled by dummy events in GTID mode. the transactions in a hole are not sent by the upstream because they are
+// contained in the GTID sets relay started from, so the dummy event carries no GTID, and the readers recover the
+// GTIDs of the hole from this file.
+const relayLogGapsFilename = "relay.gaps"
+
+// relayLogGap is a hole in a relay log file filled by a dummy event.
+type relayLogGap struct {
+	Start int64 `json:"start"` // start position of the dummy event
+	End   int64 `json:"end"`   // end position of the dummy event, also the start position of the next event
+	// GTIDSet is the GTID sets of the relay when the hole is filled, which contains all transactions in the hole
+	// and before it. the transactions after the hole are not contained in it, otherwise they're not sent either.
+	GTIDSet string `json:"gtid-set"`
+}
+
+// relayLogGaps is the content of the relay.gaps file, the gaps of each relay log file.
+type relayLogGaps struct {
+	Files map[string][]relayLogGap `json:"files"`
+}
+
+// readRelayLogGaps reads the gaps of the relay log files in the sub directory `dir` in `s`.
+func readRelayLogGaps(s relayStorage, dir string) (*relayLogGaps, error) {
+	gaps := &relayLogGaps{Files: make(map[string][]relayLogGap)}
+	gapsPath := filepath.Join(dir, relayLogGapsFilename)
+	if !s.exists(gapsPath) {
+		return gaps, nil
+	}
+	data, err := s.readFile(gapsPath)
+	if err != nil {
+		return nil, errors.Annotatef(err, "read relay log gaps %s", gapsPath)
+	}
+	if err = json.Unmarshal(data, gaps); err != nil {
+		return nil, errors.Annotatef(err, "decode relay log gaps %s", gapsPath)
+	}
+	if gaps.Files == nil {
+		gaps.Files = make(map[string][]relayLogGap)
+	}
+	return gaps, nil
+}
+
+// recordRelayLogGap records the gap of the relay log file `filename` into the local sub directory `dir` atomically,
+// the gap recorded before with the same start position is replaced, like the one recorded before a recovery.
+func recordRelayLogGap(dir, filename string, gap relayLogGap) error {
+	gaps, err := readRelayLogGaps(localRelayStorage{}, dir)
+	if err != nil {
+		return err
+	}
+	fileGaps := gaps.Files[filename][:0]
+	for _, g := range gaps.Files[filename] {
+		if g.Start != gap.Start {
+			fileGaps = append(fileGaps, g)
+		}
+	}
+	gaps.Files[filename] = append(fileGaps, gap)
+	data, err := json.Marshal(gaps)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(utils.WriteFileAtomic(filepath.Join(dir, relayLogGapsFilename), data, 0o644))
+}
+
+// findRelayLogGap returns the gap of the relay log file `fullPath` in `s` which starts at `start`.
+func findRelayLogGap(s relayStorage, fullPath string, start int64) (relayLogGap, bool, error) {
+	gaps, err := readRelayLogGaps(s, filepath.Dir(fullPath))
+	if err != nil {
+		return relayLogGap{}, false, err
+	}
+	for _, g := range gaps.Files[filepath.Base(fullPath)] {
+		if g.Start == start {
+			return g, true, nil
+		}
+	}
+	return relayLogGap{}, false, nil
+}
@@ -0,0 +1,299 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pingcap/errors"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+const (
+	// relayLogKeyringFilename is the name of the keyring file in each relay sub directory, which keeps the data keys
+	// of the relay log files in the sub directory wrapped by the master key.
+	relayLogKeyringFilename = "relay.keyring"
+	// relayLogDataKeyLen is the length of the AES-256 data key generated for each relay log file.
+	relayLogDataKeyLen = 32
+)
+
+// keyringMu serializes the updates of keyring files in this process.
+var keyringMu sync.Mutex
+
+// MasterKey wraps and unwraps the data keys of relay log files in envelope encryption.
+// it can be backed by a local key (see NewLocalMasterKey) or a key management service.
+type MasterKey interface {
+	// ID returns the identity of the master key, it's recorded with the wrapped data keys.
+	ID() string
+	// Wrap encrypts the data key.
+	Wrap(dataKey []byte) ([]byte, error)
+	// Unwrap decrypts the data key wrapped by Wrap.
+	Unwrap(wrapped []byte) ([]byte, error)
+}
+
+// localMasterKey is a MasterKey which wraps data keys with AES-GCM.
+type localMasterKey struct {
+	id   string
+	aead cipher.AEAD
+}
+
+// NewLocalMasterKey creates a MasterKey with the AES key (16, 24 or 32 bytes).
+func NewLocalMasterKey(id string, key []byte) (MasterKey, error) {
+	block, err := newRelayLogCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, terror.ErrEncryptGenCipher.Delegate(err)
+	}
+	return &localMasterKey{id: id, aead: aead}, nil
+}
+
+// ID implements MasterKey.ID.
+func (k *localMasterKey) ID() string {
+	return k.id
+}
+
+// Wrap implements MasterKey.Wrap, the wrapped key is the nonce followed by the sealed data key.
+func (k *localMasterKey) Wrap(dataKey []byte) ([]byte, error) {
+	nonce := make([]byte, k.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, terror.ErrEncryptGenIV.Delegate(err)
+	}
+	return k.aead.Seal(nonce, nonce, dataKey, []byte(k.id)), nil
+}
+
+// Unwrap implements MasterKey.Unwrap.
+func (k *localMasterKey) Unwrap(wrapped []byte) ([]byte, error) {
+	if len(wrapped) < k.aead.NonceSize() {
+		return nil, terror.ErrCiphertextLenNotValid.Generate(k.aead.NonceSize(), len(wrapped))
+	}
+	nonce, sealed := wrapped[:k.aead.NonceSize()], wrapped[k.aead.NonceSize():]
+	dataKey, err := k.aead.Open(nil, nonce, sealed, []byte(k.id))
+	if err != nil {
+		return nil, terror.ErrCiphertextContextNotValid.Delegate(err)
+	}
+	return dataKey, nil
+}
+
+// EncryptionConfig is the configuration used for encrypting relay log files at rest. each relay log file is encrypted
+// by a random data key, and the data keys are wrapped by the master key and kept in the keyring file of the sub
+// directory, so rotating the master key only re-wraps the data keys (see RotateRelayLogKeys).
+type EncryptionConfig struct {
+	// MasterKeyID identifies the master key, the data keys are unwrapped by the master key with the same ID.
+	MasterKeyID string `toml:"master-key-id" json:"master-key-id"`
+	// MasterKey is the hex encoded AES key (16, 24 or 32 bytes) used as the master key.
+	MasterKey string `toml:"master-key" json:"-"`
+	// MasterKeyFile is the path of the file which contains the hex encoded master key, used if MasterKey is empty.
+	MasterKeyFile string `toml:"master-key-file" json:"master-key-file"`
+	// EncryptKey is the hex encoded AES key (16, 24 or 32 bytes) used to decrypt the relay log files which are
+	// encrypted by a single key without a keyring, it's ignored when the master key is set.
+	EncryptKey string `toml:"encrypt-key" json:"-"`
+	// KMS is the master key backed by a key management service set by the caller, which overrides the local one.
+	KMS MasterKey `toml:"-" json:"-"`
+}
+
+// enabled returns whether the relay log files should be encrypted.
+func (c *EncryptionConfig) enabled() bool {
+	return c.KMS != nil || c.MasterKey != "" || c.MasterKeyFile != ""
+}
+
+// masterKey returns the master key of the config, nil if encryption is not enabled.
+func (c *EncryptionConfig) masterKey() (MasterKey, error) {
+	if c.KMS != nil {
+		return c.KMS, nil
+	}
+	hexKey := c.MasterKey
+	if hexKey == "" && c.MasterKeyFile != "" {
+		data, err := os.ReadFile(c.MasterKeyFile)
+		if err != nil {
+			return nil, terror.ErrEncryptSecretKeyNotValid.Delegate(err, 0)
+		}
+		hexKey = strings.TrimSpace(string(data))
+	}
+	if hexKey == "" {
+		return nil, nil
+	}
+	key, err := hex.DecodeString(hexKey)
+	if err != nil {
+		return nil, terror.ErrEncryptSecretKeyNotValid.Delegate(err, len(hexKey))
+	}
+	return NewLocalMasterKey(c.MasterKeyID, key)
+}
+
+// encryptKey returns the decoded single key of the config, nil if it's not set.
+func (c *EncryptionConfig) encryptKey() ([]byte, error) {
+	if c.EncryptKey == "" {
+		return nil, nil
+	}
+	key, err := hex.DecodeString(c.EncryptKey)
+	if err != nil {
+		return nil, terror.ErrEncryptSecretKeyNotValid.Delegate(err, len(c.EncryptKey))
+	}
+	if _, err = newRelayLogCipher(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// wrappedDataKey is a data key wrapped by the master key.
+type wrappedDataKey struct {
+	MasterKeyID string `json:"master-key-id"`
+	DataKey     []byte `json:"data-key"`
+}
+
+// relayLogKeyring keeps the wrapped data keys of the relay log files in a sub directory.
+type relayLogKeyring struct {
+	Keys map[string]wrappedDataKey `json:"keys"` // relay log filename -> wrapped data key
+}
+
+// readRelayLogKeyring reads the keyring in the sub directory `dir` of `s`, an empty keyring is returned if not exists.
+func readRelayLogKeyring(s relayStorage, dir string) (*relayLogKeyring, error) {
+	kr := &relayLogKeyring{Keys: make(map[string]wrappedDataKey)}
+	keyringPath := filepath.Join(dir, relayLogKeyringFilename)
+	if !s.exists(keyringPath) {
+		return kr, nil
+	}
+	data, err := s.readFile(keyringPath)
+	if err != nil {
+		return nil, errors.Annotatef(err, "read relay log keyring %s", keyringPath)
+	}
+	if err = json.Unmarshal(data, kr); err != nil {
+		return nil, errors.Annotatef(err, "decode relay log keyring %s", keyringPath)
+	}
+	if kr.Keys == nil {
+		kr.Keys = make(map[string]wrappedDataKey)
+	}
+	return kr, nil
+}
+
+// writeRelayLogKeyring writes the keyring into the local sub directory `dir` atomically.
+func writeRelayLogKeyring(dir string, kr *relayLogKeyring) error {
+	data, err := json.Marshal(kr)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(utils.WriteFileAtomic(filepath.Join(dir, relayLogKeyringFilename), data, 0o600))
+}
+
+// loadRelayLogDataKey returns the data key of the relay log file `fullPath` in `s`,
+// nil is returned if the relay log file is not encrypted with envelope encryption.
+func loadRelayLogDataKey(s relayStorage, fullPath string, mk MasterKey) ([]byte, error) {
+	kr, err := readRelayLogKeyring(s, filepath.Dir(fullPath))
+	if err != nil {
+		return nil, err
+	}
+	wrapped, ok := kr.Keys[filepath.Base(fullPath)]
+	if !ok {
+		return nil, nil
+	}
+	if wrapped.MasterKeyID != mk.ID() {
+		return nil, terror.ErrEncryptSecretKeyNotValid.Generatef("data key of relay log file %s is wrapped by master key %s, but got master key %s",
+			fullPath, wrapped.MasterKeyID, mk.ID())
+	}
+	return mk.Unwrap(wrapped.DataKey)
+}
+
+// newRelayLogDataKey generates a data key for the local relay log file `fullPath` and adds it into the keyring.
+func newRelayLogDataKey(fullPath string, mk MasterKey) ([]byte, error) {
+	dataKey := make([]byte, relayLogDataKeyLen)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, terror.ErrEncryptGenIV.Delegate(err)
+	}
+	wrapped, err := mk.Wrap(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	keyringMu.Lock()
+	defer keyringMu.Unlock()
+	dir := filepath.Dir(fullPath)
+	kr, err := readRelayLogKeyring(localRelayStorage{}, dir)
+	if err != nil {
+		return nil, err
+	}
+	kr.Keys[filepath.Base(fullPath)] = wrappedDataKey{MasterKeyID: mk.ID(), DataKey: wrapped}
+	if err = writeRelayLogKeyring(dir, kr); err != nil {
+		return nil, err
+	}
+	return dataKey, nil
+}
+
+// RotateRelayLogKeys re-wraps the data keys of relay log files in all sub directories of `relayDir` by `newKey`,
+// the data keys are unwrapped by the one in `oldKeys` with the same ID. the keyring files of relay log files being
+// written are updated concurrently by the relay, so it should be called in the relay process or when relay stopped.
+// it returns the number of re-wrapped data keys.
+func RotateRelayLogKeys(relayDir string, newKey MasterKey, oldKeys ...MasterKey) (int, error) {
+	keys := make(map[string]MasterKey, len(oldKeys)+1)
+	for _, k := range oldKeys {
+		keys[k.ID()] = k
+	}
+	keys[newKey.ID()] = newKey
+
+	entries, err := os.ReadDir(relayDir)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+
+	keyringMu.Lock()
+	defer keyringMu.Unlock()
+	rotated := 0
+	for _, entry := range entries {
+		if !isDirEntryDir(relayDir, entry) {
+			continue
+		}
+		dir := filepath.Join(relayDir, entry.Name())
+		kr, err2 := readRelayLogKeyring(localRelayStorage{}, dir)
+		if err2 != nil {
+			return rotated, err2
+		}
+		if len(kr.Keys) == 0 {
+			continue
+		}
+		for file, wrapped := range kr.Keys {
+			if wrapped.MasterKeyID == newKey.ID() {
+				continue
+			}
+			oldKey, ok := keys[wrapped.MasterKeyID]
+			if !ok {
+				return rotated, terror.ErrEncryptSecretKeyNotValid.Generatef("master key %s of relay log file %s not provided",
+					wrapped.MasterKeyID, filepath.Join(dir, file))
+			}
+			dataKey, err3 := oldKey.Unwrap(wrapped.DataKey)
+			if err3 != nil {
+				return rotated, err3
+			}
+			if wrapped.DataKey, err3 = newKey.Wrap(dataKey); err3 != nil {
+				return rotated, err3
+			}
+			wrapped.MasterKeyID = newKey.ID()
+			kr.Keys[file] = wrapped
+			rotated++
+		}
+		if err2 = writeRelayLogKeyring(dir, kr); err2 != nil {
+			return rotated, err2
+		}
+	}
+	return rotated, nil
+}
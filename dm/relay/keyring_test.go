@@ -0,0 +1,243 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/parser"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/binlog/event"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+var _ = Suite(&testKeyringSuite{})
+
+type testKeyringSuite struct{}
+
+func (t *testKeyringSuite) TestLocalMasterKey(c *C) {
+	_, err := NewLocalMasterKey("k1", []byte("invalid"))
+	c.Assert(terror.ErrEncryptSecretKeyNotValid.Equal(err), IsTrue)
+
+	mk, err := NewLocalMasterKey("k1", bytes.Repeat([]byte{0x01}, 32))
+	c.Assert(err, IsNil)
+	c.Assert(mk.ID(), Equals, "k1")
+	dataKey := bytes.Repeat([]byte{0x02}, relayLogDataKeyLen)
+	wrapped, err := mk.Wrap(dataKey)
+	c.Assert(err, IsNil)
+	c.Assert(bytes.Contains(wrapped, dataKey), IsFalse)
+	unwrapped, err := mk.Unwrap(wrapped)
+	c.Assert(err, IsNil)
+	c.Assert(unwrapped, DeepEquals, dataKey)
+
+	// wrong master key
+	mk2, err := NewLocalMasterKey("k1", bytes.Repeat([]byte{0x03}, 32))
+	c.Assert(err, IsNil)
+	_, err = mk2.Unwrap(wrapped)
+	c.Assert(terror.ErrCiphertextContextNotValid.Equal(err), IsTrue)
+	_, err = mk.Unwrap(wrapped[:3])
+	c.Assert(terror.ErrCiphertextLenNotValid.Equal(err), IsTrue)
+
+	// master key from the config
+	cfg := EncryptionConfig{}
+	c.Assert(cfg.enabled(), IsFalse)
+	keyFile := filepath.Join(c.MkDir(), "master.key")
+	c.Assert(os.WriteFile(keyFile, []byte("01010101010101010101010101010101\n"), 0o600), IsNil)
+	cfg = EncryptionConfig{MasterKeyID: "k1", MasterKeyFile: keyFile}
+	c.Assert(cfg.enabled(), IsTrue)
+	mk3, err := cfg.masterKey()
+	c.Assert(err, IsNil)
+	_, err = mk3.Unwrap(wrapped)
+	c.Assert(terror.ErrCiphertextContextNotValid.Equal(err), IsTrue) // the key file contains a 16 bytes key
+	cfg = EncryptionConfig{MasterKeyID: "k1", MasterKey: "not-hex"}
+	_, err = cfg.masterKey()
+	c.Assert(terror.ErrEncryptSecretKeyNotValid.Equal(err), IsTrue)
+}
+
+func (t *testKeyringSuite) TestEncryptKeyFromConfig(c *C) {
+	cfg := FromSourceCfg(&config.SourceConfig{RelayEncryptKey: "34343434343434343434343434343434"})
+	r := NewRealRelay(cfg).(*Relay)
+	c.Assert(r.keyErr, IsNil)
+
+	// the reader decrypts the relay log files with the key of the relay if not specified
+	br := r.NewReader(log.L(), &BinlogReaderConfig{RelayDir: c.MkDir(), Flavor: "mysql"})
+	key, err := br.relayLogKey("mysql-bin.000001")
+	c.Assert(err, IsNil)
+	c.Assert(key, DeepEquals, bytes.Repeat([]byte{0x34}, 16))
+	br = r.NewReader(log.L(), &BinlogReaderConfig{RelayDir: c.MkDir(), Flavor: "mysql", EncryptKey: bytes.Repeat([]byte{0x56}, 16)})
+	key, err = br.relayLogKey("mysql-bin.000001")
+	c.Assert(err, IsNil)
+	c.Assert(key, DeepEquals, bytes.Repeat([]byte{0x56}, 16))
+
+	// invalid key is reported in Init
+	r = NewRealRelay(&Config{Flavor: "mysql", RelayDir: c.MkDir(), Encryption: EncryptionConfig{EncryptKey: "3434"}}).(*Relay)
+	c.Assert(terror.ErrEncryptSecretKeyNotValid.Equal(r.Init(context.Background())), IsTrue)
+}
+
+func (t *testKeyringSuite) TestRotateRelayLogKeys(c *C) {
+	var (
+		relayDir = c.MkDir()
+		subDir   = filepath.Join(relayDir, "server-uuid.000001")
+		file1    = filepath.Join(subDir, "mysql-bin.000001")
+		file2    = filepath.Join(subDir, "mysql-bin.000002")
+	)
+	c.Assert(os.MkdirAll(subDir, 0o755), IsNil)
+	c.Assert(os.MkdirAll(filepath.Join(relayDir, "server-uuid.000002"), 0o755), IsNil)
+	old, err := NewLocalMasterKey("old", bytes.Repeat([]byte{0x01}, 16))
+	c.Assert(err, IsNil)
+	newKey, err := NewLocalMasterKey("new", bytes.Repeat([]byte{0x02}, 32))
+	c.Assert(err, IsNil)
+
+	dataKey1, err := newRelayLogDataKey(file1, old)
+	c.Assert(err, IsNil)
+	dataKey2, err := newRelayLogDataKey(file2, old)
+	c.Assert(err, IsNil)
+	c.Assert(dataKey1, Not(DeepEquals), dataKey2)
+
+	// not in the keyring
+	dataKey, err := loadRelayLogDataKey(localRelayStorage{}, filepath.Join(subDir, "mysql-bin.000003"), old)
+	c.Assert(err, IsNil)
+	c.Assert(dataKey, IsNil)
+
+	// the old key is not provided
+	_, err = RotateRelayLogKeys(relayDir, newKey)
+	c.Assert(terror.ErrEncryptSecretKeyNotValid.Equal(err), IsTrue)
+
+	rotated, err := RotateRelayLogKeys(relayDir, newKey, old)
+	c.Assert(err, IsNil)
+	c.Assert(rotated, Equals, 2)
+	_, err = loadRelayLogDataKey(localRelayStorage{}, file1, old)
+	c.Assert(terror.ErrEncryptSecretKeyNotValid.Equal(err), IsTrue)
+	dataKey, err = loadRelayLogDataKey(localRelayStorage{}, file1, newKey)
+	c.Assert(err, IsNil)
+	c.Assert(dataKey, DeepEquals, dataKey1)
+	dataKey, err = loadRelayLogDataKey(localRelayStorage{}, file2, newKey)
+	c.Assert(err, IsNil)
+	c.Assert(dataKey, DeepEquals, dataKey2)
+
+	// rotate again, nothing to do
+	rotated, err = RotateRelayLogKeys(relayDir, newKey)
+	c.Assert(err, IsNil)
+	c.Assert(rotated, Equals, 0)
+}
+
+func (t *testKeyringSuite) TestEncryptedFileWriter(c *C) {
+	var (
+		relayDir = c.MkDir()
+		uuid     = "3ccc475b-2343-11e7-be21-6c0b84d59f30.000001"
+		filename = "test-mysql-bin.000001"
+		fullName = filepath.Join(relayDir, uuid, filename)
+		header   = &replication.EventHeader{
+			Timestamp: uint32(time.Now().Unix()),
+			ServerID:  11,
+		}
+	)
+	c.Assert(os.MkdirAll(filepath.Join(relayDir, uuid), 0o755), IsNil)
+	mk, err := NewLocalMasterKey("k1", bytes.Repeat([]byte{0x01}, 32))
+	c.Assert(err, IsNil)
+
+	formatDescEv, err := event.GenFormatDescriptionEvent(header, 4)
+	c.Assert(err, IsNil)
+	queryEv, err := event.GenQueryEvent(header, formatDescEv.Header.LogPos, 0, 0, 0, nil, []byte("db"), []byte("CREATE DATABASE db"))
+	c.Assert(err, IsNil)
+
+	// compression is disabled for encrypted relay log files
+	w := NewFileWriter(log.L(), relayDir, SyncConfig{}, true, mk)
+	c.Assert(w.(*FileWriter).compressRotated, IsFalse)
+	w.Init(uuid, filename)
+	_, err = w.WriteEvent(formatDescEv)
+	c.Assert(err, IsNil)
+	_, err = w.WriteEvent(queryEv)
+	c.Assert(err, IsNil)
+	plainSize := int64(queryEv.Header.LogPos)
+	c.Assert(w.(*FileWriter).offset(), Equals, plainSize)
+	c.Assert(w.Close(), IsNil)
+
+	// the file is encrypted
+	data, err := os.ReadFile(fullName)
+	c.Assert(err, IsNil)
+	c.Assert(int64(len(data)), Equals, plainSize+encryptedRelayLogHeaderLen)
+	c.Assert(bytes.Contains(data, replication.BinLogFileHeader), IsFalse)
+	c.Assert(bytes.Contains(data, []byte("CREATE DATABASE")), IsFalse)
+
+	// continue writing the file, the written events are ignored
+	w = NewFileWriter(log.L(), relayDir, SyncConfig{}, false, mk)
+	w.Init(uuid, filename)
+	result, err := w.WriteEvent(formatDescEv)
+	c.Assert(err, IsNil)
+	c.Assert(result.Ignore, IsTrue)
+	result, err = w.WriteEvent(queryEv)
+	c.Assert(err, IsNil)
+	c.Assert(result.Ignore, IsTrue)
+	xidEv, err := event.GenXIDEvent(header, queryEv.Header.LogPos, 10)
+	c.Assert(err, IsNil)
+	result, err = w.WriteEvent(xidEv)
+	c.Assert(err, IsNil)
+	c.Assert(result.Ignore, IsFalse)
+	plainSize = int64(xidEv.Header.LogPos)
+	c.Assert(w.(*FileWriter).offset(), Equals, plainSize)
+	c.Assert(w.Close(), IsNil)
+
+	// read the events back with the data key
+	dataKey, err := loadRelayLogDataKey(localRelayStorage{}, fullName, mk)
+	c.Assert(err, IsNil)
+	f, _, err := openRelayLogFile(localRelayStorage{}, fullName, dataKey)
+	c.Assert(err, IsNil)
+	_, err = f.Seek(int64(len(replication.BinLogFileHeader)), io.SeekStart)
+	c.Assert(err, IsNil)
+	events := make([]*replication.BinlogEvent, 0, 3)
+	err = replication.NewBinlogParser().ParseReader(f, func(e *replication.BinlogEvent) error {
+		events = append(events, e)
+		return nil
+	})
+	c.Assert(err, IsNil)
+	c.Assert(f.Close(), IsNil)
+	c.Assert(events, HasLen, 3)
+	c.Assert(events[0].RawData, DeepEquals, formatDescEv.RawData)
+	c.Assert(events[1].RawData, DeepEquals, queryEv.RawData)
+	c.Assert(events[2].RawData, DeepEquals, xidEv.RawData)
+
+	// recover the file with a torn event
+	fd, err := os.OpenFile(fullName, os.O_WRONLY|os.O_APPEND, 0o600)
+	c.Assert(err, IsNil)
+	_, err = fd.Write([]byte("torn"))
+	c.Assert(err, IsNil)
+	c.Assert(fd.Close(), IsNil)
+	pos, _, err := getTxnPosGTIDs(context.Background(), fullName, parser.New(), dataKey)
+	c.Assert(err, IsNil)
+	c.Assert(pos, Equals, plainSize)
+	r := NewRealRelay(&Config{Flavor: "mysql", RelayDir: relayDir, Encryption: EncryptionConfig{KMS: mk}}).(*Relay)
+	result2, err := r.doRecovering(context.Background(), filepath.Join(relayDir, uuid), filename, parser.New())
+	c.Assert(err, IsNil)
+	c.Assert(result2.Truncated, IsTrue)
+	c.Assert(int64(result2.LatestPos.Pos), Equals, plainSize)
+	fi, err := os.Stat(fullName)
+	c.Assert(err, IsNil)
+	c.Assert(fi.Size(), Equals, plainSize+encryptedRelayLogHeaderLen)
+
+	// the reader decrypts the relay log files with the master key
+	br := r.NewReader(log.L(), &BinlogReaderConfig{RelayDir: relayDir, Flavor: "mysql"})
+	key, err := br.relayLogKey(fullName)
+	c.Assert(err, IsNil)
+	c.Assert(key, DeepEquals, dataKey)
+}
@@ -14,11 +14,13 @@
 package relay
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -29,6 +31,7 @@ import (
 	"github.com/pingcap/errors"
 	"go.uber.org/zap"
 
+	"github.com/pingcap/tiflow/dm/dm/config"
 	"github.com/pingcap/tiflow/dm/pkg/binlog"
 	"github.com/pingcap/tiflow/dm/pkg/binlog/event"
 	"github.com/pingcap/tiflow/dm/pkg/binlog/reader"
@@ -45,24 +48,69 @@ var ErrorMaybeDuplicateEvent = errors.New("truncate binlog file found, event may
 
 // BinlogReaderConfig is the configuration for BinlogReader.
 type BinlogReaderConfig struct {
+	// RelayDir is the local relay directory, or the URL of an external storage (like `s3://bucket/prefix` or
+	// `gcs://bucket/prefix`) where the relay directory is archived by another node. relay log files in the external
+	// storage are read with range reads and polled for appended data.
 	RelayDir string
 	Timezone *time.Location
 	Flavor   string
+	// PrefetchEvents is the max number of events buffered when parsing the next relay log file in advance,
+	// 0 means disable prefetching.
+	PrefetchEvents int
+	// EncryptKey is the AES key (16, 24 or 32 bytes) used to decrypt relay log files which are encrypted at rest,
+	// empty means relay log files are not encrypted.
+	EncryptKey []byte
+	// MasterKey is the master key used to unwrap the data keys of relay log files encrypted with envelope encryption,
+	// the data key of each relay log file is read from the keyring file in the sub directory. it overrides EncryptKey.
+	MasterKey MasterKey
+	// ChannelCapacity is the max number of events buffered in the streamer before they are consumed,
+	// 0 means using the default capacity.
+	ChannelCapacity int
+	// Task and SourceID are the task and the source which read the relay log, they're used as the labels of metrics.
+	Task     string
+	SourceID string
+	// RecoverTornEvent enables skipping the torn (half-written) event at the end of a relay log file when a newer
+	// relay log file has been generated, the torn event is often left by a crash and will never be completed.
+	// if it's false, the torn event in any relay log file except the newest one is reported as an error.
+	RecoverTornEvent bool
+	// EventsPerSecond and BytesPerSecond limit the rate of events sent to each streamer, 0 means unlimited.
+	// they can be changed by BinlogReader.SetRateLimit at runtime.
+	EventsPerSecond float64
+	BytesPerSecond  float64
+	// ArchiveURL is the URL of the external storage where the relay log files are archived by the relay, the relay log
+	// files purged from the local RelayDir are read from it. it's ignored if RelayDir is an external storage.
+	ArchiveURL string
+	// RebuildUUIDIndex enables rebuilding the server-uuid index file from the relay sub directories when it's
+	// missing or truncated, like after a partial restore of the relay directory. it's ignored for external storages.
+	RebuildUUIDIndex bool
+	// MaxEventSize is the max size of an event read from the relay log, 0 means unlimited. the larger events are
+	// handled by MaxEventSizePolicy before they're read into memory, see eventSizeGuard.
+	MaxEventSize       int64
+	MaxEventSizePolicy config.MaxEventSizePolicy
 }
 
 // BinlogReader is a binlog reader.
+// StartSyncByPos and StartSyncByGTID can be called multiple times, streamers started after the first one are
+// served by child readers which share the configuration and UUID index cache with this reader.
 type BinlogReader struct {
 	cfg    *BinlogReaderConfig
 	parser *replication.BinlogParser
 
-	indexPath string   // relay server-uuid index file path
-	uuids     []string // master UUIDs (relay sub dir)
+	storage   relayStorage    // where the relay log files are read from, shared with child readers
+	baseDir   string          // relay dir in storage
+	indexPath string          // relay server-uuid index file path
+	uuidCache *uuidIndexCache // shared with child readers
+	fileIndex *relayFileIndex // shared with child readers
+	uuids     []string        // master UUIDs (relay sub dir)
 
 	latestServerID uint32 // latest server ID, got from relay log
 
-	running bool
-	wg      sync.WaitGroup
-	cancel  context.CancelFunc
+	mu       sync.Mutex // protects running, children and eventFilter
+	running  bool
+	children []*BinlogReader
+	isChild  bool // child readers share the metrics with their parent reader
+	wg       sync.WaitGroup
+	cancel   context.CancelFunc
 
 	tctx *tcontext.Context
 
@@ -75,35 +123,229 @@ type BinlogReader struct {
 	currentUUID string // current UUID(with suffix)
 
 	lastFileGracefulEnd bool
+
+	prefetcher *filePrefetcher // prefetcher for the next relay log file
+
+	progress readerProgress
+	metrics  *readerMetrics
+	pause    *pauseController // shared with child readers
+
+	// eventFilter decides whether an event should be sent to the streamer, nil means sending all events.
+	eventFilter func(*replication.BinlogEvent) bool
+	limiter     *eventRateLimiter
+
+	payloadDecoder *transactionPayloadDecoder
+	sizeGuard      *eventSizeGuard // nil if the event size is not limited
 }
 
 // newBinlogReader creates a new BinlogReader.
 func newBinlogReader(logger log.Logger, cfg *BinlogReaderConfig, relay Process) *BinlogReader {
 	ctx, cancel := context.WithCancel(context.Background()) // only can be canceled in `Close`
-	parser := replication.NewBinlogParser()
-	parser.SetVerifyChecksum(true)
-	// use string representation of decimal, to replicate the exact value
-	parser.SetUseDecimal(false)
-	if cfg.Timezone != nil {
-		parser.SetTimestampStringLocation(cfg.Timezone)
-	}
-
 	newtctx := tcontext.NewContext(ctx, logger.WithFields(zap.String("component", "binlog reader")))
+	storage, baseDir := newRelayStorage(ctx, cfg.RelayDir, cfg.ArchiveURL)
+	return newBinlogReaderWithCache(newtctx, cancel, cfg, relay, storage, baseDir, &uuidIndexCache{}, newRelayFileIndex())
+}
+
+// offlineRelay is used by the readers of relay directories which are not written by a running relay,
+// only the methods used by BinlogReader are implemented, the others are from a nil Relay and must not be called.
+type offlineRelay struct {
+	*Relay
+}
+
+// IsActive implements Process.IsActive, no relay log file is active.
+func (offlineRelay) IsActive(uuid, filename string) (bool, int64) { return false, 0 }
+
+// RegisterListener implements Process.RegisterListener.
+func (offlineRelay) RegisterListener(el Listener) {}
 
+// UnRegisterListener implements Process.UnRegisterListener.
+func (offlineRelay) UnRegisterListener(el Listener) {}
+
+// NewOfflineReader creates a BinlogReader which reads a relay directory not written by a running relay,
+// like a relay directory copied from another node. the reader waits for new events after reaching the end
+// of the relay log files, so use GetEventWithTimeout of the streamer to stop reading.
+func NewOfflineReader(logger log.Logger, cfg *BinlogReaderConfig) *BinlogReader {
+	return newBinlogReader(logger, cfg, offlineRelay{})
+}
+
+func newBinlogReaderWithCache(
+	tctx *tcontext.Context,
+	cancel context.CancelFunc,
+	cfg *BinlogReaderConfig,
+	relay Process,
+	storage relayStorage,
+	baseDir string,
+	uuidCache *uuidIndexCache,
+	fileIndex *relayFileIndex,
+) *BinlogReader {
 	binlogReader := &BinlogReader{
 		cfg:                 cfg,
-		parser:              parser,
-		indexPath:           path.Join(cfg.RelayDir, utils.UUIDIndexFilename),
+		parser:              newRelayLogParser(cfg),
+		storage:             storage,
+		baseDir:             baseDir,
+		indexPath:           path.Join(baseDir, utils.UUIDIndexFilename),
+		uuidCache:           uuidCache,
+		fileIndex:           fileIndex,
 		cancel:              cancel,
-		tctx:                newtctx,
+		tctx:                tctx,
 		notifyCh:            make(chan interface{}, 1),
 		relay:               relay,
 		lastFileGracefulEnd: true,
+		metrics:             newReaderMetrics(cfg.Task, cfg.SourceID),
+		pause:               &pauseController{},
+		limiter:             newEventRateLimiter(cfg.EventsPerSecond, cfg.BytesPerSecond),
+		payloadDecoder:      newTransactionPayloadDecoder(cfg),
+		sizeGuard:           newEventSizeGuard(cfg, tctx.L()),
 	}
 	binlogReader.relay.RegisterListener(binlogReader)
 	return binlogReader
 }
 
+// startChild starts another streamer by `start` with a new reader which shares the configuration, storage,
+// UUID index cache and relay file index with r, the new reader will be closed when r is closed.
+func (r *BinlogReader) startChild(start func(child *BinlogReader) (reader.Streamer, error)) (reader.Streamer, error) {
+	ctx, cancel := context.WithCancel(r.tctx.Context())
+	child := newBinlogReaderWithCache(r.tctx.WithContext(ctx), cancel, r.cfg, r.relay, r.storage, r.baseDir, r.uuidCache, r.fileIndex)
+	child.isChild = true
+	child.pause = r.pause
+	child.eventFilter = r.eventFilter
+	child.limiter.setLimit(r.limiter.limits())
+	s, err := start(child)
+	if err != nil {
+		child.Close()
+		return nil, err
+	}
+	r.children = append(r.children, child)
+	return s, nil
+}
+
+// uuidIndexCache caches the UUIDs parsed from the relay server-uuid index file,
+// the file is only re-parsed when its size or modification time changed.
+// the index file in a remote storage is not cached, as it's small and getting its size costs a request too.
+type uuidIndexCache struct {
+	mu      sync.Mutex
+	size    int64
+	modTime time.Time
+	uuids   []string
+}
+
+// get returns a copy of the UUIDs in the index file.
+func (c *uuidIndexCache) get(s relayStorage, indexPath string) ([]string, error) {
+	if s.remote() {
+		if !s.exists(indexPath) {
+			return nil, nil
+		}
+		data, err := s.readFile(indexPath)
+		if err != nil {
+			return nil, terror.ErrRelayParseUUIDIndex.Delegate(err)
+		}
+		return utils.ParseUUIDIndexFromReader(bytes.NewReader(data))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	fi, err := os.Stat(indexPath)
+	if err == nil && c.uuids != nil && fi.Size() == c.size && fi.ModTime().Equal(c.modTime) {
+		return append([]string(nil), c.uuids...), nil
+	}
+
+	uuids, err := utils.ParseUUIDIndex(indexPath)
+	if err != nil {
+		return nil, err
+	}
+	c.uuids = nil // not cache it if the index file not exist
+	if fi != nil && uuids != nil {
+		c.size, c.modTime = fi.Size(), fi.ModTime()
+		c.uuids = append([]string(nil), uuids...)
+	}
+	return uuids, nil
+}
+
+// pauseController pauses and resumes the parsing of readers.
+type pauseController struct {
+	mu       sync.Mutex
+	paused   bool
+	resumeCh chan struct{} // closed when resumed
+}
+
+// pause pauses the parsing, returns false if it has already been paused.
+func (p *pauseController) pause() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.paused {
+		return false
+	}
+	p.paused = true
+	p.resumeCh = make(chan struct{})
+	return true
+}
+
+// resume resumes the parsing, returns false if it's not paused.
+func (p *pauseController) resume() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.paused {
+		return false
+	}
+	p.paused = false
+	close(p.resumeCh)
+	return true
+}
+
+// isPaused returns whether the parsing is paused.
+func (p *pauseController) isPaused() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.paused
+}
+
+// resumed returns a channel which is closed when resumed, or nil if it's not paused.
+func (p *pauseController) resumed() <-chan struct{} {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.paused {
+		return nil
+	}
+	return p.resumeCh
+}
+
+// wait blocks until resumed or the context is done.
+func (p *pauseController) wait(ctx context.Context) error {
+	ch := p.resumed()
+	if ch == nil {
+		return nil
+	}
+
+	select {
+	case <-ch:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// newRelayLogParser creates a binlog parser to parse relay log files.
+func newRelayLogParser(cfg *BinlogReaderConfig) *replication.BinlogParser {
+	parser := replication.NewBinlogParser()
+	parser.SetVerifyChecksum(true)
+	// use string representation of decimal, to replicate the exact value
+	parser.SetUseDecimal(false)
+	if cfg.Timezone != nil {
+		parser.SetTimestampStringLocation(cfg.Timezone)
+	}
+	return parser
+}
+
+// relayLogKey returns the key used to decrypt the relay log file, nil if it's not encrypted.
+func (r *BinlogReader) relayLogKey(fullPath string) ([]byte, error) {
+	if r.cfg.MasterKey == nil {
+		return r.cfg.EncryptKey, nil
+	}
+	// the data key is kept for the uncompressed file name
+	return loadRelayLogDataKey(r.storage, strings.TrimSuffix(fullPath, compressedRelayLogExt), r.cfg.MasterKey)
+}
+
 // checkRelayPos will check whether the given relay pos is too big.
 func (r *BinlogReader) checkRelayPos(pos mysql.Position) error {
 	currentUUID, _, realPos, err := binlog.ExtractPos(pos, r.uuids)
@@ -111,13 +353,21 @@ func (r *BinlogReader) checkRelayPos(pos mysql.Position) error {
 		return terror.Annotatef(err, "parse relay dir with pos %s", pos)
 	}
 	pos = realPos
-	relayFilepath := path.Join(r.cfg.RelayDir, currentUUID, pos.Name)
+	relayFilepath, compressed := resolveRelayLogFile(r.storage, path.Join(r.baseDir, currentUUID, pos.Name))
 	r.tctx.L().Info("start to check relay log file", zap.String("path", relayFilepath), zap.Stringer("position", pos))
-	fi, err := os.Stat(relayFilepath)
+	size, err := r.storage.size(relayFilepath)
 	if err != nil {
 		return terror.ErrGetRelayLogStat.Delegate(err, relayFilepath)
 	}
-	if fi.Size() < int64(pos.Pos) {
+	key, err := r.relayLogKey(relayFilepath)
+	if err != nil {
+		return err
+	}
+	if len(key) > 0 {
+		size = plainRelayLogSize(size)
+	}
+	// we can't know the uncompressed size of a compressed file without decompressing it
+	if !compressed && size < int64(pos.Pos) {
 		return terror.ErrRelayLogGivenPosTooBig.Generate(pos)
 	}
 	return nil
@@ -125,48 +375,56 @@ func (r *BinlogReader) checkRelayPos(pos mysql.Position) error {
 
 // IsGTIDCoverPreviousFiles check whether gset contains file's previous_gset.
 func (r *BinlogReader) IsGTIDCoverPreviousFiles(ctx context.Context, filePath string, gset mysql.GTIDSet) (bool, error) {
-	fileReader := reader.NewFileReader(&reader.FileReaderConfig{Timezone: r.cfg.Timezone})
-	defer fileReader.Close()
-	err := fileReader.StartSyncByPos(mysql.Position{Name: filePath, Pos: binlog.FileHeaderLen})
+	key, err := r.relayLogKey(filePath)
 	if err != nil {
 		return false, err
 	}
+	f, _, err := openRelayLogFile(r.storage, filePath, key)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	if _, err = f.Seek(binlog.FileHeaderLen, io.SeekStart); err != nil {
+		return false, terror.ErrParserParseRelayLog.Delegate(err, filePath)
+	}
 
-	var gs gtid.Set
+	var (
+		parser = newRelayLogParser(r.cfg)
+		gs     gtid.Set
+		found  bool
+	)
+	onEvent := func(e *replication.BinlogEvent) error {
+		var err2 error
+		switch e.Header.EventType {
+		case replication.PREVIOUS_GTIDS_EVENT:
+			gs, err2 = event.GTIDsFromPreviousGTIDsEvent(e)
+		case replication.MARIADB_GTID_LIST_EVENT:
+			gs, err2 = event.GTIDsFromMariaDBGTIDListEvent(e)
+		default:
+			return nil
+		}
+		found = err2 == nil
+		return err2
+	}
 
-	for {
+	// Maybe we can only Parse the first three fakeRotate, Format_desc and Previous_gtids events.
+	for !found {
 		select {
 		case <-ctx.Done():
 			return false, nil
 		default:
 		}
 
-		ctx2, cancel := context.WithTimeout(ctx, time.Second)
-		e, err := fileReader.GetEvent(ctx2)
-		cancel()
+		eof, err := parser.ParseSingleEvent(f, onEvent)
 		if err != nil {
-			// reach end of file
-			// Maybe we can only Parse the first three fakeRotate, Format_desc and Previous_gtids events.
-			if terror.ErrReaderReachEndOfFile.Equal(err) {
-				return false, terror.ErrPreviousGTIDNotExist.Generate(filePath)
-			}
-			return false, err
-		}
-
-		switch {
-		case e.Header.EventType == replication.PREVIOUS_GTIDS_EVENT:
-			gs, err = event.GTIDsFromPreviousGTIDsEvent(e)
-		case e.Header.EventType == replication.MARIADB_GTID_LIST_EVENT:
-			gs, err = event.GTIDsFromMariaDBGTIDListEvent(e)
-		default:
-			continue
+			return false, terror.ErrParserParseRelayLog.Delegate(err, filePath)
 		}
-
-		if err != nil {
-			return false, err
+		if eof {
+			// reach end of file
+			return false, terror.ErrPreviousGTIDNotExist.Generate(filePath)
 		}
-		return gset.Contain(gs.Origin()), nil
 	}
+	return gset.Contain(gs.Origin()), nil
 }
 
 // getPosByGTID gets file position by gtid, result should be (filename, 4).
@@ -179,8 +437,8 @@ func (r *BinlogReader) getPosByGTID(gset mysql.GTIDSet) (*mysql.Position, error)
 			return nil, err
 		}
 
-		uuidDir := path.Join(r.cfg.RelayDir, uuid)
-		allFiles, err := CollectAllBinlogFiles(uuidDir)
+		uuidDir := path.Join(r.baseDir, uuid)
+		allFiles, err := collectBinlogFiles(r.storage, uuidDir)
 		if err != nil {
 			return nil, err
 		}
@@ -212,14 +470,90 @@ func (r *BinlogReader) getPosByGTID(gset mysql.GTIDSet) (*mysql.Position, error)
 	return nil, terror.ErrNoRelayPosMatchGTID.Generate(gset.String())
 }
 
+// SeekByTime gets the start position of the latest relay log file whose first event is not later than `ts`,
+// with UUID suffix added, result should be (filename, 4).
+// if `ts` is earlier than all relay log files, the start position of the earliest file is returned.
+// the timestamp of events are in seconds, so `ts` is truncated to seconds too.
+func (r *BinlogReader) SeekByTime(ts time.Time) (mysql.Position, error) {
+	if err := r.updateUUIDs(); err != nil {
+		return mysql.Position{}, err
+	}
+
+	type relayFile struct {
+		uuid     string
+		filename string
+	}
+	var files []relayFile
+	for _, uuid := range r.uuids {
+		allFiles, err := collectBinlogFiles(r.storage, path.Join(r.baseDir, uuid))
+		if err != nil {
+			return mysql.Position{}, err
+		}
+		for _, f := range allFiles {
+			files = append(files, relayFile{uuid: uuid, filename: f})
+		}
+	}
+	if len(files) == 0 {
+		return mysql.Position{}, terror.ErrBinlogFilesNotFound.Generate(r.cfg.RelayDir)
+	}
+
+	target := ts.Unix()
+	var searchErr error
+	// find the first file whose first event is later than `ts`, binlog files are in ascending time order.
+	idx := sort.Search(len(files), func(i int) bool {
+		if searchErr != nil {
+			return true
+		}
+		fullPath := path.Join(r.baseDir, files[i].uuid, files[i].filename)
+		key, err := r.relayLogKey(fullPath)
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		firstTS, exist, err := getFirstEventTimestamp(r.storage, fullPath, key)
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		// the newest file may have not been written yet, treat it as later than `ts`
+		return !exist || int64(firstTS) > target
+	})
+	if searchErr != nil {
+		return mysql.Position{}, searchErr
+	}
+	if idx > 0 {
+		idx--
+	}
+
+	_, suffix, err := utils.ParseSuffixForUUID(files[idx].uuid)
+	if err != nil {
+		return mysql.Position{}, err
+	}
+	fileName, err := binlog.ParseFilename(files[idx].filename)
+	if err != nil {
+		return mysql.Position{}, err
+	}
+	pos := mysql.Position{
+		Name: binlog.ConstructFilenameWithUUIDSuffix(fileName, utils.SuffixIntToStr(suffix)),
+		Pos:  binlog.FileHeaderLen,
+	}
+	r.tctx.L().Info("get pos by time", zap.Time("time", ts), zap.Stringer("position", pos))
+	return pos, nil
+}
+
 // StartSyncByPos start sync by pos
 // TODO:  thread-safe?
 func (r *BinlogReader) StartSyncByPos(pos mysql.Position) (reader.Streamer, error) {
 	if pos.Name == "" {
 		return nil, terror.ErrBinlogFileNotSpecified.Generate()
 	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	if r.running {
-		return nil, terror.ErrReaderAlreadyRunning.Generate()
+		r.tctx.L().Info("reader is running, start another streamer", zap.Stringer("position", pos))
+		return r.startChild(func(child *BinlogReader) (reader.Streamer, error) {
+			return child.StartSyncByPos(pos)
+		})
 	}
 
 	// load and update UUID list
@@ -233,33 +567,27 @@ func (r *BinlogReader) StartSyncByPos(pos mysql.Position) (reader.Streamer, erro
 		return nil, err
 	}
 
-	r.latestServerID = 0
-	r.running = true
-	s := newLocalStreamer()
-
-	r.wg.Add(1)
-	go func() {
-		defer r.wg.Done()
-		r.tctx.L().Info("start reading", zap.Stringer("position", pos))
-		err = r.parseRelay(r.tctx.Context(), s, pos)
-		if errors.Cause(err) == r.tctx.Context().Err() {
-			r.tctx.L().Warn("parse relay finished", log.ShortError(err))
-		} else if err != nil {
-			s.closeWithError(err)
-			r.tctx.L().Error("parse relay stopped", zap.Error(err))
-		}
-	}()
+	// reset GTID states which may be left by a previous sync in GTID mode.
+	r.usingGTID = false
+	r.prevGset = nil
+	r.currGset = nil
 
-	return s, nil
+	return r.startSync(pos), nil
 }
 
 // StartSyncByGTID start sync by gtid.
+// it locates the first relay log file which may contain events not included in `gset`, and starts from the
+// beginning of that file. events already contained in `gset` will be replaced by HEARTBEAT events.
 func (r *BinlogReader) StartSyncByGTID(gset mysql.GTIDSet) (reader.Streamer, error) {
 	r.tctx.L().Info("begin to sync binlog", zap.Stringer("GTID Set", gset))
-	r.usingGTID = true
 
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	if r.running {
-		return nil, terror.ErrReaderAlreadyRunning.Generate()
+		r.tctx.L().Info("reader is running, start another streamer", zap.Stringer("GTID Set", gset))
+		return r.startChild(func(child *BinlogReader) (reader.Streamer, error) {
+			return child.StartSyncByGTID(gset)
+		})
 	}
 
 	if err := r.updateUUIDs(); err != nil {
@@ -272,18 +600,31 @@ func (r *BinlogReader) StartSyncByGTID(gset mysql.GTIDSet) (reader.Streamer, err
 	}
 	r.tctx.L().Info("get pos by gtid", zap.Stringer("GTID Set", gset), zap.Stringer("Position", pos))
 
+	r.usingGTID = true
 	r.prevGset = gset
 	r.currGset = nil
 
+	return r.startSync(*pos), nil
+}
+
+// startSync starts a goroutine to parse relay log from the given (UUID-suffixed) position.
+// callers should have checked the position and prepared the GTID states.
+func (r *BinlogReader) startSync(pos mysql.Position) *LocalStreamer {
 	r.latestServerID = 0
 	r.running = true
-	s := newLocalStreamer()
+	r.resetProgress()
+	capacity := r.cfg.ChannelCapacity
+	if capacity <= 0 {
+		capacity = defaultLocalStreamerChannelCapacity
+	}
+	s := newLocalStreamerWithCapacity(capacity)
+	s.pause = r.pause
 
 	r.wg.Add(1)
 	go func() {
 		defer r.wg.Done()
 		r.tctx.L().Info("start reading", zap.Stringer("position", pos))
-		err = r.parseRelay(r.tctx.Context(), s, *pos)
+		err := r.parseRelay(r.tctx.Context(), s, pos)
 		if errors.Cause(err) == r.tctx.Context().Err() {
 			r.tctx.L().Warn("parse relay finished", log.ShortError(err))
 		} else if err != nil {
@@ -292,7 +633,23 @@ func (r *BinlogReader) StartSyncByGTID(gset mysql.GTIDSet) (reader.Streamer, err
 		}
 	}()
 
-	return s, nil
+	return s
+}
+
+// sendEvent sends the event to the streamer, it blocks until the event is sent or the context is done.
+// the time blocked by the full channel and the channel occupancy are reported as metrics.
+func (r *BinlogReader) sendEvent(ctx context.Context, s *LocalStreamer, e *replication.BinlogEvent) {
+	select {
+	case s.ch <- e:
+	default:
+		start := time.Now()
+		select {
+		case s.ch <- e:
+		case <-ctx.Done():
+		}
+		r.metrics.blockedSendDuration.Observe(time.Since(start).Seconds())
+	}
+	r.metrics.channelOccupancy.Set(float64(len(s.ch)))
 }
 
 // SwitchPath represents next binlog file path which should be switched.
@@ -342,19 +699,19 @@ func (r *BinlogReader) parseRelay(ctx context.Context, s *LocalStreamer, pos mys
 
 		// when switching subdirectory, last binlog file may contain unfinished transaction, so we send a notification.
 		if !r.lastFileGracefulEnd {
-			s.ch <- &replication.BinlogEvent{
+			r.sendEvent(ctx, s, &replication.BinlogEvent{
 				RawData: []byte(ErrorMaybeDuplicateEvent.Error()),
 				Header: &replication.EventHeader{
 					EventType: replication.IGNORABLE_EVENT,
 				},
-			}
+			})
 		}
 	}
 }
 
 func (r *BinlogReader) getSwitchPath() (*SwitchPath, error) {
 	// reload uuid
-	uuids, err := utils.ParseUUIDIndex(r.indexPath)
+	uuids, err := r.uuidCache.get(r.storage, r.indexPath)
 	if err != nil {
 		return nil, err
 	}
@@ -367,7 +724,7 @@ func (r *BinlogReader) getSwitchPath() (*SwitchPath, error) {
 	}
 
 	// try to get the first binlog file in next subdirectory
-	nextBinlogName, err := getFirstBinlogName(r.cfg.RelayDir, nextUUID)
+	nextBinlogName, err := getFirstBinlogName(r.storage, r.baseDir, nextUUID)
 	if err != nil {
 		// because creating subdirectory and writing relay log file are not atomic
 		if terror.ErrBinlogFilesNotFound.Equal(err) {
@@ -382,8 +739,9 @@ func (r *BinlogReader) getSwitchPath() (*SwitchPath, error) {
 // parseDirAsPossible parses relay sub directory as far as possible.
 func (r *BinlogReader) parseDirAsPossible(ctx context.Context, s *LocalStreamer, pos mysql.Position) (needSwitch bool, err error) {
 	firstParse := true // the first parse time for the relay log file
-	dir := path.Join(r.cfg.RelayDir, r.currentUUID)
+	dir := path.Join(r.baseDir, r.currentUUID)
 	r.tctx.L().Info("start to parse relay log files in sub directory", zap.String("directory", dir), zap.Stringer("position", pos))
+	defer r.closePrefetcher()
 
 	for {
 		select {
@@ -391,7 +749,7 @@ func (r *BinlogReader) parseDirAsPossible(ctx context.Context, s *LocalStreamer,
 			return false, ctx.Err()
 		default:
 		}
-		files, err := CollectBinlogFilesCmp(dir, pos.Name, FileCmpBiggerEqual)
+		files, err := r.fileIndex.filesFrom(r.storage, dir, pos.Name)
 		if err != nil {
 			return false, terror.Annotatef(err, "parse relay dir %s with pos %s", dir, pos)
 		} else if len(files) == 0 {
@@ -424,6 +782,10 @@ func (r *BinlogReader) parseDirAsPossible(ctx context.Context, s *LocalStreamer,
 				offset = binlog.FileHeaderLen // for other relay log file, start parse from 4
 				firstParse = true             // new relay log file need to parse
 			}
+			// only prefetch files before the last one, they will not be written anymore
+			if r.cfg.PrefetchEvents > 0 && i+1 < len(files)-1 {
+				r.startPrefetcher(ctx, filepath.Join(dir, files[i+1]))
+			}
 			needSwitch, latestPos, err = r.parseFileAsPossible(ctx, s, relayLogFile, offset, dir, firstParse, i == len(files)-1)
 			if err != nil {
 				return false, terror.Annotatef(err, "parse relay log file %s from offset %d in dir %s", relayLogFile, offset, dir)
@@ -448,22 +810,36 @@ type binlogFileParseState struct {
 	fullPath                  string
 	relayLogFile, relayLogDir string
 
-	f *os.File
+	f          relayLogFile
+	compressed bool // compressed relay log file, it will not be written anymore
+	encrypted  bool // encrypted relay log file, its size is larger than the plain one
+
+	// prefetcher of this file, only set if the file is parsed from the beginning
+	prefetcher *filePrefetcher
 
 	// states may change
 	replaceWithHeartbeat bool
 	formatDescEventRead  bool
 	latestPos            int64
+	// tornEventPos is the start position of the incomplete event met in the latest parsing, 0 if not met
+	tornEventPos int64
+	// tornEventRechecked is true if the file has been parsed again to confirm the torn event before it's skipped
+	tornEventRechecked bool
 }
 
 // parseFileAsPossible parses single relay log file as far as possible.
 func (r *BinlogReader) parseFileAsPossible(ctx context.Context, s *LocalStreamer, relayLogFile string, offset int64, relayLogDir string, firstParse bool, possibleLast bool) (bool, int64, error) {
 	r.tctx.L().Debug("start to parse relay log file", zap.String("file", relayLogFile), zap.Int64("position", offset), zap.String("directory", relayLogDir))
 
+	r.onFileProgress(filepath.Base(relayLogDir), relayLogFile, offset)
 	fullPath := filepath.Join(relayLogDir, relayLogFile)
-	f, err := os.Open(fullPath)
+	key, err := r.relayLogKey(fullPath)
+	if err != nil {
+		return false, 0, err
+	}
+	f, compressed, err := openRelayLogFile(r.storage, fullPath, key)
 	if err != nil {
-		return false, 0, errors.Trace(err)
+		return false, 0, err
 	}
 	defer f.Close()
 
@@ -473,9 +849,16 @@ func (r *BinlogReader) parseFileAsPossible(ctx context.Context, s *LocalStreamer
 		relayLogFile:         relayLogFile,
 		relayLogDir:          relayLogDir,
 		f:                    f,
+		compressed:           compressed,
+		encrypted:            len(key) > 0,
 		latestPos:            offset,
 		replaceWithHeartbeat: false,
 	}
+	if r.prefetcher != nil && r.prefetcher.fullPath == fullPath && offset == binlog.FileHeaderLen {
+		state.prefetcher = r.prefetcher
+		r.prefetcher = nil
+		defer state.prefetcher.close()
+	}
 
 	for {
 		select {
@@ -483,6 +866,9 @@ func (r *BinlogReader) parseFileAsPossible(ctx context.Context, s *LocalStreamer
 			return false, 0, ctx.Err()
 		default:
 		}
+		if err = r.pause.wait(ctx); err != nil {
+			return false, 0, err
+		}
 		needSwitch, needReParse, err := r.parseFile(ctx, s, firstParse, state)
 		if err != nil {
 			return false, 0, terror.Annotatef(err, "parse relay log file %s from offset %d in dir %s", relayLogFile, state.latestPos, relayLogDir)
@@ -511,14 +897,26 @@ func (r *BinlogReader) parseFile(
 	offset := state.latestPos
 	r.lastFileGracefulEnd = false
 
-	onEventFunc := func(e *replication.BinlogEvent) error {
+	var onEventFunc func(e *replication.BinlogEvent) error
+	onEventFunc = func(e *replication.BinlogEvent) error {
 		if ce := r.tctx.L().Check(zap.DebugLevel, ""); ce != nil {
 			r.tctx.L().Debug("read event", zap.Reflect("header", e.Header))
 		}
 		r.latestServerID = e.Header.ServerID // record server_id
+		r.sizeGuard.observe(e)
+
+		if r.prevGset != nil && e.Header.Flags&replication.LOG_EVENT_RELAY_LOG_F != 0 {
+			// dummy event filling a hole
+			if err2 := r.mergeGapGtidSet(state, e); err2 != nil {
+				return err2
+			}
+		}
 
 		switch ev := e.Event.(type) {
 		case *replication.FormatDescriptionEvent:
+			if err2 := r.payloadDecoder.setFormatDescription(e); err2 != nil {
+				return err2
+			}
 			state.formatDescEventRead = true
 			state.latestPos = int64(e.Header.LogPos)
 		case *replication.RotateEvent:
@@ -597,12 +995,40 @@ func (r *BinlogReader) parseFile(
 				// replace with heartbeat event
 				e = event.GenHeartbeatEvent(e.Header)
 			default:
+				if e.Header.EventType == event.TransactionPayloadEvent {
+					// the whole transaction is replaced, no need to unpack it
+					e = event.GenHeartbeatEvent(e.Header)
+				}
 			}
 		}
 
-		select {
-		case s.ch <- e:
-		case <-ctx.Done():
+		// the TransactionPayloadEvent is sent before its inner events, so the callers can know the inner events
+		// are wrapped in it and have the same log position.
+		var innerEvents []*replication.BinlogEvent
+		if e.Header.EventType == event.TransactionPayloadEvent {
+			var err2 error
+			if innerEvents, err2 = r.payloadDecoder.decode(e); err2 != nil {
+				return err2
+			}
+		}
+
+		if r.keepEvent(e) {
+			if err2 := r.pause.wait(ctx); err2 != nil {
+				return err2
+			}
+			if err2 := r.limiter.wait(ctx, len(e.RawData)); err2 != nil {
+				return err2
+			}
+			r.sendEvent(ctx, s, e)
+			r.onEventProgress(state.latestPos)
+		} else {
+			r.onFilteredEventProgress(state.latestPos)
+		}
+
+		for _, inner := range innerEvents {
+			if err2 := onEventFunc(inner); err2 != nil {
+				return err2
+			}
 		}
 		return nil
 	}
@@ -635,13 +1061,42 @@ func (r *BinlogReader) parseFile(
 		state.formatDescEventRead = true
 	}
 
+	if state.prefetcher != nil {
+		err = state.prefetcher.consume(ctx, onEventFunc)
+		// the prefetcher can only be used once, if we need to re-parse the file, we parse it by ourselves,
+		// and the parser needs to read the FORMAT DESCRIPTION event again.
+		state.prefetcher = nil
+		state.formatDescEventRead = false
+		if err != nil {
+			if r.cfg.RecoverTornEvent && isIgnorableParseError(err) {
+				return false, true, nil // parse the torn event by ourselves
+			}
+			return false, false, err
+		}
+		r.tctx.L().Debug("parse prefetched relay log file", zap.String("file", state.fullPath), zap.Int64("offset", state.latestPos))
+		return r.waitBinlogChanged(ctx, state)
+	}
+
 	// we need to seek explicitly, as parser may read in-complete event and return error(ignorable) last time
 	// and offset may be messed up
 	if _, err = state.f.Seek(offset, io.SeekStart); err != nil {
 		return false, false, terror.ErrParserParseRelayLog.Delegate(err, state.fullPath)
 	}
 
-	err = r.parser.ParseReader(state.f, onEventFunc)
+	err = r.parser.ParseReader(r.sizeGuard.wrap(state.f), onEventFunc)
+	if err != nil && isIgnorableParseError(err) {
+		if state.tornEventPos != state.latestPos {
+			state.tornEventPos, state.tornEventRechecked = state.latestPos, false
+		}
+		if !state.possibleLast && r.cfg.RecoverTornEvent {
+			if r.skipTornEvent(state) {
+				return false, false, nil
+			}
+			return false, true, nil // parse again to confirm the torn event
+		}
+	} else {
+		state.tornEventPos, state.tornEventRechecked = 0, false
+	}
 	if err != nil && (!state.possibleLast || !isIgnorableParseError(err)) {
 		r.tctx.L().Error("parse relay log file", zap.String("file", state.fullPath), zap.Int64("offset", offset), zap.Error(err))
 		return false, false, terror.ErrParserParseRelayLog.Delegate(err, state.fullPath)
@@ -652,13 +1107,16 @@ func (r *BinlogReader) parseFile(
 }
 
 func (r *BinlogReader) waitBinlogChanged(ctx context.Context, state *binlogFileParseState) (needSwitch, needReParse bool, err error) {
-	active, relayOffset := r.relay.IsActive(r.currentUUID, state.relayLogFile)
+	active, relayOffset := r.isRelayActive(state.relayLogFile)
 	if active && relayOffset > state.latestPos {
 		return false, true, nil
 	}
 	if !active {
 		meta := &LocalMeta{}
-		_, err := toml.DecodeFile(filepath.Join(state.relayLogDir, utils.MetaFilename), meta)
+		data, err := r.storage.readFile(path.Join(state.relayLogDir, utils.MetaFilename))
+		if err == nil {
+			_, err = toml.Decode(string(data), meta)
+		}
 		if err != nil {
 			return false, false, terror.Annotate(err, "decode relay meta toml file failed")
 		}
@@ -669,16 +1127,17 @@ func (r *BinlogReader) waitBinlogChanged(ctx context.Context, state *binlogFileP
 		// will find the right one
 		if meta.BinLogName != state.relayLogFile {
 			// we need check file size again, as the file may have been changed during our metafile check
-			cmp, err2 := fileSizeUpdated(state.fullPath, state.latestPos)
+			cmp, err2 := r.fileSizeUpdated(state)
 			if err2 != nil {
 				return false, false, terror.Annotatef(err2, "latestFilePath=%s endOffset=%d", state.fullPath, state.latestPos)
 			}
 			switch {
 			case cmp < 0:
 				return false, false, terror.ErrRelayLogFileSizeSmaller.Generate(state.fullPath)
-			case cmp > 0:
+			case cmp > 0 && !r.skipTornEvent(state):
 				return false, true, nil
 			default:
+				r.fileIndex.expect(r.storage, state.relayLogDir, meta.BinLogName)
 				nextFilePath := filepath.Join(state.relayLogDir, meta.BinLogName)
 				log.L().Info("newer relay log file is already generated",
 					zap.String("now file path", state.fullPath),
@@ -694,14 +1153,14 @@ func (r *BinlogReader) waitBinlogChanged(ctx context.Context, state *binlogFileP
 		}
 		if switchPath != nil {
 			// we need check file size again, as the file may have been changed during path check
-			cmp, err := fileSizeUpdated(state.fullPath, state.latestPos)
+			cmp, err := r.fileSizeUpdated(state)
 			if err != nil {
 				return false, false, terror.Annotatef(err, "latestFilePath=%s endOffset=%d", state.fullPath, state.latestPos)
 			}
 			switch {
 			case cmp < 0:
 				return false, false, terror.ErrRelayLogFileSizeSmaller.Generate(state.fullPath)
-			case cmp > 0:
+			case cmp > 0 && !r.skipTornEvent(state):
 				return false, true, nil
 			default:
 				log.L().Info("newer relay uuid path is already generated",
@@ -712,12 +1171,21 @@ func (r *BinlogReader) waitBinlogChanged(ctx context.Context, state *binlogFileP
 		}
 	}
 
+	// the relay which writes the relay log files in a remote storage can't notify us, so we poll them
+	var pollCh <-chan time.Time
+	if r.storage.remote() {
+		ticker := time.NewTicker(remoteRelayPollInterval)
+		defer ticker.Stop()
+		pollCh = ticker.C
+	}
 	for {
 		select {
 		case <-ctx.Done():
 			return false, false, nil
+		case <-pollCh:
+			return false, true, nil
 		case <-r.Notified():
-			active, relayOffset = r.relay.IsActive(r.currentUUID, state.relayLogFile)
+			active, relayOffset = r.isRelayActive(state.relayLogFile)
 			if active {
 				if relayOffset > state.latestPos {
 					return false, true, nil
@@ -731,6 +1199,50 @@ func (r *BinlogReader) waitBinlogChanged(ctx context.Context, state *binlogFileP
 	}
 }
 
+// isRelayActive returns whether the relay log file in the current sub directory is being written by the relay
+// of this node, and the offset written. relay log files in a remote storage are never active.
+func (r *BinlogReader) isRelayActive(relayLogFile string) (bool, int64) {
+	if r.storage.remote() {
+		return false, 0
+	}
+	return r.relay.IsActive(r.currentUUID, relayLogFile)
+}
+
+// skipTornEvent returns true if the torn event at the end of the relay log file which will not be written anymore
+// can be skipped, the events before it are kept and state.latestPos is the safe position of the file.
+// the torn event is skipped only if it's still incomplete after parsing the file again, because the writer may
+// be finishing the last event when a newer file is generated.
+func (r *BinlogReader) skipTornEvent(state *binlogFileParseState) bool {
+	if !r.cfg.RecoverTornEvent || state.tornEventPos == 0 || state.tornEventPos != state.latestPos {
+		return false
+	}
+	if !state.tornEventRechecked {
+		state.tornEventRechecked = true
+		return false
+	}
+	r.tctx.L().Warn("skip the torn event at the end of relay log file",
+		zap.String("file", state.fullPath), zap.Int64("safe position", state.latestPos))
+	return true
+}
+
+// fileSizeUpdated checks whether the relay log file's size has updated, see fileSizeUpdated.
+func (r *BinlogReader) fileSizeUpdated(s *binlogFileParseState) (int, error) {
+	if s.compressed {
+		// compressed file is complete, and we only compare the latest position with its uncompressed size.
+		return 0, nil
+	}
+	latestSize := s.latestPos
+	if s.encrypted {
+		latestSize += encryptedRelayLogHeaderLen
+	}
+	cmp, err := fileSizeUpdated(r.storage, s.fullPath, latestSize)
+	if err != nil && !r.storage.exists(s.fullPath) && r.storage.exists(s.fullPath+compressedRelayLogExt) {
+		// the relay log file has been compressed after rotated, but the opened one can still be read to the end
+		return openedFileSizeUpdated(s.f, latestSize)
+	}
+	return cmp, err
+}
+
 func (r *BinlogReader) parseFormatDescEvent(state *binlogFileParseState) error {
 	// FORMAT_DESCRIPTION event should always be read by default (despite that fact passed offset may be higher than 4)
 	if _, err := state.f.Seek(binlog.FileHeaderLen, io.SeekStart); err != nil {
@@ -739,7 +1251,8 @@ func (r *BinlogReader) parseFormatDescEvent(state *binlogFileParseState) error {
 
 	onEvent := func(e *replication.BinlogEvent) error {
 		if _, ok := e.Event.(*replication.FormatDescriptionEvent); ok {
-			return nil
+			r.sizeGuard.observe(e)
+			return r.payloadDecoder.setFormatDescription(e)
 		}
 		// the first event in binlog file must be FORMAT_DESCRIPTION event.
 		return errors.New("corrupted binlog file")
@@ -756,26 +1269,150 @@ func (r *BinlogReader) parseFormatDescEvent(state *binlogFileParseState) error {
 	return nil
 }
 
+// startPrefetcher starts to prefetch the relay log file if it's not being prefetched.
+func (r *BinlogReader) startPrefetcher(ctx context.Context, fullPath string) {
+	if r.prefetcher != nil {
+		if r.prefetcher.fullPath == fullPath {
+			return
+		}
+		r.closePrefetcher()
+	}
+	key, err := r.relayLogKey(fullPath)
+	if err != nil {
+		// the error will be reported when the relay log file is parsed
+		r.tctx.L().Warn("fail to get the key of relay log file, skip prefetching", zap.String("file", fullPath), zap.Error(err))
+		return
+	}
+	r.tctx.L().Debug("start to prefetch relay log file", zap.String("file", fullPath))
+	r.prefetcher = newFilePrefetcher(ctx, r.storage, fullPath, r.cfg.PrefetchEvents, key, r.pause, newEventSizeGuard(r.cfg, r.tctx.L()), func() *replication.BinlogParser {
+		return newRelayLogParser(r.cfg)
+	})
+}
+
+// closePrefetcher closes the prefetcher which has not been consumed.
+func (r *BinlogReader) closePrefetcher() {
+	if r.prefetcher != nil {
+		r.prefetcher.close()
+		r.prefetcher = nil
+	}
+}
+
 // updateUUIDs re-parses UUID index file and updates UUID list.
 func (r *BinlogReader) updateUUIDs() error {
-	uuids, err := utils.ParseUUIDIndex(r.indexPath)
+	uuids, err := r.uuidCache.get(r.storage, r.indexPath)
 	if err != nil {
 		return terror.Annotatef(err, "index file path %s", r.indexPath)
 	}
+	if r.cfg.RebuildUUIDIndex && !r.storage.remote() {
+		if uuids, err = r.rebuildUUIDIndexIfNeeded(uuids); err != nil {
+			return err
+		}
+	}
 	oldUUIDs := r.uuids
 	r.uuids = uuids
 	r.tctx.L().Info("update relay UUIDs", zap.Strings("old uuids", oldUUIDs), zap.Strings("uuids", uuids))
 	return nil
 }
 
-// Close closes BinlogReader.
+// rebuildUUIDIndexIfNeeded rebuilds the server-uuid index file if it's missing or truncated compared with the relay
+// sub directories, and returns the UUIDs in the rebuilt one.
+func (r *BinlogReader) rebuildUUIDIndexIfNeeded(indexed []string) ([]string, error) {
+	uuids, err := rebuildUUIDs(r.baseDir, indexed)
+	if err != nil {
+		return nil, err
+	}
+	if !uuidIndexNeedRebuild(indexed, uuids) {
+		return indexed, nil
+	}
+	if err = writeUUIDIndex(r.indexPath, uuids); err != nil {
+		return nil, err
+	}
+	r.tctx.L().Warn("server-uuid index file rebuilt", zap.String("file", r.indexPath),
+		zap.Strings("old uuids", indexed), zap.Strings("uuids", uuids))
+	return uuids, nil
+}
+
+// SetEventFilter sets a filter which decides whether an event should be sent to the streamer, events for which
+// the filter returns false are dropped after the position have been advanced, so the dropped events don't take
+// the channel capacity. RotateEvent, FormatDescriptionEvent and TransactionPayloadEvent are always sent to keep the
+// position bookkeeping of the callers correct, while the filter is applied to the inner events of TransactionPayloadEvent.
+// it should be called before StartSyncByPos or StartSyncByGTID, and it also applies to streamers started later.
+func (r *BinlogReader) SetEventFilter(filter func(*replication.BinlogEvent) bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.eventFilter = filter
+}
+
+// keepEvent returns whether the event should be sent to the streamer.
+func (r *BinlogReader) keepEvent(e *replication.BinlogEvent) bool {
+	if r.eventFilter == nil {
+		return true
+	}
+	switch e.Header.EventType {
+	case replication.ROTATE_EVENT, replication.FORMAT_DESCRIPTION_EVENT, event.TransactionPayloadEvent:
+		return true
+	}
+	return r.eventFilter(e)
+}
+
+// SetRateLimit changes the limits of events/sec and bytes/sec for all streamers started by the reader,
+// 0 means unlimited. it can be called when the streamers are running.
+func (r *BinlogReader) SetRateLimit(eventsPerSecond, bytesPerSecond float64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.limiter.setLimit(eventsPerSecond, bytesPerSecond)
+	for _, child := range r.children {
+		child.SetRateLimit(eventsPerSecond, bytesPerSecond)
+	}
+	r.tctx.L().Info("binlog reader rate limit changed",
+		zap.Float64("events per second", eventsPerSecond), zap.Float64("bytes per second", bytesPerSecond))
+}
+
+// Pause stops delivering events and reading relay log files for all streamers started by the reader,
+// the streamers are kept and only return heartbeat events when paused, they continue from the position where
+// they paused after Resume.
+// it returns false if the reader has already been paused.
+func (r *BinlogReader) Pause() bool {
+	paused := r.pause.pause()
+	if paused {
+		r.tctx.L().Info("binlog reader paused")
+	}
+	return paused
+}
+
+// Resume resumes the reader paused by Pause, it returns false if the reader is not paused.
+func (r *BinlogReader) Resume() bool {
+	resumed := r.pause.resume()
+	if resumed {
+		r.tctx.L().Info("binlog reader resumed")
+	}
+	return resumed
+}
+
+// IsPaused returns whether the reader is paused.
+func (r *BinlogReader) IsPaused() bool {
+	return r.pause.isPaused()
+}
+
+// Close closes BinlogReader and all its child readers.
 func (r *BinlogReader) Close() {
 	r.tctx.L().Info("binlog reader closing")
+	r.mu.Lock()
+	children := r.children
+	r.children = nil
 	r.running = false
+	r.mu.Unlock()
+	for _, child := range children {
+		child.Close()
+	}
+
 	r.cancel()
 	r.parser.Stop()
 	r.wg.Wait()
 	r.relay.UnRegisterListener(r)
+	if !r.isChild {
+		removeReaderMetrics(r.cfg.Task, r.cfg.SourceID)
+	}
 	r.tctx.L().Info("binlog reader closed")
 }
 
@@ -822,11 +1459,50 @@ func (r *BinlogReader) advanceCurrentGtidSet(gtid string) (bool, error) {
 	return false, err
 }
 
+// mergeGapGtidSet merges the GTID sets of the hole filled by the dummy event `e` into the current GTID sets,
+// the transactions in the hole are not in the relay log file.
+func (r *BinlogReader) mergeGapGtidSet(state *binlogFileParseState, e *replication.BinlogEvent) error {
+	filename := strings.TrimSuffix(state.relayLogFile, compressedRelayLogExt)
+	start := int64(e.Header.LogPos - e.Header.EventSize)
+	gap, ok, err := findRelayLogGap(r.storage, filepath.Join(state.relayLogDir, filename), start)
+	if err != nil {
+		return err
+	} else if !ok {
+		// the hole is filled by an older version or not in GTID mode
+		return nil
+	}
+	if r.currGset == nil {
+		r.currGset = r.prevGset.Clone()
+	}
+	// not use `Update` because it moves the domain backward for a smaller sequence number for MariaDB.
+	currGset, err := gtid.ParserGTID(r.cfg.Flavor, r.currGset.String())
+	if err != nil {
+		return terror.ErrRelayUpdateGTID.Delegate(err, r.currGset, gap.GTIDSet)
+	}
+	gapGset, err := gtid.ParserGTID(r.cfg.Flavor, gap.GTIDSet)
+	if err != nil {
+		return terror.ErrRelayUpdateGTID.Delegate(err, r.currGset, gap.GTIDSet)
+	}
+	merged, err := gtid.Merge(currGset, gapGset)
+	if err != nil {
+		return terror.ErrRelayUpdateGTID.Delegate(err, r.currGset, gap.GTIDSet)
+	}
+	if !merged.Equal(currGset) {
+		r.prevGset, r.currGset = r.currGset, merged.Origin()
+		r.tctx.L().Info("merge GTID sets of the hole", zap.String("file", filename), zap.Int64("position", start),
+			zap.Stringer("GTID sets", r.currGset))
+	}
+	return nil
+}
+
 func (r *BinlogReader) Notified() chan interface{} {
 	return r.notifyCh
 }
 
-func (r *BinlogReader) OnEvent(_ *replication.BinlogEvent) {
+func (r *BinlogReader) OnEvent(e *replication.BinlogEvent) {
+	if e.Header.EventType == replication.FORMAT_DESCRIPTION_EVENT {
+		r.fileIndex.onFileCreated() // FormatDescriptionEvent is the first event of a relay log file
+	}
 	// skip if there's pending notify
 	select {
 	case r.notifyCh <- struct{}{}:
@@ -0,0 +1,265 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/streamer"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+// BinlogReaderStatus represents the progress of BinlogReader.
+type BinlogReaderStatus struct {
+	RelaySubDir string // relay sub directory (UUID with suffix) being parsed
+	RelayBinlog string // relay log file being parsed
+	Offset      int64  // offset of the latest parsed event in RelayBinlog
+	// BytesBehind is the size of relay log data not parsed yet, up to the newest relay log file.
+	// it's calculated by the file size on disk, so it's not accurate for compressed or encrypted relay log files.
+	BytesBehind int64
+	// EventsPerSecond is the average number of events sent to the streamer since the previous call of Status.
+	EventsPerSecond float64
+}
+
+// readerProgress records the progress of BinlogReader, it's updated by the parsing goroutine.
+type readerProgress struct {
+	sync.Mutex
+	uuid   string
+	file   string
+	offset int64
+	events uint64
+
+	// used to calculate the events per second
+	sampleTime   time.Time
+	sampleEvents uint64
+
+	// the time when the metric of bytes behind is updated by the parsing goroutine
+	bytesBehindTime time.Time
+}
+
+// bytesBehindUpdateInterval is the min interval for the parsing goroutine to update the metric of bytes behind,
+// as it gets the sizes of all relay log files after the one being parsed.
+var bytesBehindUpdateInterval = 5 * time.Second
+
+// readerMetrics holds the metrics of BinlogReader with labels filled.
+type readerMetrics struct {
+	channelOccupancy    prometheus.Gauge
+	blockedSendDuration prometheus.Observer
+	binlogFile          prometheus.Gauge
+	binlogPos           prometheus.Gauge
+	bytesBehind         prometheus.Gauge
+	eventCount          prometheus.Counter
+}
+
+func newReaderMetrics(task, source string) *readerMetrics {
+	return &readerMetrics{
+		channelOccupancy:    relayReaderChannelOccupancyGauge.WithLabelValues(task, source),
+		blockedSendDuration: relayReaderBlockedSendDurationHistogram.WithLabelValues(task, source),
+		binlogFile:          relayReaderBinlogFileGauge.WithLabelValues(task, source),
+		binlogPos:           relayReaderBinlogPosGauge.WithLabelValues(task, source),
+		bytesBehind:         relayReaderBytesBehindGauge.WithLabelValues(task, source),
+		eventCount:          relayReaderEventCounter.WithLabelValues(task, source),
+	}
+}
+
+// removeReaderMetrics removes the metrics of the readers of the task and the source.
+func removeReaderMetrics(task, source string) {
+	labels := prometheus.Labels{"task": task, "source_id": source}
+	relayReaderChannelOccupancyGauge.DeleteAllAboutLabels(labels)
+	relayReaderBlockedSendDurationHistogram.DeleteAllAboutLabels(labels)
+	relayReaderBinlogFileGauge.DeleteAllAboutLabels(labels)
+	relayReaderBinlogPosGauge.DeleteAllAboutLabels(labels)
+	relayReaderBytesBehindGauge.DeleteAllAboutLabels(labels)
+	relayReaderEventCounter.DeleteAllAboutLabels(labels)
+}
+
+// resetProgress resets the progress when starting to sync.
+func (r *BinlogReader) resetProgress() {
+	r.progress.Lock()
+	defer r.progress.Unlock()
+	r.progress.uuid, r.progress.file, r.progress.offset = "", "", 0
+	r.progress.events, r.progress.sampleEvents = 0, 0
+	r.progress.sampleTime = time.Now()
+	r.progress.bytesBehindTime = time.Time{}
+}
+
+// onFileProgress records that the relay log file starts to be parsed from offset.
+func (r *BinlogReader) onFileProgress(uuid, file string, offset int64) {
+	r.progress.Lock()
+	switched := r.progress.uuid != uuid || r.progress.file != file
+	r.progress.uuid, r.progress.file, r.progress.offset = uuid, file, offset
+	r.progress.Unlock()
+
+	if index, err := binlog.GetFilenameIndex(file); err == nil {
+		r.metrics.binlogFile.Set(float64(index))
+	}
+	r.metrics.binlogPos.Set(float64(offset))
+	r.updateBytesBehind(switched)
+}
+
+// onEventProgress records that an event has been sent to the streamer, and the latest parsed offset.
+func (r *BinlogReader) onEventProgress(offset int64) {
+	r.progress.Lock()
+	r.progress.offset = offset
+	r.progress.events++
+	r.progress.Unlock()
+
+	r.metrics.binlogPos.Set(float64(offset))
+	r.metrics.eventCount.Inc()
+	r.updateBytesBehind(false)
+}
+
+// updateBytesBehind updates the metric of bytes behind, it's skipped if the metric has been updated within
+// bytesBehindUpdateInterval unless force is true.
+func (r *BinlogReader) updateBytesBehind(force bool) {
+	r.progress.Lock()
+	now := time.Now()
+	if !force && now.Sub(r.progress.bytesBehindTime) < bytesBehindUpdateInterval {
+		r.progress.Unlock()
+		return
+	}
+	r.progress.bytesBehindTime = now
+	uuid, file, offset := r.progress.uuid, r.progress.file, r.progress.offset
+	r.progress.Unlock()
+	if file == "" {
+		return
+	}
+
+	bytesBehind, err := r.bytesBehind(uuid, file, offset)
+	if err != nil {
+		r.tctx.L().Warn("fail to calculate bytes behind of relay log reader", zap.Error(err))
+		return
+	}
+	r.metrics.bytesBehind.Set(float64(bytesBehind))
+}
+
+// onFilteredEventProgress records the latest parsed offset of an event dropped by the event filter.
+func (r *BinlogReader) onFilteredEventProgress(offset int64) {
+	r.progress.Lock()
+	r.progress.offset = offset
+	r.progress.Unlock()
+
+	r.metrics.binlogPos.Set(float64(offset))
+}
+
+// activeRelayLog returns the relay log file being parsed, nil if the reader has not started to parse yet.
+func (r *BinlogReader) activeRelayLog() *streamer.RelayLogInfo {
+	r.progress.Lock()
+	uuid, file := r.progress.uuid, r.progress.file
+	r.progress.Unlock()
+	if file == "" {
+		return nil
+	}
+	_, suffix, err := utils.ParseSuffixForUUID(uuid)
+	if err != nil {
+		r.tctx.L().Warn("invalid relay sub directory being parsed", zap.String("sub directory", uuid), zap.Error(err))
+		return nil
+	}
+	return &streamer.RelayLogInfo{
+		TaskName:   r.cfg.Task,
+		UUID:       uuid,
+		UUIDSuffix: suffix,
+		Filename:   file,
+	}
+}
+
+// Status returns the progress of the reader, the metric of bytes behind is also updated by it.
+// only the progress of the first streamer is returned if multiple streamers are started.
+func (r *BinlogReader) Status() (*BinlogReaderStatus, error) {
+	r.progress.Lock()
+	status := &BinlogReaderStatus{
+		RelaySubDir: r.progress.uuid,
+		RelayBinlog: r.progress.file,
+		Offset:      r.progress.offset,
+	}
+	now := time.Now()
+	if elapsed := now.Sub(r.progress.sampleTime).Seconds(); elapsed > 0 {
+		status.EventsPerSecond = float64(r.progress.events-r.progress.sampleEvents) / elapsed
+	}
+	r.progress.sampleTime, r.progress.sampleEvents = now, r.progress.events
+	r.progress.Unlock()
+
+	if status.RelayBinlog == "" {
+		return status, nil
+	}
+	bytesBehind, err := r.bytesBehind(status.RelaySubDir, status.RelayBinlog, status.Offset)
+	if err != nil {
+		return nil, err
+	}
+	status.BytesBehind = bytesBehind
+	r.metrics.bytesBehind.Set(float64(bytesBehind))
+	return status, nil
+}
+
+// bytesBehind calculates the size of relay log data after the offset of the given relay log file.
+func (r *BinlogReader) bytesBehind(uuid, file string, offset int64) (int64, error) {
+	uuids, err := r.uuidCache.get(r.storage, r.indexPath)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		total     int64
+		started   bool
+		fileFound bool
+	)
+	for _, u := range uuids {
+		if u == uuid {
+			started = true
+		} else if !started {
+			continue
+		}
+		dir := filepath.Join(r.baseDir, u)
+		files, err2 := collectBinlogFiles(r.storage, dir)
+		if err2 != nil {
+			return 0, err2
+		}
+		for _, f := range files {
+			isCurrent := false
+			if u == uuid && !fileFound {
+				if f != file {
+					continue // parsed files
+				}
+				fileFound, isCurrent = true, true
+			}
+			realPath, _ := resolveRelayLogFile(r.storage, filepath.Join(dir, f))
+			size, err2 := r.storage.size(realPath)
+			if err2 != nil {
+				if os.IsNotExist(err2) || errors.IsNotFound(err2) {
+					continue // purged
+				}
+				return 0, terror.ErrGetRelayLogStat.Delegate(err2, realPath)
+			}
+			if isCurrent {
+				size -= offset
+			}
+			if size > 0 {
+				total += size
+			}
+		}
+	}
+	if !started {
+		r.tctx.L().Warn("relay sub directory not found in index file", zap.String("sub directory", uuid))
+	}
+	return total, nil
+}
@@ -31,9 +31,13 @@ import (
 	gmysql "github.com/go-mysql-org/go-mysql/mysql"
 	"github.com/go-mysql-org/go-mysql/replication"
 	"github.com/google/uuid"
+	"github.com/klauspost/compress/zstd"
 	. "github.com/pingcap/check"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
+	"github.com/prometheus/client_golang/prometheus"
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
 
 	"github.com/pingcap/tiflow/dm/pkg/binlog/event"
 	"github.com/pingcap/tiflow/dm/pkg/binlog/reader"
@@ -559,6 +563,792 @@ func (t *testReaderSuite) TestStartSyncByPos(c *C) {
 	r.Close()
 }
 
+func (t *testReaderSuite) TestStartSyncByPosWithPrefetch(c *C) {
+	var (
+		filenamePrefix         = "test-mysql-bin.00000"
+		baseDir                = c.MkDir()
+		baseEvents, lastPos, _ = t.genBinlogEvents(c, t.lastPos, t.lastGTID)
+		eventsBuf              bytes.Buffer
+		uuid                   = "b60868af-5a6f-11e9-9ea3-0242ac160006.000001"
+		fileCount              = 4
+		cfg                    = &BinlogReaderConfig{RelayDir: baseDir, Flavor: gmysql.MySQLFlavor, PrefetchEvents: 2}
+		r                      = newBinlogReaderForTest(log.L(), cfg, false, "")
+		startPos               = gmysql.Position{Name: "test-mysql-bin|000001.000001"}
+	)
+
+	_, err := eventsBuf.Write(replication.BinLogFileHeader)
+	c.Assert(err, IsNil)
+	for _, ev := range baseEvents {
+		_, err = eventsBuf.Write(ev.RawData)
+		c.Assert(err, IsNil)
+	}
+	t.writeUUIDs(c, baseDir, []string{uuid})
+	subDir := filepath.Join(baseDir, uuid)
+	c.Assert(os.MkdirAll(subDir, 0o700), IsNil)
+	for j := 1; j <= fileCount; j++ {
+		content := append([]byte{}, eventsBuf.Bytes()...)
+		if j != fileCount {
+			rotateEvent, err2 := event.GenRotateEvent(baseEvents[0].Header, lastPos, []byte(filenamePrefix+strconv.Itoa(j+1)), 4)
+			c.Assert(err2, IsNil)
+			content = append(content, rotateEvent.RawData...)
+		}
+		c.Assert(os.WriteFile(filepath.Join(subDir, filenamePrefix+strconv.Itoa(j)), content, 0o600), IsNil)
+	}
+	t.createMetaFile(c, subDir, filenamePrefix+strconv.Itoa(fileCount), startPos.Pos, t.lastGTID.String())
+
+	s, err := r.StartSyncByPos(startPos)
+	c.Assert(err, IsNil)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// the first three files are prefetched (except the first one), and the last one is parsed as usual
+	obtainEvents := readNEvents(ctx, c, s, fileCount*(len(baseEvents)+1)-1, false)
+	t.verifyNoEventsInStreamer(c, s)
+	for i := 0; i < len(obtainEvents); i += len(baseEvents) + 1 {
+		c.Assert(obtainEvents[i:i+len(baseEvents)], DeepEquals, baseEvents)
+		if i+len(baseEvents) < len(obtainEvents) {
+			c.Assert(obtainEvents[i+len(baseEvents)].Header.EventType, Equals, replication.ROTATE_EVENT)
+		}
+	}
+	r.Close()
+	c.Assert(r.prefetcher, IsNil)
+}
+
+func (t *testReaderSuite) TestMultipleStreamers(c *C) {
+	var (
+		filenamePrefix         = "test-mysql-bin.00000"
+		baseDir                = c.MkDir()
+		baseEvents, lastPos, _ = t.genBinlogEvents(c, t.lastPos, t.lastGTID)
+		eventsBuf              bytes.Buffer
+		uuid                   = "b60868af-5a6f-11e9-9ea3-0242ac160006.000001"
+		cfg                    = &BinlogReaderConfig{RelayDir: baseDir, Flavor: gmysql.MySQLFlavor}
+		r                      = newBinlogReaderForTest(log.L(), cfg, false, "")
+	)
+
+	_, err := eventsBuf.Write(replication.BinLogFileHeader)
+	c.Assert(err, IsNil)
+	for _, ev := range baseEvents {
+		_, err = eventsBuf.Write(ev.RawData)
+		c.Assert(err, IsNil)
+	}
+	t.writeUUIDs(c, baseDir, []string{uuid})
+	subDir := filepath.Join(baseDir, uuid)
+	c.Assert(os.MkdirAll(subDir, 0o700), IsNil)
+	rotateEvent, err := event.GenRotateEvent(baseEvents[0].Header, lastPos, []byte(filenamePrefix+"2"), 4)
+	c.Assert(err, IsNil)
+	c.Assert(os.WriteFile(filepath.Join(subDir, filenamePrefix+"1"), append(append([]byte{}, eventsBuf.Bytes()...), rotateEvent.RawData...), 0o600), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(subDir, filenamePrefix+"2"), eventsBuf.Bytes(), 0o600), IsNil)
+	t.createMetaFile(c, subDir, filenamePrefix+"2", 4, t.lastGTID.String())
+
+	s1, err := r.StartSyncByPos(gmysql.Position{Name: "test-mysql-bin|000001.000001"})
+	c.Assert(err, IsNil)
+	s2, err := r.StartSyncByPos(gmysql.Position{Name: "test-mysql-bin|000001.000002"})
+	c.Assert(err, IsNil)
+	c.Assert(r.children, HasLen, 1)
+	c.Assert(r.children[0].uuidCache, Equals, r.uuidCache)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	// the second streamer only reads the second file
+	events2 := readNEvents(ctx, c, s2, len(baseEvents), false)
+	c.Assert(events2, DeepEquals, baseEvents)
+	t.verifyNoEventsInStreamer(c, s2)
+	// the first streamer reads both files
+	events1 := readNEvents(ctx, c, s1, 2*len(baseEvents)+1, false)
+	c.Assert(events1[:len(baseEvents)], DeepEquals, baseEvents)
+	c.Assert(events1[len(baseEvents)+1:], DeepEquals, baseEvents)
+	t.verifyNoEventsInStreamer(c, s1)
+
+	r.Close()
+	c.Assert(r.children, HasLen, 0)
+}
+
+func (t *testReaderSuite) TestChannelCapacity(c *C) {
+	var (
+		filenamePrefix   = "test-mysql-bin.00000"
+		baseDir          = c.MkDir()
+		baseEvents, _, _ = t.genBinlogEvents(c, t.lastPos, t.lastGTID)
+		eventsBuf        bytes.Buffer
+		uuid             = "b60868af-5a6f-11e9-9ea3-0242ac160006.000001"
+		cfg              = &BinlogReaderConfig{RelayDir: baseDir, Flavor: gmysql.MySQLFlavor, ChannelCapacity: 1, Task: "test"}
+		r                = newBinlogReaderForTest(log.L(), cfg, false, "")
+	)
+
+	_, err := eventsBuf.Write(replication.BinLogFileHeader)
+	c.Assert(err, IsNil)
+	for _, ev := range baseEvents {
+		_, err = eventsBuf.Write(ev.RawData)
+		c.Assert(err, IsNil)
+	}
+	t.writeUUIDs(c, baseDir, []string{uuid})
+	subDir := filepath.Join(baseDir, uuid)
+	c.Assert(os.MkdirAll(subDir, 0o700), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(subDir, filenamePrefix+"1"), eventsBuf.Bytes(), 0o600), IsNil)
+	t.createMetaFile(c, subDir, filenamePrefix+"1", 4, t.lastGTID.String())
+
+	s, err := r.StartSyncByPos(gmysql.Position{Name: "test-mysql-bin|000001.000001"})
+	c.Assert(err, IsNil)
+	c.Assert(cap(s.(*LocalStreamer).ch), Equals, 1)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	// the parser is blocked by the full channel until events are consumed
+	obtainEvents := readNEvents(ctx, c, s, len(baseEvents), false)
+	c.Assert(obtainEvents, DeepEquals, baseEvents)
+	t.verifyNoEventsInStreamer(c, s)
+	r.Close()
+
+	// use the default capacity
+	cfg = &BinlogReaderConfig{RelayDir: baseDir, Flavor: gmysql.MySQLFlavor}
+	r = newBinlogReaderForTest(log.L(), cfg, false, "")
+	s, err = r.StartSyncByPos(gmysql.Position{Name: "test-mysql-bin|000001.000001"})
+	c.Assert(err, IsNil)
+	c.Assert(cap(s.(*LocalStreamer).ch), Equals, defaultLocalStreamerChannelCapacity)
+	r.Close()
+}
+
+func (t *testReaderSuite) TestStatus(c *C) {
+	var (
+		filenamePrefix         = "test-mysql-bin.00000"
+		baseDir                = c.MkDir()
+		baseEvents, lastPos, _ = t.genBinlogEvents(c, t.lastPos, t.lastGTID)
+		eventsBuf              bytes.Buffer
+		uuid                   = "b60868af-5a6f-11e9-9ea3-0242ac160006.000001"
+		cfg                    = &BinlogReaderConfig{RelayDir: baseDir, Flavor: gmysql.MySQLFlavor}
+		r                      = newBinlogReaderForTest(log.L(), cfg, false, "")
+	)
+
+	_, err := eventsBuf.Write(replication.BinLogFileHeader)
+	c.Assert(err, IsNil)
+	for _, ev := range baseEvents {
+		_, err = eventsBuf.Write(ev.RawData)
+		c.Assert(err, IsNil)
+	}
+	t.writeUUIDs(c, baseDir, []string{uuid})
+	subDir := filepath.Join(baseDir, uuid)
+	c.Assert(os.MkdirAll(subDir, 0o700), IsNil)
+	rotateEvent, err := event.GenRotateEvent(baseEvents[0].Header, lastPos, []byte(filenamePrefix+"2"), 4)
+	c.Assert(err, IsNil)
+	file1 := append(append([]byte{}, eventsBuf.Bytes()...), rotateEvent.RawData...)
+	c.Assert(os.WriteFile(filepath.Join(subDir, filenamePrefix+"1"), file1, 0o600), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(subDir, filenamePrefix+"2"), eventsBuf.Bytes(), 0o600), IsNil)
+	t.createMetaFile(c, subDir, filenamePrefix+"2", 4, t.lastGTID.String())
+
+	// not started
+	status, err := r.Status()
+	c.Assert(err, IsNil)
+	c.Assert(status.RelayBinlog, Equals, "")
+
+	s, err := r.StartSyncByPos(gmysql.Position{Name: "test-mysql-bin|000001.000001"})
+	c.Assert(err, IsNil)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	readNEvents(ctx, c, s, 2*len(baseEvents)+1, false)
+	t.verifyNoEventsInStreamer(c, s)
+
+	status, err = r.Status()
+	c.Assert(err, IsNil)
+	c.Assert(status.RelaySubDir, Equals, uuid)
+	c.Assert(status.RelayBinlog, Equals, filenamePrefix+"2")
+	c.Assert(status.Offset, Equals, int64(eventsBuf.Len()))
+	c.Assert(status.BytesBehind, Equals, int64(0))
+	c.Assert(status.EventsPerSecond, Greater, float64(0))
+	r.Close()
+
+	// bytes behind from the middle of the first file
+	behind, err := r.bytesBehind(uuid, filenamePrefix+"1", 100)
+	c.Assert(err, IsNil)
+	c.Assert(behind, Equals, int64(len(file1)-100+eventsBuf.Len()))
+}
+
+func (t *testReaderSuite) TestReaderMetrics(c *C) {
+	var (
+		filenamePrefix         = "test-mysql-bin.00000"
+		baseDir                = c.MkDir()
+		baseEvents, lastPos, _ = t.genBinlogEvents(c, t.lastPos, t.lastGTID)
+		eventsBuf              bytes.Buffer
+		uuid                   = "b60868af-5a6f-11e9-9ea3-0242ac160006.000001"
+		cfg                    = &BinlogReaderConfig{RelayDir: baseDir, Flavor: gmysql.MySQLFlavor, Task: "task-metrics", SourceID: "source-metrics"}
+		r                      = newBinlogReaderForTest(log.L(), cfg, false, "")
+	)
+	defer func(interval time.Duration) {
+		bytesBehindUpdateInterval = interval
+	}(bytesBehindUpdateInterval)
+	bytesBehindUpdateInterval = 0
+
+	_, err := eventsBuf.Write(replication.BinLogFileHeader)
+	c.Assert(err, IsNil)
+	for _, ev := range baseEvents {
+		_, err = eventsBuf.Write(ev.RawData)
+		c.Assert(err, IsNil)
+	}
+	t.writeUUIDs(c, baseDir, []string{uuid})
+	subDir := filepath.Join(baseDir, uuid)
+	c.Assert(os.MkdirAll(subDir, 0o700), IsNil)
+	rotateEvent, err := event.GenRotateEvent(baseEvents[0].Header, lastPos, []byte(filenamePrefix+"2"), 4)
+	c.Assert(err, IsNil)
+	file1 := append(append([]byte{}, eventsBuf.Bytes()...), rotateEvent.RawData...)
+	c.Assert(os.WriteFile(filepath.Join(subDir, filenamePrefix+"1"), file1, 0o600), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(subDir, filenamePrefix+"2"), eventsBuf.Bytes(), 0o600), IsNil)
+	t.createMetaFile(c, subDir, filenamePrefix+"2", 4, t.lastGTID.String())
+
+	// the bytes behind is updated by the parsing goroutine without calling Status.
+	relayReaderBytesBehindGauge.WithLabelValues(cfg.Task, cfg.SourceID).Set(-1)
+	s, err := r.StartSyncByPos(gmysql.Position{Name: "test-mysql-bin|000001.000001"})
+	c.Assert(err, IsNil)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	readNEvents(ctx, c, s, 2*len(baseEvents)+1, false)
+	t.verifyNoEventsInStreamer(c, s)
+	c.Assert(promtestutil.ToFloat64(relayReaderBytesBehindGauge.WithLabelValues(cfg.Task, cfg.SourceID)), Equals, float64(0))
+	c.Assert(promtestutil.ToFloat64(relayReaderBinlogFileGauge.WithLabelValues(cfg.Task, cfg.SourceID)), Equals, float64(2))
+
+	// the metrics are removed after the reader is closed.
+	r.Close()
+	c.Assert(hasMetricOfTask(relayReaderBytesBehindGauge, cfg.Task), IsFalse)
+	c.Assert(hasMetricOfTask(relayReaderEventCounter, cfg.Task), IsFalse)
+}
+
+// hasMetricOfTask returns whether the collector has a metric labeled by the task.
+func hasMetricOfTask(collector prometheus.Collector, task string) bool {
+	ch := make(chan prometheus.Metric, 100)
+	collector.Collect(ch)
+	close(ch)
+	for m := range ch {
+		var metric dto.Metric
+		if err := m.Write(&metric); err != nil {
+			continue
+		}
+		for _, label := range metric.Label {
+			if label.GetName() == "task" && label.GetValue() == task {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (t *testReaderSuite) TestPauseResume(c *C) {
+	var (
+		filenamePrefix         = "test-mysql-bin.00000"
+		baseDir                = c.MkDir()
+		baseEvents, lastPos, _ = t.genBinlogEvents(c, t.lastPos, t.lastGTID)
+		eventsBuf              bytes.Buffer
+		uuid                   = "b60868af-5a6f-11e9-9ea3-0242ac160006.000001"
+		cfg                    = &BinlogReaderConfig{RelayDir: baseDir, Flavor: gmysql.MySQLFlavor}
+		r                      = newBinlogReaderForTest(log.L(), cfg, false, "")
+	)
+
+	_, err := eventsBuf.Write(replication.BinLogFileHeader)
+	c.Assert(err, IsNil)
+	for _, ev := range baseEvents {
+		_, err = eventsBuf.Write(ev.RawData)
+		c.Assert(err, IsNil)
+	}
+	t.writeUUIDs(c, baseDir, []string{uuid})
+	subDir := filepath.Join(baseDir, uuid)
+	c.Assert(os.MkdirAll(subDir, 0o700), IsNil)
+	rotateEvent, err := event.GenRotateEvent(baseEvents[0].Header, lastPos, []byte(filenamePrefix+"2"), 4)
+	c.Assert(err, IsNil)
+	c.Assert(os.WriteFile(filepath.Join(subDir, filenamePrefix+"1"), append(append([]byte{}, eventsBuf.Bytes()...), rotateEvent.RawData...), 0o600), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(subDir, filenamePrefix+"2"), eventsBuf.Bytes(), 0o600), IsNil)
+	t.createMetaFile(c, subDir, filenamePrefix+"2", 4, t.lastGTID.String())
+
+	c.Assert(r.Resume(), IsFalse)
+	c.Assert(r.Pause(), IsTrue)
+	c.Assert(r.Pause(), IsFalse)
+	c.Assert(r.IsPaused(), IsTrue)
+
+	// no events are delivered before resumed
+	s, err := r.StartSyncByPos(gmysql.Position{Name: "test-mysql-bin|000001.000001"})
+	c.Assert(err, IsNil)
+	t.verifyNoEventsInStreamer(c, s)
+	c.Assert(r.Resume(), IsTrue)
+	c.Assert(r.IsPaused(), IsFalse)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	obtainEvents := readNEvents(ctx, c, s, len(baseEvents), false)
+	c.Assert(obtainEvents, DeepEquals, baseEvents)
+
+	// pause in the middle, the streamer continues from where it paused after resumed
+	c.Assert(r.Pause(), IsTrue)
+	t.verifyNoEventsInStreamer(c, s)
+	c.Assert(r.Resume(), IsTrue)
+	rest := readNEvents(ctx, c, s, len(baseEvents)+1, false)
+	c.Assert(rest[0].Header.EventType, Equals, replication.ROTATE_EVENT)
+	c.Assert(rest[1:], DeepEquals, baseEvents)
+	t.verifyNoEventsInStreamer(c, s)
+	r.Close()
+}
+
+func (t *testReaderSuite) TestEventFilter(c *C) {
+	var (
+		filenamePrefix         = "test-mysql-bin.00000"
+		baseDir                = c.MkDir()
+		baseEvents, lastPos, _ = t.genBinlogEvents(c, t.lastPos, t.lastGTID)
+		eventsBuf              bytes.Buffer
+		uuid                   = "b60868af-5a6f-11e9-9ea3-0242ac160006.000001"
+		cfg                    = &BinlogReaderConfig{RelayDir: baseDir, Flavor: gmysql.MySQLFlavor}
+		r                      = newBinlogReaderForTest(log.L(), cfg, false, "")
+		keptEvents             []*replication.BinlogEvent
+	)
+
+	_, err := eventsBuf.Write(replication.BinLogFileHeader)
+	c.Assert(err, IsNil)
+	for _, ev := range baseEvents {
+		_, err = eventsBuf.Write(ev.RawData)
+		c.Assert(err, IsNil)
+		if ev.Header.EventType != replication.QUERY_EVENT {
+			keptEvents = append(keptEvents, ev)
+		}
+	}
+	c.Assert(len(keptEvents), Less, len(baseEvents))
+	t.writeUUIDs(c, baseDir, []string{uuid})
+	subDir := filepath.Join(baseDir, uuid)
+	c.Assert(os.MkdirAll(subDir, 0o700), IsNil)
+	rotateEvent, err := event.GenRotateEvent(baseEvents[0].Header, lastPos, []byte(filenamePrefix+"2"), 4)
+	c.Assert(err, IsNil)
+	c.Assert(os.WriteFile(filepath.Join(subDir, filenamePrefix+"1"), append(append([]byte{}, eventsBuf.Bytes()...), rotateEvent.RawData...), 0o600), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(subDir, filenamePrefix+"2"), eventsBuf.Bytes(), 0o600), IsNil)
+	t.createMetaFile(c, subDir, filenamePrefix+"2", 4, t.lastGTID.String())
+
+	// drop all QueryEvents, and try to drop RotateEvents
+	r.SetEventFilter(func(e *replication.BinlogEvent) bool {
+		return e.Header.EventType != replication.QUERY_EVENT && e.Header.EventType != replication.ROTATE_EVENT
+	})
+	s, err := r.StartSyncByPos(gmysql.Position{Name: "test-mysql-bin|000001.000001"})
+	c.Assert(err, IsNil)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	obtainEvents := readNEvents(ctx, c, s, 2*len(keptEvents)+1, false)
+	c.Assert(obtainEvents[:len(keptEvents)], DeepEquals, keptEvents)
+	c.Assert(obtainEvents[len(keptEvents)].Header.EventType, Equals, replication.ROTATE_EVENT)
+	c.Assert(obtainEvents[len(keptEvents)+1:], DeepEquals, keptEvents)
+	t.verifyNoEventsInStreamer(c, s)
+
+	// the position is advanced by the dropped events
+	status, err := r.Status()
+	c.Assert(err, IsNil)
+	c.Assert(status.RelayBinlog, Equals, filenamePrefix+"2")
+	c.Assert(status.Offset, Equals, int64(eventsBuf.Len()))
+	r.Close()
+}
+
+func (t *testReaderSuite) TestValidate(c *C) {
+	var (
+		filenamePrefix   = "test-mysql-bin.00000"
+		baseDir          = c.MkDir()
+		baseEvents, _, _ = t.genBinlogEvents(c, t.lastPos, t.lastGTID)
+		eventsBuf        bytes.Buffer
+		uuids            = []string{
+			"b60868af-5a6f-11e9-9ea3-0242ac160006.000001",
+			"b60868af-5a6f-11e9-9ea3-0242ac160007.000002",
+		}
+		notIndexed = "b60868af-5a6f-11e9-9ea3-0242ac160008.000003"
+		cfg        = &BinlogReaderConfig{RelayDir: baseDir, Flavor: gmysql.MySQLFlavor}
+		r          = newBinlogReaderForTest(log.L(), cfg, false, "")
+		ctx        = context.Background()
+	)
+
+	_, err := eventsBuf.Write(replication.BinLogFileHeader)
+	c.Assert(err, IsNil)
+	for _, ev := range baseEvents {
+		_, err = eventsBuf.Write(ev.RawData)
+		c.Assert(err, IsNil)
+	}
+	t.writeUUIDs(c, baseDir, uuids)
+	for _, uuid := range append(uuids, notIndexed) {
+		c.Assert(os.MkdirAll(filepath.Join(baseDir, uuid), 0o700), IsNil)
+	}
+	// the first sub directory has files 1, 2 and 4, the second one has file 1
+	for _, i := range []int{1, 2, 4} {
+		c.Assert(os.WriteFile(filepath.Join(baseDir, uuids[0], filenamePrefix+strconv.Itoa(i)), eventsBuf.Bytes(), 0o600), IsNil)
+	}
+	c.Assert(os.WriteFile(filepath.Join(baseDir, uuids[1], filenamePrefix+"1"), eventsBuf.Bytes(), 0o600), IsNil)
+
+	// no issues except the sub directory not in the index file
+	report, err := r.Validate(ctx, gmysql.Position{Name: "test-mysql-bin|000001.000002"})
+	c.Assert(err, IsNil)
+	c.Assert(report.SubDirs, Equals, 2)
+	c.Assert(report.Files, Equals, 3)
+	c.Assert(report.Events, Equals, 3*len(baseEvents))
+	c.Assert(report.Issues, HasLen, 2)
+	c.Assert(report.Issues[0].Type, Equals, ValidateIssueUUIDIndex)
+	c.Assert(report.Issues[0].SubDir, Equals, notIndexed)
+	c.Assert(report.Issues[1].Type, Equals, ValidateIssueFileGap)
+	c.Assert(report.Issues[1].File, Equals, filenamePrefix+"4")
+
+	// corrupt the second file by truncating the last event
+	lastEventPos := int64(eventsBuf.Len() - len(baseEvents[len(baseEvents)-1].RawData))
+	c.Assert(os.Truncate(filepath.Join(baseDir, uuids[0], filenamePrefix+"2"), lastEventPos+5), IsNil)
+	// the sub directory in the index file not exists
+	c.Assert(os.RemoveAll(filepath.Join(baseDir, uuids[1])), IsNil)
+	report, err = r.Validate(ctx, gmysql.Position{})
+	c.Assert(err, IsNil)
+	c.Assert(report.SubDirs, Equals, 1)
+	c.Assert(report.Files, Equals, 3)
+	c.Assert(report.Events, Equals, 3*len(baseEvents)-1)
+	c.Assert(report.Issues, HasLen, 4)
+	c.Assert(report.Issues[0].Type, Equals, ValidateIssueUUIDIndex)
+	c.Assert(report.Issues[0].SubDir, Equals, uuids[1])
+	c.Assert(report.Issues[1].Type, Equals, ValidateIssueUUIDIndex)
+	c.Assert(report.Issues[1].SubDir, Equals, notIndexed)
+	c.Assert(report.Issues[2].Type, Equals, ValidateIssueFileGap)
+	c.Assert(report.Issues[3].Type, Equals, ValidateIssueCorruptedEvent)
+	c.Assert(report.Issues[3].File, Equals, filenamePrefix+"2")
+	c.Assert(report.Issues[3].Pos, Equals, lastEventPos)
+
+	// invalid position
+	_, err = r.Validate(ctx, gmysql.Position{Name: "test-mysql-bin|000009.000001"})
+	c.Assert(terror.ErrBinlogExtractPosition.Equal(err), IsTrue)
+}
+
+func (t *testReaderSuite) TestRecoverTornEvent(c *C) {
+	var (
+		filenamePrefix   = "test-mysql-bin.00000"
+		baseDir          = c.MkDir()
+		baseEvents, _, _ = t.genBinlogEvents(c, t.lastPos, t.lastGTID)
+		eventsBuf        bytes.Buffer
+		uuid             = "b60868af-5a6f-11e9-9ea3-0242ac160006.000001"
+		startPos         = gmysql.Position{Name: "test-mysql-bin|000001.000001"}
+	)
+
+	_, err := eventsBuf.Write(replication.BinLogFileHeader)
+	c.Assert(err, IsNil)
+	for _, ev := range baseEvents {
+		_, err = eventsBuf.Write(ev.RawData)
+		c.Assert(err, IsNil)
+	}
+	t.writeUUIDs(c, baseDir, []string{uuid})
+	subDir := filepath.Join(baseDir, uuid)
+	c.Assert(os.MkdirAll(subDir, 0o700), IsNil)
+	// the last event of the second file is torn, and a newer file is generated
+	lastEvent := baseEvents[len(baseEvents)-1]
+	c.Assert(os.WriteFile(filepath.Join(subDir, filenamePrefix+"1"), eventsBuf.Bytes(), 0o600), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(subDir, filenamePrefix+"2"), eventsBuf.Bytes()[:eventsBuf.Len()-len(lastEvent.RawData)/2], 0o600), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(subDir, filenamePrefix+"3"), eventsBuf.Bytes(), 0o600), IsNil)
+	t.createMetaFile(c, subDir, filenamePrefix+"3", 4, t.lastGTID.String())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// not recover
+	cfg := &BinlogReaderConfig{RelayDir: baseDir, Flavor: gmysql.MySQLFlavor}
+	r := newBinlogReaderForTest(log.L(), cfg, false, "")
+	s, err := r.StartSyncByPos(startPos)
+	c.Assert(err, IsNil)
+	for {
+		_, err = s.GetEvent(ctx)
+		if err != nil {
+			break
+		}
+	}
+	c.Assert(terror.ErrParserParseRelayLog.Equal(err), IsTrue)
+	r.Close()
+
+	// recover, with or without prefetching
+	for _, prefetch := range []int{0, 2} {
+		cfg = &BinlogReaderConfig{RelayDir: baseDir, Flavor: gmysql.MySQLFlavor, RecoverTornEvent: true, PrefetchEvents: prefetch}
+		r = newBinlogReaderForTest(log.L(), cfg, false, "")
+		s, err = r.StartSyncByPos(startPos)
+		c.Assert(err, IsNil)
+		obtainEvents := readNEvents(ctx, c, s, 3*len(baseEvents)-1, false)
+		c.Assert(obtainEvents[:len(baseEvents)], DeepEquals, baseEvents)
+		c.Assert(obtainEvents[len(baseEvents):2*len(baseEvents)-1], DeepEquals, baseEvents[:len(baseEvents)-1])
+		c.Assert(obtainEvents[2*len(baseEvents)-1:], DeepEquals, baseEvents)
+		t.verifyNoEventsInStreamer(c, s)
+		r.Close()
+	}
+}
+
+func (t *testReaderSuite) TestRateLimit(c *C) {
+	var (
+		filenamePrefix   = "test-mysql-bin.00000"
+		baseDir          = c.MkDir()
+		baseEvents, _, _ = t.genBinlogEvents(c, t.lastPos, t.lastGTID)
+		eventsBuf        bytes.Buffer
+		uuid             = "b60868af-5a6f-11e9-9ea3-0242ac160006.000001"
+		cfg              = &BinlogReaderConfig{RelayDir: baseDir, Flavor: gmysql.MySQLFlavor, EventsPerSecond: 1}
+		r                = newBinlogReaderForTest(log.L(), cfg, false, "")
+	)
+
+	_, err := eventsBuf.Write(replication.BinLogFileHeader)
+	c.Assert(err, IsNil)
+	for _, ev := range baseEvents {
+		_, err = eventsBuf.Write(ev.RawData)
+		c.Assert(err, IsNil)
+	}
+	t.writeUUIDs(c, baseDir, []string{uuid})
+	subDir := filepath.Join(baseDir, uuid)
+	c.Assert(os.MkdirAll(subDir, 0o700), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(subDir, filenamePrefix+"1"), eventsBuf.Bytes(), 0o600), IsNil)
+	t.createMetaFile(c, subDir, filenamePrefix+"1", 4, t.lastGTID.String())
+
+	s, err := r.StartSyncByPos(gmysql.Position{Name: "test-mysql-bin|000001.000001"})
+	c.Assert(err, IsNil)
+	s2, err := r.StartSyncByPos(gmysql.Position{Name: "test-mysql-bin|000001.000001"})
+	c.Assert(err, IsNil)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	// only the fake rotate event is sent in the first second
+	for _, st := range []reader.Streamer{s, s2} {
+		e, err2 := st.GetEvent(ctx)
+		c.Assert(err2, IsNil)
+		c.Assert(e.Header.EventType, Equals, replication.ROTATE_EVENT)
+		c.Assert(e.Header.Timestamp, Equals, uint32(0))
+		t.verifyNoEventsInStreamer(c, st)
+	}
+
+	// remove the limit at runtime, for all streamers
+	r.SetRateLimit(0, 0)
+	c.Assert(readNEvents(ctx, c, s, len(baseEvents), false), DeepEquals, baseEvents)
+	c.Assert(readNEvents(ctx, c, s2, len(baseEvents), false), DeepEquals, baseEvents)
+	r.Close()
+}
+
+func (t *testReaderSuite) TestTransactionPayload(c *C) {
+	var (
+		filename = "test-mysql-bin.000001"
+		baseDir  = c.MkDir()
+		uuid     = "b60868af-5a6f-11e9-9ea3-0242ac160006.000001"
+		cfg      = &BinlogReaderConfig{RelayDir: baseDir, Flavor: gmysql.MySQLFlavor}
+		r        = newBinlogReaderForTest(log.L(), cfg, false, "")
+		header   = &replication.EventHeader{
+			Timestamp: uint32(time.Now().Unix()),
+			ServerID:  11,
+		}
+		dmlData = []*event.DMLData{{
+			TableID:    8,
+			Schema:     "foo",
+			Table:      "bar",
+			ColumnType: []byte{gmysql.MYSQL_TYPE_LONG},
+			Rows:       [][]interface{}{{int32(1)}, {int32(2)}},
+		}}
+		eventsBuf   bytes.Buffer
+		latestGTID  = t.lastGTID
+		wrapped     [][]*replication.BinlogEvent
+		outerEvents []*replication.BinlogEvent
+	)
+
+	fde, err := event.GenFormatDescriptionEvent(header, 4)
+	c.Assert(err, IsNil)
+	eventsBuf.Write(replication.BinLogFileHeader)
+	eventsBuf.Write(fde.RawData)
+	latestPos := fde.Header.LogPos
+	// one transaction compressed by zstd, and one not compressed
+	for _, compressionType := range []uint64{event.TransactionPayloadCompressionZstd, event.TransactionPayloadCompressionNone} {
+		result, err2 := event.GenDMLEvents(gmysql.MySQLFlavor, header.ServerID, latestPos, latestGTID, replication.WRITE_ROWS_EVENTv2, 10, dmlData, true, false, 0)
+		c.Assert(err2, IsNil)
+		gtidEv := result.Events[0]
+		payloadEv, err2 := event.GenTransactionPayloadEvent(header, gtidEv.Header.LogPos, compressionType, result.Events[1:])
+		c.Assert(err2, IsNil)
+		eventsBuf.Write(gtidEv.RawData)
+		eventsBuf.Write(payloadEv.RawData)
+		wrapped = append(wrapped, result.Events[1:])
+		outerEvents = append(outerEvents, gtidEv, payloadEv)
+		latestPos, latestGTID = payloadEv.Header.LogPos, result.LatestGTID
+	}
+	t.writeUUIDs(c, baseDir, []string{uuid})
+	subDir := filepath.Join(baseDir, uuid)
+	c.Assert(os.MkdirAll(subDir, 0o700), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(subDir, filename), eventsBuf.Bytes(), 0o600), IsNil)
+	t.createMetaFile(c, subDir, filename, latestPos, latestGTID.String())
+
+	s, err := r.StartSyncByPos(gmysql.Position{Name: "test-mysql-bin|000001.000001"})
+	c.Assert(err, IsNil)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	obtainEvents := readNEvents(ctx, c, s, 1+len(outerEvents)+len(wrapped[0])+len(wrapped[1]), false)
+	c.Assert(obtainEvents[0].Header.EventType, Equals, replication.FORMAT_DESCRIPTION_EVENT)
+	obtainEvents = obtainEvents[1:]
+	for i := range wrapped {
+		c.Assert(obtainEvents[0], DeepEquals, outerEvents[2*i])
+		payloadEv := obtainEvents[1]
+		c.Assert(payloadEv.Header.EventType, Equals, event.TransactionPayloadEvent)
+		c.Assert(payloadEv.RawData, DeepEquals, outerEvents[2*i+1].RawData)
+		// the inner events follow the TransactionPayloadEvent with the same log position
+		for j, expected := range wrapped[i] {
+			e := obtainEvents[2+j]
+			c.Assert(e.Header.EventType, Equals, expected.Header.EventType)
+			c.Assert(e.Header.LogPos, Equals, payloadEv.Header.LogPos)
+			c.Assert(e.Header.EventSize, Equals, expected.Header.EventSize-4) // no checksum
+			switch ev := e.Event.(type) {
+			case *replication.QueryEvent:
+				c.Assert(ev.Query, DeepEquals, []byte("BEGIN"))
+			case *replication.TableMapEvent:
+				c.Assert(ev.Table, DeepEquals, []byte("bar"))
+			case *replication.RowsEvent:
+				c.Assert(ev.Rows, DeepEquals, dmlData[0].Rows)
+			case *replication.XIDEvent:
+				c.Assert(ev.XID, Equals, expected.Event.(*replication.XIDEvent).XID)
+			default:
+				c.Fatalf("unexpected inner event %+v", e.Header)
+			}
+		}
+		obtainEvents = obtainEvents[2+len(wrapped[i]):]
+	}
+	t.verifyNoEventsInStreamer(c, s)
+	r.Close()
+}
+
+func (t *testReaderSuite) TestRemoteRelayDir(c *C) {
+	originInterval := remoteRelayPollInterval
+	remoteRelayPollInterval = 10 * time.Millisecond
+	defer func() {
+		remoteRelayPollInterval = originInterval
+	}()
+
+	var (
+		filenamePrefix = "test-mysql-bin.00000"
+		baseDir        = c.MkDir()
+		uuid           = "b60868af-5a6f-11e9-9ea3-0242ac160006.000001"
+		subDir         = filepath.Join(baseDir, uuid)
+		// read from the local directory with the URL of external storage, so the relay log files are polled
+		cfg = &BinlogReaderConfig{RelayDir: "file://" + baseDir, Flavor: gmysql.MySQLFlavor}
+		r   = newBinlogReaderForTest(log.L(), cfg, false, "")
+	)
+	c.Assert(r.storage.remote(), IsTrue)
+	c.Assert(r.baseDir, Equals, "")
+	t.writeUUIDs(c, baseDir, []string{uuid})
+	c.Assert(os.MkdirAll(subDir, 0o700), IsNil)
+
+	writeEvents := func(filename string, events []*replication.BinlogEvent, appendFile bool) {
+		flag := os.O_CREATE | os.O_WRONLY | os.O_APPEND
+		if !appendFile {
+			flag |= os.O_TRUNC
+		}
+		f, err := os.OpenFile(filepath.Join(subDir, filename), flag, 0o600)
+		c.Assert(err, IsNil)
+		defer f.Close()
+		if !appendFile {
+			_, err = f.Write(replication.BinLogFileHeader)
+			c.Assert(err, IsNil)
+		}
+		for _, ev := range events {
+			_, err = f.Write(ev.RawData)
+			c.Assert(err, IsNil)
+		}
+	}
+
+	events1, lastPos, lastGTID := t.genBinlogEvents(c, t.lastPos, t.lastGTID)
+	writeEvents(filenamePrefix+"1", events1, false)
+	t.createMetaFile(c, subDir, filenamePrefix+"1", lastPos, lastGTID.String())
+
+	s, err := r.StartSyncByPos(gmysql.Position{Name: "test-mysql-bin|000001.000001"})
+	c.Assert(err, IsNil)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	obtainEvents := readNEvents(ctx, c, s, len(events1), false)
+	c.Assert(obtainEvents, DeepEquals, events1)
+
+	// events appended to the file are read by polling
+	events2, lastPos, lastGTID := t.genBinlogEvents(c, lastPos, lastGTID)
+	writeEvents(filenamePrefix+"1", events2, true)
+	obtainEvents = readNEvents(ctx, c, s, len(events2), false)
+	c.Assert(obtainEvents, DeepEquals, events2)
+
+	// switch to the newer file
+	events3, lastPos, lastGTID := t.genBinlogEvents(c, 4, lastGTID)
+	writeEvents(filenamePrefix+"2", events3, false)
+	t.createMetaFile(c, subDir, filenamePrefix+"2", lastPos, lastGTID.String())
+	obtainEvents = readNEvents(ctx, c, s, len(events3), false)
+	c.Assert(obtainEvents, DeepEquals, events3)
+
+	t.verifyNoEventsInStreamer(c, s)
+	r.Close()
+}
+
+func (t *testReaderSuite) TestStartSyncByPosCompressed(c *C) {
+	var (
+		filenamePrefix         = "test-mysql-bin.00000"
+		baseDir                = c.MkDir()
+		baseEvents, lastPos, _ = t.genBinlogEvents(c, t.lastPos, t.lastGTID)
+		eventsBuf              bytes.Buffer
+		uuid                   = "b60868af-5a6f-11e9-9ea3-0242ac160006.000001"
+		cfg                    = &BinlogReaderConfig{RelayDir: baseDir, Flavor: gmysql.MySQLFlavor}
+		r                      = newBinlogReaderForTest(log.L(), cfg, false, "")
+	)
+
+	_, err := eventsBuf.Write(replication.BinLogFileHeader)
+	c.Assert(err, IsNil)
+	for _, ev := range baseEvents {
+		_, err = eventsBuf.Write(ev.RawData)
+		c.Assert(err, IsNil)
+	}
+	t.writeUUIDs(c, baseDir, []string{uuid})
+	subDir := filepath.Join(baseDir, uuid)
+	c.Assert(os.MkdirAll(subDir, 0o700), IsNil)
+	rotateEvent, err := event.GenRotateEvent(baseEvents[0].Header, lastPos, []byte(filenamePrefix+"2"), 4)
+	c.Assert(err, IsNil)
+	// the first file is compressed, the second (active) one is not
+	enc, err := zstd.NewWriter(nil)
+	c.Assert(err, IsNil)
+	compressed := enc.EncodeAll(append(append([]byte{}, eventsBuf.Bytes()...), rotateEvent.RawData...), nil)
+	c.Assert(os.WriteFile(filepath.Join(subDir, filenamePrefix+"1"+compressedRelayLogExt), compressed, 0o600), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(subDir, filenamePrefix+"2"), eventsBuf.Bytes(), 0o600), IsNil)
+	t.createMetaFile(c, subDir, filenamePrefix+"2", 4, t.lastGTID.String())
+
+	files, err := CollectAllBinlogFiles(subDir)
+	c.Assert(err, IsNil)
+	c.Assert(files, DeepEquals, []string{filenamePrefix + "1", filenamePrefix + "2"})
+
+	s, err := r.StartSyncByPos(gmysql.Position{Name: "test-mysql-bin|000001.000001"})
+	c.Assert(err, IsNil)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	obtainEvents := readNEvents(ctx, c, s, 2*len(baseEvents)+1, false)
+	c.Assert(obtainEvents[:len(baseEvents)], DeepEquals, baseEvents)
+	c.Assert(obtainEvents[len(baseEvents)].Header.EventType, Equals, replication.ROTATE_EVENT)
+	c.Assert(obtainEvents[len(baseEvents)+1:], DeepEquals, baseEvents)
+	t.verifyNoEventsInStreamer(c, s)
+	r.Close()
+}
+
+func (t *testReaderSuite) TestStartSyncByPosEncrypted(c *C) {
+	var (
+		filenamePrefix         = "test-mysql-bin.00000"
+		baseDir                = c.MkDir()
+		baseEvents, lastPos, _ = t.genBinlogEvents(c, t.lastPos, t.lastGTID)
+		eventsBuf              bytes.Buffer
+		uuid                   = "b60868af-5a6f-11e9-9ea3-0242ac160006.000001"
+		key                    = bytes.Repeat([]byte{0x34}, 16)
+		iv                     = bytes.Repeat([]byte{0x56}, encryptedRelayLogHeaderLen)
+		cfg                    = &BinlogReaderConfig{RelayDir: baseDir, Flavor: gmysql.MySQLFlavor, EncryptKey: key}
+		r                      = newBinlogReaderForTest(log.L(), cfg, false, "")
+	)
+
+	_, err := eventsBuf.Write(replication.BinLogFileHeader)
+	c.Assert(err, IsNil)
+	for _, ev := range baseEvents {
+		_, err = eventsBuf.Write(ev.RawData)
+		c.Assert(err, IsNil)
+	}
+	t.writeUUIDs(c, baseDir, []string{uuid})
+	subDir := filepath.Join(baseDir, uuid)
+	c.Assert(os.MkdirAll(subDir, 0o700), IsNil)
+	rotateEvent, err := event.GenRotateEvent(baseEvents[0].Header, lastPos, []byte(filenamePrefix+"2"), 4)
+	c.Assert(err, IsNil)
+	file1 := encryptRelayLog(c, key, iv, append(append([]byte{}, eventsBuf.Bytes()...), rotateEvent.RawData...))
+	c.Assert(os.WriteFile(filepath.Join(subDir, filenamePrefix+"1"), file1, 0o600), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(subDir, filenamePrefix+"2"), encryptRelayLog(c, key, iv, eventsBuf.Bytes()), 0o600), IsNil)
+	t.createMetaFile(c, subDir, filenamePrefix+"2", 4, t.lastGTID.String())
+
+	// the given position is checked against the size of the plain file
+	_, err = r.StartSyncByPos(gmysql.Position{Name: "test-mysql-bin|000001.000001", Pos: uint32(len(file1))})
+	c.Assert(terror.ErrRelayLogGivenPosTooBig.Equal(err), IsTrue)
+
+	s, err := r.StartSyncByPos(gmysql.Position{Name: "test-mysql-bin|000001.000001"})
+	c.Assert(err, IsNil)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	obtainEvents := readNEvents(ctx, c, s, 2*len(baseEvents)+1, false)
+	c.Assert(obtainEvents[:len(baseEvents)], DeepEquals, baseEvents)
+	c.Assert(obtainEvents[len(baseEvents)].Header.EventType, Equals, replication.ROTATE_EVENT)
+	c.Assert(obtainEvents[len(baseEvents)+1:], DeepEquals, baseEvents)
+	t.verifyNoEventsInStreamer(c, s)
+	r.Close()
+}
+
 func readNEvents(ctx context.Context, c *C, s reader.Streamer, l int, tolerateMayDup bool) []*replication.BinlogEvent {
 	var result []*replication.BinlogEvent
 	for {
@@ -891,18 +1681,23 @@ func (t *testReaderSuite) TestStartSyncError(c *C) {
 	s, err = r.StartSyncByGTID(t.lastGTID.Origin().Clone())
 	c.Assert(err, ErrorMatches, ".*no such file or directory.*")
 	c.Assert(s, IsNil)
+	// GTID states should not be changed if failed to start
+	c.Assert(r.usingGTID, IsFalse)
+	c.Assert(r.prevGset, IsNil)
 
-	// can not re-start the reader
+	// start another streamer when the reader is running, and the child reader should be closed if failed
 	r.running = true
 	s, err = r.StartSyncByPos(startPos)
-	c.Assert(terror.ErrReaderAlreadyRunning.Equal(err), IsTrue)
+	c.Assert(err, ErrorMatches, fmt.Sprintf(".*%s.*not found.*", startPos.Name))
 	c.Assert(s, IsNil)
+	c.Assert(r.children, HasLen, 0)
 	r.Close()
 
 	r.running = true
 	s, err = r.StartSyncByGTID(t.lastGTID.Origin().Clone())
-	c.Assert(terror.ErrReaderAlreadyRunning.Equal(err), IsTrue)
+	c.Assert(err, ErrorMatches, ".*no such file or directory.*")
 	c.Assert(s, IsNil)
+	c.Assert(r.children, HasLen, 0)
 	r.Close()
 
 	// too big startPos
@@ -919,6 +1714,65 @@ func (t *testReaderSuite) TestStartSyncError(c *C) {
 	c.Assert(s, IsNil)
 }
 
+func (t *testReaderSuite) TestSeekByTime(c *C) {
+	var (
+		baseDir = c.MkDir()
+		UUIDs   = []string{
+			"b60868af-5a6f-11e9-9ea3-0242ac160006.000001",
+			"b60868af-5a6f-11e9-9ea3-0242ac160007.000002",
+		}
+		cfg = &BinlogReaderConfig{RelayDir: baseDir, Flavor: gmysql.MySQLFlavor}
+		r   = newBinlogReaderForTest(log.L(), cfg, true, "")
+	)
+
+	// no UUIDs
+	_, err := r.SeekByTime(time.Unix(100, 0))
+	c.Assert(err, NotNil)
+
+	t.writeUUIDs(c, baseDir, UUIDs)
+	for _, uuid := range UUIDs {
+		c.Assert(os.MkdirAll(filepath.Join(baseDir, uuid), 0o700), IsNil)
+	}
+
+	// no relay log files
+	_, err = r.SeekByTime(time.Unix(100, 0))
+	c.Assert(terror.ErrBinlogFilesNotFound.Equal(err), IsTrue)
+
+	writeFile := func(uuid, filename string, ts int64) {
+		_, data, err2 := event.GenCommonFileHeader(gmysql.MySQLFlavor, 1, t.lastGTID, true, ts)
+		c.Assert(err2, IsNil)
+		c.Assert(os.WriteFile(filepath.Join(baseDir, uuid, filename), data, 0o600), IsNil)
+	}
+	writeFile(UUIDs[0], "mysql-bin.000001", 100)
+	writeFile(UUIDs[0], "mysql-bin.000002", 200)
+	writeFile(UUIDs[1], "mysql-bin.000001", 300)
+	// the newest file only has binlog file header
+	c.Assert(os.WriteFile(filepath.Join(baseDir, UUIDs[1], "mysql-bin.000002"), replication.BinLogFileHeader, 0o600), IsNil)
+
+	cases := []struct {
+		ts  int64
+		pos gmysql.Position
+	}{
+		{50, gmysql.Position{Name: "mysql-bin|000001.000001", Pos: 4}},
+		{100, gmysql.Position{Name: "mysql-bin|000001.000001", Pos: 4}},
+		{150, gmysql.Position{Name: "mysql-bin|000001.000001", Pos: 4}},
+		{200, gmysql.Position{Name: "mysql-bin|000001.000002", Pos: 4}},
+		{299, gmysql.Position{Name: "mysql-bin|000001.000002", Pos: 4}},
+		{300, gmysql.Position{Name: "mysql-bin|000002.000001", Pos: 4}},
+		{1000, gmysql.Position{Name: "mysql-bin|000002.000001", Pos: 4}},
+	}
+	for _, cs := range cases {
+		pos, err2 := r.SeekByTime(time.Unix(cs.ts, 0))
+		c.Assert(err2, IsNil)
+		c.Assert(pos, DeepEquals, cs.pos, Commentf("ts %d", cs.ts))
+	}
+
+	// corrupt first event
+	c.Assert(os.WriteFile(filepath.Join(baseDir, UUIDs[1], "mysql-bin.000001"), append(replication.BinLogFileHeader, make([]byte, 100)...), 0o600), IsNil)
+	_, err = r.SeekByTime(time.Unix(1000, 0))
+	c.Assert(err, NotNil)
+}
+
 func (t *testReaderSuite) TestAdvanceCurrentGTIDSet(c *C) {
 	var (
 		baseDir        = c.MkDir()
@@ -953,6 +1807,46 @@ func (t *testReaderSuite) TestAdvanceCurrentGTIDSet(c *C) {
 	c.Assert(r.currGset.String(), Equals, "0-1-6")
 }
 
+func (t *testReaderSuite) TestMergeGapGTIDSet(c *C) {
+	var (
+		baseDir    = c.MkDir()
+		cfg        = &BinlogReaderConfig{RelayDir: baseDir, Flavor: gmysql.MySQLFlavor}
+		r          = newBinlogReaderForTest(log.L(), cfg, true, "")
+		uuidDir    = filepath.Join(baseDir, "b60868af-5a6f-11e9-9ea3-0242ac160006.000001")
+		file       = "mysql-bin.000001"
+		mysqlGset  = "b60868af-5a6f-11e9-9ea3-0242ac160006:1-6"
+		gapGset    = "b60868af-5a6f-11e9-9ea3-0242ac160006:1-10"
+		startGs, _ = gmysql.ParseMysqlGTIDSet(mysqlGset)
+		state      = &binlogFileParseState{relayLogFile: file, relayLogDir: uuidDir}
+	)
+	c.Assert(os.MkdirAll(uuidDir, 0o700), IsNil)
+	dummyEv, err := event.GenDummyEvent(&replication.EventHeader{Timestamp: uint32(time.Now().Unix()), ServerID: 11}, 100, 50)
+	c.Assert(err, IsNil)
+	r.prevGset = startGs.Clone()
+
+	// no gap recorded
+	c.Assert(r.mergeGapGtidSet(state, dummyEv), IsNil)
+	c.Assert(r.currGset, IsNil)
+
+	c.Assert(recordRelayLogGap(uuidDir, file, relayLogGap{Start: 100, End: 150, GTIDSet: "b60868af-5a6f-11e9-9ea3-0242ac160006:1-8"}), IsNil)
+	c.Assert(recordRelayLogGap(uuidDir, "mysql-bin.000002", relayLogGap{Start: 100, End: 150, GTIDSet: "b60868af-5a6f-11e9-9ea3-0242ac160006:1-20"}), IsNil)
+	// replaces the gap recorded before with the same start position
+	c.Assert(recordRelayLogGap(uuidDir, file, relayLogGap{Start: 100, End: 150, GTIDSet: gapGset}), IsNil)
+	gaps, err := readRelayLogGaps(localRelayStorage{}, uuidDir)
+	c.Assert(err, IsNil)
+	c.Assert(gaps.Files[file], HasLen, 1)
+
+	c.Assert(r.mergeGapGtidSet(state, dummyEv), IsNil)
+	c.Assert(r.currGset.String(), Equals, gapGset)
+	c.Assert(r.prevGset.String(), Equals, mysqlGset)
+
+	// the events after the hole advance the GTID sets from it
+	notUpdated, err := r.advanceCurrentGtidSet("b60868af-5a6f-11e9-9ea3-0242ac160006:11")
+	c.Assert(err, IsNil)
+	c.Assert(notUpdated, IsFalse)
+	c.Assert(r.currGset.String(), Equals, "b60868af-5a6f-11e9-9ea3-0242ac160006:1-11")
+}
+
 func (t *testReaderSuite) TestReParseUsingGTID(c *C) {
 	var (
 		baseDir   = c.MkDir()
@@ -0,0 +1,305 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/errors"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+// ValidateIssueType is the type of the issue found when validating the relay directory.
+type ValidateIssueType string
+
+// types of ValidateIssue.
+const (
+	// ValidateIssueCorruptedEvent means an event (or the binlog file header) can't be parsed.
+	ValidateIssueCorruptedEvent ValidateIssueType = "corrupted event"
+	// ValidateIssueFileGap means some relay log files are missing in a relay sub directory.
+	ValidateIssueFileGap ValidateIssueType = "file gap"
+	// ValidateIssueUUIDIndex means the relay server-uuid index file is inconsistent with the relay sub directories.
+	ValidateIssueUUIDIndex ValidateIssueType = "uuid index"
+)
+
+// ValidateIssue is an issue found when validating the relay directory.
+type ValidateIssue struct {
+	Type    ValidateIssueType
+	SubDir  string // relay sub directory (UUID with suffix)
+	File    string // relay log file, empty if the issue is not about a file
+	Pos     int64  // offset in the relay log file, only for ValidateIssueCorruptedEvent
+	Message string
+}
+
+// String implements Stringer.String.
+func (i ValidateIssue) String() string {
+	return fmt.Sprintf("%s: sub-dir %s, file %s, pos %d, %s", i.Type, i.SubDir, i.File, i.Pos, i.Message)
+}
+
+// ValidateReport is the report of validating the relay directory.
+type ValidateReport struct {
+	SubDirs int // number of relay sub directories walked
+	Files   int // number of relay log files parsed
+	Events  int // number of events parsed
+	Issues  []ValidateIssue
+}
+
+// Validate walks all relay sub directories from `fromPos` (UUID-suffixed position like StartSyncByPos), parses every
+// relay log file, and reports corrupted events, gaps between relay log files and inconsistencies of the relay
+// server-uuid index file, without streaming any events. it validates from the beginning if `fromPos` has no name.
+// it can be used when the reader is not started, but not for relay log files in a remote storage.
+func (r *BinlogReader) Validate(ctx context.Context, fromPos mysql.Position) (*ValidateReport, error) {
+	if r.storage.remote() {
+		return nil, errors.NotSupportedf("validate relay log files in remote storage %s", r.cfg.RelayDir)
+	}
+	uuids, err := utils.ParseUUIDIndex(r.indexPath)
+	if err != nil {
+		return nil, err
+	}
+	report := &ValidateReport{}
+	validateUUIDIndex(r.cfg.RelayDir, uuids, report)
+
+	var (
+		startUUID string
+		startPos  = mysql.Position{Pos: binlog.FileHeaderLen}
+	)
+	if fromPos.Name != "" {
+		startUUID, _, startPos, err = binlog.ExtractPos(fromPos, uuids)
+		if err != nil {
+			return nil, terror.Annotatef(err, "parse relay dir with pos %s", fromPos)
+		}
+		if startPos.Pos < binlog.FileHeaderLen {
+			startPos.Pos = binlog.FileHeaderLen
+		}
+	}
+
+	started := startUUID == ""
+	for i, uuid := range uuids {
+		if !started {
+			if uuid != startUUID {
+				continue
+			}
+			started = true
+		}
+		dir := filepath.Join(r.cfg.RelayDir, uuid)
+		if !utils.IsDirExists(dir) {
+			continue // reported by validateUUIDIndex
+		}
+		files, err2 := CollectAllBinlogFiles(dir)
+		if err2 != nil {
+			return nil, err2
+		}
+		report.SubDirs++
+		validateFileGaps(uuid, files, report)
+
+		for j, file := range files {
+			offset := int64(binlog.FileHeaderLen)
+			if uuid == startUUID {
+				// skip files before the start one in the sub directory of `fromPos`
+				if file != startPos.Name && !fileGreaterThan(file, startPos.Name) {
+					continue
+				} else if file == startPos.Name {
+					offset = int64(startPos.Pos)
+				}
+			}
+			// the newest relay log file may be being written
+			possibleActive := i == len(uuids)-1 && j == len(files)-1
+			if err3 := r.validateFile(ctx, uuid, filepath.Join(dir, file), offset, possibleActive, report); err3 != nil {
+				return nil, err3
+			}
+		}
+	}
+
+	r.tctx.L().Info("relay directory validated", zap.String("directory", r.cfg.RelayDir),
+		zap.Int("sub directories", report.SubDirs), zap.Int("files", report.Files),
+		zap.Int("events", report.Events), zap.Int("issues", len(report.Issues)))
+	return report, nil
+}
+
+// validateUUIDIndex checks the UUIDs in the index file against the relay sub directories.
+func validateUUIDIndex(relayDir string, uuids []string, report *ValidateReport) {
+	indexed := make(map[string]struct{}, len(uuids))
+	lastSuffix := 0
+	for _, uuid := range uuids {
+		indexed[uuid] = struct{}{}
+		_, suffix, err := utils.ParseSuffixForUUID(uuid)
+		if err != nil {
+			report.Issues = append(report.Issues, ValidateIssue{
+				Type: ValidateIssueUUIDIndex, SubDir: uuid, Message: err.Error(),
+			})
+			continue
+		}
+		if suffix <= lastSuffix {
+			report.Issues = append(report.Issues, ValidateIssue{
+				Type: ValidateIssueUUIDIndex, SubDir: uuid,
+				Message: fmt.Sprintf("suffix %d is not larger than the previous one %d", suffix, lastSuffix),
+			})
+		}
+		lastSuffix = suffix
+		if !utils.IsDirExists(filepath.Join(relayDir, uuid)) {
+			report.Issues = append(report.Issues, ValidateIssue{
+				Type: ValidateIssueUUIDIndex, SubDir: uuid, Message: "sub directory in the index file not exists",
+			})
+		}
+	}
+
+	entries, err := os.ReadDir(relayDir)
+	if err != nil {
+		report.Issues = append(report.Issues, ValidateIssue{
+			Type: ValidateIssueUUIDIndex, Message: fmt.Sprintf("read relay directory: %v", err),
+		})
+		return
+	}
+	for _, entry := range entries {
+		if !isDirEntryDir(relayDir, entry) {
+			continue
+		}
+		if _, _, err = utils.ParseSuffixForUUID(entry.Name()); err != nil {
+			continue // not a relay sub directory
+		}
+		if _, ok := indexed[entry.Name()]; !ok {
+			report.Issues = append(report.Issues, ValidateIssue{
+				Type: ValidateIssueUUIDIndex, SubDir: entry.Name(), Message: "sub directory not in the index file",
+			})
+		}
+	}
+}
+
+// validateFileGaps checks whether the sorted relay log files in a sub directory have continuous indexes.
+func validateFileGaps(uuid string, files []string, report *ValidateReport) {
+	var lastIndex int64
+	for i, file := range files {
+		index, err := binlog.GetFilenameIndex(file)
+		if err != nil {
+			report.Issues = append(report.Issues, ValidateIssue{
+				Type: ValidateIssueFileGap, SubDir: uuid, File: file, Message: err.Error(),
+			})
+			continue
+		}
+		if i > 0 && index != lastIndex+1 {
+			report.Issues = append(report.Issues, ValidateIssue{
+				Type: ValidateIssueFileGap, SubDir: uuid, File: file,
+				Message: fmt.Sprintf("%d relay log files missing before it", index-lastIndex-1),
+			})
+		}
+		lastIndex = index
+	}
+}
+
+// validateFile parses the relay log file from the offset, and reports the first corrupted event.
+func (r *BinlogReader) validateFile(ctx context.Context, uuid, fullPath string, offset int64, possibleActive bool, report *ValidateReport) error {
+	file := filepath.Base(fullPath)
+	addIssue := func(pos int64, msg string) {
+		if possibleActive {
+			msg += " (the file may be being written)"
+		}
+		report.Issues = append(report.Issues, ValidateIssue{
+			Type: ValidateIssueCorruptedEvent, SubDir: uuid, File: file, Pos: pos, Message: msg,
+		})
+	}
+
+	key, err := r.relayLogKey(fullPath)
+	if err != nil {
+		return terror.ErrGetRelayLogStat.Delegate(err, fullPath)
+	}
+	f, _, err := openRelayLogFile(r.storage, fullPath, key)
+	if err != nil {
+		return terror.ErrGetRelayLogStat.Delegate(err, fullPath)
+	}
+	defer f.Close()
+	report.Files++
+
+	if exist, err2 := checkBinlogHeaderExistFd(f); err2 != nil {
+		addIssue(0, err2.Error())
+		return nil
+	} else if !exist {
+		addIssue(0, "binlog file header not exists")
+		return nil
+	}
+
+	// FormatDescriptionEvent is always parsed for the checksum algorithm
+	var (
+		parser = newRelayLogParser(r.cfg)
+		pos    = int64(binlog.FileHeaderLen)
+		cr     = &countingReader{r: f, n: pos}
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		var eventSize uint32
+		eof, err2 := parser.ParseSingleEvent(cr, func(e *replication.BinlogEvent) error {
+			eventSize = e.Header.EventSize
+			return nil
+		})
+		if err2 != nil {
+			addIssue(pos, err2.Error())
+			return nil
+		}
+		if eof {
+			if cr.n > pos {
+				addIssue(pos, fmt.Sprintf("incomplete event header with %d bytes", cr.n-pos))
+			}
+			return nil
+		}
+		report.Events++
+		pos += int64(eventSize)
+
+		// skip to the offset after FormatDescriptionEvent parsed
+		if pos < offset {
+			if _, err2 = f.Seek(offset, io.SeekStart); err2 != nil {
+				return terror.ErrParserParseRelayLog.Delegate(err2, fullPath)
+			}
+			pos, cr.n = offset, offset
+		}
+	}
+}
+
+// countingReader counts the bytes read.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+// Read implements io.Reader.
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// fileGreaterThan returns whether the relay log file `a` is after `b`, invalid filenames are not greater.
+func fileGreaterThan(a, b string) bool {
+	fa, err := binlog.ParseFilename(a)
+	if err != nil {
+		return false
+	}
+	fb, err := binlog.ParseFilename(b)
+	if err != nil {
+		return false
+	}
+	return fa.GreaterThan(fb)
+}
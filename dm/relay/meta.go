@@ -402,14 +402,7 @@ func (lm *LocalMeta) String() string {
 
 // updateIndexFile updates the content of server-uuid.index file.
 func (lm *LocalMeta) updateIndexFile(uuids []string) error {
-	var buf bytes.Buffer
-	for _, uuid := range uuids {
-		buf.WriteString(uuid)
-		buf.WriteString("\n")
-	}
-
-	err := utils.WriteFileAtomic(lm.uuidIndexPath, buf.Bytes(), 0o644)
-	return terror.ErrRelayUpdateIndexFile.Delegate(err, lm.uuidIndexPath)
+	return writeUUIDIndex(lm.uuidIndexPath, uuids)
 }
 
 func (lm *LocalMeta) verifyUUIDs(uuids []string) error {
@@ -475,7 +468,7 @@ func (lm *LocalMeta) loadMetaData() error {
 	}
 
 	if len(lm.BinlogGTID) != 0 {
-		gset, err := gtid.ParserGTID("", lm.BinlogGTID)
+		gset, err := gtid.ParserGTID(lm.flavor, lm.BinlogGTID)
 		if err != nil {
 			return terror.ErrRelayLoadMetaData.Delegate(err)
 		}
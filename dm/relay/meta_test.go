@@ -297,3 +297,45 @@ func (r *testMetaSuite) TestLocalMetaPotentialDataRace(c *C) {
 	c.Assert(ch2Err, IsNil)
 	c.Logf("GTID string from the go routine: %s", gtidString)
 }
+
+func (r *testMetaSuite) TestLocalMetaTakeOver(c *C) {
+	dir := c.MkDir()
+	gs := "85ab69d1-b21f-11e6-9c5e-64006a8978d2:1-12"
+
+	// take over with empty local meta
+	lm := NewLocalMeta("mysql", dir).(*LocalMeta)
+	c.Assert(lm.Load(), IsNil)
+	tookOver, err := lm.takeOver("server-a-uuid.000002", mysql.Position{Name: "mysql-bin.000003", Pos: 123}, gs)
+	c.Assert(err, IsNil)
+	c.Assert(tookOver, IsTrue)
+
+	lm2 := NewLocalMeta("mysql", dir)
+	c.Assert(lm2.Load(), IsNil)
+	uuid, pos := lm2.Pos()
+	c.Assert(uuid, Equals, "server-a-uuid.000002")
+	c.Assert(pos, DeepEquals, mysql.Position{Name: "mysql-bin.000003", Pos: 123})
+	_, gset := lm2.GTID()
+	c.Assert(gset.String(), Equals, gs)
+
+	// older meta is not taken over
+	tookOver, err = lm.takeOver("server-a-uuid.000002", mysql.Position{Name: "mysql-bin.000003", Pos: 4}, gs)
+	c.Assert(err, IsNil)
+	c.Assert(tookOver, IsFalse)
+	tookOver, err = lm.takeOver("server-a-uuid.000001", mysql.Position{Name: "mysql-bin.000004", Pos: 4}, gs)
+	c.Assert(err, IsNil)
+	c.Assert(tookOver, IsFalse)
+
+	// the next sub directory is appended to the index
+	tookOver, err = lm.takeOver("server-b-uuid.000003", mysql.Position{Name: "mysql-bin.000001", Pos: 4}, gs)
+	c.Assert(err, IsNil)
+	c.Assert(tookOver, IsTrue)
+	c.Assert(lm.uuids, DeepEquals, []string{"server-a-uuid.000002", "server-b-uuid.000003"})
+
+	// the index only contains the sub directory if not continuous
+	tookOver, err = lm.takeOver("server-c-uuid.000005", mysql.Position{Name: "mysql-bin.000001", Pos: 4}, gs)
+	c.Assert(err, IsNil)
+	c.Assert(tookOver, IsTrue)
+	c.Assert(lm.uuids, DeepEquals, []string{"server-c-uuid.000005"})
+	c.Assert(lm2.Load(), IsNil)
+	c.Assert(lm2.UUID(), Equals, "server-c-uuid.000005")
+}
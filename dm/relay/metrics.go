@@ -89,6 +89,49 @@ var (
 			Buckets:   prometheus.ExponentialBuckets(0.000005, 2, 25),
 		})
 
+	relayLogWriteBytesCounter = metricsproxy.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "write_bytes",
+			Help:      "bytes written into the relay log file, the rate of it is the write throughput",
+		}, []string{"file"})
+
+	relayLogWriteEventCounter = metricsproxy.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "write_event_count",
+			Help:      "counter of events written into relay log files",
+		}, []string{"type"})
+
+	// should alert.
+	relayBinlogGapGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "binlog_gap_bytes",
+			Help:      "size of upstream binlog not pulled into relay log files yet",
+		})
+
+	relayLogFsyncDurationHistogram = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "fsync_duration",
+			Help:      "bucketed histogram of fsync time (s) of relay log file",
+			Buckets:   prometheus.ExponentialBuckets(0.000005, 2, 25),
+		})
+
+	relayLogFsyncSizeHistogram = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "fsync_size",
+			Help:      "size of relay log data synced by single fsync",
+			Buckets:   prometheus.ExponentialBuckets(16, 2, 20),
+		})
+
 	// should alert.
 	relayLogWriteErrorCounter = prometheus.NewCounter(
 		prometheus.CounterOpts{
@@ -125,6 +168,89 @@ var (
 			Buckets:   prometheus.ExponentialBuckets(0.000005, 2, 25),
 		})
 
+	relayReaderChannelOccupancyGauge = metricsproxy.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "reader_channel_occupancy",
+			Help:      "number of events buffered in the channel of relay log streamer",
+		}, []string{"task", "source_id"})
+
+	relayReaderBlockedSendDurationHistogram = metricsproxy.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "reader_blocked_send_duration",
+			Help:      "bucketed histogram of blocked time (s) of sending an event to the full channel of relay log streamer",
+			Buckets:   prometheus.ExponentialBuckets(0.000005, 2, 25),
+		}, []string{"task", "source_id"})
+
+	relayReaderBinlogFileGauge = metricsproxy.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "reader_binlog_file",
+			Help:      "index of the relay log file being parsed by relay log reader",
+		}, []string{"task", "source_id"})
+
+	relayReaderBinlogPosGauge = metricsproxy.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "reader_binlog_pos",
+			Help:      "position of the latest event parsed by relay log reader in current relay log file",
+		}, []string{"task", "source_id"})
+
+	relayReaderBytesBehindGauge = metricsproxy.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "reader_bytes_behind",
+			Help:      "size of relay log data not parsed by relay log reader yet",
+		}, []string{"task", "source_id"})
+
+	relayReaderEventCounter = metricsproxy.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "reader_event_count",
+			Help:      "counter of events sent by relay log reader",
+		}, []string{"task", "source_id"})
+
+	relayPurgeBlockedCounter = metricsproxy.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "purge_blocked_count",
+			Help:      "counter of relay log purges blocked by the position of a relay log reader or task",
+		}, []string{"reader"})
+
+	relayDiskQuotaUsageGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "disk_quota_usage",
+			Help:      "the size of relay directory scanned for the disk quota",
+		})
+
+	// should alert.
+	relayDiskQuotaExhaustedGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "disk_quota_exhausted",
+			Help:      "whether relay pauses pulling binlog events because the disk quota is approached, 1 means paused",
+		})
+
+	// should alert.
+	relayDiskQuotaExhaustedCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "disk_quota_exhausted_count",
+			Help:      "counter of relay pausing pulling binlog events because the disk quota is approached",
+		})
+
 	// should alert.
 	relayExitWithErrorCounter = prometheus.NewCounter(
 		prometheus.CounterOpts{
@@ -144,11 +270,26 @@ func RegisterMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(relayLogDataCorruptionCounter)
 	registry.MustRegister(relayLogWriteSizeHistogram)
 	registry.MustRegister(relayLogWriteDurationHistogram)
+	registry.MustRegister(relayLogWriteBytesCounter)
+	registry.MustRegister(relayLogWriteEventCounter)
+	registry.MustRegister(relayBinlogGapGauge)
+	registry.MustRegister(relayLogFsyncDurationHistogram)
+	registry.MustRegister(relayLogFsyncSizeHistogram)
 	registry.MustRegister(relayLogWriteErrorCounter)
 	registry.MustRegister(binlogReadErrorCounter)
 	registry.MustRegister(binlogReadDurationHistogram)
 	registry.MustRegister(binlogTransformDurationHistogram)
 	registry.MustRegister(relayExitWithErrorCounter)
+	registry.MustRegister(relayReaderChannelOccupancyGauge)
+	registry.MustRegister(relayReaderBlockedSendDurationHistogram)
+	registry.MustRegister(relayReaderBinlogFileGauge)
+	registry.MustRegister(relayReaderBinlogPosGauge)
+	registry.MustRegister(relayReaderBytesBehindGauge)
+	registry.MustRegister(relayReaderEventCounter)
+	registry.MustRegister(relayPurgeBlockedCounter)
+	registry.MustRegister(relayDiskQuotaUsageGauge)
+	registry.MustRegister(relayDiskQuotaExhaustedGauge)
+	registry.MustRegister(relayDiskQuotaExhaustedCounter)
 }
 
 func reportRelayLogSpaceInBackground(ctx context.Context, dirpath string) error {
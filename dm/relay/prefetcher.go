@@ -0,0 +1,115 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// filePrefetcher parses a complete (non-active) relay log file in background,
+// and buffers the parsed events in a bounded channel until they are consumed.
+type filePrefetcher struct {
+	storage    relayStorage
+	fullPath   string
+	encryptKey []byte
+	pause      *pauseController
+	sizeGuard  *eventSizeGuard
+
+	ch     chan *replication.BinlogEvent
+	err    error // available after ch closed
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// newFilePrefetcher starts to parse the relay log file from its beginning with a new parser created by `newParser`,
+// the relay log file is read through `sizeGuard`.
+func newFilePrefetcher(ctx context.Context, storage relayStorage, fullPath string, bufferSize int, encryptKey []byte, pause *pauseController, sizeGuard *eventSizeGuard, newParser func() *replication.BinlogParser) *filePrefetcher {
+	ctx, cancel := context.WithCancel(ctx)
+	p := &filePrefetcher{
+		storage:    storage,
+		fullPath:   fullPath,
+		encryptKey: encryptKey,
+		pause:      pause,
+		sizeGuard:  sizeGuard,
+		ch:         make(chan *replication.BinlogEvent, bufferSize),
+		cancel:     cancel,
+	}
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		defer close(p.ch)
+		p.err = p.run(ctx, newParser())
+	}()
+	return p
+}
+
+func (p *filePrefetcher) run(ctx context.Context, parser *replication.BinlogParser) error {
+	f, _, err := openRelayLogFile(p.storage, p.fullPath, p.encryptKey)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err = f.Seek(binlog.FileHeaderLen, io.SeekStart); err != nil {
+		return terror.ErrParserParseRelayLog.Delegate(err, p.fullPath)
+	}
+
+	err = parser.ParseReader(p.sizeGuard.wrap(f), func(e *replication.BinlogEvent) error {
+		p.sizeGuard.observe(e)
+		if err2 := p.pause.wait(ctx); err2 != nil {
+			return err2
+		}
+		select {
+		case p.ch <- e:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+	if err != nil {
+		return terror.ErrParserParseRelayLog.Delegate(err, p.fullPath)
+	}
+	return nil
+}
+
+// consume calls `onEvent` for all prefetched events in order, and returns the first error met.
+func (p *filePrefetcher) consume(ctx context.Context, onEvent func(*replication.BinlogEvent) error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case e, ok := <-p.ch:
+			if !ok {
+				return p.err
+			}
+			if err := onEvent(e); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// close stops the background parsing and waits for it to exit.
+func (p *filePrefetcher) close() {
+	p.cancel()
+	p.wg.Wait()
+}
@@ -35,6 +35,8 @@ const (
 	strategyFilename
 	strategyTime
 	strategySpace
+	strategyArchived
+	strategySubDirGC
 )
 
 func (s strategyType) String() string {
@@ -47,6 +49,10 @@ func (s strategyType) String() string {
 		return "time strategy"
 	case strategySpace:
 		return "space strategy"
+	case strategyArchived:
+		return "archived strategy"
+	case strategySubDirGC:
+		return "sub directory GC"
 	default:
 		return "unknown strategy"
 	}
@@ -358,3 +364,71 @@ func (s *timeStrategy) Purging() bool {
 func (s *timeStrategy) Type() strategyType {
 	return strategyTime
 }
+
+// archivedArgs represents args needed by archivedStrategy.
+type archivedArgs struct {
+	files []string // local paths of the archived relay log files
+}
+
+// SetActiveRelayLog implements StrategyArgs.SetActiveRelayLog, the active relay log files are not considered,
+// as readers fall back to the archive.
+func (aa *archivedArgs) SetActiveRelayLog(active *streamer.RelayLogInfo) {
+}
+
+func (aa *archivedArgs) String() string {
+	return fmt.Sprintf("(Files: %s)", strings.Join(aa.files, ";"))
+}
+
+// archivedStrategy represents a relay purge strategy which purges the relay log files archived to an external storage.
+type archivedStrategy struct {
+	purging atomic.Bool
+
+	logger log.Logger
+}
+
+func newArchivedStrategy() PurgeStrategy {
+	return &archivedStrategy{
+		logger: log.With(zap.String("component", "relay purger"), zap.String("strategy", "archived")),
+	}
+}
+
+func (s *archivedStrategy) Check(args interface{}) (bool, error) {
+	aa, ok := args.(*archivedArgs)
+	if !ok {
+		return false, terror.ErrRelayPurgeArgsNotValid.Generate(args, args)
+	}
+	for _, f := range aa.files {
+		if utils.IsFileExists(f) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *archivedStrategy) Do(args interface{}) error {
+	if !s.purging.CAS(false, true) {
+		return terror.ErrRelayThisStrategyIsPurging.Generate()
+	}
+	defer s.purging.Store(false)
+
+	aa, ok := args.(*archivedArgs)
+	if !ok {
+		return terror.ErrRelayPurgeArgsNotValid.Generate(args, args)
+	}
+
+	for _, f := range aa.files {
+		if err := removeRelayPath(f); err != nil {
+			return terror.ErrRelayRemoveFileFail.Delegate(err, "file", f)
+		}
+		s.logger.Info("purged archived relay log file", zap.String("file", f))
+	}
+	return nil
+}
+
+func (s *archivedStrategy) Purging() bool {
+	return s.purging.Load()
+}
+
+func (s *archivedStrategy) Type() strategyType {
+	return strategyArchived
+}
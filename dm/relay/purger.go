@@ -15,6 +15,7 @@ package relay
 
 import (
 	"context"
+	"os"
 	"path/filepath"
 	"sync"
 	"time"
@@ -36,6 +37,25 @@ type Operator interface {
 	EarliestActiveRelayLog() *streamer.RelayLogInfo
 }
 
+// ArchiveOperator represents an operator which archives relay log files to an external storage.
+type ArchiveOperator interface {
+	// ArchivedRelayLogs returns the local paths of relay log files which have been archived, they can be purged
+	// even if they are not read yet, as readers fall back to the archive.
+	ArchivedRelayLogs() []string
+}
+
+// ReaderOperator represents an operator which has registered relay log readers.
+type ReaderOperator interface {
+	// ActiveReaderRelayLogs returns the relay log files being read by the registered readers
+	ActiveReaderRelayLogs() []*streamer.RelayLogInfo
+}
+
+// UUIDIndexOperator represents an operator which maintains the server-uuid index file, like the relay.
+type UUIDIndexOperator interface {
+	// TrimUUIDs removes the UUIDs whose sub directories not exist from the index file, returns the removed ones
+	TrimUUIDs() ([]string, error)
+}
+
 // PurgeInterceptor represents an interceptor may forbid the purge process.
 type PurgeInterceptor interface {
 	// ForbidPurge returns whether forbidding purge currently and an optional message
@@ -75,6 +95,9 @@ type relayPurger struct {
 	baseRelayDir string
 	indexPath    string // server-uuid.index file path
 	operators    []Operator
+	archivers    []ArchiveOperator // operators which archive relay log files
+	readers      []ReaderOperator  // operators which have registered relay log readers
+	indexers     []UUIDIndexOperator
 	interceptors []PurgeInterceptor
 	strategies   map[strategyType]PurgeStrategy
 
@@ -93,11 +116,24 @@ func NewRelayPurger(cfg config.PurgeConfig, baseRelayDir string, operators []Ope
 		logger:       log.With(zap.String("component", "relay purger")),
 	}
 
+	for _, op := range operators {
+		if ao, ok := op.(ArchiveOperator); ok {
+			p.archivers = append(p.archivers, ao)
+		}
+		if ro, ok := op.(ReaderOperator); ok {
+			p.readers = append(p.readers, ro)
+		}
+		if uo, ok := op.(UUIDIndexOperator); ok {
+			p.indexers = append(p.indexers, uo)
+		}
+	}
+
 	// add strategies
 	p.strategies[strategyInactive] = newInactiveStrategy()
 	p.strategies[strategyFilename] = newFilenameStrategy()
 	p.strategies[strategyTime] = newTimeStrategy()
 	p.strategies[strategySpace] = newSpaceStrategy()
+	p.strategies[strategyArchived] = newArchivedStrategy()
 
 	return p
 }
@@ -108,7 +144,7 @@ func (p *relayPurger) Start() {
 		return
 	}
 
-	if p.cfg.Interval <= 0 || (p.cfg.Expires <= 0 && p.cfg.RemainSpace <= 0) {
+	if p.cfg.Interval <= 0 || (p.cfg.Expires <= 0 && p.cfg.RemainSpace <= 0 && p.cfg.SubDirRetention <= 0 && len(p.archivers) == 0) {
 		return // no need do purge in the background
 	}
 
@@ -138,6 +174,11 @@ func (p *relayPurger) run() {
 			return
 		case <-ticker.C:
 			p.tryPurge()
+			if p.cfg.SubDirRetention > 0 {
+				if err := p.gcSubDirs(); err != nil {
+					p.logger.Error("GC relay sub directories", zap.Error(err))
+				}
+			}
 		}
 	}
 }
@@ -235,6 +276,11 @@ func (p *relayPurger) doPurge(ps PurgeStrategy, args StrategyArgs) error {
 	if earliest == nil {
 		return terror.ErrRelayNoActiveRelayLog.Generate()
 	}
+	if earliest.TaskName != fakeRelayTaskName {
+		// relay log files not read by the reader or task yet are kept even if they match the strategy
+		relayPurgeBlockedCounter.WithLabelValues(earliest.TaskName).Inc()
+		p.logger.Info("purge is blocked by the active relay log of reader", zap.String("reader", earliest.TaskName), zap.Stringer("relay log", earliest))
+	}
 	args.SetActiveRelayLog(earliest)
 
 	p.logger.Info("start purging relay log files", zap.Stringer("type", ps.Type()), zap.Any("args", args))
@@ -288,20 +334,142 @@ func (p *relayPurger) check() (PurgeStrategy, StrategyArgs, error) {
 		}
 	}
 
+	// 5. strategyArchived should be started if some relay log files are archived
+	if len(p.archivers) > 0 {
+		args := &archivedArgs{}
+		for _, ao := range p.archivers {
+			args.files = append(args.files, ao.ArchivedRelayLogs()...)
+		}
+		ps := p.strategies[strategyArchived]
+		need, err := ps.Check(args)
+		if err != nil {
+			return nil, nil, terror.Annotatef(err, "check with %s with args %+v", ps.Type(), args)
+		}
+		if need {
+			return ps, args, nil
+		}
+	}
+
 	return nil, nil, nil
 }
 
-// earliestActiveRelayLog returns the current earliest active relay log info.
+// gcSubDirs removes the relay sub directories which have no relay log files, are not modified within the retention
+// and are earlier than the earliest active relay log, then trims them from the UUID index file.
+// the active relay logs of readers and task checkpoints are always considered even if IgnoreActiveReaders is set.
+func (p *relayPurger) gcSubDirs() error {
+	if !p.purgingStrategy.CAS(uint32(strategyNone), uint32(strategySubDirGC)) {
+		return terror.ErrRelayOtherStrategyIsPurging.Generate(strategySubDirGC)
+	}
+	defer p.purgingStrategy.Store(uint32(strategyNone))
+
+	for _, inter := range p.interceptors {
+		forbidden, msg := inter.ForbidPurge()
+		if forbidden {
+			return terror.ErrRelayPurgeIsForbidden.Generate(msg)
+		}
+	}
+	earliest := p.earliestRelayLog(true)
+	if earliest == nil {
+		return terror.ErrRelayNoActiveRelayLog.Generate()
+	}
+	uuids, err := utils.ParseUUIDIndex(p.indexPath)
+	if err != nil {
+		return terror.Annotatef(err, "parse UUID index file %s", p.indexPath)
+	}
+
+	safeTime := time.Now().Add(time.Duration(-p.cfg.SubDirRetention) * time.Hour)
+	needTrim := false
+	// the latest sub directory is always kept
+	for i := 0; i+1 < len(uuids); i++ {
+		_, suffix, err2 := utils.ParseSuffixForUUID(uuids[i])
+		if err2 != nil {
+			return err2
+		}
+		if suffix >= earliest.UUIDSuffix {
+			break
+		}
+		dir := filepath.Join(p.baseRelayDir, uuids[i])
+		fi, err2 := os.Stat(dir)
+		if err2 != nil {
+			if os.IsNotExist(err2) {
+				needTrim = true
+				continue
+			}
+			return terror.ErrGetRelayLogStat.Delegate(err2, dir)
+		}
+		// the modified time of the directory is updated when the relay log files in it are purged
+		if fi.ModTime().After(safeTime) {
+			continue
+		}
+		files, err2 := CollectAllBinlogFiles(dir)
+		if err2 != nil {
+			return terror.Annotatef(err2, "dir %s", dir)
+		}
+		if len(files) > 0 {
+			continue
+		}
+		p.logger.Info("GC relay sub directory", zap.String("directory", dir), zap.Time("modified time", fi.ModTime()))
+		if err2 = removeRelayPath(dir); err2 != nil {
+			return terror.ErrRelayRemoveFileFail.Delegate(err2, "dir", dir)
+		}
+		needTrim = true
+	}
+	if !needTrim {
+		return nil
+	}
+	return p.trimUUIDIndex(uuids)
+}
+
+// trimUUIDIndex removes the UUIDs whose sub directories not exist from the index file by the operators maintaining
+// it, so they can update the UUIDs in memory too. the index file is written directly if no such operator.
+func (p *relayPurger) trimUUIDIndex(uuids []string) error {
+	if len(p.indexers) > 0 {
+		for _, uo := range p.indexers {
+			trimmed, err := uo.TrimUUIDs()
+			if err != nil {
+				return err
+			}
+			p.logger.Info("trim UUIDs", zap.Strings("UUIDs", trimmed))
+		}
+		return nil
+	}
+	kept := make([]string, 0, len(uuids))
+	for _, uuid := range uuids {
+		if utils.IsDirExists(filepath.Join(p.baseRelayDir, uuid)) {
+			kept = append(kept, uuid)
+		}
+	}
+	p.logger.Info("trim UUIDs", zap.Strings("UUIDs", kept))
+	return writeUUIDIndex(p.indexPath, kept)
+}
+
+// earliestActiveRelayLog returns the current earliest active relay log info, including the ones being read by
+// the registered readers. only the one being written by the relay is considered if IgnoreActiveReaders is set.
 func (p *relayPurger) earliestActiveRelayLog() *streamer.RelayLogInfo {
+	return p.earliestRelayLog(!p.cfg.IgnoreActiveReaders)
+}
+
+// earliestRelayLog returns the current earliest active relay log info, the ones being read by the registered readers
+// are included if `withReaders` is true.
+func (p *relayPurger) earliestRelayLog(withReaders bool) *streamer.RelayLogInfo {
 	var earliest *streamer.RelayLogInfo
-	for _, op := range p.operators {
-		info := op.EarliestActiveRelayLog()
-		if info == nil {
-			continue
+	update := func(info *streamer.RelayLogInfo) {
+		if info == nil || (!withReaders && info.TaskName != fakeRelayTaskName) {
+			return
 		} else if earliest == nil || info.Earlier(earliest) {
 			earliest = info
 		}
 	}
+	for _, op := range p.operators {
+		update(op.EarliestActiveRelayLog())
+	}
+	if withReaders {
+		for _, ro := range p.readers {
+			for _, info := range ro.ActiveReaderRelayLogs() {
+				update(info)
+			}
+		}
+	}
 	return earliest
 }
 
@@ -128,7 +128,7 @@ func collectRelayFilesBeforeFileAndTime(logger log.Logger, relayBaseDir string,
 		}
 		fullFiles := make([]string, 0, len(shortFiles))
 		for _, f := range shortFiles {
-			fp := filepath.Join(dir, f)
+			fp, _ := resolveRelayLogFile(localRelayStorage{}, filepath.Join(dir, f))
 			if safeTime.Unix() > 0 {
 				// check modified time
 				fs, err := os.Stat(fp)
@@ -168,7 +168,7 @@ func purgeRelayFiles(logger log.Logger, files []*subRelayFiles) error {
 	for _, subRelay := range files {
 		for _, f := range subRelay.files {
 			logger.Info("purging relay log file", zap.String("file", f))
-			err := os.Remove(f)
+			err := removeRelayPath(f)
 			if err != nil {
 				return terror.ErrRelayRemoveFileFail.Delegate(err, "file", f)
 			}
@@ -176,7 +176,7 @@ func purgeRelayFiles(logger log.Logger, files []*subRelayFiles) error {
 		if subRelay.hasAll {
 			// if all relay log files removed, remove the directory and all other files (like relay.meta)
 			logger.Info("purging relay log directory", zap.String("directory", subRelay.dir))
-			err := os.RemoveAll(subRelay.dir)
+			err := removeRelayPath(subRelay.dir)
 			if err != nil {
 				return terror.ErrRelayRemoveFileFail.Delegate(err, "dir", subRelay.dir)
 			}
@@ -21,10 +21,12 @@ import (
 	"strings"
 	"time"
 
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
 	. "github.com/pingcap/check"
 
 	"github.com/pingcap/tiflow/dm/dm/config"
 	"github.com/pingcap/tiflow/dm/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/pingcap/tiflow/dm/pkg/streamer"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 )
@@ -340,3 +342,166 @@ func (t *testPurgerSuite) TestPurgerInterceptor(c *C) {
 	c.Assert(err, NotNil)
 	c.Assert(strings.Contains(err.Error(), interceptor.msg), IsTrue)
 }
+
+type fakeArchiveOperator struct {
+	files []string
+}
+
+func (o *fakeArchiveOperator) EarliestActiveRelayLog() *streamer.RelayLogInfo {
+	return nil
+}
+
+func (o *fakeArchiveOperator) ArchivedRelayLogs() []string {
+	return o.files
+}
+
+func (t *testPurgerSuite) TestPurgeAutomaticallyArchived(c *C) {
+	baseDir := c.MkDir()
+	relayDirsPath, relayFilesPath, _ := t.genRelayLogFiles(c, baseDir, -1, -1)
+	c.Assert(t.genUUIDIndexFile(baseDir), IsNil)
+
+	// the archived relay log files are purged even if they are newer than the active relay log
+	archived := []string{relayFilesPath[0][1], relayFilesPath[2][0], filepath.Join(relayDirsPath[2], "mysql-bin.000004")}
+	cfg := config.PurgeConfig{
+		Interval: 1, // enable automatically
+	}
+	purger := NewPurger(cfg, baseDir, []Operator{t, &fakeArchiveOperator{files: archived}}, nil)
+	purger.Start()
+	time.Sleep(2 * time.Second) // sleep enough time to purge the archived relay log files
+	purger.Close()
+
+	for i, fps := range relayFilesPath {
+		c.Assert(utils.IsDirExists(relayDirsPath[i]), IsTrue)
+		for j, fp := range fps {
+			c.Assert(utils.IsFileExists(fp), Equals, !(i == 0 && j == 1 || i == 2 && j == 0))
+		}
+	}
+
+	// nothing to purge
+	strategy, _, err := purger.(*relayPurger).check()
+	c.Assert(err, IsNil)
+	c.Assert(strategy, IsNil)
+}
+
+type fakeReaderOperator struct {
+	writer  *streamer.RelayLogInfo
+	readers []*streamer.RelayLogInfo
+}
+
+func (o *fakeReaderOperator) EarliestActiveRelayLog() *streamer.RelayLogInfo {
+	return o.writer
+}
+
+func (o *fakeReaderOperator) ActiveReaderRelayLogs() []*streamer.RelayLogInfo {
+	return o.readers
+}
+
+func (t *testPurgerSuite) TestPurgeRespectActiveReaders(c *C) {
+	baseDir := c.MkDir()
+	relayDirsPath, relayFilesPath, _ := t.genRelayLogFiles(c, baseDir, -1, -1)
+	c.Assert(t.genUUIDIndexFile(baseDir), IsNil)
+
+	writer := *t.activeRelayLog
+	writer.TaskName = fakeRelayTaskName
+	op := &fakeReaderOperator{
+		writer: &writer,
+		readers: []*streamer.RelayLogInfo{
+			{TaskName: "task-2", UUID: t.uuids[2], UUIDSuffix: 3, Filename: t.relayFiles[2][0]},
+			{TaskName: "task-1", UUID: t.uuids[1], UUIDSuffix: 2, Filename: t.relayFiles[1][1]},
+		},
+	}
+	req := &pb.PurgeRelayRequest{Inactive: true}
+
+	// the relay log files being read by the slowest reader are not purged
+	purger := NewPurger(config.PurgeConfig{}, baseDir, []Operator{op}, nil)
+	earliest := purger.(*relayPurger).earliestActiveRelayLog()
+	c.Assert(earliest.TaskName, Equals, "task-1")
+	c.Assert(purger.Do(context.Background(), req), IsNil)
+	c.Assert(utils.IsDirExists(relayDirsPath[0]), IsFalse)
+	c.Assert(utils.IsFileExists(relayFilesPath[1][0]), IsFalse)
+	c.Assert(utils.IsFileExists(relayFilesPath[1][1]), IsTrue)
+
+	// only the relay log file being written is kept if ignoring active readers
+	purger = NewPurger(config.PurgeConfig{IgnoreActiveReaders: true}, baseDir, []Operator{op}, nil)
+	earliest = purger.(*relayPurger).earliestActiveRelayLog()
+	c.Assert(earliest.TaskName, Equals, fakeRelayTaskName)
+	c.Assert(purger.Do(context.Background(), req), IsNil)
+	c.Assert(utils.IsFileExists(relayFilesPath[1][1]), IsFalse)
+	c.Assert(utils.IsFileExists(relayFilesPath[1][2]), IsTrue)
+}
+
+func (t *testPurgerSuite) TestActiveReaderRelayLogs(c *C) {
+	cfg := &BinlogReaderConfig{RelayDir: c.MkDir(), Flavor: gmysql.MySQLFlavor, Task: "task-1"}
+	r := newBinlogReaderForTest(log.L(), cfg, false, "")
+	relay := r.relay.(*Relay)
+
+	// not started to parse yet
+	c.Assert(relay.ActiveReaderRelayLogs(), HasLen, 0)
+
+	r.onFileProgress(t.uuids[1], t.relayFiles[1][1], 4)
+	c.Assert(relay.ActiveReaderRelayLogs(), DeepEquals, []*streamer.RelayLogInfo{
+		{TaskName: "task-1", UUID: t.uuids[1], UUIDSuffix: 2, Filename: t.relayFiles[1][1]},
+	})
+
+	// unregistered after closed
+	r.Close()
+	c.Assert(relay.ActiveReaderRelayLogs(), HasLen, 0)
+}
+
+type fakeUUIDIndexOperator struct {
+	fakeReaderOperator
+	trimmed int
+}
+
+func (o *fakeUUIDIndexOperator) TrimUUIDs() ([]string, error) {
+	o.trimmed++
+	return nil, nil
+}
+
+func (t *testPurgerSuite) TestGCSubDirs(c *C) {
+	baseDir := c.MkDir()
+	relayDirsPath, relayFilesPath, _ := t.genRelayLogFiles(c, baseDir, -1, -1)
+	c.Assert(t.genUUIDIndexFile(baseDir), IsNil)
+	// the first two sub directories are fully purged
+	for _, fps := range relayFilesPath[:2] {
+		for _, fp := range fps {
+			c.Assert(os.Remove(fp), IsNil)
+		}
+	}
+
+	op := &fakeReaderOperator{
+		writer:  &streamer.RelayLogInfo{TaskName: fakeRelayTaskName, UUID: t.uuids[2], UUIDSuffix: 3, Filename: t.relayFiles[2][2]},
+		readers: []*streamer.RelayLogInfo{{TaskName: "task-1", UUID: t.uuids[1], UUIDSuffix: 2, Filename: t.relayFiles[1][2]}},
+	}
+	// readers are respected even if ignoring active readers
+	purger := NewPurger(config.PurgeConfig{SubDirRetention: 1, IgnoreActiveReaders: true}, baseDir, []Operator{op}, nil).(*relayPurger)
+
+	// modified within the retention
+	c.Assert(purger.gcSubDirs(), IsNil)
+	c.Assert(utils.IsDirExists(relayDirsPath[0]), IsTrue)
+
+	oldTime := time.Now().Add(-2 * time.Hour)
+	for _, dir := range relayDirsPath {
+		c.Assert(os.Chtimes(dir, oldTime, oldTime), IsNil)
+	}
+	// the sub directory being read is kept, and the index is compacted
+	c.Assert(purger.gcSubDirs(), IsNil)
+	c.Assert(utils.IsDirExists(relayDirsPath[0]), IsFalse)
+	c.Assert(utils.IsDirExists(relayDirsPath[1]), IsTrue)
+	c.Assert(utils.IsDirExists(relayDirsPath[2]), IsTrue)
+	uuids, err := utils.ParseUUIDIndex(purger.indexPath)
+	c.Assert(err, IsNil)
+	c.Assert(uuids, DeepEquals, t.uuids[1:])
+
+	// the index is compacted by the operator maintaining it
+	iop := &fakeUUIDIndexOperator{fakeReaderOperator: *op}
+	iop.readers = nil
+	purger = NewPurger(config.PurgeConfig{SubDirRetention: 1}, baseDir, []Operator{iop}, nil).(*relayPurger)
+	c.Assert(purger.gcSubDirs(), IsNil)
+	c.Assert(utils.IsDirExists(relayDirsPath[1]), IsFalse)
+	c.Assert(iop.trimmed, Equals, 1)
+	// the latest sub directory with relay log files is kept, trimming is retried as the fake operator trims nothing
+	c.Assert(purger.gcSubDirs(), IsNil)
+	c.Assert(utils.IsDirExists(relayDirsPath[2]), IsTrue)
+	c.Assert(iop.trimmed, Equals, 2)
+}
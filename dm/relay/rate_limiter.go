@@ -0,0 +1,93 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// eventRateLimiter limits the rate of events sent to the streamer in both events/sec and bytes/sec.
+type eventRateLimiter struct {
+	events *rate.Limiter
+	bytes  *rate.Limiter
+
+	mu              sync.Mutex
+	eventsPerSecond float64
+	bytesPerSecond  float64
+}
+
+// newEventRateLimiter creates an eventRateLimiter, non-positive limit means unlimited.
+func newEventRateLimiter(eventsPerSecond, bytesPerSecond float64) *eventRateLimiter {
+	l := &eventRateLimiter{
+		events: rate.NewLimiter(rate.Inf, 1),
+		bytes:  rate.NewLimiter(rate.Inf, 1),
+	}
+	l.setLimit(eventsPerSecond, bytesPerSecond)
+	return l
+}
+
+// toLimit converts the limit per second to rate.Limit and burst.
+func toLimit(perSecond float64) (rate.Limit, int) {
+	if perSecond <= 0 {
+		return rate.Inf, 1
+	}
+	burst := int(perSecond)
+	if burst < 1 {
+		burst = 1
+	}
+	return rate.Limit(perSecond), burst
+}
+
+// setLimit changes the limits, it's safe to be called concurrently with wait.
+func (l *eventRateLimiter) setLimit(eventsPerSecond, bytesPerSecond float64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.eventsPerSecond, l.bytesPerSecond = eventsPerSecond, bytesPerSecond
+
+	limit, burst := toLimit(eventsPerSecond)
+	l.events.SetBurst(burst)
+	l.events.SetLimit(limit)
+	limit, burst = toLimit(bytesPerSecond)
+	l.bytes.SetBurst(burst)
+	l.bytes.SetLimit(limit)
+}
+
+// limits returns the limits of events/sec and bytes/sec.
+func (l *eventRateLimiter) limits() (eventsPerSecond, bytesPerSecond float64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.eventsPerSecond, l.bytesPerSecond
+}
+
+// wait blocks until an event with `size` bytes can be sent or the context is done.
+func (l *eventRateLimiter) wait(ctx context.Context, size int) error {
+	if err := l.events.Wait(ctx); err != nil {
+		return err
+	}
+	// an event may be larger than the burst, wait for it by pieces
+	for size > 0 {
+		n := size
+		if burst := l.bytes.Burst(); l.bytes.Limit() != rate.Inf && n > burst {
+			n = burst
+		}
+		if err := l.bytes.WaitN(ctx, n); err != nil {
+			return err
+		}
+		size -= n
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"time"
+
+	. "github.com/pingcap/check"
+)
+
+var _ = Suite(&testRateLimiterSuite{})
+
+type testRateLimiterSuite struct{}
+
+func (t *testRateLimiterSuite) TestEventRateLimiter(c *C) {
+	ctx := context.Background()
+
+	// unlimited
+	l := newEventRateLimiter(0, 0)
+	start := time.Now()
+	for i := 0; i < 1000; i++ {
+		c.Assert(l.wait(ctx, 1<<20), IsNil)
+	}
+	c.Assert(time.Since(start), Less, time.Second)
+
+	// limit events, no tokens are available after changing from unlimited
+	l.setLimit(20, 0)
+	eventsPerSecond, bytesPerSecond := l.limits()
+	c.Assert(eventsPerSecond, Equals, float64(20))
+	c.Assert(bytesPerSecond, Equals, float64(0))
+	start = time.Now()
+	for i := 0; i < 10; i++ {
+		c.Assert(l.wait(ctx, 1<<20), IsNil)
+	}
+	c.Assert(time.Since(start), GreaterEqual, 400*time.Millisecond)
+
+	// limit bytes, an event larger than the burst is waited by pieces
+	l.setLimit(0, 1000)
+	start = time.Now()
+	c.Assert(l.wait(ctx, 1200), IsNil)
+	c.Assert(time.Since(start), GreaterEqual, time.Second)
+
+	// the context is done
+	ctx2, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	c.Assert(l.wait(ctx2, 10000), NotNil)
+
+	// change to unlimited at runtime
+	l.setLimit(0, 0)
+	start = time.Now()
+	c.Assert(l.wait(ctx, 1<<20), IsNil)
+	c.Assert(time.Since(start), Less, time.Second)
+}
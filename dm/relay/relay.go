@@ -29,6 +29,7 @@ import (
 	"github.com/pingcap/failpoint"
 	toolutils "github.com/pingcap/tidb-tools/pkg/utils"
 	"github.com/pingcap/tidb/parser"
+	"go.etcd.io/etcd/clientv3"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
@@ -37,6 +38,7 @@ import (
 	"github.com/pingcap/tiflow/dm/dm/unit"
 	"github.com/pingcap/tiflow/dm/pkg/binlog"
 	"github.com/pingcap/tiflow/dm/pkg/binlog/common"
+	"github.com/pingcap/tiflow/dm/pkg/binlog/event"
 	binlogReader "github.com/pingcap/tiflow/dm/pkg/binlog/reader"
 	"github.com/pingcap/tiflow/dm/pkg/conn"
 	"github.com/pingcap/tiflow/dm/pkg/gtid"
@@ -81,6 +83,8 @@ type Process interface {
 	ActiveRelayLog() *pkgstreamer.RelayLogInfo
 	// Reload reloads config
 	Reload(newCfg *Config) error
+	// SwitchUpstream switches the upstream master of a paused relay log process unit
+	SwitchUpstream(ctx context.Context, from config.DBConfig, binlogName, binlogGTID string) error
 	// Update updates config
 	Update(cfg *config.SubTaskConfig) error
 	// Resume resumes paused relay log process unit
@@ -130,6 +134,13 @@ type Relay struct {
 
 	writer    Writer
 	listeners map[Listener]struct{} // make it a set to make it easier to remove listener
+
+	archiver *relayArchiver // nil if relay log files are not archived
+	quota    *diskQuota     // nil if no disk quota for the relay directory
+
+	masterKey  MasterKey // nil if relay log files are not encrypted
+	encryptKey []byte    // nil if relay log files are not encrypted by a single key
+	keyErr     error     // error when loading the keys for relay log encryption, reported in Init
 }
 
 // NewRealRelay creates an instance of Relay.
@@ -140,14 +151,31 @@ func NewRealRelay(cfg *Config) Process {
 		logger:    log.With(zap.String("component", "relay log")),
 		listeners: make(map[Listener]struct{}),
 	}
-	r.writer = NewFileWriter(r.logger, cfg.RelayDir)
+	r.masterKey, r.keyErr = cfg.Encryption.masterKey()
+	if r.keyErr == nil {
+		r.encryptKey, r.keyErr = cfg.Encryption.encryptKey()
+	}
+	r.writer = NewFileWriter(r.logger, cfg.RelayDir, cfg.Sync, cfg.CompressRotated, r.masterKey)
+	if cfg.Archive.URL != "" {
+		r.archiver = newRelayArchiver(r.logger, cfg.RelayDir, cfg.Archive)
+	}
+	r.quota = newDiskQuota(r.logger, cfg.RelayDir, cfg.DiskQuota)
 	return r
 }
 
 // Init implements the dm.Unit interface.
 // NOTE when Init encounters an error, it will make DM-worker exit when it boots up and assigned relay.
 func (r *Relay) Init(ctx context.Context) (err error) {
-	return reportRelayLogSpaceInBackground(ctx, r.cfg.RelayDir)
+	if r.keyErr != nil {
+		return terror.Annotate(r.keyErr, "load keys for relay log encryption")
+	}
+	if err = reportRelayLogSpaceInBackground(ctx, r.cfg.RelayDir); err != nil {
+		return err
+	}
+	if r.archiver != nil {
+		r.archiver.start(ctx)
+	}
+	return nil
 }
 
 // Process implements the dm.Unit interface.
@@ -347,7 +375,7 @@ func (r *Relay) PurgeRelayDir() error {
 		return err
 	}
 	for _, name := range names {
-		err = os.RemoveAll(filepath.Join(dir, name))
+		err = removeRelayPath(filepath.Join(dir, name))
 		if err != nil {
 			return err
 		}
@@ -440,9 +468,19 @@ func (r *Relay) doRecovering(ctx context.Context, binlogDir, filename string, pa
 	} else if err != nil {
 		return recoverResult{}, terror.ErrRelayWriterGetFileStat.Delegate(err, fullName)
 	}
+	var encryptKey []byte
+	if r.masterKey != nil {
+		if encryptKey, err = loadRelayLogDataKey(localRelayStorage{}, fullName, r.masterKey); err != nil {
+			return recoverResult{}, terror.Annotatef(err, "load data key of %s", fullName)
+		}
+	}
+	size, headerLen := fs.Size(), int64(0)
+	if len(encryptKey) > 0 {
+		size, headerLen = plainRelayLogSize(size), encryptedRelayLogHeaderLen
+	}
 
 	// get latest pos/GTID set for all completed transactions from the file
-	latestPos, latestGTIDs, err := getTxnPosGTIDs(ctx, fullName, parser)
+	latestPos, latestGTIDs, err := getTxnPosGTIDs(ctx, fullName, parser, encryptKey)
 	if err != nil {
 		return recoverResult{}, terror.Annotatef(err, "get latest pos/GTID set from %s", fullName)
 	}
@@ -454,14 +492,14 @@ func (r *Relay) doRecovering(ctx context.Context, binlogDir, filename string, pa
 	})
 
 	// in most cases, we think the file is fine, so compare the size is simpler.
-	if fs.Size() == latestPos {
+	if size == latestPos {
 		return recoverResult{
 			Truncated:   false,
 			LatestPos:   mysql.Position{Name: filename, Pos: uint32(latestPos)},
 			LatestGTIDs: latestGTIDs,
 		}, nil
-	} else if fs.Size() < latestPos {
-		return recoverResult{}, terror.ErrRelayWriterLatestPosGTFileSize.Generate(latestPos, fs.Size())
+	} else if size < latestPos {
+		return recoverResult{}, terror.ErrRelayWriterLatestPosGTFileSize.Generate(latestPos, size)
 	}
 
 	failpoint.Label("bypass")
@@ -472,7 +510,7 @@ func (r *Relay) doRecovering(ctx context.Context, binlogDir, filename string, pa
 		return recoverResult{}, terror.Annotatef(terror.ErrRelayWriterFileOperate.New(err.Error()), "open %s", fullName)
 	}
 	defer f.Close()
-	err = f.Truncate(latestPos)
+	err = f.Truncate(latestPos + headerLen)
 	if err != nil {
 		return recoverResult{}, terror.Annotatef(terror.ErrRelayWriterFileOperate.New(err.Error()), "truncate %s to %d", fullName, latestPos)
 	}
@@ -514,8 +552,8 @@ func (r *Relay) preprocessEvent(e *replication.BinlogEvent, parser2 *parser.Pars
 		result.NextLogName = string(ev.NextLogName) // for RotateEvent, update binlog name
 	case *replication.QueryEvent:
 		// when RawModeEnabled not true, QueryEvent will be parsed.
-		if parserpkg.CheckIsDDL(string(ev.Query), parser2) {
-			// we only update/save GTID for DDL/XID event
+		if parserpkg.CheckIsDDL(string(ev.Query), parser2) || event.IsCommitQuery(ev.Query) {
+			// we only update/save GTID for DDL/XID/COMMIT event
 			// if the query is something like `BEGIN`, we do not update/save GTID.
 			result.GTIDSet = ev.GSet
 			result.CanSaveGTID = true
@@ -567,6 +605,17 @@ func (r *Relay) handleEvents(
 
 	firstEvent := true
 	for {
+		// 0. wait for the purger to free space if the relay directory is approaching its disk quota,
+		// the reader is not closed so that the upstream connection is kept.
+		if r.quota != nil {
+			if err = r.quota.wait(ctx); err != nil {
+				if errors.Cause(err) == context.Canceled {
+					return nil
+				}
+				return err
+			}
+		}
+
 		// 1. read events from upstream server
 		readTimer := time.Now()
 		rResult, err := reader2.GetEvent(ctx)
@@ -673,8 +722,20 @@ func (r *Relay) handleEvents(
 			r.tryUpdateActiveRelayLog(e, lastPos.Name) // even the event ignored we still need to try this update.
 			continue
 		}
+		if wResult.FilledHoleSize > 0 && r.cfg.EnableGTID {
+			// record the GTID sets of the hole before notifying the readers, so they can keep their GTID sets exact
+			holeEnd := int64(e.Header.LogPos - e.Header.EventSize)
+			gap := relayLogGap{Start: holeEnd - wResult.FilledHoleSize, End: holeEnd, GTIDSet: lastGTID.String()}
+			if err = recordRelayLogGap(r.meta.Dir(), lastPos.Name, gap); err != nil {
+				return terror.Annotatef(err, "record the hole filled in %s", lastPos.Name)
+			}
+			r.logger.Info("record the hole filled", zap.String("file", lastPos.Name), zap.Reflect("gap", gap))
+		}
 
 		r.notify(e)
+		if r.quota != nil {
+			r.quota.addWritten(int64(e.Header.EventSize))
+		}
 
 		relayLogWriteDurationHistogram.Observe(time.Since(writeTimer).Seconds())
 		r.tryUpdateActiveRelayLog(e, lastPos.Name) // wrote a event, try update the current active relay log.
@@ -699,6 +760,7 @@ func (r *Relay) handleEvents(
 		}
 
 		relayLogWriteSizeHistogram.Observe(float64(e.Header.EventSize))
+		relayLogWriteEventCounter.WithLabelValues(e.Header.EventType.String()).Inc()
 		relayLogPosGauge.WithLabelValues("relay").Set(float64(lastPos.Pos))
 		if index, err2 := binlog.GetFilenameIndex(lastPos.Name); err2 != nil {
 			r.logger.Error("parse binlog file name", zap.String("file name", lastPos.Name), log.ShortError(err2))
@@ -832,6 +894,19 @@ func (r *Relay) updateMetricsRelaySubDirIndex() {
 	relaySubDirIndex.WithLabelValues(node, uuidWithSuffix).Set(float64(suffix))
 }
 
+// updateBinlogGap updates the size of upstream binlog not pulled into relay log files yet, the caller should hold r.RLock.
+func (r *Relay) updateBinlogGap(ctx context.Context) {
+	ctx2, cancel2 := context.WithTimeout(ctx, utils.DefaultDBTimeout)
+	files, err := binlog.GetBinaryLogs(ctx2, r.db.DB)
+	cancel2()
+	if err != nil {
+		r.logger.Warn("get binary logs", zap.Error(err))
+		return
+	}
+	_, pos := r.meta.Pos()
+	relayBinlogGapGauge.Set(float64(files.After(pos)))
+}
+
 func (r *Relay) doIntervalOps(ctx context.Context) {
 	flushTicker := time.NewTicker(flushMetaInterval)
 	defer flushTicker.Stop()
@@ -879,6 +954,7 @@ func (r *Relay) doIntervalOps(ctx context.Context) {
 			}
 			relayLogFileGauge.WithLabelValues("master").Set(float64(index))
 			relayLogPosGauge.WithLabelValues("master").Set(float64(pos.Pos))
+			r.updateBinlogGap(ctx)
 			r.RUnlock()
 		case <-trimUUIDsTicker.C:
 			r.RLock()
@@ -953,9 +1029,20 @@ func (r *Relay) SaveMeta(pos mysql.Position, gset gtid.Set) error {
 func (r *Relay) ResetMeta() {
 	r.Lock()
 	defer r.Unlock()
+	if em, ok := r.meta.(*EtcdMeta); ok {
+		r.meta = em.reset()
+		return
+	}
 	r.meta = NewLocalMeta(r.cfg.Flavor, r.cfg.RelayDir)
 }
 
+// UseEtcdMeta makes relay persist its meta into etcd besides local files, it should be called before Process.
+func (r *Relay) UseEtcdMeta(cli *clientv3.Client, source, worker string) {
+	r.Lock()
+	defer r.Unlock()
+	r.meta = NewEtcdMeta(cli, source, worker, r.cfg.Flavor, r.cfg.RelayDir)
+}
+
 // FlushMeta flush relay meta.
 func (r *Relay) FlushMeta() error {
 	return r.meta.Flush()
@@ -988,6 +1075,10 @@ func (r *Relay) Close() {
 
 	r.closeDB()
 
+	if r.archiver != nil {
+		r.archiver.close()
+	}
+
 	r.closed.Store(true)
 	r.logger.Info("relay unit closed")
 }
@@ -1099,6 +1190,74 @@ func (r *Relay) Reload(newCfg *Config) error {
 	return nil
 }
 
+// SwitchUpstream switches the upstream master to `from`, it should be called when the relay is paused.
+// a new sub directory is added for the new master, and relay log is pulled from `binlogName` (or `binlogGTID` if
+// GTID enabled) of it after resumed. if GTID enabled and `binlogGTID` is empty, the current GTID sets are continued.
+// readers switch to the new sub directory after they read all relay log files of the previous one.
+func (r *Relay) SwitchUpstream(ctx context.Context, from config.DBConfig, binlogName, binlogGTID string) error {
+	r.Lock()
+	defer r.Unlock()
+
+	var (
+		newPos  *mysql.Position
+		newGset gtid.Set
+		err     error
+	)
+	if r.cfg.EnableGTID {
+		if len(binlogGTID) > 0 {
+			if newGset, err = gtid.ParserGTID(r.cfg.Flavor, binlogGTID); err != nil {
+				return terror.ErrRelaySwitchUpstreamPosNotValid.Delegate(err, "parse GTID sets "+binlogGTID)
+			}
+		}
+	} else {
+		if len(binlogName) == 0 {
+			return terror.ErrRelaySwitchUpstreamPosNotValid.Generate("binlog name is required when GTID not enabled")
+		} else if !binlog.VerifyFilename(binlogName) {
+			return terror.ErrRelaySwitchUpstreamPosNotValid.Generatef("binlog name %s not valid", binlogName)
+		}
+		newPos = &mysql.Position{Name: binlogName, Pos: binlog.MinPosition.Pos}
+	}
+
+	r.logger.Info("relay unit is switching upstream", zap.String("host", from.Host), zap.Int("port", from.Port),
+		zap.String("binlog name", binlogName), zap.String("binlog GTID", binlogGTID))
+	r.cfg.From = from
+	r.closeDB()
+	if r.cfg.From.RawDBCfg == nil {
+		r.cfg.From.RawDBCfg = config.DefaultRawDBConfig()
+	}
+	r.cfg.From.RawDBCfg.ReadTimeout = showStatusConnectionTimeout
+	db, err := conn.DefaultDBProvider.Apply(&r.cfg.From)
+	if err != nil {
+		return terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeUpstream)
+	}
+	r.db = db
+	if err = r.setSyncConfig(); err != nil {
+		return err
+	}
+
+	uuid, err := utils.GetServerUUID(ctx, r.db.DB, r.cfg.Flavor)
+	if err != nil {
+		return err
+	}
+	if err = r.meta.Flush(); err != nil {
+		return err
+	}
+	// add the sub directory even if it's the same server, like it's recreated from a backup
+	if err = r.meta.AddDir(uuid, newPos, newGset, 0); err != nil {
+		return err
+	}
+	// the start position of the new master is the needed one, so relay meta is not regarded as outdated after resumed
+	_, pos := r.meta.Pos()
+	_, gs := r.meta.GTID()
+	r.cfg.BinLogName = pos.Name
+	if gs != nil {
+		r.cfg.BinlogGTID = gs.String()
+	}
+	r.updateMetricsRelaySubDirIndex()
+	r.logger.Info("relay unit switched upstream", zap.String("uuid", r.meta.UUID()), zap.Stringer("position", pos), zap.Stringer("GTID sets", gs))
+	return nil
+}
+
 // setActiveRelayLog sets or updates the current active relay log to file.
 func (r *Relay) setActiveRelayLog(filename string) {
 	uuid := r.meta.UUID()
@@ -1195,10 +1354,57 @@ func (r *Relay) notify(e *replication.BinlogEvent) {
 	}
 }
 
+// NewReader implements Process.NewReader.
+// the relay log files archived by the relay are read from the archive if they have been purged locally.
 func (r *Relay) NewReader(logger log.Logger, cfg *BinlogReaderConfig) *BinlogReader {
+	if cfg.ArchiveURL == "" && r.cfg.Archive.URL != "" {
+		clone := *cfg
+		clone.ArchiveURL = r.cfg.Archive.URL
+		cfg = &clone
+	}
+	if cfg.MasterKey == nil && r.masterKey != nil {
+		clone := *cfg
+		clone.MasterKey = r.masterKey
+		cfg = &clone
+	}
+	if cfg.EncryptKey == nil && r.encryptKey != nil {
+		clone := *cfg
+		clone.EncryptKey = r.encryptKey
+		cfg = &clone
+	}
 	return newBinlogReader(logger, cfg, r)
 }
 
+// ArchivedRelayLogs implements ArchiveOperator.ArchivedRelayLogs.
+func (r *Relay) ArchivedRelayLogs() []string {
+	if r.archiver == nil {
+		return nil
+	}
+	return r.archiver.archivedRelayLogs()
+}
+
+// ActiveReaderRelayLogs implements ReaderOperator.ActiveReaderRelayLogs.
+func (r *Relay) ActiveReaderRelayLogs() []*pkgstreamer.RelayLogInfo {
+	r.RLock()
+	defer r.RUnlock()
+	var infos []*pkgstreamer.RelayLogInfo
+	for el := range r.listeners {
+		if br, ok := el.(*BinlogReader); ok {
+			if info := br.activeRelayLog(); info != nil {
+				infos = append(infos, info)
+			}
+		}
+	}
+	return infos
+}
+
+// TrimUUIDs implements UUIDIndexOperator.TrimUUIDs.
+func (r *Relay) TrimUUIDs() ([]string, error) {
+	r.RLock()
+	defer r.RUnlock()
+	return r.meta.TrimUUIDs()
+}
+
 // RegisterListener implements Process.RegisterListener.
 func (r *Relay) RegisterListener(el Listener) {
 	r.Lock()
@@ -36,6 +36,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/binlog/event"
 	"github.com/pingcap/tiflow/dm/pkg/conn"
 	"github.com/pingcap/tiflow/dm/pkg/gtid"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 )
 
@@ -622,6 +623,46 @@ func (t *testRelaySuite) TestReSetupMeta(c *C) {
 	c.Assert(mockDB.ExpectationsWereMet(), IsNil)
 }
 
+func (t *testRelaySuite) TestSwitchUpstream(c *C) {
+	var (
+		relayCfg = newRelayCfg(c, gmysql.MySQLFlavor)
+		r        = NewRelay(relayCfg).(*Relay)
+		oldUUID  = "3ccc475b-2343-11e7-be21-6c0b84d59f30.000001"
+		newUUID  = "12e57f06-f360-11eb-8235-585cc2bc66c9.000002"
+		newFrom  = config.DBConfig{Host: "127.0.0.2", Port: 3307, User: "root"}
+	)
+	c.Assert(r.meta.Load(), IsNil)
+	c.Assert(r.meta.AddDir("3ccc475b-2343-11e7-be21-6c0b84d59f30", &gmysql.Position{Name: "mysql-bin.000010", Pos: 1234}, nil, 0), IsNil)
+	defer r.closeDB()
+
+	// binlog name is required in position mode
+	err := r.SwitchUpstream(context.Background(), newFrom, "", "")
+	c.Assert(terror.ErrRelaySwitchUpstreamPosNotValid.Equal(err), IsTrue)
+	err = r.SwitchUpstream(context.Background(), newFrom, "invalid-name", "")
+	c.Assert(terror.ErrRelaySwitchUpstreamPosNotValid.Equal(err), IsTrue)
+
+	mockDB := conn.InitMockDB(c)
+	mockGetServerUUID(mockDB)
+	c.Assert(r.SwitchUpstream(context.Background(), newFrom, "mysql-bin.000003", ""), IsNil)
+	c.Assert(mockDB.ExpectationsWereMet(), IsNil)
+	c.Assert(r.cfg.From.Host, Equals, newFrom.Host)
+	c.Assert(r.syncerCfg.Port, Equals, uint16(newFrom.Port))
+	c.Assert(r.cfg.BinLogName, Equals, "mysql-bin.000003")
+	t.verifyMetadata(c, r, newUUID, gmysql.Position{Name: "mysql-bin.000003", Pos: 4}, "", []string{oldUUID, newUUID})
+
+	// continue the GTID sets if not specified in GTID mode
+	r.cfg.EnableGTID = true
+	gs := "24ecd093-8cec-11e9-aa0d-0242ac170002:1-23"
+	c.Assert(r.SaveMeta(gmysql.Position{Name: "mysql-bin.000003", Pos: 456}, mustParseGTID(c, gs)), IsNil)
+	mockDB = conn.InitMockDB(c)
+	mockGetServerUUID(mockDB)
+	c.Assert(r.SwitchUpstream(context.Background(), newFrom, "", ""), IsNil)
+	c.Assert(mockDB.ExpectationsWereMet(), IsNil)
+	newUUID3 := "12e57f06-f360-11eb-8235-585cc2bc66c9.000003"
+	t.verifyMetadata(c, r, newUUID3, minCheckpoint, gs, []string{oldUUID, newUUID, newUUID3})
+	c.Assert(r.cfg.BinlogGTID, Equals, gs)
+}
+
 func (t *testRelaySuite) verifyMetadata(c *C, r *Relay, uuidExpected string,
 	posExpected gmysql.Position, gsStrExpected string, uuidsExpected []string) {
 	uuid, pos := r.meta.Pos()
@@ -945,3 +986,9 @@ func (t *testRelaySuite) TestRecoverMySQLNone(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(result.Truncated, IsFalse)
 }
+
+func mustParseGTID(c *C, gs string) gtid.Set {
+	gset, err := gtid.ParserGTID(gmysql.MySQLFlavor, gs)
+	c.Assert(err, IsNil)
+	return gset
+}
@@ -15,6 +15,7 @@ package relay
 
 import (
 	"path/filepath"
+	"sync"
 	"time"
 
 	gmysql "github.com/go-mysql-org/go-mysql/mysql"
@@ -39,6 +40,9 @@ const (
 type WResult struct {
 	Ignore       bool   // whether the event ignored by the writer
 	IgnoreReason string // why the writer ignore the event
+	// FilledHoleSize is the size of the hole filled by a dummy event before the event, 0 if no hole exists.
+	// the hole ends at the start position of the event.
+	FilledHoleSize int64
 }
 
 // Writer writes binlog events into disk or any other memory structure.
@@ -71,16 +75,29 @@ type FileWriter struct {
 	uuid     string        // with suffix, like 3ccc475b-2343-11e7-be21-6c0b84d59f30.000001
 	filename atomic.String // current binlog filename
 
+	// compress the relay log files after rotated in background, one at a time
+	compressRotated bool
+	compressMu      sync.Mutex
+	compressWg      sync.WaitGroup
+
 	logger log.Logger
 }
 
 // NewFileWriter creates a FileWriter instances.
-func NewFileWriter(logger log.Logger, relayDir string) Writer {
+// if `compressRotated` is true, the relay log files are compressed after rotated (not the active one any more).
+// if `masterKey` is not nil, new relay log files are encrypted with data keys wrapped by it, and they are not compressed.
+func NewFileWriter(logger log.Logger, relayDir string, syncCfg SyncConfig, compressRotated bool, masterKey MasterKey) Writer {
 	w := &FileWriter{
-		relayDir: relayDir,
-		logger:   logger.WithFields(zap.String("sub component", "relay writer")),
+		relayDir:        relayDir,
+		compressRotated: compressRotated,
+		logger:          logger.WithFields(zap.String("sub component", "relay writer")),
+	}
+	if masterKey != nil && compressRotated {
+		w.logger.Warn("encrypted relay log files can't be compressed, disable compressing rotated relay log files")
+		w.compressRotated = false
 	}
-	w.out = NewBinlogWriter(w.logger, relayDir)
+	w.out = NewBinlogWriter(w.logger, relayDir, syncCfg)
+	w.out.masterKey = masterKey
 	return w
 }
 
@@ -90,8 +107,11 @@ func (w *FileWriter) Init(uuid, filename string) {
 }
 
 // Close implements Writer.Close.
+// it waits for the background compression of rotated relay log files.
 func (w *FileWriter) Close() error {
-	return w.out.Close()
+	err := w.out.Close()
+	w.compressWg.Wait()
+	return err
 }
 
 // WriteEvent implements Writer.WriteEvent.
@@ -120,10 +140,14 @@ func (w *FileWriter) offset() int64 {
 func (w *FileWriter) handleFormatDescriptionEvent(ev *replication.BinlogEvent) (WResult, error) {
 	// close the previous binlog file
 	w.logger.Info("closing previous underlying binlog writer", zap.Reflect("status", w.out.Status()))
+	prevUUID, prevFile := w.out.uuid, w.out.filename
 	err := w.out.Close()
 	if err != nil {
 		return WResult{}, terror.Annotate(err, "close previous underlying binlog writer")
 	}
+	if w.compressRotated && prevFile != "" && (prevUUID != w.uuid || prevFile != w.filename.Load()) {
+		w.compressInBackground(filepath.Join(w.relayDir, prevUUID, prevFile))
+	}
 
 	// verify filename
 	if !binlog.VerifyFilename(w.filename.Load()) {
@@ -139,7 +163,7 @@ func (w *FileWriter) handleFormatDescriptionEvent(ev *replication.BinlogEvent) (
 	w.logger.Info("open underlying binlog writer", zap.Reflect("status", w.out.Status()))
 
 	// write the binlog file header if not exists
-	exist, err := checkBinlogHeaderExist(fullName)
+	exist, err := checkBinlogHeaderExist(fullName, w.out.encryptKey())
 	if err != nil {
 		return WResult{}, terror.Annotatef(err, "check binlog file header for %s", fullName)
 	} else if !exist {
@@ -150,7 +174,7 @@ func (w *FileWriter) handleFormatDescriptionEvent(ev *replication.BinlogEvent) (
 	}
 
 	// write the FormatDescriptionEvent if not exists one
-	exist, err = checkFormatDescriptionEventExist(fullName)
+	exist, err = checkFormatDescriptionEventExist(fullName, w.out.encryptKey())
 	if err != nil {
 		return WResult{}, terror.Annotatef(err, "check FormatDescriptionEvent for %s", fullName)
 	} else if !exist {
@@ -170,6 +194,24 @@ func (w *FileWriter) handleFormatDescriptionEvent(ev *replication.BinlogEvent) (
 	}, nil
 }
 
+// compressInBackground compresses the rotated relay log file in background.
+// if failed, the relay log file is kept uncompressed.
+func (w *FileWriter) compressInBackground(fullName string) {
+	w.compressWg.Add(1)
+	go func() {
+		defer w.compressWg.Done()
+		w.compressMu.Lock()
+		defer w.compressMu.Unlock()
+
+		startTime := time.Now()
+		if err := compressRelayLogFile(fullName); err != nil {
+			w.logger.Warn("fail to compress rotated relay log file", zap.String("file", fullName), zap.Error(err))
+			return
+		}
+		w.logger.Info("rotated relay log file compressed", zap.String("file", fullName), zap.Duration("cost time", time.Since(startTime)))
+	}()
+}
+
 // handle RotateEvent:
 //   1. update binlog filename if needed
 //   2. write the RotateEvent if not fake
@@ -261,14 +303,15 @@ func (w *FileWriter) handleEventDefault(ev *replication.BinlogEvent) (WResult, e
 	failpoint.Label("afterWrite")
 
 	return WResult{
-		Ignore: false,
+		Ignore:         false,
+		FilledHoleSize: result.FilledHoleSize,
 	}, terror.Annotatef(err, "write event %+v", ev.Header)
 }
 
 // handlePotentialHoleOrDuplicate combines handleFileHoleExist and handleDuplicateEventsExist.
 func (w *FileWriter) handlePotentialHoleOrDuplicate(ev *replication.BinlogEvent) (WResult, error) {
 	// handle a potential hole
-	mayDuplicate, err := w.handleFileHoleExist(ev)
+	holeSize, mayDuplicate, err := w.handleFileHoleExist(ev)
 	if err != nil {
 		return WResult{}, terror.Annotatef(err, "handle a potential hole in %s before %+v",
 			w.filename.Load(), ev.Header)
@@ -288,22 +331,23 @@ func (w *FileWriter) handlePotentialHoleOrDuplicate(ev *replication.BinlogEvent)
 	}
 
 	return WResult{
-		Ignore: false,
+		Ignore:         false,
+		FilledHoleSize: holeSize,
 	}, nil
 }
 
-// handleFileHoleExist tries to handle a potential hole after this event wrote.
+// handleFileHoleExist tries to handle a potential hole after this event wrote, returns the size of the filled hole.
 // A hole exists often because some binlog events not sent by the master.
 // If no hole exists, then ev may be a duplicate event.
 // NOTE: handle cases when file size > 4GB.
-func (w *FileWriter) handleFileHoleExist(ev *replication.BinlogEvent) (bool, error) {
+func (w *FileWriter) handleFileHoleExist(ev *replication.BinlogEvent) (int64, bool, error) {
 	// 1. detect whether a hole exists
 	evStartPos := int64(ev.Header.LogPos - ev.Header.EventSize)
 	fileOffset := w.out.Offset()
 	holeSize := evStartPos - fileOffset
 	if holeSize <= 0 {
 		// no hole exists, but duplicate events may exists, this should be handled in another place.
-		return holeSize < 0, nil
+		return 0, holeSize < 0, nil
 	}
 	w.logger.Info("hole exist from pos1 to pos2", zap.Int64("pos1", fileOffset), zap.Int64("pos2", evStartPos), zap.String("file", w.filename.Load()))
 
@@ -318,18 +362,21 @@ func (w *FileWriter) handleFileHoleExist(ev *replication.BinlogEvent) (bool, err
 	)
 	dummyEv, err := event.GenDummyEvent(header, latestPos, eventSize)
 	if err != nil {
-		return false, terror.Annotatef(err, "generate dummy event at %d with size %d", latestPos, eventSize)
+		return 0, false, terror.Annotatef(err, "generate dummy event at %d with size %d", latestPos, eventSize)
 	}
 
 	// 3. write the dummy event
 	err = w.out.Write(dummyEv.RawData)
-	return false, terror.Annotatef(err, "write dummy event %+v to fill the hole", dummyEv.Header)
+	if err != nil {
+		return 0, false, terror.Annotatef(err, "write dummy event %+v to fill the hole", dummyEv.Header)
+	}
+	return holeSize, false, nil
 }
 
 // handleDuplicateEventsExist tries to handle a potential duplicate event in the binlog file.
 func (w *FileWriter) handleDuplicateEventsExist(ev *replication.BinlogEvent) (WResult, error) {
 	filename := filepath.Join(w.relayDir, w.uuid, w.filename.Load())
-	duplicate, err := checkIsDuplicateEvent(filename, ev)
+	duplicate, err := checkIsDuplicateEvent(filename, ev, w.out.encryptKey())
 	if err != nil {
 		return WResult{}, terror.Annotatef(err, "check event %+v whether duplicate in %s", ev.Header, filename)
 	} else if duplicate {
@@ -27,6 +27,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/binlog/event"
 	"github.com/pingcap/tiflow/dm/pkg/gtid"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
 )
 
 var _ = check.Suite(&testFileWriterSuite{})
@@ -49,7 +50,7 @@ func (t *testFileWriterSuite) TestInterfaceMethods(c *check.C) {
 
 	c.Assert(os.MkdirAll(path.Join(relayDir, uuid), 0o755), check.IsNil)
 
-	w := NewFileWriter(log.L(), relayDir)
+	w := NewFileWriter(log.L(), relayDir, SyncConfig{}, false, nil)
 	c.Assert(w, check.NotNil)
 
 	// not prepared
@@ -82,27 +83,27 @@ func (t *testFileWriterSuite) TestRelayDir(c *check.C) {
 	c.Assert(err, check.IsNil)
 
 	// not inited
-	w1 := NewFileWriter(log.L(), relayDir)
+	w1 := NewFileWriter(log.L(), relayDir, SyncConfig{}, false, nil)
 	defer w1.Close()
 	_, err = w1.WriteEvent(ev)
 	c.Assert(err, check.ErrorMatches, ".*not valid.*")
 
 	// invalid dir
-	w2 := NewFileWriter(log.L(), relayDir)
+	w2 := NewFileWriter(log.L(), relayDir, SyncConfig{}, false, nil)
 	defer w2.Close()
 	w2.Init("invalid\x00uuid", "bin.000001")
 	_, err = w2.WriteEvent(ev)
 	c.Assert(err, check.ErrorMatches, ".*invalid argument.*")
 
 	// valid directory, but no filename specified
-	w3 := NewFileWriter(log.L(), relayDir)
+	w3 := NewFileWriter(log.L(), relayDir, SyncConfig{}, false, nil)
 	defer w3.Close()
 	w3.Init(uuid, "")
 	_, err = w3.WriteEvent(ev)
 	c.Assert(err, check.ErrorMatches, ".*not valid.*")
 
 	// valid directory, but invalid filename
-	w4 := NewFileWriter(log.L(), relayDir)
+	w4 := NewFileWriter(log.L(), relayDir, SyncConfig{}, false, nil)
 	defer w4.Close()
 	w4.Init(uuid, "test-mysql-bin.666abc")
 	_, err = w4.WriteEvent(ev)
@@ -111,7 +112,7 @@ func (t *testFileWriterSuite) TestRelayDir(c *check.C) {
 	c.Assert(os.MkdirAll(filepath.Join(relayDir, uuid), 0o755), check.IsNil)
 
 	// valid directory, valid filename
-	w5 := NewFileWriter(log.L(), relayDir)
+	w5 := NewFileWriter(log.L(), relayDir, SyncConfig{}, false, nil)
 	defer w5.Close()
 	w5.Init(uuid, "test-mysql-bin.000001")
 	result, err := w5.WriteEvent(ev)
@@ -136,7 +137,7 @@ func (t *testFileWriterSuite) TestFormatDescriptionEvent(c *check.C) {
 	c.Assert(os.Mkdir(path.Join(relayDir, uuid), 0o755), check.IsNil)
 
 	// write FormatDescriptionEvent to empty file
-	w := NewFileWriter(log.L(), relayDir)
+	w := NewFileWriter(log.L(), relayDir, SyncConfig{}, false, nil)
 	defer w.Close()
 	w.Init(uuid, filename)
 	result, err := w.WriteEvent(formatDescEv)
@@ -234,7 +235,7 @@ func (t *testFileWriterSuite) TestRotateEventWithFormatDescriptionEvent(c *check
 	c.Assert(holeRotateEv, check.NotNil)
 
 	// 1: non-fake RotateEvent before FormatDescriptionEvent, invalid
-	w1 := NewFileWriter(log.L(), relayDir)
+	w1 := NewFileWriter(log.L(), relayDir, SyncConfig{}, false, nil)
 	defer w1.Close()
 	w1.Init(uuid, filename)
 	_, err = w1.WriteEvent(rotateEv)
@@ -243,7 +244,7 @@ func (t *testFileWriterSuite) TestRotateEventWithFormatDescriptionEvent(c *check
 	// 2. fake RotateEvent before FormatDescriptionEvent
 	relayDir = c.MkDir() // use a new relay directory
 	c.Assert(os.MkdirAll(filepath.Join(relayDir, uuid), 0o755), check.IsNil)
-	w2 := NewFileWriter(log.L(), relayDir)
+	w2 := NewFileWriter(log.L(), relayDir, SyncConfig{}, false, nil)
 	defer w2.Close()
 	w2.Init(uuid, filename)
 	result, err := w2.WriteEvent(fakeRotateEv)
@@ -272,7 +273,7 @@ func (t *testFileWriterSuite) TestRotateEventWithFormatDescriptionEvent(c *check
 	// 3. FormatDescriptionEvent before fake RotateEvent
 	relayDir = c.MkDir() // use a new relay directory
 	c.Assert(os.MkdirAll(filepath.Join(relayDir, uuid), 0o755), check.IsNil)
-	w3 := NewFileWriter(log.L(), relayDir)
+	w3 := NewFileWriter(log.L(), relayDir, SyncConfig{}, false, nil)
 	defer w3.Close()
 	w3.Init(uuid, filename)
 	result, err = w3.WriteEvent(formatDescEv)
@@ -301,7 +302,7 @@ func (t *testFileWriterSuite) TestRotateEventWithFormatDescriptionEvent(c *check
 	// 4. FormatDescriptionEvent before non-fake RotateEvent
 	relayDir = c.MkDir() // use a new relay directory
 	c.Assert(os.MkdirAll(filepath.Join(relayDir, uuid), 0o755), check.IsNil)
-	w4 := NewFileWriter(log.L(), relayDir)
+	w4 := NewFileWriter(log.L(), relayDir, SyncConfig{}, false, nil)
 	defer w4.Close()
 	w4.Init(uuid, filename)
 	result, err = w4.WriteEvent(formatDescEv)
@@ -392,7 +393,7 @@ func (t *testFileWriterSuite) TestWriteMultiEvents(c *check.C) {
 	c.Assert(os.MkdirAll(filepath.Join(relayDir, uuid), 0o755), check.IsNil)
 
 	// write the events to the file
-	w := NewFileWriter(log.L(), relayDir)
+	w := NewFileWriter(log.L(), relayDir, SyncConfig{}, false, nil)
 	w.Init(uuid, filename)
 	for _, ev := range allEvents {
 		result, err2 := w.WriteEvent(ev)
@@ -426,7 +427,7 @@ func (t *testFileWriterSuite) TestHandleFileHoleExist(c *check.C) {
 
 	c.Assert(os.MkdirAll(filepath.Join(relayDir, uuid), 0o755), check.IsNil)
 
-	w := NewFileWriter(log.L(), relayDir)
+	w := NewFileWriter(log.L(), relayDir, SyncConfig{}, false, nil)
 	defer w.Close()
 	w.Init(uuid, filename)
 
@@ -434,6 +435,7 @@ func (t *testFileWriterSuite) TestHandleFileHoleExist(c *check.C) {
 	result, err := w.WriteEvent(formatDescEv)
 	c.Assert(err, check.IsNil)
 	c.Assert(result.Ignore, check.IsFalse)
+	c.Assert(result.FilledHoleSize, check.Equals, int64(0))
 
 	// hole exits, but the size is too small, invalid
 	latestPos = formatDescEv.Header.LogPos + event.MinUserVarEventLen - 1
@@ -449,6 +451,7 @@ func (t *testFileWriterSuite) TestHandleFileHoleExist(c *check.C) {
 	result, err = w.WriteEvent(queryEv)
 	c.Assert(err, check.IsNil)
 	c.Assert(result.Ignore, check.IsFalse)
+	c.Assert(result.FilledHoleSize, check.Equals, int64(event.MinUserVarEventLen))
 	fileSize := int64(queryEv.Header.LogPos)
 	t.verifyFilenameOffset(c, w, filename, fileSize)
 
@@ -490,7 +493,7 @@ func (t *testFileWriterSuite) TestHandleDuplicateEventsExist(c *check.C) {
 		latestPos uint32 = 4
 	)
 	c.Assert(os.MkdirAll(filepath.Join(relayDir, uuid), 0o755), check.IsNil)
-	w := NewFileWriter(log.L(), relayDir)
+	w := NewFileWriter(log.L(), relayDir, SyncConfig{}, false, nil)
 	defer w.Close()
 	w.Init(uuid, filename)
 
@@ -522,3 +525,53 @@ func (t *testFileWriterSuite) TestHandleDuplicateEventsExist(c *check.C) {
 	_, err = w.WriteEvent(queryEv)
 	c.Assert(err, check.ErrorMatches, ".*handle a potential duplicate event.*")
 }
+
+func (t *testFileWriterSuite) TestCompressRotated(c *check.C) {
+	var (
+		relayDir     = c.MkDir()
+		uuid         = "3ccc475b-2343-11e7-be21-6c0b84d59f30.000001"
+		filename     = "test-mysql-bin.000001"
+		nextFilename = "test-mysql-bin.000002"
+		header       = &replication.EventHeader{
+			Timestamp: uint32(time.Now().Unix()),
+			ServerID:  11,
+		}
+	)
+	c.Assert(os.Mkdir(path.Join(relayDir, uuid), 0o755), check.IsNil)
+	formatDescEv, err := event.GenFormatDescriptionEvent(header, 4)
+	c.Assert(err, check.IsNil)
+	rotateEv, err := event.GenRotateEvent(header, formatDescEv.Header.LogPos, []byte(nextFilename), 4)
+	c.Assert(err, check.IsNil)
+
+	w := NewFileWriter(log.L(), relayDir, SyncConfig{}, true, nil)
+	w.Init(uuid, filename)
+	for _, ev := range []*replication.BinlogEvent{formatDescEv, rotateEv, formatDescEv} {
+		_, err = w.WriteEvent(ev)
+		c.Assert(err, check.IsNil)
+	}
+	c.Assert(w.Close(), check.IsNil) // wait for the compression
+
+	// the rotated file is compressed, but the active one is not
+	fullName := filepath.Join(relayDir, uuid, filename)
+	c.Assert(utils.IsFileExists(fullName), check.IsFalse)
+	c.Assert(utils.IsFileExists(fullName+compressedRelayLogExt), check.IsTrue)
+	nextFullName := filepath.Join(relayDir, uuid, nextFilename)
+	c.Assert(utils.IsFileExists(nextFullName), check.IsTrue)
+	c.Assert(utils.IsFileExists(nextFullName+compressedRelayLogExt), check.IsFalse)
+
+	f, compressed, err := openRelayLogFile(localRelayStorage{}, fullName, nil)
+	c.Assert(err, check.IsNil)
+	defer f.Close()
+	c.Assert(compressed, check.IsTrue)
+	var events []*replication.BinlogEvent
+	_, err = f.Seek(int64(len(replication.BinLogFileHeader)), 0)
+	c.Assert(err, check.IsNil)
+	err = replication.NewBinlogParser().ParseReader(f, func(e *replication.BinlogEvent) error {
+		events = append(events, e)
+		return nil
+	})
+	c.Assert(err, check.IsNil)
+	c.Assert(events, check.HasLen, 2)
+	c.Assert(events[0].RawData, check.DeepEquals, formatDescEv.RawData)
+	c.Assert(events[1].RawData, check.DeepEquals, rotateEv.RawData)
+}
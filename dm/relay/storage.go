@@ -0,0 +1,372 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+// remoteRelayPollInterval is the interval to check whether the relay log files in the remote storage are changed,
+// as no notification is sent by the relay which writes them.
+var remoteRelayPollInterval = time.Second
+
+// remoteRelaySchemes are the URL schemes of RelayDir which makes the relay log files read from an external storage.
+var remoteRelaySchemes = map[string]struct{}{
+	"s3":    {},
+	"gcs":   {},
+	"gs":    {},
+	"file":  {},
+	"local": {},
+}
+
+// storageFile is a file opened from a relayStorage.
+type storageFile interface {
+	relayLogFile
+	io.ReaderAt
+}
+
+// relayStorage is the storage where BinlogReader reads the relay log files from.
+type relayStorage interface {
+	// open opens the file for reading.
+	open(path string) (storageFile, error)
+	// readFile reads the whole file.
+	readFile(path string) ([]byte, error)
+	// exists checks whether the file exists.
+	exists(path string) bool
+	// size returns the current size of the file.
+	size(path string) (int64, error)
+	// listFiles returns the names of the files in the directory (not recursively).
+	listFiles(dir string) ([]string, error)
+	// remote returns whether the relay log files are written by another node,
+	// which means the files should be polled for changes.
+	remote() bool
+}
+
+// newRelayStorage creates the relayStorage for RelayDir of BinlogReaderConfig, and returns the path of the relay
+// directory in the storage. RelayDir is a local path, or an URL of an external storage like `s3://bucket/prefix`.
+// if `archiveURL` is not empty, the relay log files purged from the local RelayDir are read from the archive.
+func newRelayStorage(ctx context.Context, relayDir, archiveURL string) (relayStorage, string) {
+	if u, err := storage.ParseRawURL(relayDir); err == nil {
+		if _, ok := remoteRelaySchemes[u.Scheme]; ok {
+			return &remoteRelayStorage{ctx: ctx, uri: relayDir}, ""
+		}
+	}
+	if archiveURL != "" {
+		return &archivedRelayStorage{relayDir: relayDir, archive: &remoteRelayStorage{ctx: ctx, uri: archiveURL}}, relayDir
+	}
+	return localRelayStorage{}, relayDir
+}
+
+// localRelayStorage reads the relay log files from the local filesystem.
+type localRelayStorage struct{}
+
+func (localRelayStorage) open(path string) (storageFile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (localRelayStorage) readFile(path string) ([]byte, error) {
+	return os.ReadFile(path)
+}
+
+func (localRelayStorage) exists(path string) bool {
+	return utils.IsFileExists(path)
+}
+
+func (localRelayStorage) size(path string) (int64, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+	return fi.Size(), nil
+}
+
+func (localRelayStorage) listFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !isDirEntryDir(dir, entry) {
+			names = append(names, entry.Name())
+		}
+	}
+	return names, nil
+}
+
+func (localRelayStorage) remote() bool {
+	return false
+}
+
+// archivedRelayStorage reads the relay log files from the local filesystem, and falls back to the archive
+// uploaded by relayArchiver for the files in the relay directory purged locally.
+type archivedRelayStorage struct {
+	localRelayStorage
+	relayDir string
+	archive  *remoteRelayStorage
+}
+
+// archivePath returns the path of the local file `p` in the archive.
+func (a *archivedRelayStorage) archivePath(p string) (string, bool) {
+	rel, err := filepath.Rel(a.relayDir, p)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return "", false
+	}
+	return filepath.ToSlash(rel), true
+}
+
+func (a *archivedRelayStorage) open(p string) (storageFile, error) {
+	f, err := a.localRelayStorage.open(p)
+	if err != nil && os.IsNotExist(err) {
+		if rel, ok := a.archivePath(p); ok && a.archive.exists(rel) {
+			return a.archive.open(rel)
+		}
+	}
+	return f, err
+}
+
+func (a *archivedRelayStorage) readFile(p string) ([]byte, error) {
+	data, err := a.localRelayStorage.readFile(p)
+	if err != nil && os.IsNotExist(err) {
+		if rel, ok := a.archivePath(p); ok && a.archive.exists(rel) {
+			return a.archive.readFile(rel)
+		}
+	}
+	return data, err
+}
+
+func (a *archivedRelayStorage) exists(p string) bool {
+	if a.localRelayStorage.exists(p) {
+		return true
+	}
+	rel, ok := a.archivePath(p)
+	return ok && a.archive.exists(rel)
+}
+
+func (a *archivedRelayStorage) size(p string) (int64, error) {
+	size, err := a.localRelayStorage.size(p)
+	if err != nil && os.IsNotExist(err) {
+		if rel, ok := a.archivePath(p); ok {
+			return a.archive.size(rel)
+		}
+	}
+	return size, err
+}
+
+// listFiles returns the files both in the local directory and the archive.
+func (a *archivedRelayStorage) listFiles(dir string) ([]string, error) {
+	names, err := a.localRelayStorage.listFiles(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	rel, ok := a.archivePath(dir)
+	if !ok {
+		return names, err
+	}
+	archivedNames, err2 := a.archive.listFiles(rel)
+	if err2 != nil {
+		if err == nil {
+			log.L().Warn("fail to list relay log files in archive", zap.String("directory", rel), zap.Error(err2))
+			return names, nil
+		}
+		return nil, err2
+	}
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		seen[name] = struct{}{}
+	}
+	for _, name := range archivedNames {
+		if _, ok := seen[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// remoteRelayStorage reads the relay log files from an external storage like S3 or GCS, which are often archived
+// by the relay of another node. the external storage is created when it's used for the first time.
+type remoteRelayStorage struct {
+	ctx context.Context
+	uri string
+
+	once sync.Once
+	s    storage.ExternalStorage
+	err  error
+}
+
+func (r *remoteRelayStorage) externalStorage() (storage.ExternalStorage, error) {
+	r.once.Do(func() {
+		backend, err := storage.ParseBackend(r.uri, &storage.BackendOptions{})
+		if err != nil {
+			r.err = errors.Annotatef(err, "parse relay dir %s", r.uri)
+			return
+		}
+		r.s, r.err = storage.New(r.ctx, backend, &storage.ExternalStorageOptions{})
+		r.err = errors.Annotatef(r.err, "create storage for relay dir %s", r.uri)
+	})
+	return r.s, r.err
+}
+
+func (r *remoteRelayStorage) open(path string) (storageFile, error) {
+	s, err := r.externalStorage()
+	if err != nil {
+		return nil, err
+	}
+	reader, err := s.Open(r.ctx, path)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &remoteStorageFile{r: reader, name: path}, nil
+}
+
+func (r *remoteRelayStorage) readFile(path string) ([]byte, error) {
+	s, err := r.externalStorage()
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.ReadFile(r.ctx, path)
+	return data, errors.Trace(err)
+}
+
+func (r *remoteRelayStorage) exists(path string) bool {
+	s, err := r.externalStorage()
+	if err != nil {
+		return false
+	}
+	exist, err := s.FileExists(r.ctx, path)
+	return err == nil && exist
+}
+
+// size lists the directory of the file to get its size, as not all external storages support to get it directly.
+func (r *remoteRelayStorage) size(filePath string) (int64, error) {
+	var size int64 = -1
+	err := r.walkDir(path.Dir(filePath), func(name string, fileSize int64) {
+		if name == path.Base(filePath) {
+			size = fileSize
+		}
+	})
+	if err != nil {
+		return 0, err
+	}
+	if size < 0 {
+		return 0, errors.NotFoundf("file %s in %s", filePath, r.uri)
+	}
+	return size, nil
+}
+
+func (r *remoteRelayStorage) listFiles(dir string) ([]string, error) {
+	var names []string
+	err := r.walkDir(dir, func(name string, _ int64) {
+		names = append(names, name)
+	})
+	return names, err
+}
+
+// walkDir calls `fn` with the name and size of files in the directory `dir`, files in its sub directories are skipped.
+func (r *remoteRelayStorage) walkDir(dir string, fn func(name string, size int64)) error {
+	s, err := r.externalStorage()
+	if err != nil {
+		return err
+	}
+	dir = path.Clean(dir)
+	if dir == "." {
+		dir = ""
+	}
+	err = s.WalkDir(r.ctx, &storage.WalkOption{SubDir: dir}, func(filePath string, size int64) error {
+		filePath = strings.TrimPrefix(filePath, "/")
+		if fileDir := path.Dir(filePath); fileDir == dir || (fileDir == "." && dir == "") {
+			fn(path.Base(filePath), size)
+		}
+		return nil
+	})
+	return errors.Trace(err)
+}
+
+func (r *remoteRelayStorage) remote() bool {
+	return true
+}
+
+// remoteStorageFile is a file opened from remoteRelayStorage, the file is read with range reads of the external storage.
+type remoteStorageFile struct {
+	r    storage.ExternalFileReader
+	name string
+}
+
+// Name returns the path of the file in the external storage.
+func (f *remoteStorageFile) Name() string {
+	return f.name
+}
+
+// Read implements io.Reader.
+func (f *remoteStorageFile) Read(p []byte) (int, error) {
+	n, err := f.r.Read(p)
+	if n == 0 && err == nil && len(p) > 0 {
+		// the reader may return nothing without io.EOF at the end of the object
+		return 0, io.EOF
+	}
+	return n, err
+}
+
+// Seek implements io.Seeker, seeking to a different offset starts a new range read.
+func (f *remoteStorageFile) Seek(offset int64, whence int) (int64, error) {
+	return f.r.Seek(offset, whence)
+}
+
+// ReadAt implements io.ReaderAt, but changes the offset of the file.
+func (f *remoteStorageFile) ReadAt(p []byte, off int64) (int, error) {
+	if _, err := f.r.Seek(off, io.SeekStart); err != nil {
+		return 0, errors.Trace(err)
+	}
+	n, err := io.ReadFull(f, p)
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+	return n, err
+}
+
+// Close implements io.Closer.
+func (f *remoteStorageFile) Close() error {
+	return f.r.Close()
+}
+
+// collectBinlogFiles is like CollectAllBinlogFiles, but collects the files in `s`.
+func collectBinlogFiles(s relayStorage, dir string) ([]string, error) {
+	if _, ok := s.(localRelayStorage); ok {
+		return CollectAllBinlogFiles(dir)
+	}
+	names, err := s.listFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	return binlog.SortBinlogFilenamesWithExt(names, compressedRelayLogExt), nil
+}
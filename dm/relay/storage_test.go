@@ -0,0 +1,118 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	. "github.com/pingcap/check"
+)
+
+var _ = Suite(&testStorageSuite{})
+
+type testStorageSuite struct{}
+
+func (t *testStorageSuite) TestNewRelayStorage(c *C) {
+	ctx := context.Background()
+	for _, dir := range []string{"/tmp/relay", "./relay_log", "relay_log"} {
+		s, baseDir := newRelayStorage(ctx, dir, "")
+		c.Assert(s.remote(), IsFalse)
+		c.Assert(baseDir, Equals, dir)
+	}
+	for _, dir := range []string{"s3://bucket/prefix", "gcs://bucket/prefix", "file:///tmp/relay"} {
+		s, baseDir := newRelayStorage(ctx, dir, "")
+		c.Assert(s.remote(), IsTrue)
+		c.Assert(baseDir, Equals, "")
+	}
+}
+
+func (t *testStorageSuite) TestRemoteRelayStorage(c *C) {
+	var (
+		dir     = c.MkDir()
+		uuid    = "b60868af-5a6f-11e9-9ea3-0242ac160006.000001"
+		content = []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+		s, _    = newRelayStorage(context.Background(), "file://"+dir, "")
+	)
+	c.Assert(os.MkdirAll(filepath.Join(dir, uuid, "sub"), 0o700), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(dir, "server-uuid.index"), []byte(uuid+"\n"), 0o600), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(dir, uuid, "mysql-bin.000001"), content, 0o600), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(dir, uuid, "mysql-bin.000002.zst"), content[:10], 0o600), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(dir, uuid, "sub", "mysql-bin.000003"), content, 0o600), IsNil)
+
+	// files in sub directories are not listed
+	names, err := s.listFiles(uuid)
+	c.Assert(err, IsNil)
+	c.Assert(names, DeepEquals, []string{"mysql-bin.000001", "mysql-bin.000002.zst"})
+	files, err := collectBinlogFiles(s, uuid)
+	c.Assert(err, IsNil)
+	c.Assert(files, DeepEquals, []string{"mysql-bin.000001", "mysql-bin.000002"})
+	files, err = collectBinlogFilesCmp(s, uuid, "mysql-bin.000001", FileCmpBigger)
+	c.Assert(err, IsNil)
+	c.Assert(files, DeepEquals, []string{"mysql-bin.000002"})
+
+	uuids, err := (&uuidIndexCache{}).get(s, "server-uuid.index")
+	c.Assert(err, IsNil)
+	c.Assert(uuids, DeepEquals, []string{uuid})
+	uuids, err = (&uuidIndexCache{}).get(s, "not-exist.index")
+	c.Assert(err, IsNil)
+	c.Assert(uuids, IsNil)
+
+	filePath := uuid + "/mysql-bin.000001"
+	c.Assert(s.exists(filePath), IsTrue)
+	c.Assert(s.exists(uuid+"/mysql-bin.000003"), IsFalse)
+	size, err := s.size(filePath)
+	c.Assert(err, IsNil)
+	c.Assert(size, Equals, int64(len(content)))
+	_, err = s.size(uuid + "/mysql-bin.000003")
+	c.Assert(err, ErrorMatches, ".*not found.*")
+	realPath, compressed := resolveRelayLogFile(s, uuid+"/mysql-bin.000002")
+	c.Assert(realPath, Equals, uuid+"/mysql-bin.000002.zst")
+	c.Assert(compressed, IsTrue)
+
+	f, err := s.open(filePath)
+	c.Assert(err, IsNil)
+	defer f.Close()
+	c.Assert(f.Name(), Equals, filePath)
+	buf := make([]byte, 4)
+	n, err := f.ReadAt(buf, 10)
+	c.Assert(err, IsNil)
+	c.Assert(buf[:n], DeepEquals, content[10:14])
+	n, err = f.ReadAt(buf, int64(len(content)-2))
+	c.Assert(err, Equals, io.EOF)
+	c.Assert(buf[:n], DeepEquals, content[len(content)-2:])
+	_, err = f.Seek(0, io.SeekStart)
+	c.Assert(err, IsNil)
+	data, err := io.ReadAll(f)
+	c.Assert(err, IsNil)
+	c.Assert(data, DeepEquals, content)
+
+	// the appended data can be read after opened again
+	appended := append(append([]byte{}, content...), content...)
+	c.Assert(os.WriteFile(filepath.Join(dir, filePath), appended, 0o600), IsNil)
+	size, err = s.size(filePath)
+	c.Assert(err, IsNil)
+	c.Assert(size, Equals, int64(len(appended)))
+	cmp, err := fileSizeUpdated(s, filePath, int64(len(content)))
+	c.Assert(err, IsNil)
+	c.Assert(cmp, Equals, 1)
+
+	// failed to create the storage
+	s, _ = newRelayStorage(context.Background(), "s3:///no-bucket", "")
+	_, err = s.listFiles(uuid)
+	c.Assert(err, NotNil)
+	c.Assert(s.exists(filePath), IsFalse)
+}
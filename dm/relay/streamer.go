@@ -24,6 +24,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 
+	"github.com/go-mysql-org/go-mysql/mysql"
 	"github.com/go-mysql-org/go-mysql/replication"
 	"github.com/pingcap/failpoint"
 	"go.uber.org/zap"
@@ -31,6 +32,9 @@ import (
 
 var heartbeatInterval = common.MasterHeartbeatPeriod
 
+// defaultLocalStreamerChannelCapacity is the default capacity of the event channel of LocalStreamer.
+const defaultLocalStreamerChannelCapacity = 10240
+
 // TODO: maybe one day we can make a pull request to go-mysql to support LocalStreamer.
 
 // LocalStreamer reads and parses binlog events from local binlog file.
@@ -39,6 +43,8 @@ type LocalStreamer struct {
 	ech           chan error
 	heatBeatTimer *time.Timer
 	err           error
+	pause         *pauseController // events are not delivered when paused, may be nil
+	pos           mysql.Position   // end position of the latest event returned, carried by heartbeat events
 }
 
 // GetEvent gets the binlog event one by one, it will block until parser occurs some errors.
@@ -68,20 +74,27 @@ func (s *LocalStreamer) GetEvent(ctx context.Context) (*replication.BinlogEvent,
 			}
 		}
 	}()
+	if s.pause != nil {
+		if resumed := s.pause.resumed(); resumed != nil {
+			// keep sending heartbeat events when paused
+			select {
+			case <-s.heatBeatTimer.C:
+				fired = true
+				return s.heartbeatEvent(), nil
+			case <-resumed:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+	}
+
 	select {
 	case <-s.heatBeatTimer.C:
 		fired = true
 		// MySQL will send heartbeat event 30s by default
-		heartbeatHeader := &replication.EventHeader{}
-		return event.GenHeartbeatEvent(heartbeatHeader), nil
+		return s.heartbeatEvent(), nil
 	case c := <-s.ch:
-		// special check for maybe truncated relay log
-		if c.Header.EventType == replication.IGNORABLE_EVENT {
-			if bytes.Equal(c.RawData, []byte(ErrorMaybeDuplicateEvent.Error())) {
-				return nil, ErrorMaybeDuplicateEvent
-			}
-		}
-		return c, nil
+		return s.checkEvent(c)
 	case s.err = <-s.ech:
 		return nil, s.err
 	case <-ctx.Done():
@@ -89,6 +102,69 @@ func (s *LocalStreamer) GetEvent(ctx context.Context) (*replication.BinlogEvent,
 	}
 }
 
+// GetEventWithTimeout gets the binlog event like GetEvent, but returns context.DeadlineExceeded if no event is got within `d`.
+func (s *LocalStreamer) GetEventWithTimeout(d time.Duration) (*replication.BinlogEvent, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+	return s.GetEvent(ctx)
+}
+
+// TryGetEvent gets the binlog event without blocking, it returns a nil event and nil error if no event is available now.
+// no heartbeat event is returned by TryGetEvent.
+func (s *LocalStreamer) TryGetEvent() (*replication.BinlogEvent, error) {
+	if s.err != nil {
+		return nil, terror.ErrNeedSyncAgain.Generate()
+	}
+	if s.pause != nil && s.pause.resumed() != nil {
+		return nil, nil
+	}
+
+	select {
+	case c := <-s.ch:
+		return s.checkEvent(c)
+	case s.err = <-s.ech:
+		return nil, s.err
+	default:
+		return nil, nil
+	}
+}
+
+// heartbeatEvent generates a heartbeat event carrying the end position of the latest event returned, so the
+// consumer can know it has caught up with the relay log when no event comes.
+func (s *LocalStreamer) heartbeatEvent() *replication.BinlogEvent {
+	if s.pos.Name == "" {
+		return event.GenHeartbeatEvent(&replication.EventHeader{})
+	}
+	return event.GenHeartbeatEventWithPosition(&replication.EventHeader{}, s.pos)
+}
+
+// checkEvent checks the event got from the channel and records its end position.
+func (s *LocalStreamer) checkEvent(e *replication.BinlogEvent) (*replication.BinlogEvent, error) {
+	e, err := checkMaybeDuplicateEvent(e)
+	if err != nil {
+		return nil, err
+	}
+	switch ev := e.Event.(type) {
+	case *replication.RotateEvent:
+		s.pos = mysql.Position{Name: string(ev.NextLogName), Pos: uint32(ev.Position)}
+	default:
+		if e.Header.LogPos > 0 && s.pos.Name != "" {
+			s.pos.Pos = e.Header.LogPos
+		}
+	}
+	return e, nil
+}
+
+// checkMaybeDuplicateEvent is a special check for maybe truncated relay log.
+func checkMaybeDuplicateEvent(e *replication.BinlogEvent) (*replication.BinlogEvent, error) {
+	if e.Header.EventType == replication.IGNORABLE_EVENT {
+		if bytes.Equal(e.RawData, []byte(ErrorMaybeDuplicateEvent.Error())) {
+			return nil, ErrorMaybeDuplicateEvent
+		}
+	}
+	return e, nil
+}
+
 func (s *LocalStreamer) close() {
 	s.closeWithError(terror.ErrSyncClosed.Generate())
 }
@@ -105,9 +181,14 @@ func (s *LocalStreamer) closeWithError(err error) {
 }
 
 func newLocalStreamer() *LocalStreamer {
+	return newLocalStreamerWithCapacity(defaultLocalStreamerChannelCapacity)
+}
+
+// newLocalStreamerWithCapacity creates a LocalStreamer whose event channel can buffer `capacity` events.
+func newLocalStreamerWithCapacity(capacity int) *LocalStreamer {
 	s := new(LocalStreamer)
 
-	s.ch = make(chan *replication.BinlogEvent, 10240)
+	s.ch = make(chan *replication.BinlogEvent, capacity)
 	s.ech = make(chan error, 4)
 	s.heatBeatTimer = utils.NewStoppedTimer()
 
@@ -17,6 +17,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/go-mysql-org/go-mysql/mysql"
 	"github.com/go-mysql-org/go-mysql/replication"
 	. "github.com/pingcap/check"
 	"github.com/pingcap/errors"
@@ -104,6 +105,67 @@ func (t *testStreamerSuite) TestStreamer(c *C) {
 	c.Assert(ev2, IsNil)
 }
 
+func (t *testStreamerSuite) TestGetEventWithTimeoutAndTryGetEvent(c *C) {
+	header := &replication.EventHeader{
+		Timestamp: uint32(time.Now().Unix()),
+		ServerID:  11,
+	}
+	ev, err := event.GenFormatDescriptionEvent(header, 4)
+	c.Assert(err, IsNil)
+
+	s := newLocalStreamer()
+	// no event
+	ev2, err := s.TryGetEvent()
+	c.Assert(err, IsNil)
+	c.Assert(ev2, IsNil)
+	ev2, err = s.GetEventWithTimeout(10 * time.Millisecond)
+	c.Assert(errors.Cause(err), Equals, context.DeadlineExceeded)
+	c.Assert(ev2, IsNil)
+
+	s.ch <- ev
+	ev2, err = s.TryGetEvent()
+	c.Assert(err, IsNil)
+	c.Assert(ev2, DeepEquals, ev)
+	s.ch <- ev
+	ev2, err = s.GetEventWithTimeout(time.Second)
+	c.Assert(err, IsNil)
+	c.Assert(ev2, DeepEquals, ev)
+
+	// no event is returned when paused
+	s.pause = &pauseController{}
+	s.pause.pause()
+	s.ch <- ev
+	ev2, err = s.TryGetEvent()
+	c.Assert(err, IsNil)
+	c.Assert(ev2, IsNil)
+	s.pause.resume()
+	ev2, err = s.TryGetEvent()
+	c.Assert(err, IsNil)
+	c.Assert(ev2, DeepEquals, ev)
+
+	// maybe duplicate event
+	s.ch <- &replication.BinlogEvent{
+		Header:  &replication.EventHeader{EventType: replication.IGNORABLE_EVENT},
+		RawData: []byte(ErrorMaybeDuplicateEvent.Error()),
+	}
+	ev2, err = s.TryGetEvent()
+	c.Assert(err, Equals, ErrorMaybeDuplicateEvent)
+	c.Assert(ev2, IsNil)
+
+	// read error
+	errIn := errors.New("error use for streamer test")
+	s.ech <- errIn
+	ev2, err = s.TryGetEvent()
+	c.Assert(err, Equals, errIn)
+	c.Assert(ev2, IsNil)
+	ev2, err = s.TryGetEvent()
+	c.Assert(terror.ErrNeedSyncAgain.Equal(err), IsTrue)
+	c.Assert(ev2, IsNil)
+	ev2, err = s.GetEventWithTimeout(time.Second)
+	c.Assert(terror.ErrNeedSyncAgain.Equal(err), IsTrue)
+	c.Assert(ev2, IsNil)
+}
+
 func (t *testStreamerSuite) TestHeartbeat(c *C) {
 	c.Assert(failpoint.Enable("github.com/pingcap/tiflow/dm/relay/SetHeartbeatInterval", "return(1)"), IsNil)
 	defer func() {
@@ -117,3 +179,26 @@ func (t *testStreamerSuite) TestHeartbeat(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(ev.Header.EventType, Equals, replication.HEARTBEAT_EVENT)
 }
+
+func (t *testStreamerSuite) TestHeartbeatPosition(c *C) {
+	s := newLocalStreamer()
+	_, ok := event.PositionFromHeartbeatEvent(s.heartbeatEvent())
+	c.Assert(ok, IsFalse)
+
+	header := &replication.EventHeader{ServerID: 11}
+	rotateEv, err := event.GenRotateEvent(header, 0, []byte("mysql-bin.000002"), 4)
+	c.Assert(err, IsNil)
+	xidEv, err := event.GenXIDEvent(header, 4, 123)
+	c.Assert(err, IsNil)
+	s.ch <- rotateEv
+	s.ch <- xidEv
+	for i := 0; i < 2; i++ {
+		ev, err2 := s.TryGetEvent()
+		c.Assert(err2, IsNil)
+		c.Assert(ev, NotNil)
+	}
+
+	pos, ok := event.PositionFromHeartbeatEvent(s.heartbeatEvent())
+	c.Assert(ok, IsTrue)
+	c.Assert(pos, DeepEquals, mysql.Position{Name: "mysql-bin.000002", Pos: xidEv.Header.LogPos})
+}
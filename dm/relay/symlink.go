@@ -0,0 +1,56 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// the relay sub directories and the relay log files in them can be symbolic links, so operators can move the cold
+// relay logs to slower but cheaper volumes. the readers follow the links when opening the files, and the purgers
+// remove the targets of the links too.
+
+// isDirEntryDir returns whether the entry in `dir` is a directory, a symbolic link is resolved to its target.
+func isDirEntryDir(dir string, entry os.DirEntry) bool {
+	if entry.Type()&os.ModeSymlink == 0 {
+		return entry.IsDir()
+	}
+	fi, err := os.Stat(filepath.Join(dir, entry.Name()))
+	return err == nil && fi.IsDir()
+}
+
+// removeRelayPath removes the file or directory (recursively) `path`. if `path` is a symbolic link, the target it
+// links to is removed before the link.
+func removeRelayPath(path string) error {
+	fi, err := os.Lstat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if fi.Mode()&os.ModeSymlink != 0 {
+		target, err2 := filepath.EvalSymlinks(path)
+		switch {
+		case err2 == nil:
+			if err2 = os.RemoveAll(target); err2 != nil {
+				return err2
+			}
+		case !os.IsNotExist(err2):
+			return err2
+		}
+	}
+	return os.RemoveAll(path)
+}
@@ -0,0 +1,82 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+var _ = Suite(&testSymlinkSuite{})
+
+type testSymlinkSuite struct{}
+
+func (t *testSymlinkSuite) TestSymlinkedRelayDir(c *C) {
+	var (
+		relayDir = c.MkDir()
+		coldDir  = c.MkDir()
+		uuids    = []string{
+			"b60868af-5a6f-11e9-9ea3-0242ac160006.000001",
+			"b60868af-5a6f-11e9-9ea3-0242ac160007.000002",
+		}
+	)
+	// the first sub directory is moved to the cold volume
+	(&testUUIDIndexSuite{}).prepareSubDirs(c, coldDir, uuids[:1], true)
+	c.Assert(os.Symlink(filepath.Join(coldDir, uuids[0]), filepath.Join(relayDir, uuids[0])), IsNil)
+	(&testUUIDIndexSuite{}).prepareSubDirs(c, relayDir, uuids[1:], true)
+	// the first relay log file in the second sub directory is moved to the cold volume
+	coldFile := filepath.Join(coldDir, "mysql-bin.000001")
+	c.Assert(os.WriteFile(coldFile, []byte("cold"), 0o600), IsNil)
+	c.Assert(os.Symlink(coldFile, filepath.Join(relayDir, uuids[1], "mysql-bin.000001")), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(relayDir, uuids[1], "mysql-bin.000002"), []byte("hot"), 0o600), IsNil)
+
+	rebuilt, err := rebuildUUIDs(relayDir, nil)
+	c.Assert(err, IsNil)
+	c.Assert(rebuilt, DeepEquals, uuids)
+
+	files, err := localRelayStorage{}.listFiles(relayDir)
+	c.Assert(err, IsNil)
+	c.Assert(files, HasLen, 0)
+	files, err = localRelayStorage{}.listFiles(filepath.Join(relayDir, uuids[1]))
+	c.Assert(err, IsNil)
+	c.Assert(files, DeepEquals, []string{"mysql-bin.000001", "mysql-bin.000002", utils.MetaFilename})
+	data, err := os.ReadFile(filepath.Join(relayDir, uuids[1], "mysql-bin.000001"))
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, "cold")
+
+	// the targets of the links are purged too
+	err = purgeRelayFiles(log.L(), []*subRelayFiles{
+		{dir: filepath.Join(relayDir, uuids[0]), hasAll: true},
+		{dir: filepath.Join(relayDir, uuids[1]), files: []string{filepath.Join(relayDir, uuids[1], "mysql-bin.000001")}},
+	})
+	c.Assert(err, IsNil)
+	c.Assert(utils.IsDirExists(filepath.Join(coldDir, uuids[0])), IsFalse)
+	_, err = os.Lstat(filepath.Join(relayDir, uuids[0]))
+	c.Assert(os.IsNotExist(err), IsTrue)
+	c.Assert(utils.IsFileExists(coldFile), IsFalse)
+	_, err = os.Lstat(filepath.Join(relayDir, uuids[1], "mysql-bin.000001"))
+	c.Assert(os.IsNotExist(err), IsTrue)
+	c.Assert(utils.IsFileExists(filepath.Join(relayDir, uuids[1], "mysql-bin.000002")), IsTrue)
+
+	// dangling links are removed
+	c.Assert(os.Symlink(filepath.Join(coldDir, "not-exist"), filepath.Join(relayDir, "dangling")), IsNil)
+	c.Assert(removeRelayPath(filepath.Join(relayDir, "dangling")), IsNil)
+	_, err = os.Lstat(filepath.Join(relayDir, "dangling"))
+	c.Assert(os.IsNotExist(err), IsTrue)
+}
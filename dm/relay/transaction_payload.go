@@ -0,0 +1,87 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"encoding/binary"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+
+	"github.com/pingcap/tiflow/dm/pkg/binlog/event"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// transactionPayloadDecoder unpacks the inner events of TransactionPayloadEvents (MySQL 8.0 compressed transactions).
+// the inner events have no checksum, so they are parsed by a separate parser with the FormatDescriptionEvent of the
+// relay log file but with the checksum disabled.
+type transactionPayloadDecoder struct {
+	cfg    *BinlogReaderConfig
+	parser *replication.BinlogParser // nil before the FormatDescriptionEvent is read
+}
+
+func newTransactionPayloadDecoder(cfg *BinlogReaderConfig) *transactionPayloadDecoder {
+	return &transactionPayloadDecoder{cfg: cfg}
+}
+
+// setFormatDescription prepares the parser for inner events with the FormatDescriptionEvent of the relay log file.
+func (d *transactionPayloadDecoder) setFormatDescription(e *replication.BinlogEvent) error {
+	fde, ok := e.Event.(*replication.FormatDescriptionEvent)
+	if !ok {
+		return terror.ErrBinlogExpectFormatDescEv.Generate(e.Header)
+	}
+	rawData := make([]byte, len(e.RawData))
+	copy(rawData, e.RawData)
+	if fde.ChecksumAlgorithm == replication.BINLOG_CHECKSUM_ALG_CRC32 {
+		// the checksum algorithm is the 1 byte before the checksum
+		rawData[len(rawData)-replication.BinlogChecksumLength-1] = replication.BINLOG_CHECKSUM_ALG_OFF
+	}
+
+	parser := newRelayLogParser(d.cfg)
+	if _, err := parser.Parse(rawData); err != nil {
+		return terror.ErrBinlogEventDecode.Delegate(err, rawData)
+	}
+	d.parser = parser
+	return nil
+}
+
+// decode returns the inner events of the TransactionPayloadEvent `e`. the inner events are given the end log position
+// of `e`, as they can't be located in the relay log file separately.
+func (d *transactionPayloadDecoder) decode(e *replication.BinlogEvent) ([]*replication.BinlogEvent, error) {
+	if d.parser == nil {
+		return nil, terror.ErrBinlogTransactionPayloadNotValid.Generate("no FormatDescriptionEvent before it")
+	}
+	ev, ok := e.Event.(*replication.GenericEvent)
+	if !ok {
+		return nil, terror.ErrBinlogTransactionPayloadNotValid.Generate("not parsed as GenericEvent")
+	}
+	payload, err := event.DecodeTransactionPayload(ev.Data)
+	if err != nil {
+		return nil, err
+	}
+	rawEvents, err := event.SplitTransactionPayload(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*replication.BinlogEvent, 0, len(rawEvents))
+	for _, rawData := range rawEvents {
+		binary.LittleEndian.PutUint32(rawData[13:], e.Header.LogPos) // log_pos
+		inner, err2 := d.parser.Parse(rawData)
+		if err2 != nil {
+			return nil, terror.ErrBinlogTransactionPayloadNotValid.Generate(err2.Error())
+		}
+		events = append(events, inner)
+	}
+	return events, nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/pkg/binlog/event"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+var _ = Suite(&testTransactionPayloadSuite{})
+
+type testTransactionPayloadSuite struct{}
+
+func (t *testTransactionPayloadSuite) TestDecode(c *C) {
+	var (
+		header = &replication.EventHeader{
+			Timestamp: uint32(time.Now().Unix()),
+			ServerID:  11,
+		}
+		d = newTransactionPayloadDecoder(&BinlogReaderConfig{})
+	)
+	fde, err := event.GenFormatDescriptionEvent(header, 4)
+	c.Assert(err, IsNil)
+	xidEv, err := event.GenXIDEvent(header, fde.Header.LogPos, 123)
+	c.Assert(err, IsNil)
+	payloadEv, err := event.GenTransactionPayloadEvent(header, fde.Header.LogPos, event.TransactionPayloadCompressionZstd, []*replication.BinlogEvent{xidEv})
+	c.Assert(err, IsNil)
+	rawData := append([]byte(nil), payloadEv.RawData...)
+
+	// no FormatDescriptionEvent
+	_, err = d.decode(payloadEv)
+	c.Assert(terror.ErrBinlogTransactionPayloadNotValid.Equal(err), IsTrue)
+	c.Assert(terror.ErrBinlogExpectFormatDescEv.Equal(d.setFormatDescription(xidEv)), IsTrue)
+
+	c.Assert(d.setFormatDescription(fde), IsNil)
+	events, err := d.decode(payloadEv)
+	c.Assert(err, IsNil)
+	c.Assert(events, HasLen, 1)
+	c.Assert(events[0].Header.EventType, Equals, replication.XID_EVENT)
+	c.Assert(events[0].Header.LogPos, Equals, payloadEv.Header.LogPos)
+	c.Assert(events[0].Event.(*replication.XIDEvent).XID, Equals, uint64(123))
+	// the TransactionPayloadEvent is not changed
+	c.Assert(payloadEv.RawData, DeepEquals, rawData)
+
+	// not a GenericEvent
+	_, err = d.decode(xidEv)
+	c.Assert(terror.ErrBinlogTransactionPayloadNotValid.Equal(err), IsTrue)
+}
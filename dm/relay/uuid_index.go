@@ -0,0 +1,129 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/BurntSushi/toml"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+// RebuildUUIDIndex rebuilds the server-uuid index file in `relayDir` from the relay sub directories, and returns the
+// UUIDs in the rebuilt index file. it's used to recover from a missing or truncated index file, like after a partial
+// restore of the relay directory. see rebuildUUIDs for which sub directories are kept.
+func RebuildUUIDIndex(relayDir string) ([]string, error) {
+	indexPath := filepath.Join(relayDir, utils.UUIDIndexFilename)
+	indexed, err := utils.ParseUUIDIndex(indexPath)
+	if err != nil {
+		log.L().Warn("ignore the server-uuid index file can't be parsed", zap.String("file", indexPath), zap.Error(err))
+		indexed = nil
+	}
+	uuids, err := rebuildUUIDs(relayDir, indexed)
+	if err != nil {
+		return nil, err
+	}
+	if err = writeUUIDIndex(indexPath, uuids); err != nil {
+		return nil, err
+	}
+	log.L().Info("server-uuid index file rebuilt", zap.String("file", indexPath),
+		zap.Strings("old uuids", indexed), zap.Strings("uuids", uuids))
+	return uuids, nil
+}
+
+// rebuildUUIDs returns the UUIDs of the relay sub directories in `relayDir` sorted by their suffixes, which are
+// the sub directories having a valid relay meta file, or the existing ones in `indexed` (the UUIDs in the old index
+// file), because the relay creates the relay meta file after adding the sub directory to the index file.
+// the suffixes of the UUIDs should be continuous, or some relay log files are missing.
+func rebuildUUIDs(relayDir string, indexed []string) ([]string, error) {
+	entries, err := os.ReadDir(relayDir)
+	if err != nil {
+		return nil, terror.ErrReadDir.Delegate(err, relayDir)
+	}
+	kept := make(map[string]struct{}, len(indexed))
+	for _, uuid := range indexed {
+		if _, _, err2 := utils.ParseSuffixForUUID(uuid); err2 == nil && utils.IsDirExists(filepath.Join(relayDir, uuid)) {
+			kept[uuid] = struct{}{}
+		}
+	}
+	for _, entry := range entries {
+		if !isDirEntryDir(relayDir, entry) {
+			continue
+		}
+		uuid := entry.Name()
+		if _, _, err2 := utils.ParseSuffixForUUID(uuid); err2 != nil {
+			continue // not a relay sub directory
+		}
+		meta := &LocalMeta{}
+		if _, err2 := toml.DecodeFile(filepath.Join(relayDir, uuid, utils.MetaFilename), meta); err2 != nil {
+			log.L().Warn("skip relay sub directory without valid relay meta file", zap.String("sub directory", uuid), zap.Error(err2))
+			continue
+		}
+		kept[uuid] = struct{}{}
+	}
+
+	uuids := make([]string, 0, len(kept))
+	suffixes := make(map[string]int, len(kept))
+	for uuid := range kept {
+		_, suffix, _ := utils.ParseSuffixForUUID(uuid)
+		uuids = append(uuids, uuid)
+		suffixes[uuid] = suffix
+	}
+	sort.Slice(uuids, func(i, j int) bool {
+		return suffixes[uuids[i]] < suffixes[uuids[j]]
+	})
+	for i := 1; i < len(uuids); i++ {
+		if suffix, previousSuffix := suffixes[uuids[i]], suffixes[uuids[i-1]]; suffix != previousSuffix+1 {
+			return nil, terror.ErrRelayUUIDSuffixNotValid.Generate(uuids[i], suffix, previousSuffix)
+		}
+	}
+	return uuids, nil
+}
+
+// uuidIndexNeedRebuild checks whether the UUIDs parsed from the index file are missing or truncated compared with
+// `uuids` of the relay sub directories returned by rebuildUUIDs.
+func uuidIndexNeedRebuild(indexed, uuids []string) bool {
+	exist := make(map[string]struct{}, len(indexed))
+	for _, uuid := range indexed {
+		if _, _, err := utils.ParseSuffixForUUID(uuid); err != nil {
+			return true // truncated
+		}
+		exist[uuid] = struct{}{}
+	}
+	for _, uuid := range uuids {
+		if _, ok := exist[uuid]; !ok {
+			return true
+		}
+	}
+	return false
+}
+
+// writeUUIDIndex writes the server-uuid index file atomically.
+func writeUUIDIndex(indexPath string, uuids []string) error {
+	var buf bytes.Buffer
+	for _, uuid := range uuids {
+		buf.WriteString(uuid)
+		buf.WriteString("\n")
+	}
+
+	err := utils.WriteFileAtomic(indexPath, buf.Bytes(), 0o644)
+	return terror.ErrRelayUpdateIndexFile.Delegate(err, indexPath)
+}
@@ -0,0 +1,120 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"os"
+	"path/filepath"
+
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+var _ = Suite(&testUUIDIndexSuite{})
+
+type testUUIDIndexSuite struct{}
+
+func (t *testUUIDIndexSuite) prepareSubDirs(c *C, relayDir string, uuids []string, withMeta bool) {
+	for _, uuid := range uuids {
+		subDir := filepath.Join(relayDir, uuid)
+		c.Assert(os.MkdirAll(subDir, 0o700), IsNil)
+		if withMeta {
+			(&testReaderSuite{}).createMetaFile(c, subDir, "mysql-bin.000001", 4, "")
+		}
+	}
+}
+
+func (t *testUUIDIndexSuite) TestRebuildUUIDIndex(c *C) {
+	var (
+		relayDir  = c.MkDir()
+		indexPath = filepath.Join(relayDir, utils.UUIDIndexFilename)
+		uuids     = []string{
+			"b60868af-5a6f-11e9-9ea3-0242ac160006.000001",
+			"b60868af-5a6f-11e9-9ea3-0242ac160007.000002",
+			"b60868af-5a6f-11e9-9ea3-0242ac160008.000003",
+		}
+	)
+	t.prepareSubDirs(c, relayDir, uuids[:2], true)
+	// the newest sub directory is added to the index file before its meta file is created
+	t.prepareSubDirs(c, relayDir, uuids[2:], false)
+	// not relay sub directories
+	c.Assert(os.MkdirAll(filepath.Join(relayDir, "tmp"), 0o700), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(relayDir, "b60868af-5a6f-11e9-9ea3-0242ac160009.000004"), nil, 0o600), IsNil)
+
+	// missing index file
+	rebuilt, err := rebuildUUIDs(relayDir, nil)
+	c.Assert(err, IsNil)
+	c.Assert(rebuilt, DeepEquals, uuids[:2])
+	c.Assert(uuidIndexNeedRebuild(nil, rebuilt), IsTrue)
+	rebuilt, err = RebuildUUIDIndex(relayDir)
+	c.Assert(err, IsNil)
+	c.Assert(rebuilt, DeepEquals, uuids[:2])
+	indexed, err := utils.ParseUUIDIndex(indexPath)
+	c.Assert(err, IsNil)
+	c.Assert(indexed, DeepEquals, uuids[:2])
+
+	// truncated index file
+	c.Assert(os.WriteFile(indexPath, []byte(uuids[0]+"\n"+uuids[1]+"\n"+uuids[2][:40]), 0o600), IsNil)
+	indexed, err = utils.ParseUUIDIndex(indexPath)
+	c.Assert(err, IsNil)
+	rebuilt, err = rebuildUUIDs(relayDir, indexed)
+	c.Assert(err, IsNil)
+	c.Assert(rebuilt, DeepEquals, uuids[:2])
+	c.Assert(uuidIndexNeedRebuild(indexed, rebuilt), IsTrue)
+
+	// the sub directories in the index file are kept
+	c.Assert(os.WriteFile(indexPath, []byte(uuids[1]+"\n"+uuids[2]+"\n"), 0o600), IsNil)
+	rebuilt, err = RebuildUUIDIndex(relayDir)
+	c.Assert(err, IsNil)
+	c.Assert(rebuilt, DeepEquals, uuids)
+	c.Assert(uuidIndexNeedRebuild(uuids, rebuilt), IsFalse)
+	c.Assert(uuidIndexNeedRebuild(uuids[1:], rebuilt), IsTrue)
+
+	// not continuous suffixes
+	c.Assert(os.RemoveAll(filepath.Join(relayDir, uuids[1])), IsNil)
+	_, err = RebuildUUIDIndex(relayDir)
+	c.Assert(terror.ErrRelayUUIDSuffixNotValid.Equal(err), IsTrue)
+
+	_, err = RebuildUUIDIndex(filepath.Join(relayDir, "not-exist"))
+	c.Assert(terror.ErrReadDir.Equal(err), IsTrue)
+}
+
+func (t *testUUIDIndexSuite) TestReaderRebuildUUIDIndex(c *C) {
+	var (
+		relayDir = c.MkDir()
+		uuids    = []string{
+			"b60868af-5a6f-11e9-9ea3-0242ac160006.000001",
+			"b60868af-5a6f-11e9-9ea3-0242ac160007.000002",
+		}
+		cfg = &BinlogReaderConfig{RelayDir: relayDir, Flavor: gmysql.MySQLFlavor}
+		r   = newBinlogReaderForTest(log.L(), cfg, false, "")
+	)
+	t.prepareSubDirs(c, relayDir, uuids, true)
+	c.Assert(os.WriteFile(r.indexPath, []byte(uuids[0]+"\n"+uuids[1][:20]), 0o600), IsNil)
+
+	// not rebuilt by default
+	c.Assert(r.updateUUIDs(), IsNil)
+	c.Assert(r.uuids, DeepEquals, []string{uuids[0], uuids[1][:20]})
+
+	cfg.RebuildUUIDIndex = true
+	c.Assert(r.updateUUIDs(), IsNil)
+	c.Assert(r.uuids, DeepEquals, uuids)
+	indexed, err := utils.ParseUUIDIndex(r.indexPath)
+	c.Assert(err, IsNil)
+	c.Assert(indexed, DeepEquals, uuids)
+}
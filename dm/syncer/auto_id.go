@@ -0,0 +1,100 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"strconv"
+	"strings"
+
+	cm "github.com/pingcap/tidb-tools/pkg/column-mapping"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// autoIDMapping is the column mapping to prefix the auto-increment key of a source table, it's rebuilt when the
+// table info is changed by DDLs.
+type autoIDMapping struct {
+	ti      *model.TableInfo
+	mapping *cm.Mapping // nil if the table has no BIGINT auto-increment key
+}
+
+// autoIDKeyColumn returns the auto-increment column of the table if it's a BIGINT column and is the primary key or a
+// unique key by itself, otherwise nil is returned.
+func autoIDKeyColumn(ti *model.TableInfo) *model.ColumnInfo {
+	var col *model.ColumnInfo
+	for _, c := range ti.Columns {
+		if mysql.HasAutoIncrementFlag(c.Flag) {
+			col = c
+			break
+		}
+	}
+	if col == nil || col.Tp != mysql.TypeLonglong {
+		return nil
+	}
+	if ti.PKIsHandle && mysql.HasPriKeyFlag(col.Flag) {
+		return col
+	}
+	for _, idx := range ti.Indices {
+		if (idx.Primary || idx.Unique) && len(idx.Columns) == 1 && idx.Columns[0].Offset == col.Offset {
+			return col
+		}
+	}
+	return nil
+}
+
+// trimNumericSuffix returns the name without the trailing digits, which is used as the prefix argument of the
+// `partition id` expression, the trailing digits are the ID of the schema or table.
+func trimNumericSuffix(name string) string {
+	return strings.TrimRightFunc(name, func(r rune) bool { return r >= '0' && r <= '9' })
+}
+
+// autoIDMappingOf returns the column mapping to prefix the auto-increment key of the source table with the index of
+// the source and the numeric suffixes of the schema and table names when `auto-id-conflict-strategy` is
+// `source-prefix`, or nil if the key of the table can't be prefixed.
+func (s *Syncer) autoIDMappingOf(table *filter.Table, ti *model.TableInfo) (*cm.Mapping, error) {
+	if s.cfg.AutoIDConflictStrategy != config.AutoIDConflictSourcePrefix {
+		return nil, nil
+	}
+	if s.autoIDMappings == nil {
+		s.autoIDMappings = make(map[string]*autoIDMapping)
+	}
+	key := table.String()
+	if m, ok := s.autoIDMappings[key]; ok && m.ti == ti {
+		return m.mapping, nil
+	}
+
+	m := &autoIDMapping{ti: ti}
+	if col := autoIDKeyColumn(ti); col != nil {
+		rule := &cm.Rule{
+			PatternSchema: table.Schema,
+			PatternTable:  table.Name,
+			TargetColumn:  col.Name.O,
+			Expression:    cm.PartitionID,
+			Arguments: []string{
+				strconv.Itoa(s.cfg.SourceIndex), trimNumericSuffix(table.Schema), trimNumericSuffix(table.Name), "",
+			},
+		}
+		mapping, err := cm.NewMapping(s.cfg.CaseSensitive, []*cm.Rule{rule})
+		if err != nil {
+			return nil, terror.ErrSyncerUnitGenColumnMapping.Delegate(err)
+		}
+		m.mapping = mapping
+	}
+	s.autoIDMappings[key] = m
+	return m.mapping, nil
+}
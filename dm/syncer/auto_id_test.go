@@ -0,0 +1,67 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/util/mock"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+)
+
+func (s *testSyncerSuite) TestAutoIDSourcePrefix(c *C) {
+	p := parser.New()
+	se := mock.NewContext()
+	cfg, err := s.cfg.Clone()
+	c.Assert(err, IsNil)
+	cfg.AutoIDConflictStrategy = config.AutoIDConflictSourcePrefix
+	cfg.SourceIndex = 1
+	syncer := NewSyncer(cfg, nil, nil)
+
+	ti, err := createTableInfo(p, se, 1, "create table t_2 (id bigint primary key auto_increment, c int)")
+	c.Assert(err, IsNil)
+	c.Assert(autoIDKeyColumn(ti).Name.O, Equals, "id")
+	table := &filter.Table{Schema: "db_3", Name: "t_2"}
+	rows, err := syncer.mappingDML(table, ti, [][]interface{}{{int64(10), int64(1)}})
+	c.Assert(err, IsNil)
+	// 4 bits of the source index, 7 bits of the schema ID and 8 bits of the table ID.
+	c.Assert(rows, DeepEquals, [][]interface{}{{int64(1<<59 | 3<<52 | 2<<44 | 10), int64(1)}})
+	mapping, err := syncer.autoIDMappingOf(table, ti)
+	c.Assert(err, IsNil)
+	c.Assert(mapping, NotNil)
+
+	// the schema and table IDs are 0 if the names have no numeric suffix.
+	ti2, err := createTableInfo(p, se, 2, "create table t (id bigint auto_increment, c int, unique key(id))")
+	c.Assert(err, IsNil)
+	rows, err = syncer.mappingDML(&filter.Table{Schema: "db", Name: "t"}, ti2, [][]interface{}{{int64(10), int64(1)}})
+	c.Assert(err, IsNil)
+	c.Assert(rows, DeepEquals, [][]interface{}{{int64(1<<59 | 10), int64(1)}})
+
+	// the keys which are not BIGINT are not prefixed.
+	ti3, err := createTableInfo(p, se, 3, "create table t_3 (id int primary key auto_increment, c int)")
+	c.Assert(err, IsNil)
+	c.Assert(autoIDKeyColumn(ti3), IsNil)
+	rows, err = syncer.mappingDML(&filter.Table{Schema: "db_1", Name: "t_3"}, ti3, [][]interface{}{{int32(10), int64(1)}})
+	c.Assert(err, IsNil)
+	c.Assert(rows, DeepEquals, [][]interface{}{{int32(10), int64(1)}})
+
+	// the mapping is rebuilt when the table info is changed.
+	ti4, err := createTableInfo(p, se, 1, "create table t_2 (c int, id bigint primary key auto_increment)")
+	c.Assert(err, IsNil)
+	rows, err = syncer.mappingDML(table, ti4, [][]interface{}{{int64(1), int64(10)}})
+	c.Assert(err, IsNil)
+	c.Assert(rows, DeepEquals, [][]interface{}{{int64(1), int64(1<<59 | 3<<52 | 2<<44 | 10)}})
+}
@@ -23,6 +23,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/binlog/event"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 )
 
@@ -55,15 +56,48 @@ type locationRecorder struct {
 	// distinguish DML query event.
 	inDML bool
 
+	// the inner events of a TransactionPayloadEvent (MySQL 8.0 compressed transaction) follow it and all of them have the
+	// end position of the TransactionPayloadEvent, so the curStartLocation of them is kept as the location before the
+	// TransactionPayloadEvent.
+	inPayload            bool
+	payloadStartLocation binlog.Location
+	payloadEndPos        uint32
+
+	// inTxn is true after the first event of a transaction is updated, and false after the last one.
+	// txnBegan and txnEnded record the transaction boundaries met by the latest update, the hooks are called for them
+	// after the lock is released.
+	inTxn         bool
+	txnBegan      bool
+	txnEnded      bool
+	txnBeginHooks []func()
+	txnEndHooks   []func(loc binlog.Location)
+
 	mu sync.Mutex // guard curEndLocation because Syncer.printStatus is reading it from another goroutine.
 }
 
+// OnTxnBegin registers a hook which is called when the first event of a transaction is updated.
+func (l *locationRecorder) OnTxnBegin(hook func()) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.txnBeginHooks = append(l.txnBeginHooks, hook)
+}
+
+// OnTxnEnd registers a hook which is called with the txnEndLocation when the last event of a transaction is updated.
+// the hooks are called in the goroutine calling update, and should not block.
+func (l *locationRecorder) OnTxnEnd(hook func(loc binlog.Location)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.txnEndHooks = append(l.txnEndHooks, hook)
+}
+
 func (l *locationRecorder) reset(loc binlog.Location) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 	l.curStartLocation = loc
 	l.curEndLocation = loc
 	l.txnEndLocation = loc
+	l.inPayload = false
+	l.inTxn = false
 }
 
 //nolint:unused
@@ -82,6 +116,10 @@ func (l *locationRecorder) setCurEndLocation(location binlog.Location) {
 
 func (l *locationRecorder) saveTxnEndLocation() {
 	l.txnEndLocation = l.curEndLocation.Clone()
+	if l.inTxn {
+		l.inTxn = false
+		l.txnEnded = true
+	}
 }
 
 // shouldUpdatePos returns true when the given event is from a real upstream writing, returns false when the event is
@@ -117,25 +155,69 @@ func (l *locationRecorder) setCurrentGTID(gset mysql.GTIDSet) {
 // - txnEndLocation is assigned to curEndLocation when `e` is the last event of a transaction.
 func (l *locationRecorder) update(e *replication.BinlogEvent) {
 	l.mu.Lock()
-	defer l.mu.Unlock()
+	l.txnBegan, l.txnEnded = false, false
+	l.updateLocked(e)
+	var (
+		beginHooks []func()
+		endHooks   []func(loc binlog.Location)
+		endLoc     binlog.Location
+	)
+	if l.txnBegan {
+		beginHooks = l.txnBeginHooks
+	}
+	if l.txnEnded {
+		endHooks = l.txnEndHooks
+		endLoc = l.txnEndLocation.Clone()
+	}
+	l.mu.Unlock()
 
+	for _, hook := range beginHooks {
+		hook()
+	}
+	for _, hook := range endHooks {
+		hook(endLoc)
+	}
+}
+
+func (l *locationRecorder) updateLocked(e *replication.BinlogEvent) {
 	l.curStartLocation = l.curEndLocation
 
 	if !shouldUpdatePos(e) {
 		return
 	}
 
+	if l.inPayload {
+		if e.Header.LogPos == l.payloadEndPos && e.Header.EventType != event.TransactionPayloadEvent {
+			l.curStartLocation = l.payloadStartLocation
+		} else {
+			l.inPayload = false
+		}
+	}
+	if e.Header.EventType == event.TransactionPayloadEvent {
+		// the location is updated by the inner events
+		l.inPayload = true
+		l.payloadStartLocation = l.curEndLocation.Clone()
+		l.payloadEndPos = e.Header.LogPos
+		return
+	}
+
 	if event, ok := e.Event.(*replication.RotateEvent); ok {
 		nextName := string(event.NextLogName)
 		if l.curEndLocation.Position.Name != nextName {
 			l.curEndLocation.Position.Name = nextName
 			l.curEndLocation.Position.Pos = binlog.FileHeaderLen
+			// not the end of a transaction, an incomplete one is re-read from its beginning after rotated.
+			l.inTxn = false
 			l.saveTxnEndLocation()
 		}
 		return
 	}
 
 	l.curEndLocation.Position.Pos = e.Header.LogPos
+	if !l.inTxn {
+		l.inTxn = true
+		l.txnBegan = true
+	}
 
 	switch ev := e.Event.(type) {
 	case *replication.XIDEvent:
@@ -154,6 +236,14 @@ func (l *locationRecorder) update(e *replication.BinlogEvent) {
 		case "COMMIT":
 			// for non-transactional engines like MyISAM, COMMIT is query event
 			l.inDML = false
+		default:
+			switch op, _ := parseXAStatement(query); op {
+			case xaStart, xaEnd:
+				// the event group of a XA transaction is ended by a XA_PREPARE_LOG_EVENT, which has no GTID set, so we
+				// record the GTID set from `XA START` and `XA END`.
+				l.setCurrentGTID(ev.GSet)
+				l.inDML = true
+			}
 		}
 
 		if l.inDML {
@@ -163,12 +253,28 @@ func (l *locationRecorder) update(e *replication.BinlogEvent) {
 		l.setCurrentGTID(ev.GSet)
 		l.saveTxnEndLocation()
 	case *replication.MariadbGTIDEvent:
-		if !ev.IsDDL() {
+		// a standalone event group has only one QueryEvent without COMMIT or XIDEvent.
+		if !ev.IsDDL() && !ev.IsStandalone() {
 			l.inDML = true
 		}
+	default:
+		if e.Header.EventType == replication.XA_PREPARE_LOG_EVENT {
+			// `XA PREPARE` or `XA COMMIT ... ONE PHASE`, the following `XA COMMIT` or `XA ROLLBACK` of a prepared XA
+			// transaction is another event group.
+			l.saveTxnEndLocation()
+			l.inDML = false
+		}
 	}
 }
 
+// isTxnEnd returns true when no transaction is in progress, which means the latest event is the end of a transaction
+// or does not belong to any transaction.
+func (l *locationRecorder) isTxnEnd() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return binlog.ComparePosition(l.curEndLocation.Position, l.txnEndLocation.Position) == 0
+}
+
 // String implements fmt.Stringer.
 func (l *locationRecorder) String() string {
 	return fmt.Sprintf("curStartLocation: %s, curEndLocation: %s, txnEndLocation: %s",
@@ -358,3 +358,193 @@ func (s *testLocationSuite) TestRotateEvent(c *C) {
 	s.checkOneTxnEvents(c, events[:4], expected[:5])
 	s.checkOneTxnEvents(c, events[4:], expected[4:])
 }
+
+func (s *testLocationSuite) TestTransactionPayloadUpdateLocations(c *C) {
+	events := s.generateDMLEvents(c)
+	c.Assert(events, HasLen, 8)
+	c.Assert(events[3].Header.EventType, Equals, replication.GTID_EVENT)
+	c.Assert(events[7].Header.EventType, Equals, replication.XID_EVENT)
+
+	// wrap events after the GTID event, and set their positions like the relay reader does
+	header := &replication.EventHeader{
+		Timestamp: uint32(time.Now().Unix()),
+		ServerID:  s.serverID,
+	}
+	payloadEv, err := event.GenTransactionPayloadEvent(header, events[3].Header.LogPos, event.TransactionPayloadCompressionZstd, events[4:])
+	c.Assert(err, IsNil)
+	for _, e := range events[4:] {
+		e.Header.LogPos = payloadEv.Header.LogPos
+	}
+	events = append(events[:4], append([]*replication.BinlogEvent{payloadEv}, events[4:]...)...)
+
+	r := &locationRecorder{}
+	r.reset(s.loc)
+	for _, e := range events[:4] {
+		r.update(e)
+	}
+	gtidLoc := s.loc
+	gtidLoc.Position.Pos = events[3].Header.LogPos
+	c.Assert(r.curEndLocation, DeepEquals, gtidLoc)
+
+	// the TransactionPayloadEvent itself doesn't change the location
+	r.update(events[4])
+	c.Assert(r.curStartLocation, DeepEquals, gtidLoc)
+	c.Assert(r.curEndLocation, DeepEquals, gtidLoc)
+
+	payloadEndLoc := gtidLoc
+	payloadEndLoc.Position.Pos = payloadEv.Header.LogPos
+	for _, e := range events[5:] {
+		r.update(e)
+		c.Assert(r.curStartLocation, DeepEquals, gtidLoc)
+		c.Assert(r.curEndLocation.Position, DeepEquals, payloadEndLoc.Position)
+	}
+	c.Assert(r.txnEndLocation.Position, DeepEquals, payloadEndLoc.Position)
+	c.Assert(r.txnEndLocation.GetGTID().String(), Equals, s.currGSetStr)
+	c.Assert(r.inPayload, IsTrue)
+
+	// leaves the payload after an event with another position
+	rotate, err := event.GenRotateEvent(header, payloadEv.Header.LogPos, []byte(s.nextBinlogFile), 4)
+	c.Assert(err, IsNil)
+	r.update(rotate)
+	c.Assert(r.inPayload, IsFalse)
+	c.Assert(r.curStartLocation.Position, DeepEquals, payloadEndLoc.Position)
+	c.Assert(r.curEndLocation.Position, DeepEquals, mysql.Position{Name: s.nextBinlogFile, Pos: 4})
+}
+
+func (s *testLocationSuite) TestMariaDBUpdateLocations(c *C) {
+	prevGSet, err := gtid.ParserGTID(mysql.MariaDBFlavor, "0-1-5,1-2-3")
+	c.Assert(err, IsNil)
+	loc := binlog.InitLocation(mysql.Position{Name: s.binlogFile, Pos: s.binlogPos}, prevGSet)
+
+	var (
+		logPos = s.binlogPos
+		gset   = prevGSet.Origin()
+	)
+	gtidEvent := func(domainID uint32, seq uint64, flags byte) *replication.BinlogEvent {
+		logPos += 40
+		g := mysql.MariadbGTID{DomainID: domainID, ServerID: s.serverID, SequenceNumber: seq}
+		c.Assert(gset.Update(g.String()), IsNil)
+		return &replication.BinlogEvent{
+			Header: &replication.EventHeader{EventType: replication.MARIADB_GTID_EVENT, LogPos: logPos},
+			Event:  &replication.MariadbGTIDEvent{GTID: g, Flags: flags},
+		}
+	}
+	queryEvent := func(query string) *replication.BinlogEvent {
+		logPos += 60
+		return &replication.BinlogEvent{
+			Header: &replication.EventHeader{EventType: replication.QUERY_EVENT, LogPos: logPos},
+			Event:  &replication.QueryEvent{Query: []byte(query), GSet: gset.Clone()},
+		}
+	}
+
+	r := &locationRecorder{}
+	r.reset(loc)
+	c.Assert(r.isTxnEnd(), IsTrue)
+
+	// a standalone event group without COMMIT or XIDEvent
+	r.update(gtidEvent(0, 6, replication.BINLOG_MARIADB_FL_STANDALONE))
+	c.Assert(r.isTxnEnd(), IsFalse)
+	r.update(queryEvent("FLUSH PRIVILEGES"))
+	c.Assert(r.isTxnEnd(), IsTrue)
+	c.Assert(r.txnEndLocation.Position.Pos, Equals, logPos)
+	c.Assert(r.txnEndLocation.GetGTID().String(), Equals, "0-101-6,1-2-3")
+
+	// a transaction of non-transactional engines in another domain
+	r.update(gtidEvent(1, 4, 0))
+	r.update(queryEvent("INSERT INTO db.tbl VALUES (1)"))
+	c.Assert(r.isTxnEnd(), IsFalse)
+	r.update(queryEvent("COMMIT"))
+	c.Assert(r.isTxnEnd(), IsTrue)
+	c.Assert(r.txnEndLocation.Position.Pos, Equals, logPos)
+	c.Assert(r.txnEndLocation.GetGTID().String(), Equals, "0-101-6,1-101-4")
+}
+
+func (s *testLocationSuite) TestTxnBoundaryHooks(c *C) {
+	var (
+		r       = &locationRecorder{}
+		begins  []int
+		endLocs []binlog.Location
+		idx     int
+	)
+	r.reset(s.loc)
+	r.OnTxnBegin(func() {
+		begins = append(begins, idx)
+	})
+	r.OnTxnEnd(func(loc binlog.Location) {
+		// the hooks are called without holding the lock
+		c.Assert(r.isTxnEnd(), IsTrue)
+		endLocs = append(endLocs, loc)
+	})
+
+	events := s.generateDMLEvents(c)
+	c.Assert(events[3].Header.EventType, Equals, replication.GTID_EVENT)
+	c.Assert(events[7].Header.EventType, Equals, replication.XID_EVENT)
+	for i, e := range events {
+		idx = i
+		r.update(e)
+		if i < 7 {
+			c.Assert(endLocs, HasLen, 0)
+		}
+	}
+	c.Assert(begins, DeepEquals, []int{3})
+	c.Assert(endLocs, HasLen, 1)
+	c.Assert(endLocs[0].Position.Pos, Equals, events[7].Header.LogPos)
+	c.Assert(endLocs[0].GetGTID().String(), Equals, s.currGSetStr)
+
+	// a DDL query event without GTID both begins and ends a transaction
+	header := &replication.EventHeader{ServerID: s.serverID}
+	ddl, err := event.GenQueryEvent(header, events[7].Header.LogPos, 0, 0, 0, nil, []byte("foo"), []byte("CREATE TABLE foo.bar (c INT)"))
+	c.Assert(err, IsNil)
+	idx = 8
+	r.update(ddl)
+	c.Assert(begins, DeepEquals, []int{3, 8})
+	c.Assert(endLocs, HasLen, 2)
+	c.Assert(endLocs[1].Position.Pos, Equals, ddl.Header.LogPos)
+
+	// an incomplete transaction before rotated doesn't end
+	idx = 9
+	r.update(events[3])
+	rotate, err := event.GenRotateEvent(header, events[3].Header.LogPos, []byte(s.nextBinlogFile), 4)
+	c.Assert(err, IsNil)
+	r.update(rotate)
+	c.Assert(begins, DeepEquals, []int{3, 8, 9})
+	c.Assert(endLocs, HasLen, 2)
+	c.Assert(r.isTxnEnd(), IsTrue)
+}
+
+func (s *testLocationSuite) TestXAUpdateLocations(c *C) {
+	var (
+		logPos = s.binlogPos
+		header = &replication.EventHeader{ServerID: s.serverID}
+	)
+	queryEvent := func(query string) *replication.BinlogEvent {
+		logPos += 60
+		return &replication.BinlogEvent{
+			Header: &replication.EventHeader{EventType: replication.QUERY_EVENT, LogPos: logPos},
+			Event:  &replication.QueryEvent{Query: []byte(query), GSet: s.currGSet.Origin()},
+		}
+	}
+
+	r := &locationRecorder{}
+	r.reset(s.loc)
+	r.update(queryEvent("XA START X'6162',X'',1"))
+	c.Assert(r.isTxnEnd(), IsFalse)
+	r.update(queryEvent("XA END X'6162',X'',1"))
+	c.Assert(r.isTxnEnd(), IsFalse)
+	c.Assert(r.txnEndLocation, DeepEquals, s.loc)
+
+	// the XA_PREPARE_LOG_EVENT ends the event group with the GTID set of XA START and XA END
+	prepare, err := event.GenXAPrepareEvent(header, logPos, false, 1, []byte("ab"), nil)
+	c.Assert(err, IsNil)
+	logPos = prepare.Header.LogPos
+	r.update(prepare)
+	c.Assert(r.isTxnEnd(), IsTrue)
+	c.Assert(r.inDML, IsFalse)
+	c.Assert(r.txnEndLocation.Position.Pos, Equals, logPos)
+	c.Assert(r.txnEndLocation.GetGTID().String(), Equals, s.currGSetStr)
+
+	// XA COMMIT is another event group
+	r.update(queryEvent("XA COMMIT X'6162',X'',1"))
+	c.Assert(r.isTxnEnd(), IsTrue)
+	c.Assert(r.txnEndLocation.Position.Pos, Equals, logPos)
+}
@@ -15,18 +15,21 @@ package syncer
 
 import (
 	"math"
+	"sort"
 	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tiflow/dm/dm/config"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/pingcap/tiflow/dm/syncer/metrics"
 )
 
 // causality provides a simple mechanism to improve the concurrency of SQLs execution under the premise of ensuring correctness.
 // causality groups sqls that maybe contain causal relationships, and syncer executes them linearly.
-// if some conflicts exist in more than one groups, causality generate a conflict job and reset.
+// if the keys of a job exist in more than one groups, causality merges the groups and generates a conflict job to wait
+// for the DML queues of the merged groups, the jobs in other queues are not blocked.
 // this mechanism meets quiescent consistency to ensure correctness.
 // causality relation is consisted of groups of keys separated by flush job, and such design helps removed flushed dml job keys.
 type causality struct {
@@ -36,6 +39,8 @@ type causality struct {
 	logger      log.Logger
 	sessCtx     sessionctx.Context
 	workerCount int
+	// orderForeignKeys adds the keys of the referenced parent rows, so the DMLs of parent and child rows are ordered.
+	orderForeignKeys bool
 
 	// for metrics
 	task   string
@@ -53,6 +58,8 @@ func causalityWrap(inCh chan *job, syncer *Syncer) chan *job {
 		outCh:       make(chan *job, syncer.cfg.QueueSize),
 		sessCtx:     syncer.sessCtx,
 		workerCount: syncer.cfg.WorkerCount,
+
+		orderForeignKeys: syncer.cfg.ForeignKeyPolicy == config.ForeignKeyPolicyOrdered,
 	}
 
 	go func() {
@@ -80,14 +87,16 @@ func (c *causality) run() {
 			continue
 		default:
 			keys := j.dml.identifyKeys(c.sessCtx)
+			if c.orderForeignKeys {
+				keys = append(keys, j.dml.foreignKeys(j.targetTable.Schema)...)
+			}
 
 			// detectConflict before add
 			if c.detectConflict(keys) {
-				c.logger.Debug("meet causality key, will generate a conflict job to flush all sqls", zap.Strings("keys", keys))
-				c.outCh <- newConflictJob(c.workerCount)
-				c.relation.clear()
+				j.dml.key = c.resolveConflict(keys)
+			} else {
+				j.dml.key = c.add(keys)
 			}
-			j.dml.key = c.add(keys)
 			c.logger.Debug("key for keys", zap.String("key", j.dml.key), zap.Strings("keys", keys))
 		}
 		metrics.ConflictDetectDurationHistogram.WithLabelValues(c.task, c.source).Observe(time.Since(startTime).Seconds())
@@ -125,6 +134,49 @@ func (c *causality) add(keys []string) string {
 	return selectedRelation
 }
 
+// resolveConflict merges the causality relations of the keys, which belong to more than one relations, into the
+// relation of the first key and returns it. if the DML queues of other relations differ from the merged one, a conflict
+// job is sent to wait for them, the queues unrelated to the conflict keep executing.
+func (c *causality) resolveConflict(keys []string) string {
+	var (
+		selectedRelation string
+		otherRelations   = make(map[string]struct{})
+	)
+	for _, key := range keys {
+		val, ok := c.relation.get(key)
+		if !ok {
+			continue
+		}
+		if selectedRelation == "" {
+			selectedRelation = val
+		} else if val != selectedRelation {
+			otherRelations[val] = struct{}{}
+		}
+	}
+
+	selectedQueue := dmlQueueID(selectedRelation, c.workerCount)
+	queueSet := make(map[int]struct{}, len(otherRelations))
+	for val := range otherRelations {
+		if queueID := dmlQueueID(val, c.workerCount); queueID != selectedQueue {
+			queueSet[queueID] = struct{}{}
+		}
+	}
+	if len(queueSet) > 0 {
+		queues := make([]int, 0, len(queueSet))
+		for queueID := range queueSet {
+			queues = append(queues, queueID)
+		}
+		sort.Ints(queues)
+		c.logger.Debug("meet causality key, will generate a conflict job to flush conflicting queues", zap.Strings("keys", keys), zap.Ints("queues", queues))
+		c.outCh <- newConflictJob(queues)
+	}
+
+	for _, key := range keys {
+		c.relation.set(key, selectedRelation)
+	}
+	return selectedRelation
+}
+
 // detectConflict detects whether there is a conflict.
 func (c *causality) detectConflict(keys []string) bool {
 	if len(keys) == 0 {
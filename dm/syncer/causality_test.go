@@ -15,6 +15,8 @@ package syncer
 
 import (
 	"math"
+	"math/rand"
+	"sort"
 	"time"
 
 	. "github.com/pingcap/check"
@@ -90,7 +92,8 @@ func (s *testSyncerSuite) TestCasuality(c *C) {
 	syncer := &Syncer{
 		cfg: &config.SubTaskConfig{
 			SyncerConfig: config.SyncerConfig{
-				QueueSize: 1024,
+				QueueSize:   1024,
+				WorkerCount: 5,
 			},
 			Name:     "task",
 			SourceID: "source",
@@ -127,6 +130,8 @@ func (s *testSyncerSuite) TestCasuality(c *C) {
 		},
 	}
 	results := []opType{insert, insert, update, del, conflict, insert}
+	// the conflicting causality groups should be executed in different queues to generate a conflict job
+	c.Assert(dmlQueueID("1.a.", 5), Not(Equals), dmlQueueID("2.a.", 5))
 	table := &filter.Table{Schema: "test", Name: "t1"}
 	location := binlog.NewLocation("")
 	ec := &eventContext{startLocation: &location, currentLocation: &location, lastLocation: &location}
@@ -161,7 +166,8 @@ func (s *testSyncerSuite) TestCasualityWithPrefixIndex(c *C) {
 	syncer := &Syncer{
 		cfg: &config.SubTaskConfig{
 			SyncerConfig: config.SyncerConfig{
-				QueueSize: 1024,
+				QueueSize:   1024,
+				WorkerCount: 5,
 			},
 			Name:     "task",
 			SourceID: "source",
@@ -199,6 +205,7 @@ func (s *testSyncerSuite) TestCasualityWithPrefixIndex(c *C) {
 	}
 	results := []opType{insert, insert, update, del, conflict, insert}
 	resultKeys := []string{"123.c1.", "234.c1.", "234.c1.", "123.c1.", "conflict", "234.c1."}
+	c.Assert(dmlQueueID("123.c1.", 5), Not(Equals), dmlQueueID("234.c1.", 5))
 	table := &filter.Table{Schema: "test", Name: "t1"}
 	location := binlog.NewLocation("")
 	ec := &eventContext{startLocation: &location, currentLocation: &location, lastLocation: &location}
@@ -221,6 +228,171 @@ func (s *testSyncerSuite) TestCasualityWithPrefixIndex(c *C) {
 	}
 }
 
+func (s *testSyncerSuite) TestCausalityOrdering(c *C) {
+	p := parser.New()
+	se := mock.NewContext()
+	ti, err := createTableInfo(p, se, int64(0), "create table tb(a int primary key, b int unique, c int)")
+	c.Assert(err, IsNil)
+	downTi := schema.GetDownStreamTI(ti, ti)
+	table := &filter.Table{Schema: "test", Name: "tb"}
+	location := binlog.NewLocation("")
+	ec := &eventContext{startLocation: &location, currentLocation: &location, lastLocation: &location}
+	sessCtx := utils.NewSessionCtx(map[string]string{"time_zone": "UTC"})
+
+	const (
+		workerCount = 4
+		jobCount    = 2000
+		keyRange    = 20
+	)
+	seed := time.Now().UnixNano()
+	rnd := rand.New(rand.NewSource(seed))
+	comment := Commentf("seed %d", seed)
+
+	jobCh := make(chan *job, jobCount)
+	syncer := &Syncer{
+		cfg: &config.SubTaskConfig{
+			SyncerConfig: config.SyncerConfig{
+				QueueSize:   jobCount * 2,
+				WorkerCount: workerCount,
+			},
+			Name:     "task",
+			SourceID: "source",
+		},
+		tctx:    tcontext.Background().WithLogger(log.L()),
+		sessCtx: sessCtx,
+	}
+	causalityCh := causalityWrap(jobCh, syncer)
+
+	// generate random DMLs on a small range of keys, so the rows conflict on PK and UK frequently.
+	seqOfJob := make(map[*job]int, jobCount)
+	for i := 0; i < jobCount; i++ {
+		var (
+			op      = opType(rnd.Intn(3) + int(insert))
+			vals    = []interface{}{rnd.Intn(keyRange), rnd.Intn(keyRange), i}
+			oldVals []interface{}
+		)
+		if op == update {
+			oldVals = []interface{}{rnd.Intn(keyRange), rnd.Intn(keyRange), i}
+		}
+		j := newDMLJob(op, table, table, newDML(op, false, "`test`.`tb`", table, oldVals, vals, oldVals, vals, ti.Columns, ti, downTi.AbsoluteUKIndexInfo, downTi), ec)
+		seqOfJob[j] = i
+		jobCh <- j
+	}
+	close(jobCh)
+
+	// simulate DMLWorker, the queues execute their jobs in order but interleave with each other randomly,
+	// and a conflict job waits for all jobs in its queues to be executed.
+	var (
+		queues       = make([][]*job, workerCount)
+		executedSeqs = make(map[string][]int)
+		conflictCnt  int
+	)
+	execute := func(queueID int) {
+		j := queues[queueID][0]
+		queues[queueID] = queues[queueID][1:]
+		for _, key := range j.dml.identifyKeys(sessCtx) {
+			executedSeqs[key] = append(executedSeqs[key], seqOfJob[j])
+		}
+	}
+	for j := range causalityCh {
+		if j.tp == conflict {
+			conflictCnt++
+			c.Assert(j.conflictQueues, Not(HasLen), 0, comment)
+			for _, queueID := range j.conflictQueues {
+				for len(queues[queueID]) > 0 {
+					execute(queueID)
+				}
+			}
+			continue
+		}
+		queueID := dmlQueueID(j.dml.key, workerCount)
+		queues[queueID] = append(queues[queueID], j)
+		for k := rnd.Intn(3); k > 0; k-- {
+			if queueID = rnd.Intn(workerCount); len(queues[queueID]) > 0 {
+				execute(queueID)
+			}
+		}
+	}
+	for queueID := range queues {
+		for len(queues[queueID]) > 0 {
+			execute(queueID)
+		}
+	}
+
+	c.Assert(conflictCnt > 0, IsTrue, comment)
+	c.Assert(executedSeqs, Not(HasLen), 0, comment)
+	for key, seqs := range executedSeqs {
+		c.Assert(sort.IntsAreSorted(seqs), IsTrue, Commentf("seed %d, key %s, seqs %v", seed, key, seqs))
+	}
+}
+
+func (s *testSyncerSuite) TestCausalityForeignKey(c *C) {
+	p := parser.New()
+	se := mock.NewContext()
+	parentTi, err := createTableInfo(p, se, int64(0), "create table p(id int primary key)")
+	c.Assert(err, IsNil)
+	childTi, err := createTableInfo(p, se, int64(1), "create table c(id int primary key, pid int, foreign key (pid) references p(ID))")
+	c.Assert(err, IsNil)
+	parentDownTi := schema.GetDownStreamTI(parentTi, parentTi)
+	childDownTi := schema.GetDownStreamTI(childTi, childTi)
+	c.Assert(childDownTi.ForeignKeys, HasLen, 1)
+	c.Assert(childDownTi.ForeignKeys[0].Offsets, DeepEquals, []int{1})
+
+	parent := &filter.Table{Schema: "test", Name: "p"}
+	child := &filter.Table{Schema: "test", Name: "c"}
+	location := binlog.NewLocation("")
+	ec := &eventContext{startLocation: &location, currentLocation: &location, lastLocation: &location}
+	testCases := []struct {
+		op    opType
+		table *filter.Table
+		vals  []interface{}
+	}{
+		{insert, parent, []interface{}{1}},
+		{insert, child, []interface{}{10, 1}},
+		{insert, child, []interface{}{11, nil}},
+		{del, child, []interface{}{10, 1}},
+		{del, parent, []interface{}{1}},
+	}
+
+	for _, policy := range []config.ForeignKeyPolicy{config.ForeignKeyPolicyNone, config.ForeignKeyPolicyOrdered} {
+		jobCh := make(chan *job, 10)
+		syncer := &Syncer{
+			cfg: &config.SubTaskConfig{
+				SyncerConfig: config.SyncerConfig{
+					QueueSize:        1024,
+					WorkerCount:      5,
+					ForeignKeyPolicy: policy,
+				},
+				Name:     "task",
+				SourceID: "source",
+			},
+			tctx:    tcontext.Background().WithLogger(log.L()),
+			sessCtx: utils.NewSessionCtx(map[string]string{"time_zone": "UTC"}),
+		}
+		causalityCh := causalityWrap(jobCh, syncer)
+		for _, tc := range testCases {
+			ti, downTi := parentTi, parentDownTi
+			if tc.table == child {
+				ti, downTi = childTi, childDownTi
+			}
+			jobCh <- newDMLJob(tc.op, tc.table, tc.table, newDML(tc.op, false, utils.GenTableID(tc.table), tc.table, nil, tc.vals, nil, tc.vals, ti.Columns, ti, downTi.AbsoluteUKIndexInfo, downTi), ec)
+		}
+		close(jobCh)
+
+		keys := make([]string, 0, len(testCases))
+		for j := range causalityCh {
+			c.Assert(j.tp, Not(Equals), conflict)
+			keys = append(keys, j.dml.key)
+		}
+		c.Assert(keys, HasLen, len(testCases))
+		if policy == config.ForeignKeyPolicyOrdered {
+			c.Assert(keys, DeepEquals, []string{"1.id.`test`.`p`", "1.id.`test`.`p`", "11.id.`test`.`c`", "1.id.`test`.`p`", "1.id.`test`.`p`"})
+		} else {
+			c.Assert(keys, DeepEquals, []string{"1.id.`test`.`p`", "10.id.`test`.`c`", "11.id.`test`.`c`", "10.id.`test`.`c`", "1.id.`test`.`p`"})
+		}
+	}
+}
+
 func (s *testSyncerSuite) TestCasualityRelation(c *C) {
 	rm := newCausalityRelation()
 	c.Assert(rm.len(), Equals, 0)
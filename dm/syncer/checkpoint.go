@@ -19,8 +19,6 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
-	"os"
-	"path"
 	"sync"
 	"time"
 
@@ -35,13 +33,13 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/gtid"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/pingcap/tiflow/dm/pkg/schema"
+	"github.com/pingcap/tiflow/dm/pkg/storage"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/dm/syncer/dbconn"
 
 	"github.com/go-mysql-org/go-mysql/mysql"
 	"github.com/pingcap/failpoint"
-	"github.com/pingcap/tidb-tools/pkg/dbutil"
 	"github.com/pingcap/tidb-tools/pkg/filter"
 	"github.com/pingcap/tidb/parser/model"
 	tmysql "github.com/pingcap/tidb/parser/mysql"
@@ -79,10 +77,10 @@ type binlogPoint struct {
 
 	savedPoint   tablePoint
 	flushedPoint tablePoint // point which flushed persistently
-	enableGTID   bool
+	locationCmp  *binlog.LocationComparator
 }
 
-func newBinlogPoint(location, flushedLocation binlog.Location, ti, flushedTI *model.TableInfo, enableGTID bool) *binlogPoint {
+func newBinlogPoint(location, flushedLocation binlog.Location, ti, flushedTI *model.TableInfo, locationCmp *binlog.LocationComparator) *binlogPoint {
 	return &binlogPoint{
 		savedPoint: tablePoint{
 			location: location,
@@ -92,7 +90,7 @@ func newBinlogPoint(location, flushedLocation binlog.Location, ti, flushedTI *mo
 			location: flushedLocation,
 			ti:       flushedTI,
 		},
-		enableGTID: enableGTID,
+		locationCmp: locationCmp,
 	}
 }
 
@@ -100,7 +98,7 @@ func (b *binlogPoint) save(location binlog.Location, ti *model.TableInfo) error
 	b.Lock()
 	defer b.Unlock()
 
-	if binlog.CompareLocation(location, b.savedPoint.location, b.enableGTID) < 0 {
+	if b.locationCmp.Compare(location, b.savedPoint.location) < 0 {
 		// support to save equal location, but not older location
 		return terror.ErrCheckpointSaveInvalidPos.Generate(location, b.savedPoint.location)
 	}
@@ -155,7 +153,7 @@ func (b *binlogPoint) outOfDateBy(pos binlog.Location) bool {
 	b.RLock()
 	defer b.RUnlock()
 
-	return binlog.CompareLocation(pos, b.flushedPoint.location, b.enableGTID) > 0
+	return b.locationCmp.Compare(pos, b.flushedPoint.location) > 0
 }
 
 // MySQLLocation returns point as binlog.Location.
@@ -237,6 +235,31 @@ type CheckPoint interface {
 	// corresponding to Meta.Save
 	SaveGlobalPoint(point binlog.Location)
 
+	// SaveDDLHistory saves the DDLs rewritten before applied to the downstream in memory, they're flushed along with
+	// the next checkpoint snapshot. appliedDDLs[i] is the rewritten originDDLs[i], empty if the DDL is skipped.
+	SaveDDLHistory(location binlog.Location, originDDLs, appliedDDLs []string)
+
+	// GTIDSkipList returns the GTID set of the transactions to skip, nil if no transaction is skipped
+	GTIDSkipList() gtid.Set
+
+	// SaveGTIDSkipList saves the GTID set of the transactions to skip, it's flushed to the downstream immediately
+	SaveGTIDSkipList(tctx *tcontext.Context, skipList gtid.Set) error
+
+	// RulesHistory returns the versions of the hot reloaded rules sorted by version, nil if the rules are never hot reloaded
+	RulesHistory() []*rulesRecord
+
+	// SaveRules records the rules of the version which take effect from the location, it's flushed to the downstream immediately
+	SaveRules(tctx *tcontext.Context, version int64, location binlog.Location, rules string) error
+
+	// PausedTables returns the tables paused with buffer policy and the locations from which they're paused
+	PausedTables() []*pausedTableRecord
+
+	// SavePausedTable records the location from which the table is paused with buffer policy, it's flushed to the downstream immediately
+	SavePausedTable(tctx *tcontext.Context, table *filter.Table, location binlog.Location) error
+
+	// DeletePausedTable deletes the record of the resumed table, it's flushed to the downstream immediately
+	DeletePausedTable(tctx *tcontext.Context, table *filter.Table) error
+
 	// Snapshot make a snapshot of current checkpoint
 	Snapshot(isSyncFlush bool) *SnapshotInfo
 
@@ -301,6 +324,27 @@ type remoteCheckpointSnapshot struct {
 	globalPoint         *tablePoint
 	globalPointSaveTime time.Time
 	points              map[string]map[string]tablePoint
+	ddlHistory          []*ddlHistoryRecord
+}
+
+// ddlHistoryRecord is a DDL rewritten before applied to the downstream.
+type ddlHistoryRecord struct {
+	location   binlog.Location
+	originDDL  string
+	appliedDDL string
+}
+
+// rulesRecord is a version of the hot reloaded rules, which take effect from the location.
+type rulesRecord struct {
+	version  int64
+	location binlog.Location
+	rules    string // the rules in JSON
+}
+
+// pausedTableRecord is a table paused with buffer policy, its changes from the location are held back.
+type pausedTableRecord struct {
+	table    *filter.Table
+	location binlog.Location
 }
 
 // RemoteCheckPoint implements CheckPoint
@@ -310,13 +354,39 @@ type remoteCheckpointSnapshot struct {
 type RemoteCheckPoint struct {
 	sync.RWMutex
 
-	cfg *config.SubTaskConfig
+	cfg         *config.SubTaskConfig
+	locationCmp *binlog.LocationComparator
 
 	db        *conn.BaseDB
 	dbConn    *dbconn.DBConn
+	dialect   sqlDialect
 	tableName string // qualified table name: schema is set through task config, table is task name
 	id        string // checkpoint ID, now it is `source-id`
 
+	// qualified name of the DDL history table, empty if the DDLs are not rewritten
+	ddlHistoryTable string
+	// the rewritten DDLs which are not taken by snapshots yet
+	ddlHistory []*ddlHistoryRecord
+
+	// qualified name of the GTID skip list table, empty if GTID is not enabled
+	gtidSkipListTable string
+	// the GTID set of the transactions to skip, it's kept until it's changed by SaveGTIDSkipList
+	gtidSkipList gtid.Set
+
+	// qualified name of the rules table, it's created when the rules are hot reloaded for the first time
+	rulesTable string
+	// the versions of the hot reloaded rules sorted by version
+	rulesHistory []*rulesRecord
+
+	// qualified name of the paused tables table, it's created when a table is paused with buffer policy for the first time
+	pausedTablesTable string
+	// the tables paused with buffer policy
+	pausedTables []*pausedTableRecord
+
+	// qualified name of the schema snapshot table, empty if schema-snapshot-interval is not set
+	schemaSnapshotTable    string
+	lastSchemaSnapshotTime time.Time
+
 	// source-schema -> source-table -> checkpoint
 	// used to filter the synced binlog when re-syncing for sharding group
 	points map[string]map[string]*binlogPoint
@@ -348,15 +418,30 @@ type RemoteCheckPoint struct {
 
 // NewRemoteCheckPoint creates a new RemoteCheckPoint.
 func NewRemoteCheckPoint(tctx *tcontext.Context, cfg *config.SubTaskConfig, id string) CheckPoint {
+	locationCmp := newLocationComparator(cfg)
+	dialect := newSQLDialect(&cfg.To)
 	cp := &RemoteCheckPoint{
-		cfg:         cfg,
-		tableName:   dbutil.TableName(cfg.MetaSchema, cputil.SyncerCheckpoint(cfg.Name)),
-		id:          id,
-		points:      make(map[string]map[string]*binlogPoint),
-		globalPoint: newBinlogPoint(binlog.NewLocation(cfg.Flavor), binlog.NewLocation(cfg.Flavor), nil, nil, cfg.EnableGTID),
-		logCtx:      tcontext.Background().WithLogger(tctx.L().WithFields(zap.String("component", "remote checkpoint"))),
-		snapshots:   make([]*remoteCheckpointSnapshot, 0),
-		snapshotSeq: 0,
+		cfg:               cfg,
+		locationCmp:       locationCmp,
+		dialect:           dialect,
+		tableName:         dialect.tableName(cfg.MetaSchema, cputil.SyncerCheckpoint(cfg.Name)),
+		rulesTable:        dialect.tableName(cfg.MetaSchema, cputil.SyncerRules(cfg.Name)),
+		pausedTablesTable: dialect.tableName(cfg.MetaSchema, cputil.SyncerPausedTables(cfg.Name)),
+		id:                id,
+		points:            make(map[string]map[string]*binlogPoint),
+		globalPoint:       newBinlogPoint(binlog.NewLocation(cfg.Flavor), binlog.NewLocation(cfg.Flavor), nil, nil, locationCmp),
+		logCtx:            tcontext.Background().WithLogger(tctx.L().WithFields(zap.String("component", "remote checkpoint"))),
+		snapshots:         make([]*remoteCheckpointSnapshot, 0),
+		snapshotSeq:       0,
+	}
+	if cfg.EnableDDLRewrite() {
+		cp.ddlHistoryTable = dialect.tableName(cfg.MetaSchema, cputil.SyncerDDLHistory(cfg.Name))
+	}
+	if cfg.EnableGTID {
+		cp.gtidSkipListTable = dialect.tableName(cfg.MetaSchema, cputil.SyncerGTIDSkipList(cfg.Name))
+	}
+	if cfg.SchemaSnapshotInterval > 0 {
+		cp.schemaSnapshotTable = dialect.tableName(cfg.MetaSchema, cputil.SyncerSchemaSnapshot(cfg.Name))
 	}
 
 	return cp
@@ -387,14 +472,16 @@ func (cp *RemoteCheckPoint) Snapshot(isSyncFlush bool) *SnapshotInfo {
 	flushGlobalPoint := cp.globalPoint.outOfDate() || cp.globalPointSaveTime.IsZero() || (isSyncFlush && cp.needFlushSafeModeExitPoint.Load())
 
 	// if there is no change on both table points and global point, just return an empty snapshot
-	if len(tableCheckPoints) == 0 && !flushGlobalPoint {
+	if len(tableCheckPoints) == 0 && !flushGlobalPoint && len(cp.ddlHistory) == 0 {
 		return nil
 	}
 
 	snapshot := &remoteCheckpointSnapshot{
-		id:     id,
-		points: tableCheckPoints,
+		id:         id,
+		points:     tableCheckPoints,
+		ddlHistory: cp.ddlHistory,
 	}
+	cp.ddlHistory = nil
 
 	globalPoint := &tablePoint{
 		location: cp.globalPoint.savedPoint.location.Clone(),
@@ -459,21 +546,35 @@ func (cp *RemoteCheckPoint) Clear(tctx *tcontext.Context) error {
 	// use a new context apart from syncer, to make sure when syncer call `cancel` checkpoint could update
 	tctx2, cancel := tctx.WithContext(context.Background()).WithTimeout(maxDMLConnectionDuration)
 	defer cancel()
-	_, err := cp.dbConn.ExecuteSQL(
-		tctx2,
-		[]string{`DELETE FROM ` + cp.tableName + ` WHERE id = ?`},
-		[]interface{}{cp.id},
-	)
+	sqls := []string{cp.dialect.rebind(`DELETE FROM ` + cp.tableName + ` WHERE id = ?`)}
+	args := [][]interface{}{{cp.id}}
+	if cp.schemaSnapshotTable != "" {
+		sqls = append(sqls, cp.dialect.rebind(`DELETE FROM `+cp.schemaSnapshotTable+` WHERE id = ?`))
+		args = append(args, []interface{}{cp.id})
+	}
+	if len(cp.rulesHistory) > 0 {
+		sqls = append(sqls, cp.dialect.rebind(`DELETE FROM `+cp.rulesTable+` WHERE id = ?`))
+		args = append(args, []interface{}{cp.id})
+	}
+	if len(cp.pausedTables) > 0 {
+		sqls = append(sqls, cp.dialect.rebind(`DELETE FROM `+cp.pausedTablesTable+` WHERE id = ?`))
+		args = append(args, []interface{}{cp.id})
+	}
+	_, err := cp.dbConn.ExecuteSQL(tctx2, sqls, args...)
 	if err != nil {
 		return err
 	}
 
-	cp.globalPoint = newBinlogPoint(binlog.NewLocation(cp.cfg.Flavor), binlog.NewLocation(cp.cfg.Flavor), nil, nil, cp.cfg.EnableGTID)
+	cp.globalPoint = newBinlogPoint(binlog.NewLocation(cp.cfg.Flavor), binlog.NewLocation(cp.cfg.Flavor), nil, nil, cp.locationCmp)
 	cp.globalPointSaveTime = time.Time{}
 	cp.lastSnapshotCreationTime = time.Time{}
 	cp.points = make(map[string]map[string]*binlogPoint)
 	cp.snapshots = make([]*remoteCheckpointSnapshot, 0)
 	cp.safeModeExitPoint = nil
+	cp.ddlHistory = nil
+	cp.lastSchemaSnapshotTime = time.Time{}
+	cp.rulesHistory = nil
+	cp.pausedTables = nil
 
 	return nil
 }
@@ -487,7 +588,7 @@ func (cp *RemoteCheckPoint) SaveTablePoint(table *filter.Table, point binlog.Loc
 
 // saveTablePoint saves single table's checkpoint without mutex.Lock.
 func (cp *RemoteCheckPoint) saveTablePoint(sourceTable *filter.Table, location binlog.Location, ti *model.TableInfo) {
-	if binlog.CompareLocation(cp.globalPoint.savedPoint.location, location, cp.cfg.EnableGTID) > 0 {
+	if cp.locationCmp.Compare(cp.globalPoint.savedPoint.location, location) > 0 {
 		panic(fmt.Sprintf("table checkpoint %+v less than global checkpoint %+v", location, cp.globalPoint))
 	}
 
@@ -500,7 +601,7 @@ func (cp *RemoteCheckPoint) saveTablePoint(sourceTable *filter.Table, location b
 	}
 	point, ok := mSchema[sourceTable.Name]
 	if !ok {
-		mSchema[sourceTable.Name] = newBinlogPoint(location, binlog.NewLocation(cp.cfg.Flavor), ti, nil, cp.cfg.EnableGTID)
+		mSchema[sourceTable.Name] = newBinlogPoint(location, binlog.NewLocation(cp.cfg.Flavor), ti, nil, cp.locationCmp)
 	} else if err := point.save(location, ti); err != nil {
 		cp.logCtx.L().Error("fail to save table point", zap.Stringer("table", sourceTable), log.ShortError(err))
 	}
@@ -510,7 +611,7 @@ func (cp *RemoteCheckPoint) saveTablePoint(sourceTable *filter.Table, location b
 // shouldn't call concurrently (only called before loop in Syncer.Run and in loop to reset).
 func (cp *RemoteCheckPoint) SaveSafeModeExitPoint(point *binlog.Location) {
 	if cp.safeModeExitPoint == nil || point == nil ||
-		binlog.CompareLocation(*point, *cp.safeModeExitPoint, cp.cfg.EnableGTID) > 0 {
+		cp.locationCmp.Compare(*point, *cp.safeModeExitPoint) > 0 {
 		cp.safeModeExitPoint = point
 		cp.needFlushSafeModeExitPoint.Store(true)
 	}
@@ -541,7 +642,7 @@ func (cp *RemoteCheckPoint) DeleteTablePoint(tctx *tcontext.Context, table *filt
 	cp.logCtx.L().Info("delete table checkpoint", zap.String("schema", sourceSchema), zap.String("table", sourceTable))
 	_, err := cp.dbConn.ExecuteSQL(
 		tctx2,
-		[]string{`DELETE FROM ` + cp.tableName + ` WHERE id = ? AND cp_schema = ? AND cp_table = ?`},
+		[]string{cp.dialect.rebind(`DELETE FROM ` + cp.tableName + ` WHERE id = ? AND cp_schema = ? AND cp_table = ?`)},
 		[]interface{}{cp.id, sourceSchema, sourceTable},
 	)
 	if err != nil {
@@ -566,7 +667,7 @@ func (cp *RemoteCheckPoint) DeleteSchemaPoint(tctx *tcontext.Context, sourceSche
 	cp.logCtx.L().Info("delete schema checkpoint", zap.String("schema", sourceSchema))
 	_, err := cp.dbConn.ExecuteSQL(
 		tctx2,
-		[]string{`DELETE FROM ` + cp.tableName + ` WHERE id = ? AND cp_schema = ?`},
+		[]string{cp.dialect.rebind(`DELETE FROM ` + cp.tableName + ` WHERE id = ? AND cp_schema = ?`)},
 		[]interface{}{cp.id, sourceSchema},
 	)
 	if err != nil {
@@ -597,10 +698,28 @@ func (cp *RemoteCheckPoint) IsOlderThanTablePoint(table *filter.Table, location
 	oldLocation := point.MySQLLocation()
 	cp.logCtx.L().Debug("compare table location whether is newer", zap.Stringer("location", location), zap.Stringer("old location", oldLocation))
 
+	if cp.cfg.EnableGTID {
+		if older, ok := isOlderByTableGTID(location, oldLocation); ok {
+			return older
+		}
+	}
 	if isDDL || !cp.cfg.EnableGTID {
-		return binlog.CompareLocation(location, oldLocation, cp.cfg.EnableGTID) <= 0
+		return cp.locationCmp.Compare(location, oldLocation) <= 0
 	}
-	return binlog.CompareLocation(location, oldLocation, cp.cfg.EnableGTID) < 0
+	return cp.locationCmp.Compare(location, oldLocation) < 0
+}
+
+// isOlderByTableGTID checks whether the event at `location` is replicated for a table by the GTID set recorded in its
+// checkpoint `tableLocation`. the event is replicated if its GTID set is strictly contained in the one of the table,
+// no matter how the binlog positions are ordered, so the check still works after the upstream fails over to another
+// server. it returns false if the result can't be decided by GTID sets, like one of them is empty or they are equal,
+// which means the event belongs to the transaction of the table checkpoint.
+func isOlderByTableGTID(location, tableLocation binlog.Location) (older bool, ok bool) {
+	gSet, tableGSet := location.GetGTID(), tableLocation.GetGTID()
+	if gSet == nil || tableGSet == nil || gSet.String() == "" || tableGSet.String() == "" || gSet.Equal(tableGSet) {
+		return false, false
+	}
+	return tableGSet.Contain(gSet), true
 }
 
 // SaveGlobalPoint implements CheckPoint.SaveGlobalPoint.
@@ -614,6 +733,177 @@ func (cp *RemoteCheckPoint) SaveGlobalPoint(location binlog.Location) {
 	}
 }
 
+// SaveDDLHistory implements CheckPoint.SaveDDLHistory.
+func (cp *RemoteCheckPoint) SaveDDLHistory(location binlog.Location, originDDLs, appliedDDLs []string) {
+	if cp.ddlHistoryTable == "" {
+		return
+	}
+	cp.Lock()
+	defer cp.Unlock()
+	for i := range originDDLs {
+		if originDDLs[i] != appliedDDLs[i] {
+			cp.ddlHistory = append(cp.ddlHistory, &ddlHistoryRecord{
+				location:   location.Clone(),
+				originDDL:  originDDLs[i],
+				appliedDDL: appliedDDLs[i],
+			})
+		}
+	}
+}
+
+// GTIDSkipList implements CheckPoint.GTIDSkipList.
+func (cp *RemoteCheckPoint) GTIDSkipList() gtid.Set {
+	cp.RLock()
+	defer cp.RUnlock()
+	return cp.gtidSkipList
+}
+
+// SaveGTIDSkipList implements CheckPoint.SaveGTIDSkipList.
+func (cp *RemoteCheckPoint) SaveGTIDSkipList(tctx *tcontext.Context, skipList gtid.Set) error {
+	if cp.gtidSkipListTable == "" {
+		return terror.ErrSyncerGTIDSkipListNotSupported.Generate(cp.cfg.EnableGTID, cp.cfg.Flavor)
+	}
+	var skipListStr string
+	if skipList != nil {
+		skipListStr = skipList.String()
+	}
+	if skipListStr == "" {
+		skipList = nil
+	}
+
+	cp.Lock()
+	defer cp.Unlock()
+
+	sqls := []string{cp.dialect.rebind(`DELETE FROM ` + cp.gtidSkipListTable + ` WHERE id = ?`)}
+	args := [][]interface{}{{cp.id}}
+	if skipList != nil {
+		sqls = append(sqls, cp.dialect.rebind(`INSERT INTO `+cp.gtidSkipListTable+` (id, gtid_set) VALUES (?, ?)`))
+		args = append(args, []interface{}{cp.id, skipListStr})
+	}
+	if err := cp.execInSeparateConn(tctx, sqls, args); err != nil {
+		return err
+	}
+
+	cp.gtidSkipList = skipList
+	cp.logCtx.L().Info("save GTID skip list", zap.String("GTID skip list", skipListStr))
+	return nil
+}
+
+// RulesHistory implements CheckPoint.RulesHistory.
+func (cp *RemoteCheckPoint) RulesHistory() []*rulesRecord {
+	cp.RLock()
+	defer cp.RUnlock()
+	return cp.rulesHistory
+}
+
+// SaveRules implements CheckPoint.SaveRules.
+func (cp *RemoteCheckPoint) SaveRules(tctx *tcontext.Context, version int64, location binlog.Location, rules string) error {
+	cp.Lock()
+	defer cp.Unlock()
+
+	sqls := []string{
+		cp.dialect.rulesTableSQL(cp.rulesTable),
+		cp.dialect.rebind(`DELETE FROM ` + cp.rulesTable + ` WHERE id = ? AND version = ?`),
+		cp.dialect.rebind(`INSERT INTO ` + cp.rulesTable + ` (id, version, binlog_name, binlog_pos, binlog_gtid, rules) VALUES (?, ?, ?, ?, ?, ?)`),
+	}
+	args := [][]interface{}{
+		nil,
+		{cp.id, version},
+		{cp.id, version, location.Position.Name, location.Position.Pos, location.GTIDSetStr(), rules},
+	}
+	if err := cp.execInSeparateConn(tctx, sqls, args); err != nil {
+		return err
+	}
+
+	// the versions are saved in order, the same or newer versions saved before are replaced by this one
+	history := make([]*rulesRecord, 0, len(cp.rulesHistory)+1)
+	for _, record := range cp.rulesHistory {
+		if record.version < version {
+			history = append(history, record)
+		}
+	}
+	cp.rulesHistory = append(history, &rulesRecord{version: version, location: location.Clone(), rules: rules})
+	cp.logCtx.L().Info("save rules", zap.Int64("version", version), zap.Stringer("location", location))
+	return nil
+}
+
+// PausedTables implements CheckPoint.PausedTables.
+func (cp *RemoteCheckPoint) PausedTables() []*pausedTableRecord {
+	cp.RLock()
+	defer cp.RUnlock()
+	return cp.pausedTables
+}
+
+// SavePausedTable implements CheckPoint.SavePausedTable.
+func (cp *RemoteCheckPoint) SavePausedTable(tctx *tcontext.Context, table *filter.Table, location binlog.Location) error {
+	cp.Lock()
+	defer cp.Unlock()
+
+	sqls := []string{
+		cp.dialect.pausedTablesTableSQL(cp.pausedTablesTable),
+		cp.dialect.rebind(`DELETE FROM ` + cp.pausedTablesTable + ` WHERE id = ? AND table_schema = ? AND table_name = ?`),
+		cp.dialect.rebind(`INSERT INTO ` + cp.pausedTablesTable + ` (id, table_schema, table_name, binlog_name, binlog_pos, binlog_gtid) VALUES (?, ?, ?, ?, ?, ?)`),
+	}
+	args := [][]interface{}{
+		nil,
+		{cp.id, table.Schema, table.Name},
+		{cp.id, table.Schema, table.Name, location.Position.Name, location.Position.Pos, location.GTIDSetStr()},
+	}
+	if err := cp.execInSeparateConn(tctx, sqls, args); err != nil {
+		return err
+	}
+
+	records := make([]*pausedTableRecord, 0, len(cp.pausedTables)+1)
+	for _, record := range cp.pausedTables {
+		if record.table.String() != table.String() {
+			records = append(records, record)
+		}
+	}
+	cp.pausedTables = append(records, &pausedTableRecord{table: table.Clone(), location: location.Clone()})
+	cp.logCtx.L().Info("save paused table", zap.Stringer("table", table), zap.Stringer("location", location))
+	return nil
+}
+
+// DeletePausedTable implements CheckPoint.DeletePausedTable.
+func (cp *RemoteCheckPoint) DeletePausedTable(tctx *tcontext.Context, table *filter.Table) error {
+	cp.Lock()
+	defer cp.Unlock()
+
+	sqls := []string{cp.dialect.rebind(`DELETE FROM ` + cp.pausedTablesTable + ` WHERE id = ? AND table_schema = ? AND table_name = ?`)}
+	args := [][]interface{}{{cp.id, table.Schema, table.Name}}
+	if err := cp.execInSeparateConn(tctx, sqls, args); err != nil {
+		return err
+	}
+
+	records := make([]*pausedTableRecord, 0, len(cp.pausedTables))
+	for _, record := range cp.pausedTables {
+		if record.table.String() != table.String() {
+			records = append(records, record)
+		}
+	}
+	cp.pausedTables = records
+	cp.logCtx.L().Info("delete paused table", zap.Stringer("table", table))
+	return nil
+}
+
+// execInSeparateConn executes the SQLs in a transaction with a separate connection, because the checkpoints may be
+// flushed by cp.dbConn concurrently.
+func (cp *RemoteCheckPoint) execInSeparateConn(tctx *tcontext.Context, sqls []string, args [][]interface{}) error {
+	tctx2, cancel := tctx.WithContext(context.Background()).WithTimeout(maxDMLConnectionDuration)
+	defer cancel()
+	baseConn, err := cp.db.GetBaseConn(tctx2.Context())
+	if err != nil {
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+	defer func() {
+		_ = cp.db.CloseBaseConn(baseConn)
+	}()
+	if _, err = baseConn.ExecuteSQL(tctx2, nil, cp.cfg.Name, sqls, args...); err != nil {
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+	return nil
+}
+
 // FlushPointsExcept implements CheckPoint.FlushSnapshotPointsExcept.
 func (cp *RemoteCheckPoint) FlushPointsExcept(
 	tctx *tcontext.Context,
@@ -658,6 +948,7 @@ func (cp *RemoteCheckPoint) FlushPointsExcept(
 	}
 
 	points := make([]*tableCpSnapshotTuple, 0, 100)
+	flushedTables := make(map[string]map[string]tablePoint)
 
 	for schema, mSchema := range snapshotCp.points {
 		schemaCp := cp.points[schema]
@@ -682,14 +973,43 @@ func (cp *RemoteCheckPoint) FlushPointsExcept(
 					tableCp:         tableCP,
 					snapshotTableCP: point,
 				})
+				if _, ok := flushedTables[schema]; !ok {
+					flushedTables[schema] = make(map[string]tablePoint)
+				}
+				flushedTables[schema][table] = point
 			}
 		}
 	}
+	for _, record := range snapshotCp.ddlHistory {
+		sqls = append(sqls, cp.dialect.rebind(`INSERT INTO `+cp.ddlHistoryTable+
+			` (id, binlog_name, binlog_pos, binlog_gtid, origin_ddl, applied_ddl) VALUES (?, ?, ?, ?, ?, ?)`))
+		args = append(args, []interface{}{
+			cp.id, record.location.Position.Name, record.location.Position.Pos, record.location.GTIDSetStr(),
+			record.originDDL, record.appliedDDL,
+		})
+	}
 	for i := range extraSQLs {
 		sqls = append(sqls, extraSQLs[i])
 		args = append(args, extraArgs[i])
 	}
 
+	// save the schema snapshot in the same transaction, so it's consistent with the table checkpoints.
+	snapshotSaved := false
+	if cp.schemaSnapshotTable != "" && time.Since(cp.lastSchemaSnapshotTime) >= time.Duration(cp.cfg.SchemaSnapshotInterval)*time.Second {
+		location := cp.globalPoint.FlushedMySQLLocation()
+		if snapshotCp.globalPoint != nil {
+			location = snapshotCp.globalPoint.location
+		}
+		snapshotSQLs, snapshotArgs, err := cp.genSchemaSnapshotSQLs(location, flushedTables)
+		if err != nil {
+			cp.Unlock()
+			return err
+		}
+		sqls = append(sqls, snapshotSQLs...)
+		args = append(args, snapshotArgs...)
+		snapshotSaved = true
+	}
+
 	cp.Unlock()
 
 	// use a new context apart from syncer, to make sure when syncer call `cancel` checkpoint could update
@@ -710,6 +1030,11 @@ func (cp *RemoteCheckPoint) FlushPointsExcept(
 	for _, point := range points {
 		point.tableCp.flushBy(point.snapshotTableCP)
 	}
+	if snapshotSaved {
+		cp.Lock()
+		cp.lastSchemaSnapshotTime = time.Now()
+		cp.Unlock()
+	}
 	cp.needFlushSafeModeExitPoint.Store(false)
 	return nil
 }
@@ -924,7 +1249,7 @@ func (cp *RemoteCheckPoint) prepare(tctx *tcontext.Context) error {
 
 func (cp *RemoteCheckPoint) createSchema(tctx *tcontext.Context) error {
 	// TODO(lance6716): change ColumnName to IdentName or something
-	sql2 := fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s", dbutil.ColumnName(cp.cfg.MetaSchema))
+	sql2 := fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s", cp.dialect.quoteName(cp.cfg.MetaSchema))
 	args := make([]interface{}, 0)
 	_, err := cp.dbConn.ExecuteSQL(tctx, []string{sql2}, [][]interface{}{args}...)
 	cp.logCtx.L().Info("create checkpoint schema", zap.String("statement", sql2))
@@ -932,23 +1257,15 @@ func (cp *RemoteCheckPoint) createSchema(tctx *tcontext.Context) error {
 }
 
 func (cp *RemoteCheckPoint) createTable(tctx *tcontext.Context) error {
-	sqls := []string{
-		`CREATE TABLE IF NOT EXISTS ` + cp.tableName + ` (
-			id VARCHAR(32) NOT NULL,
-			cp_schema VARCHAR(128) NOT NULL,
-			cp_table VARCHAR(128) NOT NULL,
-			binlog_name VARCHAR(128),
-			binlog_pos INT UNSIGNED,
-			binlog_gtid TEXT,
-			exit_safe_binlog_name VARCHAR(128) DEFAULT '',
-			exit_safe_binlog_pos INT UNSIGNED DEFAULT 0,
-			exit_safe_binlog_gtid TEXT,
-			table_info JSON NOT NULL,
-			is_global BOOLEAN,
-			create_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
-			update_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
-			UNIQUE KEY uk_id_schema_table (id, cp_schema, cp_table)
-		)`,
+	sqls := []string{cp.dialect.checkpointTableSQL(cp.tableName)}
+	if cp.ddlHistoryTable != "" {
+		sqls = append(sqls, cp.dialect.ddlHistoryTableSQL(cp.ddlHistoryTable))
+	}
+	if cp.gtidSkipListTable != "" {
+		sqls = append(sqls, cp.dialect.gtidSkipListTableSQL(cp.gtidSkipListTable))
+	}
+	if cp.schemaSnapshotTable != "" {
+		sqls = append(sqls, cp.dialect.schemaSnapshotTableSQL(cp.schemaSnapshotTable))
 	}
 	_, err := cp.dbConn.ExecuteSQL(tctx, sqls)
 	cp.logCtx.L().Info("create checkpoint table", zap.Strings("statements", sqls))
@@ -960,8 +1277,24 @@ func (cp *RemoteCheckPoint) Load(tctx *tcontext.Context) error {
 	cp.Lock()
 	defer cp.Unlock()
 
-	query := `SELECT cp_schema, cp_table, binlog_name, binlog_pos, binlog_gtid, exit_safe_binlog_name, exit_safe_binlog_pos, exit_safe_binlog_gtid, table_info, is_global FROM ` + cp.tableName + ` WHERE id = ?`
-	rows, err := cp.dbConn.QuerySQL(tctx, query, cp.id)
+	var snapshot schemaSnapshot
+	if cp.schemaSnapshotTable != "" {
+		var err error
+		if snapshot, err = cp.loadSchemaSnapshot(tctx); err != nil {
+			return err
+		}
+	}
+
+	query := cp.dialect.rebind(`SELECT cp_schema, cp_table, binlog_name, binlog_pos, binlog_gtid, exit_safe_binlog_name, exit_safe_binlog_pos, exit_safe_binlog_gtid, table_info, is_global FROM ` + cp.tableName + ` WHERE id = ?`)
+	queryArgs := []interface{}{cp.id}
+	if snapshot != nil {
+		// the table infos of the checkpoints not updated after the snapshot are the same as the ones in the snapshot,
+		// skip them to avoid transferring and unmarshalling them.
+		query = cp.dialect.rebind(`SELECT cp_schema, cp_table, binlog_name, binlog_pos, binlog_gtid, exit_safe_binlog_name, exit_safe_binlog_pos, exit_safe_binlog_gtid, ` +
+			`CASE WHEN update_time >= (SELECT update_time FROM ` + cp.schemaSnapshotTable + ` WHERE id = ?) THEN table_info ELSE NULL END, is_global FROM ` + cp.tableName + ` WHERE id = ?`)
+		queryArgs = []interface{}{cp.id, cp.id}
+	}
+	rows, err := cp.dbConn.QuerySQL(tctx, query, queryArgs...)
 	defer func() {
 		if rows != nil {
 			rows.Close()
@@ -1012,7 +1345,7 @@ func (cp *RemoteCheckPoint) Load(tctx *tcontext.Context) error {
 		if isGlobal {
 			// Use IsFreshPosition here to make sure checkpoint can be updated if gset is empty
 			if !binlog.IsFreshPosition(location, cp.cfg.Flavor, cp.cfg.EnableGTID) {
-				cp.globalPoint = newBinlogPoint(location, location, nil, nil, cp.cfg.EnableGTID)
+				cp.globalPoint = newBinlogPoint(location, location, nil, nil, cp.locationCmp)
 				cp.logCtx.L().Info("fetch global checkpoint from DB", log.WrapStringerField("global checkpoint", cp.globalPoint))
 			}
 
@@ -1047,7 +1380,10 @@ func (cp *RemoteCheckPoint) Load(tctx *tcontext.Context) error {
 		}
 
 		var ti *model.TableInfo
-		if !bytes.Equal(tiBytes, []byte("null")) {
+		if tiBytes == nil {
+			// NULL means the table info is taken from the snapshot.
+			ti = snapshot[cpSchema][cpTable]
+		} else if !bytes.Equal(tiBytes, []byte("null")) {
 			// only create table if `table_info` is not `null`.
 			if err = json.Unmarshal(tiBytes, &ti); err != nil {
 				return terror.ErrSchemaTrackerInvalidJSON.Delegate(err, cpSchema, cpTable)
@@ -1059,10 +1395,139 @@ func (cp *RemoteCheckPoint) Load(tctx *tcontext.Context) error {
 			mSchema = make(map[string]*binlogPoint)
 			cp.points[cpSchema] = mSchema
 		}
-		mSchema[cpTable] = newBinlogPoint(location, location, ti, ti, cp.cfg.EnableGTID)
+		mSchema[cpTable] = newBinlogPoint(location, location, ti, ti, cp.locationCmp)
+	}
+	if err = rows.Err(); err != nil {
+		return terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeDownstream)
+	}
+
+	if err = cp.loadGTIDSkipList(tctx); err != nil {
+		return err
+	}
+	if err = cp.loadRulesHistory(tctx); err != nil {
+		return err
+	}
+	return cp.loadPausedTables(tctx)
+}
+
+// loadGTIDSkipList loads the GTID set of the transactions to skip from the downstream.
+func (cp *RemoteCheckPoint) loadGTIDSkipList(tctx *tcontext.Context) error {
+	if cp.gtidSkipListTable == "" {
+		return nil
+	}
+	query := cp.dialect.rebind(`SELECT gtid_set FROM ` + cp.gtidSkipListTable + ` WHERE id = ?`)
+	rows, err := cp.dbConn.QuerySQL(tctx, query, cp.id)
+	if err != nil {
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+	defer rows.Close()
+
+	var gtidSetStr sql.NullString
+	for rows.Next() {
+		if err = rows.Scan(&gtidSetStr); err != nil {
+			return terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeDownstream)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeDownstream)
+	}
+	if gtidSetStr.String == "" {
+		return nil
+	}
+	skipList, err := gtid.ParserGTID(cp.cfg.Flavor, gtidSetStr.String)
+	if err != nil {
+		return err
+	}
+	cp.gtidSkipList = skipList
+	cp.logCtx.L().Info("fetch GTID skip list from DB", zap.Stringer("GTID skip list", skipList))
+	return nil
+}
+
+// loadRulesHistory loads the versions of the hot reloaded rules from the downstream. the rules table only exists after
+// the rules are hot reloaded, which increases the rules version in the subtask config.
+func (cp *RemoteCheckPoint) loadRulesHistory(tctx *tcontext.Context) error {
+	if cp.cfg.RulesVersion == 0 {
+		return nil
+	}
+	// the table may not be created yet if the subtask is restarted before the updated rules are applied
+	if _, err := cp.dbConn.ExecuteSQL(tctx, []string{cp.dialect.rulesTableSQL(cp.rulesTable)}); err != nil {
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+	query := cp.dialect.rebind(`SELECT version, binlog_name, binlog_pos, binlog_gtid, rules FROM ` + cp.rulesTable + ` WHERE id = ? ORDER BY version`)
+	rows, err := cp.dbConn.QuerySQL(tctx, query, cp.id)
+	if err != nil {
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+	defer rows.Close()
+
+	var (
+		version       int64
+		binlogName    string
+		binlogPos     uint32
+		binlogGTIDSet sql.NullString
+		rules         string
+		history       []*rulesRecord
+	)
+	for rows.Next() {
+		if err = rows.Scan(&version, &binlogName, &binlogPos, &binlogGTIDSet, &rules); err != nil {
+			return terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeDownstream)
+		}
+		gset, err2 := gtid.ParserGTID(cp.cfg.Flavor, binlogGTIDSet.String)
+		if err2 != nil {
+			return err2
+		}
+		location := binlog.InitLocation(mysql.Position{Name: binlogName, Pos: binlogPos}, gset)
+		history = append(history, &rulesRecord{version: version, location: location, rules: rules})
+	}
+	if err = rows.Err(); err != nil {
+		return terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeDownstream)
+	}
+	cp.rulesHistory = history
+	cp.logCtx.L().Info("fetch rules history from DB", zap.Int("versions", len(history)))
+	return nil
+}
+
+// loadPausedTables loads the tables paused with buffer policy from the downstream. the paused tables table only exists
+// after a table is paused, which adds the table to the subtask config.
+func (cp *RemoteCheckPoint) loadPausedTables(tctx *tcontext.Context) error {
+	if len(cp.cfg.PausedTables) == 0 {
+		return nil
+	}
+	// the table may not be created yet if the subtask is restarted before the paused table is applied
+	if _, err := cp.dbConn.ExecuteSQL(tctx, []string{cp.dialect.pausedTablesTableSQL(cp.pausedTablesTable)}); err != nil {
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+	query := cp.dialect.rebind(`SELECT table_schema, table_name, binlog_name, binlog_pos, binlog_gtid FROM ` + cp.pausedTablesTable + ` WHERE id = ?`)
+	rows, err := cp.dbConn.QuerySQL(tctx, query, cp.id)
+	if err != nil {
+		return terror.WithScope(err, terror.ScopeDownstream)
 	}
+	defer rows.Close()
 
-	return terror.WithScope(terror.DBErrorAdapt(rows.Err(), terror.ErrDBDriverError), terror.ScopeDownstream)
+	var (
+		schema, table string
+		binlogName    string
+		binlogPos     uint32
+		binlogGTIDSet sql.NullString
+		records       []*pausedTableRecord
+	)
+	for rows.Next() {
+		if err = rows.Scan(&schema, &table, &binlogName, &binlogPos, &binlogGTIDSet); err != nil {
+			return terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeDownstream)
+		}
+		gset, err2 := gtid.ParserGTID(cp.cfg.Flavor, binlogGTIDSet.String)
+		if err2 != nil {
+			return err2
+		}
+		location := binlog.InitLocation(mysql.Position{Name: binlogName, Pos: binlogPos}, gset)
+		records = append(records, &pausedTableRecord{table: &filter.Table{Schema: schema, Name: table}, location: location})
+	}
+	if err = rows.Err(); err != nil {
+		return terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeDownstream)
+	}
+	cp.pausedTables = records
+	cp.logCtx.L().Info("fetch paused tables from DB", zap.Int("tables", len(records)))
+	return nil
 }
 
 // CheckAndUpdate check the checkpoint data consistency and try to fix them if possible.
@@ -1125,7 +1590,7 @@ func (cp *RemoteCheckPoint) LoadMeta() error {
 		// load meta from task config
 		if cp.cfg.Meta == nil {
 			cp.logCtx.L().Warn("didn't set meta in increment task-mode")
-			cp.globalPoint = newBinlogPoint(binlog.NewLocation(cp.cfg.Flavor), binlog.NewLocation(cp.cfg.Flavor), nil, nil, cp.cfg.EnableGTID)
+			cp.globalPoint = newBinlogPoint(binlog.NewLocation(cp.cfg.Flavor), binlog.NewLocation(cp.cfg.Flavor), nil, nil, cp.locationCmp)
 			return nil
 		}
 		gset, err := gtid.ParserGTID(cp.cfg.Flavor, cp.cfg.Meta.BinLogGTID)
@@ -1148,7 +1613,7 @@ func (cp *RemoteCheckPoint) LoadMeta() error {
 
 	// if meta loaded, we will start syncing from meta's pos
 	if location != nil {
-		cp.globalPoint = newBinlogPoint(*location, *location, nil, nil, cp.cfg.EnableGTID)
+		cp.globalPoint = newBinlogPoint(*location, *location, nil, nil, cp.locationCmp)
 		cp.logCtx.L().Info("loaded checkpoints from meta", log.WrapStringerField("global checkpoint", cp.globalPoint))
 	}
 	if safeModeExitLoc != nil {
@@ -1161,21 +1626,7 @@ func (cp *RemoteCheckPoint) LoadMeta() error {
 
 // genUpdateSQL generates SQL and arguments for update checkpoint.
 func (cp *RemoteCheckPoint) genUpdateSQL(cpSchema, cpTable string, location binlog.Location, safeModeExitLoc *binlog.Location, tiBytes []byte, isGlobal bool) (string, []interface{}) {
-	// use `INSERT INTO ... ON DUPLICATE KEY UPDATE` rather than `REPLACE INTO`
-	// to keep `create_time`, `update_time` correctly
-	sql2 := `INSERT INTO ` + cp.tableName + `
-		(id, cp_schema, cp_table, binlog_name, binlog_pos, binlog_gtid, exit_safe_binlog_name, exit_safe_binlog_pos, exit_safe_binlog_gtid, table_info, is_global) VALUES
-		(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-		ON DUPLICATE KEY UPDATE
-			binlog_name = VALUES(binlog_name),
-			binlog_pos = VALUES(binlog_pos),
-			binlog_gtid = VALUES(binlog_gtid),
-			exit_safe_binlog_name = VALUES(exit_safe_binlog_name),
-			exit_safe_binlog_pos = VALUES(exit_safe_binlog_pos),
-			exit_safe_binlog_gtid = VALUES(exit_safe_binlog_gtid),
-			table_info = VALUES(table_info),
-			is_global = VALUES(is_global);
-	`
+	sql2 := cp.dialect.checkpointUpsertSQL(cp.tableName)
 
 	if isGlobal {
 		cpSchema = globalCpSchema
@@ -1207,11 +1658,12 @@ func (cp *RemoteCheckPoint) genUpdateSQL(cpSchema, cpTable string, location binl
 
 func (cp *RemoteCheckPoint) parseMetaData() (*binlog.Location, *binlog.Location, error) {
 	// `metadata` is mydumper's output meta file name
-	filename := path.Join(cp.cfg.Dir, "metadata")
+	ctx, cancel := context.WithTimeout(context.Background(), utils.DefaultDBTimeout)
+	defer cancel()
 
-	loc, loc2, err := dumpling.ParseMetaData(filename, cp.cfg.Flavor)
+	loc, loc2, err := dumpling.ParseMetaDataInDir(ctx, cp.cfg.Dir, cp.cfg.Flavor)
 	if err != nil {
-		toPrint, err2 := os.ReadFile(filename)
+		toPrint, err2 := storage.ReadFile(ctx, cp.cfg.Dir, "metadata")
 		if err2 != nil {
 			toPrint = []byte(err2.Error())
 		}
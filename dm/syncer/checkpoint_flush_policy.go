@@ -0,0 +1,110 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"github.com/go-mysql-org/go-mysql/replication"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+)
+
+// checkpointFlushPolicy decides when the checkpoint saved in memory should be flushed to downstream. the checkpoint is
+// only flushed at transaction boundaries, so it never points to the middle of a transaction no matter which policy is
+// used. all methods are called in the main goroutine of Syncer.
+type checkpointFlushPolicy interface {
+	// observe is called for every job handled by Syncer.
+	observe(j *job)
+	// shouldFlush returns true when the checkpoint should be flushed.
+	shouldFlush(cp CheckPoint) bool
+	// reset is called after a snapshot of the checkpoint is created to be flushed.
+	reset()
+}
+
+func newCheckpointFlushPolicy(cfg *config.SyncerConfig) checkpointFlushPolicy {
+	switch cfg.CheckpointFlushPolicy {
+	case config.CheckpointFlushByTxn:
+		return &txnFlushPolicy{threshold: cfg.CheckpointFlushTxnCount}
+	case config.CheckpointFlushByBytes:
+		return &bytesFlushPolicy{threshold: cfg.CheckpointFlushBytes}
+	case config.CheckpointFlushManually:
+		return manualFlushPolicy{}
+	default:
+		return intervalFlushPolicy{}
+	}
+}
+
+// intervalFlushPolicy flushes the checkpoint every checkpoint-flush-interval seconds.
+type intervalFlushPolicy struct{}
+
+func (intervalFlushPolicy) observe(*job) {}
+
+func (intervalFlushPolicy) shouldFlush(cp CheckPoint) bool {
+	return cp.CheckGlobalPoint() && cp.CheckLastSnapshotCreationTime()
+}
+
+func (intervalFlushPolicy) reset() {}
+
+// txnFlushPolicy flushes the checkpoint every `threshold` transactions.
+type txnFlushPolicy struct {
+	threshold int
+	count     int
+}
+
+func (p *txnFlushPolicy) observe(j *job) {
+	if j.tp == xid || j.tp == ddl {
+		p.count++
+	}
+}
+
+func (p *txnFlushPolicy) shouldFlush(CheckPoint) bool {
+	return p.count >= p.threshold
+}
+
+func (p *txnFlushPolicy) reset() {
+	p.count = 0
+}
+
+// bytesFlushPolicy flushes the checkpoint every `threshold` bytes of binlog events applied.
+type bytesFlushPolicy struct {
+	threshold  int64
+	bytes      int64
+	lastHeader *replication.EventHeader // the jobs generated from one event share its header
+}
+
+func (p *bytesFlushPolicy) observe(j *job) {
+	if j.eventHeader != nil && j.eventHeader != p.lastHeader {
+		p.bytes += int64(j.eventHeader.EventSize)
+		p.lastHeader = j.eventHeader
+	}
+}
+
+func (p *bytesFlushPolicy) shouldFlush(CheckPoint) bool {
+	return p.bytes >= p.threshold
+}
+
+func (p *bytesFlushPolicy) reset() {
+	p.bytes = 0
+}
+
+// manualFlushPolicy never flushes the checkpoint by itself, it's flushed after DDL, when the task is paused or stopped,
+// and when requested by Syncer.FlushCheckpoint.
+type manualFlushPolicy struct{}
+
+func (manualFlushPolicy) observe(*job) {}
+
+func (manualFlushPolicy) shouldFlush(CheckPoint) bool {
+	return false
+}
+
+func (manualFlushPolicy) reset() {}
@@ -0,0 +1,63 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"github.com/go-mysql-org/go-mysql/replication"
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+)
+
+var _ = Suite(&testCheckpointFlushPolicySuite{})
+
+type testCheckpointFlushPolicySuite struct{}
+
+func (t *testCheckpointFlushPolicySuite) TestCheckpointFlushPolicy(c *C) {
+	_, ok := newCheckpointFlushPolicy(&config.SyncerConfig{}).(intervalFlushPolicy)
+	c.Assert(ok, IsTrue)
+
+	// txn
+	p := newCheckpointFlushPolicy(&config.SyncerConfig{CheckpointFlushPolicy: config.CheckpointFlushByTxn, CheckpointFlushTxnCount: 2})
+	header := &replication.EventHeader{EventSize: 100}
+	p.observe(&job{tp: insert, eventHeader: header})
+	p.observe(&job{tp: xid})
+	c.Assert(p.shouldFlush(nil), IsFalse)
+	p.observe(&job{tp: ddl, eventHeader: header})
+	c.Assert(p.shouldFlush(nil), IsTrue)
+	p.reset()
+	c.Assert(p.shouldFlush(nil), IsFalse)
+
+	// bytes, the jobs of one event are counted once
+	p = newCheckpointFlushPolicy(&config.SyncerConfig{CheckpointFlushPolicy: config.CheckpointFlushByBytes, CheckpointFlushBytes: 200})
+	p.observe(&job{tp: insert, eventHeader: header})
+	p.observe(&job{tp: insert, eventHeader: header})
+	p.observe(&job{tp: xid})
+	c.Assert(p.shouldFlush(nil), IsFalse)
+	p.observe(&job{tp: update, eventHeader: &replication.EventHeader{EventSize: 100}})
+	c.Assert(p.shouldFlush(nil), IsTrue)
+	p.reset()
+	c.Assert(p.shouldFlush(nil), IsFalse)
+
+	// manual, only flushed when requested
+	s := &Syncer{
+		checkpointFlushPolicy: newCheckpointFlushPolicy(&config.SyncerConfig{CheckpointFlushPolicy: config.CheckpointFlushManually}),
+	}
+	for i := 0; i < 10; i++ {
+		s.checkpointFlushPolicy.observe(&job{tp: xid})
+	}
+	c.Assert(s.needFlushCheckpoint(), IsFalse)
+	s.FlushCheckpoint()
+	c.Assert(s.needFlushCheckpoint(), IsTrue)
+}
@@ -15,6 +15,7 @@ package syncer
 
 import (
 	"context"
+	"database/sql/driver"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -29,6 +30,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/gtid"
 	"github.com/pingcap/tiflow/dm/pkg/retry"
 	"github.com/pingcap/tiflow/dm/pkg/schema"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/syncer/dbconn"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -494,4 +496,429 @@ func (s *testCheckpointSuite) testTableCheckPoint(c *C, cp CheckPoint) {
 	c.Assert(rcp.points[schemaName][tableName].TableInfo(), NotNil)
 	c.Assert(rcp.points[schemaName][tableName].flushedPoint.ti, NotNil)
 	c.Assert(*rcp.safeModeExitPoint, DeepEquals, binlog.InitLocation(pos2, gs))
+
+	// the GTID set of the table checkpoint is used to decide the replicated events regardless of positions, like the
+	// positions of another upstream server after failover
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(clearCheckPointSQL).WithArgs(cpid).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+	c.Assert(cp.Clear(tctx), IsNil)
+	tableGSetStr := "03fc0263-28c7-11e7-a653-6c0b84d59f30:1-123"
+	tableGs, _ := gtid.ParserGTID(flavor, tableGSetStr)
+	s.mock.ExpectQuery(loadCheckPointSQL).WithArgs(cpid).WillReturnRows(
+		sqlmock.NewRows(columns).AddRow("", "", pos2.Name, pos2.Pos, tableGs.String(), "", 0, "", "null", true).
+			AddRow(schemaName, tableName, pos2.Name, pos2.Pos, tableGs.String(), "", 0, "", tiBytes, false))
+	c.Assert(cp.Load(tctx), IsNil)
+	table = &filter.Table{Schema: schemaName, Name: tableName}
+	failoverPos := mysql.Position{Name: "mysql-bin.000001", Pos: 4}
+	for _, cs := range []struct {
+		gSetStr string
+		pos     mysql.Position
+		older   bool
+	}{
+		{"03fc0263-28c7-11e7-a653-6c0b84d59f30:1-100", failoverPos, true},
+		{"03fc0263-28c7-11e7-a653-6c0b84d59f30:1-100", mysql.Position{Name: "mysql-bin.999999", Pos: 4}, true},
+		{"03fc0263-28c7-11e7-a653-6c0b84d59f30:1-123,a1b2c3d4-28c7-11e7-a653-6c0b84d59f30:1", failoverPos, false},
+		// the same transaction as the table checkpoint, compared by position
+		{tableGSetStr, pos2, false},
+	} {
+		gs2, err2 := gtid.ParserGTID(flavor, cs.gSetStr)
+		c.Assert(err2, IsNil)
+		c.Assert(cp.IsOlderThanTablePoint(table, binlog.InitLocation(cs.pos, gs2), false), Equals, cs.older, Commentf("%s", cs.gSetStr))
+	}
+}
+
+func (s *testCheckpointSuite) TestDDLHistory(c *C) {
+	tctx := tcontext.Background()
+	cfg, err := s.cfg.Clone()
+	c.Assert(err, IsNil)
+	cfg.DDLRewriteRules = []*config.DDLRewriteRule{{Pattern: "ENGINE=MyISAM", Replacement: "ENGINE=InnoDB"}}
+	// the GTID skip list table is created with GTID enabled
+	cfg.EnableGTID = false
+
+	cp := NewRemoteCheckPoint(tctx, cfg, cpid)
+	defer func() {
+		s.mock.ExpectClose()
+		cp.Close()
+	}()
+
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	s.mock = mock
+	s.prepareCheckPointSQL()
+	historyTable := dbutil.TableName(cfg.MetaSchema, cputil.SyncerDDLHistory(cfg.Name))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(schemaCreateSQL).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(tableCreateSQL).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS " + historyTable + " .*").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	dbConn, err := db.Conn(tcontext.Background().Context())
+	c.Assert(err, IsNil)
+	cp.(*RemoteCheckPoint).dbConn = &dbconn.DBConn{Cfg: cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})}
+	c.Assert(cp.(*RemoteCheckPoint).prepare(tctx), IsNil)
+
+	// only the rewritten DDLs are recorded, along with the checkpoint
+	location := binlog.Location{Position: mysql.Position{Name: "mysql-bin.000003", Pos: 1943}}
+	cp.SaveGlobalPoint(location)
+	cp.SaveDDLHistory(
+		location,
+		[]string{"CREATE TABLE `t1` (`id` INT) ENGINE=MyISAM", "CREATE TABLE `t2` (`id` INT)"},
+		[]string{"CREATE TABLE `t1` (`id` INT) ENGINE=InnoDB", "CREATE TABLE `t2` (`id` INT)"},
+	)
+	mock.ExpectBegin()
+	mock.ExpectExec(flushCheckPointSQL).WithArgs(cpid, "", "", location.Position.Name, location.Position.Pos, "", "", 0, "", "null", true).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO "+historyTable+" .* VALUES .*").
+		WithArgs(cpid, location.Position.Name, location.Position.Pos, "", "CREATE TABLE `t1` (`id` INT) ENGINE=MyISAM", "CREATE TABLE `t1` (`id` INT) ENGINE=InnoDB").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	c.Assert(cp.FlushPointsExcept(tctx, cp.Snapshot(true).id, nil, nil, nil), IsNil)
+
+	// the recorded DDLs are not flushed again
+	c.Assert(cp.Snapshot(true), IsNil)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+}
+
+func (s *testCheckpointSuite) TestGTIDSkipList(c *C) {
+	tctx := tcontext.Background()
+	cfg, err := s.cfg.Clone()
+	c.Assert(err, IsNil)
+	cfg.EnableGTID = true
+	cfg.Flavor = mysql.MySQLFlavor
+
+	cp := NewRemoteCheckPoint(tctx, cfg, cpid)
+	defer func() {
+		s.mock.ExpectClose()
+		cp.Close()
+	}()
+
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	s.mock = mock
+	s.prepareCheckPointSQL()
+	skipListTable := dbutil.TableName(cfg.MetaSchema, cputil.SyncerGTIDSkipList(cfg.Name))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(schemaCreateSQL).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(tableCreateSQL).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS " + skipListTable + " .*").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	dbConn, err := db.Conn(tcontext.Background().Context())
+	c.Assert(err, IsNil)
+	rcp := cp.(*RemoteCheckPoint)
+	rcp.db = conn.NewBaseDB(db)
+	rcp.dbConn = &dbconn.DBConn{Cfg: cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})}
+	c.Assert(rcp.prepare(tctx), IsNil)
+
+	// the GTID skip list is loaded along with the checkpoints
+	gSetStr := "03fc0263-28c7-11e7-a653-6c0b84d59f30:5-8:12"
+	mock.ExpectQuery(loadCheckPointSQL).WithArgs(cpid).WillReturnRows(sqlmock.NewRows(nil))
+	mock.ExpectQuery("SELECT gtid_set FROM " + skipListTable + " WHERE id = \\?").WithArgs(cpid).
+		WillReturnRows(sqlmock.NewRows([]string{"gtid_set"}).AddRow(gSetStr))
+	c.Assert(cp.Load(tctx), IsNil)
+	c.Assert(cp.GTIDSkipList().String(), Equals, gSetStr)
+
+	// the GTID skip list is flushed immediately, and an empty one is deleted
+	gs, err := gtid.ParserGTID(cfg.Flavor, "03fc0263-28c7-11e7-a653-6c0b84d59f30:5-8")
+	c.Assert(err, IsNil)
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM " + skipListTable + " WHERE id = \\?").WithArgs(cpid).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO "+skipListTable+" \\(id, gtid_set\\) VALUES \\(\\?, \\?\\)").WithArgs(cpid, gs.String()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	c.Assert(cp.SaveGTIDSkipList(tctx, gs), IsNil)
+	c.Assert(cp.GTIDSkipList(), Equals, gs)
+
+	gs, err = gtid.ParserGTID(cfg.Flavor, "")
+	c.Assert(err, IsNil)
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM " + skipListTable + " WHERE id = \\?").WithArgs(cpid).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	c.Assert(cp.SaveGTIDSkipList(tctx, gs), IsNil)
+	c.Assert(cp.GTIDSkipList(), IsNil)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// the GTID skip list is not supported without GTID
+	cfg.EnableGTID = false
+	cp2 := NewRemoteCheckPoint(tctx, cfg, cpid)
+	c.Assert(terror.ErrSyncerGTIDSkipListNotSupported.Equal(cp2.SaveGTIDSkipList(tctx, gs)), IsTrue)
+}
+
+func (s *testCheckpointSuite) TestRulesHistory(c *C) {
+	tctx := tcontext.Background()
+	cfg, err := s.cfg.Clone()
+	c.Assert(err, IsNil)
+	cfg.EnableGTID = false
+	cfg.Flavor = mysql.MySQLFlavor
+	cfg.RulesVersion = 2
+
+	cp := NewRemoteCheckPoint(tctx, cfg, cpid)
+	defer func() {
+		s.mock.ExpectClose()
+		cp.Close()
+	}()
+
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	s.mock = mock
+	s.prepareCheckPointSQL()
+	rulesTable := dbutil.TableName(cfg.MetaSchema, cputil.SyncerRules(cfg.Name))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(schemaCreateSQL).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(tableCreateSQL).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	dbConn, err := db.Conn(tcontext.Background().Context())
+	c.Assert(err, IsNil)
+	rcp := cp.(*RemoteCheckPoint)
+	rcp.db = conn.NewBaseDB(db)
+	rcp.dbConn = &dbconn.DBConn{Cfg: cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})}
+	c.Assert(rcp.prepare(tctx), IsNil)
+
+	// the rules history is loaded along with the checkpoints in order of the versions
+	mock.ExpectQuery(loadCheckPointSQL).WithArgs(cpid).WillReturnRows(sqlmock.NewRows(nil))
+	mock.ExpectBegin()
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS " + rulesTable + " .*").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery("SELECT version, binlog_name, binlog_pos, binlog_gtid, rules FROM " + rulesTable + " WHERE id = \\? ORDER BY version").
+		WithArgs(cpid).WillReturnRows(sqlmock.NewRows([]string{"version", "binlog_name", "binlog_pos", "binlog_gtid", "rules"}).
+		AddRow(0, "", 4, "", "{}").AddRow(1, "mysql-bin.000001", 1234, "", `{"route-rules":[]}`))
+	c.Assert(cp.Load(tctx), IsNil)
+	history := cp.RulesHistory()
+	c.Assert(history, HasLen, 2)
+	c.Assert(history[0].version, Equals, int64(0))
+	c.Assert(history[1].version, Equals, int64(1))
+	c.Assert(history[1].location.Position, Equals, mysql.Position{Name: "mysql-bin.000001", Pos: 1234})
+	c.Assert(history[1].rules, Equals, `{"route-rules":[]}`)
+
+	// the saved rules replace the same and newer versions
+	location := binlog.InitLocation(mysql.Position{Name: "mysql-bin.000001", Pos: 1000}, nil)
+	mock.ExpectBegin()
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS " + rulesTable + " .*").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM "+rulesTable+" WHERE id = \\? AND version = \\?").WithArgs(cpid, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO "+rulesTable+" \\(id, version, binlog_name, binlog_pos, binlog_gtid, rules\\) VALUES \\(\\?, \\?, \\?, \\?, \\?, \\?\\)").
+		WithArgs(cpid, 1, "mysql-bin.000001", 1000, "", "{}").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	c.Assert(cp.SaveRules(tctx, 1, location, "{}"), IsNil)
+	history = cp.RulesHistory()
+	c.Assert(history, HasLen, 2)
+	c.Assert(history[1].location.Position, Equals, location.Position)
+	c.Assert(history[1].rules, Equals, "{}")
+
+	// the rules history is deleted along with the checkpoints
+	mock.ExpectBegin()
+	mock.ExpectExec(clearCheckPointSQL).WithArgs(cpid).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM " + rulesTable + " WHERE id = \\?").WithArgs(cpid).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	c.Assert(cp.Clear(tctx), IsNil)
+	c.Assert(cp.RulesHistory(), HasLen, 0)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+}
+
+func (s *testCheckpointSuite) TestPausedTables(c *C) {
+	tctx := tcontext.Background()
+	cfg, err := s.cfg.Clone()
+	c.Assert(err, IsNil)
+	cfg.EnableGTID = false
+	cfg.Flavor = mysql.MySQLFlavor
+	cfg.PausedTables = []*config.PausedTable{{Schema: "db", Table: "tbl1", Policy: config.PauseTablePolicyBuffer}}
+
+	cp := NewRemoteCheckPoint(tctx, cfg, cpid)
+	defer func() {
+		s.mock.ExpectClose()
+		cp.Close()
+	}()
+
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	s.mock = mock
+	s.prepareCheckPointSQL()
+	pausedTablesTable := dbutil.TableName(cfg.MetaSchema, cputil.SyncerPausedTables(cfg.Name))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(schemaCreateSQL).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(tableCreateSQL).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	dbConn, err := db.Conn(tcontext.Background().Context())
+	c.Assert(err, IsNil)
+	rcp := cp.(*RemoteCheckPoint)
+	rcp.db = conn.NewBaseDB(db)
+	rcp.dbConn = &dbconn.DBConn{Cfg: cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})}
+	c.Assert(rcp.prepare(tctx), IsNil)
+
+	// the paused tables are loaded along with the checkpoints if there're paused tables in the subtask config
+	mock.ExpectQuery(loadCheckPointSQL).WithArgs(cpid).WillReturnRows(sqlmock.NewRows(nil))
+	mock.ExpectBegin()
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS " + pausedTablesTable + " .*").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery("SELECT table_schema, table_name, binlog_name, binlog_pos, binlog_gtid FROM " + pausedTablesTable + " WHERE id = \\?").
+		WithArgs(cpid).WillReturnRows(sqlmock.NewRows([]string{"table_schema", "table_name", "binlog_name", "binlog_pos", "binlog_gtid"}).
+		AddRow("db", "tbl1", "mysql-bin.000001", 1234, ""))
+	c.Assert(cp.Load(tctx), IsNil)
+	records := cp.PausedTables()
+	c.Assert(records, HasLen, 1)
+	c.Assert(records[0].table, DeepEquals, &filter.Table{Schema: "db", Name: "tbl1"})
+	c.Assert(records[0].location.Position, Equals, mysql.Position{Name: "mysql-bin.000001", Pos: 1234})
+
+	// the saved table replaces the record of the same table
+	location := binlog.InitLocation(mysql.Position{Name: "mysql-bin.000001", Pos: 1000}, nil)
+	expectSave := func(table string) {
+		mock.ExpectBegin()
+		mock.ExpectExec("CREATE TABLE IF NOT EXISTS " + pausedTablesTable + " .*").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("DELETE FROM "+pausedTablesTable+" WHERE id = \\? AND table_schema = \\? AND table_name = \\?").
+			WithArgs(cpid, "db", table).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO "+pausedTablesTable+" \\(id, table_schema, table_name, binlog_name, binlog_pos, binlog_gtid\\) VALUES \\(\\?, \\?, \\?, \\?, \\?, \\?\\)").
+			WithArgs(cpid, "db", table, "mysql-bin.000001", 1000, "").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+	}
+	expectSave("tbl1")
+	c.Assert(cp.SavePausedTable(tctx, &filter.Table{Schema: "db", Name: "tbl1"}, location), IsNil)
+	expectSave("tbl2")
+	c.Assert(cp.SavePausedTable(tctx, &filter.Table{Schema: "db", Name: "tbl2"}, location), IsNil)
+	records = cp.PausedTables()
+	c.Assert(records, HasLen, 2)
+	c.Assert(records[0].location.Position, Equals, location.Position)
+
+	// the record of the resumed table is deleted
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM "+pausedTablesTable+" WHERE id = \\? AND table_schema = \\? AND table_name = \\?").
+		WithArgs(cpid, "db", "tbl1").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	c.Assert(cp.DeletePausedTable(tctx, &filter.Table{Schema: "db", Name: "tbl1"}), IsNil)
+	records = cp.PausedTables()
+	c.Assert(records, HasLen, 1)
+	c.Assert(records[0].table.Name, Equals, "tbl2")
+
+	// the paused tables are deleted along with the checkpoints
+	mock.ExpectBegin()
+	mock.ExpectExec(clearCheckPointSQL).WithArgs(cpid).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM " + pausedTablesTable + " WHERE id = \\?").WithArgs(cpid).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	c.Assert(cp.Clear(tctx), IsNil)
+	c.Assert(cp.PausedTables(), HasLen, 0)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+}
+
+// snapshotDataArg matches any argument and keeps it, to check the schema snapshot flushed.
+type snapshotDataArg struct {
+	data []byte
+}
+
+func (a *snapshotDataArg) Match(v driver.Value) bool {
+	a.data, _ = v.([]byte)
+	return true
+}
+
+func (s *testCheckpointSuite) TestSchemaSnapshot(c *C) {
+	tctx := tcontext.Background()
+	cfg, err := s.cfg.Clone()
+	c.Assert(err, IsNil)
+	cfg.EnableGTID = false
+	cfg.SchemaSnapshotInterval = 3600
+
+	cp := NewRemoteCheckPoint(tctx, cfg, cpid)
+	defer func() {
+		s.mock.ExpectClose()
+		cp.Close()
+	}()
+
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	s.mock = mock
+	s.prepareCheckPointSQL()
+	snapshotTable := dbutil.TableName(cfg.MetaSchema, cputil.SyncerSchemaSnapshot(cfg.Name))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(schemaCreateSQL).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(tableCreateSQL).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS " + snapshotTable + " .*").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	dbConn, err := db.Conn(tcontext.Background().Context())
+	c.Assert(err, IsNil)
+	rcp := cp.(*RemoteCheckPoint)
+	rcp.dbConn = &dbconn.DBConn{Cfg: cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})}
+	c.Assert(rcp.prepare(tctx), IsNil)
+
+	ctx := context.Background()
+	c.Assert(s.tracker.CreateSchemaIfNotExists("snapshot_db"), IsNil)
+	c.Assert(s.tracker.Exec(ctx, "snapshot_db", "create table snapshot_tb1 (c int)"), IsNil)
+	c.Assert(s.tracker.Exec(ctx, "snapshot_db", "create table snapshot_tb2 (c int, c2 int)"), IsNil)
+	table1 := &filter.Table{Schema: "snapshot_db", Name: "snapshot_tb1"}
+	table2 := &filter.Table{Schema: "snapshot_db", Name: "snapshot_tb2"}
+	ti1, err := s.tracker.GetTableInfo(table1)
+	c.Assert(err, IsNil)
+	ti2, err := s.tracker.GetTableInfo(table2)
+	c.Assert(err, IsNil)
+
+	// the snapshot is flushed along with the checkpoints
+	location := binlog.Location{Position: mysql.Position{Name: "mysql-bin.000003", Pos: 1943}}
+	cp.SaveTablePoint(table1, location, ti1)
+	cp.SaveTablePoint(table2, location, ti2)
+	cp.SaveGlobalPoint(location)
+	data := &snapshotDataArg{}
+	mock.ExpectBegin()
+	mock.ExpectExec(flushCheckPointSQL).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(flushCheckPointSQL).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(flushCheckPointSQL).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM " + snapshotTable + " WHERE id = \\?").WithArgs(cpid).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO "+snapshotTable+" .*").WithArgs(cpid, location.Position.Name, location.Position.Pos, "", data).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	c.Assert(cp.FlushPointsExcept(tctx, cp.Snapshot(true).id, nil, nil, nil), IsNil)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+	snapshot, err := decodeSchemaSnapshot(data.data)
+	c.Assert(err, IsNil)
+	c.Assert(snapshot["snapshot_db"]["snapshot_tb1"].Columns, HasLen, 1)
+	c.Assert(snapshot["snapshot_db"]["snapshot_tb2"].Columns, HasLen, 2)
+
+	// the snapshot is not flushed again before the interval elapses
+	location2 := binlog.Location{Position: mysql.Position{Name: "mysql-bin.000003", Pos: 2000}}
+	cp.SaveTablePoint(table1, location2, ti1)
+	mock.ExpectBegin()
+	mock.ExpectExec(flushCheckPointSQL).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	c.Assert(cp.FlushPointsExcept(tctx, cp.Snapshot(true).id, nil, nil, nil), IsNil)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// the table infos not updated after the snapshot are taken from the snapshot when loading
+	tiBytes, err := json.Marshal(ti1)
+	c.Assert(err, IsNil)
+	mock.ExpectQuery("SELECT binlog_name, binlog_pos, binlog_gtid, table_infos FROM " + snapshotTable + " WHERE id = \\?").WithArgs(cpid).
+		WillReturnRows(sqlmock.NewRows([]string{"binlog_name", "binlog_pos", "binlog_gtid", "table_infos"}).
+			AddRow(location.Position.Name, location.Position.Pos, "", data.data))
+	mock.ExpectQuery(loadCheckPointSQL).WithArgs(cpid, cpid).WillReturnRows(
+		sqlmock.NewRows([]string{"cp_schema", "cp_table", "binlog_name", "binlog_pos", "binlog_gtid", "exit_safe_binlog_name", "exit_safe_binlog_pos", "exit_safe_binlog_gtid", "table_info", "is_global"}).
+			AddRow("", "", location.Position.Name, location.Position.Pos, "", "", 0, "", nil, true).
+			AddRow(table1.Schema, table1.Name, location2.Position.Name, location2.Position.Pos, "", "", 0, "", tiBytes, false).
+			AddRow(table2.Schema, table2.Name, location.Position.Name, location.Position.Pos, "", "", 0, "", nil, false))
+	cp2 := NewRemoteCheckPoint(tctx, cfg, cpid)
+	cp2.(*RemoteCheckPoint).dbConn = rcp.dbConn
+	c.Assert(cp2.Load(tctx), IsNil)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+	c.Assert(cp2.GetFlushedTableInfo(table1).Columns, HasLen, 1)
+	c.Assert(cp2.GetFlushedTableInfo(table2).Columns, HasLen, 2)
+
+	// the snapshot is deleted along with the checkpoints
+	mock.ExpectBegin()
+	mock.ExpectExec(clearCheckPointSQL).WithArgs(cpid).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM " + snapshotTable + " WHERE id = \\?").WithArgs(cpid).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	c.Assert(cp.Clear(tctx), IsNil)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
 }
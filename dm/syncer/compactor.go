@@ -41,6 +41,7 @@ type compactor struct {
 	task         string
 	source       string
 	addCountFunc func(bool, string, opType, int64, *filter.Table)
+	memQuota     *memQuota
 }
 
 // compactorWrap creates and runs a compactor instance.
@@ -58,6 +59,7 @@ func compactorWrap(inCh chan *job, syncer *Syncer) chan *job {
 		task:         syncer.cfg.Name,
 		source:       syncer.cfg.SourceID,
 		addCountFunc: syncer.addCount,
+		memQuota:     syncer.memQuota,
 	}
 	go func() {
 		compactor.run()
@@ -107,6 +109,8 @@ func (c *compactor) run() {
 				insertJob := j.clone()
 				insertJob.tp = insert
 				insertJob.dml = insertDML
+				// the memory quota is released by the delete job
+				insertJob.size = 0
 
 				c.compactJob(delJob)
 				c.compactJob(insertJob)
@@ -154,8 +158,8 @@ func (c *compactor) flushBuffer() {
 // INSERT + INSERT => X			‾|
 // UPDATE + INSERT => X			 |=> DELETE + INSERT => INSERT ON DUPLICATE KEY UPDATE(REPLACE)
 // DELETE + INSERT => REPLACE	_|
-// INSERT + DELETE => DELETE	‾|
-// UPDATE + DELETE => DELETE	 |=> anything + DELETE => DELETE
+// INSERT + DELETE => NOTHING	‾|
+// UPDATE + DELETE => DELETE	 |=> INSERT + DELETE => NOTHING, anything else + DELETE => DELETE
 // DELETE + DELETE => X			_|
 // INSERT + UPDATE => INSERT	‾|
 // UPDATE + UPDATE => UPDATE	 |=> INSERT + UPDATE => INSERT, UPDATE + UPDATE => UPDATE
@@ -213,10 +217,21 @@ func (c *compactor) compactJob(j *job) {
 			j.dml.safeMode = true
 		}
 	case del:
-		// do nothing because anything + DELETE => DELETE
+		// INSERT + DELETE => NOTHING, the row doesn't exist in downstream before the INSERT.
+		// but in safe mode the INSERT may be replayed or come from DELETE + INSERT, so keep the DELETE.
+		if prevJob.tp == insert && !c.safeMode && !prevJob.dml.safeMode && !j.dml.safeMode {
+			c.buffer[prevPos] = nil
+			delete(tableKeyMap, key)
+			c.memQuota.release(prevJob, j)
+			c.logger.Debug("finish to compact", zap.String("dml", "nothing"))
+			c.addCountFunc(true, adminQueueName, compact, 2, prevJob.targetTable)
+			return
+		}
+		// do nothing because anything else + DELETE => DELETE
 	}
 
-	// mark previous job as compacted(nil), add new job
+	// mark previous job as compacted(nil), add new job, the quota of previous job is released with the new job
+	j.size += prevJob.size
 	c.buffer[prevPos] = nil
 	tableKeyMap[key] = len(c.buffer)
 	c.buffer = append(c.buffer, j)
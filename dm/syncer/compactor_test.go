@@ -185,6 +185,52 @@ func (s *testSyncerSuite) TestCompactJob(c *C) {
 	}
 }
 
+func (s *testSyncerSuite) TestCompactInsertDelete(c *C) {
+	location := binlog.NewLocation("")
+	ec := &eventContext{startLocation: &location, currentLocation: &location, lastLocation: &location}
+	sourceTable := &filter.Table{Schema: "test", Name: "tb1"}
+	targetTable := &filter.Table{Schema: "test", Name: "tb"}
+	ti, err := createTableInfo(parser.New(), mock.NewContext(), 0, "create table test.tb(id int primary key, col1 int)")
+	c.Assert(err, IsNil)
+	downTi := schema.GetDownStreamTI(ti, ti)
+	tiIndex := downTi.AvailableUKIndexList[0]
+
+	compacted := int64(0)
+	for _, safeMode := range []bool{false, true} {
+		compactor := &compactor{
+			logger: log.L(),
+			keyMap: make(map[string]map[string]int),
+			addCountFunc: func(_ bool, _ string, _ opType, n int64, _ *filter.Table) {
+				compacted += n
+			},
+		}
+		for _, dml := range []*DML{
+			newDML(insert, safeMode, "`test`.`tb`", sourceTable, nil, []interface{}{1, 1}, nil, []interface{}{1, 1}, ti.Columns, ti, tiIndex, downTi),
+			newDML(insert, safeMode, "`test`.`tb`", sourceTable, nil, []interface{}{2, 2}, nil, []interface{}{2, 2}, ti.Columns, ti, tiIndex, downTi),
+			newDML(del, safeMode, "`test`.`tb`", sourceTable, nil, []interface{}{1, 1}, nil, []interface{}{1, 1}, ti.Columns, ti, tiIndex, downTi),
+		} {
+			compactor.safeMode = safeMode
+			compactor.compactJob(newDMLJob(dml.op, sourceTable, targetTable, dml, ec))
+		}
+
+		var ops []opType
+		for _, j := range compactor.buffer {
+			if j != nil {
+				ops = append(ops, j.tp)
+			}
+		}
+		if safeMode {
+			// the INSERT may be replayed in safe mode, the DELETE is kept
+			c.Assert(ops, DeepEquals, []opType{insert, del})
+			c.Assert(compacted, Equals, int64(3))
+		} else {
+			// INSERT + DELETE => NOTHING
+			c.Assert(ops, DeepEquals, []opType{insert})
+			c.Assert(compacted, Equals, int64(2))
+		}
+	}
+}
+
 func (s *testSyncerSuite) TestCompactorSafeMode(c *C) {
 	location := binlog.NewLocation("")
 	ec := &eventContext{startLocation: &location, currentLocation: &location, lastLocation: &location}
@@ -243,8 +289,8 @@ func (s *testSyncerSuite) TestCompactorSafeMode(c *C) {
 			},
 			output: []*DML{
 				newDML(insert, false, targetTableID, sourceTable, nil, []interface{}{3, 3, "c"}, nil, []interface{}{3, 3, "c"}, ti.Columns, ti, tiIndex, downTi),
-				newDML(insert, true, targetTableID, sourceTable, nil, []interface{}{1, 1, "a"}, nil, []interface{}{1, 1, "a"}, ti.Columns, ti, tiIndex, downTi),
-				newDML(insert, true, targetTableID, sourceTable, nil, []interface{}{2, 2, "c"}, nil, []interface{}{2, 2, "c"}, ti.Columns, ti, tiIndex, downTi),
+				newDML(insert, false, targetTableID, sourceTable, nil, []interface{}{1, 1, "a"}, nil, []interface{}{1, 1, "a"}, ti.Columns, ti, tiIndex, downTi),
+				newDML(insert, false, targetTableID, sourceTable, nil, []interface{}{2, 2, "c"}, nil, []interface{}{2, 2, "c"}, ti.Columns, ti, tiIndex, downTi),
 			},
 		},
 	}
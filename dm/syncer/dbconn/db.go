@@ -58,6 +58,9 @@ func CloseBaseDB(logCtx *tcontext.Context, baseDB *conn.BaseDB) {
 type DBConn struct {
 	Cfg      *config.SubTaskConfig
 	BaseConn *conn.BaseConn
+	// the statements are executed one by one in autocommit mode rather than in one transaction, which is required by
+	// the pipelined DML of TiDB.
+	Autocommit bool
 
 	// generate new BaseConn and close old one
 	ResetBaseConnFn func(*tcontext.Context, *conn.BaseConn) (*conn.BaseConn, error)
@@ -65,14 +68,46 @@ type DBConn struct {
 
 // ResetConn reset one worker connection from specify *BaseDB.
 func (conn *DBConn) ResetConn(tctx *tcontext.Context) error {
+	stmtCache := conn.stmtCache()
 	baseConn, err := conn.ResetBaseConnFn(tctx, conn.BaseConn)
 	if err != nil {
 		return err
 	}
+	// the prepared statements belong to the old connection
+	if stmtCache != nil {
+		stmtCache.Clear()
+		baseConn.StmtCache = stmtCache
+	}
 	conn.BaseConn = baseConn
 	return nil
 }
 
+func (conn *DBConn) stmtCache() *conn.StmtCache {
+	if conn.BaseConn == nil {
+		return nil
+	}
+	return conn.BaseConn.StmtCache
+}
+
+// EnableStmtCache enables the LRU cache of prepared statements for DMLs, which holds at most `capacity` statements.
+func (conn *DBConn) EnableStmtCache(capacity int) {
+	conn.BaseConn.StmtCache = newStmtCache(conn.Cfg, capacity)
+}
+
+func newStmtCache(cfg *config.SubTaskConfig, capacity int) *conn.StmtCache {
+	cache := conn.NewStmtCache(capacity)
+	cache.OnHit = func() {
+		metrics.PreparedStmtCacheTotal.WithLabelValues("hit", cfg.Name, cfg.SourceID).Inc()
+	}
+	cache.OnMiss = func() {
+		metrics.PreparedStmtCacheTotal.WithLabelValues("miss", cfg.Name, cfg.SourceID).Inc()
+	}
+	cache.OnEvict = func() {
+		metrics.PreparedStmtCacheTotal.WithLabelValues("evict", cfg.Name, cfg.SourceID).Inc()
+	}
+	return cache
+}
+
 // QuerySQL does one query.
 func (conn *DBConn) QuerySQL(tctx *tcontext.Context, query string, args ...interface{}) (*sql.Rows, error) {
 	if conn == nil || conn.BaseConn == nil {
@@ -194,12 +229,28 @@ func (conn *DBConn) ExecuteSQLWithIgnore(tctx *tcontext.Context, ignoreError fun
 		},
 	}
 
+	// the number of statements committed in autocommit mode, they're not executed again when retrying.
+	committed := 0
 	ret, _, err := conn.BaseConn.ApplyRetryStrategy(
 		tctx,
 		params,
 		func(ctx *tcontext.Context) (interface{}, error) {
 			startTime := time.Now()
-			ret, err := conn.BaseConn.ExecuteSQLWithIgnoreError(ctx, metrics.StmtHistogram, conn.Cfg.Name, ignoreError, queries, args...)
+			var (
+				ret int
+				err error
+			)
+			if conn.Autocommit {
+				var restArgs [][]interface{}
+				if len(args) > committed {
+					restArgs = args[committed:]
+				}
+				ret, err = conn.BaseConn.ExecuteSQLAutocommit(ctx, metrics.StmtHistogram, conn.Cfg.Name, ignoreError, queries[committed:], restArgs...)
+				committed += ret
+				ret = committed
+			} else {
+				ret, err = conn.BaseConn.ExecuteSQLWithIgnoreError(ctx, metrics.StmtHistogram, conn.Cfg.Name, ignoreError, queries, args...)
+			}
 			if err == nil {
 				cost := time.Since(startTime)
 				// duration seconds
@@ -27,6 +27,7 @@ import (
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
 	parserpkg "github.com/pingcap/tiflow/dm/pkg/parser"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/transcode"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/dm/syncer/metrics"
 )
@@ -36,6 +37,12 @@ func parseOneStmt(qec *queryEventContext) (stmt ast.StmtNode, err error) {
 	// by ParseOneStmt(it's a limitation of tidb parser.)
 	qec.tctx.L().Info("parse ddl", zap.String("event", "query"), zap.Stringer("query event context", qec))
 	stmts, err := parserpkg.Parse(qec.p, qec.originSQL, "", "")
+	if err != nil {
+		// the invisible columns are parsed as normal columns marked by comment
+		if sql, ok := parserpkg.ReplaceInvisibleColumns(qec.p, qec.originSQL); ok {
+			stmts, err = parserpkg.Parse(qec.p, sql, "", "")
+		}
+	}
 	if err != nil {
 		// log error rather than fatal, so other defer can be executed
 		qec.tctx.L().Error("parse ddl", zap.String("event", "query"), zap.Stringer("query event context", qec))
@@ -115,6 +122,9 @@ func (s *Syncer) genDDLInfo(qec *queryEventContext, sql string) (*ddlInfo, error
 		return nil, err
 	}
 
+	// the invisible columns are replicated as normal columns, and they're marked by comment only in the schema tracker
+	invisibleCols := parserpkg.UnmarkInvisibleColumns(stmt)
+
 	targetTables := make([]*filter.Table, 0, len(sourceTables))
 	for i := range sourceTables {
 		renamedTable := s.route(sourceTables[i])
@@ -132,9 +142,17 @@ func (s *Syncer) genDDLInfo(qec *queryEventContext, sql string) (*ddlInfo, error
 	if s.cfg.CollationCompatible == config.StrictCollationCompatible {
 		adjustCollation(s.tctx, ddlInfo, qec.eventStatusVars, s.charsetAndDefaultCollation, s.idAndCollationMap)
 	}
+	// the charset clauses are rewritten after the collation is adjusted, so the added collation of the legacy charset
+	// is rewritten too
+	if rule := s.transcoder.Match(sourceTables[0].Schema, sourceTables[0].Name); rule != nil {
+		if transcode.RewriteDDL(rule, ddlInfo.originStmt) {
+			s.tctx.L().Info("rewrite charset of DDL", zap.String("originSQL", sql), zap.Reflect("rule", rule))
+		}
+	}
 
 	routedDDL, err := parserpkg.RenameDDLTable(ddlInfo.originStmt, ddlInfo.targetTables)
 	ddlInfo.routedDDL = routedDDL
+	parserpkg.MarkInvisibleColumns(invisibleCols)
 	return ddlInfo, err
 }
 
@@ -0,0 +1,162 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// ddlRewriteHookTimeout is the timeout of a request to the DDL rewrite hook.
+var ddlRewriteHookTimeout = 10 * time.Second
+
+// ddlRewriteHookRequest is the body of the POST request sent to the DDL rewrite hook.
+type ddlRewriteHookRequest struct {
+	Task     string   `json:"task"`
+	SourceID string   `json:"source-id"`
+	DDLs     []string `json:"ddls"`
+}
+
+// ddlRewriteHookResponse is the body of the response of the DDL rewrite hook, DDLs[i] is the rewritten DDLs[i] of
+// the request, an empty DDL is skipped.
+type ddlRewriteHookResponse struct {
+	DDLs []string `json:"ddls"`
+}
+
+type ddlRewriteRule struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+// ddlRewriter rewrites the routed DDLs by the regular expressions and then by the DDL rewrite hook.
+type ddlRewriter struct {
+	task     string
+	sourceID string
+	rules    []*ddlRewriteRule
+	hook     string
+	client   *http.Client
+}
+
+// newDDLRewriter creates a ddlRewriter, returns nil if the DDLs are not rewritten.
+func newDDLRewriter(cfg *config.SubTaskConfig) (*ddlRewriter, error) {
+	if !cfg.EnableDDLRewrite() {
+		return nil, nil
+	}
+	r := &ddlRewriter{
+		task:     cfg.Name,
+		sourceID: cfg.SourceID,
+		rules:    make([]*ddlRewriteRule, 0, len(cfg.DDLRewriteRules)),
+		hook:     cfg.DDLRewriteHook,
+		client:   &http.Client{Timeout: ddlRewriteHookTimeout},
+	}
+	for _, rule := range cfg.DDLRewriteRules {
+		pattern, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return nil, terror.ErrConfigInvalidDDLRewrite.Generate("ddl-rewrite-rules pattern", rule.Pattern, err.Error())
+		}
+		r.rules = append(r.rules, &ddlRewriteRule{pattern: pattern, replacement: rule.Replacement})
+	}
+	return r, nil
+}
+
+// rewrite returns the rewritten DDLs, the result has the same length as ddls and an empty DDL should be skipped.
+func (r *ddlRewriter) rewrite(tctx *tcontext.Context, ddls []string) ([]string, error) {
+	rewritten := make([]string, 0, len(ddls))
+	for _, ddl := range ddls {
+		for _, rule := range r.rules {
+			ddl = rule.pattern.ReplaceAllString(ddl, rule.replacement)
+		}
+		rewritten = append(rewritten, strings.TrimSpace(ddl))
+	}
+	if r.hook != "" {
+		var err error
+		rewritten, err = r.callHook(tctx.Context(), rewritten)
+		if err != nil {
+			return nil, err
+		}
+	}
+	tctx.L().Info("rewrite DDLs", zap.Strings("DDLs", ddls), zap.Strings("rewritten DDLs", rewritten))
+	return rewritten, nil
+}
+
+func (r *ddlRewriter) callHook(ctx context.Context, ddls []string) ([]string, error) {
+	body, err := json.Marshal(&ddlRewriteHookRequest{Task: r.task, SourceID: r.sourceID, DDLs: ddls})
+	if err != nil {
+		return nil, terror.ErrSyncerDDLRewriteHook.Delegate(err, ddls, r.hook)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.hook, bytes.NewReader(body))
+	if err != nil {
+		return nil, terror.ErrSyncerDDLRewriteHook.Delegate(err, ddls, r.hook)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, terror.ErrSyncerDDLRewriteHook.Delegate(err, ddls, r.hook)
+	}
+	defer resp.Body.Close()
+
+	body, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, terror.ErrSyncerDDLRewriteHook.Delegate(err, ddls, r.hook)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, terror.ErrSyncerDDLRewriteHook.Delegate(
+			errors.Errorf("unexpected status %s: %s", resp.Status, body), ddls, r.hook)
+	}
+	var result ddlRewriteHookResponse
+	if err = json.Unmarshal(body, &result); err != nil {
+		return nil, terror.ErrSyncerDDLRewriteHook.Delegate(err, ddls, r.hook)
+	}
+	if len(result.DDLs) != len(ddls) {
+		return nil, terror.ErrSyncerDDLRewriteHook.Delegate(
+			errors.Errorf("expect %d DDLs in response, got %d", len(ddls), len(result.DDLs)), ddls, r.hook)
+	}
+	for i := range result.DDLs {
+		result.DDLs[i] = strings.TrimSpace(result.DDLs[i])
+	}
+	return result.DDLs, nil
+}
+
+// rewriteDDLs rewrites the DDLs before they're applied, the result has the same length as ddls and an empty DDL
+// should be skipped.
+func (s *Syncer) rewriteDDLs(tctx *tcontext.Context, ddls []string) ([]string, error) {
+	if s.ddlRewriter == nil {
+		return ddls, nil
+	}
+	return s.ddlRewriter.rewrite(tctx, ddls)
+}
+
+// removeSkippedDDLs removes the DDLs rewritten to empty.
+func removeSkippedDDLs(ddls []string) []string {
+	ret := make([]string, 0, len(ddls))
+	for _, ddl := range ddls {
+		if ddl != "" {
+			ret = append(ret, ddl)
+		}
+	}
+	return ret
+}
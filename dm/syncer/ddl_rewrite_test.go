@@ -0,0 +1,96 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+func (s *testSyncerSuite) TestDDLRewriter(c *C) {
+	tctx := tcontext.Background()
+	cfg := &config.SubTaskConfig{Name: "test", SourceID: "source"}
+	r, err := newDDLRewriter(cfg)
+	c.Assert(err, IsNil)
+	c.Assert(r, IsNil)
+
+	cfg.DDLRewriteRules = []*config.DDLRewriteRule{
+		{Pattern: `(?i)\s+ENGINE\s*=\s*MyISAM`, Replacement: " ENGINE = InnoDB"},
+		{Pattern: `(?i)^ALTER TABLE .* DISCARD TABLESPACE$`, Replacement: ""},
+		{Pattern: "COMMENT '(.*)'", Replacement: "COMMENT '${1}, rewritten'"},
+	}
+	r, err = newDDLRewriter(cfg)
+	c.Assert(err, IsNil)
+	ddls := []string{
+		"CREATE TABLE `db`.`t` (`id` INT PRIMARY KEY) ENGINE=MyISAM COMMENT 'test'",
+		"ALTER TABLE `db`.`t` DISCARD TABLESPACE",
+		"ALTER TABLE `db`.`t` ADD COLUMN `c` INT",
+	}
+	rewritten, err := r.rewrite(tctx, ddls)
+	c.Assert(err, IsNil)
+	c.Assert(rewritten, DeepEquals, []string{
+		"CREATE TABLE `db`.`t` (`id` INT PRIMARY KEY) ENGINE = InnoDB COMMENT 'test, rewritten'",
+		"",
+		"ALTER TABLE `db`.`t` ADD COLUMN `c` INT",
+	})
+	c.Assert(removeSkippedDDLs(rewritten), DeepEquals, []string{rewritten[0], rewritten[2]})
+
+	// the hook rewrites the DDLs after the rules
+	var (
+		received ddlRewriteHookRequest
+		response = &ddlRewriteHookResponse{}
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, http.MethodPost)
+		c.Assert(json.NewDecoder(req.Body).Decode(&received), IsNil)
+		if response == nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		c.Assert(json.NewEncoder(w).Encode(response), IsNil)
+	}))
+	defer server.Close()
+
+	cfg.DDLRewriteRules = cfg.DDLRewriteRules[:1]
+	cfg.DDLRewriteHook = server.URL
+	r, err = newDDLRewriter(cfg)
+	c.Assert(err, IsNil)
+	response.DDLs = []string{"CREATE TABLE `db`.`t` (`id` INT PRIMARY KEY)", " "}
+	rewritten, err = r.rewrite(tctx, ddls[:2])
+	c.Assert(err, IsNil)
+	c.Assert(rewritten, DeepEquals, []string{"CREATE TABLE `db`.`t` (`id` INT PRIMARY KEY)", ""})
+	c.Assert(received, DeepEquals, ddlRewriteHookRequest{
+		Task:     "test",
+		SourceID: "source",
+		DDLs:     []string{"CREATE TABLE `db`.`t` (`id` INT PRIMARY KEY) ENGINE = InnoDB COMMENT 'test'", ddls[1]},
+	})
+
+	// the number of DDLs can't be changed
+	_, err = r.rewrite(tctx, ddls)
+	c.Assert(terror.ErrSyncerDDLRewriteHook.Equal(err), IsTrue)
+	c.Assert(err, ErrorMatches, ".*expect 3 DDLs in response, got 2.*")
+
+	response = nil
+	_, err = r.rewrite(tctx, ddls)
+	c.Assert(terror.ErrSyncerDDLRewriteHook.Equal(err), IsTrue)
+	c.Assert(strings.Contains(err.Error(), "500"), IsTrue)
+}
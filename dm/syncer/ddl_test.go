@@ -22,7 +22,6 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	. "github.com/pingcap/check"
 	"github.com/pingcap/tidb-tools/pkg/filter"
-	router "github.com/pingcap/tidb-tools/pkg/table-router"
 	"github.com/pingcap/tidb/parser"
 	"github.com/pingcap/tidb/parser/ast"
 	"go.uber.org/zap"
@@ -32,6 +31,7 @@ import (
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	parserpkg "github.com/pingcap/tiflow/dm/pkg/parser"
+	"github.com/pingcap/tiflow/dm/pkg/router"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	onlineddl "github.com/pingcap/tiflow/dm/syncer/online-ddl-tools"
@@ -574,6 +574,74 @@ func (s *testDDLSuite) TestMistakeOnlineDDLRegex(c *C) {
 	cluster.Stop()
 }
 
+func (s *testDDLSuite) TestOnlineDDLTools(c *C) {
+	cases := []struct {
+		tool      string
+		trashName string
+		ghostName string
+	}{
+		{onlineddl.ToolSpirit, "_t1_old", "_t1_new"},
+		{onlineddl.ToolFBOSC, "__osc_old_t1", "__osc_new_t1"},
+		{onlineddl.ToolLHM, "lhma_2022_01_02_03_04_05_006_t1", "lhmn_t1"},
+	}
+	tctx := tcontext.Background().WithLogger(log.With(zap.String("test", "TestOnlineDDLTools")))
+	p := parser.New()
+
+	ec := &eventContext{tctx: tctx}
+	cluster, err := conn.NewCluster()
+	c.Assert(err, IsNil)
+	c.Assert(cluster.Start(), IsNil)
+	defer cluster.Stop()
+	dbCfg := config.GetDBConfigForTest()
+	dbCfg.Port = cluster.Port
+	dbCfg.Password = ""
+	cfg := s.newSubTaskCfg(dbCfg)
+
+	cfg.OnlineDDLTools = []string{"not-exist"}
+	_, err = onlineddl.NewRealOnlinePlugin(tctx, cfg)
+	c.Assert(terror.ErrConfigOnlineDDLToolNotSupport.Equal(err), IsTrue)
+
+	applyDDL := func(syncer *Syncer, sql string) []string {
+		qec := &queryEventContext{
+			eventContext: ec,
+			ddlSchema:    "test",
+			appliedDDLs:  make([]string, 0),
+			p:            p,
+			originSQL:    sql,
+		}
+		stmt, err2 := parseOneStmt(qec)
+		c.Assert(err2, IsNil)
+		qec.splitDDLs, err2 = parserpkg.SplitDDL(stmt, qec.ddlSchema)
+		c.Assert(err2, IsNil)
+		for _, splitDDL := range qec.splitDDLs {
+			sqls, err2 := syncer.processOneDDL(qec, splitDDL)
+			c.Assert(err2, IsNil)
+			qec.appliedDDLs = append(qec.appliedDDLs, sqls...)
+		}
+		return qec.appliedDDLs
+	}
+
+	for _, ca := range cases {
+		cfg.OnlineDDLTools = []string{ca.tool}
+		plugin, err := onlineddl.NewRealOnlinePlugin(tctx, cfg)
+		c.Assert(err, IsNil)
+		syncer := NewSyncer(cfg, nil, nil)
+		syncer.tctx = tctx
+		syncer.onlineDDL = plugin
+		c.Assert(plugin.Clear(tctx), IsNil)
+		c.Assert(syncer.genRouter(), IsNil)
+		c.Assert(plugin.TableType(ca.ghostName), Equals, onlineddl.GhostTable)
+		c.Assert(plugin.TableType(ca.trashName), Equals, onlineddl.TrashTable)
+
+		// the DDLs of the intermediate tables are skipped
+		c.Assert(applyDDL(syncer, fmt.Sprintf("CREATE TABLE IF NOT EXISTS `test`.`%s` (`n` INT)", ca.trashName)), HasLen, 0)
+		c.Assert(applyDDL(syncer, fmt.Sprintf("ALTER TABLE `test`.`%s` ADD COLUMN `n` INT", ca.ghostName)), HasLen, 0)
+		// the cutover is collapsed into the DDL of the real table
+		sqls := applyDDL(syncer, fmt.Sprintf("RENAME TABLE `test`.`t1` TO `test`.`%s`, `test`.`%s` TO `test`.`t1`", ca.trashName, ca.ghostName))
+		c.Assert(sqls, DeepEquals, []string{"ALTER TABLE `test`.`t1` ADD COLUMN `n` INT"})
+	}
+}
+
 func (s *testDDLSuite) TestDropSchemaInSharding(c *C) {
 	var (
 		targetTable = &filter.Table{
@@ -0,0 +1,176 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+)
+
+// sqlDialect generates the statements executed in the downstream database.
+type sqlDialect interface {
+	// tableName returns the quoted name of a table.
+	tableName(schema, table string) string
+	// quoteName returns the quoted name of a schema or column.
+	quoteName(name string) string
+	// rebind rewrites the `?` placeholders of a statement generated by DM to the placeholders of the dialect.
+	rebind(query string) string
+	// genDMLSQL generates the statements and their arguments of a DML.
+	genDMLSQL(dml *DML) ([]string, [][]interface{})
+	// convertDDLs converts the routed DDLs of the upstream to the statements of the downstream.
+	convertDDLs(ddls []string) ([]string, error)
+	// checkpointTableSQL returns the statement to create the checkpoint table.
+	checkpointTableSQL(tableName string) string
+	// checkpointUpsertSQL returns the statement to insert or update a row of the checkpoint table.
+	checkpointUpsertSQL(tableName string) string
+	// ddlHistoryTableSQL returns the statement to create the table recording the rewritten DDLs.
+	ddlHistoryTableSQL(tableName string) string
+	// gtidSkipListTableSQL returns the statement to create the table recording the GTIDs of the transactions to skip.
+	gtidSkipListTableSQL(tableName string) string
+	// schemaSnapshotTableSQL returns the statement to create the table recording the snapshot of table infos.
+	schemaSnapshotTableSQL(tableName string) string
+	// rulesTableSQL returns the statement to create the table recording the versions of the hot reloaded rules.
+	rulesTableSQL(tableName string) string
+	// pausedTablesTableSQL returns the statement to create the table recording the locations of the paused tables.
+	pausedTablesTableSQL(tableName string) string
+}
+
+// newSQLDialect returns the dialect of the database.
+func newSQLDialect(cfg *config.DBConfig) sqlDialect {
+	if cfg.IsPostgres() {
+		return postgresDialect{}
+	}
+	return mysqlDialect{}
+}
+
+// mysqlDialect is the dialect of MySQL and TiDB.
+type mysqlDialect struct{}
+
+func (mysqlDialect) tableName(schema, table string) string {
+	return dbutil.TableName(schema, table)
+}
+
+func (mysqlDialect) quoteName(name string) string {
+	return dbutil.ColumnName(name)
+}
+
+func (mysqlDialect) rebind(query string) string {
+	return query
+}
+
+func (mysqlDialect) genDMLSQL(dml *DML) ([]string, [][]interface{}) {
+	return dml.genSQL()
+}
+
+func (mysqlDialect) convertDDLs(ddls []string) ([]string, error) {
+	return ddls, nil
+}
+
+func (mysqlDialect) checkpointTableSQL(tableName string) string {
+	return `CREATE TABLE IF NOT EXISTS ` + tableName + ` (
+			id VARCHAR(32) NOT NULL,
+			cp_schema VARCHAR(128) NOT NULL,
+			cp_table VARCHAR(128) NOT NULL,
+			binlog_name VARCHAR(128),
+			binlog_pos INT UNSIGNED,
+			binlog_gtid TEXT,
+			exit_safe_binlog_name VARCHAR(128) DEFAULT '',
+			exit_safe_binlog_pos INT UNSIGNED DEFAULT 0,
+			exit_safe_binlog_gtid TEXT,
+			table_info JSON NOT NULL,
+			is_global BOOLEAN,
+			create_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			update_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+			UNIQUE KEY uk_id_schema_table (id, cp_schema, cp_table)
+		)`
+}
+
+func (mysqlDialect) checkpointUpsertSQL(tableName string) string {
+	// use `INSERT INTO ... ON DUPLICATE KEY UPDATE` rather than `REPLACE INTO`
+	// to keep `create_time`, `update_time` correctly
+	return `INSERT INTO ` + tableName + `
+		(id, cp_schema, cp_table, binlog_name, binlog_pos, binlog_gtid, exit_safe_binlog_name, exit_safe_binlog_pos, exit_safe_binlog_gtid, table_info, is_global) VALUES
+		(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			binlog_name = VALUES(binlog_name),
+			binlog_pos = VALUES(binlog_pos),
+			binlog_gtid = VALUES(binlog_gtid),
+			exit_safe_binlog_name = VALUES(exit_safe_binlog_name),
+			exit_safe_binlog_pos = VALUES(exit_safe_binlog_pos),
+			exit_safe_binlog_gtid = VALUES(exit_safe_binlog_gtid),
+			table_info = VALUES(table_info),
+			is_global = VALUES(is_global);
+	`
+}
+
+func (mysqlDialect) ddlHistoryTableSQL(tableName string) string {
+	return `CREATE TABLE IF NOT EXISTS ` + tableName + ` (
+			id VARCHAR(32) NOT NULL,
+			binlog_name VARCHAR(128),
+			binlog_pos INT UNSIGNED,
+			binlog_gtid TEXT,
+			origin_ddl TEXT,
+			applied_ddl TEXT,
+			create_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			INDEX idx_id (id)
+		)`
+}
+
+func (mysqlDialect) gtidSkipListTableSQL(tableName string) string {
+	return `CREATE TABLE IF NOT EXISTS ` + tableName + ` (
+			id VARCHAR(32) NOT NULL,
+			gtid_set TEXT,
+			update_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (id)
+		)`
+}
+
+func (mysqlDialect) schemaSnapshotTableSQL(tableName string) string {
+	return `CREATE TABLE IF NOT EXISTS ` + tableName + ` (
+			id VARCHAR(32) NOT NULL,
+			binlog_name VARCHAR(128),
+			binlog_pos INT UNSIGNED,
+			binlog_gtid TEXT,
+			table_infos LONGBLOB NOT NULL,
+			update_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (id)
+		)`
+}
+
+func (mysqlDialect) rulesTableSQL(tableName string) string {
+	return `CREATE TABLE IF NOT EXISTS ` + tableName + ` (
+			id VARCHAR(32) NOT NULL,
+			version BIGINT NOT NULL,
+			binlog_name VARCHAR(128),
+			binlog_pos INT UNSIGNED,
+			binlog_gtid TEXT,
+			rules MEDIUMTEXT NOT NULL,
+			create_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (id, version)
+		)`
+}
+
+func (mysqlDialect) pausedTablesTableSQL(tableName string) string {
+	return `CREATE TABLE IF NOT EXISTS ` + tableName + ` (
+			id VARCHAR(32) NOT NULL,
+			table_schema VARCHAR(128) NOT NULL,
+			table_name VARCHAR(128) NOT NULL,
+			binlog_name VARCHAR(128),
+			binlog_pos INT UNSIGNED,
+			binlog_gtid TEXT,
+			create_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (id, table_schema, table_name)
+		)`
+}
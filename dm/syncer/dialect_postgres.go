@@ -0,0 +1,786 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/charset"
+	"github.com/pingcap/tidb/parser/format"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+// postgresDialect is the dialect of PostgreSQL.
+// - identifiers are quoted by `"` and placeholders are `$n`.
+// - `REPLACE` in safe-mode is generated as `INSERT ... ON CONFLICT ... DO UPDATE` on a unique key.
+// - `UPDATE` and `DELETE` have no `LIMIT`, a row of tables without unique key is located by `ctid`.
+// - DDLs are converted to PostgreSQL statements, MySQL types are mapped to the closest PostgreSQL types.
+type postgresDialect struct{}
+
+func (d postgresDialect) tableName(schema, table string) string {
+	return d.quoteName(schema) + "." + d.quoteName(table)
+}
+
+func (postgresDialect) quoteName(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func (postgresDialect) rebind(query string) string {
+	var (
+		buf strings.Builder
+		n   int
+	)
+	buf.Grow(len(query) + 16)
+	for i := 0; i < len(query); i++ {
+		if query[i] != '?' {
+			buf.WriteByte(query[i])
+			continue
+		}
+		n++
+		buf.WriteString("$" + strconv.Itoa(n))
+	}
+	return buf.String()
+}
+
+// targetTableName returns the quoted name of the table ID like "`db`.`tb`".
+func (d postgresDialect) targetTableName(tableID string) string {
+	table := utils.UnpackTableID(tableID)
+	return d.tableName(strings.ReplaceAll(table.Schema, "``", "`"), strings.ReplaceAll(table.Name, "``", "`"))
+}
+
+// postgresArgs collects the arguments of a statement and returns their placeholders.
+type postgresArgs []interface{}
+
+func (a *postgresArgs) add(value interface{}, ft *types.FieldType) string {
+	*a = append(*a, postgresValue(value, ft))
+	return "$" + strconv.Itoa(len(*a))
+}
+
+// postgresValue adapts a value of the binlog to the column type mapped in PostgreSQL.
+func postgresValue(value interface{}, ft *types.FieldType) interface{} {
+	switch ft.Tp {
+	case mysql.TypeEnum:
+		if idx, ok := value.(int64); ok {
+			if idx <= 0 || int(idx) > len(ft.Elems) {
+				return ""
+			}
+			return ft.Elems[idx-1]
+		}
+	case mysql.TypeSet:
+		if bits, ok := value.(int64); ok {
+			elems := make([]string, 0, len(ft.Elems))
+			for i, elem := range ft.Elems {
+				if bits&(1<<uint(i)) != 0 {
+					elems = append(elems, elem)
+				}
+			}
+			return strings.Join(elems, ",")
+		}
+	}
+
+	switch v := value.(type) {
+	case uint64:
+		// the driver can't send an uint64 with the high bit set, it's mapped to NUMERIC(20).
+		if v > math.MaxInt64 {
+			return strconv.FormatUint(v, 10)
+		}
+		return int64(v)
+	case []byte:
+		// only binary strings are mapped to BYTEA, others are texts.
+		if ft.Tp == mysql.TypeJSON || ft.Charset != charset.CharsetBin {
+			return string(v)
+		}
+	}
+	return value
+}
+
+func (d postgresDialect) genDMLSQL(dml *DML) ([]string, [][]interface{}) {
+	switch dml.op {
+	case insert:
+		return d.genInsertSQL(dml)
+	case del:
+		return d.genDeleteSQL(dml)
+	case update:
+		if dml.safeMode {
+			sqls, args := d.genDeleteSQL(dml)
+			insertSQLs, insertArgs := d.genInsertSQL(dml)
+			return append(sqls, insertSQLs...), append(args, insertArgs...)
+		}
+		return d.genUpdateSQL(dml)
+	}
+	return nil, nil
+}
+
+// genWhere writes the `WHERE` condition, a row of tables without unique key is located by `ctid` because there's no
+// `LIMIT` for `UPDATE` and `DELETE` in PostgreSQL.
+func (d postgresDialect) genWhere(buf *strings.Builder, dml *DML, args *postgresArgs) {
+	columns, values := dml.sourceTableInfo.Columns, dml.originValues
+	if dml.op == update {
+		values = dml.originOldValues
+	}
+	if dml.pickedDownstreamIndexInfo != nil {
+		columns, values = getColumnData(dml.sourceTableInfo.Columns, dml.pickedDownstreamIndexInfo, values)
+	}
+
+	var cond strings.Builder
+	for i, column := range columns {
+		if i != 0 {
+			cond.WriteString(" AND ")
+		}
+		cond.WriteString(d.quoteName(column.Name.O))
+		if values[i] == nil {
+			cond.WriteString(" IS NULL")
+		} else {
+			cond.WriteString(" = " + args.add(values[i], &column.FieldType))
+		}
+	}
+
+	buf.WriteString(" WHERE ")
+	if dml.pickedDownstreamIndexInfo != nil {
+		buf.WriteString(cond.String())
+		return
+	}
+	fmt.Fprintf(buf, "ctid = (SELECT ctid FROM %s WHERE %s LIMIT 1)", d.targetTableName(dml.targetTableID), cond.String())
+}
+
+func (d postgresDialect) genUpdateSQL(dml *DML) ([]string, [][]interface{}) {
+	var (
+		buf  strings.Builder
+		args postgresArgs
+	)
+	buf.Grow(2048)
+	buf.WriteString("UPDATE " + d.targetTableName(dml.targetTableID) + " SET ")
+	for i, column := range dml.columns {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(d.quoteName(column.Name.O) + " = " + args.add(dml.values[i], &column.FieldType))
+	}
+	d.genWhere(&buf, dml, &args)
+	return []string{buf.String()}, [][]interface{}{args}
+}
+
+func (d postgresDialect) genDeleteSQL(dml *DML) ([]string, [][]interface{}) {
+	var (
+		buf  strings.Builder
+		args postgresArgs
+	)
+	buf.Grow(1024)
+	buf.WriteString("DELETE FROM " + d.targetTableName(dml.targetTableID))
+	d.genWhere(&buf, dml, &args)
+	return []string{buf.String()}, [][]interface{}{args}
+}
+
+// genInsertSQL generates an `INSERT`, in safe-mode the row is updated on conflict of the primary key or a unique key.
+func (d postgresDialect) genInsertSQL(dml *DML) ([]string, [][]interface{}) {
+	var (
+		buf  strings.Builder
+		args postgresArgs
+	)
+	buf.Grow(1024)
+	buf.WriteString("INSERT INTO " + d.targetTableName(dml.targetTableID) + " (")
+	for i, column := range dml.columns {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(d.quoteName(column.Name.O))
+	}
+	buf.WriteString(") VALUES (")
+	for i, column := range dml.columns {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(args.add(dml.values[i], &column.FieldType))
+	}
+	buf.WriteByte(')')
+
+	if dml.safeMode {
+		d.genOnConflict(&buf, dml)
+	}
+	return []string{buf.String()}, [][]interface{}{args}
+}
+
+func (d postgresDialect) genOnConflict(buf *strings.Builder, dml *DML) {
+	index := dml.downstreamTableInfo.AbsoluteUKIndexInfo
+	if index == nil && len(dml.downstreamTableInfo.AvailableUKIndexList) > 0 {
+		index = dml.downstreamTableInfo.AvailableUKIndexList[0]
+	}
+	if index == nil {
+		// no row can conflict with the new row.
+		return
+	}
+
+	keys := make(map[string]struct{}, len(index.Columns))
+	buf.WriteString(" ON CONFLICT (")
+	for i, column := range index.Columns {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(d.quoteName(column.Name.O))
+		keys[column.Name.L] = struct{}{}
+	}
+	buf.WriteString(") DO ")
+
+	updated := 0
+	for _, column := range dml.columns {
+		if _, ok := keys[column.Name.L]; ok {
+			continue
+		}
+		if updated == 0 {
+			buf.WriteString("UPDATE SET ")
+		} else {
+			buf.WriteByte(',')
+		}
+		name := d.quoteName(column.Name.O)
+		buf.WriteString(name + " = EXCLUDED." + name)
+		updated++
+	}
+	if updated == 0 {
+		buf.WriteString("NOTHING")
+	}
+}
+
+func (postgresDialect) checkpointTableSQL(tableName string) string {
+	return `CREATE TABLE IF NOT EXISTS ` + tableName + ` (
+			id VARCHAR(32) NOT NULL,
+			cp_schema VARCHAR(128) NOT NULL,
+			cp_table VARCHAR(128) NOT NULL,
+			binlog_name VARCHAR(128),
+			binlog_pos BIGINT,
+			binlog_gtid TEXT,
+			exit_safe_binlog_name VARCHAR(128) DEFAULT '',
+			exit_safe_binlog_pos BIGINT DEFAULT 0,
+			exit_safe_binlog_gtid TEXT,
+			table_info JSONB NOT NULL,
+			is_global BOOLEAN,
+			create_time TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			update_time TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			UNIQUE (id, cp_schema, cp_table)
+		)`
+}
+
+func (postgresDialect) checkpointUpsertSQL(tableName string) string {
+	return `INSERT INTO ` + tableName + `
+		(id, cp_schema, cp_table, binlog_name, binlog_pos, binlog_gtid, exit_safe_binlog_name, exit_safe_binlog_pos, exit_safe_binlog_gtid, table_info, is_global) VALUES
+		($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (id, cp_schema, cp_table) DO UPDATE SET
+			binlog_name = EXCLUDED.binlog_name,
+			binlog_pos = EXCLUDED.binlog_pos,
+			binlog_gtid = EXCLUDED.binlog_gtid,
+			exit_safe_binlog_name = EXCLUDED.exit_safe_binlog_name,
+			exit_safe_binlog_pos = EXCLUDED.exit_safe_binlog_pos,
+			exit_safe_binlog_gtid = EXCLUDED.exit_safe_binlog_gtid,
+			table_info = EXCLUDED.table_info,
+			is_global = EXCLUDED.is_global,
+			update_time = CURRENT_TIMESTAMP
+	`
+}
+
+func (postgresDialect) ddlHistoryTableSQL(tableName string) string {
+	return `CREATE TABLE IF NOT EXISTS ` + tableName + ` (
+			id VARCHAR(32) NOT NULL,
+			binlog_name VARCHAR(128),
+			binlog_pos BIGINT,
+			binlog_gtid TEXT,
+			origin_ddl TEXT,
+			applied_ddl TEXT,
+			create_time TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`
+}
+
+func (postgresDialect) gtidSkipListTableSQL(tableName string) string {
+	return `CREATE TABLE IF NOT EXISTS ` + tableName + ` (
+			id VARCHAR(32) NOT NULL,
+			gtid_set TEXT,
+			update_time TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (id)
+		)`
+}
+
+func (postgresDialect) schemaSnapshotTableSQL(tableName string) string {
+	return `CREATE TABLE IF NOT EXISTS ` + tableName + ` (
+			id VARCHAR(32) NOT NULL,
+			binlog_name VARCHAR(128),
+			binlog_pos BIGINT,
+			binlog_gtid TEXT,
+			table_infos BYTEA NOT NULL,
+			update_time TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (id)
+		)`
+}
+
+func (postgresDialect) rulesTableSQL(tableName string) string {
+	return `CREATE TABLE IF NOT EXISTS ` + tableName + ` (
+			id VARCHAR(32) NOT NULL,
+			version BIGINT NOT NULL,
+			binlog_name VARCHAR(128),
+			binlog_pos BIGINT,
+			binlog_gtid TEXT,
+			rules TEXT NOT NULL,
+			create_time TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (id, version)
+		)`
+}
+
+func (postgresDialect) pausedTablesTableSQL(tableName string) string {
+	return `CREATE TABLE IF NOT EXISTS ` + tableName + ` (
+			id VARCHAR(32) NOT NULL,
+			table_schema VARCHAR(128) NOT NULL,
+			table_name VARCHAR(128) NOT NULL,
+			binlog_name VARCHAR(128),
+			binlog_pos BIGINT,
+			binlog_gtid TEXT,
+			create_time TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (id, table_schema, table_name)
+		)`
+}
+
+// convertDDLs converts the routed DDLs to PostgreSQL, only the DDLs of schemas, tables, columns and indexes are
+// supported. the options only meaningful for MySQL like charset, comment and engine are ignored.
+func (d postgresDialect) convertDDLs(ddls []string) ([]string, error) {
+	p := parser.New()
+	converted := make([]string, 0, len(ddls))
+	for _, ddl := range ddls {
+		stmt, err := p.ParseOneStmt(ddl, "", "")
+		if err != nil {
+			return nil, terror.ErrSyncerParseDDL.Delegate(err, ddl)
+		}
+		sqls, err := d.convertDDL(stmt)
+		if err != nil {
+			return nil, terror.ErrSyncerUnsupportedDDLForPostgres.Generate(ddl, err.Error())
+		}
+		converted = append(converted, sqls...)
+	}
+	return converted, nil
+}
+
+func (d postgresDialect) convertDDL(stmt ast.StmtNode) ([]string, error) {
+	switch v := stmt.(type) {
+	case *ast.CreateDatabaseStmt:
+		return []string{"CREATE SCHEMA IF NOT EXISTS " + d.quoteName(v.Name)}, nil
+	case *ast.AlterDatabaseStmt:
+		// only charset and collation can be changed.
+		return nil, nil
+	case *ast.DropDatabaseStmt:
+		return []string{"DROP SCHEMA IF EXISTS " + d.quoteName(v.Name) + " CASCADE"}, nil
+	case *ast.CreateTableStmt:
+		return d.convertCreateTable(v)
+	case *ast.DropTableStmt:
+		if v.IsView {
+			return nil, fmt.Errorf("views are not supported")
+		}
+		names := make([]string, 0, len(v.Tables))
+		for _, table := range v.Tables {
+			names = append(names, d.tableName(table.Schema.O, table.Name.O))
+		}
+		return []string{"DROP TABLE IF EXISTS " + strings.Join(names, ", ")}, nil
+	case *ast.TruncateTableStmt:
+		return []string{"TRUNCATE TABLE " + d.tableName(v.Table.Schema.O, v.Table.Name.O)}, nil
+	case *ast.RenameTableStmt:
+		sqls := make([]string, 0, len(v.TableToTables))
+		for _, t2t := range v.TableToTables {
+			sqls = append(sqls, d.renameTable(t2t.OldTable, t2t.NewTable)...)
+		}
+		return sqls, nil
+	case *ast.AlterTableStmt:
+		return d.convertAlterTable(v)
+	case *ast.CreateIndexStmt:
+		if v.KeyType != ast.IndexKeyTypeNone && v.KeyType != ast.IndexKeyTypeUnique {
+			return nil, fmt.Errorf("index type is not supported")
+		}
+		return d.createIndex(v.Table, v.IndexName, v.KeyType == ast.IndexKeyTypeUnique, v.IndexPartSpecifications)
+	case *ast.DropIndexStmt:
+		return []string{d.dropIndex(v.Table, v.IndexName)}, nil
+	}
+	return nil, fmt.Errorf("statement type is not supported")
+}
+
+// indexName returns the name of an index in PostgreSQL, the names of indexes are unique in a schema rather than a
+// table in PostgreSQL, so the table name is prefixed.
+func (d postgresDialect) indexName(table *ast.TableName, index string) string {
+	return d.quoteName(table.Name.O + "_" + index)
+}
+
+func (d postgresDialect) createIndex(table *ast.TableName, index string, unique bool, keys []*ast.IndexPartSpecification) ([]string, error) {
+	columns, err := d.indexColumns(keys, unique)
+	if err != nil {
+		return nil, err
+	}
+	if index == "" {
+		// same as the default index name of MySQL.
+		index = keys[0].Column.Name.O
+	}
+	createIndex := "CREATE INDEX IF NOT EXISTS "
+	if unique {
+		createIndex = "CREATE UNIQUE INDEX IF NOT EXISTS "
+	}
+	return []string{createIndex + d.indexName(table, index) + " ON " + d.tableName(table.Schema.O, table.Name.O) + " (" + columns + ")"}, nil
+}
+
+func (d postgresDialect) dropIndex(table *ast.TableName, index string) string {
+	return "DROP INDEX IF EXISTS " + d.quoteName(table.Schema.O) + "." + d.indexName(table, index)
+}
+
+// indexColumns returns the columns of an index, the prefix of columns is dropped for non-unique indexes.
+func (d postgresDialect) indexColumns(keys []*ast.IndexPartSpecification, unique bool) (string, error) {
+	columns := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if key.Expr != nil {
+			return "", fmt.Errorf("expression index is not supported")
+		}
+		if key.Length > 0 && unique {
+			return "", fmt.Errorf("unique index on column prefix is not supported")
+		}
+		columns = append(columns, d.quoteName(key.Column.Name.O))
+	}
+	return strings.Join(columns, ","), nil
+}
+
+func (d postgresDialect) renameTable(oldTable, newTable *ast.TableName) []string {
+	sqls := make([]string, 0, 2)
+	name := d.tableName(oldTable.Schema.O, oldTable.Name.O)
+	if oldTable.Schema.O != newTable.Schema.O {
+		sqls = append(sqls, "ALTER TABLE "+name+" SET SCHEMA "+d.quoteName(newTable.Schema.O))
+		name = d.tableName(newTable.Schema.O, oldTable.Name.O)
+	}
+	if oldTable.Name.O != newTable.Name.O {
+		sqls = append(sqls, "ALTER TABLE "+name+" RENAME TO "+d.quoteName(newTable.Name.O))
+	}
+	return sqls
+}
+
+func (d postgresDialect) convertCreateTable(stmt *ast.CreateTableStmt) ([]string, error) {
+	switch {
+	case stmt.ReferTable != nil:
+		return nil, fmt.Errorf("CREATE TABLE LIKE is not supported")
+	case stmt.Select != nil:
+		return nil, fmt.Errorf("CREATE TABLE SELECT is not supported")
+	case stmt.TemporaryKeyword != ast.TemporaryNone:
+		return nil, fmt.Errorf("temporary table is not supported")
+	}
+
+	var (
+		defs    = make([]string, 0, len(stmt.Cols)+1)
+		indexes []*ast.Constraint
+	)
+	for _, col := range stmt.Cols {
+		def, colIndexes, err := d.columnDef(col)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, def)
+		indexes = append(indexes, colIndexes...)
+	}
+	indexes = append(indexes, stmt.Constraints...)
+
+	sqls := make([]string, 1, len(indexes)+1)
+	for _, index := range indexes {
+		if index.Tp == ast.ConstraintPrimaryKey {
+			columns, err := d.indexColumns(index.Keys, true)
+			if err != nil {
+				return nil, err
+			}
+			defs = append(defs, "PRIMARY KEY ("+columns+")")
+			continue
+		}
+		indexSQLs, err := d.convertIndex(stmt.Table, index)
+		if err != nil {
+			return nil, err
+		}
+		sqls = append(sqls, indexSQLs...)
+	}
+	sqls[0] = "CREATE TABLE IF NOT EXISTS " + d.tableName(stmt.Table.Schema.O, stmt.Table.Name.O) + " (" + strings.Join(defs, ", ") + ")"
+	return sqls, nil
+}
+
+// convertIndex converts the index constraints except the primary key, the foreign keys, full-text indexes and check
+// constraints are ignored because they are not needed to replicate data.
+func (d postgresDialect) convertIndex(table *ast.TableName, index *ast.Constraint) ([]string, error) {
+	switch index.Tp {
+	case ast.ConstraintKey, ast.ConstraintIndex:
+		return d.createIndex(table, index.Name, false, index.Keys)
+	case ast.ConstraintUniq, ast.ConstraintUniqKey, ast.ConstraintUniqIndex:
+		return d.createIndex(table, index.Name, true, index.Keys)
+	case ast.ConstraintForeignKey, ast.ConstraintFulltext, ast.ConstraintCheck:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("constraint type is not supported")
+}
+
+// columnDef converts the definition of a column, the primary key and unique key in the column options are returned
+// as index constraints.
+func (d postgresDialect) columnDef(col *ast.ColumnDef) (string, []*ast.Constraint, error) {
+	tp, err := postgresType(col.Tp)
+	if err != nil {
+		return "", nil, err
+	}
+	def := d.quoteName(col.Name.Name.O) + " " + tp
+
+	var indexes []*ast.Constraint
+	keys := []*ast.IndexPartSpecification{{Column: col.Name}}
+	for _, opt := range col.Options {
+		switch opt.Tp {
+		case ast.ColumnOptionNotNull:
+			def += " NOT NULL"
+		case ast.ColumnOptionDefaultValue:
+			value, err2 := postgresDefaultValue(opt.Expr)
+			if err2 != nil {
+				return "", nil, err2
+			}
+			def += " DEFAULT " + value
+		case ast.ColumnOptionPrimaryKey:
+			indexes = append(indexes, &ast.Constraint{Tp: ast.ConstraintPrimaryKey, Keys: keys})
+		case ast.ColumnOptionUniqKey:
+			indexes = append(indexes, &ast.Constraint{Tp: ast.ConstraintUniqKey, Keys: keys})
+		case ast.ColumnOptionNull, ast.ColumnOptionAutoIncrement, ast.ColumnOptionOnUpdate, ast.ColumnOptionComment,
+			ast.ColumnOptionCollate, ast.ColumnOptionColumnFormat, ast.ColumnOptionStorage, ast.ColumnOptionReference,
+			ast.ColumnOptionCheck:
+			// the values are always taken from the upstream.
+		default:
+			return "", nil, fmt.Errorf("column option of %s is not supported", col.Name.Name.O)
+		}
+	}
+	return def, indexes, nil
+}
+
+// postgresDefaultValue converts the default value of a column, only literals and CURRENT_TIMESTAMP are supported.
+func postgresDefaultValue(expr ast.ExprNode) (string, error) {
+	switch v := expr.(type) {
+	case ast.ValueExpr, *ast.UnaryOperationExpr:
+		var buf strings.Builder
+		flags := format.RestoreStringSingleQuotes | format.RestoreKeyWordUppercase | format.RestoreStringWithoutCharset
+		if err := expr.Restore(format.NewRestoreCtx(flags, &buf)); err != nil {
+			return "", err
+		}
+		return buf.String(), nil
+	case *ast.FuncCallExpr:
+		switch v.FnName.L {
+		case ast.CurrentTimestamp, ast.Now, ast.LocalTime, ast.LocalTimestamp:
+			return "CURRENT_TIMESTAMP", nil
+		}
+	}
+	return "", fmt.Errorf("default value expression is not supported")
+}
+
+// postgresType maps a MySQL type to the closest PostgreSQL type which can hold all values of it.
+func postgresType(ft *types.FieldType) (string, error) {
+	unsigned := mysql.HasUnsignedFlag(ft.Flag)
+	binary := ft.Charset == charset.CharsetBin
+	switch ft.Tp {
+	case mysql.TypeTiny:
+		return "SMALLINT", nil
+	case mysql.TypeShort:
+		if unsigned {
+			return "INTEGER", nil
+		}
+		return "SMALLINT", nil
+	case mysql.TypeInt24:
+		return "INTEGER", nil
+	case mysql.TypeLong:
+		if unsigned {
+			return "BIGINT", nil
+		}
+		return "INTEGER", nil
+	case mysql.TypeLonglong:
+		if unsigned {
+			return "NUMERIC(20)", nil
+		}
+		return "BIGINT", nil
+	case mysql.TypeBit:
+		return "BIGINT", nil
+	case mysql.TypeYear:
+		return "SMALLINT", nil
+	case mysql.TypeFloat:
+		return "REAL", nil
+	case mysql.TypeDouble:
+		return "DOUBLE PRECISION", nil
+	case mysql.TypeNewDecimal:
+		flen, decimal := ft.Flen, ft.Decimal
+		if flen == types.UnspecifiedLength {
+			flen = mysql.DefaultLengthOfMysqlTypes[mysql.TypeNewDecimal]
+		}
+		if decimal == types.UnspecifiedLength {
+			decimal = 0
+		}
+		return fmt.Sprintf("NUMERIC(%d,%d)", flen, decimal), nil
+	case mysql.TypeDate:
+		return "DATE", nil
+	case mysql.TypeDatetime:
+		return "TIMESTAMP" + postgresFsp(ft), nil
+	case mysql.TypeTimestamp:
+		return "TIMESTAMP" + postgresFsp(ft) + " WITH TIME ZONE", nil
+	case mysql.TypeDuration:
+		// TIME of MySQL is in [-838:59:59, 838:59:59].
+		return "INTERVAL", nil
+	case mysql.TypeString:
+		if binary {
+			return "BYTEA", nil
+		}
+		if ft.Flen > 0 {
+			return fmt.Sprintf("CHAR(%d)", ft.Flen), nil
+		}
+		return "CHAR", nil
+	case mysql.TypeVarchar, mysql.TypeVarString:
+		if binary {
+			return "BYTEA", nil
+		}
+		if ft.Flen > 0 {
+			return fmt.Sprintf("VARCHAR(%d)", ft.Flen), nil
+		}
+		return "TEXT", nil
+	case mysql.TypeTinyBlob, mysql.TypeBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob:
+		if binary {
+			return "BYTEA", nil
+		}
+		return "TEXT", nil
+	case mysql.TypeEnum, mysql.TypeSet:
+		return "TEXT", nil
+	case mysql.TypeJSON:
+		return "JSONB", nil
+	}
+	return "", fmt.Errorf("type %s is not supported", types.TypeToStr(ft.Tp, ft.Charset))
+}
+
+func postgresFsp(ft *types.FieldType) string {
+	if ft.Decimal > 0 {
+		return fmt.Sprintf("(%d)", ft.Decimal)
+	}
+	return "(0)"
+}
+
+func (d postgresDialect) convertAlterTable(stmt *ast.AlterTableStmt) ([]string, error) {
+	table := d.tableName(stmt.Table.Schema.O, stmt.Table.Name.O)
+	sqls := make([]string, 0, len(stmt.Specs))
+	for _, spec := range stmt.Specs {
+		switch spec.Tp {
+		case ast.AlterTableOption, ast.AlterTableLock, ast.AlterTableAlgorithm, ast.AlterTableForce:
+			// only meaningful for MySQL.
+		case ast.AlterTableAddColumns:
+			for _, col := range spec.NewColumns {
+				def, indexes, err := d.columnDef(col)
+				if err != nil {
+					return nil, err
+				}
+				sqls = append(sqls, "ALTER TABLE "+table+" ADD COLUMN IF NOT EXISTS "+def)
+				for _, index := range indexes {
+					indexSQLs, err := d.addConstraint(stmt.Table, index)
+					if err != nil {
+						return nil, err
+					}
+					sqls = append(sqls, indexSQLs...)
+				}
+			}
+		case ast.AlterTableDropColumn:
+			sqls = append(sqls, "ALTER TABLE "+table+" DROP COLUMN IF EXISTS "+d.quoteName(spec.OldColumnName.Name.O))
+		case ast.AlterTableModifyColumn, ast.AlterTableChangeColumn:
+			col := spec.NewColumns[0]
+			name := col.Name.Name.O
+			if spec.Tp == ast.AlterTableChangeColumn && spec.OldColumnName.Name.O != name {
+				sqls = append(sqls, "ALTER TABLE "+table+" RENAME COLUMN "+d.quoteName(spec.OldColumnName.Name.O)+" TO "+d.quoteName(name))
+			}
+			alterSQL, err := d.modifyColumn(table, col)
+			if err != nil {
+				return nil, err
+			}
+			sqls = append(sqls, alterSQL)
+		case ast.AlterTableRenameColumn:
+			sqls = append(sqls, "ALTER TABLE "+table+" RENAME COLUMN "+d.quoteName(spec.OldColumnName.Name.O)+" TO "+d.quoteName(spec.NewColumnName.Name.O))
+		case ast.AlterTableAlterColumn:
+			col := spec.NewColumns[0]
+			if len(col.Options) == 0 {
+				sqls = append(sqls, "ALTER TABLE "+table+" ALTER COLUMN "+d.quoteName(col.Name.Name.O)+" DROP DEFAULT")
+				continue
+			}
+			value, err := postgresDefaultValue(col.Options[0].Expr)
+			if err != nil {
+				return nil, err
+			}
+			sqls = append(sqls, "ALTER TABLE "+table+" ALTER COLUMN "+d.quoteName(col.Name.Name.O)+" SET DEFAULT "+value)
+		case ast.AlterTableAddConstraint:
+			indexSQLs, err := d.addConstraint(stmt.Table, spec.Constraint)
+			if err != nil {
+				return nil, err
+			}
+			sqls = append(sqls, indexSQLs...)
+		case ast.AlterTableDropPrimaryKey:
+			// the default name of primary key in PostgreSQL.
+			sqls = append(sqls, "ALTER TABLE "+table+" DROP CONSTRAINT IF EXISTS "+d.quoteName(stmt.Table.Name.O+"_pkey"))
+		case ast.AlterTableDropIndex:
+			sqls = append(sqls, d.dropIndex(stmt.Table, spec.Name))
+		case ast.AlterTableRenameIndex:
+			sqls = append(sqls, "ALTER INDEX IF EXISTS "+d.quoteName(stmt.Table.Schema.O)+"."+d.indexName(stmt.Table, spec.FromKey.O)+
+				" RENAME TO "+d.indexName(stmt.Table, spec.ToKey.O))
+		case ast.AlterTableRenameTable:
+			sqls = append(sqls, d.renameTable(stmt.Table, spec.NewTable)...)
+		default:
+			return nil, fmt.Errorf("ALTER TABLE type is not supported")
+		}
+	}
+	return sqls, nil
+}
+
+func (d postgresDialect) addConstraint(table *ast.TableName, index *ast.Constraint) ([]string, error) {
+	if index.Tp != ast.ConstraintPrimaryKey {
+		return d.convertIndex(table, index)
+	}
+	columns, err := d.indexColumns(index.Keys, true)
+	if err != nil {
+		return nil, err
+	}
+	return []string{"ALTER TABLE " + d.tableName(table.Schema.O, table.Name.O) + " ADD PRIMARY KEY (" + columns + ")"}, nil
+}
+
+// modifyColumn generates the statement to change the type, nullability and default value of a column.
+func (d postgresDialect) modifyColumn(table string, col *ast.ColumnDef) (string, error) {
+	tp, err := postgresType(col.Tp)
+	if err != nil {
+		return "", err
+	}
+	name := d.quoteName(col.Name.Name.O)
+	actions := []string{fmt.Sprintf("ALTER COLUMN %s TYPE %s USING %s::%s", name, tp, name, tp)}
+
+	notNull, defaultValue := false, ""
+	for _, opt := range col.Options {
+		switch opt.Tp {
+		case ast.ColumnOptionNotNull:
+			notNull = true
+		case ast.ColumnOptionDefaultValue:
+			if defaultValue, err = postgresDefaultValue(opt.Expr); err != nil {
+				return "", err
+			}
+		case ast.ColumnOptionPrimaryKey, ast.ColumnOptionUniqKey, ast.ColumnOptionGenerated, ast.ColumnOptionAutoRandom:
+			return "", fmt.Errorf("column option of %s is not supported", col.Name.Name.O)
+		}
+	}
+	if notNull {
+		actions = append(actions, "ALTER COLUMN "+name+" SET NOT NULL")
+	} else {
+		actions = append(actions, "ALTER COLUMN "+name+" DROP NOT NULL")
+	}
+	if defaultValue != "" {
+		actions = append(actions, "ALTER COLUMN "+name+" SET DEFAULT "+defaultValue)
+	} else {
+		actions = append(actions, "ALTER COLUMN "+name+" DROP DEFAULT")
+	}
+	return "ALTER TABLE " + table + " " + strings.Join(actions, ", "), nil
+}
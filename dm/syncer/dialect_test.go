@@ -0,0 +1,182 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"math"
+
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/util/mock"
+
+	"github.com/pingcap/tiflow/dm/pkg/schema"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+func (s *testSyncerSuite) TestPostgresDMLSQL(c *C) {
+	p := parser.New()
+	se := mock.NewContext()
+	d := postgresDialect{}
+	tableID := "`db`.`tb`"
+
+	ti, err := createTableInfo(p, se, 0, "create table db.tb(id bigint unsigned primary key, e enum('a','b'), name varchar(24))")
+	c.Assert(err, IsNil)
+	downTi := schema.GetDownStreamTI(ti, ti)
+	index := downTi.AbsoluteUKIndexInfo
+	old := []interface{}{uint64(1), int64(1), []byte("x")}
+	values := []interface{}{uint64(math.MaxUint64), int64(2), nil}
+
+	testCases := []struct {
+		dml     *DML
+		queries []string
+		args    [][]interface{}
+	}{
+		{
+			newDML(insert, false, tableID, &filter.Table{}, nil, values, nil, values, ti.Columns, ti, index, downTi),
+			[]string{`INSERT INTO "db"."tb" ("id","e","name") VALUES ($1,$2,$3)`},
+			[][]interface{}{{"18446744073709551615", "b", nil}},
+		},
+		{
+			newDML(insert, true, tableID, &filter.Table{}, nil, values, nil, values, ti.Columns, ti, index, downTi),
+			[]string{`INSERT INTO "db"."tb" ("id","e","name") VALUES ($1,$2,$3) ON CONFLICT ("id") DO UPDATE SET "e" = EXCLUDED."e","name" = EXCLUDED."name"`},
+			[][]interface{}{{"18446744073709551615", "b", nil}},
+		},
+		{
+			newDML(del, false, tableID, &filter.Table{}, nil, old, nil, old, ti.Columns, ti, index, downTi),
+			[]string{`DELETE FROM "db"."tb" WHERE "id" = $1`},
+			[][]interface{}{{int64(1)}},
+		},
+		{
+			newDML(update, false, tableID, &filter.Table{}, old, values, old, values, ti.Columns, ti, index, downTi),
+			[]string{`UPDATE "db"."tb" SET "id" = $1, "e" = $2, "name" = $3 WHERE "id" = $4`},
+			[][]interface{}{{"18446744073709551615", "b", nil, int64(1)}},
+		},
+		{
+			newDML(update, true, tableID, &filter.Table{}, old, values, old, values, ti.Columns, ti, index, downTi),
+			[]string{
+				`DELETE FROM "db"."tb" WHERE "id" = $1`,
+				`INSERT INTO "db"."tb" ("id","e","name") VALUES ($1,$2,$3) ON CONFLICT ("id") DO UPDATE SET "e" = EXCLUDED."e","name" = EXCLUDED."name"`,
+			},
+			[][]interface{}{{int64(1)}, {"18446744073709551615", "b", nil}},
+		},
+	}
+	for _, tc := range testCases {
+		queries, args := d.genDMLSQL(tc.dml)
+		c.Assert(queries, DeepEquals, tc.queries)
+		c.Assert(args, DeepEquals, tc.args)
+	}
+
+	// the row of a table without unique key is located by ctid
+	ti, err = createTableInfo(p, se, 0, "create table db.tb(id int, name varchar(24))")
+	c.Assert(err, IsNil)
+	downTi = schema.GetDownStreamTI(ti, ti)
+	old = []interface{}{int64(1), nil}
+	dml := newDML(del, false, tableID, &filter.Table{}, nil, old, nil, old, ti.Columns, ti, nil, downTi)
+	queries, args := d.genDMLSQL(dml)
+	c.Assert(queries, DeepEquals, []string{`DELETE FROM "db"."tb" WHERE ctid = (SELECT ctid FROM "db"."tb" WHERE "id" = $1 AND "name" IS NULL LIMIT 1)`})
+	c.Assert(args, DeepEquals, [][]interface{}{{int64(1)}})
+	dml = newDML(insert, true, tableID, &filter.Table{}, nil, old, nil, old, ti.Columns, ti, nil, downTi)
+	queries, _ = d.genDMLSQL(dml)
+	c.Assert(queries, DeepEquals, []string{`INSERT INTO "db"."tb" ("id","name") VALUES ($1,$2)`})
+
+	// all columns are keys
+	ti, err = createTableInfo(p, se, 0, "create table db.tb(id int primary key)")
+	c.Assert(err, IsNil)
+	downTi = schema.GetDownStreamTI(ti, ti)
+	dml = newDML(insert, true, tableID, &filter.Table{}, nil, old[:1], nil, old[:1], ti.Columns, ti, downTi.AbsoluteUKIndexInfo, downTi)
+	queries, _ = d.genDMLSQL(dml)
+	c.Assert(queries, DeepEquals, []string{`INSERT INTO "db"."tb" ("id") VALUES ($1) ON CONFLICT ("id") DO NOTHING`})
+}
+
+func (s *testSyncerSuite) TestPostgresConvertDDLs(c *C) {
+	d := postgresDialect{}
+	testCases := []struct {
+		ddl       string
+		converted []string
+	}{
+		{"CREATE DATABASE IF NOT EXISTS `db` CHARACTER SET = utf8mb4", []string{`CREATE SCHEMA IF NOT EXISTS "db"`}},
+		{"DROP DATABASE `db`", []string{`DROP SCHEMA IF EXISTS "db" CASCADE`}},
+		{"ALTER DATABASE `db` CHARACTER SET = utf8mb4", []string{}},
+		{
+			"CREATE TABLE `db`.`tb` (`id` INT UNSIGNED NOT NULL AUTO_INCREMENT, `b` BIGINT UNSIGNED, `c` VARCHAR(20) DEFAULT 'x' COMMENT 'c', " +
+				"`d` BLOB, `e` ENUM('a','b'), `f` DATETIME(3), `g` TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP, " +
+				"`h` DECIMAL(10,2) DEFAULT -1, `i` JSON, `j` TEXT, PRIMARY KEY (`id`), UNIQUE KEY `uk` (`b`), KEY (`c`(4), `f`)) ENGINE = InnoDB",
+			[]string{
+				`CREATE TABLE IF NOT EXISTS "db"."tb" ("id" BIGINT NOT NULL, "b" NUMERIC(20), "c" VARCHAR(20) DEFAULT 'x', "d" BYTEA, "e" TEXT, ` +
+					`"f" TIMESTAMP(3), "g" TIMESTAMP(0) WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP, "h" NUMERIC(10,2) DEFAULT -1, "i" JSONB, "j" TEXT, PRIMARY KEY ("id"))`,
+				`CREATE UNIQUE INDEX IF NOT EXISTS "tb_uk" ON "db"."tb" ("b")`,
+				`CREATE INDEX IF NOT EXISTS "tb_c" ON "db"."tb" ("c","f")`,
+			},
+		},
+		{"CREATE TABLE `db`.`tb` (`id` INT PRIMARY KEY, `u` TINYINT UNIQUE)", []string{
+			`CREATE TABLE IF NOT EXISTS "db"."tb" ("id" INTEGER, "u" SMALLINT, PRIMARY KEY ("id"))`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "tb_u" ON "db"."tb" ("u")`,
+		}},
+		{"DROP TABLE IF EXISTS `db`.`tb1`, `db`.`tb2`", []string{`DROP TABLE IF EXISTS "db"."tb1", "db"."tb2"`}},
+		{"TRUNCATE TABLE `db`.`tb`", []string{`TRUNCATE TABLE "db"."tb"`}},
+		{"RENAME TABLE `db`.`tb1` TO `db`.`tb2`, `db`.`tb3` TO `db2`.`tb3`", []string{
+			`ALTER TABLE "db"."tb1" RENAME TO "tb2"`,
+			`ALTER TABLE "db"."tb3" SET SCHEMA "db2"`,
+		}},
+		{"ALTER TABLE `db`.`tb` ADD COLUMN `c` INT NOT NULL DEFAULT 0 AFTER `b`", []string{
+			`ALTER TABLE "db"."tb" ADD COLUMN IF NOT EXISTS "c" INTEGER NOT NULL DEFAULT 0`,
+		}},
+		{"ALTER TABLE `db`.`tb` DROP COLUMN `c`", []string{`ALTER TABLE "db"."tb" DROP COLUMN IF EXISTS "c"`}},
+		{"ALTER TABLE `db`.`tb` CHANGE COLUMN `c` `d` VARCHAR(10) NOT NULL", []string{
+			`ALTER TABLE "db"."tb" RENAME COLUMN "c" TO "d"`,
+			`ALTER TABLE "db"."tb" ALTER COLUMN "d" TYPE VARCHAR(10) USING "d"::VARCHAR(10), ALTER COLUMN "d" SET NOT NULL, ALTER COLUMN "d" DROP DEFAULT`,
+		}},
+		{"ALTER TABLE `db`.`tb` ALTER COLUMN `c` SET DEFAULT 'a'", []string{`ALTER TABLE "db"."tb" ALTER COLUMN "c" SET DEFAULT 'a'`}},
+		{"ALTER TABLE `db`.`tb` ADD UNIQUE INDEX `uk`(`a`, `b`)", []string{`CREATE UNIQUE INDEX IF NOT EXISTS "tb_uk" ON "db"."tb" ("a","b")`}},
+		{"ALTER TABLE `db`.`tb` ADD PRIMARY KEY (`a`)", []string{`ALTER TABLE "db"."tb" ADD PRIMARY KEY ("a")`}},
+		{"ALTER TABLE `db`.`tb` DROP PRIMARY KEY", []string{`ALTER TABLE "db"."tb" DROP CONSTRAINT IF EXISTS "tb_pkey"`}},
+		{"ALTER TABLE `db`.`tb` DROP INDEX `uk`", []string{`DROP INDEX IF EXISTS "db"."tb_uk"`}},
+		{"ALTER TABLE `db`.`tb` RENAME INDEX `a` TO `b`", []string{`ALTER INDEX IF EXISTS "db"."tb_a" RENAME TO "tb_b"`}},
+		{"ALTER TABLE `db`.`tb` COMMENT = 'x'", []string{}},
+		{"CREATE INDEX `idx` ON `db`.`tb` (`a`)", []string{`CREATE INDEX IF NOT EXISTS "tb_idx" ON "db"."tb" ("a")`}},
+		{"DROP INDEX `idx` ON `db`.`tb`", []string{`DROP INDEX IF EXISTS "db"."tb_idx"`}},
+	}
+	for _, tc := range testCases {
+		converted, err := d.convertDDLs([]string{tc.ddl})
+		c.Assert(err, IsNil, Commentf("ddl: %s", tc.ddl))
+		c.Assert(converted, DeepEquals, tc.converted, Commentf("ddl: %s", tc.ddl))
+	}
+
+	unsupported := []string{
+		"CREATE TABLE `db`.`tb2` LIKE `db`.`tb`",
+		"CREATE TABLE `db`.`tb` (`a` INT, `b` INT AS (`a` + 1))",
+		"CREATE TABLE `db`.`tb` (`a` VARCHAR(10), UNIQUE KEY (`a`(4)))",
+		"CREATE TEMPORARY TABLE `db`.`tb` (`a` INT)",
+		"ALTER TABLE `db`.`tb` ADD PARTITION (PARTITION p1 VALUES LESS THAN (10))",
+		"CREATE VIEW `db`.`v` AS SELECT 1",
+	}
+	for _, ddl := range unsupported {
+		_, err := d.convertDDLs([]string{ddl})
+		c.Assert(terror.ErrSyncerUnsupportedDDLForPostgres.Equal(err), IsTrue, Commentf("ddl: %s", ddl))
+	}
+
+	// MySQL DDLs are not converted
+	converted, err := mysqlDialect{}.convertDDLs(unsupported)
+	c.Assert(err, IsNil)
+	c.Assert(converted, DeepEquals, unsupported)
+}
+
+func (s *testSyncerSuite) TestPostgresCheckpointSQL(c *C) {
+	d := postgresDialect{}
+	c.Assert(d.tableName("dm_meta", `a"b`), Equals, `"dm_meta"."a""b"`)
+	c.Assert(d.rebind("DELETE FROM t WHERE id = ? AND cp_schema = ?"), Equals, "DELETE FROM t WHERE id = $1 AND cp_schema = $2")
+	c.Assert(mysqlDialect{}.rebind("DELETE FROM t WHERE id = ?"), Equals, "DELETE FROM t WHERE id = ?")
+	c.Assert(d.checkpointUpsertSQL(`"dm_meta"."cp"`), Matches, `(?s)INSERT INTO "dm_meta"."cp".*ON CONFLICT \(id, cp_schema, cp_table\) DO UPDATE SET.*`)
+}
@@ -32,8 +32,11 @@ import (
 	"github.com/shopspring/decimal"
 	"go.uber.org/zap"
 
+	"github.com/pingcap/tiflow/dm/dm/config"
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	parserpkg "github.com/pingcap/tiflow/dm/pkg/parser"
+	"github.com/pingcap/tiflow/dm/pkg/rowfilter"
 	"github.com/pingcap/tiflow/dm/pkg/schema"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
@@ -68,14 +71,16 @@ func (op dmlOpType) String() (str string) {
 
 // genDMLParam stores pruned columns, data as well as the original columns, data, index.
 type genDMLParam struct {
-	targetTableID   string              // as a key in map like `schema`.`table`
-	sourceTable     *filter.Table       // origin table
-	safeMode        bool                // only used in update
-	data            [][]interface{}     // pruned data
-	originalData    [][]interface{}     // all data
-	columns         []*model.ColumnInfo // pruned columns
-	sourceTableInfo *model.TableInfo    // all table info
-	extendData      [][]interface{}     // all data include extend data
+	targetTableID   string                // as a key in map like `schema`.`table`
+	sourceTable     *filter.Table         // origin table
+	safeMode        bool                  // only used in update
+	data            [][]interface{}       // pruned data
+	originalData    [][]interface{}       // all data
+	columns         []*model.ColumnInfo   // pruned columns
+	sourceTableInfo *model.TableInfo      // all table info
+	extendData      [][]interface{}       // all data include extend data
+	rowFilters      []rowfilter.RowFilter // filters loaded from plugins
+	rowValueRules   []*rowValueRule       // binlog event filter rules with row-value-expr
 }
 
 // extractValueFromData adjust the values obtained from go-mysql so that
@@ -162,6 +167,29 @@ RowLoop:
 			}
 		}
 
+		skip, err := s.skipDMLByRowValue(param.rowValueRules, originalValue, ti)
+		if err != nil {
+			return nil, err
+		}
+		if skip {
+			s.filteredInsert.Add(1)
+			continue RowLoop
+		}
+
+		if len(param.rowFilters) > 0 {
+			change := newRowChange(rowfilter.Insert, param.sourceTable, ti, nil, originalValue)
+			skip, err := SkipDMLByRowFilters(param.rowFilters, change)
+			if err != nil {
+				return nil, err
+			}
+			if skip {
+				s.filteredInsert.Add(1)
+				continue RowLoop
+			}
+			originalValue = change.NewValues
+			value = pickColumnValues(originalValue, columns, ti)
+		}
+
 		if downstreamIndexColumns == nil {
 			downstreamIndexColumns = s.schemaTracker.GetAvailableDownStreamUKIndexInfo(tableID, value)
 		}
@@ -244,6 +272,31 @@ RowLoop:
 			}
 		}
 
+		// the rows of UPDATE are filtered by the new values
+		skip, err := s.skipDMLByRowValue(param.rowValueRules, oriChangedValues, ti)
+		if err != nil {
+			return nil, err
+		}
+		if skip {
+			s.filteredUpdate.Add(1)
+			continue RowLoop
+		}
+
+		if len(param.rowFilters) > 0 {
+			change := newRowChange(rowfilter.Update, param.sourceTable, ti, oriOldValues, oriChangedValues)
+			skip, err := SkipDMLByRowFilters(param.rowFilters, change)
+			if err != nil {
+				return nil, err
+			}
+			if skip {
+				s.filteredUpdate.Add(1)
+				continue RowLoop
+			}
+			oriOldValues, oriChangedValues = change.OldValues, change.NewValues
+			oldValues = pickColumnValues(oriOldValues, columns, ti)
+			changedValues = pickColumnValues(oriChangedValues, columns, ti)
+		}
+
 		if downstreamIndexColumns == nil {
 			downstreamIndexColumns = s.schemaTracker.GetAvailableDownStreamUKIndexInfo(tableID, oriOldValues)
 		}
@@ -293,6 +346,28 @@ RowLoop:
 			}
 		}
 
+		skip, err := s.skipDMLByRowValue(param.rowValueRules, value, ti)
+		if err != nil {
+			return nil, err
+		}
+		if skip {
+			s.filteredDelete.Add(1)
+			continue RowLoop
+		}
+
+		if len(param.rowFilters) > 0 {
+			change := newRowChange(rowfilter.Delete, param.sourceTable, ti, value, nil)
+			skip, err := SkipDMLByRowFilters(param.rowFilters, change)
+			if err != nil {
+				return nil, err
+			}
+			if skip {
+				s.filteredDelete.Add(1)
+				continue RowLoop
+			}
+			value = change.OldValues
+		}
+
 		if downstreamIndexColumns == nil {
 			downstreamIndexColumns = s.schemaTracker.GetAvailableDownStreamUKIndexInfo(tableID, value)
 		}
@@ -442,7 +517,14 @@ func getColumnData(columns []*model.ColumnInfo, indexColumns *model.IndexInfo, d
 }
 
 func (s *Syncer) mappingDML(table *filter.Table, ti *model.TableInfo, data [][]interface{}) ([][]interface{}, error) {
-	if s.columnMapping == nil {
+	mapping := s.columnMapping
+	if mapping == nil {
+		var err error
+		if mapping, err = s.autoIDMappingOf(table, ti); err != nil {
+			return nil, err
+		}
+	}
+	if mapping == nil {
 		return data, nil
 	}
 
@@ -456,7 +538,7 @@ func (s *Syncer) mappingDML(table *filter.Table, ti *model.TableInfo, data [][]i
 		rows = make([][]interface{}, len(data))
 	)
 	for i := range data {
-		rows[i], _, err = s.columnMapping.HandleRowValue(table.Schema, table.Name, columns, data[i])
+		rows[i], _, err = mapping.HandleRowValue(table.Schema, table.Name, columns, data[i])
 		if err != nil {
 			return nil, terror.ErrSyncerUnitDoColumnMapping.Delegate(err, data[i], table)
 		}
@@ -464,33 +546,64 @@ func (s *Syncer) mappingDML(table *filter.Table, ti *model.TableInfo, data [][]i
 	return rows, nil
 }
 
-// pruneGeneratedColumnDML filters columns list, data and index removing all
-// generated column. because generated column is not support setting value
-// directly in DML, we must remove generated column from DML, including column
-// list and data list including generated columns.
-func pruneGeneratedColumnDML(ti *model.TableInfo, data [][]interface{}) ([]*model.ColumnInfo, [][]interface{}, error) {
-	// search for generated columns. if none found, return everything as-is.
-	firstGeneratedColumnIndex := -1
+// skippedColumns returns whether the columns of the source table are skipped in DMLs, or nil if no column is
+// skipped. because generated column is not support setting value directly in DML, the generated columns are skipped
+// unless generated-column-policy materializes them and they're not generated columns in downstream. the invisible
+// columns are skipped by invisible-column-policy.
+func (s *Syncer) skippedColumns(tctx *tcontext.Context, ti *model.TableInfo, targetTableID string) ([]bool, error) {
+	var (
+		skipped        []bool
+		downstreamCols map[string]*model.ColumnInfo
+	)
 	for i, c := range ti.Columns {
-		if c.IsGenerated() {
-			firstGeneratedColumnIndex = i
-			break
+		skip := false
+		switch {
+		case c.IsGenerated():
+			policy := s.cfg.GeneratedColumnPolicy
+			if policy == config.ColumnPolicySkip || (policy == config.ColumnPolicyMaterializeStored && !c.GeneratedStored) {
+				skip = true
+				break
+			}
+			if downstreamCols == nil {
+				downstreamTableInfo, err := s.schemaTracker.GetDownStreamTableInfo(tctx, targetTableID, ti)
+				if err != nil {
+					return nil, err
+				}
+				downstreamCols = make(map[string]*model.ColumnInfo, len(downstreamTableInfo.TableInfo.Columns))
+				for _, col := range downstreamTableInfo.TableInfo.Columns {
+					downstreamCols[col.Name.L] = col
+				}
+			}
+			col, ok := downstreamCols[c.Name.L]
+			skip = !ok || col.IsGenerated()
+		case c.Comment == parserpkg.InvisibleColumnComment:
+			skip = s.cfg.InvisibleColumnPolicy == config.ColumnPolicySkip
+		}
+		if skip {
+			if skipped == nil {
+				skipped = make([]bool, len(ti.Columns))
+			}
+			skipped[i] = true
 		}
 	}
-	if firstGeneratedColumnIndex < 0 {
+	return skipped, nil
+}
+
+// pruneColumnDML filters columns list and data removing the skipped columns.
+func pruneColumnDML(ti *model.TableInfo, data [][]interface{}, skipped []bool) ([]*model.ColumnInfo, [][]interface{}, error) {
+	if skipped == nil {
 		return ti.Columns, data, nil
 	}
 
-	// remove generated columns from the list of columns
+	// remove skipped columns from the list of columns
 	cols := make([]*model.ColumnInfo, 0, len(ti.Columns))
-	cols = append(cols, ti.Columns[:firstGeneratedColumnIndex]...)
-	for _, c := range ti.Columns[(firstGeneratedColumnIndex + 1):] {
-		if !c.IsGenerated() {
+	for i, c := range ti.Columns {
+		if !skipped[i] {
 			cols = append(cols, c)
 		}
 	}
 
-	// remove generated columns from the list of data.
+	// remove skipped columns from the list of data.
 	rows := make([][]interface{}, 0, len(data))
 	for _, row := range data {
 		if len(row) != len(ti.Columns) {
@@ -498,7 +611,7 @@ func pruneGeneratedColumnDML(ti *model.TableInfo, data [][]interface{}) ([]*mode
 		}
 		value := make([]interface{}, 0, len(cols))
 		for i := range row {
-			if !ti.Columns[i].IsGenerated() {
+			if !skipped[i] {
 				value = append(value, row[i])
 			}
 		}
@@ -664,6 +777,23 @@ func (dml *DML) identifyKeys(ctx sessionctx.Context) []string {
 	return keys
 }
 
+// foreignKeys gens the keys of the parent rows referenced by the row, which are the same as the keys of the PK/UK of
+// the parent rows generated by identifyKeys, so the DMLs of the parent rows and child rows are executed in order.
+// the parent tables are regarded as in the same schema with the child table.
+// This is used for causality.
+func (dml *DML) foreignKeys(targetSchema string) []string {
+	var keys []string
+	// for UPDATE statement
+	if dml.originOldValues != nil {
+		keys = append(keys, genForeignKeys(dml.downstreamTableInfo, dml.sourceTableInfo, dml.originOldValues, targetSchema)...)
+	}
+
+	if dml.originValues != nil {
+		keys = append(keys, genForeignKeys(dml.downstreamTableInfo, dml.sourceTableInfo, dml.originValues, targetSchema)...)
+	}
+	return keys
+}
+
 // columnNames return column names of DML.
 func (dml *DML) columnNames() []string {
 	columnNames := make([]string, 0, len(dml.columns))
@@ -727,7 +857,7 @@ func genKeyList(table string, columns []*model.ColumnInfo, dataSeq []interface{}
 		// one column key looks like:`column_val.column_name.`
 		buf.WriteString(columnValue(data, &columns[i].FieldType))
 		buf.WriteString(".")
-		buf.WriteString(columns[i].Name.O)
+		buf.WriteString(columns[i].Name.L)
 		buf.WriteString(".")
 	}
 	if buf.Len() == 0 {
@@ -753,26 +883,30 @@ func truncateIndexValues(ctx sessionctx.Context, ti *model.TableInfo, indexColum
 	return values
 }
 
-// genMultipleKeys gens keys with UNIQUE NOT NULL value.
-// if not UNIQUE NOT NULL value, use table name instead.
+// genMultipleKeys gens keys from all PK/UKs of downstream table.
+// a UK with any `null` value doesn't generate a key, because `null` never conflicts with other values in a UK.
+// if some UKs can't be tracked (e.g. expression index), or no key is generated, use table name as a key, which means no
+// concurrence for rows in the same table.
 func genMultipleKeys(ctx sessionctx.Context, downstreamTableInfo *schema.DownstreamTableInfo, ti *model.TableInfo, value []interface{}, table string) []string {
-	multipleKeys := make([]string, 0, len(downstreamTableInfo.AvailableUKIndexList))
+	multipleKeys := make([]string, 0, len(downstreamTableInfo.AvailableUKIndexList)+1)
 
 	for _, indexCols := range downstreamTableInfo.AvailableUKIndexList {
 		cols, vals := getColumnData(ti.Columns, indexCols, value)
+		if hasNullValue(vals) {
+			log.L().Debug("ignore key with null value", zap.String("table", table))
+			continue
+		}
 		// handle prefix index
 		truncVals := truncateIndexValues(ctx, ti, indexCols, cols, vals)
 		key := genKeyList(table, cols, truncVals)
-		if len(key) > 0 { // ignore `null` value.
+		if len(key) > 0 {
 			multipleKeys = append(multipleKeys, key)
 		} else {
 			log.L().Debug("ignore empty key", zap.String("table", table))
 		}
 	}
 
-	if len(multipleKeys) == 0 {
-		// use table name as key if no key generated (no PK/UK),
-		// no concurrence for rows in the same table.
+	if len(multipleKeys) == 0 || downstreamTableInfo.HasUntrackedUK {
 		log.L().Debug("use table name as the key", zap.String("table", table))
 		multipleKeys = append(multipleKeys, table)
 	}
@@ -780,6 +914,40 @@ func genMultipleKeys(ctx sessionctx.Context, downstreamTableInfo *schema.Downstr
 	return multipleKeys
 }
 
+// genForeignKeys gens keys from all foreign keys of downstream table. the columns are named by the referenced columns
+// and the table is the referenced table, so the keys are the same as the ones of the parent rows generated by
+// genMultipleKeys. a foreign key with any `null` value doesn't generate a key, because it's not checked.
+func genForeignKeys(downstreamTableInfo *schema.DownstreamTableInfo, ti *model.TableInfo, value []interface{}, targetSchema string) []string {
+	keys := make([]string, 0, len(downstreamTableInfo.ForeignKeys))
+	for _, fk := range downstreamTableInfo.ForeignKeys {
+		cols := make([]*model.ColumnInfo, 0, len(fk.Offsets))
+		vals := make([]interface{}, 0, len(fk.Offsets))
+		for i, offset := range fk.Offsets {
+			col := ti.Columns[offset].Clone()
+			col.Name = fk.RefColumns[i]
+			cols = append(cols, col)
+			vals = append(vals, value[offset])
+		}
+		if hasNullValue(vals) {
+			continue
+		}
+		refTable := utils.GenTableID(&filter.Table{Schema: targetSchema, Name: fk.RefTable.O})
+		if key := genKeyList(refTable, cols, vals); len(key) > 0 {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func hasNullValue(values []interface{}) bool {
+	for _, v := range values {
+		if v == nil {
+			return true
+		}
+	}
+	return false
+}
+
 // genWhere generates where condition.
 func (dml *DML) genWhere(buf *strings.Builder) []interface{} {
 	whereColumns, whereValues := dml.whereColumnsAndValues()
@@ -1038,7 +1206,8 @@ func sameColumns(lhs *DML, rhs *DML) bool {
 // insert into tb(a,b,d) values(2,2,2)
 // we can only combine DMLs with same column names.
 // all dmls should have same dmlOpType and same tableName.
-func genDMLsWithSameCols(op dmlOpType, dmls []*DML) ([]string, [][]interface{}) {
+// if maxRows > 0, at most maxRows DMLs are combined into one statement.
+func genDMLsWithSameCols(op dmlOpType, dmls []*DML, maxRows int) ([]string, [][]interface{}) {
 	queries := make([]string, 0, len(dmls))
 	args := make([][]interface{}, 0, len(dmls))
 	var lastDML *DML
@@ -1051,7 +1220,7 @@ func genDMLsWithSameCols(op dmlOpType, dmls []*DML) ([]string, [][]interface{})
 		if i == 0 {
 			lastDML = dml
 		}
-		if !sameColumns(lastDML, dml) {
+		if !sameColumns(lastDML, dml) || (maxRows > 0 && len(groupDMLs) >= maxRows) {
 			query, arg = genSQLMultipleRows(op, groupDMLs)
 			queries = append(queries, query...)
 			args = append(args, arg...)
@@ -1071,7 +1240,7 @@ func genDMLsWithSameCols(op dmlOpType, dmls []*DML) ([]string, [][]interface{})
 
 // genDMLsWithSameTable groups and generates dmls with same table.
 // all the dmls should have same dmlOpType.
-func genDMLsWithSameTable(op dmlOpType, dmls []*DML) ([]string, [][]interface{}) {
+func genDMLsWithSameTable(op dmlOpType, dmls []*DML, maxRows int) ([]string, [][]interface{}) {
 	queries := make([]string, 0, len(dmls))
 	args := make([][]interface{}, 0, len(dmls))
 	var lastTable string
@@ -1093,7 +1262,7 @@ func genDMLsWithSameTable(op dmlOpType, dmls []*DML) ([]string, [][]interface{})
 			lastTable = dml.targetTableID
 		}
 		if lastTable != dml.targetTableID {
-			query, arg := genDMLsWithSameCols(op, groupDMLs)
+			query, arg := genDMLsWithSameCols(op, groupDMLs, maxRows)
 			queries = append(queries, query...)
 			args = append(args, arg...)
 
@@ -1103,7 +1272,7 @@ func genDMLsWithSameTable(op dmlOpType, dmls []*DML) ([]string, [][]interface{})
 		groupDMLs = append(groupDMLs, dml)
 	}
 	if len(groupDMLs) > 0 {
-		query, arg := genDMLsWithSameCols(op, groupDMLs)
+		query, arg := genDMLsWithSameCols(op, groupDMLs, maxRows)
 		queries = append(queries, query...)
 		args = append(args, arg...)
 	}
@@ -1112,7 +1281,7 @@ func genDMLsWithSameTable(op dmlOpType, dmls []*DML) ([]string, [][]interface{})
 
 // genDMLsWithSameOp groups and generates dmls by dmlOpType.
 // TODO: implement a volcano iterator interface for genDMLsWithSameXXX.
-func genDMLsWithSameOp(dmls []*DML) ([]string, [][]interface{}) {
+func genDMLsWithSameOp(dmls []*DML, maxRows int) ([]string, [][]interface{}) {
 	queries := make([]string, 0, len(dmls))
 	args := make([][]interface{}, 0, len(dmls))
 	var lastOp dmlOpType
@@ -1135,7 +1304,7 @@ func genDMLsWithSameOp(dmls []*DML) ([]string, [][]interface{}) {
 
 		// now there are 5 situations: [insert, replace(insert with safemode), insert on duplicate(update without identify keys), update(update identify keys/update with safemode), delete]
 		if lastOp != curOp {
-			query, arg := genDMLsWithSameTable(lastOp, groupDMLs)
+			query, arg := genDMLsWithSameTable(lastOp, groupDMLs, maxRows)
 			queries = append(queries, query...)
 			args = append(args, arg...)
 
@@ -1145,7 +1314,7 @@ func genDMLsWithSameOp(dmls []*DML) ([]string, [][]interface{}) {
 		groupDMLs = append(groupDMLs, dml)
 	}
 	if len(groupDMLs) > 0 {
-		query, arg := genDMLsWithSameTable(lastOp, groupDMLs)
+		query, arg := genDMLsWithSameTable(lastOp, groupDMLs, maxRows)
 		queries = append(queries, query...)
 		args = append(args, arg...)
 	}
@@ -14,13 +14,21 @@
 package syncer
 
 import (
+	"context"
 	"math"
 	"strings"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	. "github.com/pingcap/check"
 
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/retry"
+	"github.com/pingcap/tiflow/dm/pkg/router"
 	"github.com/pingcap/tiflow/dm/pkg/schema"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/pingcap/tiflow/dm/syncer/dbconn"
 
 	"github.com/pingcap/tidb-tools/pkg/filter"
 	tiddl "github.com/pingcap/tidb/ddl"
@@ -214,6 +222,24 @@ func (s *testSyncerSuite) TestGenMultipleKeys(c *C) {
 			values: []interface{}{17, nil},
 			keys:   []string{"17.a.table"},
 		},
+		{
+			// `null` for one column of unique key of multiple columns
+			schema: `create table t9(a int primary key, b int, c int, unique key(b, c))`,
+			values: []interface{}{18, 28, nil},
+			keys:   []string{"18.a.table"},
+		},
+		{
+			// `null` for unique key without primary key
+			schema: `create table t10(a int, b int, unique key(a, b), unique key(b))`,
+			values: []interface{}{19, nil},
+			keys:   []string{"table"},
+		},
+		{
+			// `null` for one of the unique keys without primary key
+			schema: `create table t11(a int, b int, unique key(a), unique key(b))`,
+			values: []interface{}{nil, 20},
+			keys:   []string{"20.b.table"},
+		},
 	}
 	sessCtx := utils.NewSessionCtx(map[string]string{"time_zone": "UTC"})
 	for i, tc := range testCases {
@@ -231,6 +257,77 @@ func (s *testSyncerSuite) TestGenMultipleKeys(c *C) {
 	}
 }
 
+func (s *testSyncerSuite) TestGenMultipleKeysUntrackedUK(c *C) {
+	p := parser.New()
+	se := mock.NewContext()
+	sessCtx := utils.NewSessionCtx(map[string]string{"time_zone": "UTC"})
+
+	ti, err := createTableInfo(p, se, 1, `create table t(a int primary key, b int)`)
+	c.Assert(err, IsNil)
+	// the unique key of downstream contains a column which doesn't exist in upstream
+	downTi, err := createTableInfo(p, se, 2, `create table t(a int primary key, b int, c int, unique key(b, c))`)
+	c.Assert(err, IsNil)
+	dti := schema.GetDownStreamTI(downTi, ti)
+	c.Assert(dti.HasUntrackedUK, IsTrue)
+	keys := genMultipleKeys(sessCtx, dti, ti, []interface{}{1, 2}, "table")
+	c.Assert(keys, DeepEquals, []string{"1.a.table", "table"})
+
+	// the hidden column of expression index is not in binlog
+	ti.Columns = append(ti.Columns, &model.ColumnInfo{
+		Name:   model.NewCIStr("_V$_expr_idx_0"),
+		Offset: len(ti.Columns),
+		Hidden: true,
+	})
+	downTi.Indices[0].Columns[1].Name = model.NewCIStr("_V$_expr_idx_0")
+	dti = schema.GetDownStreamTI(downTi, ti)
+	c.Assert(dti.HasUntrackedUK, IsTrue)
+	keys = genMultipleKeys(sessCtx, dti, ti, []interface{}{1, 2}, "table")
+	c.Assert(keys, DeepEquals, []string{"1.a.table", "table"})
+
+	// all unique keys are tracked
+	dti = schema.GetDownStreamTI(ti, ti)
+	c.Assert(dti.HasUntrackedUK, IsFalse)
+	keys = genMultipleKeys(sessCtx, dti, ti, []interface{}{1, 2}, "table")
+	c.Assert(keys, DeepEquals, []string{"1.a.table"})
+}
+
+func (s *testSyncerSuite) TestGenMultipleKeysWithSourceTag(c *C) {
+	p := parser.New()
+	se := mock.NewContext()
+	sessCtx := utils.NewSessionCtx(map[string]string{"time_zone": "UTC"})
+
+	// the table info of a merged table is fetched from downstream, which contains the source tag column.
+	ti, err := createTableInfo(p, se, 1, "create table t(id int, _dm_source varchar(32), primary key(id, _dm_source))")
+	c.Assert(err, IsNil)
+	dti := schema.GetDownStreamTI(ti, ti)
+	r, err := router.NewTableRouter(false, []*router.TableRule{{
+		SchemaPattern: "db_*", TargetSchema: "db",
+		SourceExtractor: &router.SourceExtractor{TargetColumn: "_dm_source", SourceRegexp: "(.*)"},
+	}})
+	c.Assert(err, IsNil)
+	table := &filter.Table{Schema: "db_1", Name: "t"}
+
+	rows1 := generateExtendColumn([][]interface{}{{1}}, r, table, "mysql-01")
+	c.Assert(rows1, DeepEquals, [][]interface{}{{1, "mysql-01"}})
+	rows2 := generateExtendColumn([][]interface{}{{1}}, r, table, "mysql-02")
+	keys1 := genMultipleKeys(sessCtx, dti, ti, rows1[0], "table")
+	keys2 := genMultipleKeys(sessCtx, dti, ti, rows2[0], "table")
+	c.Assert(keys1, DeepEquals, []string{"1.id.mysql-01._dm_source.table"})
+	c.Assert(keys2, DeepEquals, []string{"1.id.mysql-02._dm_source.table"})
+
+	// the tables not matched by any route rule are tagged too
+	cfg, err := s.cfg.Clone()
+	c.Assert(err, IsNil)
+	cfg.RouteRules = nil
+	cfg.SourceTagColumn = "_dm_source"
+	syncer := NewSyncer(cfg, nil, nil)
+	c.Assert(syncer.genRouter(), IsNil)
+	rows3 := generateExtendColumn([][]interface{}{{1}}, syncer.tableRouter, &filter.Table{Schema: "other", Name: "t"}, cfg.SourceID)
+	c.Assert(rows3, DeepEquals, [][]interface{}{{1, cfg.SourceID}})
+	keys3 := genMultipleKeys(sessCtx, dti, ti, rows3[0], "table")
+	c.Assert(keys3, DeepEquals, []string{"1.id." + cfg.SourceID + "._dm_source.table"})
+}
+
 func (s *testSyncerSuite) TestGenWhere(c *C) {
 	p := parser.New()
 	se := mock.NewContext()
@@ -566,7 +663,7 @@ func (s *testSyncerSuite) TestGenDMLWithSameOp(c *C) {
 		{44, 55, 66},
 	}
 
-	queries, args := genDMLsWithSameOp(dmls)
+	queries, args := genDMLsWithSameOp(dmls, 0)
 	c.Assert(queries, DeepEquals, expectQueries)
 	c.Assert(args, DeepEquals, expectArgs)
 }
@@ -647,3 +744,54 @@ func (s *testSyncerSuite) TestGBKExtractValueFromData(c *C) {
 	got := extractValueFromData(row, ti.Columns, ti)
 	c.Assert(got, DeepEquals, expect)
 }
+
+func (s *testSyncerSuite) TestSkippedColumns(c *C) {
+	ti, err := createTableInfo(parser.New(), mock.NewContext(), 1, `create table t (
+		id int primary key, a int,
+		g1 int as (a + 1) stored, g2 int as (a + 2) virtual, g3 int as (a + 3) virtual,
+		h int comment 'dm:invisible-column')`)
+	c.Assert(err, IsNil)
+
+	db, dbMock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	dbConn, err := db.Conn(context.Background())
+	c.Assert(err, IsNil)
+	cfg := &config.SubTaskConfig{}
+	syncer := NewSyncer(cfg, nil, nil)
+	syncer.schemaTracker, err = schema.NewTracker(context.Background(), "test", defaultTestSessionCfg,
+		&dbconn.DBConn{Cfg: cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})})
+	c.Assert(err, IsNil)
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec("SET SESSION SQL_MODE.*").WillReturnResult(sqlmock.NewResult(0, 0))
+	dbMock.ExpectCommit()
+	dbMock.ExpectQuery("SHOW CREATE TABLE.*").WillReturnRows(
+		sqlmock.NewRows([]string{"Table", "Create Table"}).AddRow("t", "CREATE TABLE `t` (`id` int PRIMARY KEY, `a` int, "+
+			"`g1` int, `g2` int GENERATED ALWAYS AS (`a` + 2) VIRTUAL, `h` int)"))
+	tctx := tcontext.Background()
+
+	cases := []struct {
+		generated config.ColumnPolicy
+		invisible config.ColumnPolicy
+		skipped   []bool
+	}{
+		{config.ColumnPolicySkip, config.ColumnPolicyMaterialize, []bool{false, false, true, true, true, false}},
+		{config.ColumnPolicyMaterialize, config.ColumnPolicySkip, []bool{false, false, false, true, true, true}},
+		{config.ColumnPolicyMaterializeStored, config.ColumnPolicyMaterialize, []bool{false, false, false, true, true, false}},
+	}
+	for _, cs := range cases {
+		cfg.GeneratedColumnPolicy = cs.generated
+		cfg.InvisibleColumnPolicy = cs.invisible
+		skipped, err2 := syncer.skippedColumns(tctx, ti, "`test`.`t`")
+		c.Assert(err2, IsNil)
+		c.Assert(skipped, DeepEquals, cs.skipped)
+	}
+	c.Assert(dbMock.ExpectationsWereMet(), IsNil)
+
+	cols, rows, err := pruneColumnDML(ti, [][]interface{}{{1, 2, 3, 4, 5, 6}}, cases[1].skipped)
+	c.Assert(err, IsNil)
+	c.Assert(cols, DeepEquals, ti.Columns[:3])
+	c.Assert(rows, DeepEquals, [][]interface{}{{1, 2, 3}})
+	cols, _, err = pruneColumnDML(ti, nil, nil)
+	c.Assert(err, IsNil)
+	c.Assert(cols, DeepEquals, ti.Columns)
+}
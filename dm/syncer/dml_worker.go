@@ -19,6 +19,7 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb-tools/pkg/filter"
+	tmysql "github.com/pingcap/tidb/parser/mysql"
 	"go.uber.org/zap"
 
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
@@ -27,6 +28,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/dm/syncer/dbconn"
 	"github.com/pingcap/tiflow/dm/syncer/metrics"
+	sm "github.com/pingcap/tiflow/dm/syncer/safe-mode"
 )
 
 // DMLWorker is used to sync dml.
@@ -35,9 +37,18 @@ type DMLWorker struct {
 	workerCount  int
 	chanSize     int
 	multipleRows bool
-	toDBConns    []*dbconn.DBConn
-	tctx         *tcontext.Context
-	logger       log.Logger
+	// the max number of rows combined into one statement in multipleRows mode, 0 means no limit.
+	multipleRowsBatch int
+	toDBConns         []*dbconn.DBConn
+	dialect           sqlDialect
+	tctx              *tcontext.Context
+	logger            log.Logger
+	// the tables which enter safe-mode automatically on duplicate entry or missing row errors, nil means disabled.
+	autoSafeMode *sm.AutoSafeMode
+	// the quota of the buffered jobs, it's released after the jobs are executed.
+	memQuota *memQuota
+	// the statements are written to it instead of being executed in dry-run mode.
+	dryRun *dryRunWriter
 
 	// for metrics
 	task   string
@@ -63,22 +74,30 @@ func dmlWorkerWrap(inCh chan *job, syncer *Syncer) chan *job {
 		chanSize /= 2
 	}
 	dmlWorker := &DMLWorker{
-		batch:        syncer.cfg.Batch,
-		workerCount:  syncer.cfg.WorkerCount,
-		chanSize:     chanSize,
-		multipleRows: syncer.cfg.MultipleRows,
-		task:         syncer.cfg.Name,
-		source:       syncer.cfg.SourceID,
-		worker:       syncer.cfg.WorkerName,
-		logger:       syncer.tctx.Logger.WithFields(zap.String("component", "dml_worker")),
-		successFunc:  syncer.successFunc,
-		fatalFunc:    syncer.fatalFunc,
-		lagFunc:      syncer.updateReplicationJobTS,
-		addCountFunc: syncer.addCount,
-		tctx:         syncer.tctx,
-		toDBConns:    syncer.toDBConns,
-		inCh:         inCh,
-		flushCh:      make(chan *job),
+		batch:             syncer.cfg.Batch,
+		workerCount:       syncer.cfg.WorkerCount,
+		chanSize:          chanSize,
+		multipleRows:      syncer.cfg.MultipleRows,
+		multipleRowsBatch: syncer.cfg.MultipleRowsBatch,
+		task:              syncer.cfg.Name,
+		source:            syncer.cfg.SourceID,
+		worker:            syncer.cfg.WorkerName,
+		logger:            syncer.tctx.Logger.WithFields(zap.String("component", "dml_worker")),
+		successFunc:       syncer.successFunc,
+		fatalFunc:         syncer.fatalFunc,
+		lagFunc:           syncer.updateReplicationJobTS,
+		addCountFunc:      syncer.addCount,
+		tctx:              syncer.tctx,
+		toDBConns:         syncer.toDBConns,
+		dialect:           syncer.dialect,
+		memQuota:          syncer.memQuota,
+		dryRun:            syncer.dryRun,
+		inCh:              inCh,
+		flushCh:           make(chan *job),
+	}
+
+	if syncer.cfg.AutoSafeModeDuration > 0 {
+		dmlWorker.autoSafeMode = sm.NewAutoSafeMode(time.Duration(syncer.cfg.AutoSafeModeDuration) * time.Second)
 	}
 
 	go func() {
@@ -128,11 +147,16 @@ func (w *DMLWorker) run() {
 			w.flushCh <- j
 		case conflict:
 			w.addCountFunc(false, adminQueueName, j.tp, 1, j.targetTable)
-			w.sendJobToAllDmlQueue(j, jobChs, queueBucketMapping)
+			// only the queues of the conflicting causality groups are synchronized, others keep executing.
+			for _, queueID := range j.conflictQueues {
+				startTime := time.Now()
+				jobChs[queueID] <- j
+				metrics.AddJobDurationHistogram.WithLabelValues(j.tp.String(), w.task, queueBucketMapping[queueID], w.source).Observe(time.Since(startTime).Seconds())
+			}
 			j.flushWg.Wait()
 			w.addCountFunc(true, adminQueueName, j.tp, 1, j.targetTable)
 		default:
-			queueBucket := int(utils.GenHashKey(j.dml.key)) % w.workerCount
+			queueBucket := dmlQueueID(j.dml.key, w.workerCount)
 			w.addCountFunc(false, queueBucketMapping[queueBucket], j.tp, 1, j.targetTable)
 			startTime := time.Now()
 			w.logger.Debug("queue for key", zap.Int("queue", queueBucket), zap.String("key", j.dml.key))
@@ -142,6 +166,11 @@ func (w *DMLWorker) run() {
 	}
 }
 
+// dmlQueueID returns the DML queue of a causality key, jobs with the same key are executed in order in the same queue.
+func dmlQueueID(key string, workerCount int) int {
+	return int(utils.GenHashKey(key)) % workerCount
+}
+
 func (w *DMLWorker) sendJobToAllDmlQueue(j *job, jobChs []chan *job, queueBucketMapping []string) {
 	// flush for every DML queue
 	for i, jobCh := range jobChs {
@@ -205,6 +234,8 @@ func (w *DMLWorker) executeBatchJobs(queueID int, jobs []*job) {
 	)
 
 	defer func() {
+		// the jobs are not buffered anymore whether they're executed or not
+		w.memQuota.release(jobs...)
 		if err == nil {
 			w.successFunc(queueID, len(dmls), jobs)
 		} else {
@@ -241,7 +272,7 @@ func (w *DMLWorker) executeBatchJobs(queueID int, jobs []*job) {
 	for _, j := range jobs {
 		dmls = append(dmls, j.dml)
 	}
-	queries, args = w.genSQLs(dmls)
+	w.applyAutoSafeMode(dmls)
 	failpoint.Inject("WaitUserCancel", func(v failpoint.Value) {
 		t := v.(int)
 		time.Sleep(time.Duration(t) * time.Second)
@@ -249,7 +280,27 @@ func (w *DMLWorker) executeBatchJobs(queueID int, jobs []*job) {
 	// use background context to execute sqls as much as possible
 	ctx, cancel := w.tctx.WithTimeout(maxDMLExecutionDuration)
 	defer cancel()
-	affect, err = db.ExecuteSQL(ctx, queries, args...)
+	execute := func() {
+		queries, args = w.genSQLs(dmls)
+		if w.dryRun != nil {
+			affect, err = w.dryRun.writeDMLs(jobs, queries, args)
+			return
+		}
+		affect, err = db.ExecuteSQL(ctx, queries, args...)
+		if err != nil && w.multipleRows && len(dmls) > 1 && ctx.Context().Err() == nil {
+			// the SQLs are executed in one transaction which has been rolled back, so fall back to one statement per row
+			// to skip the limitation of multiple rows statements like max_allowed_packet, or find out the failed row.
+			w.logger.Warn("fail to execute DMLs with multiple rows, fall back to one statement per row",
+				zap.Int("queries", len(queries)), zap.Int("rows", len(dmls)), log.ShortError(err))
+			queries, args = w.genSQLsPerRow(dmls)
+			affect, err = db.ExecuteSQL(ctx, queries, args...)
+		}
+	}
+	execute()
+	if err != nil && w.autoSafeMode != nil && ctx.Context().Err() == nil && w.triggerAutoSafeMode(dmls, len(queries), affect, err) {
+		// the transaction has been rolled back, retry all DMLs with the affected tables in safe-mode.
+		execute()
+	}
 	failpoint.Inject("SafeModeExit", func(val failpoint.Value) {
 		if intVal, ok := val.(int); ok && intVal == 4 && len(jobs) > 0 {
 			w.logger.Warn("fail to exec DML", zap.String("failpoint", "SafeModeExit"))
@@ -265,16 +316,62 @@ func (w *DMLWorker) executeBatchJobs(queueID int, jobs []*job) {
 	})
 }
 
+// applyAutoSafeMode sets safe-mode for the DMLs of the tables which enter safe-mode automatically.
+func (w *DMLWorker) applyAutoSafeMode(dmls []*DML) {
+	if w.autoSafeMode == nil {
+		return
+	}
+	for _, dml := range dmls {
+		if !dml.safeMode && w.autoSafeMode.Enable(dml.targetTableID) {
+			dml.safeMode = true
+		}
+	}
+}
+
+// triggerAutoSafeMode lets the tables of the failed DMLs enter safe-mode if err is a duplicate entry or missing row
+// error, which is usually caused by re-executing DMLs after an unclean resume. it returns whether the DMLs should be
+// retried in safe-mode.
+func (w *DMLWorker) triggerAutoSafeMode(dmls []*DML, queryCount, affect int, err error) bool {
+	if !utils.IsMySQLError(err, tmysql.ErrDupEntry) && !utils.IsMySQLError(err, tmysql.ErrKeyNotFound) {
+		return false
+	}
+	failedDMLs := dmls
+	if queryCount == len(dmls) && affect < len(dmls) {
+		failedDMLs = dmls[affect : affect+1]
+	}
+
+	tables := make([]string, 0, len(failedDMLs))
+	for _, dml := range failedDMLs {
+		// safe-mode can't help the DMLs already in safe-mode.
+		if dml.safeMode {
+			continue
+		}
+		w.autoSafeMode.Trigger(dml.targetTableID)
+		tables = append(tables, dml.targetTableID)
+	}
+	if len(tables) == 0 {
+		return false
+	}
+	w.logger.Warn("meet duplicate entry or missing row error, enter safe-mode automatically for tables",
+		zap.Strings("tables", tables), log.ShortError(err))
+	w.applyAutoSafeMode(dmls)
+	return true
+}
+
 // genSQLs generate SQLs in single row mode or multiple rows mode.
 func (w *DMLWorker) genSQLs(dmls []*DML) ([]string, [][]interface{}) {
 	if w.multipleRows {
-		return genDMLsWithSameOp(dmls)
+		return genDMLsWithSameOp(dmls, w.multipleRowsBatch)
 	}
+	return w.genSQLsPerRow(dmls)
+}
 
+// genSQLsPerRow generates SQLs for each row.
+func (w *DMLWorker) genSQLsPerRow(dmls []*DML) ([]string, [][]interface{}) {
 	queries := make([]string, 0, len(dmls))
 	args := make([][]interface{}, 0, len(dmls))
 	for _, dml := range dmls {
-		query, arg := dml.genSQL()
+		query, arg := w.dialect.genDMLSQL(dml)
 		queries = append(queries, query...)
 		args = append(args, arg...)
 	}
@@ -0,0 +1,179 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	tmysql "github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/util/mock"
+
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/retry"
+	"github.com/pingcap/tiflow/dm/pkg/schema"
+	"github.com/pingcap/tiflow/dm/syncer/dbconn"
+	sm "github.com/pingcap/tiflow/dm/syncer/safe-mode"
+)
+
+func genInsertJobsForTest(c *C, rows int) []*job {
+	location := binlog.NewLocation("")
+	ec := &eventContext{startLocation: &location, currentLocation: &location, lastLocation: &location}
+	sourceTable := &filter.Table{Schema: "test", Name: "tb1"}
+	targetTable := &filter.Table{Schema: "test", Name: "tb"}
+	ti, err := createTableInfo(parser.New(), mock.NewContext(), 0, "create table test.tb(id int primary key, col1 int)")
+	c.Assert(err, IsNil)
+	downTi := schema.GetDownStreamTI(ti, ti)
+
+	jobs := make([]*job, 0, rows)
+	for i := 0; i < rows; i++ {
+		values := []interface{}{i, i}
+		dml := newDML(insert, false, "`test`.`tb`", sourceTable, nil, values, nil, values, ti.Columns, ti, downTi.AvailableUKIndexList[0], downTi)
+		jobs = append(jobs, newDMLJob(insert, sourceTable, targetTable, dml, ec))
+	}
+	return jobs
+}
+
+func (s *testSyncerSuite) TestGenSQLsMultipleRowsBatch(c *C) {
+	jobs := genInsertJobsForTest(c, 5)
+	dmls := make([]*DML, 0, len(jobs))
+	for _, j := range jobs {
+		dmls = append(dmls, j.dml)
+	}
+
+	w := &DMLWorker{multipleRows: true, dialect: mysqlDialect{}}
+	queries, args := w.genSQLs(dmls)
+	c.Assert(queries, DeepEquals, []string{"INSERT INTO `test`.`tb` (`id`,`col1`) VALUES (?,?),(?,?),(?,?),(?,?),(?,?)"})
+	c.Assert(args, HasLen, 1)
+
+	w.multipleRowsBatch = 2
+	queries, args = w.genSQLs(dmls)
+	c.Assert(queries, DeepEquals, []string{
+		"INSERT INTO `test`.`tb` (`id`,`col1`) VALUES (?,?),(?,?)",
+		"INSERT INTO `test`.`tb` (`id`,`col1`) VALUES (?,?),(?,?)",
+		"INSERT INTO `test`.`tb` (`id`,`col1`) VALUES (?,?)",
+	})
+	c.Assert(args, DeepEquals, [][]interface{}{{0, 0, 1, 1}, {2, 2, 3, 3}, {4, 4}})
+
+	w.multipleRows = false
+	queries, _ = w.genSQLs(dmls)
+	c.Assert(queries, HasLen, 5)
+}
+
+func (s *testSyncerSuite) TestExecuteMultipleRowsFallback(c *C) {
+	db, dbMock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	dbConn, err := db.Conn(context.Background())
+	c.Assert(err, IsNil)
+
+	var (
+		succeed int
+		failed  error
+	)
+	w := &DMLWorker{
+		multipleRows: true,
+		toDBConns:    []*dbconn.DBConn{{Cfg: s.cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})}},
+		dialect:      mysqlDialect{},
+		tctx:         tcontext.Background(),
+		logger:       log.L(),
+		successFunc: func(_, n int, _ []*job) {
+			succeed += n
+		},
+		fatalFunc: func(_ *job, err error) {
+			failed = err
+		},
+	}
+
+	// the statement with multiple rows fails, then each row is executed with its own statement
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec("INSERT INTO `test`.`tb` \\(`id`,`col1`\\) VALUES \\(\\?,\\?\\),\\(\\?,\\?\\)").
+		WillReturnError(errors.New("packet for query is too large"))
+	dbMock.ExpectRollback()
+	dbMock.ExpectBegin()
+	for i := 0; i < 2; i++ {
+		dbMock.ExpectExec("INSERT INTO `test`.`tb` \\(`id`,`col1`\\) VALUES \\(\\?,\\?\\)").
+			WithArgs(i, i).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	dbMock.ExpectCommit()
+	w.executeBatchJobs(0, genInsertJobsForTest(c, 2))
+	c.Assert(failed, IsNil)
+	c.Assert(succeed, Equals, 2)
+	c.Assert(dbMock.ExpectationsWereMet(), IsNil)
+}
+
+func (s *testSyncerSuite) TestExecuteAutoSafeMode(c *C) {
+	db, dbMock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	dbConn, err := db.Conn(context.Background())
+	c.Assert(err, IsNil)
+
+	var (
+		succeed int
+		failed  error
+	)
+	w := &DMLWorker{
+		toDBConns:    []*dbconn.DBConn{{Cfg: s.cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})}},
+		dialect:      mysqlDialect{},
+		tctx:         tcontext.Background(),
+		logger:       log.L(),
+		autoSafeMode: sm.NewAutoSafeMode(time.Minute),
+		successFunc: func(_, n int, _ []*job) {
+			succeed += n
+		},
+		fatalFunc: func(_ *job, err error) {
+			failed = err
+		},
+	}
+
+	// the duplicate entry error lets the table enter safe-mode, and the DMLs are retried in safe-mode
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec("INSERT INTO `test`.`tb`").WithArgs(0, 0).WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectExec("INSERT INTO `test`.`tb`").WithArgs(1, 1).
+		WillReturnError(&mysql.MySQLError{Number: tmysql.ErrDupEntry, Message: "Duplicate entry '1' for key 'PRIMARY'"})
+	dbMock.ExpectRollback()
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec("REPLACE INTO `test`.`tb`").WithArgs(0, 0).WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectExec("REPLACE INTO `test`.`tb`").WithArgs(1, 1).WillReturnResult(sqlmock.NewResult(0, 2))
+	dbMock.ExpectCommit()
+	w.executeBatchJobs(0, genInsertJobsForTest(c, 2))
+	c.Assert(failed, IsNil)
+	c.Assert(succeed, Equals, 2)
+
+	// the table is still in safe-mode
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec("REPLACE INTO `test`.`tb`").WithArgs(0, 0).WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectCommit()
+	w.executeBatchJobs(0, genInsertJobsForTest(c, 1))
+	c.Assert(failed, IsNil)
+	c.Assert(dbMock.ExpectationsWereMet(), IsNil)
+
+	// other errors don't trigger safe-mode
+	w.autoSafeMode = sm.NewAutoSafeMode(time.Minute)
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec("INSERT INTO `test`.`tb`").WithArgs(0, 0).
+		WillReturnError(&mysql.MySQLError{Number: tmysql.ErrNoSuchTable, Message: "Table 'test.tb' doesn't exist"})
+	dbMock.ExpectRollback()
+	w.executeBatchJobs(0, genInsertJobsForTest(c, 1))
+	c.Assert(failed, NotNil)
+	c.Assert(dbMock.ExpectationsWereMet(), IsNil)
+}
@@ -0,0 +1,113 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// dryRunRecord is a statement generated in dry-run mode, it's written to the dry-run file as a line of JSON.
+type dryRunRecord struct {
+	Type          string        `json:"type"`
+	StartLocation string        `json:"start-location"`
+	EndLocation   string        `json:"end-location"`
+	SQL           string        `json:"sql"`
+	Args          []interface{} `json:"args,omitempty"`
+}
+
+// dryRunWriter writes the statements generated in dry-run mode to `<dry-run-dir>/<task>.<source>.jsonl` instead of
+// executing them in the target database. the DMLs of different DML queues are written concurrently, so they're only
+// ordered by the locations in the same queue.
+type dryRunWriter struct {
+	mu   sync.Mutex
+	path string
+	file *os.File
+}
+
+// newDryRunWriter opens the dry-run file of the subtask, the statements are appended to the existing file.
+func newDryRunWriter(cfg *config.SubTaskConfig) (*dryRunWriter, error) {
+	if err := os.MkdirAll(cfg.DryRunDir, 0o755); err != nil {
+		return nil, terror.ErrSyncerDryRunWrite.Delegate(err, cfg.DryRunDir)
+	}
+	path := filepath.Join(cfg.DryRunDir, fmt.Sprintf("%s.%s.jsonl", cfg.Name, cfg.SourceID))
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, terror.ErrSyncerDryRunWrite.Delegate(err, path)
+	}
+	return &dryRunWriter{path: path, file: file}, nil
+}
+
+// writeDMLs writes the statements generated from the DML jobs, and returns the number of written statements.
+func (w *dryRunWriter) writeDMLs(jobs []*job, queries []string, args [][]interface{}) (int, error) {
+	records := make([]*dryRunRecord, 0, len(queries))
+	for i, query := range queries {
+		// the statements can't be matched to the jobs when the rows are combined or split by safe-mode.
+		start, end := jobs[0].startLocation, jobs[len(jobs)-1].currentLocation
+		tp := "dml"
+		if len(queries) == len(jobs) {
+			start, end, tp = jobs[i].startLocation, jobs[i].currentLocation, jobs[i].tp.String()
+		}
+		records = append(records, newDryRunRecord(tp, start, end, query, args[i]))
+	}
+	return w.write(records)
+}
+
+// writeQueries writes the statements without arguments of an event, like DDLs, and returns the number of written
+// statements.
+func (w *dryRunWriter) writeQueries(tp string, start, end binlog.Location, queries []string) (int, error) {
+	records := make([]*dryRunRecord, 0, len(queries))
+	for _, query := range queries {
+		records = append(records, newDryRunRecord(tp, start, end, query, nil))
+	}
+	return w.write(records)
+}
+
+func newDryRunRecord(tp string, start, end binlog.Location, query string, args []interface{}) *dryRunRecord {
+	return &dryRunRecord{
+		Type:          tp,
+		StartLocation: start.String(),
+		EndLocation:   end.String(),
+		SQL:           query,
+		Args:          args,
+	}
+}
+
+func (w *dryRunWriter) write(records []*dryRunRecord) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for i, record := range records {
+		data, err := json.Marshal(record)
+		if err != nil {
+			return i, terror.ErrSyncerDryRunWrite.Delegate(err, w.path)
+		}
+		if _, err = w.file.Write(append(data, '\n')); err != nil {
+			return i, terror.ErrSyncerDryRunWrite.Delegate(err, w.path)
+		}
+	}
+	return len(records), nil
+}
+
+func (w *dryRunWriter) close() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	_ = w.file.Close()
+}
@@ -0,0 +1,99 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/syncer/dbconn"
+)
+
+func (s *testSyncerSuite) TestDryRunWriter(c *C) {
+	cfg, err := s.cfg.Clone()
+	c.Assert(err, IsNil)
+	cfg.DryRunDir = filepath.Join(c.MkDir(), "dry-run")
+	dryRun, err := newDryRunWriter(cfg)
+	c.Assert(err, IsNil)
+	c.Assert(dryRun.path, Equals, filepath.Join(cfg.DryRunDir, cfg.Name+"."+cfg.SourceID+".jsonl"))
+
+	// the DMLs are written instead of being executed in the target database
+	var (
+		succeed int
+		failed  error
+	)
+	w := &DMLWorker{
+		multipleRows: true,
+		toDBConns:    []*dbconn.DBConn{nil},
+		dialect:      mysqlDialect{},
+		tctx:         tcontext.Background(),
+		logger:       log.L(),
+		dryRun:       dryRun,
+		successFunc: func(_, n int, _ []*job) {
+			succeed += n
+		},
+		fatalFunc: func(_ *job, err error) {
+			failed = err
+		},
+	}
+	w.executeBatchJobs(0, genInsertJobsForTest(c, 2))
+	c.Assert(failed, IsNil)
+	c.Assert(succeed, Equals, 2)
+	w.multipleRows = false
+	w.executeBatchJobs(0, genInsertJobsForTest(c, 1))
+	c.Assert(failed, IsNil)
+	c.Assert(succeed, Equals, 3)
+
+	start, end := binlog.NewLocation(""), binlog.NewLocation("")
+	end.Position.Pos = 100
+	n, err := dryRun.writeQueries(ddl.String(), start, end, []string{"ALTER TABLE `test`.`tb` ADD COLUMN `c` INT"})
+	c.Assert(err, IsNil)
+	c.Assert(n, Equals, 1)
+	dryRun.close()
+
+	// the statements are appended after reopening
+	dryRun, err = newDryRunWriter(cfg)
+	c.Assert(err, IsNil)
+	_, err = dryRun.writeQueries("statement-dml", start, end, []string{"DELETE FROM `test`.`tb`"})
+	c.Assert(err, IsNil)
+	dryRun.close()
+
+	data, err := os.ReadFile(filepath.Join(cfg.DryRunDir, cfg.Name+"."+cfg.SourceID+".jsonl"))
+	c.Assert(err, IsNil)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	c.Assert(lines, HasLen, 4)
+	records := make([]dryRunRecord, 0, len(lines))
+	for _, line := range lines {
+		var record dryRunRecord
+		c.Assert(json.Unmarshal([]byte(line), &record), IsNil)
+		records = append(records, record)
+	}
+	c.Assert(records[0].Type, Equals, "dml")
+	c.Assert(records[0].SQL, Equals, "INSERT INTO `test`.`tb` (`id`,`col1`) VALUES (?,?),(?,?)")
+	c.Assert(records[0].Args, DeepEquals, []interface{}{0.0, 0.0, 1.0, 1.0})
+	c.Assert(records[1].Type, Equals, "insert")
+	c.Assert(records[1].SQL, Equals, "INSERT INTO `test`.`tb` (`id`,`col1`) VALUES (?,?)")
+	c.Assert(records[2].Type, Equals, "ddl")
+	c.Assert(records[2].StartLocation, Equals, start.String())
+	c.Assert(records[2].EndLocation, Equals, end.String())
+	c.Assert(records[2].Args, IsNil)
+	c.Assert(records[3].Type, Equals, "statement-dml")
+}
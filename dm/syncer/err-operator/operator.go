@@ -19,6 +19,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/go-mysql-org/go-mysql/mysql"
 	"github.com/go-mysql-org/go-mysql/replication"
@@ -31,6 +32,9 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 )
 
+// maxHistoryCount is the max number of records of the operations kept by Holder.
+const maxHistoryCount = 100
+
 // Operator contains an operation for specified binlog pos
 // used by `handle-error`.
 type Operator struct {
@@ -38,6 +42,7 @@ type Operator struct {
 	op        pb.ErrorOp
 	events    []*replication.BinlogEvent // ddls -> events
 	originReq *pb.HandleWorkerErrorRequest
+	setTime   time.Time
 }
 
 // newOperator creates a new operator with a random UUID.
@@ -47,9 +52,37 @@ func newOperator(op pb.ErrorOp, events []*replication.BinlogEvent, originReq *pb
 		op:        op,
 		events:    events,
 		originReq: originReq,
+		setTime:   time.Now(),
+	}
+}
+
+// Record is a record of an operation, the op is in lower case like `skip` and the binlog pos is in the format of
+// `--binlog-pos` like `mysql-bin.000001:3270`.
+type Record struct {
+	Op        string    `json:"op"`
+	BinlogPos string    `json:"binlog_pos"`
+	Sqls      []string  `json:"sqls,omitempty"`
+	Time      time.Time `json:"time"`
+}
+
+func newRecord(req *pb.HandleWorkerErrorRequest, t time.Time) *Record {
+	binlogPos := req.BinlogPos
+	if pos, err := binlog.PositionFromPosStr(binlogPos); err == nil {
+		binlogPos = FormatBinlogPos(pos)
+	}
+	return &Record{
+		Op:        strings.ToLower(req.Op.String()),
+		BinlogPos: binlogPos,
+		Sqls:      req.Sqls,
+		Time:      t,
 	}
 }
 
+// FormatBinlogPos formats the position in the format of `--binlog-pos`.
+func FormatBinlogPos(pos mysql.Position) string {
+	return fmt.Sprintf("%s:%d", pos.Name, pos.Pos)
+}
+
 func (o *Operator) String() string {
 	events := make([]string, 0)
 	for _, e := range o.events {
@@ -64,6 +97,7 @@ func (o *Operator) String() string {
 type Holder struct {
 	mu        sync.RWMutex
 	operators map[string]*Operator
+	history   []*Record // the operations set or reverted, used to audit the operations
 
 	logger log.Logger
 }
@@ -89,6 +123,7 @@ func (h *Holder) Set(req *pb.HandleWorkerErrorRequest, events []*replication.Bin
 			return terror.ErrSyncerOperatorNotExist.Generate(pos)
 		}
 		delete(h.operators, pos)
+		h.addHistory(newRecord(req, time.Now()))
 		return nil
 	}
 
@@ -98,15 +133,54 @@ func (h *Holder) Set(req *pb.HandleWorkerErrorRequest, events []*replication.Bin
 		h.logger.Warn("overwrite operator", zap.String("position", pos), zap.Stringer("old operator", pre))
 	}
 	h.operators[pos] = oper
+	h.addHistory(newRecord(req, oper.setTime))
 	h.logger.Info("set a new operator", zap.String("position", pos), zap.Stringer("new operator", oper))
 	return nil
 }
 
+func (h *Holder) addHistory(record *Record) {
+	h.history = append(h.history, record)
+	if len(h.history) > maxHistoryCount {
+		h.history = h.history[len(h.history)-maxHistoryCount:]
+	}
+}
+
+// GetHistory returns the records of the operations set or reverted, in the order of time.
+func (h *Holder) GetHistory() []*Record {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return append(make([]*Record, 0, len(h.history)), h.history...)
+}
+
 // GetBehindCommands gets behind commands.
 func (h *Holder) GetBehindCommands(pos string) []*pb.HandleWorkerErrorRequest {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
 
+	operators := h.behindOperators(pos)
+	res := make([]*pb.HandleWorkerErrorRequest, 0, len(operators))
+	for _, operator := range operators {
+		res = append(res, operator.originReq)
+	}
+	return res
+}
+
+// GetBehindRecords gets the records of behind operators.
+func (h *Holder) GetBehindRecords(pos string) []*Record {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	operators := h.behindOperators(pos)
+	res := make([]*Record, 0, len(operators))
+	for _, operator := range operators {
+		res = append(res, newRecord(operator.originReq, operator.setTime))
+	}
+	return res
+}
+
+// behindOperators returns the operators at or after pos in the order of position.
+func (h *Holder) behindOperators(pos string) []*Operator {
 	current, _ := binlog.PositionFromPosStr(pos)
 
 	// find behind position
@@ -121,9 +195,9 @@ func (h *Holder) GetBehindCommands(pos string) []*pb.HandleWorkerErrorRequest {
 		return behindPositions[i].Compare(behindPositions[j]) < 0
 	})
 
-	res := make([]*pb.HandleWorkerErrorRequest, 0, len(behindPositions))
+	res := make([]*Operator, 0, len(behindPositions))
 	for _, behindPosition := range behindPositions {
-		res = append(res, h.operators[behindPosition.String()].originReq)
+		res = append(res, h.operators[behindPosition.String()])
 	}
 
 	return res
@@ -141,6 +215,19 @@ func (h *Holder) IsInject(startLocation binlog.Location) bool {
 	return operator.op == pb.ErrorOp_Inject
 }
 
+// IsApproved returns whether the DDLs at startLocation are approved to be applied.
+func (h *Holder) IsApproved(startLocation binlog.Location) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	key := startLocation.Position.String()
+	operator, ok := h.operators[key]
+	if !ok {
+		return false
+	}
+	return operator.op == pb.ErrorOp_Approve
+}
+
 // GetEvent return a replace binlog event
 // for example:
 //			startLocation		endLocation
@@ -179,7 +266,8 @@ func (h *Holder) MatchAndApply(startLocation, endLocation binlog.Location, curre
 
 	key := startLocation.Position.String()
 	operator, ok := h.operators[key]
-	if !ok {
+	// approve operator doesn't change the event, it's checked by IsApproved when handling the DDLs
+	if !ok || operator.op == pb.ErrorOp_Approve {
 		return false, pb.ErrorOp_InvalidErrorOp
 	}
 
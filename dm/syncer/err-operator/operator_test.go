@@ -319,3 +319,84 @@ func (o *testOperatorSuite) TestGetBehindCommands(c *C) {
 	c.Assert(commands, NotNil)
 	c.Assert(len(commands), Equals, 0)
 }
+
+func (o *testOperatorSuite) TestApproveOperator(c *C) {
+	logger := log.L()
+	h := NewHolder(&logger)
+
+	startLocation := binlog.Location{
+		Position: mysql.Position{
+			Name: "mysql-bin.000001",
+			Pos:  233,
+		},
+	}
+	endLocation := binlog.Location{
+		Position: mysql.Position{
+			Name: "mysql-bin.000001",
+			Pos:  250,
+		},
+	}
+	event1 := &replication.BinlogEvent{
+		Header: &replication.EventHeader{
+			EventType: replication.QUERY_EVENT,
+		},
+		Event: &replication.QueryEvent{
+			Schema: []byte("db"),
+			Query:  []byte("alter table tb add column a int"),
+		},
+	}
+	c.Assert(h.IsApproved(startLocation), IsFalse)
+
+	err := h.Set(&pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Approve, BinlogPos: startLocation.Position.String()}, nil)
+	c.Assert(err, IsNil)
+	c.Assert(h.IsApproved(startLocation), IsTrue)
+	c.Assert(h.IsApproved(endLocation), IsFalse)
+	// approved event is handled as usual
+	apply, op := h.MatchAndApply(startLocation, endLocation, event1)
+	c.Assert(apply, IsFalse)
+	c.Assert(op, Equals, pb.ErrorOp_InvalidErrorOp)
+	c.Assert(h.IsApproved(startLocation), IsTrue)
+
+	// skip the event instead
+	err = h.Set(&pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Skip, BinlogPos: startLocation.Position.String()}, nil)
+	c.Assert(err, IsNil)
+	c.Assert(h.IsApproved(startLocation), IsFalse)
+	apply, op = h.MatchAndApply(startLocation, endLocation, event1)
+	c.Assert(apply, IsTrue)
+	c.Assert(op, Equals, pb.ErrorOp_Skip)
+}
+
+func (o *testOperatorSuite) TestHistory(c *C) {
+	logger := log.L()
+	h := NewHolder(&logger)
+
+	pos := mysql.Position{Name: "mysql-bin.000001", Pos: 233}
+	c.Assert(FormatBinlogPos(pos), Equals, "mysql-bin.000001:233")
+	err := h.Set(&pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Revert, BinlogPos: pos.String()}, nil)
+	c.Assert(terror.ErrSyncerOperatorNotExist.Equal(err), IsTrue)
+	c.Assert(h.GetHistory(), HasLen, 0)
+
+	sqls := []string{"alter table db.tb add column a int"}
+	c.Assert(h.Set(&pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Replace, BinlogPos: pos.String(), Sqls: sqls}, nil), IsNil)
+	c.Assert(h.Set(&pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Revert, BinlogPos: pos.String()}, nil), IsNil)
+	history := h.GetHistory()
+	c.Assert(history, HasLen, 2)
+	c.Assert(history[0].Op, Equals, "replace")
+	c.Assert(history[0].BinlogPos, Equals, "mysql-bin.000001:233")
+	c.Assert(history[0].Sqls, DeepEquals, sqls)
+	c.Assert(history[1].Op, Equals, "revert")
+	c.Assert(history[1].Time.Before(history[0].Time), IsFalse)
+	c.Assert(h.GetBehindRecords(pos.String()), HasLen, 0)
+
+	// only the latest records are kept
+	for i := 0; i < maxHistoryCount; i++ {
+		p := mysql.Position{Name: "mysql-bin.000002", Pos: uint32(i)}
+		c.Assert(h.Set(&pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Skip, BinlogPos: p.String()}, nil), IsNil)
+	}
+	history = h.GetHistory()
+	c.Assert(history, HasLen, maxHistoryCount)
+	c.Assert(history[0].BinlogPos, Equals, "mysql-bin.000002:0")
+	records := h.GetBehindRecords(mysql.Position{Name: "mysql-bin.000002", Pos: 10}.String())
+	c.Assert(records, HasLen, maxHistoryCount-10)
+	c.Assert(records[0].BinlogPos, Equals, "mysql-bin.000002:10")
+}
@@ -25,6 +25,8 @@ import (
 
 	"github.com/pingcap/tiflow/dm/dm/config"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/rowfilter"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 )
 
@@ -41,6 +43,9 @@ type ExprFilterGroup struct {
 	hasUpdateNewFilter map[string]struct{} // set(tableName)
 	hasDeleteFilter    map[string]struct{} // set(tableName)
 
+	hasPlugin  map[string]struct{}              // set(tableName)
+	rowFilters map[string][]rowfilter.RowFilter // tableName -> row filters loaded from plugins
+
 	ctx sessionctx.Context
 }
 
@@ -56,6 +61,8 @@ func NewExprFilterGroup(ctx sessionctx.Context, exprConfig []*config.ExpressionF
 		hasUpdateOldFilter: map[string]struct{}{},
 		hasUpdateNewFilter: map[string]struct{}{},
 		hasDeleteFilter:    map[string]struct{}{},
+		hasPlugin:          map[string]struct{}{},
+		rowFilters:         map[string][]rowfilter.RowFilter{},
 		ctx:                ctx,
 	}
 	for _, c := range exprConfig {
@@ -74,6 +81,9 @@ func NewExprFilterGroup(ctx sessionctx.Context, exprConfig []*config.ExpressionF
 		if c.DeleteValueExpr != "" {
 			ret.hasDeleteFilter[tableName] = struct{}{}
 		}
+		if c.Plugin != "" {
+			ret.hasPlugin[tableName] = struct{}{}
+		}
 	}
 	return ret
 }
@@ -173,6 +183,33 @@ func (g *ExprFilterGroup) GetDeleteExprs(table *filter.Table, ti *model.TableInf
 	return g.deleteExprs[tableID], nil
 }
 
+// GetRowFilters returns the row filters loaded from plugins for given table.
+// This function will lazy load the plugins if not initialized.
+func (g *ExprFilterGroup) GetRowFilters(table *filter.Table) ([]rowfilter.RowFilter, error) {
+	tableID := utils.GenTableID(table)
+
+	if ret, ok := g.rowFilters[tableID]; ok {
+		return ret, nil
+	}
+	if _, ok := g.hasPlugin[tableID]; !ok {
+		return nil, nil
+	}
+
+	filters := make([]rowfilter.RowFilter, 0, len(g.configs[tableID]))
+	for _, c := range g.configs[tableID] {
+		if c.Plugin != "" {
+			f, err := rowfilter.Load(c.Plugin, c.PluginArgs)
+			if err != nil {
+				return nil, err
+			}
+			log.L().Info("load row filter plugin", zap.String("table", tableID), zap.String("plugin", c.Plugin))
+			filters = append(filters, &pluginRowFilter{RowFilter: f, path: c.Plugin})
+		}
+	}
+	g.rowFilters[tableID] = filters
+	return filters, nil
+}
+
 // ResetExprs deletes the expressions generated before. This should be called after table structure changed.
 func (g *ExprFilterGroup) ResetExprs(table *filter.Table) {
 	tableID := utils.GenTableID(table)
@@ -199,6 +236,74 @@ func SkipDMLByExpression(ctx sessionctx.Context, row []interface{}, expr express
 	return d.GetInt64() == 1, nil
 }
 
+// pluginRowFilter wraps the row filter loaded from a plugin, to check its results.
+type pluginRowFilter struct {
+	rowfilter.RowFilter
+	path string
+}
+
+// Filter implements rowfilter.RowFilter.
+func (f *pluginRowFilter) Filter(change *rowfilter.RowChange) (rowfilter.Action, error) {
+	oldValues := append([]interface{}(nil), change.OldValues...)
+	newValues := append([]interface{}(nil), change.NewValues...)
+	action, err := f.RowFilter.Filter(change)
+	if err != nil {
+		return action, terror.ErrSyncerRowFilterPlugin.Delegate(err, "filter "+change.Type.String(), f.path)
+	}
+	if action != rowfilter.Rewrite {
+		// only the rewritten values are used
+		change.OldValues, change.NewValues = oldValues, newValues
+		return action, nil
+	}
+	if len(change.OldValues) != len(oldValues) || len(change.NewValues) != len(newValues) {
+		return action, terror.ErrSyncerRowFilterPlugin.Generate("rewrite "+change.Type.String()+" with wrong number of values", f.path)
+	}
+	return action, nil
+}
+
+// SkipDMLByRowFilters passes the row change to the row filters in order, it returns true when the row change is
+// dropped by any filter. the values of the row change are replaced when rewritten by the filters.
+func SkipDMLByRowFilters(filters []rowfilter.RowFilter, change *rowfilter.RowChange) (bool, error) {
+	for _, f := range filters {
+		action, err := f.Filter(change)
+		if err != nil {
+			return false, err
+		}
+		if action == rowfilter.Drop {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// newRowChange creates a row change of the source table for row filters.
+func newRowChange(tp rowfilter.ChangeType, table *filter.Table, ti *model.TableInfo, oldValues, newValues []interface{}) *rowfilter.RowChange {
+	columns := make([]string, 0, len(ti.Columns))
+	for _, col := range ti.Columns {
+		columns = append(columns, col.Name.O)
+	}
+	return &rowfilter.RowChange{
+		Schema:    table.Schema,
+		Table:     table.Name,
+		Type:      tp,
+		Columns:   columns,
+		OldValues: oldValues,
+		NewValues: newValues,
+	}
+}
+
+// pickColumnValues picks the values of the pruned columns from the values of all columns.
+func pickColumnValues(values []interface{}, columns []*model.ColumnInfo, ti *model.TableInfo) []interface{} {
+	if len(columns) == len(ti.Columns) {
+		return values
+	}
+	picked := make([]interface{}, 0, len(columns))
+	for _, col := range columns {
+		picked = append(picked, values[col.Offset])
+	}
+	return picked
+}
+
 // getSimpleExprOfTable returns an expression of given `expr` string, using the table structure that is tracked before.
 func getSimpleExprOfTable(ctx sessionctx.Context, expr string, ti *model.TableInfo) (expression.Expression, error) {
 	// TODO: use upstream timezone?
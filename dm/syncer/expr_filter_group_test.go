@@ -18,10 +18,15 @@ import (
 
 	. "github.com/pingcap/check"
 	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/util/mock"
 
 	"github.com/pingcap/tiflow/dm/dm/config"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/rowfilter"
 	"github.com/pingcap/tiflow/dm/pkg/schema"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/dm/syncer/dbconn"
 )
@@ -443,3 +448,66 @@ create table t (
 	c.Assert(err, IsNil)
 	c.Assert(skip, Equals, false)
 }
+
+// testRowFilter drops the rows whose first value is 0, and doubles the first value of other rows.
+type testRowFilter struct {
+	action rowfilter.Action
+}
+
+func (f *testRowFilter) Filter(change *rowfilter.RowChange) (rowfilter.Action, error) {
+	values := change.NewValues
+	if change.Type == rowfilter.Delete {
+		values = change.OldValues
+	}
+	if values[0] == int64(0) {
+		return rowfilter.Drop, nil
+	}
+	values[0] = values[0].(int64) * 2
+	if f.action == rowfilter.Rewrite && change.Type == rowfilter.Update {
+		change.NewValues = change.NewValues[:1]
+	}
+	return f.action, nil
+}
+
+func (s *testFilterSuite) TestSkipDMLByRowFilters(c *C) {
+	ti, err := createTableInfo(parser.New(), mock.NewContext(), 0, "create table t(id bigint primary key, b int as (id + 1), c int)")
+	c.Assert(err, IsNil)
+	table := &filter.Table{Schema: "test", Name: "t"}
+	keep := []rowfilter.RowFilter{&pluginRowFilter{RowFilter: &testRowFilter{action: rowfilter.Keep}, path: "keep.so"}}
+	rewrite := []rowfilter.RowFilter{&pluginRowFilter{RowFilter: &testRowFilter{action: rowfilter.Rewrite}, path: "rewrite.so"}}
+
+	change := newRowChange(rowfilter.Insert, table, ti, nil, []interface{}{int64(0), int64(1), int64(2)})
+	c.Assert(change.Columns, DeepEquals, []string{"id", "b", "c"})
+	skip, err := SkipDMLByRowFilters(keep, change)
+	c.Assert(err, IsNil)
+	c.Assert(skip, IsTrue)
+
+	// the values modified by the filter are ignored unless it returns Rewrite
+	change = newRowChange(rowfilter.Insert, table, ti, nil, []interface{}{int64(1), int64(2), int64(3)})
+	skip, err = SkipDMLByRowFilters(keep, change)
+	c.Assert(err, IsNil)
+	c.Assert(skip, IsFalse)
+	c.Assert(change.NewValues, DeepEquals, []interface{}{int64(1), int64(2), int64(3)})
+
+	change = newRowChange(rowfilter.Delete, table, ti, []interface{}{int64(1), int64(2), int64(3)}, nil)
+	skip, err = SkipDMLByRowFilters(rewrite, change)
+	c.Assert(err, IsNil)
+	c.Assert(skip, IsFalse)
+	c.Assert(change.OldValues, DeepEquals, []interface{}{int64(2), int64(2), int64(3)})
+
+	prunedColumns := []*model.ColumnInfo{ti.Columns[0], ti.Columns[2]}
+	c.Assert(pickColumnValues(change.OldValues, prunedColumns, ti), DeepEquals, []interface{}{int64(2), int64(3)})
+	c.Assert(pickColumnValues(change.OldValues, ti.Columns, ti), DeepEquals, change.OldValues)
+
+	// the number of values can't be changed
+	change = newRowChange(rowfilter.Update, table, ti, []interface{}{int64(1), int64(2), int64(3)}, []interface{}{int64(1), int64(2), int64(4)})
+	_, err = SkipDMLByRowFilters(rewrite, change)
+	c.Assert(terror.ErrSyncerRowFilterPlugin.Equal(err), IsTrue)
+
+	g := NewExprFilterGroup(utils.NewSessionCtx(nil), []*config.ExpressionFilter{{Schema: "test", Table: "t", Plugin: "not-exist.so"}})
+	filters, err := g.GetRowFilters(&filter.Table{Schema: "test", Name: "t2"})
+	c.Assert(err, IsNil)
+	c.Assert(filters, HasLen, 0)
+	_, err = g.GetRowFilters(table)
+	c.Assert(terror.ErrSyncerRowFilterPlugin.Equal(err), IsTrue)
+}
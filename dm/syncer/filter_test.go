@@ -70,6 +70,7 @@ func (s *testFilterSuite) TestSkipQueryEvent(c *C) {
 	syncer.schemaTracker, err = schema.NewTracker(context.Background(), syncer.cfg.Name, defaultTestSessionCfg, syncer.ddlDBConn)
 	c.Assert(err, IsNil)
 	syncer.exprFilterGroup = NewExprFilterGroup(utils.NewSessionCtx(nil), nil)
+	syncer.rowValueFilter, _ = NewRowValueFilter(utils.NewSessionCtx(nil), false, nil)
 
 	// test binlog filter
 	filterRules := []*bf.BinlogEventRule{
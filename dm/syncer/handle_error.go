@@ -27,10 +27,23 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/binlog"
 	parserpkg "github.com/pingcap/tiflow/dm/pkg/parser"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
+	operator "github.com/pingcap/tiflow/dm/syncer/err-operator"
 )
 
+// errorOperations is the result of `Show` operation, which contains the current error and the error operations.
+type errorOperations struct {
+	BinlogPos  string             `json:"binlog_pos,omitempty"`
+	OriginSQL  string             `json:"origin_sql,omitempty"`
+	Operations []*operator.Record `json:"operations"`
+	History    []*operator.Record `json:"history"`
+}
+
 // HandleError handle error for syncer.
 func (s *Syncer) HandleError(ctx context.Context, req *pb.HandleWorkerErrorRequest) (string, error) {
+	if req.Op == pb.ErrorOp_Show {
+		return s.showErrorOperations()
+	}
+
 	pos := req.BinlogPos
 
 	if len(pos) == 0 {
@@ -80,6 +93,24 @@ func (s *Syncer) HandleError(ctx context.Context, req *pb.HandleWorkerErrorReque
 	return "", s.errOperatorHolder.Set(req, events)
 }
 
+// showErrorOperations returns the current error location and the error operations in JSON.
+func (s *Syncer) showErrorOperations() (string, error) {
+	flushedPos := s.checkpoint.FlushedGlobalPoint().Position
+	ret := &errorOperations{
+		Operations: s.errOperatorHolder.GetBehindRecords(flushedPos.String()),
+		History:    s.errOperatorHolder.GetHistory(),
+	}
+	if startLocation, _ := s.getErrLocation(); startLocation != nil {
+		ret.BinlogPos = operator.FormatBinlogPos(startLocation.Position)
+		ret.OriginSQL = s.getErrOriginSQL()
+	}
+	retJSON, err := json.Marshal(ret)
+	if err != nil {
+		return "", err
+	}
+	return string(retJSON), nil
+}
+
 func (s *Syncer) genEvents(ctx context.Context, sqls []string) ([]*replication.BinlogEvent, error) {
 	events := make([]*replication.BinlogEvent, 0)
 
@@ -15,11 +15,15 @@ package syncer
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
+	"github.com/go-mysql-org/go-mysql/mysql"
 	. "github.com/pingcap/check"
+	"github.com/pingcap/errors"
 
 	"github.com/pingcap/tiflow/dm/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
 	"github.com/pingcap/tiflow/dm/pkg/conn"
 	"github.com/pingcap/tiflow/dm/syncer/dbconn"
 )
@@ -102,3 +106,45 @@ func (s *testSyncerSuite) TestHandleError(c *C) {
 	}
 	c.Assert(mockDB.ExpectationsWereMet(), IsNil)
 }
+
+func (s *testSyncerSuite) TestShowErrorOperations(c *C) {
+	var (
+		syncer = NewSyncer(s.cfg, nil, nil)
+		task   = "test"
+		ctx    = context.Background()
+	)
+	show := func() *errorOperations {
+		msg, err := syncer.HandleError(ctx, &pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Show, Task: task})
+		c.Assert(err, IsNil)
+		ret := &errorOperations{}
+		c.Assert(json.Unmarshal([]byte(msg), ret), IsNil)
+		return ret
+	}
+	ret := show()
+	c.Assert(ret.BinlogPos, Equals, "")
+	c.Assert(ret.Operations, HasLen, 0)
+	c.Assert(ret.History, HasLen, 0)
+
+	originSQL := "alter table db.tb add column a int"
+	startLocation := binlog.InitLocation(mysql.Position{Name: "mysql-bin.000001", Pos: 2345}, nil)
+	endLocation := binlog.InitLocation(mysql.Position{Name: "mysql-bin.000001", Pos: 2400}, nil)
+	err := syncer.handleEventError(errors.New("mock error"), startLocation, endLocation, true, originSQL)
+	c.Assert(err, NotNil)
+	_, err = syncer.HandleError(ctx, &pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Skip, Task: task})
+	c.Assert(err, IsNil)
+	ret = show()
+	c.Assert(ret.BinlogPos, Equals, "mysql-bin.000001:2345")
+	c.Assert(ret.OriginSQL, Equals, originSQL)
+	c.Assert(ret.Operations, HasLen, 1)
+	c.Assert(ret.Operations[0].Op, Equals, "skip")
+	c.Assert(ret.Operations[0].BinlogPos, Equals, "mysql-bin.000001:2345")
+	c.Assert(ret.History, DeepEquals, ret.Operations)
+
+	_, err = syncer.HandleError(ctx, &pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Revert, Task: task, BinlogPos: "mysql-bin.000001:2345"})
+	c.Assert(err, IsNil)
+	ret = show()
+	c.Assert(ret.Operations, HasLen, 0)
+	c.Assert(ret.History, HasLen, 2)
+	c.Assert(ret.History[1].Op, Equals, "revert")
+	c.Assert(ret.History[1].BinlogPos, Equals, "mysql-bin.000001:2345")
+}
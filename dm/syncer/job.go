@@ -95,6 +95,12 @@ type job struct {
 	jobAddTime  time.Time       // job commit time
 	flushSeq    int64           // sequence number for sync and async flush job
 	flushWg     *sync.WaitGroup // wait group for sync, async and conflict job
+	// DML queues which should be synchronized by the conflict job
+	conflictQueues []int
+	// the memory accounted to the memory quota by the job
+	size int64
+	// target tables whose primary keys are changed by the DDLs, only for DDL job
+	keyFencedTables []*filter.Table
 }
 
 func (j *job) clone() *job {
@@ -143,6 +149,7 @@ func newDDLJob(qec *queryEventContext) *job {
 		currentLocation: *qec.currentLocation,
 		eventHeader:     qec.header,
 		jobAddTime:      time.Now(),
+		keyFencedTables: qec.keyFencedTables,
 	}
 
 	ddlInfo := qec.shardingDDLInfo
@@ -216,15 +223,17 @@ func newGCJob(flushJobSeq int64) *job {
 	}
 }
 
-func newConflictJob(workerCount int) *job {
+// newConflictJob creates a conflict job which waits for all the jobs in `queues` to be executed.
+func newConflictJob(queues []int) *job {
 	wg := &sync.WaitGroup{}
-	wg.Add(workerCount)
+	wg.Add(len(queues))
 
 	return &job{
-		tp:          conflict,
-		targetTable: &filter.Table{},
-		jobAddTime:  time.Now(),
-		flushWg:     wg,
+		tp:             conflict,
+		targetTable:    &filter.Table{},
+		jobAddTime:     time.Now(),
+		flushWg:        wg,
+		conflictQueues: queues,
 	}
 }
 
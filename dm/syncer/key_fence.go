@@ -0,0 +1,110 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"strings"
+
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"go.uber.org/zap"
+)
+
+// primaryKeyColumns returns the lower-case names of the primary key columns of the table.
+func primaryKeyColumns(ti *model.TableInfo) map[string]struct{} {
+	cols := make(map[string]struct{})
+	if ti == nil {
+		return cols
+	}
+	if ti.PKIsHandle {
+		for _, col := range ti.Columns {
+			if mysql.HasPriKeyFlag(col.Flag) {
+				cols[col.Name.L] = struct{}{}
+			}
+		}
+	}
+	for _, idx := range ti.Indices {
+		if idx.Primary {
+			for _, col := range idx.Columns {
+				cols[col.Name.L] = struct{}{}
+			}
+		}
+	}
+	return cols
+}
+
+// changesPrimaryKey returns whether the DDL adds, drops or changes the primary key of the table, ti is the table info
+// before the DDL, which is used to find the changes of the primary key columns, it can be nil if the table isn't
+// tracked yet.
+func changesPrimaryKey(stmt ast.StmtNode, ti *model.TableInfo) bool {
+	switch node := stmt.(type) {
+	case *ast.DropIndexStmt:
+		return strings.EqualFold(node.IndexName, mysql.PrimaryKeyName)
+	case *ast.AlterTableStmt:
+		pkCols := primaryKeyColumns(ti)
+		for _, spec := range node.Specs {
+			switch spec.Tp {
+			case ast.AlterTableAddConstraint:
+				if spec.Constraint != nil && spec.Constraint.Tp == ast.ConstraintPrimaryKey {
+					return true
+				}
+			case ast.AlterTableDropPrimaryKey:
+				return true
+			case ast.AlterTableDropIndex:
+				if strings.EqualFold(spec.Name, mysql.PrimaryKeyName) {
+					return true
+				}
+			case ast.AlterTableDropColumn:
+				if _, ok := pkCols[spec.OldColumnName.Name.L]; ok {
+					return true
+				}
+			case ast.AlterTableRenameColumn:
+				if _, ok := pkCols[spec.OldColumnName.Name.L]; ok {
+					return true
+				}
+			case ast.AlterTableAddColumns, ast.AlterTableModifyColumn, ast.AlterTableChangeColumn:
+				if spec.OldColumnName != nil {
+					if _, ok := pkCols[spec.OldColumnName.Name.L]; ok {
+						return true
+					}
+				}
+				for _, col := range spec.NewColumns {
+					if _, ok := pkCols[col.Name.Name.L]; ok && spec.Tp != ast.AlterTableAddColumns {
+						return true
+					}
+					for _, opt := range col.Options {
+						if opt.Tp == ast.ColumnOptionPrimaryKey {
+							return true
+						}
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+// rebuildKeyMetadata rebuilds the metadata to extract the keys of the DMLs for the target tables whose primary keys
+// are changed by the DDL job. it's called after the DDL is applied and all pending DMLs are drained, so the downstream
+// table infos fetched before the DDL is applied, like the ones fetched by the DMLs of the shard tables which haven't
+// synced the DDL yet, are not used to extract the keys of the following DMLs anymore.
+func (s *Syncer) rebuildKeyMetadata(job *job) {
+	if len(job.keyFencedTables) == 0 {
+		return
+	}
+	s.schemaTracker.RemoveDownstreamSchema(s.tctx, job.keyFencedTables)
+	s.tctx.L().Info("rebuild key metadata after primary key changed", zap.Stringer("job", job),
+		zap.Any("tables", job.keyFencedTables))
+}
@@ -0,0 +1,64 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/util/mock"
+)
+
+func (s *testSyncerSuite) TestChangesPrimaryKey(c *C) {
+	p := parser.New()
+	se := mock.NewContext()
+	ti, err := createTableInfo(p, se, 1, "create table t (a int, b int, c int, primary key(a, b), unique key(c))")
+	c.Assert(err, IsNil)
+	tiHandle, err := createTableInfo(p, se, 2, "create table t (a int primary key, b int)")
+	c.Assert(err, IsNil)
+
+	cases := []struct {
+		sql      string
+		expected bool
+	}{
+		{"alter table t add primary key(c)", true},
+		{"alter table t drop primary key", true},
+		{"alter table t drop index `PRIMARY`", true},
+		{"drop index `primary` on t", true},
+		{"alter table t drop column a", true},
+		{"alter table t drop column c", false},
+		{"alter table t modify column b bigint", true},
+		{"alter table t modify column c bigint", false},
+		{"alter table t change column a d int", true},
+		{"alter table t change column c d int", false},
+		{"alter table t rename column b to d", true},
+		{"alter table t add column d int primary key", true},
+		{"alter table t add column d int", false},
+		{"alter table t add unique key(b)", false},
+		{"alter table t drop index c", false},
+		{"drop index c on t", false},
+		{"create table t2 (a int primary key)", false},
+	}
+	for _, cs := range cases {
+		stmt, err := p.ParseOneStmt(cs.sql, "", "")
+		c.Assert(err, IsNil)
+		c.Assert(changesPrimaryKey(stmt, ti), Equals, cs.expected, Commentf("sql: %s", cs.sql))
+	}
+
+	// the clustered primary key
+	stmt, err := p.ParseOneStmt("alter table t modify column a bigint", "", "")
+	c.Assert(err, IsNil)
+	c.Assert(changesPrimaryKey(stmt, tiHandle), IsTrue)
+	// the changes of the primary key columns can't be found without table info
+	c.Assert(changesPrimaryKey(stmt, nil), IsFalse)
+}
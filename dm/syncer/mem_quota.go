@@ -0,0 +1,129 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pingcap/tiflow/dm/syncer/metrics"
+)
+
+// valueOverhead is the estimated memory of a non-string value in a row, including the interface header.
+const valueOverhead = 24
+
+// memQuota tracks the memory of the DML jobs buffered in the pipeline from the binlog streamer to the DML workers.
+// the producer is blocked when the quota is used up, and it's unblocked after the executed jobs release the quota.
+// a nil memQuota means unlimited.
+type memQuota struct {
+	mu       sync.Mutex
+	released chan struct{} // closed and replaced after some quota is released, to wake up the blocked producer
+	quota    int64
+	used     int64
+
+	task   string
+	source string
+}
+
+// newMemQuota creates a memQuota, it returns nil if quota is not positive.
+func newMemQuota(quota int64, task, source string) *memQuota {
+	if quota <= 0 {
+		return nil
+	}
+	return &memQuota{
+		released: make(chan struct{}),
+		quota:    quota,
+		task:     task,
+		source:   source,
+	}
+}
+
+// consume blocks until the quota is enough for the job, then accounts the size of its row changes to job.size.
+// a job larger than the whole quota is let through when nothing else is buffered, to avoid blocking forever.
+// it returns the error of ctx if ctx is done while blocking, like the DML workers exit and no quota will be released.
+func (q *memQuota) consume(ctx context.Context, j *job) error {
+	if q == nil || j.dml == nil {
+		return nil
+	}
+	size := j.dml.memSize()
+
+	q.mu.Lock()
+	for q.used > 0 && q.used+size > q.quota {
+		released := q.released
+		q.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-released:
+		}
+		q.mu.Lock()
+	}
+	q.used += size
+	j.size = size
+	metrics.MemoryQuotaUsedGauge.WithLabelValues(q.task, q.source).Set(float64(q.used))
+	q.mu.Unlock()
+	return nil
+}
+
+// release releases the quota of the jobs which are executed or dropped.
+func (q *memQuota) release(jobs ...*job) {
+	if q == nil {
+		return
+	}
+	var size int64
+	for _, j := range jobs {
+		if j != nil {
+			size += j.size
+			j.size = 0
+		}
+	}
+	if size == 0 {
+		return
+	}
+
+	q.mu.Lock()
+	q.used -= size
+	metrics.MemoryQuotaUsedGauge.WithLabelValues(q.task, q.source).Set(float64(q.used))
+	close(q.released)
+	q.released = make(chan struct{})
+	q.mu.Unlock()
+}
+
+// usedBytes returns the used quota.
+func (q *memQuota) usedBytes() int64 {
+	if q == nil {
+		return 0
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.used
+}
+
+// memSize estimates the memory of the decoded row changes of the DML.
+func (dml *DML) memSize() int64 {
+	var size int64
+	for _, values := range [][]interface{}{dml.oldValues, dml.values} {
+		for _, v := range values {
+			switch val := v.(type) {
+			case string:
+				size += int64(len(val)) + valueOverhead
+			case []byte:
+				size += int64(len(val)) + valueOverhead
+			default:
+				size += valueOverhead
+			}
+		}
+	}
+	return size
+}
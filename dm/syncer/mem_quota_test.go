@@ -0,0 +1,85 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"time"
+
+	. "github.com/pingcap/check"
+	"go.uber.org/atomic"
+
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+func (s *testSyncerSuite) TestMemQuota(c *C) {
+	var q *memQuota
+	ctx := context.Background()
+	c.Assert(newMemQuota(0, "task", "source"), IsNil)
+	// nil memQuota means unlimited
+	j := &job{tp: insert, dml: &DML{values: []interface{}{int64(1), "abc"}}}
+	c.Assert(q.consume(ctx, j), IsNil)
+	q.release(j)
+	c.Assert(q.usedBytes(), Equals, int64(0))
+
+	c.Assert(j.dml.memSize(), Equals, int64(2*valueOverhead+3))
+	c.Assert((&DML{oldValues: []interface{}{[]byte("ab")}, values: []interface{}{nil}}).memSize(), Equals, int64(2*valueOverhead+2))
+
+	q = newMemQuota(100, "task", "source")
+	j1 := &job{tp: insert, dml: &DML{values: []interface{}{make([]byte, 40)}}}
+	j2 := &job{tp: insert, dml: &DML{values: []interface{}{make([]byte, 40)}}}
+	c.Assert(q.consume(ctx, j1), IsNil)
+	c.Assert(j1.size, Equals, int64(40+valueOverhead))
+	c.Assert(q.usedBytes(), Equals, j1.size)
+
+	// the producer is blocked until the quota is released
+	consumed := atomic.NewBool(false)
+	go func() {
+		c.Assert(q.consume(ctx, j2), IsNil)
+		consumed.Store(true)
+	}()
+	time.Sleep(100 * time.Millisecond)
+	c.Assert(consumed.Load(), IsFalse)
+	q.release(j1)
+	c.Assert(j1.size, Equals, int64(0))
+	c.Assert(utils.WaitSomething(10, 50*time.Millisecond, consumed.Load), IsTrue)
+	c.Assert(q.usedBytes(), Equals, j2.size)
+	q.release(j2, nil)
+	c.Assert(q.usedBytes(), Equals, int64(0))
+
+	// a job larger than the quota is let through when nothing is buffered
+	j3 := &job{tp: insert, dml: &DML{values: []interface{}{make([]byte, 200)}}}
+	c.Assert(q.consume(ctx, j3), IsNil)
+	c.Assert(q.usedBytes(), Equals, int64(200+valueOverhead))
+
+	// the blocked producer returns when the context is canceled, like the DML workers exit and never release quota
+	j4 := &job{tp: insert, dml: &DML{values: []interface{}{make([]byte, 10)}}}
+	cancelCtx, cancel := context.WithCancel(ctx)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- q.consume(cancelCtx, j4)
+	}()
+	time.Sleep(100 * time.Millisecond)
+	c.Assert(errCh, HasLen, 0)
+	cancel()
+	select {
+	case err := <-errCh:
+		c.Assert(err, Equals, context.Canceled)
+	case <-time.After(time.Second):
+		c.Fatal("consume is not canceled")
+	}
+	c.Assert(j4.size, Equals, int64(0))
+	q.release(j3, j4)
+	c.Assert(q.usedBytes(), Equals, int64(0))
+}
@@ -162,6 +162,70 @@ var (
 			Help:      "total number of sql retries",
 		}, []string{"type", "task"})
 
+	PreparedStmtCacheTotal = metricsproxy.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "syncer",
+			Name:      "prepared_stmt_cache_total",
+			Help:      "total number of hits, misses and evictions of the prepared statement cache",
+		}, []string{"type", "task", "source_id"})
+
+	SchemaTrackerTablesTotal = metricsproxy.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "syncer",
+			Name:      "schema_tracker_tables_total",
+			Help:      "total number of tables loaded into and evicted from the schema tracker",
+		}, []string{"type", "task", "source_id"})
+
+	SchemaTrackerMemoryGauge = metricsproxy.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "syncer",
+			Name:      "schema_tracker_memory_bytes",
+			Help:      "estimated memory of the table infos held by the schema tracker",
+		}, []string{"task", "source_id"})
+
+	FilteredRowsTotal = metricsproxy.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "syncer",
+			Name:      "filtered_rows_total",
+			Help:      "total number of rows filtered by the binlog event filter rules with row-value-expr",
+		}, []string{"rule", "task", "source_id"})
+
+	StatementDMLTotal = metricsproxy.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "syncer",
+			Name:      "statement_dml_total",
+			Help:      "total number of statement-format DMLs converted to rows, applied verbatim or skipped",
+		}, []string{"type", "task", "source_id"})
+
+	ValidatorRowsGauge = metricsproxy.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "syncer",
+			Name:      "validator_rows",
+			Help:      "number of pending and failed rows of the continuous data validation",
+		}, []string{"state", "task", "source_id"})
+
+	ValidatedRowsTotal = metricsproxy.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "syncer",
+			Name:      "validated_rows_total",
+			Help:      "total number of rows validated to be consistent with upstream",
+		}, []string{"task", "source_id"})
+
+	MemoryQuotaUsedGauge = metricsproxy.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "syncer",
+			Name:      "memory_quota_used_bytes",
+			Help:      "estimated memory of the DML jobs buffered in the syncer",
+		}, []string{"task", "source_id"})
+
 	TxnHistogram = metricsproxy.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: "dm",
@@ -280,6 +344,14 @@ func RegisterMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(FinishedJobsTotal)
 	registry.MustRegister(QueueSizeGauge)
 	registry.MustRegister(SQLRetriesTotal)
+	registry.MustRegister(PreparedStmtCacheTotal)
+	registry.MustRegister(SchemaTrackerTablesTotal)
+	registry.MustRegister(SchemaTrackerMemoryGauge)
+	registry.MustRegister(StatementDMLTotal)
+	registry.MustRegister(FilteredRowsTotal)
+	registry.MustRegister(ValidatorRowsGauge)
+	registry.MustRegister(ValidatedRowsTotal)
+	registry.MustRegister(MemoryQuotaUsedGauge)
 	registry.MustRegister(BinlogPosGauge)
 	registry.MustRegister(BinlogFileGauge)
 	registry.MustRegister(TxnHistogram)
@@ -312,6 +384,14 @@ func RemoveLabelValuesWithTaskInMetrics(task string) {
 	FinishedJobsTotal.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 	QueueSizeGauge.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 	SQLRetriesTotal.DeleteAllAboutLabels(prometheus.Labels{"task": task})
+	PreparedStmtCacheTotal.DeleteAllAboutLabels(prometheus.Labels{"task": task})
+	SchemaTrackerTablesTotal.DeleteAllAboutLabels(prometheus.Labels{"task": task})
+	SchemaTrackerMemoryGauge.DeleteAllAboutLabels(prometheus.Labels{"task": task})
+	StatementDMLTotal.DeleteAllAboutLabels(prometheus.Labels{"task": task})
+	FilteredRowsTotal.DeleteAllAboutLabels(prometheus.Labels{"task": task})
+	ValidatorRowsGauge.DeleteAllAboutLabels(prometheus.Labels{"task": task})
+	ValidatedRowsTotal.DeleteAllAboutLabels(prometheus.Labels{"task": task})
+	MemoryQuotaUsedGauge.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 	BinlogPosGauge.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 	BinlogFileGauge.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 	TxnHistogram.DeleteAllAboutLabels(prometheus.Labels{"task": task})
@@ -0,0 +1,442 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/tikv/client-go/v2/oracle"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink"
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	parserpkg "github.com/pingcap/tiflow/dm/pkg/parser"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/pingcap/tiflow/dm/syncer/metrics"
+	cdcconfig "github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	cdcfilter "github.com/pingcap/tiflow/pkg/filter"
+	"github.com/pingcap/tiflow/pkg/util"
+)
+
+// mqFlushCheckInterval is the interval to check whether the published row changes are acknowledged by Kafka.
+const mqFlushCheckInterval = 50 * time.Millisecond
+
+// mqSink publishes the row changes and DDLs to Kafka by the sink of TiCDC.
+type mqSink struct {
+	sink.Sink
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	// the background errors of the sink, like the failure of producer.
+	errCh chan error
+	// split the update which changes the handle key into a delete and an insert when dispatching by primary key.
+	splitUpdate bool
+	// the commit ts of the last published event, which is composed of the timestamp of binlog event and kept
+	// increasing, so the consumers can order the events and the sink can flush the events until it.
+	lastTs atomic.Uint64
+}
+
+// newMQSink creates the sink for the `sink-uri` of task.
+func newMQSink(cfg *config.SubTaskConfig) (*mqSink, error) {
+	replicaConfig := cdcconfig.GetDefaultReplicaConfig()
+	replicaConfig.CaseSensitive = cfg.CaseSensitive
+	dispatcher := "table"
+	if cfg.SinkDispatcher == config.SinkDispatchByPK {
+		dispatcher = "index-value"
+	}
+	replicaConfig.Sink.DispatchRules = []*cdcconfig.DispatchRule{{Matcher: []string{"*.*"}, Dispatcher: dispatcher}}
+	cdcFilter, err := cdcfilter.NewFilter(replicaConfig)
+	if err != nil {
+		return nil, terror.ErrSyncerMQSink.Delegate(err, "create filter")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ctx = util.PutChangefeedIDInCtx(ctx, cfg.Name)
+	errCh := make(chan error, 1)
+	s, err := sink.New(ctx, cfg.Name, cfg.SinkURI, cdcFilter, replicaConfig, map[string]string{}, errCh)
+	if err != nil {
+		cancel()
+		return nil, terror.ErrSyncerMQSink.Delegate(err, "open the sink")
+	}
+	return &mqSink{
+		Sink:        s,
+		ctx:         ctx,
+		cancel:      cancel,
+		errCh:       errCh,
+		splitUpdate: cfg.SinkDispatcher == config.SinkDispatchByPK,
+	}, nil
+}
+
+// nextTs returns the commit ts of the next event.
+func (s *mqSink) nextTs(header *replication.EventHeader) uint64 {
+	physical := time.Now().UnixNano() / int64(time.Millisecond)
+	if header != nil {
+		physical = int64(header.Timestamp) * 1000
+	}
+	ts := oracle.ComposeTS(physical, 0)
+	for {
+		lastTs := s.lastTs.Load()
+		if ts <= lastTs {
+			ts = lastTs + 1
+		}
+		if s.lastTs.CAS(lastTs, ts) {
+			return ts
+		}
+	}
+}
+
+// flush waits for all the published row changes to be acknowledged by Kafka.
+func (s *mqSink) flush() error {
+	ts := s.lastTs.Load()
+	ticker := time.NewTicker(mqFlushCheckInterval)
+	defer ticker.Stop()
+	for {
+		checkpointTs, err := s.FlushRowChangedEvents(s.ctx, 0, ts)
+		if err != nil {
+			return terror.ErrSyncerMQSink.Delegate(err, "flush row changes")
+		}
+		if checkpointTs >= ts {
+			break
+		}
+		select {
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	// let the consumers know all the events before ts have been published.
+	if err := s.EmitCheckpointTs(s.ctx, ts); err != nil {
+		return terror.ErrSyncerMQSink.Delegate(err, "publish checkpoint")
+	}
+	return nil
+}
+
+// publishDDLs publishes the routed DDLs, they are executed after all the row changes before them are flushed.
+func (s *mqSink) publishDDLs(ddls []string, header *replication.EventHeader) error {
+	events, err := genDDLEvents(ddls, s.nextTs(header))
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		err = s.EmitDDLEvent(s.ctx, event)
+		if err != nil && !cerror.ErrDDLEventIgnored.Equal(err) {
+			return terror.ErrSyncerMQSink.Delegate(err, "publish DDL "+event.Query)
+		}
+	}
+	return nil
+}
+
+func (s *mqSink) close() {
+	s.cancel()
+	if err := s.Close(context.Background()); err != nil {
+		log.L().Warn("fail to close Kafka sink", log.ShortError(err))
+	}
+}
+
+// mqWorker publishes the row changes to Kafka instead of executing them in the downstream.
+type mqWorker struct {
+	// the number of DML queues, the flush jobs are created to wait for all of them.
+	workerCount int
+	sink        *mqSink
+	logger      log.Logger
+	// the wrapped table info of TiCDC for each target table, it's refreshed when the table info changes.
+	tableInfos map[string]*mqTableInfo
+	// the quota of the buffered jobs, it's released after the jobs are published.
+	memQuota *memQuota
+
+	// for metrics
+	task   string
+	source string
+
+	// callback func
+	successFunc  func(int, int, []*job)
+	fatalFunc    func(*job, error)
+	lagFunc      func(*job, int)
+	addCountFunc func(bool, string, opType, int64, *filter.Table)
+
+	// channel
+	inCh    chan *job
+	flushCh chan *job
+}
+
+type mqTableInfo struct {
+	sourceTableInfo *model.TableInfo
+	tableInfo       *cdcmodel.TableInfo
+}
+
+// mqWorkerWrap creates and runs a mqWorker instance and returns flush job channel.
+func mqWorkerWrap(inCh chan *job, syncer *Syncer) chan *job {
+	mqWorker := &mqWorker{
+		workerCount:  syncer.cfg.WorkerCount,
+		sink:         syncer.mqSink,
+		logger:       syncer.tctx.Logger.WithFields(zap.String("component", "mq_worker")),
+		tableInfos:   make(map[string]*mqTableInfo),
+		task:         syncer.cfg.Name,
+		source:       syncer.cfg.SourceID,
+		successFunc:  syncer.successFunc,
+		fatalFunc:    syncer.fatalFunc,
+		lagFunc:      syncer.updateReplicationJobTS,
+		addCountFunc: syncer.addCount,
+		memQuota:     syncer.memQuota,
+		inCh:         inCh,
+		flushCh:      make(chan *job),
+	}
+
+	go func() {
+		mqWorker.run()
+		close(mqWorker.flushCh)
+	}()
+	return mqWorker.flushCh
+}
+
+// run publishes the row changes in order, the partition of each row change is decided by the dispatcher of sink.
+func (w *mqWorker) run() {
+	var asyncFlushWg sync.WaitGroup
+	defer asyncFlushWg.Wait()
+
+	queueBucket := queueBucketName(0)
+	for j := range w.inCh {
+		metrics.QueueSizeGauge.WithLabelValues(w.task, "mq_worker_input", w.source).Set(float64(len(w.inCh)))
+		switch j.tp {
+		case flush:
+			w.addCountFunc(false, adminQueueName, j.tp, 1, j.targetTable)
+			w.flush(j)
+			w.addCountFunc(true, adminQueueName, j.tp, 1, j.targetTable)
+			w.flushCh <- j
+		case asyncFlush:
+			w.addCountFunc(false, adminQueueName, j.tp, 1, j.targetTable)
+			asyncFlushWg.Add(1)
+			go func(j *job) {
+				defer asyncFlushWg.Done()
+				w.flush(j)
+			}(j)
+			w.flushCh <- j
+		case conflict:
+			// the row changes are published in order, there is nothing to wait for.
+		default:
+			w.addCountFunc(false, queueBucket, j.tp, 1, j.targetTable)
+			w.lagFunc(j, dmlWorkerJobIdx(0))
+			err := w.publish(j)
+			w.memQuota.release(j)
+			if err != nil {
+				w.fatalFunc(j, err)
+				continue
+			}
+			w.successFunc(0, 1, []*job{j})
+		}
+	}
+}
+
+// flush waits for the published row changes to be acknowledged, then the checkpoint of flush job can be saved.
+func (w *mqWorker) flush(j *job) {
+	if err := w.sink.flush(); err != nil {
+		w.fatalFunc(j, err)
+	}
+	for i := 0; i < w.workerCount; i++ {
+		j.flushWg.Done()
+	}
+}
+
+func (w *mqWorker) publish(j *job) error {
+	dml := j.dml
+	ti, ok := w.tableInfos[dml.targetTableID]
+	if !ok || ti.sourceTableInfo != dml.sourceTableInfo {
+		ti = &mqTableInfo{
+			sourceTableInfo: dml.sourceTableInfo,
+			tableInfo:       cdcmodel.WrapTableInfo(0, j.targetTable.Schema, 0, dml.sourceTableInfo),
+		}
+		w.tableInfos[dml.targetTableID] = ti
+	}
+
+	events := genRowChangedEvents(dml, j.targetTable, ti.tableInfo, w.sink.nextTs(j.eventHeader), w.sink.splitUpdate)
+	if err := w.sink.EmitRowChangedEvents(w.sink.ctx, events...); err != nil {
+		return terror.ErrSyncerMQSink.Delegate(err, "publish row changes")
+	}
+	return nil
+}
+
+// genRowChangedEvents converts a DML to the row changed events of TiCDC. if splitUpdate is true, an update which
+// changes the handle key is split into a delete and an insert, so they can be dispatched by their own keys.
+func genRowChangedEvents(dml *DML, targetTable *filter.Table, tableInfo *cdcmodel.TableInfo, ts uint64, splitUpdate bool) []*cdcmodel.RowChangedEvent {
+	event := &cdcmodel.RowChangedEvent{
+		StartTs:  ts,
+		CommitTs: ts,
+		Table: &cdcmodel.TableName{
+			Schema:  targetTable.Schema,
+			Table:   targetTable.Name,
+			TableID: tableInfo.ID,
+		},
+	}
+	switch dml.op {
+	case insert:
+		event.Columns = genMQColumns(dml.columns, dml.values, tableInfo)
+	case del:
+		event.PreColumns = genMQColumns(dml.columns, dml.values, tableInfo)
+	case update:
+		event.PreColumns = genMQColumns(dml.columns, dml.oldValues, tableInfo)
+		event.Columns = genMQColumns(dml.columns, dml.values, tableInfo)
+		if splitUpdate && isHandleKeyUpdated(event) {
+			delEvent, insertEvent := *event, *event
+			delEvent.Columns = nil
+			insertEvent.PreColumns = nil
+			return []*cdcmodel.RowChangedEvent{&delEvent, &insertEvent}
+		}
+	}
+	return []*cdcmodel.RowChangedEvent{event}
+}
+
+func genMQColumns(columns []*model.ColumnInfo, values []interface{}, tableInfo *cdcmodel.TableInfo) []*cdcmodel.Column {
+	cols := make([]*cdcmodel.Column, 0, len(columns))
+	for i, col := range columns {
+		cols = append(cols, &cdcmodel.Column{
+			Name:  col.Name.O,
+			Type:  col.Tp,
+			Flag:  tableInfo.ColumnsFlag[col.ID],
+			Value: mqColumnValue(values[i], col),
+		})
+	}
+	return cols
+}
+
+// mqColumnValue converts the value of binlog to the type of TiCDC, which the encoders of TiCDC expect.
+func mqColumnValue(value interface{}, col *model.ColumnInfo) interface{} {
+	switch v := value.(type) {
+	case int:
+		value = int64(v)
+	case float32:
+		value = float64(v)
+	}
+
+	switch col.Tp {
+	case mysql.TypeEnum, mysql.TypeSet, mysql.TypeBit:
+		if v, ok := value.(int64); ok {
+			return uint64(v)
+		}
+	case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar,
+		mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		if v, ok := value.(string); ok {
+			return []byte(v)
+		}
+	case mysql.TypeJSON:
+		if v, ok := value.([]byte); ok {
+			return string(v)
+		}
+	}
+	return value
+}
+
+func isHandleKeyUpdated(event *cdcmodel.RowChangedEvent) bool {
+	for i, col := range event.Columns {
+		if col.Flag.IsHandleKey() &&
+			cdcmodel.ColumnValueString(col.Value) != cdcmodel.ColumnValueString(event.PreColumns[i].Value) {
+			return true
+		}
+	}
+	return false
+}
+
+// genDDLEvents converts the routed DDLs to the DDL events of TiCDC.
+func genDDLEvents(ddls []string, ts uint64) ([]*cdcmodel.DDLEvent, error) {
+	p := parser.New()
+	events := make([]*cdcmodel.DDLEvent, 0, len(ddls))
+	for _, ddl := range ddls {
+		stmt, err := p.ParseOneStmt(ddl, "", "")
+		if err != nil {
+			return nil, terror.ErrSyncerParseDDL.Delegate(err, ddl)
+		}
+		tables, err := parserpkg.FetchDDLTables("", stmt, utils.LCTableNamesSensitive)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, &cdcmodel.DDLEvent{
+			StartTs:  ts,
+			CommitTs: ts,
+			TableInfo: &cdcmodel.SimpleTableInfo{
+				Schema: tables[0].Schema,
+				Table:  tables[0].Name,
+			},
+			Query: ddl,
+			Type:  ddlActionType(stmt),
+		})
+	}
+	return events, nil
+}
+
+// ddlActionType returns the action type of DDL, the DDLs have been split so an ALTER TABLE has only one spec.
+func ddlActionType(stmt ast.StmtNode) model.ActionType {
+	switch v := stmt.(type) {
+	case *ast.CreateDatabaseStmt:
+		return model.ActionCreateSchema
+	case *ast.DropDatabaseStmt:
+		return model.ActionDropSchema
+	case *ast.AlterDatabaseStmt:
+		return model.ActionModifySchemaCharsetAndCollate
+	case *ast.CreateTableStmt:
+		return model.ActionCreateTable
+	case *ast.DropTableStmt:
+		return model.ActionDropTable
+	case *ast.TruncateTableStmt:
+		return model.ActionTruncateTable
+	case *ast.RenameTableStmt:
+		return model.ActionRenameTable
+	case *ast.CreateIndexStmt:
+		return model.ActionAddIndex
+	case *ast.DropIndexStmt:
+		return model.ActionDropIndex
+	case *ast.AlterTableStmt:
+		if len(v.Specs) == 0 {
+			return model.ActionNone
+		}
+		spec := v.Specs[0]
+		switch spec.Tp {
+		case ast.AlterTableAddColumns:
+			return model.ActionAddColumn
+		case ast.AlterTableDropColumn:
+			return model.ActionDropColumn
+		case ast.AlterTableModifyColumn, ast.AlterTableChangeColumn:
+			return model.ActionModifyColumn
+		case ast.AlterTableAlterColumn:
+			return model.ActionSetDefaultValue
+		case ast.AlterTableRenameTable:
+			return model.ActionRenameTable
+		case ast.AlterTableRenameIndex:
+			return model.ActionRenameIndex
+		case ast.AlterTableDropIndex:
+			return model.ActionDropIndex
+		case ast.AlterTableDropPrimaryKey:
+			return model.ActionDropPrimaryKey
+		case ast.AlterTableAddConstraint:
+			if spec.Constraint != nil && spec.Constraint.Tp == ast.ConstraintPrimaryKey {
+				return model.ActionAddPrimaryKey
+			}
+			return model.ActionAddIndex
+		case ast.AlterTableOption:
+			return model.ActionModifyTableCharsetAndCollate
+		}
+	}
+	return model.ActionNone
+}
@@ -0,0 +1,116 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/util/mock"
+
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
+)
+
+func (s *testSyncerSuite) TestGenRowChangedEvents(c *C) {
+	ti, err := createTableInfo(parser.New(), mock.NewContext(), 0,
+		"create table test.tb(id int primary key, name varchar(20), tp enum('a', 'b'))")
+	c.Assert(err, IsNil)
+	sourceTable := &filter.Table{Schema: "test", Name: "tb1"}
+	targetTable := &filter.Table{Schema: "test", Name: "tb"}
+	tableInfo := cdcmodel.WrapTableInfo(0, targetTable.Schema, 0, ti)
+
+	oldValues := []interface{}{int64(1), "a", int64(1)}
+	values := []interface{}{int64(1), "b", int64(2)}
+
+	dml := newDML(insert, false, "`test`.`tb`", sourceTable, nil, values, nil, values, ti.Columns, ti, nil, nil)
+	events := genRowChangedEvents(dml, targetTable, tableInfo, 100, false)
+	c.Assert(events, HasLen, 1)
+	c.Assert(events[0].CommitTs, Equals, uint64(100))
+	c.Assert(events[0].Table.Schema, Equals, "test")
+	c.Assert(events[0].Table.Table, Equals, "tb")
+	c.Assert(events[0].PreColumns, IsNil)
+	c.Assert(events[0].Columns, HasLen, 3)
+	c.Assert(events[0].Columns[0].Flag.IsHandleKey(), IsTrue)
+	c.Assert(events[0].Columns[1].Flag.IsHandleKey(), IsFalse)
+	c.Assert(events[0].Columns[0].Value, Equals, int64(1))
+	c.Assert(events[0].Columns[1].Value, DeepEquals, []byte("b"))
+	c.Assert(events[0].Columns[2].Value, Equals, uint64(2))
+
+	dml = newDML(del, false, "`test`.`tb`", sourceTable, nil, oldValues, nil, oldValues, ti.Columns, ti, nil, nil)
+	events = genRowChangedEvents(dml, targetTable, tableInfo, 101, false)
+	c.Assert(events, HasLen, 1)
+	c.Assert(events[0].Columns, IsNil)
+	c.Assert(events[0].PreColumns[1].Value, DeepEquals, []byte("a"))
+
+	// the update which doesn't change the handle key is not split
+	dml = newDML(update, false, "`test`.`tb`", sourceTable, oldValues, values, oldValues, values, ti.Columns, ti, nil, nil)
+	events = genRowChangedEvents(dml, targetTable, tableInfo, 102, true)
+	c.Assert(events, HasLen, 1)
+	c.Assert(events[0].PreColumns[1].Value, DeepEquals, []byte("a"))
+	c.Assert(events[0].Columns[1].Value, DeepEquals, []byte("b"))
+
+	values = []interface{}{int64(2), "b", int64(2)}
+	dml = newDML(update, false, "`test`.`tb`", sourceTable, oldValues, values, oldValues, values, ti.Columns, ti, nil, nil)
+	events = genRowChangedEvents(dml, targetTable, tableInfo, 103, false)
+	c.Assert(events, HasLen, 1)
+	events = genRowChangedEvents(dml, targetTable, tableInfo, 103, true)
+	c.Assert(events, HasLen, 2)
+	c.Assert(events[0].Columns, IsNil)
+	c.Assert(events[0].PreColumns[0].Value, Equals, int64(1))
+	c.Assert(events[1].PreColumns, IsNil)
+	c.Assert(events[1].Columns[0].Value, Equals, int64(2))
+}
+
+func (s *testSyncerSuite) TestGenDDLEvents(c *C) {
+	ddls := []string{
+		"CREATE DATABASE IF NOT EXISTS `test`",
+		"CREATE TABLE IF NOT EXISTS `test`.`tb` (`id` INT PRIMARY KEY)",
+		"ALTER TABLE `test`.`tb` ADD COLUMN `c1` INT",
+		"ALTER TABLE `test`.`tb` ADD PRIMARY KEY (`c1`)",
+		"RENAME TABLE `test`.`tb` TO `test`.`tb2`",
+		"INSERT INTO `test`.`tb` VALUES (1)",
+	}
+	events, err := genDDLEvents(ddls[:5], 100)
+	c.Assert(err, IsNil)
+	c.Assert(events, HasLen, 5)
+
+	expected := []struct {
+		table string
+		tp    model.ActionType
+	}{
+		{"", model.ActionCreateSchema},
+		{"tb", model.ActionCreateTable},
+		{"tb", model.ActionAddColumn},
+		{"tb", model.ActionAddPrimaryKey},
+		{"tb", model.ActionRenameTable},
+	}
+	for i, event := range events {
+		c.Assert(event.CommitTs, Equals, uint64(100))
+		c.Assert(event.Query, Equals, ddls[i])
+		c.Assert(event.TableInfo.Schema, Equals, "test")
+		c.Assert(event.TableInfo.Table, Equals, expected[i].table)
+		c.Assert(event.Type, Equals, expected[i].tp)
+	}
+
+	_, err = genDDLEvents(ddls[5:], 100)
+	c.Assert(err, NotNil)
+}
+
+func (s *testSyncerSuite) TestMQSinkNextTs(c *C) {
+	sink := &mqSink{}
+	ts1 := sink.nextTs(nil)
+	ts2 := sink.nextTs(nil)
+	c.Assert(ts2, Greater, ts1)
+}
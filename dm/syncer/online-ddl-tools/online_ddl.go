@@ -393,6 +393,7 @@ func (s *Storage) CheckAndUpdate(
 // (_*).*_new ghost table
 // (_*).*_old ghost trash table
 // we don't support `--new-table-name` flag.
+// the tables of other tools are recognized by the Recognizer of the tools in `online-ddl-tools`.
 type RealOnlinePlugin struct {
 	storage    *Storage
 	shadowRegs []*regexp.Regexp
@@ -401,16 +402,20 @@ type RealOnlinePlugin struct {
 
 // NewRealOnlinePlugin returns real online plugin.
 func NewRealOnlinePlugin(tctx *tcontext.Context, cfg *config.SubTaskConfig) (OnlinePlugin, error) {
-	shadowRegs := make([]*regexp.Regexp, 0, len(cfg.ShadowTableRules))
-	trashRegs := make([]*regexp.Regexp, 0, len(cfg.TrashTableRules))
-	for _, sg := range cfg.ShadowTableRules {
+	shadowRules, trashRules, err := tableRulesOfTools(cfg)
+	if err != nil {
+		return nil, err
+	}
+	shadowRegs := make([]*regexp.Regexp, 0, len(shadowRules))
+	trashRegs := make([]*regexp.Regexp, 0, len(trashRules))
+	for _, sg := range shadowRules {
 		shadowReg, err := regexp.Compile(sg)
 		if err != nil {
 			return nil, terror.ErrConfigOnlineDDLInvalidRegex.Generate(config.ShadowTableRules, sg, "fail to compile: "+err.Error())
 		}
 		shadowRegs = append(shadowRegs, shadowReg)
 	}
-	for _, tg := range cfg.TrashTableRules {
+	for _, tg := range trashRules {
 		trashReg, err := regexp.Compile(tg)
 		if err != nil {
 			return nil, terror.ErrConfigOnlineDDLInvalidRegex.Generate(config.TrashTableRules, tg, "fail to compile: "+err.Error())
@@ -0,0 +1,129 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package onlineddl
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// Recognizer recognizes the intermediate tables of an online schema change tool by the table names. the tables
+// matched by the shadow table rules are where the tool applies the DDLs, and the tables matched by the trash table
+// rules are ignored. every rule must contain exactly one submatch which is the name of the real table, so the DDLs
+// of the shadow table are collapsed into a single DDL of the real table when the tool cuts over by RENAME.
+type Recognizer interface {
+	// Name returns the name of the tool, which is used in `online-ddl-tools` of the task config.
+	Name() string
+	// ShadowTableRules returns the regexes of the shadow tables.
+	ShadowTableRules() []string
+	// TrashTableRules returns the regexes of the trash tables, like the changelog and the old tables.
+	TrashTableRules() []string
+}
+
+// ruleRecognizer is a Recognizer with fixed rules.
+type ruleRecognizer struct {
+	name   string
+	shadow []string
+	trash  []string
+}
+
+func (r *ruleRecognizer) Name() string {
+	return r.name
+}
+
+func (r *ruleRecognizer) ShadowTableRules() []string {
+	return r.shadow
+}
+
+func (r *ruleRecognizer) TrashTableRules() []string {
+	return r.trash
+}
+
+// NewRuleRecognizer creates a Recognizer with the shadow and trash table rules.
+func NewRuleRecognizer(name string, shadowRules, trashRules []string) Recognizer {
+	return &ruleRecognizer{name: name, shadow: shadowRules, trash: trashRules}
+}
+
+// names of the built-in online schema change tools besides gh-ost and pt-osc.
+const (
+	ToolSpirit = "spirit"
+	ToolFBOSC  = "fb-osc"
+	ToolLHM    = "lhm"
+)
+
+var (
+	recognizersMu sync.RWMutex
+	recognizers   = map[string]Recognizer{}
+)
+
+func init() {
+	// gh-ost: _*_gho is the shadow table, _*_ghc is the changelog table and _*_del is the old table.
+	RegisterRecognizer(NewRuleRecognizer(config.GHOST, []string{"^_(.+)_gho$"}, []string{"^_(.+)_(?:ghc|del)$"}))
+	// pt-online-schema-change: _*_new is the shadow table and _*_old is the old table.
+	RegisterRecognizer(NewRuleRecognizer(config.PT, []string{"^_(.+)_new$"}, []string{"^_(.+)_old$"}))
+	// spirit: _*_new is the shadow table, _*_old is the old table and _*_chkpnt is the checkpoint table.
+	RegisterRecognizer(NewRuleRecognizer(ToolSpirit, []string{"^_(.+)_new$"}, []string{"^_(.+)_(?:old|chkpnt)$"}))
+	// OnlineSchemaChange of facebook: __osc_new_* is the shadow table, __osc_old_* is the old table and
+	// __osc_chg_* is the change capture table.
+	RegisterRecognizer(NewRuleRecognizer(ToolFBOSC, []string{"^__osc_new_(.+)$"}, []string{"^__osc_(?:old|chg)_(.+)$"}))
+	// large hadron migrator: lhmn_* is the shadow table and lhma_<timestamp>_* is the archived old table.
+	RegisterRecognizer(NewRuleRecognizer(ToolLHM, []string{"^lhmn_(.+)$"}, []string{"^lhma_[0-9_]+_(.+)$"}))
+}
+
+// RegisterRecognizer registers a Recognizer, the Recognizer with the same name is replaced.
+func RegisterRecognizer(r Recognizer) {
+	recognizersMu.Lock()
+	defer recognizersMu.Unlock()
+	recognizers[r.Name()] = r
+}
+
+// GetRecognizer returns the registered Recognizer of the tool.
+func GetRecognizer(name string) (Recognizer, error) {
+	recognizersMu.RLock()
+	defer recognizersMu.RUnlock()
+	r, ok := recognizers[name]
+	if !ok {
+		return nil, terror.ErrConfigOnlineDDLToolNotSupport.Generate(name, registeredRecognizers())
+	}
+	return r, nil
+}
+
+// registeredRecognizers returns the sorted names of the registered recognizers, the caller must hold the lock.
+func registeredRecognizers() []string {
+	names := make([]string, 0, len(recognizers))
+	for name := range recognizers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// tableRulesOfTools returns the shadow and trash table rules of the config, along with the rules of the online schema
+// change tools in `online-ddl-tools`.
+func tableRulesOfTools(cfg *config.SubTaskConfig) ([]string, []string, error) {
+	shadowRules := append([]string{}, cfg.ShadowTableRules...)
+	trashRules := append([]string{}, cfg.TrashTableRules...)
+	for _, tool := range cfg.OnlineDDLTools {
+		r, err := GetRecognizer(tool)
+		if err != nil {
+			return nil, nil, err
+		}
+		shadowRules = append(shadowRules, r.ShadowTableRules()...)
+		trashRules = append(trashRules, r.TrashTableRules()...)
+	}
+	return shadowRules, trashRules, nil
+}
@@ -0,0 +1,306 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+// pausedTable is a table paused by pause-table, its events after the location are skipped or held back according to
+// the policy.
+type pausedTable struct {
+	table    *filter.Table
+	policy   config.PauseTablePolicy
+	location binlog.Location
+}
+
+// pausedTableOp is an operation to pause or resume a table, it's applied at the next transaction boundary.
+type pausedTableOp struct {
+	table *filter.Table
+	// the policy of the paused table, empty for resuming the table.
+	policy config.PauseTablePolicy
+}
+
+// pausedTableReplay is the replay of the events held back for the resumed tables paused with buffer policy. the events
+// of a resumed table are replayed from the location where it's paused, the other events before the location where the
+// tables are resumed are skipped because they're already replicated.
+type pausedTableReplay struct {
+	tables map[string]binlog.Location
+	end    binlog.Location
+}
+
+// PausedTableInfo is the status of a paused table returned by OperatePausedTable in JSON.
+type PausedTableInfo struct {
+	Schema string                  `json:"schema_name"`
+	Table  string                  `json:"table_name"`
+	Policy config.PauseTablePolicy `json:"policy"`
+	// the location from which the table is paused, empty if the table is not paused at the transaction boundary yet.
+	Location string `json:"location"`
+	// whether the table is resumed but not at the transaction boundary yet.
+	Resuming bool `json:"resuming"`
+}
+
+// initPausedTables initializes the paused tables from the subtask config and the checkpoint. the tables paused with
+// buffer policy keep the locations recorded in the checkpoint, the other tables in the subtask config are paused at
+// the first transaction boundary, and the recorded tables not in the subtask config, which are resumed before the
+// subtask is restarted, are resumed at the first transaction boundary. the operations received before are kept.
+func (s *Syncer) initPausedTables() {
+	s.pausedTablesMu.Lock()
+	defer s.pausedTablesMu.Unlock()
+
+	receivedOps := s.pausedTableOps
+	s.pausedTables = make(map[string]*pausedTable)
+	s.pausedTableOps = nil
+	s.pausedTableReplay = nil
+	for _, record := range s.checkpoint.PausedTables() {
+		s.pausedTables[utils.GenTableID(record.table)] = &pausedTable{
+			table:    record.table,
+			policy:   config.PauseTablePolicyBuffer,
+			location: record.location,
+		}
+	}
+
+	kept := make(map[string]struct{}, len(s.cfg.PausedTables))
+	pauseOps := make([]*pausedTableOp, 0, len(s.cfg.PausedTables))
+	for _, t := range s.cfg.PausedTables {
+		table := &filter.Table{Schema: t.Schema, Name: t.Table}
+		tableID := utils.GenTableID(table)
+		if pt, ok := s.pausedTables[tableID]; ok && pt.policy == t.Policy {
+			kept[tableID] = struct{}{}
+			continue
+		}
+		pauseOps = append(pauseOps, &pausedTableOp{table: table, policy: t.Policy})
+	}
+	for tableID, pt := range s.pausedTables {
+		if _, ok := kept[tableID]; !ok {
+			s.pausedTableOps = append(s.pausedTableOps, &pausedTableOp{table: pt.table})
+		}
+	}
+	s.pausedTableOps = append(s.pausedTableOps, pauseOps...)
+	s.pausedTableOps = append(s.pausedTableOps, receivedOps...)
+}
+
+// OperatePausedTable lists, pauses or resumes the upstream tables, and returns the paused tables after the operation
+// in JSON. the operations are applied at the next transaction boundary. the DMLs of the tables paused with skip policy
+// are skipped, and the DMLs and DDLs of the tables paused with buffer policy are held back, they're replicated from the
+// location where the table is paused after the table is resumed.
+func (s *Syncer) OperatePausedTable(op pb.PauseTableOp, tables []*filter.Table, policy config.PauseTablePolicy) (string, error) {
+	if s.cfg.ShardMode != "" {
+		return "", terror.ErrSyncerPauseTableNotSupported.Generate(s.cfg.ShardMode)
+	}
+
+	s.pausedTablesMu.Lock()
+	defer s.pausedTablesMu.Unlock()
+
+	switch op {
+	case pb.PauseTableOp_ListPausedTable:
+	case pb.PauseTableOp_PauseTable:
+		latest := s.latestPausedTables()
+		for _, table := range tables {
+			if policy != config.PauseTablePolicySkip && policy != config.PauseTablePolicyBuffer {
+				return "", terror.ErrConfigInvalidPausedTable.Generate(table, fmt.Sprintf("invalid policy '%s'", policy))
+			}
+			if info, ok := latest[utils.GenTableID(table)]; ok && !info.Resuming && info.Policy != policy {
+				return "", terror.ErrSyncerTableAlreadyPaused.Generate(table, info.Policy)
+			}
+		}
+		for _, table := range tables {
+			s.pausedTableOps = append(s.pausedTableOps, &pausedTableOp{table: table, policy: policy})
+		}
+		s.tctx.L().Info("tables paused, they will be applied at the next transaction boundary",
+			zap.Reflect("tables", tables), zap.String("policy", string(policy)))
+	case pb.PauseTableOp_ResumeTable:
+		for _, table := range tables {
+			s.pausedTableOps = append(s.pausedTableOps, &pausedTableOp{table: table})
+		}
+		s.tctx.L().Info("tables resumed, they will be applied at the next transaction boundary", zap.Reflect("tables", tables))
+	default:
+		return "", fmt.Errorf("invalid paused table operation %s", op)
+	}
+
+	latest := s.latestPausedTables()
+	infos := make([]*PausedTableInfo, 0, len(latest))
+	for _, info := range latest {
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		if infos[i].Schema != infos[j].Schema {
+			return infos[i].Schema < infos[j].Schema
+		}
+		return infos[i].Table < infos[j].Table
+	})
+	data, err := json.Marshal(infos)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// latestPausedTables returns the paused tables including the operations not applied yet, the key is the table ID.
+// caller should hold pausedTablesMu.
+func (s *Syncer) latestPausedTables() map[string]*PausedTableInfo {
+	infos := make(map[string]*PausedTableInfo, len(s.pausedTables))
+	for tableID, pt := range s.pausedTables {
+		infos[tableID] = &PausedTableInfo{
+			Schema:   pt.table.Schema,
+			Table:    pt.table.Name,
+			Policy:   pt.policy,
+			Location: pt.location.String(),
+		}
+	}
+	for _, op := range s.pausedTableOps {
+		tableID := utils.GenTableID(op.table)
+		info, ok := infos[tableID]
+		switch {
+		case op.policy == "" && ok && info.Location == "":
+			delete(infos, tableID)
+		case op.policy == "" && ok:
+			info.Resuming = true
+		case op.policy != "" && (!ok || info.Resuming):
+			infos[tableID] = &PausedTableInfo{Schema: op.table.Schema, Table: op.table.Name, Policy: op.policy}
+		}
+	}
+	return infos
+}
+
+// hasPendingPausedTableOps returns whether there are operations of the paused tables to apply or a replay to finish at
+// the transaction boundaries.
+func (s *Syncer) hasPendingPausedTableOps() bool {
+	s.pausedTablesMu.Lock()
+	defer s.pausedTablesMu.Unlock()
+	return len(s.pausedTableOps) > 0 || s.pausedTableReplay != nil
+}
+
+// applyPausedTableOps applies the operations of the paused tables at the transaction boundary. it returns the location
+// to redirect the binlog stream to if the events held back for the resumed tables need to be replayed. the operations
+// are kept until the previous replay is finished.
+func (s *Syncer) applyPausedTableOps(tctx *tcontext.Context, location binlog.Location) (*binlog.Location, error) {
+	s.pausedTablesMu.Lock()
+	defer s.pausedTablesMu.Unlock()
+
+	if r := s.pausedTableReplay; r != nil {
+		if s.locationCmp.Compare(location, r.end) < 0 {
+			return nil, nil
+		}
+		s.pausedTableReplay = nil
+		tctx.L().Info("finish replaying the resumed tables", zap.Stringer("location", location))
+	}
+
+	var (
+		replayTables = make(map[string]binlog.Location)
+		replayFrom   *binlog.Location
+	)
+	for i, op := range s.pausedTableOps {
+		tableID := utils.GenTableID(op.table)
+		pt, paused := s.pausedTables[tableID]
+		if op.policy == "" {
+			if !paused {
+				continue
+			}
+			if pt.policy == config.PauseTablePolicyBuffer {
+				if err := s.checkpoint.DeletePausedTable(tctx, pt.table); err != nil {
+					s.pausedTableOps = s.pausedTableOps[i:]
+					return nil, err
+				}
+				if s.locationCmp.Compare(pt.location, location) < 0 {
+					replayTables[tableID] = pt.location
+					if replayFrom == nil || s.locationCmp.Compare(pt.location, *replayFrom) < 0 {
+						loc := pt.location.Clone()
+						replayFrom = &loc
+					}
+				}
+			}
+			delete(s.pausedTables, tableID)
+			tctx.L().Info("resume table", zap.Stringer("table", pt.table), zap.Stringer("paused location", pt.location))
+			continue
+		}
+
+		if paused {
+			continue
+		}
+		if op.policy == config.PauseTablePolicyBuffer {
+			if err := s.checkpoint.SavePausedTable(tctx, op.table, location); err != nil {
+				s.pausedTableOps = s.pausedTableOps[i:]
+				return nil, err
+			}
+		}
+		s.pausedTables[tableID] = &pausedTable{table: op.table, policy: op.policy, location: location.Clone()}
+		tctx.L().Info("pause table", zap.Stringer("table", op.table), zap.String("policy", string(op.policy)), zap.Stringer("location", location))
+	}
+	s.pausedTableOps = nil
+
+	if replayFrom != nil {
+		s.pausedTableReplay = &pausedTableReplay{tables: replayTables, end: location.Clone()}
+		tctx.L().Info("replay the resumed tables", zap.Stringer("from", replayFrom), zap.Stringer("to", location))
+	}
+	return replayFrom, nil
+}
+
+// skipByPausedTables returns whether the event of the table at the location should be skipped, because the table is
+// paused, or the event is already replicated before the replay of the resumed tables. the DDLs of the tables paused
+// with skip policy are not skipped to keep the table structure in downstream up to date.
+// it's only called in the main replication goroutine which modifies the paused tables, so the lock is not needed.
+func (s *Syncer) skipByPausedTables(table *filter.Table, location binlog.Location, isDDL bool) bool {
+	if len(s.pausedTables) == 0 && s.pausedTableReplay == nil {
+		return false
+	}
+	tableID := utils.GenTableID(table)
+	if r := s.pausedTableReplay; r != nil && s.locationCmp.Compare(location, r.end) <= 0 {
+		from, ok := r.tables[tableID]
+		if !ok || s.locationCmp.Compare(location, from) <= 0 {
+			return true
+		}
+	}
+	pt, ok := s.pausedTables[tableID]
+	if !ok || s.locationCmp.Compare(location, pt.location) <= 0 {
+		return false
+	}
+	return !isDDL || pt.policy == config.PauseTablePolicyBuffer
+}
+
+// skipDDLByPausedTables returns whether the DDL at the location should be skipped, it's skipped if any of the tables
+// in it should be skipped.
+func (s *Syncer) skipDDLByPausedTables(tables []*filter.Table, location binlog.Location) bool {
+	for _, table := range tables {
+		if s.skipByPausedTables(table, location, true) {
+			return true
+		}
+	}
+	return false
+}
+
+// adjustLocationForPausedTables doesn't let the location pass the locations from which the tables are paused with
+// buffer policy, the events held back are re-read after restarting.
+func (s *Syncer) adjustLocationForPausedTables(location binlog.Location) binlog.Location {
+	s.pausedTablesMu.Lock()
+	defer s.pausedTablesMu.Unlock()
+	for _, pt := range s.pausedTables {
+		if pt.policy == config.PauseTablePolicyBuffer && s.locationCmp.Compare(pt.location, location) < 0 {
+			location = pt.location
+		}
+	}
+	return location
+}
@@ -0,0 +1,160 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-mysql-org/go-mysql/mysql"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/cputil"
+	"github.com/pingcap/tiflow/dm/pkg/retry"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/syncer/dbconn"
+)
+
+func (s *testSyncerSuite) TestPauseTables(c *C) {
+	cfg, err := s.cfg.Clone()
+	c.Assert(err, IsNil)
+	cfg.EnableGTID = false
+	cfg.Flavor = mysql.MySQLFlavor
+	cfg.ShardMode = ""
+	cfg.PausedTables = []*config.PausedTable{{Schema: "db", Table: "tbl1", Policy: config.PauseTablePolicySkip}}
+	syncer := NewSyncer(cfg, nil, nil)
+
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	dbConn, err := db.Conn(context.Background())
+	c.Assert(err, IsNil)
+	cp := syncer.checkpoint.(*RemoteCheckPoint)
+	cp.db = conn.NewBaseDB(db)
+	cp.dbConn = &dbconn.DBConn{Cfg: cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})}
+	pausedTablesTable := dbutil.TableName(cfg.MetaSchema, cputil.SyncerPausedTables(cfg.Name))
+	expectSave := func(table string, pos uint32) {
+		mock.ExpectBegin()
+		mock.ExpectExec("CREATE TABLE IF NOT EXISTS " + pausedTablesTable + " .*").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("DELETE FROM "+pausedTablesTable+" .*").WithArgs(sqlmock.AnyArg(), "db", table).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO "+pausedTablesTable+" .*").
+			WithArgs(sqlmock.AnyArg(), "db", table, sqlmock.AnyArg(), pos, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+	}
+	expectDelete := func(table string) {
+		mock.ExpectBegin()
+		mock.ExpectExec("DELETE FROM "+pausedTablesTable+" .*").WithArgs(sqlmock.AnyArg(), "db", table).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+	}
+	loc := func(pos uint32) binlog.Location {
+		return binlog.InitLocation(mysql.Position{Name: "mysql-bin.000001", Pos: pos}, nil)
+	}
+	table := func(name string) *filter.Table {
+		return &filter.Table{Schema: "db", Name: name}
+	}
+	list := func() []*PausedTableInfo {
+		msg, err2 := syncer.OperatePausedTable(pb.PauseTableOp_ListPausedTable, nil, "")
+		c.Assert(err2, IsNil)
+		var infos []*PausedTableInfo
+		c.Assert(json.Unmarshal([]byte(msg), &infos), IsNil)
+		return infos
+	}
+	tctx := tcontext.Background()
+
+	// the tables of the subtask in shard mode can't be paused
+	syncer.cfg.ShardMode = config.ShardOptimistic
+	_, err = syncer.OperatePausedTable(pb.PauseTableOp_ListPausedTable, nil, "")
+	c.Assert(terror.ErrSyncerPauseTableNotSupported.Equal(err), IsTrue)
+	syncer.cfg.ShardMode = ""
+
+	// the tables in the subtask config are paused at the first transaction boundary, and the table recorded in the
+	// checkpoint but not in the subtask config is resumed
+	cp.pausedTables = []*pausedTableRecord{{table: table("tbl3"), location: loc(500)}}
+	syncer.initPausedTables()
+	c.Assert(syncer.hasPendingPausedTableOps(), IsTrue)
+	infos := list()
+	c.Assert(infos, HasLen, 2)
+	c.Assert(infos[0].Table, Equals, "tbl1")
+	c.Assert(infos[0].Location, Equals, "")
+	c.Assert(infos[1].Table, Equals, "tbl3")
+	c.Assert(infos[1].Resuming, IsTrue)
+
+	// the paused table can't be paused with another policy, and the policy should be valid
+	_, err = syncer.OperatePausedTable(pb.PauseTableOp_PauseTable, []*filter.Table{table("tbl1")}, config.PauseTablePolicyBuffer)
+	c.Assert(terror.ErrSyncerTableAlreadyPaused.Equal(err), IsTrue)
+	_, err = syncer.OperatePausedTable(pb.PauseTableOp_PauseTable, []*filter.Table{table("tbl2")}, "drop")
+	c.Assert(terror.ErrConfigInvalidPausedTable.Equal(err), IsTrue)
+	_, err = syncer.OperatePausedTable(pb.PauseTableOp_PauseTable, []*filter.Table{table("tbl2")}, config.PauseTablePolicyBuffer)
+	c.Assert(err, IsNil)
+
+	// the record of tbl3 is deleted without replaying because its location is not passed yet
+	expectDelete("tbl3")
+	expectSave("tbl2", 400)
+	replayFrom, err := syncer.applyPausedTableOps(tctx, loc(400))
+	c.Assert(err, IsNil)
+	c.Assert(replayFrom, IsNil)
+	c.Assert(syncer.hasPendingPausedTableOps(), IsFalse)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// the DMLs of the paused tables and the DDLs of the tables paused with buffer policy are skipped after the locations
+	c.Assert(syncer.skipByPausedTables(table("tbl1"), loc(1100), false), IsTrue)
+	c.Assert(syncer.skipByPausedTables(table("tbl1"), loc(1100), true), IsFalse)
+	c.Assert(syncer.skipByPausedTables(table("tbl2"), loc(1100), true), IsTrue)
+	c.Assert(syncer.skipByPausedTables(table("tbl2"), loc(300), false), IsFalse)
+	c.Assert(syncer.skipByPausedTables(table("tbl3"), loc(1100), false), IsFalse)
+	c.Assert(syncer.skipDDLByPausedTables([]*filter.Table{table("tbl3"), table("tbl2")}, loc(1100)), IsTrue)
+	// the global checkpoint doesn't pass the table paused with buffer policy
+	c.Assert(syncer.adjustLocationForPausedTables(loc(2000)), DeepEquals, loc(400))
+	c.Assert(syncer.adjustLocationForPausedTables(loc(300)), DeepEquals, loc(300))
+
+	// the resumed table paused with buffer policy is replayed from the location where it's paused
+	_, err = syncer.OperatePausedTable(pb.PauseTableOp_ResumeTable, []*filter.Table{table("tbl2")}, "")
+	c.Assert(err, IsNil)
+	c.Assert(list()[1].Resuming, IsTrue)
+	expectDelete("tbl2")
+	replayFrom, err = syncer.applyPausedTableOps(tctx, loc(2000))
+	c.Assert(err, IsNil)
+	c.Assert(*replayFrom, DeepEquals, loc(400))
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+	c.Assert(syncer.adjustLocationForPausedTables(loc(2000)), DeepEquals, loc(2000))
+	c.Assert(syncer.skipByPausedTables(table("tbl2"), loc(1100), false), IsFalse)
+	c.Assert(syncer.skipByPausedTables(table("tbl3"), loc(1100), false), IsTrue)
+	c.Assert(syncer.skipByPausedTables(table("tbl3"), loc(2100), false), IsFalse)
+
+	// the operations are kept until the replay is finished
+	_, err = syncer.OperatePausedTable(pb.PauseTableOp_PauseTable, []*filter.Table{table("tbl3")}, config.PauseTablePolicySkip)
+	c.Assert(err, IsNil)
+	replayFrom, err = syncer.applyPausedTableOps(tctx, loc(1500))
+	c.Assert(err, IsNil)
+	c.Assert(replayFrom, IsNil)
+	c.Assert(syncer.hasPendingPausedTableOps(), IsTrue)
+	replayFrom, err = syncer.applyPausedTableOps(tctx, loc(2000))
+	c.Assert(err, IsNil)
+	c.Assert(replayFrom, IsNil)
+	c.Assert(syncer.hasPendingPausedTableOps(), IsFalse)
+	c.Assert(syncer.skipByPausedTables(table("tbl3"), loc(2100), false), IsTrue)
+	infos = list()
+	c.Assert(infos, HasLen, 2)
+	c.Assert(infos[1].Policy, Equals, config.PauseTablePolicySkip)
+	c.Assert(infos[1].Location, Equals, loc(2000).String())
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+}
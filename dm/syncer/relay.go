@@ -134,3 +134,15 @@ func (s *Syncer) removeActiveRelayLog() {
 	s.readerHub.RemoveActiveRelayLog(s.cfg.Name)
 	s.tctx.L().Info("current earliest active relay log", log.WrapStringerField("active relay log", s.readerHub.EarliestActiveRelayLog()))
 }
+
+// newLocationComparator creates the comparator of binlog locations for the subtask, the relay server-uuid.index is
+// consulted to order the locations generated by different upstream servers if relay log is used.
+func newLocationComparator(cfg *config.SubTaskConfig) *binlog.LocationComparator {
+	if !cfg.UseRelay || cfg.RelayDir == "" {
+		return binlog.NewLocationComparator(cfg.EnableGTID, nil)
+	}
+	indexPath := filepath.Join(cfg.RelayDir, utils.UUIDIndexFilename)
+	return binlog.NewLocationComparator(cfg.EnableGTID, func() ([]string, error) {
+		return utils.ParseUUIDIndex(indexPath)
+	})
+}
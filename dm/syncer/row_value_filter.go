@@ -0,0 +1,119 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/pingcap/tiflow/dm/syncer/metrics"
+)
+
+// RowValueFilter filters the rows of row events by the binlog event filter rules with row-value-expr.
+type RowValueFilter struct {
+	rules    []*config.BinlogEventRule
+	matchers []*bf.BinlogEvent                  // matchers[i] matches the tables and events of rules[i]
+	exprs    map[string][]expression.Expression // tableName -> exprs of rules, nil if not calculated
+
+	ctx sessionctx.Context
+}
+
+// rowValueRule is a rule with row-value-expr which matches the table and event.
+type rowValueRule struct {
+	name   string
+	action bf.ActionType
+	expr   expression.Expression
+}
+
+// NewRowValueFilter creates a RowValueFilter.
+func NewRowValueFilter(ctx sessionctx.Context, caseSensitive bool, rules []*config.BinlogEventRule) (*RowValueFilter, error) {
+	rules = config.RowValueFilterRules(rules)
+	f := &RowValueFilter{
+		rules:    rules,
+		matchers: make([]*bf.BinlogEvent, 0, len(rules)),
+		exprs:    map[string][]expression.Expression{},
+		ctx:      ctx,
+	}
+	for _, rule := range rules {
+		// a copied rule with `Ignore` action is used to check whether the table and event are matched
+		matchRule := rule.BinlogEventRule
+		matchRule.Action = bf.Ignore
+		matcher, err := bf.NewBinlogEvent(caseSensitive, []*bf.BinlogEventRule{&matchRule})
+		if err != nil {
+			return nil, terror.ErrSyncerUnitGenBinlogEventFilter.Delegate(err)
+		}
+		f.matchers = append(f.matchers, matcher)
+	}
+	return f, nil
+}
+
+// getRules returns the rules matching the table and event type.
+// This function will lazy calculate expressions if not initialized.
+func (f *RowValueFilter) getRules(table *filter.Table, ti *model.TableInfo, et bf.EventType) ([]*rowValueRule, error) {
+	if len(f.rules) == 0 {
+		return nil, nil
+	}
+	tableID := utils.GenTableID(table)
+	exprs, ok := f.exprs[tableID]
+	if !ok {
+		exprs = make([]expression.Expression, len(f.rules))
+		f.exprs[tableID] = exprs
+	}
+
+	var ret []*rowValueRule
+	for i, matcher := range f.matchers {
+		action, err := matcher.Filter(table.Schema, table.Name, et, "")
+		if err != nil {
+			return nil, terror.ErrSyncerUnitBinlogEventFilter.Delegate(err)
+		}
+		if action != bf.Ignore {
+			continue
+		}
+		if exprs[i] == nil {
+			exprs[i], err = getSimpleExprOfTable(f.ctx, f.rules[i].RowValueExpr, ti)
+			if err != nil {
+				return nil, err
+			}
+		}
+		ret = append(ret, &rowValueRule{name: f.rules[i].Name, action: f.rules[i].Action, expr: exprs[i]})
+	}
+	return ret, nil
+}
+
+// ResetExprs deletes the expressions generated before. This should be called after table structure changed.
+func (f *RowValueFilter) ResetExprs(table *filter.Table) {
+	delete(f.exprs, utils.GenTableID(table))
+}
+
+// skipDMLByRowValue returns true when the row is dropped by any of the rules. a row satisfying the expression is
+// dropped by an `Ignore` rule, and a row not satisfying it is dropped by a `Do` rule.
+func (s *Syncer) skipDMLByRowValue(rules []*rowValueRule, row []interface{}, ti *model.TableInfo) (bool, error) {
+	for _, rule := range rules {
+		satisfied, err := SkipDMLByExpression(s.sessCtx, row, rule.expr, ti.Columns)
+		if err != nil {
+			return false, err
+		}
+		if satisfied == (rule.action == bf.Ignore) {
+			metrics.FilteredRowsTotal.WithLabelValues(rule.name, s.cfg.Name, s.cfg.SourceID).Inc()
+			return true, nil
+		}
+	}
+	return false, nil
+}
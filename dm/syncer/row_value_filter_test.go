@@ -0,0 +1,107 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	. "github.com/pingcap/check"
+	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/util/mock"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+func (s *testFilterSuite) TestRowValueFilter(c *C) {
+	ti, err := createTableInfo(parser.New(), mock.NewContext(), 0, "create table t(id bigint primary key, tenant_id bigint)")
+	c.Assert(err, IsNil)
+	table := &filter.Table{Schema: "test", Name: "t"}
+	rules := []*config.BinlogEventRule{
+		{
+			BinlogEventRule: bf.BinlogEventRule{
+				SchemaPattern: "test",
+				TablePattern:  "t",
+				Events:        []bf.EventType{bf.InsertEvent, bf.UpdateEvent},
+				Action:        bf.Ignore,
+			},
+			Name:         "ignore-tenant",
+			RowValueExpr: "tenant_id = 42",
+		},
+		{
+			BinlogEventRule: bf.BinlogEventRule{
+				SchemaPattern: "test",
+				TablePattern:  "t",
+				Events:        []bf.EventType{bf.DeleteEvent},
+				Action:        bf.Do,
+			},
+			Name:         "do-small-id",
+			RowValueExpr: "id < 10",
+		},
+		// the rule without row-value-expr is handled by binlog event filter
+		{
+			BinlogEventRule: bf.BinlogEventRule{
+				SchemaPattern: "test",
+				Events:        []bf.EventType{bf.AllDML},
+				Action:        bf.Ignore,
+			},
+		},
+	}
+	f, err := NewRowValueFilter(utils.NewSessionCtx(nil), false, rules)
+	c.Assert(err, IsNil)
+	syncer := &Syncer{
+		cfg:     &config.SubTaskConfig{Name: "test", SourceID: "source"},
+		sessCtx: utils.NewSessionCtx(nil),
+	}
+
+	cases := []struct {
+		et      bf.EventType
+		row     []interface{}
+		matched int
+		skip    bool
+	}{
+		{bf.InsertEvent, []interface{}{int64(1), int64(42)}, 1, true},
+		{bf.InsertEvent, []interface{}{int64(1), int64(41)}, 1, false},
+		{bf.UpdateEvent, []interface{}{int64(1), nil}, 1, false},
+		{bf.DeleteEvent, []interface{}{int64(1), int64(42)}, 1, false},
+		{bf.DeleteEvent, []interface{}{int64(10), int64(1)}, 1, true},
+	}
+	for _, cs := range cases {
+		matched, err2 := f.getRules(table, ti, cs.et)
+		c.Assert(err2, IsNil)
+		c.Assert(matched, HasLen, cs.matched)
+		skip, err2 := syncer.skipDMLByRowValue(matched, cs.row, ti)
+		c.Assert(err2, IsNil)
+		c.Assert(skip, Equals, cs.skip, Commentf("event %s, row %v", cs.et, cs.row))
+	}
+
+	matched, err := f.getRules(&filter.Table{Schema: "test", Name: "t2"}, ti, bf.InsertEvent)
+	c.Assert(err, IsNil)
+	c.Assert(matched, HasLen, 0)
+
+	// expressions are generated again after the table structure changed
+	c.Assert(f.exprs, HasKey, utils.GenTableID(table))
+	f.ResetExprs(table)
+	c.Assert(f.exprs, Not(HasKey), utils.GenTableID(table))
+	ti, err = createTableInfo(parser.New(), mock.NewContext(), 0, "create table t(id bigint primary key)")
+	c.Assert(err, IsNil)
+	matched, err = f.getRules(table, ti, bf.InsertEvent)
+	c.Assert(err, IsNil)
+	c.Assert(matched, HasLen, 1)
+	// the expression contains non-exist column is always false
+	c.Assert(matched[0].expr.String(), Equals, "0")
+	skip, err := syncer.skipDMLByRowValue(matched, []interface{}{int64(1)}, ti)
+	c.Assert(err, IsNil)
+	c.Assert(skip, IsFalse)
+}
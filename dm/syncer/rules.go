@@ -0,0 +1,247 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"encoding/json"
+
+	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/router"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// hotReloadRules is the block-allow-list, route and binlog filter rules which can be hot reloaded, they're recorded in
+// JSON in the checkpoint.
+type hotReloadRules struct {
+	BAList      *filter.Rules             `json:"block-allow-list"`
+	RouteRules  []*router.TableRule       `json:"route-rules"`
+	FilterRules []*config.BinlogEventRule `json:"filter-rules"`
+}
+
+// ruleSet is a version of the hot reloadable rules with the filters and router built from them.
+type ruleSet struct {
+	version int64
+	rules   hotReloadRules
+	// the location from which the rules take effect, it's nil for the updated rules not applied yet.
+	location *binlog.Location
+
+	baList         *filter.Filter
+	tableRouter    *router.Table
+	binlogFilter   *bf.BinlogEvent
+	rowValueFilter *RowValueFilter
+}
+
+// newRuleSet builds the filters and router of the rules.
+func (s *Syncer) newRuleSet(version int64, rules hotReloadRules) (*ruleSet, error) {
+	var (
+		rs  = &ruleSet{version: version, rules: rules}
+		err error
+	)
+	if rs.baList, err = filter.New(s.cfg.CaseSensitive, rules.BAList); err != nil {
+		return nil, terror.ErrSyncerUnitGenBAList.Delegate(err)
+	}
+	if rs.tableRouter, err = router.NewTableRouter(s.cfg.CaseSensitive, rules.RouteRules); err != nil {
+		return nil, terror.ErrSyncerUnitGenTableRouter.Delegate(err)
+	}
+	rs.tableRouter.SetSourceTagColumn(s.cfg.SourceTagColumn)
+	if rs.binlogFilter, err = bf.NewBinlogEvent(s.cfg.CaseSensitive, config.BinlogFilterRules(rules.FilterRules)); err != nil {
+		return nil, terror.ErrSyncerUnitGenBinlogEventFilter.Delegate(err)
+	}
+	if rs.rowValueFilter, err = NewRowValueFilter(s.sessCtx, s.cfg.CaseSensitive, rules.FilterRules); err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// initRules takes the rules built from the subtask config in Init as the rules in use.
+func (s *Syncer) initRules() {
+	s.rulesMu.Lock()
+	defer s.rulesMu.Unlock()
+	s.rules = &ruleSet{
+		version: s.cfg.RulesVersion,
+		rules: hotReloadRules{
+			BAList:      s.cfg.BAList,
+			RouteRules:  s.cfg.RouteRules,
+			FilterRules: s.cfg.FilterRules,
+		},
+		baList:         s.baList,
+		tableRouter:    s.tableRouter,
+		binlogFilter:   s.binlogFilter,
+		rowValueFilter: s.rowValueFilter,
+	}
+	s.updatedRules = nil
+	s.scheduledRules = nil
+}
+
+// UpdateRules hot reloads the block-allow-list, route and binlog filter rules of the subtask. the rules are applied
+// at the next transaction boundary and recorded along with the location in the checkpoint, so the subtask resumed
+// from an earlier location still uses the rules in effect at that location.
+// the rules not newer than the latest ones are ignored.
+func (s *Syncer) UpdateRules(cfg *config.SubTaskConfig) error {
+	if s.cfg.ShardMode != "" {
+		return terror.ErrSyncerRulesNotHotReloadable.Generate(s.cfg.ShardMode)
+	}
+	rs, err := s.newRuleSet(cfg.RulesVersion, hotReloadRules{
+		BAList:      cfg.BAList,
+		RouteRules:  cfg.RouteRules,
+		FilterRules: cfg.FilterRules,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.rulesMu.Lock()
+	defer s.rulesMu.Unlock()
+	if latest := s.latestRulesVersion(); rs.version <= latest {
+		s.tctx.L().Warn("ignore the rules not newer than the latest ones", zap.Int64("version", rs.version), zap.Int64("latest version", latest))
+		return nil
+	}
+	s.updatedRules = rs
+	s.tctx.L().Info("rules updated, they will be applied at the next transaction boundary", zap.Int64("version", rs.version))
+	return nil
+}
+
+// latestRulesVersion returns the version of the latest rules, including the ones not applied yet.
+// caller should hold rulesMu.
+func (s *Syncer) latestRulesVersion() int64 {
+	switch {
+	case s.updatedRules != nil:
+		return s.updatedRules.version
+	case len(s.scheduledRules) > 0:
+		return s.scheduledRules[len(s.scheduledRules)-1].version
+	case s.rules != nil:
+		return s.rules.version
+	default:
+		return s.cfg.RulesVersion
+	}
+}
+
+// resetRules resets the rules in use to the version in effect at the location where the replication starts from, the
+// newer versions recorded in the checkpoint are scheduled to be applied when the replication reaches their locations.
+func (s *Syncer) resetRules(location binlog.Location) error {
+	history := s.checkpoint.RulesHistory()
+	if len(history) == 0 {
+		return nil
+	}
+
+	s.rulesMu.Lock()
+	defer s.rulesMu.Unlock()
+	var (
+		active    *ruleSet
+		scheduled = make([]*ruleSet, 0)
+	)
+	for _, record := range history {
+		var rules hotReloadRules
+		if err := json.Unmarshal([]byte(record.rules), &rules); err != nil {
+			return terror.ErrSyncerRulesHistory.Delegate(err, record.version)
+		}
+		rs, err := s.newRuleSet(record.version, rules)
+		if err != nil {
+			return err
+		}
+		loc := record.location.Clone()
+		rs.location = &loc
+		if s.locationCmp.Compare(loc, location) <= 0 {
+			active = rs
+		} else {
+			scheduled = append(scheduled, rs)
+		}
+	}
+	if active != nil {
+		s.useRules(active)
+	}
+	s.scheduledRules = scheduled
+	// the rules in the subtask config are updated but not applied before the subtask is restarted.
+	if last := history[len(history)-1]; s.updatedRules == nil && s.cfg.RulesVersion > last.version {
+		rs, err := s.newRuleSet(s.cfg.RulesVersion, hotReloadRules{
+			BAList:      s.cfg.BAList,
+			RouteRules:  s.cfg.RouteRules,
+			FilterRules: s.cfg.FilterRules,
+		})
+		if err != nil {
+			return err
+		}
+		s.updatedRules = rs
+	}
+	s.tctx.L().Info("reset rules", zap.Stringer("location", location), zap.Int64("version", s.rules.version),
+		zap.Int("scheduled versions", len(s.scheduledRules)), zap.Bool("has updated rules", s.updatedRules != nil))
+	return nil
+}
+
+// hasPendingRules returns whether there are rules to apply at the transaction boundaries.
+func (s *Syncer) hasPendingRules() bool {
+	s.rulesMu.Lock()
+	defer s.rulesMu.Unlock()
+	return s.updatedRules != nil || len(s.scheduledRules) > 0
+}
+
+// applyPendingRules applies the scheduled rules whose locations are reached, then applies the updated rules and
+// records them in the checkpoint. it's called at the transaction boundaries.
+func (s *Syncer) applyPendingRules(tctx *tcontext.Context, location binlog.Location) error {
+	s.rulesMu.Lock()
+	defer s.rulesMu.Unlock()
+
+	for len(s.scheduledRules) > 0 && s.locationCmp.Compare(*s.scheduledRules[0].location, location) <= 0 {
+		s.useRules(s.scheduledRules[0])
+		s.scheduledRules = s.scheduledRules[1:]
+	}
+	if len(s.scheduledRules) > 0 || s.updatedRules == nil {
+		return nil
+	}
+
+	if len(s.checkpoint.RulesHistory()) == 0 {
+		// record the rules before the first hot reloading, they're used by the locations before the updated rules.
+		if err := s.saveRules(tctx, s.rules, binlog.NewLocation(s.cfg.Flavor)); err != nil {
+			return err
+		}
+	}
+	rs := s.updatedRules
+	if err := s.saveRules(tctx, rs, location); err != nil {
+		return err
+	}
+	loc := location.Clone()
+	rs.location = &loc
+	s.updatedRules = nil
+	s.useRules(rs)
+	return nil
+}
+
+// saveRules records the rules which take effect from the location in the checkpoint.
+func (s *Syncer) saveRules(tctx *tcontext.Context, rs *ruleSet, location binlog.Location) error {
+	data, err := json.Marshal(rs.rules)
+	if err != nil {
+		return terror.ErrSyncerRulesHistory.Delegate(err, rs.version)
+	}
+	return s.checkpoint.SaveRules(tctx, rs.version, location, string(data))
+}
+
+// useRules replaces the rules in use.
+// caller should hold rulesMu.
+func (s *Syncer) useRules(rs *ruleSet) {
+	if s.rules != nil && s.rules.version == rs.version {
+		return
+	}
+	s.baList = rs.baList
+	s.tableRouter = rs.tableRouter
+	s.binlogFilter = rs.binlogFilter
+	s.rowValueFilter = rs.rowValueFilter
+	s.rules = rs
+	s.tctx.L().Info("use rules", zap.Int64("version", rs.version), zap.Stringer("location", rs.location))
+}
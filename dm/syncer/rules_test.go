@@ -0,0 +1,120 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-mysql-org/go-mysql/mysql"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/cputil"
+	"github.com/pingcap/tiflow/dm/pkg/retry"
+	"github.com/pingcap/tiflow/dm/pkg/router"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/pingcap/tiflow/dm/syncer/dbconn"
+)
+
+func (s *testSyncerSuite) TestHotReloadRules(c *C) {
+	cfg, err := s.cfg.Clone()
+	c.Assert(err, IsNil)
+	cfg.EnableGTID = false
+	cfg.Flavor = mysql.MySQLFlavor
+	cfg.RouteRules = nil
+	cfg.FilterRules = nil
+	cfg.RulesVersion = 0
+	syncer := NewSyncer(cfg, nil, nil)
+	syncer.sessCtx = utils.NewSessionCtx(nil)
+	rs, err := syncer.newRuleSet(0, hotReloadRules{BAList: cfg.BAList})
+	c.Assert(err, IsNil)
+	syncer.useRules(rs)
+
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	dbConn, err := db.Conn(context.Background())
+	c.Assert(err, IsNil)
+	cp := syncer.checkpoint.(*RemoteCheckPoint)
+	cp.db = conn.NewBaseDB(db)
+	cp.dbConn = &dbconn.DBConn{Cfg: cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})}
+	rulesTable := dbutil.TableName(cfg.MetaSchema, cputil.SyncerRules(cfg.Name))
+	expectSave := func(version int64, pos uint32) {
+		mock.ExpectBegin()
+		mock.ExpectExec("CREATE TABLE IF NOT EXISTS " + rulesTable + " .*").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("DELETE FROM "+rulesTable+" .*").WithArgs(sqlmock.AnyArg(), version).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO "+rulesTable+" .*").
+			WithArgs(sqlmock.AnyArg(), version, sqlmock.AnyArg(), pos, sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+	}
+	loc := func(pos uint32) binlog.Location {
+		return binlog.InitLocation(mysql.Position{Name: "mysql-bin.000001", Pos: pos}, nil)
+	}
+	routedSchema := func() string {
+		schema, _, err2 := syncer.tableRouter.Route("db", "tbl")
+		c.Assert(err2, IsNil)
+		return schema
+	}
+	tctx := tcontext.Background()
+
+	newCfg, err := cfg.Clone()
+	c.Assert(err, IsNil)
+	newCfg.RouteRules = []*router.TableRule{{SchemaPattern: "db", TargetSchema: "db2"}}
+	newCfg.RulesVersion = 1
+
+	// the rules of the subtask in shard mode can't be hot reloaded
+	syncer.cfg.ShardMode = config.ShardOptimistic
+	c.Assert(terror.ErrSyncerRulesNotHotReloadable.Equal(syncer.UpdateRules(newCfg)), IsTrue)
+	syncer.cfg.ShardMode = ""
+
+	// the updated rules are applied at the transaction boundary, the rules before them are recorded as the base version
+	c.Assert(syncer.hasPendingRules(), IsFalse)
+	c.Assert(syncer.UpdateRules(newCfg), IsNil)
+	c.Assert(syncer.hasPendingRules(), IsTrue)
+	c.Assert(routedSchema(), Equals, "db")
+	expectSave(0, 4)
+	expectSave(1, 1000)
+	c.Assert(syncer.applyPendingRules(tctx, loc(1000)), IsNil)
+	c.Assert(syncer.hasPendingRules(), IsFalse)
+	c.Assert(routedSchema(), Equals, "db2")
+	c.Assert(cp.RulesHistory(), HasLen, 2)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// the rules not newer than the latest ones are ignored
+	c.Assert(syncer.UpdateRules(newCfg), IsNil)
+	c.Assert(syncer.hasPendingRules(), IsFalse)
+
+	// the replication resumed from an earlier location uses the rules in effect at that location
+	c.Assert(syncer.resetRules(loc(500)), IsNil)
+	c.Assert(routedSchema(), Equals, "db")
+	c.Assert(syncer.hasPendingRules(), IsTrue)
+	c.Assert(syncer.applyPendingRules(tctx, loc(800)), IsNil)
+	c.Assert(routedSchema(), Equals, "db")
+	c.Assert(syncer.applyPendingRules(tctx, loc(1000)), IsNil)
+	c.Assert(routedSchema(), Equals, "db2")
+	c.Assert(syncer.hasPendingRules(), IsFalse)
+
+	// the invalid rules are rejected
+	newCfg.RouteRules = []*router.TableRule{{SchemaPattern: "", TargetSchema: "db2"}}
+	newCfg.RulesVersion = 2
+	c.Assert(syncer.UpdateRules(newCfg), NotNil)
+	c.Assert(syncer.hasPendingRules(), IsFalse)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+}
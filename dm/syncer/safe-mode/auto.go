@@ -0,0 +1,61 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mode
+
+import (
+	"sync"
+	"time"
+)
+
+// AutoSafeMode records the tables which enter safe-mode automatically, each table stays in safe-mode for a window
+// of duration after it's triggered. it's used when DMLs of a table meet duplicate entry or missing row errors.
+type AutoSafeMode struct {
+	mu       sync.Mutex
+	duration time.Duration
+	// table ID -> the time to exit safe-mode
+	deadlines map[string]time.Time
+
+	nowFunc func() time.Time // for test
+}
+
+// NewAutoSafeMode creates a new AutoSafeMode instance.
+func NewAutoSafeMode(duration time.Duration) *AutoSafeMode {
+	return &AutoSafeMode{
+		duration:  duration,
+		deadlines: make(map[string]time.Time),
+		nowFunc:   time.Now,
+	}
+}
+
+// Trigger lets the table enter safe-mode, or extends its window if it's in safe-mode already.
+func (m *AutoSafeMode) Trigger(tableID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deadlines[tableID] = m.nowFunc().Add(m.duration)
+}
+
+// Enable returns whether the table is in safe-mode currently.
+func (m *AutoSafeMode) Enable(tableID string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	deadline, ok := m.deadlines[tableID]
+	if !ok {
+		return false
+	}
+	if m.nowFunc().After(deadline) {
+		delete(m.deadlines, tableID)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,44 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mode
+
+import (
+	"time"
+
+	. "github.com/pingcap/check"
+)
+
+func (t *testModeSuite) TestAutoSafeMode(c *C) {
+	now := time.Now()
+	m := NewAutoSafeMode(time.Minute)
+	m.nowFunc = func() time.Time { return now }
+
+	tb1, tb2 := "`db`.`tb1`", "`db`.`tb2`"
+	c.Assert(m.Enable(tb1), IsFalse)
+
+	m.Trigger(tb1)
+	c.Assert(m.Enable(tb1), IsTrue)
+	c.Assert(m.Enable(tb2), IsFalse)
+
+	// extend the window of tb1
+	now = now.Add(30 * time.Second)
+	m.Trigger(tb1)
+	now = now.Add(time.Minute)
+	c.Assert(m.Enable(tb1), IsTrue)
+
+	// exit safe-mode after the window
+	now = now.Add(time.Second)
+	c.Assert(m.Enable(tb1), IsFalse)
+	c.Assert(m.deadlines, HasLen, 0)
+}
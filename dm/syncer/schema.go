@@ -64,6 +64,18 @@ func (s *Syncer) OperateSchema(ctx context.Context, req *pb.OperateWorkerSchemaR
 	case pb.SchemaOp_GetSchema:
 		// we only try to get schema from schema-tracker now.
 		// in other words, we can not get the schema if any DDL/DML has been replicated, or set a schema previously.
+		createTable, err := s.schemaTracker.GetCreateTable(ctx, sourceTable)
+		if err == nil || !schema.IsTableNotExists(err) {
+			return createTable, err
+		}
+		// the table may be evicted from schema-tracker, load it from the checkpoint again.
+		if err2 := s.schemaTracker.CreateSchemaIfNotExists(sourceTable.Schema); err2 != nil {
+			return "", terror.ErrSchemaTrackerCannotCreateSchema.Delegate(err2, sourceTable.Schema)
+		}
+		ti, err2 := s.loadTableInfoFromCheckpoint(s.tctx.WithContext(ctx), sourceTable)
+		if err2 != nil || ti == nil {
+			return "", err
+		}
 		return s.schemaTracker.GetCreateTable(ctx, sourceTable)
 	case pb.SchemaOp_SetSchema:
 		// from source or target need get schema
@@ -126,6 +138,7 @@ func (s *Syncer) OperateSchema(ctx context.Context, req *pb.OperateWorkerSchemaR
 		}
 
 		s.exprFilterGroup.ResetExprs(sourceTable)
+		s.rowValueFilter.ResetExprs(sourceTable)
 
 		if !req.Flush && !req.Sync {
 			break
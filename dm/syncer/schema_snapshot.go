@@ -0,0 +1,142 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"database/sql"
+	"encoding/json"
+	"io"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/pingcap/tidb/parser/model"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/gtid"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// schemaSnapshot is the table infos of all tables with checkpoints, which is saved along with the checkpoints. when
+// resuming, only the table infos of the checkpoints updated after the snapshot are loaded and unmarshalled from the
+// checkpoint table, the others are taken from the snapshot.
+// source-schema -> source-table -> table info.
+type schemaSnapshot map[string]map[string]*model.TableInfo
+
+// encodeSchemaSnapshot marshals the snapshot to JSON and compresses it by gzip.
+func encodeSchemaSnapshot(snapshot schemaSnapshot) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(w).Encode(snapshot); err != nil {
+		return nil, terror.ErrSyncerSchemaSnapshot.Delegate(err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, terror.ErrSyncerSchemaSnapshot.Delegate(err)
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeSchemaSnapshot is the reverse of encodeSchemaSnapshot.
+func decodeSchemaSnapshot(data []byte) (schemaSnapshot, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, terror.ErrSyncerSchemaSnapshot.Delegate(err)
+	}
+	defer r.Close()
+	data, err = io.ReadAll(r)
+	if err != nil {
+		return nil, terror.ErrSyncerSchemaSnapshot.Delegate(err)
+	}
+	var snapshot schemaSnapshot
+	if err = json.Unmarshal(data, &snapshot); err != nil {
+		return nil, terror.ErrSyncerSchemaSnapshot.Delegate(err)
+	}
+	return snapshot, nil
+}
+
+// genSchemaSnapshotSQLs generates the statements to replace the schema snapshot of the checkpoint, flushedTables are
+// the table points which will be flushed along with the snapshot.
+// NOTE: must be called with the lock of checkpoint held.
+func (cp *RemoteCheckPoint) genSchemaSnapshotSQLs(location binlog.Location, flushedTables map[string]map[string]tablePoint) ([]string, [][]interface{}, error) {
+	snapshot := make(schemaSnapshot, len(cp.points))
+	for schema, mSchema := range cp.points {
+		tables := make(map[string]*model.TableInfo, len(mSchema))
+		for table, point := range mSchema {
+			if tp, ok := flushedTables[schema][table]; ok {
+				tables[table] = tp.ti
+				continue
+			}
+			point.RLock()
+			tables[table] = point.flushedPoint.ti
+			point.RUnlock()
+		}
+		snapshot[schema] = tables
+	}
+	data, err := encodeSchemaSnapshot(snapshot)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sqls := []string{
+		cp.dialect.rebind(`DELETE FROM ` + cp.schemaSnapshotTable + ` WHERE id = ?`),
+		cp.dialect.rebind(`INSERT INTO ` + cp.schemaSnapshotTable + ` (id, binlog_name, binlog_pos, binlog_gtid, table_infos) VALUES (?, ?, ?, ?, ?)`),
+	}
+	args := [][]interface{}{
+		{cp.id},
+		{cp.id, location.Position.Name, location.Position.Pos, location.GTIDSetStr(), data},
+	}
+	return sqls, args, nil
+}
+
+// loadSchemaSnapshot loads the schema snapshot of the checkpoint, returns nil if there's no snapshot.
+func (cp *RemoteCheckPoint) loadSchemaSnapshot(tctx *tcontext.Context) (schemaSnapshot, error) {
+	query := cp.dialect.rebind(`SELECT binlog_name, binlog_pos, binlog_gtid, table_infos FROM ` + cp.schemaSnapshotTable + ` WHERE id = ?`)
+	rows, err := cp.dbConn.QuerySQL(tctx, query, cp.id)
+	if err != nil {
+		return nil, terror.WithScope(err, terror.ScopeDownstream)
+	}
+	defer rows.Close()
+
+	var (
+		binlogName    string
+		binlogPos     uint32
+		binlogGTIDSet sql.NullString
+		data          []byte
+	)
+	for rows.Next() {
+		if err = rows.Scan(&binlogName, &binlogPos, &binlogGTIDSet, &data); err != nil {
+			return nil, terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeDownstream)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeDownstream)
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	snapshot, err := decodeSchemaSnapshot(data)
+	if err != nil {
+		return nil, err
+	}
+	gset, err := gtid.ParserGTID(cp.cfg.Flavor, binlogGTIDSet.String)
+	if err != nil {
+		return nil, err
+	}
+	location := binlog.InitLocation(mysql.Position{Name: binlogName, Pos: binlogPos}, gset)
+	cp.logCtx.L().Info("fetch schema snapshot from DB", zap.Stringer("location", location), zap.Int("schemas", len(snapshot)))
+	return snapshot, nil
+}
@@ -99,17 +99,17 @@ type ShardingMeta struct {
 	sources   map[string]*ShardingSequence // source table ID -> its sharding sequence
 	tableName string                       // table name (with schema) used in downstream meta db
 
-	enableGTID bool // whether enableGTID, used to compare location
+	locationCmp *binlog.LocationComparator // used to compare location
 }
 
 // NewShardingMeta creates a new ShardingMeta.
-func NewShardingMeta(schema, table string, enableGTID bool) *ShardingMeta {
+func NewShardingMeta(schema, table string, locationCmp *binlog.LocationComparator) *ShardingMeta {
 	return &ShardingMeta{
 		tableName: dbutil.TableName(schema, table),
 		global:    &ShardingSequence{Items: make([]*DDLItem, 0)},
 		sources:   make(map[string]*ShardingSequence),
 
-		enableGTID: enableGTID,
+		locationCmp: locationCmp,
 	}
 }
 
@@ -163,7 +163,7 @@ func (meta *ShardingMeta) checkItemExists(item *DDLItem) (int, bool) {
 		return 0, false
 	}
 	for idx, ddlItem := range source.Items {
-		if binlog.CompareLocation(item.FirstLocation, ddlItem.FirstLocation, meta.enableGTID) == 0 {
+		if meta.locationCmp.Compare(item.FirstLocation, ddlItem.FirstLocation) == 0 {
 			return idx, true
 		}
 	}
@@ -201,6 +201,22 @@ func (meta *ShardingMeta) AddItem(item *DDLItem) (active bool, err error) {
 	return index == meta.activeIdx, nil
 }
 
+// AdoptItems replaces the sequence of the source table with the first n DDLItems in global sequence, it's used when
+// the source table joins in sequence sharding and is created with the schema after these DDLs.
+func (meta *ShardingMeta) AdoptItems(source string, n int) {
+	if n > len(meta.global.Items) {
+		n = len(meta.global.Items)
+	}
+	items := make([]*DDLItem, n)
+	copy(items, meta.global.Items[:n])
+	meta.sources[source] = &ShardingSequence{Items: items}
+}
+
+// RemoveSource removes the sequence of the source table, it's used when the source table leaves the sharding group.
+func (meta *ShardingMeta) RemoveSource(source string) {
+	delete(meta.sources, source)
+}
+
 // GetGlobalActiveDDL returns activeDDL in global sequence.
 func (meta *ShardingMeta) GetGlobalActiveDDL() *DDLItem {
 	if meta.activeIdx < len(meta.global.Items) {
@@ -46,7 +46,7 @@ func (t *testShardMetaSuite) TestShardingMeta(c *check.C) {
 		metaTable  = "test_syncer_sharding_meta"
 		sourceID   = "mysql-replica-01"
 		tableID    = "`target_db`.`target_table`"
-		meta       = NewShardingMeta(metaSchema, metaTable, false)
+		meta       = NewShardingMeta(metaSchema, metaTable, binlog.NewLocationComparator(false, nil))
 		items      = []*DDLItem{
 			NewDDLItem(binlog.Location{Position: mysql.Position{Name: filename, Pos: 1000}}, []string{"ddl1"}, table1),
 			NewDDLItem(binlog.Location{Position: mysql.Position{Name: filename, Pos: 1200}}, []string{"ddl2-1,ddl2-2"}, table1),
@@ -197,7 +197,7 @@ func (t *testShardMetaSuite) TestShardingMetaWrongSequence(c *check.C) {
 		filename = "mysql-bin.000001"
 		table1   = "table1"
 		table2   = "table2"
-		meta     = NewShardingMeta("", "", false)
+		meta     = NewShardingMeta("", "", binlog.NewLocationComparator(false, nil))
 		items    = []*DDLItem{
 			NewDDLItem(binlog.Location{Position: mysql.Position{Name: filename, Pos: 1000}}, []string{"ddl1"}, table1),
 			NewDDLItem(binlog.Location{Position: mysql.Position{Name: filename, Pos: 1200}}, []string{"ddl2"}, table1),
@@ -250,8 +250,8 @@ func (t *testShardMetaSuite) TestFlushLoadMeta(c *check.C) {
 		metaTable  = "test_syncer_sharding_meta"
 		sourceID   = "mysql-replica-01"
 		tableID    = "`target_db`.`target_table`"
-		meta       = NewShardingMeta(metaSchema, metaTable, false)
-		loadedMeta = NewShardingMeta(metaSchema, metaTable, false)
+		meta       = NewShardingMeta(metaSchema, metaTable, binlog.NewLocationComparator(false, nil))
+		loadedMeta = NewShardingMeta(metaSchema, metaTable, binlog.NewLocationComparator(false, nil))
 		items      = []*DDLItem{
 			NewDDLItem(binlog.Location{Position: mysql.Position{Name: filename, Pos: 1000}}, []string{"ddl1"}, table1),
 			NewDDLItem(binlog.Location{Position: mysql.Position{Name: filename, Pos: 1200}}, []string{"ddl1"}, table2),
@@ -110,12 +110,12 @@ type ShardingGroup struct {
 	firstEndLocation *binlog.Location // first DDL's binlog End_log_pos and gtid, used to re-direct binlog streamer after synced
 	ddls             []string         // DDL which current in syncing
 
-	flavor     string
-	enableGTID bool
+	flavor      string
+	locationCmp *binlog.LocationComparator
 }
 
 // NewShardingGroup creates a new ShardingGroup.
-func NewShardingGroup(sourceID, shardMetaSchema, shardMetaTable string, sources []string, meta *shardmeta.ShardingMeta, isSchemaOnly bool, flavor string, enableGTID bool) *ShardingGroup {
+func NewShardingGroup(sourceID, shardMetaSchema, shardMetaTable string, sources []string, meta *shardmeta.ShardingMeta, isSchemaOnly bool, flavor string, locationCmp *binlog.LocationComparator) *ShardingGroup {
 	sg := &ShardingGroup{
 		remain:           len(sources),
 		sources:          make(map[string]bool, len(sources)),
@@ -124,12 +124,12 @@ func NewShardingGroup(sourceID, shardMetaSchema, shardMetaTable string, sources
 		firstLocation:    nil,
 		firstEndLocation: nil,
 		flavor:           flavor,
-		enableGTID:       enableGTID,
+		locationCmp:      locationCmp,
 	}
 	if meta != nil {
 		sg.meta = meta
 	} else {
-		sg.meta = shardmeta.NewShardingMeta(shardMetaSchema, shardMetaTable, enableGTID)
+		sg.meta = shardmeta.NewShardingMeta(shardMetaSchema, shardMetaTable, locationCmp)
 	}
 	for _, source := range sources {
 		sg.sources[source] = false
@@ -141,22 +141,22 @@ func NewShardingGroup(sourceID, shardMetaSchema, shardMetaTable string, sources
 // used cases
 //   * add a new table to exists sharding group
 //   * add new table(s) to parent database's sharding group
-//  if group is in sequence sharding, return error directly
-//  otherwise add it in source, set it false and increment remain
+//  add it in source, set it false and increment remain
+//  if group is in sequence sharding, the new source adopts the resolved DDLs in sharding sequence,
+//  use `AdoptActiveDDL` if it's created with the schema after the active DDL too.
 func (sg *ShardingGroup) Merge(sources []string) (bool, bool, int, error) {
 	sg.Lock()
 	defer sg.Unlock()
 
-	// NOTE: we don't support add shard table when in sequence sharding
-	if sg.meta.InSequenceSharding() {
-		return true, sg.remain <= 0, sg.remain, terror.ErrSyncUnitAddTableInSharding.Generate(sg.meta.GetGlobalActiveDDL(), sg.meta.GetGlobalItems())
-	}
-
+	inSequenceSharding := sg.meta.InSequenceSharding()
 	for _, source := range sources {
 		_, exist := sg.sources[source]
 		if !exist {
 			sg.remain++
 			sg.sources[source] = false
+			if inSequenceSharding {
+				sg.meta.AdoptItems(source, sg.meta.ActiveIdx())
+			}
 		}
 	}
 
@@ -168,12 +168,13 @@ func (sg *ShardingGroup) Merge(sources []string) (bool, bool, int, error) {
 // used cases
 //   * drop a database
 //   * drop table
+//  if group is in sequence sharding, the sources can't leave if the active DDL is only waiting for them,
+//  because no DDL will trigger to resolve the group anymore, use `WaitingOnlyFor` to check it before leaving.
 func (sg *ShardingGroup) Leave(sources []string) error {
 	sg.Lock()
 	defer sg.Unlock()
 
-	// NOTE: if group is in sequence sharding, we can't do drop (DROP DATABASE / TABLE)
-	if sg.meta.InSequenceSharding() {
+	if sg.waitingOnlyFor(sources) {
 		return terror.ErrSyncUnitDropSchemaTableInSharding.Generate(sources, sg.meta.GetGlobalActiveDDL(), sg.meta.GetGlobalItems())
 	}
 
@@ -182,8 +183,68 @@ func (sg *ShardingGroup) Leave(sources []string) error {
 			sg.remain--
 		}
 		delete(sg.sources, source)
+		sg.meta.RemoveSource(source)
+	}
+
+	return nil
+}
+
+// WaitingOnlyFor returns whether the active DDL has been synced by some sources and is only waiting for the sources.
+func (sg *ShardingGroup) WaitingOnlyFor(sources []string) bool {
+	sg.RLock()
+	defer sg.RUnlock()
+	return sg.waitingOnlyFor(sources)
+}
+
+func (sg *ShardingGroup) waitingOnlyFor(sources []string) bool {
+	if !sg.meta.InSequenceSharding() || sg.remain == len(sg.sources) {
+		return false
 	}
+	unsynced := 0
+	for _, source := range sources {
+		if synced, ok := sg.sources[source]; ok && !synced {
+			unsynced++
+		}
+	}
+	return unsynced > 0 && unsynced == sg.remain
+}
 
+// SyncedSourceOfActiveDDL returns a source which has synced the active DDL, if the group is in sequence sharding and
+// the given source hasn't synced the active DDL yet.
+func (sg *ShardingGroup) SyncedSourceOfActiveDDL(source string) (string, bool) {
+	sg.RLock()
+	defer sg.RUnlock()
+	if !sg.meta.InSequenceSharding() || sg.sources[source] {
+		return "", false
+	}
+	for s, synced := range sg.sources {
+		if synced {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// AdoptActiveDDL marks the source joined in sequence sharding as synced for the active DDL without executing it,
+// it's used when the source is created with the same schema as the sources which have synced the active DDL.
+func (sg *ShardingGroup) AdoptActiveDDL(source string) (bool, int) {
+	sg.Lock()
+	defer sg.Unlock()
+	if synced, ok := sg.sources[source]; ok && !synced && sg.meta.InSequenceSharding() {
+		sg.meta.AdoptItems(source, sg.meta.ActiveIdx()+1)
+		sg.sources[source] = true
+		sg.remain--
+	}
+	return sg.remain <= 0, sg.remain
+}
+
+// ActiveDDLs returns the DDLs of the active DDL in sequence sharding.
+func (sg *ShardingGroup) ActiveDDLs() []string {
+	sg.RLock()
+	defer sg.RUnlock()
+	if item := sg.meta.GetGlobalActiveDDL(); item != nil {
+		return item.DDLs
+	}
 	return nil
 }
 
@@ -239,7 +300,7 @@ func (sg *ShardingGroup) CheckSyncing(source string, location binlog.Location) (
 	if activeDDLItem == nil {
 		return true
 	}
-	return binlog.CompareLocation(activeDDLItem.FirstLocation, location, sg.enableGTID) > 0
+	return sg.locationCmp.Compare(activeDDLItem.FirstLocation, location) > 0
 }
 
 // UnresolvedGroupInfo returns pb.ShardingGroup if is unresolved, else returns nil.
@@ -368,8 +429,9 @@ func (sg *ShardingGroup) FlushData(targetTableID string) ([]string, [][]interfac
 // ShardingGroupKeeper used to keep ShardingGroup.
 type ShardingGroupKeeper struct {
 	sync.RWMutex
-	groups map[string]*ShardingGroup // target table ID -> ShardingGroup
-	cfg    *config.SubTaskConfig
+	groups      map[string]*ShardingGroup // target table ID -> ShardingGroup
+	cfg         *config.SubTaskConfig
+	locationCmp *binlog.LocationComparator
 
 	shardMetaSchema    string
 	shardMetaTable     string
@@ -384,9 +446,10 @@ type ShardingGroupKeeper struct {
 // NewShardingGroupKeeper creates a new ShardingGroupKeeper.
 func NewShardingGroupKeeper(tctx *tcontext.Context, cfg *config.SubTaskConfig) *ShardingGroupKeeper {
 	k := &ShardingGroupKeeper{
-		groups: make(map[string]*ShardingGroup),
-		cfg:    cfg,
-		tctx:   tctx.WithLogger(tctx.L().WithFields(zap.String("component", "shard group keeper"))),
+		groups:      make(map[string]*ShardingGroup),
+		cfg:         cfg,
+		locationCmp: newLocationComparator(cfg),
+		tctx:        tctx.WithLogger(tctx.L().WithFields(zap.String("component", "shard group keeper"))),
 	}
 	k.shardMetaSchema = cfg.MetaSchema
 	k.shardMetaTable = cputil.SyncerShardMeta(cfg.Name)
@@ -405,7 +468,7 @@ func (k *ShardingGroupKeeper) AddGroup(targetTable *filter.Table, sourceIDs []st
 	defer k.Unlock()
 
 	if schemaGroup, ok := k.groups[targetSchemaID]; !ok {
-		k.groups[targetSchemaID] = NewShardingGroup(k.cfg.SourceID, k.shardMetaSchema, k.shardMetaTable, sourceIDs, meta, true, k.cfg.Flavor, k.cfg.EnableGTID)
+		k.groups[targetSchemaID] = NewShardingGroup(k.cfg.SourceID, k.shardMetaSchema, k.shardMetaTable, sourceIDs, meta, true, k.cfg.Flavor, k.locationCmp)
 	} else {
 		_, _, _, err = schemaGroup.Merge(sourceIDs)
 		if err != nil {
@@ -416,7 +479,7 @@ func (k *ShardingGroupKeeper) AddGroup(targetTable *filter.Table, sourceIDs []st
 	group, ok := k.groups[targetTableID]
 	switch {
 	case !ok:
-		group = NewShardingGroup(k.cfg.SourceID, k.shardMetaSchema, k.shardMetaTable, sourceIDs, meta, false, k.cfg.Flavor, k.cfg.EnableGTID)
+		group = NewShardingGroup(k.cfg.SourceID, k.shardMetaSchema, k.shardMetaTable, sourceIDs, meta, false, k.cfg.Flavor, k.locationCmp)
 		k.groups[targetTableID] = group
 	case merge:
 		needShardingHandle, synced, remain, err = k.groups[targetTableID].Merge(sourceIDs)
@@ -578,7 +641,7 @@ func (k *ShardingGroupKeeper) lowestFirstLocationInGroups() *binlog.Location {
 		}
 		if lowest == nil {
 			lowest = location
-		} else if binlog.CompareLocation(*lowest, *location, k.cfg.EnableGTID) > 0 {
+		} else if k.locationCmp.Compare(*lowest, *location) > 0 {
 			lowest = location
 		}
 	}
@@ -588,7 +651,7 @@ func (k *ShardingGroupKeeper) lowestFirstLocationInGroups() *binlog.Location {
 // AdjustGlobalLocation adjusts globalLocation with sharding groups' lowest first point.
 func (k *ShardingGroupKeeper) AdjustGlobalLocation(globalLocation binlog.Location) binlog.Location {
 	lowestFirstLocation := k.lowestFirstLocationInGroups()
-	if lowestFirstLocation != nil && binlog.CompareLocation(*lowestFirstLocation, globalLocation, k.cfg.EnableGTID) < 0 {
+	if lowestFirstLocation != nil && k.locationCmp.Compare(*lowestFirstLocation, globalLocation) < 0 {
 		return *lowestFirstLocation
 	}
 	return globalLocation
@@ -707,7 +770,7 @@ func (k *ShardingGroupKeeper) createTable() error {
 }
 
 // LoadShardMeta implements CheckPoint.LoadShardMeta.
-func (k *ShardingGroupKeeper) LoadShardMeta(flavor string, enableGTID bool) (map[string]*shardmeta.ShardingMeta, error) {
+func (k *ShardingGroupKeeper) LoadShardMeta(flavor string) (map[string]*shardmeta.ShardingMeta, error) {
 	query := fmt.Sprintf("SELECT `target_table_id`, `source_table_id`, `active_index`, `is_global`, `data` FROM %s WHERE `source_id`='%s'", k.shardMetaTableName, k.cfg.SourceID)
 	rows, err := k.dbConn.QuerySQL(k.tctx, query)
 	if err != nil {
@@ -729,7 +792,7 @@ func (k *ShardingGroupKeeper) LoadShardMeta(flavor string, enableGTID bool) (map
 			return nil, terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeDownstream)
 		}
 		if _, ok := meta[targetTableID]; !ok {
-			meta[targetTableID] = shardmeta.NewShardingMeta(k.shardMetaSchema, k.shardMetaTable, enableGTID)
+			meta[targetTableID] = shardmeta.NewShardingMeta(k.shardMetaSchema, k.shardMetaTable, k.locationCmp)
 		}
 		err = meta[targetTableID].RestoreFromData(sourceTableID, activeIndex, isGlobal, []byte(data), flavor)
 		if err != nil {
@@ -81,18 +81,18 @@ func (t *testShardingGroupSuite) SetUpSuite(c *C) {
 func (t *testShardingGroupSuite) TestLowestFirstPosInGroups(c *C) {
 	k := NewShardingGroupKeeper(tcontext.Background(), t.cfg)
 
-	g1 := NewShardingGroup(k.cfg.SourceID, k.shardMetaSchema, k.shardMetaTable, []string{"db1.tbl1", "db1.tbl2"}, nil, false, "", false)
+	g1 := NewShardingGroup(k.cfg.SourceID, k.shardMetaSchema, k.shardMetaTable, []string{"db1.tbl1", "db1.tbl2"}, nil, false, "", binlog.NewLocationComparator(false, nil))
 	// nolint:dogsled
 	_, _, _, err := g1.TrySync("db1.tbl1", pos11, endPos11, ddls1)
 	c.Assert(err, IsNil)
 
 	// lowest
-	g2 := NewShardingGroup(k.cfg.SourceID, k.shardMetaSchema, k.shardMetaTable, []string{"db2.tbl1", "db2.tbl2"}, nil, false, "", false)
+	g2 := NewShardingGroup(k.cfg.SourceID, k.shardMetaSchema, k.shardMetaTable, []string{"db2.tbl1", "db2.tbl2"}, nil, false, "", binlog.NewLocationComparator(false, nil))
 	// nolint:dogsled
 	_, _, _, err = g2.TrySync("db2.tbl1", pos21, endPos21, ddls1)
 	c.Assert(err, IsNil)
 
-	g3 := NewShardingGroup(k.cfg.SourceID, k.shardMetaSchema, k.shardMetaTable, []string{"db3.tbl1", "db3.tbl2"}, nil, false, "", false)
+	g3 := NewShardingGroup(k.cfg.SourceID, k.shardMetaSchema, k.shardMetaTable, []string{"db3.tbl1", "db3.tbl2"}, nil, false, "", binlog.NewLocationComparator(false, nil))
 	// nolint:dogsled
 	_, _, _, err = g3.TrySync("db3.tbl1", pos3, endPos3, ddls1)
 	c.Assert(err, IsNil)
@@ -106,7 +106,7 @@ func (t *testShardingGroupSuite) TestLowestFirstPosInGroups(c *C) {
 
 func (t *testShardingGroupSuite) TestMergeAndLeave(c *C) {
 	k := NewShardingGroupKeeper(tcontext.Background(), t.cfg)
-	g1 := NewShardingGroup(k.cfg.SourceID, k.shardMetaSchema, k.shardMetaTable, []string{source1, source2}, nil, false, "", false)
+	g1 := NewShardingGroup(k.cfg.SourceID, k.shardMetaSchema, k.shardMetaTable, []string{source1, source2}, nil, false, "", binlog.NewLocationComparator(false, nil))
 	c.Assert(g1.Sources(), DeepEquals, map[string]bool{source1: false, source2: false})
 
 	needShardingHandle, synced, remain, err := g1.Merge([]string{source3})
@@ -140,20 +140,74 @@ func (t *testShardingGroupSuite) TestMergeAndLeave(c *C) {
 
 	// nolint:dogsled
 	_, _, _, err = g1.Merge([]string{source1})
-	c.Assert(terror.ErrSyncUnitAddTableInSharding.Equal(err), IsTrue)
+	c.Assert(err, IsNil)
 	err = g1.Leave([]string{source2})
 	c.Assert(terror.ErrSyncUnitDropSchemaTableInSharding.Equal(err), IsTrue)
 }
 
+func (t *testShardingGroupSuite) TestJoinAndLeaveInSequenceSharding(c *C) {
+	k := NewShardingGroupKeeper(tcontext.Background(), t.cfg)
+	g1 := NewShardingGroup(k.cfg.SourceID, k.shardMetaSchema, k.shardMetaTable, []string{source1, source2, source3}, nil, false, "", binlog.NewLocationComparator(false, nil))
+	synced, active, remain, err := g1.TrySync(source1, pos11, endPos11, ddls1)
+	c.Assert(err, IsNil)
+	c.Assert(synced, IsFalse)
+	c.Assert(active, IsTrue)
+	c.Assert(remain, Equals, 2)
+
+	// the active DDL is only waiting for source2 and source3
+	c.Assert(g1.WaitingOnlyFor([]string{source2}), IsFalse)
+	c.Assert(g1.WaitingOnlyFor([]string{source1, source2}), IsFalse)
+	c.Assert(g1.WaitingOnlyFor([]string{source2, source3}), IsTrue)
+	c.Assert(terror.ErrSyncUnitDropSchemaTableInSharding.Equal(g1.Leave([]string{source2, source3})), IsTrue)
+
+	// tables can join and leave in sequence sharding
+	needShardingHandle, synced, remain, err := g1.Merge([]string{source4})
+	c.Assert(err, IsNil)
+	c.Assert(needShardingHandle, IsFalse)
+	c.Assert(synced, IsFalse)
+	c.Assert(remain, Equals, 3)
+	c.Assert(g1.Leave([]string{source2}), IsNil)
+	c.Assert(g1.Sources(), DeepEquals, map[string]bool{source1: true, source3: false, source4: false})
+	c.Assert(g1.ActiveDDLs(), DeepEquals, ddls1)
+
+	// the joined table created with the schema after the active DDL adopts it
+	syncedSource, ok := g1.SyncedSourceOfActiveDDL(source4)
+	c.Assert(ok, IsTrue)
+	c.Assert(syncedSource, Equals, source1)
+	synced, remain = g1.AdoptActiveDDL(source4)
+	c.Assert(synced, IsFalse)
+	c.Assert(remain, Equals, 1)
+	_, ok = g1.SyncedSourceOfActiveDDL(source4)
+	c.Assert(ok, IsFalse)
+	c.Assert(g1.WaitingOnlyFor([]string{source3}), IsTrue)
+
+	// the last table syncs the active DDL, and the adopted table needs to sync the next DDL by itself
+	synced, active, _, err = g1.TrySync(source1, pos12, endPos12, ddls2)
+	c.Assert(err, IsNil)
+	c.Assert(synced, IsFalse)
+	c.Assert(active, IsFalse)
+	synced, active, remain, err = g1.TrySync(source3, pos21, endPos21, ddls1)
+	c.Assert(err, IsNil)
+	c.Assert(synced, IsTrue)
+	c.Assert(active, IsTrue)
+	c.Assert(remain, Equals, 0)
+	c.Assert(g1.ResolveShardingDDL(), IsFalse)
+	g1.Reset()
+	c.Assert(g1.ActiveDDLs(), DeepEquals, ddls2)
+	_, active, _, err = g1.TrySync(source4, pos22, endPos22, ddls2)
+	c.Assert(err, IsNil)
+	c.Assert(active, IsTrue)
+}
+
 func (t *testShardingGroupSuite) TestSync(c *C) {
 	k := NewShardingGroupKeeper(tcontext.Background(), t.cfg)
-	g1 := NewShardingGroup(k.cfg.SourceID, k.shardMetaSchema, k.shardMetaTable, []string{source1, source2}, nil, false, "", false)
+	g1 := NewShardingGroup(k.cfg.SourceID, k.shardMetaSchema, k.shardMetaTable, []string{source1, source2}, nil, false, "", binlog.NewLocationComparator(false, nil))
 	synced, active, remain, err := g1.TrySync(source1, pos11, endPos11, ddls1)
 	c.Assert(err, IsNil)
 	c.Assert(synced, IsFalse)
 	c.Assert(active, IsTrue)
 	c.Assert(remain, Equals, 1)
-	synced, active, remain, err = g1.TrySync(source1, pos12, endPos12, ddls2)
+	synced, active, _, err = g1.TrySync(source1, pos12, endPos12, ddls2)
 	c.Assert(err, IsNil)
 	c.Assert(synced, IsFalse)
 	c.Assert(active, IsFalse)
@@ -256,7 +310,7 @@ func (t *testShardingGroupSuite) TestKeeper(c *C) {
 
 	mock.ExpectQuery(" SELECT `target_table_id`, `source_table_id`, `active_index`, `is_global`, `data` FROM `test`.`checkpoint_ut_syncer_sharding_meta`.*").
 		WillReturnRows(sqlmock.NewRows([]string{"target_table_id", "source_table_id", "active_index", "is_global", "data"}))
-	meta, err := k.LoadShardMeta(mysql.MySQLFlavor, false)
+	meta, err := k.LoadShardMeta(mysql.MySQLFlavor)
 	c.Assert(err, IsNil)
 	c.Assert(meta, HasLen, 0)
 	mock.ExpectQuery(" SELECT `target_table_id`, `source_table_id`, `active_index`, `is_global`, `data` FROM `test`.`checkpoint_ut_syncer_sharding_meta`.*").
@@ -264,7 +318,7 @@ func (t *testShardingGroupSuite) TestKeeper(c *C) {
 			AddRow(target, "", 0, true, "[{\"ddls\":[\"DUMMY DDL\"],\"source\":\"`db1`.`tbl1`\",\"first-position\":{\"Name\":\"mysql-bin.000002\",\"Pos\":123},\"first-gtid-set\":\"\"},{\"ddls\":[\"ANOTHER DUMMY DDL\"],\"source\":\"`db1`.`tbl1`\",\"first-position\":{\"Name\":\"mysql-bin.000002\",\"Pos\":789},\"first-gtid-set\":\"\"}]").
 			AddRow(target, source1, 0, false, "[{\"ddls\":[\"DUMMY DDL\"],\"source\":\"`db1`.`tbl1`\",\"first-position\":{\"Name\":\"mysql-bin.000002\",\"Pos\":123},\"first-gtid-set\":\"\"},{\"ddls\":[\"ANOTHER DUMMY DDL\"],\"source\":\"`db1`.`tbl1`\",\"first-position\":{\"Name\":\"mysql-bin.000002\",\"Pos\":789},\"first-gtid-set\":\"\"}]"))
 
-	meta, err = k.LoadShardMeta(mysql.MySQLFlavor, false)
+	meta, err = k.LoadShardMeta(mysql.MySQLFlavor)
 	c.Assert(err, IsNil)
 	c.Assert(meta, HasLen, 1) // has meta of `target`
 
@@ -0,0 +1,78 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"fmt"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/gtid"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// OperateSkipGTID lists, adds or removes the GTIDs of the transactions to skip, and returns the GTID skip list after
+// the operation. unlike the one-shot `binlog skip`, the GTID skip list is persisted along with the checkpoint and
+// honored after restarts, it's used to skip the recurring bad transactions from upstream.
+// NOTE: a MariaDB GTID set can't record discrete transactions, so only MySQL with GTID enabled is supported.
+func (s *Syncer) OperateSkipGTID(op pb.SkipGTIDOp, gtidSetStr string) (string, error) {
+	if !s.cfg.EnableGTID || s.cfg.Flavor != mysql.MySQLFlavor {
+		return "", terror.ErrSyncerGTIDSkipListNotSupported.Generate(s.cfg.EnableGTID, s.cfg.Flavor)
+	}
+
+	skipList := s.checkpoint.GTIDSkipList()
+	if op != pb.SkipGTIDOp_ListSkipGTID {
+		gs, err := gtid.ParserGTID(s.cfg.Flavor, gtidSetStr)
+		if err != nil {
+			return "", err
+		}
+		switch op {
+		case pb.SkipGTIDOp_AddSkipGTID:
+			skipList, err = gtid.Merge(skipList, gs)
+		case pb.SkipGTIDOp_RemoveSkipGTID:
+			skipList, err = gtid.Subtract(skipList, gs)
+		default:
+			return "", fmt.Errorf("invalid GTID skip list operation %s", op)
+		}
+		if err != nil {
+			return "", err
+		}
+		if err = s.checkpoint.SaveGTIDSkipList(s.tctx, skipList); err != nil {
+			return "", err
+		}
+		s.tctx.L().Info("GTID skip list changed", zap.Stringer("op", op), zap.String("GTID set", gtidSetStr))
+		skipList = s.checkpoint.GTIDSkipList()
+	}
+
+	if skipList == nil {
+		return "", nil
+	}
+	return skipList.String(), nil
+}
+
+// isGTIDSkipped returns whether the transaction of the GTID is in the GTID skip list.
+func (s *Syncer) isGTIDSkipped(gtidStr string) bool {
+	skipList := s.checkpoint.GTIDSkipList()
+	if skipList == nil || gtidStr == "" {
+		return false
+	}
+	gs, err := gtid.ParserGTID(s.cfg.Flavor, gtidStr)
+	if err != nil {
+		s.tctx.L().Warn("fail to parse GTID of transaction", zap.String("GTID", gtidStr), zap.Error(err))
+		return false
+	}
+	return gtid.IsSubset(gs, skipList)
+}
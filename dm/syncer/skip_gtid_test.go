@@ -0,0 +1,94 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-mysql-org/go-mysql/mysql"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+
+	"github.com/pingcap/tiflow/dm/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/pingcap/tiflow/dm/pkg/cputil"
+	"github.com/pingcap/tiflow/dm/pkg/retry"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/syncer/dbconn"
+)
+
+func (s *testSyncerSuite) TestOperateSkipGTID(c *C) {
+	cfg, err := s.cfg.Clone()
+	c.Assert(err, IsNil)
+	syncer := NewSyncer(cfg, nil, nil)
+	_, err = syncer.OperateSkipGTID(pb.SkipGTIDOp_ListSkipGTID, "")
+	c.Assert(terror.ErrSyncerGTIDSkipListNotSupported.Equal(err), IsTrue)
+
+	cfg.EnableGTID = true
+	cfg.Flavor = mysql.MySQLFlavor
+	syncer = NewSyncer(cfg, nil, nil)
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	dbConn, err := db.Conn(context.Background())
+	c.Assert(err, IsNil)
+	cp := syncer.checkpoint.(*RemoteCheckPoint)
+	cp.db = conn.NewBaseDB(db)
+	cp.dbConn = &dbconn.DBConn{Cfg: cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})}
+	skipListTable := dbutil.TableName(cfg.MetaSchema, cputil.SyncerGTIDSkipList(cfg.Name))
+	expectSave := func(gtidSet string) {
+		mock.ExpectBegin()
+		mock.ExpectExec("DELETE FROM " + skipListTable + " WHERE id = \\?").WillReturnResult(sqlmock.NewResult(0, 1))
+		if gtidSet != "" {
+			mock.ExpectExec("INSERT INTO "+skipListTable+" .*").WithArgs(sqlmock.AnyArg(), gtidSet).WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+		mock.ExpectCommit()
+	}
+
+	uuid := "3ccc475b-2343-11e7-be21-6c0b84d59f30"
+	skipList, err := syncer.OperateSkipGTID(pb.SkipGTIDOp_ListSkipGTID, "")
+	c.Assert(err, IsNil)
+	c.Assert(skipList, Equals, "")
+	c.Assert(syncer.isGTIDSkipped(uuid+":5"), IsFalse)
+
+	// the GTIDs are merged into the skip list
+	expectSave(uuid + ":5-8")
+	skipList, err = syncer.OperateSkipGTID(pb.SkipGTIDOp_AddSkipGTID, uuid+":5-8")
+	c.Assert(err, IsNil)
+	c.Assert(skipList, Equals, uuid+":5-8")
+	expectSave(uuid + ":5-8:12")
+	skipList, err = syncer.OperateSkipGTID(pb.SkipGTIDOp_AddSkipGTID, uuid+":6:12")
+	c.Assert(err, IsNil)
+	c.Assert(skipList, Equals, uuid+":5-8:12")
+	c.Assert(syncer.isGTIDSkipped(uuid+":5"), IsTrue)
+	c.Assert(syncer.isGTIDSkipped(uuid+":12"), IsTrue)
+	c.Assert(syncer.isGTIDSkipped(uuid+":9"), IsFalse)
+	c.Assert(syncer.isGTIDSkipped("a1b2c3d4-28c7-11e7-a653-6c0b84d59f30:5"), IsFalse)
+
+	// the GTIDs are removed from the skip list
+	expectSave(uuid + ":5:8:12")
+	skipList, err = syncer.OperateSkipGTID(pb.SkipGTIDOp_RemoveSkipGTID, uuid+":6-7")
+	c.Assert(err, IsNil)
+	c.Assert(skipList, Equals, uuid+":5:8:12")
+	c.Assert(syncer.isGTIDSkipped(uuid+":6"), IsFalse)
+	expectSave("")
+	skipList, err = syncer.OperateSkipGTID(pb.SkipGTIDOp_RemoveSkipGTID, uuid+":1-100")
+	c.Assert(err, IsNil)
+	c.Assert(skipList, Equals, "")
+	c.Assert(syncer.isGTIDSkipped(uuid+":5"), IsFalse)
+
+	_, err = syncer.OperateSkipGTID(pb.SkipGTIDOp_AddSkipGTID, "invalid")
+	c.Assert(err, NotNil)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+}
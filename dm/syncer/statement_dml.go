@@ -0,0 +1,597 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/format"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/opcode"
+	"github.com/pingcap/tidb/types"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/syncer/metrics"
+)
+
+// statementDMLAction is how a DML found in a query event is handled when statement-dml-fallback is enabled.
+type statementDMLAction int
+
+const (
+	statementDMLSkip statementDMLAction = iota
+	// the DML is converted to row changes, which are handled like a rows event.
+	statementDMLToRows
+	// the DML is executed in downstream as it is, only the table name is routed.
+	statementDMLVerbatim
+)
+
+func (a statementDMLAction) String() string {
+	switch a {
+	case statementDMLToRows:
+		return "rows"
+	case statementDMLVerbatim:
+		return "verbatim"
+	default:
+		return "skip"
+	}
+}
+
+// nonDeterministicFuncs are the functions whose results may differ between upstream and downstream.
+var nonDeterministicFuncs = map[string]struct{}{
+	ast.Rand: {}, ast.RandomBytes: {}, ast.UUID: {}, ast.UUIDShort: {},
+	ast.Now: {}, ast.Sysdate: {}, ast.CurrentTimestamp: {}, ast.Curdate: {}, ast.CurrentDate: {}, ast.Curtime: {},
+	ast.CurrentTime: {}, ast.LocalTime: {}, ast.LocalTimestamp: {}, ast.UnixTimestamp: {}, ast.UTCDate: {},
+	ast.UTCTime: {}, ast.UTCTimestamp: {},
+	ast.ConnectionID: {}, ast.LastInsertId: {}, ast.FoundRows: {}, ast.RowCount: {}, ast.User: {},
+	ast.CurrentUser: {}, ast.CurrentRole: {}, ast.SessionUser: {}, ast.SystemUser: {}, ast.Database: {},
+	ast.Schema: {}, ast.Version: {},
+	ast.Sleep: {}, ast.Benchmark: {}, ast.LoadFile: {}, ast.GetLock: {}, ast.ReleaseLock: {},
+	ast.ReleaseAllLocks: {}, ast.IsFreeLock: {}, ast.IsUsedLock: {},
+	ast.GetVar: {}, ast.SetVar: {}, ast.NextVal: {}, ast.LastVal: {}, ast.SetVal: {},
+}
+
+// deterministicChecker finds the expressions which make a DML statement non-deterministic.
+type deterministicChecker struct {
+	reason string
+}
+
+// Enter implements ast.Visitor interface.
+func (c *deterministicChecker) Enter(in ast.Node) (ast.Node, bool) {
+	switch n := in.(type) {
+	case *ast.FuncCallExpr:
+		if _, ok := nonDeterministicFuncs[n.FnName.L]; ok {
+			c.reason = fmt.Sprintf("non-deterministic function %s", n.FnName.O)
+		}
+	case *ast.VariableExpr:
+		c.reason = "user or system variable"
+	case *ast.SubqueryExpr:
+		c.reason = "subquery"
+	}
+	return in, c.reason != ""
+}
+
+// Leave implements ast.Visitor interface.
+func (c *deterministicChecker) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+// classifyStatementDML decides how to handle a DML statement on the table, the reason is returned when the DML
+// should be skipped.
+func classifyStatementDML(node ast.DMLNode, ti *model.TableInfo, safeMode bool) (statementDMLAction, string) {
+	checker := &deterministicChecker{}
+	node.Accept(checker)
+	if checker.reason != "" {
+		return statementDMLSkip, checker.reason
+	}
+
+	switch n := node.(type) {
+	case *ast.InsertStmt:
+		if n.Select != nil {
+			return statementDMLSkip, "INSERT ... SELECT"
+		}
+		rows, reason := insertRowExprs(n, ti)
+		if reason != "" {
+			return statementDMLSkip, reason
+		}
+		if len(n.OnDuplicate) > 0 && hasOnUpdateNowColumn(ti) {
+			return statementDMLSkip, "column with ON UPDATE CURRENT_TIMESTAMP"
+		}
+		if !n.IgnoreErr && len(n.OnDuplicate) == 0 && isConstantRows(rows) {
+			return statementDMLToRows, ""
+		}
+		if safeMode && !isIdempotentAssignments(n.OnDuplicate) {
+			return statementDMLSkip, "not idempotent in safe mode"
+		}
+		return statementDMLVerbatim, ""
+	case *ast.UpdateStmt:
+		if n.MultipleTable || n.TableRefs.TableRefs.Right != nil {
+			return statementDMLSkip, "multiple-table UPDATE"
+		}
+		if n.Limit != nil && n.Order == nil {
+			return statementDMLSkip, "LIMIT without ORDER BY"
+		}
+		if hasOnUpdateNowColumn(ti) {
+			return statementDMLSkip, "column with ON UPDATE CURRENT_TIMESTAMP"
+		}
+		for _, assignment := range n.List {
+			if isDefaultExpr(assignment.Expr) {
+				if reason := checkColumnDefault(model.FindColumnInfo(ti.Columns, assignment.Column.Name.L)); reason != "" {
+					return statementDMLSkip, reason
+				}
+			}
+		}
+		if safeMode && !isIdempotentAssignments(n.List) {
+			return statementDMLSkip, "not idempotent in safe mode"
+		}
+		return statementDMLVerbatim, ""
+	case *ast.DeleteStmt:
+		if n.IsMultiTable || n.TableRefs.TableRefs.Right != nil {
+			return statementDMLSkip, "multiple-table DELETE"
+		}
+		if n.Limit != nil && n.Order == nil {
+			return statementDMLSkip, "LIMIT without ORDER BY"
+		}
+		return statementDMLVerbatim, ""
+	}
+	return statementDMLSkip, "unsupported statement"
+}
+
+// insertRowExprs returns the expressions of each row of an INSERT statement, indexed by the offsets of columns.
+// the expression is nil if the column is not specified.
+func insertRowExprs(n *ast.InsertStmt, ti *model.TableInfo) ([][]ast.ExprNode, string) {
+	var (
+		columns []*model.ColumnInfo
+		lists   = n.Lists
+	)
+	switch {
+	case len(n.Setlist) > 0:
+		row := make([]ast.ExprNode, 0, len(n.Setlist))
+		for _, assignment := range n.Setlist {
+			columns = append(columns, model.FindColumnInfo(ti.Columns, assignment.Column.Name.L))
+			row = append(row, assignment.Expr)
+		}
+		lists = [][]ast.ExprNode{row}
+	case len(n.Columns) > 0:
+		for _, col := range n.Columns {
+			columns = append(columns, model.FindColumnInfo(ti.Columns, col.Name.L))
+		}
+	default:
+		columns = ti.Columns
+	}
+
+	rows := make([][]ast.ExprNode, 0, len(lists))
+	for _, list := range lists {
+		if len(list) != len(columns) {
+			return nil, "column count doesn't match value count"
+		}
+		row := make([]ast.ExprNode, len(ti.Columns))
+		for i, col := range columns {
+			if col == nil {
+				return nil, "unknown column"
+			}
+			row[col.Offset] = list[i]
+		}
+		for _, col := range ti.Columns {
+			expr := row[col.Offset]
+			if col.IsGenerated() {
+				continue
+			}
+			if mysql.HasAutoIncrementFlag(col.Flag) {
+				if v, ok := expr.(ast.ValueExpr); expr == nil || ok && v.GetValue() == nil {
+					return nil, "auto-increment value generated by upstream"
+				}
+			}
+			if isDefaultExpr(expr) {
+				if reason := checkColumnDefault(col); reason != "" {
+					return nil, reason
+				}
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, ""
+}
+
+// isDefaultExpr checks whether the column gets its default value by the expression of INSERT.
+func isDefaultExpr(expr ast.ExprNode) bool {
+	if expr == nil {
+		return true
+	}
+	d, ok := expr.(*ast.DefaultExpr)
+	return ok && d.Name == nil
+}
+
+// checkColumnDefault checks whether the default value of the column is the same in upstream and downstream.
+func checkColumnDefault(col *model.ColumnInfo) string {
+	if col == nil {
+		return "unknown column"
+	}
+	if mysql.HasAutoIncrementFlag(col.Flag) {
+		return "auto-increment value generated by upstream"
+	}
+	if col.DefaultIsExpr {
+		return fmt.Sprintf("expression default value of column %s", col.Name.O)
+	}
+	switch v := col.GetDefaultValue().(type) {
+	case nil:
+		if mysql.HasNotNullFlag(col.Flag) {
+			return fmt.Sprintf("no default value of column %s", col.Name.O)
+		}
+	case string:
+		if strings.EqualFold(v, ast.CurrentTimestamp) {
+			return fmt.Sprintf("CURRENT_TIMESTAMP default value of column %s", col.Name.O)
+		}
+	}
+	return ""
+}
+
+func hasOnUpdateNowColumn(ti *model.TableInfo) bool {
+	for _, col := range ti.Columns {
+		if mysql.HasOnUpdateNowFlag(col.Flag) {
+			return true
+		}
+	}
+	return false
+}
+
+func isConstantRows(rows [][]ast.ExprNode) bool {
+	for _, row := range rows {
+		for _, expr := range row {
+			if isDefaultExpr(expr) {
+				continue
+			}
+			if _, ok := constantValue(expr); !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isIdempotentAssignments checks whether the assignments get the same result when executed more than once, that's
+// they don't refer to any column except in VALUES().
+func isIdempotentAssignments(assignments []*ast.Assignment) bool {
+	for _, assignment := range assignments {
+		checker := &columnRefChecker{}
+		assignment.Expr.Accept(checker)
+		if checker.found {
+			return false
+		}
+	}
+	return true
+}
+
+type columnRefChecker struct {
+	found bool
+}
+
+// Enter implements ast.Visitor interface.
+func (c *columnRefChecker) Enter(in ast.Node) (ast.Node, bool) {
+	switch in.(type) {
+	case *ast.ValuesExpr:
+		return in, true
+	case *ast.ColumnNameExpr:
+		c.found = true
+	}
+	return in, c.found
+}
+
+// Leave implements ast.Visitor interface.
+func (c *columnRefChecker) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+// constantValue returns the value of a constant expression.
+func constantValue(expr ast.ExprNode) (interface{}, bool) {
+	switch e := expr.(type) {
+	case ast.ValueExpr:
+		if d, ok := e.GetValue().(*types.MyDecimal); ok {
+			return d.String(), true
+		}
+		return e.GetValue(), true
+	case *ast.ParenthesesExpr:
+		return constantValue(e.Expr)
+	case *ast.UnaryOperationExpr:
+		v, ok := constantValue(e.V)
+		if !ok {
+			return nil, false
+		}
+		switch e.Op {
+		case opcode.Plus:
+			return v, true
+		case opcode.Minus:
+			switch n := v.(type) {
+			case int64:
+				return -n, true
+			case uint64:
+				if n <= 1<<63 {
+					return -int64(n), true
+				}
+			case float64:
+				return -n, true
+			case string:
+				if strings.HasPrefix(n, "-") {
+					return n[1:], true
+				}
+				return "-" + n, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// statementValue converts the value of a constant expression to the value of the column in a rows event.
+func statementValue(col *model.ColumnInfo, v interface{}) (interface{}, error) {
+	switch col.Tp {
+	case mysql.TypeEnum:
+		if s, ok := v.(string); ok {
+			e, err := types.ParseEnumName(col.Elems, s, col.Collate)
+			if err != nil {
+				return nil, err
+			}
+			return int64(e.Value), nil
+		}
+	case mysql.TypeSet:
+		if s, ok := v.(string); ok {
+			set, err := types.ParseSetName(col.Elems, s, col.Collate)
+			if err != nil {
+				return nil, err
+			}
+			return int64(set.Value), nil
+		}
+	case mysql.TypeBit:
+		var lit types.BinaryLiteral
+		switch b := v.(type) {
+		case types.BinaryLiteral:
+			lit = b
+		case string:
+			lit = types.BinaryLiteral(b)
+		default:
+			return v, nil
+		}
+		n, err := lit.ToInt(nil)
+		if err != nil {
+			return nil, err
+		}
+		return int64(n), nil
+	}
+	if lit, ok := v.(types.BinaryLiteral); ok {
+		return []byte(lit), nil
+	}
+	return v, nil
+}
+
+// genStatementRows generates the rows of the rows event for an INSERT statement of constant values, the reason is
+// returned when some values can't be converted.
+func genStatementRows(n *ast.InsertStmt, ti *model.TableInfo) ([][]interface{}, string) {
+	exprs, reason := insertRowExprs(n, ti)
+	if reason != "" {
+		return nil, reason
+	}
+	rows := make([][]interface{}, 0, len(exprs))
+	for _, exprRow := range exprs {
+		row := make([]interface{}, len(ti.Columns))
+		for i, col := range ti.Columns {
+			if col.IsGenerated() {
+				continue
+			}
+			var v interface{}
+			if isDefaultExpr(exprRow[i]) {
+				v = col.GetDefaultValue()
+			} else {
+				v, _ = constantValue(exprRow[i])
+			}
+			if v == nil {
+				continue
+			}
+			value, err := statementValue(col, v)
+			if err != nil {
+				return nil, fmt.Sprintf("invalid value of column %s: %v", col.Name.O, err)
+			}
+			row[i] = value
+		}
+		rows = append(rows, row)
+	}
+	return rows, ""
+}
+
+// statementDMLRenamer replaces the source table with the target table in a single-table DML statement.
+type statementDMLRenamer struct {
+	defaultSchema string
+	sourceTable   *filter.Table
+	targetTable   *filter.Table
+}
+
+// Enter implements ast.Visitor interface.
+func (r *statementDMLRenamer) Enter(in ast.Node) (ast.Node, bool) {
+	switch n := in.(type) {
+	case *ast.TableName:
+		if n.Schema.O == "" {
+			n.Schema = model.NewCIStr(r.defaultSchema)
+		}
+		if n.Schema.O == r.sourceTable.Schema && n.Name.O == r.sourceTable.Name {
+			n.Schema = model.NewCIStr(r.targetTable.Schema)
+			n.Name = model.NewCIStr(r.targetTable.Name)
+		}
+	case *ast.ColumnName:
+		if n.Table.O == r.sourceTable.Name && (n.Schema.O == "" || n.Schema.O == r.sourceTable.Schema) {
+			n.Schema = model.NewCIStr(r.targetTable.Schema)
+			n.Table = model.NewCIStr(r.targetTable.Name)
+		}
+	}
+	return in, false
+}
+
+// Leave implements ast.Visitor interface.
+func (r *statementDMLRenamer) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+// routeStatementDML replaces the source table with the target table in the DML statement and restores it.
+func routeStatementDML(node ast.DMLNode, defaultSchema string, sourceTable, targetTable *filter.Table) (string, error) {
+	node.Accept(&statementDMLRenamer{defaultSchema: defaultSchema, sourceTable: sourceTable, targetTable: targetTable})
+	var sb strings.Builder
+	if err := node.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)); err != nil {
+		return "", terror.ErrRestoreASTNode.Delegate(err)
+	}
+	return sb.String(), nil
+}
+
+// handleStatementDML handles a DML found in a query event when statement-dml-fallback is enabled. INSERT and REPLACE
+// of constant values are converted to row changes, other deterministic DMLs are executed in downstream verbatim,
+// and the rest are skipped with a warning.
+func (s *Syncer) handleStatementDML(qec *queryEventContext, node ast.DMLNode) error {
+	sourceTable, err := getTableByDML(node)
+	if err != nil {
+		return s.skipStatementDML(qec, err.Error())
+	}
+	if len(sourceTable.Schema) == 0 {
+		sourceTable.Schema = qec.ddlSchema
+	}
+	targetTable := s.route(sourceTable)
+	tableInfo, err := s.getTableInfo(qec.tctx, sourceTable, targetTable)
+	if err != nil {
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+
+	action, reason := classifyStatementDML(node, tableInfo, qec.safeMode)
+	if action == statementDMLVerbatim {
+		reason, err = s.checkStatementDMLVerbatim(node, sourceTable, tableInfo)
+		if err != nil {
+			return err
+		}
+	}
+	if reason != "" {
+		return s.skipStatementDML(qec, reason)
+	}
+
+	if action == statementDMLToRows {
+		insert := node.(*ast.InsertStmt)
+		rows, reason := genStatementRows(insert, tableInfo)
+		if reason != "" {
+			return s.skipStatementDML(qec, reason)
+		}
+		header := *qec.header
+		header.EventType = replication.WRITE_ROWS_EVENTv2
+		ec := *qec.eventContext
+		ec.header = &header
+		// REPLACE deletes the rows conflicting on any unique key, which is what the INSERT in safe-mode does
+		ec.safeMode = ec.safeMode || insert.IsReplace
+		ev := &replication.RowsEvent{
+			Table: &replication.TableMapEvent{Schema: []byte(sourceTable.Schema), Table: []byte(sourceTable.Name)},
+			Rows:  rows,
+		}
+		metrics.StatementDMLTotal.WithLabelValues(action.String(), s.cfg.Name, s.cfg.SourceID).Inc()
+		qec.tctx.L().Info("convert statement-format DML to rows", zap.String("query", qec.originSQL), zap.Int("rows", len(rows)))
+		return s.handleRowsEvent(ev, ec)
+	}
+
+	if s.checkpoint.IsOlderThanTablePoint(sourceTable, *qec.currentLocation, false) {
+		qec.tctx.L().Debug("ignore obsolete event that is old than table checkpoint",
+			zap.String("event", "query"),
+			log.WrapStringerField("location", qec.currentLocation),
+			zap.Stringer("source table", sourceTable))
+		return nil
+	}
+	query, err := routeStatementDML(node, qec.ddlSchema, sourceTable, targetTable)
+	if err != nil {
+		return err
+	}
+	// the DMLs before it must be executed first
+	if err = s.flushJobs(); err != nil {
+		return err
+	}
+	qec.tctx.L().Info("execute statement-format DML verbatim", zap.String("query", qec.originSQL), zap.String("routed query", query))
+	if s.dryRun != nil {
+		if _, err = s.dryRun.writeQueries("statement-dml", *qec.startLocation, *qec.currentLocation, []string{query}); err != nil {
+			return err
+		}
+	} else if _, err = s.ddlDBConn.ExecuteSQL(qec.tctx, []string{query}); err != nil {
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+	metrics.StatementDMLTotal.WithLabelValues(action.String(), s.cfg.Name, s.cfg.SourceID).Inc()
+	s.saveTablePoint(sourceTable, *qec.currentLocation)
+	return s.checkShouldFlush()
+}
+
+// checkStatementDMLVerbatim checks whether the DML can be executed in downstream verbatim, the reason is returned if
+// it can't.
+func (s *Syncer) checkStatementDMLVerbatim(node ast.DMLNode, sourceTable *filter.Table, ti *model.TableInfo) (string, error) {
+	switch {
+	case s.cfg.To.IsPostgres():
+		return "executing verbatim in PostgreSQL", nil
+	case s.cfg.SinkURI != "":
+		return "executing verbatim with Kafka sink", nil
+	case s.xa.reading():
+		return "executing verbatim in XA transaction", nil
+	case s.columnMapping != nil:
+		return "executing verbatim with column mapping", nil
+	case s.cfg.AutoIDConflictStrategy == config.AutoIDConflictSourcePrefix:
+		return "executing verbatim with prefixed auto-increment keys", nil
+	}
+	if extendCol, _ := s.tableRouter.FetchExtendColumn(sourceTable.Schema, sourceTable.Name, s.cfg.SourceID); len(extendCol) > 0 {
+		return "executing verbatim with extended columns", nil
+	}
+
+	var hasExprFilter bool
+	switch node.(type) {
+	case *ast.InsertStmt:
+		exprs, err := s.exprFilterGroup.GetInsertExprs(sourceTable, ti)
+		if err != nil {
+			return "", err
+		}
+		hasExprFilter = len(exprs) > 0
+	case *ast.UpdateStmt:
+		oldExprs, newExprs, err := s.exprFilterGroup.GetUpdateExprs(sourceTable, ti)
+		if err != nil {
+			return "", err
+		}
+		hasExprFilter = len(oldExprs) > 0 || len(newExprs) > 0
+	case *ast.DeleteStmt:
+		exprs, err := s.exprFilterGroup.GetDeleteExprs(sourceTable, ti)
+		if err != nil {
+			return "", err
+		}
+		hasExprFilter = len(exprs) > 0
+	}
+	if hasExprFilter {
+		return "executing verbatim with expression filter", nil
+	}
+	rowFilters, err := s.exprFilterGroup.GetRowFilters(sourceTable)
+	if err != nil {
+		return "", err
+	}
+	if len(rowFilters) > 0 {
+		return "executing verbatim with row filter plugin", nil
+	}
+	return "", nil
+}
+
+// skipStatementDML skips a DML found in a query event and reports why it's skipped.
+func (s *Syncer) skipStatementDML(qec *queryEventContext, reason string) error {
+	metrics.StatementDMLTotal.WithLabelValues(statementDMLSkip.String(), s.cfg.Name, s.cfg.SourceID).Inc()
+	qec.tctx.L().Warn("skip statement-format DML",
+		zap.String("query", qec.originSQL),
+		zap.String("reason", reason),
+		log.WrapStringerField("location", qec.currentLocation))
+	return s.recordSkipSQLsLocation(qec.eventContext)
+}
@@ -0,0 +1,131 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/util/mock"
+)
+
+func (s *testSyncerSuite) TestClassifyStatementDML(c *C) {
+	p := parser.New()
+	ti, err := createTableInfo(p, mock.NewContext(), 0,
+		"create table test.tb(id int primary key, name varchar(20) not null, c int default 1, ts timestamp null default current_timestamp)")
+	c.Assert(err, IsNil)
+
+	cases := []struct {
+		sql      string
+		safeMode bool
+		action   statementDMLAction
+		reason   string
+	}{
+		{"insert into tb values (1, 'a', -2, '2022-01-01 00:00:00')", false, statementDMLToRows, ""},
+		{"replace into tb (id, name, ts) values (1, 'a', null), (2, 'b', null)", false, statementDMLToRows, ""},
+		{"insert into tb set id = 1, name = 'a', c = default, ts = null", false, statementDMLToRows, ""},
+		{"insert into tb (id, name) values (1, 'a')", false, statementDMLSkip, "CURRENT_TIMESTAMP default value of column ts"},
+		{"insert into tb (id, ts) values (1, null)", false, statementDMLSkip, "no default value of column name"},
+		{"insert into tb (id, name, ts) values (1, 'a')", false, statementDMLSkip, "column count doesn't match value count"},
+		{"insert into tb (id, name, ts) values (1, now(), null)", false, statementDMLSkip, "non-deterministic function now"},
+		{"insert into tb (id, name, ts) values (1, @a, null)", false, statementDMLSkip, "user or system variable"},
+		{"insert into tb (id, name, ts) select id, name, ts from tb2", false, statementDMLSkip, "INSERT ... SELECT"},
+		{"insert into tb (id, name, ts) values (1, concat('a', 'b'), null)", false, statementDMLVerbatim, ""},
+		{"insert ignore into tb (id, name, ts) values (1, 'a', null)", true, statementDMLVerbatim, ""},
+		{"insert into tb (id, name, ts) values (1, 'a', null) on duplicate key update c = values(c)", true, statementDMLVerbatim, ""},
+		{"insert into tb (id, name, ts) values (1, 'a', null) on duplicate key update c = c + 1", true, statementDMLSkip, "not idempotent in safe mode"},
+		{"insert into tb (id, name, ts) values (1, 'a', null) on duplicate key update c = c + 1", false, statementDMLVerbatim, ""},
+		{"update tb set c = c + 1 where id = 1", false, statementDMLVerbatim, ""},
+		{"update tb set c = c + 1 where id = 1", true, statementDMLSkip, "not idempotent in safe mode"},
+		{"update tb set c = 2 where id in (select id from tb2)", false, statementDMLSkip, "subquery"},
+		{"update tb set c = 2 limit 1", false, statementDMLSkip, "LIMIT without ORDER BY"},
+		{"update tb set ts = default where id = 1", false, statementDMLSkip, "CURRENT_TIMESTAMP default value of column ts"},
+		{"update tb, tb2 set tb.c = tb2.c where tb.id = tb2.id", false, statementDMLSkip, "multiple-table UPDATE"},
+		{"delete from tb where id = 1", true, statementDMLVerbatim, ""},
+		{"delete from tb order by id limit 1", false, statementDMLVerbatim, ""},
+		{"delete from tb where ts < now()", false, statementDMLSkip, "non-deterministic function now"},
+	}
+	for _, cs := range cases {
+		stmt, err := p.ParseOneStmt(cs.sql, "", "")
+		c.Assert(err, IsNil)
+		action, reason := classifyStatementDML(stmt.(ast.DMLNode), ti, cs.safeMode)
+		c.Assert(action, Equals, cs.action, Commentf("sql: %s", cs.sql))
+		c.Assert(reason, Equals, cs.reason, Commentf("sql: %s", cs.sql))
+	}
+
+	ti, err = createTableInfo(p, mock.NewContext(), 0,
+		"create table test.tb(id int auto_increment primary key, ts timestamp default current_timestamp on update current_timestamp)")
+	c.Assert(err, IsNil)
+	stmt, err := p.ParseOneStmt("insert into tb (ts) values ('2022-01-01 00:00:00')", "", "")
+	c.Assert(err, IsNil)
+	_, reason := classifyStatementDML(stmt.(ast.DMLNode), ti, false)
+	c.Assert(reason, Equals, "auto-increment value generated by upstream")
+	stmt, err = p.ParseOneStmt("update tb set id = 2 where id = 1", "", "")
+	c.Assert(err, IsNil)
+	_, reason = classifyStatementDML(stmt.(ast.DMLNode), ti, false)
+	c.Assert(reason, Equals, "column with ON UPDATE CURRENT_TIMESTAMP")
+}
+
+func (s *testSyncerSuite) TestGenStatementRows(c *C) {
+	p := parser.New()
+	ti, err := createTableInfo(p, mock.NewContext(), 0,
+		"create table test.tb(id int primary key, d decimal(10, 2), tp enum('a', 'b') default 'b', st set('x', 'y'), b bit(8), bin varbinary(10), g int as (id + 1))")
+	c.Assert(err, IsNil)
+
+	stmt, err := p.ParseOneStmt("insert into tb (id, d, st, b, bin) values (1, -1.5, 'x,y', b'101', x'0102'), (-2, 3, null, 7, 'a')", "", "")
+	c.Assert(err, IsNil)
+	rows, reason := genStatementRows(stmt.(*ast.InsertStmt), ti)
+	c.Assert(reason, Equals, "")
+	c.Assert(rows, DeepEquals, [][]interface{}{
+		{int64(1), "-1.5", int64(2), int64(3), int64(5), []byte{1, 2}, nil},
+		{int64(-2), int64(3), int64(2), nil, int64(7), "a", nil},
+	})
+
+	stmt, err = p.ParseOneStmt("insert into tb (id, tp) values (1, 'c')", "", "")
+	c.Assert(err, IsNil)
+	_, reason = genStatementRows(stmt.(*ast.InsertStmt), ti)
+	c.Assert(reason, Matches, "invalid value of column tp.*")
+}
+
+func (s *testSyncerSuite) TestRouteStatementDML(c *C) {
+	p := parser.New()
+	sourceTable := &filter.Table{Schema: "db1", Name: "tb1"}
+	targetTable := &filter.Table{Schema: "db", Name: "tb"}
+
+	cases := []struct {
+		sql      string
+		expected string
+	}{
+		{
+			"update tb1 set tb1.c = tb1.c + 1 where id = 1",
+			"UPDATE `db`.`tb` SET `db`.`tb`.`c`=`db`.`tb`.`c`+1 WHERE `id`=1",
+		},
+		{
+			"delete from db1.tb1 where db1.tb1.id = 1",
+			"DELETE FROM `db`.`tb` WHERE `db`.`tb`.`id`=1",
+		},
+		{
+			"insert ignore into tb1 (id) values (1)",
+			"INSERT IGNORE INTO `db`.`tb` (`id`) VALUES (1)",
+		},
+	}
+	for _, cs := range cases {
+		stmt, err := p.ParseOneStmt(cs.sql, "", "")
+		c.Assert(err, IsNil)
+		query, err := routeStatementDML(stmt.(ast.DMLNode), "db1", sourceTable, targetTable)
+		c.Assert(err, IsNil)
+		c.Assert(query, Equals, cs.expected)
+	}
+}
@@ -24,18 +24,27 @@ import (
 	"github.com/pingcap/tiflow/dm/dm/pb"
 	"github.com/pingcap/tiflow/dm/pkg/binlog"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/streamer"
 	"github.com/pingcap/tiflow/dm/syncer/metrics"
 )
 
 // Status implements Unit.Status.
 func (s *Syncer) Status(sourceStatus *binlog.SourceStatus) interface{} {
 	syncerLocation := s.checkpoint.FlushedGlobalPoint()
+	// the checkpoint keeps the relay name with UUID suffix, show the upstream binlog filename to users.
+	syncRealPos, err := streamer.ToUpstreamPosition(syncerLocation.Position)
+	if err != nil {
+		s.tctx.L().Error("fail to parse real mysql position",
+			zap.Any("position", syncerLocation.Position),
+			log.ShortError(err))
+	}
 	st := &pb.SyncStatus{
 		TotalEvents:         s.count.Load(),
 		TotalTps:            s.totalTps.Load(),
 		RecentTps:           s.tps.Load(),
-		SyncerBinlog:        syncerLocation.Position.String(),
+		SyncerBinlog:        syncRealPos.String(),
 		SecondsBehindMaster: s.secondsBehindMaster.Load(),
+		DmlType:             string(s.dmlType),
 	}
 
 	if syncerLocation.GetGTID() != nil {
@@ -50,12 +59,6 @@ func (s *Syncer) Status(sourceStatus *binlog.SourceStatus) interface{} {
 			// rely on sorted GTID set when String()
 			st.Synced = st.MasterBinlogGtid == st.SyncerBinlogGtid
 		} else {
-			syncRealPos, err := binlog.RealMySQLPos(syncerLocation.Position)
-			if err != nil {
-				s.tctx.L().Error("fail to parse real mysql position",
-					zap.Any("position", syncerLocation.Position),
-					log.ShortError(err))
-			}
 			st.Synced = syncRealPos.Compare(sourceStatus.Location.Position) == 0
 		}
 	}
@@ -70,6 +73,10 @@ func (s *Syncer) Status(sourceStatus *binlog.SourceStatus) interface{} {
 		st.UnresolvedGroups = s.sgk.UnresolvedGroups()
 	}
 
+	if s.validator != nil {
+		st.Validation = s.validator.status()
+	}
+
 	pendingShardInfo := s.pessimist.PendingInfo()
 	if pendingShardInfo != nil {
 		st.BlockingDDLs = pendingShardInfo.DDLs
@@ -88,6 +95,11 @@ func (s *Syncer) Status(sourceStatus *binlog.SourceStatus) interface{} {
 	return st
 }
 
+// TotalEvents returns the number of binlog events processed by the syncer since it's created.
+func (s *Syncer) TotalEvents() int64 {
+	return s.count.Load()
+}
+
 func (s *Syncer) printStatus(sourceStatus *binlog.SourceStatus) {
 	if sourceStatus == nil {
 		// often happened when source status is not interested, such as in an unit test
@@ -65,14 +65,42 @@ func (t *statusSuite) TestStatusRace(c *C) {
 	wg.Wait()
 }
 
+func (t *statusSuite) TestStatusUpstreamName(c *C) {
+	s := &Syncer{}
+
+	l := log.With(zap.String("unit test", "TestStatusUpstreamName"))
+	s.tctx = tcontext.Background().WithLogger(l)
+	s.cfg = &config.SubTaskConfig{}
+	s.checkpoint = &mockCheckpoint{name: "mysql-bin|000002.000123"}
+	s.pessimist = shardddl.NewPessimist(&l, nil, "", "")
+
+	sourceStatus := &binlog.SourceStatus{
+		Location: binlog.Location{
+			Position: mysql.Position{
+				Name: "mysql-bin.000123",
+				Pos:  123,
+			},
+		},
+		Binlogs: binlog.FileSizes(nil),
+	}
+	status := s.Status(sourceStatus).(*pb.SyncStatus)
+	c.Assert(status.SyncerBinlog, Equals, "(mysql-bin.000123, 123)")
+	c.Assert(status.Synced, IsTrue)
+}
+
 type mockCheckpoint struct {
 	CheckPoint
+	name string // binlog filename of the global point, default is `mysql-bin.000123`
 }
 
-func (*mockCheckpoint) FlushedGlobalPoint() binlog.Location {
+func (m *mockCheckpoint) FlushedGlobalPoint() binlog.Location {
+	name := m.name
+	if name == "" {
+		name = "mysql-bin.000123"
+	}
 	return binlog.Location{
 		Position: mysql.Position{
-			Name: "mysql-bin.000123",
+			Name: name,
 			Pos:  123,
 		},
 	}
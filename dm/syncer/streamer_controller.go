@@ -25,6 +25,7 @@ import (
 	"github.com/pingcap/failpoint"
 	"go.uber.org/zap"
 
+	"github.com/pingcap/tiflow/dm/dm/config"
 	"github.com/pingcap/tiflow/dm/pkg/binlog"
 	"github.com/pingcap/tiflow/dm/pkg/binlog/common"
 	"github.com/pingcap/tiflow/dm/pkg/binlog/reader"
@@ -77,15 +78,21 @@ func (r *remoteBinlogReader) generateStreamer(location binlog.Location) (reader.
 		r.tctx.L().Info("last slave connection", zap.Uint32("connection ID", lastSlaveConnectionID))
 	}()
 
+	var (
+		streamer *replication.BinlogStreamer
+		err      error
+	)
 	if r.EnableGTID {
-		streamer, err := r.reader.StartSyncGTID(location.GetGTID().Origin().Clone())
-		return streamer, terror.ErrSyncerUnitRemoteSteamerStartSync.Delegate(err)
+		streamer, err = r.reader.StartSyncGTID(location.GetGTID().Origin().Clone())
+	} else {
+		// position's name may contain uuid, so need remove it
+		adjustedPos := binlog.AdjustPosition(location.Position)
+		streamer, err = r.reader.StartSync(adjustedPos)
 	}
-
-	// position's name may contain uuid, so need remove it
-	adjustedPos := binlog.AdjustPosition(location.Position)
-	streamer, err := r.reader.StartSync(adjustedPos)
-	return streamer, terror.ErrSyncerUnitRemoteSteamerStartSync.Delegate(err)
+	if err != nil {
+		return nil, terror.ErrSyncerUnitRemoteSteamerStartSync.Delegate(err)
+	}
+	return reader.NewStreamer(streamer), nil
 }
 
 // StreamerController controls the streamer for read binlog, include:
@@ -107,6 +114,21 @@ type StreamerController struct {
 	localBinlogDir string
 	timezone       *time.Location
 
+	// limit the size of events read from the relay log, see relay.BinlogReaderConfig.
+	maxEventSize       int64
+	maxEventSizePolicy config.MaxEventSizePolicy
+
+	// the options of the relay log readers, see relay.BinlogReaderConfig.
+	task             string
+	sourceID         string
+	channelCapacity  int
+	prefetchEvents   int
+	recoverTornEvent bool
+	eventsPerSecond  float64
+	bytesPerSecond   float64
+	rebuildUUIDIndex bool
+	encryptKey       []byte
+
 	streamer         reader.Streamer
 	streamerProducer StreamerProducer
 
@@ -163,6 +185,51 @@ func NewStreamerController(
 	return streamerController
 }
 
+// setMaxEventSize sets the max size of events read from the relay log and the policy for the larger ones,
+// it takes effect when the streamer is reset.
+func (c *StreamerController) setMaxEventSize(size int64, policy config.MaxEventSizePolicy) {
+	c.Lock()
+	defer c.Unlock()
+	c.maxEventSize = size
+	c.maxEventSizePolicy = policy
+}
+
+// setRelayReaderOptions sets the options of the relay log readers from the subtask config,
+// it takes effect when the streamer is reset.
+func (c *StreamerController) setRelayReaderOptions(cfg *config.SubTaskConfig) {
+	c.Lock()
+	defer c.Unlock()
+	c.task = cfg.Name
+	c.sourceID = cfg.SourceID
+	c.channelCapacity = cfg.RelayReaderChannelCapacity
+	c.prefetchEvents = cfg.RelayReaderPrefetchEvents
+	c.recoverTornEvent = cfg.RelayReaderRecoverTornEvent
+	c.eventsPerSecond = cfg.RelayReaderEventsPerSecond
+	c.bytesPerSecond = cfg.RelayReaderBytesPerSecond
+	c.rebuildUUIDIndex = cfg.RelayReaderRebuildUUIDIndex
+	c.encryptKey = cfg.RelayEncryptKey
+}
+
+// relayReaderConfig returns the config of the relay log readers.
+func (c *StreamerController) relayReaderConfig() *relay.BinlogReaderConfig {
+	return &relay.BinlogReaderConfig{
+		RelayDir:           c.localBinlogDir,
+		Timezone:           c.timezone,
+		Flavor:             c.syncCfg.Flavor,
+		MaxEventSize:       c.maxEventSize,
+		MaxEventSizePolicy: c.maxEventSizePolicy,
+		Task:               c.task,
+		SourceID:           c.sourceID,
+		ChannelCapacity:    c.channelCapacity,
+		PrefetchEvents:     c.prefetchEvents,
+		RecoverTornEvent:   c.recoverTornEvent,
+		EventsPerSecond:    c.eventsPerSecond,
+		BytesPerSecond:     c.bytesPerSecond,
+		RebuildUUIDIndex:   c.rebuildUUIDIndex,
+		EncryptKey:         c.encryptKey,
+	}
+}
+
 // Start starts streamer controller.
 func (c *StreamerController) Start(tctx *tcontext.Context, location binlog.Location) error {
 	c.Lock()
@@ -234,7 +301,7 @@ func (c *StreamerController) resetReplicationSyncer(tctx *tcontext.Context, loca
 	if c.currentBinlogType == RemoteBinlog {
 		c.streamerProducer = &remoteBinlogReader{replication.NewBinlogSyncer(c.syncCfg), tctx, c.syncCfg.Flavor, c.enableGTID}
 	} else {
-		c.streamerProducer = &localBinlogReader{c.relay.NewReader(tctx.L(), &relay.BinlogReaderConfig{RelayDir: c.localBinlogDir, Timezone: c.timezone, Flavor: c.syncCfg.Flavor}), c.enableGTID}
+		c.streamerProducer = &localBinlogReader{c.relay.NewReader(tctx.L(), c.relayReaderConfig()), c.enableGTID}
 	}
 
 	c.streamer, err = c.streamerProducer.generateStreamer(location)
@@ -14,12 +14,14 @@
 package syncer
 
 import (
+	"bytes"
 	"time"
 
 	"github.com/go-mysql-org/go-mysql/replication"
 	. "github.com/pingcap/check"
 	"github.com/pingcap/errors"
 
+	"github.com/pingcap/tiflow/dm/dm/config"
 	"github.com/pingcap/tiflow/dm/relay"
 )
 
@@ -59,3 +61,29 @@ func (s *testSyncerSuite) TestCanErrorRetry(c *C) {
 	time.Sleep(100 * time.Millisecond)
 	c.Assert(controller.CanRetry(mockErr), IsTrue)
 }
+
+func (s *testSyncerSuite) TestRelayReaderConfig(c *C) {
+	controller := NewStreamerController(replication.BinlogSyncerConfig{Flavor: "mysql"}, true, nil, "/tmp/relay", nil, &relay.Relay{})
+	cfg := &config.SubTaskConfig{Name: "task1", SourceID: "source1"}
+	cfg.RelayReaderChannelCapacity = 100
+	cfg.RelayReaderPrefetchEvents = 1000
+	cfg.RelayReaderRecoverTornEvent = true
+	cfg.RelayReaderEventsPerSecond = 100
+	cfg.RelayReaderBytesPerSecond = 1024
+	cfg.RelayReaderRebuildUUIDIndex = true
+	cfg.RelayEncryptKey = bytes.Repeat([]byte{0x34}, 16)
+	controller.setRelayReaderOptions(cfg)
+
+	readerCfg := controller.relayReaderConfig()
+	c.Assert(readerCfg.RelayDir, Equals, "/tmp/relay")
+	c.Assert(readerCfg.Flavor, Equals, "mysql")
+	c.Assert(readerCfg.Task, Equals, "task1")
+	c.Assert(readerCfg.SourceID, Equals, "source1")
+	c.Assert(readerCfg.ChannelCapacity, Equals, 100)
+	c.Assert(readerCfg.PrefetchEvents, Equals, 1000)
+	c.Assert(readerCfg.RecoverTornEvent, IsTrue)
+	c.Assert(readerCfg.EventsPerSecond, Equals, float64(100))
+	c.Assert(readerCfg.BytesPerSecond, Equals, float64(1024))
+	c.Assert(readerCfg.RebuildUUIDIndex, IsTrue)
+	c.Assert(readerCfg.EncryptKey, DeepEquals, bytes.Repeat([]byte{0x34}, 16))
+}
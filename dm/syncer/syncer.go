@@ -19,7 +19,6 @@ import (
 	"crypto/tls"
 	"fmt"
 	"math"
-	"os"
 	"path"
 	"reflect"
 	"strconv"
@@ -27,6 +26,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/docker/go-units"
 	"github.com/go-mysql-org/go-mysql/mysql"
 	"github.com/go-mysql-org/go-mysql/replication"
 	"github.com/pingcap/errors"
@@ -35,7 +35,7 @@ import (
 	cm "github.com/pingcap/tidb-tools/pkg/column-mapping"
 	"github.com/pingcap/tidb-tools/pkg/dbutil"
 	"github.com/pingcap/tidb-tools/pkg/filter"
-	router "github.com/pingcap/tidb-tools/pkg/table-router"
+	"github.com/pingcap/tidb-tools/pkg/schemacmp"
 	toolutils "github.com/pingcap/tidb-tools/pkg/utils"
 	"github.com/pingcap/tidb/parser"
 	"github.com/pingcap/tidb/parser/ast"
@@ -47,7 +47,6 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/pingcap/tiflow/dm/dm/config"
-	common2 "github.com/pingcap/tiflow/dm/dm/ctl/common"
 	"github.com/pingcap/tiflow/dm/dm/pb"
 	"github.com/pingcap/tiflow/dm/dm/unit"
 	"github.com/pingcap/tiflow/dm/pkg/binlog"
@@ -57,13 +56,18 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/conn"
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
 	fr "github.com/pingcap/tiflow/dm/pkg/func-rollback"
+	"github.com/pingcap/tiflow/dm/pkg/gtid"
 	"github.com/pingcap/tiflow/dm/pkg/ha"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	parserpkg "github.com/pingcap/tiflow/dm/pkg/parser"
+	"github.com/pingcap/tiflow/dm/pkg/ratelimit"
+	"github.com/pingcap/tiflow/dm/pkg/router"
 	"github.com/pingcap/tiflow/dm/pkg/schema"
 	"github.com/pingcap/tiflow/dm/pkg/shardddl/pessimism"
+	"github.com/pingcap/tiflow/dm/pkg/storage"
 	"github.com/pingcap/tiflow/dm/pkg/streamer"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/transcode"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/dm/relay"
 	"github.com/pingcap/tiflow/dm/syncer/dbconn"
@@ -128,10 +132,11 @@ type Syncer struct {
 	cfg     *config.SubTaskConfig
 	syncCfg replication.BinlogSyncerConfig
 
-	sgk       *ShardingGroupKeeper // keeper to keep all sharding (sub) group in this syncer
-	pessimist *shardddl.Pessimist  // shard DDL pessimist
-	optimist  *shardddl.Optimist   // shard DDL optimist
-	cli       *clientv3.Client
+	sgk         *ShardingGroupKeeper       // keeper to keep all sharding (sub) group in this syncer
+	pessimist   *shardddl.Pessimist        // shard DDL pessimist
+	optimist    *shardddl.Optimist         // shard DDL optimist
+	locationCmp *binlog.LocationComparator // compare binlog locations which may be with or without GTID sets
+	cli         *clientv3.Client
 
 	binlogType         BinlogType
 	streamerController *StreamerController
@@ -149,6 +154,16 @@ type Syncer struct {
 	ddlDB               *conn.BaseDB
 	ddlDBConn           *dbconn.DBConn
 	downstreamTrackConn *dbconn.DBConn
+	// dialect generates the statements executed in the downstream database.
+	dialect sqlDialect
+	// mqSink publishes the row changes and DDLs to Kafka instead of executing them in the downstream database,
+	// it's opened for each run when `sink-uri` is set.
+	mqSink *mqSink
+	// the DML type used by the DML connections, which may fall back from the one of config.
+	dmlType config.DMLType
+	// dryRun writes the generated statements to a file instead of executing them in the downstream database, it's
+	// opened for each run when `dry-run-dir` is set.
+	dryRun *dryRunWriter
 
 	dmlJobCh            chan *job
 	ddlJobCh            chan *job
@@ -161,10 +176,34 @@ type Syncer struct {
 	tableRouter     *router.Table
 	binlogFilter    *bf.BinlogEvent
 	columnMapping   *cm.Mapping
+	autoIDMappings  map[string]*autoIDMapping
 	baList          *filter.Filter
 	exprFilterGroup *ExprFilterGroup
+	rowValueFilter  *RowValueFilter
+	ddlRewriter     *ddlRewriter
+	timeWindow      *timeWindow
+	validator       *validator
+	rateLimiter     *ratelimit.Limiter
+	memQuota        *memQuota
+	transcoder      *transcode.Transcoder
 	sessCtx         sessionctx.Context
 
+	// rules is the version of the block-allow-list, route and binlog filter rules in use, scheduledRules are the newer
+	// versions recorded in the checkpoint which are applied when the replication reaches their locations, and
+	// updatedRules is the version hot reloaded by UpdateRules which is applied at the next transaction boundary.
+	rulesMu        sync.Mutex
+	rules          *ruleSet
+	scheduledRules []*ruleSet
+	updatedRules   *ruleSet
+
+	// pausedTables are the tables paused by pause-table, pausedTableOps are the operations to pause or resume tables
+	// which are applied at the next transaction boundary, and pausedTableReplay is the replay of the events held back
+	// for the resumed tables. pausedTables and pausedTableReplay are only modified in the main replication goroutine.
+	pausedTablesMu    sync.Mutex
+	pausedTables      map[string]*pausedTable
+	pausedTableOps    []*pausedTableOp
+	pausedTableReplay *pausedTableReplay
+
 	closed atomic.Bool
 
 	start    atomic.Time
@@ -197,6 +236,8 @@ type Syncer struct {
 
 	// record process error rather than log.Fatal
 	runFatalChan chan *pb.ProcessError
+	// the context of the running Process, it's canceled when the subtask is paused or any DML worker exits with error
+	processCtx context.Context
 	// record whether error occurred when execute SQLs
 	execError atomic.Error
 
@@ -217,6 +258,7 @@ type Syncer struct {
 		startLocation *binlog.Location
 		endLocation   *binlog.Location
 		isQueryEvent  bool
+		originSQL     string
 	}
 
 	handleJobFunc func(*job) (bool, error)
@@ -231,8 +273,13 @@ type Syncer struct {
 	lastCheckpointFlushedTime time.Time
 
 	locations *locationRecorder
+	xa        *xaTransactions
+
+	checkpointFlushPolicy    checkpointFlushPolicy
+	checkpointFlushRequested atomic.Bool // set by FlushCheckpoint, and cleared after a snapshot of checkpoint is created
 
 	relay                      relay.Process
+	cliArgs                    *config.TaskCliArgs
 	charsetAndDefaultCollation map[string]string
 	idAndCollationMap          map[int]string
 }
@@ -258,7 +305,13 @@ func NewSyncer(cfg *config.SubTaskConfig, etcdClient *clientv3.Client, relay rel
 	syncer.handleJobFunc = syncer.handleJob
 	syncer.cli = etcdClient
 
+	syncer.locationCmp = newLocationComparator(cfg)
+	syncer.dialect = newSQLDialect(&cfg.To)
 	syncer.checkpoint = NewRemoteCheckPoint(syncer.tctx, cfg, syncer.checkpointID())
+	syncer.checkpointFlushPolicy = newCheckpointFlushPolicy(&cfg.SyncerConfig)
+	syncer.rateLimiter = ratelimit.NewLimiter(cfg.RowsPerSecond, cfg.BytesPerSecond)
+	syncer.memQuota = newMemQuota(cfg.MemoryQuota, cfg.Name, cfg.SourceID)
+	syncer.processCtx = context.Background()
 
 	syncer.binlogType = toBinlogType(relay)
 	syncer.errOperatorHolder = operator.NewHolder(&logger)
@@ -276,6 +329,7 @@ func NewSyncer(cfg *config.SubTaskConfig, etcdClient *clientv3.Client, relay rel
 	syncer.lastCheckpointFlushedTime = time.Time{}
 	syncer.relay = relay
 	syncer.locations = &locationRecorder{}
+	syncer.xa = newXATransactions()
 	return syncer
 }
 
@@ -345,13 +399,15 @@ func (s *Syncer) Init(ctx context.Context) (err error) {
 	}
 
 	s.streamerController = NewStreamerController(s.syncCfg, s.cfg.EnableGTID, s.fromDB, s.cfg.RelayDir, s.timezone, s.relay)
+	s.streamerController.setMaxEventSize(s.cfg.MaxEventSize, s.cfg.MaxEventSizePolicy)
+	s.streamerController.setRelayReaderOptions(s.cfg)
 
 	s.baList, err = filter.New(s.cfg.CaseSensitive, s.cfg.BAList)
 	if err != nil {
 		return terror.ErrSyncerUnitGenBAList.Delegate(err)
 	}
 
-	s.binlogFilter, err = bf.NewBinlogEvent(s.cfg.CaseSensitive, s.cfg.FilterRules)
+	s.binlogFilter, err = bf.NewBinlogEvent(s.cfg.CaseSensitive, config.BinlogFilterRules(s.cfg.FilterRules))
 	if err != nil {
 		return terror.ErrSyncerUnitGenBinlogEventFilter.Delegate(err)
 	}
@@ -361,6 +417,26 @@ func (s *Syncer) Init(ctx context.Context) (err error) {
 	}
 	s.sessCtx = utils.NewSessionCtx(vars)
 	s.exprFilterGroup = NewExprFilterGroup(s.sessCtx, s.cfg.ExprFilter)
+	s.rowValueFilter, err = NewRowValueFilter(s.sessCtx, s.cfg.CaseSensitive, s.cfg.FilterRules)
+	if err != nil {
+		return err
+	}
+	s.ddlRewriter, err = newDDLRewriter(s.cfg)
+	if err != nil {
+		return err
+	}
+	s.timeWindow, err = newTimeWindow(s.cfg, s.timezone)
+	if err != nil {
+		return err
+	}
+	s.transcoder, err = transcode.NewTranscoder(s.cfg.CaseSensitive, s.cfg.CharsetRules)
+	if err != nil {
+		return err
+	}
+	// the changes published to Kafka or written in dry-run mode can't be validated
+	if s.cfg.ValidationMode != "" && s.cfg.ValidationMode != config.ValidationNone && s.cfg.SinkURI == "" && s.cfg.DryRunDir == "" {
+		s.validator = newValidator(s.cfg, s.toDB, s.dialect, s.tctx.L())
+	}
 
 	if len(s.cfg.ColumnMappingRules) > 0 {
 		s.columnMapping, err = cm.NewMapping(s.cfg.CaseSensitive, s.cfg.ColumnMappingRules)
@@ -381,6 +457,7 @@ func (s *Syncer) Init(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	s.initRules()
 
 	var schemaMap map[string]string
 	var tableMap map[string]map[string]string
@@ -420,6 +497,7 @@ func (s *Syncer) Init(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	s.initPausedTables()
 	if s.SourceTableNamesFlavor == utils.LCTableNamesSensitive {
 		if err = s.checkpoint.CheckAndUpdate(ctx, schemaMap, tableMap); err != nil {
 			return err
@@ -431,6 +509,19 @@ func (s *Syncer) Init(ctx context.Context) (err error) {
 			}
 		}
 	}
+	if s.cli != nil {
+		s.cliArgs, err = ha.GetTaskCliArgs(s.cli, s.cfg.Name, s.cfg.SourceID)
+		if err != nil {
+			return err
+		}
+	}
+	if s.cliArgs != nil && s.cliArgs.StartTime != "" {
+		err = s.setGlobalPointByTime(tctx, s.cliArgs.StartTime)
+		if err != nil {
+			return err
+		}
+	}
+
 	s.checkpointFlushWorker = &checkpointFlushWorker{
 		input:        nil, // will be created in s.reset()
 		cp:           s.checkpoint,
@@ -522,7 +613,7 @@ func (s *Syncer) initShardingGroups(ctx context.Context, needCheck bool) error {
 		}
 	}
 
-	loadMeta, err2 := s.sgk.LoadShardMeta(s.cfg.Flavor, s.cfg.EnableGTID)
+	loadMeta, err2 := s.sgk.LoadShardMeta(s.cfg.Flavor)
 	if err2 != nil {
 		return err2
 	}
@@ -565,11 +656,12 @@ func (s *Syncer) reset() {
 	s.newJobChans()
 
 	s.execError.Store(nil)
-	s.setErrLocation(nil, nil, false)
+	s.setErrLocation(nil, nil, false, "")
 	s.isReplacingOrInjectingErr = false
 	s.waitXIDJob.Store(int64(noWait))
 	s.isTransactionEnd = true
 	s.flushSeq = 0
+	s.xa.reset()
 
 	switch s.cfg.ShardMode {
 	case config.ShardPessimistic:
@@ -642,6 +734,7 @@ func (s *Syncer) Process(ctx context.Context, pr chan pb.ProcessResult) {
 
 	runFatalChan := make(chan *pb.ProcessError, s.cfg.WorkerCount+1)
 	s.runFatalChan = runFatalChan
+	s.processCtx = newCtx
 	var (
 		errs   = make([]*pb.ProcessError, 0, 2)
 		errsMu sync.Mutex
@@ -678,6 +771,8 @@ func (s *Syncer) Process(ctx context.Context, pr chan pb.ProcessResult) {
 	}
 	s.closeJobChans()   // Run returned, all jobs sent, we can close s.jobs
 	s.wg.Wait()         // wait for sync goroutine to return
+	s.closeMQSink()     // all jobs flushed, no event will be published
+	s.closeDryRun()     // all jobs flushed, no statement will be written
 	close(runFatalChan) // Run returned, all potential fatal sent to s.runFatalChan
 	wg.Wait()           // wait for receive all fatal from s.runFatalChan
 
@@ -703,7 +798,7 @@ func (s *Syncer) Process(ctx context.Context, pr chan pb.ProcessResult) {
 	prePos := s.checkpoint.GlobalPoint()
 	s.checkpoint.Rollback(s.schemaTracker)
 	currPos := s.checkpoint.GlobalPoint()
-	if binlog.CompareLocation(prePos, currPos, s.cfg.EnableGTID) != 0 {
+	if s.locationCmp.Compare(prePos, currPos) != 0 {
 		s.tctx.L().Warn("something wrong with rollback global checkpoint", zap.Stringer("previous position", prePos), zap.Stringer("current position", currPos))
 	}
 
@@ -727,12 +822,8 @@ func (s *Syncer) getTableInfo(tctx *tcontext.Context, sourceTable, targetTable *
 	}
 
 	// if table already exists in checkpoint, create it in schema tracker
-	if ti = s.checkpoint.GetFlushedTableInfo(sourceTable); ti != nil {
-		if err = s.schemaTracker.CreateTableIfNotExists(sourceTable, ti); err != nil {
-			return nil, terror.ErrSchemaTrackerCannotCreateTable.Delegate(err, sourceTable)
-		}
-		tctx.L().Debug("lazy init table info in schema tracker", zap.Stringer("table", sourceTable))
-		return ti, nil
+	if ti, err = s.loadTableInfoFromCheckpoint(tctx, sourceTable); err != nil || ti != nil {
+		return ti, err
 	}
 
 	// if the table does not exist (IsTableNotExists(err)), continue to fetch the table from downstream and create it.
@@ -750,16 +841,65 @@ func (s *Syncer) getTableInfo(tctx *tcontext.Context, sourceTable, targetTable *
 	return ti, nil
 }
 
+// loadTableInfoFromCheckpoint creates the table in the schema tracker by the table info flushed with the checkpoint,
+// it's used when the table is used for the first time or evicted from the schema tracker. it returns nil if the table
+// info doesn't exist in the checkpoint.
+func (s *Syncer) loadTableInfoFromCheckpoint(tctx *tcontext.Context, sourceTable *filter.Table) (*model.TableInfo, error) {
+	ti := s.checkpoint.GetFlushedTableInfo(sourceTable)
+	if ti == nil {
+		return nil, nil
+	}
+	if err := s.schemaTracker.CreateTableIfNotExists(sourceTable, ti); err != nil {
+		return nil, terror.ErrSchemaTrackerCannotCreateTable.Delegate(err, sourceTable)
+	}
+	metrics.SchemaTrackerTablesTotal.WithLabelValues("load", s.cfg.Name, s.cfg.SourceID).Inc()
+	tctx.L().Debug("lazy init table info in schema tracker", zap.Stringer("table", sourceTable))
+	return ti, nil
+}
+
+// schemaTrackerMemoryUnit is the unit of schema-tracker-memory-budget, it's changed in tests.
+var schemaTrackerMemoryUnit int64 = units.MiB
+
+// evictSchemaTracker evicts the cold tables from the schema tracker when the estimated memory of the table infos
+// exceeds schema-tracker-memory-budget. only the tables whose table infos are flushed with the checkpoints can be
+// evicted, so they can be loaded by loadTableInfoFromCheckpoint again.
+func (s *Syncer) evictSchemaTracker() {
+	if s.cfg.SchemaTrackerMemoryBudget <= 0 {
+		return
+	}
+	budget := int64(s.cfg.SchemaTrackerMemoryBudget) * schemaTrackerMemoryUnit
+	evicted, err := s.schemaTracker.EvictColdTables(budget, func(table *filter.Table, ti *model.TableInfo) bool {
+		// the table info in the schema tracker is saved into the checkpoint and flushed, the pointers are the same.
+		return s.checkpoint.GetFlushedTableInfo(table) == ti
+	})
+	if err != nil {
+		s.tctx.L().Warn("fail to evict tables from schema tracker", zap.Error(err))
+	}
+	memory := s.schemaTracker.TrackedTablesMemory()
+	metrics.SchemaTrackerMemoryGauge.WithLabelValues(s.cfg.Name, s.cfg.SourceID).Set(float64(memory))
+	if evicted > 0 {
+		metrics.SchemaTrackerTablesTotal.WithLabelValues("evict", s.cfg.Name, s.cfg.SourceID).Add(float64(evicted))
+		s.tctx.L().Info("evict tables from schema tracker", zap.Int("tables", evicted), zap.Int64("memory", memory))
+	}
+}
+
 // trackTableInfoFromDownstream tries to track the table info from the downstream. It will not overwrite existing table.
 func (s *Syncer) trackTableInfoFromDownstream(tctx *tcontext.Context, sourceTable, targetTable *filter.Table) error {
 	// TODO: Switch to use the HTTP interface to retrieve the TableInfo directly if HTTP port is available
 	// use parser for downstream.
-	parser2, err := utils.GetParserForConn(tctx.Ctx, s.ddlDBConn.BaseConn.DBConn)
+	dbConn, table := s.ddlDBConn.BaseConn.DBConn, targetTable
+	if s.ddlDBConn.Cfg.To.IsPostgres() || s.ddlDBConn.Cfg.SinkURI != "" || s.ddlDBConn.Cfg.DryRunDir != "" {
+		// the table structure of PostgreSQL can't be parsed, and there is no downstream table when the changes are
+		// published to Kafka, or the downstream table isn't changed by DDLs in dry-run mode, use the current one of
+		// the upstream.
+		dbConn, table = s.fromConn.BaseConn.DBConn, sourceTable
+	}
+	parser2, err := utils.GetParserForConn(tctx.Ctx, dbConn)
 	if err != nil {
 		return terror.ErrSchemaTrackerCannotParseDownstreamTable.Delegate(err, targetTable, sourceTable)
 	}
 
-	createSQL, err := utils.GetTableCreateSQL(tctx.Ctx, s.ddlDBConn.BaseConn.DBConn, targetTable.String())
+	createSQL, err := utils.GetTableCreateSQL(tctx.Ctx, dbConn, table.String())
 	if err != nil {
 		return terror.ErrSchemaTrackerCannotFetchDownstreamTable.Delegate(err, targetTable, sourceTable)
 	}
@@ -768,9 +908,16 @@ func (s *Syncer) trackTableInfoFromDownstream(tctx *tcontext.Context, sourceTabl
 	var createNode ast.StmtNode
 	createNode, err = parser2.ParseOneStmt(createSQL, "", "")
 	if err != nil {
-		return terror.ErrSchemaTrackerCannotParseDownstreamTable.Delegate(err, targetTable, sourceTable)
+		sql, ok := parserpkg.ReplaceInvisibleColumns(parser2, createSQL)
+		if !ok {
+			return terror.ErrSchemaTrackerCannotParseDownstreamTable.Delegate(err, targetTable, sourceTable)
+		}
+		if createNode, err = parser2.ParseOneStmt(sql, "", ""); err != nil {
+			return terror.ErrSchemaTrackerCannotParseDownstreamTable.Delegate(err, targetTable, sourceTable)
+		}
 	}
 	createStmt := createNode.(*ast.CreateTableStmt)
+	parserpkg.MarkInvisibleColumns(parserpkg.UnmarkInvisibleColumns(createStmt))
 	createStmt.IfNotExists = true
 	createStmt.Table.Schema = model.NewCIStr(sourceTable.Schema)
 	createStmt.Table.Name = model.NewCIStr(sourceTable.Name)
@@ -874,6 +1021,8 @@ func (s *Syncer) updateReplicationLagMetric() {
 }
 
 func (s *Syncer) saveTablePoint(table *filter.Table, location binlog.Location) {
+	// the row events of the unresolved XA transactions should not be skipped by the table checkpoint after restarting.
+	location = s.xa.adjustLocation(s.locationCmp, location)
 	ti, err := s.schemaTracker.GetTableInfo(table)
 	if err != nil && table.Name != "" {
 		// TODO: if we RENAME tb1 TO tb2, the tracker will remove TableInfo of tb1 but we still save the table
@@ -972,9 +1121,11 @@ func (s *Syncer) addJob(job *job) {
 
 // checkShouldFlush checks whether syncer should flush now because last flushing is outdated.
 func (s *Syncer) checkShouldFlush() error {
-	if !s.checkpoint.CheckGlobalPoint() || !s.checkpoint.CheckLastSnapshotCreationTime() {
+	if !s.needFlushCheckpoint() {
 		return nil
 	}
+	// evict the cold tables along with flushing checkpoints, which is between the handling of binlog events.
+	s.evictSchemaTracker()
 
 	if s.cfg.Experimental.AsyncCheckpointFlush {
 		jobSeq := s.getFlushSeq()
@@ -1013,6 +1164,7 @@ func (s *Syncer) handleJob(job *job) (added2Queue bool, err error) {
 		s.tctx.L().Info("All jobs is completed before syncer close, the coming job will be reject", zap.Any("job", job))
 		return
 	}
+	s.checkpointFlushPolicy.observe(job)
 
 	switch job.tp {
 	case xid:
@@ -1027,6 +1179,14 @@ func (s *Syncer) handleJob(job *job) (added2Queue bool, err error) {
 
 	// 2. send the job to queue
 
+	switch job.tp {
+	case insert, update, del:
+		// the waitTransactionLock is held, so wait with the context of Process which is canceled when any DML worker
+		// exits, otherwise nobody releases the quota and the syncer hangs.
+		if err = s.memQuota.consume(s.processCtx, job); err != nil {
+			return false, err
+		}
+	}
 	s.addJob(job)
 	added2Queue = true
 
@@ -1049,6 +1209,8 @@ func (s *Syncer) handleJob(job *job) (added2Queue bool, err error) {
 		}
 
 		s.updateReplicationJobTS(job, ddlJobIdx)
+		// no DML is in flight now, so the key metadata is rebuilt atomically between the DMLs before and after the DDL.
+		s.rebuildKeyMetadata(job)
 
 		failpoint.Inject("ExitAfterDDLBeforeFlush", func() {
 			s.tctx.L().Warn("exit triggered", zap.String("failpoint", "ExitAfterDDLBeforeFlush"))
@@ -1096,12 +1258,28 @@ func (s *Syncer) handleJob(job *job) (added2Queue bool, err error) {
 	return
 }
 
+// needFlushCheckpoint returns true when the checkpoint should be flushed by the checkpoint flush policy or requested by
+// FlushCheckpoint.
+func (s *Syncer) needFlushCheckpoint() bool {
+	return s.checkpointFlushRequested.Load() || s.checkpointFlushPolicy.shouldFlush(s.checkpoint)
+}
+
+// FlushCheckpoint requests to flush the checkpoint at the next transaction boundary or heartbeat event, it can be used
+// when the checkpoint flush policy is "manual" or a fresher checkpoint is needed.
+func (s *Syncer) FlushCheckpoint() {
+	s.checkpointFlushRequested.Store(true)
+}
+
 func (s *Syncer) saveGlobalPoint(globalLocation binlog.Location) {
 	if s.cfg.ShardMode == config.ShardPessimistic {
 		// NOTE: for the optimistic mode, because we don't handle conflicts automatically (or no re-direct supported),
 		// so it is not need to adjust global checkpoint now, and after re-direct supported this should be updated.
 		globalLocation = s.sgk.AdjustGlobalLocation(globalLocation)
 	}
+	// don't advance the global checkpoint past the unresolved XA transactions, they are re-read after restarting.
+	globalLocation = s.xa.adjustLocation(s.locationCmp, globalLocation)
+	// don't advance the global checkpoint past the tables paused with buffer policy, their events are held back.
+	globalLocation = s.adjustLocationForPausedTables(globalLocation)
 	s.checkpoint.SaveGlobalPoint(globalLocation)
 }
 
@@ -1194,6 +1372,8 @@ func (s *Syncer) flushCheckPointsAsync(asyncFlushJob *job) {
 }
 
 func (s *Syncer) createCheckpointSnapshot(isSyncFlush bool) (*SnapshotInfo, []*filter.Table, []string, [][]interface{}) {
+	s.checkpointFlushRequested.Store(false)
+	s.checkpointFlushPolicy.reset()
 	snapshotInfo := s.checkpoint.Snapshot(isSyncFlush)
 	if snapshotInfo == nil {
 		return nil, nil, nil, nil
@@ -1293,6 +1473,7 @@ func (s *Syncer) syncDDL(tctx *tcontext.Context, queueBucket string, db *dbconn.
 			}
 		}
 
+		var rewrittenDDLs []string
 		failpoint.Inject("ExecDDLError", func() {
 			s.tctx.L().Warn("execute ddl error", zap.Strings("DDL", ddlJob.ddls), zap.String("failpoint", "ExecDDLError"))
 			err = terror.ErrDBUnExpect.Delegate(errors.Errorf("execute ddl %v error", ddlJob.ddls))
@@ -1300,11 +1481,24 @@ func (s *Syncer) syncDDL(tctx *tcontext.Context, queueBucket string, db *dbconn.
 		})
 
 		if !ignore {
-			var affected int
-			affected, err = db.ExecuteSQLWithIgnore(tctx, errorutil.IsIgnorableMySQLDDLError, ddlJob.ddls)
-			if err != nil {
-				err = s.handleSpecialDDLError(tctx, err, ddlJob.ddls, affected, db)
-				err = terror.WithScope(err, terror.ScopeDownstream)
+			rewrittenDDLs, err = s.rewriteDDLs(tctx, ddlJob.ddls)
+		}
+		if err == nil && !ignore && s.mqSink != nil {
+			err = s.mqSink.publishDDLs(removeSkippedDDLs(rewrittenDDLs), ddlJob.eventHeader)
+		} else if err == nil && !ignore {
+			var (
+				affected int
+				ddls     []string
+			)
+			ddls, err = s.dialect.convertDDLs(removeSkippedDDLs(rewrittenDDLs))
+			if err == nil && s.dryRun != nil {
+				_, err = s.dryRun.writeQueries(ddlJob.tp.String(), ddlJob.startLocation, ddlJob.currentLocation, ddls)
+			} else if err == nil {
+				affected, err = db.ExecuteSQLWithIgnore(tctx, errorutil.IsIgnorableMySQLDDLError, ddls)
+				if err != nil {
+					err = s.handleSpecialDDLError(tctx, err, ddls, affected, db)
+					err = terror.WithScope(err, terror.ScopeDownstream)
+				}
 			}
 		}
 		failpoint.Label("bypass")
@@ -1329,6 +1523,9 @@ func (s *Syncer) syncDDL(tctx *tcontext.Context, queueBucket string, db *dbconn.
 			s.jobWg.Done()
 			continue
 		}
+		if s.ddlRewriter != nil && !ignore {
+			s.checkpoint.SaveDDLHistory(ddlJob.startLocation, ddlJob.ddls, rewrittenDDLs)
+		}
 
 		switch s.cfg.ShardMode {
 		case config.ShardPessimistic:
@@ -1394,6 +1591,9 @@ func (s *Syncer) successFunc(queueID int, statementsCnt int, jobs []*job) {
 	for _, sqlJob := range jobs {
 		s.addCount(true, queueBucket, sqlJob.tp, 1, sqlJob.targetTable)
 	}
+	if s.validator != nil {
+		s.validator.addJobs(jobs)
+	}
 	s.updateReplicationJobTS(nil, dmlWorkerJobIdx(queueID))
 	metrics.ReplicationTransactionBatch.WithLabelValues(s.cfg.WorkerName, s.cfg.Name, s.cfg.SourceID, queueBucket, "statements").Observe(float64(statementsCnt))
 	metrics.ReplicationTransactionBatch.WithLabelValues(s.cfg.WorkerName, s.cfg.Name, s.cfg.SourceID, queueBucket, "rows").Observe(float64(len(jobs)))
@@ -1415,8 +1615,14 @@ func (s *Syncer) syncDML() {
 	if s.cfg.Compact {
 		dmlJobCh = compactorWrap(dmlJobCh, s)
 	}
-	causalityCh := causalityWrap(dmlJobCh, s)
-	flushCh := dmlWorkerWrap(causalityCh, s)
+	var flushCh chan *job
+	if s.mqSink != nil {
+		// the row changes are published in order, the causality is not needed.
+		flushCh = mqWorkerWrap(dmlJobCh, s)
+	} else {
+		causalityCh := causalityWrap(dmlJobCh, s)
+		flushCh = dmlWorkerWrap(causalityCh, s)
+	}
 
 	for range flushCh {
 		s.jobWg.Done()
@@ -1473,6 +1679,11 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 		if err != nil {
 			return err
 		}
+		if s.cfg.Mode == config.ModeAll {
+			if err = s.checkBinlogContinuity(tctx, s.checkpoint.GlobalPoint()); err != nil {
+				return err
+			}
+		}
 	}
 
 	// start flush checkpoints worker.
@@ -1491,6 +1702,10 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	// the checkpoint is replaced by the location of start-time in Init, record it as the initial checkpoint
+	if s.cliArgs != nil && s.cliArgs.StartTime != "" {
+		flushCheckpoint = true
+	}
 	if fresh && s.cfg.Mode == config.ModeAll {
 		delLoadTask = true
 		flushCheckpoint = true
@@ -1514,6 +1729,12 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 			return err
 		}
 	}
+	if s.cliArgs != nil && s.cliArgs.StartTime != "" {
+		if err = s.clearStartTime(); err != nil {
+			tctx.L().Warn("fail to clear start-time in etcd", zap.Error(err))
+			return err
+		}
+	}
 	if delLoadTask {
 		if err = s.delLoadTask(); err != nil {
 			tctx.L().Warn("error when del load task in etcd", zap.Error(err))
@@ -1521,7 +1742,7 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 	}
 	if cleanDumpFile {
 		tctx.L().Info("try to remove all dump files")
-		if err = os.RemoveAll(s.cfg.Dir); err != nil {
+		if err = storage.RemoveAll(ctx, s.cfg.Dir); err != nil {
 			tctx.L().Warn("error when remove loaded dump folder", zap.String("data folder", s.cfg.Dir), zap.Error(err))
 		}
 	}
@@ -1578,6 +1799,13 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 		currentGTID string
 	)
 	tctx.L().Info("replicate binlog from checkpoint", zap.Stringer("checkpoint", lastLocation))
+	if err = s.resetRules(lastLocation); err != nil {
+		return err
+	}
+	// the replay of the resumed tables is interrupted, their events are re-read from the checkpoint.
+	s.pausedTablesMu.Lock()
+	s.pausedTableReplay = nil
+	s.pausedTablesMu.Unlock()
 
 	if s.streamerController.IsClosed() {
 		s.locations.reset(lastLocation)
@@ -1587,6 +1815,18 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 		}
 	}
 
+	if s.cfg.SinkURI != "" {
+		if err = s.openMQSink(runCtx); err != nil {
+			return err
+		}
+	}
+	if s.cfg.DryRunDir != "" {
+		if s.dryRun, err = newDryRunWriter(s.cfg); err != nil {
+			return err
+		}
+		tctx.L().Info("run in dry-run mode, the statements are written to file", zap.String("path", s.dryRun.path))
+	}
+
 	s.wg.Add(1)
 	go s.syncDML()
 
@@ -1608,6 +1848,14 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 		}
 	}()
 
+	if s.validator != nil {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.validator.run(tctx)
+		}()
+	}
+
 	// syncing progress with sharding DDL group
 	// 1. use the global streamer to sync regular binlog events
 	// 2. sharding DDL synced for some sharding groups
@@ -1639,7 +1887,7 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 			err2            error
 			exitSafeModeLoc binlog.Location
 		)
-		if binlog.CompareLocation(currentLocation, savedGlobalLastLocation, s.cfg.EnableGTID) > 0 {
+		if s.locationCmp.Compare(currentLocation, savedGlobalLastLocation) > 0 {
 			exitSafeModeLoc = currentLocation.Clone()
 		} else {
 			exitSafeModeLoc = savedGlobalLastLocation.Clone()
@@ -1664,6 +1912,12 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 	s.lastTime.Store(now)
 
 	tryReSync := true
+	// whether the events are in a transaction, the replication stops at the first transaction or DDL committed after
+	// stop-time
+	inTransaction := false
+	// whether the GTID of the current transaction is in the GTID skip list, the rows events and DDLs of the transaction
+	// are skipped, and the XID event is still handled to move the checkpoint forward
+	skipTxn := false
 
 	// safeMode makes syncer reentrant.
 	// we make each operator reentrant to make syncer reentrant.
@@ -1751,6 +2005,30 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 		s.currentLocationMu.currentLocation = currentLocation
 		s.currentLocationMu.Unlock()
 
+		// apply the hot reloaded rules at the transaction boundary
+		if shardingReSync == nil && !s.isReplacingOrInjectingErr && s.isTransactionEnd && s.locations.isTxnEnd() && s.hasPendingRules() {
+			if err = s.applyPendingRules(tctx, currentLocation); err != nil {
+				return err
+			}
+		}
+
+		// apply the operations of the paused tables at the transaction boundary, redirect the stream to replay the
+		// events held back for the resumed tables if needed
+		if shardingReSync == nil && !s.isReplacingOrInjectingErr && s.isTransactionEnd && s.locations.isTxnEnd() && s.hasPendingPausedTableOps() {
+			var replayFrom *binlog.Location
+			if replayFrom, err = s.applyPausedTableOps(tctx, currentLocation); err != nil {
+				return err
+			}
+			if replayFrom != nil {
+				currentLocation = *replayFrom
+				lastLocation = *replayFrom
+				s.locations.reset(currentLocation)
+				if err = s.streamerController.RedirectStreamer(tctx, currentLocation); err != nil {
+					return err
+				}
+			}
+		}
+
 		// fetch from sharding resync channel if needed, and redirect global
 		// stream to current binlog position recorded by ShardingReSync
 		if shardingReSync == nil && len(shardingReSyncCh) > 0 {
@@ -1852,6 +2130,9 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 				continue
 			}
 
+			if s.cfg.EnableGTID && utils.IsErrBinlogPurged(err) {
+				s.logPurgedTransactions(tctx, lastLocation)
+			}
 			return terror.ErrSyncerGetEvent.Generate(err)
 		}
 
@@ -1925,7 +2206,6 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 						// revert currentLocation to startLocation
 						currentLocation = startLocation
 					} else if op == pb.ErrorOp_Skip {
-						queryEvent := ev.(*replication.QueryEvent)
 						ec := eventContext{
 							tctx:            tctx,
 							header:          e.Header,
@@ -1933,27 +2213,7 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 							currentLocation: &currentLocation,
 							lastLocation:    &lastLocation,
 						}
-						var sourceTbls map[string]map[string]struct{}
-						sourceTbls, err = s.trackOriginDDL(queryEvent, ec)
-						if err != nil {
-							tctx.L().Warn("failed to track query when handle-error skip", zap.Error(err), zap.ByteString("sql", queryEvent.Query))
-						}
-
-						s.saveGlobalPoint(currentLocation)
-						for sourceSchema, tableMap := range sourceTbls {
-							if sourceSchema == "" {
-								continue
-							}
-							for sourceTable := range tableMap {
-								s.saveTablePoint(&filter.Table{Schema: sourceSchema, Name: sourceTable}, currentLocation)
-							}
-						}
-						err = s.flushJobs()
-						if err != nil {
-							tctx.L().Warn("failed to flush jobs when handle-error skip", zap.Error(err))
-						} else {
-							tctx.L().Info("flush jobs when handle-error skip")
-						}
+						s.skipQueryEventWithoutExec(ev.(*replication.QueryEvent), ec, "handle-error skip")
 					}
 					// skip the current event
 					continue
@@ -1978,7 +2238,7 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 		if safeModeExitLoc != nil && !s.isReplacingOrInjectingErr && shardingReSync == nil {
 			// TODO: for RowsEvent (in fact other than QueryEvent), `currentLocation` is updated in `handleRowsEvent`
 			// so here the meaning of `currentLocation` is the location of last event
-			if binlog.CompareLocation(currentLocation, *safeModeExitLoc, s.cfg.EnableGTID) > 0 {
+			if s.locationCmp.Compare(currentLocation, *safeModeExitLoc) > 0 {
 				s.checkpoint.SaveSafeModeExitPoint(nil)
 				// must flush here to avoid the following situation:
 				// 1. quit safe mode
@@ -2018,13 +2278,43 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 		case *replication.RowsEvent:
 			eventIndex++
 			metrics.BinlogEventRowHistogram.WithLabelValues(s.cfg.WorkerName, s.cfg.Name, s.cfg.SourceID).Observe(float64(len(ev.Rows)))
+			if skipTxn {
+				tctx.L().Debug("skip rows event in GTID skip list", zap.String("GTID", currentGTID), zap.Stringer("location", currentLocation))
+				break
+			}
 			err2 = s.handleRowsEvent(ev, ec)
 		case *replication.QueryEvent:
 			originSQL = strings.TrimSpace(string(ev.Query))
-			err2 = s.handleQueryEvent(ev, ec, originSQL)
+			op, xid := parseXAStatement(originSQL)
+			if !inTransaction && s.timeWindow.afterStop(e.Header.Timestamp) {
+				tctx.L().Info("stop replication at the event committed after stop-time",
+					zap.String("stop-time", s.cfg.StopTime),
+					zap.Stringer("location", startLocation),
+					zap.String("query", originSQL))
+				return nil
+			}
+			switch {
+			case originSQL == "BEGIN" || op == xaStart:
+				inTransaction = true
+			case originSQL == "COMMIT" || op == xaEnd:
+				inTransaction = false
+			}
+			switch {
+			case op != xaNone:
+				if op == xaCommit || op == xaRollback {
+					eventIndex = 0
+				}
+				err2 = s.handleXAStatement(op, xid, ec)
+			case skipTxn && originSQL != "BEGIN" && originSQL != "COMMIT":
+				tctx.L().Info("skip query event in GTID skip list", zap.String("GTID", currentGTID), zap.String("query", originSQL), zap.Stringer("location", startLocation))
+				s.skipQueryEventWithoutExec(ev, ec, "GTID skip list")
+			default:
+				err2 = s.handleQueryEvent(ev, ec, originSQL)
+			}
 		case *replication.XIDEvent:
 			// reset eventIndex and force safeMode flag here.
 			eventIndex = 0
+			inTransaction = false
 			if shardingReSync != nil {
 				shardingReSync.currLocation.Position.Pos = e.Header.LogPos
 				shardingReSync.currLocation.Suffix = currentLocation.Suffix
@@ -2035,7 +2325,7 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 
 				// only need compare binlog position?
 				lastLocation = shardingReSync.currLocation
-				if binlog.CompareLocation(shardingReSync.currLocation, shardingReSync.latestLocation, s.cfg.EnableGTID) >= 0 {
+				if s.locationCmp.Compare(shardingReSync.currLocation, shardingReSync.latestLocation) >= 0 {
 					tctx.L().Info("re-replicate shard group was completed", zap.String("event", "XID"), zap.Stringer("re-shard", shardingReSync))
 					err = closeShardingResync()
 					if err != nil {
@@ -2061,18 +2351,22 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 			job := newXIDJob(currentLocation, startLocation, currentLocation)
 			_, err2 = s.handleJobFunc(job)
 		case *replication.GenericEvent:
-			if e.Header.EventType == replication.HEARTBEAT_EVENT {
-				// flush checkpoint even if there are no real binlog events
-				if s.checkpoint.CheckGlobalPoint() {
-					tctx.L().Info("meet heartbeat event and then flush jobs")
-					err2 = s.flushJobs()
-				}
+			switch e.Header.EventType {
+			case replication.HEARTBEAT_EVENT:
+				err2 = s.handleHeartbeatEvent(e, ec)
+			case replication.XA_PREPARE_LOG_EVENT:
+				eventIndex = 0
+				err2 = s.handleXAPrepareEvent(e, ec)
 			}
 		case *replication.GTIDEvent, *replication.MariadbGTIDEvent:
 			currentGTID, err2 = event.GetGTIDStr(e)
 			if err2 != nil {
 				return err2
 			}
+			skipTxn = s.isGTIDSkipped(currentGTID)
+			if skipTxn {
+				tctx.L().Info("skip transaction in GTID skip list", zap.String("GTID", currentGTID), zap.Stringer("location", currentLocation))
+			}
 		}
 		if err2 != nil {
 			if err := s.handleEventError(err2, startLocation, currentLocation, e.Header.EventType == replication.QUERY_EVENT, originSQL); err != nil {
@@ -2085,6 +2379,31 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 	}
 }
 
+// skipQueryEventWithoutExec skips the query event without executing it in the downstream, the DDL is still tracked by the schema
+// tracker to keep the table structures same as the upstream, and the checkpoints are saved and flushed.
+func (s *Syncer) skipQueryEventWithoutExec(ev *replication.QueryEvent, ec eventContext, reason string) {
+	sourceTbls, err := s.trackOriginDDL(ev, ec)
+	if err != nil {
+		ec.tctx.L().Warn("failed to track query of skipped event", zap.String("reason", reason), zap.Error(err), zap.ByteString("sql", ev.Query))
+	}
+
+	s.saveGlobalPoint(*ec.currentLocation)
+	for sourceSchema, tableMap := range sourceTbls {
+		if sourceSchema == "" {
+			continue
+		}
+		for sourceTable := range tableMap {
+			s.saveTablePoint(&filter.Table{Schema: sourceSchema, Name: sourceTable}, *ec.currentLocation)
+		}
+	}
+	err = s.flushJobs()
+	if err != nil {
+		ec.tctx.L().Warn("failed to flush jobs of skipped event", zap.String("reason", reason), zap.Error(err))
+	} else {
+		ec.tctx.L().Info("flush jobs of skipped event", zap.String("reason", reason))
+	}
+}
+
 type eventContext struct {
 	tctx                *tcontext.Context
 	header              *replication.EventHeader
@@ -2103,6 +2422,29 @@ type eventContext struct {
 }
 
 // TODO: Further split into smaller functions and group common arguments into a context struct.
+// handleHeartbeatEvent advances the locations to the position carried by the heartbeat event, which is the end of the
+// latest event sent by upstream or relay, so the global checkpoint can still move forward when the latest events are
+// all skipped. the locations are only advanced when no transaction is in progress.
+func (s *Syncer) handleHeartbeatEvent(e *replication.BinlogEvent, ec eventContext) error {
+	pos, ok := event.PositionFromHeartbeatEvent(e)
+	if ok && ec.shardingReSync == nil && !s.isReplacingOrInjectingErr && s.isTransactionEnd && s.locations.isTxnEnd() &&
+		pos.Name == ec.currentLocation.Position.Name && pos.Pos > ec.currentLocation.Position.Pos {
+		ec.tctx.L().Debug("advance location by heartbeat event", zap.Stringer("location", ec.currentLocation), zap.Stringer("position", pos))
+		ec.currentLocation.Position.Pos = pos.Pos
+		if ec.lastLocation.Position.Name == pos.Name && ec.lastLocation.Position.Pos < pos.Pos {
+			ec.lastLocation.Position.Pos = pos.Pos
+		}
+		s.saveGlobalPoint(*ec.currentLocation)
+	}
+
+	// flush checkpoint even if there are no real binlog events
+	if s.needFlushCheckpoint() {
+		ec.tctx.L().Info("meet heartbeat event and then flush jobs")
+		return s.flushJobs()
+	}
+	return nil
+}
+
 func (s *Syncer) handleRotateEvent(ev *replication.RotateEvent, ec eventContext) error {
 	failpoint.Inject("MakeFakeRotateEvent", func(val failpoint.Value) {
 		ec.header.LogPos = 0
@@ -2144,16 +2486,16 @@ func (s *Syncer) handleRotateEvent(ev *replication.RotateEvent, ec eventContext)
 		ec.currentLocation.GetGTID(),
 	)
 
-	if binlog.CompareLocation(*ec.currentLocation, *ec.lastLocation, s.cfg.EnableGTID) >= 0 {
+	if s.locationCmp.Compare(*ec.currentLocation, *ec.lastLocation) >= 0 {
 		*ec.lastLocation = *ec.currentLocation
 	}
 
 	if ec.shardingReSync != nil {
-		if binlog.CompareLocation(*ec.currentLocation, ec.shardingReSync.currLocation, s.cfg.EnableGTID) > 0 {
+		if s.locationCmp.Compare(*ec.currentLocation, ec.shardingReSync.currLocation) > 0 {
 			ec.shardingReSync.currLocation = *ec.currentLocation
 		}
 
-		if binlog.CompareLocation(ec.shardingReSync.currLocation, ec.shardingReSync.latestLocation, s.cfg.EnableGTID) >= 0 {
+		if s.locationCmp.Compare(ec.shardingReSync.currLocation, ec.shardingReSync.latestLocation) >= 0 {
 			ec.tctx.L().Info("re-replicate shard group was completed", zap.String("event", "rotate"), zap.Stringer("re-shard", ec.shardingReSync))
 			err := ec.closeShardingResync()
 			if err != nil {
@@ -2192,7 +2534,7 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) err
 
 	if ec.shardingReSync != nil {
 		ec.shardingReSync.currLocation = *ec.currentLocation
-		if binlog.CompareLocation(ec.shardingReSync.currLocation, ec.shardingReSync.latestLocation, s.cfg.EnableGTID) >= 0 {
+		if s.locationCmp.Compare(ec.shardingReSync.currLocation, ec.shardingReSync.latestLocation) >= 0 {
 			ec.tctx.L().Info("re-replicate shard group was completed", zap.String("event", "row"), zap.Stringer("re-shard", ec.shardingReSync))
 			return ec.closeShardingResync()
 		}
@@ -2212,6 +2554,13 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) err
 			zap.Stringer("source table", sourceTable))
 		return nil
 	}
+	if s.skipByPausedTables(sourceTable, *ec.currentLocation, false) {
+		ec.tctx.L().Debug("skip event of paused table",
+			zap.String("event", "row"),
+			log.WrapStringerField("location", ec.currentLocation),
+			zap.Stringer("source table", sourceTable))
+		return s.recordSkipSQLsLocation(&ec)
+	}
 
 	ec.tctx.L().Debug("",
 		zap.String("event", "row"),
@@ -2224,7 +2573,7 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) err
 	if err != nil {
 		return err
 	}
-	if needSkip {
+	if needSkip || s.timeWindow.beforeStart(ec.header.Timestamp) {
 		metrics.SkipBinlogDurationHistogram.WithLabelValues("rows", s.cfg.Name, s.cfg.SourceID).Observe(time.Since(ec.startTime).Seconds())
 		// for RowsEvent, we should record lastLocation rather than currentLocation
 		return s.recordSkipSQLsLocation(&ec)
@@ -2251,6 +2600,11 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) err
 	if err != nil {
 		return err
 	}
+	if rule := s.transcoder.Match(sourceTable.Schema, sourceTable.Name); rule != nil {
+		if err = transcode.DecodeRows(rule, tableInfo, originRows); err != nil {
+			return terror.ErrSyncerTranscodeRow.Delegate(err, sourceTable, rule.FromCharset)
+		}
+	}
 	if err2 := checkLogColumns(ev.SkippedColumns); err2 != nil {
 		return err2
 	}
@@ -2261,7 +2615,15 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) err
 		rows = extRows
 	}
 
-	prunedColumns, prunedRows, err := pruneGeneratedColumnDML(tableInfo, rows)
+	skippedColumns, err := s.skippedColumns(ec.tctx, tableInfo, utils.GenTableID(targetTable))
+	if err != nil {
+		return err
+	}
+	prunedColumns, prunedRows, err := pruneColumnDML(tableInfo, rows, skippedColumns)
+	if err != nil {
+		return err
+	}
+	rowFilters, err := s.exprFilterGroup.GetRowFilters(sourceTable)
 	if err != nil {
 		return err
 	}
@@ -2279,6 +2641,7 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) err
 		sourceTableInfo: tableInfo,
 		sourceTable:     sourceTable,
 		extendData:      extRows,
+		rowFilters:      rowFilters,
 	}
 
 	switch ec.header.EventType {
@@ -2287,6 +2650,10 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) err
 		if err2 != nil {
 			return err2
 		}
+		param.rowValueRules, err2 = s.rowValueFilter.getRules(sourceTable, tableInfo, bf.InsertEvent)
+		if err2 != nil {
+			return err2
+		}
 
 		param.safeMode = ec.safeMode
 		dmls, err = s.genAndFilterInsertDMLs(ec.tctx, param, exprFilter)
@@ -2301,6 +2668,10 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) err
 		if err2 != nil {
 			return err2
 		}
+		param.rowValueRules, err2 = s.rowValueFilter.getRules(sourceTable, tableInfo, bf.UpdateEvent)
+		if err2 != nil {
+			return err2
+		}
 
 		param.safeMode = ec.safeMode
 		dmls, err = s.genAndFilterUpdateDMLs(ec.tctx, param, oldExprFilter, newExprFilter)
@@ -2315,6 +2686,10 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) err
 		if err2 != nil {
 			return err2
 		}
+		param.rowValueRules, err2 = s.rowValueFilter.getRules(sourceTable, tableInfo, bf.DeleteEvent)
+		if err2 != nil {
+			return err2
+		}
 
 		dmls, err = s.genAndFilterDeleteDMLs(ec.tctx, param, exprFilter)
 		if err != nil {
@@ -2328,9 +2703,18 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) err
 		return nil
 	}
 
+	if err = s.rateLimiter.WaitN(ec.tctx.Ctx, len(dmls), int(ec.header.EventSize)); err != nil {
+		return err
+	}
+
 	startTime := time.Now()
+	inXA := s.xa.reading()
 	for i := range dmls {
 		job := newDMLJob(jobType, sourceTable, targetTable, dmls[i], &ec)
+		if inXA {
+			s.xa.add(job)
+			continue
+		}
 		added2Queue, err2 := s.handleJobFunc(job)
 		if err2 != nil || !added2Queue {
 			return err2
@@ -2338,7 +2722,8 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) err
 	}
 	metrics.DispatchBinlogDurationHistogram.WithLabelValues(jobType.String(), s.cfg.Name, s.cfg.SourceID).Observe(time.Since(startTime).Seconds())
 
-	if len(sourceTable.Schema) != 0 {
+	// the jobs of a XA transaction are not executed until it's committed, so don't save table checkpoint for them.
+	if len(sourceTable.Schema) != 0 && !inXA {
 		// when in position-based replication, now events before table checkpoint is sent to queue. But in GTID-based
 		// replication events may share one GTID, so event whose end position is equal to table checkpoint may not be
 		// sent to queue. We may need event index in GTID to resolve it.
@@ -2377,6 +2762,9 @@ type queryEventContext struct {
 	sourceTbls      map[string]map[string]struct{} // db name -> tb name
 	onlineDDLTable  *filter.Table
 	eventStatusVars []byte // binlog StatusVars
+	// target tables whose primary keys are changed by the DDLs, the metadata to extract the keys of DMLs are rebuilt
+	// after the DDLs are applied
+	keyFencedTables []*filter.Table
 }
 
 func (qec *queryEventContext) String() string {
@@ -2488,6 +2876,9 @@ func (s *Syncer) handleQueryEvent(ev *replication.QueryEvent, ec eventContext, o
 	}
 
 	if node, ok := stmt.(ast.DMLNode); ok {
+		if s.timeWindow.beforeStart(ec.header.Timestamp) {
+			return nil
+		}
 		// if DML can be ignored, we do not report an error
 		table, err2 := getTableByDML(node)
 		if err2 == nil {
@@ -2499,6 +2890,9 @@ func (s *Syncer) handleQueryEvent(ev *replication.QueryEvent, ec eventContext, o
 				return nil
 			}
 		}
+		if s.cfg.StatementDMLFallback {
+			return s.handleStatementDML(qec, node)
+		}
 		return terror.Annotatef(terror.ErrSyncUnitDMLStatementFound.Generate(), "query %s", qec.originSQL)
 	}
 
@@ -2508,7 +2902,7 @@ func (s *Syncer) handleQueryEvent(ev *replication.QueryEvent, ec eventContext, o
 
 	if qec.shardingReSync != nil {
 		qec.shardingReSync.currLocation = *qec.currentLocation
-		if binlog.CompareLocation(qec.shardingReSync.currLocation, qec.shardingReSync.latestLocation, s.cfg.EnableGTID) >= 0 {
+		if s.locationCmp.Compare(qec.shardingReSync.currLocation, qec.shardingReSync.latestLocation) >= 0 {
 			qec.tctx.L().Info("re-replicate shard group was completed", zap.String("event", "query"), zap.Stringer("queryEventContext", qec))
 			err2 := qec.closeShardingResync()
 			if err2 != nil {
@@ -2589,6 +2983,10 @@ func (s *Syncer) handleQueryEvent(ev *replication.QueryEvent, ec eventContext, o
 			qec.tctx.L().Info("filter obsolete DDL", zap.String("event", "query"), zap.String("statement", sql), log.WrapStringerField("location", qec.currentLocation))
 			continue
 		}
+		if s.skipDDLByPausedTables(ddlInfo.sourceTables, *qec.currentLocation) {
+			qec.tctx.L().Info("skip DDL of paused table", zap.String("event", "query"), zap.String("statement", sql), log.WrapStringerField("location", qec.currentLocation))
+			continue
+		}
 
 		// pre-filter of sharding
 		if s.cfg.ShardMode == config.ShardPessimistic {
@@ -2601,6 +2999,18 @@ func (s *Syncer) handleQueryEvent(ev *replication.QueryEvent, ec eventContext, o
 				continue
 			case *ast.DropTableStmt:
 				sourceTableID := utils.GenTableID(sourceTable)
+				if group := s.sgk.Group(targetTable); group != nil && group.WaitingOnlyFor([]string{sourceTableID}) {
+					// the active sharding DDL is only waiting for the dropped table, so the table syncs the DDL before
+					// leaving the group, otherwise no DDL will trigger to resolve the group anymore.
+					qec.tctx.L().Info("sync active DDL for dropped table in shard group", zap.String("event", "query"),
+						zap.String("statement", sql), zap.String("sourceTableID", sourceTableID), zap.Strings("ddls", group.ActiveDDLs()))
+					if qec.shardingDDLInfo != nil {
+						return terror.ErrSyncerUnitDDLOnMultipleTable.Generate(qec.originSQL)
+					}
+					qec.shardingDDLInfo = ddlInfo
+					qec.needHandleDDLs = append(qec.needHandleDDLs, group.ActiveDDLs()...)
+					continue
+				}
 				err = s.sgk.LeaveGroup(targetTable, []string{sourceTableID})
 				if err != nil {
 					return err
@@ -2631,6 +3041,13 @@ func (s *Syncer) handleQueryEvent(ev *replication.QueryEvent, ec eventContext, o
 			}
 		}
 
+		// the table info before the DDL, it's nil if the table isn't tracked yet
+		ti, _ := s.schemaTracker.GetTableInfo(sourceTable)
+		if changesPrimaryKey(ddlInfo.originStmt, ti) {
+			qec.tctx.L().Info("primary key is changed by DDL", zap.String("event", "query"), zap.String("statement", sql),
+				zap.Stringer("table", sourceTable))
+			qec.keyFencedTables = append(qec.keyFencedTables, ddlInfo.targetTables...)
+		}
 		qec.needHandleDDLs = append(qec.needHandleDDLs, ddlInfo.routedDDL)
 		qec.trackInfos = append(qec.trackInfos, ddlInfo)
 		// TODO: current table checkpoints will be deleted in track ddls, but created and updated in flush checkpoints,
@@ -2654,6 +3071,11 @@ func (s *Syncer) handleQueryEvent(ev *replication.QueryEvent, ec eventContext, o
 		}
 	})
 
+	// the DDLs are applied after they're approved by `binlog approve`.
+	if s.cfg.DDLApproval && !s.errOperatorHolder.IsApproved(*qec.startLocation) {
+		return terror.ErrSyncerDDLNeedApproval.Generate(qec.needHandleDDLs, qec.startLocation.Position)
+	}
+
 	// flush previous DMLs and checkpoint if needing to handle the DDL.
 	// NOTE: do this flush before operations on shard groups which may lead to skip a table caused by `UnresolvedTables`.
 	if err = s.flushJobs(); err != nil {
@@ -2800,6 +3222,12 @@ func (s *Syncer) handleQueryEventPessimistic(qec *queryEventContext) error {
 		}
 	}
 
+	if _, ok := ddlInfo.originStmt.(*ast.CreateTableStmt); ok && group != nil {
+		if err = s.reconcileShardTable(qec.tctx, group, ddlInfo.sourceTables[0], ddlInfo.targetTables[0]); err != nil {
+			return err
+		}
+	}
+
 	if needShardingHandle {
 		metrics.UnsyncedTableGauge.WithLabelValues(s.cfg.Name, ddlInfo.targetTables[0].String(), s.cfg.SourceID).Set(float64(remain))
 		err = s.safeMode.IncrForTable(qec.tctx, ddlInfo.targetTables[0]) // try enable safe-mode when starting syncing for sharding group
@@ -2939,10 +3367,48 @@ func (s *Syncer) handleQueryEventPessimistic(qec *queryEventContext) error {
 		}
 	}
 
+	if _, ok := ddlInfo.originStmt.(*ast.DropTableStmt); ok {
+		// the dropped table has synced the active sharding DDL, retire it from the shard group now.
+		if err = s.sgk.LeaveGroup(ddlInfo.targetTables[0], []string{sourceTableID}); err != nil {
+			return err
+		}
+		if err = s.checkpoint.DeleteTablePoint(qec.tctx, ddlInfo.sourceTables[0]); err != nil {
+			return err
+		}
+	}
+
 	qec.tctx.L().Info("finish to handle ddls in shard mode", zap.String("event", "query"), zap.Stringer("queryEventContext", qec))
 	return nil
 }
 
+// reconcileShardTable reconciles the table created in sequence sharding with its shard group. if the table is created
+// with the same schema as the tables which have synced the active sharding DDL, it adopts the DDL without executing
+// it, otherwise the table is expected to execute the DDL by itself later.
+func (s *Syncer) reconcileShardTable(tctx *tcontext.Context, group *ShardingGroup, sourceTable, targetTable *filter.Table) error {
+	sourceTableID := utils.GenTableID(sourceTable)
+	syncedTableID, ok := group.SyncedSourceOfActiveDDL(sourceTableID)
+	if !ok {
+		return nil
+	}
+	ti, err := s.getTableInfo(tctx, sourceTable, targetTable)
+	if err != nil {
+		return err
+	}
+	syncedTI, err := s.getTableInfo(tctx, utils.UnpackTableID(syncedTableID), targetTable)
+	if err != nil {
+		return err
+	}
+	if cmp, err2 := schemacmp.Encode(ti).Compare(schemacmp.Encode(syncedTI)); err2 != nil || cmp != 0 {
+		tctx.L().Info("table created in sequence sharding needs to execute the active DDL", zap.String("sourceTableID", sourceTableID),
+			zap.String("syncedTableID", syncedTableID), zap.Strings("ddls", group.ActiveDDLs()))
+		return nil
+	}
+	synced, remain := group.AdoptActiveDDL(sourceTableID)
+	tctx.L().Info("table created in sequence sharding adopts the active DDL", zap.String("sourceTableID", sourceTableID),
+		zap.String("syncedTableID", syncedTableID), zap.Strings("ddls", group.ActiveDDLs()), zap.Bool("is-synced", synced), zap.Int("unsynced", remain))
+	return nil
+}
+
 // trackDDL tracks ddl in schemaTracker.
 func (s *Syncer) trackDDL(usedSchema string, trackInfo *ddlInfo, ec *eventContext) error {
 	var (
@@ -3068,6 +3534,7 @@ func (s *Syncer) trackDDL(usedSchema string, trackInfo *ddlInfo, ec *eventContex
 			return terror.ErrSchemaTrackerCannotExecDDL.Delegate(err, trackInfo.originDDL)
 		}
 		s.exprFilterGroup.ResetExprs(srcTable)
+		s.rowValueFilter.ResetExprs(srcTable)
 	}
 
 	return nil
@@ -3140,6 +3607,7 @@ func (s *Syncer) trackOriginDDL(ev *replication.QueryEvent, ec eventContext) (ma
 
 func (s *Syncer) genRouter() error {
 	s.tableRouter, _ = router.NewTableRouter(s.cfg.CaseSensitive, []*router.TableRule{})
+	s.tableRouter.SetSourceTagColumn(s.cfg.SourceTagColumn)
 	for _, rule := range s.cfg.RouteRules {
 		err := s.tableRouter.AddRule(rule)
 		if err != nil {
@@ -3152,7 +3620,7 @@ func (s *Syncer) genRouter() error {
 func (s *Syncer) loadTableStructureFromDump(ctx context.Context) error {
 	logger := s.tctx.L()
 
-	files, err := utils.CollectDirFiles(s.cfg.Dir)
+	files, err := storage.CollectDirFiles(ctx, s.cfg.Dir)
 	if err != nil {
 		logger.Warn("fail to get dump files", zap.Error(err))
 		return err
@@ -3193,7 +3661,7 @@ func (s *Syncer) loadTableStructureFromDump(ctx context.Context) error {
 	for _, dbAndFile := range tableFiles {
 		db, file := dbAndFile[0], dbAndFile[1]
 		filepath := path.Join(s.cfg.Dir, file)
-		content, err2 := common2.GetFileContent(filepath)
+		content, err2 := storage.ReadFile(ctx, s.cfg.Dir, file)
 		if err2 != nil {
 			logger.Warn("fail to read file for creating table in schema tracker",
 				zap.String("db", db),
@@ -3264,37 +3732,114 @@ func (s *Syncer) createDBs(ctx context.Context) error {
 		}
 		s.cfg.To.Session["sql_mode"] = sqlModes
 	}
+	if s.cfg.ForeignKeyPolicy == config.ForeignKeyPolicyDisableChecks {
+		if s.cfg.To.IsPostgres() {
+			// the foreign keys of PostgreSQL are implemented by triggers, which are not fired for replica role.
+			s.cfg.To.Session["session_replication_role"] = "replica"
+		} else {
+			s.cfg.To.Session["foreign_key_checks"] = "0"
+		}
+	}
 
+	// baseConn for ddl
 	dbCfg = s.cfg.To
-	dbCfg.RawDBCfg = config.DefaultRawDBConfig().
-		SetReadTimeout(maxDMLConnectionTimeout).
-		SetMaxIdleConns(s.cfg.WorkerCount)
+	dbCfg.RawDBCfg = config.DefaultRawDBConfig().SetReadTimeout(maxDDLConnectionTimeout)
 
-	s.toDB, s.toDBConns, err = dbconn.CreateConns(s.tctx, s.cfg, &dbCfg, s.cfg.WorkerCount)
+	var ddlDBConns []*dbconn.DBConn
+	s.ddlDB, ddlDBConns, err = dbconn.CreateConns(s.tctx, s.cfg, &dbCfg, 2)
 	if err != nil {
 		dbconn.CloseUpstreamConn(s.tctx, s.fromDB) // release resources acquired before return with error
 		return err
 	}
-	// baseConn for ddl
+	s.ddlDBConn = ddlDBConns[0]
+	s.downstreamTrackConn = ddlDBConns[1]
+
 	dbCfg = s.cfg.To
-	dbCfg.RawDBCfg = config.DefaultRawDBConfig().SetReadTimeout(maxDDLConnectionTimeout)
+	s.dmlType = s.detectDMLType(ctx)
+	if s.dmlType == config.DMLTypeBulk {
+		// only the DML connections use the pipelined DML
+		dbCfg.Session = make(map[string]string, len(s.cfg.To.Session)+1)
+		for k, v := range s.cfg.To.Session {
+			dbCfg.Session[k] = v
+		}
+		dbCfg.Session["tidb_dml_type"] = config.DMLTypeBulk
+	}
+	dbCfg.RawDBCfg = config.DefaultRawDBConfig().
+		SetReadTimeout(maxDMLConnectionTimeout).
+		SetMaxIdleConns(s.cfg.WorkerCount)
 
-	var ddlDBConns []*dbconn.DBConn
-	s.ddlDB, ddlDBConns, err = dbconn.CreateConns(s.tctx, s.cfg, &dbCfg, 2)
+	s.toDB, s.toDBConns, err = dbconn.CreateConns(s.tctx, s.cfg, &dbCfg, s.cfg.WorkerCount)
 	if err != nil {
 		dbconn.CloseUpstreamConn(s.tctx, s.fromDB)
-		dbconn.CloseBaseDB(s.tctx, s.toDB)
+		dbconn.CloseBaseDB(s.tctx, s.ddlDB)
 		return err
 	}
-	s.ddlDBConn = ddlDBConns[0]
-	s.downstreamTrackConn = ddlDBConns[1]
+	for _, conn := range s.toDBConns {
+		if s.cfg.PreparedStmtCacheSize > 0 {
+			conn.EnableStmtCache(s.cfg.PreparedStmtCacheSize)
+		}
+		// the pipelined DML only takes effect for the autocommit statements
+		conn.Autocommit = s.dmlType == config.DMLTypeBulk
+	}
 	printServerVersion(s.tctx, s.fromDB.BaseDB, "upstream")
 	printServerVersion(s.tctx, s.toDB, "downstream")
 
 	return nil
 }
 
+// detectDMLType returns the DML type used by the DML connections, "bulk" falls back to "standard" if the downstream
+// doesn't support the pipelined DML of TiDB.
+func (s *Syncer) detectDMLType(ctx context.Context) config.DMLType {
+	if s.cfg.DMLType != config.DMLTypeBulk {
+		return config.DMLTypeStandard
+	}
+	if s.cfg.To.IsPostgres() || s.cfg.SinkURI != "" || s.cfg.DryRunDir != "" {
+		s.tctx.L().Warn("the DMLs are not executed in TiDB, fall back to standard dml-type")
+		return config.DMLTypeStandard
+	}
+	// `tidb_dml_type` is supported since TiDB v8.0
+	if _, err := utils.GetGlobalVariable(ctx, s.ddlDB.DB, "tidb_dml_type"); err != nil {
+		s.tctx.L().Warn("the downstream doesn't support the pipelined DML, fall back to standard dml-type", log.ShortError(err))
+		return config.DMLTypeStandard
+	}
+	s.tctx.L().Info("execute the DMLs with the pipelined DML of TiDB")
+	return config.DMLTypeBulk
+}
+
 // closeBaseDB closes all opened DBs, rollback for createConns.
+// openMQSink opens the sink of Kafka, the background errors of sink are sent to runFatalChan until ctx is done.
+func (s *Syncer) openMQSink(ctx context.Context) error {
+	var err error
+	s.mqSink, err = newMQSink(s.cfg)
+	if err != nil {
+		return err
+	}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		select {
+		case err2 := <-s.mqSink.errCh:
+			s.runFatalChan <- unit.NewProcessError(terror.ErrSyncerMQSink.Delegate(err2, "publish changes"))
+		case <-ctx.Done():
+		}
+	}()
+	return nil
+}
+
+func (s *Syncer) closeMQSink() {
+	if s.mqSink != nil {
+		s.mqSink.close()
+		s.mqSink = nil
+	}
+}
+
+func (s *Syncer) closeDryRun() {
+	if s.dryRun != nil {
+		s.dryRun.close()
+		s.dryRun = nil
+	}
+}
+
 func (s *Syncer) closeDBs() {
 	dbconn.CloseUpstreamConn(s.tctx, s.fromDB)
 	dbconn.CloseBaseDB(s.tctx, s.toDB)
@@ -3449,11 +3994,12 @@ func (s *Syncer) Update(ctx context.Context, cfg *config.SubTaskConfig) error {
 	}
 
 	var (
-		err              error
-		oldBaList        *filter.Filter
-		oldTableRouter   *router.Table
-		oldBinlogFilter  *bf.BinlogEvent
-		oldColumnMapping *cm.Mapping
+		err               error
+		oldBaList         *filter.Filter
+		oldTableRouter    *router.Table
+		oldBinlogFilter   *bf.BinlogEvent
+		oldRowValueFilter *RowValueFilter
+		oldColumnMapping  *cm.Mapping
 	)
 
 	defer func() {
@@ -3469,6 +4015,9 @@ func (s *Syncer) Update(ctx context.Context, cfg *config.SubTaskConfig) error {
 		if oldBinlogFilter != nil {
 			s.binlogFilter = oldBinlogFilter
 		}
+		if oldRowValueFilter != nil {
+			s.rowValueFilter = oldRowValueFilter
+		}
 		if oldColumnMapping != nil {
 			s.columnMapping = oldColumnMapping
 		}
@@ -3487,13 +4036,19 @@ func (s *Syncer) Update(ctx context.Context, cfg *config.SubTaskConfig) error {
 	if err != nil {
 		return terror.ErrSyncerUnitGenTableRouter.Delegate(err)
 	}
+	s.tableRouter.SetSourceTagColumn(cfg.SourceTagColumn)
 
 	// update binlog filter
 	oldBinlogFilter = s.binlogFilter
-	s.binlogFilter, err = bf.NewBinlogEvent(cfg.CaseSensitive, cfg.FilterRules)
+	s.binlogFilter, err = bf.NewBinlogEvent(cfg.CaseSensitive, config.BinlogFilterRules(cfg.FilterRules))
 	if err != nil {
 		return terror.ErrSyncerUnitGenBinlogEventFilter.Delegate(err)
 	}
+	oldRowValueFilter = s.rowValueFilter
+	s.rowValueFilter, err = NewRowValueFilter(s.sessCtx, cfg.CaseSensitive, cfg.FilterRules)
+	if err != nil {
+		return err
+	}
 
 	// update column-mappings
 	oldColumnMapping = s.columnMapping
@@ -3631,25 +4186,43 @@ func (s *Syncer) setSyncCfg() error {
 	return nil
 }
 
+// SetRateLimit changes the max rows and bytes replicated per second, non-positive value means unlimited.
+func (s *Syncer) SetRateLimit(rowsPerSecond, bytesPerSecond int64) {
+	s.rateLimiter.SetLimit(rowsPerSecond, bytesPerSecond)
+	s.tctx.L().Info("rate limit changed", zap.Int64("rows per second", rowsPerSecond), zap.Int64("bytes per second", bytesPerSecond))
+}
+
+// SetThrottle throttles the rows replicated per second besides the rate limit, it's used by the DM-worker to throttle
+// the subtasks of lower priorities when it's busy. non-positive value removes the throttle.
+func (s *Syncer) SetThrottle(rowsPerSecond int64) {
+	if s.rateLimiter.Throttle() == rowsPerSecond {
+		return
+	}
+	s.rateLimiter.SetThrottle(rowsPerSecond)
+	s.tctx.L().Info("throttle changed", zap.Int64("rows per second", rowsPerSecond))
+}
+
 // ShardDDLOperation returns the current pending to handle shard DDL lock operation.
 func (s *Syncer) ShardDDLOperation() *pessimism.Operation {
 	return s.pessimist.PendingOperation()
 }
 
-func (s *Syncer) setErrLocation(startLocation, endLocation *binlog.Location, isQueryEventEvent bool) {
+func (s *Syncer) setErrLocation(startLocation, endLocation *binlog.Location, isQueryEventEvent bool, originSQL string) {
 	s.errLocation.Lock()
 	defer s.errLocation.Unlock()
 
 	s.errLocation.isQueryEvent = isQueryEventEvent
 	if s.errLocation.startLocation == nil || startLocation == nil {
 		s.errLocation.startLocation = startLocation
-	} else if binlog.CompareLocation(*startLocation, *s.errLocation.startLocation, s.cfg.EnableGTID) < 0 {
+		s.errLocation.originSQL = originSQL
+	} else if s.locationCmp.Compare(*startLocation, *s.errLocation.startLocation) < 0 {
 		s.errLocation.startLocation = startLocation
+		s.errLocation.originSQL = originSQL
 	}
 
 	if s.errLocation.endLocation == nil || endLocation == nil {
 		s.errLocation.endLocation = endLocation
-	} else if binlog.CompareLocation(*endLocation, *s.errLocation.endLocation, s.cfg.EnableGTID) < 0 {
+	} else if s.locationCmp.Compare(*endLocation, *s.errLocation.endLocation) < 0 {
 		s.errLocation.endLocation = endLocation
 	}
 }
@@ -3660,11 +4233,18 @@ func (s *Syncer) getErrLocation() (*binlog.Location, bool) {
 	return s.errLocation.startLocation, s.errLocation.isQueryEvent
 }
 
+// getErrOriginSQL returns the origin SQL of the event at the error location.
+func (s *Syncer) getErrOriginSQL() string {
+	s.errLocation.Lock()
+	defer s.errLocation.Unlock()
+	return s.errLocation.originSQL
+}
+
 func (s *Syncer) handleEventError(err error, startLocation, endLocation binlog.Location, isQueryEvent bool, originSQL string) error {
 	if err == nil {
 		return nil
 	}
-	s.setErrLocation(&startLocation, &endLocation, isQueryEvent)
+	s.setErrLocation(&startLocation, &endLocation, isQueryEvent, originSQL)
 	if len(originSQL) > 0 {
 		return terror.Annotatef(err, "startLocation: [%s], endLocation: [%s], origin SQL: [%s]", startLocation, endLocation, originSQL)
 	}
@@ -3688,6 +4268,74 @@ func (s *Syncer) getEvent(tctx *tcontext.Context, startLocation binlog.Location)
 	return e, err
 }
 
+// logPurgedTransactions logs the transactions which are required to resume from `location` but purged in upstream.
+func (s *Syncer) logPurgedTransactions(tctx *tcontext.Context, location binlog.Location) {
+	dbConn, err := s.fromDB.BaseDB.GetBaseConn(tctx.Context())
+	if err != nil {
+		tctx.L().Warn("fail to build connection", zap.Stringer("location", location), zap.Error(err))
+		return
+	}
+	defer func() {
+		_ = s.fromDB.BaseDB.CloseBaseConn(dbConn)
+	}()
+	purged, err := utils.GetGTIDPurged(tctx.Context(), dbConn.DBConn)
+	if err != nil {
+		tctx.L().Warn("fail to get purged gtidSet", zap.Stringer("location", location), zap.Error(err))
+		return
+	}
+	missing, err := gtid.MissingTransactions(purged, location.GetGTID())
+	if err != nil {
+		tctx.L().Warn("fail to compare purged gtidSet", zap.Stringer("location", location), zap.Stringer("purged", purged), zap.Error(err))
+		return
+	}
+	tctx.L().Error("binlog required to resume has been purged in upstream", zap.Stringer("location", location), zap.String("purged", missing))
+}
+
+// checkBinlogContinuity checks the binlog from the location recorded by the dump unit is still available in upstream
+// before the syncer starts from it, otherwise the data changed in the gap would be lost silently. when relay is
+// enabled, the relay log reader reports the error itself if the location is not in relay log.
+func (s *Syncer) checkBinlogContinuity(tctx *tcontext.Context, location binlog.Location) error {
+	if s.binlogType == LocalBinlog || location.Position.Name == "" {
+		return nil
+	}
+
+	var gap string
+	if s.cfg.EnableGTID && s.cfg.Flavor == mysql.MySQLFlavor && location.GTIDSetStr() != "" {
+		dbConn, err := s.fromDB.BaseDB.GetBaseConn(tctx.Context())
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = s.fromDB.BaseDB.CloseBaseConn(dbConn)
+		}()
+		purged, err := utils.GetGTIDPurged(tctx.Context(), dbConn.DBConn)
+		if err != nil {
+			return err
+		}
+		if gap, err = gtid.MissingTransactions(purged, location.GetGTID()); err != nil {
+			return terror.WithClass(err, terror.ClassSyncUnit)
+		}
+	} else {
+		files, err := binlog.GetBinaryLogs(tctx.Context(), s.fromDB.BaseDB.DB)
+		if err != nil {
+			return err
+		}
+		if !files.Contains(location.Position) {
+			gap = fmt.Sprintf("binlog file %s is purged", location.Position.Name)
+		}
+	}
+	if gap == "" {
+		return nil
+	}
+
+	tctx.L().Error("binlog from the dumped location has been purged in upstream", zap.Stringer("location", location),
+		zap.String("gap", gap), zap.String("on-binlog-gap", string(s.cfg.OnBinlogGap)))
+	if s.cfg.OnBinlogGap == config.BinlogGapRedump {
+		return terror.ErrSyncerRedumpForBinlogGap.Generate(location, gap)
+	}
+	return terror.ErrSyncerBinlogGap.Generate(location, gap)
+}
+
 func (s *Syncer) adjustGlobalPointGTID(tctx *tcontext.Context) (bool, error) {
 	location := s.checkpoint.GlobalPoint()
 	// situations that don't need to adjust
@@ -3699,6 +4347,8 @@ func (s *Syncer) adjustGlobalPointGTID(tctx *tcontext.Context) (bool, error) {
 	}
 	// set enableGTID to false for new streamerController
 	streamerController := NewStreamerController(s.syncCfg, false, s.fromDB, s.cfg.RelayDir, s.timezone, s.relay)
+	streamerController.setMaxEventSize(s.cfg.MaxEventSize, s.cfg.MaxEventSizePolicy)
+	streamerController.setRelayReaderOptions(s.cfg)
 
 	endPos := binlog.AdjustPosition(location.Position)
 	startPos := mysql.Position{
@@ -3746,6 +4396,123 @@ func (s *Syncer) adjustGlobalPointGTID(tctx *tcontext.Context) (bool, error) {
 	return true, nil
 }
 
+// setGlobalPointByTime replaces the checkpoint with the location of the first transaction not earlier than
+// `timeStr`, which is parsed in the time zone of upstream. the location is found in relay log if relay is enabled,
+// otherwise in the binlog of upstream.
+func (s *Syncer) setGlobalPointByTime(tctx *tcontext.Context, timeStr string) error {
+	t, err := config.ParseStartTime(timeStr, s.timezone)
+	if err != nil {
+		return terror.Annotatef(err, "parse start-time %s", timeStr)
+	}
+
+	var (
+		loc   *binlog.Location
+		posTp binlog.PosType
+	)
+	if s.binlogType == LocalBinlog {
+		loc, posTp, err = s.findRelayLocationByTime(tctx, t)
+	} else {
+		finder := binlog.NewRemoteBinlogPosFinder(tctx, s.fromDB.BaseDB.DB, s.syncCfg, s.cfg.EnableGTID)
+		loc, posTp, err = finder.FindByTimestamp(t.Unix())
+	}
+	if err != nil {
+		tctx.L().Error("fail to find binlog location by start-time", zap.String("start-time", timeStr), zap.Error(err))
+		return err
+	}
+
+	switch posTp {
+	case binlog.InRangeBinlogPos:
+		tctx.L().Info("find binlog location by start-time", zap.String("start-time", timeStr), zap.Stringer("location", loc))
+	case binlog.BelowLowerBoundBinlogPos:
+		tctx.L().Warn("start-time is earlier than all binlog, will replicate from the earliest location",
+			zap.String("start-time", timeStr), zap.Stringer("location", loc))
+	case binlog.AboveUpperBoundBinlogPos:
+		return terror.ErrConfigStartTimeTooLate.Generate(timeStr)
+	}
+
+	// the table checkpoints are dropped too, otherwise the events before them are skipped.
+	if err = s.checkpoint.Clear(tctx); err != nil {
+		return err
+	}
+	s.checkpoint.SaveGlobalPoint(*loc)
+	tctx.L().Info("will replicate from the location of start-time, the checkpoint and the meta in task config are ignored",
+		zap.String("start-time", timeStr), zap.Stringer("location", loc))
+	return nil
+}
+
+// findRelayLocationByTime finds the location of the first transaction not earlier than `t` in the relay log.
+// the relay sub directory is chosen by BinlogReader.SeekByTime which searches all sub directories, so `t` can be
+// earlier than the last switch of upstream master. the file name of the returned location has the UUID suffix.
+func (s *Syncer) findRelayLocationByTime(tctx *tcontext.Context, t time.Time) (*binlog.Location, binlog.PosType, error) {
+	r := s.relay.NewReader(tctx.L(), &relay.BinlogReaderConfig{
+		RelayDir:   s.cfg.RelayDir,
+		Timezone:   s.timezone,
+		Flavor:     s.cfg.Flavor,
+		EncryptKey: s.cfg.RelayEncryptKey,
+	})
+	defer r.Close()
+
+	pos, err := r.SeekByTime(t)
+	if err != nil {
+		return nil, binlog.InvalidBinlogPos, err
+	}
+	uuids := r.GetUUIDs()
+	uuidWithSuffix, _, _, err := binlog.ExtractPos(pos, uuids)
+	if err != nil {
+		return nil, binlog.InvalidBinlogPos, err
+	}
+	var (
+		idx   int
+		loc   *binlog.Location
+		posTp binlog.PosType
+	)
+	for idx < len(uuids) && uuids[idx] != uuidWithSuffix {
+		idx++
+	}
+	for ; idx < len(uuids); idx++ {
+		finder := binlog.NewLocalBinlogPosFinder(tctx, s.cfg.EnableGTID, s.cfg.Flavor, path.Join(s.cfg.RelayDir, uuids[idx]))
+		loc, posTp, err = finder.FindByTimestamp(t.Unix())
+		if err != nil {
+			return nil, binlog.InvalidBinlogPos, err
+		}
+		if posTp != binlog.AboveUpperBoundBinlogPos {
+			break
+		}
+		// `t` is later than all events of this sub directory, the first event of the next one is the target.
+	}
+	if posTp == binlog.AboveUpperBoundBinlogPos {
+		return nil, posTp, nil
+	}
+	if posTp == binlog.BelowLowerBoundBinlogPos && idx > 0 {
+		// `t` falls in the gap between two sub directories.
+		posTp = binlog.InRangeBinlogPos
+	}
+
+	_, suffix, err := utils.ParseSuffixForUUID(uuids[idx])
+	if err != nil {
+		return nil, binlog.InvalidBinlogPos, err
+	}
+	fileName, err := binlog.ParseFilename(loc.Position.Name)
+	if err != nil {
+		return nil, binlog.InvalidBinlogPos, err
+	}
+	loc.Position.Name = binlog.ConstructFilenameWithUUIDSuffix(fileName, utils.SuffixIntToStr(suffix))
+	return loc, posTp, nil
+}
+
+// clearStartTime removes start-time from the command line arguments of the task in etcd after the location of it is
+// recorded as the checkpoint, so the task will continue from the checkpoint after resumed or restarted.
+func (s *Syncer) clearStartTime() error {
+	args := *s.cliArgs
+	args.StartTime = ""
+	if err := ha.PutTaskCliArgs(s.cli, s.cfg.Name, []string{s.cfg.SourceID}, args); err != nil {
+		return err
+	}
+	s.cliArgs = &args
+	s.tctx.L().Info("clear start-time in etcd", zap.String("task", s.cfg.Name), zap.String("source", s.cfg.SourceID))
+	return nil
+}
+
 // delLoadTask is called when finish restoring data, to delete load worker in etcd.
 func (s *Syncer) delLoadTask() error {
 	_, _, err := ha.DelLoadTask(s.cli, s.cfg.Name, s.cfg.SourceID)
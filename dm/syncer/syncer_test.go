@@ -14,6 +14,7 @@
 package syncer
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"fmt"
@@ -37,8 +38,11 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	parserpkg "github.com/pingcap/tiflow/dm/pkg/parser"
 	"github.com/pingcap/tiflow/dm/pkg/retry"
+	"github.com/pingcap/tiflow/dm/pkg/router"
 	"github.com/pingcap/tiflow/dm/pkg/schema"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/pingcap/tiflow/dm/relay"
 	"github.com/pingcap/tiflow/dm/syncer/dbconn"
 	"github.com/pingcap/tiflow/pkg/errorutil"
 
@@ -51,7 +55,6 @@ import (
 	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
 	cm "github.com/pingcap/tidb-tools/pkg/column-mapping"
 	"github.com/pingcap/tidb-tools/pkg/filter"
-	router "github.com/pingcap/tidb-tools/pkg/table-router"
 	"github.com/pingcap/tidb/infoschema"
 	"github.com/pingcap/tidb/parser"
 	"github.com/pingcap/tidb/parser/ast"
@@ -115,6 +118,17 @@ func (m *MockStreamer) GetEvent(ctx context.Context) (*replication.BinlogEvent,
 	return e, nil
 }
 
+func (m *MockStreamer) GetEventWithTimeout(d time.Duration) (*replication.BinlogEvent, error) {
+	return m.GetEvent(context.Background())
+}
+
+func (m *MockStreamer) TryGetEvent() (*replication.BinlogEvent, error) {
+	if int(m.idx) >= len(m.events) {
+		return nil, nil
+	}
+	return m.GetEvent(context.Background())
+}
+
 type MockStreamProducer struct {
 	events []*replication.BinlogEvent
 }
@@ -528,28 +542,28 @@ func (s *testSyncerSuite) TestIgnoreTable(c *C) {
 }
 
 func (s *testSyncerSuite) TestSkipDML(c *C) {
-	s.cfg.FilterRules = []*bf.BinlogEventRule{
-		{
+	s.cfg.FilterRules = []*config.BinlogEventRule{
+		{BinlogEventRule: bf.BinlogEventRule{
 			SchemaPattern: "*",
 			TablePattern:  "",
 			Events:        []bf.EventType{bf.UpdateEvent},
 			Action:        bf.Ignore,
-		}, {
+		}}, {BinlogEventRule: bf.BinlogEventRule{
 			SchemaPattern: "foo",
 			TablePattern:  "",
 			Events:        []bf.EventType{bf.DeleteEvent},
 			Action:        bf.Ignore,
-		}, {
+		}}, {BinlogEventRule: bf.BinlogEventRule{
 			SchemaPattern: "foo1",
 			TablePattern:  "bar1",
 			Events:        []bf.EventType{bf.DeleteEvent},
 			Action:        bf.Ignore,
-		}, {
+		}}, {BinlogEventRule: bf.BinlogEventRule{
 			SchemaPattern: "foo1",
 			TablePattern:  "bar2",
 			Events:        []bf.EventType{bf.EventType(strings.ToUpper(string(bf.DeleteEvent)))},
 			Action:        bf.Ignore,
-		},
+		}},
 	}
 	s.cfg.BAList = nil
 
@@ -616,7 +630,7 @@ func (s *testSyncerSuite) TestSkipDML(c *C) {
 	syncer := NewSyncer(cfg, nil, nil)
 	c.Assert(syncer.genRouter(), IsNil)
 
-	syncer.binlogFilter, err = bf.NewBinlogEvent(false, s.cfg.FilterRules)
+	syncer.binlogFilter, err = bf.NewBinlogEvent(false, config.BinlogFilterRules(s.cfg.FilterRules))
 	c.Assert(err, IsNil)
 
 	for _, sql := range sqls {
@@ -807,6 +821,7 @@ func (s *testSyncerSuite) TestRun(c *C) {
 		sqlmock.NewRows([]string{"Table", "Create Table"}).
 			AddRow("t_2", "create table t_2(id int primary key, name varchar(24))"))
 	syncer.exprFilterGroup = NewExprFilterGroup(utils.NewSessionCtx(nil), nil)
+	syncer.rowValueFilter, _ = NewRowValueFilter(utils.NewSessionCtx(nil), false, nil)
 	c.Assert(err, IsNil)
 	c.Assert(syncer.Type(), Equals, pb.UnitType_Sync)
 
@@ -1078,6 +1093,7 @@ func (s *testSyncerSuite) TestExitSafeModeByConfig(c *C) {
 
 	syncer.schemaTracker, err = schema.NewTracker(context.Background(), s.cfg.Name, defaultTestSessionCfg, syncer.ddlDBConn)
 	syncer.exprFilterGroup = NewExprFilterGroup(utils.NewSessionCtx(nil), nil)
+	syncer.rowValueFilter, _ = NewRowValueFilter(utils.NewSessionCtx(nil), false, nil)
 	c.Assert(err, IsNil)
 	c.Assert(syncer.Type(), Equals, pb.UnitType_Sync)
 
@@ -1275,6 +1291,7 @@ func (s *testSyncerSuite) TestTrackDDL(c *C) {
 	syncer.checkpoint.(*RemoteCheckPoint).dbConn = &dbconn.DBConn{Cfg: s.cfg, BaseConn: conn.NewBaseConn(checkPointDBConn, &retry.FiniteRetryStrategy{})}
 	syncer.schemaTracker, err = schema.NewTracker(context.Background(), s.cfg.Name, defaultTestSessionCfg, syncer.ddlDBConn)
 	syncer.exprFilterGroup = NewExprFilterGroup(utils.NewSessionCtx(nil), nil)
+	syncer.rowValueFilter, _ = NewRowValueFilter(utils.NewSessionCtx(nil), false, nil)
 	c.Assert(syncer.genRouter(), IsNil)
 	c.Assert(err, IsNil)
 
@@ -1701,5 +1718,225 @@ func (s *testSyncerSuite) TestExecuteSQLSWithIgnore(c *C) {
 	c.Assert(err, ErrorMatches, ".*column a already exists.*")
 	c.Assert(n, Equals, 0)
 
+	// the statements before the failed one are committed in autocommit mode
+	conn.Autocommit = true
+	mock.ExpectExec(sqls[0]).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(sqls[1]).WillReturnError(newMysqlErr(uint16(infoschema.ErrColumnExists.Code()), "column b already exists"))
+	n, err = conn.ExecuteSQL(tctx, sqls)
+	c.Assert(err, ErrorMatches, ".*column b already exists.*")
+	c.Assert(n, Equals, 1)
+
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+}
+
+func (s *testSyncerSuite) TestDetectDMLType(c *C) {
+	cfg, err := s.cfg.Clone()
+	c.Assert(err, IsNil)
+	syncer := NewSyncer(cfg, nil, nil)
+	c.Assert(syncer.detectDMLType(context.Background()), Equals, config.DMLType(config.DMLTypeStandard))
+
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	syncer.ddlDB = conn.NewBaseDB(db)
+	cfg.DMLType = config.DMLTypeBulk
+	mock.ExpectQuery("SHOW GLOBAL VARIABLES LIKE 'tidb_dml_type'").
+		WillReturnRows(sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("tidb_dml_type", "standard"))
+	c.Assert(syncer.detectDMLType(context.Background()), Equals, config.DMLType(config.DMLTypeBulk))
+
+	// fall back to standard if the downstream doesn't support the pipelined DML
+	mock.ExpectQuery("SHOW GLOBAL VARIABLES LIKE 'tidb_dml_type'").
+		WillReturnRows(sqlmock.NewRows([]string{"Variable_name", "Value"}))
+	c.Assert(syncer.detectDMLType(context.Background()), Equals, config.DMLType(config.DMLTypeStandard))
+	cfg.DryRunDir = c.MkDir()
+	c.Assert(syncer.detectDMLType(context.Background()), Equals, config.DMLType(config.DMLTypeStandard))
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+}
+
+type mockOfflineRelay struct {
+	*relay.Relay
+}
+
+func (mockOfflineRelay) NewReader(logger log.Logger, cfg *relay.BinlogReaderConfig) *relay.BinlogReader {
+	return relay.NewOfflineReader(logger, cfg)
+}
+
+func (s *testSyncerSuite) TestSetGlobalPointByTime(c *C) {
+	var (
+		relayDir   = c.MkDir()
+		subDirs    = []string{"c6ae5afe-c7a3-11e8-a19d-0242ac130006.000001", "e9540a0d-f16d-11e8-8cb7-0242ac130008.000002"}
+		beforeTime = time.Now().Truncate(time.Second)
+		timeStr    = func(t time.Time) string { return t.Format(config.StartTimeFormat) }
+	)
+	c.Assert(os.WriteFile(filepath.Join(relayDir, utils.UUIDIndexFilename), []byte(strings.Join(subDirs, "\n")+"\n"), 0o644), IsNil)
+	// the master is switched between the two sub directories, every sub directory has one relay log file with two DDLs
+	writeRelayLog := func(subDir string, latestGTID string, startTS int64) []*replication.BinlogEvent {
+		c.Assert(os.Mkdir(filepath.Join(relayDir, subDir), 0o755), IsNil)
+		generator, err := event.NewGeneratorV2(mysql.MySQLFlavor, "5.7.0", latestGTID, false)
+		c.Assert(err, IsNil)
+		var buf bytes.Buffer
+		_, data, err := generator.GenFileHeader(startTS)
+		c.Assert(err, IsNil)
+		buf.Write(data)
+		ddl1, data, err := generator.GenDDLEvents("test", "create table t1(id int)", startTS+1)
+		c.Assert(err, IsNil)
+		buf.Write(data)
+		_, data, err = generator.GenDDLEvents("test", "create table t2(id int)", startTS+3)
+		c.Assert(err, IsNil)
+		buf.Write(data)
+		c.Assert(os.WriteFile(filepath.Join(relayDir, subDir, "mysql-bin.000001"), buf.Bytes(), 0o644), IsNil)
+		return ddl1
+	}
+	ddl1 := writeRelayLog(subDirs[0], "3ccc475b-2343-11e7-be21-6c0b84d59f30:14", beforeTime.Add(time.Second).Unix())
+	ddl3 := writeRelayLog(subDirs[1], "53ea0ed1-9bf8-11e6-8bea-64006a897c73:1", beforeTime.Add(10*time.Second).Unix())
+
+	checkPointDB, checkPointMock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	checkPointDBConn, err := checkPointDB.Conn(context.Background())
+	c.Assert(err, IsNil)
+
+	cfg, err := s.cfg.Clone()
+	c.Assert(err, IsNil)
+	cfg.RelayDir = relayDir
+	syncer := NewSyncer(cfg, nil, mockOfflineRelay{})
+	syncer.timezone = time.Local
+	syncer.checkpoint.(*RemoteCheckPoint).dbConn = &dbconn.DBConn{Cfg: s.cfg, BaseConn: conn.NewBaseConn(checkPointDBConn, &retry.FiniteRetryStrategy{})}
+	syncer.checkpoint.SaveGlobalPoint(binlog.InitLocation(mysql.Position{Name: "mysql-bin.000002", Pos: 4}, nil))
+	tctx := tcontext.Background()
+
+	cases := []struct {
+		startTime time.Time
+		expected  mysql.Position
+	}{
+		// the location of the second DDL before the master switch
+		{beforeTime.Add(3 * time.Second), mysql.Position{Name: "mysql-bin|000001.000001", Pos: ddl1[len(ddl1)-1].Header.LogPos}},
+		// between the two sub directories, the first event after the master switch
+		{beforeTime.Add(6 * time.Second), mysql.Position{Name: "mysql-bin|000002.000001", Pos: 4}},
+		// the location of the second DDL after the master switch
+		{beforeTime.Add(12 * time.Second), mysql.Position{Name: "mysql-bin|000002.000001", Pos: ddl3[len(ddl3)-1].Header.LogPos}},
+	}
+	for _, cs := range cases {
+		// the table checkpoints are cleared with the global checkpoint
+		checkPointMock.ExpectBegin()
+		checkPointMock.ExpectExec("DELETE FROM").WillReturnResult(sqlmock.NewResult(0, 1))
+		checkPointMock.ExpectCommit()
+		c.Assert(syncer.setGlobalPointByTime(tctx, timeStr(cs.startTime)), IsNil)
+		c.Assert(syncer.checkpoint.GlobalPoint().Position, DeepEquals, cs.expected)
+		c.Assert(checkPointMock.ExpectationsWereMet(), IsNil)
+	}
+
+	// too late
+	err = syncer.setGlobalPointByTime(tctx, timeStr(beforeTime.Add(time.Minute)))
+	c.Assert(terror.ErrConfigStartTimeTooLate.Equal(err), IsTrue)
+
+	// invalid format
+	c.Assert(syncer.setGlobalPointByTime(tctx, "12:00:00"), NotNil)
+}
+
+func (s *testSyncerSuite) TestCheckBinlogContinuity(c *C) {
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	cfg, err := s.cfg.Clone()
+	c.Assert(err, IsNil)
+	cfg.EnableGTID = false
+	syncer := NewSyncer(cfg, nil, nil)
+	syncer.fromDB = &dbconn.UpStreamConn{BaseDB: conn.NewBaseDB(db)}
+	tctx := tcontext.Background()
+	mockBinaryLogs := func() {
+		mock.ExpectQuery("SHOW BINARY LOGS").WillReturnRows(
+			sqlmock.NewRows([]string{"Log_name", "File_size"}).
+				AddRow("mysql-bin.000002", 1000).
+				AddRow("mysql-bin.000003", 2000))
+	}
+
+	// the dumped location is still in upstream
+	mockBinaryLogs()
+	c.Assert(syncer.checkBinlogContinuity(tctx, binlog.InitLocation(mysql.Position{Name: "mysql-bin.000002", Pos: 4}, nil)), IsNil)
+
+	// the binlog file is purged
+	purgedLocation := binlog.InitLocation(mysql.Position{Name: "mysql-bin.000001", Pos: 4}, nil)
+	mockBinaryLogs()
+	err = syncer.checkBinlogContinuity(tctx, purgedLocation)
+	c.Assert(terror.ErrSyncerBinlogGap.Equal(err), IsTrue)
+	c.Assert(err, ErrorMatches, ".*binlog file mysql-bin.000001 is purged.*")
+
+	// dump again automatically
+	syncer.cfg.OnBinlogGap = config.BinlogGapRedump
+	mockBinaryLogs()
+	err = syncer.checkBinlogContinuity(tctx, purgedLocation)
+	c.Assert(terror.ErrSyncerRedumpForBinlogGap.Equal(err), IsTrue)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// compare with gtid_purged if GTID is enabled
+	syncer.cfg.EnableGTID = true
+	syncer.cfg.Flavor = mysql.MySQLFlavor
+	syncer.cfg.OnBinlogGap = config.BinlogGapError
+	dumped, err := gtid.ParserGTID(mysql.MySQLFlavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-20")
+	c.Assert(err, IsNil)
+	location := binlog.InitLocation(mysql.Position{Name: "mysql-bin.000001", Pos: 4}, dumped)
+	mockGTIDPurged := func(purged string) sqlmock.Sqlmock {
+		// the connection is closed after the check, so a new mock is needed for each check
+		db, mock, err := sqlmock.New()
+		c.Assert(err, IsNil)
+		syncer.fromDB = &dbconn.UpStreamConn{BaseDB: conn.NewBaseDB(db)}
+		mock.ExpectQuery("select @@GLOBAL.gtid_purged").WillReturnRows(
+			sqlmock.NewRows([]string{"@@GLOBAL.gtid_purged"}).AddRow(purged))
+		return mock
+	}
+	mock = mockGTIDPurged("3ccc475b-2343-11e7-be21-6c0b84d59f30:1-10")
+	c.Assert(syncer.checkBinlogContinuity(tctx, location), IsNil)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	mock = mockGTIDPurged("3ccc475b-2343-11e7-be21-6c0b84d59f30:1-30")
+	err = syncer.checkBinlogContinuity(tctx, location)
+	c.Assert(terror.ErrSyncerBinlogGap.Equal(err), IsTrue)
+	c.Assert(err, ErrorMatches, ".*3ccc475b-2343-11e7-be21-6c0b84d59f30:21-30.*")
 	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// skip the check if relay is enabled
+	syncer.binlogType = LocalBinlog
+	c.Assert(syncer.checkBinlogContinuity(tctx, location), IsNil)
+}
+
+func (s *testSyncerSuite) TestEvictSchemaTracker(c *C) {
+	ctx := context.Background()
+	tctx := tcontext.Background()
+	cfg, err := s.cfg.Clone()
+	c.Assert(err, IsNil)
+	cfg.SchemaTrackerMemoryBudget = 1
+	defer func(unit int64) {
+		schemaTrackerMemoryUnit = unit
+	}(schemaTrackerMemoryUnit)
+	schemaTrackerMemoryUnit = 1
+
+	syncer := NewSyncer(cfg, nil, nil)
+	syncer.schemaTracker, err = schema.NewTracker(ctx, cfg.Name, defaultTestSessionCfg, nil)
+	c.Assert(err, IsNil)
+	c.Assert(syncer.schemaTracker.CreateSchemaIfNotExists("test"), IsNil)
+	tb1 := &filter.Table{Schema: "test", Name: "tb1"}
+	tb2 := &filter.Table{Schema: "test", Name: "tb2"}
+	c.Assert(syncer.schemaTracker.Exec(ctx, "test", "create table tb1 (id int primary key)"), IsNil)
+	c.Assert(syncer.schemaTracker.Exec(ctx, "test", "create table tb2 (id int primary key)"), IsNil)
+
+	// only the tables whose table infos are flushed with the checkpoints are evicted
+	location := binlog.Location{Position: mysql.Position{Name: "mysql-bin.000001", Pos: 4}}
+	_, err = syncer.getTableInfo(tctx, tb1, tb1)
+	c.Assert(err, IsNil)
+	syncer.saveTablePoint(tb1, location)
+	syncer.checkpoint.(*RemoteCheckPoint).points["test"]["tb1"].flush()
+	_, err = syncer.getTableInfo(tctx, tb2, tb2)
+	c.Assert(err, IsNil)
+	syncer.saveTablePoint(tb2, location)
+	syncer.evictSchemaTracker()
+	_, err = syncer.schemaTracker.GetTableInfo(tb1)
+	c.Assert(schema.IsTableNotExists(err), IsTrue)
+	_, err = syncer.schemaTracker.GetTableInfo(tb2)
+	c.Assert(err, IsNil)
+
+	// the evicted table is loaded from the checkpoint on the next use
+	ti, err := syncer.getTableInfo(tctx, tb1, tb1)
+	c.Assert(err, IsNil)
+	c.Assert(ti.Columns, HasLen, 1)
+	createTable, err := syncer.OperateSchema(ctx, &pb.OperateWorkerSchemaRequest{Op: pb.SchemaOp_GetSchema, Database: "test", Table: "tb1"})
+	c.Assert(err, IsNil)
+	c.Assert(createTable, Matches, "CREATE TABLE `tb1`.*")
 }
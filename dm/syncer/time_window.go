@@ -0,0 +1,63 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// timeWindow is the replication time window of `start-time` and `stop-time` in syncer config. the timestamps are
+// unix seconds and 0 means unlimited.
+type timeWindow struct {
+	start int64
+	stop  int64
+}
+
+// newTimeWindow creates a timeWindow with the time parsed in the location of upstream, returns nil if the time window
+// is not set.
+func newTimeWindow(cfg *config.SubTaskConfig, loc *time.Location) (*timeWindow, error) {
+	if cfg.StartTime == "" && cfg.StopTime == "" {
+		return nil, nil
+	}
+	w := &timeWindow{}
+	if cfg.StartTime != "" {
+		t, err := config.ParseStartTime(cfg.StartTime, loc)
+		if err != nil {
+			return nil, terror.ErrConfigInvalidTimeWindow.Generate(fmt.Sprintf("start-time %s: %s", cfg.StartTime, err.Error()))
+		}
+		w.start = t.Unix()
+	}
+	if cfg.StopTime != "" {
+		t, err := config.ParseStartTime(cfg.StopTime, loc)
+		if err != nil {
+			return nil, terror.ErrConfigInvalidTimeWindow.Generate(fmt.Sprintf("stop-time %s: %s", cfg.StopTime, err.Error()))
+		}
+		w.stop = t.Unix()
+	}
+	return w, nil
+}
+
+// beforeStart returns whether the event of the timestamp is committed before the time window.
+func (w *timeWindow) beforeStart(ts uint32) bool {
+	return w != nil && w.start > 0 && ts > 0 && int64(ts) < w.start
+}
+
+// afterStop returns whether the event of the timestamp is committed after the time window.
+func (w *timeWindow) afterStop(ts uint32) bool {
+	return w != nil && w.stop > 0 && int64(ts) > w.stop
+}
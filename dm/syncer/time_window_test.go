@@ -0,0 +1,57 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"time"
+
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+func (s *testSyncerSuite) TestTimeWindow(c *C) {
+	cfg := &config.SubTaskConfig{}
+	w, err := newTimeWindow(cfg, time.UTC)
+	c.Assert(err, IsNil)
+	c.Assert(w, IsNil)
+	c.Assert(w.beforeStart(1), IsFalse)
+	c.Assert(w.afterStop(1), IsFalse)
+
+	loc := time.FixedZone("UTC+8", 8*3600)
+	cfg.StartTime = "2022-01-01 08:00:00"
+	cfg.StopTime = "2022-01-02T08:00:00"
+	w, err = newTimeWindow(cfg, loc)
+	c.Assert(err, IsNil)
+	start := uint32(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC).Unix())
+	stop := uint32(time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC).Unix())
+	c.Assert(w.beforeStart(start-1), IsTrue)
+	c.Assert(w.beforeStart(start), IsFalse)
+	c.Assert(w.afterStop(stop), IsFalse)
+	c.Assert(w.afterStop(stop+1), IsTrue)
+	// the events without timestamp like fake rotate events are never filtered
+	c.Assert(w.beforeStart(0), IsFalse)
+
+	// only stop-time is set
+	cfg.StartTime = ""
+	w, err = newTimeWindow(cfg, loc)
+	c.Assert(err, IsNil)
+	c.Assert(w.beforeStart(start-1), IsFalse)
+	c.Assert(w.afterStop(stop+1), IsTrue)
+
+	cfg.StopTime = "2022/01/02"
+	_, err = newTimeWindow(cfg, loc)
+	c.Assert(terror.ErrConfigInvalidTimeWindow.Equal(err), IsTrue)
+}
@@ -0,0 +1,438 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"hash/crc32"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/syncer/metrics"
+)
+
+const (
+	// the backoff of re-checking a mismatched row is at most 2^maxValidationBackoffShift times of the interval.
+	maxValidationBackoffShift = 6
+	// the max number of the keys of failed rows shown in the status.
+	maxFailedRowKeys = 10
+)
+
+// validateRow is a row applied to downstream which is waiting to be validated.
+type validateRow struct {
+	key      string   // like "`db`.`tbl`:1", identifies the row in validator
+	table    string   // quoted name of the target table
+	columns  []string // names of the columns
+	pkIdx    []int    // offsets of the primary key or not null unique key in columns
+	pkValues []string
+	values   []*string // expected values of the columns, a nil value means NULL, nil slice means the row is deleted
+
+	retry     int
+	nextCheck time.Time
+	failed    bool
+}
+
+// chunkKey returns the key of the chunk, the rows of a chunk are queried by one statement.
+func (r *validateRow) chunkKey() string {
+	return r.table + ":" + strings.Join(r.columns, ",")
+}
+
+// validator validates the rows applied to downstream continuously. the rows of the DMLs executed successfully are
+// added to validator, and they're compared with downstream rows by checksum every interval asynchronously. the rows
+// are kept in memory, so the pending rows are not validated after the subtask is restarted.
+type validator struct {
+	sync.Mutex
+
+	cfg      *config.SubTaskConfig
+	logger   log.Logger
+	db       *conn.BaseDB
+	dialect  sqlDialect
+	interval time.Duration
+	// the pending and failed rows, a newer change of the same row replaces the old one
+	rows map[string]*validateRow
+
+	validated atomic.Int64
+}
+
+// newValidator creates a validator.
+func newValidator(cfg *config.SubTaskConfig, db *conn.BaseDB, dialect sqlDialect, logger log.Logger) *validator {
+	return &validator{
+		cfg:      cfg,
+		logger:   logger.WithFields(zap.String("component", "validator")),
+		db:       db,
+		dialect:  dialect,
+		interval: time.Duration(cfg.ValidationInterval) * time.Second,
+		rows:     make(map[string]*validateRow),
+	}
+}
+
+// addJobs adds the rows of the DML jobs which have been applied to downstream.
+func (v *validator) addJobs(jobs []*job) {
+	now := time.Now()
+	v.Lock()
+	defer v.Unlock()
+	for _, j := range jobs {
+		if j.dml == nil {
+			continue
+		}
+		for _, row := range v.genValidateRows(j.targetTable, j.dml) {
+			row.nextCheck = now
+			v.rows[row.key] = row
+		}
+	}
+}
+
+// genValidateRows generates the rows to be validated of a DML. the DMLs of the table without primary key or not null
+// unique key are not validated.
+func (v *validator) genValidateRows(targetTable *filter.Table, dml *DML) []*validateRow {
+	if dml.downstreamTableInfo == nil || len(dml.columns) != len(dml.values) {
+		return nil
+	}
+	pkNames := dml.identifyColumns()
+	if len(pkNames) == 0 {
+		return nil
+	}
+	columns := dml.columnNames()
+	pkIdx := make([]int, 0, len(pkNames))
+	for _, name := range pkNames {
+		idx := -1
+		for i, column := range columns {
+			if strings.EqualFold(column, name) {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			return nil
+		}
+		pkIdx = append(pkIdx, idx)
+	}
+
+	table := v.dialect.tableName(targetTable.Schema, targetTable.Name)
+	newRow := func(values []interface{}, deleted bool) *validateRow {
+		strValues := make([]*string, len(values))
+		for i, value := range values {
+			if value != nil {
+				str := columnValue(value, &dml.columns[i].FieldType)
+				strValues[i] = &str
+			}
+		}
+		pkValues := make([]string, 0, len(pkIdx))
+		for _, idx := range pkIdx {
+			if strValues[idx] == nil {
+				return nil
+			}
+			pkValues = append(pkValues, *strValues[idx])
+		}
+		row := &validateRow{
+			key:      fmt.Sprintf("%s:%s", dml.targetTableID, strings.Join(pkValues, ",")),
+			table:    table,
+			columns:  columns,
+			pkIdx:    pkIdx,
+			pkValues: pkValues,
+			values:   strValues,
+		}
+		if deleted {
+			row.values = nil
+		}
+		return row
+	}
+
+	var rows []*validateRow
+	switch dml.op {
+	case insert:
+		rows = append(rows, newRow(dml.values, false))
+	case update:
+		if dml.updateIdentify() && len(dml.oldValues) == len(dml.values) {
+			rows = append(rows, newRow(dml.oldValues, true))
+		}
+		rows = append(rows, newRow(dml.values, false))
+	case del:
+		rows = append(rows, newRow(dml.values, true))
+	}
+	ret := rows[:0]
+	for _, row := range rows {
+		if row != nil {
+			ret = append(ret, row)
+		}
+	}
+	return ret
+}
+
+// run validates the rows every interval until ctx is done.
+func (v *validator) run(tctx *tcontext.Context) {
+	v.logger.Info("start validator", zap.String("mode", string(v.cfg.ValidationMode)), zap.Duration("interval", v.interval))
+	ticker := time.NewTicker(v.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-tctx.Ctx.Done():
+			return
+		case now := <-ticker.C:
+			v.validate(tctx.Ctx, now)
+		}
+	}
+}
+
+// validate validates the rows whose re-check time is not after now. the consistent rows are removed, and the
+// mismatched rows are re-checked with exponential backoff, they're taken as failed after retried too many times.
+func (v *validator) validate(ctx context.Context, now time.Time) {
+	chunkSize := 1
+	if v.cfg.ValidationMode == config.ValidationChunk {
+		chunkSize = v.cfg.ValidationChunkSize
+	}
+
+	v.Lock()
+	chunks := make(map[string][]*validateRow)
+	for _, row := range v.rows {
+		if row.nextCheck.After(now) {
+			continue
+		}
+		key := row.chunkKey()
+		chunks[key] = append(chunks[key], row)
+	}
+	v.Unlock()
+
+	for _, rows := range chunks {
+		for len(rows) > 0 {
+			n := chunkSize
+			if n > len(rows) {
+				n = len(rows)
+			}
+			chunk := rows[:n]
+			rows = rows[n:]
+
+			matched, err := v.validateChunk(ctx, chunk)
+			if err != nil {
+				v.logger.Warn("fail to validate rows", zap.String("table", chunk[0].table), zap.Int("rows", len(chunk)), log.ShortError(err))
+			}
+			v.updateRows(chunk, matched, now)
+		}
+	}
+
+	pending, failed := v.count()
+	metrics.ValidatorRowsGauge.WithLabelValues("pending", v.cfg.Name, v.cfg.SourceID).Set(float64(pending))
+	metrics.ValidatorRowsGauge.WithLabelValues("failed", v.cfg.Name, v.cfg.SourceID).Set(float64(failed))
+}
+
+// updateRows updates the rows by the result of validation, matched is nil if the validation is failed because of an
+// error. the rows changed again during validation are left as is.
+func (v *validator) updateRows(rows []*validateRow, matched []bool, now time.Time) {
+	v.Lock()
+	defer v.Unlock()
+	var validated int64
+	for i, row := range rows {
+		if v.rows[row.key] != row {
+			continue
+		}
+		switch {
+		case matched == nil:
+			row.nextCheck = now.Add(v.interval)
+		case matched[i]:
+			delete(v.rows, row.key)
+			validated++
+		default:
+			row.retry++
+			shift := row.retry
+			if shift > maxValidationBackoffShift {
+				shift = maxValidationBackoffShift
+			}
+			row.nextCheck = now.Add(v.interval << shift)
+			if !row.failed && row.retry >= v.cfg.ValidationMaxRetry {
+				row.failed = true
+				v.logger.Warn("row is inconsistent with upstream", zap.String("row", row.key), zap.Int("retry", row.retry))
+			}
+		}
+	}
+	v.validated.Add(validated)
+	metrics.ValidatedRowsTotal.WithLabelValues(v.cfg.Name, v.cfg.SourceID).Add(float64(validated))
+}
+
+// validateChunk queries the rows of a chunk from downstream and returns whether every row is consistent with upstream.
+// the checksum of the whole chunk is compared first, and the checksum of every row is compared if it's mismatched.
+func (v *validator) validateChunk(ctx context.Context, rows []*validateRow) ([]bool, error) {
+	first := rows[0]
+	var (
+		buf  strings.Builder
+		args = make([]interface{}, 0, len(rows)*len(first.pkIdx))
+	)
+	buf.WriteString("SELECT ")
+	for i, column := range first.columns {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(v.dialect.quoteName(column))
+	}
+	buf.WriteString(" FROM ")
+	buf.WriteString(first.table)
+	buf.WriteString(" WHERE ")
+	if len(rows) == 1 {
+		for i, idx := range first.pkIdx {
+			if i > 0 {
+				buf.WriteString(" AND ")
+			}
+			buf.WriteString(v.dialect.quoteName(first.columns[idx]) + " = ?")
+		}
+	} else {
+		pkNames := make([]string, 0, len(first.pkIdx))
+		for _, idx := range first.pkIdx {
+			pkNames = append(pkNames, v.dialect.quoteName(first.columns[idx]))
+		}
+		holder := "(" + strings.TrimSuffix(strings.Repeat("?,", len(pkNames)), ",") + ")"
+		buf.WriteString("(" + strings.Join(pkNames, ",") + ") IN (")
+		buf.WriteString(strings.TrimSuffix(strings.Repeat(holder+",", len(rows)), ","))
+		buf.WriteString(")")
+	}
+	for _, row := range rows {
+		for _, pkValue := range row.pkValues {
+			args = append(args, pkValue)
+		}
+	}
+
+	sqlRows, err := v.db.DB.QueryContext(ctx, v.dialect.rebind(buf.String()), args...)
+	if err != nil {
+		return nil, terror.DBErrorAdapt(err, terror.ErrDBDriverError)
+	}
+	defer sqlRows.Close()
+
+	actual := make(map[string]uint32, len(rows))
+	var actualSum uint32
+	for sqlRows.Next() {
+		values := make([]sql.NullString, len(first.columns))
+		dest := make([]interface{}, len(values))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err = sqlRows.Scan(dest...); err != nil {
+			return nil, terror.DBErrorAdapt(err, terror.ErrDBDriverError)
+		}
+		strValues := make([]*string, len(values))
+		pkValues := make([]string, 0, len(first.pkIdx))
+		for i := range values {
+			if values[i].Valid {
+				strValues[i] = &values[i].String
+			}
+		}
+		for _, idx := range first.pkIdx {
+			pkValues = append(pkValues, values[idx].String)
+		}
+		checksum := rowChecksum(strValues)
+		actual[strings.Join(pkValues, "\x00")] = checksum
+		actualSum ^= checksum
+	}
+	if err = sqlRows.Err(); err != nil {
+		return nil, terror.DBErrorAdapt(err, terror.ErrDBDriverError)
+	}
+
+	expected := make([]uint32, len(rows))
+	var (
+		expectedSum   uint32
+		expectedCount int
+	)
+	for i, row := range rows {
+		if row.values != nil {
+			expected[i] = rowChecksum(row.values)
+			expectedSum ^= expected[i]
+			expectedCount++
+		}
+	}
+
+	matched := make([]bool, len(rows))
+	chunkMatched := expectedCount == len(actual) && expectedSum == actualSum
+	for i, row := range rows {
+		if chunkMatched {
+			matched[i] = true
+			continue
+		}
+		checksum, ok := actual[strings.Join(row.pkValues, "\x00")]
+		if row.values == nil {
+			matched[i] = !ok
+		} else {
+			matched[i] = ok && checksum == expected[i]
+		}
+	}
+	return matched, nil
+}
+
+// rowChecksum returns the checksum of the values of a row.
+func rowChecksum(values []*string) uint32 {
+	h := crc32.NewIEEE()
+	for _, value := range values {
+		if value == nil {
+			_, _ = h.Write([]byte{'N'})
+			continue
+		}
+		_, _ = h.Write([]byte(strconv.Itoa(len(*value)) + ":" + *value))
+	}
+	return h.Sum32()
+}
+
+// count returns the number of pending and failed rows.
+func (v *validator) count() (pending, failed int64) {
+	v.Lock()
+	defer v.Unlock()
+	for _, row := range v.rows {
+		if row.failed {
+			failed++
+		} else {
+			pending++
+		}
+	}
+	return pending, failed
+}
+
+// status returns the status of validation.
+func (v *validator) status() *pb.ValidationStatus {
+	v.Lock()
+	var (
+		pending int64
+		keys    []string
+	)
+	for _, row := range v.rows {
+		if row.failed {
+			keys = append(keys, row.key)
+		} else {
+			pending++
+		}
+	}
+	v.Unlock()
+
+	sort.Strings(keys)
+	st := &pb.ValidationStatus{
+		Mode:          string(v.cfg.ValidationMode),
+		ValidatedRows: v.validated.Load(),
+		PendingRows:   pending,
+		FailedRows:    int64(len(keys)),
+		FailedRowKeys: keys,
+	}
+	if len(keys) > maxFailedRowKeys {
+		st.FailedRowKeys = keys[:maxFailedRowKeys]
+	}
+	return st
+}
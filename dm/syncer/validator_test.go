@@ -0,0 +1,106 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/util/mock"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/schema"
+)
+
+func (s *testSyncerSuite) TestValidator(c *C) {
+	ti, err := createTableInfo(parser.New(), mock.NewContext(), 0, "create table db.tb(id int primary key, name varchar(24))")
+	c.Assert(err, IsNil)
+	downTi := schema.GetDownStreamTI(ti, ti)
+	table := &filter.Table{Schema: "db", Name: "tb"}
+	newJob := func(op opType, oldValues, values []interface{}) *job {
+		dml := newDML(op, false, "`db`.`tb`", table, oldValues, values, oldValues, values, ti.Columns, ti, downTi.AbsoluteUKIndexInfo, downTi)
+		return &job{tp: op, targetTable: table, dml: dml}
+	}
+
+	db, dbMock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	cfg := &config.SubTaskConfig{Name: "test", SourceID: "source"}
+	cfg.ValidationMode = config.ValidationChunk
+	cfg.ValidationChunkSize = 10
+	cfg.ValidationInterval = 1
+	cfg.ValidationMaxRetry = 2
+	v := newValidator(cfg, conn.NewBaseDB(db), mysqlDialect{}, log.L())
+	ctx := context.Background()
+
+	v.addJobs([]*job{
+		newJob(insert, nil, []interface{}{int32(1), "a"}),
+		newJob(insert, nil, []interface{}{int32(2), "b"}),
+		newJob(del, nil, []interface{}{int32(3), "c"}),
+		newJob(update, []interface{}{int32(4), "d"}, []interface{}{int32(5), "e"}),
+		{tp: flush},
+	})
+	st := v.status()
+	c.Assert(st.Mode, Equals, "chunk")
+	c.Assert(st.PendingRows, Equals, int64(5))
+
+	// the chunk checksum is mismatched, so the rows are compared one by one
+	now := time.Now()
+	dbMock.ExpectQuery("SELECT `id`, `name` FROM `db`.`tb` WHERE \\(`id`\\) IN \\(\\(\\?\\),\\(\\?\\),\\(\\?\\),\\(\\?\\),\\(\\?\\)\\)").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("1", "a").AddRow("2", "x").AddRow("3", "c"))
+	v.validate(ctx, now)
+	st = v.status()
+	c.Assert(st.ValidatedRows, Equals, int64(2))
+	c.Assert(st.PendingRows, Equals, int64(3))
+	c.Assert(st.FailedRows, Equals, int64(0))
+
+	// the mismatched rows are re-checked with backoff
+	v.validate(ctx, now.Add(time.Second))
+	c.Assert(dbMock.ExpectationsWereMet(), IsNil)
+
+	dbMock.ExpectQuery("SELECT `id`, `name` FROM `db`.`tb` WHERE .*").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("2", "b").AddRow("3", "c").AddRow("5", "e"))
+	v.validate(ctx, now.Add(2*time.Second))
+	st = v.status()
+	c.Assert(st.ValidatedRows, Equals, int64(4))
+	c.Assert(st.PendingRows, Equals, int64(0))
+	c.Assert(st.FailedRows, Equals, int64(1))
+	c.Assert(st.FailedRowKeys, DeepEquals, []string{"`db`.`tb`:3"})
+
+	// a new change of the failed row makes it pending again
+	v.addJobs([]*job{newJob(insert, nil, []interface{}{int32(3), "c"})})
+	dbMock.ExpectQuery("SELECT `id`, `name` FROM `db`.`tb` WHERE `id` = \\?").WithArgs("3").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("3", "c"))
+	v.validate(ctx, time.Now())
+	st = v.status()
+	c.Assert(st.ValidatedRows, Equals, int64(5))
+	c.Assert(st.FailedRows, Equals, int64(0))
+
+	// the rows are not retried for errors of downstream
+	cfg.ValidationMode = config.ValidationRow
+	v.addJobs([]*job{newJob(insert, nil, []interface{}{int32(6), nil})})
+	dbMock.ExpectQuery("SELECT `id`, `name` FROM `db`.`tb` WHERE `id` = \\?").WithArgs("6").
+		WillReturnError(errors.New("connection refused"))
+	v.validate(ctx, time.Now())
+	c.Assert(v.rows["`db`.`tb`:6"].retry, Equals, 0)
+	c.Assert(dbMock.ExpectationsWereMet(), IsNil)
+
+	c.Assert(rowChecksum([]*string{nil}), Not(Equals), rowChecksum([]*string{new(string)}))
+}
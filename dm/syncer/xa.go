@@ -0,0 +1,214 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"strings"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/binlog/event"
+)
+
+// xaOp is the operation of a XA statement in binlog.
+type xaOp int
+
+const (
+	xaNone xaOp = iota
+	xaStart
+	xaEnd
+	xaCommit
+	xaRollback
+)
+
+var xaStatementPrefixes = []struct {
+	prefix string
+	op     xaOp
+}{
+	{"XA START ", xaStart},
+	{"XA BEGIN ", xaStart},
+	{"XA END ", xaEnd},
+	{"XA COMMIT ", xaCommit},
+	{"XA ROLLBACK ", xaRollback},
+}
+
+// parseXAStatement parses a XA statement of a QueryEvent, and returns the operation and the normalized xid.
+// it returns xaNone if `query` is not a XA statement.
+func parseXAStatement(query string) (xaOp, string) {
+	query = strings.TrimSpace(query)
+	for _, p := range xaStatementPrefixes {
+		if len(query) > len(p.prefix) && strings.EqualFold(query[:len(p.prefix)], p.prefix) {
+			xid := query[len(p.prefix):]
+			if p.op == xaCommit {
+				// `XA COMMIT xid ONE PHASE` is written as a XA_PREPARE_LOG_EVENT, but trim it to be safe.
+				xid = trimSuffixFold(xid, "ONE PHASE")
+			}
+			return p.op, event.NormalizeXID(xid)
+		}
+	}
+	return xaNone, ""
+}
+
+func trimSuffixFold(s, suffix string) string {
+	s = strings.TrimSpace(s)
+	if len(s) >= len(suffix) && strings.EqualFold(s[len(s)-len(suffix):], suffix) {
+		return strings.TrimSpace(s[:len(s)-len(suffix)])
+	}
+	return s
+}
+
+// xaTxn is a XA transaction which is read from binlog but not committed yet.
+type xaTxn struct {
+	xid string
+	// startLocation is the end location of the last transaction before `XA START`, the global checkpoint should not
+	// be advanced past it before the XA transaction is committed or rolled back.
+	startLocation binlog.Location
+	jobs          []*job
+}
+
+// xaTransactions buffers the DML jobs of XA transactions.
+//
+// for a XA transaction, MySQL writes `XA START`, the row events, `XA END` and a XA_PREPARE_LOG_EVENT when it's
+// prepared, and writes `XA COMMIT` or `XA ROLLBACK` as another event group when it's resolved, which may be far away
+// and interleaved with other transactions. so the jobs are kept in memory until the XA transaction is committed, and
+// the checkpoint is held before the earliest unresolved one, the interleaved transactions replicated again after
+// restarting are handled by safe mode.
+type xaTransactions struct {
+	current  *xaTxn            // the XA transaction being read, between `XA START` and the XA_PREPARE_LOG_EVENT
+	prepared map[string]*xaTxn // xid -> prepared XA transaction
+}
+
+func newXATransactions() *xaTransactions {
+	return &xaTransactions{prepared: make(map[string]*xaTxn)}
+}
+
+// start starts reading a XA transaction, `startLocation` is the end location of the last transaction.
+func (x *xaTransactions) start(xid string, startLocation binlog.Location) {
+	x.current = &xaTxn{xid: xid, startLocation: startLocation.Clone()}
+}
+
+// reading returns true when a XA transaction is being read, the DML jobs should be buffered by add.
+func (x *xaTransactions) reading() bool {
+	return x.current != nil
+}
+
+// add buffers `j` into the XA transaction being read.
+func (x *xaTransactions) add(j *job) {
+	x.current.jobs = append(x.current.jobs, j)
+}
+
+// prepare finishes reading the current XA transaction. the jobs of a one-phase committed transaction are returned to
+// be executed at once, otherwise the transaction is kept until it's committed or rolled back.
+func (x *xaTransactions) prepare(onePhase bool) []*job {
+	txn := x.current
+	x.current = nil
+	if txn == nil {
+		return nil
+	}
+	if onePhase {
+		return txn.jobs
+	}
+	x.prepared[txn.xid] = txn
+	return nil
+}
+
+// resolve removes a prepared XA transaction, and returns its jobs and whether it's found.
+func (x *xaTransactions) resolve(xid string) ([]*job, bool) {
+	txn, ok := x.prepared[xid]
+	if !ok {
+		return nil, false
+	}
+	delete(x.prepared, xid)
+	return txn.jobs, true
+}
+
+// adjustLocation returns the earlier one of `location` and the start locations of the unresolved XA transactions.
+func (x *xaTransactions) adjustLocation(cmp *binlog.LocationComparator, location binlog.Location) binlog.Location {
+	if x.current != nil && cmp.Compare(x.current.startLocation, location) < 0 {
+		location = x.current.startLocation
+	}
+	for _, txn := range x.prepared {
+		if cmp.Compare(txn.startLocation, location) < 0 {
+			location = txn.startLocation
+		}
+	}
+	return location
+}
+
+// reset drops all XA transactions, it's called when the binlog stream is re-read from the checkpoint.
+func (x *xaTransactions) reset() {
+	x.current = nil
+	x.prepared = make(map[string]*xaTxn)
+}
+
+// handleXAStatement handles the XA statements in QueryEvent. the DML jobs after `XA START` are buffered, and they are
+// sent to the queue when the XA transaction is committed.
+func (s *Syncer) handleXAStatement(op xaOp, xid string, ec eventContext) error {
+	switch op {
+	case xaStart:
+		s.xa.start(xid, *ec.lastLocation)
+		// like BEGIN, see handleQueryEvent
+		*ec.lastLocation = *ec.currentLocation
+		return nil
+	case xaEnd:
+		return nil
+	}
+
+	jobs, ok := s.xa.resolve(xid)
+	if !ok {
+		// the XA transaction may be prepared before the location where the task starts
+		ec.tctx.L().Warn("XA transaction not found, ignore it", zap.String("xid", xid), zap.Stringer("location", ec.currentLocation))
+	}
+	if op == xaCommit {
+		if err := s.addXAJobs(jobs); err != nil {
+			return err
+		}
+	}
+	*ec.lastLocation = *ec.currentLocation
+	job := newXIDJob(*ec.currentLocation, *ec.startLocation, *ec.currentLocation)
+	_, err := s.handleJobFunc(job)
+	return err
+}
+
+// handleXAPrepareEvent handles the XA_PREPARE_LOG_EVENT which ends the event group of a XA transaction.
+func (s *Syncer) handleXAPrepareEvent(e *replication.BinlogEvent, ec eventContext) error {
+	x, err := event.DecodeXAPrepareEvent(e)
+	if err != nil {
+		return err
+	}
+	if !s.xa.reading() {
+		ec.tctx.L().Warn("XA START not found, ignore the XA transaction", zap.String("xid", x.XID()), zap.Stringer("location", ec.currentLocation))
+	}
+	if err = s.addXAJobs(s.xa.prepare(x.OnePhase)); err != nil {
+		return err
+	}
+
+	ec.currentLocation.Position.Pos = e.Header.LogPos
+	ec.lastLocation.Position.Pos = e.Header.LogPos
+	job := newXIDJob(*ec.currentLocation, *ec.startLocation, *ec.currentLocation)
+	_, err = s.handleJobFunc(job)
+	return err
+}
+
+func (s *Syncer) addXAJobs(jobs []*job) error {
+	for _, j := range jobs {
+		added2Queue, err := s.handleJobFunc(j)
+		if err != nil || !added2Queue {
+			return err
+		}
+	}
+	return nil
+}
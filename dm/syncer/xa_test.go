@@ -0,0 +1,145 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/binlog/event"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+)
+
+var _ = Suite(&testXASuite{})
+
+type testXASuite struct{}
+
+func (t *testXASuite) TestParseXAStatement(c *C) {
+	cases := []struct {
+		query string
+		op    xaOp
+		xid   string
+	}{
+		{"XA START X'6162',X'',1", xaStart, "x'6162',x'',1"},
+		{"xa begin X'6162', X'', 1", xaStart, "x'6162',x'',1"},
+		{" XA END X'6162',X'',1 ", xaEnd, "x'6162',x'',1"},
+		{"XA COMMIT X'6162',X'',1", xaCommit, "x'6162',x'',1"},
+		{"XA COMMIT X'6162',X'',1 ONE PHASE", xaCommit, "x'6162',x'',1"},
+		{"XA ROLLBACK X'6162',X'',1", xaRollback, "x'6162',x'',1"},
+		{"BEGIN", xaNone, ""},
+		{"XA RECOVER", xaNone, ""},
+		{"CREATE TABLE xa (c INT)", xaNone, ""},
+	}
+	for _, cs := range cases {
+		op, xid := parseXAStatement(cs.query)
+		c.Assert(op, Equals, cs.op, Commentf("query %s", cs.query))
+		c.Assert(xid, Equals, cs.xid, Commentf("query %s", cs.query))
+	}
+	c.Assert(event.NormalizeXID((&event.XAPrepare{FormatID: 1, Gtrid: []byte("ab")}).XID()), Equals, "x'6162',x'',1")
+}
+
+func (t *testXASuite) TestHandleXATransactions(c *C) {
+	cfg := &config.SubTaskConfig{Flavor: mysql.MySQLFlavor}
+	s := &Syncer{
+		cfg:         cfg,
+		tctx:        tcontext.Background(),
+		xa:          newXATransactions(),
+		locationCmp: newLocationComparator(cfg),
+	}
+	var jobs []*job
+	s.handleJobFunc = func(j *job) (bool, error) {
+		jobs = append(jobs, j)
+		return true, nil
+	}
+
+	loc := func(pos uint32) binlog.Location {
+		return binlog.InitLocation(mysql.Position{Name: "mysql-bin.000001", Pos: pos}, nil)
+	}
+	var (
+		startLocation   binlog.Location
+		currentLocation binlog.Location
+		lastLocation    = loc(100)
+		ec              = eventContext{
+			tctx:            s.tctx,
+			startLocation:   &startLocation,
+			currentLocation: &currentLocation,
+			lastLocation:    &lastLocation,
+		}
+	)
+	query := func(sql string, pos uint32) {
+		startLocation, currentLocation = lastLocation, loc(pos)
+		op, xid := parseXAStatement(sql)
+		c.Assert(op, Not(Equals), xaNone)
+		c.Assert(s.handleXAStatement(op, xid, ec), IsNil)
+	}
+	dml := func(pos uint32) *job {
+		c.Assert(s.xa.reading(), IsTrue)
+		j := &job{tp: insert, currentLocation: loc(pos)}
+		s.xa.add(j)
+		return j
+	}
+	prepare := func(onePhase bool, gtrid string, pos uint32) {
+		ev, err := event.GenXAPrepareEvent(&replication.EventHeader{}, lastLocation.Position.Pos, onePhase, 1, []byte(gtrid), nil)
+		c.Assert(err, IsNil)
+		ev.Header.LogPos = pos
+		c.Assert(s.handleXAPrepareEvent(ev, ec), IsNil)
+		c.Assert(currentLocation.Position.Pos, Equals, pos)
+		c.Assert(lastLocation.Position.Pos, Equals, pos)
+	}
+
+	// a prepared XA transaction is buffered, and the location is held before it
+	query("XA START X'61',X'',1", 200)
+	c.Assert(lastLocation, DeepEquals, loc(200))
+	j1 := dml(300)
+	query("XA END X'61',X'',1", 400)
+	c.Assert(jobs, HasLen, 0)
+	prepare(false, "a", 500)
+	c.Assert(jobs, HasLen, 1)
+	c.Assert(jobs[0].tp, Equals, xid)
+	c.Assert(s.xa.adjustLocation(s.locationCmp, loc(500)), DeepEquals, loc(100))
+
+	// a one-phase committed XA transaction is executed at once
+	query("XA START X'62',X'',1", 600)
+	j2 := dml(700)
+	query("XA END X'62',X'',1", 800)
+	prepare(true, "b", 900)
+	c.Assert(jobs[1:], DeepEquals, []*job{j2, jobs[2]})
+	c.Assert(jobs[2].tp, Equals, xid)
+	c.Assert(s.xa.adjustLocation(s.locationCmp, loc(900)), DeepEquals, loc(100))
+
+	// another prepared XA transaction which is rolled back
+	query("XA START X'63',X'',1", 1000)
+	dml(1100)
+	query("XA END X'63',X'',1", 1200)
+	prepare(false, "c", 1300)
+	query("XA ROLLBACK X'63',X'',1", 1400)
+	c.Assert(jobs, HasLen, 5)
+	c.Assert(jobs[4].tp, Equals, xid)
+	c.Assert(s.xa.adjustLocation(s.locationCmp, loc(1400)), DeepEquals, loc(100))
+
+	// the first one is committed, and the location is not held anymore
+	query("XA COMMIT X'61',X'',1", 1500)
+	c.Assert(jobs[5:], DeepEquals, []*job{j1, jobs[6]})
+	c.Assert(jobs[6].tp, Equals, xid)
+	c.Assert(jobs[6].location, DeepEquals, loc(1500))
+	c.Assert(s.xa.adjustLocation(s.locationCmp, loc(1500)), DeepEquals, loc(1500))
+
+	// unknown XA transaction
+	query("XA COMMIT X'64',X'',1", 1600)
+	c.Assert(jobs, HasLen, 8)
+	c.Assert(jobs[7].tp, Equals, xid)
+}
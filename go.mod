@@ -44,7 +44,8 @@ require (
 	github.com/jarcoal/httpmock v1.0.5
 	github.com/jmoiron/sqlx v1.3.3
 	github.com/kami-zh/go-capturer v0.0.0-20171211120116-e492ea43421d
-	github.com/lib/pq v1.3.0 // indirect
+	github.com/klauspost/compress v1.11.7
+	github.com/lib/pq v1.3.0
 	github.com/linkedin/goavro/v2 v2.9.8
 	github.com/mattn/go-colorable v0.1.11 // indirect
 	github.com/mattn/go-shellwords v1.0.12